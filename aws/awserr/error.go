@@ -53,6 +53,14 @@ func New(code, message string, origErr error) Error {
 	return newBaseError(code, message, origErr)
 }
 
+// Wrap returns an Error object described by the code, message, and origErr.
+//
+// Unlike New, origErr is always wrapped within the new Error object, even if
+// it satisfies the Error interface itself.
+func Wrap(code, message string, origErr error) Error {
+	return newBaseError(code, message, origErr)
+}
+
 // A RequestFailure is an interface to extract request failure information from
 // an Error such as the request ID of the failed request returned by a service.
 // RequestFailures may not always have a requestID value if the request failed
@@ -0,0 +1,322 @@
+// Package awstesting provides utilities for testing code which uses the SDK
+// without making requests to AWS.
+package awstesting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// A RecorderMode is the mode a Recorder is run in.
+type RecorderMode int
+
+const (
+	// ModeReplay replays the responses of the requests saved in the cassette,
+	// without sending them.
+	ModeReplay RecorderMode = iota
+
+	// ModeRecord sends requests, and records them with their responses so
+	// they can be saved to the cassette.
+	ModeRecord
+)
+
+// strippedHeaders are the request headers which are not saved to cassettes
+// because they contain credentials, or change with every request.
+var strippedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Date",
+}
+
+// strippedQuery are the query parameters of presigned requests which are not
+// saved to cassettes, or matched when replaying requests.
+var strippedQuery = []string{
+	"X-Amz-Credential",
+	"X-Amz-Date",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+}
+
+// A Cassette is the list of request and response interactions a Recorder
+// saves and replays.
+type Cassette struct {
+	Interactions []Interaction
+}
+
+// An Interaction is a request and the response it received.
+type Interaction struct {
+	Request  RecordedRequest
+	Response RecordedResponse
+}
+
+// A RecordedRequest is a request saved to a cassette.
+type RecordedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// A RecordedResponse is a response saved to a cassette.
+type RecordedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// A Recorder is an http.RoundTripper which records requests and their
+// responses to a cassette file, and replays them from it. It can be used to
+// test code using service clients deterministically, and without network
+// access, by setting its client as the Config's HTTPClient.
+//
+// Replayed requests are matched to the recorded requests by method, path,
+// query, and normalized body. Each recorded interaction is replayed once, in
+// the order they were recorded.
+//
+// Example:
+//     rec, err := awstesting.NewRecorder("testdata/query.json", awstesting.ModeReplay)
+//     if err != nil {
+//         t.Fatal(err)
+//     }
+//     defer rec.Save()
+//
+//     svc := dynamodb.New(&aws.Config{HTTPClient: rec.Client()})
+type Recorder struct {
+	// The file the cassette is loaded from and saved to.
+	Filename string
+	Mode     RecorderMode
+
+	// The transport requests are sent with in ModeRecord. Defaults to
+	// http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// NewRecorder returns a Recorder for the cassette file in the mode provided.
+// In ModeReplay the cassette is loaded from the file, and an error returned
+// if it cannot be read.
+func NewRecorder(filename string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{Filename: filename, Mode: mode}
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to load cassette %s, %v", filename, err)
+	}
+	return r, nil
+}
+
+// Client returns an http.Client which sends its requests with the Recorder.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Save writes the interactions recorded to the cassette file. Save does
+// nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.Mode == ModeReplay {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.Filename, b, 0644)
+}
+
+// RoundTrip records or replays the request depending on the Recorder's mode.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+// record sends the request, and records it with its response.
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	header := http.Header{}
+	for k, v := range req.Header {
+		header[k] = v
+	}
+	for _, k := range strippedHeaders {
+		header.Del(k)
+	}
+
+	u := *req.URL
+	u.RawQuery = stripQuery(u.Query()).Encode()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    u.String(),
+			Header: header,
+			Body:   body,
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       respBody,
+		},
+	})
+
+	return resp, nil
+}
+
+// replay returns the response of the first recorded request matching the
+// request which has not been replayed yet.
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.replayed == nil {
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	}
+	for i, in := range r.cassette.Interactions {
+		if r.replayed[i] || !requestMatches(in.Request, req, body) {
+			continue
+		}
+		r.replayed[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header,
+			Body:          ioutil.NopCloser(bytes.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction in %s matches request %s %s",
+		r.Filename, req.Method, req.URL.String())
+}
+
+// requestMatches returns if the recorded request has the same method, path,
+// query, and normalized body as the request.
+func requestMatches(recorded RecordedRequest, req *http.Request, body []byte) bool {
+	if recorded.Method != req.Method {
+		return false
+	}
+
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	if requestPath(u) != requestPath(req.URL) {
+		return false
+	}
+	if !reflect.DeepEqual(stripQuery(u.Query()), stripQuery(req.URL.Query())) {
+		return false
+	}
+
+	contentType := recorded.Header.Get("Content-Type")
+	return bytes.Equal(normalizeBody(contentType, recorded.Body), normalizeBody(contentType, body))
+}
+
+// normalizeBody returns the body with its formatting removed, so bodies
+// which only differ in whitespace, or the order of their fields, match.
+func normalizeBody(contentType string, body []byte) []byte {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if v, err := url.ParseQuery(string(body)); err == nil {
+			return []byte(v.Encode())
+		}
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if b, err := json.Marshal(v); err == nil {
+			return b
+		}
+	}
+
+	return bytes.TrimSpace(body)
+}
+
+// requestPath returns the unescaped path of the URL. The REST protocols build
+// their escaped paths into the URL's Opaque instead of its Path.
+func requestPath(u *url.URL) string {
+	if u.Opaque == "" {
+		return u.Path
+	}
+
+	p := u.Opaque
+	if strings.HasPrefix(p, "//") {
+		if i := strings.Index(p[2:], "/"); i >= 0 {
+			p = p[2+i:]
+		} else {
+			p = "/"
+		}
+	}
+	if pu, err := url.Parse(p); err == nil {
+		return pu.Path
+	}
+	return p
+}
+
+// stripQuery removes the presigned request parameters from the query.
+func stripQuery(query url.Values) url.Values {
+	for _, k := range strippedQuery {
+		query.Del(k)
+	}
+	return query
+}
+
+// readBody reads the request's body, and replaces it so it can be read again.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -0,0 +1,128 @@
+package awstesting_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awstesting"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func tempCassette(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "awstesting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "cassette.json"), func() { os.RemoveAll(dir) }
+}
+
+func testConfig(endpoint string, client *http.Client) *aws.Config {
+	return &aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		Region:      aws.String("mock-region"),
+		Endpoint:    aws.String(endpoint),
+		DisableSSL:  aws.Boolean(true),
+		HTTPClient:  client,
+		MaxRetries:  aws.Int(0),
+	}
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	filename, cleanup := tempCassette(t)
+	defer cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/bucket/") {
+			w.Header().Set("ETag", `"etag"`)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"TableNames":["%d"]}`, len(b))
+	}))
+
+	rec, err := awstesting.NewRecorder(filename, awstesting.ModeRecord)
+	assert.NoError(t, err)
+
+	db := dynamodb.New(testConfig(server.URL, rec.Client()))
+	out, err := db.ListTables(&dynamodb.ListTablesInput{Limit: aws.Long(10)})
+	assert.NoError(t, err)
+	assert.Equal(t, "12", *out.TableNames[0])
+
+	svc := s3.New(testConfig(server.URL, rec.Client()))
+	svc.Config.S3ForcePathStyle = aws.Boolean(true)
+	put, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("a key+"),
+		Body:   bytes.NewReader([]byte{0, 1, 2, 0xff}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `"etag"`, *put.ETag)
+
+	assert.NoError(t, rec.Save())
+	server.Close()
+
+	b, err := ioutil.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "Authorization")
+	assert.NotContains(t, string(b), "X-Amz-Security-Token")
+	assert.NotContains(t, string(b), "X-Amz-Date")
+
+	rec, err = awstesting.NewRecorder(filename, awstesting.ModeReplay)
+	assert.NoError(t, err)
+
+	db = dynamodb.New(testConfig(server.URL, rec.Client()))
+	out, err = db.ListTables(&dynamodb.ListTablesInput{Limit: aws.Long(10)})
+	assert.NoError(t, err)
+	assert.Equal(t, "12", *out.TableNames[0])
+
+	svc = s3.New(testConfig(server.URL, rec.Client()))
+	svc.Config.S3ForcePathStyle = aws.Boolean(true)
+	put, err = svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("a key+"),
+		Body:   bytes.NewReader([]byte{0, 1, 2, 0xff}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `"etag"`, *put.ETag)
+
+	// each interaction is only replayed once
+	_, err = db.ListTables(&dynamodb.ListTablesInput{Limit: aws.Long(10)})
+	assert.Error(t, err)
+}
+
+func TestRecorderReplayMatchesNormalizedBody(t *testing.T) {
+	filename, cleanup := tempCassette(t)
+	defer cleanup()
+
+	cassette := `{"Interactions":[
+	{"Request":{"Method":"POST","URL":"http://example.com/?b=2&a=1","Header":{"Content-Type":["application/json"]},"Body":"eyJhIjogMSwgImIiOiAyfQ=="},
+	 "Response":{"StatusCode":200,"Header":{},"Body":"b2s="}}]}`
+	assert.NoError(t, ioutil.WriteFile(filename, []byte(cassette), 0644))
+
+	rec, err := awstesting.NewRecorder(filename, awstesting.ModeReplay)
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest("POST", "http://other.example.com/?a=1&b=2&X-Amz-Signature=abc",
+		strings.NewReader(`{"b":2,"a":1}`))
+	resp, err := rec.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	b, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, "ok", string(b))
+}
+
+func TestRecorderReplayMissingCassette(t *testing.T) {
+	_, err := awstesting.NewRecorder(filepath.Join(os.TempDir(), "awstesting-missing.json"), awstesting.ModeReplay)
+	assert.Error(t, err)
+}
@@ -51,6 +51,15 @@ func rValuesAtPath(v interface{}, path string, create bool, caseSensitive bool)
 
 		nextvals := []reflect.Value{}
 		for _, value := range values {
+			if c == "*" && value.Kind() == reflect.Map { // pull all map values
+				for _, k := range value.MapKeys() {
+					if f := reflect.Indirect(value.MapIndex(k)); f.IsValid() {
+						nextvals = append(nextvals, f)
+					}
+				}
+				continue
+			}
+
 			// pull component name out of struct member
 			if value.Kind() != reflect.Struct {
 				continue
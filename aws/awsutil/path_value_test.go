@@ -35,6 +35,15 @@ func TestValueAtPathSuccess(t *testing.T) {
 	assert.Equal(t, []interface{}{"initial"}, awsutil.ValuesAtPath(data, "A.D.X || C"))
 }
 
+func TestValueAtPathMapWildcard(t *testing.T) {
+	m := struct {
+		M map[string]*Struct
+	}{
+		M: map[string]*Struct{"a": {C: "value1"}},
+	}
+	assert.Equal(t, []interface{}{"value1"}, awsutil.ValuesAtPath(m, "M.*.C"))
+}
+
 func TestValueAtPathFailure(t *testing.T) {
 	assert.Equal(t, []interface{}(nil), awsutil.ValuesAtPath(data, "C.x"))
 	assert.Equal(t, []interface{}(nil), awsutil.ValuesAtPath(data, ".x"))
@@ -50,9 +50,14 @@ func stringValue(v reflect.Value, indent int, buf *bytes.Buffer) {
 
 		for i, n := range names {
 			val := v.FieldByName(n)
+			field, _ := v.Type().FieldByName(n)
 			buf.WriteString(strings.Repeat(" ", indent+2))
 			buf.WriteString(n + ": ")
-			stringValue(val, indent+2, buf)
+			if field.Tag.Get("sensitive") == "true" {
+				buf.WriteString("<sensitive>") // never print sensitive values
+			} else {
+				stringValue(val, indent+2, buf)
+			}
 
 			if i < len(names)-1 {
 				buf.WriteString(",\n")
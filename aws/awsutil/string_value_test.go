@@ -0,0 +1,22 @@
+package awsutil_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type sensitiveStruct struct {
+	Name     *string
+	Password *string `sensitive:"true"`
+}
+
+func TestStringValueSensitive(t *testing.T) {
+	s := sensitiveStruct{Name: aws.String("user"), Password: aws.String("secret")}
+	str := awsutil.StringValue(s)
+	assert.Contains(t, str, `Name: "user"`)
+	assert.Contains(t, str, "Password: <sensitive>")
+	assert.NotContains(t, str, "secret")
+}
@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"io"
 	"net/http"
 	"os"
 	"time"
@@ -12,51 +11,219 @@ import (
 // DefaultChainCredentials is a Credentials which will find the first available
 // credentials Value from the list of Providers.
 //
+// If the program is running in a container with a task role, the container
+// credentials are used ahead of the EC2 instance's role.
+//
 // This should be used in the default case. Once the type of credentials are
 // known switching to the specific Credentials will be more efficient.
-var DefaultChainCredentials = credentials.NewChainCredentials(
-	[]credentials.Provider{
+var DefaultChainCredentials = credentials.NewChainCredentials(defaultCredentialProviders())
+
+// defaultCredentialProviders returns the Providers of DefaultChainCredentials.
+// The ContainerProvider is only included if the container credentials
+// endpoint is set in the environment.
+func defaultCredentialProviders() []credentials.Provider {
+	providers := []credentials.Provider{
 		&credentials.EnvProvider{},
 		&credentials.SharedCredentialsProvider{Filename: "", Profile: ""},
-		&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute},
-	})
+	}
+	if credentials.ContainerCredentialsEndpoint() != "" {
+		providers = append(providers, &credentials.ContainerProvider{ExpiryWindow: 5 * time.Minute})
+	}
+	return append(providers, &credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute})
+}
 
 // The default number of retries for a service. The value of -1 indicates that
 // the service specific retry default will be used.
 const DefaultRetries = -1
 
 // DefaultConfig is the default all service configuration will be based off of.
+//
+// The default region is read from the "AWS_REGION" environment variable.
+// Programs running on EC2 can enable DetectEC2Region to use the region of the
+// instance when it is not set:
+//
+//     aws.DefaultConfig.DetectEC2Region = aws.Boolean(true)
 var DefaultConfig = &Config{
 	Credentials:             DefaultChainCredentials,
-	Endpoint:                "",
-	Region:                  os.Getenv("AWS_REGION"),
-	DisableSSL:              false,
-	ManualSend:              false,
+	Endpoint:                String(""),
+	EndpointResolver:        DefaultEndpointResolver{},
+	Region:                  String(os.Getenv("AWS_REGION")),
+	DisableSSL:              Boolean(false),
+	ManualSend:              Boolean(false),
 	HTTPClient:              http.DefaultClient,
-	LogHTTPBody:             false,
-	LogLevel:                0,
-	Logger:                  os.Stdout,
-	MaxRetries:              DefaultRetries,
-	DisableParamValidation:  false,
-	DisableComputeChecksums: false,
-	S3ForcePathStyle:        false,
+	LogLevel:                LogLevel(LogOff),
+	Logger:                  NewDefaultLogger(),
+	MaxRetries:              Int(DefaultRetries),
+	Retryer:                 nil,
+	Observer:                nil,
+	DisableParamValidation:  Boolean(false),
+	EnableEnumValidation:    Boolean(false),
+	DisableComputeChecksums: Boolean(false),
+	S3ForcePathStyle:        Boolean(false),
+	S3UseLegacySignature:    Boolean(false),
+	DetectEC2Region:         Boolean(false),
 }
 
-// A Config provides service configuration
+// A Config provides service configuration.
+//
+// The value fields of a Config are pointers so that a field which has not
+// been set can be told apart from a field explicitly set to its zero value.
+// Fields which are nil are not set, and will not override the value of the
+// Config they are merged into. Use the With* methods to set the fields of a
+// Config without taking the address of each value.
+//
+//     cfg := aws.NewConfig().WithRegion("us-west-2").WithMaxRetries(5)
 type Config struct {
 	Credentials             *credentials.Credentials
-	Endpoint                string
-	Region                  string
-	DisableSSL              bool
-	ManualSend              bool
+	Endpoint                *string
+	EndpointResolver        EndpointResolver
+	Region                  *string
+	DisableSSL              *bool
+	ManualSend              *bool
 	HTTPClient              *http.Client
-	LogHTTPBody             bool
-	LogLevel                uint
-	Logger                  io.Writer
-	MaxRetries              int
-	DisableParamValidation  bool
-	DisableComputeChecksums bool
-	S3ForcePathStyle        bool
+	LogLevel                *LogLevelType
+	Logger                  Logger
+	MaxRetries              *int
+	Retryer                 Retryer
+	Observer                RequestObserver
+	DisableParamValidation  *bool
+	EnableEnumValidation    *bool
+	DisableComputeChecksums *bool
+	S3ForcePathStyle        *bool
+	S3UseLegacySignature    *bool
+	DetectEC2Region         *bool
+}
+
+// NewConfig returns a new Config pointer with no fields set, which can be
+// chained with the With* builder methods.
+func NewConfig() *Config {
+	return &Config{}
+}
+
+// WithCredentials sets the Config's Credentials, returning the Config for
+// chaining.
+func (c *Config) WithCredentials(creds *credentials.Credentials) *Config {
+	c.Credentials = creds
+	return c
+}
+
+// WithEndpoint sets the Config's Endpoint, returning the Config for chaining.
+func (c *Config) WithEndpoint(endpoint string) *Config {
+	c.Endpoint = &endpoint
+	return c
+}
+
+// WithEndpointResolver sets the Config's EndpointResolver, returning the
+// Config for chaining.
+func (c *Config) WithEndpointResolver(resolver EndpointResolver) *Config {
+	c.EndpointResolver = resolver
+	return c
+}
+
+// WithRegion sets the Config's Region, returning the Config for chaining.
+func (c *Config) WithRegion(region string) *Config {
+	c.Region = &region
+	return c
+}
+
+// WithDisableSSL sets the Config's DisableSSL, returning the Config for
+// chaining.
+func (c *Config) WithDisableSSL(disable bool) *Config {
+	c.DisableSSL = &disable
+	return c
+}
+
+// WithManualSend sets the Config's ManualSend, returning the Config for
+// chaining.
+func (c *Config) WithManualSend(manual bool) *Config {
+	c.ManualSend = &manual
+	return c
+}
+
+// WithHTTPClient sets the Config's HTTPClient, returning the Config for
+// chaining.
+func (c *Config) WithHTTPClient(client *http.Client) *Config {
+	c.HTTPClient = client
+	return c
+}
+
+// WithLogLevel sets the Config's LogLevel, returning the Config for chaining.
+func (c *Config) WithLogLevel(level LogLevelType) *Config {
+	c.LogLevel = &level
+	return c
+}
+
+// WithLogger sets the Config's Logger, returning the Config for chaining.
+func (c *Config) WithLogger(logger Logger) *Config {
+	c.Logger = logger
+	return c
+}
+
+// WithMaxRetries sets the Config's MaxRetries, returning the Config for
+// chaining. DefaultRetries will use the service's default.
+func (c *Config) WithMaxRetries(max int) *Config {
+	c.MaxRetries = &max
+	return c
+}
+
+// WithRetryer sets the Config's Retryer, returning the Config for chaining.
+func (c *Config) WithRetryer(retryer Retryer) *Config {
+	c.Retryer = retryer
+	return c
+}
+
+// WithObserver sets the Config's Observer, returning the Config for chaining.
+func (c *Config) WithObserver(observer RequestObserver) *Config {
+	c.Observer = observer
+	return c
+}
+
+// WithDisableParamValidation sets the Config's DisableParamValidation,
+// returning the Config for chaining.
+func (c *Config) WithDisableParamValidation(disable bool) *Config {
+	c.DisableParamValidation = &disable
+	return c
+}
+
+// WithEnableEnumValidation sets the Config's EnableEnumValidation, returning
+// the Config for chaining. When set input parameters with an enum constraint
+// must be one of the values known to the SDK. It is not set by default, since
+// services add values to their enums which older SDKs do not know about.
+func (c *Config) WithEnableEnumValidation(enable bool) *Config {
+	c.EnableEnumValidation = &enable
+	return c
+}
+
+// WithDisableComputeChecksums sets the Config's DisableComputeChecksums,
+// returning the Config for chaining.
+func (c *Config) WithDisableComputeChecksums(disable bool) *Config {
+	c.DisableComputeChecksums = &disable
+	return c
+}
+
+// WithS3ForcePathStyle sets the Config's S3ForcePathStyle, returning the
+// Config for chaining.
+func (c *Config) WithS3ForcePathStyle(force bool) *Config {
+	c.S3ForcePathStyle = &force
+	return c
+}
+
+// WithS3UseLegacySignature sets the Config's S3UseLegacySignature, returning
+// the Config for chaining. When set S3 requests, and presigned URLs, are
+// signed with the legacy HMAC-SHA1 S3 signature instead of Signature Version
+// 4, for S3-compatible object stores which only support the legacy signature.
+func (c *Config) WithS3UseLegacySignature(legacy bool) *Config {
+	c.S3UseLegacySignature = &legacy
+	return c
+}
+
+// WithDetectEC2Region sets the Config's DetectEC2Region, returning the
+// Config for chaining. When set, and neither a Region nor an Endpoint is
+// configured, services use the region of the EC2 instance the program is
+// running on, detected once from the EC2 instance metadata service.
+func (c *Config) WithDetectEC2Region(detect bool) *Config {
+	c.DetectEC2Region = &detect
+	return c
 }
 
 // Copy will return a shallow copy of the Config object.
@@ -64,109 +231,93 @@ func (c Config) Copy() Config {
 	dst := Config{}
 	dst.Credentials = c.Credentials
 	dst.Endpoint = c.Endpoint
+	dst.EndpointResolver = c.EndpointResolver
 	dst.Region = c.Region
 	dst.DisableSSL = c.DisableSSL
 	dst.ManualSend = c.ManualSend
 	dst.HTTPClient = c.HTTPClient
-	dst.LogHTTPBody = c.LogHTTPBody
 	dst.LogLevel = c.LogLevel
 	dst.Logger = c.Logger
 	dst.MaxRetries = c.MaxRetries
+	dst.Retryer = c.Retryer
+	dst.Observer = c.Observer
 	dst.DisableParamValidation = c.DisableParamValidation
+	dst.EnableEnumValidation = c.EnableEnumValidation
 	dst.DisableComputeChecksums = c.DisableComputeChecksums
 	dst.S3ForcePathStyle = c.S3ForcePathStyle
+	dst.S3UseLegacySignature = c.S3UseLegacySignature
+	dst.DetectEC2Region = c.DetectEC2Region
 
 	return dst
 }
 
-// Merge merges the newcfg attribute values into this Config. Each attribute
-// will be merged into this config if the newcfg attribute's value is non-zero.
-// Due to this, newcfg attributes with zero values cannot be merged in. For
-// example bool attributes cannot be cleared using Merge, and must be explicitly
-// set on the Config structure.
+// Merge returns a new Config with the attribute values of newcfg merged into
+// a copy of this Config. Each attribute of newcfg which is set, non-nil, will
+// override the attribute of this Config, including attributes explicitly set
+// to their zero value such as false or 0. Neither Config is modified.
+//
+// Configs can be layered by merging them in order:
+//
+//     cfg := globalCfg.Merge(teamCfg).Merge(clientCfg)
 func (c Config) Merge(newcfg *Config) *Config {
+	cfg := c.Copy()
 	if newcfg == nil {
-		return &c
+		return &cfg
 	}
 
-	cfg := Config{}
-
 	if newcfg.Credentials != nil {
 		cfg.Credentials = newcfg.Credentials
-	} else {
-		cfg.Credentials = c.Credentials
 	}
-
-	if newcfg.Endpoint != "" {
+	if newcfg.Endpoint != nil {
 		cfg.Endpoint = newcfg.Endpoint
-	} else {
-		cfg.Endpoint = c.Endpoint
 	}
-
-	if newcfg.Region != "" {
+	if newcfg.EndpointResolver != nil {
+		cfg.EndpointResolver = newcfg.EndpointResolver
+	}
+	if newcfg.Region != nil {
 		cfg.Region = newcfg.Region
-	} else {
-		cfg.Region = c.Region
 	}
-
-	if newcfg.DisableSSL {
+	if newcfg.DisableSSL != nil {
 		cfg.DisableSSL = newcfg.DisableSSL
-	} else {
-		cfg.DisableSSL = c.DisableSSL
 	}
-
-	if newcfg.ManualSend {
+	if newcfg.ManualSend != nil {
 		cfg.ManualSend = newcfg.ManualSend
-	} else {
-		cfg.ManualSend = c.ManualSend
 	}
-
 	if newcfg.HTTPClient != nil {
 		cfg.HTTPClient = newcfg.HTTPClient
-	} else {
-		cfg.HTTPClient = c.HTTPClient
 	}
-
-	if newcfg.LogHTTPBody {
-		cfg.LogHTTPBody = newcfg.LogHTTPBody
-	} else {
-		cfg.LogHTTPBody = c.LogHTTPBody
-	}
-
-	if newcfg.LogLevel != 0 {
+	if newcfg.LogLevel != nil {
 		cfg.LogLevel = newcfg.LogLevel
-	} else {
-		cfg.LogLevel = c.LogLevel
 	}
-
 	if newcfg.Logger != nil {
 		cfg.Logger = newcfg.Logger
-	} else {
-		cfg.Logger = c.Logger
 	}
-
-	if newcfg.MaxRetries != DefaultRetries {
+	if newcfg.MaxRetries != nil {
 		cfg.MaxRetries = newcfg.MaxRetries
-	} else {
-		cfg.MaxRetries = c.MaxRetries
 	}
-
-	if newcfg.DisableParamValidation {
+	if newcfg.Retryer != nil {
+		cfg.Retryer = newcfg.Retryer
+	}
+	if newcfg.Observer != nil {
+		cfg.Observer = newcfg.Observer
+	}
+	if newcfg.DisableParamValidation != nil {
 		cfg.DisableParamValidation = newcfg.DisableParamValidation
-	} else {
-		cfg.DisableParamValidation = c.DisableParamValidation
 	}
-
-	if newcfg.DisableComputeChecksums {
+	if newcfg.EnableEnumValidation != nil {
+		cfg.EnableEnumValidation = newcfg.EnableEnumValidation
+	}
+	if newcfg.DisableComputeChecksums != nil {
 		cfg.DisableComputeChecksums = newcfg.DisableComputeChecksums
-	} else {
-		cfg.DisableComputeChecksums = c.DisableComputeChecksums
 	}
-
-	if newcfg.S3ForcePathStyle {
+	if newcfg.S3ForcePathStyle != nil {
 		cfg.S3ForcePathStyle = newcfg.S3ForcePathStyle
-	} else {
-		cfg.S3ForcePathStyle = c.S3ForcePathStyle
+	}
+	if newcfg.S3UseLegacySignature != nil {
+		cfg.S3UseLegacySignature = newcfg.S3UseLegacySignature
+	}
+	if newcfg.DetectEC2Region != nil {
+		cfg.DetectEC2Region = newcfg.DetectEC2Region
 	}
 
 	return &cfg
@@ -20,18 +20,23 @@ var testCredentials = credentials.NewChainCredentials([]credentials.Provider{
 
 var copyTestConfig = Config{
 	Credentials:             testCredentials,
-	Endpoint:                "CopyTestEndpoint",
-	Region:                  "COPY_TEST_AWS_REGION",
-	DisableSSL:              true,
-	ManualSend:              true,
+	Endpoint:                String("CopyTestEndpoint"),
+	EndpointResolver:        DefaultEndpointResolver{},
+	Region:                  String("COPY_TEST_AWS_REGION"),
+	DisableSSL:              Boolean(true),
+	ManualSend:              Boolean(true),
 	HTTPClient:              http.DefaultClient,
-	LogHTTPBody:             true,
-	LogLevel:                2,
-	Logger:                  os.Stdout,
-	MaxRetries:              DefaultRetries,
-	DisableParamValidation:  true,
-	DisableComputeChecksums: true,
-	S3ForcePathStyle:        true,
+	LogLevel:                LogLevel(LogDebug),
+	Logger:                  NewDefaultLogger(),
+	MaxRetries:              Int(DefaultRetries),
+	Retryer:                 DefaultRetryer{NumMaxRetries: 5},
+	Observer:                RequestObserverFuncs{},
+	DisableParamValidation:  Boolean(true),
+	EnableEnumValidation:    Boolean(true),
+	DisableComputeChecksums: Boolean(true),
+	S3ForcePathStyle:        Boolean(true),
+	S3UseLegacySignature:    Boolean(true),
+	DetectEC2Region:         Boolean(true),
 }
 
 func TestCopy(t *testing.T) {
@@ -51,22 +56,63 @@ func TestCopyReturnsNewInstance(t *testing.T) {
 	}
 }
 
-var mergeTestZeroValueConfig = Config{MaxRetries: DefaultRetries}
+var mergeTestZeroValueConfig = Config{
+	Endpoint:                String(""),
+	Region:                  String(""),
+	DisableSSL:              Boolean(false),
+	ManualSend:              Boolean(false),
+	LogLevel:                LogLevel(LogOff),
+	MaxRetries:              Int(DefaultRetries),
+	DisableParamValidation:  Boolean(false),
+	EnableEnumValidation:    Boolean(false),
+	DisableComputeChecksums: Boolean(false),
+	S3ForcePathStyle:        Boolean(false),
+	S3UseLegacySignature:    Boolean(false),
+	DetectEC2Region:         Boolean(false),
+}
+
+var testLogger = NewDefaultLogger()
 
 var mergeTestConfig = Config{
 	Credentials:             testCredentials,
-	Endpoint:                "MergeTestEndpoint",
-	Region:                  "MERGE_TEST_AWS_REGION",
-	DisableSSL:              true,
-	ManualSend:              true,
+	Endpoint:                String("MergeTestEndpoint"),
+	EndpointResolver:        DefaultEndpointResolver{},
+	Region:                  String("MERGE_TEST_AWS_REGION"),
+	DisableSSL:              Boolean(true),
+	ManualSend:              Boolean(true),
+	HTTPClient:              http.DefaultClient,
+	LogLevel:                LogLevel(LogDebug),
+	Logger:                  testLogger,
+	MaxRetries:              Int(10),
+	Retryer:                 DefaultRetryer{NumMaxRetries: 5},
+	Observer:                RequestObserverFuncs{},
+	DisableParamValidation:  Boolean(true),
+	EnableEnumValidation:    Boolean(true),
+	DisableComputeChecksums: Boolean(true),
+	S3ForcePathStyle:        Boolean(true),
+	S3UseLegacySignature:    Boolean(true),
+	DetectEC2Region:         Boolean(true),
+}
+
+var mergeTestClearedConfig = Config{
+	Credentials:             testCredentials,
+	Endpoint:                String(""),
+	EndpointResolver:        DefaultEndpointResolver{},
+	Region:                  String(""),
+	DisableSSL:              Boolean(false),
+	ManualSend:              Boolean(false),
 	HTTPClient:              http.DefaultClient,
-	LogHTTPBody:             true,
-	LogLevel:                2,
-	Logger:                  os.Stdout,
-	MaxRetries:              10,
-	DisableParamValidation:  true,
-	DisableComputeChecksums: true,
-	S3ForcePathStyle:        true,
+	LogLevel:                LogLevel(LogOff),
+	Logger:                  testLogger,
+	MaxRetries:              Int(DefaultRetries),
+	Retryer:                 DefaultRetryer{NumMaxRetries: 5},
+	Observer:                RequestObserverFuncs{},
+	DisableParamValidation:  Boolean(false),
+	EnableEnumValidation:    Boolean(false),
+	DisableComputeChecksums: Boolean(false),
+	S3ForcePathStyle:        Boolean(false),
+	S3UseLegacySignature:    Boolean(false),
+	DetectEC2Region:         Boolean(false),
 }
 
 var mergeTests = []struct {
@@ -75,8 +121,11 @@ var mergeTests = []struct {
 	want *Config
 }{
 	{&Config{}, nil, &Config{}},
-	{&Config{}, &mergeTestZeroValueConfig, &Config{}},
+	{&Config{}, &Config{}, &Config{}},
+	{&Config{}, &mergeTestZeroValueConfig, &mergeTestZeroValueConfig},
 	{&Config{}, &mergeTestConfig, &mergeTestConfig},
+	{&mergeTestConfig, &Config{}, &mergeTestConfig},
+	{&mergeTestConfig, &mergeTestZeroValueConfig, &mergeTestClearedConfig},
 }
 
 func TestMerge(t *testing.T) {
@@ -90,3 +139,103 @@ func TestMerge(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeDoesNotModifyConfigs(t *testing.T) {
+	cfg := NewConfig().WithRegion("us-west-2").WithDisableSSL(true)
+	in := NewConfig().WithDisableSSL(false)
+
+	got := cfg.Merge(in)
+	if *got.DisableSSL {
+		t.Errorf("Merge() DisableSSL = true; want false")
+	}
+	if !*cfg.DisableSSL || *in.DisableSSL || in.Region != nil {
+		t.Errorf("Merge() modified its configs, %+v, %+v", cfg, in)
+	}
+}
+
+func TestMergeLayered(t *testing.T) {
+	global := NewConfig().WithRegion("us-east-1").WithMaxRetries(5).WithS3ForcePathStyle(true)
+	team := NewConfig().WithRegion("us-west-2").WithLogLevel(LogRetries)
+	client := NewConfig().WithMaxRetries(0).WithS3ForcePathStyle(false)
+
+	got := global.Merge(team).Merge(client)
+	want := NewConfig().WithRegion("us-west-2").WithMaxRetries(0).
+		WithS3ForcePathStyle(false).WithLogLevel(LogRetries)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %+v", got)
+		t.Errorf("    want %+v", want)
+	}
+}
+
+func TestConfigWithBuilders(t *testing.T) {
+	client := &http.Client{}
+	logger := NewDefaultLogger()
+	retryer := DefaultRetryer{NumMaxRetries: 2}
+	observer := RequestObserverFuncs{}
+	resolver := DefaultEndpointResolver{}
+
+	got := NewConfig().
+		WithCredentials(testCredentials).
+		WithEndpoint("endpoint").
+		WithEndpointResolver(resolver).
+		WithRegion("region").
+		WithDisableSSL(true).
+		WithManualSend(true).
+		WithHTTPClient(client).
+		WithLogLevel(LogDebug).
+		WithLogger(logger).
+		WithMaxRetries(DefaultRetries).
+		WithRetryer(retryer).
+		WithObserver(observer).
+		WithDisableParamValidation(true).
+		WithEnableEnumValidation(true).
+		WithDisableComputeChecksums(false).
+		WithS3ForcePathStyle(true).
+		WithS3UseLegacySignature(true).
+		WithDetectEC2Region(true)
+
+	want := &Config{
+		Credentials:             testCredentials,
+		Endpoint:                String("endpoint"),
+		EndpointResolver:        resolver,
+		Region:                  String("region"),
+		DisableSSL:              Boolean(true),
+		ManualSend:              Boolean(true),
+		HTTPClient:              client,
+		LogLevel:                LogLevel(LogDebug),
+		Logger:                  logger,
+		MaxRetries:              Int(DefaultRetries),
+		Retryer:                 retryer,
+		Observer:                observer,
+		DisableParamValidation:  Boolean(true),
+		EnableEnumValidation:    Boolean(true),
+		DisableComputeChecksums: Boolean(false),
+		S3ForcePathStyle:        Boolean(true),
+		S3UseLegacySignature:    Boolean(true),
+		DetectEC2Region:         Boolean(true),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("With*() = %+v", got)
+		t.Errorf("    want %+v", want)
+	}
+}
+
+func TestDefaultCredentialProviders(t *testing.T) {
+	os.Clearenv()
+	providers := defaultCredentialProviders()
+	if len(providers) != 3 {
+		t.Fatalf("defaultCredentialProviders() = %d providers; want 3", len(providers))
+	}
+
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "/v2/credentials/task")
+	providers = defaultCredentialProviders()
+	if len(providers) != 4 {
+		t.Fatalf("defaultCredentialProviders() = %d providers; want 4", len(providers))
+	}
+	if _, ok := providers[2].(*credentials.ContainerProvider); !ok {
+		t.Errorf("providers[2] = %T; want *credentials.ContainerProvider", providers[2])
+	}
+	if _, ok := providers[3].(*credentials.EC2RoleProvider); !ok {
+		t.Errorf("providers[3] = %T; want *credentials.EC2RoleProvider", providers[3])
+	}
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeRequestCanceled is the error code that will be returned by an
+// API request that was canceled. Requests given an aws.Context may
+// return this error when canceled.
+const ErrCodeRequestCanceled = "RequestCanceled"
+
+// A Context provides cancellation and deadlines for requests. It is
+// compatible with the standard library's context.Context, so a value of
+// that type can be used wherever a Context is expected.
+//
+// Once the context's Done channel is closed, any in-flight HTTP request
+// is aborted, retry delays are cut short, and the request fails with the
+// ErrCodeRequestCanceled error code. A request whose context is already done
+// when it is sent is not built, signed, or sent.
+type Context interface {
+	// Deadline returns the time when work done on behalf of this context
+	// should be canceled. ok is false when no deadline is set.
+	Deadline() (deadline time.Time, ok bool)
+
+	// Done returns a channel that is closed when work done on behalf of
+	// this context should be canceled. Done may return nil if this context
+	// can never be canceled.
+	Done() <-chan struct{}
+
+	// Err returns a non-nil error value after Done is closed.
+	Err() error
+
+	// Value returns the value associated with this context for key, or nil.
+	Value(key interface{}) interface{}
+}
+
+// SetContext sets the context the request will use for cancellation and
+// deadlines. A nil ctx will panic.
+func (r *Request) SetContext(ctx Context) {
+	if ctx == nil {
+		panic("context cannot be nil")
+	}
+	r.context = ctx
+}
+
+// canceled returns a RequestCanceled error if the request's context is
+// done, otherwise nil.
+func (r *Request) canceled() error {
+	if r.context == nil {
+		return nil
+	}
+
+	select {
+	case <-r.context.Done():
+		return newRequestCanceledError(r.context.Err())
+	default:
+		return nil
+	}
+}
+
+// newRequestCanceledError returns the error for a canceled request.
+func newRequestCanceledError(err error) error {
+	return awserr.New(ErrCodeRequestCanceled, "request context canceled", err)
+}
+
+// requestCanceler is satisfied by HTTP transports which are able to abort
+// an in-flight request, e.g. http.Transport.
+type requestCanceler interface {
+	CancelRequest(*http.Request)
+}
+
+// sendWithContext sends the request's HTTP request with the service's HTTP
+// client. If the request has a context, the in-flight HTTP request will be
+// aborted when the context is done.
+func sendWithContext(r *Request) (*http.Response, error) {
+	client := r.Service.Config.HTTPClient
+	if r.context == nil || r.context.Done() == nil {
+		return client.Do(r.HTTPRequest)
+	}
+	if r.canceled() != nil {
+		// Don't start sending a request which is already canceled.
+		return nil, r.context.Err()
+	}
+
+	type result struct {
+		resp *http.Response
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		resp, err := client.Do(r.HTTPRequest)
+		ch <- result{resp, err}
+	}()
+
+	select {
+	case res := <-ch:
+		return res.resp, res.err
+	case <-r.context.Done():
+		tr := client.Transport
+		if tr == nil {
+			tr = http.DefaultTransport
+		}
+		if c, ok := tr.(requestCanceler); ok {
+			c.CancelRequest(r.HTTPRequest)
+		}
+
+		// Release the response if the request completed regardless.
+		go func() {
+			if res := <-ch; res.resp != nil {
+				res.resp.Body.Close()
+			}
+		}()
+		return nil, r.context.Err()
+	}
+}
+
+// sleepWithContext waits for the delay to pass. If the request has a
+// context which is done before the delay passes, a RequestCanceled error is
+// returned.
+func sleepWithContext(r *Request, delay time.Duration) error {
+	if r.context == nil || r.context.Done() == nil {
+		sleepDelay(delay)
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-r.context.Done():
+		return newRequestCanceledError(r.context.Err())
+	}
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+// testContext is a minimal Context which is canceled by closing done.
+type testContext struct {
+	done chan struct{}
+	err  error
+}
+
+func newTestContext() *testContext {
+	return &testContext{done: make(chan struct{})}
+}
+
+func (c *testContext) cancel() {
+	c.err = errors.New("test context canceled")
+	close(c.done)
+}
+
+func (c *testContext) Deadline() (time.Time, bool)       { return time.Time{}, false }
+func (c *testContext) Done() <-chan struct{}             { return c.done }
+func (c *testContext) Err() error                        { return c.err }
+func (c *testContext) Value(key interface{}) interface{} { return nil }
+
+func TestRequestSetContextNil(t *testing.T) {
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+	assert.Panics(t, func() { r.SetContext(nil) })
+}
+
+// test that a canceled context stops the retry delay.
+func TestRequestCanceledDuringRetryDelay(t *testing.T) {
+	ctx := newTestContext()
+
+	reqNum := 0
+	s := NewService(&Config{MaxRetries: Int(10)})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: 500,
+			Body:       body(`{"__type":"UnknownError","message":"An error occurred."}`),
+		}
+		reqNum++
+		// cancel before the retry delay
+		ctx.cancel()
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, 1, reqNum)
+}
+
+// test that a request whose context is already canceled is never sent.
+func TestRequestAlreadyCanceled(t *testing.T) {
+	reqNum, signed := 0, false
+	s := NewService(&Config{MaxRetries: Int(10)})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Sign.PushBack(func(r *Request) { signed = true })
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		reqNum++
+	})
+
+	ctx := newTestContext()
+	ctx.cancel()
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, ctx.Err(), err.(awserr.Error).OrigErr())
+	assert.Equal(t, 0, reqNum)
+	assert.False(t, signed, "expect request to not be signed")
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+// test that a canceled context aborts the in-flight HTTP request.
+func TestRequestCanceledInFlight(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	s := NewService(&Config{Endpoint: String(server.URL), MaxRetries: Int(10)})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+
+	ctx := newTestContext()
+	time.AfterFunc(10*time.Millisecond, ctx.cancel)
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, ctx.Err(), err.(awserr.Error).OrigErr())
+	assert.Equal(t, 0, int(r.RetryCount))
+}
@@ -0,0 +1,323 @@
+// Package cognitocreds are credential Providers to retrieve AWS credentials
+// for Amazon Cognito identities.
+//
+// Credentials are retrieved for an identity of an identity pool, optionally
+// authenticated with the login tokens of public identity providers, such as
+// Facebook or Google, or of the developer's own authentication system.
+package cognitocreds
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// cognitoLoginProvider is the login provider name of OpenID tokens issued by
+// Amazon Cognito.
+const cognitoLoginProvider = "cognito-identity.amazonaws.com"
+
+// IdentityClient represents the minimal subset of the Cognito Identity client
+// API used by this provider.
+type IdentityClient interface {
+	GetID(input *cognitoidentity.GetIDInput) (*cognitoidentity.GetIDOutput, error)
+	GetOpenIDToken(input *cognitoidentity.GetOpenIDTokenInput) (*cognitoidentity.GetOpenIDTokenOutput, error)
+	GetOpenIDTokenForDeveloperIdentity(input *cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput) (*cognitoidentity.GetOpenIDTokenForDeveloperIdentityOutput, error)
+	GetCredentialsForIdentity(input *cognitoidentity.GetCredentialsForIdentityInput) (*cognitoidentity.GetCredentialsForIdentityOutput, error)
+}
+
+// CognitoProvider retrieves temporary credentials for a Cognito identity, and
+// keeps track of their expiration time. The identity's ID is looked up once,
+// and cached in IdentityID for later retrievals.
+//
+// By default the enhanced flow is used, the credentials are retrieved from
+// Cognito Identity with GetCredentialsForIdentity. If RoleARN is set the
+// classic flow is used instead, an OpenID token retrieved from Cognito
+// Identity is exchanged for the credentials of the role with STS's
+// AssumeRoleWithWebIdentity.
+//
+// Example how to configure a service to use an identity authenticated by
+// Facebook:
+//
+//		config := &aws.Config{
+//			Credentials: cognitocreds.NewCredentials(nil, "us-east-1:pool-id",
+//				map[string]string{"graph.facebook.com": facebookToken}, 10*time.Second),
+//		}
+//		// Use config for creating your AWS service.
+//
+// Example how to retrieve credentials for a developer authenticated identity
+// with the classic flow. The Client must be configured with the developer's
+// AWS credentials:
+//
+//		provider := &cognitocreds.CognitoProvider{
+//			Client:                 cognitoidentity.New(developerConfig),
+//			IdentityPoolID:         "us-east-1:pool-id",
+//			Logins:                 map[string]string{"login.mycompany.myapp": "user-id"},
+//			DeveloperAuthenticated: true,
+//			RoleARN:                "arn-of-the-role-to-assume",
+//		}
+//		creds := credentials.NewCredentials(provider)
+//
+type CognitoProvider struct {
+	credentials.Expiry
+
+	// Custom Cognito Identity client. If not set the default Cognito Identity
+	// client will be used.
+	Client IdentityClient
+
+	// Custom STS client used by the classic flow. If not set the default STS
+	// client will be used.
+	STSClient stscreds.WebIdentityAssumer
+
+	// The identity pool the identity belongs to.
+	IdentityPoolID string
+
+	// Optional AWS account ID of the identity pool, passed to the GetId call.
+	AccountID string
+
+	// The ID of the identity. If not set the ID is looked up, and cached,
+	// when the credentials are first retrieved.
+	IdentityID string
+
+	// Optional login tokens of the identity, keyed by identity provider name.
+	// For a developer authenticated identity the developer provider name is
+	// mapped to the user's identifier.
+	Logins map[string]string
+
+	// If set the identity is authenticated by the developer's own system, and
+	// its OpenID token is retrieved with GetOpenIDTokenForDeveloperIdentity.
+	// This call must be signed with the developer's AWS credentials.
+	DeveloperAuthenticated bool
+
+	// Expiry duration of the developer authenticated identity's OpenID token.
+	// Cognito's default is used if not set.
+	TokenDuration time.Duration
+
+	// Role to be assumed with the classic flow. If not set the enhanced flow
+	// is used, and the role is chosen by the identity pool.
+	RoleARN string
+
+	// Session name of the role assumed with the classic flow. Defaults to a
+	// nanosecond timestamp if not set.
+	RoleSessionName string
+
+	// Expiry duration of the credentials of the role assumed with the classic
+	// flow. Defaults to 15 minutes if not set.
+	Duration time.Duration
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring. This is beneficial so race conditions
+	// with expiring credentials do not cause request to fail unexpectedly
+	// due to ExpiredTokenException exceptions.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewCredentials returns a pointer to a new Credentials object wrapping the
+// CognitoProvider. The credentials are retrieved with the enhanced flow for
+// the identity of the pool authenticated by the logins, or an unauthenticated
+// identity if logins is empty.
+//
+// Pass nil as client to use the default client. Its region is taken from the
+// identity pool ID's region prefix.
+//
+// Window is the expiry window that will be subtracted from the expiry returned
+// by the credential request. This is done so that the credentials will
+// expire sooner than their actual lifespan.
+func NewCredentials(client IdentityClient, identityPoolID string, logins map[string]string, window time.Duration) *credentials.Credentials {
+	return credentials.NewCredentials(&CognitoProvider{
+		Client:         client,
+		IdentityPoolID: identityPoolID,
+		Logins:         logins,
+		ExpiryWindow:   window,
+	})
+}
+
+// Retrieve generates a new set of temporary credentials for the identity.
+func (p *CognitoProvider) Retrieve() (credentials.Value, error) {
+	if p.Client == nil {
+		p.Client = cognitoidentity.New(p.clientConfig())
+	}
+
+	var token string
+	if p.DeveloperAuthenticated {
+		var err error
+		if token, err = p.developerToken(); err != nil {
+			return credentials.Value{}, err
+		}
+	} else if p.IdentityID == "" {
+		if err := p.lookupIdentityID(); err != nil {
+			return credentials.Value{}, err
+		}
+	}
+
+	if p.RoleARN != "" {
+		return p.retrieveClassic(token)
+	}
+	return p.retrieveEnhanced(token)
+}
+
+// lookupIdentityID looks up, and caches, the ID of the identity.
+func (p *CognitoProvider) lookupIdentityID() error {
+	input := &cognitoidentity.GetIDInput{
+		IdentityPoolID: aws.String(p.IdentityPoolID),
+		Logins:         loginsInput(p.Logins),
+	}
+	if p.AccountID != "" {
+		input.AccountID = aws.String(p.AccountID)
+	}
+
+	out, err := p.Client.GetID(input)
+	if err != nil {
+		return err
+	}
+	if aws.StringValue(out.IdentityID) == "" {
+		return awserr.New("CognitoIdentityIDNotFound",
+			"no identity ID returned for identity pool "+p.IdentityPoolID, nil)
+	}
+
+	p.IdentityID = *out.IdentityID
+	return nil
+}
+
+// developerToken returns the OpenID token of the developer authenticated
+// identity, and caches its identity ID.
+func (p *CognitoProvider) developerToken() (string, error) {
+	input := &cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput{
+		IdentityPoolID: aws.String(p.IdentityPoolID),
+		Logins:         loginsInput(p.Logins),
+	}
+	if p.IdentityID != "" {
+		input.IdentityID = aws.String(p.IdentityID)
+	}
+	if p.TokenDuration > 0 {
+		input.TokenDuration = aws.Long(int64(p.TokenDuration / time.Second))
+	}
+
+	out, err := p.Client.GetOpenIDTokenForDeveloperIdentity(input)
+	if err != nil {
+		return "", err
+	}
+
+	if id := aws.StringValue(out.IdentityID); id != "" {
+		p.IdentityID = id
+	}
+	return aws.StringValue(out.Token), nil
+}
+
+// retrieveEnhanced retrieves the identity's credentials from Cognito
+// Identity. The OpenID token of a developer authenticated identity is used as
+// its login.
+func (p *CognitoProvider) retrieveEnhanced(token string) (credentials.Value, error) {
+	logins := p.Logins
+	if token != "" {
+		logins = map[string]string{cognitoLoginProvider: token}
+	}
+
+	out, err := p.Client.GetCredentialsForIdentity(&cognitoidentity.GetCredentialsForIdentityInput{
+		IdentityID: aws.String(p.IdentityID),
+		Logins:     loginsInput(logins),
+	})
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	if out.Credentials == nil {
+		return credentials.Value{}, awserr.New("CognitoCredentialsNotFound",
+			"no credentials returned for identity "+p.IdentityID, nil)
+	}
+
+	if out.Credentials.Expiration != nil {
+		p.SetExpiration(*out.Credentials.Expiration, p.ExpiryWindow)
+	}
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(out.Credentials.AccessKeyID),
+		SecretAccessKey: aws.StringValue(out.Credentials.SecretKey),
+		SessionToken:    aws.StringValue(out.Credentials.SessionToken),
+	}, nil
+}
+
+// retrieveClassic exchanges the identity's OpenID token for the credentials of
+// the provider's role with STS. The token is retrieved from Cognito Identity
+// if not already known.
+func (p *CognitoProvider) retrieveClassic(token string) (credentials.Value, error) {
+	if token == "" {
+		out, err := p.Client.GetOpenIDToken(&cognitoidentity.GetOpenIDTokenInput{
+			IdentityID: aws.String(p.IdentityID),
+			Logins:     loginsInput(p.Logins),
+		})
+		if err != nil {
+			return credentials.Value{}, err
+		}
+		token = aws.StringValue(out.Token)
+	}
+
+	if p.STSClient == nil {
+		p.STSClient = sts.New(p.clientConfig())
+	}
+	if p.RoleSessionName == "" {
+		// Try to work out a role name that will hopefully end up unique.
+		p.RoleSessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+	if p.Duration == 0 {
+		// Expire as often as AWS permits.
+		p.Duration = 15 * time.Minute
+	}
+
+	out, err := p.STSClient.AssumeRoleWithWebIdentity(&sts.AssumeRoleWithWebIdentityInput{
+		DurationSeconds:  aws.Long(int64(p.Duration / time.Second)),
+		RoleARN:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(p.RoleSessionName),
+		WebIdentityToken: aws.String(token),
+	})
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	if out.Credentials == nil {
+		return credentials.Value{}, awserr.New("CognitoCredentialsNotFound",
+			"no credentials returned for role "+p.RoleARN, nil)
+	}
+
+	// We will proactively generate new credentials before they expire.
+	if out.Credentials.Expiration != nil {
+		p.SetExpiration(*out.Credentials.Expiration, p.ExpiryWindow)
+	}
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(out.Credentials.AccessKeyID),
+		SecretAccessKey: aws.StringValue(out.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(out.Credentials.SessionToken),
+	}, nil
+}
+
+// clientConfig returns the Config of the default clients. Their region is
+// taken from the identity pool ID, which is prefixed with the pool's region,
+// e.g. "us-east-1:pool-id". Nil is returned if the ID has no region prefix.
+func (p *CognitoProvider) clientConfig() *aws.Config {
+	i := strings.Index(p.IdentityPoolID, ":")
+	if i <= 0 {
+		return nil
+	}
+	return &aws.Config{Region: aws.String(p.IdentityPoolID[:i])}
+}
+
+// loginsInput returns the logins as the map of string pointers the Cognito
+// Identity API expects, or nil if there are no logins.
+func loginsInput(logins map[string]string) map[string]*string {
+	if len(logins) == 0 {
+		return nil
+	}
+
+	m := make(map[string]*string, len(logins))
+	for k, v := range logins {
+		m[k] = aws.String(v)
+	}
+	return m
+}
@@ -0,0 +1,221 @@
+package cognitocreds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubCognito struct {
+	getIDCalls  int
+	getIDInput  *cognitoidentity.GetIDInput
+	tokenInput  *cognitoidentity.GetOpenIDTokenInput
+	devInput    *cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput
+	credsInput  *cognitoidentity.GetCredentialsForIdentityInput
+	credsExpiry time.Time
+}
+
+func (s *stubCognito) GetID(input *cognitoidentity.GetIDInput) (*cognitoidentity.GetIDOutput, error) {
+	s.getIDCalls++
+	s.getIDInput = input
+	return &cognitoidentity.GetIDOutput{IdentityID: aws.String("us-east-1:identity")}, nil
+}
+
+func (s *stubCognito) GetOpenIDToken(input *cognitoidentity.GetOpenIDTokenInput) (*cognitoidentity.GetOpenIDTokenOutput, error) {
+	s.tokenInput = input
+	return &cognitoidentity.GetOpenIDTokenOutput{
+		IdentityID: input.IdentityID,
+		Token:      aws.String("openIDToken"),
+	}, nil
+}
+
+func (s *stubCognito) GetOpenIDTokenForDeveloperIdentity(input *cognitoidentity.GetOpenIDTokenForDeveloperIdentityInput) (*cognitoidentity.GetOpenIDTokenForDeveloperIdentityOutput, error) {
+	s.devInput = input
+	return &cognitoidentity.GetOpenIDTokenForDeveloperIdentityOutput{
+		IdentityID: aws.String("us-east-1:developer"),
+		Token:      aws.String("developerToken"),
+	}, nil
+}
+
+func (s *stubCognito) GetCredentialsForIdentity(input *cognitoidentity.GetCredentialsForIdentityInput) (*cognitoidentity.GetCredentialsForIdentityOutput, error) {
+	s.credsInput = input
+	return &cognitoidentity.GetCredentialsForIdentityOutput{
+		IdentityID: input.IdentityID,
+		Credentials: &cognitoidentity.Credentials{
+			AccessKeyID:  aws.String("cognitoAccessKey"),
+			SecretKey:    aws.String("cognitoSecretKey"),
+			SessionToken: aws.String("cognitoSessionToken"),
+			Expiration:   &s.credsExpiry,
+		},
+	}, nil
+}
+
+type stubSTS struct {
+	input         *sts.AssumeRoleWithWebIdentityInput
+	noCredentials bool
+}
+
+func (s *stubSTS) AssumeRoleWithWebIdentity(input *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	s.input = input
+	if s.noCredentials {
+		return &sts.AssumeRoleWithWebIdentityOutput{}, nil
+	}
+	expiry := time.Now().Add(60 * time.Minute)
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &sts.Credentials{
+			// Just reflect the web identity token to the provider.
+			AccessKeyID:     input.WebIdentityToken,
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      &expiry,
+		},
+	}, nil
+}
+
+func TestCognitoProviderEnhanced(t *testing.T) {
+	stub := &stubCognito{credsExpiry: time.Date(2014, 12, 16, 1, 51, 37, 0, time.UTC)}
+	p := &CognitoProvider{
+		Client:         stub,
+		IdentityPoolID: "us-east-1:pool",
+		AccountID:      "123456789012",
+		Logins:         map[string]string{"graph.facebook.com": "facebookToken"},
+	}
+	p.CurrentTime = func() time.Time {
+		return time.Date(2014, 12, 15, 21, 26, 0, 0, time.UTC)
+	}
+
+	assert.True(t, p.IsExpired(), "Expect creds to be expired before retrieve.")
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "cognitoAccessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "cognitoSecretKey", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "cognitoSessionToken", creds.SessionToken, "Expect session token to match")
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
+
+	assert.Equal(t, "us-east-1:pool", *stub.getIDInput.IdentityPoolID)
+	assert.Equal(t, "123456789012", *stub.getIDInput.AccountID)
+	assert.Equal(t, "facebookToken", *stub.getIDInput.Logins["graph.facebook.com"])
+	assert.Equal(t, "us-east-1:identity", p.IdentityID, "Expect identity ID to be cached")
+	assert.Equal(t, "us-east-1:identity", *stub.credsInput.IdentityID)
+	assert.Equal(t, "facebookToken", *stub.credsInput.Logins["graph.facebook.com"])
+
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, 1, stub.getIDCalls, "Expect identity ID to be looked up once")
+}
+
+func TestCognitoProviderUnauthenticated(t *testing.T) {
+	stub := &stubCognito{credsExpiry: time.Now().Add(time.Hour)}
+
+	creds, err := NewCredentials(stub, "us-east-1:pool", nil, 0).Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "cognitoAccessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Nil(t, stub.getIDInput.AccountID, "Expect no account ID")
+	assert.Nil(t, stub.getIDInput.Logins, "Expect no logins")
+	assert.Nil(t, stub.credsInput.Logins, "Expect no logins")
+}
+
+func TestCognitoProviderDeveloperAuthenticated(t *testing.T) {
+	stub := &stubCognito{credsExpiry: time.Now().Add(time.Hour)}
+	p := &CognitoProvider{
+		Client:                 stub,
+		IdentityPoolID:         "us-east-1:pool",
+		Logins:                 map[string]string{"login.mycompany.myapp": "user"},
+		DeveloperAuthenticated: true,
+		TokenDuration:          time.Hour,
+	}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "cognitoAccessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, 0, stub.getIDCalls, "Expect identity ID to not be looked up")
+
+	assert.Nil(t, stub.devInput.IdentityID, "Expect no identity ID on first retrieve")
+	assert.Equal(t, "user", *stub.devInput.Logins["login.mycompany.myapp"])
+	assert.Equal(t, int64(3600), *stub.devInput.TokenDuration)
+	assert.Equal(t, "us-east-1:developer", *stub.credsInput.IdentityID)
+	assert.Equal(t, map[string]*string{
+		"cognito-identity.amazonaws.com": aws.String("developerToken"),
+	}, stub.credsInput.Logins)
+
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "us-east-1:developer", *stub.devInput.IdentityID, "Expect cached identity ID")
+}
+
+func TestCognitoProviderClassic(t *testing.T) {
+	stub := &stubCognito{}
+	stsStub := &stubSTS{}
+	p := &CognitoProvider{
+		Client:         stub,
+		STSClient:      stsStub,
+		IdentityPoolID: "us-east-1:pool",
+		Logins:         map[string]string{"accounts.google.com": "googleToken"},
+		RoleARN:        "roleARN",
+	}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "openIDToken", creds.AccessKeyID, "Expect access key ID to be reflected token")
+	assert.Equal(t, "assumedSecretAccessKey", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "assumedSessionToken", creds.SessionToken, "Expect session token to match")
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
+
+	assert.Nil(t, stub.credsInput, "Expect enhanced flow to not be used")
+	assert.Equal(t, "us-east-1:identity", *stub.tokenInput.IdentityID)
+	assert.Equal(t, "googleToken", *stub.tokenInput.Logins["accounts.google.com"])
+	assert.Equal(t, "roleARN", *stsStub.input.RoleARN)
+	assert.Equal(t, int64(900), *stsStub.input.DurationSeconds)
+	assert.NotEmpty(t, *stsStub.input.RoleSessionName)
+}
+
+func TestCognitoProviderClassicDeveloperAuthenticated(t *testing.T) {
+	stub := &stubCognito{}
+	p := &CognitoProvider{
+		Client:                 stub,
+		STSClient:              &stubSTS{},
+		IdentityPoolID:         "us-east-1:pool",
+		Logins:                 map[string]string{"login.mycompany.myapp": "user"},
+		DeveloperAuthenticated: true,
+		RoleARN:                "roleARN",
+	}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "developerToken", creds.AccessKeyID, "Expect access key ID to be reflected token")
+	assert.Nil(t, stub.tokenInput, "Expect developer token to be used")
+}
+
+func TestCognitoProviderClassicNoCredentials(t *testing.T) {
+	p := &CognitoProvider{
+		Client:         &stubCognito{},
+		STSClient:      &stubSTS{noCredentials: true},
+		IdentityPoolID: "us-east-1:pool",
+		RoleARN:        "roleARN",
+	}
+
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.Equal(t, "CognitoCredentialsNotFound", err.(awserr.Error).Code())
+	assert.True(t, p.IsExpired(), "Expect creds to be expired after failed retrieve.")
+}
+
+func TestCognitoProviderClientConfig(t *testing.T) {
+	p := &CognitoProvider{IdentityPoolID: "eu-west-1:pool"}
+	cfg := p.clientConfig()
+	assert.Equal(t, "eu-west-1", aws.StringValue(cfg.Region))
+
+	client := cognitoidentity.New(cfg)
+	assert.Equal(t, "https://cognito-identity.eu-west-1.amazonaws.com", client.Endpoint)
+	stsClient := sts.New(cfg)
+	assert.Equal(t, "eu-west-1", aws.StringValue(stsClient.Config.Region))
+
+	p = &CognitoProvider{IdentityPoolID: "pool"}
+	assert.Nil(t, p.clientConfig())
+}
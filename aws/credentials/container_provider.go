@@ -0,0 +1,205 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ContainerCredentialsHost is the host of the container credentials agent
+// relative URIs are resolved against.
+const ContainerCredentialsHost = "http://169.254.170.2"
+
+var (
+	// ErrContainerCredentialsURINotFound is returned when the
+	// ContainerProvider's Endpoint is not set, and neither of the container
+	// credentials URI environment variables are set.
+	ErrContainerCredentialsURINotFound = awserr.New("ContainerCredentialsURINotFound", "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI not found in environment", nil)
+)
+
+// containerHTTPClient is used by ContainerProviders without a Client. The
+// agent is local to the host, so requests are expected to complete quickly.
+var containerHTTPClient = &http.Client{Timeout: 5 * time.Second}
+
+// ContainerCredentialsEndpoint returns the endpoint of the container
+// credentials agent set in the environment, or "" if it is not set.
+//
+// AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is resolved against
+// ContainerCredentialsHost, and takes precedence over
+// AWS_CONTAINER_CREDENTIALS_FULL_URI.
+func ContainerCredentialsEndpoint() string {
+	if uri := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); uri != "" {
+		return ContainerCredentialsHost + uri
+	}
+	return os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+}
+
+// A ContainerProvider retrieves the credentials of the task role of a
+// container from the credentials agent on the container's host, and keeps
+// track if those credentials are expired.
+//
+// Environment variables used, if the provider's fields are not set:
+// - Endpoint:           AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI
+// - AuthorizationToken: AWS_CONTAINER_AUTHORIZATION_TOKEN
+//
+// A full URI must use HTTPS, or refer to a loopback host, so credentials are
+// not sent to, or requested from, a host outside of the container's host.
+//
+//     creds := credentials.NewCredentials(&credentials.ContainerProvider{
+//         ExpiryWindow: 5 * time.Minute,
+//     })
+type ContainerProvider struct {
+	Expiry
+
+	// The URL credentials are requested from. If empty the endpoint is read
+	// from the environment, see ContainerCredentialsEndpoint.
+	Endpoint string
+
+	// The value of the Authorization header sent with the request. If empty
+	// AWS_CONTAINER_AUTHORIZATION_TOKEN is used, if set.
+	AuthorizationToken string
+
+	// The HTTP client the credentials are requested with. If nil a client
+	// with a 5 second timeout is used.
+	Client *http.Client
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewContainerCredentials returns a pointer to a new Credentials object
+// wrapping the ContainerProvider, configured from the environment.
+func NewContainerCredentials() *Credentials {
+	return NewCredentials(&ContainerProvider{})
+}
+
+// A containerCredentialsOutput provides the shape for deserializing the
+// credentials, or error, returned by the container credentials agent.
+type containerCredentialsOutput struct {
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	Token           string
+	Expiration      time.Time
+
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Retrieve retrieves credentials from the container credentials agent.
+// Error will be returned if the request fails, or unable to extract
+// the desired credentials.
+func (p *ContainerProvider) Retrieve() (Value, error) {
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = ContainerCredentialsEndpoint()
+	}
+	if endpoint == "" {
+		return Value{}, ErrContainerCredentialsURINotFound
+	}
+	if err := validateContainerEndpoint(endpoint); err != nil {
+		return Value{}, err
+	}
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return Value{}, awserr.New("ContainerCredentialsRequestError",
+			"failed to build container credentials request", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	token := p.AuthorizationToken
+	if token == "" {
+		token = os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	client := p.Client
+	if client == nil {
+		client = containerHTTPClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Value{}, awserr.New("ContainerCredentialsRequestError",
+			"failed to request container credentials", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Value{}, awserr.New("ContainerCredentialsRequestError",
+			"failed to read container credentials response", err)
+	}
+
+	creds := containerCredentialsOutput{}
+	if err := json.Unmarshal(body, &creds); err != nil && resp.StatusCode == http.StatusOK {
+		return Value{}, awserr.New("ContainerCredentialsParse",
+			"failed to decode container credentials", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		code, msg := creds.Code, creds.Message
+		if code == "" {
+			code = "ContainerCredentialsError"
+		}
+		if msg == "" {
+			msg = fmt.Sprintf("container credentials request failed with status %d", resp.StatusCode)
+		}
+		return Value{}, awserr.NewRequestFailure(awserr.New(code, msg, nil), resp.StatusCode, "")
+	}
+
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return Value{}, awserr.New("ContainerCredentialsParse",
+			"container credentials missing AccessKeyId or SecretAccessKey", nil)
+	}
+
+	p.SetExpiration(creds.Expiration, p.ExpiryWindow)
+
+	return Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.Token,
+	}, nil
+}
+
+// validateContainerEndpoint returns an error if the endpoint is not a valid
+// URL, or is neither HTTPS nor refers to a loopback host or the container
+// credentials agent.
+func validateContainerEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return awserr.New("ContainerCredentialsInvalidURI",
+			fmt.Sprintf("invalid container credentials URI %q", endpoint), err)
+	}
+
+	if u.Scheme == "https" || "http://"+u.Host == ContainerCredentialsHost {
+		return nil
+	}
+	if u.Scheme == "http" {
+		host := u.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if host == "localhost" {
+			return nil
+		}
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			return nil
+		}
+	}
+
+	return awserr.New("ContainerCredentialsInvalidURI",
+		fmt.Sprintf("container credentials URI %q must use https, or a loopback host", endpoint), nil)
+}
@@ -0,0 +1,131 @@
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func initContainerTestServer(expireOn string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/credentials/task" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code": "NotFound", "message": "no credentials for path"}`)
+			return
+		}
+		if r.Header.Get("Authorization") == "denied" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"code": "AccessDenied", "message": "invalid token"}`)
+			return
+		}
+		fmt.Fprintf(w, `{
+  "RoleArn" : "arn:aws:iam::123456789012:role/task",
+  "AccessKeyId" : "accessKey",
+  "SecretAccessKey" : "secret",
+  "Token" : "token",
+  "Expiration" : "%s"
+}`, expireOn)
+	}))
+}
+
+func TestContainerProvider(t *testing.T) {
+	server := initContainerTestServer("2014-12-16T01:51:37Z")
+	defer server.Close()
+
+	p := &ContainerProvider{Endpoint: server.URL + "/v2/credentials/task"}
+	p.CurrentTime = func() time.Time {
+		return time.Date(2014, 12, 15, 21, 26, 0, 0, time.UTC)
+	}
+
+	assert.True(t, p.IsExpired(), "Expect creds to be expired before retrieve.")
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
+
+	p.CurrentTime = func() time.Time {
+		return time.Date(3014, 12, 15, 21, 26, 0, 0, time.UTC)
+	}
+	assert.True(t, p.IsExpired(), "Expect creds to be expired.")
+}
+
+func TestContainerProviderFromEnv(t *testing.T) {
+	var gotToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"AccessKeyId": "accessKey", "SecretAccessKey": "secret", "Expiration": "2014-12-16T01:51:37Z"}`)
+	}))
+	defer server.Close()
+
+	os.Clearenv()
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/creds")
+	os.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN", "Basic abc")
+
+	p := &ContainerProvider{}
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "Basic abc", gotToken, "Expect authorization token to be sent")
+
+	p.AuthorizationToken = "Bearer xyz"
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "Bearer xyz", gotToken, "Expect provider token to take precedence")
+}
+
+func TestContainerCredentialsEndpoint(t *testing.T) {
+	os.Clearenv()
+	assert.Equal(t, "", ContainerCredentialsEndpoint())
+
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", "http://127.0.0.1:8080/creds")
+	assert.Equal(t, "http://127.0.0.1:8080/creds", ContainerCredentialsEndpoint())
+
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "/v2/credentials/task")
+	assert.Equal(t, "http://169.254.170.2/v2/credentials/task", ContainerCredentialsEndpoint())
+}
+
+func TestContainerProviderNotSet(t *testing.T) {
+	os.Clearenv()
+
+	p := &ContainerProvider{}
+	_, err := p.Retrieve()
+	assert.Equal(t, ErrContainerCredentialsURINotFound, err, "Expect missing URI error")
+	assert.True(t, p.IsExpired(), "Expect creds to be expired.")
+}
+
+func TestContainerProviderError(t *testing.T) {
+	server := initContainerTestServer("2014-12-16T01:51:37Z")
+	defer server.Close()
+
+	p := &ContainerProvider{Endpoint: server.URL + "/v2/credentials/task", AuthorizationToken: "denied"}
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	reqErr := err.(awserr.RequestFailure)
+	assert.Equal(t, "AccessDenied", reqErr.Code())
+	assert.Equal(t, "invalid token", reqErr.Message())
+	assert.Equal(t, http.StatusForbidden, reqErr.StatusCode())
+}
+
+func TestContainerProviderInvalidURI(t *testing.T) {
+	for _, endpoint := range []string{
+		"http://example.com/creds",
+		"http://10.0.0.1/creds",
+		"ftp://127.0.0.1/creds",
+		"/creds",
+	} {
+		p := &ContainerProvider{Endpoint: endpoint}
+		_, err := p.Retrieve()
+		assert.Error(t, err, endpoint)
+		assert.Equal(t, "ContainerCredentialsInvalidURI", err.(awserr.Error).Code(), endpoint)
+	}
+}
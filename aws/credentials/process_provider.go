@@ -0,0 +1,179 @@
+package credentials
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// DefaultProcessTimeout is the time a ProcessProvider's command is allowed to
+// run, if the provider's Timeout is not set.
+const DefaultProcessTimeout = 1 * time.Minute
+
+var (
+	// ErrProcessProviderEmptyCommand is returned when the ProcessProvider's
+	// Command is not set.
+	ErrProcessProviderEmptyCommand = awserr.New("ProcessProviderEmptyCommand", "process provider command not set", nil)
+)
+
+// A ProcessProvider retrieves credentials from the output of an external
+// command, such as a single sign-on or hardware token helper. The command
+// must print the credentials to stdout as JSON:
+//
+//     {
+//         "Version": 1,
+//         "AccessKeyId": "AKID",
+//         "SecretAccessKey": "SECRET",
+//         "SessionToken": "TOKEN",
+//         "Expiration": "2015-11-01T12:00:00Z"
+//     }
+//
+// SessionToken and Expiration are optional. If Expiration is set the command
+// will be run again once the credentials have expired, otherwise the
+// credentials never expire.
+//
+// The command is run with the system shell, "sh -c" or "cmd.exe /C" on
+// Windows, and the current environment.
+//
+//     creds := credentials.NewCredentials(&credentials.ProcessProvider{
+//         Command: "/usr/local/bin/aws-sso-helper --profile dev",
+//         Timeout: 30 * time.Second,
+//     })
+type ProcessProvider struct {
+	Expiry
+
+	// The command to run, and its arguments.
+	Command string
+
+	// The time the command is allowed to run before it is killed. If 0
+	// DefaultProcessTimeout is used.
+	Timeout time.Duration
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	retrieved bool
+	expires   bool
+}
+
+// NewProcessCredentials returns a pointer to a new Credentials object
+// wrapping the ProcessProvider running the command.
+func NewProcessCredentials(command string) *Credentials {
+	return NewCredentials(&ProcessProvider{Command: command})
+}
+
+// A processCredentialsOutput provides the shape for deserializing the
+// credentials printed by the command.
+type processCredentialsOutput struct {
+	Version         int
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+// Retrieve runs the command and returns the credentials it printed. Error
+// will be returned if the command fails, or its output is not valid
+// credentials.
+func (p *ProcessProvider) Retrieve() (Value, error) {
+	p.retrieved = false
+
+	out, err := p.run()
+	if err != nil {
+		return Value{}, err
+	}
+
+	creds := processCredentialsOutput{}
+	if err := json.Unmarshal(out, &creds); err != nil {
+		return Value{}, awserr.New("ProcessProviderParse",
+			"failed to decode process provider credentials", err)
+	}
+	if creds.Version != 0 && creds.Version != 1 {
+		return Value{}, awserr.New("ProcessProviderVersion",
+			fmt.Sprintf("unsupported process provider credentials version %d", creds.Version), nil)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return Value{}, awserr.New("ProcessProviderParse",
+			"process provider credentials missing AccessKeyId or SecretAccessKey", nil)
+	}
+
+	p.expires = creds.Expiration != nil
+	if p.expires {
+		p.SetExpiration(*creds.Expiration, p.ExpiryWindow)
+	}
+	p.retrieved = true
+
+	return Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+	}, nil
+}
+
+// IsExpired returns if the credentials have expired, or have not been
+// retrieved yet.
+func (p *ProcessProvider) IsExpired() bool {
+	if !p.retrieved {
+		return true
+	}
+	if !p.expires {
+		return false
+	}
+	return p.Expiry.IsExpired()
+}
+
+// run runs the command, returning its stdout. The command is killed if it
+// does not exit within the provider's timeout.
+func (p *ProcessProvider) run() ([]byte, error) {
+	if p.Command == "" {
+		return nil, ErrProcessProviderEmptyCommand
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.Command("sh", "-c", p.Command)
+	}
+	cmd.Env = os.Environ()
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, awserr.New("ProcessProviderExecution",
+			fmt.Sprintf("failed to start process provider command %q", p.Command), err)
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultProcessTimeout
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, awserr.New("ProcessProviderExecution",
+				fmt.Sprintf("process provider command %q failed: %s", p.Command, strings.TrimSpace(stderr.String())), err)
+		}
+	case <-time.After(timeout):
+		cmd.Process.Kill()
+		return nil, awserr.New("ProcessProviderExecution",
+			fmt.Sprintf("process provider command %q timed out after %s", p.Command, timeout), nil)
+	}
+
+	return stdout.Bytes(), nil
+}
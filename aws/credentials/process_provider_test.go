@@ -0,0 +1,104 @@
+package credentials
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+// setProcessPath sets the PATH the commands are looked up in, which other
+// tests may have cleared from the environment.
+func setProcessPath() {
+	os.Setenv("PATH", "/usr/local/bin:/usr/bin:/bin")
+}
+
+func TestProcessProvider(t *testing.T) {
+	setProcessPath()
+
+	p := &ProcessProvider{
+		Command: `echo '{"Version": 1, "AccessKeyId": "accessKey", "SecretAccessKey": "secret", "SessionToken": "token", "Expiration": "2014-12-16T01:51:37Z"}'`,
+	}
+	p.CurrentTime = func() time.Time {
+		return time.Date(2014, 12, 15, 21, 26, 0, 0, time.UTC)
+	}
+
+	assert.True(t, p.IsExpired(), "Expect creds to be expired before retrieve.")
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
+
+	p.CurrentTime = func() time.Time {
+		return time.Date(3014, 12, 15, 21, 26, 0, 0, time.UTC)
+	}
+	assert.True(t, p.IsExpired(), "Expect creds to be expired.")
+}
+
+func TestProcessProviderNoExpiration(t *testing.T) {
+	setProcessPath()
+
+	p := &ProcessProvider{
+		Command: `echo '{"AccessKeyId": "accessKey", "SecretAccessKey": "secret"}'`,
+	}
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
+	assert.Empty(t, creds.SessionToken, "Expect no session token")
+	assert.False(t, p.IsExpired(), "Expect creds without expiration to never expire.")
+}
+
+func TestProcessProviderErrors(t *testing.T) {
+	setProcessPath()
+
+	cases := []struct {
+		Command, Code, Message string
+	}{
+		{"", "ProcessProviderEmptyCommand", "process provider command not set"},
+		{"echo 'token expired' >&2; exit 1", "ProcessProviderExecution", "token expired"},
+		{"echo 'not json'", "ProcessProviderParse", "failed to decode"},
+		{`echo '{"Version": 2, "AccessKeyId": "a", "SecretAccessKey": "s"}'`, "ProcessProviderVersion", "version 2"},
+		{`echo '{"Version": 1, "AccessKeyId": "a"}'`, "ProcessProviderParse", "missing"},
+	}
+
+	for _, c := range cases {
+		p := &ProcessProvider{Command: c.Command}
+		_, err := p.Retrieve()
+		assert.Error(t, err, c.Command)
+		assert.Equal(t, c.Code, err.(awserr.Error).Code(), c.Command)
+		assert.Contains(t, err.(awserr.Error).Message(), c.Message, c.Command)
+		assert.True(t, p.IsExpired(), "Expect creds to be expired after error.")
+	}
+}
+
+func TestProcessProviderTimeout(t *testing.T) {
+	setProcessPath()
+
+	p := &ProcessProvider{Command: "sleep 5", Timeout: 100 * time.Millisecond}
+
+	start := time.Now()
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.Contains(t, err.(awserr.Error).Message(), "timed out")
+	assert.True(t, time.Now().Sub(start) < 5*time.Second, "Expect command to be killed")
+}
+
+func TestProcessProviderInChain(t *testing.T) {
+	setProcessPath()
+
+	creds := NewChainCredentials([]Provider{
+		&ProcessProvider{Command: "exit 1"},
+		&ProcessProvider{Command: `echo '{"AccessKeyId": "accessKey", "SecretAccessKey": "secret"}'`},
+	})
+
+	v, err := creds.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "accessKey", v.AccessKeyID, "Expect access key ID to match")
+}
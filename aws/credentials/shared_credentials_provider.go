@@ -20,8 +20,9 @@ var (
 //
 // Profile ini file example: $HOME/.aws/credentials
 type SharedCredentialsProvider struct {
-	// Path to the shared credentials file. If empty will default to current user's
-	// home directory.
+	// Path to the shared credentials file. If empty will default to environment
+	// variable "AWS_SHARED_CREDENTIALS_FILE", or the current user's home directory
+	// if the environment variable is also not set.
 	Filename string
 
 	// AWS Profile to extract credentials from the shared credentials file. If empty
@@ -99,10 +100,15 @@ func loadProfile(filename, profile string) (Value, error) {
 	}, nil
 }
 
-// filename returns the filename to use to read AWS shared credentials.
+// filename returns the filename to use to read AWS shared credentials. If empty
+// will read environment variable "AWS_SHARED_CREDENTIALS_FILE". If that is not
+// set the file in the current user's home directory will be used.
 //
 // Will return an error if the user's home directory path cannot be found.
 func (p *SharedCredentialsProvider) filename() (string, error) {
+	if p.Filename == "" {
+		p.Filename = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
 	if p.Filename == "" {
 		homeDir := os.Getenv("HOME") // *nix
 		if homeDir == "" {           // Windows
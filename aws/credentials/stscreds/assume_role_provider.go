@@ -5,11 +5,22 @@
 package stscreds
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/sts"
-	"time"
+)
+
+var (
+	// ErrTokenProviderNotSet is returned when the AssumeRoleProvider's
+	// SerialNumber is set, but its TokenProvider is not.
+	ErrTokenProviderNotSet = awserr.New("AssumeRoleTokenProviderNotSet", "assume role with MFA enabled, but TokenProvider is not set", nil)
 )
 
 // AssumeRoler represents the minimal subset of the STS client API used by this provider.
@@ -17,6 +28,12 @@ type AssumeRoler interface {
 	AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
 }
 
+// WebIdentityAssumer represents the minimal subset of the STS client API used
+// to assume a role with a web identity token.
+type WebIdentityAssumer interface {
+	AssumeRoleWithWebIdentity(input *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error)
+}
+
 // AssumeRoleProvider retrieves temporary credentials from the STS service, and
 // keeps track of their expiration time. This provider must be used explicitly,
 // as it is not included in the credentials chain.
@@ -38,6 +55,16 @@ type AssumeRoler interface {
 //		}
 //		creds := credentials.NewCredentials(provider)
 //
+// Example how to assume a role which requires MFA. The token code is read
+// from stdin each time the credentials are refreshed:
+//
+//		provider := &stscreds.AssumeRoleProvider{
+//			RoleARN:       "arn-of-the-role-to-assume",
+//			SerialNumber:  "arn-of-the-mfa-device",
+//			TokenProvider: stscreds.StdinTokenProvider,
+//		}
+//		creds := credentials.NewCredentials(provider)
+//
 type AssumeRoleProvider struct {
 	credentials.Expiry
 
@@ -50,9 +77,26 @@ type AssumeRoleProvider struct {
 	// Session name, if you wish to reuse the credentials elsewhere.
 	RoleSessionName string
 
+	// Optional external ID to pass to the AssumeRole call, required by roles
+	// which can be assumed by third parties.
+	ExternalID string
+
 	// Expiry duration of the STS credentials. Defaults to 15 minutes if not set.
 	Duration time.Duration
 
+	// Optional identification number of the MFA device, such as the ARN of a
+	// virtual device, required by roles which can only be assumed with MFA.
+	SerialNumber string
+
+	// TokenProvider returns the current code of the MFA device identified by
+	// SerialNumber. It is called each time the role is assumed, and must be
+	// set if SerialNumber is.
+	TokenProvider func() (string, error)
+
+	// Optional IAM policy in JSON format, which further restricts the
+	// permissions of the assumed role's credentials.
+	Policy string
+
 	// ExpiryWindow will allow the credentials to trigger refreshing prior to
 	// the credentials actually expiring. This is beneficial so race conditions
 	// with expiring credentials do not cause request to fail unexpectedly
@@ -99,22 +143,82 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 		p.Duration = 15 * time.Minute
 	}
 
-	roleOutput, err := p.Client.AssumeRole(&sts.AssumeRoleInput{
+	input := &sts.AssumeRoleInput{
 		DurationSeconds: aws.Long(int64(p.Duration / time.Second)),
 		RoleARN:         aws.String(p.RoleARN),
 		RoleSessionName: aws.String(p.RoleSessionName),
-	})
+	}
+	if p.ExternalID != "" {
+		input.ExternalID = aws.String(p.ExternalID)
+	}
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+	if p.SerialNumber != "" {
+		if p.TokenProvider == nil {
+			return credentials.Value{}, ErrTokenProviderNotSet
+		}
+		code, err := p.TokenProvider()
+		if err != nil {
+			return credentials.Value{}, err
+		}
+		input.SerialNumber = aws.String(p.SerialNumber)
+		input.TokenCode = aws.String(code)
+	}
+
+	roleOutput, err := p.Client.AssumeRole(input)
 
 	if err != nil {
 		return credentials.Value{}, err
 	}
 
+	if roleOutput.Credentials == nil {
+		return credentials.Value{}, awserr.New("AssumeRoleCredentialsNotFound",
+			"no credentials returned for role "+p.RoleARN, nil)
+	}
+
 	// We will proactively generate new credentials before they expire.
-	p.SetExpiration(*roleOutput.Credentials.Expiration, p.ExpiryWindow)
+	if roleOutput.Credentials.Expiration != nil {
+		p.SetExpiration(*roleOutput.Credentials.Expiration, p.ExpiryWindow)
+	}
 
 	return credentials.Value{
-		AccessKeyID:     *roleOutput.Credentials.AccessKeyID,
-		SecretAccessKey: *roleOutput.Credentials.SecretAccessKey,
-		SessionToken:    *roleOutput.Credentials.SessionToken,
+		AccessKeyID:     aws.StringValue(roleOutput.Credentials.AccessKeyID),
+		SecretAccessKey: aws.StringValue(roleOutput.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(roleOutput.Credentials.SessionToken),
 	}, nil
 }
+
+// NewChainedCredentials returns a pointer to a new Credentials object which
+// assumes the role of each of the providers in turn, each role's credentials
+// signing the AssumeRole call of the next. The source credentials sign the
+// first provider's call, or the default credentials if source is nil.
+//
+// A provider's Client is only set to an STS client using the previous role's
+// credentials if it is not already set.
+//
+//		creds := stscreds.NewChainedCredentials(nil,
+//			&stscreds.AssumeRoleProvider{RoleARN: "arn-of-the-jump-role"},
+//			&stscreds.AssumeRoleProvider{RoleARN: "arn-of-the-target-role", ExternalID: "id"},
+//		)
+func NewChainedCredentials(source *credentials.Credentials, providers ...*AssumeRoleProvider) *credentials.Credentials {
+	creds := source
+	for _, p := range providers {
+		if p.Client == nil && creds != nil {
+			p.Client = sts.New(&aws.Config{Credentials: creds})
+		}
+		creds = credentials.NewCredentials(p)
+	}
+	return creds
+}
+
+// StdinTokenProvider prompts on stderr for the MFA token code, and reads it
+// from stdin. It can be used as an AssumeRoleProvider's TokenProvider.
+func StdinTokenProvider() (string, error) {
+	fmt.Fprint(os.Stderr, "Assume Role MFA token code: ")
+	code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && code == "" {
+		return "", awserr.New("AssumeRoleTokenCodeRead", "failed to read MFA token code", err)
+	}
+	return strings.TrimSpace(code), nil
+}
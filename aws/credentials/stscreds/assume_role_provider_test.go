@@ -1,17 +1,28 @@
 package stscreds
 
 import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/stretchr/testify/assert"
-	"testing"
-	"time"
 )
 
 type stubSTS struct {
+	input         *sts.AssumeRoleInput
+	noCredentials bool
 }
 
 func (s *stubSTS) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	s.input = input
+	if s.noCredentials {
+		return &sts.AssumeRoleOutput{}, nil
+	}
 	expiry := time.Now().Add(60 * time.Minute)
 	return &sts.AssumeRoleOutput{
 		Credentials: &sts.Credentials{
@@ -39,6 +50,117 @@ func TestAssumeRoleProvider(t *testing.T) {
 	assert.Equal(t, "assumedSessionToken", creds.SessionToken, "Expect session token to match")
 }
 
+func TestAssumeRoleProviderNoCredentials(t *testing.T) {
+	p := &AssumeRoleProvider{
+		Client:  &stubSTS{noCredentials: true},
+		RoleARN: "roleARN",
+	}
+
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.Equal(t, "AssumeRoleCredentialsNotFound", err.(awserr.Error).Code())
+	assert.True(t, p.IsExpired(), "Expect creds to be expired after failed retrieve.")
+}
+
+func TestAssumeRoleProviderWithExternalID(t *testing.T) {
+	stub := &stubSTS{}
+	p := &AssumeRoleProvider{
+		Client:     stub,
+		RoleARN:    "roleARN",
+		ExternalID: "externalID",
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "externalID", *stub.input.ExternalID, "Expect external ID to be passed")
+
+	p.ExternalID = ""
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Nil(t, stub.input.ExternalID, "Expect no external ID")
+}
+
+func TestAssumeRoleProviderWithPolicy(t *testing.T) {
+	stub := &stubSTS{}
+	p := &AssumeRoleProvider{
+		Client:  stub,
+		RoleARN: "roleARN",
+		Policy:  `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, p.Policy, *stub.input.Policy, "Expect policy to be passed")
+	assert.Nil(t, stub.input.SerialNumber, "Expect no MFA serial number")
+	assert.Nil(t, stub.input.TokenCode, "Expect no MFA token code")
+}
+
+func TestAssumeRoleProviderWithMFA(t *testing.T) {
+	stub := &stubSTS{}
+	calls := 0
+	p := &AssumeRoleProvider{
+		Client:       stub,
+		RoleARN:      "roleARN",
+		SerialNumber: "arn:aws:iam::123456789012:mfa/user",
+		TokenProvider: func() (string, error) {
+			calls++
+			return fmt.Sprintf("%06d", calls), nil
+		},
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "arn:aws:iam::123456789012:mfa/user", *stub.input.SerialNumber, "Expect serial number to be passed")
+	assert.Equal(t, "000001", *stub.input.TokenCode, "Expect token code to be passed")
+
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "000002", *stub.input.TokenCode, "Expect token code to be requested on each retrieve")
+}
+
+func TestAssumeRoleProviderWithMFAErrors(t *testing.T) {
+	stub := &stubSTS{}
+	p := &AssumeRoleProvider{
+		Client:       stub,
+		RoleARN:      "roleARN",
+		SerialNumber: "arn:aws:iam::123456789012:mfa/user",
+	}
+
+	_, err := p.Retrieve()
+	assert.Equal(t, ErrTokenProviderNotSet, err, "Expect token provider error")
+
+	tokenErr := errors.New("token device unavailable")
+	p.TokenProvider = func() (string, error) { return "", tokenErr }
+	_, err = p.Retrieve()
+	assert.Equal(t, tokenErr, err, "Expect token provider's error")
+	assert.Nil(t, stub.input, "Expect AssumeRole to not be called")
+}
+
+func TestNewChainedCredentials(t *testing.T) {
+	source := credentials.NewStaticCredentials("AKID", "SECRET", "")
+	stub := &stubSTS{}
+	first := &AssumeRoleProvider{RoleARN: "firstRoleARN"}
+	second := &AssumeRoleProvider{Client: stub, RoleARN: "secondRoleARN"}
+	third := &AssumeRoleProvider{RoleARN: "thirdRoleARN"}
+
+	creds := NewChainedCredentials(source, first, second, third)
+	assert.NotNil(t, creds)
+
+	assert.Equal(t, source, first.Client.(*sts.STS).Config.Credentials, "Expect source credentials to sign first role")
+	assert.Equal(t, stub, second.Client, "Expect custom client to be kept")
+
+	// The third role is signed by the second role's credentials.
+	value, err := third.Client.(*sts.STS).Config.Credentials.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "secondRoleARN", value.AccessKeyID, "Expect second role's credentials")
+}
+
+func TestNewChainedCredentialsDefaultSource(t *testing.T) {
+	p := &AssumeRoleProvider{RoleARN: "roleARN"}
+	NewChainedCredentials(nil, p)
+	assert.Nil(t, p.Client, "Expect default client to be used")
+}
+
 func BenchmarkAssumeRoleProvider(b *testing.B) {
 	stub := &stubSTS{}
 	p := &AssumeRoleProvider{
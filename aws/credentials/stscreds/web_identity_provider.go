@@ -0,0 +1,168 @@
+package stscreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+var (
+	// ErrWebIdentityTokenFileNotFound is returned when the
+	// WebIdentityRoleProvider's TokenFilePath is not set, and
+	// AWS_WEB_IDENTITY_TOKEN_FILE is not set in the environment.
+	ErrWebIdentityTokenFileNotFound = awserr.New("WebIdentityTokenFileNotFound", "AWS_WEB_IDENTITY_TOKEN_FILE not found in environment", nil)
+
+	// ErrWebIdentityRoleARNNotFound is returned when the
+	// WebIdentityRoleProvider's RoleARN is not set, and AWS_ROLE_ARN is not
+	// set in the environment.
+	ErrWebIdentityRoleARNNotFound = awserr.New("WebIdentityRoleARNNotFound", "AWS_ROLE_ARN not found in environment", nil)
+)
+
+// WebIdentityRoleProvider retrieves temporary credentials from the STS service
+// by assuming a role with an OpenID Connect token read from a file, and keeps
+// track of their expiration time. The token file is read each time the
+// credentials are refreshed, so the token can be rotated by writing a new
+// token to the file.
+//
+// Environment variables used, if the provider's fields are not set:
+// - Token file:        AWS_WEB_IDENTITY_TOKEN_FILE
+// - Role ARN:          AWS_ROLE_ARN
+// - Role session name: AWS_ROLE_SESSION_NAME
+//
+// A provider configured from the environment returns an error if the
+// environment variables are not set, so it can be used in a ChainProvider:
+//
+//		creds := credentials.NewChainCredentials([]credentials.Provider{
+//			&credentials.EnvProvider{},
+//			&stscreds.WebIdentityRoleProvider{},
+//			&credentials.EC2RoleProvider{},
+//		})
+//
+type WebIdentityRoleProvider struct {
+	credentials.Expiry
+
+	// Custom STS client. If not set the default STS client will be used.
+	Client WebIdentityAssumer
+
+	// Role to be assumed. If not set AWS_ROLE_ARN is used.
+	RoleARN string
+
+	// Session name. If not set AWS_ROLE_SESSION_NAME is used, or a
+	// nanosecond timestamp if the environment variable is also not set.
+	RoleSessionName string
+
+	// Path to the file the token is read from. If not set
+	// AWS_WEB_IDENTITY_TOKEN_FILE is used.
+	TokenFilePath string
+
+	// Optional IAM policy in JSON format, which further restricts the
+	// permissions of the assumed role's credentials.
+	Policy string
+
+	// Expiry duration of the STS credentials. Defaults to 15 minutes if not set.
+	Duration time.Duration
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring. This is beneficial so race conditions
+	// with expiring credentials do not cause request to fail unexpectedly
+	// due to ExpiredTokenException exceptions.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewWebIdentityCredentials returns a pointer to a new Credentials object
+// wrapping the WebIdentityRoleProvider, assuming the role with the token read
+// from the file at tokenFilePath.
+//
+// Pass nil as client to use the default client.
+func NewWebIdentityCredentials(client WebIdentityAssumer, roleARN, roleSessionName, tokenFilePath string) *credentials.Credentials {
+	return credentials.NewCredentials(&WebIdentityRoleProvider{
+		Client:          client,
+		RoleARN:         roleARN,
+		RoleSessionName: roleSessionName,
+		TokenFilePath:   tokenFilePath,
+	})
+}
+
+// Retrieve reads the token file, and generates a new set of temporary
+// credentials using STS.
+func (p *WebIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	tokenFilePath := p.TokenFilePath
+	if tokenFilePath == "" {
+		tokenFilePath = os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	}
+	if tokenFilePath == "" {
+		return credentials.Value{}, ErrWebIdentityTokenFileNotFound
+	}
+
+	roleARN := p.RoleARN
+	if roleARN == "" {
+		roleARN = os.Getenv("AWS_ROLE_ARN")
+	}
+	if roleARN == "" {
+		return credentials.Value{}, ErrWebIdentityRoleARNNotFound
+	}
+
+	sessionName := p.RoleSessionName
+	if sessionName == "" {
+		sessionName = os.Getenv("AWS_ROLE_SESSION_NAME")
+	}
+	if sessionName == "" {
+		// Try to work out a role name that will hopefully end up unique.
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	b, err := ioutil.ReadFile(tokenFilePath)
+	if err != nil {
+		return credentials.Value{}, awserr.New("WebIdentityTokenFileRead",
+			"failed to read web identity token file "+tokenFilePath, err)
+	}
+	token := strings.TrimSpace(string(b))
+
+	// Apply defaults where parameters are not set.
+	if p.Client == nil {
+		p.Client = sts.New(nil)
+	}
+	if p.Duration == 0 {
+		// Expire as often as AWS permits.
+		p.Duration = 15 * time.Minute
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		DurationSeconds:  aws.Long(int64(p.Duration / time.Second)),
+		RoleARN:          aws.String(roleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(token),
+	}
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+
+	out, err := p.Client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	if out.Credentials == nil {
+		return credentials.Value{}, awserr.New("WebIdentityCredentialsNotFound",
+			"no credentials returned for role "+roleARN, nil)
+	}
+
+	// We will proactively generate new credentials before they expire.
+	if out.Credentials.Expiration != nil {
+		p.SetExpiration(*out.Credentials.Expiration, p.ExpiryWindow)
+	}
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(out.Credentials.AccessKeyID),
+		SecretAccessKey: aws.StringValue(out.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(out.Credentials.SessionToken),
+	}, nil
+}
@@ -0,0 +1,146 @@
+package stscreds
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubWebIdentitySTS struct {
+	input         *sts.AssumeRoleWithWebIdentityInput
+	noCredentials bool
+}
+
+func (s *stubWebIdentitySTS) AssumeRoleWithWebIdentity(input *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	s.input = input
+	if s.noCredentials {
+		return &sts.AssumeRoleWithWebIdentityOutput{}, nil
+	}
+	expiry := time.Now().Add(60 * time.Minute)
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &sts.Credentials{
+			// Just reflect the web identity token to the provider.
+			AccessKeyID:     input.WebIdentityToken,
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      &expiry,
+		},
+	}, nil
+}
+
+func writeTokenFile(t *testing.T, token string) string {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(filename, []byte(token), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestWebIdentityRoleProvider(t *testing.T) {
+	filename := writeTokenFile(t, "firstToken\n")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	stub := &stubWebIdentitySTS{}
+	p := &WebIdentityRoleProvider{
+		Client:          stub,
+		RoleARN:         "roleARN",
+		RoleSessionName: "session",
+		TokenFilePath:   filename,
+		Policy:          `{"Statement":[]}`,
+	}
+
+	assert.True(t, p.IsExpired(), "Expect creds to be expired before retrieve.")
+
+	creds, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "firstToken", creds.AccessKeyID, "Expect access key ID to be reflected token")
+	assert.Equal(t, "assumedSecretAccessKey", creds.SecretAccessKey, "Expect secret access key to match")
+	assert.Equal(t, "assumedSessionToken", creds.SessionToken, "Expect session token to match")
+	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
+
+	assert.Equal(t, "roleARN", *stub.input.RoleARN)
+	assert.Equal(t, "session", *stub.input.RoleSessionName)
+	assert.Equal(t, int64(900), *stub.input.DurationSeconds)
+	assert.Equal(t, `{"Statement":[]}`, *stub.input.Policy)
+
+	// The token file is read again on each retrieve.
+	ioutil.WriteFile(filename, []byte("rotatedToken"), 0600)
+	creds, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "rotatedToken", creds.AccessKeyID, "Expect rotated token to be used")
+}
+
+func TestWebIdentityRoleProviderFromEnv(t *testing.T) {
+	filename := writeTokenFile(t, "envToken")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	os.Clearenv()
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", filename)
+	os.Setenv("AWS_ROLE_ARN", "envRoleARN")
+	os.Setenv("AWS_ROLE_SESSION_NAME", "envSession")
+
+	stub := &stubWebIdentitySTS{}
+	creds, err := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvProvider{},
+		&WebIdentityRoleProvider{Client: stub},
+	}).Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "envToken", creds.AccessKeyID, "Expect access key ID to be reflected token")
+	assert.Equal(t, "envRoleARN", *stub.input.RoleARN)
+	assert.Equal(t, "envSession", *stub.input.RoleSessionName)
+	assert.Nil(t, stub.input.Policy)
+}
+
+func TestWebIdentityRoleProviderNotSet(t *testing.T) {
+	os.Clearenv()
+
+	p := &WebIdentityRoleProvider{Client: &stubWebIdentitySTS{}}
+	_, err := p.Retrieve()
+	assert.Equal(t, ErrWebIdentityTokenFileNotFound, err, "Expect missing token file error")
+
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "token")
+	_, err = p.Retrieve()
+	assert.Equal(t, ErrWebIdentityRoleARNNotFound, err, "Expect missing role ARN error")
+}
+
+func TestWebIdentityRoleProviderTokenFileMissing(t *testing.T) {
+	stub := &stubWebIdentitySTS{}
+	p := &WebIdentityRoleProvider{
+		Client:        stub,
+		RoleARN:       "roleARN",
+		TokenFilePath: filepath.Join(os.TempDir(), "stscreds-missing-token"),
+	}
+
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.Equal(t, "WebIdentityTokenFileRead", err.(awserr.Error).Code())
+	assert.Nil(t, stub.input, "Expect AssumeRoleWithWebIdentity to not be called")
+}
+
+func TestWebIdentityRoleProviderNoCredentials(t *testing.T) {
+	filename := writeTokenFile(t, "token")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	p := &WebIdentityRoleProvider{
+		Client:        &stubWebIdentitySTS{noCredentials: true},
+		RoleARN:       "roleARN",
+		TokenFilePath: filename,
+	}
+
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.Equal(t, "WebIdentityCredentialsNotFound", err.(awserr.Error).Code())
+	assert.True(t, p.IsExpired(), "Expect creds to be expired after failed retrieve.")
+}
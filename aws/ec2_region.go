@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+)
+
+// ec2MetadataClient is the client the region of the EC2 instance is detected
+// with. It does not retry, so programs not running on EC2 are not delayed.
+var ec2MetadataClient = &ec2metadata.Client{}
+
+// ec2Region caches the region of the EC2 instance the program is running on.
+var ec2Region struct {
+	once   sync.Once
+	region string
+}
+
+// detectEC2Region returns the region of the EC2 instance the program is
+// running on, or "" if it is not running on EC2. The region is detected once
+// from the EC2 instance metadata service.
+func detectEC2Region() string {
+	ec2Region.once.Do(func() {
+		ec2Region.region, _ = ec2MetadataClient.Region()
+	})
+	return ec2Region.region
+}
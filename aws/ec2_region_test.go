@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEC2RegionDetection(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, "eu-west-1a")
+	}))
+	defer server.Close()
+
+	defaultClient := ec2MetadataClient
+	defer func() {
+		ec2MetadataClient = defaultClient
+		ec2Region.once, ec2Region.region = sync.Once{}, ""
+	}()
+	ec2MetadataClient = &ec2metadata.Client{Endpoint: server.URL}
+	ec2Region.once, ec2Region.region = sync.Once{}, ""
+
+	// Detection is not enabled by default.
+	s := NewService(&Config{})
+	assert.Equal(t, "", StringValue(s.Config.Region))
+	assert.Equal(t, 0, requests)
+
+	cfg := NewConfig().WithDetectEC2Region(true)
+	s = &Service{Config: cfg, ServiceName: "sqs"}
+	s.Initialize()
+	assert.Equal(t, "eu-west-1", StringValue(s.Config.Region))
+	assert.Equal(t, "https://sqs.eu-west-1.amazonaws.com", s.Endpoint)
+	assert.Nil(t, cfg.Region, "Expect the caller's Config to be unchanged")
+
+	// The region is only detected once.
+	s = NewService(NewConfig().WithDetectEC2Region(true))
+	assert.Equal(t, "eu-west-1", StringValue(s.Config.Region))
+	assert.Equal(t, 1, requests)
+
+	// A configured region is used instead.
+	s = NewService(NewConfig().WithDetectEC2Region(true).WithRegion("us-west-2"))
+	assert.Equal(t, "us-west-2", StringValue(s.Config.Region))
+}
@@ -0,0 +1,208 @@
+package ec2metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// InstanceID returns the ID of the instance.
+func (c *Client) InstanceID() (string, error) {
+	return c.GetMetadata("instance-id")
+}
+
+// AvailabilityZone returns the availability zone the instance is running
+// in, e.g. "us-west-2a".
+func (c *Client) AvailabilityZone() (string, error) {
+	return c.GetMetadata("placement/availability-zone")
+}
+
+// Region returns the region the instance is running in, e.g. "us-west-2".
+// Services are not configured with it automatically, it can be used as the
+// region of a Config when the "AWS_REGION" environment variable is not set.
+func (c *Client) Region() (string, error) {
+	az, err := c.AvailabilityZone()
+	if err != nil {
+		return "", err
+	}
+	if az == "" {
+		return "", awserr.New("EC2MetadataError", "empty EC2 availability zone", nil)
+	}
+
+	// The region is the availability zone without its zone letter.
+	return az[:len(az)-1], nil
+}
+
+// An InstanceIdentityDocument describes the instance, and is signed by AWS
+// so that it can be verified by other parties.
+type InstanceIdentityDocument struct {
+	AccountID        string    `json:"accountId"`
+	Architecture     string    `json:"architecture"`
+	AvailabilityZone string    `json:"availabilityZone"`
+	ImageID          string    `json:"imageId"`
+	InstanceID       string    `json:"instanceId"`
+	InstanceType     string    `json:"instanceType"`
+	KernelID         string    `json:"kernelId"`
+	PendingTime      time.Time `json:"pendingTime"`
+	PrivateIP        string    `json:"privateIp"`
+	RamdiskID        string    `json:"ramdiskId"`
+	Region           string    `json:"region"`
+	Version          string    `json:"version"`
+}
+
+// GetInstanceIdentityDocument returns the instance's identity document.
+func (c *Client) GetInstanceIdentityDocument() (InstanceIdentityDocument, error) {
+	doc := InstanceIdentityDocument{}
+
+	resp, err := c.GetDynamicData("instance-identity/document")
+	if err != nil {
+		return doc, err
+	}
+	if err := json.Unmarshal([]byte(resp), &doc); err != nil {
+		return doc, awserr.New("SerializationError",
+			"failed to decode EC2 instance identity document", err)
+	}
+	return doc, nil
+}
+
+// A NetworkInterface describes one of the network interfaces attached to
+// the instance.
+type NetworkInterface struct {
+	MAC          string
+	DeviceNumber int
+	InterfaceID  string
+	SubnetID     string
+	VPCID        string
+	LocalIPv4s   []string
+	PublicIPv4s  []string
+}
+
+// NetworkInterfaces returns the network interfaces attached to the instance,
+// ordered by their device number.
+func (c *Client) NetworkInterfaces() ([]NetworkInterface, error) {
+	macs, err := c.GetMetadata("network/interfaces/macs/")
+	if err != nil {
+		return nil, err
+	}
+
+	ifaces := []NetworkInterface{}
+	for _, mac := range lines(macs) {
+		iface, err := c.networkInterface(strings.TrimSuffix(mac, "/"))
+		if err != nil {
+			return nil, err
+		}
+		ifaces = append(ifaces, iface)
+	}
+
+	// Insertion sort, there are only a few interfaces.
+	for i := 1; i < len(ifaces); i++ {
+		for j := i; j > 0 && ifaces[j].DeviceNumber < ifaces[j-1].DeviceNumber; j-- {
+			ifaces[j], ifaces[j-1] = ifaces[j-1], ifaces[j]
+		}
+	}
+	return ifaces, nil
+}
+
+// networkInterface returns the network interface with the MAC address.
+func (c *Client) networkInterface(mac string) (NetworkInterface, error) {
+	path := "network/interfaces/macs/" + mac + "/"
+	iface := NetworkInterface{MAC: mac}
+
+	var err error
+	if iface.InterfaceID, err = c.GetMetadata(path + "interface-id"); err != nil {
+		return iface, err
+	}
+
+	num, err := c.GetMetadata(path + "device-number")
+	if err != nil {
+		return iface, err
+	}
+	if iface.DeviceNumber, err = strconv.Atoi(strings.TrimSpace(num)); err != nil {
+		return iface, awserr.New("SerializationError",
+			fmt.Sprintf("failed to decode device number of network interface %s", mac), err)
+	}
+
+	ips, err := c.GetMetadata(path + "local-ipv4s")
+	if err != nil {
+		return iface, err
+	}
+	iface.LocalIPv4s = lines(ips)
+
+	// Interfaces outside of a VPC, or without a public address, do not have
+	// these values.
+	if iface.SubnetID, err = c.optionalMetadata(path + "subnet-id"); err != nil {
+		return iface, err
+	}
+	if iface.VPCID, err = c.optionalMetadata(path + "vpc-id"); err != nil {
+		return iface, err
+	}
+	if ips, err = c.optionalMetadata(path + "public-ipv4s"); err != nil {
+		return iface, err
+	}
+	iface.PublicIPv4s = lines(ips)
+
+	return iface, nil
+}
+
+// optionalMetadata returns the instance metadata at the path, or "" if it
+// does not exist.
+func (c *Client) optionalMetadata(path string) (string, error) {
+	v, err := c.GetMetadata(path)
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == 404 {
+		return "", nil
+	}
+	return v, err
+}
+
+// IAMRoles returns the names of the IAM roles of the instance's instance
+// profile.
+func (c *Client) IAMRoles() ([]string, error) {
+	resp, err := c.GetMetadata("iam/security-credentials/")
+	if err != nil {
+		return nil, err
+	}
+	return lines(resp), nil
+}
+
+// IAMSecurityCredentials are the temporary credentials of one of the
+// instance's IAM roles.
+type IAMSecurityCredentials struct {
+	Code            string
+	LastUpdated     time.Time
+	Type            string
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	Token           string
+	Expiration      time.Time
+}
+
+// GetIAMSecurityCredentials returns the temporary credentials of the IAM
+// role.
+func (c *Client) GetIAMSecurityCredentials(role string) (IAMSecurityCredentials, error) {
+	creds := IAMSecurityCredentials{}
+
+	resp, err := c.GetMetadata("iam/security-credentials/" + role)
+	if err != nil {
+		return creds, err
+	}
+	if err := json.Unmarshal([]byte(resp), &creds); err != nil {
+		return creds, awserr.New("SerializationError",
+			fmt.Sprintf("failed to decode %s EC2 role credentials", role), err)
+	}
+	return creds, nil
+}
+
+// lines returns the non-empty lines of the metadata listing.
+func lines(s string) []string {
+	l := []string{}
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			l = append(l, line)
+		}
+	}
+	return l
+}
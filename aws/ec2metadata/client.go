@@ -0,0 +1,144 @@
+// Package ec2metadata provides a client for the EC2 instance metadata service,
+// which returns information about the EC2 instance a program is running on,
+// such as its instance ID, region, user data, and IAM role credentials.
+package ec2metadata
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// DefaultEndpoint is the endpoint, and version, of the EC2 instance metadata
+// service.
+const DefaultEndpoint = "http://169.254.169.254/latest"
+
+// DefaultRetries is the number of times a Client created by New retries a
+// failed request.
+const DefaultRetries = 3
+
+// defaultHTTPClient is used by Clients without an HTTPClient. The metadata
+// service is local to the instance, so a short connect timeout quickly
+// detects when the program is not running on EC2.
+var defaultHTTPClient = &http.Client{
+	Timeout: 5 * time.Second,
+	Transport: &http.Transport{
+		Dial: (&net.Dialer{Timeout: 1 * time.Second}).Dial,
+	},
+}
+
+// retryDelay is the delay before the first retry of a request, doubled for
+// each retry after it.
+var retryDelay = 50 * time.Millisecond
+
+// A Client retrieves data from the EC2 instance metadata service.
+//
+// Example of a Client used to retrieve the instance's ID:
+//
+//     c := ec2metadata.New()
+//     if !c.Available() {
+//         return errors.New("not running on EC2")
+//     }
+//     id, err := c.InstanceID()
+type Client struct {
+	// Endpoint of the metadata service, including its version. If empty
+	// DefaultEndpoint is used.
+	Endpoint string
+
+	// HTTP client used to connect to the metadata service. If nil a client
+	// with a short connect timeout is used.
+	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a request which fails to connect, or
+	// receives a 5xx response, is retried.
+	MaxRetries int
+}
+
+// New returns a new Client for the metadata service at DefaultEndpoint,
+// which retries failed requests DefaultRetries times.
+func New() *Client {
+	return &Client{Endpoint: DefaultEndpoint, MaxRetries: DefaultRetries}
+}
+
+// GetMetadata returns the instance metadata at the path, e.g. "instance-id".
+func (c *Client) GetMetadata(path string) (string, error) {
+	return c.get("meta-data/" + path)
+}
+
+// GetDynamicData returns the dynamic data at the path, e.g.
+// "instance-identity/document".
+func (c *Client) GetDynamicData(path string) (string, error) {
+	return c.get("dynamic/" + path)
+}
+
+// GetUserData returns the user data the instance was launched with. An
+// awserr.RequestFailure with status code 404 is returned if the instance has
+// no user data.
+func (c *Client) GetUserData() (string, error) {
+	return c.get("user-data")
+}
+
+// Available returns if the metadata service is available, which is only the
+// case when running on an EC2 instance. Failed requests are not retried.
+func (c *Client) Available() bool {
+	probe := *c
+	probe.MaxRetries = 0
+	_, err := probe.GetMetadata("instance-id")
+	return err == nil
+}
+
+// get returns the body of the path, retrying the request if it fails to
+// connect, or receives a server error.
+func (c *Client) get(path string) (string, error) {
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	client := c.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	url := strings.TrimRight(endpoint, "/") + "/" + path
+
+	var body string
+	var err error
+	var retry bool
+	for i := 0; ; i++ {
+		body, retry, err = getOnce(client, url, path)
+		if err == nil || !retry || i >= c.MaxRetries {
+			return body, err
+		}
+		time.Sleep(retryDelay * time.Duration(1<<uint(i)))
+	}
+}
+
+// getOnce makes a single request for the url, returning if a failed request
+// can be retried.
+func getOnce(client *http.Client, url, path string) (string, bool, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", true, awserr.New("EC2MetadataRequestError",
+			fmt.Sprintf("failed to get EC2 metadata %s", path), err)
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", true, awserr.New("EC2MetadataRequestError",
+			fmt.Sprintf("failed to read EC2 metadata %s", path), err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", resp.StatusCode >= 500, awserr.NewRequestFailure(
+			awserr.New("EC2MetadataError",
+				fmt.Sprintf("failed to get EC2 metadata %s, %s", path, resp.Status), nil),
+			resp.StatusCode, "")
+	}
+
+	return string(b), false, nil
+}
@@ -0,0 +1,188 @@
+package ec2metadata_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+var testMetadata = map[string]string{
+	"/latest/meta-data/instance-id":                 "i-1234567890abcdef0",
+	"/latest/meta-data/placement/availability-zone": "us-west-2b",
+	"/latest/user-data":                             "#!/bin/sh\necho hello",
+	"/latest/dynamic/instance-identity/document": `{
+  "accountId" : "123456789012",
+  "availabilityZone" : "us-west-2b",
+  "imageId" : "ami-5fb8c835",
+  "instanceId" : "i-1234567890abcdef0",
+  "instanceType" : "t2.micro",
+  "pendingTime" : "2015-11-19T16:32:11Z",
+  "privateIp" : "10.158.112.84",
+  "region" : "us-west-2"
+}`,
+	"/latest/meta-data/network/interfaces/macs/":                                "0e:11:11:11:11:11/\n0e:00:00:00:00:00/",
+	"/latest/meta-data/network/interfaces/macs/0e:00:00:00:00:00/interface-id":  "eni-0",
+	"/latest/meta-data/network/interfaces/macs/0e:00:00:00:00:00/device-number": "0",
+	"/latest/meta-data/network/interfaces/macs/0e:00:00:00:00:00/local-ipv4s":   "10.0.0.1\n10.0.0.2",
+	"/latest/meta-data/network/interfaces/macs/0e:00:00:00:00:00/public-ipv4s":  "54.0.0.1",
+	"/latest/meta-data/network/interfaces/macs/0e:00:00:00:00:00/subnet-id":     "subnet-0",
+	"/latest/meta-data/network/interfaces/macs/0e:00:00:00:00:00/vpc-id":        "vpc-0",
+	"/latest/meta-data/network/interfaces/macs/0e:11:11:11:11:11/interface-id":  "eni-1",
+	"/latest/meta-data/network/interfaces/macs/0e:11:11:11:11:11/device-number": "1",
+	"/latest/meta-data/network/interfaces/macs/0e:11:11:11:11:11/local-ipv4s":   "10.0.1.1",
+	"/latest/meta-data/iam/security-credentials/":                               "role",
+	"/latest/meta-data/iam/security-credentials/role": `{
+  "Code" : "Success",
+  "AccessKeyId" : "accessKey",
+  "SecretAccessKey" : "secret",
+  "Token" : "token",
+  "Expiration" : "2014-12-16T01:51:37Z"
+}`,
+}
+
+func newTestClient() (*ec2metadata.Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, ok := testMetadata[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, v)
+	}))
+
+	c := ec2metadata.New()
+	c.Endpoint = server.URL + "/latest"
+	return c, server
+}
+
+func TestGetMetadata(t *testing.T) {
+	c, server := newTestClient()
+	defer server.Close()
+
+	id, err := c.InstanceID()
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1234567890abcdef0", id)
+
+	az, err := c.AvailabilityZone()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2b", az)
+
+	region, err := c.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", region)
+
+	data, err := c.GetUserData()
+	assert.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho hello", data)
+
+	assert.True(t, c.Available())
+}
+
+func TestGetMetadataNotFound(t *testing.T) {
+	c, server := newTestClient()
+	defer server.Close()
+
+	_, err := c.GetMetadata("missing")
+	assert.Error(t, err)
+	reqErr := err.(awserr.RequestFailure)
+	assert.Equal(t, "EC2MetadataError", reqErr.Code())
+	assert.Equal(t, 404, reqErr.StatusCode())
+}
+
+func TestGetMetadataRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, "i-1234567890abcdef0")
+	}))
+	defer server.Close()
+
+	c := &ec2metadata.Client{Endpoint: server.URL, MaxRetries: 2}
+	id, err := c.InstanceID()
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1234567890abcdef0", id)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	c.MaxRetries = 1
+	_, err = c.InstanceID()
+	assert.Error(t, err)
+	assert.Equal(t, 503, err.(awserr.RequestFailure).StatusCode())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestNotAvailable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	c := &ec2metadata.Client{Endpoint: server.URL, MaxRetries: 3}
+	assert.False(t, c.Available())
+
+	_, err := c.InstanceID()
+	assert.Error(t, err)
+	assert.Equal(t, "EC2MetadataRequestError", err.(awserr.Error).Code())
+}
+
+func TestGetInstanceIdentityDocument(t *testing.T) {
+	c, server := newTestClient()
+	defer server.Close()
+
+	doc, err := c.GetInstanceIdentityDocument()
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012", doc.AccountID)
+	assert.Equal(t, "i-1234567890abcdef0", doc.InstanceID)
+	assert.Equal(t, "t2.micro", doc.InstanceType)
+	assert.Equal(t, "us-west-2", doc.Region)
+	assert.Equal(t, 2015, doc.PendingTime.Year())
+}
+
+func TestNetworkInterfaces(t *testing.T) {
+	c, server := newTestClient()
+	defer server.Close()
+
+	ifaces, err := c.NetworkInterfaces()
+	assert.NoError(t, err)
+	assert.Equal(t, []ec2metadata.NetworkInterface{
+		{
+			MAC:          "0e:00:00:00:00:00",
+			DeviceNumber: 0,
+			InterfaceID:  "eni-0",
+			SubnetID:     "subnet-0",
+			VPCID:        "vpc-0",
+			LocalIPv4s:   []string{"10.0.0.1", "10.0.0.2"},
+			PublicIPv4s:  []string{"54.0.0.1"},
+		},
+		{
+			MAC:          "0e:11:11:11:11:11",
+			DeviceNumber: 1,
+			InterfaceID:  "eni-1",
+			LocalIPv4s:   []string{"10.0.1.1"},
+			PublicIPv4s:  []string{},
+		},
+	}, ifaces)
+}
+
+func TestIAMSecurityCredentials(t *testing.T) {
+	c, server := newTestClient()
+	defer server.Close()
+
+	roles, err := c.IAMRoles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"role"}, roles)
+
+	creds, err := c.GetIAMSecurityCredentials("role")
+	assert.NoError(t, err)
+	assert.Equal(t, "accessKey", creds.AccessKeyID)
+	assert.Equal(t, "secret", creds.SecretAccessKey)
+	assert.Equal(t, "token", creds.Token)
+	assert.Equal(t, 2014, creds.Expiration.Year())
+}
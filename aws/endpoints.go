@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/internal/endpoints"
+)
+
+// Partition IDs of the partitions the SDK resolves endpoints for. Each
+// partition is a separate set of regions, with its own endpoints.
+const (
+	PartitionAWS      = "aws"
+	PartitionAWSCN    = "aws-cn"
+	PartitionAWSUSGov = "aws-us-gov"
+)
+
+// PartitionForRegion returns the ID of the partition the region belongs to.
+// Regions which do not belong to a known partition belong to PartitionAWS.
+func PartitionForRegion(region string) string {
+	return endpoints.PartitionForRegion(region)
+}
+
+// An EndpointResolver resolves the endpoint URL, and signing region, of a
+// service in a region. The service is identified by its ServiceName, e.g.
+// "s3" or "sqs".
+//
+// The URL may omit its scheme, in which case it will use https unless the
+// Config's DisableSSL is set. An empty signing region signs requests with
+// the Config's Region.
+//
+// An EndpointResolver can be set on the Config to change the endpoints of
+// all the services created with it. The Config's Endpoint takes precedence
+// over the resolver.
+type EndpointResolver interface {
+	EndpointFor(service, region string) (url, signingRegion string, err error)
+}
+
+// EndpointResolverFunc is a function which implements the EndpointResolver
+// interface.
+type EndpointResolverFunc func(service, region string) (url, signingRegion string, err error)
+
+// EndpointFor calls f(service, region).
+func (f EndpointResolverFunc) EndpointFor(service, region string) (string, string, error) {
+	return f(service, region)
+}
+
+// A ServiceEndpoint is the endpoint URL, and optional signing region, a
+// service's requests are sent to.
+type ServiceEndpoint struct {
+	URL           string
+	SigningRegion string
+}
+
+// DefaultEndpointResolver implements the EndpointResolver interface with the
+// endpoints of the partition the region belongs to, overridden by the
+// endpoints set in ServiceEndpoints.
+//
+// This can be used to point some services at local implementations while
+// others use their AWS endpoints:
+//
+//     cfg := aws.NewConfig().WithEndpointResolver(aws.DefaultEndpointResolver{
+//         ServiceEndpoints: map[string]aws.ServiceEndpoint{
+//             "s3":  {URL: "http://localhost:9000"},
+//             "sqs": {URL: "http://localhost:9324"},
+//         },
+//     })
+type DefaultEndpointResolver struct {
+	// ServiceEndpoints maps service names to the endpoint used by the service
+	// in every region.
+	ServiceEndpoints map[string]ServiceEndpoint
+}
+
+// EndpointFor returns the endpoint of the service in the region.
+func (d DefaultEndpointResolver) EndpointFor(service, region string) (string, string, error) {
+	if ep, ok := d.ServiceEndpoints[service]; ok {
+		return ep.URL, ep.SigningRegion, nil
+	}
+
+	url, signingRegion := endpoints.EndpointForRegion(service, region)
+	return url, signingRegion, nil
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEndpointTestService(name string, cfg *Config) *Service {
+	s := &Service{Config: DefaultConfig.Merge(cfg), ServiceName: name}
+	s.Initialize()
+	return s
+}
+
+func TestDefaultEndpointResolverPartitions(t *testing.T) {
+	r := DefaultEndpointResolver{}
+
+	url, signingRegion, err := r.EndpointFor("sqs", "us-west-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "sqs.us-west-2.amazonaws.com", url)
+	assert.Equal(t, "", signingRegion)
+
+	url, _, err = r.EndpointFor("sqs", "cn-north-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "sqs.cn-north-1.amazonaws.com.cn", url)
+
+	url, signingRegion, err = r.EndpointFor("iam", "us-east-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "iam.amazonaws.com", url)
+	assert.Equal(t, "us-east-1", signingRegion)
+
+	url, signingRegion, err = r.EndpointFor("iam", "us-gov-west-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "iam.us-gov.amazonaws.com", url)
+	assert.Equal(t, "", signingRegion)
+
+	assert.Equal(t, PartitionAWS, PartitionForRegion("eu-west-1"))
+	assert.Equal(t, PartitionAWSCN, PartitionForRegion("cn-north-1"))
+	assert.Equal(t, PartitionAWSUSGov, PartitionForRegion("us-gov-west-1"))
+}
+
+func TestDefaultEndpointResolverServiceEndpoints(t *testing.T) {
+	cfg := NewConfig().WithRegion("us-west-2").WithEndpointResolver(DefaultEndpointResolver{
+		ServiceEndpoints: map[string]ServiceEndpoint{
+			"s3":  {URL: "http://localhost:9000"},
+			"sqs": {URL: "localhost:9324", SigningRegion: "elasticmq"},
+		},
+	})
+
+	s3 := newEndpointTestService("s3", cfg)
+	assert.Equal(t, "http://localhost:9000", s3.Endpoint)
+	assert.Equal(t, "", s3.SigningRegion)
+
+	sqs := newEndpointTestService("sqs", cfg)
+	assert.Equal(t, "https://localhost:9324", sqs.Endpoint)
+	assert.Equal(t, "elasticmq", sqs.SigningRegion)
+
+	sns := newEndpointTestService("sns", cfg)
+	assert.Equal(t, "https://sns.us-west-2.amazonaws.com", sns.Endpoint)
+
+	sqs = newEndpointTestService("sqs", cfg.Merge(NewConfig().WithDisableSSL(true)))
+	assert.Equal(t, "http://localhost:9324", sqs.Endpoint)
+}
+
+func TestEndpointTakesPrecedenceOverResolver(t *testing.T) {
+	cfg := NewConfig().WithRegion("us-west-2").WithEndpoint("https://endpoint").
+		WithEndpointResolver(EndpointResolverFunc(func(service, region string) (string, string, error) {
+			t.Errorf("expect resolver not to be called")
+			return "", "", nil
+		}))
+
+	s := newEndpointTestService("sqs", cfg)
+	assert.Equal(t, "https://endpoint", s.Endpoint)
+}
+
+func TestEndpointResolverFunc(t *testing.T) {
+	cfg := NewConfig().WithRegion("mock-region").
+		WithEndpointResolver(EndpointResolverFunc(func(service, region string) (string, string, error) {
+			return service + "." + region + ".example.com", "signing-region", nil
+		}))
+
+	s := newEndpointTestService("sqs", cfg)
+	assert.Equal(t, "https://sqs.mock-region.example.com", s.Endpoint)
+	assert.Equal(t, "signing-region", s.SigningRegion)
+}
+
+func TestEndpointResolverError(t *testing.T) {
+	cfg := NewConfig().WithRegion("mock-region").
+		WithEndpointResolver(EndpointResolverFunc(func(service, region string) (string, string, error) {
+			return "", "", errors.New("unknown service")
+		}))
+
+	s := newEndpointTestService("sqs", cfg)
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Build()
+	assert.Error(t, err)
+	aerr := err.(awserr.Error)
+	assert.Equal(t, "EndpointResolverError", aerr.Code())
+	assert.Equal(t, "unknown service", aerr.OrigErr().Error())
+}
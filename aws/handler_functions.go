@@ -26,7 +26,7 @@ type lener interface {
 // BuildContentLength builds the content length of a request based on the body,
 // or will use the HTTPRequest.Header's "Content-Length" if defined. If unable
 // to determine request body length and no "Content-Length" was specified it will panic.
-func BuildContentLength(r *Request) {
+var BuildContentLength = NamedHandler{Name: "core.BuildContentLength", Fn: func(r *Request) {
 	if slength := r.HTTPRequest.Header.Get("Content-Length"); slength != "" {
 		length, _ := strconv.ParseInt(slength, 10, 64)
 		r.HTTPRequest.ContentLength = length
@@ -50,19 +50,25 @@ func BuildContentLength(r *Request) {
 
 	r.HTTPRequest.ContentLength = length
 	r.HTTPRequest.Header.Set("Content-Length", fmt.Sprintf("%d", length))
-}
+}}
 
 // UserAgentHandler is a request handler for injecting User agent into requests.
-func UserAgentHandler(r *Request) {
+var UserAgentHandler = NamedHandler{Name: "core.UserAgentHandler", Fn: func(r *Request) {
 	r.HTTPRequest.Header.Set("User-Agent", SDKName+"/"+SDKVersion)
-}
+}}
 
 var reStatusCode = regexp.MustCompile(`^(\d+)`)
 
 // SendHandler is a request handler to send service request using HTTP client.
-func SendHandler(r *Request) {
+var SendHandler = NamedHandler{Name: "core.SendHandler", Fn: func(r *Request) {
+	if r.Error != nil {
+		// A handler earlier in the Send list already failed the request,
+		// e.g. a RateLimiter whose wait was canceled. Don't send it.
+		return
+	}
+
 	var err error
-	r.HTTPResponse, err = r.Service.Config.HTTPClient.Do(r.HTTPRequest)
+	r.HTTPResponse, err = sendWithContext(r)
 	if err != nil {
 		// Capture the case where url.Error is returned for error processing
 		// response. e.g. 301 without location header comes back as string
@@ -79,7 +85,7 @@ func SendHandler(r *Request) {
 				return
 			}
 		}
-		if r.HTTPRequest == nil {
+		if r.HTTPResponse == nil {
 			// Add a dummy request response object to ensure the HTTPResponse
 			// value is consistent.
 			r.HTTPResponse = &http.Response{
@@ -88,32 +94,49 @@ func SendHandler(r *Request) {
 				Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
 			}
 		}
+		if cerr := r.canceled(); cerr != nil {
+			// The request was aborted because its context is done.
+			r.Error = cerr
+			r.Retryable.Set(false)
+			return
+		}
 		// Catch all other request errors.
 		r.Error = awserr.New("RequestError", "send request failed", err)
 		r.Retryable.Set(true) // network errors are retryable
 	}
-}
+}}
 
 // ValidateResponseHandler is a request handler to validate service response.
-func ValidateResponseHandler(r *Request) {
+var ValidateResponseHandler = NamedHandler{Name: "core.ValidateResponseHandler", Fn: func(r *Request) {
 	if r.HTTPResponse.StatusCode == 0 || r.HTTPResponse.StatusCode >= 300 {
 		// this may be replaced by an UnmarshalError handler
 		r.Error = awserr.New("UnknownError", "unknown error", nil)
 	}
-}
+}}
 
 // AfterRetryHandler performs final checks to determine if the request should
 // be retried and how long to delay.
-func AfterRetryHandler(r *Request) {
+var AfterRetryHandler = NamedHandler{Name: "core.AfterRetryHandler", Fn: func(r *Request) {
 	// If one of the other handlers already set the retry state
 	// we don't want to override it based on the service's state
 	if !r.Retryable.IsSet() {
-		r.Retryable.Set(r.Service.ShouldRetry(r))
+		r.Retryable.Set(r.Service.retryer().ShouldRetry(r))
 	}
 
 	if r.WillRetry() {
-		r.RetryDelay = r.Service.RetryRules(r)
-		sleepDelay(r.RetryDelay)
+		if !r.acquireRetryQuota() {
+			return
+		}
+
+		r.RetryDelay = r.Service.retryer().RetryRules(r)
+		if r.Config.LogLevel.Value().Matches(LogRetries) {
+			r.Config.Logger.Log(fmt.Sprintf("DEBUG: Retrying Request %s/%s, attempt %d, delay %s, error %v",
+				r.ServiceName, r.Operation.Name, r.RetryCount+1, r.RetryDelay, r.Error))
+		}
+		if err := sleepWithContext(r, r.RetryDelay); err != nil {
+			r.Error = err
+			return
+		}
 
 		// when the expired token exception occurs the credentials
 		// need to be expired locally so that the next request to
@@ -129,7 +152,7 @@ func AfterRetryHandler(r *Request) {
 		r.RetryCount++
 		r.Error = nil
 	}
-}
+}}
 
 var (
 	// ErrMissingRegion is an error that is returned if region configuration is
@@ -143,11 +166,14 @@ var (
 
 // ValidateEndpointHandler is a request handler to validate a request had the
 // appropriate Region and Endpoint set. Will set r.Error if the endpoint or
-// region is not valid.
-func ValidateEndpointHandler(r *Request) {
-	if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
+// region is not valid, or the Config's EndpointResolver failed to resolve the
+// endpoint.
+var ValidateEndpointHandler = NamedHandler{Name: "core.ValidateEndpointHandler", Fn: func(r *Request) {
+	if r.Service.endpointErr != nil {
+		r.Error = r.Service.endpointErr
+	} else if r.Service.SigningRegion == "" && StringValue(r.Service.Config.Region) == "" {
 		r.Error = ErrMissingRegion
 	} else if r.Service.Endpoint == "" {
 		r.Error = ErrMissingEndpoint
 	}
-}
+}}
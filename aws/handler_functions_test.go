@@ -12,9 +12,9 @@ import (
 
 func TestValidateEndpointHandler(t *testing.T) {
 	os.Clearenv()
-	svc := NewService(&Config{Region: "us-west-2"})
+	svc := NewService(&Config{Region: String("us-west-2")})
 	svc.Handlers.Clear()
-	svc.Handlers.Validate.PushBack(ValidateEndpointHandler)
+	svc.Handlers.Validate.PushBackNamed(ValidateEndpointHandler)
 
 	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
 	err := req.Build()
@@ -26,7 +26,7 @@ func TestValidateEndpointHandlerErrorRegion(t *testing.T) {
 	os.Clearenv()
 	svc := NewService(nil)
 	svc.Handlers.Clear()
-	svc.Handlers.Validate.PushBack(ValidateEndpointHandler)
+	svc.Handlers.Validate.PushBackNamed(ValidateEndpointHandler)
 
 	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
 	err := req.Build()
@@ -52,7 +52,7 @@ func (m *mockCredsProvider) IsExpired() bool {
 func TestAfterRetryRefreshCreds(t *testing.T) {
 	os.Clearenv()
 	credProvider := &mockCredsProvider{}
-	svc := NewService(&Config{Credentials: credentials.NewCredentials(credProvider), MaxRetries: 1})
+	svc := NewService(&Config{Credentials: credentials.NewCredentials(credProvider), MaxRetries: Int(1)})
 
 	svc.Handlers.Clear()
 	svc.Handlers.ValidateResponse.PushBack(func(r *Request) {
@@ -63,7 +63,7 @@ func TestAfterRetryRefreshCreds(t *testing.T) {
 		r.Error = awserr.New("ExpiredTokenException", "", nil)
 	})
 	svc.Handlers.AfterRetry.PushBack(func(r *Request) {
-		AfterRetryHandler(r)
+		AfterRetryHandler.Fn(r)
 	})
 
 	assert.True(t, svc.Config.Credentials.IsExpired(), "Expect to start out expired")
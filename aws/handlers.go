@@ -47,19 +47,50 @@ func (h *Handlers) Clear() {
 
 // A HandlerList manages zero or more handlers in a list.
 type HandlerList struct {
-	list []func(*Request)
+	list []NamedHandler
+
+	// Called after each request handler in the list is called. If set
+	// and the func returns true the HandlerList will continue to iterate
+	// over the request handlers. If false is returned the HandlerList
+	// will stop iterating.
+	//
+	// Should be used if extra logic to be performed between each handler
+	// in the list. This can be used to terminate a list's iteration
+	// based on a condition such as error like, HandlerListStopOnError.
+	// Or for logging like HandlerListLogItem.
+	AfterEachFn func(item HandlerListRunItem) bool
+}
+
+// A HandlerListRunItem represents an entry in the HandlerList which
+// is being run.
+type HandlerListRunItem struct {
+	Index   int
+	Handler NamedHandler
+	Request *Request
+}
+
+// A NamedHandler is a struct that contains a name and function callback.
+type NamedHandler struct {
+	Name string
+	Fn   func(*Request)
 }
 
+// anonymousHandlerName is the name given to handlers added to a list
+// without a name.
+const anonymousHandlerName = "__anonymous"
+
 // copy creates a copy of the handler list.
 func (l *HandlerList) copy() HandlerList {
-	var n HandlerList
-	n.list = append([]func(*Request){}, l.list...)
+	n := HandlerList{
+		AfterEachFn: l.AfterEachFn,
+	}
+	n.list = append([]NamedHandler{}, l.list...)
 	return n
 }
 
 // Clear clears the handler list.
 func (l *HandlerList) Clear() {
-	l.list = []func(*Request){}
+	l.list = []NamedHandler{}
 }
 
 // Len returns the number of handlers in the list.
@@ -69,17 +100,107 @@ func (l *HandlerList) Len() int {
 
 // PushBack pushes handlers f to the back of the handler list.
 func (l *HandlerList) PushBack(f ...func(*Request)) {
-	l.list = append(l.list, f...)
+	for _, fn := range f {
+		l.PushBackNamed(NamedHandler{Name: anonymousHandlerName, Fn: fn})
+	}
 }
 
 // PushFront pushes handlers f to the front of the handler list.
 func (l *HandlerList) PushFront(f ...func(*Request)) {
-	l.list = append(f, l.list...)
+	for i := len(f) - 1; i >= 0; i-- {
+		l.PushFrontNamed(NamedHandler{Name: anonymousHandlerName, Fn: f[i]})
+	}
+}
+
+// PushBackNamed pushes named handler n to the back of the handler list.
+func (l *HandlerList) PushBackNamed(n NamedHandler) {
+	l.list = append(l.list, n)
+}
+
+// PushFrontNamed pushes named handler n to the front of the handler list.
+func (l *HandlerList) PushFrontNamed(n NamedHandler) {
+	l.list = append([]NamedHandler{n}, l.list...)
+}
+
+// InsertBeforeNamed inserts named handler n before the first handler in the
+// list with the name provided. False is returned, and n is not inserted, if
+// no handler has the name.
+func (l *HandlerList) InsertBeforeNamed(name string, n NamedHandler) bool {
+	return l.insertAt(name, 0, n)
+}
+
+// InsertAfterNamed inserts named handler n after the first handler in the
+// list with the name provided. False is returned, and n is not inserted, if
+// no handler has the name.
+func (l *HandlerList) InsertAfterNamed(name string, n NamedHandler) bool {
+	return l.insertAt(name, 1, n)
+}
+
+func (l *HandlerList) insertAt(name string, offset int, n NamedHandler) bool {
+	for i := 0; i < len(l.list); i++ {
+		if l.list[i].Name == name {
+			i += offset
+			l.list = append(l.list, NamedHandler{})
+			copy(l.list[i+1:], l.list[i:])
+			l.list[i] = n
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes all handlers in the list with the name provided.
+func (l *HandlerList) Remove(name string) {
+	for i := 0; i < len(l.list); i++ {
+		if l.list[i].Name == name {
+			l.list = append(l.list[:i], l.list[i+1:]...)
+			i--
+		}
+	}
+}
+
+// Swap replaces all handlers in the list with the name provided with the
+// replacement handler. Returns true if a handler was replaced.
+func (l *HandlerList) Swap(name string, replace NamedHandler) bool {
+	swapped := false
+	for i := 0; i < len(l.list); i++ {
+		if l.list[i].Name == name {
+			l.list[i] = replace
+			swapped = true
+		}
+	}
+	return swapped
 }
 
 // Run executes all handlers in the list with a given request object.
 func (l *HandlerList) Run(r *Request) {
-	for _, f := range l.list {
-		f(r)
+	for i, h := range l.list {
+		h.Fn(r)
+		item := HandlerListRunItem{
+			Index: i, Handler: h, Request: r,
+		}
+		if l.AfterEachFn != nil && !l.AfterEachFn(item) {
+			return
+		}
+	}
+}
+
+// HandlerListLogItem logs the request handler and the state of the
+// request's Error value. Always returns true to continue iterating
+// request handlers in a HandlerList.
+func HandlerListLogItem(item HandlerListRunItem) bool {
+	if item.Request.Config.Logger == nil {
+		return true
 	}
+	item.Request.Config.Logger.Log("DEBUG: RequestHandler",
+		item.Index, item.Handler.Name, item.Request.Error)
+
+	return true
+}
+
+// HandlerListStopOnError returns false to stop the HandlerList iterating
+// over request handlers if Request.Error is not nil. True otherwise
+// to continue iterating.
+func HandlerListStopOnError(item HandlerListRunItem) bool {
+	return item.Request.Error == nil
 }
@@ -29,3 +29,96 @@ func TestMultipleHandlers(t *testing.T) {
 		t.Error("Expected handler to execute")
 	}
 }
+
+func TestNamedHandlers(t *testing.T) {
+	l := HandlerList{}
+	named := NamedHandler{Name: "Name", Fn: func(r *Request) {}}
+	named2 := NamedHandler{Name: "NotName", Fn: func(r *Request) {}}
+	l.PushBackNamed(named)
+	l.PushBackNamed(named)
+	l.PushBackNamed(named2)
+	l.PushBack(func(r *Request) {})
+	assert.Equal(t, 4, l.Len())
+	l.Remove("Name")
+	assert.Equal(t, 2, l.Len())
+	assert.Equal(t, "NotName", l.list[0].Name)
+	assert.Equal(t, anonymousHandlerName, l.list[1].Name)
+}
+
+func TestSwapHandlers(t *testing.T) {
+	firstHandlerCalled := 0
+	swappedOutHandlerCalled := 0
+	swappedInHandlerCalled := 0
+
+	l := HandlerList{}
+	l.PushBackNamed(NamedHandler{Name: "Foo", Fn: func(r *Request) { firstHandlerCalled++ }})
+	l.PushBackNamed(NamedHandler{Name: "SwapOutMe", Fn: func(r *Request) { swappedOutHandlerCalled++ }})
+
+	swapped := l.Swap("SwapOutMe", NamedHandler{Name: "SwapIn", Fn: func(r *Request) { swappedInHandlerCalled++ }})
+	assert.True(t, swapped)
+	assert.False(t, l.Swap("Missing", NamedHandler{Name: "SwapIn", Fn: func(r *Request) {}}))
+
+	l.Run(&Request{})
+	assert.Equal(t, 1, firstHandlerCalled)
+	assert.Equal(t, 0, swappedOutHandlerCalled)
+	assert.Equal(t, 1, swappedInHandlerCalled)
+}
+
+func TestInsertNamedHandlers(t *testing.T) {
+	s := ""
+	handler := func(v string) NamedHandler {
+		return NamedHandler{Name: v, Fn: func(r *Request) { s += v }}
+	}
+
+	l := HandlerList{}
+	l.PushBackNamed(handler("a"))
+	l.PushBackNamed(handler("c"))
+	assert.True(t, l.InsertBeforeNamed("c", handler("b")))
+	assert.True(t, l.InsertAfterNamed("c", handler("d")))
+	assert.True(t, l.InsertBeforeNamed("a", handler("0")))
+	assert.False(t, l.InsertAfterNamed("missing", handler("x")))
+
+	l.Run(&Request{})
+	assert.Equal(t, "0abcd", s)
+}
+
+func TestPushFrontMultipleHandlersOrder(t *testing.T) {
+	s := ""
+	l := HandlerList{}
+	l.PushBack(func(r *Request) { s += "c" })
+	l.PushFront(func(r *Request) { s += "a" }, func(r *Request) { s += "b" })
+	l.Run(&Request{})
+	assert.Equal(t, "abc", s)
+}
+
+func TestStopHandlers(t *testing.T) {
+	l := HandlerList{}
+	stopAt := 1
+	l.AfterEachFn = func(item HandlerListRunItem) bool {
+		return item.Index != stopAt
+	}
+
+	called := 0
+	l.PushBackNamed(NamedHandler{Name: "name1", Fn: func(r *Request) { called++ }})
+	l.PushBackNamed(NamedHandler{Name: "name2", Fn: func(r *Request) { called++ }})
+	l.PushBackNamed(NamedHandler{Name: "name3", Fn: func(r *Request) {
+		assert.Fail(t, "third handler should not be called")
+	}})
+	l.Run(&Request{})
+	assert.Equal(t, 2, called)
+
+	c := l.copy()
+	called = 0
+	c.Run(&Request{})
+	assert.Equal(t, 2, called, "copy should keep the AfterEachFn")
+}
+
+func TestHandlerListStopOnError(t *testing.T) {
+	l := HandlerList{AfterEachFn: HandlerListStopOnError}
+	l.PushBack(func(r *Request) { r.Error = ErrMissingRegion })
+	l.PushBack(func(r *Request) { assert.Fail(t, "handler after error should not be called") })
+
+	r := &Request{}
+	l.Run(r)
+	assert.Equal(t, ErrMissingRegion, r.Error)
+}
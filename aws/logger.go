@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"log"
+	"os"
+)
+
+// A LogLevelType defines the level logging should be performed at. The level
+// is a set of bit flags, each enabling a category of debug log messages.
+// Flags can be combined with a bitwise OR, e.g. LogRetries|LogRequestErrors.
+type LogLevelType uint
+
+// LogLevel converts a LogLevelType into a LogLevelType pointer, for setting
+// the Config's LogLevel.
+func LogLevel(l LogLevelType) *LogLevelType {
+	return &l
+}
+
+// Value returns the value of the LogLevelType pointer, or LogOff if it is nil.
+func (l *LogLevelType) Value() LogLevelType {
+	if l != nil {
+		return *l
+	}
+	return LogOff
+}
+
+// Matches returns true if all of the log flags in v are enabled in l.
+func (l LogLevelType) Matches(v LogLevelType) bool {
+	return v != LogOff && l&v == v
+}
+
+// LogOff states that no logging should be performed by the SDK. This is the
+// default state of the SDK, and should be used to disable all logging.
+const LogOff LogLevelType = 0
+
+const (
+	// LogHTTPHeaders states the SDK should log the HTTP request and response
+	// dumps, excluding their bodies.
+	LogHTTPHeaders LogLevelType = 1 << iota
+
+	// LogHTTPBody states the SDK should log the HTTP request and response
+	// dumps including their bodies. Bodies may contain sensitive data, and
+	// will be read into memory when logged.
+	LogHTTPBody
+
+	// LogSigning states the SDK should log the canonical string and string to
+	// sign computed when signing requests.
+	LogSigning
+
+	// LogRetries states the SDK should log when a request is retried, along
+	// with the error that caused the retry.
+	LogRetries
+
+	// LogRequestErrors states the SDK should log requests which fail with an
+	// error that will be returned to the caller.
+	LogRequestErrors
+)
+
+// LogDebug states the SDK should log all debug information.
+const LogDebug = LogHTTPHeaders | LogHTTPBody | LogSigning | LogRetries | LogRequestErrors
+
+// A Logger is a minimalistic interface for the SDK to log messages to. Should
+// be used to provide custom logging writers for the SDK to use, such as
+// routing the messages to a structured logging system.
+type Logger interface {
+	Log(...interface{})
+}
+
+// A LoggerFunc is a convenience type to convert a function taking a variadic
+// list of arguments and wrap it so the Logger interface can be used.
+//
+// Example:
+//     svc := s3.New(&aws.Config{Logger: aws.LoggerFunc(func(args ...interface{}) {
+//         fmt.Fprintln(os.Stdout, args...)
+//     })})
+type LoggerFunc func(...interface{})
+
+// Log calls the wrapped function with the arguments provided.
+func (f LoggerFunc) Log(args ...interface{}) {
+	f(args...)
+}
+
+// NewDefaultLogger returns a Logger which will write log messages to stdout,
+// using the standard library's log package.
+func NewDefaultLogger() Logger {
+	return &defaultLogger{
+		logger: log.New(os.Stdout, "", log.LstdFlags),
+	}
+}
+
+// A defaultLogger provides a minimalistic logger satisfying the Logger
+// interface.
+type defaultLogger struct {
+	logger *log.Logger
+}
+
+// Log logs the parameters to the stdlib logger. See log.Println.
+func (l defaultLogger) Log(args ...interface{}) {
+	l.logger.Println(args...)
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLevelMatches(t *testing.T) {
+	assert.False(t, LogOff.Matches(LogRetries))
+	assert.False(t, LogOff.Matches(LogOff))
+	assert.True(t, LogRetries.Matches(LogRetries))
+	assert.False(t, LogRetries.Matches(LogHTTPHeaders))
+	assert.True(t, (LogRetries | LogRequestErrors).Matches(LogRequestErrors))
+	assert.False(t, LogRetries.Matches(LogRetries|LogRequestErrors))
+	assert.True(t, LogDebug.Matches(LogSigning))
+	assert.True(t, LogDebug.Matches(LogHTTPBody))
+}
+
+func newLogTestService(level LogLevelType, msgs *[]string) *Service {
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 400, Body: body(`{"__type":"ValidationError","message":"Invalid input."}`)},
+	}
+
+	s := NewService(&Config{
+		MaxRetries: Int(10),
+		LogLevel:   LogLevel(level),
+		Logger: LoggerFunc(func(args ...interface{}) {
+			*msgs = append(*msgs, fmt.Sprint(args...))
+		}),
+	})
+	s.ServiceName = "mock"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	return s
+}
+
+func TestLogRetriesOnly(t *testing.T) {
+	msgs := []string{}
+	s := newLogTestService(LogRetries, &msgs)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, 1, len(msgs))
+	assert.True(t, strings.HasPrefix(msgs[0], "DEBUG: Retrying Request mock/Operation, attempt 1"), msgs[0])
+	assert.Contains(t, msgs[0], "UnknownError")
+}
+
+func TestLogRequestErrors(t *testing.T) {
+	msgs := []string{}
+	s := newLogTestService(LogRetries|LogRequestErrors, &msgs)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, 2, len(msgs))
+	assert.True(t, strings.HasPrefix(msgs[1], "DEBUG: Send Request mock/Operation failed, attempt 2"), msgs[1])
+	assert.Contains(t, msgs[1], "ValidationError")
+}
+
+func TestLogOff(t *testing.T) {
+	msgs := []string{}
+	s := newLogTestService(LogOff, &msgs)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Empty(t, msgs)
+}
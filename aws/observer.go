@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// A RequestObserver is notified of the lifecycle events of the requests made
+// by a service. It can be used to collect metrics, or trace requests, tagged
+// by service and operation name.
+//
+// Observers are called synchronously from the goroutine sending the request,
+// and must be safe for concurrent use if the service is used concurrently.
+//
+// Set an observer on the Config to observe the requests of the services
+// created with it.
+type RequestObserver interface {
+	// AttemptStart is called before each attempt to send the request,
+	// including retries.
+	AttemptStart(RequestAttemptStartEvent)
+
+	// AttemptEnd is called after each attempt to send the request, before
+	// the request is retried.
+	AttemptEnd(RequestAttemptEndEvent)
+
+	// RequestComplete is called once the request has succeeded, or failed
+	// and will not be retried.
+	RequestComplete(RequestCompleteEvent)
+}
+
+// A RequestAttemptStartEvent is the event of a request attempt starting.
+type RequestAttemptStartEvent struct {
+	ServiceName   string
+	OperationName string
+
+	// The attempt number, starting at 1 for the first attempt.
+	Attempt int
+	Time    time.Time
+
+	Request *Request
+}
+
+// A RequestAttemptEndEvent is the event of a request attempt ending.
+type RequestAttemptEndEvent struct {
+	ServiceName   string
+	OperationName string
+
+	// The attempt number, starting at 1 for the first attempt.
+	Attempt int
+
+	// The HTTP status code of the attempt's response, zero if no response
+	// was received.
+	StatusCode int
+
+	// The time taken by the attempt to sign, send, and unmarshal the request.
+	Duration time.Duration
+
+	// Throttled is true if the attempt failed because it was throttled.
+	Throttled bool
+	Error     error
+
+	Request *Request
+}
+
+// A RequestCompleteEvent is the event of a request completing.
+type RequestCompleteEvent struct {
+	ServiceName   string
+	OperationName string
+
+	// The total number of attempts, retries, and throttled attempts made
+	// sending the request.
+	Attempts      int
+	RetryCount    int
+	ThrottleCount int
+
+	// The time taken to send the request, including all retries.
+	Duration time.Duration
+
+	// The error code of the error the request failed with. Empty if the
+	// request succeeded, or its error is not an awserr.Error.
+	ErrorCode string
+	Error     error
+
+	Request *Request
+}
+
+// RequestObserverFuncs is a RequestObserver which calls the functions that
+// are set for each event. Functions which are nil are not called.
+//
+// Example:
+//     svc := s3.New(&aws.Config{Observer: aws.RequestObserverFuncs{
+//         RequestCompleteFn: func(e aws.RequestCompleteEvent) {
+//             fmt.Println(e.ServiceName, e.OperationName, e.RetryCount, e.ErrorCode)
+//         },
+//     }})
+type RequestObserverFuncs struct {
+	AttemptStartFn    func(RequestAttemptStartEvent)
+	AttemptEndFn      func(RequestAttemptEndEvent)
+	RequestCompleteFn func(RequestCompleteEvent)
+}
+
+// AttemptStart calls AttemptStartFn if set.
+func (o RequestObserverFuncs) AttemptStart(e RequestAttemptStartEvent) {
+	if o.AttemptStartFn != nil {
+		o.AttemptStartFn(e)
+	}
+}
+
+// AttemptEnd calls AttemptEndFn if set.
+func (o RequestObserverFuncs) AttemptEnd(e RequestAttemptEndEvent) {
+	if o.AttemptEndFn != nil {
+		o.AttemptEndFn(e)
+	}
+}
+
+// RequestComplete calls RequestCompleteFn if set.
+func (o RequestObserverFuncs) RequestComplete(e RequestCompleteEvent) {
+	if o.RequestCompleteFn != nil {
+		o.RequestCompleteFn(e)
+	}
+}
+
+// observeAttemptStart notifies the request's observer an attempt is starting.
+func (r *Request) observeAttemptStart() {
+	r.attemptStart = time.Now()
+	if r.Config.Observer == nil {
+		return
+	}
+
+	r.Config.Observer.AttemptStart(RequestAttemptStartEvent{
+		ServiceName:   r.ServiceName,
+		OperationName: r.Operation.Name,
+		Attempt:       int(r.RetryCount) + 1,
+		Time:          r.attemptStart,
+		Request:       r,
+	})
+}
+
+// observeAttemptEnd notifies the request's observer the attempt has ended.
+func (r *Request) observeAttemptEnd() {
+	if r.Config.Observer == nil {
+		return
+	}
+
+	throttled := r.Error != nil && r.isThrottled()
+	if throttled {
+		r.throttleCount++
+	}
+
+	statusCode := 0
+	if r.HTTPResponse != nil {
+		statusCode = r.HTTPResponse.StatusCode
+	}
+
+	r.Config.Observer.AttemptEnd(RequestAttemptEndEvent{
+		ServiceName:   r.ServiceName,
+		OperationName: r.Operation.Name,
+		Attempt:       int(r.RetryCount) + 1,
+		StatusCode:    statusCode,
+		Duration:      time.Now().Sub(r.attemptStart),
+		Throttled:     throttled,
+		Error:         r.Error,
+		Request:       r,
+	})
+}
+
+// observeComplete notifies the request's observer the request has completed.
+func (r *Request) observeComplete(start time.Time, err error) {
+	if r.Config.Observer == nil {
+		return
+	}
+
+	code := ""
+	if aerr, ok := err.(awserr.Error); ok {
+		code = aerr.Code()
+	}
+
+	r.Config.Observer.RequestComplete(RequestCompleteEvent{
+		ServiceName:   r.ServiceName,
+		OperationName: r.Operation.Name,
+		Attempts:      int(r.RetryCount) + 1,
+		RetryCount:    int(r.RetryCount),
+		ThrottleCount: r.throttleCount,
+		Duration:      time.Now().Sub(start),
+		ErrorCode:     code,
+		Error:         err,
+		Request:       r,
+	})
+}
+
+// isThrottled returns if the request failed because it was throttled.
+func (r *Request) isThrottled() bool {
+	if d, ok := r.Service.retryer().(DefaultRetryer); ok {
+		return d.isThrottle(r)
+	}
+	return DefaultRetryer{}.isThrottle(r)
+}
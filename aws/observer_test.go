@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingObserver struct {
+	starts    []RequestAttemptStartEvent
+	ends      []RequestAttemptEndEvent
+	completes []RequestCompleteEvent
+}
+
+func (o *recordingObserver) AttemptStart(e RequestAttemptStartEvent) {
+	o.starts = append(o.starts, e)
+}
+
+func (o *recordingObserver) AttemptEnd(e RequestAttemptEndEvent) {
+	o.ends = append(o.ends, e)
+}
+
+func (o *recordingObserver) RequestComplete(e RequestCompleteEvent) {
+	o.completes = append(o.completes, e)
+}
+
+func newObserverTestService(o RequestObserver, reqs []http.Response) *Service {
+	reqNum := 0
+	s := NewService(&Config{MaxRetries: Int(10), Observer: o})
+	s.ServiceName = "mock"
+	s.Retryer = DefaultRetryer{MinRetryDelay: 1, MinThrottleDelay: 1}
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+	return s
+}
+
+func TestObserverRetriedRequest(t *testing.T) {
+	o := &recordingObserver{}
+	s := newObserverTestService(o, []http.Response{
+		{StatusCode: 400, Body: body(`{"__type":"Throttling","message":"Rate exceeded."}`)},
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	})
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	err := r.Send()
+	assert.Nil(t, err)
+
+	assert.Equal(t, 3, len(o.starts))
+	assert.Equal(t, 3, len(o.ends))
+	for i, e := range o.ends {
+		assert.Equal(t, "mock", e.ServiceName)
+		assert.Equal(t, "Operation", e.OperationName)
+		assert.Equal(t, i+1, o.starts[i].Attempt)
+		assert.Equal(t, i+1, e.Attempt)
+		assert.True(t, e.Duration >= 0)
+	}
+	assert.Equal(t, 400, o.ends[0].StatusCode)
+	assert.True(t, o.ends[0].Throttled)
+	assert.Equal(t, 500, o.ends[1].StatusCode)
+	assert.False(t, o.ends[1].Throttled)
+	assert.Error(t, o.ends[1].Error)
+	assert.Equal(t, 200, o.ends[2].StatusCode)
+	assert.Nil(t, o.ends[2].Error)
+
+	assert.Equal(t, 1, len(o.completes))
+	c := o.completes[0]
+	assert.Equal(t, "mock", c.ServiceName)
+	assert.Equal(t, "Operation", c.OperationName)
+	assert.Equal(t, 3, c.Attempts)
+	assert.Equal(t, 2, c.RetryCount)
+	assert.Equal(t, 1, c.ThrottleCount)
+	assert.Equal(t, "", c.ErrorCode)
+	assert.Nil(t, c.Error)
+}
+
+func TestObserverFailedRequest(t *testing.T) {
+	var complete *RequestCompleteEvent
+	s := newObserverTestService(RequestObserverFuncs{
+		RequestCompleteFn: func(e RequestCompleteEvent) { complete = &e },
+	}, []http.Response{
+		{StatusCode: 400, Body: body(`{"__type":"ValidationError","message":"Invalid input."}`)},
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+
+	assert.NotNil(t, complete)
+	assert.Equal(t, 1, complete.Attempts)
+	assert.Equal(t, 0, complete.RetryCount)
+	assert.Equal(t, 0, complete.ThrottleCount)
+	assert.Equal(t, "ValidationError", complete.ErrorCode)
+	assert.Equal(t, err, complete.Error)
+}
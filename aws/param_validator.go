@@ -3,16 +3,24 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
+// ValidateParametersHandler is a request handler to validate the input parameters.
+// Validating parameters only has meaning if done prior to the request being sent.
+var ValidateParametersHandler = NamedHandler{Name: "core.ValidateParametersHandler", Fn: ValidateParameters}
+
 // ValidateParameters is a request handler to validate the input parameters.
 // Validating parameters only has meaning if done prior to the request being sent.
 func ValidateParameters(r *Request) {
 	if r.ParamsFilled() {
-		v := validator{errors: []string{}}
+		v := validator{errors: []string{}, enums: BooleanValue(r.Config.EnableEnumValidation)}
 		v.validateAny(reflect.ValueOf(r.Params), "")
 
 		if count := len(v.errors); count > 0 {
@@ -26,6 +34,9 @@ func ValidateParameters(r *Request) {
 // A validator validates values. Collects validations errors which occurs.
 type validator struct {
 	errors []string
+
+	// enums enables the validation of enum constraints.
+	enums bool
 }
 
 // validateAny will validate any struct, slice or map type. All validations
@@ -83,7 +94,110 @@ func (v *validator) validateStruct(value reflect.Value, path string) {
 			msg := "missing required parameter: " + path + prefix + f.Name
 			v.errors = append(v.errors, msg)
 		} else {
+			v.validateConstraints(f.Tag, fvalue, path+prefix+f.Name)
 			v.validateAny(fvalue, path+prefix+f.Name)
 		}
 	}
 }
+
+// validateConstraints validates the value against the min, max, and pattern
+// constraints in the field's tag, and its enum constraint if enum validation
+// is enabled. Values which are not set are not validated.
+func (v *validator) validateConstraints(tag reflect.StructTag, value reflect.Value, path string) {
+	value = reflect.Indirect(value)
+	if !value.IsValid() {
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		s := value.String()
+		v.validateLength(tag, utf8.RuneCountInString(s), path)
+		if p := tag.Get("pattern"); p != "" {
+			if re := compilePattern(p); re != nil && !re.MatchString(s) {
+				v.errors = append(v.errors, fmt.Sprintf("%s must match pattern %s", path, p))
+			}
+		}
+		if e := tag.Get("enum"); e != "" && v.enums {
+			if !enumContains(e, s) {
+				v.errors = append(v.errors, fmt.Sprintf("%s must be one of: %s",
+					path, strings.Replace(e, ",", ", ", -1)))
+			}
+		}
+	case reflect.Slice, reflect.Map:
+		if value.IsNil() {
+			return
+		}
+		v.validateLength(tag, value.Len(), path)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.validateRange(tag, float64(value.Int()), path)
+	case reflect.Float32, reflect.Float64:
+		v.validateRange(tag, value.Float(), path)
+	}
+}
+
+// validateLength validates the length of a string, blob, list, or map value
+// against the min and max constraints in the field's tag.
+func (v *validator) validateLength(tag reflect.StructTag, n int, path string) {
+	if min, ok := tagFloat(tag, "min"); ok && float64(n) < min {
+		v.errors = append(v.errors, fmt.Sprintf("%s length must be >= %s", path, tag.Get("min")))
+	}
+	if max, ok := tagFloat(tag, "max"); ok && float64(n) > max {
+		v.errors = append(v.errors, fmt.Sprintf("%s length must be <= %s", path, tag.Get("max")))
+	}
+}
+
+// validateRange validates a numeric value against the min and max
+// constraints in the field's tag.
+func (v *validator) validateRange(tag reflect.StructTag, n float64, path string) {
+	if min, ok := tagFloat(tag, "min"); ok && n < min {
+		v.errors = append(v.errors, fmt.Sprintf("%s must be >= %s", path, tag.Get("min")))
+	}
+	if max, ok := tagFloat(tag, "max"); ok && n > max {
+		v.errors = append(v.errors, fmt.Sprintf("%s must be <= %s", path, tag.Get("max")))
+	}
+}
+
+// tagFloat returns the numeric value of the tag key. False is returned if the
+// tag does not have the key, or its value is not a number.
+func tagFloat(tag reflect.StructTag, key string) (float64, bool) {
+	s := tag.Get(key)
+	if s == "" {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
+// enumContains returns if the comma separated list of enum values contains s.
+func enumContains(enum, s string) bool {
+	for _, e := range strings.Split(enum, ",") {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+var patterns = struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}{m: map[string]*regexp.Regexp{}}
+
+// compilePattern returns the compiled regexp for the pattern. The compiled
+// regexps are cached, since the same patterns are validated for every request.
+// Nil is returned if the pattern is not a valid regexp.
+func compilePattern(p string) *regexp.Regexp {
+	patterns.Lock()
+	defer patterns.Unlock()
+
+	re, ok := patterns.m[p]
+	if !ok {
+		re, _ = regexp.Compile(p)
+		patterns.m[p] = re
+	}
+	return re
+}
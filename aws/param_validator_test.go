@@ -82,3 +82,82 @@ func TestNestedMissingRequiredParameters(t *testing.T) {
 	assert.Equal(t, "3 validation errors:\n- missing required parameter: RequiredList[0].Name\n- missing required parameter: RequiredMap[\"key2\"].Name\n- missing required parameter: OptionalStruct.Name", req.Error.(awserr.Error).Message())
 
 }
+
+type ConstraintShape struct {
+	Key     *string            `type:"string" min:"1" max:"5"`
+	MaxKeys *int64             `type:"integer" min:"1" max:"1000"`
+	Ratio   *float64           `type:"double" min:"0.5"`
+	Name    *string            `type:"string" pattern:"^[a-z]+$"`
+	Mode    *string            `type:"string" enum:"fast,slow"`
+	Blob    []byte             `type:"blob" max:"2"`
+	List    []*string          `type:"list" min:"1"`
+	Map     map[string]*string `type:"map" max:"1"`
+	Nested  *ConstraintShape   `type:"structure"`
+}
+
+func TestConstraintsNoErrors(t *testing.T) {
+	input := &ConstraintShape{
+		Key:     aws.String("abcde"),
+		MaxKeys: aws.Long(1000),
+		Ratio:   aws.Double(0.5),
+		Name:    aws.String("name"),
+		Mode:    aws.String("slow"),
+		Blob:    []byte("ab"),
+		List:    []*string{aws.String("a")},
+		Map:     map[string]*string{"a": aws.String("a")},
+		Nested:  &ConstraintShape{},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestConstraintsErrors(t *testing.T) {
+	input := &ConstraintShape{
+		Key:     aws.String(""),
+		MaxKeys: aws.Long(1001),
+		Ratio:   aws.Double(0.1),
+		Name:    aws.String("Name1"),
+		Mode:    aws.String("medium"),
+		Blob:    []byte("abc"),
+		List:    []*string{},
+		Map:     map[string]*string{"a": nil, "b": nil},
+		Nested:  &ConstraintShape{Key: aws.String("abcdef")},
+	}
+
+	svc := aws.NewService(aws.NewConfig().WithEnableEnumValidation(true))
+	req := aws.NewRequest(svc, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+
+	assert.Error(t, req.Error)
+	assert.Equal(t, "InvalidParameter", req.Error.(awserr.Error).Code())
+	assert.Equal(t, "9 validation errors:\n"+
+		"- Key length must be >= 1\n"+
+		"- MaxKeys must be <= 1000\n"+
+		"- Ratio must be >= 0.5\n"+
+		"- Name must match pattern ^[a-z]+$\n"+
+		"- Mode must be one of: fast, slow\n"+
+		"- Blob length must be <= 2\n"+
+		"- List length must be >= 1\n"+
+		"- Map length must be <= 1\n"+
+		"- Nested.Key length must be <= 5", req.Error.(awserr.Error).Message())
+}
+
+func TestConstraintsStringLengthCountsCharacters(t *testing.T) {
+	input := &ConstraintShape{Key: aws.String("ééééé")}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestConstraintsEnumNotValidatedByDefault(t *testing.T) {
+	input := &ConstraintShape{Mode: aws.String("medium"), Name: aws.String("Name1")}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+
+	assert.Error(t, req.Error)
+	assert.Equal(t, "1 validation errors:\n- Name must match pattern ^[a-z]+$", req.Error.(awserr.Error).Message())
+}
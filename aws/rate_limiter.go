@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"sync"
+	"time"
+)
+
+// A RateLimiter limits the rate requests are sent at with a token bucket.
+// The bucket is refilled at Rate tokens per second, up to Burst tokens, and
+// each request sent consumes a single token. Requests sent when the bucket
+// is empty wait until a token is available.
+//
+// A RateLimiter is safe for concurrent use. Adding its NamedHandler to the
+// front of a Service's Send handler list limits all of the service's requests,
+// including their retries.
+//
+//     limiter := aws.NewRateLimiter(100, 10)
+//     svc.Handlers.Send.PushFrontNamed(limiter.NamedHandler())
+type RateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewRateLimiter returns a RateLimiter which allows rate requests per second
+// with bursts of up to burst requests. The limiter starts full. A burst less
+// than one is treated as one, and a rate of zero or less disables the limit.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// NamedHandler returns the limiter's Handler as a NamedHandler, so it can be
+// found and removed from a handler list by name.
+func (l *RateLimiter) NamedHandler() NamedHandler {
+	return NamedHandler{Name: "core.RateLimiterHandler", Fn: l.Handler}
+}
+
+// Handler is a Send handler which waits until the request is allowed to be
+// sent by the limiter. If the request's context is done before then, the
+// request fails with the ErrCodeRequestCanceled error code and is not sent.
+func (l *RateLimiter) Handler(r *Request) {
+	if delay := l.reserve(); delay > 0 {
+		if err := sleepWithContext(r, delay); err != nil {
+			r.Error = err
+			r.Retryable.Set(false)
+		}
+	}
+}
+
+// reserve consumes a token from the bucket, returning how long the caller
+// must wait before the token is available.
+func (l *RateLimiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter(rate float64, burst int, now *time.Time) *RateLimiter {
+	l := NewRateLimiter(rate, burst)
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newTestRateLimiter(10, 2, &now)
+
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 100*time.Millisecond, l.reserve())
+	assert.Equal(t, 200*time.Millisecond, l.reserve())
+
+	now = now.Add(time.Second)
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 100*time.Millisecond, l.reserve())
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newTestRateLimiter(0, 1, &now)
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, time.Duration(0), l.reserve())
+	}
+}
+
+func TestRateLimiterHandler(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+
+	now := time.Unix(0, 0)
+	l := newTestRateLimiter(2, 1, &now)
+
+	s := NewService(&Config{})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBackNamed(l.NamedHandler())
+
+	for i := 0; i < 3; i++ {
+		r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+		r.Handlers.Send.Run(r)
+		assert.NoError(t, r.Error)
+	}
+	assert.Equal(t, []time.Duration{500 * time.Millisecond, time.Second}, delays)
+}
+
+func TestRateLimiterHandlerCanceled(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newTestRateLimiter(1, 1, &now)
+	l.reserve()
+
+	ctx := newTestContext()
+	ctx.cancel()
+
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	l.Handler(r)
+	assert.Error(t, r.Error)
+	assert.Equal(t, ErrCodeRequestCanceled, r.Error.(awserr.Error).Code())
+}
+
+// test that a request whose rate limiter wait is canceled is never sent.
+func TestRateLimiterCanceledNotSent(t *testing.T) {
+	reqNum := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqNum++
+	}))
+	defer server.Close()
+
+	now := time.Unix(0, 0)
+	l := newTestRateLimiter(1, 1, &now)
+	l.reserve()
+
+	s := NewService(&Config{Endpoint: String(server.URL), MaxRetries: Int(0)})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Send.PushFrontNamed(l.NamedHandler())
+
+	ctx := newTestContext()
+	time.AfterFunc(10*time.Millisecond, ctx.cancel)
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+	r.SetContext(ctx)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRequestCanceled, err.(awserr.Error).Code())
+	assert.Equal(t, 0, reqNum)
+	assert.Nil(t, r.HTTPResponse)
+}
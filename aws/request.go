@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -32,7 +33,15 @@ type Request struct {
 	Retryable    SettableBool
 	RetryDelay   time.Duration
 
-	built bool
+	// SignedHeaderVals are the headers signed by the request's signer, which
+	// must be sent with a presigned request's URL.
+	SignedHeaderVals http.Header
+
+	built              bool
+	context            Context
+	retryQuotaAcquired uint
+	attemptStart       time.Time
+	throttleCount      int
 }
 
 // An Operation is the service API operation to be made.
@@ -125,6 +134,9 @@ func (r *Request) SetReaderBody(reader io.ReadSeeker) {
 
 // Presign returns the request's signed URL. Error will be returned
 // if the signing fails.
+//
+// Headers the request has, such as those set from operation parameters, are
+// signed and must be sent with the URL. Use PresignRequest to get them.
 func (r *Request) Presign(expireTime time.Duration) (string, error) {
 	r.ExpireTime = expireTime
 	r.Sign()
@@ -134,6 +146,27 @@ func (r *Request) Presign(expireTime time.Duration) (string, error) {
 	return r.HTTPRequest.URL.String(), nil
 }
 
+// PresignRequest returns the request's signed URL, and the headers which were
+// signed with it. The headers must be sent with the URL, by whoever uses it,
+// for the request's signature to be valid. Error will be returned if the
+// signing fails.
+//
+// Parameters of the operation which are serialized to headers, such as the
+// content type, ACL, or SSE customer key of an S3 PutObject, are signed and
+// returned.
+func (r *Request) PresignRequest(expireTime time.Duration) (string, http.Header, error) {
+	url, err := r.Presign(expireTime)
+	if err != nil {
+		return "", nil, err
+	}
+
+	header := http.Header{}
+	for k, v := range r.SignedHeaderVals {
+		header[k] = v
+	}
+	return url, header, nil
+}
+
 // Build will build the request's object so it can be signed and sent
 // to the service. Build will also validate all the request's parameters.
 // Anny additional build Handlers set on this request will be run
@@ -177,9 +210,30 @@ func (r *Request) Sign() error {
 // Send will sign the request prior to sending. All Send Handlers will
 // be executed in the order they were set.
 func (r *Request) Send() error {
+	start := time.Now()
+	err := r.send()
+	r.observeComplete(start, err)
+	if err != nil && r.Config.LogLevel.Value().Matches(LogRequestErrors) {
+		r.Config.Logger.Log(fmt.Sprintf("DEBUG: Send Request %s/%s failed, attempt %d, error %v",
+			r.ServiceName, r.Operation.Name, r.RetryCount+1, err))
+	}
+	return err
+}
+
+// send sends the request, retrying it until it succeeds, or fails with an
+// error which is not retryable.
+func (r *Request) send() error {
 	for {
+		// Don't build, sign, or send a request whose context is already done.
+		if err := r.canceled(); err != nil {
+			r.Error = err
+			return r.Error
+		}
+
+		r.observeAttemptStart()
 		r.Sign()
 		if r.Error != nil {
+			r.observeAttemptEnd()
 			return r.Error
 		}
 
@@ -192,6 +246,7 @@ func (r *Request) Send() error {
 
 		r.Handlers.Send.Run(r)
 		if r.Error != nil {
+			r.observeAttemptEnd()
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -204,6 +259,7 @@ func (r *Request) Send() error {
 		r.Handlers.ValidateResponse.Run(r)
 		if r.Error != nil {
 			r.Handlers.UnmarshalError.Run(r)
+			r.observeAttemptEnd()
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
@@ -213,6 +269,7 @@ func (r *Request) Send() error {
 		}
 
 		r.Handlers.Unmarshal.Run(r)
+		r.observeAttemptEnd()
 		if r.Error != nil {
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
@@ -225,6 +282,7 @@ func (r *Request) Send() error {
 		break
 	}
 
+	r.releaseRetryQuota()
 	return nil
 }
 
@@ -280,6 +338,7 @@ func (r *Request) NextPage() *Request {
 
 	data := reflect.New(reflect.TypeOf(r.Data).Elem()).Interface()
 	nr := NewRequest(r.Service, r.Operation, awsutil.CopyOf(r.Params), data)
+	nr.context = r.context
 	for i, intok := range nr.Operation.InputTokens {
 		awsutil.SetValueAtAnyPath(nr.Params, intok, tokens[i])
 	}
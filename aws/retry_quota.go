@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeRetryQuotaExceeded is the error code returned by a request which
+// would have been retried, but its service's RetryQuota has no tokens left.
+const ErrCodeRetryQuotaExceeded = "RetryQuotaExceeded"
+
+const (
+	// DefaultRetryQuotaCapacity is the number of tokens a RetryQuota created
+	// by NewRetryQuota starts with, and can be refilled to.
+	DefaultRetryQuotaCapacity = 500
+
+	// DefaultRetryQuotaRetryCost is the number of tokens each retry consumes.
+	DefaultRetryQuotaRetryCost = 5
+
+	// DefaultRetryQuotaSuccessRefund is the number of tokens a request which
+	// succeeds without being retried refills.
+	DefaultRetryQuotaSuccessRefund = 1
+)
+
+// A RetryQuota is a token bucket limiting the number of retries made by all
+// of the requests sharing it. Each retry consumes RetryCost tokens, and a
+// request which eventually succeeds refills the tokens its retries consumed,
+// or SuccessRefund tokens if it was not retried. When the quota does not have
+// enough tokens left for a retry the request fails with the
+// ErrCodeRetryQuotaExceeded error code instead of being retried.
+//
+// This prevents clients from amplifying an outage by retrying all of their
+// requests while the service is degraded.
+//
+// A RetryQuota is safe for concurrent use, and is enabled by setting it on
+// the Service.
+//
+//     svc.RetryQuota = aws.NewRetryQuota(aws.DefaultRetryQuotaCapacity)
+type RetryQuota struct {
+	RetryCost     uint
+	SuccessRefund uint
+
+	mu        sync.Mutex
+	capacity  uint
+	available uint
+}
+
+// NewRetryQuota returns a full RetryQuota with the capacity provided, using
+// the default retry cost and success refund.
+func NewRetryQuota(capacity uint) *RetryQuota {
+	return &RetryQuota{
+		RetryCost:     DefaultRetryQuotaRetryCost,
+		SuccessRefund: DefaultRetryQuotaSuccessRefund,
+		capacity:      capacity,
+		available:     capacity,
+	}
+}
+
+// Available returns the number of tokens left in the quota.
+func (q *RetryQuota) Available() uint {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.available
+}
+
+// acquire consumes the cost of a retry from the quota, returning the number
+// of tokens consumed. False is returned if there are not enough tokens left.
+func (q *RetryQuota) acquire() (uint, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.RetryCost > q.available {
+		return 0, false
+	}
+	q.available -= q.RetryCost
+	return q.RetryCost, true
+}
+
+// release refills the quota with n tokens up to its capacity.
+func (q *RetryQuota) release(n uint) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.available += n
+	if q.available > q.capacity {
+		q.available = q.capacity
+	}
+}
+
+// acquireRetryQuota consumes the cost of retrying the request from its
+// service's RetryQuota. If the quota is exhausted the request's error is
+// wrapped in a RetryQuotaExceeded error and false is returned.
+func (r *Request) acquireRetryQuota() bool {
+	if r.Service.RetryQuota == nil {
+		return true
+	}
+
+	n, ok := r.Service.RetryQuota.acquire()
+	if !ok {
+		r.Error = awserr.Wrap(ErrCodeRetryQuotaExceeded,
+			"retry quota exceeded, not retrying request", r.Error)
+		r.Retryable.Set(false)
+		return false
+	}
+	r.retryQuotaAcquired += n
+	return true
+}
+
+// releaseRetryQuota refills its service's RetryQuota after the request
+// succeeded.
+func (r *Request) releaseRetryQuota() {
+	if r.Service.RetryQuota == nil {
+		return
+	}
+
+	if r.retryQuotaAcquired > 0 {
+		r.Service.RetryQuota.release(r.retryQuotaAcquired)
+		r.retryQuotaAcquired = 0
+	} else {
+		r.Service.RetryQuota.release(r.Service.RetryQuota.SuccessRefund)
+	}
+}
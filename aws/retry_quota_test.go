@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryQuotaAcquireRelease(t *testing.T) {
+	q := NewRetryQuota(12)
+	q.RetryCost = 5
+
+	n, ok := q.acquire()
+	assert.True(t, ok)
+	assert.Equal(t, uint(5), n)
+	_, ok = q.acquire()
+	assert.True(t, ok)
+	_, ok = q.acquire()
+	assert.False(t, ok)
+	assert.Equal(t, uint(2), q.Available())
+
+	q.release(100)
+	assert.Equal(t, uint(12), q.Available())
+}
+
+func newRetryQuotaTestService(statuses []int, q *RetryQuota) (*Service, *int) {
+	reqNum := 0
+	s := NewService(&Config{MaxRetries: Int(10)})
+	s.RetryQuota = q
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		if statuses[reqNum] == 200 {
+			r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+		} else {
+			r.HTTPResponse = &http.Response{
+				StatusCode: statuses[reqNum],
+				Body:       body(`{"__type":"UnknownError","message":"An error occurred."}`),
+			}
+		}
+		reqNum++
+	})
+	return s, &reqNum
+}
+
+func TestRetryQuotaExceeded(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+
+	q := NewRetryQuota(10)
+	s, reqNum := newRetryQuotaTestService([]int{500, 500, 500, 500}, q)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, ErrCodeRetryQuotaExceeded, err.(awserr.Error).Code())
+	assert.Equal(t, "UnknownError", err.(awserr.Error).OrigErr().(awserr.Error).Code())
+	assert.Equal(t, 3, *reqNum)
+	assert.Equal(t, 2, int(r.RetryCount))
+	assert.Equal(t, uint(0), q.Available())
+}
+
+func TestRetryQuotaRefilledOnSuccess(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+
+	q := NewRetryQuota(20)
+	s, _ := newRetryQuotaTestService([]int{500, 500, 200, 200}, q)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 2, int(r.RetryCount))
+	assert.Equal(t, uint(20), q.Available())
+
+	q.acquire()
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 0, int(r.RetryCount))
+	assert.Equal(t, uint(16), q.Available())
+}
@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// A Retryer determines if a failed request should be retried, how long to wait
+// before retrying it, and the maximum number of times it will be retried.
+//
+// A Retryer can be set on the Config to override a service's default retry
+// behavior.
+type Retryer interface {
+	RetryRules(*Request) time.Duration
+	ShouldRetry(*Request) bool
+	MaxRetries() uint
+}
+
+const (
+	// DefaultRetryerMaxRetries is the number of times a request will be
+	// retried by a DefaultRetryer with no NumMaxRetries set.
+	DefaultRetryerMaxRetries = 3
+
+	// DefaultRetryerMinRetryDelay is the base delay a DefaultRetryer will
+	// back off from when retrying a request.
+	DefaultRetryerMinRetryDelay = 30 * time.Millisecond
+
+	// DefaultRetryerMinThrottleDelay is the base delay a DefaultRetryer will
+	// back off from when retrying a throttled request.
+	DefaultRetryerMinThrottleDelay = 500 * time.Millisecond
+
+	// DefaultRetryerMaxRetryDelay is the maximum delay a DefaultRetryer will
+	// wait before retrying a request.
+	DefaultRetryerMaxRetryDelay = 20 * time.Second
+)
+
+// DefaultRetryer implements the Retryer interface with a capped exponential
+// backoff with full jitter. Each delay is a random duration between zero and
+// min(MaxRetryDelay, MinRetryDelay * 2^RetryCount). Throttled requests back
+// off from MinThrottleDelay instead of MinRetryDelay.
+//
+// If the service responds with a Retry-After header the delay it specifies
+// will be used instead, capped at MaxRetryDelay.
+//
+// Zero value fields will use their DefaultRetryer* constant's value.
+type DefaultRetryer struct {
+	NumMaxRetries    uint
+	MinRetryDelay    time.Duration
+	MinThrottleDelay time.Duration
+	MaxRetryDelay    time.Duration
+
+	// ThrottleCodes are service specific error codes which will be retried
+	// as throttled requests in addition to the codes all services share.
+	ThrottleCodes []string
+}
+
+// MaxRetries returns the number of maximum retries the retryer will use.
+func (d DefaultRetryer) MaxRetries() uint {
+	if d.NumMaxRetries == 0 {
+		return DefaultRetryerMaxRetries
+	}
+	return d.NumMaxRetries
+}
+
+// RetryRules returns the delay duration before retrying the request.
+func (d DefaultRetryer) RetryRules(r *Request) time.Duration {
+	maxDelay := durationOr(d.MaxRetryDelay, DefaultRetryerMaxRetryDelay)
+	if delay, ok := retryAfter(r); ok {
+		if delay > maxDelay {
+			return maxDelay
+		}
+		return delay
+	}
+
+	minDelay := durationOr(d.MinRetryDelay, DefaultRetryerMinRetryDelay)
+	if d.isThrottle(r) {
+		minDelay = durationOr(d.MinThrottleDelay, DefaultRetryerMinThrottleDelay)
+	}
+
+	delay := maxDelay
+	if r.RetryCount < 63 {
+		if backoff := minDelay << r.RetryCount; backoff > 0 && backoff < maxDelay {
+			delay = backoff
+		}
+	}
+
+	return time.Duration(randInt63n(int64(delay) + 1))
+}
+
+// ShouldRetry returns if the request should be retried.
+func (d DefaultRetryer) ShouldRetry(r *Request) bool {
+	if err, ok := r.Error.(awserr.Error); ok && err.Code() == ErrCodeRequestCanceled {
+		return false
+	}
+	if r.HTTPResponse != nil {
+		if r.HTTPResponse.StatusCode >= 500 || r.HTTPResponse.StatusCode == 429 {
+			return true
+		}
+	}
+	if err, ok := r.Error.(awserr.Error); ok {
+		return isCodeRetryable(err.Code()) || d.isThrottleCode(err.Code())
+	}
+	return false
+}
+
+// isThrottle returns if the request failed because it was throttled.
+func (d DefaultRetryer) isThrottle(r *Request) bool {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == 429 {
+		return true
+	}
+	if err, ok := r.Error.(awserr.Error); ok {
+		return d.isThrottleCode(err.Code())
+	}
+	return false
+}
+
+// isThrottleCode returns if the code is a throttle code shared by all
+// services, or one of the retryer's service specific throttle codes.
+func (d DefaultRetryer) isThrottleCode(code string) bool {
+	if isCodeThrottle(code) {
+		return true
+	}
+	for _, c := range d.ThrottleCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter returns the delay requested by the response's Retry-After
+// header, if one is present. The header's value may either be a number of
+// seconds, or an HTTP date.
+func retryAfter(r *Request) (time.Duration, bool) {
+	if r.HTTPResponse == nil || r.HTTPResponse.Header == nil {
+		return 0, false
+	}
+	v := r.HTTPResponse.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		delay := t.Sub(time.Now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+func durationOr(v, def time.Duration) time.Duration {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+// retryableCodes is a collection of service response codes which are retry-able
+// without any further action.
+var retryableCodes = map[string]struct{}{
+	"RequestError":   {},
+	"RequestTimeout": {},
+}
+
+// throttleCodes is a collection of service response codes which signify the
+// request was throttled, and are retry-able after backing off.
+var throttleCodes = map[string]struct{}{
+	"ProvisionedThroughputExceededException": {},
+	"Throttling":                             {},
+	"ThrottlingException":                    {},
+	"RequestLimitExceeded":                   {},
+	"RequestThrottled":                       {},
+	"RequestThrottledException":              {},
+	"TooManyRequestsException":               {},
+	"PriorRequestNotComplete":                {},
+	"TransactionInProgressException":         {},
+	"SlowDown":                               {},
+	"EC2ThrottledException":                  {},
+	"BandwidthLimitExceeded":                 {},
+}
+
+// credsExpiredCodes is a collection of error codes which signify the credentials
+// need to be refreshed. Expired tokens require refreshing of credentials, and
+// resigning before the request can be retried.
+var credsExpiredCodes = map[string]struct{}{
+	"ExpiredToken":          {},
+	"ExpiredTokenException": {},
+	"RequestExpired":        {}, // EC2 Only
+}
+
+func isCodeRetryable(code string) bool {
+	if _, ok := retryableCodes[code]; ok {
+		return true
+	}
+
+	return isCodeThrottle(code) || isCodeExpiredCreds(code)
+}
+
+func isCodeThrottle(code string) bool {
+	_, ok := throttleCodes[code]
+	return ok
+}
+
+func isCodeExpiredCreds(code string) bool {
+	_, ok := credsExpiredCodes[code]
+	return ok
+}
+
+// retryRand is the source of the retry delay jitter. The default math/rand
+// source is not used so the jitter does not depend on it being seeded.
+var retryRand = struct {
+	sync.Mutex
+	*rand.Rand
+}{Rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+func randInt63n(n int64) int64 {
+	retryRand.Lock()
+	defer retryRand.Unlock()
+	return retryRand.Int63n(n)
+}
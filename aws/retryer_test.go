@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestRequest(status int, code string, retryCount uint) *Request {
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+	r.HTTPResponse = &http.Response{StatusCode: status, Header: http.Header{}}
+	if code != "" {
+		r.Error = awserr.New(code, "message", nil)
+	}
+	r.RetryCount = retryCount
+	return r
+}
+
+func TestDefaultRetryerShouldRetry(t *testing.T) {
+	cases := []struct {
+		status int
+		code   string
+		retry  bool
+	}{
+		{500, "InternalError", true},
+		{503, "", true},
+		{429, "", true},
+		{400, "Throttling", true},
+		{400, "ThrottlingException", true},
+		{400, "RequestLimitExceeded", true},
+		{400, "TooManyRequestsException", true},
+		{503, "SlowDown", true},
+		{400, "ProvisionedThroughputExceededException", true},
+		{400, "ExpiredTokenException", true},
+		{0, "RequestError", true},
+		{400, "ValidationError", false},
+		{404, "NotFound", false},
+		{0, ErrCodeRequestCanceled, false},
+	}
+
+	for i, c := range cases {
+		r := newRetryTestRequest(c.status, c.code, 0)
+		assert.Equal(t, c.retry, DefaultRetryer{}.ShouldRetry(r), "case %d, %d %s", i, c.status, c.code)
+	}
+}
+
+func TestDefaultRetryerServiceThrottleCodes(t *testing.T) {
+	r := newRetryTestRequest(400, "CustomThrottle", 0)
+	assert.False(t, DefaultRetryer{}.ShouldRetry(r))
+
+	d := DefaultRetryer{ThrottleCodes: []string{"CustomThrottle"}}
+	assert.True(t, d.ShouldRetry(r))
+	assert.True(t, d.isThrottle(r))
+}
+
+func TestDefaultRetryerRetryRulesJitter(t *testing.T) {
+	d := DefaultRetryer{MinRetryDelay: 10 * time.Millisecond, MaxRetryDelay: 100 * time.Millisecond}
+
+	for retryCount := uint(0); retryCount < 70; retryCount++ {
+		max := 10 * time.Millisecond << retryCount
+		if retryCount >= 4 {
+			max = 100 * time.Millisecond
+		}
+		r := newRetryTestRequest(500, "InternalError", retryCount)
+		for i := 0; i < 50; i++ {
+			delay := d.RetryRules(r)
+			assert.True(t, delay >= 0 && delay <= max, "retry %d, delay %s > %s", retryCount, delay, max)
+		}
+	}
+}
+
+func TestDefaultRetryerRetryRulesThrottle(t *testing.T) {
+	d := DefaultRetryer{MinRetryDelay: time.Millisecond, MinThrottleDelay: time.Second}
+	r := newRetryTestRequest(400, "Throttling", 0)
+
+	var max time.Duration
+	for i := 0; i < 50; i++ {
+		if delay := d.RetryRules(r); delay > max {
+			max = delay
+		}
+	}
+	assert.True(t, max > time.Millisecond, "expected throttle delay, got %s", max)
+}
+
+func TestDefaultRetryerRetryAfter(t *testing.T) {
+	r := newRetryTestRequest(429, "", 0)
+	r.HTTPResponse.Header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, DefaultRetryer{}.RetryRules(r))
+
+	r.HTTPResponse.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	delay := DefaultRetryer{MaxRetryDelay: 2 * time.Hour}.RetryRules(r)
+	assert.True(t, delay > 59*time.Minute && delay <= time.Hour, "unexpected delay %s", delay)
+
+	// The Retry-After delay is capped at MaxRetryDelay.
+	r.HTTPResponse.Header.Set("Retry-After", "86400")
+	assert.Equal(t, DefaultRetryerMaxRetryDelay, DefaultRetryer{}.RetryRules(r))
+	assert.Equal(t, time.Minute, DefaultRetryer{MaxRetryDelay: time.Minute}.RetryRules(r))
+
+	r.HTTPResponse.Header.Set("Retry-After", "invalid")
+	delay = DefaultRetryer{}.RetryRules(r)
+	assert.True(t, delay <= DefaultRetryerMinThrottleDelay, "unexpected delay %s", delay)
+}
+
+type testRetryer struct {
+	DefaultRetryer
+	retries uint
+}
+
+func (r testRetryer) MaxRetries() uint { return r.retries }
+
+func TestConfigRetryerOverridesService(t *testing.T) {
+	s := NewService(&Config{MaxRetries: Int(DefaultRetries)})
+	assert.Equal(t, uint(DefaultRetryerMaxRetries), s.MaxRetries())
+
+	s.Retryer = DefaultRetryer{NumMaxRetries: 10}
+	assert.Equal(t, uint(10), s.MaxRetries())
+
+	s.Config.Retryer = testRetryer{retries: 1}
+	assert.Equal(t, uint(1), s.MaxRetries())
+
+	s.Config.MaxRetries = Int(2)
+	assert.Equal(t, uint(2), s.MaxRetries())
+}
@@ -2,32 +2,30 @@ package aws
 
 import (
 	"fmt"
-	"math"
 	"net/http"
 	"net/http/httputil"
 	"regexp"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/internal/endpoints"
 )
 
 // A Service implements the base service request and response handling
 // used by all services.
 type Service struct {
-	Config            *Config
-	Handlers          Handlers
-	ManualSend        bool
-	ServiceName       string
-	APIVersion        string
-	Endpoint          string
-	SigningName       string
-	SigningRegion     string
-	JSONVersion       string
-	TargetPrefix      string
-	RetryRules        func(*Request) time.Duration
-	ShouldRetry       func(*Request) bool
-	DefaultMaxRetries uint
+	Config        *Config
+	Handlers      Handlers
+	ManualSend    bool
+	ServiceName   string
+	APIVersion    string
+	Endpoint      string
+	SigningName   string
+	SigningRegion string
+	JSONVersion   string
+	TargetPrefix  string
+	Retryer       Retryer
+	RetryQuota    *RetryQuota
+
+	endpointErr error
 }
 
 var schemeRE = regexp.MustCompile("^([^:]+)://")
@@ -47,42 +45,63 @@ func (s *Service) Initialize() {
 	if s.Config.HTTPClient == nil {
 		s.Config.HTTPClient = http.DefaultClient
 	}
-
-	if s.RetryRules == nil {
-		s.RetryRules = retryRules
+	if s.Config.Logger == nil {
+		s.Config.Logger = NewDefaultLogger()
 	}
 
-	if s.ShouldRetry == nil {
-		s.ShouldRetry = shouldRetry
+	if s.Retryer == nil {
+		s.Retryer = DefaultRetryer{}
 	}
 
-	s.DefaultMaxRetries = 3
-	s.Handlers.Validate.PushBack(ValidateEndpointHandler)
-	s.Handlers.Build.PushBack(UserAgentHandler)
-	s.Handlers.Sign.PushBack(BuildContentLength)
-	s.Handlers.Send.PushBack(SendHandler)
-	s.Handlers.AfterRetry.PushBack(AfterRetryHandler)
-	s.Handlers.ValidateResponse.PushBack(ValidateResponseHandler)
+	s.Handlers.Validate.PushBackNamed(ValidateEndpointHandler)
+	s.Handlers.Build.PushBackNamed(UserAgentHandler)
+	s.Handlers.Sign.PushBackNamed(BuildContentLength)
+	s.Handlers.Send.PushBackNamed(SendHandler)
+	s.Handlers.AfterRetry.PushBackNamed(AfterRetryHandler)
+	s.Handlers.ValidateResponse.PushBackNamed(ValidateResponseHandler)
 	s.AddDebugHandlers()
+
+	// Without a region, or endpoint, the region of the EC2 instance the
+	// program is running on is used if detecting it is enabled. The region
+	// is set on a copy of the Config, so the caller's Config is unchanged.
+	if BooleanValue(s.Config.DetectEC2Region) &&
+		StringValue(s.Config.Region) == "" && StringValue(s.Config.Endpoint) == "" {
+		if region := detectEC2Region(); region != "" {
+			cfg := s.Config.Copy()
+			cfg.Region = String(region)
+			s.Config = &cfg
+		}
+	}
 	s.buildEndpoint()
 
-	if !s.Config.DisableParamValidation {
-		s.Handlers.Validate.PushBack(ValidateParameters)
+	if !BooleanValue(s.Config.DisableParamValidation) {
+		s.Handlers.Validate.PushBackNamed(ValidateParametersHandler)
 	}
 }
 
 // buildEndpoint builds the endpoint values the service will use to make requests with.
+// The Config's Endpoint takes precedence over the endpoint resolved by its
+// EndpointResolver.
 func (s *Service) buildEndpoint() {
-	if s.Config.Endpoint != "" {
-		s.Endpoint = s.Config.Endpoint
+	if StringValue(s.Config.Endpoint) != "" {
+		s.Endpoint = *s.Config.Endpoint
 	} else {
-		s.Endpoint, s.SigningRegion =
-			endpoints.EndpointForRegion(s.ServiceName, s.Config.Region)
+		resolver := s.Config.EndpointResolver
+		if resolver == nil {
+			resolver = DefaultEndpointResolver{}
+		}
+		var err error
+		s.Endpoint, s.SigningRegion, err =
+			resolver.EndpointFor(s.ServiceName, StringValue(s.Config.Region))
+		if err != nil {
+			s.endpointErr = awserr.New("EndpointResolverError",
+				"failed to resolve endpoint for "+s.ServiceName, err)
+		}
 	}
 
 	if s.Endpoint != "" && !schemeRE.MatchString(s.Endpoint) {
 		scheme := "https"
-		if s.Config.DisableSSL {
+		if BooleanValue(s.Config.DisableSSL) {
 			scheme = "http"
 		}
 		s.Endpoint = scheme + "://" + s.Endpoint
@@ -90,88 +109,69 @@ func (s *Service) buildEndpoint() {
 }
 
 // AddDebugHandlers injects debug logging handlers into the service to log request
-// debug information.
+// debug information. The HTTP request and response are logged when the Config's
+// LogLevel enables LogHTTPHeaders or LogHTTPBody.
 func (s *Service) AddDebugHandlers() {
-	out := s.Config.Logger
-	if s.Config.LogLevel == 0 {
-		return
+	logLevel := s.Config.LogLevel.Value()
+	if logLevel.Matches(LogHTTPHeaders) || logLevel.Matches(LogHTTPBody) {
+		s.Handlers.Send.PushFrontNamed(LogHTTPRequestHandler)
+		s.Handlers.Send.PushBackNamed(LogHTTPResponseHandler)
 	}
-
-	s.Handlers.Send.PushFront(func(r *Request) {
-		logBody := r.Config.LogHTTPBody
-		dumpedBody, _ := httputil.DumpRequestOut(r.HTTPRequest, logBody)
-
-		fmt.Fprintf(out, "---[ REQUEST POST-SIGN ]-----------------------------\n")
-		fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
-	s.Handlers.Send.PushBack(func(r *Request) {
-		fmt.Fprintf(out, "---[ RESPONSE ]--------------------------------------\n")
-		if r.HTTPResponse != nil {
-			logBody := r.Config.LogHTTPBody
-			dumpedBody, _ := httputil.DumpResponse(r.HTTPResponse, logBody)
-			fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		} else if r.Error != nil {
-			fmt.Fprintf(out, "%s\n", r.Error)
-		}
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
 }
 
-// MaxRetries returns the number of maximum returns the service will use to make
-// an individual API request.
-func (s *Service) MaxRetries() uint {
-	if s.Config.MaxRetries < 0 {
-		return s.DefaultMaxRetries
-	}
-	return uint(s.Config.MaxRetries)
-}
+// LogHTTPRequestHandler is a request handler which logs the HTTP request
+// before it is sent. It is added by AddDebugHandlers.
+var LogHTTPRequestHandler = NamedHandler{Name: "core.LogHTTPRequestHandler", Fn: logRequest}
 
-// retryRules returns the delay duration before retrying this request again
-func retryRules(r *Request) time.Duration {
-	delay := time.Duration(math.Pow(2, float64(r.RetryCount))) * 30
-	return delay * time.Millisecond
-}
+const logReqMsg = `DEBUG: Request %s/%s Details:
+---[ REQUEST POST-SIGN ]-----------------------------
+%s
+-----------------------------------------------------`
 
-// retryableCodes is a collection of service response codes which are retry-able
-// without any further action.
-var retryableCodes = map[string]struct{}{
-	"RequestError":                           {},
-	"ProvisionedThroughputExceededException": {},
-	"Throttling":                             {},
-}
+func logRequest(r *Request) {
+	logBody := r.Config.LogLevel.Value().Matches(LogHTTPBody)
+	dumpedBody, _ := httputil.DumpRequestOut(r.HTTPRequest, logBody)
 
-// credsExpiredCodes is a collection of error codes which signify the credentials
-// need to be refreshed. Expired tokens require refreshing of credentials, and
-// resigning before the request can be retried.
-var credsExpiredCodes = map[string]struct{}{
-	"ExpiredToken":          {},
-	"ExpiredTokenException": {},
-	"RequestExpired":        {}, // EC2 Only
+	r.Config.Logger.Log(fmt.Sprintf(logReqMsg, r.ServiceName, r.Operation.Name, string(dumpedBody)))
 }
 
-func isCodeRetryable(code string) bool {
-	if _, ok := retryableCodes[code]; ok {
-		return true
+// LogHTTPResponseHandler is a request handler which logs the HTTP response
+// after it is received. It is added by AddDebugHandlers.
+var LogHTTPResponseHandler = NamedHandler{Name: "core.LogHTTPResponseHandler", Fn: logResponse}
+
+const logRespMsg = `DEBUG: Response %s/%s Details:
+---[ RESPONSE ]--------------------------------------
+%s
+-----------------------------------------------------`
+
+func logResponse(r *Request) {
+	var msg string
+	if r.HTTPResponse != nil {
+		logBody := r.Config.LogLevel.Value().Matches(LogHTTPBody)
+		dumpedBody, _ := httputil.DumpResponse(r.HTTPResponse, logBody)
+		msg = string(dumpedBody)
+	} else if r.Error != nil {
+		msg = r.Error.Error()
 	}
 
-	return isCodeExpiredCreds(code)
+	r.Config.Logger.Log(fmt.Sprintf(logRespMsg, r.ServiceName, r.Operation.Name, msg))
 }
 
-func isCodeExpiredCreds(code string) bool {
-	_, ok := credsExpiredCodes[code]
-	return ok
+// MaxRetries returns the number of maximum returns the service will use to make
+// an individual API request. The Config's MaxRetries takes precedence over the
+// Retryer's value unless it is not set, or is DefaultRetries.
+func (s *Service) MaxRetries() uint {
+	if s.Config.MaxRetries == nil || *s.Config.MaxRetries < 0 {
+		return s.retryer().MaxRetries()
+	}
+	return uint(*s.Config.MaxRetries)
 }
 
-// shouldRetry returns if the request should be retried.
-func shouldRetry(r *Request) bool {
-	if r.HTTPResponse.StatusCode >= 500 {
-		return true
-	}
-	if r.Error != nil {
-		if err, ok := r.Error.(awserr.Error); ok {
-			return isCodeRetryable(err.Code())
-		}
+// retryer returns the Retryer the service's requests will use. A Retryer set
+// on the Config takes precedence over the service's default.
+func (s *Service) retryer() Retryer {
+	if s.Config.Retryer != nil {
+		return s.Config.Retryer
 	}
-	return false
+	return s.Retryer
 }
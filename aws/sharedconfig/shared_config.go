@@ -0,0 +1,330 @@
+// Package sharedconfig loads the configuration of AWS profiles from the shared
+// config (~/.aws/config) and credentials (~/.aws/credentials) files used by
+// the AWS CLI, so Go tools select their region and credentials the same way.
+package sharedconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/vaughan0/go-ini"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// DefaultProfile is the name of the profile used if none is selected.
+const DefaultProfile = "default"
+
+var (
+	// ErrHomeNotFound is returned when the user's home directory cannot be
+	// found to locate the default shared files.
+	ErrHomeNotFound = awserr.New("UserHomeNotFound", "user home directory not found.", nil)
+)
+
+// A Profile is the configuration of a named profile, read from the shared
+// config and credentials files.
+type Profile struct {
+	Name string
+
+	// The region, and CLI output format of the profile.
+	Region string
+	Output string
+
+	// Static credentials of the profile, empty if not set.
+	Credentials credentials.Value
+
+	// The command the profile's credentials are retrieved from, if it does
+	// not have static credentials.
+	CredentialProcess string
+
+	// The role the profile's credentials are retrieved by assuming, using the
+	// credentials of SourceProfile.
+	RoleARN         string
+	ExternalID      string
+	RoleSessionName string
+	SourceProfile   *Profile
+}
+
+// A Loader loads profiles from the shared config and credentials files.
+//
+// Values in the shared credentials file take precedence over values for the
+// same profile in the shared config file. In the shared config file profiles
+// other than the default are in "[profile name]" sections, as they are for the
+// AWS CLI.
+//
+// Example:
+//     cfg, err := sharedconfig.Config()
+//     if err != nil {
+//         return err
+//     }
+//     svc := s3.New(cfg)
+type Loader struct {
+	// Path to the shared config file. If empty will default to environment
+	// variable "AWS_CONFIG_FILE", or $HOME/.aws/config if the environment
+	// variable is also not set.
+	ConfigFilename string
+
+	// Path to the shared credentials file. If empty will default to environment
+	// variable "AWS_SHARED_CREDENTIALS_FILE", or $HOME/.aws/credentials if the
+	// environment variable is also not set.
+	CredentialsFilename string
+
+	// Name of the profile to load. If empty will default to environment
+	// variable "AWS_PROFILE", or "default" if the environment variable is also
+	// not set.
+	Profile string
+
+	// NewAssumeRoleClient returns the STS client used to assume the role of
+	// profiles with a role_arn, using the source profile's credentials. If nil
+	// the default STS client is used.
+	NewAssumeRoleClient func(creds *credentials.Credentials, region string) stscreds.AssumeRoler
+}
+
+// Config returns the aws.Config of the profile selected by the environment,
+// loaded from the default shared files.
+func Config() (*aws.Config, error) {
+	return (&Loader{}).Config()
+}
+
+// Config returns an aws.Config with the region and credentials of the
+// loader's profile. The "AWS_REGION" environment variable takes precedence
+// over the profile's region. If the profile does not have credentials the
+// config's Credentials are nil, and the default credentials will be used.
+func (l *Loader) Config() (*aws.Config, error) {
+	p, err := l.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := l.credentials(p)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &aws.Config{Credentials: creds}
+	if r := region(p); r != "" {
+		cfg.Region = aws.String(r)
+	}
+	return cfg, nil
+}
+
+// Load loads the loader's profile. An error is returned if a profile other
+// than the default profile does not exist, or one of the files cannot be
+// parsed. Files which do not exist are ignored.
+func (l *Loader) Load() (*Profile, error) {
+	configFilename, err := l.configFilename()
+	if err != nil {
+		return nil, err
+	}
+	configFile, err := loadFile(configFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	credsFilename, err := l.credentialsFilename()
+	if err != nil {
+		return nil, err
+	}
+	credsFile, err := loadFile(credsFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	name := l.profile()
+	return loadProfile(configFile, credsFile, name, name == DefaultProfile, map[string]bool{})
+}
+
+// loadProfile loads the named profile, and its source profile, from the
+// files. The visited profiles are tracked to detect source profile loops.
+func loadProfile(configFile, credsFile ini.File, name string, optional bool, visited map[string]bool) (*Profile, error) {
+	visited[name] = true
+
+	configSection := "profile " + name
+	if name == DefaultProfile {
+		if _, ok := configFile[DefaultProfile]; ok {
+			configSection = DefaultProfile
+		}
+	}
+
+	values := map[string]string{}
+	_, inConfig := configFile[configSection]
+	for k, v := range configFile[configSection] {
+		values[k] = v
+	}
+	_, inCreds := credsFile[name]
+	for k, v := range credsFile[name] {
+		values[k] = v
+	}
+
+	if !inConfig && !inCreds && !optional {
+		return nil, awserr.New("SharedConfigProfileNotExists",
+			fmt.Sprintf("failed to load profile %s, profile does not exist", name), nil)
+	}
+
+	p := &Profile{
+		Name:   name,
+		Region: values["region"],
+		Output: values["output"],
+		Credentials: credentials.Value{
+			AccessKeyID:     values["aws_access_key_id"],
+			SecretAccessKey: values["aws_secret_access_key"],
+			SessionToken:    values["aws_session_token"],
+		},
+		CredentialProcess: values["credential_process"],
+		RoleARN:           values["role_arn"],
+		ExternalID:        values["external_id"],
+		RoleSessionName:   values["role_session_name"],
+	}
+
+	if p.RoleARN == "" {
+		return p, nil
+	}
+
+	source := values["source_profile"]
+	switch {
+	case source == "":
+		return nil, awserr.New("SharedConfigSourceProfileMissing",
+			fmt.Sprintf("profile %s has a role_arn, but no source_profile", name), nil)
+	case source == name:
+		// The role is assumed with the static credentials of the profile.
+		src := *p
+		src.RoleARN, src.ExternalID, src.RoleSessionName = "", "", ""
+		p.SourceProfile = &src
+	case visited[source]:
+		return nil, awserr.New("SharedConfigSourceProfileLoop",
+			fmt.Sprintf("profile %s has a source_profile loop through %s", name, source), nil)
+	default:
+		src, err := loadProfile(configFile, credsFile, source, false, visited)
+		if err != nil {
+			return nil, err
+		}
+		p.SourceProfile = src
+	}
+
+	return p, nil
+}
+
+// credentials returns the credentials of the profile, or nil if it does not
+// have any.
+func (l *Loader) credentials(p *Profile) (*credentials.Credentials, error) {
+	if p.RoleARN != "" {
+		srcCreds, err := l.credentials(p.SourceProfile)
+		if err != nil {
+			return nil, err
+		}
+		if srcCreds == nil {
+			return nil, awserr.New("SharedConfigSourceProfileNoCredentials",
+				fmt.Sprintf("source_profile %s of profile %s has no credentials", p.SourceProfile.Name, p.Name), nil)
+		}
+
+		return credentials.NewCredentials(&stscreds.AssumeRoleProvider{
+			Client:          l.newAssumeRoleClient(srcCreds, region(p)),
+			RoleARN:         p.RoleARN,
+			ExternalID:      p.ExternalID,
+			RoleSessionName: p.RoleSessionName,
+		}), nil
+	}
+
+	if p.Credentials.AccessKeyID != "" {
+		return credentials.NewStaticCredentials(p.Credentials.AccessKeyID,
+			p.Credentials.SecretAccessKey, p.Credentials.SessionToken), nil
+	}
+
+	if p.CredentialProcess != "" {
+		return credentials.NewProcessCredentials(p.CredentialProcess), nil
+	}
+
+	return nil, nil
+}
+
+// newAssumeRoleClient returns the STS client used to assume a profile's role.
+func (l *Loader) newAssumeRoleClient(creds *credentials.Credentials, region string) stscreds.AssumeRoler {
+	if l.NewAssumeRoleClient != nil {
+		return l.NewAssumeRoleClient(creds, region)
+	}
+	cfg := &aws.Config{Credentials: creds}
+	if region != "" {
+		cfg.Region = aws.String(region)
+	}
+	return sts.New(cfg)
+}
+
+// loadFile loads the ini file. An empty file is returned if it does not exist.
+func loadFile(filename string) (ini.File, error) {
+	f, err := ini.LoadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ini.File{}, nil
+		}
+		return nil, awserr.New("SharedConfigLoad",
+			fmt.Sprintf("failed to load shared config file %s", filename), err)
+	}
+	return f, nil
+}
+
+// region returns the region of the profile, unless the "AWS_REGION"
+// environment variable is set.
+func region(p *Profile) string {
+	if r := os.Getenv("AWS_REGION"); r != "" {
+		return r
+	}
+	return p.Region
+}
+
+// configFilename returns the filename of the shared config file.
+func (l *Loader) configFilename() (string, error) {
+	if l.ConfigFilename == "" {
+		l.ConfigFilename = os.Getenv("AWS_CONFIG_FILE")
+	}
+	if l.ConfigFilename == "" {
+		home, err := homeDir()
+		if err != nil {
+			return "", err
+		}
+		l.ConfigFilename = filepath.Join(home, ".aws", "config")
+	}
+	return l.ConfigFilename, nil
+}
+
+// credentialsFilename returns the filename of the shared credentials file.
+func (l *Loader) credentialsFilename() (string, error) {
+	if l.CredentialsFilename == "" {
+		l.CredentialsFilename = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
+	if l.CredentialsFilename == "" {
+		home, err := homeDir()
+		if err != nil {
+			return "", err
+		}
+		l.CredentialsFilename = filepath.Join(home, ".aws", "credentials")
+	}
+	return l.CredentialsFilename, nil
+}
+
+// profile returns the name of the profile to load.
+func (l *Loader) profile() string {
+	if l.Profile == "" {
+		l.Profile = os.Getenv("AWS_PROFILE")
+	}
+	if l.Profile == "" {
+		l.Profile = DefaultProfile
+	}
+	return l.Profile
+}
+
+// homeDir returns the current user's home directory.
+func homeDir() (string, error) {
+	home := os.Getenv("HOME") // *nix
+	if home == "" {           // Windows
+		home = os.Getenv("USERPROFILE")
+	}
+	if home == "" {
+		return "", ErrHomeNotFound
+	}
+	return home, nil
+}
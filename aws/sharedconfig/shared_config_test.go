@@ -0,0 +1,203 @@
+package sharedconfig
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubSTS struct {
+	creds  *credentials.Credentials
+	region string
+	input  *sts.AssumeRoleInput
+}
+
+func (s *stubSTS) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	s.input = input
+	v, err := s.creds.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := time.Now().Add(60 * time.Minute)
+	return &sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			// Reflect the source credentials and role to the provider.
+			AccessKeyID:     aws.String(v.AccessKeyID + ":" + *input.RoleARN),
+			SecretAccessKey: aws.String("assumedSecretAccessKey"),
+			SessionToken:    aws.String("assumedSessionToken"),
+			Expiration:      &expiry,
+		},
+	}, nil
+}
+
+func newTestLoader(profile string, clients *[]*stubSTS) *Loader {
+	return &Loader{
+		ConfigFilename:      "example_config.ini",
+		CredentialsFilename: "example_credentials.ini",
+		Profile:             profile,
+		NewAssumeRoleClient: func(creds *credentials.Credentials, region string) stscreds.AssumeRoler {
+			s := &stubSTS{creds: creds, region: region}
+			*clients = append(*clients, s)
+			return s
+		},
+	}
+}
+
+func TestLoadDefaultProfile(t *testing.T) {
+	os.Clearenv()
+
+	p, err := newTestLoader("", nil).Load()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "default", p.Name)
+	assert.Equal(t, "us-west-2", p.Region)
+	assert.Equal(t, "json", p.Output)
+	assert.Equal(t, "defaultAccessKey", p.Credentials.AccessKeyID)
+	assert.Equal(t, "defaultSecret", p.Credentials.SecretAccessKey)
+	assert.Equal(t, "defaultToken", p.Credentials.SessionToken)
+}
+
+func TestLoadProfileFromEnv(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_PROFILE", "dev")
+	os.Setenv("AWS_CONFIG_FILE", "example_config.ini")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "example_credentials.ini")
+
+	p, err := (&Loader{}).Load()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "dev", p.Name)
+	assert.Equal(t, "us-east-1", p.Region, "Expect credentials file to take precedence")
+	assert.Equal(t, "text", p.Output)
+	assert.Equal(t, "devAccessKey", p.Credentials.AccessKeyID)
+	assert.Empty(t, p.Credentials.SessionToken)
+}
+
+func TestLoadMissingFiles(t *testing.T) {
+	os.Clearenv()
+
+	l := &Loader{ConfigFilename: "missing_config.ini", CredentialsFilename: "missing_credentials.ini"}
+	p, err := l.Load()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "default", p.Name)
+	assert.Empty(t, p.Region)
+
+	cfg, err := l.Config()
+	assert.Nil(t, err, "Expect no error")
+	assert.Nil(t, cfg.Credentials, "Expect default credentials to be used")
+	assert.Nil(t, cfg.Region, "Expect default region to be used")
+}
+
+func TestLoadProfileErrors(t *testing.T) {
+	os.Clearenv()
+
+	cases := map[string]string{
+		"missing":   "SharedConfigProfileNotExists",
+		"no_source": "SharedConfigSourceProfileMissing",
+		"loop_a":    "SharedConfigSourceProfileLoop",
+	}
+	for profile, code := range cases {
+		_, err := newTestLoader(profile, nil).Load()
+		assert.Error(t, err, profile)
+		assert.Equal(t, code, err.(awserr.Error).Code(), profile)
+	}
+}
+
+func TestConfigStaticCredentials(t *testing.T) {
+	os.Clearenv()
+
+	cfg, err := newTestLoader("default", nil).Config()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "us-west-2", *cfg.Region)
+
+	v, err := cfg.Credentials.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "defaultAccessKey", v.AccessKeyID)
+	assert.Equal(t, "defaultToken", v.SessionToken)
+}
+
+func TestConfigRegionFromEnv(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_REGION", "sa-east-1")
+
+	cfg, err := newTestLoader("default", nil).Config()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "sa-east-1", *cfg.Region)
+}
+
+func TestConfigAssumeRole(t *testing.T) {
+	os.Clearenv()
+
+	clients := []*stubSTS{}
+	cfg, err := newTestLoader("role", &clients).Config()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "ap-southeast-2", *cfg.Region)
+
+	v, err := cfg.Credentials.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "devAccessKey:arn:aws:iam::123456789012:role/role", v.AccessKeyID)
+	assert.Equal(t, "assumedSessionToken", v.SessionToken)
+
+	assert.Equal(t, 1, len(clients))
+	assert.Equal(t, "ap-southeast-2", clients[0].region)
+	assert.Equal(t, "externalID", *clients[0].input.ExternalID)
+	assert.Equal(t, "session", *clients[0].input.RoleSessionName)
+}
+
+func TestConfigAssumeRoleChained(t *testing.T) {
+	os.Clearenv()
+
+	clients := []*stubSTS{}
+	cfg, err := newTestLoader("chained", &clients).Config()
+	assert.Nil(t, err, "Expect no error")
+
+	v, err := cfg.Credentials.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "devAccessKey:arn:aws:iam::123456789012:role/role:arn:aws:iam::123456789012:role/chained", v.AccessKeyID)
+	assert.Equal(t, 2, len(clients))
+}
+
+func TestConfigAssumeRoleSelfSource(t *testing.T) {
+	os.Clearenv()
+
+	clients := []*stubSTS{}
+	cfg, err := newTestLoader("self", &clients).Config()
+	assert.Nil(t, err, "Expect no error")
+
+	v, err := cfg.Credentials.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "selfAccessKey:arn:aws:iam::123456789012:role/self", v.AccessKeyID)
+}
+
+func TestConfigCredentialProcess(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PATH", "/usr/local/bin:/usr/bin:/bin")
+
+	cfg, err := newTestLoader("process", nil).Config()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "us-west-1", *cfg.Region)
+
+	v, err := cfg.Credentials.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "processAccessKey", v.AccessKeyID)
+	assert.Equal(t, "processSecret", v.SecretAccessKey)
+}
+
+func TestConfigAssumeRoleCredentialProcessSource(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PATH", "/usr/local/bin:/usr/bin:/bin")
+
+	clients := []*stubSTS{}
+	cfg, err := newTestLoader("process_role", &clients).Config()
+	assert.Nil(t, err, "Expect no error")
+
+	v, err := cfg.Credentials.Get()
+	assert.Nil(t, err, "Expect no error")
+	assert.Equal(t, "processAccessKey:arn:aws:iam::123456789012:role/process_role", v.AccessKeyID)
+}
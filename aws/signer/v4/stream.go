@@ -0,0 +1,138 @@
+package v4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// StreamingPayload is the X-Amz-Content-Sha256 value of requests whose
+	// body is sent as "aws-chunked" encoded chunks, each signed separately.
+	StreamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+	// DefaultChunkSize is the size of the chunks a streamed body is split
+	// into, if the Signer's ChunkSize is not set.
+	DefaultChunkSize = 64 * 1024
+
+	chunkAlgorithm       = "AWS4-HMAC-SHA256-PAYLOAD"
+	chunkSignaturePrefix = ";chunk-signature="
+)
+
+var emptyStringSHA256 = hex.EncodeToString(makeSha256([]byte{}))
+
+// SignStreaming signs the request to upload body as a stream of "aws-chunked"
+// encoded chunks, where each chunk is signed as it is read. This allows a
+// body which cannot be seeked, or held in memory, to be sent without reading
+// it twice. Only services which support chunked uploads, such as Amazon S3,
+// accept these requests.
+//
+// decodedLength must be the length of the body. The request's Body is
+// replaced with the encoded chunks, and its Content-Length is set to their
+// length. The headers which were signed are returned.
+//
+// Example:
+//     signer := v4.NewSigner(creds)
+//     req, _ := http.NewRequest("PUT", "https://bucket.s3.amazonaws.com/key", nil)
+//     _, err := signer.SignStreaming(req, conn, length, "s3", "us-east-1", time.Now())
+func (v4 Signer) SignStreaming(r *http.Request, body io.Reader, decodedLength int64, service, region string, signTime time.Time) (http.Header, error) {
+	chunkSize := v4.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	r.Header.Set("X-Amz-Content-Sha256", StreamingPayload)
+	r.Header.Set("X-Amz-Decoded-Content-Length", strconv.FormatInt(decodedLength, 10))
+	if enc := r.Header.Get("Content-Encoding"); enc == "" {
+		r.Header.Set("Content-Encoding", "aws-chunked")
+	} else if !strings.HasPrefix(enc, "aws-chunked") {
+		r.Header.Set("Content-Encoding", "aws-chunked,"+enc)
+	}
+	r.ContentLength = StreamingContentLength(decodedLength, chunkSize)
+	r.Header.Set("Content-Length", strconv.FormatInt(r.ContentLength, 10))
+
+	ctx := v4.newSigningCtx(r, nil, service, region, 0, signTime)
+	if err := ctx.sign(); err != nil {
+		return http.Header{}, err
+	}
+
+	r.Body = ioutil.NopCloser(&chunkReader{
+		body:     body,
+		key:      ctx.signingKey(),
+		scope:    ctx.formattedTime + "\n" + ctx.credentialString,
+		prevSig:  ctx.signature,
+		chunk:    make([]byte, chunkSize),
+		encoding: &bytes.Buffer{},
+	})
+	return ctx.signedHeaderValues(), nil
+}
+
+// StreamingContentLength returns the length of a body of decodedLength
+// bytes, once it has been encoded as signed chunks of chunkSize bytes.
+func StreamingContentLength(decodedLength int64, chunkSize int) int64 {
+	size := int64(chunkSize)
+	length := (decodedLength / size) * encodedChunkLength(size)
+	if rem := decodedLength % size; rem > 0 {
+		length += encodedChunkLength(rem)
+	}
+	return length + encodedChunkLength(0)
+}
+
+// encodedChunkLength returns the length of a chunk of n bytes, including its
+// size and signature.
+func encodedChunkLength(n int64) int64 {
+	return int64(len(strconv.FormatInt(n, 16))+len(chunkSignaturePrefix)+64+2) + n + 2
+}
+
+// A chunkReader reads a body as signed "aws-chunked" chunks. Each chunk's
+// signature is chained to the signature of the chunk before it, starting with
+// the request's signature. The body ends with a signed empty chunk.
+type chunkReader struct {
+	body    io.Reader
+	key     []byte
+	scope   string
+	prevSig string
+
+	chunk    []byte
+	encoding *bytes.Buffer
+	done     bool
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for c.encoding.Len() == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(c.body, c.chunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		c.writeChunk(c.chunk[:n])
+		c.done = n == 0
+	}
+
+	return c.encoding.Read(p)
+}
+
+// writeChunk signs and encodes the chunk of data.
+func (c *chunkReader) writeChunk(data []byte) {
+	stringToSign := strings.Join([]string{
+		chunkAlgorithm,
+		c.scope,
+		c.prevSig,
+		emptyStringSHA256,
+		hex.EncodeToString(makeSha256(data)),
+	}, "\n")
+	c.prevSig = hex.EncodeToString(makeHmac(c.key, []byte(stringToSign)))
+
+	c.encoding.WriteString(strconv.FormatInt(int64(len(data)), 16))
+	c.encoding.WriteString(chunkSignaturePrefix + c.prevSig + "\r\n")
+	c.encoding.Write(data)
+	c.encoding.WriteString("\r\n")
+}
@@ -0,0 +1,76 @@
+package v4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamingContentLength(t *testing.T) {
+	assert.Equal(t, int64(66824), StreamingContentLength(66560, 64*1024))
+	assert.Equal(t, int64(86), StreamingContentLength(0, 64*1024))
+	assert.Equal(t, int64(2*(1+17+64+2+8+2)+86), StreamingContentLength(16, 8))
+}
+
+// Example from the Amazon S3 documentation of chunked uploads with Signature
+// Version 4.
+func TestChunkReaderSignatures(t *testing.T) {
+	ctx := &signingCtx{
+		CredValues:         credentials.Value{SecretAccessKey: "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"},
+		Region:             "us-east-1",
+		ServiceName:        "s3",
+		formattedShortTime: "20130524",
+	}
+	r := &chunkReader{
+		body:     bytes.NewReader(bytes.Repeat([]byte("a"), 66560)),
+		key:      ctx.signingKey(),
+		scope:    "20130524T000000Z\n20130524/us-east-1/s3/aws4_request",
+		prevSig:  "4f232c4386841ef735655705268965c44a0e4690baa4adea153f7db9fa80a0a9",
+		chunk:    make([]byte, 64*1024),
+		encoding: &bytes.Buffer{},
+	}
+
+	b, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, 66824, len(b))
+
+	body := string(b)
+	assert.True(t, strings.HasPrefix(body, "10000;chunk-signature=ad80c730a21e5b8d04586a2213dd63b9a0e99e0e2307b0ade35a65485a288648\r\n"))
+	assert.Contains(t, body, "\r\n400;chunk-signature=0055627c9e194cb4542bae2aa5492e3c1575bbb81b612b7d234b86a503ef5497\r\n")
+	assert.True(t, strings.HasSuffix(body, "\r\n0;chunk-signature=b6c6ea8a5354eaf15b3cb7646744f4275b71ea724fed81ceb9323e279d449df9\r\n\r\n"))
+}
+
+func TestSignStreaming(t *testing.T) {
+	req, _ := http.NewRequest("PUT", "https://bucket.s3.amazonaws.com/key", nil)
+	req.Header.Set("Content-Encoding", "gzip")
+
+	signer := NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"))
+	signer.ChunkSize = 4
+	body := strings.NewReader("hello world")
+	signed, err := signer.SignStreaming(req, body, int64(body.Len()), "s3", "us-east-1", time.Unix(0, 0))
+	assert.NoError(t, err)
+
+	assert.Equal(t, StreamingPayload, req.Header.Get("X-Amz-Content-Sha256"))
+	assert.Equal(t, "11", req.Header.Get("X-Amz-Decoded-Content-Length"))
+	assert.Equal(t, "aws-chunked,gzip", req.Header.Get("Content-Encoding"))
+	assert.Contains(t, req.Header.Get("Authorization"),
+		"SignedHeaders=content-encoding;host;x-amz-content-sha256;x-amz-date;x-amz-decoded-content-length;x-amz-security-token")
+	assert.Equal(t, []string{StreamingPayload}, signed["X-Amz-Content-Sha256"])
+
+	b, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, req.ContentLength, int64(len(b)))
+
+	chunks := strings.Split(strings.TrimSuffix(string(b), "\r\n"), "\r\n")
+	assert.Equal(t, []string{"hell", "o wo", "rld"}, []string{chunks[1], chunks[3], chunks[5]})
+	assert.True(t, strings.HasPrefix(chunks[6], "0;chunk-signature="))
+	_, err = hex.DecodeString(strings.TrimPrefix(chunks[6], "0;chunk-signature="))
+	assert.NoError(t, err)
+}
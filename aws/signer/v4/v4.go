@@ -0,0 +1,383 @@
+// Package v4 implements signing for AWS V4 signer
+//
+// The Signer can be used to sign any http.Request sent to an AWS service, or
+// a service authenticated with AWS credentials, such as an Amazon
+// Elasticsearch Service domain or API Gateway API.
+package v4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/protocol/rest"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	authHeaderPrefix = "AWS4-HMAC-SHA256"
+	timeFormat       = "20060102T150405Z"
+	shortTimeFormat  = "20060102"
+)
+
+var ignoredHeaders = map[string]bool{
+	"Authorization":  true,
+	"Content-Length": true,
+	"User-Agent":     true,
+}
+
+// Signer applies AWS Signature Version 4 signing to http.Requests.
+//
+// Example:
+//     signer := v4.NewSigner(credentials.NewEnvCredentials())
+//
+//     body := strings.NewReader(`{"query":{"match_all":{}}}`)
+//     req, _ := http.NewRequest("POST", endpoint+"/index/_search", body)
+//     _, err := signer.Sign(req, body, "es", "us-west-2", time.Now())
+type Signer struct {
+	// The credentials requests are signed with.
+	Credentials *credentials.Credentials
+
+	// Debug and Logger log the canonical string and string to sign of
+	// signed requests, when Debug enables aws.LogSigning.
+	Debug  aws.LogLevelType
+	Logger aws.Logger
+
+	// ChunkSize is the size of the chunks of bodies signed with
+	// SignStreaming. If zero DefaultChunkSize is used.
+	ChunkSize int
+}
+
+// NewSigner returns a Signer which signs requests with the credentials.
+func NewSigner(credentials *credentials.Credentials) *Signer {
+	return &Signer{Credentials: credentials}
+}
+
+// Sign signs the request by adding an Authorization header, and the other
+// headers used to sign it. The body is read to compute the request's
+// payload hash, unless the X-Amz-Content-Sha256 header is already set, and
+// then seeked back to its original position. A nil body is treated as an
+// empty payload.
+//
+// The headers which were signed are returned, and must be sent with the
+// request unmodified.
+func (v4 Signer) Sign(r *http.Request, body io.ReadSeeker, service, region string, signTime time.Time) (http.Header, error) {
+	return v4.signWithBody(r, body, service, region, 0, signTime)
+}
+
+// Presign signs the request by adding the signature to the request's URL
+// query, so that the URL can be used without the credentials until it
+// expires after exp. The request's headers are signed, and are not moved
+// into the query.
+//
+// The headers which were signed are returned, and must be sent with the
+// presigned URL for the signature to be valid.
+func (v4 Signer) Presign(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) (http.Header, error) {
+	return v4.signWithBody(r, body, service, region, exp, signTime)
+}
+
+func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) (http.Header, error) {
+	ctx := v4.newSigningCtx(r, body, service, region, exp, signTime)
+	if err := ctx.sign(); err != nil {
+		return http.Header{}, err
+	}
+	return ctx.signedHeaderValues(), nil
+}
+
+func (v4 Signer) newSigningCtx(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) *signingCtx {
+	return &signingCtx{
+		Request:     r,
+		Time:        signTime,
+		ExpireTime:  exp,
+		Query:       r.URL.Query(),
+		Body:        body,
+		ServiceName: service,
+		Region:      region,
+		Credentials: v4.Credentials,
+		Debug:       v4.Debug,
+		Logger:      v4.Logger,
+	}
+}
+
+type signingCtx struct {
+	Request     *http.Request
+	Time        time.Time
+	ExpireTime  time.Duration
+	ServiceName string
+	Region      string
+	CredValues  credentials.Value
+	Credentials *credentials.Credentials
+	Query       url.Values
+	Body        io.ReadSeeker
+	Debug       aws.LogLevelType
+	Logger      aws.Logger
+
+	isPresign          bool
+	formattedTime      string
+	formattedShortTime string
+
+	signedHeaders    string
+	canonicalHeaders string
+	canonicalString  string
+	credentialString string
+	stringToSign     string
+	signature        string
+	authorization    string
+}
+
+func (v4 *signingCtx) sign() error {
+	if v4.ExpireTime != 0 {
+		v4.isPresign = true
+	}
+
+	if v4.isPresign && v4.Query.Get("X-Amz-Signature") != "" {
+		// A previous signature of the request is invalid, and must be
+		// removed before the request is signed again.
+		v4.removePresign()
+		// Update the request's query string to ensure the values stays in
+		// sync in the case retrieving the new credentials fails.
+		v4.Request.URL.RawQuery = v4.Query.Encode()
+	}
+
+	var err error
+	v4.CredValues, err = v4.Credentials.Get()
+	if err != nil {
+		return err
+	}
+
+	if v4.isPresign {
+		v4.Query.Set("X-Amz-Algorithm", authHeaderPrefix)
+		if v4.CredValues.SessionToken != "" {
+			v4.Query.Set("X-Amz-Security-Token", v4.CredValues.SessionToken)
+		} else {
+			v4.Query.Del("X-Amz-Security-Token")
+		}
+	} else if v4.CredValues.SessionToken != "" {
+		v4.Request.Header.Set("X-Amz-Security-Token", v4.CredValues.SessionToken)
+	}
+
+	v4.build()
+
+	if v4.Debug.Matches(aws.LogSigning) && v4.Logger != nil {
+		v4.logSigningInfo()
+	}
+
+	return nil
+}
+
+const logSignInfoMsg = `DEBUG: Request Signature:
+---[ CANONICAL STRING  ]-----------------------------
+%s
+---[ STRING TO SIGN ]--------------------------------
+%s%s
+-----------------------------------------------------`
+const logSignedURLMsg = `
+---[ SIGNED URL ]------------------------------------
+%s`
+
+func (v4 *signingCtx) logSigningInfo() {
+	signedURLMsg := ""
+	if v4.isPresign {
+		signedURLMsg = fmt.Sprintf(logSignedURLMsg, v4.Request.URL.String())
+	}
+	msg := fmt.Sprintf(logSignInfoMsg, v4.canonicalString, v4.stringToSign, signedURLMsg)
+	v4.Logger.Log(msg)
+}
+
+func (v4 *signingCtx) build() {
+
+	v4.buildTime()             // no depends
+	v4.buildCredentialString() // no depends
+	v4.buildCanonicalHeaders() // depends on cred string
+	v4.buildCanonicalString()  // depends on canon headers / signed headers
+	v4.buildStringToSign()     // depends on canon string
+	v4.buildSignature()        // depends on string to sign
+
+	if v4.isPresign {
+		v4.Request.URL.RawQuery += "&X-Amz-Signature=" + v4.signature
+	} else {
+		parts := []string{
+			authHeaderPrefix + " Credential=" + v4.CredValues.AccessKeyID + "/" + v4.credentialString,
+			"SignedHeaders=" + v4.signedHeaders,
+			"Signature=" + v4.signature,
+		}
+		v4.Request.Header.Set("Authorization", strings.Join(parts, ", "))
+	}
+}
+
+func (v4 *signingCtx) buildTime() {
+	v4.formattedTime = v4.Time.UTC().Format(timeFormat)
+	v4.formattedShortTime = v4.Time.UTC().Format(shortTimeFormat)
+
+	if v4.isPresign {
+		duration := int64(v4.ExpireTime / time.Second)
+		v4.Query.Set("X-Amz-Date", v4.formattedTime)
+		v4.Query.Set("X-Amz-Expires", strconv.FormatInt(duration, 10))
+	} else {
+		v4.Request.Header.Set("X-Amz-Date", v4.formattedTime)
+	}
+}
+
+func (v4 *signingCtx) buildCredentialString() {
+	v4.credentialString = strings.Join([]string{
+		v4.formattedShortTime,
+		v4.Region,
+		v4.ServiceName,
+		"aws4_request",
+	}, "/")
+
+	if v4.isPresign {
+		v4.Query.Set("X-Amz-Credential", v4.CredValues.AccessKeyID+"/"+v4.credentialString)
+	}
+}
+
+func (v4 *signingCtx) buildCanonicalHeaders() {
+	var headers []string
+	headers = append(headers, "host")
+	for k := range v4.Request.Header {
+		if _, ok := ignoredHeaders[http.CanonicalHeaderKey(k)]; ok {
+			continue // ignored header
+		}
+		headers = append(headers, strings.ToLower(k))
+	}
+	sort.Strings(headers)
+
+	v4.signedHeaders = strings.Join(headers, ";")
+
+	if v4.isPresign {
+		v4.Query.Set("X-Amz-SignedHeaders", v4.signedHeaders)
+	}
+
+	headerValues := make([]string, len(headers))
+	for i, k := range headers {
+		if k == "host" {
+			headerValues[i] = "host:" + v4.Request.URL.Host
+		} else {
+			headerValues[i] = k + ":" +
+				strings.Join(v4.Request.Header[http.CanonicalHeaderKey(k)], ",")
+		}
+	}
+
+	v4.canonicalHeaders = strings.Join(headerValues, "\n")
+}
+
+func (v4 *signingCtx) buildCanonicalString() {
+	v4.Request.URL.RawQuery = strings.Replace(v4.Query.Encode(), "+", "%20", -1)
+	uri := v4.Request.URL.Opaque
+	if uri != "" {
+		uri = "/" + strings.Join(strings.Split(uri, "/")[3:], "/")
+	} else {
+		uri = v4.Request.URL.Path
+	}
+	if uri == "" {
+		uri = "/"
+	}
+
+	if v4.ServiceName != "s3" {
+		uri = rest.EscapePath(uri, false)
+	}
+
+	v4.canonicalString = strings.Join([]string{
+		v4.Request.Method,
+		uri,
+		v4.Request.URL.RawQuery,
+		v4.canonicalHeaders + "\n",
+		v4.signedHeaders,
+		v4.bodyDigest(),
+	}, "\n")
+}
+
+func (v4 *signingCtx) buildStringToSign() {
+	v4.stringToSign = strings.Join([]string{
+		authHeaderPrefix,
+		v4.formattedTime,
+		v4.credentialString,
+		hex.EncodeToString(makeSha256([]byte(v4.canonicalString))),
+	}, "\n")
+}
+
+func (v4 *signingCtx) buildSignature() {
+	signature := makeHmac(v4.signingKey(), []byte(v4.stringToSign))
+	v4.signature = hex.EncodeToString(signature)
+}
+
+// signingKey returns the key derived from the secret access key for the
+// request's date, region and service.
+func (v4 *signingCtx) signingKey() []byte {
+	secret := v4.CredValues.SecretAccessKey
+	date := makeHmac([]byte("AWS4"+secret), []byte(v4.formattedShortTime))
+	region := makeHmac(date, []byte(v4.Region))
+	service := makeHmac(region, []byte(v4.ServiceName))
+	return makeHmac(service, []byte("aws4_request"))
+}
+
+func (v4 *signingCtx) bodyDigest() string {
+	hash := v4.Request.Header.Get("X-Amz-Content-Sha256")
+	if hash == "" {
+		if v4.isPresign && v4.ServiceName == "s3" {
+			hash = "UNSIGNED-PAYLOAD"
+		} else if v4.Body == nil {
+			hash = hex.EncodeToString(makeSha256([]byte{}))
+		} else {
+			hash = hex.EncodeToString(makeSha256Reader(v4.Body))
+		}
+		v4.Request.Header.Add("X-Amz-Content-Sha256", hash)
+	}
+	return hash
+}
+
+// signedHeaderValues returns the values of the headers which were signed,
+// excluding the host header which is sent as part of the URL.
+func (v4 *signingCtx) signedHeaderValues() http.Header {
+	h := http.Header{}
+	for _, k := range strings.Split(v4.signedHeaders, ";") {
+		if k == "host" {
+			continue
+		}
+		k = http.CanonicalHeaderKey(k)
+		h[k] = append([]string(nil), v4.Request.Header[k]...)
+	}
+	return h
+}
+
+// unsign removes signing flags for both signed and presigned requests.
+func (v4 *signingCtx) removePresign() {
+	v4.Query.Del("X-Amz-Algorithm")
+	v4.Query.Del("X-Amz-Signature")
+	v4.Query.Del("X-Amz-Security-Token")
+	v4.Query.Del("X-Amz-Date")
+	v4.Query.Del("X-Amz-Expires")
+	v4.Query.Del("X-Amz-Credential")
+	v4.Query.Del("X-Amz-SignedHeaders")
+}
+
+func makeHmac(key []byte, data []byte) []byte {
+	hash := hmac.New(sha256.New, key)
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+func makeSha256(data []byte) []byte {
+	hash := sha256.New()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+func makeSha256Reader(reader io.ReadSeeker) []byte {
+	hash := sha256.New()
+	start, _ := reader.Seek(0, 1)
+	defer reader.Seek(start, 0)
+
+	io.Copy(hash, reader)
+	return hash.Sum(nil)
+}
@@ -0,0 +1,192 @@
+package v4
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildSigner(serviceName string, region string, signTime time.Time, expireTime time.Duration, body string) signingCtx {
+	endpoint := "https://" + serviceName + "." + region + ".amazonaws.com"
+	reader := strings.NewReader(body)
+	req, _ := http.NewRequest("POST", endpoint, reader)
+	req.URL.Opaque = "//example.org/bucket/key-._~,!@#$%^&*()"
+	req.Header.Add("X-Amz-Target", "prefix.Operation")
+	req.Header.Add("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Add("Content-Length", strconv.Itoa(len(body)))
+	req.Header.Add("X-Amz-Meta-Other-Header", "some-value=!@#$%^&* (+)")
+
+	return signingCtx{
+		Request:     req,
+		Time:        signTime,
+		ExpireTime:  expireTime,
+		Query:       req.URL.Query(),
+		Body:        reader,
+		ServiceName: serviceName,
+		Region:      region,
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+	}
+}
+
+func removeWS(text string) string {
+	text = strings.Replace(text, " ", "", -1)
+	text = strings.Replace(text, "\n", "", -1)
+	text = strings.Replace(text, "\t", "", -1)
+	return text
+}
+
+func assertEqual(t *testing.T, expected, given string) {
+	if removeWS(expected) != removeWS(given) {
+		t.Errorf("\nExpected: %s\nGiven:    %s", expected, given)
+	}
+}
+
+func TestPresignRequest(t *testing.T) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Unix(0, 0), 300*time.Second, "{}")
+	signer.sign()
+
+	expectedDate := "19700101T000000Z"
+	expectedHeaders := "content-type;host;x-amz-meta-other-header;x-amz-target"
+	expectedSig := "e331dff83a0cd01dae67d4c48f8bc76ed2bf7092cb960e739a70f1fa196062c0"
+	expectedCred := "AKID/19700101/us-east-1/dynamodb/aws4_request"
+
+	q := signer.Request.URL.Query()
+	assert.Equal(t, expectedSig, q.Get("X-Amz-Signature"))
+	assert.Equal(t, expectedCred, q.Get("X-Amz-Credential"))
+	assert.Equal(t, expectedHeaders, q.Get("X-Amz-SignedHeaders"))
+	assert.Equal(t, expectedDate, q.Get("X-Amz-Date"))
+}
+
+func TestSignRequest(t *testing.T) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Unix(0, 0), 0, "{}")
+	signer.sign()
+
+	expectedDate := "19700101T000000Z"
+	expectedSig := "AWS4-HMAC-SHA256 Credential=AKID/19700101/us-east-1/dynamodb/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-meta-other-header;x-amz-security-token;x-amz-target, Signature=5d3983fb3de907bdc2f3a6951d968e510f0252a8358c038f7680aa02374eeb67"
+
+	q := signer.Request.Header
+	assert.Equal(t, expectedSig, q.Get("Authorization"))
+	assert.Equal(t, expectedDate, q.Get("X-Amz-Date"))
+}
+
+func TestSignEmptyBody(t *testing.T) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 0, "")
+	signer.Body = nil
+	signer.sign()
+	hash := signer.Request.Header.Get("X-Amz-Content-Sha256")
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", hash)
+}
+
+func TestSignBody(t *testing.T) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 0, "hello")
+	signer.sign()
+	hash := signer.Request.Header.Get("X-Amz-Content-Sha256")
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hash)
+}
+
+func TestSignSeekedBody(t *testing.T) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 0, "   hello")
+	signer.Body.Read(make([]byte, 3)) // consume first 3 bytes so body is now "hello"
+	signer.sign()
+	hash := signer.Request.Header.Get("X-Amz-Content-Sha256")
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hash)
+
+	start, _ := signer.Body.Seek(0, 1)
+	assert.Equal(t, int64(3), start)
+}
+
+func TestPresignEmptyBodyS3(t *testing.T) {
+	signer := buildSigner("s3", "us-east-1", time.Now(), 5*time.Minute, "hello")
+	signer.sign()
+	hash := signer.Request.Header.Get("X-Amz-Content-Sha256")
+	assert.Equal(t, "UNSIGNED-PAYLOAD", hash)
+}
+
+func TestSignPrecomputedBodyChecksum(t *testing.T) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 0, "hello")
+	signer.Request.Header.Set("X-Amz-Content-Sha256", "PRECOMPUTED")
+	signer.sign()
+	hash := signer.Request.Header.Get("X-Amz-Content-Sha256")
+	assert.Equal(t, "PRECOMPUTED", hash)
+}
+
+func TestSignerSign(t *testing.T) {
+	body := strings.NewReader("{}")
+	req, _ := http.NewRequest("POST", "https://dynamodb.us-east-1.amazonaws.com", body)
+	req.Header.Set("X-Amz-Target", "prefix.Operation")
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+
+	signer := NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"))
+	signed, err := signer.Sign(req, body, "dynamodb", "us-east-1", time.Unix(0, 0))
+	assert.NoError(t, err)
+
+	assert.Contains(t, req.Header.Get("Authorization"), "Credential=AKID/19700101/us-east-1/dynamodb/aws4_request")
+	assert.Equal(t, "19700101T000000Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "SESSION", req.Header.Get("X-Amz-Security-Token"))
+
+	assert.Equal(t, []string{"prefix.Operation"}, signed["X-Amz-Target"])
+	assert.Equal(t, []string{"19700101T000000Z"}, signed["X-Amz-Date"])
+	assert.Empty(t, signed.Get("Authorization"))
+	assert.Equal(t, []string{"application/x-amz-json-1.0"}, signed["Content-Type"])
+	assert.Empty(t, signed.Get("Host"))
+
+	start, _ := body.Seek(0, 1)
+	assert.Equal(t, int64(0), start)
+}
+
+func TestSignerPresign(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://bucket.s3.amazonaws.com/key", nil)
+	req.Header.Set("X-Amz-Meta-Other-Header", "value")
+	req.Header.Set("Content-Md5", "md5")
+
+	signer := NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", ""))
+	signed, err := signer.Presign(req, nil, "s3", "us-east-1", 5*time.Minute, time.Unix(0, 0))
+	assert.NoError(t, err)
+
+	q := req.URL.Query()
+	assert.NotEmpty(t, q.Get("X-Amz-Signature"))
+	assert.Equal(t, "300", q.Get("X-Amz-Expires"))
+	assert.Equal(t, "content-md5;host;x-amz-meta-other-header", q.Get("X-Amz-SignedHeaders"))
+	assert.Empty(t, q.Get("Content-Md5"), "Expect headers to not be moved into the query")
+	assert.Empty(t, q.Get("X-Amz-Security-Token"))
+	assert.Empty(t, req.Header.Get("Authorization"))
+
+	assert.Equal(t, http.Header{
+		"Content-Md5":             []string{"md5"},
+		"X-Amz-Meta-Other-Header": []string{"value"},
+	}, signed)
+}
+
+func TestSignerPresignResign(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://bucket.s3.amazonaws.com/key", nil)
+	signer := NewSigner(credentials.NewStaticCredentials("AKID", "SECRET", ""))
+
+	_, err := signer.Presign(req, nil, "s3", "us-east-1", 5*time.Minute, time.Unix(0, 0))
+	assert.NoError(t, err)
+	sig := req.URL.Query().Get("X-Amz-Signature")
+
+	_, err = signer.Presign(req, nil, "s3", "us-east-1", 5*time.Minute, time.Unix(60, 0))
+	assert.NoError(t, err)
+	q := req.URL.Query()
+	assert.Len(t, q["X-Amz-Signature"], 1)
+	assert.NotEqual(t, sig, q.Get("X-Amz-Signature"))
+}
+
+func BenchmarkPresignRequest(b *testing.B) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 300*time.Second, "{}")
+	for i := 0; i < b.N; i++ {
+		signer.sign()
+	}
+}
+
+func BenchmarkSignRequest(b *testing.B) {
+	signer := buildSigner("dynamodb", "us-east-1", time.Now(), 0, "{}")
+	for i := 0; i < b.N; i++ {
+		signer.sign()
+	}
+}
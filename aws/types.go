@@ -16,6 +16,11 @@ func Boolean(v bool) *bool {
 	return &v
 }
 
+// Int converts a Go int into an int pointer.
+func Int(v int) *int {
+	return &v
+}
+
 // Long converts a Go int64 into a long pointer.
 func Long(v int64) *int64 {
 	return &v
@@ -31,6 +36,30 @@ func Time(t time.Time) *time.Time {
 	return &t
 }
 
+// StringValue returns the value of the string pointer, or "" if it is nil.
+func StringValue(v *string) string {
+	if v != nil {
+		return *v
+	}
+	return ""
+}
+
+// BooleanValue returns the value of the boolean pointer, or false if it is nil.
+func BooleanValue(v *bool) bool {
+	if v != nil {
+		return *v
+	}
+	return false
+}
+
+// IntValue returns the value of the int pointer, or 0 if it is nil.
+func IntValue(v *int) int {
+	if v != nil {
+		return *v
+	}
+	return 0
+}
+
 // ReadSeekCloser wraps a io.Reader returning a ReaderSeakerCloser
 func ReadSeekCloser(r io.Reader) ReaderSeekerCloser {
 	return ReaderSeekerCloser{r}
@@ -70,6 +99,12 @@ func (r ReaderSeekerCloser) Seek(offset int64, whence int) (int64, error) {
 	return int64(0), nil
 }
 
+// IsSeeker returns if the underlying reader is also a seeker.
+func (r ReaderSeekerCloser) IsSeeker() bool {
+	_, ok := r.r.(io.Seeker)
+	return ok
+}
+
 // Close closes the ReaderSeekerCloser.
 //
 // If the ReaderSeekerCloser is not an io.Closer nothing will be done.
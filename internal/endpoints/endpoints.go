@@ -8,7 +8,12 @@ import "strings"
 
 // EndpointForRegion returns an endpoint and its signing region for a service and region.
 // if the service and region pair are not found endpoint and signingRegion will be empty.
+//
+// The endpoint is resolved from the partition the region belongs to, so regions
+// which are not known yet still resolve to their partition's endpoints.
 func EndpointForRegion(svcName, region string) (endpoint, signingRegion string) {
+	p := partitionForRegion(region)
+
 	derivedKeys := []string{
 		region + "/" + svcName,
 		region + "/*",
@@ -17,10 +22,11 @@ func EndpointForRegion(svcName, region string) (endpoint, signingRegion string)
 	}
 
 	for _, key := range derivedKeys {
-		if val, ok := endpointsMap.Endpoints[key]; ok {
+		if val, ok := p.Endpoints[key]; ok {
 			ep := val.Endpoint
 			ep = strings.Replace(ep, "{region}", region, -1)
 			ep = strings.Replace(ep, "{service}", svcName, -1)
+			ep = strings.Replace(ep, "{dnsSuffix}", p.DNSSuffix, -1)
 
 			endpoint = ep
 			signingRegion = val.SigningRegion
@@ -29,3 +35,20 @@ func EndpointForRegion(svcName, region string) (endpoint, signingRegion string)
 	}
 	return
 }
+
+// PartitionForRegion returns the ID of the partition the region belongs to,
+// e.g. "aws", "aws-cn", or "aws-us-gov". Regions which do not match any
+// partition belong to the first, "aws", partition.
+func PartitionForRegion(region string) string {
+	return partitionForRegion(region).ID
+}
+
+// partitionForRegion returns the partition the region belongs to.
+func partitionForRegion(region string) partition {
+	for _, p := range endpointsMap.Partitions {
+		if p.RegionRegex.MatchString(region) {
+			return p
+		}
+	}
+	return endpointsMap.Partitions[0]
+}
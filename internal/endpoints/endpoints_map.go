@@ -2,9 +2,18 @@ package endpoints
 
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
 
+import "regexp"
+
 type endpointStruct struct {
-	Version   int
-	Endpoints map[string]endpointEntry
+	Version    int
+	Partitions []partition
+}
+
+type partition struct {
+	ID          string
+	RegionRegex *regexp.Regexp
+	DNSSuffix   string
+	Endpoints   map[string]endpointEntry
 }
 
 type endpointEntry struct {
@@ -13,77 +22,98 @@ type endpointEntry struct {
 }
 
 var endpointsMap = endpointStruct{
-	Version: 2,
-	Endpoints: map[string]endpointEntry{
-		"*/*": {
-			Endpoint: "{service}.{region}.amazonaws.com",
-		},
-		"*/cloudfront": {
-			Endpoint:      "cloudfront.amazonaws.com",
-			SigningRegion: "us-east-1",
-		},
-		"*/cloudsearchdomain": {
-			Endpoint:      "",
-			SigningRegion: "us-east-1",
-		},
-		"*/iam": {
-			Endpoint:      "iam.amazonaws.com",
-			SigningRegion: "us-east-1",
-		},
-		"*/importexport": {
-			Endpoint:      "importexport.amazonaws.com",
-			SigningRegion: "us-east-1",
-		},
-		"*/route53": {
-			Endpoint:      "route53.amazonaws.com",
-			SigningRegion: "us-east-1",
-		},
-		"*/sts": {
-			Endpoint:      "sts.amazonaws.com",
-			SigningRegion: "us-east-1",
-		},
-		"ap-northeast-1/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"ap-southeast-1/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"ap-southeast-2/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"cn-north-1/*": {
-			Endpoint: "{service}.{region}.amazonaws.com.cn",
-		},
-		"eu-central-1/s3": {
-			Endpoint: "{service}.{region}.amazonaws.com",
-		},
-		"eu-west-1/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"sa-east-1/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"us-east-1/s3": {
-			Endpoint: "s3.amazonaws.com",
-		},
-		"us-east-1/sdb": {
-			Endpoint:      "sdb.amazonaws.com",
-			SigningRegion: "us-east-1",
-		},
-		"us-gov-west-1/iam": {
-			Endpoint: "iam.us-gov.amazonaws.com",
-		},
-		"us-gov-west-1/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"us-gov-west-1/sts": {
-			Endpoint: "sts.us-gov-west-1.amazonaws.com",
-		},
-		"us-west-1/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
-		},
-		"us-west-2/s3": {
-			Endpoint: "s3-{region}.amazonaws.com",
+	Version: 3,
+	Partitions: []partition{
+		{
+			ID:          "aws",
+			RegionRegex: regexp.MustCompile("^(us|eu|ap|sa)\\-\\w+\\-\\d+$"),
+			DNSSuffix:   "amazonaws.com",
+			Endpoints: map[string]endpointEntry{
+				"*/*": {
+					Endpoint: "{service}.{region}.{dnsSuffix}",
+				},
+				"*/cloudfront": {
+					Endpoint:      "cloudfront.amazonaws.com",
+					SigningRegion: "us-east-1",
+				},
+				"*/cloudsearchdomain": {
+					Endpoint:      "",
+					SigningRegion: "us-east-1",
+				},
+				"*/iam": {
+					Endpoint:      "iam.amazonaws.com",
+					SigningRegion: "us-east-1",
+				},
+				"*/importexport": {
+					Endpoint:      "importexport.amazonaws.com",
+					SigningRegion: "us-east-1",
+				},
+				"*/route53": {
+					Endpoint:      "route53.amazonaws.com",
+					SigningRegion: "us-east-1",
+				},
+				"*/sts": {
+					Endpoint:      "sts.amazonaws.com",
+					SigningRegion: "us-east-1",
+				},
+				"ap-northeast-1/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+				"ap-southeast-1/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+				"ap-southeast-2/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+				"eu-central-1/s3": {
+					Endpoint: "{service}.{region}.amazonaws.com",
+				},
+				"eu-west-1/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+				"sa-east-1/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+				"us-east-1/s3": {
+					Endpoint: "s3.amazonaws.com",
+				},
+				"us-east-1/sdb": {
+					Endpoint:      "sdb.amazonaws.com",
+					SigningRegion: "us-east-1",
+				},
+				"us-west-1/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+				"us-west-2/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+			},
+		},
+		{
+			ID:          "aws-cn",
+			RegionRegex: regexp.MustCompile("^cn\\-\\w+\\-\\d+$"),
+			DNSSuffix:   "amazonaws.com.cn",
+			Endpoints: map[string]endpointEntry{
+				"*/*": {
+					Endpoint: "{service}.{region}.{dnsSuffix}",
+				},
+			},
+		},
+		{
+			ID:          "aws-us-gov",
+			RegionRegex: regexp.MustCompile("^us\\-gov\\-\\w+\\-\\d+$"),
+			DNSSuffix:   "amazonaws.com",
+			Endpoints: map[string]endpointEntry{
+				"*/*": {
+					Endpoint: "{service}.{region}.{dnsSuffix}",
+				},
+				"*/iam": {
+					Endpoint: "iam.us-gov.amazonaws.com",
+				},
+				"*/s3": {
+					Endpoint: "s3-{region}.amazonaws.com",
+				},
+			},
 		},
 	},
 }
@@ -26,3 +26,41 @@ func TestServicesInCN(t *testing.T) {
 		assert.Equal(t, name+"."+region+".amazonaws.com.cn", ep)
 	}
 }
+
+func TestServicesInGovCloud(t *testing.T) {
+	region := "us-gov-west-1"
+
+	ep, sr := EndpointForRegion("iam", region)
+	assert.Equal(t, "iam.us-gov.amazonaws.com", ep)
+	assert.Equal(t, "", sr)
+
+	for _, name := range []string{"sts", "route53", "ec2"} {
+		ep, sr := EndpointForRegion(name, region)
+		assert.Equal(t, name+"."+region+".amazonaws.com", ep)
+		assert.Equal(t, "", sr)
+	}
+
+	ep, _ = EndpointForRegion("s3", region)
+	assert.Equal(t, "s3-us-gov-west-1.amazonaws.com", ep)
+}
+
+func TestPartitionForRegion(t *testing.T) {
+	cases := map[string]string{
+		"us-east-1":      "aws",
+		"eu-central-1":   "aws",
+		"mock-region":    "aws",
+		"cn-north-1":     "aws-cn",
+		"cn-northwest-1": "aws-cn",
+		"us-gov-west-1":  "aws-us-gov",
+		"us-gov-east-1":  "aws-us-gov",
+	}
+
+	for region, id := range cases {
+		assert.Equal(t, id, PartitionForRegion(region), region)
+	}
+}
+
+func TestUnknownRegionInPartition(t *testing.T) {
+	ep, _ := EndpointForRegion("sqs", "cn-northwest-1")
+	assert.Equal(t, "sqs.cn-northwest-1.amazonaws.com.cn", ep)
+}
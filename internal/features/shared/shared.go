@@ -26,11 +26,10 @@ const Imported = true
 
 func init() {
 	if os.Getenv("DEBUG") != "" {
-		aws.DefaultConfig.LogLevel = 1
+		aws.DefaultConfig.LogLevel = aws.LogLevel(aws.LogHTTPHeaders | aws.LogSigning)
 	}
 	if os.Getenv("DEBUG_BODY") != "" {
-		aws.DefaultConfig.LogLevel = 1
-		aws.DefaultConfig.LogHTTPBody = true
+		aws.DefaultConfig.LogLevel = aws.LogLevel(aws.LogDebug)
 	}
 
 	When(`^I call the "(.+?)" API$`, func(op string) {
@@ -74,6 +74,7 @@ var extraImports = []string{
 	"time",
 	"net/url",
 	"",
+	"github.com/aws/aws-sdk-go/aws/awsutil",
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil",
 	"github.com/aws/aws-sdk-go/internal/util",
 	"github.com/stretchr/testify/assert",
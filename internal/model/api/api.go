@@ -17,6 +17,7 @@ type API struct {
 	Metadata      Metadata
 	Operations    map[string]*Operation
 	Shapes        map[string]*Shape
+	Waiters       []Waiter `json:"-"`
 	Documentation string
 
 	// Disables inflection checks. Only use this when generating tests
@@ -52,6 +53,15 @@ func (a *API) PackageName() string {
 	return strings.ToLower(a.StructName())
 }
 
+// SignerPackage returns the name of the internal/signer package the API's
+// requests are signed with, selected by the API's signature version.
+func (a *API) SignerPackage() string {
+	if a.Metadata.SignatureVersion == "v2" {
+		return "v2"
+	}
+	return "v4"
+}
+
 // InterfacePackageName returns the package name for the interface.
 func (a *API) InterfacePackageName() string {
 	return a.PackageName() + "iface"
@@ -191,6 +201,11 @@ var tplAPI = template.Must(template.New("api").Parse(`
 {{ range $_, $s := .ShapeList }}
 {{ if eq $s.Type "structure" }}{{ $s.GoCode }}{{ end }}
 
+{{ end }}
+
+{{ range $_, $s := .ShapeList }}
+{{ if $s.IsEnum }}{{ $s.GoCode }}{{ end }}
+
 {{ end }}
 `))
 
@@ -234,11 +249,11 @@ func New(config *aws.Config) *{{ .StructName }} {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack({{ .ProtocolPackage }}.Build)
-	service.Handlers.Unmarshal.PushBack({{ .ProtocolPackage }}.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack({{ .ProtocolPackage }}.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack({{ .ProtocolPackage }}.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed({{ .SignerPackage }}.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed({{ .ProtocolPackage }}.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed({{ .ProtocolPackage }}.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed({{ .ProtocolPackage }}.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed({{ .ProtocolPackage }}.UnmarshalErrorHandler)
 
 	{{ if .UseInitMethods }}// Run custom service initialization if present
 	if initService != nil {
@@ -267,7 +282,7 @@ func (c *{{ .StructName }}) newRequest(op *aws.Operation, params, data interface
 // ServiceGoCode renders service go code. Returning it as a string.
 func (a *API) ServiceGoCode() string {
 	a.resetImports()
-	a.imports["github.com/aws/aws-sdk-go/internal/signer/v4"] = true
+	a.imports["github.com/aws/aws-sdk-go/internal/signer/"+a.SignerPackage()] = true
 	a.imports["github.com/aws/aws-sdk-go/internal/protocol/"+a.ProtocolPackage()] = true
 
 	var buf bytes.Buffer
@@ -308,6 +323,9 @@ type {{ .StructName }}API interface {
     {{ range $_, $o := .OperationList }}
         {{ $o.InterfaceSignature }}
     {{ end }}
+    {{ range $_, $w := .Waiters }}
+        {{ $w.InterfaceSignature }}
+    {{ end }}
 }
 `))
 
@@ -317,6 +335,7 @@ type {{ .StructName }}API interface {
 func (a *API) InterfaceGoCode() string {
 	a.resetImports()
 	a.imports = map[string]bool{
+		"github.com/aws/aws-sdk-go/aws":                        true,
 		"github.com/aws/aws-sdk-go/service/" + a.PackageName(): true,
 	}
 
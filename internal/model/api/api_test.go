@@ -40,3 +40,45 @@ func TestStructNameForExceptions(t *testing.T) {
 	}
 	assert.Equal(t, a.StructName(), "ConfigService")
 }
+
+func TestEnumNames(t *testing.T) {
+	s := &Shape{
+		ShapeName: "storageClass",
+		Type:      "string",
+		Enum:      []string{"STANDARD_IA", "ReducedRedundancy", "s3:ObjectCreated:*", "t2.micro"},
+	}
+	assert.Equal(t, "StorageClassStandardIa", s.EnumName(0))
+	assert.Equal(t, "StorageClassReducedRedundancy", s.EnumName(1))
+	assert.Equal(t, "StorageClassS3ObjectCreated", s.EnumName(2))
+	assert.Equal(t, "StorageClassT2Micro", s.EnumName(3))
+}
+
+func TestConstraintTags(t *testing.T) {
+	min, max := 1.0, 1024.0
+	s := &Shape{Type: "string", Min: &min, Max: &max, Pattern: `[\u0020-\u00FF]+`}
+	assert.Equal(t, `min:"1" max:"1024" pattern:"[\\x{0020}-\\x{00FF}]+" `, s.constraintTags())
+
+	s = &Shape{Type: "string", Pattern: `^(?!-)[a-z]+$`, Enum: []string{"a", "b"}}
+	assert.Equal(t, `enum:"a,b" `, s.constraintTags())
+}
+
+func TestS3CustomizationsCopySourcePattern(t *testing.T) {
+	a := &API{Shapes: map[string]*Shape{}}
+	a.Shapes["CopySource"] = &Shape{API: a, ShapeName: "CopySource", Type: "string", Pattern: `\/.+\/.+`}
+
+	s3Customizations(a)
+	assert.Equal(t, "", a.Shapes["CopySource"].constraintTags())
+}
+
+func TestSetupEnumsNameCollisions(t *testing.T) {
+	a := &API{Shapes: map[string]*Shape{}}
+	a.Shapes["Mode"] = &Shape{API: a, ShapeName: "Mode", Type: "string",
+		Enum: []string{"on", "ON", "off"}}
+	a.Shapes["ModeOff"] = &Shape{API: a, ShapeName: "ModeOff", Type: "structure"}
+
+	a.setupEnums()
+
+	s := a.Shapes["Mode"]
+	assert.Equal(t, []string{"on", "ON", "off"}, s.Enum, "expect enum values to be kept")
+	assert.Equal(t, []string{"ModeOn", "ModeOn2", "ModeOff2"}, s.EnumConsts)
+}
@@ -21,6 +21,12 @@ func s3Customizations(a *API) {
 		}
 	}
 
+	// S3 accepts copy sources without a leading slash, which the CopySource
+	// pattern rejects, so the pattern is not validated.
+	if s, ok := a.Shapes["CopySource"]; ok {
+		s.Pattern = ""
+	}
+
 	// Rename "Rule" to "LifecycleRule"
 	if s, ok := a.Shapes["Rule"]; ok {
 		s.Rename("LifecycleRule")
@@ -16,7 +16,7 @@ func Load(api, docs, paginators, waiters string) *API {
 	a.Attach(api)
 	a.Attach(docs)
 	a.Attach(paginators)
-	a.Attach(waiters)
+	a.AttachWaiters(waiters)
 	a.Setup()
 	return &a
 }
@@ -53,11 +53,14 @@ func (a *API) Setup() {
 	a.updateTopLevelShapeReferences()
 	a.createInputOutputShapes()
 	a.customizationPasses()
+	a.markSensitiveMembers()
 
 	if !a.NoRemoveUnusedShapes {
 		a.removeUnusedShapes()
 	}
 
+	a.setupEnums()
+
 	if len(a.unrecognizedNames) > 0 {
 		msg := []string{
 			"Unrecognized inflections for the following export names:",
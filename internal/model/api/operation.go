@@ -77,6 +77,17 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 	return out, err
 }
 
+// {{ .ExportedName }}WithContext is the same as {{ .ExportedName }} with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *{{ .API.StructName }}) {{ .ExportedName }}WithContext(` +
+	`ctx aws.Context, input {{ .InputRef.GoType }}) ({{ .OutputRef.GoType }}, error) {
+	req, out := c.{{ .ExportedName }}Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 {{ if .Paginator }}
 func (c *{{ .API.StructName }}) {{ .ExportedName }}Pages(` +
 	`input {{ .InputRef.GoType }}, fn func(p {{ .OutputRef.GoType }}, lastPage bool) (shouldContinue bool)) error {
@@ -102,6 +113,8 @@ func (o *Operation) GoCode() string {
 // tplInfSig defines the template for rendering an Operation's signature within an Interface definition.
 var tplInfSig = template.Must(template.New("opsig").Parse(`
 {{ .ExportedName }}({{ .InputRef.GoTypeWithPkgName }}) ({{ .OutputRef.GoTypeWithPkgName }}, error)
+
+{{ .ExportedName }}WithContext(aws.Context, {{ .InputRef.GoTypeWithPkgName }}) ({{ .OutputRef.GoTypeWithPkgName }}, error)
 `))
 
 // InterfaceSignature returns a string representing the Operation's interface{}
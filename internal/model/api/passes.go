@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 )
 
 // updateTopLevelShapeReferences moves resultWrapper, locationName, and
@@ -232,3 +233,52 @@ func (a *API) removeUnusedShapes() {
 		}
 	}
 }
+
+// setupEnums names the constants of each enum shape. The shape's Enum values
+// are not changed. A constant name which collides with an already named
+// constant, or shape, is made unique by appending a number to it.
+func (a *API) setupEnums() {
+	names := map[string]bool{}
+	for _, s := range a.ShapeList() {
+		if !s.IsEnum() {
+			names[s.ShapeName] = true
+		}
+	}
+
+	for _, s := range a.ShapeList() {
+		if !s.IsEnum() {
+			continue
+		}
+
+		s.EnumConsts = make([]string, len(s.Enum))
+		for i := range s.Enum {
+			n := s.EnumName(i)
+			for j := 2; names[n]; j++ {
+				n = s.EnumName(i) + strconv.Itoa(j)
+			}
+			names[n] = true
+			s.EnumConsts[i] = n
+		}
+	}
+}
+
+// sensitiveMemberNames are names of members which carry credentials or key
+// material, but are not modeled with the sensitive trait.
+var sensitiveMemberNames = map[string]struct{}{
+	"CopySourceSSECustomerKey": {},
+	"SSECustomerKey":           {},
+	"SecretAccessKey":          {},
+	"SessionToken":             {},
+}
+
+// markSensitiveMembers flags members which should not be printed as
+// sensitive in addition to those modeled with the sensitive trait.
+func (a *API) markSensitiveMembers() {
+	for _, s := range a.Shapes {
+		for n, ref := range s.MemberRefs {
+			if _, ok := sensitiveMemberNames[n]; ok {
+				ref.Sensitive = true
+			}
+		}
+	}
+}
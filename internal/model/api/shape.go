@@ -2,7 +2,9 @@ package api
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/internal/util"
@@ -22,6 +24,7 @@ type ShapeRef struct {
 	XMLAttribute  bool
 	XMLNamespace  XMLInfo
 	Payload       string
+	Sensitive     bool
 }
 
 // A XMLInfo defines URL and prefix for Shapes when rendered as XML
@@ -44,8 +47,13 @@ type Shape struct {
 	Type          string
 	Exception     bool
 	Enum          []string
+	EnumConsts    []string `json:"-"`
+	Min           *float64
+	Max           *float64
+	Pattern       string
 	Flattened     bool
 	Streaming     bool
+	Sensitive     bool
 	Location      string
 	LocationName  string
 	XMLNamespace  XMLInfo
@@ -157,6 +165,42 @@ func (ref *ShapeRef) GoTypeElem() string {
 	return ref.Shape.GoTypeElem()
 }
 
+var reJavaUnicode = regexp.MustCompile(`\\u([0-9A-Fa-f]{4})`)
+
+// GoPattern returns the shape's pattern converted to Go regexp syntax. False
+// is returned if the shape has no pattern, or the pattern cannot be expressed
+// as a Go regexp, e.g. it uses lookaround assertions.
+func (s *Shape) GoPattern() (string, bool) {
+	if s.Pattern == "" {
+		return "", false
+	}
+
+	p := reJavaUnicode.ReplaceAllString(s.Pattern, `\x{$1}`)
+	if _, err := regexp.Compile(p); err != nil {
+		return "", false
+	}
+	return p, true
+}
+
+// constraintTags returns the rendered tags for the shape's min, max, pattern,
+// and enum constraints, which are used to validate input parameters.
+func (s *Shape) constraintTags() string {
+	code := ""
+	if s.Min != nil {
+		code += `min:"` + strconv.FormatFloat(*s.Min, 'f', -1, 64) + `" `
+	}
+	if s.Max != nil {
+		code += `max:"` + strconv.FormatFloat(*s.Max, 'f', -1, 64) + `" `
+	}
+	if p, ok := s.GoPattern(); ok {
+		code += `pattern:` + strconv.Quote(p) + ` `
+	}
+	if s.IsEnum() {
+		code += `enum:"` + strings.Join(s.Enum, ",") + `" `
+	}
+	return code
+}
+
 // GoTags returns the rendered tags string for the ShapeRef
 func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 	code := "`"
@@ -208,6 +252,12 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 		code += `xmlAttribute:"true" `
 	}
 
+	code += ref.Shape.constraintTags()
+
+	if ref.Sensitive || ref.Shape.Sensitive {
+		code += `sensitive:"true" `
+	}
+
 	if isRequired {
 		code += `required:"true"`
 	}
@@ -245,8 +295,41 @@ func (s *Shape) Docstring() string {
 	return s.Documentation
 }
 
+// IsEnum returns if the shape is a string shape with a set of enumerated
+// values.
+func (s *Shape) IsEnum() bool {
+	return s.Type == "string" && len(s.Enum) > 0
+}
+
+var enumDelims = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// enumPrefix returns the exportable prefix of the shape's enum constants.
+func (s *Shape) enumPrefix() string {
+	return strings.ToUpper(s.ShapeName[0:1]) + s.ShapeName[1:]
+}
+
+// EnumName returns the exportable Go name of the Nth value in the shape's
+// Enum list, prefixed with the shape's name.
+func (s *Shape) EnumName(n int) string {
+	name := s.enumPrefix()
+	for _, part := range enumDelims.Split(s.Enum[n], -1) {
+		if part == "" {
+			continue
+		}
+		if part == strings.ToUpper(part) || part == strings.ToLower(part) {
+			part = strings.ToLower(part)
+		}
+		name += strings.ToUpper(part[0:1]) + part[1:]
+	}
+	return name
+}
+
 // GoCode returns the rendered Go code for the Shape.
 func (s *Shape) GoCode() string {
+	if s.IsEnum() {
+		return s.enumGoCode()
+	}
+
 	code := s.Docstring() + "type " + s.ShapeName + " "
 	switch s.Type {
 	case "structure":
@@ -254,6 +337,12 @@ func (s *Shape) GoCode() string {
 		for _, n := range s.MemberNames() {
 			m := s.MemberRefs[n]
 			code += m.Docstring()
+			if m.Shape.IsEnum() {
+				if code[len(code)-2:] != "\n\n" {
+					code += "//\n"
+				}
+				code += "// See the " + m.Shape.enumPrefix() + "* constants for valid values.\n"
+			}
 			if (m.Streaming || m.Shape.Streaming) && s.Payload == n {
 				rtype := "io.ReadSeeker"
 				if len(s.refs) > 1 {
@@ -275,6 +364,19 @@ func (s *Shape) GoCode() string {
 		code += "type " + metaStruct + " struct {\n"
 		code += "SDKShapeTraits bool " + ref.GoTags(true, false)
 		code += "}"
+
+		// members named String or GoString would collide with the methods
+		if s.MemberRefs["String"] == nil && s.MemberRefs["GoString"] == nil {
+			s.API.imports["github.com/aws/aws-sdk-go/aws/awsutil"] = true
+			code += "\n\n// String returns the string representation\n"
+			code += "func (s " + s.ShapeName + ") String() string {\n"
+			code += "return awsutil.StringValue(s)\n"
+			code += "}\n\n"
+			code += "// GoString returns the string representation\n"
+			code += "func (s " + s.ShapeName + ") GoString() string {\n"
+			code += "return s.String()\n"
+			code += "}"
+		}
 	default:
 		panic("Cannot generate toplevel shape for " + s.Type)
 	}
@@ -282,6 +384,18 @@ func (s *Shape) GoCode() string {
 	return util.GoFmt(code)
 }
 
+// enumGoCode returns the rendered Go code for the constants of an enum
+// shape.
+func (s *Shape) enumGoCode() string {
+	code := "const (\n"
+	for i, v := range s.Enum {
+		code += fmt.Sprintf("\t// @enum %s\n\t%s = %q\n", s.ShapeName, s.EnumConsts[i], v)
+	}
+	code += ")"
+
+	return util.GoFmt(code)
+}
+
 // IsRequired returns if member is a required field.
 func (s *Shape) IsRequired(member string) bool {
 	for _, n := range s.Required {
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/aws/aws-sdk-go/internal/util"
+)
+
+// A WaitAcceptor is an individual wait acceptor definition.
+type WaitAcceptor struct {
+	Expected interface{}
+	Matcher  string
+	State    string
+	Argument string
+}
+
+// A Waiter is an individual waiter definition.
+type Waiter struct {
+	Name          string
+	Delay         int
+	MaxAttempts   int
+	OperationName string `json:"operation"`
+	Operation     *Operation
+	Acceptors     []WaitAcceptor
+}
+
+// used for unmarshaling from the waiters JSON file
+type waiterDefinitions struct {
+	*API
+	Waiters map[string]Waiter
+}
+
+// AttachWaiters attaches waiter configuration from filename to the API.
+//
+// Waiters with acceptor arguments the waiter runtime cannot evaluate, such as
+// JMESPath filter or multi-select expressions, are not generated. A warning
+// naming each skipped waiter is written to stderr.
+func (a *API) AttachWaiters(filename string) {
+	p := waiterDefinitions{API: a}
+
+	f, err := os.Open(filename)
+	defer f.Close()
+	if err != nil {
+		panic(err)
+	}
+	err = json.NewDecoder(f).Decode(&p)
+	if err != nil {
+		panic(err)
+	}
+
+	p.setup()
+}
+
+// reWaiterArgument matches the acceptor argument expressions the waiter
+// runtime is able to evaluate. A plain path expression, or the length of a
+// path expression compared to a constant.
+var reWaiterArgument = regexp.MustCompile("^(?:[\\w\\.\\[\\]\\*]*|length\\([\\w\\.\\[\\]\\*]+\\)\\s*(?:==|!=|>=|<=|>|<)\\s*`\\d+`)$")
+
+// setup runs post-processing on the waiter configuration.
+func (p *waiterDefinitions) setup() {
+	p.API.Waiters = []Waiter{}
+	i, keys := 0, make([]string, len(p.Waiters))
+	for k := range p.Waiters {
+		keys[i] = k
+		i++
+	}
+	sort.Strings(keys)
+
+	for _, n := range keys {
+		e := p.Waiters[n]
+		e.Name = n
+
+		if arg := e.unsupportedArgument(); arg != "" {
+			// skip waiters with expressions the waiter runtime cannot evaluate
+			fmt.Fprintf(os.Stderr, "WARNING: skipping waiter %s.%s, unsupported acceptor argument %q\n",
+				p.API.PackageName(), n, arg)
+			continue
+		}
+
+		if o, ok := p.API.Operations[e.OperationName]; ok {
+			e.Operation = o
+		} else {
+			panic("unknown operation " + e.OperationName + " for waiter " + n)
+		}
+
+		p.API.Waiters = append(p.API.Waiters, e)
+	}
+}
+
+// unsupportedArgument returns the first of the waiter's acceptor arguments
+// which cannot be evaluated, or "" if all of them can be.
+func (w *Waiter) unsupportedArgument() string {
+	for _, a := range w.Acceptors {
+		if !reWaiterArgument.MatchString(a.Argument) {
+			return a.Argument
+		}
+	}
+	return ""
+}
+
+// ExpectedString returns the string that was expected by the WaitAcceptor
+func (a *WaitAcceptor) ExpectedString() string {
+	switch a.Expected.(type) {
+	case string:
+		return fmt.Sprintf("%q", a.Expected)
+	default:
+		return fmt.Sprintf("%v", a.Expected)
+	}
+}
+
+var tplWaiter = template.Must(template.New("waiter").Parse(`
+// WaitUntil{{ .Name }} uses the {{ .Operation.API.NiceName }} API operation
+// {{ .Operation.ExportedName }} to wait for a condition to be met before returning.
+// If the condition is not met within the max attempt window an error will
+// be returned.
+func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .Name }}(input {{ .Operation.InputRef.GoType }}) error {
+	waiterCfg := waiter.Config{
+		Operation:   "{{ .Operation.ExportedName }}",
+		Delay:       {{ .Delay }},
+		MaxAttempts: {{ .MaxAttempts }},
+		Acceptors: []waiter.WaitAcceptor{
+			{{ range $_, $a := .Acceptors }}{
+				State:    "{{ .State }}",
+				Matcher:  "{{ .Matcher }}",
+				Argument: {{ printf "%q" .Argument }},
+				Expected: {{ .ExpectedString }},
+			},
+			{{ end }}
+		},
+	}
+
+	w := waiter.Waiter{
+		Client: c,
+		Input:  input,
+		Config: waiterCfg,
+	}
+	return w.Wait()
+}
+`))
+
+// GoCode returns the generated Go code for an individual waiter.
+func (w *Waiter) GoCode() string {
+	var buf bytes.Buffer
+	if err := tplWaiter.Execute(&buf, w); err != nil {
+		panic(err)
+	}
+
+	return strings.TrimSpace(util.GoFmt(buf.String()))
+}
+
+// InterfaceSignature returns a string representing the Waiter's interface
+// function signature.
+func (w *Waiter) InterfaceSignature() string {
+	return fmt.Sprintf("WaitUntil%s(%s) error",
+		w.Name, w.Operation.InputRef.GoTypeWithPkgName())
+}
+
+// WaitersGoCode generates and returns Go code for each of the waiters of
+// this API.
+func (a *API) WaitersGoCode() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "import (\n\t%q\n)",
+		"github.com/aws/aws-sdk-go/internal/waiter")
+
+	for _, w := range a.Waiters {
+		buf.WriteString("\n\n")
+		buf.WriteString(w.GoCode())
+	}
+	return util.GoFmt(buf.String())
+}
@@ -24,11 +24,6 @@ type generateInfo struct {
 	PackageDir string
 }
 
-var excludeServices = map[string]struct{}{
-	"simpledb":     struct{}{},
-	"importexport": struct{}{},
-}
-
 // newGenerateInfo initializes the service API's folder structure for a specific service.
 // If the SERVICES environment variable is set, and this service is not apart of the list
 // this service will be skipped.
@@ -36,10 +31,6 @@ func newGenerateInfo(modelFile, svcPath string) *generateInfo {
 	g := &generateInfo{API: &api.API{}}
 	g.API.Attach(modelFile)
 
-	if _, ok := excludeServices[g.API.PackageName()]; ok {
-		return nil
-	}
-
 	paginatorsFile := strings.Replace(modelFile, "api-2.json", "paginators-1.json", -1)
 	if _, err := os.Stat(paginatorsFile); err == nil {
 		g.API.AttachPaginators(paginatorsFile)
@@ -50,6 +41,11 @@ func newGenerateInfo(modelFile, svcPath string) *generateInfo {
 		g.API.AttachDocs(docsFile)
 	}
 
+	waitersFile := strings.Replace(modelFile, "api-2.json", "waiters-2.json", -1)
+	if _, err := os.Stat(waitersFile); err == nil {
+		g.API.AttachWaiters(waitersFile)
+	}
+
 	g.API.Setup()
 
 	if svc := os.Getenv("SERVICES"); svc != "" {
@@ -101,13 +97,6 @@ func main() {
 		}
 	}
 
-	for svcName := range excludeServices {
-		if strings.Contains(os.Getenv("SERVICES"), svcName) {
-			fmt.Printf("Service %s is not supported\n", svcName)
-			os.Exit(1)
-		}
-	}
-
 	sort.Strings(files)
 
 	// Remove old API versions from list
@@ -115,7 +104,7 @@ func main() {
 	for i := range files {
 		idx := len(files) - 1 - i
 		parts := strings.Split(files[idx], string(filepath.Separator))
-		svc := parts[len(parts)-2] // service name is 2nd-to-last component
+		svc := parts[len(parts)-3] // service name is 3rd-to-last component
 
 		if m[svc] {
 			files[idx] = "" // wipe this one out if we already saw the service
@@ -141,18 +130,15 @@ func main() {
 			}()
 
 			if g := newGenerateInfo(file, svcPath); g != nil {
-				if _, ok := excludeServices[g.API.PackageName()]; !ok {
-					// Skip services not yet supported.
-
-					fmt.Printf("Generating %s (%s)...\n",
-						g.API.PackageName(), g.API.Metadata.APIVersion)
-
-					// write api.go and service.go files
-					g.writeAPIFile()
-					g.writeExamplesFile()
-					g.writeServiceFile()
-					g.writeInterfaceFile()
-				}
+				fmt.Printf("Generating %s (%s)...\n",
+					g.API.PackageName(), g.API.Metadata.APIVersion)
+
+				// write api.go and service.go files
+				g.writeAPIFile()
+				g.writeExamplesFile()
+				g.writeServiceFile()
+				g.writeInterfaceFile()
+				g.writeWaitersFile()
 			}
 		}()
 	}
@@ -208,6 +194,20 @@ func (g *generateInfo) writeInterfaceFile() {
 	)
 }
 
+// writeWaitersFile writes out the service waiters file.
+func (g *generateInfo) writeWaitersFile() {
+	if len(g.API.Waiters) == 0 {
+		return
+	}
+
+	writeGoFile(filepath.Join(g.PackageDir, "waiters.go"),
+		codeLayout,
+		"",
+		g.API.PackageName(),
+		g.API.WaitersGoCode(),
+	)
+}
+
 // writeAPIFile writes out the service api file.
 func (g *generateInfo) writeAPIFile() {
 	writeGoFile(filepath.Join(g.PackageDir, "api.go"),
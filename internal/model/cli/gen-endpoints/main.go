@@ -25,10 +25,15 @@ func main() {
 	defer in.Close()
 
 	var endpoints struct {
-		Version   int
-		Endpoints map[string]struct {
-			Endpoint      string
-			SigningRegion string
+		Version    int
+		Partitions []struct {
+			Partition   string
+			RegionRegex string
+			DNSSuffix   string
+			Endpoints   map[string]struct {
+				Endpoint      string
+				SigningRegion string
+			}
 		}
 	}
 	if err := json.NewDecoder(in).Decode(&endpoints); err != nil {
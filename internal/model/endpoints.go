@@ -33,9 +33,18 @@ package endpoints
 
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
 
+import "regexp"
+
 type endpointStruct struct {
-	Version   int
-	Endpoints map[string]endpointEntry
+	Version    int
+	Partitions []partition
+}
+
+type partition struct {
+	ID          string
+	RegionRegex *regexp.Regexp
+	DNSSuffix   string
+	Endpoints   map[string]endpointEntry
 }
 
 type endpointEntry struct {
@@ -45,11 +54,19 @@ type endpointEntry struct {
 
 var endpointsMap = endpointStruct{
 	Version: {{ .Version }},
-	Endpoints: map[string]endpointEntry{
-		{{ range $key, $entry := .Endpoints }}"{{ $key }}": endpointEntry{
-			Endpoint:      "{{ $entry.Endpoint }}",
-			{{ if ne $entry.SigningRegion "" }}SigningRegion: "{{ $entry.SigningRegion }}",
-			{{ end }}
+	Partitions: []partition{
+		{{ range $_, $p := .Partitions }}{
+			ID:          "{{ $p.Partition }}",
+			RegionRegex: regexp.MustCompile({{ printf "%q" $p.RegionRegex }}),
+			DNSSuffix:   "{{ $p.DNSSuffix }}",
+			Endpoints: map[string]endpointEntry{
+				{{ range $key, $entry := $p.Endpoints }}"{{ $key }}": endpointEntry{
+					Endpoint:      "{{ $entry.Endpoint }}",
+					{{ if ne $entry.SigningRegion "" }}SigningRegion: "{{ $entry.SigningRegion }}",
+					{{ end }}
+				},
+				{{ end }}
+			},
 		},
 		{{ end }}
 	},
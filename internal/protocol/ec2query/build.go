@@ -11,6 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/query/queryutil"
 )
 
+// BuildHandler is a named request handler for building EC2 Query protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "ec2query.BuildHandler", Fn: Build}
+
 // Build builds a request for the EC2 protocol.
 func Build(r *aws.Request) {
 	body := url.Values{
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/ec2query"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService1ProtocolTest{service}
 }
@@ -85,6 +86,16 @@ func (c *InputService1ProtocolTest) InputService1TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService1TestCaseOperation1WithContext is the same as InputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService1ProtocolTest) InputService1TestCaseOperation1WithContext(ctx aws.Context, input *InputService1TestShapeInputShape) (*InputService1TestShapeInputService1TestCaseOperation1Output, error) {
+	req, out := c.InputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService1TestShapeInputService1TestCaseOperation1Output struct {
 	metadataInputService1TestShapeInputService1TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -93,6 +104,16 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService1TestShapeInputShape struct {
 	Bar *string `type:"string"`
 
@@ -105,6 +126,16 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -119,11 +150,11 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService2ProtocolTest{service}
 }
@@ -160,6 +191,16 @@ func (c *InputService2ProtocolTest) InputService2TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService2TestCaseOperation1WithContext is the same as InputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService2ProtocolTest) InputService2TestCaseOperation1WithContext(ctx aws.Context, input *InputService2TestShapeInputShape) (*InputService2TestShapeInputService2TestCaseOperation1Output, error) {
+	req, out := c.InputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService2TestShapeInputService2TestCaseOperation1Output struct {
 	metadataInputService2TestShapeInputService2TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -168,6 +209,16 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService2TestShapeInputShape struct {
 	Bar *string `locationName:"barLocationName" type:"string"`
 
@@ -182,6 +233,16 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -196,11 +257,11 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService3ProtocolTest{service}
 }
@@ -237,6 +298,16 @@ func (c *InputService3ProtocolTest) InputService3TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService3TestCaseOperation1WithContext is the same as InputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService3ProtocolTest) InputService3TestCaseOperation1WithContext(ctx aws.Context, input *InputService3TestShapeInputShape) (*InputService3TestShapeInputService3TestCaseOperation1Output, error) {
+	req, out := c.InputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService3TestShapeInputService3TestCaseOperation1Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -245,6 +316,16 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeInputShape struct {
 	StructArg *InputService3TestShapeStructType `locationName:"Struct" type:"structure"`
 
@@ -255,6 +336,16 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeStructType struct {
 	ScalarArg *string `locationName:"Scalar" type:"string"`
 
@@ -265,6 +356,16 @@ type metadataInputService3TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeStructType) GoString() string {
+	return s.String()
+}
+
 type InputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -279,11 +380,11 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService4ProtocolTest{service}
 }
@@ -320,6 +421,16 @@ func (c *InputService4ProtocolTest) InputService4TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService4TestCaseOperation1WithContext is the same as InputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService4ProtocolTest) InputService4TestCaseOperation1WithContext(ctx aws.Context, input *InputService4TestShapeInputShape) (*InputService4TestShapeInputService4TestCaseOperation1Output, error) {
+	req, out := c.InputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService4TestShapeInputService4TestCaseOperation1Output struct {
 	metadataInputService4TestShapeInputService4TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -328,6 +439,16 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeInputShape struct {
 	ListArg []*string `type:"list"`
 
@@ -338,6 +459,16 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -352,11 +483,11 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService5ProtocolTest{service}
 }
@@ -393,6 +524,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation1WithContext is the same as InputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation1WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation1Output, error) {
+	req, out := c.InputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService5TestShapeInputService5TestCaseOperation1Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -401,6 +542,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputShape struct {
 	ListArg []*string `locationName:"ListMemberName" locationNameList:"item" type:"list"`
 
@@ -411,6 +562,16 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -425,11 +586,11 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService6ProtocolTest{service}
 }
@@ -466,6 +627,16 @@ func (c *InputService6ProtocolTest) InputService6TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService6TestCaseOperation1WithContext is the same as InputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService6ProtocolTest) InputService6TestCaseOperation1WithContext(ctx aws.Context, input *InputService6TestShapeInputShape) (*InputService6TestShapeInputService6TestCaseOperation1Output, error) {
+	req, out := c.InputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService6TestShapeInputService6TestCaseOperation1Output struct {
 	metadataInputService6TestShapeInputService6TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -474,6 +645,16 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService6TestShapeInputShape struct {
 	ListArg []*string `locationName:"ListMemberName" queryName:"ListQueryName" locationNameList:"item" type:"list"`
 
@@ -484,6 +665,16 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -498,11 +689,11 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService7ProtocolTest{service}
 }
@@ -539,6 +730,16 @@ func (c *InputService7ProtocolTest) InputService7TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService7TestCaseOperation1WithContext is the same as InputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService7ProtocolTest) InputService7TestCaseOperation1WithContext(ctx aws.Context, input *InputService7TestShapeInputShape) (*InputService7TestShapeInputService7TestCaseOperation1Output, error) {
+	req, out := c.InputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService7TestShapeInputService7TestCaseOperation1Output struct {
 	metadataInputService7TestShapeInputService7TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -547,6 +748,16 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService7TestShapeInputShape struct {
 	BlobArg []byte `type:"blob"`
 
@@ -557,6 +768,16 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -571,11 +792,11 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &InputService8ProtocolTest{service}
 }
@@ -612,6 +833,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation1WithContext is the same as InputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation1WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation1Output, error) {
+	req, out := c.InputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService8TestShapeInputService8TestCaseOperation1Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -620,6 +851,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
@@ -630,6 +871,16 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
@@ -11,6 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 )
 
+// UnmarshalHandler is a named request handler for unmarshaling EC2 Query protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "ec2query.UnmarshalHandler", Fn: Unmarshal}
+
 // Unmarshal unmarshals a response body for the EC2 protocol.
 func Unmarshal(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
@@ -24,6 +27,9 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling EC2 Query protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "ec2query.UnmarshalMetaHandler", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals response headers for the EC2 protocol.
 func UnmarshalMeta(r *aws.Request) {
 	// TODO implement unmarshaling of request IDs
@@ -36,6 +42,9 @@ type xmlErrorResponse struct {
 	RequestID string   `xml:"RequestId"`
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling EC2 Query protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "ec2query.UnmarshalErrorHandler", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals a response error for the EC2 protocol.
 func UnmarshalError(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
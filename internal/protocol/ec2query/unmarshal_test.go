@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/ec2query"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService1ProtocolTest{service}
 }
@@ -85,6 +86,16 @@ func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService1TestCaseOperation1WithContext is the same as OutputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1WithContext(ctx aws.Context, input *OutputService1TestShapeOutputService1TestCaseOperation1Input) (*OutputService1TestShapeOutputShape, error) {
+	req, out := c.OutputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService1TestShapeOutputService1TestCaseOperation1Input struct {
 	metadataOutputService1TestShapeOutputService1TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -93,6 +104,16 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -117,6 +138,16 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -131,11 +162,11 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService2ProtocolTest{service}
 }
@@ -172,6 +203,16 @@ func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService2TestCaseOperation1WithContext is the same as OutputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1WithContext(ctx aws.Context, input *OutputService2TestShapeOutputService2TestCaseOperation1Input) (*OutputService2TestShapeOutputShape, error) {
+	req, out := c.OutputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService2TestShapeOutputService2TestCaseOperation1Input struct {
 	metadataOutputService2TestShapeOutputService2TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -180,6 +221,16 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService2TestShapeOutputShape struct {
 	Blob []byte `type:"blob"`
 
@@ -190,6 +241,16 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -204,11 +265,11 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService3ProtocolTest{service}
 }
@@ -245,6 +306,16 @@ func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService3TestCaseOperation1WithContext is the same as OutputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1WithContext(ctx aws.Context, input *OutputService3TestShapeOutputService3TestCaseOperation1Input) (*OutputService3TestShapeOutputShape, error) {
+	req, out := c.OutputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService3TestShapeOutputService3TestCaseOperation1Input struct {
 	metadataOutputService3TestShapeOutputService3TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -253,6 +324,16 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService3TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -263,6 +344,16 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -277,11 +368,11 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService4ProtocolTest{service}
 }
@@ -318,6 +409,16 @@ func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService4TestCaseOperation1WithContext is the same as OutputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1WithContext(ctx aws.Context, input *OutputService4TestShapeOutputService4TestCaseOperation1Input) (*OutputService4TestShapeOutputShape, error) {
+	req, out := c.OutputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService4TestShapeOutputService4TestCaseOperation1Input struct {
 	metadataOutputService4TestShapeOutputService4TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -326,6 +427,16 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `locationNameList:"item" type:"list"`
 
@@ -336,6 +447,16 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -350,11 +471,11 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService5ProtocolTest{service}
 }
@@ -391,6 +512,16 @@ func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService5TestCaseOperation1WithContext is the same as OutputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1WithContext(ctx aws.Context, input *OutputService5TestShapeOutputService5TestCaseOperation1Input) (*OutputService5TestShapeOutputShape, error) {
+	req, out := c.OutputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService5TestShapeOutputService5TestCaseOperation1Input struct {
 	metadataOutputService5TestShapeOutputService5TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -399,6 +530,16 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*string `type:"list" flattened:"true"`
 
@@ -409,6 +550,16 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -423,11 +574,11 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService6ProtocolTest{service}
 }
@@ -464,6 +615,16 @@ func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService6TestCaseOperation1WithContext is the same as OutputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1WithContext(ctx aws.Context, input *OutputService6TestShapeOutputService6TestCaseOperation1Input) (*OutputService6TestShapeOutputShape, error) {
+	req, out := c.OutputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService6TestShapeOutputService6TestCaseOperation1Input struct {
 	metadataOutputService6TestShapeOutputService6TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -472,6 +633,16 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService6TestShapeOutputShape struct {
 	Map map[string]*OutputService6TestShapeStructureType `type:"map"`
 
@@ -482,6 +653,16 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService6TestShapeStructureType struct {
 	Foo *string `locationName:"foo" type:"string"`
 
@@ -492,6 +673,16 @@ type metadataOutputService6TestShapeStructureType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeStructureType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeStructureType) GoString() string {
+	return s.String()
+}
+
 type OutputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -506,11 +697,11 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService7ProtocolTest{service}
 }
@@ -547,6 +738,16 @@ func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService7TestCaseOperation1WithContext is the same as OutputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1WithContext(ctx aws.Context, input *OutputService7TestShapeOutputService7TestCaseOperation1Input) (*OutputService7TestShapeOutputShape, error) {
+	req, out := c.OutputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService7TestShapeOutputService7TestCaseOperation1Input struct {
 	metadataOutputService7TestShapeOutputService7TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -555,6 +756,16 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService7TestShapeOutputShape struct {
 	Map map[string]*string `type:"map" flattened:"true"`
 
@@ -565,6 +776,16 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -579,11 +800,11 @@ func NewOutputService8ProtocolTest(config *aws.Config) *OutputService8ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(ec2query.Build)
-	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(ec2query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(ec2query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(ec2query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(ec2query.UnmarshalErrorHandler)
 
 	return &OutputService8ProtocolTest{service}
 }
@@ -620,6 +841,16 @@ func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService8TestCaseOperation1WithContext is the same as OutputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1WithContext(ctx aws.Context, input *OutputService8TestShapeOutputService8TestCaseOperation1Input) (*OutputService8TestShapeOutputShape, error) {
+	req, out := c.OutputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService8TestShapeOutputService8TestCaseOperation1Input struct {
 	metadataOutputService8TestShapeOutputService8TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -628,6 +859,16 @@ type metadataOutputService8TestShapeOutputService8TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService8TestShapeOutputShape struct {
 	Map map[string]*string `locationNameKey:"foo" locationNameValue:"bar" type:"map" flattened:"true"`
 
@@ -638,6 +879,16 @@ type metadataOutputService8TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/jsonrpc"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -46,11 +47,11 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &InputService1ProtocolTest{service}
 }
@@ -88,6 +89,16 @@ func (c *InputService1ProtocolTest) InputService1TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService1TestCaseOperation1WithContext is the same as InputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService1ProtocolTest) InputService1TestCaseOperation1WithContext(ctx aws.Context, input *InputService1TestShapeInputShape) (*InputService1TestShapeInputService1TestCaseOperation1Output, error) {
+	req, out := c.InputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService1TestShapeInputService1TestCaseOperation1Output struct {
 	metadataInputService1TestShapeInputService1TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -96,6 +107,16 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService1TestShapeInputShape struct {
 	Name *string `type:"string"`
 
@@ -106,6 +127,16 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -122,11 +153,11 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &InputService2ProtocolTest{service}
 }
@@ -163,6 +194,16 @@ func (c *InputService2ProtocolTest) InputService2TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService2TestCaseOperation1WithContext is the same as InputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService2ProtocolTest) InputService2TestCaseOperation1WithContext(ctx aws.Context, input *InputService2TestShapeInputShape) (*InputService2TestShapeInputService2TestCaseOperation1Output, error) {
+	req, out := c.InputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService2TestShapeInputService2TestCaseOperation1Output struct {
 	metadataInputService2TestShapeInputService2TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -171,6 +212,16 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService2TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"unix"`
 
@@ -181,6 +232,16 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -197,11 +258,11 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &InputService3ProtocolTest{service}
 }
@@ -238,6 +299,16 @@ func (c *InputService3ProtocolTest) InputService3TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService3TestCaseOperation1WithContext is the same as InputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService3ProtocolTest) InputService3TestCaseOperation1WithContext(ctx aws.Context, input *InputService3TestShapeInputShape) (*InputService3TestShapeInputService3TestCaseOperation1Output, error) {
+	req, out := c.InputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService3TestCaseOperation2 = "OperationName"
 
 // InputService3TestCaseOperation2Request generates a request for the InputService3TestCaseOperation2 operation.
@@ -262,6 +333,16 @@ func (c *InputService3ProtocolTest) InputService3TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService3TestCaseOperation2WithContext is the same as InputService3TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService3ProtocolTest) InputService3TestCaseOperation2WithContext(ctx aws.Context, input *InputService3TestShapeInputShape) (*InputService3TestShapeInputService3TestCaseOperation2Output, error) {
+	req, out := c.InputService3TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService3TestShapeInputService3TestCaseOperation1Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -270,6 +351,16 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeInputService3TestCaseOperation2Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -278,6 +369,16 @@ type metadataInputService3TestShapeInputService3TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeInputShape struct {
 	BlobArg []byte `type:"blob"`
 
@@ -290,6 +391,16 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -306,11 +417,11 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &InputService4ProtocolTest{service}
 }
@@ -348,6 +459,16 @@ func (c *InputService4ProtocolTest) InputService4TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService4TestCaseOperation1WithContext is the same as InputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService4ProtocolTest) InputService4TestCaseOperation1WithContext(ctx aws.Context, input *InputService4TestShapeInputShape) (*InputService4TestShapeInputService4TestCaseOperation1Output, error) {
+	req, out := c.InputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService4TestShapeInputService4TestCaseOperation1Output struct {
 	metadataInputService4TestShapeInputService4TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -356,6 +477,16 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeInputShape struct {
 	ListParam [][]byte `type:"list"`
 
@@ -366,6 +497,16 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -382,11 +523,11 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &InputService5ProtocolTest{service}
 }
@@ -423,6 +564,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation1WithContext is the same as InputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation1WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation1Output, error) {
+	req, out := c.InputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService5TestCaseOperation2 = "OperationName"
 
 // InputService5TestCaseOperation2Request generates a request for the InputService5TestCaseOperation2 operation.
@@ -447,6 +598,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation2WithContext is the same as InputService5TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation2WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation2Output, error) {
+	req, out := c.InputService5TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService5TestCaseOperation3 = "OperationName"
 
 // InputService5TestCaseOperation3Request generates a request for the InputService5TestCaseOperation3 operation.
@@ -471,6 +632,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation3(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation3WithContext is the same as InputService5TestCaseOperation3 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation3WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation3Output, error) {
+	req, out := c.InputService5TestCaseOperation3Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService5TestCaseOperation4 = "OperationName"
 
 // InputService5TestCaseOperation4Request generates a request for the InputService5TestCaseOperation4 operation.
@@ -495,6 +666,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation4(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation4WithContext is the same as InputService5TestCaseOperation4 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation4WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation4Output, error) {
+	req, out := c.InputService5TestCaseOperation4Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService5TestCaseOperation5 = "OperationName"
 
 // InputService5TestCaseOperation5Request generates a request for the InputService5TestCaseOperation5 operation.
@@ -519,6 +700,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation5(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation5WithContext is the same as InputService5TestCaseOperation5 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation5WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation5Output, error) {
+	req, out := c.InputService5TestCaseOperation5Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService5TestCaseOperation6 = "OperationName"
 
 // InputService5TestCaseOperation6Request generates a request for the InputService5TestCaseOperation6 operation.
@@ -543,6 +734,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation6(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation6WithContext is the same as InputService5TestCaseOperation6 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation6WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation6Output, error) {
+	req, out := c.InputService5TestCaseOperation6Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService5TestShapeInputService5TestCaseOperation1Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -551,6 +752,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputService5TestCaseOperation2Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -559,6 +770,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputService5TestCaseOperation3Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation3Output `json:"-" xml:"-"`
 }
@@ -567,6 +788,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation3Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation3Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputService5TestCaseOperation4Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation4Output `json:"-" xml:"-"`
 }
@@ -575,6 +806,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation4Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation4Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputService5TestCaseOperation5Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation5Output `json:"-" xml:"-"`
 }
@@ -583,6 +824,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation5Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation5Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputService5TestCaseOperation6Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation6Output `json:"-" xml:"-"`
 }
@@ -591,6 +842,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation6Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation6Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputShape struct {
 	RecursiveStruct *InputService5TestShapeRecursiveStructType `type:"structure"`
 
@@ -601,6 +862,16 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -617,6 +888,16 @@ type metadataInputService5TestShapeRecursiveStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeRecursiveStructType) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
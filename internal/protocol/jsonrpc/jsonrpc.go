@@ -17,6 +17,9 @@ import (
 
 var emptyJSON = []byte("{}")
 
+// BuildHandler is a named request handler for building JSON-RPC protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "jsonrpc.BuildHandler", Fn: Build}
+
 // Build builds a JSON payload for a JSON RPC request.
 func Build(req *aws.Request) {
 	var buf []byte
@@ -45,6 +48,9 @@ func Build(req *aws.Request) {
 	}
 }
 
+// UnmarshalHandler is a named request handler for unmarshaling JSON-RPC protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "jsonrpc.UnmarshalHandler", Fn: Unmarshal}
+
 // Unmarshal unmarshals a response for a JSON RPC service.
 func Unmarshal(req *aws.Request) {
 	defer req.HTTPResponse.Body.Close()
@@ -57,11 +63,17 @@ func Unmarshal(req *aws.Request) {
 	return
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling JSON-RPC protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "jsonrpc.UnmarshalMetaHandler", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals headers from a response for a JSON RPC service.
 func UnmarshalMeta(req *aws.Request) {
 	req.RequestID = req.HTTPResponse.Header.Get("x-amzn-requestid")
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling JSON-RPC protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "jsonrpc.UnmarshalErrorHandler", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals an error response for a JSON RPC service.
 func UnmarshalError(req *aws.Request) {
 	defer req.HTTPResponse.Body.Close()
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/jsonrpc"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -46,11 +47,11 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &OutputService1ProtocolTest{service}
 }
@@ -87,6 +88,16 @@ func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService1TestCaseOperation1WithContext is the same as OutputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1WithContext(ctx aws.Context, input *OutputService1TestShapeOutputService1TestCaseOperation1Input) (*OutputService1TestShapeOutputShape, error) {
+	req, out := c.OutputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService1TestShapeOutputService1TestCaseOperation1Input struct {
 	metadataOutputService1TestShapeOutputService1TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -95,6 +106,16 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService1TestShapeOutputShape struct {
 	metadataOutputService1TestShapeOutputShape `json:"-" xml:"-"`
 }
@@ -103,6 +124,16 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -119,11 +150,11 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &OutputService2ProtocolTest{service}
 }
@@ -160,6 +191,16 @@ func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService2TestCaseOperation1WithContext is the same as OutputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1WithContext(ctx aws.Context, input *OutputService2TestShapeOutputService2TestCaseOperation1Input) (*OutputService2TestShapeOutputShape, error) {
+	req, out := c.OutputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService2TestShapeOutputService2TestCaseOperation1Input struct {
 	metadataOutputService2TestShapeOutputService2TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -168,6 +209,16 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService2TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -192,6 +243,16 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -208,11 +269,11 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &OutputService3ProtocolTest{service}
 }
@@ -249,6 +310,16 @@ func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService3TestCaseOperation1WithContext is the same as OutputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1WithContext(ctx aws.Context, input *OutputService3TestShapeOutputService3TestCaseOperation1Input) (*OutputService3TestShapeOutputShape, error) {
+	req, out := c.OutputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService3TestShapeBlobContainer struct {
 	Foo []byte `locationName:"foo" type:"blob"`
 
@@ -259,6 +330,16 @@ type metadataOutputService3TestShapeBlobContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeBlobContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeBlobContainer) GoString() string {
+	return s.String()
+}
+
 type OutputService3TestShapeOutputService3TestCaseOperation1Input struct {
 	metadataOutputService3TestShapeOutputService3TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -267,6 +348,16 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService3TestShapeOutputShape struct {
 	BlobMember []byte `type:"blob"`
 
@@ -279,6 +370,16 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -295,11 +396,11 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &OutputService4ProtocolTest{service}
 }
@@ -336,6 +437,16 @@ func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService4TestCaseOperation1WithContext is the same as OutputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1WithContext(ctx aws.Context, input *OutputService4TestShapeOutputService4TestCaseOperation1Input) (*OutputService4TestShapeOutputShape, error) {
+	req, out := c.OutputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService4TestShapeOutputService4TestCaseOperation1Input struct {
 	metadataOutputService4TestShapeOutputService4TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -344,6 +455,16 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService4TestShapeOutputShape struct {
 	StructMember *OutputService4TestShapeTimeContainer `type:"structure"`
 
@@ -356,6 +477,16 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService4TestShapeTimeContainer struct {
 	Foo *time.Time `locationName:"foo" type:"timestamp" timestampFormat:"unix"`
 
@@ -366,6 +497,16 @@ type metadataOutputService4TestShapeTimeContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeTimeContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeTimeContainer) GoString() string {
+	return s.String()
+}
+
 type OutputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -382,11 +523,11 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &OutputService5ProtocolTest{service}
 }
@@ -423,6 +564,16 @@ func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService5TestCaseOperation1WithContext is the same as OutputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1WithContext(ctx aws.Context, input *OutputService5TestShapeOutputService5TestCaseOperation1Input) (*OutputService5TestShapeOutputShape, error) {
+	req, out := c.OutputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService5TestShapeOutputService5TestCaseOperation1Input struct {
 	metadataOutputService5TestShapeOutputService5TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -431,6 +582,16 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -441,6 +602,16 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -457,11 +628,11 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &OutputService6ProtocolTest{service}
 }
@@ -498,6 +669,16 @@ func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService6TestCaseOperation1WithContext is the same as OutputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1WithContext(ctx aws.Context, input *OutputService6TestShapeOutputService6TestCaseOperation1Input) (*OutputService6TestShapeOutputShape, error) {
+	req, out := c.OutputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService6TestShapeOutputService6TestCaseOperation1Input struct {
 	metadataOutputService6TestShapeOutputService6TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -506,6 +687,16 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService6TestShapeOutputShape struct {
 	MapMember map[string][]*int64 `type:"map"`
 
@@ -516,6 +707,16 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -532,11 +733,11 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(jsonrpc.Build)
-	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(jsonrpc.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
 
 	return &OutputService7ProtocolTest{service}
 }
@@ -573,6 +774,16 @@ func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService7TestCaseOperation1WithContext is the same as OutputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1WithContext(ctx aws.Context, input *OutputService7TestShapeOutputService7TestCaseOperation1Input) (*OutputService7TestShapeOutputShape, error) {
+	req, out := c.OutputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService7TestShapeOutputService7TestCaseOperation1Input struct {
 	metadataOutputService7TestShapeOutputService7TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -581,6 +792,16 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService7TestShapeOutputShape struct {
 	StrType *string `type:"string"`
 
@@ -591,6 +812,16 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
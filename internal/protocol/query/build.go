@@ -11,6 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/query/queryutil"
 )
 
+// BuildHandler is a named request handler for building Query protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "query.BuildHandler", Fn: Build}
+
 // Build builds a request for an AWS Query service.
 func Build(r *aws.Request) {
 	body := url.Values{
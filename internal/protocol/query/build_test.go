@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/query"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService1ProtocolTest{service}
 }
@@ -85,6 +86,16 @@ func (c *InputService1ProtocolTest) InputService1TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService1TestCaseOperation1WithContext is the same as InputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService1ProtocolTest) InputService1TestCaseOperation1WithContext(ctx aws.Context, input *InputService1TestShapeInputShape) (*InputService1TestShapeInputService1TestCaseOperation1Output, error) {
+	req, out := c.InputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService1TestShapeInputService1TestCaseOperation1Output struct {
 	metadataInputService1TestShapeInputService1TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -93,6 +104,16 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService1TestShapeInputShape struct {
 	Bar *string `type:"string"`
 
@@ -105,6 +126,16 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -119,11 +150,11 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService2ProtocolTest{service}
 }
@@ -160,6 +191,16 @@ func (c *InputService2ProtocolTest) InputService2TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService2TestCaseOperation1WithContext is the same as InputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService2ProtocolTest) InputService2TestCaseOperation1WithContext(ctx aws.Context, input *InputService2TestShapeInputShape) (*InputService2TestShapeInputService2TestCaseOperation1Output, error) {
+	req, out := c.InputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService2TestShapeInputService2TestCaseOperation1Output struct {
 	metadataInputService2TestShapeInputService2TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -168,6 +209,16 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService2TestShapeInputShape struct {
 	StructArg *InputService2TestShapeStructType `type:"structure"`
 
@@ -178,6 +229,16 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService2TestShapeStructType struct {
 	ScalarArg *string `type:"string"`
 
@@ -188,6 +249,16 @@ type metadataInputService2TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeStructType) GoString() string {
+	return s.String()
+}
+
 type InputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -202,11 +273,11 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService3ProtocolTest{service}
 }
@@ -243,6 +314,16 @@ func (c *InputService3ProtocolTest) InputService3TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService3TestCaseOperation1WithContext is the same as InputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService3ProtocolTest) InputService3TestCaseOperation1WithContext(ctx aws.Context, input *InputService3TestShapeInputShape) (*InputService3TestShapeInputService3TestCaseOperation1Output, error) {
+	req, out := c.InputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService3TestCaseOperation2 = "OperationName"
 
 // InputService3TestCaseOperation2Request generates a request for the InputService3TestCaseOperation2 operation.
@@ -267,6 +348,16 @@ func (c *InputService3ProtocolTest) InputService3TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService3TestCaseOperation2WithContext is the same as InputService3TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService3ProtocolTest) InputService3TestCaseOperation2WithContext(ctx aws.Context, input *InputService3TestShapeInputShape) (*InputService3TestShapeInputService3TestCaseOperation2Output, error) {
+	req, out := c.InputService3TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService3TestShapeInputService3TestCaseOperation1Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -275,6 +366,16 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeInputService3TestCaseOperation2Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -283,6 +384,16 @@ type metadataInputService3TestShapeInputService3TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeInputShape struct {
 	ListArg []*string `type:"list"`
 
@@ -293,6 +404,16 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -307,11 +428,11 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService4ProtocolTest{service}
 }
@@ -348,6 +469,16 @@ func (c *InputService4ProtocolTest) InputService4TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService4TestCaseOperation1WithContext is the same as InputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService4ProtocolTest) InputService4TestCaseOperation1WithContext(ctx aws.Context, input *InputService4TestShapeInputShape) (*InputService4TestShapeInputService4TestCaseOperation1Output, error) {
+	req, out := c.InputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService4TestCaseOperation2 = "OperationName"
 
 // InputService4TestCaseOperation2Request generates a request for the InputService4TestCaseOperation2 operation.
@@ -372,6 +503,16 @@ func (c *InputService4ProtocolTest) InputService4TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService4TestCaseOperation2WithContext is the same as InputService4TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService4ProtocolTest) InputService4TestCaseOperation2WithContext(ctx aws.Context, input *InputService4TestShapeInputShape) (*InputService4TestShapeInputService4TestCaseOperation2Output, error) {
+	req, out := c.InputService4TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService4TestShapeInputService4TestCaseOperation1Output struct {
 	metadataInputService4TestShapeInputService4TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -380,6 +521,16 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeInputService4TestCaseOperation2Output struct {
 	metadataInputService4TestShapeInputService4TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -388,6 +539,16 @@ type metadataInputService4TestShapeInputService4TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeInputShape struct {
 	ListArg []*string `type:"list" flattened:"true"`
 
@@ -400,6 +561,16 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -414,11 +585,11 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService5ProtocolTest{service}
 }
@@ -455,6 +626,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation1WithContext is the same as InputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation1WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation1Output, error) {
+	req, out := c.InputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService5TestCaseOperation2 = "OperationName"
 
 // InputService5TestCaseOperation2Request generates a request for the InputService5TestCaseOperation2 operation.
@@ -479,6 +660,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation2WithContext is the same as InputService5TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation2WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation2Output, error) {
+	req, out := c.InputService5TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService5TestShapeInputService5TestCaseOperation1Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -487,6 +678,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputService5TestCaseOperation2Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -495,6 +696,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputShape struct {
 	MapArg map[string]*string `type:"map"`
 
@@ -505,6 +716,16 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -519,11 +740,11 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService6ProtocolTest{service}
 }
@@ -560,6 +781,16 @@ func (c *InputService6ProtocolTest) InputService6TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService6TestCaseOperation1WithContext is the same as InputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService6ProtocolTest) InputService6TestCaseOperation1WithContext(ctx aws.Context, input *InputService6TestShapeInputShape) (*InputService6TestShapeInputService6TestCaseOperation1Output, error) {
+	req, out := c.InputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService6TestShapeInputService6TestCaseOperation1Output struct {
 	metadataInputService6TestShapeInputService6TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -568,6 +799,16 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService6TestShapeInputShape struct {
 	MapArg map[string]*string `locationNameKey:"TheKey" locationNameValue:"TheValue" type:"map"`
 
@@ -578,6 +819,16 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -592,11 +843,11 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService7ProtocolTest{service}
 }
@@ -633,6 +884,16 @@ func (c *InputService7ProtocolTest) InputService7TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService7TestCaseOperation1WithContext is the same as InputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService7ProtocolTest) InputService7TestCaseOperation1WithContext(ctx aws.Context, input *InputService7TestShapeInputShape) (*InputService7TestShapeInputService7TestCaseOperation1Output, error) {
+	req, out := c.InputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService7TestShapeInputService7TestCaseOperation1Output struct {
 	metadataInputService7TestShapeInputService7TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -641,6 +902,16 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService7TestShapeInputShape struct {
 	BlobArg []byte `type:"blob"`
 
@@ -651,6 +922,16 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -665,11 +946,11 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService8ProtocolTest{service}
 }
@@ -706,6 +987,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation1WithContext is the same as InputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation1WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation1Output, error) {
+	req, out := c.InputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService8TestShapeInputService8TestCaseOperation1Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -714,6 +1005,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
@@ -724,6 +1025,16 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService9ProtocolTest struct {
 	*aws.Service
 }
@@ -738,11 +1049,11 @@ func NewInputService9ProtocolTest(config *aws.Config) *InputService9ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &InputService9ProtocolTest{service}
 }
@@ -779,6 +1090,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation1WithContext is the same as InputService9TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation1WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation1Output, error) {
+	req, out := c.InputService9TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService9TestCaseOperation2 = "OperationName"
 
 // InputService9TestCaseOperation2Request generates a request for the InputService9TestCaseOperation2 operation.
@@ -803,6 +1124,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation2WithContext is the same as InputService9TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation2WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation2Output, error) {
+	req, out := c.InputService9TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService9TestCaseOperation3 = "OperationName"
 
 // InputService9TestCaseOperation3Request generates a request for the InputService9TestCaseOperation3 operation.
@@ -827,6 +1158,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation3(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation3WithContext is the same as InputService9TestCaseOperation3 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation3WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation3Output, error) {
+	req, out := c.InputService9TestCaseOperation3Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService9TestCaseOperation4 = "OperationName"
 
 // InputService9TestCaseOperation4Request generates a request for the InputService9TestCaseOperation4 operation.
@@ -851,6 +1192,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation4(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation4WithContext is the same as InputService9TestCaseOperation4 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation4WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation4Output, error) {
+	req, out := c.InputService9TestCaseOperation4Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService9TestCaseOperation5 = "OperationName"
 
 // InputService9TestCaseOperation5Request generates a request for the InputService9TestCaseOperation5 operation.
@@ -875,6 +1226,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation5(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation5WithContext is the same as InputService9TestCaseOperation5 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation5WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation5Output, error) {
+	req, out := c.InputService9TestCaseOperation5Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService9TestCaseOperation6 = "OperationName"
 
 // InputService9TestCaseOperation6Request generates a request for the InputService9TestCaseOperation6 operation.
@@ -899,6 +1260,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation6(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation6WithContext is the same as InputService9TestCaseOperation6 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation6WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation6Output, error) {
+	req, out := c.InputService9TestCaseOperation6Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService9TestShapeInputService9TestCaseOperation1Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -907,6 +1278,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputService9TestCaseOperation2Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -915,6 +1296,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputService9TestCaseOperation3Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation3Output `json:"-" xml:"-"`
 }
@@ -923,6 +1314,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation3Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation3Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputService9TestCaseOperation4Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation4Output `json:"-" xml:"-"`
 }
@@ -931,6 +1332,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation4Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation4Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputService9TestCaseOperation5Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation5Output `json:"-" xml:"-"`
 }
@@ -939,6 +1350,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation5Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation5Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputService9TestCaseOperation6Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation6Output `json:"-" xml:"-"`
 }
@@ -947,6 +1368,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation6Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation6Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputShape struct {
 	RecursiveStruct *InputService9TestShapeRecursiveStructType `type:"structure"`
 
@@ -957,6 +1388,16 @@ type metadataInputService9TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -973,6 +1414,16 @@ type metadataInputService9TestShapeRecursiveStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeRecursiveStructType) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
@@ -10,6 +10,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 )
 
+// UnmarshalHandler is a named request handler for unmarshaling Query protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "query.UnmarshalHandler", Fn: Unmarshal}
+
 // Unmarshal unmarshals a response for an AWS Query service.
 func Unmarshal(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
@@ -23,6 +26,9 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling Query protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "query.UnmarshalMetaHandler", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals header response values for an AWS Query service.
 func UnmarshalMeta(r *aws.Request) {
 	// TODO implement unmarshaling of request IDs
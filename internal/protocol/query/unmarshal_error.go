@@ -15,6 +15,9 @@ type xmlErrorResponse struct {
 	RequestID string   `xml:"RequestId"`
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling Query protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "query.UnmarshalErrorHandler", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals an error response for an AWS Query service.
 func UnmarshalError(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
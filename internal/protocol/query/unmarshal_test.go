@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/query"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService1ProtocolTest{service}
 }
@@ -85,6 +86,16 @@ func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService1TestCaseOperation1WithContext is the same as OutputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1WithContext(ctx aws.Context, input *OutputService1TestShapeOutputService1TestCaseOperation1Input) (*OutputService1TestShapeOutputShape, error) {
+	req, out := c.OutputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService1TestShapeOutputService1TestCaseOperation1Input struct {
 	metadataOutputService1TestShapeOutputService1TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -93,6 +104,16 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -119,6 +140,16 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -133,11 +164,11 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService2ProtocolTest{service}
 }
@@ -174,6 +205,16 @@ func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService2TestCaseOperation1WithContext is the same as OutputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1WithContext(ctx aws.Context, input *OutputService2TestShapeOutputService2TestCaseOperation1Input) (*OutputService2TestShapeOutputShape, error) {
+	req, out := c.OutputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService2TestShapeOutputService2TestCaseOperation1Input struct {
 	metadataOutputService2TestShapeOutputService2TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -182,6 +223,16 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService2TestShapeOutputShape struct {
 	Num *int64 `type:"integer"`
 
@@ -194,6 +245,16 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -208,11 +269,11 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService3ProtocolTest{service}
 }
@@ -249,6 +310,16 @@ func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService3TestCaseOperation1WithContext is the same as OutputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1WithContext(ctx aws.Context, input *OutputService3TestShapeOutputService3TestCaseOperation1Input) (*OutputService3TestShapeOutputShape, error) {
+	req, out := c.OutputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService3TestShapeOutputService3TestCaseOperation1Input struct {
 	metadataOutputService3TestShapeOutputService3TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -257,6 +328,16 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService3TestShapeOutputShape struct {
 	Blob []byte `type:"blob"`
 
@@ -267,6 +348,16 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -281,11 +372,11 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService4ProtocolTest{service}
 }
@@ -322,6 +413,16 @@ func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService4TestCaseOperation1WithContext is the same as OutputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1WithContext(ctx aws.Context, input *OutputService4TestShapeOutputService4TestCaseOperation1Input) (*OutputService4TestShapeOutputShape, error) {
+	req, out := c.OutputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService4TestShapeOutputService4TestCaseOperation1Input struct {
 	metadataOutputService4TestShapeOutputService4TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -330,6 +431,16 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -340,6 +451,16 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -354,11 +475,11 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService5ProtocolTest{service}
 }
@@ -395,6 +516,16 @@ func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService5TestCaseOperation1WithContext is the same as OutputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1WithContext(ctx aws.Context, input *OutputService5TestShapeOutputService5TestCaseOperation1Input) (*OutputService5TestShapeOutputShape, error) {
+	req, out := c.OutputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService5TestShapeOutputService5TestCaseOperation1Input struct {
 	metadataOutputService5TestShapeOutputService5TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -403,6 +534,16 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*string `locationNameList:"item" type:"list"`
 
@@ -413,6 +554,16 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -427,11 +578,11 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService6ProtocolTest{service}
 }
@@ -468,6 +619,16 @@ func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService6TestCaseOperation1WithContext is the same as OutputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1WithContext(ctx aws.Context, input *OutputService6TestShapeOutputService6TestCaseOperation1Input) (*OutputService6TestShapeOutputShape, error) {
+	req, out := c.OutputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService6TestShapeOutputService6TestCaseOperation1Input struct {
 	metadataOutputService6TestShapeOutputService6TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -476,6 +637,16 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService6TestShapeOutputShape struct {
 	ListMember []*string `type:"list" flattened:"true"`
 
@@ -486,6 +657,16 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -500,11 +681,11 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService7ProtocolTest{service}
 }
@@ -541,6 +722,16 @@ func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService7TestCaseOperation1WithContext is the same as OutputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1WithContext(ctx aws.Context, input *OutputService7TestShapeOutputService7TestCaseOperation1Input) (*OutputService7TestShapeOutputShape, error) {
+	req, out := c.OutputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService7TestShapeOutputService7TestCaseOperation1Input struct {
 	metadataOutputService7TestShapeOutputService7TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -549,6 +740,16 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService7TestShapeOutputShape struct {
 	ListMember []*string `type:"list" flattened:"true"`
 
@@ -559,6 +760,16 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -573,11 +784,11 @@ func NewOutputService8ProtocolTest(config *aws.Config) *OutputService8ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService8ProtocolTest{service}
 }
@@ -614,6 +825,16 @@ func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService8TestCaseOperation1WithContext is the same as OutputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1WithContext(ctx aws.Context, input *OutputService8TestShapeOutputService8TestCaseOperation1Input) (*OutputService8TestShapeOutputShape, error) {
+	req, out := c.OutputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService8TestShapeOutputService8TestCaseOperation1Input struct {
 	metadataOutputService8TestShapeOutputService8TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -622,6 +843,16 @@ type metadataOutputService8TestShapeOutputService8TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService8TestShapeOutputShape struct {
 	List []*OutputService8TestShapeStructureShape `type:"list"`
 
@@ -632,6 +863,16 @@ type metadataOutputService8TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService8TestShapeStructureShape struct {
 	Bar *string `type:"string"`
 
@@ -646,6 +887,16 @@ type metadataOutputService8TestShapeStructureShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeStructureShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeStructureShape) GoString() string {
+	return s.String()
+}
+
 type OutputService9ProtocolTest struct {
 	*aws.Service
 }
@@ -660,11 +911,11 @@ func NewOutputService9ProtocolTest(config *aws.Config) *OutputService9ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService9ProtocolTest{service}
 }
@@ -701,6 +952,16 @@ func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService9TestCaseOperation1WithContext is the same as OutputService9TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1WithContext(ctx aws.Context, input *OutputService9TestShapeOutputService9TestCaseOperation1Input) (*OutputService9TestShapeOutputShape, error) {
+	req, out := c.OutputService9TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService9TestShapeOutputService9TestCaseOperation1Input struct {
 	metadataOutputService9TestShapeOutputService9TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -709,6 +970,16 @@ type metadataOutputService9TestShapeOutputService9TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService9TestShapeOutputShape struct {
 	List []*OutputService9TestShapeStructureShape `type:"list" flattened:"true"`
 
@@ -719,6 +990,16 @@ type metadataOutputService9TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService9TestShapeStructureShape struct {
 	Bar *string `type:"string"`
 
@@ -733,6 +1014,16 @@ type metadataOutputService9TestShapeStructureShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeStructureShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeStructureShape) GoString() string {
+	return s.String()
+}
+
 type OutputService10ProtocolTest struct {
 	*aws.Service
 }
@@ -747,11 +1038,11 @@ func NewOutputService10ProtocolTest(config *aws.Config) *OutputService10Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService10ProtocolTest{service}
 }
@@ -788,6 +1079,16 @@ func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService10TestCaseOperation1WithContext is the same as OutputService10TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1WithContext(ctx aws.Context, input *OutputService10TestShapeOutputService10TestCaseOperation1Input) (*OutputService10TestShapeOutputShape, error) {
+	req, out := c.OutputService10TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService10TestShapeOutputService10TestCaseOperation1Input struct {
 	metadataOutputService10TestShapeOutputService10TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -796,6 +1097,16 @@ type metadataOutputService10TestShapeOutputService10TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService10TestShapeOutputShape struct {
 	List []*string `locationNameList:"NamedList" type:"list" flattened:"true"`
 
@@ -806,6 +1117,16 @@ type metadataOutputService10TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService10TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService10TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService11ProtocolTest struct {
 	*aws.Service
 }
@@ -820,11 +1141,11 @@ func NewOutputService11ProtocolTest(config *aws.Config) *OutputService11Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService11ProtocolTest{service}
 }
@@ -861,6 +1182,16 @@ func (c *OutputService11ProtocolTest) OutputService11TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService11TestCaseOperation1WithContext is the same as OutputService11TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService11ProtocolTest) OutputService11TestCaseOperation1WithContext(ctx aws.Context, input *OutputService11TestShapeOutputService11TestCaseOperation1Input) (*OutputService11TestShapeOutputShape, error) {
+	req, out := c.OutputService11TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService11TestShapeOutputService11TestCaseOperation1Input struct {
 	metadataOutputService11TestShapeOutputService11TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -869,6 +1200,16 @@ type metadataOutputService11TestShapeOutputService11TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService11TestShapeOutputShape struct {
 	Map map[string]*OutputService11TestShapeStructType `type:"map"`
 
@@ -879,6 +1220,16 @@ type metadataOutputService11TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService11TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService11TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService11TestShapeStructType struct {
 	Foo *string `locationName:"foo" type:"string"`
 
@@ -889,6 +1240,16 @@ type metadataOutputService11TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService11TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService11TestShapeStructType) GoString() string {
+	return s.String()
+}
+
 type OutputService12ProtocolTest struct {
 	*aws.Service
 }
@@ -903,11 +1264,11 @@ func NewOutputService12ProtocolTest(config *aws.Config) *OutputService12Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService12ProtocolTest{service}
 }
@@ -944,6 +1305,16 @@ func (c *OutputService12ProtocolTest) OutputService12TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService12TestCaseOperation1WithContext is the same as OutputService12TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService12ProtocolTest) OutputService12TestCaseOperation1WithContext(ctx aws.Context, input *OutputService12TestShapeOutputService12TestCaseOperation1Input) (*OutputService12TestShapeOutputShape, error) {
+	req, out := c.OutputService12TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService12TestShapeOutputService12TestCaseOperation1Input struct {
 	metadataOutputService12TestShapeOutputService12TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -952,6 +1323,16 @@ type metadataOutputService12TestShapeOutputService12TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService12TestShapeOutputService12TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService12TestShapeOutputService12TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService12TestShapeOutputShape struct {
 	Map map[string]*string `type:"map" flattened:"true"`
 
@@ -962,6 +1343,16 @@ type metadataOutputService12TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService12TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService12TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService13ProtocolTest struct {
 	*aws.Service
 }
@@ -976,11 +1367,11 @@ func NewOutputService13ProtocolTest(config *aws.Config) *OutputService13Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService13ProtocolTest{service}
 }
@@ -1017,6 +1408,16 @@ func (c *OutputService13ProtocolTest) OutputService13TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService13TestCaseOperation1WithContext is the same as OutputService13TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService13ProtocolTest) OutputService13TestCaseOperation1WithContext(ctx aws.Context, input *OutputService13TestShapeOutputService13TestCaseOperation1Input) (*OutputService13TestShapeOutputShape, error) {
+	req, out := c.OutputService13TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService13TestShapeOutputService13TestCaseOperation1Input struct {
 	metadataOutputService13TestShapeOutputService13TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -1025,6 +1426,16 @@ type metadataOutputService13TestShapeOutputService13TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService13TestShapeOutputService13TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService13TestShapeOutputService13TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService13TestShapeOutputShape struct {
 	Map map[string]*string `locationName:"Attribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 
@@ -1035,6 +1446,16 @@ type metadataOutputService13TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService13TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService13TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService14ProtocolTest struct {
 	*aws.Service
 }
@@ -1049,11 +1470,11 @@ func NewOutputService14ProtocolTest(config *aws.Config) *OutputService14Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	return &OutputService14ProtocolTest{service}
 }
@@ -1090,6 +1511,16 @@ func (c *OutputService14ProtocolTest) OutputService14TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService14TestCaseOperation1WithContext is the same as OutputService14TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService14ProtocolTest) OutputService14TestCaseOperation1WithContext(ctx aws.Context, input *OutputService14TestShapeOutputService14TestCaseOperation1Input) (*OutputService14TestShapeOutputShape, error) {
+	req, out := c.OutputService14TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService14TestShapeOutputService14TestCaseOperation1Input struct {
 	metadataOutputService14TestShapeOutputService14TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -1098,6 +1529,16 @@ type metadataOutputService14TestShapeOutputService14TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService14TestShapeOutputService14TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService14TestShapeOutputService14TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService14TestShapeOutputShape struct {
 	Map map[string]*string `locationNameKey:"foo" locationNameValue:"bar" type:"map" flattened:"true"`
 
@@ -1108,6 +1549,16 @@ type metadataOutputService14TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService14TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService14TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
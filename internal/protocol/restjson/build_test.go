@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/restjson"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService1ProtocolTest{service}
 }
@@ -87,6 +88,16 @@ func (c *InputService1ProtocolTest) InputService1TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService1TestCaseOperation1WithContext is the same as InputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService1ProtocolTest) InputService1TestCaseOperation1WithContext(ctx aws.Context, input *InputService1TestShapeInputShape) (*InputService1TestShapeInputService1TestCaseOperation1Output, error) {
+	req, out := c.InputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService1TestShapeInputService1TestCaseOperation1Output struct {
 	metadataInputService1TestShapeInputService1TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -95,6 +106,16 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService1TestShapeInputShape struct {
 	PipelineId *string `location:"uri" type:"string"`
 
@@ -105,6 +126,16 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -119,11 +150,11 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService2ProtocolTest{service}
 }
@@ -162,6 +193,16 @@ func (c *InputService2ProtocolTest) InputService2TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService2TestCaseOperation1WithContext is the same as InputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService2ProtocolTest) InputService2TestCaseOperation1WithContext(ctx aws.Context, input *InputService2TestShapeInputShape) (*InputService2TestShapeInputService2TestCaseOperation1Output, error) {
+	req, out := c.InputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService2TestShapeInputService2TestCaseOperation1Output struct {
 	metadataInputService2TestShapeInputService2TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -170,6 +211,16 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService2TestShapeInputShape struct {
 	Foo *string `location:"uri" locationName:"PipelineId" type:"string"`
 
@@ -180,6 +231,16 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -194,11 +255,11 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService3ProtocolTest{service}
 }
@@ -237,6 +298,16 @@ func (c *InputService3ProtocolTest) InputService3TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService3TestCaseOperation1WithContext is the same as InputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService3ProtocolTest) InputService3TestCaseOperation1WithContext(ctx aws.Context, input *InputService3TestShapeInputShape) (*InputService3TestShapeInputService3TestCaseOperation1Output, error) {
+	req, out := c.InputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService3TestShapeInputService3TestCaseOperation1Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -245,6 +316,16 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeInputShape struct {
 	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
 
@@ -259,6 +340,16 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -273,11 +364,11 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService4ProtocolTest{service}
 }
@@ -316,6 +407,16 @@ func (c *InputService4ProtocolTest) InputService4TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService4TestCaseOperation1WithContext is the same as InputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService4ProtocolTest) InputService4TestCaseOperation1WithContext(ctx aws.Context, input *InputService4TestShapeInputShape) (*InputService4TestShapeInputService4TestCaseOperation1Output, error) {
+	req, out := c.InputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService4TestShapeInputService4TestCaseOperation1Output struct {
 	metadataInputService4TestShapeInputService4TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -324,6 +425,16 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeInputShape struct {
 	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
 
@@ -340,6 +451,16 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeStructType struct {
 	A *string `type:"string"`
 
@@ -352,6 +473,16 @@ type metadataInputService4TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeStructType) GoString() string {
+	return s.String()
+}
+
 type InputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -366,11 +497,11 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService5ProtocolTest{service}
 }
@@ -409,6 +540,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation1WithContext is the same as InputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation1WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation1Output, error) {
+	req, out := c.InputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService5TestShapeInputService5TestCaseOperation1Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -417,6 +558,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputShape struct {
 	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
 
@@ -435,6 +586,16 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeStructType struct {
 	A *string `type:"string"`
 
@@ -447,6 +608,16 @@ type metadataInputService5TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeStructType) GoString() string {
+	return s.String()
+}
+
 type InputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -461,11 +632,11 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService6ProtocolTest{service}
 }
@@ -504,6 +675,16 @@ func (c *InputService6ProtocolTest) InputService6TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService6TestCaseOperation1WithContext is the same as InputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService6ProtocolTest) InputService6TestCaseOperation1WithContext(ctx aws.Context, input *InputService6TestShapeInputShape) (*InputService6TestShapeInputService6TestCaseOperation1Output, error) {
+	req, out := c.InputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService6TestShapeInputService6TestCaseOperation1Output struct {
 	metadataInputService6TestShapeInputService6TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -512,6 +693,16 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService6TestShapeInputShape struct {
 	Body io.ReadSeeker `locationName:"body" type:"blob"`
 
@@ -526,6 +717,16 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -540,11 +741,11 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService7ProtocolTest{service}
 }
@@ -583,6 +784,16 @@ func (c *InputService7ProtocolTest) InputService7TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService7TestCaseOperation1WithContext is the same as InputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService7ProtocolTest) InputService7TestCaseOperation1WithContext(ctx aws.Context, input *InputService7TestShapeInputShape) (*InputService7TestShapeInputService7TestCaseOperation1Output, error) {
+	req, out := c.InputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService7TestCaseOperation2 = "OperationName"
 
 // InputService7TestCaseOperation2Request generates a request for the InputService7TestCaseOperation2 operation.
@@ -609,6 +820,16 @@ func (c *InputService7ProtocolTest) InputService7TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService7TestCaseOperation2WithContext is the same as InputService7TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService7ProtocolTest) InputService7TestCaseOperation2WithContext(ctx aws.Context, input *InputService7TestShapeInputShape) (*InputService7TestShapeInputService7TestCaseOperation2Output, error) {
+	req, out := c.InputService7TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService7TestShapeInputService7TestCaseOperation1Output struct {
 	metadataInputService7TestShapeInputService7TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -617,6 +838,16 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService7TestShapeInputService7TestCaseOperation2Output struct {
 	metadataInputService7TestShapeInputService7TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -625,6 +856,16 @@ type metadataInputService7TestShapeInputService7TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService7TestShapeInputShape struct {
 	Foo *string `location:"querystring" locationName:"param-name" type:"string"`
 
@@ -635,6 +876,16 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -649,11 +900,11 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService8ProtocolTest{service}
 }
@@ -692,6 +943,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation1WithContext is the same as InputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation1WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation1Output, error) {
+	req, out := c.InputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService8TestCaseOperation2 = "OperationName"
 
 // InputService8TestCaseOperation2Request generates a request for the InputService8TestCaseOperation2 operation.
@@ -718,6 +979,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation2WithContext is the same as InputService8TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation2WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation2Output, error) {
+	req, out := c.InputService8TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService8TestCaseOperation3 = "OperationName"
 
 // InputService8TestCaseOperation3Request generates a request for the InputService8TestCaseOperation3 operation.
@@ -744,6 +1015,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation3(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation3WithContext is the same as InputService8TestCaseOperation3 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation3WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation3Output, error) {
+	req, out := c.InputService8TestCaseOperation3Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService8TestCaseOperation4 = "OperationName"
 
 // InputService8TestCaseOperation4Request generates a request for the InputService8TestCaseOperation4 operation.
@@ -770,6 +1051,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation4(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation4WithContext is the same as InputService8TestCaseOperation4 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation4WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation4Output, error) {
+	req, out := c.InputService8TestCaseOperation4Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService8TestCaseOperation5 = "OperationName"
 
 // InputService8TestCaseOperation5Request generates a request for the InputService8TestCaseOperation5 operation.
@@ -796,6 +1087,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation5(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation5WithContext is the same as InputService8TestCaseOperation5 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation5WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation5Output, error) {
+	req, out := c.InputService8TestCaseOperation5Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService8TestCaseOperation6 = "OperationName"
 
 // InputService8TestCaseOperation6Request generates a request for the InputService8TestCaseOperation6 operation.
@@ -822,6 +1123,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation6(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation6WithContext is the same as InputService8TestCaseOperation6 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation6WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation6Output, error) {
+	req, out := c.InputService8TestCaseOperation6Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService8TestShapeInputService8TestCaseOperation1Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -830,6 +1141,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputService8TestCaseOperation2Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -838,6 +1159,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputService8TestCaseOperation3Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation3Output `json:"-" xml:"-"`
 }
@@ -846,6 +1177,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation3Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation3Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputService8TestCaseOperation4Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation4Output `json:"-" xml:"-"`
 }
@@ -854,6 +1195,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation4Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation4Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputService8TestCaseOperation5Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation5Output `json:"-" xml:"-"`
 }
@@ -862,6 +1213,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation5Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation5Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputService8TestCaseOperation6Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation6Output `json:"-" xml:"-"`
 }
@@ -870,6 +1231,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation6Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation6Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputShape struct {
 	RecursiveStruct *InputService8TestShapeRecursiveStructType `type:"structure"`
 
@@ -880,6 +1251,16 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -896,6 +1277,16 @@ type metadataInputService8TestShapeRecursiveStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeRecursiveStructType) GoString() string {
+	return s.String()
+}
+
 type InputService9ProtocolTest struct {
 	*aws.Service
 }
@@ -910,11 +1301,11 @@ func NewInputService9ProtocolTest(config *aws.Config) *InputService9ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &InputService9ProtocolTest{service}
 }
@@ -953,6 +1344,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation1WithContext is the same as InputService9TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation1WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation1Output, error) {
+	req, out := c.InputService9TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService9TestCaseOperation2 = "OperationName"
 
 // InputService9TestCaseOperation2Request generates a request for the InputService9TestCaseOperation2 operation.
@@ -979,6 +1380,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation2WithContext is the same as InputService9TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation2WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation2Output, error) {
+	req, out := c.InputService9TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService9TestShapeInputService9TestCaseOperation1Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -987,6 +1398,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputService9TestCaseOperation2Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -995,6 +1416,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"unix"`
 
@@ -1007,6 +1438,16 @@ type metadataInputService9TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
@@ -16,6 +16,9 @@ import (
 	"github.com/aws/aws-sdk-go/internal/protocol/rest"
 )
 
+// BuildHandler is a named request handler for building REST JSON protocol requests.
+var BuildHandler = aws.NamedHandler{Name: "restjson.BuildHandler", Fn: Build}
+
 // Build builds a request for the REST JSON protocol.
 func Build(r *aws.Request) {
 	rest.Build(r)
@@ -25,6 +28,9 @@ func Build(r *aws.Request) {
 	}
 }
 
+// UnmarshalHandler is a named request handler for unmarshaling REST JSON protocol requests.
+var UnmarshalHandler = aws.NamedHandler{Name: "restjson.UnmarshalHandler", Fn: Unmarshal}
+
 // Unmarshal unmarshals a response body for the REST JSON protocol.
 func Unmarshal(r *aws.Request) {
 	if t := rest.PayloadType(r.Data); t == "structure" || t == "" {
@@ -32,11 +38,17 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// UnmarshalMetaHandler is a named request handler for unmarshaling REST JSON protocol request metadata.
+var UnmarshalMetaHandler = aws.NamedHandler{Name: "restjson.UnmarshalMetaHandler", Fn: UnmarshalMeta}
+
 // UnmarshalMeta unmarshals response headers for the REST JSON protocol.
 func UnmarshalMeta(r *aws.Request) {
 	rest.Unmarshal(r)
 }
 
+// UnmarshalErrorHandler is a named request handler for unmarshaling REST JSON protocol request errors.
+var UnmarshalErrorHandler = aws.NamedHandler{Name: "restjson.UnmarshalErrorHandler", Fn: UnmarshalError}
+
 // UnmarshalError unmarshals a response error for the REST JSON protocol.
 func UnmarshalError(r *aws.Request) {
 	code := r.HTTPResponse.Header.Get("X-Amzn-Errortype")
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/restjson"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService1ProtocolTest{service}
 }
@@ -85,6 +86,16 @@ func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService1TestCaseOperation1WithContext is the same as OutputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1WithContext(ctx aws.Context, input *OutputService1TestShapeOutputService1TestCaseOperation1Input) (*OutputService1TestShapeOutputShape, error) {
+	req, out := c.OutputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService1TestShapeOutputService1TestCaseOperation1Input struct {
 	metadataOutputService1TestShapeOutputService1TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -93,6 +104,16 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -123,6 +144,16 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -137,11 +168,11 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService2ProtocolTest{service}
 }
@@ -178,6 +209,16 @@ func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService2TestCaseOperation1WithContext is the same as OutputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1WithContext(ctx aws.Context, input *OutputService2TestShapeOutputService2TestCaseOperation1Input) (*OutputService2TestShapeOutputShape, error) {
+	req, out := c.OutputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService2TestShapeBlobContainer struct {
 	Foo []byte `locationName:"foo" type:"blob"`
 
@@ -188,6 +229,16 @@ type metadataOutputService2TestShapeBlobContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeBlobContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeBlobContainer) GoString() string {
+	return s.String()
+}
+
 type OutputService2TestShapeOutputService2TestCaseOperation1Input struct {
 	metadataOutputService2TestShapeOutputService2TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -196,6 +247,16 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService2TestShapeOutputShape struct {
 	BlobMember []byte `type:"blob"`
 
@@ -208,6 +269,16 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -222,11 +293,11 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService3ProtocolTest{service}
 }
@@ -263,6 +334,16 @@ func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService3TestCaseOperation1WithContext is the same as OutputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1WithContext(ctx aws.Context, input *OutputService3TestShapeOutputService3TestCaseOperation1Input) (*OutputService3TestShapeOutputShape, error) {
+	req, out := c.OutputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService3TestShapeOutputService3TestCaseOperation1Input struct {
 	metadataOutputService3TestShapeOutputService3TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -271,6 +352,16 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService3TestShapeOutputShape struct {
 	StructMember *OutputService3TestShapeTimeContainer `type:"structure"`
 
@@ -283,6 +374,16 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService3TestShapeTimeContainer struct {
 	Foo *time.Time `locationName:"foo" type:"timestamp" timestampFormat:"unix"`
 
@@ -293,6 +394,16 @@ type metadataOutputService3TestShapeTimeContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeTimeContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeTimeContainer) GoString() string {
+	return s.String()
+}
+
 type OutputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -307,11 +418,11 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService4ProtocolTest{service}
 }
@@ -348,6 +459,16 @@ func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService4TestCaseOperation1WithContext is the same as OutputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1WithContext(ctx aws.Context, input *OutputService4TestShapeOutputService4TestCaseOperation1Input) (*OutputService4TestShapeOutputShape, error) {
+	req, out := c.OutputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService4TestShapeOutputService4TestCaseOperation1Input struct {
 	metadataOutputService4TestShapeOutputService4TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -356,6 +477,16 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -366,6 +497,16 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -380,11 +521,11 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService5ProtocolTest{service}
 }
@@ -421,6 +562,16 @@ func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService5TestCaseOperation1WithContext is the same as OutputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1WithContext(ctx aws.Context, input *OutputService5TestShapeOutputService5TestCaseOperation1Input) (*OutputService5TestShapeOutputShape, error) {
+	req, out := c.OutputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService5TestShapeOutputService5TestCaseOperation1Input struct {
 	metadataOutputService5TestShapeOutputService5TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -429,6 +580,16 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*OutputService5TestShapeSingleStruct `type:"list"`
 
@@ -439,6 +600,16 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService5TestShapeSingleStruct struct {
 	Foo *string `type:"string"`
 
@@ -449,6 +620,16 @@ type metadataOutputService5TestShapeSingleStruct struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeSingleStruct) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeSingleStruct) GoString() string {
+	return s.String()
+}
+
 type OutputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -463,11 +644,11 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService6ProtocolTest{service}
 }
@@ -504,6 +685,16 @@ func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService6TestCaseOperation1WithContext is the same as OutputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1WithContext(ctx aws.Context, input *OutputService6TestShapeOutputService6TestCaseOperation1Input) (*OutputService6TestShapeOutputShape, error) {
+	req, out := c.OutputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService6TestShapeOutputService6TestCaseOperation1Input struct {
 	metadataOutputService6TestShapeOutputService6TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -512,6 +703,16 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService6TestShapeOutputShape struct {
 	MapMember map[string][]*int64 `type:"map"`
 
@@ -522,6 +723,16 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -536,11 +747,11 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService7ProtocolTest{service}
 }
@@ -577,6 +788,16 @@ func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService7TestCaseOperation1WithContext is the same as OutputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1WithContext(ctx aws.Context, input *OutputService7TestShapeOutputService7TestCaseOperation1Input) (*OutputService7TestShapeOutputShape, error) {
+	req, out := c.OutputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService7TestShapeOutputService7TestCaseOperation1Input struct {
 	metadataOutputService7TestShapeOutputService7TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -585,6 +806,16 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService7TestShapeOutputShape struct {
 	MapMember map[string]*time.Time `type:"map"`
 
@@ -595,6 +826,16 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -609,11 +850,11 @@ func NewOutputService8ProtocolTest(config *aws.Config) *OutputService8ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService8ProtocolTest{service}
 }
@@ -650,6 +891,16 @@ func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService8TestCaseOperation1WithContext is the same as OutputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1WithContext(ctx aws.Context, input *OutputService8TestShapeOutputService8TestCaseOperation1Input) (*OutputService8TestShapeOutputShape, error) {
+	req, out := c.OutputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService8TestShapeOutputService8TestCaseOperation1Input struct {
 	metadataOutputService8TestShapeOutputService8TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -658,6 +909,16 @@ type metadataOutputService8TestShapeOutputService8TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService8TestShapeOutputShape struct {
 	StrType *string `type:"string"`
 
@@ -668,6 +929,16 @@ type metadataOutputService8TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService9ProtocolTest struct {
 	*aws.Service
 }
@@ -682,11 +953,11 @@ func NewOutputService9ProtocolTest(config *aws.Config) *OutputService9ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService9ProtocolTest{service}
 }
@@ -723,6 +994,16 @@ func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService9TestCaseOperation1WithContext is the same as OutputService9TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1WithContext(ctx aws.Context, input *OutputService9TestShapeOutputService9TestCaseOperation1Input) (*OutputService9TestShapeOutputShape, error) {
+	req, out := c.OutputService9TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService9TestShapeOutputService9TestCaseOperation1Input struct {
 	metadataOutputService9TestShapeOutputService9TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -731,6 +1012,16 @@ type metadataOutputService9TestShapeOutputService9TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService9TestShapeOutputShape struct {
 	AllHeaders map[string]*string `location:"headers" type:"map"`
 
@@ -743,6 +1034,16 @@ type metadataOutputService9TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService10ProtocolTest struct {
 	*aws.Service
 }
@@ -757,11 +1058,11 @@ func NewOutputService10ProtocolTest(config *aws.Config) *OutputService10Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService10ProtocolTest{service}
 }
@@ -798,6 +1099,16 @@ func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService10TestCaseOperation1WithContext is the same as OutputService10TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1WithContext(ctx aws.Context, input *OutputService10TestShapeOutputService10TestCaseOperation1Input) (*OutputService10TestShapeOutputShape, error) {
+	req, out := c.OutputService10TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService10TestShapeBodyStructure struct {
 	Foo *string `type:"string"`
 
@@ -808,6 +1119,16 @@ type metadataOutputService10TestShapeBodyStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService10TestShapeBodyStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService10TestShapeBodyStructure) GoString() string {
+	return s.String()
+}
+
 type OutputService10TestShapeOutputService10TestCaseOperation1Input struct {
 	metadataOutputService10TestShapeOutputService10TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -816,6 +1137,16 @@ type metadataOutputService10TestShapeOutputService10TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService10TestShapeOutputShape struct {
 	Data *OutputService10TestShapeBodyStructure `type:"structure"`
 
@@ -828,6 +1159,16 @@ type metadataOutputService10TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Data"`
 }
 
+// String returns the string representation
+func (s OutputService10TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService10TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService11ProtocolTest struct {
 	*aws.Service
 }
@@ -842,11 +1183,11 @@ func NewOutputService11ProtocolTest(config *aws.Config) *OutputService11Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService11ProtocolTest{service}
 }
@@ -883,6 +1224,16 @@ func (c *OutputService11ProtocolTest) OutputService11TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService11TestCaseOperation1WithContext is the same as OutputService11TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService11ProtocolTest) OutputService11TestCaseOperation1WithContext(ctx aws.Context, input *OutputService11TestShapeOutputService11TestCaseOperation1Input) (*OutputService11TestShapeOutputShape, error) {
+	req, out := c.OutputService11TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService11TestShapeOutputService11TestCaseOperation1Input struct {
 	metadataOutputService11TestShapeOutputService11TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -891,6 +1242,16 @@ type metadataOutputService11TestShapeOutputService11TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService11TestShapeOutputShape struct {
 	Stream []byte `type:"blob"`
 
@@ -901,6 +1262,16 @@ type metadataOutputService11TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Stream"`
 }
 
+// String returns the string representation
+func (s OutputService11TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService11TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService12ProtocolTest struct {
 	*aws.Service
 }
@@ -915,11 +1286,11 @@ func NewOutputService12ProtocolTest(config *aws.Config) *OutputService12Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	return &OutputService12ProtocolTest{service}
 }
@@ -956,6 +1327,16 @@ func (c *OutputService12ProtocolTest) OutputService12TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService12TestCaseOperation1WithContext is the same as OutputService12TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService12ProtocolTest) OutputService12TestCaseOperation1WithContext(ctx aws.Context, input *OutputService12TestShapeOutputService12TestCaseOperation1Input) (*OutputService12TestShapeOutputShape, error) {
+	req, out := c.OutputService12TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService12TestShapeOutputService12TestCaseOperation1Input struct {
 	metadataOutputService12TestShapeOutputService12TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -964,6 +1345,16 @@ type metadataOutputService12TestShapeOutputService12TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService12TestShapeOutputService12TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService12TestShapeOutputService12TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService12TestShapeOutputShape struct {
 	String *string `type:"string"`
 
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/restxml"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService1ProtocolTest{service}
 }
@@ -87,6 +88,16 @@ func (c *InputService1ProtocolTest) InputService1TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService1TestCaseOperation1WithContext is the same as InputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService1ProtocolTest) InputService1TestCaseOperation1WithContext(ctx aws.Context, input *InputService1TestShapeInputShape) (*InputService1TestShapeInputService1TestCaseOperation1Output, error) {
+	req, out := c.InputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService1TestCaseOperation2 = "OperationName"
 
 // InputService1TestCaseOperation2Request generates a request for the InputService1TestCaseOperation2 operation.
@@ -113,6 +124,16 @@ func (c *InputService1ProtocolTest) InputService1TestCaseOperation2(input *Input
 	return out, err
 }
 
+// InputService1TestCaseOperation2WithContext is the same as InputService1TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService1ProtocolTest) InputService1TestCaseOperation2WithContext(ctx aws.Context, input *InputService1TestShapeInputShape) (*InputService1TestShapeInputService1TestCaseOperation2Output, error) {
+	req, out := c.InputService1TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService1TestShapeInputService1TestCaseOperation1Output struct {
 	metadataInputService1TestShapeInputService1TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -121,6 +142,16 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService1TestShapeInputService1TestCaseOperation2Output struct {
 	metadataInputService1TestShapeInputService1TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -129,6 +160,16 @@ type metadataInputService1TestShapeInputService1TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputService1TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService1TestShapeInputShape struct {
 	Description *string `type:"string"`
 
@@ -141,6 +182,16 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService1TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -155,11 +206,11 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService2ProtocolTest{service}
 }
@@ -198,6 +249,16 @@ func (c *InputService2ProtocolTest) InputService2TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService2TestCaseOperation1WithContext is the same as InputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService2ProtocolTest) InputService2TestCaseOperation1WithContext(ctx aws.Context, input *InputService2TestShapeInputShape) (*InputService2TestShapeInputService2TestCaseOperation1Output, error) {
+	req, out := c.InputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService2TestShapeInputService2TestCaseOperation1Output struct {
 	metadataInputService2TestShapeInputService2TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -206,6 +267,16 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService2TestShapeInputShape struct {
 	First *bool `type:"boolean"`
 
@@ -222,6 +293,16 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService2TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -236,11 +317,11 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService3ProtocolTest{service}
 }
@@ -279,6 +360,16 @@ func (c *InputService3ProtocolTest) InputService3TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService3TestCaseOperation1WithContext is the same as InputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService3ProtocolTest) InputService3TestCaseOperation1WithContext(ctx aws.Context, input *InputService3TestShapeInputShape) (*InputService3TestShapeInputService3TestCaseOperation1Output, error) {
+	req, out := c.InputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService3TestShapeInputService3TestCaseOperation1Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -287,6 +378,16 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeInputShape struct {
 	Description *string `type:"string"`
 
@@ -299,6 +400,16 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService3TestShapeSubStructure struct {
 	Bar *string `type:"string"`
 
@@ -311,6 +422,16 @@ type metadataInputService3TestShapeSubStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService3TestShapeSubStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService3TestShapeSubStructure) GoString() string {
+	return s.String()
+}
+
 type InputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -325,11 +446,11 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService4ProtocolTest{service}
 }
@@ -368,6 +489,16 @@ func (c *InputService4ProtocolTest) InputService4TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService4TestCaseOperation1WithContext is the same as InputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService4ProtocolTest) InputService4TestCaseOperation1WithContext(ctx aws.Context, input *InputService4TestShapeInputShape) (*InputService4TestShapeInputService4TestCaseOperation1Output, error) {
+	req, out := c.InputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService4TestShapeInputService4TestCaseOperation1Output struct {
 	metadataInputService4TestShapeInputService4TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -376,6 +507,16 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeInputShape struct {
 	Description *string `type:"string"`
 
@@ -388,6 +529,16 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService4TestShapeSubStructure struct {
 	Bar *string `type:"string"`
 
@@ -400,6 +551,16 @@ type metadataInputService4TestShapeSubStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService4TestShapeSubStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService4TestShapeSubStructure) GoString() string {
+	return s.String()
+}
+
 type InputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -414,11 +575,11 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService5ProtocolTest{service}
 }
@@ -457,6 +618,16 @@ func (c *InputService5ProtocolTest) InputService5TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService5TestCaseOperation1WithContext is the same as InputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService5ProtocolTest) InputService5TestCaseOperation1WithContext(ctx aws.Context, input *InputService5TestShapeInputShape) (*InputService5TestShapeInputService5TestCaseOperation1Output, error) {
+	req, out := c.InputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService5TestShapeInputService5TestCaseOperation1Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -465,6 +636,16 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService5TestShapeInputShape struct {
 	ListParam []*string `type:"list"`
 
@@ -475,6 +656,16 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService5TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -489,11 +680,11 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService6ProtocolTest{service}
 }
@@ -532,6 +723,16 @@ func (c *InputService6ProtocolTest) InputService6TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService6TestCaseOperation1WithContext is the same as InputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService6ProtocolTest) InputService6TestCaseOperation1WithContext(ctx aws.Context, input *InputService6TestShapeInputShape) (*InputService6TestShapeInputService6TestCaseOperation1Output, error) {
+	req, out := c.InputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService6TestShapeInputService6TestCaseOperation1Output struct {
 	metadataInputService6TestShapeInputService6TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -540,6 +741,16 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService6TestShapeInputShape struct {
 	ListParam []*string `locationName:"AlternateName" locationNameList:"NotMember" type:"list"`
 
@@ -550,6 +761,16 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService6TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -564,11 +785,11 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService7ProtocolTest{service}
 }
@@ -607,6 +828,16 @@ func (c *InputService7ProtocolTest) InputService7TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService7TestCaseOperation1WithContext is the same as InputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService7ProtocolTest) InputService7TestCaseOperation1WithContext(ctx aws.Context, input *InputService7TestShapeInputShape) (*InputService7TestShapeInputService7TestCaseOperation1Output, error) {
+	req, out := c.InputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService7TestShapeInputService7TestCaseOperation1Output struct {
 	metadataInputService7TestShapeInputService7TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -615,6 +846,16 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService7TestShapeInputShape struct {
 	ListParam []*string `type:"list" flattened:"true"`
 
@@ -625,6 +866,16 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService7TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -639,11 +890,11 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService8ProtocolTest{service}
 }
@@ -682,6 +933,16 @@ func (c *InputService8ProtocolTest) InputService8TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService8TestCaseOperation1WithContext is the same as InputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService8ProtocolTest) InputService8TestCaseOperation1WithContext(ctx aws.Context, input *InputService8TestShapeInputShape) (*InputService8TestShapeInputService8TestCaseOperation1Output, error) {
+	req, out := c.InputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService8TestShapeInputService8TestCaseOperation1Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -690,6 +951,16 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService8TestShapeInputShape struct {
 	ListParam []*string `locationName:"item" type:"list" flattened:"true"`
 
@@ -700,6 +971,16 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService8TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService9ProtocolTest struct {
 	*aws.Service
 }
@@ -714,11 +995,11 @@ func NewInputService9ProtocolTest(config *aws.Config) *InputService9ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService9ProtocolTest{service}
 }
@@ -757,6 +1038,16 @@ func (c *InputService9ProtocolTest) InputService9TestCaseOperation1(input *Input
 	return out, err
 }
 
+// InputService9TestCaseOperation1WithContext is the same as InputService9TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService9ProtocolTest) InputService9TestCaseOperation1WithContext(ctx aws.Context, input *InputService9TestShapeInputShape) (*InputService9TestShapeInputService9TestCaseOperation1Output, error) {
+	req, out := c.InputService9TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService9TestShapeInputService9TestCaseOperation1Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -765,6 +1056,16 @@ type metadataInputService9TestShapeInputService9TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeInputShape struct {
 	ListParam []*InputService9TestShapeSingleFieldStruct `locationName:"item" type:"list" flattened:"true"`
 
@@ -775,6 +1076,16 @@ type metadataInputService9TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService9TestShapeSingleFieldStruct struct {
 	Element *string `locationName:"value" type:"string"`
 
@@ -785,6 +1096,16 @@ type metadataInputService9TestShapeSingleFieldStruct struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService9TestShapeSingleFieldStruct) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService9TestShapeSingleFieldStruct) GoString() string {
+	return s.String()
+}
+
 type InputService10ProtocolTest struct {
 	*aws.Service
 }
@@ -799,11 +1120,11 @@ func NewInputService10ProtocolTest(config *aws.Config) *InputService10ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService10ProtocolTest{service}
 }
@@ -842,6 +1163,16 @@ func (c *InputService10ProtocolTest) InputService10TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService10TestCaseOperation1WithContext is the same as InputService10TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService10ProtocolTest) InputService10TestCaseOperation1WithContext(ctx aws.Context, input *InputService10TestShapeInputShape) (*InputService10TestShapeInputService10TestCaseOperation1Output, error) {
+	req, out := c.InputService10TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService10TestShapeInputService10TestCaseOperation1Output struct {
 	metadataInputService10TestShapeInputService10TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -850,6 +1181,16 @@ type metadataInputService10TestShapeInputService10TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService10TestShapeInputService10TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService10TestShapeInputService10TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService10TestShapeInputShape struct {
 	StructureParam *InputService10TestShapeStructureShape `type:"structure"`
 
@@ -860,6 +1201,16 @@ type metadataInputService10TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService10TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService10TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService10TestShapeStructureShape struct {
 	B []byte `locationName:"b" type:"blob"`
 
@@ -872,6 +1223,16 @@ type metadataInputService10TestShapeStructureShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService10TestShapeStructureShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService10TestShapeStructureShape) GoString() string {
+	return s.String()
+}
+
 type InputService11ProtocolTest struct {
 	*aws.Service
 }
@@ -886,11 +1247,11 @@ func NewInputService11ProtocolTest(config *aws.Config) *InputService11ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService11ProtocolTest{service}
 }
@@ -929,6 +1290,16 @@ func (c *InputService11ProtocolTest) InputService11TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService11TestCaseOperation1WithContext is the same as InputService11TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService11ProtocolTest) InputService11TestCaseOperation1WithContext(ctx aws.Context, input *InputService11TestShapeInputShape) (*InputService11TestShapeInputService11TestCaseOperation1Output, error) {
+	req, out := c.InputService11TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService11TestShapeInputService11TestCaseOperation1Output struct {
 	metadataInputService11TestShapeInputService11TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -937,6 +1308,16 @@ type metadataInputService11TestShapeInputService11TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService11TestShapeInputService11TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService11TestShapeInputService11TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService11TestShapeInputShape struct {
 	Foo map[string]*string `location:"headers" locationName:"x-foo-" type:"map"`
 
@@ -947,6 +1328,16 @@ type metadataInputService11TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService11TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService11TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService12ProtocolTest struct {
 	*aws.Service
 }
@@ -961,11 +1352,11 @@ func NewInputService12ProtocolTest(config *aws.Config) *InputService12ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService12ProtocolTest{service}
 }
@@ -1004,6 +1395,16 @@ func (c *InputService12ProtocolTest) InputService12TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService12TestCaseOperation1WithContext is the same as InputService12TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService12ProtocolTest) InputService12TestCaseOperation1WithContext(ctx aws.Context, input *InputService12TestShapeInputShape) (*InputService12TestShapeInputService12TestCaseOperation1Output, error) {
+	req, out := c.InputService12TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService12TestShapeInputService12TestCaseOperation1Output struct {
 	metadataInputService12TestShapeInputService12TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1012,6 +1413,16 @@ type metadataInputService12TestShapeInputService12TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService12TestShapeInputService12TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService12TestShapeInputService12TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService12TestShapeInputShape struct {
 	Foo *string `locationName:"foo" type:"string"`
 
@@ -1022,6 +1433,16 @@ type metadataInputService12TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Foo"`
 }
 
+// String returns the string representation
+func (s InputService12TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService12TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService13ProtocolTest struct {
 	*aws.Service
 }
@@ -1036,11 +1457,11 @@ func NewInputService13ProtocolTest(config *aws.Config) *InputService13ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService13ProtocolTest{service}
 }
@@ -1079,6 +1500,16 @@ func (c *InputService13ProtocolTest) InputService13TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService13TestCaseOperation1WithContext is the same as InputService13TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService13ProtocolTest) InputService13TestCaseOperation1WithContext(ctx aws.Context, input *InputService13TestShapeInputShape) (*InputService13TestShapeInputService13TestCaseOperation1Output, error) {
+	req, out := c.InputService13TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService13TestCaseOperation2 = "OperationName"
 
 // InputService13TestCaseOperation2Request generates a request for the InputService13TestCaseOperation2 operation.
@@ -1105,6 +1536,16 @@ func (c *InputService13ProtocolTest) InputService13TestCaseOperation2(input *Inp
 	return out, err
 }
 
+// InputService13TestCaseOperation2WithContext is the same as InputService13TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService13ProtocolTest) InputService13TestCaseOperation2WithContext(ctx aws.Context, input *InputService13TestShapeInputShape) (*InputService13TestShapeInputService13TestCaseOperation2Output, error) {
+	req, out := c.InputService13TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService13TestShapeInputService13TestCaseOperation1Output struct {
 	metadataInputService13TestShapeInputService13TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1113,6 +1554,16 @@ type metadataInputService13TestShapeInputService13TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService13TestShapeInputService13TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService13TestShapeInputService13TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService13TestShapeInputService13TestCaseOperation2Output struct {
 	metadataInputService13TestShapeInputService13TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -1121,6 +1572,16 @@ type metadataInputService13TestShapeInputService13TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService13TestShapeInputService13TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService13TestShapeInputService13TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService13TestShapeInputShape struct {
 	Foo []byte `locationName:"foo" type:"blob"`
 
@@ -1131,6 +1592,16 @@ type metadataInputService13TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Foo"`
 }
 
+// String returns the string representation
+func (s InputService13TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService13TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService14ProtocolTest struct {
 	*aws.Service
 }
@@ -1145,11 +1616,11 @@ func NewInputService14ProtocolTest(config *aws.Config) *InputService14ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService14ProtocolTest{service}
 }
@@ -1188,6 +1659,16 @@ func (c *InputService14ProtocolTest) InputService14TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService14TestCaseOperation1WithContext is the same as InputService14TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService14ProtocolTest) InputService14TestCaseOperation1WithContext(ctx aws.Context, input *InputService14TestShapeInputShape) (*InputService14TestShapeInputService14TestCaseOperation1Output, error) {
+	req, out := c.InputService14TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService14TestCaseOperation2 = "OperationName"
 
 // InputService14TestCaseOperation2Request generates a request for the InputService14TestCaseOperation2 operation.
@@ -1214,6 +1695,16 @@ func (c *InputService14ProtocolTest) InputService14TestCaseOperation2(input *Inp
 	return out, err
 }
 
+// InputService14TestCaseOperation2WithContext is the same as InputService14TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService14ProtocolTest) InputService14TestCaseOperation2WithContext(ctx aws.Context, input *InputService14TestShapeInputShape) (*InputService14TestShapeInputService14TestCaseOperation2Output, error) {
+	req, out := c.InputService14TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService14TestCaseOperation3 = "OperationName"
 
 // InputService14TestCaseOperation3Request generates a request for the InputService14TestCaseOperation3 operation.
@@ -1240,6 +1731,16 @@ func (c *InputService14ProtocolTest) InputService14TestCaseOperation3(input *Inp
 	return out, err
 }
 
+// InputService14TestCaseOperation3WithContext is the same as InputService14TestCaseOperation3 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService14ProtocolTest) InputService14TestCaseOperation3WithContext(ctx aws.Context, input *InputService14TestShapeInputShape) (*InputService14TestShapeInputService14TestCaseOperation3Output, error) {
+	req, out := c.InputService14TestCaseOperation3Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService14TestShapeFooShape struct {
 	Baz *string `locationName:"baz" type:"string"`
 
@@ -1250,6 +1751,16 @@ type metadataInputService14TestShapeFooShape struct {
 	SDKShapeTraits bool `locationName:"foo" type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService14TestShapeFooShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService14TestShapeFooShape) GoString() string {
+	return s.String()
+}
+
 type InputService14TestShapeInputService14TestCaseOperation1Output struct {
 	metadataInputService14TestShapeInputService14TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1258,6 +1769,16 @@ type metadataInputService14TestShapeInputService14TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService14TestShapeInputService14TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService14TestShapeInputService14TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService14TestShapeInputService14TestCaseOperation2Output struct {
 	metadataInputService14TestShapeInputService14TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -1266,6 +1787,16 @@ type metadataInputService14TestShapeInputService14TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService14TestShapeInputService14TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService14TestShapeInputService14TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService14TestShapeInputService14TestCaseOperation3Output struct {
 	metadataInputService14TestShapeInputService14TestCaseOperation3Output `json:"-" xml:"-"`
 }
@@ -1274,6 +1805,16 @@ type metadataInputService14TestShapeInputService14TestCaseOperation3Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService14TestShapeInputService14TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService14TestShapeInputService14TestCaseOperation3Output) GoString() string {
+	return s.String()
+}
+
 type InputService14TestShapeInputShape struct {
 	Foo *InputService14TestShapeFooShape `locationName:"foo" type:"structure"`
 
@@ -1284,6 +1825,16 @@ type metadataInputService14TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Foo"`
 }
 
+// String returns the string representation
+func (s InputService14TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService14TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService15ProtocolTest struct {
 	*aws.Service
 }
@@ -1298,11 +1849,11 @@ func NewInputService15ProtocolTest(config *aws.Config) *InputService15ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService15ProtocolTest{service}
 }
@@ -1341,6 +1892,16 @@ func (c *InputService15ProtocolTest) InputService15TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService15TestCaseOperation1WithContext is the same as InputService15TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService15ProtocolTest) InputService15TestCaseOperation1WithContext(ctx aws.Context, input *InputService15TestShapeInputShape) (*InputService15TestShapeInputService15TestCaseOperation1Output, error) {
+	req, out := c.InputService15TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService15TestShapeGrant struct {
 	Grantee *InputService15TestShapeGrantee `type:"structure"`
 
@@ -1351,6 +1912,16 @@ type metadataInputService15TestShapeGrant struct {
 	SDKShapeTraits bool `locationName:"Grant" type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService15TestShapeGrant) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService15TestShapeGrant) GoString() string {
+	return s.String()
+}
+
 type InputService15TestShapeGrantee struct {
 	EmailAddress *string `type:"string"`
 
@@ -1363,6 +1934,16 @@ type metadataInputService15TestShapeGrantee struct {
 	SDKShapeTraits bool `type:"structure" xmlPrefix:"xsi" xmlURI:"http://www.w3.org/2001/XMLSchema-instance"`
 }
 
+// String returns the string representation
+func (s InputService15TestShapeGrantee) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService15TestShapeGrantee) GoString() string {
+	return s.String()
+}
+
 type InputService15TestShapeInputService15TestCaseOperation1Output struct {
 	metadataInputService15TestShapeInputService15TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1371,6 +1952,16 @@ type metadataInputService15TestShapeInputService15TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService15TestShapeInputService15TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService15TestShapeInputService15TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService15TestShapeInputShape struct {
 	Grant *InputService15TestShapeGrant `locationName:"Grant" type:"structure"`
 
@@ -1381,6 +1972,16 @@ type metadataInputService15TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Grant"`
 }
 
+// String returns the string representation
+func (s InputService15TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService15TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService16ProtocolTest struct {
 	*aws.Service
 }
@@ -1395,11 +1996,11 @@ func NewInputService16ProtocolTest(config *aws.Config) *InputService16ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService16ProtocolTest{service}
 }
@@ -1438,6 +2039,16 @@ func (c *InputService16ProtocolTest) InputService16TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService16TestCaseOperation1WithContext is the same as InputService16TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService16ProtocolTest) InputService16TestCaseOperation1WithContext(ctx aws.Context, input *InputService16TestShapeInputShape) (*InputService16TestShapeInputService16TestCaseOperation1Output, error) {
+	req, out := c.InputService16TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService16TestShapeInputService16TestCaseOperation1Output struct {
 	metadataInputService16TestShapeInputService16TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1446,6 +2057,16 @@ type metadataInputService16TestShapeInputService16TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService16TestShapeInputService16TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService16TestShapeInputService16TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService16TestShapeInputShape struct {
 	Bucket *string `location:"uri" type:"string"`
 
@@ -1458,6 +2079,16 @@ type metadataInputService16TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService16TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService16TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService17ProtocolTest struct {
 	*aws.Service
 }
@@ -1472,11 +2103,11 @@ func NewInputService17ProtocolTest(config *aws.Config) *InputService17ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService17ProtocolTest{service}
 }
@@ -1515,6 +2146,16 @@ func (c *InputService17ProtocolTest) InputService17TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService17TestCaseOperation1WithContext is the same as InputService17TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService17ProtocolTest) InputService17TestCaseOperation1WithContext(ctx aws.Context, input *InputService17TestShapeInputShape) (*InputService17TestShapeInputService17TestCaseOperation1Output, error) {
+	req, out := c.InputService17TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService17TestCaseOperation2 = "OperationName"
 
 // InputService17TestCaseOperation2Request generates a request for the InputService17TestCaseOperation2 operation.
@@ -1541,6 +2182,16 @@ func (c *InputService17ProtocolTest) InputService17TestCaseOperation2(input *Inp
 	return out, err
 }
 
+// InputService17TestCaseOperation2WithContext is the same as InputService17TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService17ProtocolTest) InputService17TestCaseOperation2WithContext(ctx aws.Context, input *InputService17TestShapeInputShape) (*InputService17TestShapeInputService17TestCaseOperation2Output, error) {
+	req, out := c.InputService17TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService17TestShapeInputService17TestCaseOperation1Output struct {
 	metadataInputService17TestShapeInputService17TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1549,6 +2200,16 @@ type metadataInputService17TestShapeInputService17TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService17TestShapeInputService17TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService17TestShapeInputService17TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService17TestShapeInputService17TestCaseOperation2Output struct {
 	metadataInputService17TestShapeInputService17TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -1557,6 +2218,16 @@ type metadataInputService17TestShapeInputService17TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService17TestShapeInputService17TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService17TestShapeInputService17TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService17TestShapeInputShape struct {
 	Foo *string `location:"querystring" locationName:"param-name" type:"string"`
 
@@ -1567,6 +2238,16 @@ type metadataInputService17TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService17TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService17TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService18ProtocolTest struct {
 	*aws.Service
 }
@@ -1581,11 +2262,11 @@ func NewInputService18ProtocolTest(config *aws.Config) *InputService18ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService18ProtocolTest{service}
 }
@@ -1624,6 +2305,16 @@ func (c *InputService18ProtocolTest) InputService18TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService18TestCaseOperation1WithContext is the same as InputService18TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService18ProtocolTest) InputService18TestCaseOperation1WithContext(ctx aws.Context, input *InputService18TestShapeInputShape) (*InputService18TestShapeInputService18TestCaseOperation1Output, error) {
+	req, out := c.InputService18TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService18TestCaseOperation2 = "OperationName"
 
 // InputService18TestCaseOperation2Request generates a request for the InputService18TestCaseOperation2 operation.
@@ -1650,6 +2341,16 @@ func (c *InputService18ProtocolTest) InputService18TestCaseOperation2(input *Inp
 	return out, err
 }
 
+// InputService18TestCaseOperation2WithContext is the same as InputService18TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService18ProtocolTest) InputService18TestCaseOperation2WithContext(ctx aws.Context, input *InputService18TestShapeInputShape) (*InputService18TestShapeInputService18TestCaseOperation2Output, error) {
+	req, out := c.InputService18TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService18TestCaseOperation3 = "OperationName"
 
 // InputService18TestCaseOperation3Request generates a request for the InputService18TestCaseOperation3 operation.
@@ -1676,6 +2377,16 @@ func (c *InputService18ProtocolTest) InputService18TestCaseOperation3(input *Inp
 	return out, err
 }
 
+// InputService18TestCaseOperation3WithContext is the same as InputService18TestCaseOperation3 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService18ProtocolTest) InputService18TestCaseOperation3WithContext(ctx aws.Context, input *InputService18TestShapeInputShape) (*InputService18TestShapeInputService18TestCaseOperation3Output, error) {
+	req, out := c.InputService18TestCaseOperation3Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService18TestCaseOperation4 = "OperationName"
 
 // InputService18TestCaseOperation4Request generates a request for the InputService18TestCaseOperation4 operation.
@@ -1702,6 +2413,16 @@ func (c *InputService18ProtocolTest) InputService18TestCaseOperation4(input *Inp
 	return out, err
 }
 
+// InputService18TestCaseOperation4WithContext is the same as InputService18TestCaseOperation4 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService18ProtocolTest) InputService18TestCaseOperation4WithContext(ctx aws.Context, input *InputService18TestShapeInputShape) (*InputService18TestShapeInputService18TestCaseOperation4Output, error) {
+	req, out := c.InputService18TestCaseOperation4Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService18TestCaseOperation5 = "OperationName"
 
 // InputService18TestCaseOperation5Request generates a request for the InputService18TestCaseOperation5 operation.
@@ -1728,6 +2449,16 @@ func (c *InputService18ProtocolTest) InputService18TestCaseOperation5(input *Inp
 	return out, err
 }
 
+// InputService18TestCaseOperation5WithContext is the same as InputService18TestCaseOperation5 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService18ProtocolTest) InputService18TestCaseOperation5WithContext(ctx aws.Context, input *InputService18TestShapeInputShape) (*InputService18TestShapeInputService18TestCaseOperation5Output, error) {
+	req, out := c.InputService18TestCaseOperation5Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInputService18TestCaseOperation6 = "OperationName"
 
 // InputService18TestCaseOperation6Request generates a request for the InputService18TestCaseOperation6 operation.
@@ -1754,6 +2485,16 @@ func (c *InputService18ProtocolTest) InputService18TestCaseOperation6(input *Inp
 	return out, err
 }
 
+// InputService18TestCaseOperation6WithContext is the same as InputService18TestCaseOperation6 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService18ProtocolTest) InputService18TestCaseOperation6WithContext(ctx aws.Context, input *InputService18TestShapeInputShape) (*InputService18TestShapeInputService18TestCaseOperation6Output, error) {
+	req, out := c.InputService18TestCaseOperation6Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService18TestShapeInputService18TestCaseOperation1Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1762,6 +2503,16 @@ type metadataInputService18TestShapeInputService18TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService18TestShapeInputService18TestCaseOperation2Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation2Output `json:"-" xml:"-"`
 }
@@ -1770,6 +2521,16 @@ type metadataInputService18TestShapeInputService18TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation2Output) GoString() string {
+	return s.String()
+}
+
 type InputService18TestShapeInputService18TestCaseOperation3Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation3Output `json:"-" xml:"-"`
 }
@@ -1778,6 +2539,16 @@ type metadataInputService18TestShapeInputService18TestCaseOperation3Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation3Output) GoString() string {
+	return s.String()
+}
+
 type InputService18TestShapeInputService18TestCaseOperation4Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation4Output `json:"-" xml:"-"`
 }
@@ -1786,6 +2557,16 @@ type metadataInputService18TestShapeInputService18TestCaseOperation4Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation4Output) GoString() string {
+	return s.String()
+}
+
 type InputService18TestShapeInputService18TestCaseOperation5Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation5Output `json:"-" xml:"-"`
 }
@@ -1794,6 +2575,16 @@ type metadataInputService18TestShapeInputService18TestCaseOperation5Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation5Output) GoString() string {
+	return s.String()
+}
+
 type InputService18TestShapeInputService18TestCaseOperation6Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation6Output `json:"-" xml:"-"`
 }
@@ -1802,6 +2593,16 @@ type metadataInputService18TestShapeInputService18TestCaseOperation6Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeInputService18TestCaseOperation6Output) GoString() string {
+	return s.String()
+}
+
 type InputService18TestShapeInputShape struct {
 	RecursiveStruct *InputService18TestShapeRecursiveStructType `type:"structure"`
 
@@ -1812,6 +2613,16 @@ type metadataInputService18TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 type InputService18TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -1828,6 +2639,16 @@ type metadataInputService18TestShapeRecursiveStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService18TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService18TestShapeRecursiveStructType) GoString() string {
+	return s.String()
+}
+
 type InputService19ProtocolTest struct {
 	*aws.Service
 }
@@ -1842,11 +2663,11 @@ func NewInputService19ProtocolTest(config *aws.Config) *InputService19ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &InputService19ProtocolTest{service}
 }
@@ -1885,6 +2706,16 @@ func (c *InputService19ProtocolTest) InputService19TestCaseOperation1(input *Inp
 	return out, err
 }
 
+// InputService19TestCaseOperation1WithContext is the same as InputService19TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *InputService19ProtocolTest) InputService19TestCaseOperation1WithContext(ctx aws.Context, input *InputService19TestShapeInputShape) (*InputService19TestShapeInputService19TestCaseOperation1Output, error) {
+	req, out := c.InputService19TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type InputService19TestShapeInputService19TestCaseOperation1Output struct {
 	metadataInputService19TestShapeInputService19TestCaseOperation1Output `json:"-" xml:"-"`
 }
@@ -1893,6 +2724,16 @@ type metadataInputService19TestShapeInputService19TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService19TestShapeInputService19TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService19TestShapeInputService19TestCaseOperation1Output) GoString() string {
+	return s.String()
+}
+
 type InputService19TestShapeInputShape struct {
 	TimeArgInHeader *time.Time `location:"header" locationName:"x-amz-timearg" type:"timestamp" timestampFormat:"rfc822"`
 
@@ -1903,6 +2744,16 @@ type metadataInputService19TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputService19TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputService19TestShapeInputShape) GoString() string {
+	return s.String()
+}
+
 //
 // Tests begin here
 //
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/internal/protocol/restxml"
 	"github.com/aws/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
@@ -44,11 +45,11 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService1ProtocolTest{service}
 }
@@ -85,6 +86,16 @@ func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService1TestCaseOperation1WithContext is the same as OutputService1TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation1WithContext(ctx aws.Context, input *OutputService1TestShapeOutputService1TestCaseOperation1Input) (*OutputService1TestShapeOutputShape, error) {
+	req, out := c.OutputService1TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opOutputService1TestCaseOperation2 = "OperationName"
 
 // OutputService1TestCaseOperation2Request generates a request for the OutputService1TestCaseOperation2 operation.
@@ -109,6 +120,16 @@ func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation2(input *Out
 	return out, err
 }
 
+// OutputService1TestCaseOperation2WithContext is the same as OutputService1TestCaseOperation2 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService1ProtocolTest) OutputService1TestCaseOperation2WithContext(ctx aws.Context, input *OutputService1TestShapeOutputService1TestCaseOperation2Input) (*OutputService1TestShapeOutputShape, error) {
+	req, out := c.OutputService1TestCaseOperation2Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService1TestShapeOutputService1TestCaseOperation1Input struct {
 	metadataOutputService1TestShapeOutputService1TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -117,6 +138,16 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService1TestShapeOutputService1TestCaseOperation2Input struct {
 	metadataOutputService1TestShapeOutputService1TestCaseOperation2Input `json:"-" xml:"-"`
 }
@@ -125,6 +156,16 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation2Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation2Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputService1TestCaseOperation2Input) GoString() string {
+	return s.String()
+}
+
 type OutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -155,6 +196,16 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService1TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService2ProtocolTest struct {
 	*aws.Service
 }
@@ -169,11 +220,11 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService2ProtocolTest{service}
 }
@@ -210,6 +261,16 @@ func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService2TestCaseOperation1WithContext is the same as OutputService2TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService2ProtocolTest) OutputService2TestCaseOperation1WithContext(ctx aws.Context, input *OutputService2TestShapeOutputService2TestCaseOperation1Input) (*OutputService2TestShapeOutputShape, error) {
+	req, out := c.OutputService2TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService2TestShapeOutputService2TestCaseOperation1Input struct {
 	metadataOutputService2TestShapeOutputService2TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -218,6 +279,16 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService2TestShapeOutputShape struct {
 	Blob []byte `type:"blob"`
 
@@ -228,6 +299,16 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService2TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService3ProtocolTest struct {
 	*aws.Service
 }
@@ -242,11 +323,11 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService3ProtocolTest{service}
 }
@@ -283,6 +364,16 @@ func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService3TestCaseOperation1WithContext is the same as OutputService3TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService3ProtocolTest) OutputService3TestCaseOperation1WithContext(ctx aws.Context, input *OutputService3TestShapeOutputService3TestCaseOperation1Input) (*OutputService3TestShapeOutputShape, error) {
+	req, out := c.OutputService3TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService3TestShapeOutputService3TestCaseOperation1Input struct {
 	metadataOutputService3TestShapeOutputService3TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -291,6 +382,16 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService3TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -301,6 +402,16 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService3TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService4ProtocolTest struct {
 	*aws.Service
 }
@@ -315,11 +426,11 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService4ProtocolTest{service}
 }
@@ -356,6 +467,16 @@ func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService4TestCaseOperation1WithContext is the same as OutputService4TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService4ProtocolTest) OutputService4TestCaseOperation1WithContext(ctx aws.Context, input *OutputService4TestShapeOutputService4TestCaseOperation1Input) (*OutputService4TestShapeOutputShape, error) {
+	req, out := c.OutputService4TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService4TestShapeOutputService4TestCaseOperation1Input struct {
 	metadataOutputService4TestShapeOutputService4TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -364,6 +485,16 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `locationNameList:"item" type:"list"`
 
@@ -374,6 +505,16 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService4TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService5ProtocolTest struct {
 	*aws.Service
 }
@@ -388,11 +529,11 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService5ProtocolTest{service}
 }
@@ -429,6 +570,16 @@ func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService5TestCaseOperation1WithContext is the same as OutputService5TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService5ProtocolTest) OutputService5TestCaseOperation1WithContext(ctx aws.Context, input *OutputService5TestShapeOutputService5TestCaseOperation1Input) (*OutputService5TestShapeOutputShape, error) {
+	req, out := c.OutputService5TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService5TestShapeOutputService5TestCaseOperation1Input struct {
 	metadataOutputService5TestShapeOutputService5TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -437,6 +588,16 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*string `type:"list" flattened:"true"`
 
@@ -447,6 +608,16 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService5TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService6ProtocolTest struct {
 	*aws.Service
 }
@@ -461,11 +632,11 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService6ProtocolTest{service}
 }
@@ -502,6 +673,16 @@ func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService6TestCaseOperation1WithContext is the same as OutputService6TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService6ProtocolTest) OutputService6TestCaseOperation1WithContext(ctx aws.Context, input *OutputService6TestShapeOutputService6TestCaseOperation1Input) (*OutputService6TestShapeOutputShape, error) {
+	req, out := c.OutputService6TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService6TestShapeOutputService6TestCaseOperation1Input struct {
 	metadataOutputService6TestShapeOutputService6TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -510,6 +691,16 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService6TestShapeOutputShape struct {
 	Map map[string]*OutputService6TestShapeSingleStructure `type:"map"`
 
@@ -520,6 +711,16 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService6TestShapeSingleStructure struct {
 	Foo *string `locationName:"foo" type:"string"`
 
@@ -530,6 +731,16 @@ type metadataOutputService6TestShapeSingleStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService6TestShapeSingleStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService6TestShapeSingleStructure) GoString() string {
+	return s.String()
+}
+
 type OutputService7ProtocolTest struct {
 	*aws.Service
 }
@@ -544,11 +755,11 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService7ProtocolTest{service}
 }
@@ -585,6 +796,16 @@ func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService7TestCaseOperation1WithContext is the same as OutputService7TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService7ProtocolTest) OutputService7TestCaseOperation1WithContext(ctx aws.Context, input *OutputService7TestShapeOutputService7TestCaseOperation1Input) (*OutputService7TestShapeOutputShape, error) {
+	req, out := c.OutputService7TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService7TestShapeOutputService7TestCaseOperation1Input struct {
 	metadataOutputService7TestShapeOutputService7TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -593,6 +814,16 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService7TestShapeOutputShape struct {
 	Map map[string]*string `type:"map" flattened:"true"`
 
@@ -603,6 +834,16 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService7TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService8ProtocolTest struct {
 	*aws.Service
 }
@@ -617,11 +858,11 @@ func NewOutputService8ProtocolTest(config *aws.Config) *OutputService8ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService8ProtocolTest{service}
 }
@@ -658,6 +899,16 @@ func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService8TestCaseOperation1WithContext is the same as OutputService8TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService8ProtocolTest) OutputService8TestCaseOperation1WithContext(ctx aws.Context, input *OutputService8TestShapeOutputService8TestCaseOperation1Input) (*OutputService8TestShapeOutputShape, error) {
+	req, out := c.OutputService8TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService8TestShapeOutputService8TestCaseOperation1Input struct {
 	metadataOutputService8TestShapeOutputService8TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -666,6 +917,16 @@ type metadataOutputService8TestShapeOutputService8TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService8TestShapeOutputShape struct {
 	Map map[string]*string `locationNameKey:"foo" locationNameValue:"bar" type:"map"`
 
@@ -676,6 +937,16 @@ type metadataOutputService8TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService8TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService8TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService9ProtocolTest struct {
 	*aws.Service
 }
@@ -690,11 +961,11 @@ func NewOutputService9ProtocolTest(config *aws.Config) *OutputService9ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService9ProtocolTest{service}
 }
@@ -731,6 +1002,16 @@ func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1(input *Out
 	return out, err
 }
 
+// OutputService9TestCaseOperation1WithContext is the same as OutputService9TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1WithContext(ctx aws.Context, input *OutputService9TestShapeOutputService9TestCaseOperation1Input) (*OutputService9TestShapeOutputShape, error) {
+	req, out := c.OutputService9TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService9TestShapeOutputService9TestCaseOperation1Input struct {
 	metadataOutputService9TestShapeOutputService9TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -739,6 +1020,16 @@ type metadataOutputService9TestShapeOutputService9TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService9TestShapeOutputShape struct {
 	Data *OutputService9TestShapeSingleStructure `type:"structure"`
 
@@ -751,6 +1042,16 @@ type metadataOutputService9TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Data"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService9TestShapeSingleStructure struct {
 	Foo *string `type:"string"`
 
@@ -761,6 +1062,16 @@ type metadataOutputService9TestShapeSingleStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService9TestShapeSingleStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService9TestShapeSingleStructure) GoString() string {
+	return s.String()
+}
+
 type OutputService10ProtocolTest struct {
 	*aws.Service
 }
@@ -775,11 +1086,11 @@ func NewOutputService10ProtocolTest(config *aws.Config) *OutputService10Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService10ProtocolTest{service}
 }
@@ -816,6 +1127,16 @@ func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService10TestCaseOperation1WithContext is the same as OutputService10TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1WithContext(ctx aws.Context, input *OutputService10TestShapeOutputService10TestCaseOperation1Input) (*OutputService10TestShapeOutputShape, error) {
+	req, out := c.OutputService10TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService10TestShapeOutputService10TestCaseOperation1Input struct {
 	metadataOutputService10TestShapeOutputService10TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -824,6 +1145,16 @@ type metadataOutputService10TestShapeOutputService10TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService10TestShapeOutputShape struct {
 	Stream []byte `type:"blob"`
 
@@ -834,6 +1165,16 @@ type metadataOutputService10TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Stream"`
 }
 
+// String returns the string representation
+func (s OutputService10TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService10TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService11ProtocolTest struct {
 	*aws.Service
 }
@@ -848,11 +1189,11 @@ func NewOutputService11ProtocolTest(config *aws.Config) *OutputService11Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService11ProtocolTest{service}
 }
@@ -889,6 +1230,16 @@ func (c *OutputService11ProtocolTest) OutputService11TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService11TestCaseOperation1WithContext is the same as OutputService11TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService11ProtocolTest) OutputService11TestCaseOperation1WithContext(ctx aws.Context, input *OutputService11TestShapeOutputService11TestCaseOperation1Input) (*OutputService11TestShapeOutputShape, error) {
+	req, out := c.OutputService11TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService11TestShapeOutputService11TestCaseOperation1Input struct {
 	metadataOutputService11TestShapeOutputService11TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -897,6 +1248,16 @@ type metadataOutputService11TestShapeOutputService11TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService11TestShapeOutputShape struct {
 	Char *string `location:"header" locationName:"x-char" type:"character"`
 
@@ -923,6 +1284,16 @@ type metadataOutputService11TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService11TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService11TestShapeOutputShape) GoString() string {
+	return s.String()
+}
+
 type OutputService12ProtocolTest struct {
 	*aws.Service
 }
@@ -937,11 +1308,11 @@ func NewOutputService12ProtocolTest(config *aws.Config) *OutputService12Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	return &OutputService12ProtocolTest{service}
 }
@@ -978,6 +1349,16 @@ func (c *OutputService12ProtocolTest) OutputService12TestCaseOperation1(input *O
 	return out, err
 }
 
+// OutputService12TestCaseOperation1WithContext is the same as OutputService12TestCaseOperation1 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OutputService12ProtocolTest) OutputService12TestCaseOperation1WithContext(ctx aws.Context, input *OutputService12TestShapeOutputService12TestCaseOperation1Input) (*OutputService12TestShapeOutputShape, error) {
+	req, out := c.OutputService12TestCaseOperation1Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type OutputService12TestShapeOutputService12TestCaseOperation1Input struct {
 	metadataOutputService12TestShapeOutputService12TestCaseOperation1Input `json:"-" xml:"-"`
 }
@@ -986,6 +1367,16 @@ type metadataOutputService12TestShapeOutputService12TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputService12TestShapeOutputService12TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputService12TestShapeOutputService12TestCaseOperation1Input) GoString() string {
+	return s.String()
+}
+
 type OutputService12TestShapeOutputShape struct {
 	String *string `type:"string"`
 
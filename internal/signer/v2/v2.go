@@ -0,0 +1,185 @@
+// Package v2 implements signing for AWS V2 signer
+package v2
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+const (
+	signatureVersion = "2"
+	signatureMethod  = "HmacSHA256"
+	timeFormat       = "2006-01-02T15:04:05Z"
+)
+
+type signer struct {
+	// Values that must be populated from the request
+	Request     *http.Request
+	Time        time.Time
+	Credentials *credentials.Credentials
+	Debug       aws.LogLevelType
+	Logger      aws.Logger
+
+	Query        url.Values
+	stringToSign string
+	signature    string
+}
+
+// SignRequestHandler is a named request handler the SDK will use to sign
+// service client request with using the V2 signature.
+var SignRequestHandler = aws.NamedHandler{Name: "v2.SignRequestHandler", Fn: Sign}
+
+// Sign requests with signature version 2.
+//
+// The signature is added to the request's parameters, in the body of POST
+// requests, or the URL query of GET requests. Signing is skipped if the
+// credentials is the credentials.AnonymousCredentials object.
+func Sign(req *aws.Request) {
+	// If the request does not need to be signed ignore the signing of the
+	// request if the AnonymousCredentials object is used.
+	if req.Service.Config.Credentials == credentials.AnonymousCredentials {
+		return
+	}
+
+	method := req.HTTPRequest.Method
+	if method != "POST" && method != "GET" {
+		req.Error = awserr.New("InvalidSignatureMethod",
+			fmt.Sprintf("V2 signing only supports GET and POST requests, not %s", method), nil)
+		return
+	}
+
+	v2 := signer{
+		Request:     req.HTTPRequest,
+		Time:        req.Time,
+		Credentials: req.Service.Config.Credentials,
+		Debug:       req.Service.Config.LogLevel.Value(),
+		Logger:      req.Service.Config.Logger,
+	}
+
+	if method == "POST" {
+		// The request's parameters are in its body, which is read from the
+		// start, because a retried request will be signed again before its
+		// body is rewound.
+		if req.Body != nil {
+			req.Body.Seek(0, 0)
+			b, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				req.Error = awserr.New("SerializationError", "failed to read request body", err)
+				return
+			}
+			if v2.Query, err = url.ParseQuery(string(b)); err != nil {
+				req.Error = awserr.New("SerializationError", "failed to parse request body", err)
+				return
+			}
+		}
+	} else {
+		v2.Query = req.HTTPRequest.URL.Query()
+	}
+	if v2.Query == nil {
+		v2.Query = url.Values{}
+	}
+
+	if req.Error = v2.sign(); req.Error != nil {
+		return
+	}
+
+	if method == "POST" {
+		// The signed parameters replace the request's body, so its
+		// Content-Length is set to the length of the new body.
+		body := v2.Query.Encode()
+		req.SetStringBody(body)
+		req.HTTPRequest.ContentLength = int64(len(body))
+		req.HTTPRequest.Header.Set("Content-Length", fmt.Sprintf("%d", len(body)))
+	} else {
+		req.HTTPRequest.URL.RawQuery = v2.Query.Encode()
+	}
+}
+
+func (v2 *signer) sign() error {
+	credValue, err := v2.Credentials.Get()
+	if err != nil {
+		return err
+	}
+
+	v2.Query.Set("AWSAccessKeyId", credValue.AccessKeyID)
+	v2.Query.Set("SignatureVersion", signatureVersion)
+	v2.Query.Set("SignatureMethod", signatureMethod)
+	v2.Query.Set("Timestamp", v2.Time.UTC().Format(timeFormat))
+	if credValue.SessionToken != "" {
+		v2.Query.Set("SecurityToken", credValue.SessionToken)
+	} else {
+		v2.Query.Del("SecurityToken")
+	}
+
+	// A retried request must not include its previous signature in the
+	// string to sign.
+	v2.Query.Del("Signature")
+
+	path := v2.Request.URL.Path
+	if path == "" {
+		path = "/"
+	}
+
+	v2.stringToSign = strings.Join([]string{
+		v2.Request.Method,
+		strings.ToLower(v2.Request.URL.Host),
+		path,
+		canonicalQuery(v2.Query),
+	}, "\n")
+
+	hash := hmac.New(sha256.New, []byte(credValue.SecretAccessKey))
+	hash.Write([]byte(v2.stringToSign))
+	v2.signature = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	v2.Query.Set("Signature", v2.signature)
+
+	if v2.Debug.Matches(aws.LogSigning) && v2.Logger != nil {
+		v2.logSigningInfo()
+	}
+
+	return nil
+}
+
+// canonicalQuery returns the query sorted by key, with keys and values
+// escaped as RFC 3986 requires.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = escape(k) + "=" + escape(query.Get(k))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escape escapes the string, using %20 rather than + for spaces.
+func escape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+const logSignInfoMsg = `DEBUG: Request Signature:
+---[ STRING TO SIGN ]--------------------------------
+%s
+---[ SIGNATURE ]-------------------------------------
+%s
+-----------------------------------------------------`
+
+func (v2 *signer) logSigningInfo() {
+	msg := fmt.Sprintf(logSignInfoMsg, v2.stringToSign, v2.signature)
+	v2.Logger.Log(msg)
+}
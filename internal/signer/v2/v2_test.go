@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"io/ioutil"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildRequest(creds *credentials.Credentials, method string) *aws.Request {
+	svc := aws.NewService(&aws.Config{
+		Credentials: creds,
+		Endpoint:    aws.String("https://sdb.amazonaws.com"),
+		Region:      aws.String("us-east-1"),
+	})
+	r := aws.NewRequest(svc, &aws.Operation{Name: "ListDomains", HTTPMethod: method, HTTPPath: "/"}, nil, nil)
+	r.Time = time.Date(2015, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	params := url.Values{"Action": {"ListDomains"}, "Version": {"2009-04-15"}, "NextToken": {"a b*~"}}
+	if method == "POST" {
+		r.SetStringBody(params.Encode())
+	} else {
+		r.HTTPRequest.URL.RawQuery = params.Encode()
+	}
+	return r
+}
+
+func bodyValues(t *testing.T, r *aws.Request) url.Values {
+	r.Body.Seek(0, 0)
+	b, err := ioutil.ReadAll(r.Body)
+	assert.NoError(t, err)
+	v, err := url.ParseQuery(string(b))
+	assert.NoError(t, err)
+	return v
+}
+
+func TestSignPost(t *testing.T) {
+	r := buildRequest(credentials.NewStaticCredentials("AKID", "SECRET", ""), "POST")
+	Sign(r)
+	assert.NoError(t, r.Error)
+
+	v := bodyValues(t, r)
+	assert.Equal(t, "AKID", v.Get("AWSAccessKeyId"))
+	assert.Equal(t, "2", v.Get("SignatureVersion"))
+	assert.Equal(t, "HmacSHA256", v.Get("SignatureMethod"))
+	assert.Equal(t, "2015-01-01T12:00:00Z", v.Get("Timestamp"))
+	assert.Equal(t, "a b*~", v.Get("NextToken"))
+	assert.Empty(t, v.Get("SecurityToken"))
+	assert.Equal(t, "4Ub9iEziBM9jh1ncJGynyip+wrZwPqzbGknWpYAoyq8=", v.Get("Signature"))
+	assert.Empty(t, r.HTTPRequest.URL.RawQuery)
+
+	length := int64(len(v.Encode()))
+	assert.Equal(t, length, r.HTTPRequest.ContentLength)
+	assert.Equal(t, strconv.FormatInt(length, 10), r.HTTPRequest.Header.Get("Content-Length"))
+}
+
+func TestSignGet(t *testing.T) {
+	r := buildRequest(credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"), "GET")
+	Sign(r)
+	assert.NoError(t, r.Error)
+
+	q := r.HTTPRequest.URL.Query()
+	assert.Equal(t, "AKID", q.Get("AWSAccessKeyId"))
+	assert.Equal(t, "SESSION", q.Get("SecurityToken"))
+	assert.NotEmpty(t, q.Get("Signature"))
+}
+
+func TestResignPost(t *testing.T) {
+	r := buildRequest(credentials.NewStaticCredentials("AKID", "SECRET", ""), "POST")
+	Sign(r)
+	sig := bodyValues(t, r).Get("Signature")
+
+	// The body has been read by the previous attempt when the request is
+	// signed again.
+	ioutil.ReadAll(r.Body)
+	Sign(r)
+	assert.NoError(t, r.Error)
+
+	v := bodyValues(t, r)
+	assert.Len(t, v["Signature"], 1)
+	assert.Equal(t, sig, v.Get("Signature"))
+	assert.Equal(t, "ListDomains", v.Get("Action"))
+}
+
+func TestAnonymousCredentials(t *testing.T) {
+	r := buildRequest(credentials.AnonymousCredentials, "POST")
+	Sign(r)
+	assert.NoError(t, r.Error)
+	assert.Empty(t, bodyValues(t, r).Get("Signature"))
+}
+
+func TestSignUnsupportedMethod(t *testing.T) {
+	r := buildRequest(credentials.NewStaticCredentials("AKID", "SECRET", ""), "PUT")
+	Sign(r)
+	assert.Error(t, r.Error)
+}
@@ -0,0 +1,11 @@
+package waiter
+
+import "time"
+
+// SetSleepDelay replaces the function the waiter delays between attempts
+// with, and returns a function restoring the original.
+func SetSleepDelay(fn func(time.Duration)) func() {
+	orig := sleepDelay
+	sleepDelay = fn
+	return func() { sleepDelay = orig }
+}
@@ -0,0 +1,193 @@
+// Package waiter provides the runtime used by the generated WaitUntil*
+// methods to poll an API operation until a resource reaches a desired state.
+package waiter
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+)
+
+var sleepDelay = func(delay time.Duration) {
+	time.Sleep(delay)
+}
+
+// A Config provides a collection of configuration values to setup a generated
+// waiter code with.
+type Config struct {
+	Name        string
+	Delay       int
+	MaxAttempts int
+	Operation   string
+	Acceptors   []WaitAcceptor
+}
+
+// A WaitAcceptor provides the information needed to wait for an API operation
+// to complete.
+type WaitAcceptor struct {
+	Expected interface{}
+	Matcher  string
+	State    string
+	Argument string
+}
+
+// A Waiter provides waiting for an operation to complete.
+type Waiter struct {
+	Config
+	Client interface{}
+	Input  interface{}
+}
+
+// Wait waits for an operation to complete, expire max attempts, or fail. Error
+// is returned if the operation fails.
+func (w *Waiter) Wait() error {
+	client := reflect.ValueOf(w.Client)
+	in := reflect.ValueOf(w.Input)
+	method := client.MethodByName(w.Config.Operation + "Request")
+
+	for i := 0; i < w.MaxAttempts; i++ {
+		res := method.Call([]reflect.Value{in})
+		req := res[0].Interface().(*aws.Request)
+
+		err := req.Send()
+		for _, a := range w.Acceptors {
+			if !w.matches(a, req, err) {
+				// If there was no matching result found there is nothing more
+				// to do for this response, try the next acceptor.
+				continue
+			}
+
+			switch a.State {
+			case "success":
+				// waiter completed
+				return nil
+			case "failure":
+				// waiter failure state triggered
+				return awserr.Wrap("ResourceNotReady",
+					"failed waiting for successful resource state", err)
+			case "retry":
+				// clear the error and retry the operation
+				err = nil
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		// don't wait after the final attempt
+		if i < w.MaxAttempts-1 {
+			sleepDelay(time.Second * time.Duration(w.Delay))
+		}
+	}
+
+	return awserr.New("ResourceNotReady",
+		fmt.Sprintf("exceeded %d wait attempts", w.MaxAttempts), nil)
+}
+
+// matches returns if the acceptor matches the request's response or error.
+func (w *Waiter) matches(a WaitAcceptor, req *aws.Request, err error) bool {
+	switch a.Matcher {
+	case "pathAll", "path":
+		// Require all matches to be equal for result to match
+		vals := valuesAtArgument(req.Data, a.Argument)
+		if len(vals) == 0 {
+			return false
+		}
+		for _, val := range vals {
+			if !valueEqual(val, a.Expected) {
+				return false
+			}
+		}
+		return true
+	case "pathAny":
+		// Only a single match needs to equal for the result to match
+		for _, val := range valuesAtArgument(req.Data, a.Argument) {
+			if valueEqual(val, a.Expected) {
+				return true
+			}
+		}
+	case "status":
+		if req.HTTPResponse != nil {
+			return valueEqual(req.HTTPResponse.StatusCode, a.Expected)
+		}
+	case "error":
+		if aerr, ok := err.(awserr.Error); ok {
+			return aerr.Code() == a.Expected
+		}
+	}
+	return false
+}
+
+var reLength = regexp.MustCompile("^length\\((.+)\\)\\s*(==|!=|>=|<=|>|<)\\s*`(\\d+)`$")
+
+// valuesAtArgument returns the values found in data for the acceptor's
+// argument. The argument is either a path expression, or a comparison of
+// the length of a path expression to a constant, e.g. length(Items[]) > `0`.
+func valuesAtArgument(data interface{}, arg string) []interface{} {
+	m := reLength.FindStringSubmatch(arg)
+	if m == nil {
+		return awsutil.ValuesAtAnyPath(data, arg)
+	}
+
+	vals := awsutil.ValuesAtAnyPath(data, m[1])
+	length := len(vals)
+	if !strings.ContainsAny(m[1], "[*") && length == 1 {
+		if v := reflect.ValueOf(vals[0]); v.Kind() == reflect.String || v.Kind() == reflect.Slice {
+			length = v.Len()
+		}
+	}
+
+	n, _ := strconv.Atoi(m[3])
+	switch m[2] {
+	case "==":
+		return []interface{}{length == n}
+	case "!=":
+		return []interface{}{length != n}
+	case ">=":
+		return []interface{}{length >= n}
+	case "<=":
+		return []interface{}{length <= n}
+	case ">":
+		return []interface{}{length > n}
+	default:
+		return []interface{}{length < n}
+	}
+}
+
+// valueEqual returns if the value found in a response is equal to the
+// acceptor's expected value. Numeric values are compared regardless of their
+// Go type.
+func valueEqual(v, expected interface{}) bool {
+	rv, re := reflect.ValueOf(v), reflect.ValueOf(expected)
+	if isNumber(rv) && isNumber(re) {
+		return toFloat(rv) == toFloat(re)
+	}
+	return reflect.DeepEqual(v, expected)
+}
+
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	}
+	return float64(v.Int())
+}
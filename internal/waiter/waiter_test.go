@@ -0,0 +1,228 @@
+package waiter_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/waiter"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClient struct {
+	*aws.Service
+}
+type MockInput struct{}
+type MockOutput struct {
+	States []*MockState
+}
+type MockState struct {
+	State *string
+}
+
+func (c *mockClient) MockRequest(input *MockInput) (*aws.Request, *MockOutput) {
+	op := &aws.Operation{
+		Name:       "Mock",
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &MockInput{}
+	}
+
+	output := &MockOutput{}
+	req := aws.NewRequest(c.Service, op, input, output)
+	req.Data = output
+	return req, output
+}
+
+func newMockClient(resps []*MockOutput, statuses []int) (*mockClient, *int) {
+	svc := &mockClient{Service: aws.NewService(&aws.Config{Region: aws.String("mock-region")})}
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+
+	reqNum := 0
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		code := 200
+		if statuses != nil {
+			code = statuses[reqNum]
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: code,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+	})
+	svc.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		if resps != nil {
+			*r.Data.(*MockOutput) = *resps[reqNum]
+		}
+		reqNum++
+	})
+	svc.Handlers.UnmarshalError.PushBack(func(r *aws.Request) {
+		r.Error = awserr.New("NotFound", "resource not found", nil)
+		reqNum++
+	})
+
+	return svc, &reqNum
+}
+
+func TestWaiterPathAll(t *testing.T) {
+	svc, reqNum := newMockClient([]*MockOutput{
+		{States: []*MockState{{State: aws.String("pending")}, {State: aws.String("pending")}}},
+		{States: []*MockState{{State: aws.String("running")}, {State: aws.String("pending")}}},
+		{States: []*MockState{{State: aws.String("running")}, {State: aws.String("running")}}},
+	}, nil)
+
+	w := waiter.Waiter{
+		Client: svc,
+		Input:  &MockInput{},
+		Config: waiter.Config{
+			Operation:   "Mock",
+			Delay:       0,
+			MaxAttempts: 10,
+			Acceptors: []waiter.WaitAcceptor{
+				{State: "success", Matcher: "pathAll", Argument: "States[].State", Expected: "running"},
+			},
+		},
+	}
+
+	err := w.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *reqNum)
+}
+
+func TestWaiterPathAnyFailure(t *testing.T) {
+	svc, reqNum := newMockClient([]*MockOutput{
+		{States: []*MockState{{State: aws.String("pending")}, {State: aws.String("pending")}}},
+		{States: []*MockState{{State: aws.String("stopping")}, {State: aws.String("pending")}}},
+	}, nil)
+
+	w := waiter.Waiter{
+		Client: svc,
+		Input:  &MockInput{},
+		Config: waiter.Config{
+			Operation:   "Mock",
+			Delay:       0,
+			MaxAttempts: 10,
+			Acceptors: []waiter.WaitAcceptor{
+				{State: "success", Matcher: "pathAll", Argument: "States[].State", Expected: "running"},
+				{State: "failure", Matcher: "pathAny", Argument: "States[].State", Expected: "stopping"},
+			},
+		},
+	}
+
+	sleeps := 0
+	defer waiter.SetSleepDelay(func(time.Duration) { sleeps++ })()
+
+	err := w.Wait()
+	assert.Error(t, err)
+	assert.Equal(t, "ResourceNotReady", err.(awserr.Error).Code())
+	assert.Equal(t, 2, *reqNum)
+	assert.Equal(t, 1, sleeps, "expect no delay after the final attempt")
+}
+
+func TestWaiterStatusAndError(t *testing.T) {
+	svc, reqNum := newMockClient(nil, []int{404, 404, 200})
+
+	w := waiter.Waiter{
+		Client: svc,
+		Input:  &MockInput{},
+		Config: waiter.Config{
+			Operation:   "Mock",
+			Delay:       0,
+			MaxAttempts: 10,
+			Acceptors: []waiter.WaitAcceptor{
+				{State: "success", Matcher: "status", Expected: 200},
+				{State: "retry", Matcher: "error", Expected: "NotFound"},
+			},
+		},
+	}
+
+	err := w.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *reqNum)
+}
+
+func TestWaiterLengthExpression(t *testing.T) {
+	svc, reqNum := newMockClient([]*MockOutput{
+		{},
+		{States: []*MockState{{State: aws.String("pending")}}},
+	}, nil)
+
+	w := waiter.Waiter{
+		Client: svc,
+		Input:  &MockInput{},
+		Config: waiter.Config{
+			Operation:   "Mock",
+			Delay:       0,
+			MaxAttempts: 10,
+			Acceptors: []waiter.WaitAcceptor{
+				{State: "success", Matcher: "path", Argument: "length(States[].State) > `0`", Expected: true},
+			},
+		},
+	}
+
+	err := w.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *reqNum)
+}
+
+func TestWaiterMaxAttempts(t *testing.T) {
+	svc, reqNum := newMockClient([]*MockOutput{
+		{States: []*MockState{{State: aws.String("pending")}}},
+		{States: []*MockState{{State: aws.String("pending")}}},
+	}, nil)
+
+	w := waiter.Waiter{
+		Client: svc,
+		Input:  &MockInput{},
+		Config: waiter.Config{
+			Operation:   "Mock",
+			Delay:       0,
+			MaxAttempts: 2,
+			Acceptors: []waiter.WaitAcceptor{
+				{State: "success", Matcher: "pathAll", Argument: "States[].State", Expected: "running"},
+			},
+		},
+	}
+
+	sleeps := 0
+	defer waiter.SetSleepDelay(func(time.Duration) { sleeps++ })()
+
+	err := w.Wait()
+	assert.Error(t, err)
+	assert.Equal(t, "ResourceNotReady", err.(awserr.Error).Code())
+	assert.Equal(t, 2, *reqNum)
+	assert.Equal(t, 1, sleeps, "expect no delay after the final attempt")
+}
+
+func TestWaiterErrorFailure(t *testing.T) {
+	svc, reqNum := newMockClient(nil, []int{404})
+
+	w := waiter.Waiter{
+		Client: svc,
+		Input:  &MockInput{},
+		Config: waiter.Config{
+			Operation:   "Mock",
+			Delay:       0,
+			MaxAttempts: 10,
+			Acceptors: []waiter.WaitAcceptor{
+				{State: "success", Matcher: "status", Expected: 200},
+				{State: "failure", Matcher: "error", Expected: "NotFound"},
+			},
+		},
+	}
+
+	err := w.Wait()
+	assert.Error(t, err)
+	assert.Equal(t, "ResourceNotReady", err.(awserr.Error).Code())
+	assert.Equal(t, "NotFound", err.(awserr.Error).OrigErr().(awserr.Error).Code())
+	assert.Equal(t, 1, *reqNum)
+}
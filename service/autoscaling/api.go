@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAttachInstances = "AttachInstances"
@@ -40,6 +41,16 @@ func (c *AutoScaling) AttachInstances(input *AttachInstancesInput) (*AttachInsta
 	return out, err
 }
 
+// AttachInstancesWithContext is the same as AttachInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) AttachInstancesWithContext(ctx aws.Context, input *AttachInstancesInput) (*AttachInstancesOutput, error) {
+	req, out := c.AttachInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachLoadBalancers = "AttachLoadBalancers"
 
 // AttachLoadBalancersRequest generates a request for the AttachLoadBalancers operation.
@@ -74,6 +85,16 @@ func (c *AutoScaling) AttachLoadBalancers(input *AttachLoadBalancersInput) (*Att
 	return out, err
 }
 
+// AttachLoadBalancersWithContext is the same as AttachLoadBalancers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) AttachLoadBalancersWithContext(ctx aws.Context, input *AttachLoadBalancersInput) (*AttachLoadBalancersOutput, error) {
+	req, out := c.AttachLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCompleteLifecycleAction = "CompleteLifecycleAction"
 
 // CompleteLifecycleActionRequest generates a request for the CompleteLifecycleAction operation.
@@ -115,6 +136,16 @@ func (c *AutoScaling) CompleteLifecycleAction(input *CompleteLifecycleActionInpu
 	return out, err
 }
 
+// CompleteLifecycleActionWithContext is the same as CompleteLifecycleAction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) CompleteLifecycleActionWithContext(ctx aws.Context, input *CompleteLifecycleActionInput) (*CompleteLifecycleActionOutput, error) {
+	req, out := c.CompleteLifecycleActionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAutoScalingGroup = "CreateAutoScalingGroup"
 
 // CreateAutoScalingGroupRequest generates a request for the CreateAutoScalingGroup operation.
@@ -149,6 +180,16 @@ func (c *AutoScaling) CreateAutoScalingGroup(input *CreateAutoScalingGroupInput)
 	return out, err
 }
 
+// CreateAutoScalingGroupWithContext is the same as CreateAutoScalingGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) CreateAutoScalingGroupWithContext(ctx aws.Context, input *CreateAutoScalingGroupInput) (*CreateAutoScalingGroupOutput, error) {
+	req, out := c.CreateAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLaunchConfiguration = "CreateLaunchConfiguration"
 
 // CreateLaunchConfigurationRequest generates a request for the CreateLaunchConfiguration operation.
@@ -183,6 +224,16 @@ func (c *AutoScaling) CreateLaunchConfiguration(input *CreateLaunchConfiguration
 	return out, err
 }
 
+// CreateLaunchConfigurationWithContext is the same as CreateLaunchConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) CreateLaunchConfigurationWithContext(ctx aws.Context, input *CreateLaunchConfigurationInput) (*CreateLaunchConfigurationOutput, error) {
+	req, out := c.CreateLaunchConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateOrUpdateTags = "CreateOrUpdateTags"
 
 // CreateOrUpdateTagsRequest generates a request for the CreateOrUpdateTags operation.
@@ -223,6 +274,16 @@ func (c *AutoScaling) CreateOrUpdateTags(input *CreateOrUpdateTagsInput) (*Creat
 	return out, err
 }
 
+// CreateOrUpdateTagsWithContext is the same as CreateOrUpdateTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) CreateOrUpdateTagsWithContext(ctx aws.Context, input *CreateOrUpdateTagsInput) (*CreateOrUpdateTagsOutput, error) {
+	req, out := c.CreateOrUpdateTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAutoScalingGroup = "DeleteAutoScalingGroup"
 
 // DeleteAutoScalingGroupRequest generates a request for the DeleteAutoScalingGroup operation.
@@ -255,6 +316,16 @@ func (c *AutoScaling) DeleteAutoScalingGroup(input *DeleteAutoScalingGroupInput)
 	return out, err
 }
 
+// DeleteAutoScalingGroupWithContext is the same as DeleteAutoScalingGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DeleteAutoScalingGroupWithContext(ctx aws.Context, input *DeleteAutoScalingGroupInput) (*DeleteAutoScalingGroupOutput, error) {
+	req, out := c.DeleteAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLaunchConfiguration = "DeleteLaunchConfiguration"
 
 // DeleteLaunchConfigurationRequest generates a request for the DeleteLaunchConfiguration operation.
@@ -286,6 +357,16 @@ func (c *AutoScaling) DeleteLaunchConfiguration(input *DeleteLaunchConfiguration
 	return out, err
 }
 
+// DeleteLaunchConfigurationWithContext is the same as DeleteLaunchConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DeleteLaunchConfigurationWithContext(ctx aws.Context, input *DeleteLaunchConfigurationInput) (*DeleteLaunchConfigurationOutput, error) {
+	req, out := c.DeleteLaunchConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLifecycleHook = "DeleteLifecycleHook"
 
 // DeleteLifecycleHookRequest generates a request for the DeleteLifecycleHook operation.
@@ -316,6 +397,16 @@ func (c *AutoScaling) DeleteLifecycleHook(input *DeleteLifecycleHookInput) (*Del
 	return out, err
 }
 
+// DeleteLifecycleHookWithContext is the same as DeleteLifecycleHook with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DeleteLifecycleHookWithContext(ctx aws.Context, input *DeleteLifecycleHookInput) (*DeleteLifecycleHookOutput, error) {
+	req, out := c.DeleteLifecycleHookRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteNotificationConfiguration = "DeleteNotificationConfiguration"
 
 // DeleteNotificationConfigurationRequest generates a request for the DeleteNotificationConfiguration operation.
@@ -343,6 +434,16 @@ func (c *AutoScaling) DeleteNotificationConfiguration(input *DeleteNotificationC
 	return out, err
 }
 
+// DeleteNotificationConfigurationWithContext is the same as DeleteNotificationConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DeleteNotificationConfigurationWithContext(ctx aws.Context, input *DeleteNotificationConfigurationInput) (*DeleteNotificationConfigurationOutput, error) {
+	req, out := c.DeleteNotificationConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePolicy = "DeletePolicy"
 
 // DeletePolicyRequest generates a request for the DeletePolicy operation.
@@ -370,6 +471,16 @@ func (c *AutoScaling) DeletePolicy(input *DeletePolicyInput) (*DeletePolicyOutpu
 	return out, err
 }
 
+// DeletePolicyWithContext is the same as DeletePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DeletePolicyWithContext(ctx aws.Context, input *DeletePolicyInput) (*DeletePolicyOutput, error) {
+	req, out := c.DeletePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteScheduledAction = "DeleteScheduledAction"
 
 // DeleteScheduledActionRequest generates a request for the DeleteScheduledAction operation.
@@ -397,6 +508,16 @@ func (c *AutoScaling) DeleteScheduledAction(input *DeleteScheduledActionInput) (
 	return out, err
 }
 
+// DeleteScheduledActionWithContext is the same as DeleteScheduledAction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DeleteScheduledActionWithContext(ctx aws.Context, input *DeleteScheduledActionInput) (*DeleteScheduledActionOutput, error) {
+	req, out := c.DeleteScheduledActionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTags = "DeleteTags"
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -424,6 +545,16 @@ func (c *AutoScaling) DeleteTags(input *DeleteTagsInput) (*DeleteTagsOutput, err
 	return out, err
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DeleteTagsWithContext(ctx aws.Context, input *DeleteTagsInput) (*DeleteTagsOutput, error) {
+	req, out := c.DeleteTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAccountLimits = "DescribeAccountLimits"
 
 // DescribeAccountLimitsRequest generates a request for the DescribeAccountLimits operation.
@@ -455,6 +586,16 @@ func (c *AutoScaling) DescribeAccountLimits(input *DescribeAccountLimitsInput) (
 	return out, err
 }
 
+// DescribeAccountLimitsWithContext is the same as DescribeAccountLimits with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeAccountLimitsWithContext(ctx aws.Context, input *DescribeAccountLimitsInput) (*DescribeAccountLimitsOutput, error) {
+	req, out := c.DescribeAccountLimitsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAdjustmentTypes = "DescribeAdjustmentTypes"
 
 // DescribeAdjustmentTypesRequest generates a request for the DescribeAdjustmentTypes operation.
@@ -482,6 +623,16 @@ func (c *AutoScaling) DescribeAdjustmentTypes(input *DescribeAdjustmentTypesInpu
 	return out, err
 }
 
+// DescribeAdjustmentTypesWithContext is the same as DescribeAdjustmentTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeAdjustmentTypesWithContext(ctx aws.Context, input *DescribeAdjustmentTypesInput) (*DescribeAdjustmentTypesOutput, error) {
+	req, out := c.DescribeAdjustmentTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAutoScalingGroups = "DescribeAutoScalingGroups"
 
 // DescribeAutoScalingGroupsRequest generates a request for the DescribeAutoScalingGroups operation.
@@ -516,6 +667,16 @@ func (c *AutoScaling) DescribeAutoScalingGroups(input *DescribeAutoScalingGroups
 	return out, err
 }
 
+// DescribeAutoScalingGroupsWithContext is the same as DescribeAutoScalingGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeAutoScalingGroupsWithContext(ctx aws.Context, input *DescribeAutoScalingGroupsInput) (*DescribeAutoScalingGroupsOutput, error) {
+	req, out := c.DescribeAutoScalingGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribeAutoScalingGroupsPages(input *DescribeAutoScalingGroupsInput, fn func(p *DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAutoScalingGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -557,6 +718,16 @@ func (c *AutoScaling) DescribeAutoScalingInstances(input *DescribeAutoScalingIns
 	return out, err
 }
 
+// DescribeAutoScalingInstancesWithContext is the same as DescribeAutoScalingInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeAutoScalingInstancesWithContext(ctx aws.Context, input *DescribeAutoScalingInstancesInput) (*DescribeAutoScalingInstancesOutput, error) {
+	req, out := c.DescribeAutoScalingInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribeAutoScalingInstancesPages(input *DescribeAutoScalingInstancesInput, fn func(p *DescribeAutoScalingInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAutoScalingInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -591,6 +762,16 @@ func (c *AutoScaling) DescribeAutoScalingNotificationTypes(input *DescribeAutoSc
 	return out, err
 }
 
+// DescribeAutoScalingNotificationTypesWithContext is the same as DescribeAutoScalingNotificationTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeAutoScalingNotificationTypesWithContext(ctx aws.Context, input *DescribeAutoScalingNotificationTypesInput) (*DescribeAutoScalingNotificationTypesOutput, error) {
+	req, out := c.DescribeAutoScalingNotificationTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLaunchConfigurations = "DescribeLaunchConfigurations"
 
 // DescribeLaunchConfigurationsRequest generates a request for the DescribeLaunchConfigurations operation.
@@ -625,6 +806,16 @@ func (c *AutoScaling) DescribeLaunchConfigurations(input *DescribeLaunchConfigur
 	return out, err
 }
 
+// DescribeLaunchConfigurationsWithContext is the same as DescribeLaunchConfigurations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeLaunchConfigurationsWithContext(ctx aws.Context, input *DescribeLaunchConfigurationsInput) (*DescribeLaunchConfigurationsOutput, error) {
+	req, out := c.DescribeLaunchConfigurationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribeLaunchConfigurationsPages(input *DescribeLaunchConfigurationsInput, fn func(p *DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeLaunchConfigurationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -659,6 +850,16 @@ func (c *AutoScaling) DescribeLifecycleHookTypes(input *DescribeLifecycleHookTyp
 	return out, err
 }
 
+// DescribeLifecycleHookTypesWithContext is the same as DescribeLifecycleHookTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeLifecycleHookTypesWithContext(ctx aws.Context, input *DescribeLifecycleHookTypesInput) (*DescribeLifecycleHookTypesOutput, error) {
+	req, out := c.DescribeLifecycleHookTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLifecycleHooks = "DescribeLifecycleHooks"
 
 // DescribeLifecycleHooksRequest generates a request for the DescribeLifecycleHooks operation.
@@ -686,6 +887,16 @@ func (c *AutoScaling) DescribeLifecycleHooks(input *DescribeLifecycleHooksInput)
 	return out, err
 }
 
+// DescribeLifecycleHooksWithContext is the same as DescribeLifecycleHooks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeLifecycleHooksWithContext(ctx aws.Context, input *DescribeLifecycleHooksInput) (*DescribeLifecycleHooksOutput, error) {
+	req, out := c.DescribeLifecycleHooksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLoadBalancers = "DescribeLoadBalancers"
 
 // DescribeLoadBalancersRequest generates a request for the DescribeLoadBalancers operation.
@@ -713,6 +924,16 @@ func (c *AutoScaling) DescribeLoadBalancers(input *DescribeLoadBalancersInput) (
 	return out, err
 }
 
+// DescribeLoadBalancersWithContext is the same as DescribeLoadBalancers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeLoadBalancersWithContext(ctx aws.Context, input *DescribeLoadBalancersInput) (*DescribeLoadBalancersOutput, error) {
+	req, out := c.DescribeLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMetricCollectionTypes = "DescribeMetricCollectionTypes"
 
 // DescribeMetricCollectionTypesRequest generates a request for the DescribeMetricCollectionTypes operation.
@@ -743,6 +964,16 @@ func (c *AutoScaling) DescribeMetricCollectionTypes(input *DescribeMetricCollect
 	return out, err
 }
 
+// DescribeMetricCollectionTypesWithContext is the same as DescribeMetricCollectionTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeMetricCollectionTypesWithContext(ctx aws.Context, input *DescribeMetricCollectionTypesInput) (*DescribeMetricCollectionTypesOutput, error) {
+	req, out := c.DescribeMetricCollectionTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeNotificationConfigurations = "DescribeNotificationConfigurations"
 
 // DescribeNotificationConfigurationsRequest generates a request for the DescribeNotificationConfigurations operation.
@@ -777,6 +1008,16 @@ func (c *AutoScaling) DescribeNotificationConfigurations(input *DescribeNotifica
 	return out, err
 }
 
+// DescribeNotificationConfigurationsWithContext is the same as DescribeNotificationConfigurations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeNotificationConfigurationsWithContext(ctx aws.Context, input *DescribeNotificationConfigurationsInput) (*DescribeNotificationConfigurationsOutput, error) {
+	req, out := c.DescribeNotificationConfigurationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribeNotificationConfigurationsPages(input *DescribeNotificationConfigurationsInput, fn func(p *DescribeNotificationConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeNotificationConfigurationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -817,6 +1058,16 @@ func (c *AutoScaling) DescribePolicies(input *DescribePoliciesInput) (*DescribeP
 	return out, err
 }
 
+// DescribePoliciesWithContext is the same as DescribePolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribePoliciesWithContext(ctx aws.Context, input *DescribePoliciesInput) (*DescribePoliciesOutput, error) {
+	req, out := c.DescribePoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribePoliciesPages(input *DescribePoliciesInput, fn func(p *DescribePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribePoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -860,6 +1111,16 @@ func (c *AutoScaling) DescribeScalingActivities(input *DescribeScalingActivities
 	return out, err
 }
 
+// DescribeScalingActivitiesWithContext is the same as DescribeScalingActivities with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeScalingActivitiesWithContext(ctx aws.Context, input *DescribeScalingActivitiesInput) (*DescribeScalingActivitiesOutput, error) {
+	req, out := c.DescribeScalingActivitiesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribeScalingActivitiesPages(input *DescribeScalingActivitiesInput, fn func(p *DescribeScalingActivitiesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeScalingActivitiesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -894,6 +1155,16 @@ func (c *AutoScaling) DescribeScalingProcessTypes(input *DescribeScalingProcessT
 	return out, err
 }
 
+// DescribeScalingProcessTypesWithContext is the same as DescribeScalingProcessTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeScalingProcessTypesWithContext(ctx aws.Context, input *DescribeScalingProcessTypesInput) (*DescribeScalingProcessTypesOutput, error) {
+	req, out := c.DescribeScalingProcessTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeScheduledActions = "DescribeScheduledActions"
 
 // DescribeScheduledActionsRequest generates a request for the DescribeScheduledActions operation.
@@ -928,6 +1199,16 @@ func (c *AutoScaling) DescribeScheduledActions(input *DescribeScheduledActionsIn
 	return out, err
 }
 
+// DescribeScheduledActionsWithContext is the same as DescribeScheduledActions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeScheduledActionsWithContext(ctx aws.Context, input *DescribeScheduledActionsInput) (*DescribeScheduledActionsOutput, error) {
+	req, out := c.DescribeScheduledActionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribeScheduledActionsPages(input *DescribeScheduledActionsInput, fn func(p *DescribeScheduledActionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeScheduledActionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -977,6 +1258,16 @@ func (c *AutoScaling) DescribeTags(input *DescribeTagsInput) (*DescribeTagsOutpu
 	return out, err
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeTagsWithContext(ctx aws.Context, input *DescribeTagsInput) (*DescribeTagsOutput, error) {
+	req, out := c.DescribeTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *AutoScaling) DescribeTagsPages(input *DescribeTagsInput, fn func(p *DescribeTagsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTagsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1011,6 +1302,16 @@ func (c *AutoScaling) DescribeTerminationPolicyTypes(input *DescribeTerminationP
 	return out, err
 }
 
+// DescribeTerminationPolicyTypesWithContext is the same as DescribeTerminationPolicyTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DescribeTerminationPolicyTypesWithContext(ctx aws.Context, input *DescribeTerminationPolicyTypesInput) (*DescribeTerminationPolicyTypesOutput, error) {
+	req, out := c.DescribeTerminationPolicyTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachInstances = "DetachInstances"
 
 // DetachInstancesRequest generates a request for the DetachInstances operation.
@@ -1044,6 +1345,16 @@ func (c *AutoScaling) DetachInstances(input *DetachInstancesInput) (*DetachInsta
 	return out, err
 }
 
+// DetachInstancesWithContext is the same as DetachInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DetachInstancesWithContext(ctx aws.Context, input *DetachInstancesInput) (*DetachInstancesOutput, error) {
+	req, out := c.DetachInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachLoadBalancers = "DetachLoadBalancers"
 
 // DetachLoadBalancersRequest generates a request for the DetachLoadBalancers operation.
@@ -1076,6 +1387,16 @@ func (c *AutoScaling) DetachLoadBalancers(input *DetachLoadBalancersInput) (*Det
 	return out, err
 }
 
+// DetachLoadBalancersWithContext is the same as DetachLoadBalancers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DetachLoadBalancersWithContext(ctx aws.Context, input *DetachLoadBalancersInput) (*DetachLoadBalancersOutput, error) {
+	req, out := c.DetachLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableMetricsCollection = "DisableMetricsCollection"
 
 // DisableMetricsCollectionRequest generates a request for the DisableMetricsCollection operation.
@@ -1104,6 +1425,16 @@ func (c *AutoScaling) DisableMetricsCollection(input *DisableMetricsCollectionIn
 	return out, err
 }
 
+// DisableMetricsCollectionWithContext is the same as DisableMetricsCollection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) DisableMetricsCollectionWithContext(ctx aws.Context, input *DisableMetricsCollectionInput) (*DisableMetricsCollectionOutput, error) {
+	req, out := c.DisableMetricsCollectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableMetricsCollection = "EnableMetricsCollection"
 
 // EnableMetricsCollectionRequest generates a request for the EnableMetricsCollection operation.
@@ -1135,6 +1466,16 @@ func (c *AutoScaling) EnableMetricsCollection(input *EnableMetricsCollectionInpu
 	return out, err
 }
 
+// EnableMetricsCollectionWithContext is the same as EnableMetricsCollection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) EnableMetricsCollectionWithContext(ctx aws.Context, input *EnableMetricsCollectionInput) (*EnableMetricsCollectionOutput, error) {
+	req, out := c.EnableMetricsCollectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnterStandby = "EnterStandby"
 
 // EnterStandbyRequest generates a request for the EnterStandby operation.
@@ -1165,6 +1506,16 @@ func (c *AutoScaling) EnterStandby(input *EnterStandbyInput) (*EnterStandbyOutpu
 	return out, err
 }
 
+// EnterStandbyWithContext is the same as EnterStandby with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) EnterStandbyWithContext(ctx aws.Context, input *EnterStandbyInput) (*EnterStandbyOutput, error) {
+	req, out := c.EnterStandbyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opExecutePolicy = "ExecutePolicy"
 
 // ExecutePolicyRequest generates a request for the ExecutePolicy operation.
@@ -1192,6 +1543,16 @@ func (c *AutoScaling) ExecutePolicy(input *ExecutePolicyInput) (*ExecutePolicyOu
 	return out, err
 }
 
+// ExecutePolicyWithContext is the same as ExecutePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) ExecutePolicyWithContext(ctx aws.Context, input *ExecutePolicyInput) (*ExecutePolicyOutput, error) {
+	req, out := c.ExecutePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opExitStandby = "ExitStandby"
 
 // ExitStandbyRequest generates a request for the ExitStandby operation.
@@ -1222,6 +1583,16 @@ func (c *AutoScaling) ExitStandby(input *ExitStandbyInput) (*ExitStandbyOutput,
 	return out, err
 }
 
+// ExitStandbyWithContext is the same as ExitStandby with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) ExitStandbyWithContext(ctx aws.Context, input *ExitStandbyInput) (*ExitStandbyOutput, error) {
+	req, out := c.ExitStandbyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutLifecycleHook = "PutLifecycleHook"
 
 // PutLifecycleHookRequest generates a request for the PutLifecycleHook operation.
@@ -1266,6 +1637,16 @@ func (c *AutoScaling) PutLifecycleHook(input *PutLifecycleHookInput) (*PutLifecy
 	return out, err
 }
 
+// PutLifecycleHookWithContext is the same as PutLifecycleHook with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) PutLifecycleHookWithContext(ctx aws.Context, input *PutLifecycleHookInput) (*PutLifecycleHookOutput, error) {
+	req, out := c.PutLifecycleHookRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutNotificationConfiguration = "PutNotificationConfiguration"
 
 // PutNotificationConfigurationRequest generates a request for the PutNotificationConfiguration operation.
@@ -1301,6 +1682,16 @@ func (c *AutoScaling) PutNotificationConfiguration(input *PutNotificationConfigu
 	return out, err
 }
 
+// PutNotificationConfigurationWithContext is the same as PutNotificationConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) PutNotificationConfigurationWithContext(ctx aws.Context, input *PutNotificationConfigurationInput) (*PutNotificationConfigurationOutput, error) {
+	req, out := c.PutNotificationConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutScalingPolicy = "PutScalingPolicy"
 
 // PutScalingPolicyRequest generates a request for the PutScalingPolicy operation.
@@ -1331,6 +1722,16 @@ func (c *AutoScaling) PutScalingPolicy(input *PutScalingPolicyInput) (*PutScalin
 	return out, err
 }
 
+// PutScalingPolicyWithContext is the same as PutScalingPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) PutScalingPolicyWithContext(ctx aws.Context, input *PutScalingPolicyInput) (*PutScalingPolicyOutput, error) {
+	req, out := c.PutScalingPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutScheduledUpdateGroupAction = "PutScheduledUpdateGroupAction"
 
 // PutScheduledUpdateGroupActionRequest generates a request for the PutScheduledUpdateGroupAction operation.
@@ -1363,6 +1764,16 @@ func (c *AutoScaling) PutScheduledUpdateGroupAction(input *PutScheduledUpdateGro
 	return out, err
 }
 
+// PutScheduledUpdateGroupActionWithContext is the same as PutScheduledUpdateGroupAction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) PutScheduledUpdateGroupActionWithContext(ctx aws.Context, input *PutScheduledUpdateGroupActionInput) (*PutScheduledUpdateGroupActionOutput, error) {
+	req, out := c.PutScheduledUpdateGroupActionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRecordLifecycleActionHeartbeat = "RecordLifecycleActionHeartbeat"
 
 // RecordLifecycleActionHeartbeatRequest generates a request for the RecordLifecycleActionHeartbeat operation.
@@ -1405,6 +1816,16 @@ func (c *AutoScaling) RecordLifecycleActionHeartbeat(input *RecordLifecycleActio
 	return out, err
 }
 
+// RecordLifecycleActionHeartbeatWithContext is the same as RecordLifecycleActionHeartbeat with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) RecordLifecycleActionHeartbeatWithContext(ctx aws.Context, input *RecordLifecycleActionHeartbeatInput) (*RecordLifecycleActionHeartbeatOutput, error) {
+	req, out := c.RecordLifecycleActionHeartbeatRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResumeProcesses = "ResumeProcesses"
 
 // ResumeProcessesRequest generates a request for the ResumeProcesses operation.
@@ -1436,6 +1857,16 @@ func (c *AutoScaling) ResumeProcesses(input *ScalingProcessQuery) (*ResumeProces
 	return out, err
 }
 
+// ResumeProcessesWithContext is the same as ResumeProcesses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) ResumeProcessesWithContext(ctx aws.Context, input *ScalingProcessQuery) (*ResumeProcessesOutput, error) {
+	req, out := c.ResumeProcessesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetDesiredCapacity = "SetDesiredCapacity"
 
 // SetDesiredCapacityRequest generates a request for the SetDesiredCapacity operation.
@@ -1466,6 +1897,16 @@ func (c *AutoScaling) SetDesiredCapacity(input *SetDesiredCapacityInput) (*SetDe
 	return out, err
 }
 
+// SetDesiredCapacityWithContext is the same as SetDesiredCapacity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) SetDesiredCapacityWithContext(ctx aws.Context, input *SetDesiredCapacityInput) (*SetDesiredCapacityOutput, error) {
+	req, out := c.SetDesiredCapacityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetInstanceHealth = "SetInstanceHealth"
 
 // SetInstanceHealthRequest generates a request for the SetInstanceHealth operation.
@@ -1496,6 +1937,16 @@ func (c *AutoScaling) SetInstanceHealth(input *SetInstanceHealthInput) (*SetInst
 	return out, err
 }
 
+// SetInstanceHealthWithContext is the same as SetInstanceHealth with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) SetInstanceHealthWithContext(ctx aws.Context, input *SetInstanceHealthInput) (*SetInstanceHealthOutput, error) {
+	req, out := c.SetInstanceHealthRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSuspendProcesses = "SuspendProcesses"
 
 // SuspendProcessesRequest generates a request for the SuspendProcesses operation.
@@ -1533,6 +1984,16 @@ func (c *AutoScaling) SuspendProcesses(input *ScalingProcessQuery) (*SuspendProc
 	return out, err
 }
 
+// SuspendProcessesWithContext is the same as SuspendProcesses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) SuspendProcessesWithContext(ctx aws.Context, input *ScalingProcessQuery) (*SuspendProcessesOutput, error) {
+	req, out := c.SuspendProcessesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateInstanceInAutoScalingGroup = "TerminateInstanceInAutoScalingGroup"
 
 // TerminateInstanceInAutoScalingGroupRequest generates a request for the TerminateInstanceInAutoScalingGroup operation.
@@ -1564,6 +2025,16 @@ func (c *AutoScaling) TerminateInstanceInAutoScalingGroup(input *TerminateInstan
 	return out, err
 }
 
+// TerminateInstanceInAutoScalingGroupWithContext is the same as TerminateInstanceInAutoScalingGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) TerminateInstanceInAutoScalingGroupWithContext(ctx aws.Context, input *TerminateInstanceInAutoScalingGroupInput) (*TerminateInstanceInAutoScalingGroupOutput, error) {
+	req, out := c.TerminateInstanceInAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAutoScalingGroup = "UpdateAutoScalingGroup"
 
 // UpdateAutoScalingGroupRequest generates a request for the UpdateAutoScalingGroup operation.
@@ -1614,6 +2085,16 @@ func (c *AutoScaling) UpdateAutoScalingGroup(input *UpdateAutoScalingGroupInput)
 	return out, err
 }
 
+// UpdateAutoScalingGroupWithContext is the same as UpdateAutoScalingGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *AutoScaling) UpdateAutoScalingGroupWithContext(ctx aws.Context, input *UpdateAutoScalingGroupInput) (*UpdateAutoScalingGroupOutput, error) {
+	req, out := c.UpdateAutoScalingGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Describes scaling activity, which is a long-running process that represents
 // a change to your Auto Scaling group, such as changing its size or replacing
 // an instance.
@@ -1622,10 +2103,10 @@ type Activity struct {
 	ActivityID *string `locationName:"ActivityId" type:"string" required:"true"`
 
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The reason the activity began.
-	Cause *string `type:"string" required:"true"`
+	Cause *string `type:"string" min:"1" max:"1023" required:"true"`
 
 	// A friendly, more verbose description of the activity.
 	Description *string `type:"string"`
@@ -1643,10 +2124,12 @@ type Activity struct {
 	StartTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The current status of the activity.
-	StatusCode *string `type:"string" required:"true"`
+	//
+	// See the ScalingActivityStatusCode* constants for valid values.
+	StatusCode *string `type:"string" enum:"WaitingForSpotInstanceRequestId,WaitingForSpotInstanceId,WaitingForInstanceId,PreInService,InProgress,WaitingForELBConnectionDraining,MidLifecycleAction,Successful,Failed,Cancelled" required:"true"`
 
 	// A friendly, more verbose description of the activity status.
-	StatusMessage *string `type:"string"`
+	StatusMessage *string `type:"string" min:"1" max:"255"`
 
 	metadataActivity `json:"-" xml:"-"`
 }
@@ -1655,6 +2138,16 @@ type metadataActivity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Activity) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Activity) GoString() string {
+	return s.String()
+}
+
 // Describes a policy adjustment type.
 //
 // For more information, see Dynamic Scaling (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/as-scale-based-on-demand.html)
@@ -1662,7 +2155,7 @@ type metadataActivity struct {
 type AdjustmentType struct {
 	// The policy adjustment type. The valid values are ChangeInCapacity, ExactCapacity,
 	// and PercentChangeInCapacity.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255"`
 
 	metadataAdjustmentType `json:"-" xml:"-"`
 }
@@ -1671,13 +2164,23 @@ type metadataAdjustmentType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AdjustmentType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AdjustmentType) GoString() string {
+	return s.String()
+}
+
 // Describes an alarm.
 type Alarm struct {
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `type:"string"`
+	AlarmARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	metadataAlarm `json:"-" xml:"-"`
 }
@@ -1686,9 +2189,19 @@ type metadataAlarm struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Alarm) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Alarm) GoString() string {
+	return s.String()
+}
+
 type AttachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more EC2 instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -1700,17 +2213,37 @@ type metadataAttachInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type AttachInstancesOutput struct {
-	metadataAttachInstancesOutput `json:"-" xml:"-"`
+// String returns the string representation
+func (s AttachInstancesInput) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataAttachInstancesOutput struct {
+// GoString returns the string representation
+func (s AttachInstancesInput) GoString() string {
+	return s.String()
+}
+
+type AttachInstancesOutput struct {
+	metadataAttachInstancesOutput `json:"-" xml:"-"`
+}
+
+type metadataAttachInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type AttachLoadBalancersInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// One or more load balancer names.
 	LoadBalancerNames []*string `type:"list"`
@@ -1722,6 +2255,16 @@ type metadataAttachLoadBalancersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachLoadBalancersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachLoadBalancersInput) GoString() string {
+	return s.String()
+}
+
 type AttachLoadBalancersOutput struct {
 	metadataAttachLoadBalancersOutput `json:"-" xml:"-"`
 }
@@ -1730,10 +2273,20 @@ type metadataAttachLoadBalancersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachLoadBalancersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachLoadBalancersOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a block device mapping.
 type BlockDeviceMapping struct {
 	// The device name exposed to the EC2 instance (for example, /dev/sdh or xvdh).
-	DeviceName *string `type:"string" required:"true"`
+	DeviceName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The information about the Amazon EBS volume.
 	EBS *EBS `locationName:"Ebs" type:"structure"`
@@ -1746,7 +2299,7 @@ type BlockDeviceMapping struct {
 	NoDevice *bool `type:"boolean"`
 
 	// The name of the virtual device, ephemeral0 to ephemeral3.
-	VirtualName *string `type:"string"`
+	VirtualName *string `type:"string" min:"1" max:"255"`
 
 	metadataBlockDeviceMapping `json:"-" xml:"-"`
 }
@@ -1755,9 +2308,19 @@ type metadataBlockDeviceMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BlockDeviceMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BlockDeviceMapping) GoString() string {
+	return s.String()
+}
+
 type CompleteLifecycleActionInput struct {
 	// The name of the group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The action for the group to take. This parameter can be either CONTINUE or
 	// ABANDON.
@@ -1766,10 +2329,10 @@ type CompleteLifecycleActionInput struct {
 	// A universally unique identifier (UUID) that identifies a specific lifecycle
 	// action associated with an instance. Auto Scaling sends this token to the
 	// notification target you specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataCompleteLifecycleActionInput `json:"-" xml:"-"`
 }
@@ -1778,6 +2341,16 @@ type metadataCompleteLifecycleActionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CompleteLifecycleActionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CompleteLifecycleActionInput) GoString() string {
+	return s.String()
+}
+
 type CompleteLifecycleActionOutput struct {
 	metadataCompleteLifecycleActionOutput `json:"-" xml:"-"`
 }
@@ -1786,14 +2359,24 @@ type metadataCompleteLifecycleActionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CompleteLifecycleActionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CompleteLifecycleActionOutput) GoString() string {
+	return s.String()
+}
+
 type CreateAutoScalingGroupInput struct {
 	// The name of the group. This name must be unique within the scope of your
 	// AWS account.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more Availability Zones for the group. This parameter is optional
 	// if you specify subnets using the VPCZoneIdentifier parameter.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start.
@@ -1826,7 +2409,7 @@ type CreateAutoScalingGroupInput struct {
 	//
 	// By default, health checks use Amazon EC2 instance status checks to determine
 	// the health of an instance. For more information, see Health Checks (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/healthcheck.html).
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32"`
 
 	// The ID of the EC2 instance used to create a launch configuration for the
 	// group. Alternatively, use the LaunchConfigurationName parameter to specify
@@ -1840,11 +2423,11 @@ type CreateAutoScalingGroupInput struct {
 	// For more information, see Create an Auto Scaling Group from an EC2 Instance
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-asg-from-instance.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16"`
 
 	// The name of the launch configuration. Alternatively, use the InstanceId parameter
 	// to specify an EC2 instance instead of a launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600"`
 
 	// One or more load balancers.
 	//
@@ -1861,7 +2444,7 @@ type CreateAutoScalingGroupInput struct {
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// The tag to be created or updated. Each tag should be defined by its resource
 	// type, resource ID, key, value, and a propagate flag. Valid values: key=value,
@@ -1888,7 +2471,7 @@ type CreateAutoScalingGroupInput struct {
 	// For more information, see Auto Scaling and Amazon Virtual Private Cloud
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataCreateAutoScalingGroupInput `json:"-" xml:"-"`
 }
@@ -1897,6 +2480,16 @@ type metadataCreateAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAutoScalingGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreateAutoScalingGroupOutput struct {
 	metadataCreateAutoScalingGroupOutput `json:"-" xml:"-"`
 }
@@ -1905,6 +2498,16 @@ type metadataCreateAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAutoScalingGroupOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLaunchConfigurationInput struct {
 	// Used for groups that launch instances into a virtual private cloud (VPC).
 	// Specifies whether to assign a public IP address to each instance. For more
@@ -1929,7 +2532,7 @@ type CreateLaunchConfigurationInput struct {
 	// This parameter can only be used if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and cannot be
@@ -1955,12 +2558,12 @@ type CreateLaunchConfigurationInput struct {
 	// AWS resources. For more information, see Launch Auto Scaling Instances with
 	// an IAM Role (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/us-iam-role.html)
 	// in the Auto Scaling Developer Guide.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600"`
 
 	// The ID of the Amazon Machine Image (AMI) to use to launch your EC2 instances.
 	// For more information, see Finding an AMI (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/finding-an-ami.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ImageID *string `locationName:"ImageId" type:"string"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255"`
 
 	// The ID of the EC2 instance to use to create the launch configuration.
 	//
@@ -1973,7 +2576,7 @@ type CreateLaunchConfigurationInput struct {
 	// For more information, see Create a Launch Configuration Using an EC2 Instance
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-lc-with-instanceID.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16"`
 
 	// Enables detailed monitoring if it is disabled. Detailed monitoring is enabled
 	// by default.
@@ -1988,19 +2591,19 @@ type CreateLaunchConfigurationInput struct {
 	// The instance type of the EC2 instance. For information about available instance
 	// types, see  Available Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html#AvailableInstanceTypes)
 	// in the Amazon Elastic Cloud Compute User Guide.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"255"`
 
 	// The ID of the kernel associated with the AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255"`
 
 	// The name of the key pair. For more information, see Amazon EC2 Key Pairs
 	// (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html) in
 	// the Amazon Elastic Compute Cloud User Guide.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255"`
 
 	// The name of the launch configuration. This name must be unique within the
 	// scope of your AWS account.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The tenancy of the instance. An instance with a tenancy of dedicated runs
 	// on single-tenant hardware and can only be launched into a VPC.
@@ -2017,10 +2620,10 @@ type CreateLaunchConfigurationInput struct {
 	// in the Auto Scaling Developer Guide.
 	//
 	// Valid values: default | dedicated
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64"`
 
 	// The ID of the RAM disk associated with the AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255"`
 
 	// One or more security groups with which to associate the instances.
 	//
@@ -2039,7 +2642,7 @@ type CreateLaunchConfigurationInput struct {
 	// the current Spot market price. For more information, see Launch Spot Instances
 	// in Your Auto Scaling Group (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/US-SpotInstances.html)
 	// in the Auto Scaling Developer Guide.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data to make available to the launched EC2 instances. For more information,
 	// see Instance Metadata and User Data (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-metadata.html)
@@ -2047,7 +2650,7 @@ type CreateLaunchConfigurationInput struct {
 	//
 	// At this time, launch configurations don't support compressed (zipped) user
 	// data files.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847"`
 
 	metadataCreateLaunchConfigurationInput `json:"-" xml:"-"`
 }
@@ -2056,6 +2659,16 @@ type metadataCreateLaunchConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLaunchConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLaunchConfigurationInput) GoString() string {
+	return s.String()
+}
+
 type CreateLaunchConfigurationOutput struct {
 	metadataCreateLaunchConfigurationOutput `json:"-" xml:"-"`
 }
@@ -2064,6 +2677,16 @@ type metadataCreateLaunchConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLaunchConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLaunchConfigurationOutput) GoString() string {
+	return s.String()
+}
+
 type CreateOrUpdateTagsInput struct {
 	// One or more tags.
 	Tags []*Tag `type:"list" required:"true"`
@@ -2075,6 +2698,16 @@ type metadataCreateOrUpdateTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateOrUpdateTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateOrUpdateTagsInput) GoString() string {
+	return s.String()
+}
+
 type CreateOrUpdateTagsOutput struct {
 	metadataCreateOrUpdateTagsOutput `json:"-" xml:"-"`
 }
@@ -2083,9 +2716,19 @@ type metadataCreateOrUpdateTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateOrUpdateTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateOrUpdateTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteAutoScalingGroupInput struct {
 	// The name of the group to delete.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// Specifies that the group will be deleted along with all instances associated
 	// with the group, without waiting for all instances to be terminated. This
@@ -2099,6 +2742,16 @@ type metadataDeleteAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAutoScalingGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAutoScalingGroupOutput struct {
 	metadataDeleteAutoScalingGroupOutput `json:"-" xml:"-"`
 }
@@ -2107,9 +2760,19 @@ type metadataDeleteAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAutoScalingGroupOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLaunchConfigurationInput struct {
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteLaunchConfigurationInput `json:"-" xml:"-"`
 }
@@ -2118,6 +2781,16 @@ type metadataDeleteLaunchConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLaunchConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLaunchConfigurationInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLaunchConfigurationOutput struct {
 	metadataDeleteLaunchConfigurationOutput `json:"-" xml:"-"`
 }
@@ -2126,12 +2799,22 @@ type metadataDeleteLaunchConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLaunchConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLaunchConfigurationOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLifecycleHookInput struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataDeleteLifecycleHookInput `json:"-" xml:"-"`
 }
@@ -2140,6 +2823,16 @@ type metadataDeleteLifecycleHookInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLifecycleHookInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLifecycleHookInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLifecycleHookOutput struct {
 	metadataDeleteLifecycleHookOutput `json:"-" xml:"-"`
 }
@@ -2148,13 +2841,23 @@ type metadataDeleteLifecycleHookOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLifecycleHookOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLifecycleHookOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteNotificationConfigurationInput `json:"-" xml:"-"`
 }
@@ -2163,6 +2866,16 @@ type metadataDeleteNotificationConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNotificationConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNotificationConfigurationInput) GoString() string {
+	return s.String()
+}
+
 type DeleteNotificationConfigurationOutput struct {
 	metadataDeleteNotificationConfigurationOutput `json:"-" xml:"-"`
 }
@@ -2171,12 +2884,22 @@ type metadataDeleteNotificationConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNotificationConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNotificationConfigurationOutput) GoString() string {
+	return s.String()
+}
+
 type DeletePolicyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The name or Amazon Resource Name (ARN) of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeletePolicyInput `json:"-" xml:"-"`
 }
@@ -2185,6 +2908,16 @@ type metadataDeletePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeletePolicyOutput struct {
 	metadataDeletePolicyOutput `json:"-" xml:"-"`
 }
@@ -2193,12 +2926,22 @@ type metadataDeletePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteScheduledActionInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the action to delete.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataDeleteScheduledActionInput `json:"-" xml:"-"`
 }
@@ -2207,6 +2950,16 @@ type metadataDeleteScheduledActionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteScheduledActionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteScheduledActionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteScheduledActionOutput struct {
 	metadataDeleteScheduledActionOutput `json:"-" xml:"-"`
 }
@@ -2215,6 +2968,16 @@ type metadataDeleteScheduledActionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteScheduledActionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteScheduledActionOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteTagsInput struct {
 	// Each tag should be defined by its resource type, resource ID, key, value,
 	// and a propagate flag. Valid values are: Resource type = auto-scaling-group,
@@ -2229,6 +2992,16 @@ type metadataDeleteTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteTagsOutput struct {
 	metadataDeleteTagsOutput `json:"-" xml:"-"`
 }
@@ -2237,6 +3010,16 @@ type metadataDeleteTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAccountLimitsInput struct {
 	metadataDescribeAccountLimitsInput `json:"-" xml:"-"`
 }
@@ -2245,6 +3028,16 @@ type metadataDescribeAccountLimitsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAccountLimitsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAccountLimitsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAccountLimitsOutput struct {
 	// The maximum number of groups allowed for your AWS account. The default limit
 	// is 20 per region.
@@ -2261,6 +3054,16 @@ type metadataDescribeAccountLimitsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAccountLimitsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAccountLimitsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAdjustmentTypesInput struct {
 	metadataDescribeAdjustmentTypesInput `json:"-" xml:"-"`
 }
@@ -2269,6 +3072,16 @@ type metadataDescribeAdjustmentTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAdjustmentTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAdjustmentTypesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAdjustmentTypesOutput struct {
 	// The policy adjustment types.
 	AdjustmentTypes []*AdjustmentType `type:"list"`
@@ -2280,6 +3093,16 @@ type metadataDescribeAdjustmentTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAdjustmentTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAdjustmentTypesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAutoScalingGroupsInput struct {
 	// The group names.
 	AutoScalingGroupNames []*string `type:"list"`
@@ -2298,6 +3121,16 @@ type metadataDescribeAutoScalingGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAutoScalingGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAutoScalingGroupsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAutoScalingGroupsOutput struct {
 	// The groups.
 	AutoScalingGroups []*Group `type:"list" required:"true"`
@@ -2313,6 +3146,16 @@ type metadataDescribeAutoScalingGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAutoScalingGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAutoScalingGroupsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAutoScalingInstancesInput struct {
 	// One or more Auto Scaling instances to describe, up to 50 instances. If you
 	// omit this parameter, all Auto Scaling instances are described. If you specify
@@ -2333,6 +3176,16 @@ type metadataDescribeAutoScalingInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAutoScalingInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAutoScalingInstancesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAutoScalingInstancesOutput struct {
 	// The instances.
 	AutoScalingInstances []*InstanceDetails `type:"list"`
@@ -2348,6 +3201,16 @@ type metadataDescribeAutoScalingInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAutoScalingInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAutoScalingInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAutoScalingNotificationTypesInput struct {
 	metadataDescribeAutoScalingNotificationTypesInput `json:"-" xml:"-"`
 }
@@ -2356,6 +3219,16 @@ type metadataDescribeAutoScalingNotificationTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAutoScalingNotificationTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAutoScalingNotificationTypesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAutoScalingNotificationTypesOutput struct {
 	// One or more of the following notification types:
 	//
@@ -2377,6 +3250,16 @@ type metadataDescribeAutoScalingNotificationTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAutoScalingNotificationTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAutoScalingNotificationTypesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLaunchConfigurationsInput struct {
 	// The launch configuration names.
 	LaunchConfigurationNames []*string `type:"list"`
@@ -2395,6 +3278,16 @@ type metadataDescribeLaunchConfigurationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLaunchConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLaunchConfigurationsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLaunchConfigurationsOutput struct {
 	// The launch configurations.
 	LaunchConfigurations []*LaunchConfiguration `type:"list" required:"true"`
@@ -2410,6 +3303,16 @@ type metadataDescribeLaunchConfigurationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLaunchConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLaunchConfigurationsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLifecycleHookTypesInput struct {
 	metadataDescribeLifecycleHookTypesInput `json:"-" xml:"-"`
 }
@@ -2418,6 +3321,16 @@ type metadataDescribeLifecycleHookTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLifecycleHookTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLifecycleHookTypesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLifecycleHookTypesOutput struct {
 	// One or more of the following notification types:
 	//
@@ -2433,9 +3346,19 @@ type metadataDescribeLifecycleHookTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLifecycleHookTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLifecycleHookTypesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLifecycleHooksInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The names of one or more lifecycle hooks.
 	LifecycleHookNames []*string `type:"list"`
@@ -2447,6 +3370,16 @@ type metadataDescribeLifecycleHooksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLifecycleHooksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLifecycleHooksInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLifecycleHooksOutput struct {
 	// The lifecycle hooks for the specified group.
 	LifecycleHooks []*LifecycleHook `type:"list"`
@@ -2458,9 +3391,19 @@ type metadataDescribeLifecycleHooksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLifecycleHooksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLifecycleHooksOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancersInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The maximum number of items to return with this call.
 	MaxRecords *int64 `type:"integer"`
@@ -2476,6 +3419,16 @@ type metadataDescribeLoadBalancersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancersInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancersOutput struct {
 	// The load balancers.
 	LoadBalancers []*LoadBalancerState `type:"list"`
@@ -2491,6 +3444,16 @@ type metadataDescribeLoadBalancersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancersOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeMetricCollectionTypesInput struct {
 	metadataDescribeMetricCollectionTypesInput `json:"-" xml:"-"`
 }
@@ -2499,6 +3462,16 @@ type metadataDescribeMetricCollectionTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMetricCollectionTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMetricCollectionTypesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeMetricCollectionTypesOutput struct {
 	// The granularities for the metrics.
 	Granularities []*MetricGranularityType `type:"list"`
@@ -2513,6 +3486,16 @@ type metadataDescribeMetricCollectionTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMetricCollectionTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMetricCollectionTypesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeNotificationConfigurationsInput struct {
 	// The name of the group.
 	AutoScalingGroupNames []*string `type:"list"`
@@ -2531,6 +3514,16 @@ type metadataDescribeNotificationConfigurationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeNotificationConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNotificationConfigurationsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeNotificationConfigurationsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -2546,9 +3539,19 @@ type metadataDescribeNotificationConfigurationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeNotificationConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNotificationConfigurationsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribePoliciesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum number of items to be returned with each call.
 	MaxRecords *int64 `type:"integer"`
@@ -2570,6 +3573,16 @@ type metadataDescribePoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePoliciesInput) GoString() string {
+	return s.String()
+}
+
 type DescribePoliciesOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -2585,6 +3598,16 @@ type metadataDescribePoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeScalingActivitiesInput struct {
 	// A list containing the activity IDs of the desired scaling activities. If
 	// this list is omitted, all activities are described. If an AutoScalingGroupName
@@ -2594,7 +3617,7 @@ type DescribeScalingActivitiesInput struct {
 	ActivityIDs []*string `locationName:"ActivityIds" type:"list"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum number of items to return with this call.
 	MaxRecords *int64 `type:"integer"`
@@ -2610,6 +3633,16 @@ type metadataDescribeScalingActivitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScalingActivitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScalingActivitiesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeScalingActivitiesOutput struct {
 	// The scaling activities.
 	Activities []*Activity `type:"list" required:"true"`
@@ -2625,6 +3658,16 @@ type metadataDescribeScalingActivitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScalingActivitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScalingActivitiesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeScalingProcessTypesInput struct {
 	metadataDescribeScalingProcessTypesInput `json:"-" xml:"-"`
 }
@@ -2633,6 +3676,16 @@ type metadataDescribeScalingProcessTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScalingProcessTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScalingProcessTypesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeScalingProcessTypesOutput struct {
 	// The names of the process types.
 	Processes []*ProcessType `type:"list"`
@@ -2644,9 +3697,19 @@ type metadataDescribeScalingProcessTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScalingProcessTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScalingProcessTypesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeScheduledActionsInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The latest scheduled start time to return. If scheduled action names are
 	// provided, this parameter is ignored.
@@ -2679,6 +3742,16 @@ type metadataDescribeScheduledActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScheduledActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScheduledActionsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeScheduledActionsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -2694,6 +3767,16 @@ type metadataDescribeScheduledActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScheduledActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScheduledActionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsInput struct {
 	// A filter used to scope the tags to return.
 	Filters []*Filter `type:"list"`
@@ -2712,6 +3795,16 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -2727,6 +3820,16 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTerminationPolicyTypesInput struct {
 	metadataDescribeTerminationPolicyTypesInput `json:"-" xml:"-"`
 }
@@ -2735,6 +3838,16 @@ type metadataDescribeTerminationPolicyTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTerminationPolicyTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTerminationPolicyTypesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeTerminationPolicyTypesOutput struct {
 	// The termination policies supported by Auto Scaling (OldestInstance, OldestLaunchConfiguration,
 	// NewestInstance, ClosestToNextInstanceHour, and Default).
@@ -2747,9 +3860,19 @@ type metadataDescribeTerminationPolicyTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTerminationPolicyTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTerminationPolicyTypesOutput) GoString() string {
+	return s.String()
+}
+
 type DetachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -2765,6 +3888,16 @@ type metadataDetachInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachInstancesInput) GoString() string {
+	return s.String()
+}
+
 type DetachInstancesOutput struct {
 	// The activities related to detaching the instances from the Auto Scaling group.
 	Activities []*Activity `type:"list"`
@@ -2776,9 +3909,19 @@ type metadataDetachInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DetachLoadBalancersInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// One or more load balancer names.
 	LoadBalancerNames []*string `type:"list"`
@@ -2790,6 +3933,16 @@ type metadataDetachLoadBalancersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachLoadBalancersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachLoadBalancersInput) GoString() string {
+	return s.String()
+}
+
 type DetachLoadBalancersOutput struct {
 	metadataDetachLoadBalancersOutput `json:"-" xml:"-"`
 }
@@ -2798,9 +3951,19 @@ type metadataDetachLoadBalancersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachLoadBalancersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachLoadBalancersOutput) GoString() string {
+	return s.String()
+}
+
 type DisableMetricsCollectionInput struct {
 	// The name or Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more metrics. If you omit this parameter, all metrics are disabled.
 	//
@@ -2828,6 +3991,16 @@ type metadataDisableMetricsCollectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableMetricsCollectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableMetricsCollectionInput) GoString() string {
+	return s.String()
+}
+
 type DisableMetricsCollectionOutput struct {
 	metadataDisableMetricsCollectionOutput `json:"-" xml:"-"`
 }
@@ -2836,6 +4009,16 @@ type metadataDisableMetricsCollectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableMetricsCollectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableMetricsCollectionOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an Amazon EBS volume.
 type EBS struct {
 	// Indicates whether to delete the volume on instance termination.
@@ -2849,10 +4032,10 @@ type EBS struct {
 	// Valid values: Range is 100 to 4000.
 	//
 	// Default: None
-	IOPS *int64 `locationName:"Iops" type:"integer"`
+	IOPS *int64 `locationName:"Iops" type:"integer" min:"100" max:"20000"`
 
 	// The ID of the snapshot.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" min:"1" max:"255"`
 
 	// The volume size, in gigabytes.
 	//
@@ -2864,14 +4047,14 @@ type EBS struct {
 	// volume size, the default is the size of the snapshot.
 	//
 	// Required: Required when the volume type is io1.
-	VolumeSize *int64 `type:"integer"`
+	VolumeSize *int64 `type:"integer" min:"1" max:"16384"`
 
 	// The volume type.
 	//
 	// Valid values: standard | io1 | gp2
 	//
 	// Default: standard
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"1" max:"255"`
 
 	metadataEBS `json:"-" xml:"-"`
 }
@@ -2880,13 +4063,23 @@ type metadataEBS struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EBS) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EBS) GoString() string {
+	return s.String()
+}
+
 type EnableMetricsCollectionInput struct {
 	// The name or ARN of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The granularity to associate with the metrics to collect. The only valid
 	// value is 1Minute.
-	Granularity *string `type:"string" required:"true"`
+	Granularity *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more metrics. If you omit this parameter, all metrics are enabled.
 	//
@@ -2917,6 +4110,16 @@ type metadataEnableMetricsCollectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableMetricsCollectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableMetricsCollectionInput) GoString() string {
+	return s.String()
+}
+
 type EnableMetricsCollectionOutput struct {
 	metadataEnableMetricsCollectionOutput `json:"-" xml:"-"`
 }
@@ -2925,10 +4128,20 @@ type metadataEnableMetricsCollectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableMetricsCollectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableMetricsCollectionOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an enabled metric.
 type EnabledMetric struct {
 	// The granularity of the metric. The only valid value is 1Minute.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255"`
 
 	// The name of the metric.
 	//
@@ -2947,7 +4160,7 @@ type EnabledMetric struct {
 	// GroupTerminatingInstances
 	//
 	// GroupTotalInstances
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255"`
 
 	metadataEnabledMetric `json:"-" xml:"-"`
 }
@@ -2956,9 +4169,19 @@ type metadataEnabledMetric struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnabledMetric) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnabledMetric) GoString() string {
+	return s.String()
+}
+
 type EnterStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instances to move into Standby mode. You must specify at least
 	// one instance ID.
@@ -2977,6 +4200,16 @@ type metadataEnterStandbyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnterStandbyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnterStandbyInput) GoString() string {
+	return s.String()
+}
+
 type EnterStandbyOutput struct {
 	// The activities related to moving instances into Standby mode.
 	Activities []*Activity `type:"list"`
@@ -2988,9 +4221,19 @@ type metadataEnterStandbyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnterStandbyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnterStandbyOutput) GoString() string {
+	return s.String()
+}
+
 type ExecutePolicyInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// Set to True if you want Auto Scaling to wait for the cooldown period associated
 	// with the Auto Scaling group to complete before executing the policy.
@@ -3004,7 +4247,7 @@ type ExecutePolicyInput struct {
 	HonorCooldown *bool `type:"boolean"`
 
 	// The name or ARN of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataExecutePolicyInput `json:"-" xml:"-"`
 }
@@ -3013,6 +4256,16 @@ type metadataExecutePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExecutePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExecutePolicyInput) GoString() string {
+	return s.String()
+}
+
 type ExecutePolicyOutput struct {
 	metadataExecutePolicyOutput `json:"-" xml:"-"`
 }
@@ -3021,9 +4274,19 @@ type metadataExecutePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExecutePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExecutePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type ExitStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more instance IDs. You must specify at least one instance ID.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -3035,6 +4298,16 @@ type metadataExitStandbyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExitStandbyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExitStandbyInput) GoString() string {
+	return s.String()
+}
+
 type ExitStandbyOutput struct {
 	// The activities related to moving instances out of Standby mode.
 	Activities []*Activity `type:"list"`
@@ -3046,6 +4319,16 @@ type metadataExitStandbyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExitStandbyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExitStandbyOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a filter.
 type Filter struct {
 	// The name of the filter. The valid values are: "auto-scaling-group", "key",
@@ -3062,16 +4345,26 @@ type metadataFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Filter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Filter) GoString() string {
+	return s.String()
+}
+
 // Describes an Auto Scaling group.
 type Group struct {
 	// The Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupARN *string `type:"string"`
+	AutoScalingGroupARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list" required:"true"`
+	AvailabilityZones []*string `type:"list" min:"1" required:"true"`
 
 	// The date and time the group was created.
 	CreatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -3092,13 +4385,13 @@ type Group struct {
 
 	// The service of interest for the health status check, which can be either
 	// EC2 for Amazon EC2 or ELB for Elastic Load Balancing.
-	HealthCheckType *string `type:"string" required:"true"`
+	HealthCheckType *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The EC2 instances associated with the group.
 	Instances []*Instance `type:"list"`
 
 	// The name of the associated launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// One or more load balancers associated with the group.
 	LoadBalancerNames []*string `type:"list"`
@@ -3111,10 +4404,10 @@ type Group struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// The current state of the group when DeleteAutoScalingGroup is in progress.
-	Status *string `type:"string"`
+	Status *string `type:"string" min:"1" max:"255"`
 
 	// The suspended processes associated with the group.
 	SuspendedProcesses []*SuspendedProcess `type:"list"`
@@ -3129,7 +4422,7 @@ type Group struct {
 	//
 	// If you specify VPCZoneIdentifier and AvailabilityZones, ensure that the
 	// Availability Zones of the subnets match the values for AvailabilityZones.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataGroup `json:"-" xml:"-"`
 }
@@ -3138,23 +4431,35 @@ type metadataGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Group) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Group) GoString() string {
+	return s.String()
+}
+
 // Describes an EC2 instance.
 type Instance struct {
 	// The Availability Zone in which the instance is running.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The health status of the instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// A description of the current lifecycle state. Note that the Quarantined state
 	// is not used.
-	LifecycleState *string `type:"string" required:"true"`
+	//
+	// See the LifecycleState* constants for valid values.
+	LifecycleState *string `type:"string" enum:"Pending,Pending:Wait,Pending:Proceed,Quarantined,InService,Terminating,Terminating:Wait,Terminating:Proceed,Terminated,Detaching,Detached,EnteringStandby,Standby" required:"true"`
 
 	metadataInstance `json:"-" xml:"-"`
 }
@@ -3163,29 +4468,39 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Instance) GoString() string {
+	return s.String()
+}
+
 // Describes an EC2 instance associated with an Auto Scaling group.
 type InstanceDetails struct {
 	// The name of the Auto Scaling group associated with the instance.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Availability Zone for the instance.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The health status of this instance. "Healthy" means that the instance is
 	// healthy and should remain in service. "Unhealthy" means that the instance
 	// is unhealthy and Auto Scaling should terminate and replace it.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The lifecycle state for the instance. For more information, see Auto Scaling
 	// Instance States (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/AutoScalingGroupLifecycle.html#AutoScalingStates)
 	// in the Auto Scaling Developer Guide.
-	LifecycleState *string `type:"string" required:"true"`
+	LifecycleState *string `type:"string" min:"1" max:"32" required:"true"`
 
 	metadataInstanceDetails `json:"-" xml:"-"`
 }
@@ -3194,6 +4509,16 @@ type metadataInstanceDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceDetails) GoString() string {
+	return s.String()
+}
+
 // Describes whether instance monitoring is enabled.
 type InstanceMonitoring struct {
 	// If True, instance monitoring is enabled.
@@ -3206,6 +4531,16 @@ type metadataInstanceMonitoring struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceMonitoring) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceMonitoring) GoString() string {
+	return s.String()
+}
+
 // Describes a launch configuration.
 type LaunchConfiguration struct {
 	// Specifies whether the instances are associated with a public IP address (true)
@@ -3219,7 +4554,7 @@ type LaunchConfiguration struct {
 	// This parameter can only be used if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and cannot be
@@ -3235,45 +4570,45 @@ type LaunchConfiguration struct {
 
 	// The name or Amazon Resource Name (ARN) of the instance profile associated
 	// with the IAM role for the instance.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600"`
 
 	// The ID of the Amazon Machine Image (AMI).
-	ImageID *string `locationName:"ImageId" type:"string" required:"true"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255" required:"true"`
 
 	// Controls whether instances in this group are launched with detailed monitoring.
 	InstanceMonitoring *InstanceMonitoring `type:"structure"`
 
 	// The instance type for the instances.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The ID of the kernel associated with the AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255"`
 
 	// The name of the key pair.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the launch configuration.
-	LaunchConfigurationARN *string `type:"string"`
+	LaunchConfigurationARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The tenancy of the instance, either default or dedicated. An instance with
 	// dedicated tenancy runs in an isolated, single-tenant hardware and can only
 	// be launched into a VPC.
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64"`
 
 	// The ID of the RAM disk associated with the AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255"`
 
 	// The security groups to associate with the instances.
 	SecurityGroups []*string `type:"list"`
 
 	// The price to bid when launching Spot Instances.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data available to the instances.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847"`
 
 	metadataLaunchConfiguration `json:"-" xml:"-"`
 }
@@ -3282,6 +4617,16 @@ type metadataLaunchConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LaunchConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LaunchConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes a lifecycle hook, which tells Auto Scaling that you want to perform
 // an action when an instance launches or terminates. When you have a lifecycle
 // hook in place, the Auto Scaling group will either:
@@ -3293,7 +4638,7 @@ type metadataLaunchConfiguration struct {
 // in the Auto Scaling Developer Guide.
 type LifecycleHook struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The valid values
@@ -3311,7 +4656,7 @@ type LifecycleHook struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+"`
 
 	// The state of the EC2 instance to which you want to attach the lifecycle hook.
 	// For a list of lifecycle hook types, see DescribeLifecycleHookTypes.
@@ -3319,7 +4664,7 @@ type LifecycleHook struct {
 
 	// Additional information that you want to include any time Auto Scaling sends
 	// a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023"`
 
 	// The ARN of the notification target that Auto Scaling uses to notify you when
 	// an instance is in the transition state for the lifecycle hook. This ARN target
@@ -3328,11 +4673,11 @@ type LifecycleHook struct {
 	//
 	//  Lifecycle action token User account ID Name of the Auto Scaling group Lifecycle
 	// hook name EC2 instance ID Lifecycle transition Notification metadata
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataLifecycleHook `json:"-" xml:"-"`
 }
@@ -3341,10 +4686,20 @@ type metadataLifecycleHook struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LifecycleHook) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LifecycleHook) GoString() string {
+	return s.String()
+}
+
 // Describes the state of a load balancer.
 type LoadBalancerState struct {
 	// The name of the load balancer.
-	LoadBalancerName *string `type:"string"`
+	LoadBalancerName *string `type:"string" min:"1" max:"255"`
 
 	// The state of the load balancer.
 	//
@@ -3358,7 +4713,7 @@ type LoadBalancerState struct {
 	// Removing - The instances are being deregistered from the load balancer.
 	// If connection draining is enabled, Elastic Load Balancing waits for in-flight
 	// requests to complete before deregistering the instances.
-	State *string `type:"string"`
+	State *string `type:"string" min:"1" max:"255"`
 
 	metadataLoadBalancerState `json:"-" xml:"-"`
 }
@@ -3367,6 +4722,16 @@ type metadataLoadBalancerState struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoadBalancerState) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoadBalancerState) GoString() string {
+	return s.String()
+}
+
 // Describes a metric.
 type MetricCollectionType struct {
 	// The metric.
@@ -3386,7 +4751,7 @@ type MetricCollectionType struct {
 	// GroupTerminatingInstances
 	//
 	// GroupTotalInstances
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255"`
 
 	metadataMetricCollectionType `json:"-" xml:"-"`
 }
@@ -3395,10 +4760,20 @@ type metadataMetricCollectionType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MetricCollectionType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MetricCollectionType) GoString() string {
+	return s.String()
+}
+
 // Describes a granularity of a metric.
 type MetricGranularityType struct {
 	// The granularity. The only valid value is 1Minute.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255"`
 
 	metadataMetricGranularityType `json:"-" xml:"-"`
 }
@@ -3407,10 +4782,20 @@ type metadataMetricGranularityType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MetricGranularityType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MetricGranularityType) GoString() string {
+	return s.String()
+}
+
 // Describes a notification.
 type NotificationConfiguration struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600"`
 
 	// The types of events for an action to start.
 	//
@@ -3423,11 +4808,11 @@ type NotificationConfiguration struct {
 	// autoscaling:EC2_INSTANCE_TERMINATE_ERROR
 	//
 	// autoscaling:TEST_NOTIFICATION
-	NotificationType *string `type:"string"`
+	NotificationType *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string"`
+	TopicARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataNotificationConfiguration `json:"-" xml:"-"`
 }
@@ -3436,6 +4821,16 @@ type metadataNotificationConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NotificationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NotificationConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes a process type.
 //
 // For more information, see Auto Scaling Processes (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/US_SuspendResume.html#process-types)
@@ -3458,7 +4853,7 @@ type ProcessType struct {
 	// ReplaceUnhealthy
 	//
 	// ScheduledActions
-	ProcessName *string `type:"string" required:"true"`
+	ProcessName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataProcessType `json:"-" xml:"-"`
 }
@@ -3467,10 +4862,20 @@ type metadataProcessType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ProcessType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ProcessType) GoString() string {
+	return s.String()
+}
+
 type PutLifecycleHookInput struct {
 	// The name of the Auto Scaling group to which you want to assign the lifecycle
 	// hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The value for this
@@ -3486,7 +4891,7 @@ type PutLifecycleHookInput struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	// The instance state to which you want to attach the lifecycle hook. For a
 	// list of lifecycle hook types, see DescribeLifecycleHookTypes.
@@ -3497,7 +4902,7 @@ type PutLifecycleHookInput struct {
 
 	// Contains additional information that you want to include any time Auto Scaling
 	// sends a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023"`
 
 	// The ARN of the notification target that Auto Scaling will use to notify you
 	// when an instance is in the transition state for the lifecycle hook. This
@@ -3518,14 +4923,14 @@ type PutLifecycleHookInput struct {
 	//
 	// When you call this operation, a test message is sent to the notification
 	// target. This test message contains an additional key/value pair: Event:autoscaling:TEST_NOTIFICATION.
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
 	//
 	// This parameter is required for new lifecycle hooks, but optional when updating
 	// existing hooks.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataPutLifecycleHookInput `json:"-" xml:"-"`
 }
@@ -3534,6 +4939,16 @@ type metadataPutLifecycleHookInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutLifecycleHookInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutLifecycleHookInput) GoString() string {
+	return s.String()
+}
+
 type PutLifecycleHookOutput struct {
 	metadataPutLifecycleHookOutput `json:"-" xml:"-"`
 }
@@ -3542,9 +4957,19 @@ type metadataPutLifecycleHookOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutLifecycleHookOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutLifecycleHookOutput) GoString() string {
+	return s.String()
+}
+
 type PutNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The type of event that will cause the notification to be sent. For details
 	// about notification types supported by Auto Scaling, see DescribeAutoScalingNotificationTypes.
@@ -3552,7 +4977,7 @@ type PutNotificationConfigurationInput struct {
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	metadataPutNotificationConfigurationInput `json:"-" xml:"-"`
 }
@@ -3561,6 +4986,16 @@ type metadataPutNotificationConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutNotificationConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutNotificationConfigurationInput) GoString() string {
+	return s.String()
+}
+
 type PutNotificationConfigurationOutput struct {
 	metadataPutNotificationConfigurationOutput `json:"-" xml:"-"`
 }
@@ -3569,16 +5004,26 @@ type metadataPutNotificationConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutNotificationConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutNotificationConfigurationOutput) GoString() string {
+	return s.String()
+}
+
 type PutScalingPolicyInput struct {
 	// The adjustment type. Valid values are ChangeInCapacity, ExactCapacity, and
 	// PercentChangeInCapacity.
 	//
 	// For more information, see Dynamic Scaling (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/as-scale-based-on-demand.html)
 	// in the Auto Scaling Developer Guide.
-	AdjustmentType *string `type:"string" required:"true"`
+	AdjustmentType *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The name or ARN of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The amount of time, in seconds, after a scaling activity completes and before
 	// the next scaling activity can start.
@@ -3596,7 +5041,7 @@ type PutScalingPolicyInput struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The number of instances by which to scale. AdjustmentType determines the
 	// interpretation of this number (for example, as an absolute number or as a
@@ -3612,9 +5057,19 @@ type metadataPutScalingPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutScalingPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutScalingPolicyInput) GoString() string {
+	return s.String()
+}
+
 type PutScalingPolicyOutput struct {
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600"`
 
 	metadataPutScalingPolicyOutput `json:"-" xml:"-"`
 }
@@ -3623,9 +5078,19 @@ type metadataPutScalingPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutScalingPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutScalingPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type PutScheduledUpdateGroupActionInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The number of EC2 instances that should be running in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -3645,10 +5110,10 @@ type PutScheduledUpdateGroupActionInput struct {
 	//
 	// When StartTime and EndTime are specified with Recurrence, they form the
 	// boundaries of when the recurring action will start and stop.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255"`
 
 	// The name of this scaling action.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The time for this action to start, in "YYYY-MM-DDThh:mm:ssZ" format in UTC/GMT
 	// only (for example, 2014-06-01T00:00:00Z).
@@ -3673,6 +5138,16 @@ type metadataPutScheduledUpdateGroupActionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutScheduledUpdateGroupActionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutScheduledUpdateGroupActionInput) GoString() string {
+	return s.String()
+}
+
 type PutScheduledUpdateGroupActionOutput struct {
 	metadataPutScheduledUpdateGroupActionOutput `json:"-" xml:"-"`
 }
@@ -3681,17 +5156,27 @@ type metadataPutScheduledUpdateGroupActionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutScheduledUpdateGroupActionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutScheduledUpdateGroupActionOutput) GoString() string {
+	return s.String()
+}
+
 type RecordLifecycleActionHeartbeatInput struct {
 	// The name of the Auto Scaling group for the hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// A token that uniquely identifies a specific lifecycle action associated with
 	// an instance. Auto Scaling sends this token to the notification target you
 	// specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataRecordLifecycleActionHeartbeatInput `json:"-" xml:"-"`
 }
@@ -3700,6 +5185,16 @@ type metadataRecordLifecycleActionHeartbeatInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordLifecycleActionHeartbeatInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordLifecycleActionHeartbeatInput) GoString() string {
+	return s.String()
+}
+
 type RecordLifecycleActionHeartbeatOutput struct {
 	metadataRecordLifecycleActionHeartbeatOutput `json:"-" xml:"-"`
 }
@@ -3708,6 +5203,16 @@ type metadataRecordLifecycleActionHeartbeatOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordLifecycleActionHeartbeatOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordLifecycleActionHeartbeatOutput) GoString() string {
+	return s.String()
+}
+
 type ResumeProcessesOutput struct {
 	metadataResumeProcessesOutput `json:"-" xml:"-"`
 }
@@ -3716,18 +5221,28 @@ type metadataResumeProcessesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResumeProcessesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResumeProcessesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a scaling policy.
 type ScalingPolicy struct {
 	// Specifies whether the ScalingAdjustment is an absolute number or a percentage
 	// of the current capacity. Valid values are ChangeInCapacity, ExactCapacity,
 	// and PercentChangeInCapacity.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255"`
 
 	// The CloudWatch alarms related to the policy.
 	Alarms []*Alarm `type:"list"`
 
 	// The name of the Auto Scaling group associated with this scaling policy.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// any further trigger-related scaling activities can start.
@@ -3738,10 +5253,10 @@ type ScalingPolicy struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the scaling policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"255"`
 
 	// The number associated with the specified adjustment type. A positive value
 	// adds to the current capacity and a negative value removes from the current
@@ -3755,9 +5270,19 @@ type metadataScalingPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScalingPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScalingPolicy) GoString() string {
+	return s.String()
+}
+
 type ScalingProcessQuery struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more of the following processes:
 	//
@@ -3785,10 +5310,20 @@ type metadataScalingProcessQuery struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScalingProcessQuery) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScalingProcessQuery) GoString() string {
+	return s.String()
+}
+
 // Describes a scheduled update to an Auto Scaling group.
 type ScheduledUpdateGroupAction struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255"`
 
 	// The number of instances you prefer to maintain in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -3804,13 +5339,13 @@ type ScheduledUpdateGroupAction struct {
 	MinSize *int64 `type:"integer"`
 
 	// The recurring schedule for the action.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255"`
 
 	// The Amazon Resource Name (ARN) of the scheduled action.
-	ScheduledActionARN *string `type:"string"`
+	ScheduledActionARN *string `type:"string" min:"1" max:"1600"`
 
 	// The name of the scheduled action.
-	ScheduledActionName *string `type:"string"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255"`
 
 	// The time that the action is scheduled to begin. This value can be up to one
 	// month in the future.
@@ -3829,9 +5364,19 @@ type metadataScheduledUpdateGroupAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScheduledUpdateGroupAction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScheduledUpdateGroupAction) GoString() string {
+	return s.String()
+}
+
 type SetDesiredCapacityInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// The number of EC2 instances that should be running in the Auto Scaling group.
 	DesiredCapacity *int64 `type:"integer" required:"true"`
@@ -3849,6 +5394,16 @@ type metadataSetDesiredCapacityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetDesiredCapacityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetDesiredCapacityInput) GoString() string {
+	return s.String()
+}
+
 type SetDesiredCapacityOutput struct {
 	metadataSetDesiredCapacityOutput `json:"-" xml:"-"`
 }
@@ -3857,14 +5412,24 @@ type metadataSetDesiredCapacityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetDesiredCapacityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetDesiredCapacityOutput) GoString() string {
+	return s.String()
+}
+
 type SetInstanceHealthInput struct {
 	// The health status of the instance. Set to Healthy if you want the instance
 	// to remain in service. Set to Unhealthy if you want the instance to be out
 	// of service. Auto Scaling will terminate and replace the unhealthy instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" required:"true"`
 
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// If the Auto Scaling group of the specified instance has a HealthCheckGracePeriod
 	// specified for the group, by default, this call will respect the grace period.
@@ -3882,6 +5447,16 @@ type metadataSetInstanceHealthInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetInstanceHealthInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetInstanceHealthInput) GoString() string {
+	return s.String()
+}
+
 type SetInstanceHealthOutput struct {
 	metadataSetInstanceHealthOutput `json:"-" xml:"-"`
 }
@@ -3890,6 +5465,16 @@ type metadataSetInstanceHealthOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetInstanceHealthOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetInstanceHealthOutput) GoString() string {
+	return s.String()
+}
+
 type SuspendProcessesOutput struct {
 	metadataSuspendProcessesOutput `json:"-" xml:"-"`
 }
@@ -3898,14 +5483,24 @@ type metadataSuspendProcessesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuspendProcessesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuspendProcessesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an Auto Scaling process that has been suspended. For more information,
 // see ProcessType.
 type SuspendedProcess struct {
 	// The name of the suspended process.
-	ProcessName *string `type:"string"`
+	ProcessName *string `type:"string" min:"1" max:"255"`
 
 	// The reason that the process was suspended.
-	SuspensionReason *string `type:"string"`
+	SuspensionReason *string `type:"string" min:"1" max:"255"`
 
 	metadataSuspendedProcess `json:"-" xml:"-"`
 }
@@ -3914,10 +5509,20 @@ type metadataSuspendedProcess struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuspendedProcess) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuspendedProcess) GoString() string {
+	return s.String()
+}
+
 // Describes a tag for an Auto Scaling group.
 type Tag struct {
 	// The tag key.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// Determines whether the tag is added to new instances as they are launched
 	// in the group.
@@ -3930,7 +5535,7 @@ type Tag struct {
 	ResourceType *string `type:"string"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -3939,10 +5544,20 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // Describes a tag for an Auto Scaling group.
 type TagDescription struct {
 	// The tag key.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128"`
 
 	// Determines whether the tag is added to new instances as they are launched
 	// in the group.
@@ -3955,7 +5570,7 @@ type TagDescription struct {
 	ResourceType *string `type:"string"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTagDescription `json:"-" xml:"-"`
 }
@@ -3964,9 +5579,19 @@ type metadataTagDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TagDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TagDescription) GoString() string {
+	return s.String()
+}
+
 type TerminateInstanceInAutoScalingGroupInput struct {
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" required:"true"`
 
 	// If true, terminating this instance also decrements the size of the Auto Scaling
 	// group.
@@ -3979,6 +5604,16 @@ type metadataTerminateInstanceInAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateInstanceInAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateInstanceInAutoScalingGroupInput) GoString() string {
+	return s.String()
+}
+
 type TerminateInstanceInAutoScalingGroupOutput struct {
 	// A scaling activity.
 	Activity *Activity `type:"structure"`
@@ -3990,12 +5625,22 @@ type metadataTerminateInstanceInAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateInstanceInAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateInstanceInAutoScalingGroupOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateAutoScalingGroupInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start. For more information, see Understanding
@@ -4016,10 +5661,10 @@ type UpdateAutoScalingGroupInput struct {
 	// The type of health check for the instances in the Auto Scaling group. The
 	// health check type can either be EC2 for Amazon EC2 or ELB for Elastic Load
 	// Balancing.
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600"`
 
 	// The maximum size of the Auto Scaling group.
 	MaxSize *int64 `type:"integer"`
@@ -4029,7 +5674,7 @@ type UpdateAutoScalingGroupInput struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255"`
 
 	// A standalone termination policy or a list of termination policies used to
 	// select the instance to terminate. The policies are executed in the order
@@ -4049,7 +5694,7 @@ type UpdateAutoScalingGroupInput struct {
 	// For more information, see Auto Scaling and Amazon Virtual Private Cloud
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255"`
 
 	metadataUpdateAutoScalingGroupInput `json:"-" xml:"-"`
 }
@@ -4058,6 +5703,16 @@ type metadataUpdateAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAutoScalingGroupInput) GoString() string {
+	return s.String()
+}
+
 type UpdateAutoScalingGroupOutput struct {
 	metadataUpdateAutoScalingGroupOutput `json:"-" xml:"-"`
 }
@@ -4065,3 +5720,65 @@ type UpdateAutoScalingGroupOutput struct {
 type metadataUpdateAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAutoScalingGroupOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum LifecycleState
+	LifecycleStatePending = "Pending"
+	// @enum LifecycleState
+	LifecycleStatePendingWait = "Pending:Wait"
+	// @enum LifecycleState
+	LifecycleStatePendingProceed = "Pending:Proceed"
+	// @enum LifecycleState
+	LifecycleStateQuarantined = "Quarantined"
+	// @enum LifecycleState
+	LifecycleStateInService = "InService"
+	// @enum LifecycleState
+	LifecycleStateTerminating = "Terminating"
+	// @enum LifecycleState
+	LifecycleStateTerminatingWait = "Terminating:Wait"
+	// @enum LifecycleState
+	LifecycleStateTerminatingProceed = "Terminating:Proceed"
+	// @enum LifecycleState
+	LifecycleStateTerminated = "Terminated"
+	// @enum LifecycleState
+	LifecycleStateDetaching = "Detaching"
+	// @enum LifecycleState
+	LifecycleStateDetached = "Detached"
+	// @enum LifecycleState
+	LifecycleStateEnteringStandby = "EnteringStandby"
+	// @enum LifecycleState
+	LifecycleStateStandby = "Standby"
+)
+
+const (
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeWaitingForSpotInstanceRequestId = "WaitingForSpotInstanceRequestId"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeWaitingForSpotInstanceId = "WaitingForSpotInstanceId"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeWaitingForInstanceId = "WaitingForInstanceId"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodePreInService = "PreInService"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeInProgress = "InProgress"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeWaitingForELBConnectionDraining = "WaitingForELBConnectionDraining"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeMidLifecycleAction = "MidLifecycleAction"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeSuccessful = "Successful"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeFailed = "Failed"
+	// @enum ScalingActivityStatusCode
+	ScalingActivityStatusCodeCancelled = "Cancelled"
+)
@@ -31,11 +31,11 @@ func New(config *aws.Config) *AutoScaling {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCancelUpdateStack = "CancelUpdateStack"
@@ -39,6 +40,16 @@ func (c *CloudFormation) CancelUpdateStack(input *CancelUpdateStackInput) (*Canc
 	return out, err
 }
 
+// CancelUpdateStackWithContext is the same as CancelUpdateStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) CancelUpdateStackWithContext(ctx aws.Context, input *CancelUpdateStackInput) (*CancelUpdateStackOutput, error) {
+	req, out := c.CancelUpdateStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStack = "CreateStack"
 
 // CreateStackRequest generates a request for the CreateStack operation.
@@ -68,6 +79,16 @@ func (c *CloudFormation) CreateStack(input *CreateStackInput) (*CreateStackOutpu
 	return out, err
 }
 
+// CreateStackWithContext is the same as CreateStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) CreateStackWithContext(ctx aws.Context, input *CreateStackInput) (*CreateStackOutput, error) {
+	req, out := c.CreateStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteStack = "DeleteStack"
 
 // DeleteStackRequest generates a request for the DeleteStack operation.
@@ -97,6 +118,16 @@ func (c *CloudFormation) DeleteStack(input *DeleteStackInput) (*DeleteStackOutpu
 	return out, err
 }
 
+// DeleteStackWithContext is the same as DeleteStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) DeleteStackWithContext(ctx aws.Context, input *DeleteStackInput) (*DeleteStackOutput, error) {
+	req, out := c.DeleteStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStackEvents = "DescribeStackEvents"
 
 // DescribeStackEventsRequest generates a request for the DescribeStackEvents operation.
@@ -135,6 +166,16 @@ func (c *CloudFormation) DescribeStackEvents(input *DescribeStackEventsInput) (*
 	return out, err
 }
 
+// DescribeStackEventsWithContext is the same as DescribeStackEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) DescribeStackEventsWithContext(ctx aws.Context, input *DescribeStackEventsInput) (*DescribeStackEventsOutput, error) {
+	req, out := c.DescribeStackEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFormation) DescribeStackEventsPages(input *DescribeStackEventsInput, fn func(p *DescribeStackEventsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeStackEventsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -172,6 +213,16 @@ func (c *CloudFormation) DescribeStackResource(input *DescribeStackResourceInput
 	return out, err
 }
 
+// DescribeStackResourceWithContext is the same as DescribeStackResource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) DescribeStackResourceWithContext(ctx aws.Context, input *DescribeStackResourceInput) (*DescribeStackResourceOutput, error) {
+	req, out := c.DescribeStackResourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStackResources = "DescribeStackResources"
 
 // DescribeStackResourcesRequest generates a request for the DescribeStackResources operation.
@@ -215,6 +266,16 @@ func (c *CloudFormation) DescribeStackResources(input *DescribeStackResourcesInp
 	return out, err
 }
 
+// DescribeStackResourcesWithContext is the same as DescribeStackResources with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) DescribeStackResourcesWithContext(ctx aws.Context, input *DescribeStackResourcesInput) (*DescribeStackResourcesOutput, error) {
+	req, out := c.DescribeStackResourcesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStacks = "DescribeStacks"
 
 // DescribeStacksRequest generates a request for the DescribeStacks operation.
@@ -249,6 +310,16 @@ func (c *CloudFormation) DescribeStacks(input *DescribeStacksInput) (*DescribeSt
 	return out, err
 }
 
+// DescribeStacksWithContext is the same as DescribeStacks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) DescribeStacksWithContext(ctx aws.Context, input *DescribeStacksInput) (*DescribeStacksOutput, error) {
+	req, out := c.DescribeStacksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFormation) DescribeStacksPages(input *DescribeStacksInput, fn func(p *DescribeStacksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeStacksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -285,6 +356,16 @@ func (c *CloudFormation) EstimateTemplateCost(input *EstimateTemplateCostInput)
 	return out, err
 }
 
+// EstimateTemplateCostWithContext is the same as EstimateTemplateCost with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) EstimateTemplateCostWithContext(ctx aws.Context, input *EstimateTemplateCostInput) (*EstimateTemplateCostOutput, error) {
+	req, out := c.EstimateTemplateCostRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetStackPolicy = "GetStackPolicy"
 
 // GetStackPolicyRequest generates a request for the GetStackPolicy operation.
@@ -313,6 +394,16 @@ func (c *CloudFormation) GetStackPolicy(input *GetStackPolicyInput) (*GetStackPo
 	return out, err
 }
 
+// GetStackPolicyWithContext is the same as GetStackPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) GetStackPolicyWithContext(ctx aws.Context, input *GetStackPolicyInput) (*GetStackPolicyOutput, error) {
+	req, out := c.GetStackPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetTemplate = "GetTemplate"
 
 // GetTemplateRequest generates a request for the GetTemplate operation.
@@ -346,6 +437,16 @@ func (c *CloudFormation) GetTemplate(input *GetTemplateInput) (*GetTemplateOutpu
 	return out, err
 }
 
+// GetTemplateWithContext is the same as GetTemplate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) GetTemplateWithContext(ctx aws.Context, input *GetTemplateInput) (*GetTemplateOutput, error) {
+	req, out := c.GetTemplateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetTemplateSummary = "GetTemplateSummary"
 
 // GetTemplateSummaryRequest generates a request for the GetTemplateSummary operation.
@@ -382,6 +483,16 @@ func (c *CloudFormation) GetTemplateSummary(input *GetTemplateSummaryInput) (*Ge
 	return out, err
 }
 
+// GetTemplateSummaryWithContext is the same as GetTemplateSummary with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) GetTemplateSummaryWithContext(ctx aws.Context, input *GetTemplateSummaryInput) (*GetTemplateSummaryOutput, error) {
+	req, out := c.GetTemplateSummaryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListStackResources = "ListStackResources"
 
 // ListStackResourcesRequest generates a request for the ListStackResources operation.
@@ -418,6 +529,16 @@ func (c *CloudFormation) ListStackResources(input *ListStackResourcesInput) (*Li
 	return out, err
 }
 
+// ListStackResourcesWithContext is the same as ListStackResources with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) ListStackResourcesWithContext(ctx aws.Context, input *ListStackResourcesInput) (*ListStackResourcesOutput, error) {
+	req, out := c.ListStackResourcesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFormation) ListStackResourcesPages(input *ListStackResourcesInput, fn func(p *ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStackResourcesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -462,6 +583,16 @@ func (c *CloudFormation) ListStacks(input *ListStacksInput) (*ListStacksOutput,
 	return out, err
 }
 
+// ListStacksWithContext is the same as ListStacks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) ListStacksWithContext(ctx aws.Context, input *ListStacksInput) (*ListStacksOutput, error) {
+	req, out := c.ListStacksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFormation) ListStacksPages(input *ListStacksInput, fn func(p *ListStacksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStacksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -496,6 +627,16 @@ func (c *CloudFormation) SetStackPolicy(input *SetStackPolicyInput) (*SetStackPo
 	return out, err
 }
 
+// SetStackPolicyWithContext is the same as SetStackPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) SetStackPolicyWithContext(ctx aws.Context, input *SetStackPolicyInput) (*SetStackPolicyOutput, error) {
+	req, out := c.SetStackPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSignalResource = "SignalResource"
 
 // SignalResourceRequest generates a request for the SignalResource operation.
@@ -528,6 +669,16 @@ func (c *CloudFormation) SignalResource(input *SignalResourceInput) (*SignalReso
 	return out, err
 }
 
+// SignalResourceWithContext is the same as SignalResource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) SignalResourceWithContext(ctx aws.Context, input *SignalResourceInput) (*SignalResourceOutput, error) {
+	req, out := c.SignalResourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateStack = "UpdateStack"
 
 // UpdateStackRequest generates a request for the UpdateStack operation.
@@ -566,6 +717,16 @@ func (c *CloudFormation) UpdateStack(input *UpdateStackInput) (*UpdateStackOutpu
 	return out, err
 }
 
+// UpdateStackWithContext is the same as UpdateStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) UpdateStackWithContext(ctx aws.Context, input *UpdateStackInput) (*UpdateStackOutput, error) {
+	req, out := c.UpdateStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opValidateTemplate = "ValidateTemplate"
 
 // ValidateTemplateRequest generates a request for the ValidateTemplate operation.
@@ -593,6 +754,16 @@ func (c *CloudFormation) ValidateTemplate(input *ValidateTemplateInput) (*Valida
 	return out, err
 }
 
+// ValidateTemplateWithContext is the same as ValidateTemplate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFormation) ValidateTemplateWithContext(ctx aws.Context, input *ValidateTemplateInput) (*ValidateTemplateOutput, error) {
+	req, out := c.ValidateTemplateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // The input for CancelUpdateStack action.
 type CancelUpdateStackInput struct {
 	// The name or the unique stack ID that is associated with the stack.
@@ -605,6 +776,16 @@ type metadataCancelUpdateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelUpdateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelUpdateStackInput) GoString() string {
+	return s.String()
+}
+
 type CancelUpdateStackOutput struct {
 	metadataCancelUpdateStackOutput `json:"-" xml:"-"`
 }
@@ -613,6 +794,16 @@ type metadataCancelUpdateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelUpdateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelUpdateStackOutput) GoString() string {
+	return s.String()
+}
+
 // The input for CreateStack action.
 type CreateStackInput struct {
 	// A list of capabilities that you must specify before AWS CloudFormation can
@@ -642,14 +833,16 @@ type CreateStackInput struct {
 	// The Simple Notification Service (SNS) topic ARNs to publish stack related
 	// events. You can find your SNS topic ARNs using the SNS console (http://console.aws.amazon.com/sns)
 	// or your Command Line Interface (CLI).
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// Determines what action will be taken if stack creation fails. This must be
 	// one of: DO_NOTHING, ROLLBACK, or DELETE. You can specify either OnFailure
 	// or DisableRollback, but not both.
 	//
 	// Default: ROLLBACK
-	OnFailure *string `type:"string"`
+	//
+	// See the OnFailure* constants for valid values.
+	OnFailure *string `type:"string" enum:"DO_NOTHING,ROLLBACK,DELETE"`
 
 	// A list of Parameter structures that specify input parameters for the stack.
 	Parameters []*Parameter `type:"list"`
@@ -666,13 +859,13 @@ type CreateStackInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// A set of user-defined Tags to associate with this stack, represented by key/value
 	// pairs. Tags defined for the stack are propagated to EC2 resources that are
@@ -686,7 +879,7 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 460,800 bytes) located in an S3 bucket in the same region as the
@@ -695,12 +888,12 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// The amount of time that can pass before the stack status becomes CREATE_FAILED;
 	// if DisableRollback is not set or is set to false, the stack will be rolled
 	// back.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataCreateStackInput `json:"-" xml:"-"`
 }
@@ -709,6 +902,16 @@ type metadataCreateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStackInput) GoString() string {
+	return s.String()
+}
+
 // The output for a CreateStack action.
 type CreateStackOutput struct {
 	// Unique identifier of the stack.
@@ -721,6 +924,16 @@ type metadataCreateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStackOutput) GoString() string {
+	return s.String()
+}
+
 // The input for DeleteStack action.
 type DeleteStackInput struct {
 	// The name or the unique stack ID that is associated with the stack.
@@ -733,6 +946,16 @@ type metadataDeleteStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStackInput) GoString() string {
+	return s.String()
+}
+
 type DeleteStackOutput struct {
 	metadataDeleteStackOutput `json:"-" xml:"-"`
 }
@@ -741,13 +964,23 @@ type metadataDeleteStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStackOutput) GoString() string {
+	return s.String()
+}
+
 // The input for DescribeStackEvents action.
 type DescribeStackEventsInput struct {
 	// String that identifies the start of the next list of events, if there is
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique stack ID that is associated with the stack, which
 	// are not always interchangeable:
@@ -764,11 +997,21 @@ type metadataDescribeStackEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackEventsInput) GoString() string {
+	return s.String()
+}
+
 // The output for a DescribeStackEvents action.
 type DescribeStackEventsOutput struct {
 	// String that identifies the start of the next list of events, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackEvents structures.
 	StackEvents []*StackEvent `type:"list"`
@@ -780,6 +1023,16 @@ type metadataDescribeStackEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackEventsOutput) GoString() string {
+	return s.String()
+}
+
 // The input for DescribeStackResource action.
 type DescribeStackResourceInput struct {
 	// The logical name of the resource as specified in the template.
@@ -802,6 +1055,16 @@ type metadataDescribeStackResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackResourceInput) GoString() string {
+	return s.String()
+}
+
 // The output for a DescribeStackResource action.
 type DescribeStackResourceOutput struct {
 	// A StackResourceDetail structure containing the description of the specified
@@ -815,6 +1078,16 @@ type metadataDescribeStackResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackResourceOutput) GoString() string {
+	return s.String()
+}
+
 // The input for DescribeStackResources action.
 type DescribeStackResourcesInput struct {
 	// The logical name of the resource as specified in the template.
@@ -854,6 +1127,16 @@ type metadataDescribeStackResourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackResourcesInput) GoString() string {
+	return s.String()
+}
+
 // The output for a DescribeStackResources action.
 type DescribeStackResourcesOutput struct {
 	// A list of StackResource structures.
@@ -866,11 +1149,21 @@ type metadataDescribeStackResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackResourcesOutput) GoString() string {
+	return s.String()
+}
+
 // The input for DescribeStacks action.
 type DescribeStacksInput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique stack ID that is associated with the stack, which
 	// are not always interchangeable:
@@ -887,11 +1180,21 @@ type metadataDescribeStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStacksInput) GoString() string {
+	return s.String()
+}
+
 // The output for a DescribeStacks action.
 type DescribeStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of stack structures.
 	Stacks []*Stack `type:"list"`
@@ -903,6 +1206,16 @@ type metadataDescribeStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStacksOutput) GoString() string {
+	return s.String()
+}
+
 type EstimateTemplateCostInput struct {
 	// A list of Parameter structures that specify input parameters.
 	Parameters []*Parameter `type:"list"`
@@ -914,7 +1227,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateBody or TemplateURL. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -923,7 +1236,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataEstimateTemplateCostInput `json:"-" xml:"-"`
 }
@@ -932,6 +1245,16 @@ type metadataEstimateTemplateCostInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EstimateTemplateCostInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EstimateTemplateCostInput) GoString() string {
+	return s.String()
+}
+
 // The output for a EstimateTemplateCost action.
 type EstimateTemplateCostOutput struct {
 	// An AWS Simple Monthly Calculator URL with a query string that describes the
@@ -945,6 +1268,16 @@ type metadataEstimateTemplateCostOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EstimateTemplateCostOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EstimateTemplateCostOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the GetStackPolicy action.
 type GetStackPolicyInput struct {
 	// The name or unique stack ID that is associated with the stack whose policy
@@ -958,12 +1291,22 @@ type metadataGetStackPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetStackPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStackPolicyInput) GoString() string {
+	return s.String()
+}
+
 // The output for the GetStackPolicy action.
 type GetStackPolicyOutput struct {
 	// Structure containing the stack policy body. (For more information, go to
 	//  Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide.)
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	metadataGetStackPolicyOutput `json:"-" xml:"-"`
 }
@@ -972,6 +1315,16 @@ type metadataGetStackPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetStackPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStackPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // The input for a GetTemplate action.
 type GetTemplateInput struct {
 	// The name or the unique stack ID that is associated with the stack, which
@@ -989,12 +1342,22 @@ type metadataGetTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTemplateInput) GoString() string {
+	return s.String()
+}
+
 // The output for GetTemplate action.
 type GetTemplateOutput struct {
 	// Structure containing the template body. (For more information, go to Template
 	// Anatomy (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/template-anatomy.html)
 	// in the AWS CloudFormation User Guide.)
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	metadataGetTemplateOutput `json:"-" xml:"-"`
 }
@@ -1003,6 +1366,16 @@ type metadataGetTemplateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTemplateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTemplateOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the GetTemplateSummary action.
 type GetTemplateSummaryInput struct {
 	// The name or the stack ID that is associated with the stack, which are not
@@ -1012,7 +1385,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	StackName *string `type:"string"`
+	StackName *string `type:"string" min:"1" pattern:"([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. For more information about templates, see
@@ -1021,7 +1394,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 460,800 bytes) located in an Amazon S3 bucket. For more information
@@ -1030,7 +1403,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataGetTemplateSummaryInput `json:"-" xml:"-"`
 }
@@ -1039,6 +1412,16 @@ type metadataGetTemplateSummaryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTemplateSummaryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTemplateSummaryInput) GoString() string {
+	return s.String()
+}
+
 // The output for the GetTemplateSummary action.
 type GetTemplateSummaryOutput struct {
 	// The capabilities found within the template. Currently, AWS CloudFormation
@@ -1073,13 +1456,23 @@ type metadataGetTemplateSummaryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTemplateSummaryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTemplateSummaryOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the ListStackResource action.
 type ListStackResourcesInput struct {
 	// String that identifies the start of the next list of stack resource summaries,
 	// if there is one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique stack ID that is associated with the stack, which
 	// are not always interchangeable:
@@ -1096,11 +1489,21 @@ type metadataListStackResourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStackResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStackResourcesInput) GoString() string {
+	return s.String()
+}
+
 // The output for a ListStackResources action.
 type ListStackResourcesOutput struct {
 	// String that identifies the start of the next list of stack resources, if
 	// there is one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackResourceSummary structures.
 	StackResourceSummaries []*StackResourceSummary `type:"list"`
@@ -1112,13 +1515,23 @@ type metadataListStackResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStackResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStackResourcesOutput) GoString() string {
+	return s.String()
+}
+
 // The input for ListStacks action.
 type ListStacksInput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// Stack status to use as a filter. Specify one or more stack status codes to
 	// list only stacks with the specified status codes. For a complete list of
@@ -1132,11 +1545,21 @@ type metadataListStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStacksInput) GoString() string {
+	return s.String()
+}
+
 // The output for ListStacks action.
 type ListStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackSummary structures containing information about the specified
 	// stacks.
@@ -1149,6 +1572,16 @@ type metadataListStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStacksOutput) GoString() string {
+	return s.String()
+}
+
 // The Output data type.
 type Output struct {
 	// User defined description associated with the output.
@@ -1167,6 +1600,16 @@ type metadataOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Output) GoString() string {
+	return s.String()
+}
+
 // The Parameter data type.
 type Parameter struct {
 	// The key associated with the parameter. If you don't specify a key and value
@@ -1189,6 +1632,16 @@ type metadataParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Parameter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Parameter) GoString() string {
+	return s.String()
+}
+
 // A set of criteria that AWS CloudFormation uses to validate parameter values.
 // Although other constraints might be defined in the stack template, AWS CloudFormation
 // returns only the AllowedValues property.
@@ -1203,6 +1656,16 @@ type metadataParameterConstraints struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ParameterConstraints) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ParameterConstraints) GoString() string {
+	return s.String()
+}
+
 // The ParameterDeclaration data type.
 type ParameterDeclaration struct {
 	// The default value of the parameter.
@@ -1231,6 +1694,16 @@ type metadataParameterDeclaration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ParameterDeclaration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ParameterDeclaration) GoString() string {
+	return s.String()
+}
+
 // The input for the SetStackPolicy action.
 type SetStackPolicyInput struct {
 	// The name or unique stack ID that you want to associate a policy with.
@@ -1240,13 +1713,13 @@ type SetStackPolicyInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	metadataSetStackPolicyInput `json:"-" xml:"-"`
 }
@@ -1255,6 +1728,16 @@ type metadataSetStackPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetStackPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetStackPolicyInput) GoString() string {
+	return s.String()
+}
+
 type SetStackPolicyOutput struct {
 	metadataSetStackPolicyOutput `json:"-" xml:"-"`
 }
@@ -1263,6 +1746,16 @@ type metadataSetStackPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetStackPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetStackPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the SignalResource action.
 type SignalResourceInput struct {
 	// The logical ID of the resource that you want to signal. The logical ID is
@@ -1271,17 +1764,19 @@ type SignalResourceInput struct {
 
 	// The stack name or unique stack ID that includes the resource that you want
 	// to signal.
-	StackName *string `type:"string" required:"true"`
+	StackName *string `type:"string" min:"1" pattern:"([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)" required:"true"`
 
 	// The status of the signal, which is either success or failure. A failure signal
 	// causes AWS CloudFormation to immediately fail the stack creation or update.
-	Status *string `type:"string" required:"true"`
+	//
+	// See the ResourceSignalStatus* constants for valid values.
+	Status *string `type:"string" enum:"SUCCESS,FAILURE" required:"true"`
 
 	// A unique ID of the signal. When you signal Amazon EC2 instances or Auto Scaling
 	// groups, specify the instance ID that you are signaling as the unique ID.
 	// If you send multiple signals to a single resource (such as signaling a wait
 	// condition), each signal requires a different unique ID.
-	UniqueID *string `locationName:"UniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"UniqueId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSignalResourceInput `json:"-" xml:"-"`
 }
@@ -1290,6 +1785,16 @@ type metadataSignalResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SignalResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SignalResourceInput) GoString() string {
+	return s.String()
+}
+
 type SignalResourceOutput struct {
 	metadataSignalResourceOutput `json:"-" xml:"-"`
 }
@@ -1298,6 +1803,16 @@ type metadataSignalResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SignalResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SignalResourceOutput) GoString() string {
+	return s.String()
+}
+
 // The Stack data type.
 type Stack struct {
 	// The capabilities allowed in the stack.
@@ -1319,7 +1834,7 @@ type Stack struct {
 	LastUpdatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// SNS topic ARNs to which stack related events are published.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of output structures.
 	Outputs []*Output `type:"list"`
@@ -1334,7 +1849,9 @@ type Stack struct {
 	StackName *string `type:"string" required:"true"`
 
 	// Current status of the stack.
-	StackStatus *string `type:"string" required:"true"`
+	//
+	// See the StackStatus* constants for valid values.
+	StackStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,ROLLBACK_IN_PROGRESS,ROLLBACK_FAILED,ROLLBACK_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,UPDATE_IN_PROGRESS,UPDATE_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_COMPLETE,UPDATE_ROLLBACK_IN_PROGRESS,UPDATE_ROLLBACK_FAILED,UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_ROLLBACK_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the stack status.
 	StackStatusReason *string `type:"string"`
@@ -1343,7 +1860,7 @@ type Stack struct {
 	Tags []*Tag `type:"list"`
 
 	// The amount of time within which stack creation should complete.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataStack `json:"-" xml:"-"`
 }
@@ -1352,6 +1869,16 @@ type metadataStack struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Stack) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Stack) GoString() string {
+	return s.String()
+}
+
 // The StackEvent data type.
 type StackEvent struct {
 	// The unique ID of this event.
@@ -1368,7 +1895,9 @@ type StackEvent struct {
 	ResourceProperties *string `type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string"`
+	//
+	// See the ResourceStatus* constants for valid values.
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1394,6 +1923,16 @@ type metadataStackEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StackEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StackEvent) GoString() string {
+	return s.String()
+}
+
 // The StackResource data type.
 type StackResource struct {
 	// User defined description associated with the resource.
@@ -1407,7 +1946,9 @@ type StackResource struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	//
+	// See the ResourceStatus* constants for valid values.
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1433,6 +1974,16 @@ type metadataStackResource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StackResource) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StackResource) GoString() string {
+	return s.String()
+}
+
 // Contains detailed information about the specified stack resource.
 type StackResourceDetail struct {
 	// User defined description associated with the resource.
@@ -1454,7 +2005,9 @@ type StackResourceDetail struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	//
+	// See the ResourceStatus* constants for valid values.
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1477,6 +2030,16 @@ type metadataStackResourceDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StackResourceDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StackResourceDetail) GoString() string {
+	return s.String()
+}
+
 // Contains high-level information about the specified stack resource.
 type StackResourceSummary struct {
 	// Time the status was updated.
@@ -1490,7 +2053,9 @@ type StackResourceSummary struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	//
+	// See the ResourceStatus* constants for valid values.
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1507,6 +2072,16 @@ type metadataStackResourceSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StackResourceSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StackResourceSummary) GoString() string {
+	return s.String()
+}
+
 // The StackSummary Data Type
 type StackSummary struct {
 	// The time the stack was created.
@@ -1526,7 +2101,9 @@ type StackSummary struct {
 	StackName *string `type:"string" required:"true"`
 
 	// The current status of the stack.
-	StackStatus *string `type:"string" required:"true"`
+	//
+	// See the StackStatus* constants for valid values.
+	StackStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,ROLLBACK_IN_PROGRESS,ROLLBACK_FAILED,ROLLBACK_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,UPDATE_IN_PROGRESS,UPDATE_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_COMPLETE,UPDATE_ROLLBACK_IN_PROGRESS,UPDATE_ROLLBACK_FAILED,UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_ROLLBACK_COMPLETE" required:"true"`
 
 	// Success/Failure message associated with the stack status.
 	StackStatusReason *string `type:"string"`
@@ -1541,6 +2118,16 @@ type metadataStackSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StackSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StackSummary) GoString() string {
+	return s.String()
+}
+
 // The Tag type is used by CreateStack in the Tags parameter. It allows you
 // to specify a key/value pair that can be used to store information related
 // to cost allocation for an AWS CloudFormation stack.
@@ -1561,6 +2148,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // The TemplateParameter data type.
 type TemplateParameter struct {
 	// The default value associated with the parameter.
@@ -1583,6 +2180,16 @@ type metadataTemplateParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TemplateParameter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TemplateParameter) GoString() string {
+	return s.String()
+}
+
 // The input for UpdateStack action.
 type UpdateStackInput struct {
 	// A list of capabilities that you must specify before AWS CloudFormation can
@@ -1603,7 +2210,7 @@ type UpdateStackInput struct {
 	Capabilities []*string `type:"list"`
 
 	// Update the ARNs for the Amazon SNS topics that are associated with the stack.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of Parameter structures that specify input parameters for the stack.
 	// For more information, see the Parameter (http://docs.aws.amazon.com/AWSCloudFormation/latest/APIReference/API_Parameter.html)
@@ -1619,7 +2226,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Structure containing the temporary overriding stack policy body. You can
 	// specify either the StackPolicyDuringUpdateBody or the StackPolicyDuringUpdateURL
@@ -1628,7 +2235,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateBody *string `type:"string"`
+	StackPolicyDuringUpdateBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the temporary overriding stack policy. The
 	// URL must point to a policy (max size: 16KB) located in an S3 bucket in the
@@ -1638,7 +2245,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateURL *string `type:"string"`
+	StackPolicyDuringUpdateURL *string `type:"string" min:"1" max:"1350"`
 
 	// Location of a file containing the updated stack policy. The URL must point
 	// to a policy (max size: 16KB) located in an S3 bucket in the same region as
@@ -1648,7 +2255,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. (For more information, go to Template Anatomy
@@ -1657,7 +2264,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -1666,7 +2273,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// Reuse the existing template that is associated with the stack that you are
 	// updating.
@@ -1679,6 +2286,16 @@ type metadataUpdateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateStackInput) GoString() string {
+	return s.String()
+}
+
 // The output for a UpdateStack action.
 type UpdateStackOutput struct {
 	// Unique identifier of the stack.
@@ -1691,6 +2308,16 @@ type metadataUpdateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateStackOutput) GoString() string {
+	return s.String()
+}
+
 // The input for ValidateTemplate action.
 type ValidateTemplateInput struct {
 	// Structure containing the template body with a minimum length of 1 byte and
@@ -1700,7 +2327,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 460,800 bytes) located in an S3 bucket in the same region as the
@@ -1709,7 +2336,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataValidateTemplateInput `json:"-" xml:"-"`
 }
@@ -1718,6 +2345,16 @@ type metadataValidateTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ValidateTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidateTemplateInput) GoString() string {
+	return s.String()
+}
+
 // The output for ValidateTemplate action.
 type ValidateTemplateOutput struct {
 	// The capabilities found within the template. Currently, AWS CloudFormation
@@ -1743,3 +2380,92 @@ type ValidateTemplateOutput struct {
 type metadataValidateTemplateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s ValidateTemplateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidateTemplateOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum Capability
+	CapabilityCapabilityIam = "CAPABILITY_IAM"
+)
+
+const (
+	// @enum OnFailure
+	OnFailureDoNothing = "DO_NOTHING"
+	// @enum OnFailure
+	OnFailureRollback = "ROLLBACK"
+	// @enum OnFailure
+	OnFailureDelete = "DELETE"
+)
+
+const (
+	// @enum ResourceSignalStatus
+	ResourceSignalStatusSuccess = "SUCCESS"
+	// @enum ResourceSignalStatus
+	ResourceSignalStatusFailure = "FAILURE"
+)
+
+const (
+	// @enum ResourceStatus
+	ResourceStatusCreateInProgress = "CREATE_IN_PROGRESS"
+	// @enum ResourceStatus
+	ResourceStatusCreateFailed = "CREATE_FAILED"
+	// @enum ResourceStatus
+	ResourceStatusCreateComplete = "CREATE_COMPLETE"
+	// @enum ResourceStatus
+	ResourceStatusDeleteInProgress = "DELETE_IN_PROGRESS"
+	// @enum ResourceStatus
+	ResourceStatusDeleteFailed = "DELETE_FAILED"
+	// @enum ResourceStatus
+	ResourceStatusDeleteComplete = "DELETE_COMPLETE"
+	// @enum ResourceStatus
+	ResourceStatusDeleteSkipped = "DELETE_SKIPPED"
+	// @enum ResourceStatus
+	ResourceStatusUpdateInProgress = "UPDATE_IN_PROGRESS"
+	// @enum ResourceStatus
+	ResourceStatusUpdateFailed = "UPDATE_FAILED"
+	// @enum ResourceStatus
+	ResourceStatusUpdateComplete = "UPDATE_COMPLETE"
+)
+
+const (
+	// @enum StackStatus
+	StackStatusCreateInProgress = "CREATE_IN_PROGRESS"
+	// @enum StackStatus
+	StackStatusCreateFailed = "CREATE_FAILED"
+	// @enum StackStatus
+	StackStatusCreateComplete = "CREATE_COMPLETE"
+	// @enum StackStatus
+	StackStatusRollbackInProgress = "ROLLBACK_IN_PROGRESS"
+	// @enum StackStatus
+	StackStatusRollbackFailed = "ROLLBACK_FAILED"
+	// @enum StackStatus
+	StackStatusRollbackComplete = "ROLLBACK_COMPLETE"
+	// @enum StackStatus
+	StackStatusDeleteInProgress = "DELETE_IN_PROGRESS"
+	// @enum StackStatus
+	StackStatusDeleteFailed = "DELETE_FAILED"
+	// @enum StackStatus
+	StackStatusDeleteComplete = "DELETE_COMPLETE"
+	// @enum StackStatus
+	StackStatusUpdateInProgress = "UPDATE_IN_PROGRESS"
+	// @enum StackStatus
+	StackStatusUpdateCompleteCleanupInProgress = "UPDATE_COMPLETE_CLEANUP_IN_PROGRESS"
+	// @enum StackStatus
+	StackStatusUpdateComplete = "UPDATE_COMPLETE"
+	// @enum StackStatus
+	StackStatusUpdateRollbackInProgress = "UPDATE_ROLLBACK_IN_PROGRESS"
+	// @enum StackStatus
+	StackStatusUpdateRollbackFailed = "UPDATE_ROLLBACK_FAILED"
+	// @enum StackStatus
+	StackStatusUpdateRollbackCompleteCleanupInProgress = "UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS"
+	// @enum StackStatus
+	StackStatusUpdateRollbackComplete = "UPDATE_ROLLBACK_COMPLETE"
+)
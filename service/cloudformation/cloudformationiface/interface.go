@@ -4,6 +4,7 @@
 package cloudformationiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
@@ -11,35 +12,75 @@ import (
 type CloudFormationAPI interface {
 	CancelUpdateStack(*cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error)
 
+	CancelUpdateStackWithContext(aws.Context, *cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error)
+
 	CreateStack(*cloudformation.CreateStackInput) (*cloudformation.CreateStackOutput, error)
 
+	CreateStackWithContext(aws.Context, *cloudformation.CreateStackInput) (*cloudformation.CreateStackOutput, error)
+
 	DeleteStack(*cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error)
 
+	DeleteStackWithContext(aws.Context, *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error)
+
 	DescribeStackEvents(*cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
 
+	DescribeStackEventsWithContext(aws.Context, *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
+
 	DescribeStackResource(*cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error)
 
+	DescribeStackResourceWithContext(aws.Context, *cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error)
+
 	DescribeStackResources(*cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error)
 
+	DescribeStackResourcesWithContext(aws.Context, *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error)
+
 	DescribeStacks(*cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
 
+	DescribeStacksWithContext(aws.Context, *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
+
 	EstimateTemplateCost(*cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error)
 
+	EstimateTemplateCostWithContext(aws.Context, *cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error)
+
 	GetStackPolicy(*cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error)
 
+	GetStackPolicyWithContext(aws.Context, *cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error)
+
 	GetTemplate(*cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error)
 
+	GetTemplateWithContext(aws.Context, *cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error)
+
 	GetTemplateSummary(*cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error)
 
+	GetTemplateSummaryWithContext(aws.Context, *cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error)
+
 	ListStackResources(*cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error)
 
+	ListStackResourcesWithContext(aws.Context, *cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error)
+
 	ListStacks(*cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error)
 
+	ListStacksWithContext(aws.Context, *cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error)
+
 	SetStackPolicy(*cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error)
 
+	SetStackPolicyWithContext(aws.Context, *cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error)
+
 	SignalResource(*cloudformation.SignalResourceInput) (*cloudformation.SignalResourceOutput, error)
 
+	SignalResourceWithContext(aws.Context, *cloudformation.SignalResourceInput) (*cloudformation.SignalResourceOutput, error)
+
 	UpdateStack(*cloudformation.UpdateStackInput) (*cloudformation.UpdateStackOutput, error)
 
+	UpdateStackWithContext(aws.Context, *cloudformation.UpdateStackInput) (*cloudformation.UpdateStackOutput, error)
+
 	ValidateTemplate(*cloudformation.ValidateTemplateInput) (*cloudformation.ValidateTemplateOutput, error)
+
+	ValidateTemplateWithContext(aws.Context, *cloudformation.ValidateTemplateInput) (*cloudformation.ValidateTemplateOutput, error)
+
+	WaitUntilStackCreateComplete(*cloudformation.DescribeStacksInput) error
+
+	WaitUntilStackDeleteComplete(*cloudformation.DescribeStacksInput) error
+
+	WaitUntilStackUpdateComplete(*cloudformation.DescribeStacksInput) error
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateCloudFrontOriginAccessIdentity = "CreateCloudFrontOriginAccessIdentity2015_04_17"
@@ -36,6 +37,16 @@ func (c *CloudFront) CreateCloudFrontOriginAccessIdentity(input *CreateCloudFron
 	return out, err
 }
 
+// CreateCloudFrontOriginAccessIdentityWithContext is the same as CreateCloudFrontOriginAccessIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) CreateCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *CreateCloudFrontOriginAccessIdentityInput) (*CreateCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.CreateCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDistribution = "CreateDistribution2015_04_17"
 
 // CreateDistributionRequest generates a request for the CreateDistribution operation.
@@ -63,6 +74,16 @@ func (c *CloudFront) CreateDistribution(input *CreateDistributionInput) (*Create
 	return out, err
 }
 
+// CreateDistributionWithContext is the same as CreateDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) CreateDistributionWithContext(ctx aws.Context, input *CreateDistributionInput) (*CreateDistributionOutput, error) {
+	req, out := c.CreateDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInvalidation = "CreateInvalidation2015_04_17"
 
 // CreateInvalidationRequest generates a request for the CreateInvalidation operation.
@@ -90,6 +111,16 @@ func (c *CloudFront) CreateInvalidation(input *CreateInvalidationInput) (*Create
 	return out, err
 }
 
+// CreateInvalidationWithContext is the same as CreateInvalidation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) CreateInvalidationWithContext(ctx aws.Context, input *CreateInvalidationInput) (*CreateInvalidationOutput, error) {
+	req, out := c.CreateInvalidationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStreamingDistribution = "CreateStreamingDistribution2015_04_17"
 
 // CreateStreamingDistributionRequest generates a request for the CreateStreamingDistribution operation.
@@ -117,6 +148,16 @@ func (c *CloudFront) CreateStreamingDistribution(input *CreateStreamingDistribut
 	return out, err
 }
 
+// CreateStreamingDistributionWithContext is the same as CreateStreamingDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) CreateStreamingDistributionWithContext(ctx aws.Context, input *CreateStreamingDistributionInput) (*CreateStreamingDistributionOutput, error) {
+	req, out := c.CreateStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCloudFrontOriginAccessIdentity = "DeleteCloudFrontOriginAccessIdentity2015_04_17"
 
 // DeleteCloudFrontOriginAccessIdentityRequest generates a request for the DeleteCloudFrontOriginAccessIdentity operation.
@@ -144,6 +185,16 @@ func (c *CloudFront) DeleteCloudFrontOriginAccessIdentity(input *DeleteCloudFron
 	return out, err
 }
 
+// DeleteCloudFrontOriginAccessIdentityWithContext is the same as DeleteCloudFrontOriginAccessIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) DeleteCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *DeleteCloudFrontOriginAccessIdentityInput) (*DeleteCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.DeleteCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDistribution = "DeleteDistribution2015_04_17"
 
 // DeleteDistributionRequest generates a request for the DeleteDistribution operation.
@@ -171,6 +222,16 @@ func (c *CloudFront) DeleteDistribution(input *DeleteDistributionInput) (*Delete
 	return out, err
 }
 
+// DeleteDistributionWithContext is the same as DeleteDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) DeleteDistributionWithContext(ctx aws.Context, input *DeleteDistributionInput) (*DeleteDistributionOutput, error) {
+	req, out := c.DeleteDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteStreamingDistribution = "DeleteStreamingDistribution2015_04_17"
 
 // DeleteStreamingDistributionRequest generates a request for the DeleteStreamingDistribution operation.
@@ -198,6 +259,16 @@ func (c *CloudFront) DeleteStreamingDistribution(input *DeleteStreamingDistribut
 	return out, err
 }
 
+// DeleteStreamingDistributionWithContext is the same as DeleteStreamingDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) DeleteStreamingDistributionWithContext(ctx aws.Context, input *DeleteStreamingDistributionInput) (*DeleteStreamingDistributionOutput, error) {
+	req, out := c.DeleteStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCloudFrontOriginAccessIdentity = "GetCloudFrontOriginAccessIdentity2015_04_17"
 
 // GetCloudFrontOriginAccessIdentityRequest generates a request for the GetCloudFrontOriginAccessIdentity operation.
@@ -225,6 +296,16 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentity(input *GetCloudFrontOrigi
 	return out, err
 }
 
+// GetCloudFrontOriginAccessIdentityWithContext is the same as GetCloudFrontOriginAccessIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *GetCloudFrontOriginAccessIdentityInput) (*GetCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.GetCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCloudFrontOriginAccessIdentityConfig = "GetCloudFrontOriginAccessIdentityConfig2015_04_17"
 
 // GetCloudFrontOriginAccessIdentityConfigRequest generates a request for the GetCloudFrontOriginAccessIdentityConfig operation.
@@ -252,6 +333,16 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfig(input *GetCloudFron
 	return out, err
 }
 
+// GetCloudFrontOriginAccessIdentityConfigWithContext is the same as GetCloudFrontOriginAccessIdentityConfig with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfigWithContext(ctx aws.Context, input *GetCloudFrontOriginAccessIdentityConfigInput) (*GetCloudFrontOriginAccessIdentityConfigOutput, error) {
+	req, out := c.GetCloudFrontOriginAccessIdentityConfigRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDistribution = "GetDistribution2015_04_17"
 
 // GetDistributionRequest generates a request for the GetDistribution operation.
@@ -279,6 +370,16 @@ func (c *CloudFront) GetDistribution(input *GetDistributionInput) (*GetDistribut
 	return out, err
 }
 
+// GetDistributionWithContext is the same as GetDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) GetDistributionWithContext(ctx aws.Context, input *GetDistributionInput) (*GetDistributionOutput, error) {
+	req, out := c.GetDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDistributionConfig = "GetDistributionConfig2015_04_17"
 
 // GetDistributionConfigRequest generates a request for the GetDistributionConfig operation.
@@ -306,6 +407,16 @@ func (c *CloudFront) GetDistributionConfig(input *GetDistributionConfigInput) (*
 	return out, err
 }
 
+// GetDistributionConfigWithContext is the same as GetDistributionConfig with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) GetDistributionConfigWithContext(ctx aws.Context, input *GetDistributionConfigInput) (*GetDistributionConfigOutput, error) {
+	req, out := c.GetDistributionConfigRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetInvalidation = "GetInvalidation2015_04_17"
 
 // GetInvalidationRequest generates a request for the GetInvalidation operation.
@@ -333,6 +444,16 @@ func (c *CloudFront) GetInvalidation(input *GetInvalidationInput) (*GetInvalidat
 	return out, err
 }
 
+// GetInvalidationWithContext is the same as GetInvalidation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) GetInvalidationWithContext(ctx aws.Context, input *GetInvalidationInput) (*GetInvalidationOutput, error) {
+	req, out := c.GetInvalidationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetStreamingDistribution = "GetStreamingDistribution2015_04_17"
 
 // GetStreamingDistributionRequest generates a request for the GetStreamingDistribution operation.
@@ -360,6 +481,16 @@ func (c *CloudFront) GetStreamingDistribution(input *GetStreamingDistributionInp
 	return out, err
 }
 
+// GetStreamingDistributionWithContext is the same as GetStreamingDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) GetStreamingDistributionWithContext(ctx aws.Context, input *GetStreamingDistributionInput) (*GetStreamingDistributionOutput, error) {
+	req, out := c.GetStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetStreamingDistributionConfig = "GetStreamingDistributionConfig2015_04_17"
 
 // GetStreamingDistributionConfigRequest generates a request for the GetStreamingDistributionConfig operation.
@@ -387,6 +518,16 @@ func (c *CloudFront) GetStreamingDistributionConfig(input *GetStreamingDistribut
 	return out, err
 }
 
+// GetStreamingDistributionConfigWithContext is the same as GetStreamingDistributionConfig with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) GetStreamingDistributionConfigWithContext(ctx aws.Context, input *GetStreamingDistributionConfigInput) (*GetStreamingDistributionConfigOutput, error) {
+	req, out := c.GetStreamingDistributionConfigRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListCloudFrontOriginAccessIdentities = "ListCloudFrontOriginAccessIdentities2015_04_17"
 
 // ListCloudFrontOriginAccessIdentitiesRequest generates a request for the ListCloudFrontOriginAccessIdentities operation.
@@ -420,6 +561,16 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentities(input *ListCloudFrontO
 	return out, err
 }
 
+// ListCloudFrontOriginAccessIdentitiesWithContext is the same as ListCloudFrontOriginAccessIdentities with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesWithContext(ctx aws.Context, input *ListCloudFrontOriginAccessIdentitiesInput) (*ListCloudFrontOriginAccessIdentitiesOutput, error) {
+	req, out := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesPages(input *ListCloudFrontOriginAccessIdentitiesInput, fn func(p *ListCloudFrontOriginAccessIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -460,6 +611,16 @@ func (c *CloudFront) ListDistributions(input *ListDistributionsInput) (*ListDist
 	return out, err
 }
 
+// ListDistributionsWithContext is the same as ListDistributions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) ListDistributionsWithContext(ctx aws.Context, input *ListDistributionsInput) (*ListDistributionsOutput, error) {
+	req, out := c.ListDistributionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFront) ListDistributionsPages(input *ListDistributionsInput, fn func(p *ListDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDistributionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -500,6 +661,16 @@ func (c *CloudFront) ListInvalidations(input *ListInvalidationsInput) (*ListInva
 	return out, err
 }
 
+// ListInvalidationsWithContext is the same as ListInvalidations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) ListInvalidationsWithContext(ctx aws.Context, input *ListInvalidationsInput) (*ListInvalidationsOutput, error) {
+	req, out := c.ListInvalidationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFront) ListInvalidationsPages(input *ListInvalidationsInput, fn func(p *ListInvalidationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInvalidationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -540,6 +711,16 @@ func (c *CloudFront) ListStreamingDistributions(input *ListStreamingDistribution
 	return out, err
 }
 
+// ListStreamingDistributionsWithContext is the same as ListStreamingDistributions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) ListStreamingDistributionsWithContext(ctx aws.Context, input *ListStreamingDistributionsInput) (*ListStreamingDistributionsOutput, error) {
+	req, out := c.ListStreamingDistributionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudFront) ListStreamingDistributionsPages(input *ListStreamingDistributionsInput, fn func(p *ListStreamingDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStreamingDistributionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -574,6 +755,16 @@ func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(input *UpdateCloudFron
 	return out, err
 }
 
+// UpdateCloudFrontOriginAccessIdentityWithContext is the same as UpdateCloudFrontOriginAccessIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) UpdateCloudFrontOriginAccessIdentityWithContext(ctx aws.Context, input *UpdateCloudFrontOriginAccessIdentityInput) (*UpdateCloudFrontOriginAccessIdentityOutput, error) {
+	req, out := c.UpdateCloudFrontOriginAccessIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDistribution = "UpdateDistribution2015_04_17"
 
 // UpdateDistributionRequest generates a request for the UpdateDistribution operation.
@@ -601,6 +792,16 @@ func (c *CloudFront) UpdateDistribution(input *UpdateDistributionInput) (*Update
 	return out, err
 }
 
+// UpdateDistributionWithContext is the same as UpdateDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) UpdateDistributionWithContext(ctx aws.Context, input *UpdateDistributionInput) (*UpdateDistributionOutput, error) {
+	req, out := c.UpdateDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateStreamingDistribution = "UpdateStreamingDistribution2015_04_17"
 
 // UpdateStreamingDistributionRequest generates a request for the UpdateStreamingDistribution operation.
@@ -628,6 +829,16 @@ func (c *CloudFront) UpdateStreamingDistribution(input *UpdateStreamingDistribut
 	return out, err
 }
 
+// UpdateStreamingDistributionWithContext is the same as UpdateStreamingDistribution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudFront) UpdateStreamingDistributionWithContext(ctx aws.Context, input *UpdateStreamingDistributionInput) (*UpdateStreamingDistributionOutput, error) {
+	req, out := c.UpdateStreamingDistributionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // A complex type that lists the AWS accounts, if any, that you included in
 // the TrustedSigners complex type for the default cache behavior or for any
 // of the other cache behaviors for this distribution. These are accounts that
@@ -653,6 +864,16 @@ type metadataActiveTrustedSigners struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActiveTrustedSigners) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActiveTrustedSigners) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about CNAMEs (alternate domain names),
 // if any, for this distribution.
 type Aliases struct {
@@ -670,6 +891,16 @@ type metadataAliases struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Aliases) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Aliases) GoString() string {
+	return s.String()
+}
+
 // A complex type that controls which HTTP methods CloudFront processes and
 // forwards to your Amazon S3 bucket or your custom origin. There are three
 // choices: - CloudFront forwards only GET and HEAD requests. - CloudFront forwards
@@ -703,6 +934,16 @@ type metadataAllowedMethods struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AllowedMethods) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AllowedMethods) GoString() string {
+	return s.String()
+}
+
 // A complex type that describes how CloudFront processes requests. You can
 // create up to 10 cache behaviors.You must create at least as many cache behaviors
 // (including the default cache behavior) as you have origins if you want CloudFront
@@ -795,7 +1036,9 @@ type CacheBehavior struct {
 	// request with an HTTP status code of 301 (Moved Permanently) and the HTTPS
 	// URL, specify redirect-to-https. The viewer then resubmits the request using
 	// the HTTPS URL.
-	ViewerProtocolPolicy *string `type:"string" required:"true"`
+	//
+	// See the ViewerProtocolPolicy* constants for valid values.
+	ViewerProtocolPolicy *string `type:"string" enum:"allow-all,https-only,redirect-to-https" required:"true"`
 
 	metadataCacheBehavior `json:"-" xml:"-"`
 }
@@ -804,6 +1047,16 @@ type metadataCacheBehavior struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheBehavior) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheBehavior) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains zero or more CacheBehavior elements.
 type CacheBehaviors struct {
 	// Optional: A complex type that contains cache behaviors for this distribution.
@@ -820,6 +1073,16 @@ type metadataCacheBehaviors struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheBehaviors) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheBehaviors) GoString() string {
+	return s.String()
+}
+
 // A complex type that controls whether CloudFront caches the response to requests
 // using the specified HTTP methods. There are two choices: - CloudFront caches
 // responses to GET and HEAD requests. - CloudFront caches responses to GET,
@@ -843,6 +1106,16 @@ type metadataCachedMethods struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CachedMethods) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CachedMethods) GoString() string {
+	return s.String()
+}
+
 // A complex type that specifies the whitelisted cookies, if any, that you want
 // CloudFront to forward to your origin that is associated with this cache behavior.
 type CookieNames struct {
@@ -860,6 +1133,16 @@ type metadataCookieNames struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CookieNames) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CookieNames) GoString() string {
+	return s.String()
+}
+
 // A complex type that specifies the cookie preferences associated with this
 // cache behavior.
 type CookiePreference struct {
@@ -867,7 +1150,9 @@ type CookiePreference struct {
 	// to the origin that is associated with this cache behavior. You can specify
 	// all, none or whitelist. If you choose All, CloudFront forwards all cookies
 	// regardless of how many your application uses.
-	Forward *string `type:"string" required:"true"`
+	//
+	// See the ItemSelection* constants for valid values.
+	Forward *string `type:"string" enum:"none,whitelist,all" required:"true"`
 
 	// A complex type that specifies the whitelisted cookies, if any, that you want
 	// CloudFront to forward to your origin that is associated with this cache behavior.
@@ -880,6 +1165,16 @@ type metadataCookiePreference struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CookiePreference) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CookiePreference) GoString() string {
+	return s.String()
+}
+
 // The request to create a new origin access identity.
 type CreateCloudFrontOriginAccessIdentityInput struct {
 	// The origin access identity's configuration information.
@@ -892,6 +1187,16 @@ type metadataCreateCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityConfig"`
 }
 
+// String returns the string representation
+func (s CreateCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCloudFrontOriginAccessIdentityInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type CreateCloudFrontOriginAccessIdentityOutput struct {
 	// The origin access identity's information.
@@ -911,6 +1216,16 @@ type metadataCreateCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentity"`
 }
 
+// String returns the string representation
+func (s CreateCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCloudFrontOriginAccessIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // The request to create a new distribution.
 type CreateDistributionInput struct {
 	// The distribution's configuration information.
@@ -923,6 +1238,16 @@ type metadataCreateDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionConfig"`
 }
 
+// String returns the string representation
+func (s CreateDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDistributionInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type CreateDistributionOutput struct {
 	// The distribution's information.
@@ -942,6 +1267,16 @@ type metadataCreateDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Distribution"`
 }
 
+// String returns the string representation
+func (s CreateDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // The request to create an invalidation.
 type CreateInvalidationInput struct {
 	// The distribution's id.
@@ -957,6 +1292,16 @@ type metadataCreateInvalidationInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"InvalidationBatch"`
 }
 
+// String returns the string representation
+func (s CreateInvalidationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInvalidationInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type CreateInvalidationOutput struct {
 	// The invalidation's information.
@@ -973,6 +1318,16 @@ type metadataCreateInvalidationOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Invalidation"`
 }
 
+// String returns the string representation
+func (s CreateInvalidationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInvalidationOutput) GoString() string {
+	return s.String()
+}
+
 // The request to create a new streaming distribution.
 type CreateStreamingDistributionInput struct {
 	// The streaming distribution's configuration information.
@@ -985,6 +1340,16 @@ type metadataCreateStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionConfig"`
 }
 
+// String returns the string representation
+func (s CreateStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStreamingDistributionInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type CreateStreamingDistributionOutput struct {
 	// The current version of the streaming distribution created.
@@ -1004,6 +1369,16 @@ type metadataCreateStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistribution"`
 }
 
+// String returns the string representation
+func (s CreateStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStreamingDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that describes how you'd prefer CloudFront to respond to requests
 // that result in either a 4xx or 5xx response. You can control whether a custom
 // error page should be displayed, what the desired response code should be
@@ -1045,6 +1420,16 @@ type metadataCustomErrorResponse struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CustomErrorResponse) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CustomErrorResponse) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains zero or more CustomErrorResponse elements.
 type CustomErrorResponses struct {
 	// Optional: A complex type that contains custom error responses for this distribution.
@@ -1061,6 +1446,16 @@ type metadataCustomErrorResponses struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CustomErrorResponses) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CustomErrorResponses) GoString() string {
+	return s.String()
+}
+
 // A customer origin.
 type CustomOriginConfig struct {
 	// The HTTP port the custom origin listens on.
@@ -1070,7 +1465,9 @@ type CustomOriginConfig struct {
 	HTTPSPort *int64 `type:"integer" required:"true"`
 
 	// The origin protocol policy to apply to your origin.
-	OriginProtocolPolicy *string `type:"string" required:"true"`
+	//
+	// See the OriginProtocolPolicy* constants for valid values.
+	OriginProtocolPolicy *string `type:"string" enum:"http-only,match-viewer" required:"true"`
 
 	metadataCustomOriginConfig `json:"-" xml:"-"`
 }
@@ -1079,6 +1476,16 @@ type metadataCustomOriginConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CustomOriginConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CustomOriginConfig) GoString() string {
+	return s.String()
+}
+
 // A complex type that describes the default cache behavior if you do not specify
 // a CacheBehavior element or if files don't match any of the values of PathPattern
 // in CacheBehavior elements.You must create exactly one default cache behavior.
@@ -1151,7 +1558,9 @@ type DefaultCacheBehavior struct {
 	// request with an HTTP status code of 301 (Moved Permanently) and the HTTPS
 	// URL, specify redirect-to-https. The viewer then resubmits the request using
 	// the HTTPS URL.
-	ViewerProtocolPolicy *string `type:"string" required:"true"`
+	//
+	// See the ViewerProtocolPolicy* constants for valid values.
+	ViewerProtocolPolicy *string `type:"string" enum:"allow-all,https-only,redirect-to-https" required:"true"`
 
 	metadataDefaultCacheBehavior `json:"-" xml:"-"`
 }
@@ -1160,6 +1569,16 @@ type metadataDefaultCacheBehavior struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefaultCacheBehavior) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefaultCacheBehavior) GoString() string {
+	return s.String()
+}
+
 // The request to delete a origin access identity.
 type DeleteCloudFrontOriginAccessIdentityInput struct {
 	// The origin access identity's id.
@@ -1176,6 +1595,16 @@ type metadataDeleteCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCloudFrontOriginAccessIdentityInput) GoString() string {
+	return s.String()
+}
+
 type DeleteCloudFrontOriginAccessIdentityOutput struct {
 	metadataDeleteCloudFrontOriginAccessIdentityOutput `json:"-" xml:"-"`
 }
@@ -1184,6 +1613,16 @@ type metadataDeleteCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCloudFrontOriginAccessIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // The request to delete a distribution.
 type DeleteDistributionInput struct {
 	// The distribution id.
@@ -1200,6 +1639,16 @@ type metadataDeleteDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDistributionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteDistributionOutput struct {
 	metadataDeleteDistributionOutput `json:"-" xml:"-"`
 }
@@ -1208,6 +1657,16 @@ type metadataDeleteDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // The request to delete a streaming distribution.
 type DeleteStreamingDistributionInput struct {
 	// The distribution id.
@@ -1224,6 +1683,16 @@ type metadataDeleteStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStreamingDistributionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteStreamingDistributionOutput struct {
 	metadataDeleteStreamingDistributionOutput `json:"-" xml:"-"`
 }
@@ -1232,6 +1701,16 @@ type metadataDeleteStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStreamingDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // A distribution.
 type Distribution struct {
 	// CloudFront automatically adds this element to the response only if you've
@@ -1271,6 +1750,16 @@ type metadataDistribution struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Distribution) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Distribution) GoString() string {
+	return s.String()
+}
+
 // A distribution Configuration.
 type DistributionConfig struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -1323,7 +1812,9 @@ type DistributionConfig struct {
 	Origins *Origins `type:"structure" required:"true"`
 
 	// A complex type that contains information about price class for this distribution.
-	PriceClass *string `type:"string"`
+	//
+	// See the PriceClass* constants for valid values.
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All"`
 
 	// A complex type that identifies ways in which you want to restrict distribution
 	// of your content.
@@ -1340,6 +1831,16 @@ type metadataDistributionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DistributionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DistributionConfig) GoString() string {
+	return s.String()
+}
+
 // A distribution list.
 type DistributionList struct {
 	// A flag that indicates whether more distributions remain to be listed. If
@@ -1373,6 +1874,16 @@ type metadataDistributionList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DistributionList) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DistributionList) GoString() string {
+	return s.String()
+}
+
 // A summary of the information for an Amazon CloudFront distribution.
 type DistributionSummary struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -1408,7 +1919,8 @@ type DistributionSummary struct {
 	// A complex type that contains information about origins for this distribution.
 	Origins *Origins `type:"structure" required:"true"`
 
-	PriceClass *string `type:"string" required:"true"`
+	// See the PriceClass* constants for valid values.
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All" required:"true"`
 
 	// A complex type that identifies ways in which you want to restrict distribution
 	// of your content.
@@ -1430,6 +1942,16 @@ type metadataDistributionSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DistributionSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DistributionSummary) GoString() string {
+	return s.String()
+}
+
 // A complex type that specifies how CloudFront handles query strings, cookies
 // and headers.
 type ForwardedValues struct {
@@ -1452,6 +1974,16 @@ type metadataForwardedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ForwardedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ForwardedValues) GoString() string {
+	return s.String()
+}
+
 // A complex type that controls the countries in which your content is distributed.
 // For more information about geo restriction, go to Customizing Error Responses
 // in the Amazon CloudFront Developer Guide. CloudFront determines the location
@@ -1481,7 +2013,9 @@ type GeoRestriction struct {
 	// specify the countries in which you do not want CloudFront to distribute your
 	// content. - whitelist: The Location elements specify the countries in which
 	// you want CloudFront to distribute your content.
-	RestrictionType *string `type:"string" required:"true"`
+	//
+	// See the GeoRestrictionType* constants for valid values.
+	RestrictionType *string `type:"string" enum:"blacklist,whitelist,none" required:"true"`
 
 	metadataGeoRestriction `json:"-" xml:"-"`
 }
@@ -1490,6 +2024,16 @@ type metadataGeoRestriction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GeoRestriction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GeoRestriction) GoString() string {
+	return s.String()
+}
+
 // The request to get an origin access identity's configuration.
 type GetCloudFrontOriginAccessIdentityConfigInput struct {
 	// The identity's id.
@@ -1502,6 +2046,16 @@ type metadataGetCloudFrontOriginAccessIdentityConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCloudFrontOriginAccessIdentityConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCloudFrontOriginAccessIdentityConfigInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type GetCloudFrontOriginAccessIdentityConfigOutput struct {
 	// The origin access identity's configuration information.
@@ -1517,6 +2071,16 @@ type metadataGetCloudFrontOriginAccessIdentityConfigOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityConfig"`
 }
 
+// String returns the string representation
+func (s GetCloudFrontOriginAccessIdentityConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCloudFrontOriginAccessIdentityConfigOutput) GoString() string {
+	return s.String()
+}
+
 // The request to get an origin access identity's information.
 type GetCloudFrontOriginAccessIdentityInput struct {
 	// The identity's id.
@@ -1529,6 +2093,16 @@ type metadataGetCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCloudFrontOriginAccessIdentityInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type GetCloudFrontOriginAccessIdentityOutput struct {
 	// The origin access identity's information.
@@ -1545,6 +2119,16 @@ type metadataGetCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentity"`
 }
 
+// String returns the string representation
+func (s GetCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCloudFrontOriginAccessIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // The request to get a distribution configuration.
 type GetDistributionConfigInput struct {
 	// The distribution's id.
@@ -1557,6 +2141,16 @@ type metadataGetDistributionConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDistributionConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDistributionConfigInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type GetDistributionConfigOutput struct {
 	// The distribution's configuration information.
@@ -1572,6 +2166,16 @@ type metadataGetDistributionConfigOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionConfig"`
 }
 
+// String returns the string representation
+func (s GetDistributionConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDistributionConfigOutput) GoString() string {
+	return s.String()
+}
+
 // The request to get a distribution's information.
 type GetDistributionInput struct {
 	// The distribution's id.
@@ -1584,6 +2188,16 @@ type metadataGetDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDistributionInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type GetDistributionOutput struct {
 	// The distribution's information.
@@ -1599,6 +2213,16 @@ type metadataGetDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Distribution"`
 }
 
+// String returns the string representation
+func (s GetDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // The request to get an invalidation's information.
 type GetInvalidationInput struct {
 	// The distribution's id.
@@ -1614,6 +2238,16 @@ type metadataGetInvalidationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetInvalidationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetInvalidationInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type GetInvalidationOutput struct {
 	// The invalidation's information.
@@ -1626,6 +2260,16 @@ type metadataGetInvalidationOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Invalidation"`
 }
 
+// String returns the string representation
+func (s GetInvalidationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetInvalidationOutput) GoString() string {
+	return s.String()
+}
+
 // To request to get a streaming distribution configuration.
 type GetStreamingDistributionConfigInput struct {
 	// The streaming distribution's id.
@@ -1638,6 +2282,16 @@ type metadataGetStreamingDistributionConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetStreamingDistributionConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStreamingDistributionConfigInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type GetStreamingDistributionConfigOutput struct {
 	// The current version of the configuration. For example: E2QWRUHAPOMQZL.
@@ -1653,6 +2307,16 @@ type metadataGetStreamingDistributionConfigOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionConfig"`
 }
 
+// String returns the string representation
+func (s GetStreamingDistributionConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStreamingDistributionConfigOutput) GoString() string {
+	return s.String()
+}
+
 // The request to get a streaming distribution's information.
 type GetStreamingDistributionInput struct {
 	// The streaming distribution's id.
@@ -1665,6 +2329,16 @@ type metadataGetStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStreamingDistributionInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type GetStreamingDistributionOutput struct {
 	// The current version of the streaming distribution's information. For example:
@@ -1681,6 +2355,16 @@ type metadataGetStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistribution"`
 }
 
+// String returns the string representation
+func (s GetStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStreamingDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that specifies the headers that you want CloudFront to forward
 // to the origin for this cache behavior. For the headers that you specify,
 // CloudFront also caches separate versions of a given object based on the header
@@ -1711,6 +2395,16 @@ type metadataHeaders struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Headers) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Headers) GoString() string {
+	return s.String()
+}
+
 // An invalidation.
 type Invalidation struct {
 	// The date and time the invalidation request was first made.
@@ -1733,6 +2427,16 @@ type metadataInvalidation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Invalidation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Invalidation) GoString() string {
+	return s.String()
+}
+
 // An invalidation batch.
 type InvalidationBatch struct {
 	// A unique name that ensures the request can't be replayed. If the CallerReference
@@ -1761,6 +2465,16 @@ type metadataInvalidationBatch struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InvalidationBatch) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InvalidationBatch) GoString() string {
+	return s.String()
+}
+
 // An invalidation list.
 type InvalidationList struct {
 	// A flag that indicates whether more invalidation batch requests remain to
@@ -1794,6 +2508,16 @@ type metadataInvalidationList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InvalidationList) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InvalidationList) GoString() string {
+	return s.String()
+}
+
 // Summary of an invalidation request.
 type InvalidationSummary struct {
 	CreateTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -1811,6 +2535,16 @@ type metadataInvalidationSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InvalidationSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InvalidationSummary) GoString() string {
+	return s.String()
+}
+
 // A complex type that lists the active CloudFront key pairs, if any, that are
 // associated with AwsAccountNumber.
 type KeyPairIDs struct {
@@ -1828,6 +2562,16 @@ type metadataKeyPairIDs struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeyPairIDs) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeyPairIDs) GoString() string {
+	return s.String()
+}
+
 // The request to list origin access identities.
 type ListCloudFrontOriginAccessIdentitiesInput struct {
 	// Use this when paginating results to indicate where to begin in your list
@@ -1847,6 +2591,16 @@ type metadataListCloudFrontOriginAccessIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListCloudFrontOriginAccessIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListCloudFrontOriginAccessIdentitiesInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type ListCloudFrontOriginAccessIdentitiesOutput struct {
 	// The CloudFrontOriginAccessIdentityList type.
@@ -1859,6 +2613,16 @@ type metadataListCloudFrontOriginAccessIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityList"`
 }
 
+// String returns the string representation
+func (s ListCloudFrontOriginAccessIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListCloudFrontOriginAccessIdentitiesOutput) GoString() string {
+	return s.String()
+}
+
 // The request to list your distributions.
 type ListDistributionsInput struct {
 	// Use this when paginating results to indicate where to begin in your list
@@ -1878,6 +2642,16 @@ type metadataListDistributionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDistributionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDistributionsInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type ListDistributionsOutput struct {
 	// The DistributionList type.
@@ -1890,6 +2664,16 @@ type metadataListDistributionsOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionList"`
 }
 
+// String returns the string representation
+func (s ListDistributionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDistributionsOutput) GoString() string {
+	return s.String()
+}
+
 // The request to list invalidations.
 type ListInvalidationsInput struct {
 	// The distribution's id.
@@ -1914,6 +2698,16 @@ type metadataListInvalidationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInvalidationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInvalidationsInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type ListInvalidationsOutput struct {
 	// Information about invalidation batches.
@@ -1926,6 +2720,16 @@ type metadataListInvalidationsOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"InvalidationList"`
 }
 
+// String returns the string representation
+func (s ListInvalidationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInvalidationsOutput) GoString() string {
+	return s.String()
+}
+
 // The request to list your streaming distributions.
 type ListStreamingDistributionsInput struct {
 	// Use this when paginating results to indicate where to begin in your list
@@ -1945,6 +2749,16 @@ type metadataListStreamingDistributionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStreamingDistributionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStreamingDistributionsInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type ListStreamingDistributionsOutput struct {
 	// The StreamingDistributionList type.
@@ -1957,6 +2771,16 @@ type metadataListStreamingDistributionsOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionList"`
 }
 
+// String returns the string representation
+func (s ListStreamingDistributionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStreamingDistributionsOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that controls whether access logs are written for the distribution.
 type LoggingConfig struct {
 	// The Amazon S3 bucket to store the access logs in, for example, myawslogbucket.s3.amazonaws.com.
@@ -1991,6 +2815,16 @@ type metadataLoggingConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoggingConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoggingConfig) GoString() string {
+	return s.String()
+}
+
 // A complex type that describes the Amazon S3 bucket or the HTTP server (for
 // example, a web server) from which CloudFront gets your files.You must create
 // at least one origin.
@@ -2028,6 +2862,16 @@ type metadataOrigin struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Origin) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Origin) GoString() string {
+	return s.String()
+}
+
 // CloudFront origin access identity.
 type OriginAccessIdentity struct {
 	// The current configuration information for the identity.
@@ -2048,6 +2892,16 @@ type metadataOriginAccessIdentity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OriginAccessIdentity) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OriginAccessIdentity) GoString() string {
+	return s.String()
+}
+
 // Origin access identity configuration.
 type OriginAccessIdentityConfig struct {
 	// A unique number that ensures the request can't be replayed. If the CallerReference
@@ -2073,6 +2927,16 @@ type metadataOriginAccessIdentityConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OriginAccessIdentityConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OriginAccessIdentityConfig) GoString() string {
+	return s.String()
+}
+
 // The CloudFrontOriginAccessIdentityList type.
 type OriginAccessIdentityList struct {
 	// A flag that indicates whether more origin access identities remain to be
@@ -2107,6 +2971,16 @@ type metadataOriginAccessIdentityList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OriginAccessIdentityList) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OriginAccessIdentityList) GoString() string {
+	return s.String()
+}
+
 // Summary of the information about a CloudFront origin access identity.
 type OriginAccessIdentitySummary struct {
 	// The comment for this origin access identity, as originally specified when
@@ -2128,10 +3002,20 @@ type metadataOriginAccessIdentitySummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OriginAccessIdentitySummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OriginAccessIdentitySummary) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about origins for this distribution.
 type Origins struct {
 	// A complex type that contains origins for this distribution.
-	Items []*Origin `locationNameList:"Origin" type:"list"`
+	Items []*Origin `locationNameList:"Origin" type:"list" min:"1"`
 
 	// The number of origins for this distribution.
 	Quantity *int64 `type:"integer" required:"true"`
@@ -2143,6 +3027,16 @@ type metadataOrigins struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Origins) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Origins) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the objects that you want
 // to invalidate.
 type Paths struct {
@@ -2159,6 +3053,16 @@ type metadataPaths struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Paths) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Paths) GoString() string {
+	return s.String()
+}
+
 // A complex type that identifies ways in which you want to restrict distribution
 // of your content.
 type Restrictions struct {
@@ -2177,6 +3081,16 @@ type metadataRestrictions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Restrictions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Restrictions) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the Amazon S3 bucket from
 // which you want CloudFront to get your media files for distribution.
 type S3Origin struct {
@@ -2193,6 +3107,16 @@ type metadataS3Origin struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s S3Origin) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s S3Origin) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the Amazon S3 origin. If the
 // origin is a custom origin, use the CustomOriginConfig element instead.
 type S3OriginConfig struct {
@@ -2216,6 +3140,16 @@ type metadataS3OriginConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s S3OriginConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s S3OriginConfig) GoString() string {
+	return s.String()
+}
+
 // A complex type that lists the AWS accounts that were included in the TrustedSigners
 // complex type, as well as their active CloudFront key pair IDs, if any.
 type Signer struct {
@@ -2236,6 +3170,16 @@ type metadataSigner struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Signer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Signer) GoString() string {
+	return s.String()
+}
+
 // A streaming distribution.
 type StreamingDistribution struct {
 	// CloudFront automatically adds this element to the response only if you've
@@ -2273,6 +3217,16 @@ type metadataStreamingDistribution struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StreamingDistribution) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StreamingDistribution) GoString() string {
+	return s.String()
+}
+
 // The configuration for the streaming distribution.
 type StreamingDistributionConfig struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -2304,7 +3258,9 @@ type StreamingDistributionConfig struct {
 
 	// A complex type that contains information about price class for this streaming
 	// distribution.
-	PriceClass *string `type:"string"`
+	//
+	// See the PriceClass* constants for valid values.
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All"`
 
 	// A complex type that contains information about the Amazon S3 bucket from
 	// which you want CloudFront to get your media files for distribution.
@@ -2330,6 +3286,16 @@ type metadataStreamingDistributionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StreamingDistributionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StreamingDistributionConfig) GoString() string {
+	return s.String()
+}
+
 // A streaming distribution list.
 type StreamingDistributionList struct {
 	// A flag that indicates whether more streaming distributions remain to be listed.
@@ -2364,6 +3330,16 @@ type metadataStreamingDistributionList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StreamingDistributionList) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StreamingDistributionList) GoString() string {
+	return s.String()
+}
+
 // A summary of the information for an Amazon CloudFront streaming distribution.
 type StreamingDistributionSummary struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -2385,7 +3361,8 @@ type StreamingDistributionSummary struct {
 	// The date and time the distribution was last modified.
 	LastModifiedTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
-	PriceClass *string `type:"string" required:"true"`
+	// See the PriceClass* constants for valid values.
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All" required:"true"`
 
 	// A complex type that contains information about the Amazon S3 bucket from
 	// which you want CloudFront to get your media files for distribution.
@@ -2416,6 +3393,16 @@ type metadataStreamingDistributionSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StreamingDistributionSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StreamingDistributionSummary) GoString() string {
+	return s.String()
+}
+
 // A complex type that controls whether access logs are written for this streaming
 // distribution.
 type StreamingLoggingConfig struct {
@@ -2443,6 +3430,16 @@ type metadataStreamingLoggingConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StreamingLoggingConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StreamingLoggingConfig) GoString() string {
+	return s.String()
+}
+
 // A complex type that specifies the AWS accounts, if any, that you want to
 // allow to create signed URLs for private content. If you want to require signed
 // URLs in requests for objects in the target origin that match the PathPattern
@@ -2473,6 +3470,16 @@ type metadataTrustedSigners struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedSigners) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedSigners) GoString() string {
+	return s.String()
+}
+
 // The request to update an origin access identity.
 type UpdateCloudFrontOriginAccessIdentityInput struct {
 	// The identity's configuration information.
@@ -2492,6 +3499,16 @@ type metadataUpdateCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityConfig"`
 }
 
+// String returns the string representation
+func (s UpdateCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateCloudFrontOriginAccessIdentityInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type UpdateCloudFrontOriginAccessIdentityOutput struct {
 	// The origin access identity's information.
@@ -2507,6 +3524,16 @@ type metadataUpdateCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentity"`
 }
 
+// String returns the string representation
+func (s UpdateCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateCloudFrontOriginAccessIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // The request to update a distribution.
 type UpdateDistributionInput struct {
 	// The distribution's configuration information.
@@ -2526,6 +3553,16 @@ type metadataUpdateDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionConfig"`
 }
 
+// String returns the string representation
+func (s UpdateDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDistributionInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type UpdateDistributionOutput struct {
 	// The distribution's information.
@@ -2541,6 +3578,16 @@ type metadataUpdateDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Distribution"`
 }
 
+// String returns the string representation
+func (s UpdateDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // The request to update a streaming distribution.
 type UpdateStreamingDistributionInput struct {
 	// The streaming distribution's id.
@@ -2560,6 +3607,16 @@ type metadataUpdateStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionConfig"`
 }
 
+// String returns the string representation
+func (s UpdateStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateStreamingDistributionInput) GoString() string {
+	return s.String()
+}
+
 // The returned result of the corresponding request.
 type UpdateStreamingDistributionOutput struct {
 	// The current version of the configuration. For example: E2QWRUHAPOMQZL.
@@ -2575,6 +3632,16 @@ type metadataUpdateStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistribution"`
 }
 
+// String returns the string representation
+func (s UpdateStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateStreamingDistributionOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about viewer certificates for this
 // distribution.
 type ViewerCertificate struct {
@@ -2601,7 +3668,9 @@ type ViewerCertificate struct {
 	// If you're using a custom certificate (if you specify a value for IAMCertificateId)
 	// and if you're using SNI (if you specify sni-only for SSLSupportMethod), you
 	// must specify TLSv1 for MinimumProtocolVersion.
-	MinimumProtocolVersion *string `type:"string"`
+	//
+	// See the MinimumProtocolVersion* constants for valid values.
+	MinimumProtocolVersion *string `type:"string" enum:"SSLv3,TLSv1"`
 
 	// If you specify a value for IAMCertificateId, you must also specify how you
 	// want CloudFront to serve HTTPS requests. Valid values are vip and sni-only.
@@ -2612,7 +3681,9 @@ type ViewerCertificate struct {
 	// viewers that support Server Name Indication (SNI). All modern browsers support
 	// SNI, but some browsers still in use don't support SNI. Do not specify a value
 	// for SSLSupportMethod if you specified true for CloudFrontDefaultCertificate.
-	SSLSupportMethod *string `type:"string"`
+	//
+	// See the SSLSupportMethod* constants for valid values.
+	SSLSupportMethod *string `type:"string" enum:"sni-only,vip"`
 
 	metadataViewerCertificate `json:"-" xml:"-"`
 }
@@ -2620,3 +3691,87 @@ type ViewerCertificate struct {
 type metadataViewerCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s ViewerCertificate) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ViewerCertificate) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum GeoRestrictionType
+	GeoRestrictionTypeBlacklist = "blacklist"
+	// @enum GeoRestrictionType
+	GeoRestrictionTypeWhitelist = "whitelist"
+	// @enum GeoRestrictionType
+	GeoRestrictionTypeNone = "none"
+)
+
+const (
+	// @enum ItemSelection
+	ItemSelectionNone = "none"
+	// @enum ItemSelection
+	ItemSelectionWhitelist = "whitelist"
+	// @enum ItemSelection
+	ItemSelectionAll = "all"
+)
+
+const (
+	// @enum Method
+	MethodGet = "GET"
+	// @enum Method
+	MethodHead = "HEAD"
+	// @enum Method
+	MethodPost = "POST"
+	// @enum Method
+	MethodPut = "PUT"
+	// @enum Method
+	MethodPatch = "PATCH"
+	// @enum Method
+	MethodOptions = "OPTIONS"
+	// @enum Method
+	MethodDelete = "DELETE"
+)
+
+const (
+	// @enum MinimumProtocolVersion
+	MinimumProtocolVersionSSLv3 = "SSLv3"
+	// @enum MinimumProtocolVersion
+	MinimumProtocolVersionTLSv1 = "TLSv1"
+)
+
+const (
+	// @enum OriginProtocolPolicy
+	OriginProtocolPolicyHttpOnly = "http-only"
+	// @enum OriginProtocolPolicy
+	OriginProtocolPolicyMatchViewer = "match-viewer"
+)
+
+const (
+	// @enum PriceClass
+	PriceClassPriceClass100 = "PriceClass_100"
+	// @enum PriceClass
+	PriceClassPriceClass200 = "PriceClass_200"
+	// @enum PriceClass
+	PriceClassPriceClassAll = "PriceClass_All"
+)
+
+const (
+	// @enum SSLSupportMethod
+	SSLSupportMethodSniOnly = "sni-only"
+	// @enum SSLSupportMethod
+	SSLSupportMethodVip = "vip"
+)
+
+const (
+	// @enum ViewerProtocolPolicy
+	ViewerProtocolPolicyAllowAll = "allow-all"
+	// @enum ViewerProtocolPolicy
+	ViewerProtocolPolicyHttpsOnly = "https-only"
+	// @enum ViewerProtocolPolicy
+	ViewerProtocolPolicyRedirectToHttps = "redirect-to-https"
+)
@@ -5,6 +5,7 @@ package cloudhsm
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateHAPG = "CreateHapg"
@@ -35,6 +36,16 @@ func (c *CloudHSM) CreateHAPG(input *CreateHAPGInput) (*CreateHAPGOutput, error)
 	return out, err
 }
 
+// CreateHAPGWithContext is the same as CreateHAPG with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) CreateHAPGWithContext(ctx aws.Context, input *CreateHAPGInput) (*CreateHAPGOutput, error) {
+	req, out := c.CreateHAPGRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateHSM = "CreateHsm"
 
 // CreateHSMRequest generates a request for the CreateHSM operation.
@@ -63,6 +74,16 @@ func (c *CloudHSM) CreateHSM(input *CreateHSMInput) (*CreateHSMOutput, error) {
 	return out, err
 }
 
+// CreateHSMWithContext is the same as CreateHSM with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) CreateHSMWithContext(ctx aws.Context, input *CreateHSMInput) (*CreateHSMOutput, error) {
+	req, out := c.CreateHSMRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLunaClient = "CreateLunaClient"
 
 // CreateLunaClientRequest generates a request for the CreateLunaClient operation.
@@ -90,6 +111,16 @@ func (c *CloudHSM) CreateLunaClient(input *CreateLunaClientInput) (*CreateLunaCl
 	return out, err
 }
 
+// CreateLunaClientWithContext is the same as CreateLunaClient with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) CreateLunaClientWithContext(ctx aws.Context, input *CreateLunaClientInput) (*CreateLunaClientOutput, error) {
+	req, out := c.CreateLunaClientRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHAPG = "DeleteHapg"
 
 // DeleteHAPGRequest generates a request for the DeleteHAPG operation.
@@ -117,6 +148,16 @@ func (c *CloudHSM) DeleteHAPG(input *DeleteHAPGInput) (*DeleteHAPGOutput, error)
 	return out, err
 }
 
+// DeleteHAPGWithContext is the same as DeleteHAPG with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) DeleteHAPGWithContext(ctx aws.Context, input *DeleteHAPGInput) (*DeleteHAPGOutput, error) {
+	req, out := c.DeleteHAPGRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHSM = "DeleteHsm"
 
 // DeleteHSMRequest generates a request for the DeleteHSM operation.
@@ -145,6 +186,16 @@ func (c *CloudHSM) DeleteHSM(input *DeleteHSMInput) (*DeleteHSMOutput, error) {
 	return out, err
 }
 
+// DeleteHSMWithContext is the same as DeleteHSM with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) DeleteHSMWithContext(ctx aws.Context, input *DeleteHSMInput) (*DeleteHSMOutput, error) {
+	req, out := c.DeleteHSMRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLunaClient = "DeleteLunaClient"
 
 // DeleteLunaClientRequest generates a request for the DeleteLunaClient operation.
@@ -172,6 +223,16 @@ func (c *CloudHSM) DeleteLunaClient(input *DeleteLunaClientInput) (*DeleteLunaCl
 	return out, err
 }
 
+// DeleteLunaClientWithContext is the same as DeleteLunaClient with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) DeleteLunaClientWithContext(ctx aws.Context, input *DeleteLunaClientInput) (*DeleteLunaClientOutput, error) {
+	req, out := c.DeleteLunaClientRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeHAPG = "DescribeHapg"
 
 // DescribeHAPGRequest generates a request for the DescribeHAPG operation.
@@ -199,6 +260,16 @@ func (c *CloudHSM) DescribeHAPG(input *DescribeHAPGInput) (*DescribeHAPGOutput,
 	return out, err
 }
 
+// DescribeHAPGWithContext is the same as DescribeHAPG with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) DescribeHAPGWithContext(ctx aws.Context, input *DescribeHAPGInput) (*DescribeHAPGOutput, error) {
+	req, out := c.DescribeHAPGRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeHSM = "DescribeHsm"
 
 // DescribeHSMRequest generates a request for the DescribeHSM operation.
@@ -227,6 +298,16 @@ func (c *CloudHSM) DescribeHSM(input *DescribeHSMInput) (*DescribeHSMOutput, err
 	return out, err
 }
 
+// DescribeHSMWithContext is the same as DescribeHSM with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) DescribeHSMWithContext(ctx aws.Context, input *DescribeHSMInput) (*DescribeHSMOutput, error) {
+	req, out := c.DescribeHSMRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLunaClient = "DescribeLunaClient"
 
 // DescribeLunaClientRequest generates a request for the DescribeLunaClient operation.
@@ -254,6 +335,16 @@ func (c *CloudHSM) DescribeLunaClient(input *DescribeLunaClientInput) (*Describe
 	return out, err
 }
 
+// DescribeLunaClientWithContext is the same as DescribeLunaClient with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) DescribeLunaClientWithContext(ctx aws.Context, input *DescribeLunaClientInput) (*DescribeLunaClientOutput, error) {
+	req, out := c.DescribeLunaClientRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetConfig = "GetConfig"
 
 // GetConfigRequest generates a request for the GetConfig operation.
@@ -282,6 +373,16 @@ func (c *CloudHSM) GetConfig(input *GetConfigInput) (*GetConfigOutput, error) {
 	return out, err
 }
 
+// GetConfigWithContext is the same as GetConfig with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) GetConfigWithContext(ctx aws.Context, input *GetConfigInput) (*GetConfigOutput, error) {
+	req, out := c.GetConfigRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListAvailableZones = "ListAvailableZones"
 
 // ListAvailableZonesRequest generates a request for the ListAvailableZones operation.
@@ -309,6 +410,16 @@ func (c *CloudHSM) ListAvailableZones(input *ListAvailableZonesInput) (*ListAvai
 	return out, err
 }
 
+// ListAvailableZonesWithContext is the same as ListAvailableZones with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) ListAvailableZonesWithContext(ctx aws.Context, input *ListAvailableZonesInput) (*ListAvailableZonesOutput, error) {
+	req, out := c.ListAvailableZonesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListHSMs = "ListHsms"
 
 // ListHSMsRequest generates a request for the ListHSMs operation.
@@ -342,6 +453,16 @@ func (c *CloudHSM) ListHSMs(input *ListHSMsInput) (*ListHSMsOutput, error) {
 	return out, err
 }
 
+// ListHSMsWithContext is the same as ListHSMs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) ListHSMsWithContext(ctx aws.Context, input *ListHSMsInput) (*ListHSMsOutput, error) {
+	req, out := c.ListHSMsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListHapgs = "ListHapgs"
 
 // ListHapgsRequest generates a request for the ListHapgs operation.
@@ -374,6 +495,16 @@ func (c *CloudHSM) ListHapgs(input *ListHapgsInput) (*ListHapgsOutput, error) {
 	return out, err
 }
 
+// ListHapgsWithContext is the same as ListHapgs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) ListHapgsWithContext(ctx aws.Context, input *ListHapgsInput) (*ListHapgsOutput, error) {
+	req, out := c.ListHapgsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListLunaClients = "ListLunaClients"
 
 // ListLunaClientsRequest generates a request for the ListLunaClients operation.
@@ -406,6 +537,16 @@ func (c *CloudHSM) ListLunaClients(input *ListLunaClientsInput) (*ListLunaClient
 	return out, err
 }
 
+// ListLunaClientsWithContext is the same as ListLunaClients with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) ListLunaClientsWithContext(ctx aws.Context, input *ListLunaClientsInput) (*ListLunaClientsOutput, error) {
+	req, out := c.ListLunaClientsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyHAPG = "ModifyHapg"
 
 // ModifyHAPGRequest generates a request for the ModifyHAPG operation.
@@ -433,6 +574,16 @@ func (c *CloudHSM) ModifyHAPG(input *ModifyHAPGInput) (*ModifyHAPGOutput, error)
 	return out, err
 }
 
+// ModifyHAPGWithContext is the same as ModifyHAPG with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) ModifyHAPGWithContext(ctx aws.Context, input *ModifyHAPGInput) (*ModifyHAPGOutput, error) {
+	req, out := c.ModifyHAPGRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyHSM = "ModifyHsm"
 
 // ModifyHSMRequest generates a request for the ModifyHSM operation.
@@ -460,6 +611,16 @@ func (c *CloudHSM) ModifyHSM(input *ModifyHSMInput) (*ModifyHSMOutput, error) {
 	return out, err
 }
 
+// ModifyHSMWithContext is the same as ModifyHSM with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) ModifyHSMWithContext(ctx aws.Context, input *ModifyHSMInput) (*ModifyHSMOutput, error) {
+	req, out := c.ModifyHSMRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyLunaClient = "ModifyLunaClient"
 
 // ModifyLunaClientRequest generates a request for the ModifyLunaClient operation.
@@ -490,10 +651,20 @@ func (c *CloudHSM) ModifyLunaClient(input *ModifyLunaClientInput) (*ModifyLunaCl
 	return out, err
 }
 
+// ModifyLunaClientWithContext is the same as ModifyLunaClient with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudHSM) ModifyLunaClientWithContext(ctx aws.Context, input *ModifyLunaClientInput) (*ModifyLunaClientOutput, error) {
+	req, out := c.ModifyLunaClientRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Contains the inputs for the CreateHapgRequest action.
 type CreateHAPGInput struct {
 	// The label of the new high-availability partition group.
-	Label *string `type:"string" required:"true"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}" required:"true"`
 
 	metadataCreateHAPGInput `json:"-" xml:"-"`
 }
@@ -502,10 +673,20 @@ type metadataCreateHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHAPGInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the CreateHAPartitionGroup action.
 type CreateHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	metadataCreateHAPGOutput `json:"-" xml:"-"`
 }
@@ -514,33 +695,45 @@ type metadataCreateHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHAPGOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the CreateHsm action.
 type CreateHSMInput struct {
 	// A user-defined token to ensure idempotence. Subsequent calls to this action
 	// with the same token will be ignored.
-	ClientToken *string `locationName:"ClientToken" type:"string"`
+	ClientToken *string `locationName:"ClientToken" type:"string" pattern:"[a-zA-Z0-9]{1,64}"`
 
 	// The IP address to assign to the HSM's ENI.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The external ID from IamRoleArn, if present.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" pattern:"[\\w :+=./-]*"`
 
 	// The ARN of an IAM role to enable the AWS CloudHSM service to allocate an
 	// ENI on your behalf.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" required:"true"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}" required:"true"`
 
 	// The SSH public key to install on the HSM.
-	SSHKey *string `locationName:"SshKey" type:"string" required:"true"`
+	SSHKey *string `locationName:"SshKey" type:"string" pattern:"[a-zA-Z0-9+/= ._:\\\\@-]*" required:"true"`
 
 	// The identifier of the subnet in your VPC in which to place the HSM.
-	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}" required:"true"`
 
 	// The subscription type.
-	SubscriptionType *string `locationName:"SubscriptionType" type:"string" required:"true"`
+	//
+	// See the SubscriptionType* constants for valid values.
+	SubscriptionType *string `locationName:"SubscriptionType" type:"string" enum:"PRODUCTION" required:"true"`
 
 	// The IP address for the syslog monitoring server.
-	SyslogIP *string `locationName:"SyslogIp" type:"string"`
+	SyslogIP *string `locationName:"SyslogIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	metadataCreateHSMInput `json:"-" xml:"-"`
 }
@@ -549,10 +742,20 @@ type metadataCreateHSMInput struct {
 	SDKShapeTraits bool `locationName:"CreateHsmRequest" type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHSMInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the CreateHsm action.
 type CreateHSMOutput struct {
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	metadataCreateHSMOutput `json:"-" xml:"-"`
 }
@@ -561,14 +764,24 @@ type metadataCreateHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHSMOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the CreateLunaClient action.
 type CreateLunaClientInput struct {
 	// The contents of a Base64-Encoded X.509 v3 certificate to be installed on
 	// the HSMs used by this client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*" required:"true"`
 
 	// The label for the client.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{2,64}"`
 
 	metadataCreateLunaClientInput `json:"-" xml:"-"`
 }
@@ -577,10 +790,20 @@ type metadataCreateLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLunaClientInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the CreateLunaClient action.
 type CreateLunaClientOutput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataCreateLunaClientOutput `json:"-" xml:"-"`
 }
@@ -589,10 +812,20 @@ type metadataCreateLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLunaClientOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DeleteHapg action.
 type DeleteHAPGInput struct {
 	// The ARN of the high-availability partition group to delete.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteHAPGInput `json:"-" xml:"-"`
 }
@@ -601,10 +834,20 @@ type metadataDeleteHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHAPGInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the DeleteHapg action.
 type DeleteHAPGOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteHAPGOutput `json:"-" xml:"-"`
 }
@@ -613,10 +856,20 @@ type metadataDeleteHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHAPGOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DeleteHsm action.
 type DeleteHSMInput struct {
 	// The ARN of the HSM to delete.
-	HSMARN *string `locationName:"HsmArn" type:"string" required:"true"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteHSMInput `json:"-" xml:"-"`
 }
@@ -625,10 +878,20 @@ type metadataDeleteHSMInput struct {
 	SDKShapeTraits bool `locationName:"DeleteHsmRequest" type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHSMInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the DeleteHsm action.
 type DeleteHSMOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteHSMOutput `json:"-" xml:"-"`
 }
@@ -637,9 +900,19 @@ type metadataDeleteHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHSMOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLunaClientInput struct {
 	// The ARN of the client to delete.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteLunaClientInput `json:"-" xml:"-"`
 }
@@ -648,9 +921,19 @@ type metadataDeleteLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLunaClientInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLunaClientOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteLunaClientOutput `json:"-" xml:"-"`
 }
@@ -659,10 +942,20 @@ type metadataDeleteLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLunaClientOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DescribeHapg action.
 type DescribeHAPGInput struct {
 	// The ARN of the high-availability partition group to describe.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	metadataDescribeHAPGInput `json:"-" xml:"-"`
 }
@@ -671,13 +964,23 @@ type metadataDescribeHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeHAPGInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the DescribeHapg action.
 type DescribeHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	// The serial number of the high-availability partition group.
-	HAPGSerial *string `locationName:"HapgSerial" type:"string"`
+	HAPGSerial *string `locationName:"HapgSerial" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// Contains a list of ARNs that identify the HSMs.
 	HSMsLastActionFailed []*string `locationName:"HsmsLastActionFailed" type:"list"`
@@ -689,17 +992,19 @@ type DescribeHAPGOutput struct {
 	HSMsPendingRegistration []*string `locationName:"HsmsPendingRegistration" type:"list"`
 
 	// The label for the high-availability partition group.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The date and time the high-availability partition group was last modified.
-	LastModifiedTimestamp *string `type:"string"`
+	LastModifiedTimestamp *string `type:"string" pattern:"\\d*"`
 
 	// The list of partition serial numbers that belong to the high-availability
 	// partition group.
 	PartitionSerialList []*string `type:"list"`
 
 	// The state of the high-availability partition group.
-	State *string `type:"string"`
+	//
+	// See the ObjectState* constants for valid values.
+	State *string `type:"string" enum:"READY,UPDATING,DEGRADED"`
 
 	metadataDescribeHAPGOutput `json:"-" xml:"-"`
 }
@@ -708,15 +1013,25 @@ type metadataDescribeHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeHAPGOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DescribeHsm action.
 type DescribeHSMInput struct {
 	// The ARN of the HSM. Either the HsmArn or the SerialNumber parameter must
 	// be specified.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	// The serial number of the HSM. Either the HsmArn or the HsmSerialNumber parameter
 	// must be specified.
-	HSMSerialNumber *string `locationName:"HsmSerialNumber" type:"string"`
+	HSMSerialNumber *string `locationName:"HsmSerialNumber" type:"string" pattern:"\\d{1,16}"`
 
 	metadataDescribeHSMInput `json:"-" xml:"-"`
 }
@@ -725,70 +1040,84 @@ type metadataDescribeHSMInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeHSMInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the DescribeHsm action.
 type DescribeHSMOutput struct {
 	// The Availability Zone that the HSM is in.
-	AvailabilityZone *string `type:"string"`
+	AvailabilityZone *string `type:"string" pattern:"[a-zA-Z0-9\\-]*"`
 
 	// The identifier of the elastic network interface (ENI) attached to the HSM.
-	ENIID *string `locationName:"EniId" type:"string"`
+	ENIID *string `locationName:"EniId" type:"string" pattern:"eni-[0-9a-f]{8}"`
 
 	// The IP address assigned to the HSM's ENI.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	// The HSM model type.
-	HSMType *string `locationName:"HsmType" type:"string"`
+	HSMType *string `locationName:"HsmType" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The ARN of the IAM role assigned to the HSM.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"`
 
 	// The list of partitions on the HSM.
 	Partitions []*string `type:"list"`
 
 	// The date and time the SSH key was last updated.
-	SSHKeyLastUpdated *string `locationName:"SshKeyLastUpdated" type:"string"`
+	SSHKeyLastUpdated *string `locationName:"SshKeyLastUpdated" type:"string" pattern:"\\d*"`
 
 	// The public SSH key.
-	SSHPublicKey *string `locationName:"SshPublicKey" type:"string"`
+	SSHPublicKey *string `locationName:"SshPublicKey" type:"string" pattern:"[a-zA-Z0-9+/= ._:\\\\@-]*"`
 
 	// The serial number of the HSM.
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" pattern:"\\d{1,16}"`
 
 	// The date and time the server certificate was last updated.
-	ServerCertLastUpdated *string `type:"string"`
+	ServerCertLastUpdated *string `type:"string" pattern:"\\d*"`
 
 	// The URI of the certificate server.
-	ServerCertURI *string `locationName:"ServerCertUri" type:"string"`
+	ServerCertURI *string `locationName:"ServerCertUri" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The HSM software version.
-	SoftwareVersion *string `type:"string"`
+	SoftwareVersion *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The status of the HSM.
-	Status *string `type:"string"`
+	//
+	// See the HsmStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,RUNNING,UPDATING,SUSPENDED,TERMINATING,TERMINATED,DEGRADED"`
 
 	// Contains additional information about the status of the HSM.
-	StatusDetails *string `type:"string"`
+	StatusDetails *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The identifier of the subnet the HSM is in.
-	SubnetID *string `locationName:"SubnetId" type:"string"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}"`
 
 	// The subscription end date.
-	SubscriptionEndDate *string `type:"string"`
+	SubscriptionEndDate *string `type:"string" pattern:"\\d*"`
 
 	// The subscription start date.
-	SubscriptionStartDate *string `type:"string"`
+	SubscriptionStartDate *string `type:"string" pattern:"\\d*"`
 
 	// The subscription type.
-	SubscriptionType *string `type:"string"`
+	//
+	// See the SubscriptionType* constants for valid values.
+	SubscriptionType *string `type:"string" enum:"PRODUCTION"`
 
 	// The identifier of the VPC that the HSM is in.
-	VPCID *string `locationName:"VpcId" type:"string"`
+	VPCID *string `locationName:"VpcId" type:"string" pattern:"vpc-[0-9a-f]{8}"`
 
 	// The name of the HSM vendor.
-	VendorName *string `type:"string"`
+	VendorName *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	metadataDescribeHSMOutput `json:"-" xml:"-"`
 }
@@ -797,12 +1126,22 @@ type metadataDescribeHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeHSMOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLunaClientInput struct {
 	// The certificate fingerprint.
-	CertificateFingerprint *string `type:"string"`
+	CertificateFingerprint *string `type:"string" pattern:"([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataDescribeLunaClientInput `json:"-" xml:"-"`
 }
@@ -811,21 +1150,31 @@ type metadataDescribeLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLunaClientInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLunaClientOutput struct {
 	// The certificate installed on the HSMs used by this client.
-	Certificate *string `type:"string"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*"`
 
 	// The certificate fingerprint.
-	CertificateFingerprint *string `type:"string"`
+	CertificateFingerprint *string `type:"string" pattern:"([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	// The label of the client.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The date and time the client was last modified.
-	LastModifiedTimestamp *string `type:"string"`
+	LastModifiedTimestamp *string `type:"string" pattern:"\\d*"`
 
 	metadataDescribeLunaClientOutput `json:"-" xml:"-"`
 }
@@ -834,12 +1183,24 @@ type metadataDescribeLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLunaClientOutput) GoString() string {
+	return s.String()
+}
+
 type GetConfigInput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	// The client version.
-	ClientVersion *string `type:"string" required:"true"`
+	//
+	// See the ClientVersion* constants for valid values.
+	ClientVersion *string `type:"string" enum:"5.1,5.3" required:"true"`
 
 	// A list of ARNs that identify the high-availability partition groups that
 	// are associated with the client.
@@ -852,15 +1213,25 @@ type metadataGetConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetConfigInput) GoString() string {
+	return s.String()
+}
+
 type GetConfigOutput struct {
 	// The certificate file containing the server.pem files of the HSMs.
-	ConfigCred *string `type:"string"`
+	ConfigCred *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The chrystoki.conf configuration file.
-	ConfigFile *string `type:"string"`
+	ConfigFile *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The type of credentials.
-	ConfigType *string `type:"string"`
+	ConfigType *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	metadataGetConfigOutput `json:"-" xml:"-"`
 }
@@ -869,6 +1240,16 @@ type metadataGetConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetConfigOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the ListAvailableZones action.
 type ListAvailableZonesInput struct {
 	metadataListAvailableZonesInput `json:"-" xml:"-"`
@@ -878,6 +1259,16 @@ type metadataListAvailableZonesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAvailableZonesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAvailableZonesInput) GoString() string {
+	return s.String()
+}
+
 type ListAvailableZonesOutput struct {
 	// The list of Availability Zones that have available AWS CloudHSM capacity.
 	AZList []*string `type:"list"`
@@ -889,10 +1280,20 @@ type metadataListAvailableZonesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAvailableZonesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAvailableZonesOutput) GoString() string {
+	return s.String()
+}
+
 type ListHSMsInput struct {
 	// The NextToken value from a previous call to ListHsms. Pass null if this is
 	// the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHSMsInput `json:"-" xml:"-"`
 }
@@ -901,6 +1302,16 @@ type metadataListHSMsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHSMsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHSMsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the ListHsms action.
 type ListHSMsOutput struct {
 	// The list of ARNs that identify the HSMs.
@@ -908,7 +1319,7 @@ type ListHSMsOutput struct {
 
 	// If not null, more results are available. Pass this value to ListHsms to retrieve
 	// the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHSMsOutput `json:"-" xml:"-"`
 }
@@ -917,10 +1328,20 @@ type metadataListHSMsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHSMsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHSMsOutput) GoString() string {
+	return s.String()
+}
+
 type ListHapgsInput struct {
 	// The NextToken value from a previous call to ListHapgs. Pass null if this
 	// is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHapgsInput `json:"-" xml:"-"`
 }
@@ -929,13 +1350,23 @@ type metadataListHapgsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHapgsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHapgsInput) GoString() string {
+	return s.String()
+}
+
 type ListHapgsOutput struct {
 	// The list of high-availability partition groups.
 	HAPGList []*string `locationName:"HapgList" type:"list" required:"true"`
 
 	// If not null, more results are available. Pass this value to ListHapgs to
 	// retrieve the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHapgsOutput `json:"-" xml:"-"`
 }
@@ -944,10 +1375,20 @@ type metadataListHapgsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHapgsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHapgsOutput) GoString() string {
+	return s.String()
+}
+
 type ListLunaClientsInput struct {
 	// The NextToken value from a previous call to ListLunaClients. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListLunaClientsInput `json:"-" xml:"-"`
 }
@@ -956,13 +1397,23 @@ type metadataListLunaClientsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListLunaClientsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListLunaClientsInput) GoString() string {
+	return s.String()
+}
+
 type ListLunaClientsOutput struct {
 	// The list of clients.
 	ClientList []*string `type:"list" required:"true"`
 
 	// If not null, more results are available. Pass this to ListLunaClients to
 	// retrieve the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListLunaClientsOutput `json:"-" xml:"-"`
 }
@@ -971,12 +1422,22 @@ type metadataListLunaClientsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListLunaClientsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListLunaClientsOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyHAPGInput struct {
 	// The ARN of the high-availability partition group to modify.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	// The new label for the high-availability partition group.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The list of partition serial numbers to make members of the high-availability
 	// partition group.
@@ -989,9 +1450,19 @@ type metadataModifyHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyHAPGInput) GoString() string {
+	return s.String()
+}
+
 type ModifyHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	metadataModifyHAPGOutput `json:"-" xml:"-"`
 }
@@ -1000,25 +1471,35 @@ type metadataModifyHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyHAPGOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the ModifyHsm action.
 type ModifyHSMInput struct {
 	// The new IP address for the elastic network interface attached to the HSM.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The new external ID.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" pattern:"[\\w :+=./-]*"`
 
 	// The ARN of the HSM to modify.
-	HSMARN *string `locationName:"HsmArn" type:"string" required:"true"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}" required:"true"`
 
 	// The new IAM role ARN.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"`
 
 	// The new identifier of the subnet that the HSM is in.
-	SubnetID *string `locationName:"SubnetId" type:"string"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}"`
 
 	// The new IP address for the syslog monitoring server.
-	SyslogIP *string `locationName:"SyslogIp" type:"string"`
+	SyslogIP *string `locationName:"SyslogIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	metadataModifyHSMInput `json:"-" xml:"-"`
 }
@@ -1027,10 +1508,20 @@ type metadataModifyHSMInput struct {
 	SDKShapeTraits bool `locationName:"ModifyHsmRequest" type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyHSMInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of the ModifyHsm action.
 type ModifyHSMOutput struct {
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	metadataModifyHSMOutput `json:"-" xml:"-"`
 }
@@ -1039,12 +1530,22 @@ type metadataModifyHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyHSMOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyLunaClientInput struct {
 	// The new certificate for the client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*" required:"true"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	metadataModifyLunaClientInput `json:"-" xml:"-"`
 }
@@ -1053,9 +1554,19 @@ type metadataModifyLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyLunaClientInput) GoString() string {
+	return s.String()
+}
+
 type ModifyLunaClientOutput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataModifyLunaClientOutput `json:"-" xml:"-"`
 }
@@ -1063,3 +1574,51 @@ type ModifyLunaClientOutput struct {
 type metadataModifyLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s ModifyLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyLunaClientOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ClientVersion
+	ClientVersion51 = "5.1"
+	// @enum ClientVersion
+	ClientVersion53 = "5.3"
+)
+
+const (
+	// @enum HsmStatus
+	HsmStatusPending = "PENDING"
+	// @enum HsmStatus
+	HsmStatusRunning = "RUNNING"
+	// @enum HsmStatus
+	HsmStatusUpdating = "UPDATING"
+	// @enum HsmStatus
+	HsmStatusSuspended = "SUSPENDED"
+	// @enum HsmStatus
+	HsmStatusTerminating = "TERMINATING"
+	// @enum HsmStatus
+	HsmStatusTerminated = "TERMINATED"
+	// @enum HsmStatus
+	HsmStatusDegraded = "DEGRADED"
+)
+
+const (
+	// @enum ObjectState
+	ObjectStateReady = "READY"
+	// @enum ObjectState
+	ObjectStateUpdating = "UPDATING"
+	// @enum ObjectState
+	ObjectStateDegraded = "DEGRADED"
+)
+
+const (
+	// @enum SubscriptionType
+	SubscriptionTypeProduction = "PRODUCTION"
+)
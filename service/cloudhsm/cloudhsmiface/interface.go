@@ -4,6 +4,7 @@
 package cloudhsmiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsm"
 )
 
@@ -11,35 +12,69 @@ import (
 type CloudHSMAPI interface {
 	CreateHAPG(*cloudhsm.CreateHAPGInput) (*cloudhsm.CreateHAPGOutput, error)
 
+	CreateHAPGWithContext(aws.Context, *cloudhsm.CreateHAPGInput) (*cloudhsm.CreateHAPGOutput, error)
+
 	CreateHSM(*cloudhsm.CreateHSMInput) (*cloudhsm.CreateHSMOutput, error)
 
+	CreateHSMWithContext(aws.Context, *cloudhsm.CreateHSMInput) (*cloudhsm.CreateHSMOutput, error)
+
 	CreateLunaClient(*cloudhsm.CreateLunaClientInput) (*cloudhsm.CreateLunaClientOutput, error)
 
+	CreateLunaClientWithContext(aws.Context, *cloudhsm.CreateLunaClientInput) (*cloudhsm.CreateLunaClientOutput, error)
+
 	DeleteHAPG(*cloudhsm.DeleteHAPGInput) (*cloudhsm.DeleteHAPGOutput, error)
 
+	DeleteHAPGWithContext(aws.Context, *cloudhsm.DeleteHAPGInput) (*cloudhsm.DeleteHAPGOutput, error)
+
 	DeleteHSM(*cloudhsm.DeleteHSMInput) (*cloudhsm.DeleteHSMOutput, error)
 
+	DeleteHSMWithContext(aws.Context, *cloudhsm.DeleteHSMInput) (*cloudhsm.DeleteHSMOutput, error)
+
 	DeleteLunaClient(*cloudhsm.DeleteLunaClientInput) (*cloudhsm.DeleteLunaClientOutput, error)
 
+	DeleteLunaClientWithContext(aws.Context, *cloudhsm.DeleteLunaClientInput) (*cloudhsm.DeleteLunaClientOutput, error)
+
 	DescribeHAPG(*cloudhsm.DescribeHAPGInput) (*cloudhsm.DescribeHAPGOutput, error)
 
+	DescribeHAPGWithContext(aws.Context, *cloudhsm.DescribeHAPGInput) (*cloudhsm.DescribeHAPGOutput, error)
+
 	DescribeHSM(*cloudhsm.DescribeHSMInput) (*cloudhsm.DescribeHSMOutput, error)
 
+	DescribeHSMWithContext(aws.Context, *cloudhsm.DescribeHSMInput) (*cloudhsm.DescribeHSMOutput, error)
+
 	DescribeLunaClient(*cloudhsm.DescribeLunaClientInput) (*cloudhsm.DescribeLunaClientOutput, error)
 
+	DescribeLunaClientWithContext(aws.Context, *cloudhsm.DescribeLunaClientInput) (*cloudhsm.DescribeLunaClientOutput, error)
+
 	GetConfig(*cloudhsm.GetConfigInput) (*cloudhsm.GetConfigOutput, error)
 
+	GetConfigWithContext(aws.Context, *cloudhsm.GetConfigInput) (*cloudhsm.GetConfigOutput, error)
+
 	ListAvailableZones(*cloudhsm.ListAvailableZonesInput) (*cloudhsm.ListAvailableZonesOutput, error)
 
+	ListAvailableZonesWithContext(aws.Context, *cloudhsm.ListAvailableZonesInput) (*cloudhsm.ListAvailableZonesOutput, error)
+
 	ListHSMs(*cloudhsm.ListHSMsInput) (*cloudhsm.ListHSMsOutput, error)
 
+	ListHSMsWithContext(aws.Context, *cloudhsm.ListHSMsInput) (*cloudhsm.ListHSMsOutput, error)
+
 	ListHapgs(*cloudhsm.ListHapgsInput) (*cloudhsm.ListHapgsOutput, error)
 
+	ListHapgsWithContext(aws.Context, *cloudhsm.ListHapgsInput) (*cloudhsm.ListHapgsOutput, error)
+
 	ListLunaClients(*cloudhsm.ListLunaClientsInput) (*cloudhsm.ListLunaClientsOutput, error)
 
+	ListLunaClientsWithContext(aws.Context, *cloudhsm.ListLunaClientsInput) (*cloudhsm.ListLunaClientsOutput, error)
+
 	ModifyHAPG(*cloudhsm.ModifyHAPGInput) (*cloudhsm.ModifyHAPGOutput, error)
 
+	ModifyHAPGWithContext(aws.Context, *cloudhsm.ModifyHAPGInput) (*cloudhsm.ModifyHAPGOutput, error)
+
 	ModifyHSM(*cloudhsm.ModifyHSMInput) (*cloudhsm.ModifyHSMOutput, error)
 
+	ModifyHSMWithContext(aws.Context, *cloudhsm.ModifyHSMInput) (*cloudhsm.ModifyHSMOutput, error)
+
 	ModifyLunaClient(*cloudhsm.ModifyLunaClientInput) (*cloudhsm.ModifyLunaClientOutput, error)
+
+	ModifyLunaClientWithContext(aws.Context, *cloudhsm.ModifyLunaClientInput) (*cloudhsm.ModifyLunaClientOutput, error)
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opBuildSuggesters = "BuildSuggesters"
@@ -38,6 +39,16 @@ func (c *CloudSearch) BuildSuggesters(input *BuildSuggestersInput) (*BuildSugges
 	return out, err
 }
 
+// BuildSuggestersWithContext is the same as BuildSuggesters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) BuildSuggestersWithContext(ctx aws.Context, input *BuildSuggestersInput) (*BuildSuggestersOutput, error) {
+	req, out := c.BuildSuggestersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDomain = "CreateDomain"
 
 // CreateDomainRequest generates a request for the CreateDomain operation.
@@ -67,6 +78,16 @@ func (c *CloudSearch) CreateDomain(input *CreateDomainInput) (*CreateDomainOutpu
 	return out, err
 }
 
+// CreateDomainWithContext is the same as CreateDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) CreateDomainWithContext(ctx aws.Context, input *CreateDomainInput) (*CreateDomainOutput, error) {
+	req, out := c.CreateDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineAnalysisScheme = "DefineAnalysisScheme"
 
 // DefineAnalysisSchemeRequest generates a request for the DefineAnalysisScheme operation.
@@ -97,6 +118,16 @@ func (c *CloudSearch) DefineAnalysisScheme(input *DefineAnalysisSchemeInput) (*D
 	return out, err
 }
 
+// DefineAnalysisSchemeWithContext is the same as DefineAnalysisScheme with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DefineAnalysisSchemeWithContext(ctx aws.Context, input *DefineAnalysisSchemeInput) (*DefineAnalysisSchemeOutput, error) {
+	req, out := c.DefineAnalysisSchemeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineExpression = "DefineExpression"
 
 // DefineExpressionRequest generates a request for the DefineExpression operation.
@@ -127,6 +158,16 @@ func (c *CloudSearch) DefineExpression(input *DefineExpressionInput) (*DefineExp
 	return out, err
 }
 
+// DefineExpressionWithContext is the same as DefineExpression with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DefineExpressionWithContext(ctx aws.Context, input *DefineExpressionInput) (*DefineExpressionOutput, error) {
+	req, out := c.DefineExpressionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineIndexField = "DefineIndexField"
 
 // DefineIndexFieldRequest generates a request for the DefineIndexField operation.
@@ -161,6 +202,16 @@ func (c *CloudSearch) DefineIndexField(input *DefineIndexFieldInput) (*DefineInd
 	return out, err
 }
 
+// DefineIndexFieldWithContext is the same as DefineIndexField with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DefineIndexFieldWithContext(ctx aws.Context, input *DefineIndexFieldInput) (*DefineIndexFieldOutput, error) {
+	req, out := c.DefineIndexFieldRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDefineSuggester = "DefineSuggester"
 
 // DefineSuggesterRequest generates a request for the DefineSuggester operation.
@@ -193,6 +244,16 @@ func (c *CloudSearch) DefineSuggester(input *DefineSuggesterInput) (*DefineSugge
 	return out, err
 }
 
+// DefineSuggesterWithContext is the same as DefineSuggester with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DefineSuggesterWithContext(ctx aws.Context, input *DefineSuggesterInput) (*DefineSuggesterOutput, error) {
+	req, out := c.DefineSuggesterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAnalysisScheme = "DeleteAnalysisScheme"
 
 // DeleteAnalysisSchemeRequest generates a request for the DeleteAnalysisScheme operation.
@@ -222,6 +283,16 @@ func (c *CloudSearch) DeleteAnalysisScheme(input *DeleteAnalysisSchemeInput) (*D
 	return out, err
 }
 
+// DeleteAnalysisSchemeWithContext is the same as DeleteAnalysisScheme with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DeleteAnalysisSchemeWithContext(ctx aws.Context, input *DeleteAnalysisSchemeInput) (*DeleteAnalysisSchemeOutput, error) {
+	req, out := c.DeleteAnalysisSchemeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDomain = "DeleteDomain"
 
 // DeleteDomainRequest generates a request for the DeleteDomain operation.
@@ -252,6 +323,16 @@ func (c *CloudSearch) DeleteDomain(input *DeleteDomainInput) (*DeleteDomainOutpu
 	return out, err
 }
 
+// DeleteDomainWithContext is the same as DeleteDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DeleteDomainWithContext(ctx aws.Context, input *DeleteDomainInput) (*DeleteDomainOutput, error) {
+	req, out := c.DeleteDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteExpression = "DeleteExpression"
 
 // DeleteExpressionRequest generates a request for the DeleteExpression operation.
@@ -281,6 +362,16 @@ func (c *CloudSearch) DeleteExpression(input *DeleteExpressionInput) (*DeleteExp
 	return out, err
 }
 
+// DeleteExpressionWithContext is the same as DeleteExpression with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DeleteExpressionWithContext(ctx aws.Context, input *DeleteExpressionInput) (*DeleteExpressionOutput, error) {
+	req, out := c.DeleteExpressionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteIndexField = "DeleteIndexField"
 
 // DeleteIndexFieldRequest generates a request for the DeleteIndexField operation.
@@ -310,6 +401,16 @@ func (c *CloudSearch) DeleteIndexField(input *DeleteIndexFieldInput) (*DeleteInd
 	return out, err
 }
 
+// DeleteIndexFieldWithContext is the same as DeleteIndexField with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DeleteIndexFieldWithContext(ctx aws.Context, input *DeleteIndexFieldInput) (*DeleteIndexFieldOutput, error) {
+	req, out := c.DeleteIndexFieldRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSuggester = "DeleteSuggester"
 
 // DeleteSuggesterRequest generates a request for the DeleteSuggester operation.
@@ -339,6 +440,16 @@ func (c *CloudSearch) DeleteSuggester(input *DeleteSuggesterInput) (*DeleteSugge
 	return out, err
 }
 
+// DeleteSuggesterWithContext is the same as DeleteSuggester with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DeleteSuggesterWithContext(ctx aws.Context, input *DeleteSuggesterInput) (*DeleteSuggesterOutput, error) {
+	req, out := c.DeleteSuggesterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAnalysisSchemes = "DescribeAnalysisSchemes"
 
 // DescribeAnalysisSchemesRequest generates a request for the DescribeAnalysisSchemes operation.
@@ -372,6 +483,16 @@ func (c *CloudSearch) DescribeAnalysisSchemes(input *DescribeAnalysisSchemesInpu
 	return out, err
 }
 
+// DescribeAnalysisSchemesWithContext is the same as DescribeAnalysisSchemes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeAnalysisSchemesWithContext(ctx aws.Context, input *DescribeAnalysisSchemesInput) (*DescribeAnalysisSchemesOutput, error) {
+	req, out := c.DescribeAnalysisSchemesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAvailabilityOptions = "DescribeAvailabilityOptions"
 
 // DescribeAvailabilityOptionsRequest generates a request for the DescribeAvailabilityOptions operation.
@@ -403,6 +524,16 @@ func (c *CloudSearch) DescribeAvailabilityOptions(input *DescribeAvailabilityOpt
 	return out, err
 }
 
+// DescribeAvailabilityOptionsWithContext is the same as DescribeAvailabilityOptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeAvailabilityOptionsWithContext(ctx aws.Context, input *DescribeAvailabilityOptionsInput) (*DescribeAvailabilityOptionsOutput, error) {
+	req, out := c.DescribeAvailabilityOptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDomains = "DescribeDomains"
 
 // DescribeDomainsRequest generates a request for the DescribeDomains operation.
@@ -435,6 +566,16 @@ func (c *CloudSearch) DescribeDomains(input *DescribeDomainsInput) (*DescribeDom
 	return out, err
 }
 
+// DescribeDomainsWithContext is the same as DescribeDomains with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeDomainsWithContext(ctx aws.Context, input *DescribeDomainsInput) (*DescribeDomainsOutput, error) {
+	req, out := c.DescribeDomainsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeExpressions = "DescribeExpressions"
 
 // DescribeExpressionsRequest generates a request for the DescribeExpressions operation.
@@ -467,6 +608,16 @@ func (c *CloudSearch) DescribeExpressions(input *DescribeExpressionsInput) (*Des
 	return out, err
 }
 
+// DescribeExpressionsWithContext is the same as DescribeExpressions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeExpressionsWithContext(ctx aws.Context, input *DescribeExpressionsInput) (*DescribeExpressionsOutput, error) {
+	req, out := c.DescribeExpressionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIndexFields = "DescribeIndexFields"
 
 // DescribeIndexFieldsRequest generates a request for the DescribeIndexFields operation.
@@ -499,6 +650,16 @@ func (c *CloudSearch) DescribeIndexFields(input *DescribeIndexFieldsInput) (*Des
 	return out, err
 }
 
+// DescribeIndexFieldsWithContext is the same as DescribeIndexFields with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeIndexFieldsWithContext(ctx aws.Context, input *DescribeIndexFieldsInput) (*DescribeIndexFieldsOutput, error) {
+	req, out := c.DescribeIndexFieldsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeScalingParameters = "DescribeScalingParameters"
 
 // DescribeScalingParametersRequest generates a request for the DescribeScalingParameters operation.
@@ -529,6 +690,16 @@ func (c *CloudSearch) DescribeScalingParameters(input *DescribeScalingParameters
 	return out, err
 }
 
+// DescribeScalingParametersWithContext is the same as DescribeScalingParameters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeScalingParametersWithContext(ctx aws.Context, input *DescribeScalingParametersInput) (*DescribeScalingParametersOutput, error) {
+	req, out := c.DescribeScalingParametersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeServiceAccessPolicies = "DescribeServiceAccessPolicies"
 
 // DescribeServiceAccessPoliciesRequest generates a request for the DescribeServiceAccessPolicies operation.
@@ -561,6 +732,16 @@ func (c *CloudSearch) DescribeServiceAccessPolicies(input *DescribeServiceAccess
 	return out, err
 }
 
+// DescribeServiceAccessPoliciesWithContext is the same as DescribeServiceAccessPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeServiceAccessPoliciesWithContext(ctx aws.Context, input *DescribeServiceAccessPoliciesInput) (*DescribeServiceAccessPoliciesOutput, error) {
+	req, out := c.DescribeServiceAccessPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSuggesters = "DescribeSuggesters"
 
 // DescribeSuggestersRequest generates a request for the DescribeSuggesters operation.
@@ -594,6 +775,16 @@ func (c *CloudSearch) DescribeSuggesters(input *DescribeSuggestersInput) (*Descr
 	return out, err
 }
 
+// DescribeSuggestersWithContext is the same as DescribeSuggesters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) DescribeSuggestersWithContext(ctx aws.Context, input *DescribeSuggestersInput) (*DescribeSuggestersOutput, error) {
+	req, out := c.DescribeSuggestersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opIndexDocuments = "IndexDocuments"
 
 // IndexDocumentsRequest generates a request for the IndexDocuments operation.
@@ -623,6 +814,16 @@ func (c *CloudSearch) IndexDocuments(input *IndexDocumentsInput) (*IndexDocument
 	return out, err
 }
 
+// IndexDocumentsWithContext is the same as IndexDocuments with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) IndexDocumentsWithContext(ctx aws.Context, input *IndexDocumentsInput) (*IndexDocumentsOutput, error) {
+	req, out := c.IndexDocumentsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListDomainNames = "ListDomainNames"
 
 // ListDomainNamesRequest generates a request for the ListDomainNames operation.
@@ -650,6 +851,16 @@ func (c *CloudSearch) ListDomainNames(input *ListDomainNamesInput) (*ListDomainN
 	return out, err
 }
 
+// ListDomainNamesWithContext is the same as ListDomainNames with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) ListDomainNamesWithContext(ctx aws.Context, input *ListDomainNamesInput) (*ListDomainNamesOutput, error) {
+	req, out := c.ListDomainNamesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAvailabilityOptions = "UpdateAvailabilityOptions"
 
 // UpdateAvailabilityOptionsRequest generates a request for the UpdateAvailabilityOptions operation.
@@ -682,6 +893,16 @@ func (c *CloudSearch) UpdateAvailabilityOptions(input *UpdateAvailabilityOptions
 	return out, err
 }
 
+// UpdateAvailabilityOptionsWithContext is the same as UpdateAvailabilityOptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) UpdateAvailabilityOptionsWithContext(ctx aws.Context, input *UpdateAvailabilityOptionsInput) (*UpdateAvailabilityOptionsOutput, error) {
+	req, out := c.UpdateAvailabilityOptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateScalingParameters = "UpdateScalingParameters"
 
 // UpdateScalingParametersRequest generates a request for the UpdateScalingParameters operation.
@@ -716,6 +937,16 @@ func (c *CloudSearch) UpdateScalingParameters(input *UpdateScalingParametersInpu
 	return out, err
 }
 
+// UpdateScalingParametersWithContext is the same as UpdateScalingParameters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) UpdateScalingParametersWithContext(ctx aws.Context, input *UpdateScalingParametersInput) (*UpdateScalingParametersOutput, error) {
+	req, out := c.UpdateScalingParametersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateServiceAccessPolicies = "UpdateServiceAccessPolicies"
 
 // UpdateServiceAccessPoliciesRequest generates a request for the UpdateServiceAccessPolicies operation.
@@ -746,6 +977,16 @@ func (c *CloudSearch) UpdateServiceAccessPolicies(input *UpdateServiceAccessPoli
 	return out, err
 }
 
+// UpdateServiceAccessPoliciesWithContext is the same as UpdateServiceAccessPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearch) UpdateServiceAccessPoliciesWithContext(ctx aws.Context, input *UpdateServiceAccessPoliciesInput) (*UpdateServiceAccessPoliciesOutput, error) {
+	req, out := c.UpdateServiceAccessPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // The configured access rules for the domain's document and search endpoints,
 // and the current status of those rules.
 type AccessPoliciesStatus struct {
@@ -765,6 +1006,16 @@ type metadataAccessPoliciesStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AccessPoliciesStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AccessPoliciesStatus) GoString() string {
+	return s.String()
+}
+
 // Synonyms, stopwords, and stemming options for an analysis scheme. Includes
 // tokenization dictionary for Japanese.
 type AnalysisOptions struct {
@@ -772,7 +1023,9 @@ type AnalysisOptions struct {
 	// The available levels vary depending on the language. For more information,
 	// see Language Specific Text Processing Settings (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/text-processing.html#text-processing-settings"
 	// target="_blank) in the Amazon CloudSearch Developer Guide
-	AlgorithmicStemming *string `type:"string"`
+	//
+	// See the AlgorithmicStemming* constants for valid values.
+	AlgorithmicStemming *string `type:"string" enum:"none,minimal,light,full"`
 
 	// A JSON array that contains a collection of terms, tokens, readings and part
 	// of speech for Japanese Tokenizaiton. The Japanese tokenization dictionary
@@ -811,6 +1064,16 @@ type metadataAnalysisOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AnalysisOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AnalysisOptions) GoString() string {
+	return s.String()
+}
+
 // Configuration information for an analysis scheme. Each analysis scheme has
 // a unique name and specifies the language of the text to be processed. The
 // following options can be configured for an analysis scheme: Synonyms, Stopwords,
@@ -822,11 +1085,13 @@ type AnalysisScheme struct {
 
 	// An IETF RFC 4646 (http://tools.ietf.org/html/rfc4646" target="_blank) language
 	// code or mul for multiple languages.
-	AnalysisSchemeLanguage *string `type:"string" required:"true"`
+	//
+	// See the AnalysisSchemeLanguage* constants for valid values.
+	AnalysisSchemeLanguage *string `type:"string" enum:"ar,bg,ca,cs,da,de,el,en,es,eu,fa,fi,fr,ga,gl,he,hi,hu,hy,id,it,ja,ko,lv,mul,nl,no,pt,ro,ru,sv,th,tr,zh-Hans,zh-Hant" required:"true"`
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataAnalysisScheme `json:"-" xml:"-"`
 }
@@ -835,6 +1100,16 @@ type metadataAnalysisScheme struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AnalysisScheme) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AnalysisScheme) GoString() string {
+	return s.String()
+}
+
 // The status and configuration of an AnalysisScheme.
 type AnalysisSchemeStatus struct {
 	// Configuration information for an analysis scheme. Each analysis scheme has
@@ -853,6 +1128,16 @@ type metadataAnalysisSchemeStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AnalysisSchemeStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AnalysisSchemeStatus) GoString() string {
+	return s.String()
+}
+
 // The status and configuration of the domain's availability options.
 type AvailabilityOptionsStatus struct {
 	// The availability options configured for the domain.
@@ -868,6 +1153,16 @@ type metadataAvailabilityOptionsStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AvailabilityOptionsStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AvailabilityOptionsStatus) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the BuildSuggester operation. Specifies the
 // name of the domain you want to update.
 type BuildSuggestersInput struct {
@@ -875,7 +1170,7 @@ type BuildSuggestersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataBuildSuggestersInput `json:"-" xml:"-"`
 }
@@ -884,6 +1179,16 @@ type metadataBuildSuggestersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BuildSuggestersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BuildSuggestersInput) GoString() string {
+	return s.String()
+}
+
 // The result of a BuildSuggester request. Contains a list of the fields used
 // for suggestions.
 type BuildSuggestersOutput struct {
@@ -897,13 +1202,23 @@ type metadataBuildSuggestersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BuildSuggestersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BuildSuggestersOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the CreateDomain operation. Specifies a name
 // for the new search domain.
 type CreateDomainInput struct {
 	// A name for the domain you are creating. Allowed characters are a-z (lower-case
 	// letters), 0-9, and hyphen (-). Domain names must start with a letter or number
 	// and be at least 3 and no more than 28 characters long.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataCreateDomainInput `json:"-" xml:"-"`
 }
@@ -912,6 +1227,16 @@ type metadataCreateDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDomainInput) GoString() string {
+	return s.String()
+}
+
 // The result of a CreateDomainRequest. Contains the status of a newly created
 // domain.
 type CreateDomainOutput struct {
@@ -925,11 +1250,21 @@ type metadataCreateDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDomainOutput) GoString() string {
+	return s.String()
+}
+
 // Options for a field that contains an array of dates. Present if IndexFieldType
 // specifies the field is of type date-array. All options are enabled by default.
 type DateArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -941,7 +1276,7 @@ type DateArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataDateArrayOptions `json:"-" xml:"-"`
 }
@@ -950,13 +1285,23 @@ type metadataDateArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DateArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DateArrayOptions) GoString() string {
+	return s.String()
+}
+
 // Options for a date field. Dates and times are specified in UTC (Coordinated
 // Universal Time) according to IETF RFC3339: yyyy-mm-ddT00:00:00Z. Present
 // if IndexFieldType specifies the field is of type date. All options are enabled
 // by default.
 type DateOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -984,7 +1329,7 @@ type DateOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataDateOptions `json:"-" xml:"-"`
 }
@@ -993,6 +1338,16 @@ type metadataDateOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DateOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DateOptions) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DefineAnalysisScheme operation. Specifies
 // the name of the domain you want to update and the analysis scheme configuration.
 type DefineAnalysisSchemeInput struct {
@@ -1006,7 +1361,7 @@ type DefineAnalysisSchemeInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDefineAnalysisSchemeInput `json:"-" xml:"-"`
 }
@@ -1015,6 +1370,16 @@ type metadataDefineAnalysisSchemeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineAnalysisSchemeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineAnalysisSchemeInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DefineAnalysisScheme request. Contains the status of the
 // newly-configured analysis scheme.
 type DefineAnalysisSchemeOutput struct {
@@ -1028,6 +1393,16 @@ type metadataDefineAnalysisSchemeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineAnalysisSchemeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineAnalysisSchemeOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DefineExpression operation. Specifies
 // the name of the domain you want to update and the expression you want to
 // configure.
@@ -1036,7 +1411,7 @@ type DefineExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// A named expression that can be evaluated at search time. Can be used to sort
 	// the search results, define other expressions, or return computed information
@@ -1050,6 +1425,16 @@ type metadataDefineExpressionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineExpressionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineExpressionInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DefineExpression request. Contains the status of the newly-configured
 // expression.
 type DefineExpressionOutput struct {
@@ -1063,6 +1448,16 @@ type metadataDefineExpressionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineExpressionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineExpressionOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DefineIndexField operation. Specifies
 // the name of the domain you want to update and the index field configuration.
 type DefineIndexFieldInput struct {
@@ -1070,7 +1465,7 @@ type DefineIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The index field and field options you want to configure.
 	IndexField *IndexField `type:"structure" required:"true"`
@@ -1082,6 +1477,16 @@ type metadataDefineIndexFieldInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineIndexFieldInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineIndexFieldInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DefineIndexField request. Contains the status of the newly-configured
 // index field.
 type DefineIndexFieldOutput struct {
@@ -1095,6 +1500,16 @@ type metadataDefineIndexFieldOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineIndexFieldOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineIndexFieldOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DefineSuggester operation. Specifies
 // the name of the domain you want to update and the suggester configuration.
 type DefineSuggesterInput struct {
@@ -1102,7 +1517,7 @@ type DefineSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Configuration information for a search suggester. Each suggester has a unique
 	// name and specifies the text field you want to use for suggestions. The following
@@ -1116,6 +1531,16 @@ type metadataDefineSuggesterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineSuggesterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineSuggesterInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DefineSuggester request. Contains the status of the newly-configured
 // suggester.
 type DefineSuggesterOutput struct {
@@ -1129,18 +1554,28 @@ type metadataDefineSuggesterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefineSuggesterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefineSuggesterOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteAnalysisScheme operation. Specifies
 // the name of the domain you want to update and the analysis scheme you want
 // to delete.
 type DeleteAnalysisSchemeInput struct {
 	// The name of the analysis scheme you want to delete.
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDeleteAnalysisSchemeInput `json:"-" xml:"-"`
 }
@@ -1149,6 +1584,16 @@ type metadataDeleteAnalysisSchemeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAnalysisSchemeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAnalysisSchemeInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DeleteAnalysisScheme request. Contains the status of the
 // deleted analysis scheme.
 type DeleteAnalysisSchemeOutput struct {
@@ -1162,11 +1607,21 @@ type metadataDeleteAnalysisSchemeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAnalysisSchemeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAnalysisSchemeOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteDomain operation. Specifies the
 // name of the domain you want to delete.
 type DeleteDomainInput struct {
 	// The name of the domain you want to permanently delete.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDeleteDomainInput `json:"-" xml:"-"`
 }
@@ -1175,6 +1630,16 @@ type metadataDeleteDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDomainInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DeleteDomain request. Contains the status of a newly deleted
 // domain, or no status if the domain has already been completely deleted.
 type DeleteDomainOutput struct {
@@ -1188,6 +1653,16 @@ type metadataDeleteDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDomainOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteExpression operation. Specifies
 // the name of the domain you want to update and the name of the expression
 // you want to delete.
@@ -1196,10 +1671,10 @@ type DeleteExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The name of the Expression to delete.
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDeleteExpressionInput `json:"-" xml:"-"`
 }
@@ -1208,6 +1683,16 @@ type metadataDeleteExpressionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteExpressionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteExpressionInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DeleteExpression request. Specifies the expression being
 // deleted.
 type DeleteExpressionOutput struct {
@@ -1221,6 +1706,16 @@ type metadataDeleteExpressionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteExpressionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteExpressionOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteIndexField operation. Specifies
 // the name of the domain you want to update and the name of the index field
 // you want to delete.
@@ -1229,11 +1724,11 @@ type DeleteIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The name of the index field your want to remove from the domain's indexing
 	// options.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" pattern:"([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)" required:"true"`
 
 	metadataDeleteIndexFieldInput `json:"-" xml:"-"`
 }
@@ -1242,6 +1737,16 @@ type metadataDeleteIndexFieldInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIndexFieldInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIndexFieldInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DeleteIndexField request.
 type DeleteIndexFieldOutput struct {
 	// The status of the index field being deleted.
@@ -1254,6 +1759,16 @@ type metadataDeleteIndexFieldOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIndexFieldOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIndexFieldOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteSuggester operation. Specifies
 // the name of the domain you want to update and name of the suggester you want
 // to delete.
@@ -1262,10 +1777,10 @@ type DeleteSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Specifies the name of the suggester you want to delete.
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDeleteSuggesterInput `json:"-" xml:"-"`
 }
@@ -1274,6 +1789,16 @@ type metadataDeleteSuggesterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSuggesterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSuggesterInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DeleteSuggester request. Contains the status of the deleted
 // suggester.
 type DeleteSuggesterOutput struct {
@@ -1287,6 +1812,16 @@ type metadataDeleteSuggesterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSuggesterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSuggesterOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeAnalysisSchemes operation. Specifies
 // the name of the domain you want to describe. To limit the response to particular
 // analysis schemes, specify the names of the analysis schemes you want to describe.
@@ -1301,7 +1836,7 @@ type DescribeAnalysisSchemesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeAnalysisSchemesInput `json:"-" xml:"-"`
 }
@@ -1310,6 +1845,16 @@ type metadataDescribeAnalysisSchemesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAnalysisSchemesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAnalysisSchemesInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeAnalysisSchemes request. Contains the analysis schemes
 // configured for the domain specified in the request.
 type DescribeAnalysisSchemesOutput struct {
@@ -1323,6 +1868,16 @@ type metadataDescribeAnalysisSchemesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAnalysisSchemesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAnalysisSchemesOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeAvailabilityOptions operation.
 // Specifies the name of the domain you want to describe. To show the active
 // configuration and exclude any pending changes, set the Deployed option to
@@ -1333,7 +1888,7 @@ type DescribeAvailabilityOptionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeAvailabilityOptionsInput `json:"-" xml:"-"`
 }
@@ -1342,6 +1897,16 @@ type metadataDescribeAvailabilityOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAvailabilityOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAvailabilityOptionsInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeAvailabilityOptions request. Indicates whether or
 // not the Multi-AZ option is enabled for the domain specified in the request.
 type DescribeAvailabilityOptionsOutput struct {
@@ -1356,6 +1921,16 @@ type metadataDescribeAvailabilityOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAvailabilityOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAvailabilityOptionsOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeDomains operation. By default
 // shows the status of all domains. To restrict the response to particular domains,
 // specify the names of the domains you want to describe.
@@ -1370,6 +1945,16 @@ type metadataDescribeDomainsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDomainsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDomainsInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeDomains request. Contains the status of the domains
 // specified in the request or all domains owned by the account.
 type DescribeDomainsOutput struct {
@@ -1383,6 +1968,16 @@ type metadataDescribeDomainsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDomainsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDomainsOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeDomains operation. Specifies
 // the name of the domain you want to describe. To restrict the response to
 // particular expressions, specify the names of the expressions you want to
@@ -1394,7 +1989,7 @@ type DescribeExpressionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Limits the DescribeExpressions response to the specified expressions. If
 	// not specified, all expressions are shown.
@@ -1407,6 +2002,16 @@ type metadataDescribeExpressionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeExpressionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeExpressionsInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeExpressions request. Contains the expressions configured
 // for the domain specified in the request.
 type DescribeExpressionsOutput struct {
@@ -1420,6 +2025,16 @@ type metadataDescribeExpressionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeExpressionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeExpressionsOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeIndexFields operation. Specifies
 // the name of the domain you want to describe. To restrict the response to
 // particular index fields, specify the names of the index fields you want to
@@ -1431,7 +2046,7 @@ type DescribeIndexFieldsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// A list of the index fields you want to describe. If not specified, information
 	// is returned for all configured index fields.
@@ -1444,6 +2059,16 @@ type metadataDescribeIndexFieldsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIndexFieldsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIndexFieldsInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeIndexFields request. Contains the index fields configured
 // for the domain specified in the request.
 type DescribeIndexFieldsOutput struct {
@@ -1457,6 +2082,16 @@ type metadataDescribeIndexFieldsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIndexFieldsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIndexFieldsOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeScalingParameters operation.
 // Specifies the name of the domain you want to describe.
 type DescribeScalingParametersInput struct {
@@ -1464,7 +2099,7 @@ type DescribeScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeScalingParametersInput `json:"-" xml:"-"`
 }
@@ -1473,6 +2108,16 @@ type metadataDescribeScalingParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScalingParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScalingParametersInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeScalingParameters request. Contains the scaling parameters
 // configured for the domain specified in the request.
 type DescribeScalingParametersOutput struct {
@@ -1486,6 +2131,16 @@ type metadataDescribeScalingParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeScalingParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeScalingParametersOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeServiceAccessPolicies operation.
 // Specifies the name of the domain you want to describe. To show the active
 // configuration and exclude any pending changes, set the Deployed option to
@@ -1496,7 +2151,7 @@ type DescribeServiceAccessPoliciesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeServiceAccessPoliciesInput `json:"-" xml:"-"`
 }
@@ -1505,6 +2160,16 @@ type metadataDescribeServiceAccessPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServiceAccessPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServiceAccessPoliciesInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeServiceAccessPolicies request.
 type DescribeServiceAccessPoliciesOutput struct {
 	// The access rules configured for the domain specified in the request.
@@ -1517,6 +2182,16 @@ type metadataDescribeServiceAccessPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServiceAccessPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServiceAccessPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeSuggester operation. Specifies
 // the name of the domain you want to describe. To restrict the response to
 // particular suggesters, specify the names of the suggesters you want to describe.
@@ -1528,7 +2203,7 @@ type DescribeSuggestersInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The suggesters you want to describe.
 	SuggesterNames []*string `type:"list"`
@@ -1540,6 +2215,16 @@ type metadataDescribeSuggestersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSuggestersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSuggestersInput) GoString() string {
+	return s.String()
+}
+
 // The result of a DescribeSuggesters request.
 type DescribeSuggestersOutput struct {
 	// The suggesters configured for the domain specified in the request.
@@ -1552,6 +2237,16 @@ type metadataDescribeSuggestersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSuggestersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSuggestersOutput) GoString() string {
+	return s.String()
+}
+
 // Options for a search suggester.
 type DocumentSuggesterOptions struct {
 	// The level of fuzziness allowed when suggesting matches for a string: none,
@@ -1559,7 +2254,9 @@ type DocumentSuggesterOptions struct {
 	// With low, suggestions must differ from the specified string by no more than
 	// one character. With high, suggestions can differ by up to two characters.
 	// The default is none.
-	FuzzyMatching *string `type:"string"`
+	//
+	// See the SuggesterFuzzyMatching* constants for valid values.
+	FuzzyMatching *string `type:"string" enum:"none,low,high"`
 
 	// An expression that computes a score for each suggestion to control how they
 	// are sorted. The scores are rounded to the nearest integer, with a floor of
@@ -1571,7 +2268,7 @@ type DocumentSuggesterOptions struct {
 	SortExpression *string `type:"string"`
 
 	// The name of the index field you want to use for suggestions.
-	SourceField *string `type:"string" required:"true"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDocumentSuggesterOptions `json:"-" xml:"-"`
 }
@@ -1580,6 +2277,16 @@ type metadataDocumentSuggesterOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DocumentSuggesterOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DocumentSuggesterOptions) GoString() string {
+	return s.String()
+}
+
 // The current status of the search domain.
 type DomainStatus struct {
 	// The Amazon Resource Name (ARN) of the search domain. See Identifiers for
@@ -1603,13 +2310,13 @@ type DomainStatus struct {
 	DocService *ServiceEndpoint `type:"structure"`
 
 	// An internally generated unique identifier for a domain.
-	DomainID *string `locationName:"DomainId" type:"string" required:"true"`
+	DomainID *string `locationName:"DomainId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	Limits *Limits `type:"structure"`
 
@@ -1621,13 +2328,13 @@ type DomainStatus struct {
 	RequiresIndexDocuments *bool `type:"boolean" required:"true"`
 
 	// The number of search instances that are available to process search requests.
-	SearchInstanceCount *int64 `type:"integer"`
+	SearchInstanceCount *int64 `type:"integer" min:"1"`
 
 	// The instance type that is being used to process search requests.
 	SearchInstanceType *string `type:"string"`
 
 	// The number of partitions across which the search index is spread.
-	SearchPartitionCount *int64 `type:"integer"`
+	SearchPartitionCount *int64 `type:"integer" min:"1"`
 
 	// The service endpoint for requesting search results from a search domain.
 	SearchService *ServiceEndpoint `type:"structure"`
@@ -1639,6 +2346,16 @@ type metadataDomainStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DomainStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DomainStatus) GoString() string {
+	return s.String()
+}
+
 // Options for a field that contains an array of double-precision 64-bit floating
 // point values. Present if IndexFieldType specifies the field is of type double-array.
 // All options are enabled by default.
@@ -1656,7 +2373,7 @@ type DoubleArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataDoubleArrayOptions `json:"-" xml:"-"`
 }
@@ -1665,6 +2382,16 @@ type metadataDoubleArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DoubleArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DoubleArrayOptions) GoString() string {
+	return s.String()
+}
+
 // Options for a double-precision 64-bit floating point field. Present if IndexFieldType
 // specifies the field is of type double. All options are enabled by default.
 type DoubleOptions struct {
@@ -1686,7 +2413,7 @@ type DoubleOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataDoubleOptions `json:"-" xml:"-"`
 }
@@ -1695,19 +2422,29 @@ type metadataDoubleOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DoubleOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DoubleOptions) GoString() string {
+	return s.String()
+}
+
 // A named expression that can be evaluated at search time. Can be used to sort
 // the search results, define other expressions, or return computed information
 // in the search results.
 type Expression struct {
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	// The expression to evaluate for sorting while processing a search request.
 	// The Expression syntax is based on JavaScript expressions. For more information,
 	// see Configuring Expressions (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/configuring-expressions.html"
 	// target="_blank) in the Amazon CloudSearch Developer Guide.
-	ExpressionValue *string `type:"string" required:"true"`
+	ExpressionValue *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataExpression `json:"-" xml:"-"`
 }
@@ -1716,6 +2453,16 @@ type metadataExpression struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Expression) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Expression) GoString() string {
+	return s.String()
+}
+
 // The value of an Expression and its current status.
 type ExpressionStatus struct {
 	// The expression that is evaluated for sorting while processing a search request.
@@ -1731,6 +2478,16 @@ type metadataExpressionStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExpressionStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExpressionStatus) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the IndexDocuments operation. Specifies the
 // name of the domain you want to re-index.
 type IndexDocumentsInput struct {
@@ -1738,7 +2495,7 @@ type IndexDocumentsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataIndexDocumentsInput `json:"-" xml:"-"`
 }
@@ -1747,6 +2504,16 @@ type metadataIndexDocumentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IndexDocumentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IndexDocumentsInput) GoString() string {
+	return s.String()
+}
+
 // The result of an IndexDocuments request. Contains the status of the indexing
 // operation, including the fields being indexed.
 type IndexDocumentsOutput struct {
@@ -1760,6 +2527,16 @@ type metadataIndexDocumentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IndexDocumentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IndexDocumentsOutput) GoString() string {
+	return s.String()
+}
+
 // Configuration information for a field in the index, including its name, type,
 // and options. The supported options depend on the IndexFieldType.
 type IndexField struct {
@@ -1796,13 +2573,15 @@ type IndexField struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" pattern:"([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)" required:"true"`
 
 	// The type of field. The valid options for a field depend on the field type.
 	// For more information about the supported field types, see Configuring Index
 	// Fields (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/configuring-index-fields.html"
 	// target="_blank) in the Amazon CloudSearch Developer Guide.
-	IndexFieldType *string `type:"string" required:"true"`
+	//
+	// See the IndexFieldType* constants for valid values.
+	IndexFieldType *string `type:"string" enum:"int,double,literal,text,date,latlon,int-array,double-array,literal-array,text-array,date-array" required:"true"`
 
 	// Options for a field that contains an array of 64-bit signed integers. Present
 	// if IndexFieldType specifies the field is of type int-array. All options are
@@ -1844,6 +2623,16 @@ type metadataIndexField struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IndexField) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IndexField) GoString() string {
+	return s.String()
+}
+
 // The value of an IndexField and its current status.
 type IndexFieldStatus struct {
 	// Configuration information for a field in the index, including its name, type,
@@ -1860,6 +2649,16 @@ type metadataIndexFieldStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IndexFieldStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IndexFieldStatus) GoString() string {
+	return s.String()
+}
+
 // Options for a field that contains an array of 64-bit signed integers. Present
 // if IndexFieldType specifies the field is of type int-array. All options are
 // enabled by default.
@@ -1877,7 +2676,7 @@ type IntArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataIntArrayOptions `json:"-" xml:"-"`
 }
@@ -1886,6 +2685,16 @@ type metadataIntArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IntArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IntArrayOptions) GoString() string {
+	return s.String()
+}
+
 // Options for a 64-bit signed integer field. Present if IndexFieldType specifies
 // the field is of type int. All options are enabled by default.
 type IntOptions struct {
@@ -1907,7 +2716,7 @@ type IntOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataIntOptions `json:"-" xml:"-"`
 }
@@ -1916,12 +2725,22 @@ type metadataIntOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IntOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IntOptions) GoString() string {
+	return s.String()
+}
+
 // Options for a latlon field. A latlon field contains a location stored as
 // a latitude and longitude value pair. Present if IndexFieldType specifies
 // the field is of type latlon. All options are enabled by default.
 type LatLonOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -1949,7 +2768,7 @@ type LatLonOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataLatLonOptions `json:"-" xml:"-"`
 }
@@ -1958,10 +2777,20 @@ type metadataLatLonOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LatLonOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LatLonOptions) GoString() string {
+	return s.String()
+}
+
 type Limits struct {
-	MaximumPartitionCount *int64 `type:"integer" required:"true"`
+	MaximumPartitionCount *int64 `type:"integer" min:"1" required:"true"`
 
-	MaximumReplicationCount *int64 `type:"integer" required:"true"`
+	MaximumReplicationCount *int64 `type:"integer" min:"1" required:"true"`
 
 	metadataLimits `json:"-" xml:"-"`
 }
@@ -1970,6 +2799,16 @@ type metadataLimits struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Limits) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Limits) GoString() string {
+	return s.String()
+}
+
 type ListDomainNamesInput struct {
 	metadataListDomainNamesInput `json:"-" xml:"-"`
 }
@@ -1978,6 +2817,16 @@ type metadataListDomainNamesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDomainNamesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDomainNamesInput) GoString() string {
+	return s.String()
+}
+
 // The result of a ListDomainNames request. Contains a list of the domains owned
 // by an account.
 type ListDomainNamesOutput struct {
@@ -1991,12 +2840,22 @@ type metadataListDomainNamesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDomainNamesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDomainNamesOutput) GoString() string {
+	return s.String()
+}
+
 // Options for a field that contains an array of literal strings. Present if
 // IndexFieldType specifies the field is of type literal-array. All options
 // are enabled by default.
 type LiteralArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2008,7 +2867,7 @@ type LiteralArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataLiteralArrayOptions `json:"-" xml:"-"`
 }
@@ -2017,11 +2876,21 @@ type metadataLiteralArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LiteralArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LiteralArrayOptions) GoString() string {
+	return s.String()
+}
+
 // Options for literal field. Present if IndexFieldType specifies the field
 // is of type literal. All options are enabled by default.
 type LiteralOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2049,7 +2918,7 @@ type LiteralOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataLiteralOptions `json:"-" xml:"-"`
 }
@@ -2058,6 +2927,16 @@ type metadataLiteralOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LiteralOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LiteralOptions) GoString() string {
+	return s.String()
+}
+
 // The status of domain configuration option.
 type OptionStatus struct {
 	// A timestamp for when this option was created.
@@ -2075,13 +2954,15 @@ type OptionStatus struct {
 	// option value is not compatible with the domain's data and cannot be used
 	// to index the data. You must either modify the option value or update or remove
 	// the incompatible documents.
-	State *string `type:"string" required:"true"`
+	//
+	// See the OptionState* constants for valid values.
+	State *string `type:"string" enum:"RequiresIndexDocuments,Processing,Active,FailedToValidate" required:"true"`
 
 	// A timestamp for when this option was last updated.
 	UpdateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// A unique integer that indicates when this option was last updated.
-	UpdateVersion *int64 `type:"integer"`
+	UpdateVersion *int64 `type:"integer" min:"0"`
 
 	metadataOptionStatus `json:"-" xml:"-"`
 }
@@ -2090,18 +2971,30 @@ type metadataOptionStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OptionStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OptionStatus) GoString() string {
+	return s.String()
+}
+
 // The desired instance type and desired number of replicas of each index partition.
 type ScalingParameters struct {
 	// The instance type that you want to preconfigure for your domain. For example,
 	// search.m1.small.
-	DesiredInstanceType *string `type:"string"`
+	//
+	// See the PartitionInstanceType* constants for valid values.
+	DesiredInstanceType *string `type:"string" enum:"search.m1.small,search.m1.large,search.m2.xlarge,search.m2.2xlarge,search.m3.medium,search.m3.large,search.m3.xlarge,search.m3.2xlarge"`
 
 	// The number of partitions you want to preconfigure for your domain. Only valid
 	// when you select m2.2xlarge as the desired instance type.
-	DesiredPartitionCount *int64 `type:"integer"`
+	DesiredPartitionCount *int64 `type:"integer" min:"0"`
 
 	// The number of replicas you want to preconfigure for each index partition.
-	DesiredReplicationCount *int64 `type:"integer"`
+	DesiredReplicationCount *int64 `type:"integer" min:"0"`
 
 	metadataScalingParameters `json:"-" xml:"-"`
 }
@@ -2110,6 +3003,16 @@ type metadataScalingParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScalingParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScalingParameters) GoString() string {
+	return s.String()
+}
+
 // The status and configuration of a search domain's scaling parameters.
 type ScalingParametersStatus struct {
 	// The desired instance type and desired number of replicas of each index partition.
@@ -2125,6 +3028,16 @@ type metadataScalingParametersStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScalingParametersStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScalingParametersStatus) GoString() string {
+	return s.String()
+}
+
 // The endpoint to which service requests can be submitted.
 type ServiceEndpoint struct {
 	// The endpoint to which service requests can be submitted. For example, search-imdb-movies-oopcnjfn6ugofer3zx5iadxxca.eu-west-1.cloudsearch.amazonaws.com
@@ -2138,6 +3051,16 @@ type metadataServiceEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ServiceEndpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ServiceEndpoint) GoString() string {
+	return s.String()
+}
+
 // Configuration information for a search suggester. Each suggester has a unique
 // name and specifies the text field you want to use for suggestions. The following
 // options can be configured for a suggester: FuzzyMatching, SortExpression.
@@ -2147,7 +3070,7 @@ type Suggester struct {
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataSuggester `json:"-" xml:"-"`
 }
@@ -2156,6 +3079,16 @@ type metadataSuggester struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Suggester) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Suggester) GoString() string {
+	return s.String()
+}
+
 // The value of a Suggester and its current status.
 type SuggesterStatus struct {
 	// Configuration information for a search suggester. Each suggester has a unique
@@ -2173,15 +3106,25 @@ type metadataSuggesterStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuggesterStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuggesterStatus) GoString() string {
+	return s.String()
+}
+
 // Options for a field that contains an array of text strings. Present if IndexFieldType
 // specifies the field is of type text-array. A text-array field is always searchable.
 // All options are enabled by default.
 type TextArrayOptions struct {
 	// The name of an analysis scheme for a text-array field.
-	AnalysisScheme *string `type:"string"`
+	AnalysisScheme *string `type:"string" pattern:"[\\S]+"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2190,7 +3133,7 @@ type TextArrayOptions struct {
 	ReturnEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataTextArrayOptions `json:"-" xml:"-"`
 }
@@ -2199,15 +3142,25 @@ type metadataTextArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TextArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TextArrayOptions) GoString() string {
+	return s.String()
+}
+
 // Options for text field. Present if IndexFieldType specifies the field is
 // of type text. A text field is always searchable. All options are enabled
 // by default.
 type TextOptions struct {
 	// The name of an analysis scheme for a text field.
-	AnalysisScheme *string `type:"string"`
+	AnalysisScheme *string `type:"string" pattern:"[\\S]+"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" min:"0" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2232,7 +3185,7 @@ type TextOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataTextOptions `json:"-" xml:"-"`
 }
@@ -2241,6 +3194,16 @@ type metadataTextOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TextOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TextOptions) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the UpdateAvailabilityOptions operation.
 // Specifies the name of the domain you want to update and the Multi-AZ availability
 // option.
@@ -2249,7 +3212,7 @@ type UpdateAvailabilityOptionsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// You expand an existing search domain to a second Availability Zone by setting
 	// the Multi-AZ option to true. Similarly, you can turn off the Multi-AZ option
@@ -2264,6 +3227,16 @@ type metadataUpdateAvailabilityOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAvailabilityOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAvailabilityOptionsInput) GoString() string {
+	return s.String()
+}
+
 // The result of a UpdateAvailabilityOptions request. Contains the status of
 // the domain's availability options.
 type UpdateAvailabilityOptionsOutput struct {
@@ -2278,6 +3251,16 @@ type metadataUpdateAvailabilityOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAvailabilityOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAvailabilityOptionsOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the UpdateScalingParameters operation. Specifies
 // the name of the domain you want to update and the scaling parameters you
 // want to configure.
@@ -2286,7 +3269,7 @@ type UpdateScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The desired instance type and desired number of replicas of each index partition.
 	ScalingParameters *ScalingParameters `type:"structure" required:"true"`
@@ -2298,6 +3281,16 @@ type metadataUpdateScalingParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateScalingParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateScalingParametersInput) GoString() string {
+	return s.String()
+}
+
 // The result of a UpdateScalingParameters request. Contains the status of the
 // newly-configured scaling parameters.
 type UpdateScalingParametersOutput struct {
@@ -2311,6 +3304,16 @@ type metadataUpdateScalingParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateScalingParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateScalingParametersOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the UpdateServiceAccessPolicies operation.
 // Specifies the name of the domain you want to update and the access rules
 // you want to configure.
@@ -2323,7 +3326,7 @@ type UpdateServiceAccessPoliciesInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataUpdateServiceAccessPoliciesInput `json:"-" xml:"-"`
 }
@@ -2332,6 +3335,16 @@ type metadataUpdateServiceAccessPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateServiceAccessPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateServiceAccessPoliciesInput) GoString() string {
+	return s.String()
+}
+
 // The result of an UpdateServiceAccessPolicies request. Contains the new access
 // policies.
 type UpdateServiceAccessPoliciesOutput struct {
@@ -2344,3 +3357,161 @@ type UpdateServiceAccessPoliciesOutput struct {
 type metadataUpdateServiceAccessPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateServiceAccessPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateServiceAccessPoliciesOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum AlgorithmicStemming
+	AlgorithmicStemmingNone = "none"
+	// @enum AlgorithmicStemming
+	AlgorithmicStemmingMinimal = "minimal"
+	// @enum AlgorithmicStemming
+	AlgorithmicStemmingLight = "light"
+	// @enum AlgorithmicStemming
+	AlgorithmicStemmingFull = "full"
+)
+
+const (
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageAr = "ar"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageBg = "bg"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageCa = "ca"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageCs = "cs"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageDa = "da"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageDe = "de"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageEl = "el"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageEn = "en"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageEs = "es"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageEu = "eu"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageFa = "fa"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageFi = "fi"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageFr = "fr"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageGa = "ga"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageGl = "gl"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageHe = "he"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageHi = "hi"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageHu = "hu"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageHy = "hy"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageId = "id"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageIt = "it"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageJa = "ja"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageKo = "ko"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageLv = "lv"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageMul = "mul"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageNl = "nl"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageNo = "no"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguagePt = "pt"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageRo = "ro"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageRu = "ru"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageSv = "sv"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageTh = "th"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageTr = "tr"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageZhHans = "zh-Hans"
+	// @enum AnalysisSchemeLanguage
+	AnalysisSchemeLanguageZhHant = "zh-Hant"
+)
+
+const (
+	// @enum IndexFieldType
+	IndexFieldTypeInt = "int"
+	// @enum IndexFieldType
+	IndexFieldTypeDouble = "double"
+	// @enum IndexFieldType
+	IndexFieldTypeLiteral = "literal"
+	// @enum IndexFieldType
+	IndexFieldTypeText = "text"
+	// @enum IndexFieldType
+	IndexFieldTypeDate = "date"
+	// @enum IndexFieldType
+	IndexFieldTypeLatlon = "latlon"
+	// @enum IndexFieldType
+	IndexFieldTypeIntArray = "int-array"
+	// @enum IndexFieldType
+	IndexFieldTypeDoubleArray = "double-array"
+	// @enum IndexFieldType
+	IndexFieldTypeLiteralArray = "literal-array"
+	// @enum IndexFieldType
+	IndexFieldTypeTextArray = "text-array"
+	// @enum IndexFieldType
+	IndexFieldTypeDateArray = "date-array"
+)
+
+const (
+	// @enum OptionState
+	OptionStateRequiresIndexDocuments = "RequiresIndexDocuments"
+	// @enum OptionState
+	OptionStateProcessing = "Processing"
+	// @enum OptionState
+	OptionStateActive = "Active"
+	// @enum OptionState
+	OptionStateFailedToValidate = "FailedToValidate"
+)
+
+const (
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM1Small = "search.m1.small"
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM1Large = "search.m1.large"
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM2Xlarge = "search.m2.xlarge"
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM22xlarge = "search.m2.2xlarge"
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM3Medium = "search.m3.medium"
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM3Large = "search.m3.large"
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM3Xlarge = "search.m3.xlarge"
+	// @enum PartitionInstanceType
+	PartitionInstanceTypeSearchM32xlarge = "search.m3.2xlarge"
+)
+
+const (
+	// @enum SuggesterFuzzyMatching
+	SuggesterFuzzyMatchingNone = "none"
+	// @enum SuggesterFuzzyMatching
+	SuggesterFuzzyMatchingLow = "low"
+	// @enum SuggesterFuzzyMatching
+	SuggesterFuzzyMatchingHigh = "high"
+)
@@ -8,7 +8,15 @@ import (
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
 )
 
-// CloudSearch is a client for Amazon CloudSearch.
+// You use the Amazon CloudSearch configuration service to create, configure,
+// and manage search domains. Configuration service requests are submitted using
+// the AWS Query protocol. AWS Query requests are HTTP or HTTPS requests submitted
+// via HTTP GET or POST with a query parameter named Action.
+//
+// The endpoint for configuration service requests is region-specific: cloudsearch.region.amazonaws.com.
+// For example, cloudsearch.us-east-1.amazonaws.com. For a current list of supported
+// regions and endpoints, see Regions and Endpoints (http://docs.aws.amazon.com/general/latest/gr/rande.html#cloudsearch_region"
+// target="_blank).
 type CloudSearch struct {
 	*aws.Service
 }
@@ -29,11 +37,11 @@ func New(config *aws.Config) *CloudSearch {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
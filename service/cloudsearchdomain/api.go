@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opSearch = "Search"
@@ -54,6 +55,16 @@ func (c *CloudSearchDomain) Search(input *SearchInput) (*SearchOutput, error) {
 	return out, err
 }
 
+// SearchWithContext is the same as Search with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearchDomain) SearchWithContext(ctx aws.Context, input *SearchInput) (*SearchOutput, error) {
+	req, out := c.SearchRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSuggest = "Suggest"
 
 // SuggestRequest generates a request for the Suggest operation.
@@ -97,6 +108,16 @@ func (c *CloudSearchDomain) Suggest(input *SuggestInput) (*SuggestOutput, error)
 	return out, err
 }
 
+// SuggestWithContext is the same as Suggest with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearchDomain) SuggestWithContext(ctx aws.Context, input *SuggestInput) (*SuggestOutput, error) {
+	req, out := c.SuggestRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadDocuments = "UploadDocuments"
 
 // UploadDocumentsRequest generates a request for the UploadDocuments operation.
@@ -145,6 +166,16 @@ func (c *CloudSearchDomain) UploadDocuments(input *UploadDocumentsInput) (*Uploa
 	return out, err
 }
 
+// UploadDocumentsWithContext is the same as UploadDocuments with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudSearchDomain) UploadDocumentsWithContext(ctx aws.Context, input *UploadDocumentsInput) (*UploadDocumentsOutput, error) {
+	req, out := c.UploadDocumentsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // A container for facet information.
 type Bucket struct {
 	// The number of hits that contain the facet value in the specified facet field.
@@ -160,6 +191,16 @@ type metadataBucket struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Bucket) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Bucket) GoString() string {
+	return s.String()
+}
+
 // A container for the calculated facet values and counts.
 type BucketInfo struct {
 	// A list of the calculated facet values and counts.
@@ -172,6 +213,16 @@ type metadataBucketInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BucketInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BucketInfo) GoString() string {
+	return s.String()
+}
+
 // A warning returned by the document service when an issue is discovered while
 // processing an upload request.
 type DocumentServiceWarning struct {
@@ -185,6 +236,16 @@ type metadataDocumentServiceWarning struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DocumentServiceWarning) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DocumentServiceWarning) GoString() string {
+	return s.String()
+}
+
 // Information about a document that matches the search request.
 type Hit struct {
 	// The expressions returned from a document that matches the search request.
@@ -206,6 +267,16 @@ type metadataHit struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Hit) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Hit) GoString() string {
+	return s.String()
+}
+
 // The collection of documents that match the search request.
 type Hits struct {
 	// A cursor that can be used to retrieve the next set of matching documents
@@ -228,6 +299,16 @@ type metadataHits struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Hits) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Hits) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the Search request.
 type SearchInput struct {
 	// Retrieves a cursor value you can use to page through large result sets. Use
@@ -474,7 +555,9 @@ type SearchInput struct {
 	//   dismax: search using the simplified subset of the Apache Lucene query parser
 	// syntax defined by the DisMax query parser. For more information, see DisMax
 	// Query Parser Syntax (http://wiki.apache.org/solr/DisMaxQParserPlugin#Query_Syntax).
-	QueryParser *string `location:"querystring" locationName:"q.parser" type:"string"`
+	//
+	// See the QueryParser* constants for valid values.
+	QueryParser *string `location:"querystring" locationName:"q.parser" type:"string" enum:"simple,structured,lucene,dismax"`
 
 	// Specifies the field and expression values to include in the response. Multiple
 	// fields or expressions are specified as a comma-separated list. By default,
@@ -514,6 +597,16 @@ type metadataSearchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SearchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SearchInput) GoString() string {
+	return s.String()
+}
+
 // The result of a Search request. Contains the documents that match the specified
 // search criteria and any requested fields, highlights, and facet information.
 type SearchOutput struct {
@@ -533,6 +626,16 @@ type metadataSearchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SearchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SearchOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the resource id (rid) and the time it took to process the request
 // (timems).
 type SearchStatus struct {
@@ -549,6 +652,16 @@ type metadataSearchStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SearchStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SearchStatus) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the Suggest request.
 type SuggestInput struct {
 	// Specifies the string for which you want to get suggestions.
@@ -567,6 +680,16 @@ type metadataSuggestInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuggestInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuggestInput) GoString() string {
+	return s.String()
+}
+
 // Container for the suggestion information returned in a SuggestResponse.
 type SuggestModel struct {
 	// The number of documents that were found to match the query string.
@@ -585,6 +708,16 @@ type metadataSuggestModel struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuggestModel) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuggestModel) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a Suggest request.
 type SuggestOutput struct {
 	// The status of a SuggestRequest. Contains the resource ID (rid) and how long
@@ -601,6 +734,16 @@ type metadataSuggestOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuggestOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuggestOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the resource id (rid) and the time it took to process the request
 // (timems).
 type SuggestStatus struct {
@@ -617,6 +760,16 @@ type metadataSuggestStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuggestStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuggestStatus) GoString() string {
+	return s.String()
+}
+
 // An autocomplete suggestion that matches the query string specified in a SuggestRequest.
 type SuggestionMatch struct {
 	// The document ID of the suggested document.
@@ -635,13 +788,25 @@ type metadataSuggestionMatch struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SuggestionMatch) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SuggestionMatch) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the UploadDocuments request.
 type UploadDocumentsInput struct {
 	// The format of the batch you are uploading. Amazon CloudSearch supports two
 	// document batch formats:
 	//
 	//  application/json application/xml
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string" required:"true"`
+	//
+	// See the ContentType* constants for valid values.
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string" enum:"application/json,application/xml" required:"true"`
 
 	// A batch of documents formatted in JSON or HTML.
 	Documents io.ReadSeeker `locationName:"documents" type:"blob" required:"true"`
@@ -653,6 +818,16 @@ type metadataUploadDocumentsInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Documents"`
 }
 
+// String returns the string representation
+func (s UploadDocumentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadDocumentsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to an UploadDocuments request.
 type UploadDocumentsOutput struct {
 	// The number of documents that were added to the search domain.
@@ -673,3 +848,31 @@ type UploadDocumentsOutput struct {
 type metadataUploadDocumentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UploadDocumentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadDocumentsOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ContentType
+	ContentTypeApplicationJson = "application/json"
+	// @enum ContentType
+	ContentTypeApplicationXml = "application/xml"
+)
+
+const (
+	// @enum QueryParser
+	QueryParserSimple = "simple"
+	// @enum QueryParser
+	QueryParserStructured = "structured"
+	// @enum QueryParser
+	QueryParserLucene = "lucene"
+	// @enum QueryParser
+	QueryParserDismax = "dismax"
+)
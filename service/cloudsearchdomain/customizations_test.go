@@ -10,8 +10,8 @@ import (
 
 func TestRequireEndpointIfRegionProvided(t *testing.T) {
 	svc := cloudsearchdomain.New(&aws.Config{
-		Region:                 "mock-region",
-		DisableParamValidation: true,
+		Region:                 aws.String("mock-region"),
+		DisableParamValidation: aws.Boolean(true),
 	})
 	req, _ := svc.SearchRequest(nil)
 	err := req.Build()
@@ -23,8 +23,8 @@ func TestRequireEndpointIfRegionProvided(t *testing.T) {
 
 func TestRequireEndpointIfNoRegionProvided(t *testing.T) {
 	svc := cloudsearchdomain.New(&aws.Config{
-		Region:                 "",
-		DisableParamValidation: true,
+		Region:                 aws.String(""),
+		DisableParamValidation: aws.Boolean(true),
 	})
 	req, _ := svc.SearchRequest(nil)
 	err := req.Build()
@@ -36,9 +36,9 @@ func TestRequireEndpointIfNoRegionProvided(t *testing.T) {
 
 func TestRequireEndpointUsed(t *testing.T) {
 	svc := cloudsearchdomain.New(&aws.Config{
-		Region:                 "mock-region",
-		DisableParamValidation: true,
-		Endpoint:               "https://endpoint",
+		Region:                 aws.String("mock-region"),
+		DisableParamValidation: aws.Boolean(true),
+		Endpoint:               aws.String("https://endpoint"),
 	})
 	req, _ := svc.SearchRequest(nil)
 	err := req.Build()
@@ -39,11 +39,11 @@ func New(config *aws.Config) *CloudSearchDomain {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restjson.Build)
-	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restjson.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateTrail = "CreateTrail"
@@ -39,6 +40,16 @@ func (c *CloudTrail) CreateTrail(input *CreateTrailInput) (*CreateTrailOutput, e
 	return out, err
 }
 
+// CreateTrailWithContext is the same as CreateTrail with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) CreateTrailWithContext(ctx aws.Context, input *CreateTrailInput) (*CreateTrailOutput, error) {
+	req, out := c.CreateTrailRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTrail = "DeleteTrail"
 
 // DeleteTrailRequest generates a request for the DeleteTrail operation.
@@ -66,6 +77,16 @@ func (c *CloudTrail) DeleteTrail(input *DeleteTrailInput) (*DeleteTrailOutput, e
 	return out, err
 }
 
+// DeleteTrailWithContext is the same as DeleteTrail with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) DeleteTrailWithContext(ctx aws.Context, input *DeleteTrailInput) (*DeleteTrailOutput, error) {
+	req, out := c.DeleteTrailRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrails = "DescribeTrails"
 
 // DescribeTrailsRequest generates a request for the DescribeTrails operation.
@@ -94,6 +115,16 @@ func (c *CloudTrail) DescribeTrails(input *DescribeTrailsInput) (*DescribeTrails
 	return out, err
 }
 
+// DescribeTrailsWithContext is the same as DescribeTrails with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) DescribeTrailsWithContext(ctx aws.Context, input *DescribeTrailsInput) (*DescribeTrailsOutput, error) {
+	req, out := c.DescribeTrailsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetTrailStatus = "GetTrailStatus"
 
 // GetTrailStatusRequest generates a request for the GetTrailStatus operation.
@@ -123,6 +154,16 @@ func (c *CloudTrail) GetTrailStatus(input *GetTrailStatusInput) (*GetTrailStatus
 	return out, err
 }
 
+// GetTrailStatusWithContext is the same as GetTrailStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) GetTrailStatusWithContext(ctx aws.Context, input *GetTrailStatusInput) (*GetTrailStatusOutput, error) {
+	req, out := c.GetTrailStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opLookupEvents = "LookupEvents"
 
 // LookupEventsRequest generates a request for the LookupEvents operation.
@@ -163,6 +204,16 @@ func (c *CloudTrail) LookupEvents(input *LookupEventsInput) (*LookupEventsOutput
 	return out, err
 }
 
+// LookupEventsWithContext is the same as LookupEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) LookupEventsWithContext(ctx aws.Context, input *LookupEventsInput) (*LookupEventsOutput, error) {
+	req, out := c.LookupEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStartLogging = "StartLogging"
 
 // StartLoggingRequest generates a request for the StartLogging operation.
@@ -190,6 +241,16 @@ func (c *CloudTrail) StartLogging(input *StartLoggingInput) (*StartLoggingOutput
 	return out, err
 }
 
+// StartLoggingWithContext is the same as StartLogging with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) StartLoggingWithContext(ctx aws.Context, input *StartLoggingInput) (*StartLoggingOutput, error) {
+	req, out := c.StartLoggingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStopLogging = "StopLogging"
 
 // StopLoggingRequest generates a request for the StopLogging operation.
@@ -220,6 +281,16 @@ func (c *CloudTrail) StopLogging(input *StopLoggingInput) (*StopLoggingOutput, e
 	return out, err
 }
 
+// StopLoggingWithContext is the same as StopLogging with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) StopLoggingWithContext(ctx aws.Context, input *StopLoggingInput) (*StopLoggingOutput, error) {
+	req, out := c.StopLoggingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateTrail = "UpdateTrail"
 
 // UpdateTrailRequest generates a request for the UpdateTrail operation.
@@ -252,6 +323,16 @@ func (c *CloudTrail) UpdateTrail(input *UpdateTrailInput) (*UpdateTrailOutput, e
 	return out, err
 }
 
+// UpdateTrailWithContext is the same as UpdateTrail with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudTrail) UpdateTrailWithContext(ctx aws.Context, input *UpdateTrailInput) (*UpdateTrailOutput, error) {
+	req, out := c.UpdateTrailRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Specifies the settings for each trail.
 type CreateTrailInput struct {
 	// Specifies a log group name using an Amazon Resource Name (ARN), a unique
@@ -289,6 +370,16 @@ type metadataCreateTrailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTrailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTrailInput) GoString() string {
+	return s.String()
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type CreateTrailOutput struct {
@@ -326,6 +417,16 @@ type metadataCreateTrailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTrailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTrailOutput) GoString() string {
+	return s.String()
+}
+
 // The request that specifies the name of a trail to delete.
 type DeleteTrailInput struct {
 	// The name of a trail to be deleted.
@@ -338,6 +439,16 @@ type metadataDeleteTrailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTrailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTrailInput) GoString() string {
+	return s.String()
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type DeleteTrailOutput struct {
@@ -348,6 +459,16 @@ type metadataDeleteTrailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTrailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTrailOutput) GoString() string {
+	return s.String()
+}
+
 // Returns information about the trail.
 type DescribeTrailsInput struct {
 	// The trail returned.
@@ -360,6 +481,16 @@ type metadataDescribeTrailsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrailsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrailsInput) GoString() string {
+	return s.String()
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type DescribeTrailsOutput struct {
@@ -373,6 +504,16 @@ type metadataDescribeTrailsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrailsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrailsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about an event that was returned by a lookup request.
 // The result includes a representation of a CloudTrail event.
 type Event struct {
@@ -402,6 +543,16 @@ type metadataEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Event) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Event) GoString() string {
+	return s.String()
+}
+
 // The name of a trail about which you want the current status.
 type GetTrailStatusInput struct {
 	// The name of the trail for which you are requesting the current status.
@@ -414,6 +565,16 @@ type metadataGetTrailStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTrailStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTrailStatusInput) GoString() string {
+	return s.String()
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type GetTrailStatusOutput struct {
@@ -462,10 +623,22 @@ type metadataGetTrailStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTrailStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTrailStatusOutput) GoString() string {
+	return s.String()
+}
+
 // Specifies an attribute and value that filter the events returned.
 type LookupAttribute struct {
 	// Specifies an attribute on which to filter the events returned.
-	AttributeKey *string `type:"string" required:"true"`
+	//
+	// See the LookupAttributeKey* constants for valid values.
+	AttributeKey *string `type:"string" enum:"EventId,EventName,Username,ResourceType,ResourceName" required:"true"`
 
 	// Specifies a value for the specified AttributeKey.
 	AttributeValue *string `type:"string" required:"true"`
@@ -477,6 +650,16 @@ type metadataLookupAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LookupAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LookupAttribute) GoString() string {
+	return s.String()
+}
+
 // Contains a request for LookupEvents.
 type LookupEventsInput struct {
 	// Specifies that only events that occur before or at the specified time are
@@ -490,7 +673,7 @@ type LookupEventsInput struct {
 
 	// The number of events to return. Possible values are 1 through 50. The default
 	// is 10.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"50"`
 
 	// The token to use to get the next page of results after a previous API call.
 	// This token must be passed in with the same parameters that were specified
@@ -511,6 +694,16 @@ type metadataLookupEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LookupEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LookupEventsInput) GoString() string {
+	return s.String()
+}
+
 // Contains a response to a LookupEvents action.
 type LookupEventsOutput struct {
 	// A list of events returned based on the lookup attributes specified and the
@@ -532,6 +725,16 @@ type metadataLookupEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LookupEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LookupEventsOutput) GoString() string {
+	return s.String()
+}
+
 // Specifies the type and name of a resource referenced by an event.
 type Resource struct {
 	// The name of the resource referenced by the event returned. These are user-created
@@ -554,6 +757,16 @@ type metadataResource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Resource) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Resource) GoString() string {
+	return s.String()
+}
+
 // The request to CloudTrail to start logging AWS API calls for an account.
 type StartLoggingInput struct {
 	// The name of the trail for which CloudTrail logs AWS API calls.
@@ -566,6 +779,16 @@ type metadataStartLoggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartLoggingInput) GoString() string {
+	return s.String()
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type StartLoggingOutput struct {
@@ -576,6 +799,16 @@ type metadataStartLoggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartLoggingOutput) GoString() string {
+	return s.String()
+}
+
 // Passes the request to CloudTrail to stop logging AWS API calls for the specified
 // account.
 type StopLoggingInput struct {
@@ -590,6 +823,16 @@ type metadataStopLoggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopLoggingInput) GoString() string {
+	return s.String()
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type StopLoggingOutput struct {
@@ -600,6 +843,16 @@ type metadataStopLoggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopLoggingOutput) GoString() string {
+	return s.String()
+}
+
 // The settings for a trail.
 type Trail struct {
 	// Specifies an Amazon Resource Name (ARN), a unique identifier that represents
@@ -634,6 +887,16 @@ type metadataTrail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Trail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Trail) GoString() string {
+	return s.String()
+}
+
 // Specifies settings to update for the trail.
 type UpdateTrailInput struct {
 	// Specifies a log group name using an Amazon Resource Name (ARN), a unique
@@ -671,6 +934,16 @@ type metadataUpdateTrailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateTrailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateTrailInput) GoString() string {
+	return s.String()
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type UpdateTrailOutput struct {
@@ -707,3 +980,26 @@ type UpdateTrailOutput struct {
 type metadataUpdateTrailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateTrailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateTrailOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum LookupAttributeKey
+	LookupAttributeKeyEventId = "EventId"
+	// @enum LookupAttributeKey
+	LookupAttributeKeyEventName = "EventName"
+	// @enum LookupAttributeKey
+	LookupAttributeKeyUsername = "Username"
+	// @enum LookupAttributeKey
+	LookupAttributeKeyResourceType = "ResourceType"
+	// @enum LookupAttributeKey
+	LookupAttributeKeyResourceName = "ResourceName"
+)
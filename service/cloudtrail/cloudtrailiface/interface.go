@@ -4,6 +4,7 @@
 package cloudtrailiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 )
 
@@ -11,17 +12,33 @@ import (
 type CloudTrailAPI interface {
 	CreateTrail(*cloudtrail.CreateTrailInput) (*cloudtrail.CreateTrailOutput, error)
 
+	CreateTrailWithContext(aws.Context, *cloudtrail.CreateTrailInput) (*cloudtrail.CreateTrailOutput, error)
+
 	DeleteTrail(*cloudtrail.DeleteTrailInput) (*cloudtrail.DeleteTrailOutput, error)
 
+	DeleteTrailWithContext(aws.Context, *cloudtrail.DeleteTrailInput) (*cloudtrail.DeleteTrailOutput, error)
+
 	DescribeTrails(*cloudtrail.DescribeTrailsInput) (*cloudtrail.DescribeTrailsOutput, error)
 
+	DescribeTrailsWithContext(aws.Context, *cloudtrail.DescribeTrailsInput) (*cloudtrail.DescribeTrailsOutput, error)
+
 	GetTrailStatus(*cloudtrail.GetTrailStatusInput) (*cloudtrail.GetTrailStatusOutput, error)
 
+	GetTrailStatusWithContext(aws.Context, *cloudtrail.GetTrailStatusInput) (*cloudtrail.GetTrailStatusOutput, error)
+
 	LookupEvents(*cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error)
 
+	LookupEventsWithContext(aws.Context, *cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error)
+
 	StartLogging(*cloudtrail.StartLoggingInput) (*cloudtrail.StartLoggingOutput, error)
 
+	StartLoggingWithContext(aws.Context, *cloudtrail.StartLoggingInput) (*cloudtrail.StartLoggingOutput, error)
+
 	StopLogging(*cloudtrail.StopLoggingInput) (*cloudtrail.StopLoggingOutput, error)
 
+	StopLoggingWithContext(aws.Context, *cloudtrail.StopLoggingInput) (*cloudtrail.StopLoggingOutput, error)
+
 	UpdateTrail(*cloudtrail.UpdateTrailInput) (*cloudtrail.UpdateTrailOutput, error)
+
+	UpdateTrailWithContext(aws.Context, *cloudtrail.UpdateTrailInput) (*cloudtrail.UpdateTrailOutput, error)
 }
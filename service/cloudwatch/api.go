@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opDeleteAlarms = "DeleteAlarms"
@@ -36,6 +37,16 @@ func (c *CloudWatch) DeleteAlarms(input *DeleteAlarmsInput) (*DeleteAlarmsOutput
 	return out, err
 }
 
+// DeleteAlarmsWithContext is the same as DeleteAlarms with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) DeleteAlarmsWithContext(ctx aws.Context, input *DeleteAlarmsInput) (*DeleteAlarmsOutput, error) {
+	req, out := c.DeleteAlarmsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAlarmHistory = "DescribeAlarmHistory"
 
 // DescribeAlarmHistoryRequest generates a request for the DescribeAlarmHistory operation.
@@ -71,6 +82,16 @@ func (c *CloudWatch) DescribeAlarmHistory(input *DescribeAlarmHistoryInput) (*De
 	return out, err
 }
 
+// DescribeAlarmHistoryWithContext is the same as DescribeAlarmHistory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) DescribeAlarmHistoryWithContext(ctx aws.Context, input *DescribeAlarmHistoryInput) (*DescribeAlarmHistoryOutput, error) {
+	req, out := c.DescribeAlarmHistoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudWatch) DescribeAlarmHistoryPages(input *DescribeAlarmHistoryInput, fn func(p *DescribeAlarmHistoryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAlarmHistoryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -113,6 +134,16 @@ func (c *CloudWatch) DescribeAlarms(input *DescribeAlarmsInput) (*DescribeAlarms
 	return out, err
 }
 
+// DescribeAlarmsWithContext is the same as DescribeAlarms with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) DescribeAlarmsWithContext(ctx aws.Context, input *DescribeAlarmsInput) (*DescribeAlarmsOutput, error) {
+	req, out := c.DescribeAlarmsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudWatch) DescribeAlarmsPages(input *DescribeAlarmsInput, fn func(p *DescribeAlarmsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeAlarmsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -148,6 +179,16 @@ func (c *CloudWatch) DescribeAlarmsForMetric(input *DescribeAlarmsForMetricInput
 	return out, err
 }
 
+// DescribeAlarmsForMetricWithContext is the same as DescribeAlarmsForMetric with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) DescribeAlarmsForMetricWithContext(ctx aws.Context, input *DescribeAlarmsForMetricInput) (*DescribeAlarmsForMetricOutput, error) {
+	req, out := c.DescribeAlarmsForMetricRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableAlarmActions = "DisableAlarmActions"
 
 // DisableAlarmActionsRequest generates a request for the DisableAlarmActions operation.
@@ -176,6 +217,16 @@ func (c *CloudWatch) DisableAlarmActions(input *DisableAlarmActionsInput) (*Disa
 	return out, err
 }
 
+// DisableAlarmActionsWithContext is the same as DisableAlarmActions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) DisableAlarmActionsWithContext(ctx aws.Context, input *DisableAlarmActionsInput) (*DisableAlarmActionsOutput, error) {
+	req, out := c.DisableAlarmActionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableAlarmActions = "EnableAlarmActions"
 
 // EnableAlarmActionsRequest generates a request for the EnableAlarmActions operation.
@@ -203,6 +254,16 @@ func (c *CloudWatch) EnableAlarmActions(input *EnableAlarmActionsInput) (*Enable
 	return out, err
 }
 
+// EnableAlarmActionsWithContext is the same as EnableAlarmActions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) EnableAlarmActionsWithContext(ctx aws.Context, input *EnableAlarmActionsInput) (*EnableAlarmActionsOutput, error) {
+	req, out := c.EnableAlarmActionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetMetricStatistics = "GetMetricStatistics"
 
 // GetMetricStatisticsRequest generates a request for the GetMetricStatistics operation.
@@ -255,6 +316,16 @@ func (c *CloudWatch) GetMetricStatistics(input *GetMetricStatisticsInput) (*GetM
 	return out, err
 }
 
+// GetMetricStatisticsWithContext is the same as GetMetricStatistics with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) GetMetricStatisticsWithContext(ctx aws.Context, input *GetMetricStatisticsInput) (*GetMetricStatisticsOutput, error) {
+	req, out := c.GetMetricStatisticsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListMetrics = "ListMetrics"
 
 // ListMetricsRequest generates a request for the ListMetrics operation.
@@ -290,6 +361,16 @@ func (c *CloudWatch) ListMetrics(input *ListMetricsInput) (*ListMetricsOutput, e
 	return out, err
 }
 
+// ListMetricsWithContext is the same as ListMetrics with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) ListMetricsWithContext(ctx aws.Context, input *ListMetricsInput) (*ListMetricsOutput, error) {
+	req, out := c.ListMetricsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudWatch) ListMetricsPages(input *ListMetricsInput, fn func(p *ListMetricsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListMetricsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -330,6 +411,16 @@ func (c *CloudWatch) PutMetricAlarm(input *PutMetricAlarmInput) (*PutMetricAlarm
 	return out, err
 }
 
+// PutMetricAlarmWithContext is the same as PutMetricAlarm with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) PutMetricAlarmWithContext(ctx aws.Context, input *PutMetricAlarmInput) (*PutMetricAlarmOutput, error) {
+	req, out := c.PutMetricAlarmRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutMetricData = "PutMetricData"
 
 // PutMetricDataRequest generates a request for the PutMetricData operation.
@@ -370,6 +461,16 @@ func (c *CloudWatch) PutMetricData(input *PutMetricDataInput) (*PutMetricDataOut
 	return out, err
 }
 
+// PutMetricDataWithContext is the same as PutMetricData with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) PutMetricDataWithContext(ctx aws.Context, input *PutMetricDataInput) (*PutMetricDataOutput, error) {
+	req, out := c.PutMetricDataRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetAlarmState = "SetAlarmState"
 
 // SetAlarmStateRequest generates a request for the SetAlarmState operation.
@@ -400,21 +501,33 @@ func (c *CloudWatch) SetAlarmState(input *SetAlarmStateInput) (*SetAlarmStateOut
 	return out, err
 }
 
+// SetAlarmStateWithContext is the same as SetAlarmState with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatch) SetAlarmStateWithContext(ctx aws.Context, input *SetAlarmStateInput) (*SetAlarmStateOutput, error) {
+	req, out := c.SetAlarmStateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // The AlarmHistoryItem data type contains descriptive information about the
 // history of a specific alarm. If you call DescribeAlarmHistory, Amazon CloudWatch
 // returns this data type as part of the DescribeAlarmHistoryResult data type.
 type AlarmHistoryItem struct {
 	// The descriptive name for the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// Machine-readable data about the alarm in JSON format.
-	HistoryData *string `type:"string"`
+	HistoryData *string `type:"string" min:"1" max:"4095"`
 
 	// The type of alarm history item.
-	HistoryItemType *string `type:"string"`
+	//
+	// See the HistoryItemType* constants for valid values.
+	HistoryItemType *string `type:"string" enum:"ConfigurationUpdate,StateUpdate,Action"`
 
 	// A human-readable summary of the alarm history.
-	HistorySummary *string `type:"string"`
+	HistorySummary *string `type:"string" min:"1" max:"255"`
 
 	// The time stamp for the alarm history item. Amazon CloudWatch uses Coordinated
 	// Universal Time (UTC) when returning time stamps, which do not accommodate
@@ -430,6 +543,16 @@ type metadataAlarmHistoryItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AlarmHistoryItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AlarmHistoryItem) GoString() string {
+	return s.String()
+}
+
 // The Datapoint data type encapsulates the statistical data that Amazon CloudWatch
 // computes from metric data.
 type Datapoint struct {
@@ -457,7 +580,9 @@ type Datapoint struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The standard unit used for the datapoint.
-	Unit *string `type:"string"`
+	//
+	// See the StandardUnit* constants for valid values.
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataDatapoint `json:"-" xml:"-"`
 }
@@ -466,9 +591,19 @@ type metadataDatapoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Datapoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Datapoint) GoString() string {
+	return s.String()
+}
+
 type DeleteAlarmsInput struct {
 	// A list of alarms to be deleted.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDeleteAlarmsInput `json:"-" xml:"-"`
 }
@@ -477,6 +612,16 @@ type metadataDeleteAlarmsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAlarmsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAlarmsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAlarmsOutput struct {
 	metadataDeleteAlarmsOutput `json:"-" xml:"-"`
 }
@@ -485,18 +630,30 @@ type metadataDeleteAlarmsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAlarmsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAlarmsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAlarmHistoryInput struct {
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The ending date to retrieve alarm history.
 	EndDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The type of alarm histories to retrieve.
-	HistoryItemType *string `type:"string"`
+	//
+	// See the HistoryItemType* constants for valid values.
+	HistoryItemType *string `type:"string" enum:"ConfigurationUpdate,StateUpdate,Action"`
 
 	// The maximum number of alarm history records to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -512,6 +669,16 @@ type metadataDescribeAlarmHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAlarmHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAlarmHistoryInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeAlarmHistory action.
 type DescribeAlarmHistoryOutput struct {
 	// A list of alarm histories in JSON format.
@@ -527,24 +694,38 @@ type metadataDescribeAlarmHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAlarmHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAlarmHistoryOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAlarmsForMetricInput struct {
 	// The list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// The statistic for the metric.
-	Statistic *string `type:"string"`
+	//
+	// See the Statistic* constants for valid values.
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum"`
 
 	// The unit for the metric.
-	Unit *string `type:"string"`
+	//
+	// See the StandardUnit* constants for valid values.
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataDescribeAlarmsForMetricInput `json:"-" xml:"-"`
 }
@@ -553,6 +734,16 @@ type metadataDescribeAlarmsForMetricInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAlarmsForMetricInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAlarmsForMetricInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeAlarmsForMetric action.
 type DescribeAlarmsForMetricOutput struct {
 	// A list of information for each alarm with the specified metric.
@@ -565,26 +756,38 @@ type metadataDescribeAlarmsForMetricOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAlarmsForMetricOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAlarmsForMetricOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAlarmsInput struct {
 	// The action name prefix.
-	ActionPrefix *string `type:"string"`
+	ActionPrefix *string `type:"string" min:"1" max:"1024"`
 
 	// The alarm name prefix. AlarmNames cannot be specified if this parameter is
 	// specified.
-	AlarmNamePrefix *string `type:"string"`
+	AlarmNamePrefix *string `type:"string" min:"1" max:"255"`
 
 	// A list of alarm names to retrieve information for.
-	AlarmNames []*string `type:"list"`
+	AlarmNames []*string `type:"list" max:"100"`
 
 	// The maximum number of alarm descriptions to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
 	NextToken *string `type:"string"`
 
 	// The state value to be used in matching alarms.
-	StateValue *string `type:"string"`
+	//
+	// See the StateValue* constants for valid values.
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA"`
 
 	metadataDescribeAlarmsInput `json:"-" xml:"-"`
 }
@@ -593,6 +796,16 @@ type metadataDescribeAlarmsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAlarmsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAlarmsInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeAlarms action.
 type DescribeAlarmsOutput struct {
 	// A list of information for the specified alarms.
@@ -608,16 +821,26 @@ type metadataDescribeAlarmsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAlarmsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAlarmsOutput) GoString() string {
+	return s.String()
+}
+
 // The Dimension data type further expands on the identity of a metric using
 // a Name, Value pair.
 //
 // For examples that use one or more dimensions, see PutMetricData.
 type Dimension struct {
 	// The name of the dimension.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value representing the dimension measurement
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataDimension `json:"-" xml:"-"`
 }
@@ -626,13 +849,23 @@ type metadataDimension struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Dimension) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Dimension) GoString() string {
+	return s.String()
+}
+
 // The DimensionFilter data type is used to filter ListMetrics results.
 type DimensionFilter struct {
 	// The dimension name to be matched.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value of the dimension to be matched.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"255"`
 
 	metadataDimensionFilter `json:"-" xml:"-"`
 }
@@ -641,9 +874,19 @@ type metadataDimensionFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DimensionFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DimensionFilter) GoString() string {
+	return s.String()
+}
+
 type DisableAlarmActionsInput struct {
 	// The names of the alarms to disable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDisableAlarmActionsInput `json:"-" xml:"-"`
 }
@@ -652,6 +895,16 @@ type metadataDisableAlarmActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableAlarmActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableAlarmActionsInput) GoString() string {
+	return s.String()
+}
+
 type DisableAlarmActionsOutput struct {
 	metadataDisableAlarmActionsOutput `json:"-" xml:"-"`
 }
@@ -660,9 +913,19 @@ type metadataDisableAlarmActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableAlarmActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableAlarmActionsOutput) GoString() string {
+	return s.String()
+}
+
 type EnableAlarmActionsInput struct {
 	// The names of the alarms to enable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataEnableAlarmActionsInput `json:"-" xml:"-"`
 }
@@ -671,6 +934,16 @@ type metadataEnableAlarmActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableAlarmActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableAlarmActionsInput) GoString() string {
+	return s.String()
+}
+
 type EnableAlarmActionsOutput struct {
 	metadataEnableAlarmActionsOutput `json:"-" xml:"-"`
 }
@@ -679,9 +952,19 @@ type metadataEnableAlarmActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableAlarmActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableAlarmActionsOutput) GoString() string {
+	return s.String()
+}
+
 type GetMetricStatisticsInput struct {
 	// A list of dimensions describing qualities of the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The time stamp to use for determining the last datapoint to return. The value
 	// specified is exclusive; results will include datapoints up to the time stamp
@@ -689,14 +972,14 @@ type GetMetricStatisticsInput struct {
 	EndTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The name of the metric, with or without spaces.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric, with or without spaces.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The granularity, in seconds, of the returned datapoints. Period must be at
 	// least 60 seconds and must be a multiple of 60. The default value is 60.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The time stamp to use for determining the first datapoint to return. The
 	// value specified is inclusive; results include datapoints with the time stamp
@@ -708,10 +991,12 @@ type GetMetricStatisticsInput struct {
 	// in the Amazon CloudWatch Developer Guide.
 	//
 	//  Valid Values: Average | Sum | SampleCount | Maximum | Minimum
-	Statistics []*string `type:"list" required:"true"`
+	Statistics []*string `type:"list" min:"1" max:"5" required:"true"`
 
 	// The unit for the metric.
-	Unit *string `type:"string"`
+	//
+	// See the StandardUnit* constants for valid values.
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataGetMetricStatisticsInput `json:"-" xml:"-"`
 }
@@ -720,6 +1005,16 @@ type metadataGetMetricStatisticsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetMetricStatisticsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetMetricStatisticsInput) GoString() string {
+	return s.String()
+}
+
 // The output for the GetMetricStatistics action.
 type GetMetricStatisticsOutput struct {
 	// The datapoints for the specified metric.
@@ -735,15 +1030,25 @@ type metadataGetMetricStatisticsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetMetricStatisticsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetMetricStatisticsOutput) GoString() string {
+	return s.String()
+}
+
 type ListMetricsInput struct {
 	// A list of dimensions to filter against.
-	Dimensions []*DimensionFilter `type:"list"`
+	Dimensions []*DimensionFilter `type:"list" max:"10"`
 
 	// The name of the metric to filter against.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace to filter against.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -756,6 +1061,16 @@ type metadataListMetricsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListMetricsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListMetricsInput) GoString() string {
+	return s.String()
+}
+
 // The output for the ListMetrics action.
 type ListMetricsOutput struct {
 	// A list of metrics used to generate statistics for an AWS account.
@@ -771,6 +1086,16 @@ type metadataListMetricsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListMetricsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListMetricsOutput) GoString() string {
+	return s.String()
+}
+
 // The Metric data type contains information about a specific metric. If you
 // call ListMetrics, Amazon CloudWatch returns information contained by this
 // data type.
@@ -780,13 +1105,13 @@ type metadataListMetricsOutput struct {
 // two dimensions, InstanceID and InstanceType.
 type Metric struct {
 	// A list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	metadataMetric `json:"-" xml:"-"`
 }
@@ -795,6 +1120,16 @@ type metadataMetric struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Metric) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Metric) GoString() string {
+	return s.String()
+}
+
 // The MetricAlarm data type represents an alarm. You can use PutMetricAlarm
 // to create or update an alarm.
 type MetricAlarm struct {
@@ -803,13 +1138,13 @@ type MetricAlarm struct {
 	ActionsEnabled *bool `type:"boolean"`
 
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `locationName:"AlarmArn" type:"string"`
+	AlarmARN *string `locationName:"AlarmArn" type:"string" min:"1" max:"1600"`
 
 	// The list of actions to execute when this alarm transitions into an ALARM
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only actions supported are publishing to an Amazon
 	// SNS topic and triggering an Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The time stamp of the last update to the alarm configuration. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -819,20 +1154,22 @@ type MetricAlarm struct {
 	AlarmConfigurationUpdatedTimestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" min:"0" max:"255"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
-	ComparisonOperator *string `type:"string"`
+	//
+	// See the ComparisonOperator* constants for valid values.
+	ComparisonOperator *string `type:"string" enum:"GreaterThanOrEqualToThreshold,GreaterThanThreshold,LessThanThreshold,LessThanOrEqualToThreshold"`
 
 	// The list of dimensions associated with the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer"`
+	EvaluationPeriods *int64 `type:"integer" min:"1"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
@@ -840,28 +1177,28 @@ type MetricAlarm struct {
 	// SNS topic or triggering an Auto Scaling policy.
 	//
 	// The current WSDL lists this attribute as UnknownActions.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name of the alarm's metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of alarm's associated metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only actions supported are publishing to an Amazon SNS
 	// topic and triggering an Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// A human-readable explanation for the alarm's state.
-	StateReason *string `type:"string"`
+	StateReason *string `type:"string" min:"0" max:"1023"`
 
 	// An explanation for the alarm's state in machine-readable JSON format
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" min:"0" max:"4000"`
 
 	// The time stamp of the last update to the alarm's state. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -871,16 +1208,22 @@ type MetricAlarm struct {
 	StateUpdatedTimestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state value for the alarm.
-	StateValue *string `type:"string"`
+	//
+	// See the StateValue* constants for valid values.
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA"`
 
 	// The statistic to apply to the alarm's associated metric.
-	Statistic *string `type:"string"`
+	//
+	// See the Statistic* constants for valid values.
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum"`
 
 	// The value against which the specified statistic is compared.
 	Threshold *float64 `type:"double"`
 
 	// The unit of the alarm's associated metric.
-	Unit *string `type:"string"`
+	//
+	// See the StandardUnit* constants for valid values.
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataMetricAlarm `json:"-" xml:"-"`
 }
@@ -889,16 +1232,26 @@ type metadataMetricAlarm struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MetricAlarm) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MetricAlarm) GoString() string {
+	return s.String()
+}
+
 // The MetricDatum data type encapsulates the information sent with PutMetricData
 // to either create a new metric or add new values to be aggregated into an
 // existing metric.
 type MetricDatum struct {
 	// A list of dimensions associated with the metric. Note, when using the Dimensions
 	// value in a query, you need to append .member.N to it (e.g., Dimensions.member.N).
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// A set of statistical values describing the metric.
 	StatisticValues *StatisticSet `type:"structure"`
@@ -912,7 +1265,9 @@ type MetricDatum struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The unit of the metric.
-	Unit *string `type:"string"`
+	//
+	// See the StandardUnit* constants for valid values.
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	// The value for the metric.
 	//
@@ -929,6 +1284,16 @@ type metadataMetricDatum struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MetricDatum) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MetricDatum) GoString() string {
+	return s.String()
+}
+
 type PutMetricAlarmInput struct {
 	// Indicates whether or not actions should be executed during any changes to
 	// the alarm's state.
@@ -938,54 +1303,60 @@ type PutMetricAlarmInput struct {
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" min:"0" max:"255"`
 
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
-	ComparisonOperator *string `type:"string" required:"true"`
+	//
+	// See the ComparisonOperator* constants for valid values.
+	ComparisonOperator *string `type:"string" enum:"GreaterThanOrEqualToThreshold,GreaterThanThreshold,LessThanThreshold,LessThanOrEqualToThreshold" required:"true"`
 
 	// The dimensions for the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer" required:"true"`
+	EvaluationPeriods *int64 `type:"integer" min:"1" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name for the alarm's associated metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace for the alarm's associated metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only action supported is publishing to an Amazon SNS
 	// topic or an Amazon Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the specified statistic is applied.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The statistic to apply to the alarm's associated metric.
-	Statistic *string `type:"string" required:"true"`
+	//
+	// See the Statistic* constants for valid values.
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum" required:"true"`
 
 	// The value against which the specified statistic is compared.
 	Threshold *float64 `type:"double" required:"true"`
 
 	// The unit for the alarm's associated metric.
-	Unit *string `type:"string"`
+	//
+	// See the StandardUnit* constants for valid values.
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataPutMetricAlarmInput `json:"-" xml:"-"`
 }
@@ -994,6 +1365,16 @@ type metadataPutMetricAlarmInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutMetricAlarmInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutMetricAlarmInput) GoString() string {
+	return s.String()
+}
+
 type PutMetricAlarmOutput struct {
 	metadataPutMetricAlarmOutput `json:"-" xml:"-"`
 }
@@ -1002,12 +1383,22 @@ type metadataPutMetricAlarmOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutMetricAlarmOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutMetricAlarmOutput) GoString() string {
+	return s.String()
+}
+
 type PutMetricDataInput struct {
 	// A list of data describing the metric.
 	MetricData []*MetricDatum `type:"list" required:"true"`
 
 	// The namespace for the metric data.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	metadataPutMetricDataInput `json:"-" xml:"-"`
 }
@@ -1016,6 +1407,16 @@ type metadataPutMetricDataInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutMetricDataInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutMetricDataInput) GoString() string {
+	return s.String()
+}
+
 type PutMetricDataOutput struct {
 	metadataPutMetricDataOutput `json:"-" xml:"-"`
 }
@@ -1024,21 +1425,33 @@ type metadataPutMetricDataOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutMetricDataOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutMetricDataOutput) GoString() string {
+	return s.String()
+}
+
 type SetAlarmStateInput struct {
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account. The maximum length is 255 characters.
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in human-readable
 	// text format)
-	StateReason *string `type:"string" required:"true"`
+	StateReason *string `type:"string" min:"0" max:"1023" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in machine-readable
 	// JSON format)
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" min:"0" max:"4000"`
 
 	// The value of the state.
-	StateValue *string `type:"string" required:"true"`
+	//
+	// See the StateValue* constants for valid values.
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA" required:"true"`
 
 	metadataSetAlarmStateInput `json:"-" xml:"-"`
 }
@@ -1047,6 +1460,16 @@ type metadataSetAlarmStateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetAlarmStateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetAlarmStateInput) GoString() string {
+	return s.String()
+}
+
 type SetAlarmStateOutput struct {
 	metadataSetAlarmStateOutput `json:"-" xml:"-"`
 }
@@ -1055,6 +1478,16 @@ type metadataSetAlarmStateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetAlarmStateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetAlarmStateOutput) GoString() string {
+	return s.String()
+}
+
 // The StatisticSet data type describes the StatisticValues component of MetricDatum,
 // and represents a set of statistics that describes a specific metric.
 type StatisticSet struct {
@@ -1076,3 +1509,112 @@ type StatisticSet struct {
 type metadataStatisticSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s StatisticSet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StatisticSet) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ComparisonOperator
+	ComparisonOperatorGreaterThanOrEqualToThreshold = "GreaterThanOrEqualToThreshold"
+	// @enum ComparisonOperator
+	ComparisonOperatorGreaterThanThreshold = "GreaterThanThreshold"
+	// @enum ComparisonOperator
+	ComparisonOperatorLessThanThreshold = "LessThanThreshold"
+	// @enum ComparisonOperator
+	ComparisonOperatorLessThanOrEqualToThreshold = "LessThanOrEqualToThreshold"
+)
+
+const (
+	// @enum HistoryItemType
+	HistoryItemTypeConfigurationUpdate = "ConfigurationUpdate"
+	// @enum HistoryItemType
+	HistoryItemTypeStateUpdate = "StateUpdate"
+	// @enum HistoryItemType
+	HistoryItemTypeAction = "Action"
+)
+
+const (
+	// @enum StandardUnit
+	StandardUnitSeconds = "Seconds"
+	// @enum StandardUnit
+	StandardUnitMicroseconds = "Microseconds"
+	// @enum StandardUnit
+	StandardUnitMilliseconds = "Milliseconds"
+	// @enum StandardUnit
+	StandardUnitBytes = "Bytes"
+	// @enum StandardUnit
+	StandardUnitKilobytes = "Kilobytes"
+	// @enum StandardUnit
+	StandardUnitMegabytes = "Megabytes"
+	// @enum StandardUnit
+	StandardUnitGigabytes = "Gigabytes"
+	// @enum StandardUnit
+	StandardUnitTerabytes = "Terabytes"
+	// @enum StandardUnit
+	StandardUnitBits = "Bits"
+	// @enum StandardUnit
+	StandardUnitKilobits = "Kilobits"
+	// @enum StandardUnit
+	StandardUnitMegabits = "Megabits"
+	// @enum StandardUnit
+	StandardUnitGigabits = "Gigabits"
+	// @enum StandardUnit
+	StandardUnitTerabits = "Terabits"
+	// @enum StandardUnit
+	StandardUnitPercent = "Percent"
+	// @enum StandardUnit
+	StandardUnitCount = "Count"
+	// @enum StandardUnit
+	StandardUnitBytesSecond = "Bytes/Second"
+	// @enum StandardUnit
+	StandardUnitKilobytesSecond = "Kilobytes/Second"
+	// @enum StandardUnit
+	StandardUnitMegabytesSecond = "Megabytes/Second"
+	// @enum StandardUnit
+	StandardUnitGigabytesSecond = "Gigabytes/Second"
+	// @enum StandardUnit
+	StandardUnitTerabytesSecond = "Terabytes/Second"
+	// @enum StandardUnit
+	StandardUnitBitsSecond = "Bits/Second"
+	// @enum StandardUnit
+	StandardUnitKilobitsSecond = "Kilobits/Second"
+	// @enum StandardUnit
+	StandardUnitMegabitsSecond = "Megabits/Second"
+	// @enum StandardUnit
+	StandardUnitGigabitsSecond = "Gigabits/Second"
+	// @enum StandardUnit
+	StandardUnitTerabitsSecond = "Terabits/Second"
+	// @enum StandardUnit
+	StandardUnitCountSecond = "Count/Second"
+	// @enum StandardUnit
+	StandardUnitNone = "None"
+)
+
+const (
+	// @enum StateValue
+	StateValueOk = "OK"
+	// @enum StateValue
+	StateValueAlarm = "ALARM"
+	// @enum StateValue
+	StateValueInsufficientData = "INSUFFICIENT_DATA"
+)
+
+const (
+	// @enum Statistic
+	StatisticSampleCount = "SampleCount"
+	// @enum Statistic
+	StatisticAverage = "Average"
+	// @enum Statistic
+	StatisticSum = "Sum"
+	// @enum Statistic
+	StatisticMinimum = "Minimum"
+	// @enum Statistic
+	StatisticMaximum = "Maximum"
+)
@@ -5,6 +5,7 @@ package cloudwatchlogs
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateLogGroup = "CreateLogGroup"
@@ -40,6 +41,16 @@ func (c *CloudWatchLogs) CreateLogGroup(input *CreateLogGroupInput) (*CreateLogG
 	return out, err
 }
 
+// CreateLogGroupWithContext is the same as CreateLogGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) CreateLogGroupWithContext(ctx aws.Context, input *CreateLogGroupInput) (*CreateLogGroupOutput, error) {
+	req, out := c.CreateLogGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLogStream = "CreateLogStream"
 
 // CreateLogStreamRequest generates a request for the CreateLogStream operation.
@@ -73,6 +84,16 @@ func (c *CloudWatchLogs) CreateLogStream(input *CreateLogStreamInput) (*CreateLo
 	return out, err
 }
 
+// CreateLogStreamWithContext is the same as CreateLogStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) CreateLogStreamWithContext(ctx aws.Context, input *CreateLogStreamInput) (*CreateLogStreamOutput, error) {
+	req, out := c.CreateLogStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLogGroup = "DeleteLogGroup"
 
 // DeleteLogGroupRequest generates a request for the DeleteLogGroup operation.
@@ -101,6 +122,16 @@ func (c *CloudWatchLogs) DeleteLogGroup(input *DeleteLogGroupInput) (*DeleteLogG
 	return out, err
 }
 
+// DeleteLogGroupWithContext is the same as DeleteLogGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DeleteLogGroupWithContext(ctx aws.Context, input *DeleteLogGroupInput) (*DeleteLogGroupOutput, error) {
+	req, out := c.DeleteLogGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLogStream = "DeleteLogStream"
 
 // DeleteLogStreamRequest generates a request for the DeleteLogStream operation.
@@ -129,6 +160,16 @@ func (c *CloudWatchLogs) DeleteLogStream(input *DeleteLogStreamInput) (*DeleteLo
 	return out, err
 }
 
+// DeleteLogStreamWithContext is the same as DeleteLogStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DeleteLogStreamWithContext(ctx aws.Context, input *DeleteLogStreamInput) (*DeleteLogStreamOutput, error) {
+	req, out := c.DeleteLogStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMetricFilter = "DeleteMetricFilter"
 
 // DeleteMetricFilterRequest generates a request for the DeleteMetricFilter operation.
@@ -156,6 +197,16 @@ func (c *CloudWatchLogs) DeleteMetricFilter(input *DeleteMetricFilterInput) (*De
 	return out, err
 }
 
+// DeleteMetricFilterWithContext is the same as DeleteMetricFilter with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DeleteMetricFilterWithContext(ctx aws.Context, input *DeleteMetricFilterInput) (*DeleteMetricFilterOutput, error) {
+	req, out := c.DeleteMetricFilterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRetentionPolicy = "DeleteRetentionPolicy"
 
 // DeleteRetentionPolicyRequest generates a request for the DeleteRetentionPolicy operation.
@@ -184,6 +235,16 @@ func (c *CloudWatchLogs) DeleteRetentionPolicy(input *DeleteRetentionPolicyInput
 	return out, err
 }
 
+// DeleteRetentionPolicyWithContext is the same as DeleteRetentionPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DeleteRetentionPolicyWithContext(ctx aws.Context, input *DeleteRetentionPolicyInput) (*DeleteRetentionPolicyOutput, error) {
+	req, out := c.DeleteRetentionPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSubscriptionFilter = "DeleteSubscriptionFilter"
 
 // DeleteSubscriptionFilterRequest generates a request for the DeleteSubscriptionFilter operation.
@@ -211,6 +272,16 @@ func (c *CloudWatchLogs) DeleteSubscriptionFilter(input *DeleteSubscriptionFilte
 	return out, err
 }
 
+// DeleteSubscriptionFilterWithContext is the same as DeleteSubscriptionFilter with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DeleteSubscriptionFilterWithContext(ctx aws.Context, input *DeleteSubscriptionFilterInput) (*DeleteSubscriptionFilterOutput, error) {
+	req, out := c.DeleteSubscriptionFilterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLogGroups = "DescribeLogGroups"
 
 // DescribeLogGroupsRequest generates a request for the DescribeLogGroups operation.
@@ -251,6 +322,16 @@ func (c *CloudWatchLogs) DescribeLogGroups(input *DescribeLogGroupsInput) (*Desc
 	return out, err
 }
 
+// DescribeLogGroupsWithContext is the same as DescribeLogGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DescribeLogGroupsWithContext(ctx aws.Context, input *DescribeLogGroupsInput) (*DescribeLogGroupsOutput, error) {
+	req, out := c.DescribeLogGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudWatchLogs) DescribeLogGroupsPages(input *DescribeLogGroupsInput, fn func(p *DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeLogGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -299,6 +380,16 @@ func (c *CloudWatchLogs) DescribeLogStreams(input *DescribeLogStreamsInput) (*De
 	return out, err
 }
 
+// DescribeLogStreamsWithContext is the same as DescribeLogStreams with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DescribeLogStreamsWithContext(ctx aws.Context, input *DescribeLogStreamsInput) (*DescribeLogStreamsOutput, error) {
+	req, out := c.DescribeLogStreamsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudWatchLogs) DescribeLogStreamsPages(input *DescribeLogStreamsInput, fn func(p *DescribeLogStreamsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeLogStreamsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -345,6 +436,16 @@ func (c *CloudWatchLogs) DescribeMetricFilters(input *DescribeMetricFiltersInput
 	return out, err
 }
 
+// DescribeMetricFiltersWithContext is the same as DescribeMetricFilters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DescribeMetricFiltersWithContext(ctx aws.Context, input *DescribeMetricFiltersInput) (*DescribeMetricFiltersOutput, error) {
+	req, out := c.DescribeMetricFiltersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudWatchLogs) DescribeMetricFiltersPages(input *DescribeMetricFiltersInput, fn func(p *DescribeMetricFiltersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeMetricFiltersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -386,6 +487,16 @@ func (c *CloudWatchLogs) DescribeSubscriptionFilters(input *DescribeSubscription
 	return out, err
 }
 
+// DescribeSubscriptionFiltersWithContext is the same as DescribeSubscriptionFilters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) DescribeSubscriptionFiltersWithContext(ctx aws.Context, input *DescribeSubscriptionFiltersInput) (*DescribeSubscriptionFiltersOutput, error) {
+	req, out := c.DescribeSubscriptionFiltersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opFilterLogEvents = "FilterLogEvents"
 
 // FilterLogEventsRequest generates a request for the FilterLogEvents operation.
@@ -426,6 +537,16 @@ func (c *CloudWatchLogs) FilterLogEvents(input *FilterLogEventsInput) (*FilterLo
 	return out, err
 }
 
+// FilterLogEventsWithContext is the same as FilterLogEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) FilterLogEventsWithContext(ctx aws.Context, input *FilterLogEventsInput) (*FilterLogEventsOutput, error) {
+	req, out := c.FilterLogEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetLogEvents = "GetLogEvents"
 
 // GetLogEventsRequest generates a request for the GetLogEvents operation.
@@ -468,6 +589,16 @@ func (c *CloudWatchLogs) GetLogEvents(input *GetLogEventsInput) (*GetLogEventsOu
 	return out, err
 }
 
+// GetLogEventsWithContext is the same as GetLogEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) GetLogEventsWithContext(ctx aws.Context, input *GetLogEventsInput) (*GetLogEventsOutput, error) {
+	req, out := c.GetLogEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CloudWatchLogs) GetLogEventsPages(input *GetLogEventsInput, fn func(p *GetLogEventsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetLogEventsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -514,6 +645,16 @@ func (c *CloudWatchLogs) PutLogEvents(input *PutLogEventsInput) (*PutLogEventsOu
 	return out, err
 }
 
+// PutLogEventsWithContext is the same as PutLogEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) PutLogEventsWithContext(ctx aws.Context, input *PutLogEventsInput) (*PutLogEventsOutput, error) {
+	req, out := c.PutLogEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutMetricFilter = "PutMetricFilter"
 
 // PutMetricFilterRequest generates a request for the PutMetricFilter operation.
@@ -546,6 +687,16 @@ func (c *CloudWatchLogs) PutMetricFilter(input *PutMetricFilterInput) (*PutMetri
 	return out, err
 }
 
+// PutMetricFilterWithContext is the same as PutMetricFilter with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) PutMetricFilterWithContext(ctx aws.Context, input *PutMetricFilterInput) (*PutMetricFilterOutput, error) {
+	req, out := c.PutMetricFilterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRetentionPolicy = "PutRetentionPolicy"
 
 // PutRetentionPolicyRequest generates a request for the PutRetentionPolicy operation.
@@ -575,6 +726,16 @@ func (c *CloudWatchLogs) PutRetentionPolicy(input *PutRetentionPolicyInput) (*Pu
 	return out, err
 }
 
+// PutRetentionPolicyWithContext is the same as PutRetentionPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) PutRetentionPolicyWithContext(ctx aws.Context, input *PutRetentionPolicyInput) (*PutRetentionPolicyOutput, error) {
+	req, out := c.PutRetentionPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutSubscriptionFilter = "PutSubscriptionFilter"
 
 // PutSubscriptionFilterRequest generates a request for the PutSubscriptionFilter operation.
@@ -609,6 +770,16 @@ func (c *CloudWatchLogs) PutSubscriptionFilter(input *PutSubscriptionFilterInput
 	return out, err
 }
 
+// PutSubscriptionFilterWithContext is the same as PutSubscriptionFilter with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) PutSubscriptionFilterWithContext(ctx aws.Context, input *PutSubscriptionFilterInput) (*PutSubscriptionFilterOutput, error) {
+	req, out := c.PutSubscriptionFilterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTestMetricFilter = "TestMetricFilter"
 
 // TestMetricFilterRequest generates a request for the TestMetricFilter operation.
@@ -638,9 +809,19 @@ func (c *CloudWatchLogs) TestMetricFilter(input *TestMetricFilterInput) (*TestMe
 	return out, err
 }
 
+// TestMetricFilterWithContext is the same as TestMetricFilter with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CloudWatchLogs) TestMetricFilterWithContext(ctx aws.Context, input *TestMetricFilterInput) (*TestMetricFilterOutput, error) {
+	req, out := c.TestMetricFilterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type CreateLogGroupInput struct {
 	// The name of the log group to create.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataCreateLogGroupInput `json:"-" xml:"-"`
 }
@@ -649,6 +830,16 @@ type metadataCreateLogGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLogGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLogGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreateLogGroupOutput struct {
 	metadataCreateLogGroupOutput `json:"-" xml:"-"`
 }
@@ -657,12 +848,22 @@ type metadataCreateLogGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLogGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLogGroupOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLogStreamInput struct {
 	// The name of the log group under which the log stream is to be created.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// The name of the log stream to create.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	metadataCreateLogStreamInput `json:"-" xml:"-"`
 }
@@ -671,6 +872,16 @@ type metadataCreateLogStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLogStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLogStreamInput) GoString() string {
+	return s.String()
+}
+
 type CreateLogStreamOutput struct {
 	metadataCreateLogStreamOutput `json:"-" xml:"-"`
 }
@@ -679,9 +890,19 @@ type metadataCreateLogStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLogStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLogStreamOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLogGroupInput struct {
 	// The name of the log group to delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteLogGroupInput `json:"-" xml:"-"`
 }
@@ -690,6 +911,16 @@ type metadataDeleteLogGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLogGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLogGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLogGroupOutput struct {
 	metadataDeleteLogGroupOutput `json:"-" xml:"-"`
 }
@@ -698,12 +929,22 @@ type metadataDeleteLogGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLogGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLogGroupOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLogStreamInput struct {
 	// The name of the log group under which the log stream to delete belongs.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// The name of the log stream to delete.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	metadataDeleteLogStreamInput `json:"-" xml:"-"`
 }
@@ -712,6 +953,16 @@ type metadataDeleteLogStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLogStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLogStreamInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLogStreamOutput struct {
 	metadataDeleteLogStreamOutput `json:"-" xml:"-"`
 }
@@ -720,12 +971,22 @@ type metadataDeleteLogStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLogStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLogStreamOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteMetricFilterInput struct {
 	// The name of the metric filter to delete.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// The name of the log group that is associated with the metric filter to delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteMetricFilterInput `json:"-" xml:"-"`
 }
@@ -734,6 +995,16 @@ type metadataDeleteMetricFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMetricFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMetricFilterInput) GoString() string {
+	return s.String()
+}
+
 type DeleteMetricFilterOutput struct {
 	metadataDeleteMetricFilterOutput `json:"-" xml:"-"`
 }
@@ -742,10 +1013,20 @@ type metadataDeleteMetricFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMetricFilterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMetricFilterOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteRetentionPolicyInput struct {
 	// The name of the log group that is associated with the retention policy to
 	// delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteRetentionPolicyInput `json:"-" xml:"-"`
 }
@@ -754,6 +1035,16 @@ type metadataDeleteRetentionPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRetentionPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRetentionPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteRetentionPolicyOutput struct {
 	metadataDeleteRetentionPolicyOutput `json:"-" xml:"-"`
 }
@@ -762,13 +1053,23 @@ type metadataDeleteRetentionPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRetentionPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRetentionPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteSubscriptionFilterInput struct {
 	// The name of the subscription filter to delete.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// The name of the log group that is associated with the subscription filter
 	// to delete.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteSubscriptionFilterInput `json:"-" xml:"-"`
 }
@@ -777,6 +1078,16 @@ type metadataDeleteSubscriptionFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSubscriptionFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSubscriptionFilterInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSubscriptionFilterOutput struct {
 	metadataDeleteSubscriptionFilterOutput `json:"-" xml:"-"`
 }
@@ -785,19 +1096,29 @@ type metadataDeleteSubscriptionFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSubscriptionFilterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSubscriptionFilterOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLogGroupsInput struct {
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// Will only return log groups that match the provided logGroupNamePrefix. If
 	// you don't specify a value, no prefix filter is applied.
-	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string"`
+	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogGroups
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsInput `json:"-" xml:"-"`
 }
@@ -806,6 +1127,16 @@ type metadataDescribeLogGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLogGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLogGroupsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLogGroupsOutput struct {
 	// A list of log groups.
 	LogGroups []*LogGroup `locationName:"logGroups" type:"list"`
@@ -813,7 +1144,7 @@ type DescribeLogGroupsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsOutput `json:"-" xml:"-"`
 }
@@ -822,6 +1153,16 @@ type metadataDescribeLogGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLogGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLogGroupsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLogStreamsInput struct {
 	// If set to true, results are returned in descending order. If you don't specify
 	// a value or set it to false, results are returned in ascending order.
@@ -829,25 +1170,27 @@ type DescribeLogStreamsInput struct {
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// The log group name for which log streams are to be listed.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// Will only return log streams that match the provided logStreamNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string"`
+	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogStreams
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// Specifies what to order the returned log streams by. Valid arguments are
 	// 'LogStreamName' or 'LastEventTime'. If you don't specify a value, results
 	// are ordered by LogStreamName. If 'LastEventTime' is chosen, the request cannot
 	// also contain a logStreamNamePrefix.
-	OrderBy *string `locationName:"orderBy" type:"string"`
+	//
+	// See the OrderBy* constants for valid values.
+	OrderBy *string `locationName:"orderBy" type:"string" enum:"LogStreamName,LastEventTime"`
 
 	metadataDescribeLogStreamsInput `json:"-" xml:"-"`
 }
@@ -856,6 +1199,16 @@ type metadataDescribeLogStreamsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLogStreamsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLogStreamsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLogStreamsOutput struct {
 	// A list of log streams.
 	LogStreams []*LogStream `locationName:"logStreams" type:"list"`
@@ -863,7 +1216,7 @@ type DescribeLogStreamsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogStreamsOutput `json:"-" xml:"-"`
 }
@@ -872,22 +1225,32 @@ type metadataDescribeLogStreamsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLogStreamsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLogStreamsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeMetricFiltersInput struct {
 	// Will only return metric filters that match the provided filterNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string"`
+	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// The log group name for which metric filters are to be listed.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeMetricFilters
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersInput `json:"-" xml:"-"`
 }
@@ -896,13 +1259,23 @@ type metadataDescribeMetricFiltersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMetricFiltersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMetricFiltersInput) GoString() string {
+	return s.String()
+}
+
 type DescribeMetricFiltersOutput struct {
 	MetricFilters []*MetricFilter `locationName:"metricFilters" type:"list"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersOutput `json:"-" xml:"-"`
 }
@@ -911,21 +1284,31 @@ type metadataDescribeMetricFiltersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMetricFiltersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMetricFiltersOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeSubscriptionFiltersInput struct {
 	// Will only return subscription filters that match the provided filterNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string"`
+	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// The maximum number of results to return.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
 	// The log group name for which subscription filters are to be listed.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeSubscriptionFiltersInput `json:"-" xml:"-"`
 }
@@ -934,11 +1317,21 @@ type metadataDescribeSubscriptionFiltersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSubscriptionFiltersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSubscriptionFiltersInput) GoString() string {
+	return s.String()
+}
+
 type DescribeSubscriptionFiltersOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	SubscriptionFilters []*SubscriptionFilter `locationName:"subscriptionFilters" type:"list"`
 
@@ -949,14 +1342,24 @@ type metadataDescribeSubscriptionFiltersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSubscriptionFiltersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSubscriptionFiltersOutput) GoString() string {
+	return s.String()
+}
+
 type FilterLogEventsInput struct {
 	// A unix timestamp indicating the end time of the range for the request. If
 	// provided, events with a timestamp later than this time will not be returned.
-	EndTime *int64 `locationName:"endTime" type:"long"`
+	EndTime *int64 `locationName:"endTime" type:"long" min:"0"`
 
 	// A valid CloudWatch Logs filter pattern to use for filtering the response.
 	// If not provided, all the events are matched.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512"`
 
 	// If provided, the API will make a best effort to provide responses that contain
 	// events from multiple log streams within the log group interleaved in a single
@@ -966,22 +1369,22 @@ type FilterLogEventsInput struct {
 
 	// The maximum number of events to return in a page of results. Default is 10,000
 	// events.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"10000"`
 
 	// The name of the log group to query.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// Optional list of log stream names within the specified log group to search.
 	// Defaults to all the log streams in the log group.
-	LogStreamNames []*string `locationName:"logStreamNames" type:"list"`
+	LogStreamNames []*string `locationName:"logStreamNames" type:"list" min:"1" max:"100"`
 
 	// A pagination token obtained from a FilterLogEvents response to continue paginating
 	// the FilterLogEvents results.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// A unix timestamp indicating the start time of the range for the request.
 	// If provided, events with a timestamp prior to this time will not be returned.
-	StartTime *int64 `locationName:"startTime" type:"long"`
+	StartTime *int64 `locationName:"startTime" type:"long" min:"0"`
 
 	metadataFilterLogEventsInput `json:"-" xml:"-"`
 }
@@ -990,6 +1393,16 @@ type metadataFilterLogEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FilterLogEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FilterLogEventsInput) GoString() string {
+	return s.String()
+}
+
 type FilterLogEventsOutput struct {
 	// A list of FilteredLogEvent objects representing the matched events from the
 	// request.
@@ -997,7 +1410,7 @@ type FilterLogEventsOutput struct {
 
 	// A pagination token obtained from a FilterLogEvents response to continue paginating
 	// the FilterLogEvents results.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// A list of SearchedLogStream objects indicating which log streams have been
 	// searched in this request and whether each has been searched completely or
@@ -1011,6 +1424,16 @@ type metadataFilterLogEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FilterLogEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FilterLogEventsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a matched event from a FilterLogEvents request.
 type FilteredLogEvent struct {
 	// A unique identifier for this event.
@@ -1018,17 +1441,17 @@ type FilteredLogEvent struct {
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	IngestionTime *int64 `locationName:"ingestionTime" type:"long"`
+	IngestionTime *int64 `locationName:"ingestionTime" type:"long" min:"0"`
 
 	// The name of the log stream this event belongs to.
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// The data contained in the log event.
-	Message *string `locationName:"message" type:"string"`
+	Message *string `locationName:"message" type:"string" min:"1"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0"`
 
 	metadataFilteredLogEvent `json:"-" xml:"-"`
 }
@@ -1037,26 +1460,36 @@ type metadataFilteredLogEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FilteredLogEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FilteredLogEvent) GoString() string {
+	return s.String()
+}
+
 type GetLogEventsInput struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	EndTime *int64 `locationName:"endTime" type:"long"`
+	EndTime *int64 `locationName:"endTime" type:"long" min:"0"`
 
 	// The maximum number of log events returned in the response. If you don't specify
 	// a value, the request would return as many log events as can fit in a response
 	// size of 1MB, up to 10,000 log events.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"10000"`
 
 	// The name of the log group to query.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// The name of the log stream to query.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the nextForwardToken or nextBackwardToken
 	// fields in the response of the previous GetLogEvents request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// If set to true, the earliest log events would be returned first. The default
 	// is false (the latest log events are returned first).
@@ -1064,7 +1497,7 @@ type GetLogEventsInput struct {
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	StartTime *int64 `locationName:"startTime" type:"long"`
+	StartTime *int64 `locationName:"startTime" type:"long" min:"0"`
 
 	metadataGetLogEventsInput `json:"-" xml:"-"`
 }
@@ -1073,18 +1506,28 @@ type metadataGetLogEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetLogEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetLogEventsInput) GoString() string {
+	return s.String()
+}
+
 type GetLogEventsOutput struct {
 	Events []*OutputLogEvent `locationName:"events" type:"list"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string"`
+	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string" min:"1"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextForwardToken *string `locationName:"nextForwardToken" type:"string"`
+	NextForwardToken *string `locationName:"nextForwardToken" type:"string" min:"1"`
 
 	metadataGetLogEventsOutput `json:"-" xml:"-"`
 }
@@ -1093,16 +1536,26 @@ type metadataGetLogEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetLogEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetLogEventsOutput) GoString() string {
+	return s.String()
+}
+
 // A log event is a record of some activity that was recorded by the application
 // or resource being monitored. The log event record that Amazon CloudWatch
 // Logs understands contains two properties: the timestamp of when the event
 // occurred, and the raw event message.
 type InputLogEvent struct {
-	Message *string `locationName:"message" type:"string" required:"true"`
+	Message *string `locationName:"message" type:"string" min:"1" required:"true"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long" required:"true"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0" required:"true"`
 
 	metadataInputLogEvent `json:"-" xml:"-"`
 }
@@ -1111,14 +1564,24 @@ type metadataInputLogEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InputLogEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InputLogEvent) GoString() string {
+	return s.String()
+}
+
 type LogGroup struct {
 	ARN *string `locationName:"arn" type:"string"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+"`
 
 	// The number of metric filters associated with the log group.
 	MetricFilterCount *int64 `locationName:"metricFilterCount" type:"integer"`
@@ -1128,7 +1591,7 @@ type LogGroup struct {
 	// 365, 400, 545, 731, 1827, 3653.
 	RetentionInDays *int64 `locationName:"retentionInDays" type:"integer"`
 
-	StoredBytes *int64 `locationName:"storedBytes" type:"long"`
+	StoredBytes *int64 `locationName:"storedBytes" type:"long" min:"0"`
 
 	metadataLogGroup `json:"-" xml:"-"`
 }
@@ -1137,34 +1600,44 @@ type metadataLogGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LogGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LogGroup) GoString() string {
+	return s.String()
+}
+
 // A log stream is sequence of log events from a single emitter of logs.
 type LogStream struct {
 	ARN *string `locationName:"arn" type:"string"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	FirstEventTimestamp *int64 `locationName:"firstEventTimestamp" type:"long"`
+	FirstEventTimestamp *int64 `locationName:"firstEventTimestamp" type:"long" min:"0"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	LastEventTimestamp *int64 `locationName:"lastEventTimestamp" type:"long"`
+	LastEventTimestamp *int64 `locationName:"lastEventTimestamp" type:"long" min:"0"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	LastIngestionTime *int64 `locationName:"lastIngestionTime" type:"long"`
+	LastIngestionTime *int64 `locationName:"lastIngestionTime" type:"long" min:"0"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
-	StoredBytes *int64 `locationName:"storedBytes" type:"long"`
+	StoredBytes *int64 `locationName:"storedBytes" type:"long" min:"0"`
 
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string"`
+	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string" min:"1"`
 
 	metadataLogStream `json:"-" xml:"-"`
 }
@@ -1173,24 +1646,34 @@ type metadataLogStream struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LogStream) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LogStream) GoString() string {
+	return s.String()
+}
+
 // Metric filters can be used to express how Amazon CloudWatch Logs would extract
 // metric observations from ingested log events and transform them to metric
 // data in a CloudWatch metric.
 type MetricFilter struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
 	// A name for a metric or subscription filter.
-	FilterName *string `locationName:"filterName" type:"string"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log event. For example, a log event may contain timestamps,
 	// IP addresses, strings, and so on. You use the filter pattern to specify what
 	// to look for in the log event message.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512"`
 
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1"`
 
 	metadataMetricFilter `json:"-" xml:"-"`
 }
@@ -1199,8 +1682,18 @@ type metadataMetricFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MetricFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MetricFilter) GoString() string {
+	return s.String()
+}
+
 type MetricFilterMatchRecord struct {
-	EventMessage *string `locationName:"eventMessage" type:"string"`
+	EventMessage *string `locationName:"eventMessage" type:"string" min:"1"`
 
 	EventNumber *int64 `locationName:"eventNumber" type:"long"`
 
@@ -1213,19 +1706,29 @@ type metadataMetricFilterMatchRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MetricFilterMatchRecord) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MetricFilterMatchRecord) GoString() string {
+	return s.String()
+}
+
 type MetricTransformation struct {
 	// The name of the CloudWatch metric to which the monitored log information
 	// should be published. For example, you may publish to a metric called ErrorCount.
-	MetricName *string `locationName:"metricName" type:"string" required:"true"`
+	MetricName *string `locationName:"metricName" type:"string" max:"255" pattern:"[^:*$]*" required:"true"`
 
 	// The destination namespace of the new CloudWatch metric.
-	MetricNamespace *string `locationName:"metricNamespace" type:"string" required:"true"`
+	MetricNamespace *string `locationName:"metricNamespace" type:"string" max:"255" pattern:"[^:*$]*" required:"true"`
 
 	// What to publish to the metric. For example, if you're counting the occurrences
 	// of a particular term like "Error", the value will be "1" for each occurrence.
 	// If you're counting the bytes transferred the published value will be the
 	// value in the log event.
-	MetricValue *string `locationName:"metricValue" type:"string" required:"true"`
+	MetricValue *string `locationName:"metricValue" type:"string" max:"100" required:"true"`
 
 	metadataMetricTransformation `json:"-" xml:"-"`
 }
@@ -1234,16 +1737,26 @@ type metadataMetricTransformation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MetricTransformation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MetricTransformation) GoString() string {
+	return s.String()
+}
+
 type OutputLogEvent struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	IngestionTime *int64 `locationName:"ingestionTime" type:"long"`
+	IngestionTime *int64 `locationName:"ingestionTime" type:"long" min:"0"`
 
-	Message *string `locationName:"message" type:"string"`
+	Message *string `locationName:"message" type:"string" min:"1"`
 
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	Timestamp *int64 `locationName:"timestamp" type:"long"`
+	Timestamp *int64 `locationName:"timestamp" type:"long" min:"0"`
 
 	metadataOutputLogEvent `json:"-" xml:"-"`
 }
@@ -1252,19 +1765,29 @@ type metadataOutputLogEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OutputLogEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OutputLogEvent) GoString() string {
+	return s.String()
+}
+
 type PutLogEventsInput struct {
 	// A list of log events belonging to a log stream.
-	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" required:"true"`
+	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" min:"1" max:"10000" required:"true"`
 
 	// The name of the log group to put log events to.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// The name of the log stream to put log events to.
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A string token that must be obtained from the response of the previous PutLogEvents
 	// request.
-	SequenceToken *string `locationName:"sequenceToken" type:"string"`
+	SequenceToken *string `locationName:"sequenceToken" type:"string" min:"1"`
 
 	metadataPutLogEventsInput `json:"-" xml:"-"`
 }
@@ -1273,11 +1796,21 @@ type metadataPutLogEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutLogEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutLogEventsInput) GoString() string {
+	return s.String()
+}
+
 type PutLogEventsOutput struct {
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string"`
+	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string" min:"1"`
 
 	RejectedLogEventsInfo *RejectedLogEventsInfo `locationName:"rejectedLogEventsInfo" type:"structure"`
 
@@ -1288,19 +1821,29 @@ type metadataPutLogEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutLogEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutLogEventsOutput) GoString() string {
+	return s.String()
+}
+
 type PutMetricFilterInput struct {
 	// A name for the metric filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A valid CloudWatch Logs filter pattern for extracting metric data out of
 	// ingested log events.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
 	// The name of the log group to associate the metric filter with.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// A collection of information needed to define how metric data gets emitted.
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" required:"true"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1" required:"true"`
 
 	metadataPutMetricFilterInput `json:"-" xml:"-"`
 }
@@ -1309,6 +1852,16 @@ type metadataPutMetricFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutMetricFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutMetricFilterInput) GoString() string {
+	return s.String()
+}
+
 type PutMetricFilterOutput struct {
 	metadataPutMetricFilterOutput `json:"-" xml:"-"`
 }
@@ -1317,9 +1870,19 @@ type metadataPutMetricFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutMetricFilterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutMetricFilterOutput) GoString() string {
+	return s.String()
+}
+
 type PutRetentionPolicyInput struct {
 	// The name of the log group to associate the retention policy with.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// Specifies the number of days you want to retain log events in the specified
 	// log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180,
@@ -1333,6 +1896,16 @@ type metadataPutRetentionPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRetentionPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRetentionPolicyInput) GoString() string {
+	return s.String()
+}
+
 type PutRetentionPolicyOutput struct {
 	metadataPutRetentionPolicyOutput `json:"-" xml:"-"`
 }
@@ -1341,23 +1914,33 @@ type metadataPutRetentionPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRetentionPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRetentionPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type PutSubscriptionFilterInput struct {
 	// The ARN of an Amazon Kinesis stream to deliver matching log events to.
-	DestinationARN *string `locationName:"destinationArn" type:"string" required:"true"`
+	DestinationARN *string `locationName:"destinationArn" type:"string" min:"1" required:"true"`
 
 	// A name for the subscription filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A valid CloudWatch Logs filter pattern for subscribing to a filtered stream
 	// of log events.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
 	// The name of the log group to associate the subscription filter with.
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// The ARN of an IAM role that grants Amazon CloudWatch Logs permissions to
 	// do Amazon Kinesis PutRecord requests on the desitnation stream.
-	RoleARN *string `locationName:"roleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"roleArn" type:"string" min:"1" required:"true"`
 
 	metadataPutSubscriptionFilterInput `json:"-" xml:"-"`
 }
@@ -1366,6 +1949,16 @@ type metadataPutSubscriptionFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutSubscriptionFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutSubscriptionFilterInput) GoString() string {
+	return s.String()
+}
+
 type PutSubscriptionFilterOutput struct {
 	metadataPutSubscriptionFilterOutput `json:"-" xml:"-"`
 }
@@ -1374,6 +1967,16 @@ type metadataPutSubscriptionFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutSubscriptionFilterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutSubscriptionFilterOutput) GoString() string {
+	return s.String()
+}
+
 type RejectedLogEventsInfo struct {
 	ExpiredLogEventEndIndex *int64 `locationName:"expiredLogEventEndIndex" type:"integer"`
 
@@ -1388,11 +1991,21 @@ type metadataRejectedLogEventsInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RejectedLogEventsInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RejectedLogEventsInfo) GoString() string {
+	return s.String()
+}
+
 // An object indicating the search status of a log stream in a FilterLogEvents
 // request.
 type SearchedLogStream struct {
 	// The name of the log stream.
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// Indicates whether all the events in this log stream were searched or more
 	// data exists to search by paginating further.
@@ -1405,25 +2018,35 @@ type metadataSearchedLogStream struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SearchedLogStream) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SearchedLogStream) GoString() string {
+	return s.String()
+}
+
 type SubscriptionFilter struct {
 	// A point in time expressed as the number of milliseconds since Jan 1, 1970
 	// 00:00:00 UTC.
-	CreationTime *int64 `locationName:"creationTime" type:"long"`
+	CreationTime *int64 `locationName:"creationTime" type:"long" min:"0"`
 
-	DestinationARN *string `locationName:"destinationArn" type:"string"`
+	DestinationARN *string `locationName:"destinationArn" type:"string" min:"1"`
 
 	// A name for a metric or subscription filter.
-	FilterName *string `locationName:"filterName" type:"string"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log event. For example, a log event may contain timestamps,
 	// IP addresses, strings, and so on. You use the filter pattern to specify what
 	// to look for in the log event message.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+"`
 
-	RoleARN *string `locationName:"roleArn" type:"string"`
+	RoleARN *string `locationName:"roleArn" type:"string" min:"1"`
 
 	metadataSubscriptionFilter `json:"-" xml:"-"`
 }
@@ -1432,15 +2055,25 @@ type metadataSubscriptionFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubscriptionFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubscriptionFilter) GoString() string {
+	return s.String()
+}
+
 type TestMetricFilterInput struct {
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log event. For example, a log event may contain timestamps,
 	// IP addresses, strings, and so on. You use the filter pattern to specify what
 	// to look for in the log event message.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" min:"0" max:"512" required:"true"`
 
 	// A list of log event messages to test.
-	LogEventMessages []*string `locationName:"logEventMessages" type:"list" required:"true"`
+	LogEventMessages []*string `locationName:"logEventMessages" type:"list" min:"1" max:"50" required:"true"`
 
 	metadataTestMetricFilterInput `json:"-" xml:"-"`
 }
@@ -1449,6 +2082,16 @@ type metadataTestMetricFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TestMetricFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TestMetricFilterInput) GoString() string {
+	return s.String()
+}
+
 type TestMetricFilterOutput struct {
 	Matches []*MetricFilterMatchRecord `locationName:"matches" type:"list"`
 
@@ -1458,3 +2101,20 @@ type TestMetricFilterOutput struct {
 type metadataTestMetricFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s TestMetricFilterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TestMetricFilterOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum OrderBy
+	OrderByLogStreamName = "LogStreamName"
+	// @enum OrderBy
+	OrderByLastEventTime = "LastEventTime"
+)
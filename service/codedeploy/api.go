@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddTagsToOnPremisesInstances = "AddTagsToOnPremisesInstances"
@@ -36,6 +37,16 @@ func (c *CodeDeploy) AddTagsToOnPremisesInstances(input *AddTagsToOnPremisesInst
 	return out, err
 }
 
+// AddTagsToOnPremisesInstancesWithContext is the same as AddTagsToOnPremisesInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) AddTagsToOnPremisesInstancesWithContext(ctx aws.Context, input *AddTagsToOnPremisesInstancesInput) (*AddTagsToOnPremisesInstancesOutput, error) {
+	req, out := c.AddTagsToOnPremisesInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opBatchGetApplications = "BatchGetApplications"
 
 // BatchGetApplicationsRequest generates a request for the BatchGetApplications operation.
@@ -63,6 +74,16 @@ func (c *CodeDeploy) BatchGetApplications(input *BatchGetApplicationsInput) (*Ba
 	return out, err
 }
 
+// BatchGetApplicationsWithContext is the same as BatchGetApplications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) BatchGetApplicationsWithContext(ctx aws.Context, input *BatchGetApplicationsInput) (*BatchGetApplicationsOutput, error) {
+	req, out := c.BatchGetApplicationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opBatchGetDeployments = "BatchGetDeployments"
 
 // BatchGetDeploymentsRequest generates a request for the BatchGetDeployments operation.
@@ -90,6 +111,16 @@ func (c *CodeDeploy) BatchGetDeployments(input *BatchGetDeploymentsInput) (*Batc
 	return out, err
 }
 
+// BatchGetDeploymentsWithContext is the same as BatchGetDeployments with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) BatchGetDeploymentsWithContext(ctx aws.Context, input *BatchGetDeploymentsInput) (*BatchGetDeploymentsOutput, error) {
+	req, out := c.BatchGetDeploymentsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opBatchGetOnPremisesInstances = "BatchGetOnPremisesInstances"
 
 // BatchGetOnPremisesInstancesRequest generates a request for the BatchGetOnPremisesInstances operation.
@@ -117,6 +148,16 @@ func (c *CodeDeploy) BatchGetOnPremisesInstances(input *BatchGetOnPremisesInstan
 	return out, err
 }
 
+// BatchGetOnPremisesInstancesWithContext is the same as BatchGetOnPremisesInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) BatchGetOnPremisesInstancesWithContext(ctx aws.Context, input *BatchGetOnPremisesInstancesInput) (*BatchGetOnPremisesInstancesOutput, error) {
+	req, out := c.BatchGetOnPremisesInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateApplication = "CreateApplication"
 
 // CreateApplicationRequest generates a request for the CreateApplication operation.
@@ -144,6 +185,16 @@ func (c *CodeDeploy) CreateApplication(input *CreateApplicationInput) (*CreateAp
 	return out, err
 }
 
+// CreateApplicationWithContext is the same as CreateApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) CreateApplicationWithContext(ctx aws.Context, input *CreateApplicationInput) (*CreateApplicationOutput, error) {
+	req, out := c.CreateApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDeployment = "CreateDeployment"
 
 // CreateDeploymentRequest generates a request for the CreateDeployment operation.
@@ -171,6 +222,16 @@ func (c *CodeDeploy) CreateDeployment(input *CreateDeploymentInput) (*CreateDepl
 	return out, err
 }
 
+// CreateDeploymentWithContext is the same as CreateDeployment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) CreateDeploymentWithContext(ctx aws.Context, input *CreateDeploymentInput) (*CreateDeploymentOutput, error) {
+	req, out := c.CreateDeploymentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDeploymentConfig = "CreateDeploymentConfig"
 
 // CreateDeploymentConfigRequest generates a request for the CreateDeploymentConfig operation.
@@ -198,6 +259,16 @@ func (c *CodeDeploy) CreateDeploymentConfig(input *CreateDeploymentConfigInput)
 	return out, err
 }
 
+// CreateDeploymentConfigWithContext is the same as CreateDeploymentConfig with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) CreateDeploymentConfigWithContext(ctx aws.Context, input *CreateDeploymentConfigInput) (*CreateDeploymentConfigOutput, error) {
+	req, out := c.CreateDeploymentConfigRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDeploymentGroup = "CreateDeploymentGroup"
 
 // CreateDeploymentGroupRequest generates a request for the CreateDeploymentGroup operation.
@@ -225,6 +296,16 @@ func (c *CodeDeploy) CreateDeploymentGroup(input *CreateDeploymentGroupInput) (*
 	return out, err
 }
 
+// CreateDeploymentGroupWithContext is the same as CreateDeploymentGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) CreateDeploymentGroupWithContext(ctx aws.Context, input *CreateDeploymentGroupInput) (*CreateDeploymentGroupOutput, error) {
+	req, out := c.CreateDeploymentGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteApplication = "DeleteApplication"
 
 // DeleteApplicationRequest generates a request for the DeleteApplication operation.
@@ -252,6 +333,16 @@ func (c *CodeDeploy) DeleteApplication(input *DeleteApplicationInput) (*DeleteAp
 	return out, err
 }
 
+// DeleteApplicationWithContext is the same as DeleteApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) DeleteApplicationWithContext(ctx aws.Context, input *DeleteApplicationInput) (*DeleteApplicationOutput, error) {
+	req, out := c.DeleteApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDeploymentConfig = "DeleteDeploymentConfig"
 
 // DeleteDeploymentConfigRequest generates a request for the DeleteDeploymentConfig operation.
@@ -282,6 +373,16 @@ func (c *CodeDeploy) DeleteDeploymentConfig(input *DeleteDeploymentConfigInput)
 	return out, err
 }
 
+// DeleteDeploymentConfigWithContext is the same as DeleteDeploymentConfig with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) DeleteDeploymentConfigWithContext(ctx aws.Context, input *DeleteDeploymentConfigInput) (*DeleteDeploymentConfigOutput, error) {
+	req, out := c.DeleteDeploymentConfigRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDeploymentGroup = "DeleteDeploymentGroup"
 
 // DeleteDeploymentGroupRequest generates a request for the DeleteDeploymentGroup operation.
@@ -309,6 +410,16 @@ func (c *CodeDeploy) DeleteDeploymentGroup(input *DeleteDeploymentGroupInput) (*
 	return out, err
 }
 
+// DeleteDeploymentGroupWithContext is the same as DeleteDeploymentGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) DeleteDeploymentGroupWithContext(ctx aws.Context, input *DeleteDeploymentGroupInput) (*DeleteDeploymentGroupOutput, error) {
+	req, out := c.DeleteDeploymentGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterOnPremisesInstance = "DeregisterOnPremisesInstance"
 
 // DeregisterOnPremisesInstanceRequest generates a request for the DeregisterOnPremisesInstance operation.
@@ -336,6 +447,16 @@ func (c *CodeDeploy) DeregisterOnPremisesInstance(input *DeregisterOnPremisesIns
 	return out, err
 }
 
+// DeregisterOnPremisesInstanceWithContext is the same as DeregisterOnPremisesInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) DeregisterOnPremisesInstanceWithContext(ctx aws.Context, input *DeregisterOnPremisesInstanceInput) (*DeregisterOnPremisesInstanceOutput, error) {
+	req, out := c.DeregisterOnPremisesInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetApplication = "GetApplication"
 
 // GetApplicationRequest generates a request for the GetApplication operation.
@@ -363,6 +484,16 @@ func (c *CodeDeploy) GetApplication(input *GetApplicationInput) (*GetApplication
 	return out, err
 }
 
+// GetApplicationWithContext is the same as GetApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) GetApplicationWithContext(ctx aws.Context, input *GetApplicationInput) (*GetApplicationOutput, error) {
+	req, out := c.GetApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetApplicationRevision = "GetApplicationRevision"
 
 // GetApplicationRevisionRequest generates a request for the GetApplicationRevision operation.
@@ -390,6 +521,16 @@ func (c *CodeDeploy) GetApplicationRevision(input *GetApplicationRevisionInput)
 	return out, err
 }
 
+// GetApplicationRevisionWithContext is the same as GetApplicationRevision with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) GetApplicationRevisionWithContext(ctx aws.Context, input *GetApplicationRevisionInput) (*GetApplicationRevisionOutput, error) {
+	req, out := c.GetApplicationRevisionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDeployment = "GetDeployment"
 
 // GetDeploymentRequest generates a request for the GetDeployment operation.
@@ -417,6 +558,16 @@ func (c *CodeDeploy) GetDeployment(input *GetDeploymentInput) (*GetDeploymentOut
 	return out, err
 }
 
+// GetDeploymentWithContext is the same as GetDeployment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) GetDeploymentWithContext(ctx aws.Context, input *GetDeploymentInput) (*GetDeploymentOutput, error) {
+	req, out := c.GetDeploymentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDeploymentConfig = "GetDeploymentConfig"
 
 // GetDeploymentConfigRequest generates a request for the GetDeploymentConfig operation.
@@ -444,6 +595,16 @@ func (c *CodeDeploy) GetDeploymentConfig(input *GetDeploymentConfigInput) (*GetD
 	return out, err
 }
 
+// GetDeploymentConfigWithContext is the same as GetDeploymentConfig with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) GetDeploymentConfigWithContext(ctx aws.Context, input *GetDeploymentConfigInput) (*GetDeploymentConfigOutput, error) {
+	req, out := c.GetDeploymentConfigRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDeploymentGroup = "GetDeploymentGroup"
 
 // GetDeploymentGroupRequest generates a request for the GetDeploymentGroup operation.
@@ -471,6 +632,16 @@ func (c *CodeDeploy) GetDeploymentGroup(input *GetDeploymentGroupInput) (*GetDep
 	return out, err
 }
 
+// GetDeploymentGroupWithContext is the same as GetDeploymentGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) GetDeploymentGroupWithContext(ctx aws.Context, input *GetDeploymentGroupInput) (*GetDeploymentGroupOutput, error) {
+	req, out := c.GetDeploymentGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDeploymentInstance = "GetDeploymentInstance"
 
 // GetDeploymentInstanceRequest generates a request for the GetDeploymentInstance operation.
@@ -498,6 +669,16 @@ func (c *CodeDeploy) GetDeploymentInstance(input *GetDeploymentInstanceInput) (*
 	return out, err
 }
 
+// GetDeploymentInstanceWithContext is the same as GetDeploymentInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) GetDeploymentInstanceWithContext(ctx aws.Context, input *GetDeploymentInstanceInput) (*GetDeploymentInstanceOutput, error) {
+	req, out := c.GetDeploymentInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOnPremisesInstance = "GetOnPremisesInstance"
 
 // GetOnPremisesInstanceRequest generates a request for the GetOnPremisesInstance operation.
@@ -525,6 +706,16 @@ func (c *CodeDeploy) GetOnPremisesInstance(input *GetOnPremisesInstanceInput) (*
 	return out, err
 }
 
+// GetOnPremisesInstanceWithContext is the same as GetOnPremisesInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) GetOnPremisesInstanceWithContext(ctx aws.Context, input *GetOnPremisesInstanceInput) (*GetOnPremisesInstanceOutput, error) {
+	req, out := c.GetOnPremisesInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListApplicationRevisions = "ListApplicationRevisions"
 
 // ListApplicationRevisionsRequest generates a request for the ListApplicationRevisions operation.
@@ -558,6 +749,16 @@ func (c *CodeDeploy) ListApplicationRevisions(input *ListApplicationRevisionsInp
 	return out, err
 }
 
+// ListApplicationRevisionsWithContext is the same as ListApplicationRevisions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) ListApplicationRevisionsWithContext(ctx aws.Context, input *ListApplicationRevisionsInput) (*ListApplicationRevisionsOutput, error) {
+	req, out := c.ListApplicationRevisionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CodeDeploy) ListApplicationRevisionsPages(input *ListApplicationRevisionsInput, fn func(p *ListApplicationRevisionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListApplicationRevisionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -598,6 +799,16 @@ func (c *CodeDeploy) ListApplications(input *ListApplicationsInput) (*ListApplic
 	return out, err
 }
 
+// ListApplicationsWithContext is the same as ListApplications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) ListApplicationsWithContext(ctx aws.Context, input *ListApplicationsInput) (*ListApplicationsOutput, error) {
+	req, out := c.ListApplicationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CodeDeploy) ListApplicationsPages(input *ListApplicationsInput, fn func(p *ListApplicationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListApplicationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -638,6 +849,16 @@ func (c *CodeDeploy) ListDeploymentConfigs(input *ListDeploymentConfigsInput) (*
 	return out, err
 }
 
+// ListDeploymentConfigsWithContext is the same as ListDeploymentConfigs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) ListDeploymentConfigsWithContext(ctx aws.Context, input *ListDeploymentConfigsInput) (*ListDeploymentConfigsOutput, error) {
+	req, out := c.ListDeploymentConfigsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CodeDeploy) ListDeploymentConfigsPages(input *ListDeploymentConfigsInput, fn func(p *ListDeploymentConfigsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDeploymentConfigsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -679,6 +900,16 @@ func (c *CodeDeploy) ListDeploymentGroups(input *ListDeploymentGroupsInput) (*Li
 	return out, err
 }
 
+// ListDeploymentGroupsWithContext is the same as ListDeploymentGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) ListDeploymentGroupsWithContext(ctx aws.Context, input *ListDeploymentGroupsInput) (*ListDeploymentGroupsOutput, error) {
+	req, out := c.ListDeploymentGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CodeDeploy) ListDeploymentGroupsPages(input *ListDeploymentGroupsInput, fn func(p *ListDeploymentGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDeploymentGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -720,6 +951,16 @@ func (c *CodeDeploy) ListDeploymentInstances(input *ListDeploymentInstancesInput
 	return out, err
 }
 
+// ListDeploymentInstancesWithContext is the same as ListDeploymentInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) ListDeploymentInstancesWithContext(ctx aws.Context, input *ListDeploymentInstancesInput) (*ListDeploymentInstancesOutput, error) {
+	req, out := c.ListDeploymentInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CodeDeploy) ListDeploymentInstancesPages(input *ListDeploymentInstancesInput, fn func(p *ListDeploymentInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDeploymentInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -761,6 +1002,16 @@ func (c *CodeDeploy) ListDeployments(input *ListDeploymentsInput) (*ListDeployme
 	return out, err
 }
 
+// ListDeploymentsWithContext is the same as ListDeployments with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) ListDeploymentsWithContext(ctx aws.Context, input *ListDeploymentsInput) (*ListDeploymentsOutput, error) {
+	req, out := c.ListDeploymentsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *CodeDeploy) ListDeploymentsPages(input *ListDeploymentsInput, fn func(p *ListDeploymentsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDeploymentsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -799,6 +1050,16 @@ func (c *CodeDeploy) ListOnPremisesInstances(input *ListOnPremisesInstancesInput
 	return out, err
 }
 
+// ListOnPremisesInstancesWithContext is the same as ListOnPremisesInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) ListOnPremisesInstancesWithContext(ctx aws.Context, input *ListOnPremisesInstancesInput) (*ListOnPremisesInstancesOutput, error) {
+	req, out := c.ListOnPremisesInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterApplicationRevision = "RegisterApplicationRevision"
 
 // RegisterApplicationRevisionRequest generates a request for the RegisterApplicationRevision operation.
@@ -826,6 +1087,16 @@ func (c *CodeDeploy) RegisterApplicationRevision(input *RegisterApplicationRevis
 	return out, err
 }
 
+// RegisterApplicationRevisionWithContext is the same as RegisterApplicationRevision with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) RegisterApplicationRevisionWithContext(ctx aws.Context, input *RegisterApplicationRevisionInput) (*RegisterApplicationRevisionOutput, error) {
+	req, out := c.RegisterApplicationRevisionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterOnPremisesInstance = "RegisterOnPremisesInstance"
 
 // RegisterOnPremisesInstanceRequest generates a request for the RegisterOnPremisesInstance operation.
@@ -853,6 +1124,16 @@ func (c *CodeDeploy) RegisterOnPremisesInstance(input *RegisterOnPremisesInstanc
 	return out, err
 }
 
+// RegisterOnPremisesInstanceWithContext is the same as RegisterOnPremisesInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) RegisterOnPremisesInstanceWithContext(ctx aws.Context, input *RegisterOnPremisesInstanceInput) (*RegisterOnPremisesInstanceOutput, error) {
+	req, out := c.RegisterOnPremisesInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveTagsFromOnPremisesInstances = "RemoveTagsFromOnPremisesInstances"
 
 // RemoveTagsFromOnPremisesInstancesRequest generates a request for the RemoveTagsFromOnPremisesInstances operation.
@@ -880,6 +1161,16 @@ func (c *CodeDeploy) RemoveTagsFromOnPremisesInstances(input *RemoveTagsFromOnPr
 	return out, err
 }
 
+// RemoveTagsFromOnPremisesInstancesWithContext is the same as RemoveTagsFromOnPremisesInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) RemoveTagsFromOnPremisesInstancesWithContext(ctx aws.Context, input *RemoveTagsFromOnPremisesInstancesInput) (*RemoveTagsFromOnPremisesInstancesOutput, error) {
+	req, out := c.RemoveTagsFromOnPremisesInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStopDeployment = "StopDeployment"
 
 // StopDeploymentRequest generates a request for the StopDeployment operation.
@@ -907,6 +1198,16 @@ func (c *CodeDeploy) StopDeployment(input *StopDeploymentInput) (*StopDeployment
 	return out, err
 }
 
+// StopDeploymentWithContext is the same as StopDeployment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) StopDeploymentWithContext(ctx aws.Context, input *StopDeploymentInput) (*StopDeploymentOutput, error) {
+	req, out := c.StopDeploymentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateApplication = "UpdateApplication"
 
 // UpdateApplicationRequest generates a request for the UpdateApplication operation.
@@ -934,6 +1235,16 @@ func (c *CodeDeploy) UpdateApplication(input *UpdateApplicationInput) (*UpdateAp
 	return out, err
 }
 
+// UpdateApplicationWithContext is the same as UpdateApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) UpdateApplicationWithContext(ctx aws.Context, input *UpdateApplicationInput) (*UpdateApplicationOutput, error) {
+	req, out := c.UpdateApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDeploymentGroup = "UpdateDeploymentGroup"
 
 // UpdateDeploymentGroupRequest generates a request for the UpdateDeploymentGroup operation.
@@ -961,6 +1272,16 @@ func (c *CodeDeploy) UpdateDeploymentGroup(input *UpdateDeploymentGroupInput) (*
 	return out, err
 }
 
+// UpdateDeploymentGroupWithContext is the same as UpdateDeploymentGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CodeDeploy) UpdateDeploymentGroupWithContext(ctx aws.Context, input *UpdateDeploymentGroupInput) (*UpdateDeploymentGroupOutput, error) {
+	req, out := c.UpdateDeploymentGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the input of an adds tags to on-premises instance operation.
 type AddTagsToOnPremisesInstancesInput struct {
 	// The names of the on-premises instances to add tags to.
@@ -979,6 +1300,16 @@ type metadataAddTagsToOnPremisesInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsToOnPremisesInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsToOnPremisesInstancesInput) GoString() string {
+	return s.String()
+}
+
 type AddTagsToOnPremisesInstancesOutput struct {
 	metadataAddTagsToOnPremisesInstancesOutput `json:"-" xml:"-"`
 }
@@ -987,13 +1318,23 @@ type metadataAddTagsToOnPremisesInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsToOnPremisesInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsToOnPremisesInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Information about an application.
 type ApplicationInfo struct {
 	// The application ID.
 	ApplicationID *string `locationName:"applicationId" type:"string"`
 
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// The time that the application was created.
 	CreateTime *time.Time `locationName:"createTime" type:"timestamp" timestampFormat:"unix"`
@@ -1009,6 +1350,16 @@ type metadataApplicationInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ApplicationInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ApplicationInfo) GoString() string {
+	return s.String()
+}
+
 // Information about an Auto Scaling group.
 type AutoScalingGroup struct {
 	// An Auto Scaling lifecycle event hook name.
@@ -1024,6 +1375,16 @@ type metadataAutoScalingGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AutoScalingGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AutoScalingGroup) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a batch get applications operation.
 type BatchGetApplicationsInput struct {
 	// A list of application names, with multiple application names separated by
@@ -1037,6 +1398,16 @@ type metadataBatchGetApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetApplicationsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a batch get applications operation.
 type BatchGetApplicationsOutput struct {
 	// Information about the applications.
@@ -1049,6 +1420,16 @@ type metadataBatchGetApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetApplicationsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a batch get deployments operation.
 type BatchGetDeploymentsInput struct {
 	// A list of deployment IDs, with multiple deployment IDs separated by spaces.
@@ -1061,6 +1442,16 @@ type metadataBatchGetDeploymentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetDeploymentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetDeploymentsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a batch get deployments operation.
 type BatchGetDeploymentsOutput struct {
 	// Information about the deployments.
@@ -1073,6 +1464,16 @@ type metadataBatchGetDeploymentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetDeploymentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetDeploymentsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a batch get on-premises instances operation.
 type BatchGetOnPremisesInstancesInput struct {
 	// The names of the on-premises instances to get information about.
@@ -1085,6 +1486,16 @@ type metadataBatchGetOnPremisesInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetOnPremisesInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetOnPremisesInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a batch get on-premises instances operation.
 type BatchGetOnPremisesInstancesOutput struct {
 	// Information about the on-premises instances.
@@ -1097,11 +1508,21 @@ type metadataBatchGetOnPremisesInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetOnPremisesInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetOnPremisesInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a create application operation.
 type CreateApplicationInput struct {
 	// The name of the application. This name must be unique with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateApplicationInput `json:"-" xml:"-"`
 }
@@ -1110,6 +1531,16 @@ type metadataCreateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateApplicationInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a create application operation.
 type CreateApplicationOutput struct {
 	// A unique application ID.
@@ -1122,10 +1553,20 @@ type metadataCreateApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateApplicationOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a create deployment configuration operation.
 type CreateDeploymentConfigInput struct {
 	// The name of the deployment configuration to create.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The minimum number of healthy instances that should be available at any time
 	// during the deployment. There are two parameters expected in the input: type
@@ -1152,6 +1593,16 @@ type metadataCreateDeploymentConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentConfigInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a create deployment configuration operation.
 type CreateDeploymentConfigOutput struct {
 	// A unique deployment configuration ID.
@@ -1164,11 +1615,21 @@ type metadataCreateDeploymentConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentConfigOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a create deployment group operation.
 type CreateDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// A list of associated Auto Scaling groups.
 	AutoScalingGroups []*string `locationName:"autoScalingGroups" type:"list"`
@@ -1202,10 +1663,10 @@ type CreateDeploymentGroupInput struct {
 	// specified for either the deployment or the deployment group.  To create a
 	// custom deployment configuration, call the create deployment configuration
 	// operation.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The Amazon EC2 tags to filter on.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
@@ -1224,6 +1685,16 @@ type metadataCreateDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentGroupInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a create deployment group operation.
 type CreateDeploymentGroupOutput struct {
 	// A unique deployment group ID.
@@ -1236,11 +1707,21 @@ type metadataCreateDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a create deployment operation.
 type CreateDeploymentInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment configuration associated with the applicable
 	// IAM user or AWS account.
@@ -1248,10 +1729,10 @@ type CreateDeploymentInput struct {
 	// If not specified, the value configured in the deployment group will be used
 	// as the default. If the deployment group does not have a deployment configuration
 	// associated with it, then CodeDeployDefault.OneAtATime will be used by default.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group's name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A comment about the deployment.
 	Description *string `locationName:"description" type:"string"`
@@ -1278,6 +1759,16 @@ type metadataCreateDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a create deployment operation.
 type CreateDeploymentOutput struct {
 	// A unique deployment ID.
@@ -1290,11 +1781,21 @@ type metadataCreateDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a delete application operation.
 type DeleteApplicationInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteApplicationInput `json:"-" xml:"-"`
 }
@@ -1303,6 +1804,16 @@ type metadataDeleteApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteApplicationInput) GoString() string {
+	return s.String()
+}
+
 type DeleteApplicationOutput struct {
 	metadataDeleteApplicationOutput `json:"-" xml:"-"`
 }
@@ -1311,11 +1822,21 @@ type metadataDeleteApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteApplicationOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a delete deployment configuration operation.
 type DeleteDeploymentConfigInput struct {
 	// The name of an existing deployment configuration associated with the applicable
 	// IAM user or AWS account.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteDeploymentConfigInput `json:"-" xml:"-"`
 }
@@ -1324,6 +1845,16 @@ type metadataDeleteDeploymentConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDeploymentConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDeploymentConfigInput) GoString() string {
+	return s.String()
+}
+
 type DeleteDeploymentConfigOutput struct {
 	metadataDeleteDeploymentConfigOutput `json:"-" xml:"-"`
 }
@@ -1332,14 +1863,24 @@ type metadataDeleteDeploymentConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDeploymentConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDeploymentConfigOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a delete deployment group operation.
 type DeleteDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteDeploymentGroupInput `json:"-" xml:"-"`
 }
@@ -1348,6 +1889,16 @@ type metadataDeleteDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDeploymentGroupInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a delete deployment group operation.
 type DeleteDeploymentGroupOutput struct {
 	// If the output contains no data, and the corresponding deployment group contained
@@ -1365,6 +1916,16 @@ type metadataDeleteDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDeploymentGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Information about a deployment configuration.
 type DeploymentConfigInfo struct {
 	// The time that the deployment configuration was created.
@@ -1374,7 +1935,7 @@ type DeploymentConfigInfo struct {
 	DeploymentConfigID *string `locationName:"deploymentConfigId" type:"string"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// Information about the number or percentage of minimum healthy instances.
 	MinimumHealthyHosts *MinimumHealthyHosts `locationName:"minimumHealthyHosts" type:"structure"`
@@ -1386,22 +1947,32 @@ type metadataDeploymentConfigInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeploymentConfigInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeploymentConfigInfo) GoString() string {
+	return s.String()
+}
+
 // Information about a deployment group.
 type DeploymentGroupInfo struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A list of associated Auto Scaling groups.
 	AutoScalingGroups []*AutoScalingGroup `locationName:"autoScalingGroups" type:"list"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group ID.
 	DeploymentGroupID *string `locationName:"deploymentGroupId" type:"string"`
 
 	// The deployment group name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// The Amazon EC2 tags to filter on.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
@@ -1423,10 +1994,20 @@ type metadataDeploymentGroupInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeploymentGroupInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeploymentGroupInfo) GoString() string {
+	return s.String()
+}
+
 // Information about a deployment.
 type DeploymentInfo struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A timestamp indicating when the deployment was completed.
 	CompleteTime *time.Time `locationName:"completeTime" type:"timestamp" timestampFormat:"unix"`
@@ -1438,13 +2019,15 @@ type DeploymentInfo struct {
 	//
 	//  user: A user created the deployment. autoscaling: Auto Scaling created
 	// the deployment.
-	Creator *string `locationName:"creator" type:"string"`
+	//
+	// See the DeploymentCreator* constants for valid values.
+	Creator *string `locationName:"creator" type:"string" enum:"user,autoscaling"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// The deployment ID.
 	DeploymentID *string `locationName:"deploymentId" type:"string"`
@@ -1482,7 +2065,9 @@ type DeploymentInfo struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"unix"`
 
 	// The current state of the deployment as a whole.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the DeploymentStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"Created,Queued,InProgress,Succeeded,Failed,Stopped"`
 
 	metadataDeploymentInfo `json:"-" xml:"-"`
 }
@@ -1491,6 +2076,16 @@ type metadataDeploymentInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeploymentInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeploymentInfo) GoString() string {
+	return s.String()
+}
+
 // Information about the deployment status of the instances in the deployment.
 type DeploymentOverview struct {
 	// The number of instances that have failed in the deployment.
@@ -1515,6 +2110,16 @@ type metadataDeploymentOverview struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeploymentOverview) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeploymentOverview) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a deregister on-premises instance operation.
 type DeregisterOnPremisesInstanceInput struct {
 	// The name of the on-premises instance to deregister.
@@ -1527,6 +2132,16 @@ type metadataDeregisterOnPremisesInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterOnPremisesInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterOnPremisesInstanceInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterOnPremisesInstanceOutput struct {
 	metadataDeregisterOnPremisesInstanceOutput `json:"-" xml:"-"`
 }
@@ -1535,6 +2150,16 @@ type metadataDeregisterOnPremisesInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterOnPremisesInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterOnPremisesInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // Diagnostic information about executable scripts that are part of a deployment.
 type Diagnostics struct {
 	// The associated error code:
@@ -1545,7 +2170,9 @@ type Diagnostics struct {
 	// script did not finish running in the specified time period. ScriptFailed:
 	// The specified script failed to run as expected. UnknownError: The specified
 	// script did not run for an unknown reason.
-	ErrorCode *string `locationName:"errorCode" type:"string"`
+	//
+	// See the LifecycleErrorCode* constants for valid values.
+	ErrorCode *string `locationName:"errorCode" type:"string" enum:"Success,ScriptMissing,ScriptNotExecutable,ScriptTimedOut,ScriptFailed,UnknownError"`
 
 	// The last portion of the associated diagnostic log.
 	LogTail *string `locationName:"logTail" type:"string"`
@@ -1563,6 +2190,16 @@ type metadataDiagnostics struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Diagnostics) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Diagnostics) GoString() string {
+	return s.String()
+}
+
 // Information about a tag filter.
 type EC2TagFilter struct {
 	// The tag filter key.
@@ -1571,7 +2208,9 @@ type EC2TagFilter struct {
 	// The tag filter type:
 	//
 	//  KEY_ONLY: Key only. VALUE_ONLY: Value only. KEY_AND_VALUE: Key and value.
-	Type *string `type:"string"`
+	//
+	// See the EC2TagFilterType* constants for valid values.
+	Type *string `type:"string" enum:"KEY_ONLY,VALUE_ONLY,KEY_AND_VALUE"`
 
 	// The tag filter value.
 	Value *string `type:"string"`
@@ -1583,6 +2222,16 @@ type metadataEC2TagFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EC2TagFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EC2TagFilter) GoString() string {
+	return s.String()
+}
+
 // Information about a deployment error.
 type ErrorInformation struct {
 	// The error code:
@@ -1606,7 +2255,9 @@ type ErrorInformation struct {
 	// has timed out. REVISION_MISSING: The revision ID was missing. Note that this
 	// error code will most likely be raised if the revision is deleted after the
 	// deployment is created but before it starts.
-	Code *string `locationName:"code" type:"string"`
+	//
+	// See the ErrorCode* constants for valid values.
+	Code *string `locationName:"code" type:"string" enum:"DEPLOYMENT_GROUP_MISSING,APPLICATION_MISSING,REVISION_MISSING,IAM_ROLE_MISSING,IAM_ROLE_PERMISSIONS,NO_EC2_SUBSCRIPTION,OVER_MAX_INSTANCES,NO_INSTANCES,TIMEOUT,HEALTH_CONSTRAINTS_INVALID,HEALTH_CONSTRAINTS,INTERNAL_ERROR,THROTTLED"`
 
 	// An accompanying error message.
 	Message *string `locationName:"message" type:"string"`
@@ -1618,6 +2269,16 @@ type metadataErrorInformation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ErrorInformation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ErrorInformation) GoString() string {
+	return s.String()
+}
+
 // Information about an application revision.
 type GenericRevisionInfo struct {
 	// A list of deployment groups that use this revision.
@@ -1642,11 +2303,21 @@ type metadataGenericRevisionInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenericRevisionInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenericRevisionInfo) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a get application operation.
 type GetApplicationInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetApplicationInput `json:"-" xml:"-"`
 }
@@ -1655,6 +2326,16 @@ type metadataGetApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetApplicationInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a get application operation.
 type GetApplicationOutput struct {
 	// Information about the application.
@@ -1667,10 +2348,20 @@ type metadataGetApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetApplicationOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a get application revision operation.
 type GetApplicationRevisionInput struct {
 	// The name of the application that corresponds to the revision.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Information about the application revision to get, including the revision's
 	// type and its location.
@@ -1683,10 +2374,20 @@ type metadataGetApplicationRevisionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetApplicationRevisionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetApplicationRevisionInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a get application revision operation.
 type GetApplicationRevisionOutput struct {
 	// The name of the application that corresponds to the revision.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// Additional information about the revision, including the revision's type
 	// and its location.
@@ -1702,11 +2403,21 @@ type metadataGetApplicationRevisionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetApplicationRevisionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetApplicationRevisionOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a get deployment configuration operation.
 type GetDeploymentConfigInput struct {
 	// The name of an existing deployment configuration associated with the applicable
 	// IAM user or AWS account.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetDeploymentConfigInput `json:"-" xml:"-"`
 }
@@ -1715,6 +2426,16 @@ type metadataGetDeploymentConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentConfigInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a get deployment configuration operation.
 type GetDeploymentConfigOutput struct {
 	// Information about the deployment configuration.
@@ -1727,14 +2448,24 @@ type metadataGetDeploymentConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentConfigOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a get deployment group operation.
 type GetDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetDeploymentGroupInput `json:"-" xml:"-"`
 }
@@ -1743,6 +2474,16 @@ type metadataGetDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentGroupInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a get deployment group operation.
 type GetDeploymentGroupOutput struct {
 	// Information about the deployment group.
@@ -1755,6 +2496,16 @@ type metadataGetDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a get deployment operation.
 type GetDeploymentInput struct {
 	// An existing deployment ID associated with the applicable IAM user or AWS
@@ -1768,6 +2519,16 @@ type metadataGetDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentInput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a get deployment instance operation.
 type GetDeploymentInstanceInput struct {
 	// The unique ID of a deployment.
@@ -1783,6 +2544,16 @@ type metadataGetDeploymentInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentInstanceInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a get deployment instance operation.
 type GetDeploymentInstanceOutput struct {
 	// Information about the instance.
@@ -1795,6 +2566,16 @@ type metadataGetDeploymentInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a get deployment operation.
 type GetDeploymentOutput struct {
 	// Information about the deployment.
@@ -1807,6 +2588,16 @@ type metadataGetDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDeploymentOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a get on-premises instance operation.
 type GetOnPremisesInstanceInput struct {
 	// The name of the on-premises instance to get information about
@@ -1819,6 +2610,16 @@ type metadataGetOnPremisesInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOnPremisesInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOnPremisesInstanceInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a get on-premises instance operation.
 type GetOnPremisesInstanceOutput struct {
 	// Information about the on-premises instance.
@@ -1831,6 +2632,16 @@ type metadataGetOnPremisesInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOnPremisesInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOnPremisesInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // Information about the location of application artifacts that are stored in
 // GitHub.
 type GitHubLocation struct {
@@ -1851,6 +2662,16 @@ type metadataGitHubLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GitHubLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GitHubLocation) GoString() string {
+	return s.String()
+}
+
 // Information about an on-premises instance.
 type InstanceInfo struct {
 	// If the on-premises instance was deregistered, the time that the on-premises
@@ -1879,6 +2700,16 @@ type metadataInstanceInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceInfo) GoString() string {
+	return s.String()
+}
+
 // Information about an instance in a deployment.
 type InstanceSummary struct {
 	// The deployment ID.
@@ -1900,7 +2731,9 @@ type InstanceSummary struct {
 	// succeeded for this instance. Failed: The deployment has failed for this instance.
 	// Skipped: The deployment has been skipped for this instance. Unknown: The
 	// deployment status is unknown for this instance.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the InstanceStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"Pending,InProgress,Succeeded,Failed,Skipped,Unknown"`
 
 	metadataInstanceSummary `json:"-" xml:"-"`
 }
@@ -1909,6 +2742,16 @@ type metadataInstanceSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceSummary) GoString() string {
+	return s.String()
+}
+
 // Information about a deployment lifecycle event.
 type LifecycleEvent struct {
 	// Diagnostic information about the deployment lifecycle event.
@@ -1931,7 +2774,9 @@ type LifecycleEvent struct {
 	// has succeeded. Failed: The deployment lifecycle event has failed. Skipped:
 	// The deployment lifecycle event has been skipped. Unknown: The deployment
 	// lifecycle event is unknown.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the LifecycleEventStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"Pending,InProgress,Succeeded,Failed,Skipped,Unknown"`
 
 	metadataLifecycleEvent `json:"-" xml:"-"`
 }
@@ -1940,11 +2785,21 @@ type metadataLifecycleEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LifecycleEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LifecycleEvent) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a list application revisions operation.
 type ListApplicationRevisionsInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Whether to list revisions based on whether the revision is the target revision
 	// of an deployment group:
@@ -1953,7 +2808,9 @@ type ListApplicationRevisionsInput struct {
 	// exclude: Do not list revisions that are target revisions of a deployment
 	// group. ignore: List all revisions, regardless of whether they are target
 	// revisions of a deployment group.
-	Deployed *string `locationName:"deployed" type:"string"`
+	//
+	// See the ListStateFilterAction* constants for valid values.
+	Deployed *string `locationName:"deployed" type:"string" enum:"include,exclude,ignore"`
 
 	// An identifier that was returned from the previous list application revisions
 	// call, which can be used to return the next set of applications in the list.
@@ -1975,7 +2832,9 @@ type ListApplicationRevisionsInput struct {
 	// were first used by in a deployment. lastUsedTime: Sort the list results by
 	// when the revisions were last used in a deployment.  If not specified or set
 	// to null, the results will be returned in an arbitrary order.
-	SortBy *string `locationName:"sortBy" type:"string"`
+	//
+	// See the ApplicationRevisionSortBy* constants for valid values.
+	SortBy *string `locationName:"sortBy" type:"string" enum:"registerTime,firstUsedTime,lastUsedTime"`
 
 	// The order to sort the list results by:
 	//
@@ -1984,7 +2843,9 @@ type ListApplicationRevisionsInput struct {
 	// be sorted in ascending order.
 	//
 	// If set to null, the results will be sorted in an arbitrary order.
-	SortOrder *string `locationName:"sortOrder" type:"string"`
+	//
+	// See the SortOrder* constants for valid values.
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"ascending,descending"`
 
 	metadataListApplicationRevisionsInput `json:"-" xml:"-"`
 }
@@ -1993,6 +2854,16 @@ type metadataListApplicationRevisionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListApplicationRevisionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListApplicationRevisionsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a list application revisions operation.
 type ListApplicationRevisionsOutput struct {
 	// If the amount of information that is returned is significantly large, an
@@ -2011,6 +2882,16 @@ type metadataListApplicationRevisionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListApplicationRevisionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListApplicationRevisionsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a list applications operation.
 type ListApplicationsInput struct {
 	// An identifier that was returned from the previous list applications call,
@@ -2024,6 +2905,16 @@ type metadataListApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListApplicationsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a list applications operation.
 type ListApplicationsOutput struct {
 	// A list of application names.
@@ -2041,6 +2932,16 @@ type metadataListApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListApplicationsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a list deployment configurations operation.
 type ListDeploymentConfigsInput struct {
 	// An identifier that was returned from the previous list deployment configurations
@@ -2055,6 +2956,16 @@ type metadataListDeploymentConfigsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentConfigsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentConfigsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a list deployment configurations operation.
 type ListDeploymentConfigsOutput struct {
 	// A list of deployment configurations, including the built-in configurations
@@ -2074,11 +2985,21 @@ type metadataListDeploymentConfigsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentConfigsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentConfigsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a list deployment groups operation.
 type ListDeploymentGroupsInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// An identifier that was returned from the previous list deployment groups
 	// call, which can be used to return the next set of deployment groups in the
@@ -2092,10 +3013,20 @@ type metadataListDeploymentGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentGroupsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a list deployment groups operation.
 type ListDeploymentGroupsOutput struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A list of corresponding deployment group names.
 	DeploymentGroups []*string `locationName:"deploymentGroups" type:"list"`
@@ -2113,6 +3044,16 @@ type metadataListDeploymentGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a list deployment instances operation.
 type ListDeploymentInstancesInput struct {
 	// The unique ID of a deployment.
@@ -2141,6 +3082,16 @@ type metadataListDeploymentInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a list deployment instances operation.
 type ListDeploymentInstancesOutput struct {
 	// A list of instances IDs.
@@ -2159,18 +3110,28 @@ type metadataListDeploymentInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a list deployments operation.
 type ListDeploymentsInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A deployment creation start- and end-time range for returning a subset of
 	// the list of deployments.
 	CreateTimeRange *TimeRange `locationName:"createTimeRange" type:"structure"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A subset of deployments to list, by status:
 	//
@@ -2192,6 +3153,16 @@ type metadataListDeploymentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a list deployments operation.
 type ListDeploymentsOutput struct {
 	// A list of deployment IDs.
@@ -2209,6 +3180,16 @@ type metadataListDeploymentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeploymentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeploymentsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a list on-premises instances operation.
 //
 // .
@@ -2222,7 +3203,9 @@ type ListOnPremisesInstancesInput struct {
 	//
 	//  Deregistered: Include in the resulting list deregistered on-premises instances.
 	// Registered: Include in the resulting list registered on-premises instances.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string"`
+	//
+	// See the RegistrationStatus* constants for valid values.
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"Registered,Deregistered"`
 
 	// The on-premises instance tags that will be used to restrict the corresponding
 	// on-premises instance names that are returned.
@@ -2235,6 +3218,16 @@ type metadataListOnPremisesInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListOnPremisesInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListOnPremisesInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of list on-premises instances operation.
 type ListOnPremisesInstancesOutput struct {
 	// The list of matching on-premises instance names.
@@ -2253,6 +3246,16 @@ type metadataListOnPremisesInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListOnPremisesInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListOnPremisesInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Information about minimum healthy instances.
 type MinimumHealthyHosts struct {
 	// The minimum healthy instances type:
@@ -2270,7 +3273,9 @@ type MinimumHealthyHosts struct {
 	// will return a minimum healthy instances type of MOST_CONCURRENCY and a value
 	// of 1. This means a deployment to only one instances at a time. (You cannot
 	// set the type to MOST_CONCURRENCY, only to HOST_COUNT or FLEET_PERCENT.)
-	Type *string `locationName:"type" type:"string"`
+	//
+	// See the MinimumHealthyHostsType* constants for valid values.
+	Type *string `locationName:"type" type:"string" enum:"HOST_COUNT,FLEET_PERCENT"`
 
 	// The minimum healthy instances value.
 	Value *int64 `locationName:"value" type:"integer"`
@@ -2282,11 +3287,21 @@ type metadataMinimumHealthyHosts struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MinimumHealthyHosts) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MinimumHealthyHosts) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a register application revision operation.
 type RegisterApplicationRevisionInput struct {
 	// The name of an existing AWS CodeDeploy application associated with the applicable
 	// IAM user or AWS account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// A comment about the revision.
 	Description *string `locationName:"description" type:"string"`
@@ -2302,6 +3317,16 @@ type metadataRegisterApplicationRevisionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterApplicationRevisionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterApplicationRevisionInput) GoString() string {
+	return s.String()
+}
+
 type RegisterApplicationRevisionOutput struct {
 	metadataRegisterApplicationRevisionOutput `json:"-" xml:"-"`
 }
@@ -2310,6 +3335,16 @@ type metadataRegisterApplicationRevisionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterApplicationRevisionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterApplicationRevisionOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of register on-premises instance operation.
 type RegisterOnPremisesInstanceInput struct {
 	// The ARN of the IAM user to associate with the on-premises instance.
@@ -2325,6 +3360,16 @@ type metadataRegisterOnPremisesInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterOnPremisesInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterOnPremisesInstanceInput) GoString() string {
+	return s.String()
+}
+
 type RegisterOnPremisesInstanceOutput struct {
 	metadataRegisterOnPremisesInstanceOutput `json:"-" xml:"-"`
 }
@@ -2333,6 +3378,16 @@ type metadataRegisterOnPremisesInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterOnPremisesInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterOnPremisesInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a remove tags from on-premises instances operation.
 type RemoveTagsFromOnPremisesInstancesInput struct {
 	// The names of the on-premises instances to remove tags from.
@@ -2348,6 +3403,16 @@ type metadataRemoveTagsFromOnPremisesInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsFromOnPremisesInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsFromOnPremisesInstancesInput) GoString() string {
+	return s.String()
+}
+
 type RemoveTagsFromOnPremisesInstancesOutput struct {
 	metadataRemoveTagsFromOnPremisesInstancesOutput `json:"-" xml:"-"`
 }
@@ -2356,6 +3421,16 @@ type metadataRemoveTagsFromOnPremisesInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsFromOnPremisesInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsFromOnPremisesInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Information about an application revision's location.
 type RevisionLocation struct {
 	// Information about the location of application artifacts that are stored in
@@ -2366,7 +3441,9 @@ type RevisionLocation struct {
 	//
 	//  S3: An application revision stored in Amazon S3. GitHub: An application
 	// revision stored in GitHub.
-	RevisionType *string `locationName:"revisionType" type:"string"`
+	//
+	// See the RevisionLocationType* constants for valid values.
+	RevisionType *string `locationName:"revisionType" type:"string" enum:"S3,GitHub"`
 
 	// Information about the location of application artifacts that are stored in
 	// Amazon S3.
@@ -2379,6 +3456,16 @@ type metadataRevisionLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevisionLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevisionLocation) GoString() string {
+	return s.String()
+}
+
 // Information about the location of application artifacts that are stored in
 // Amazon S3.
 type S3Location struct {
@@ -2389,7 +3476,9 @@ type S3Location struct {
 	//
 	//  tar: A tar archive file. tgz: A compressed tar archive file. zip: A zip
 	// archive file.
-	BundleType *string `locationName:"bundleType" type:"string"`
+	//
+	// See the BundleType* constants for valid values.
+	BundleType *string `locationName:"bundleType" type:"string" enum:"tar,tgz,zip"`
 
 	// The ETag of the Amazon S3 object that represents the bundled artifacts for
 	// the application revision.
@@ -2416,6 +3505,16 @@ type metadataS3Location struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s S3Location) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s S3Location) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a stop deployment operation.
 type StopDeploymentInput struct {
 	// The unique ID of a deployment.
@@ -2428,12 +3527,24 @@ type metadataStopDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopDeploymentInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a stop deployment operation.
 type StopDeploymentOutput struct {
 	// The status of the stop deployment operation:
 	//
 	//  Pending: The stop operation is pending. Succeeded: The stop operation succeeded.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the StopStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"Pending,Succeeded"`
 
 	// An accompanying status message.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -2445,6 +3556,16 @@ type metadataStopDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopDeploymentOutput) GoString() string {
+	return s.String()
+}
+
 // Information about a tag.
 type Tag struct {
 	// The tag's key.
@@ -2460,6 +3581,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // Information about an on-premises instance tag filter.
 type TagFilter struct {
 	// The on-premises instance tag filter key.
@@ -2468,7 +3599,9 @@ type TagFilter struct {
 	// The on-premises instance tag filter type:
 	//
 	//  KEY_ONLY: Key only. VALUE_ONLY: Value only. KEY_AND_VALUE: Key and value.
-	Type *string `type:"string"`
+	//
+	// See the TagFilterType* constants for valid values.
+	Type *string `type:"string" enum:"KEY_ONLY,VALUE_ONLY,KEY_AND_VALUE"`
 
 	// The on-premises instance tag filter value.
 	Value *string `type:"string"`
@@ -2480,6 +3613,16 @@ type metadataTagFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TagFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TagFilter) GoString() string {
+	return s.String()
+}
+
 // Information about a time range.
 type TimeRange struct {
 	// The time range's end time.
@@ -2499,13 +3642,23 @@ type metadataTimeRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TimeRange) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TimeRange) GoString() string {
+	return s.String()
+}
+
 // Represents the input of an update application operation.
 type UpdateApplicationInput struct {
 	// The current name of the application that you want to change.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// The new name that you want to change the application to.
-	NewApplicationName *string `locationName:"newApplicationName" type:"string"`
+	NewApplicationName *string `locationName:"newApplicationName" type:"string" min:"1" max:"100"`
 
 	metadataUpdateApplicationInput `json:"-" xml:"-"`
 }
@@ -2514,6 +3667,16 @@ type metadataUpdateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateApplicationInput) GoString() string {
+	return s.String()
+}
+
 type UpdateApplicationOutput struct {
 	metadataUpdateApplicationOutput `json:"-" xml:"-"`
 }
@@ -2522,28 +3685,38 @@ type metadataUpdateApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateApplicationOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of an update deployment group operation.
 type UpdateDeploymentGroupInput struct {
 	// The application name corresponding to the deployment group to update.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The replacement list of Auto Scaling groups to be included in the deployment
 	// group, if you want to change them.
 	AutoScalingGroups []*string `locationName:"autoScalingGroups" type:"list"`
 
 	// The current name of the existing deployment group.
-	CurrentDeploymentGroupName *string `locationName:"currentDeploymentGroupName" type:"string" required:"true"`
+	CurrentDeploymentGroupName *string `locationName:"currentDeploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The replacement deployment configuration name to use, if you want to change
 	// it.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The replacement set of Amazon EC2 tags to filter on, if you want to change
 	// them.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
 
 	// The new name of the deployment group, if you want to change it.
-	NewDeploymentGroupName *string `locationName:"newDeploymentGroupName" type:"string"`
+	NewDeploymentGroupName *string `locationName:"newDeploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// The replacement set of on-premises instance tags for filter on, if you want
 	// to change them.
@@ -2559,6 +3732,16 @@ type metadataUpdateDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDeploymentGroupInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an update deployment group operation.
 type UpdateDeploymentGroupOutput struct {
 	// If the output contains no data, and the corresponding deployment group contained
@@ -2574,3 +3757,189 @@ type UpdateDeploymentGroupOutput struct {
 type metadataUpdateDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDeploymentGroupOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ApplicationRevisionSortBy
+	ApplicationRevisionSortByRegisterTime = "registerTime"
+	// @enum ApplicationRevisionSortBy
+	ApplicationRevisionSortByFirstUsedTime = "firstUsedTime"
+	// @enum ApplicationRevisionSortBy
+	ApplicationRevisionSortByLastUsedTime = "lastUsedTime"
+)
+
+const (
+	// @enum BundleType
+	BundleTypeTar = "tar"
+	// @enum BundleType
+	BundleTypeTgz = "tgz"
+	// @enum BundleType
+	BundleTypeZip = "zip"
+)
+
+const (
+	// @enum DeploymentCreator
+	DeploymentCreatorUser = "user"
+	// @enum DeploymentCreator
+	DeploymentCreatorAutoscaling = "autoscaling"
+)
+
+const (
+	// @enum DeploymentStatus
+	DeploymentStatusCreated = "Created"
+	// @enum DeploymentStatus
+	DeploymentStatusQueued = "Queued"
+	// @enum DeploymentStatus
+	DeploymentStatusInProgress = "InProgress"
+	// @enum DeploymentStatus
+	DeploymentStatusSucceeded = "Succeeded"
+	// @enum DeploymentStatus
+	DeploymentStatusFailed = "Failed"
+	// @enum DeploymentStatus
+	DeploymentStatusStopped = "Stopped"
+)
+
+const (
+	// @enum EC2TagFilterType
+	EC2TagFilterTypeKeyOnly = "KEY_ONLY"
+	// @enum EC2TagFilterType
+	EC2TagFilterTypeValueOnly = "VALUE_ONLY"
+	// @enum EC2TagFilterType
+	EC2TagFilterTypeKeyAndValue = "KEY_AND_VALUE"
+)
+
+const (
+	// @enum ErrorCode
+	ErrorCodeDeploymentGroupMissing = "DEPLOYMENT_GROUP_MISSING"
+	// @enum ErrorCode
+	ErrorCodeApplicationMissing = "APPLICATION_MISSING"
+	// @enum ErrorCode
+	ErrorCodeRevisionMissing = "REVISION_MISSING"
+	// @enum ErrorCode
+	ErrorCodeIamRoleMissing = "IAM_ROLE_MISSING"
+	// @enum ErrorCode
+	ErrorCodeIamRolePermissions = "IAM_ROLE_PERMISSIONS"
+	// @enum ErrorCode
+	ErrorCodeNoEc2Subscription = "NO_EC2_SUBSCRIPTION"
+	// @enum ErrorCode
+	ErrorCodeOverMaxInstances = "OVER_MAX_INSTANCES"
+	// @enum ErrorCode
+	ErrorCodeNoInstances = "NO_INSTANCES"
+	// @enum ErrorCode
+	ErrorCodeTimeout = "TIMEOUT"
+	// @enum ErrorCode
+	ErrorCodeHealthConstraintsInvalid = "HEALTH_CONSTRAINTS_INVALID"
+	// @enum ErrorCode
+	ErrorCodeHealthConstraints = "HEALTH_CONSTRAINTS"
+	// @enum ErrorCode
+	ErrorCodeInternalError = "INTERNAL_ERROR"
+	// @enum ErrorCode
+	ErrorCodeThrottled = "THROTTLED"
+)
+
+const (
+	// @enum InstanceStatus
+	InstanceStatusPending = "Pending"
+	// @enum InstanceStatus
+	InstanceStatusInProgress = "InProgress"
+	// @enum InstanceStatus
+	InstanceStatusSucceeded = "Succeeded"
+	// @enum InstanceStatus
+	InstanceStatusFailed = "Failed"
+	// @enum InstanceStatus
+	InstanceStatusSkipped = "Skipped"
+	// @enum InstanceStatus
+	InstanceStatusUnknown = "Unknown"
+)
+
+const (
+	// @enum LifecycleErrorCode
+	LifecycleErrorCodeSuccess = "Success"
+	// @enum LifecycleErrorCode
+	LifecycleErrorCodeScriptMissing = "ScriptMissing"
+	// @enum LifecycleErrorCode
+	LifecycleErrorCodeScriptNotExecutable = "ScriptNotExecutable"
+	// @enum LifecycleErrorCode
+	LifecycleErrorCodeScriptTimedOut = "ScriptTimedOut"
+	// @enum LifecycleErrorCode
+	LifecycleErrorCodeScriptFailed = "ScriptFailed"
+	// @enum LifecycleErrorCode
+	LifecycleErrorCodeUnknownError = "UnknownError"
+)
+
+const (
+	// @enum LifecycleEventStatus
+	LifecycleEventStatusPending = "Pending"
+	// @enum LifecycleEventStatus
+	LifecycleEventStatusInProgress = "InProgress"
+	// @enum LifecycleEventStatus
+	LifecycleEventStatusSucceeded = "Succeeded"
+	// @enum LifecycleEventStatus
+	LifecycleEventStatusFailed = "Failed"
+	// @enum LifecycleEventStatus
+	LifecycleEventStatusSkipped = "Skipped"
+	// @enum LifecycleEventStatus
+	LifecycleEventStatusUnknown = "Unknown"
+)
+
+const (
+	// @enum ListStateFilterAction
+	ListStateFilterActionInclude = "include"
+	// @enum ListStateFilterAction
+	ListStateFilterActionExclude = "exclude"
+	// @enum ListStateFilterAction
+	ListStateFilterActionIgnore = "ignore"
+)
+
+const (
+	// @enum MinimumHealthyHostsType
+	MinimumHealthyHostsTypeHostCount = "HOST_COUNT"
+	// @enum MinimumHealthyHostsType
+	MinimumHealthyHostsTypeFleetPercent = "FLEET_PERCENT"
+)
+
+const (
+	// @enum RegistrationStatus
+	RegistrationStatusRegistered = "Registered"
+	// @enum RegistrationStatus
+	RegistrationStatusDeregistered = "Deregistered"
+)
+
+const (
+	// @enum RevisionLocationType
+	RevisionLocationTypeS3 = "S3"
+	// @enum RevisionLocationType
+	RevisionLocationTypeGitHub = "GitHub"
+)
+
+const (
+	// @enum SortOrder
+	SortOrderAscending = "ascending"
+	// @enum SortOrder
+	SortOrderDescending = "descending"
+)
+
+const (
+	// @enum StopStatus
+	StopStatusPending = "Pending"
+	// @enum StopStatus
+	StopStatusSucceeded = "Succeeded"
+)
+
+const (
+	// @enum TagFilterType
+	TagFilterTypeKeyOnly = "KEY_ONLY"
+	// @enum TagFilterType
+	TagFilterTypeValueOnly = "VALUE_ONLY"
+	// @enum TagFilterType
+	TagFilterTypeKeyAndValue = "KEY_AND_VALUE"
+)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateIdentityPool = "CreateIdentityPool"
@@ -38,6 +39,16 @@ func (c *CognitoIdentity) CreateIdentityPool(input *CreateIdentityPoolInput) (*I
 	return out, err
 }
 
+// CreateIdentityPoolWithContext is the same as CreateIdentityPool with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) CreateIdentityPoolWithContext(ctx aws.Context, input *CreateIdentityPoolInput) (*IdentityPool, error) {
+	req, out := c.CreateIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteIdentities = "DeleteIdentities"
 
 // DeleteIdentitiesRequest generates a request for the DeleteIdentities operation.
@@ -68,6 +79,16 @@ func (c *CognitoIdentity) DeleteIdentities(input *DeleteIdentitiesInput) (*Delet
 	return out, err
 }
 
+// DeleteIdentitiesWithContext is the same as DeleteIdentities with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) DeleteIdentitiesWithContext(ctx aws.Context, input *DeleteIdentitiesInput) (*DeleteIdentitiesOutput, error) {
+	req, out := c.DeleteIdentitiesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteIdentityPool = "DeleteIdentityPool"
 
 // DeleteIdentityPoolRequest generates a request for the DeleteIdentityPool operation.
@@ -98,6 +119,16 @@ func (c *CognitoIdentity) DeleteIdentityPool(input *DeleteIdentityPoolInput) (*D
 	return out, err
 }
 
+// DeleteIdentityPoolWithContext is the same as DeleteIdentityPool with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) DeleteIdentityPoolWithContext(ctx aws.Context, input *DeleteIdentityPoolInput) (*DeleteIdentityPoolOutput, error) {
+	req, out := c.DeleteIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIdentity = "DescribeIdentity"
 
 // DescribeIdentityRequest generates a request for the DescribeIdentity operation.
@@ -128,6 +159,16 @@ func (c *CognitoIdentity) DescribeIdentity(input *DescribeIdentityInput) (*Ident
 	return out, err
 }
 
+// DescribeIdentityWithContext is the same as DescribeIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) DescribeIdentityWithContext(ctx aws.Context, input *DescribeIdentityInput) (*IdentityDescription, error) {
+	req, out := c.DescribeIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIdentityPool = "DescribeIdentityPool"
 
 // DescribeIdentityPoolRequest generates a request for the DescribeIdentityPool operation.
@@ -158,6 +199,16 @@ func (c *CognitoIdentity) DescribeIdentityPool(input *DescribeIdentityPoolInput)
 	return out, err
 }
 
+// DescribeIdentityPoolWithContext is the same as DescribeIdentityPool with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) DescribeIdentityPoolWithContext(ctx aws.Context, input *DescribeIdentityPoolInput) (*IdentityPool, error) {
+	req, out := c.DescribeIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCredentialsForIdentity = "GetCredentialsForIdentity"
 
 // GetCredentialsForIdentityRequest generates a request for the GetCredentialsForIdentity operation.
@@ -190,6 +241,16 @@ func (c *CognitoIdentity) GetCredentialsForIdentity(input *GetCredentialsForIden
 	return out, err
 }
 
+// GetCredentialsForIdentityWithContext is the same as GetCredentialsForIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) GetCredentialsForIdentityWithContext(ctx aws.Context, input *GetCredentialsForIdentityInput) (*GetCredentialsForIdentityOutput, error) {
+	req, out := c.GetCredentialsForIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetID = "GetId"
 
 // GetIDRequest generates a request for the GetID operation.
@@ -222,6 +283,16 @@ func (c *CognitoIdentity) GetID(input *GetIDInput) (*GetIDOutput, error) {
 	return out, err
 }
 
+// GetIDWithContext is the same as GetID with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) GetIDWithContext(ctx aws.Context, input *GetIDInput) (*GetIDOutput, error) {
+	req, out := c.GetIDRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetIdentityPoolRoles = "GetIdentityPoolRoles"
 
 // GetIdentityPoolRolesRequest generates a request for the GetIdentityPoolRoles operation.
@@ -251,6 +322,16 @@ func (c *CognitoIdentity) GetIdentityPoolRoles(input *GetIdentityPoolRolesInput)
 	return out, err
 }
 
+// GetIdentityPoolRolesWithContext is the same as GetIdentityPoolRoles with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) GetIdentityPoolRolesWithContext(ctx aws.Context, input *GetIdentityPoolRolesInput) (*GetIdentityPoolRolesOutput, error) {
+	req, out := c.GetIdentityPoolRolesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOpenIDToken = "GetOpenIdToken"
 
 // GetOpenIDTokenRequest generates a request for the GetOpenIDToken operation.
@@ -284,6 +365,16 @@ func (c *CognitoIdentity) GetOpenIDToken(input *GetOpenIDTokenInput) (*GetOpenID
 	return out, err
 }
 
+// GetOpenIDTokenWithContext is the same as GetOpenIDToken with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) GetOpenIDTokenWithContext(ctx aws.Context, input *GetOpenIDTokenInput) (*GetOpenIDTokenOutput, error) {
+	req, out := c.GetOpenIDTokenRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOpenIDTokenForDeveloperIdentity = "GetOpenIdTokenForDeveloperIdentity"
 
 // GetOpenIDTokenForDeveloperIdentityRequest generates a request for the GetOpenIDTokenForDeveloperIdentity operation.
@@ -326,6 +417,16 @@ func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentity(input *GetOpenIDTok
 	return out, err
 }
 
+// GetOpenIDTokenForDeveloperIdentityWithContext is the same as GetOpenIDTokenForDeveloperIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentityWithContext(ctx aws.Context, input *GetOpenIDTokenForDeveloperIdentityInput) (*GetOpenIDTokenForDeveloperIdentityOutput, error) {
+	req, out := c.GetOpenIDTokenForDeveloperIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListIdentities = "ListIdentities"
 
 // ListIdentitiesRequest generates a request for the ListIdentities operation.
@@ -355,6 +456,16 @@ func (c *CognitoIdentity) ListIdentities(input *ListIdentitiesInput) (*ListIdent
 	return out, err
 }
 
+// ListIdentitiesWithContext is the same as ListIdentities with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) ListIdentitiesWithContext(ctx aws.Context, input *ListIdentitiesInput) (*ListIdentitiesOutput, error) {
+	req, out := c.ListIdentitiesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListIdentityPools = "ListIdentityPools"
 
 // ListIdentityPoolsRequest generates a request for the ListIdentityPools operation.
@@ -384,6 +495,16 @@ func (c *CognitoIdentity) ListIdentityPools(input *ListIdentityPoolsInput) (*Lis
 	return out, err
 }
 
+// ListIdentityPoolsWithContext is the same as ListIdentityPools with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) ListIdentityPoolsWithContext(ctx aws.Context, input *ListIdentityPoolsInput) (*ListIdentityPoolsOutput, error) {
+	req, out := c.ListIdentityPoolsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opLookupDeveloperIdentity = "LookupDeveloperIdentity"
 
 // LookupDeveloperIdentityRequest generates a request for the LookupDeveloperIdentity operation.
@@ -420,6 +541,16 @@ func (c *CognitoIdentity) LookupDeveloperIdentity(input *LookupDeveloperIdentity
 	return out, err
 }
 
+// LookupDeveloperIdentityWithContext is the same as LookupDeveloperIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) LookupDeveloperIdentityWithContext(ctx aws.Context, input *LookupDeveloperIdentityInput) (*LookupDeveloperIdentityOutput, error) {
+	req, out := c.LookupDeveloperIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opMergeDeveloperIdentities = "MergeDeveloperIdentities"
 
 // MergeDeveloperIdentitiesRequest generates a request for the MergeDeveloperIdentities operation.
@@ -455,6 +586,16 @@ func (c *CognitoIdentity) MergeDeveloperIdentities(input *MergeDeveloperIdentiti
 	return out, err
 }
 
+// MergeDeveloperIdentitiesWithContext is the same as MergeDeveloperIdentities with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) MergeDeveloperIdentitiesWithContext(ctx aws.Context, input *MergeDeveloperIdentitiesInput) (*MergeDeveloperIdentitiesOutput, error) {
+	req, out := c.MergeDeveloperIdentitiesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetIdentityPoolRoles = "SetIdentityPoolRoles"
 
 // SetIdentityPoolRolesRequest generates a request for the SetIdentityPoolRoles operation.
@@ -485,6 +626,16 @@ func (c *CognitoIdentity) SetIdentityPoolRoles(input *SetIdentityPoolRolesInput)
 	return out, err
 }
 
+// SetIdentityPoolRolesWithContext is the same as SetIdentityPoolRoles with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) SetIdentityPoolRolesWithContext(ctx aws.Context, input *SetIdentityPoolRolesInput) (*SetIdentityPoolRolesOutput, error) {
+	req, out := c.SetIdentityPoolRolesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnlinkDeveloperIdentity = "UnlinkDeveloperIdentity"
 
 // UnlinkDeveloperIdentityRequest generates a request for the UnlinkDeveloperIdentity operation.
@@ -517,6 +668,16 @@ func (c *CognitoIdentity) UnlinkDeveloperIdentity(input *UnlinkDeveloperIdentity
 	return out, err
 }
 
+// UnlinkDeveloperIdentityWithContext is the same as UnlinkDeveloperIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) UnlinkDeveloperIdentityWithContext(ctx aws.Context, input *UnlinkDeveloperIdentityInput) (*UnlinkDeveloperIdentityOutput, error) {
+	req, out := c.UnlinkDeveloperIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnlinkIdentity = "UnlinkIdentity"
 
 // UnlinkIdentityRequest generates a request for the UnlinkIdentity operation.
@@ -548,6 +709,16 @@ func (c *CognitoIdentity) UnlinkIdentity(input *UnlinkIdentityInput) (*UnlinkIde
 	return out, err
 }
 
+// UnlinkIdentityWithContext is the same as UnlinkIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) UnlinkIdentityWithContext(ctx aws.Context, input *UnlinkIdentityInput) (*UnlinkIdentityOutput, error) {
+	req, out := c.UnlinkIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateIdentityPool = "UpdateIdentityPool"
 
 // UpdateIdentityPoolRequest generates a request for the UpdateIdentityPool operation.
@@ -577,6 +748,16 @@ func (c *CognitoIdentity) UpdateIdentityPool(input *IdentityPool) (*IdentityPool
 	return out, err
 }
 
+// UpdateIdentityPoolWithContext is the same as UpdateIdentityPool with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoIdentity) UpdateIdentityPoolWithContext(ctx aws.Context, input *IdentityPool) (*IdentityPool, error) {
+	req, out := c.UpdateIdentityPoolRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Input to the CreateIdentityPool action.
 type CreateIdentityPoolInput struct {
 	// TRUE if the identity pool supports unauthenticated logins.
@@ -589,16 +770,16 @@ type CreateIdentityPoolInput struct {
 	//
 	// Once you have set a developer provider name, you cannot change it. Please
 	// take care in setting this parameter.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders map[string]*string `type:"map"`
+	SupportedLoginProviders map[string]*string `type:"map" max:"10"`
 
 	metadataCreateIdentityPoolInput `json:"-" xml:"-"`
 }
@@ -607,6 +788,16 @@ type metadataCreateIdentityPoolInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateIdentityPoolInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateIdentityPoolInput) GoString() string {
+	return s.String()
+}
+
 // Credentials for the the provided identity ID.
 type Credentials struct {
 	// The Access Key portion of the credentials.
@@ -619,7 +810,7 @@ type Credentials struct {
 	SecretKey *string `type:"string"`
 
 	// The Session Token portion of the credentials
-	SessionToken *string `type:"string"`
+	SessionToken *string `type:"string" sensitive:"true"`
 
 	metadataCredentials `json:"-" xml:"-"`
 }
@@ -628,10 +819,20 @@ type metadataCredentials struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Credentials) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Credentials) GoString() string {
+	return s.String()
+}
+
 // Input to the DeleteIdentities action.
 type DeleteIdentitiesInput struct {
 	// A list of 1-60 identities that you want to delete.
-	IdentityIDsToDelete []*string `locationName:"IdentityIdsToDelete" type:"list" required:"true"`
+	IdentityIDsToDelete []*string `locationName:"IdentityIdsToDelete" type:"list" min:"1" max:"60" required:"true"`
 
 	metadataDeleteIdentitiesInput `json:"-" xml:"-"`
 }
@@ -640,11 +841,21 @@ type metadataDeleteIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIdentitiesInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a successful DeleteIdentities operation.
 type DeleteIdentitiesOutput struct {
 	// An array of UnprocessedIdentityId objects, each of which contains an ErrorCode
 	// and IdentityId.
-	UnprocessedIdentityIDs []*UnprocessedIdentityID `locationName:"UnprocessedIdentityIds" type:"list"`
+	UnprocessedIdentityIDs []*UnprocessedIdentityID `locationName:"UnprocessedIdentityIds" type:"list" max:"60"`
 
 	metadataDeleteIdentitiesOutput `json:"-" xml:"-"`
 }
@@ -653,10 +864,20 @@ type metadataDeleteIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIdentitiesOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the DeleteIdentityPool action.
 type DeleteIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDeleteIdentityPoolInput `json:"-" xml:"-"`
 }
@@ -665,6 +886,16 @@ type metadataDeleteIdentityPoolInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIdentityPoolInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIdentityPoolInput) GoString() string {
+	return s.String()
+}
+
 type DeleteIdentityPoolOutput struct {
 	metadataDeleteIdentityPoolOutput `json:"-" xml:"-"`
 }
@@ -673,10 +904,20 @@ type metadataDeleteIdentityPoolOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIdentityPoolOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIdentityPoolOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the DescribeIdentity action.
 type DescribeIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityInput `json:"-" xml:"-"`
 }
@@ -685,10 +926,20 @@ type metadataDescribeIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIdentityInput) GoString() string {
+	return s.String()
+}
+
 // Input to the DescribeIdentityPool action.
 type DescribeIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityPoolInput `json:"-" xml:"-"`
 }
@@ -697,13 +948,23 @@ type metadataDescribeIdentityPoolInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIdentityPoolInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIdentityPoolInput) GoString() string {
+	return s.String()
+}
+
 // Input to the GetCredentialsForIdentity action.
 type GetCredentialsForIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins map[string]*string `type:"map"`
+	Logins map[string]*string `type:"map" max:"10"`
 
 	metadataGetCredentialsForIdentityInput `json:"-" xml:"-"`
 }
@@ -712,13 +973,23 @@ type metadataGetCredentialsForIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCredentialsForIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCredentialsForIdentityInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a successful GetCredentialsForIdentity operation.
 type GetCredentialsForIdentityOutput struct {
 	// Credentials for the the provided identity ID.
 	Credentials *Credentials `type:"structure"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetCredentialsForIdentityOutput `json:"-" xml:"-"`
 }
@@ -727,20 +998,30 @@ type metadataGetCredentialsForIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCredentialsForIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCredentialsForIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the GetId action.
 type GetIDInput struct {
 	// A standard AWS account ID (9+ digits).
-	AccountID *string `locationName:"AccountId" type:"string"`
+	AccountID *string `locationName:"AccountId" type:"string" min:"1" max:"15" pattern:"\\d+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	//
 	// The available provider names for Logins are as follows:  Facebook: graph.facebook.com
 	//  Google: accounts.google.com  Amazon: www.amazon.com  Twitter: www.twitter.com
 	//  Digits: www.digits.com
-	Logins map[string]*string `type:"map"`
+	Logins map[string]*string `type:"map" max:"10"`
 
 	metadataGetIDInput `json:"-" xml:"-"`
 }
@@ -749,10 +1030,20 @@ type metadataGetIDInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIDInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIDInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a GetId request.
 type GetIDOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetIDOutput `json:"-" xml:"-"`
 }
@@ -761,10 +1052,20 @@ type metadataGetIDOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIDOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIDOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the GetIdentityPoolRoles action.
 type GetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetIdentityPoolRolesInput `json:"-" xml:"-"`
 }
@@ -773,14 +1074,24 @@ type metadataGetIdentityPoolRolesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityPoolRolesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityPoolRolesInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a successful GetIdentityPoolRoles operation.
 type GetIdentityPoolRolesOutput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// The map of roles associated with this pool. Currently only authenticated
 	// and unauthenticated roles are supported.
-	Roles map[string]*string `type:"map"`
+	Roles map[string]*string `type:"map" max:"2"`
 
 	metadataGetIdentityPoolRolesOutput `json:"-" xml:"-"`
 }
@@ -789,13 +1100,23 @@ type metadataGetIdentityPoolRolesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityPoolRolesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityPoolRolesOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the GetOpenIdTokenForDeveloperIdentity action.
 type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	// Each name-value pair represents a user from a public provider or developer
@@ -806,7 +1127,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// The developer user identifier is an identifier from your backend that uniquely
 	// identifies a user. When you create an identity pool, you can specify the
 	// supported logins.
-	Logins map[string]*string `type:"map" required:"true"`
+	Logins map[string]*string `type:"map" max:"10" required:"true"`
 
 	// The expiration time of the token, in seconds. You can specify a custom expiration
 	// time for the token so that you can cache it. If you don't provide an expiration
@@ -816,7 +1137,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// take care in setting the expiration time for a token, as there are significant
 	// security implications: an attacker could use a leaked token to access your
 	// AWS resources for the token's duration.
-	TokenDuration *int64 `type:"long"`
+	TokenDuration *int64 `type:"long" min:"1" max:"86400"`
 
 	metadataGetOpenIDTokenForDeveloperIdentityInput `json:"-" xml:"-"`
 }
@@ -825,10 +1146,20 @@ type metadataGetOpenIDTokenForDeveloperIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOpenIDTokenForDeveloperIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOpenIDTokenForDeveloperIdentityInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a successful GetOpenIdTokenForDeveloperIdentity request.
 type GetOpenIDTokenForDeveloperIdentityOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An OpenID token.
 	Token *string `type:"string"`
@@ -840,16 +1171,26 @@ type metadataGetOpenIDTokenForDeveloperIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOpenIDTokenForDeveloperIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOpenIDTokenForDeveloperIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the GetOpenIdToken action.
 type GetOpenIDTokenInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	// When using graph.facebook.com and www.amazon.com, supply the access_token
 	// returned from the provider's authflow. For accounts.google.com or any other
 	// OpenId Connect provider, always include the id_token.
-	Logins map[string]*string `type:"map"`
+	Logins map[string]*string `type:"map" max:"10"`
 
 	metadataGetOpenIDTokenInput `json:"-" xml:"-"`
 }
@@ -858,11 +1199,21 @@ type metadataGetOpenIDTokenInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOpenIDTokenInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOpenIDTokenInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a successful GetOpenIdToken request.
 type GetOpenIDTokenOutput struct {
 	// A unique identifier in the format REGION:GUID. Note that the IdentityId returned
 	// may not match the one passed on input.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An OpenID token, valid for 15 minutes.
 	Token *string `type:"string"`
@@ -874,13 +1225,23 @@ type metadataGetOpenIDTokenOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOpenIDTokenOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOpenIDTokenOutput) GoString() string {
+	return s.String()
+}
+
 // A description of the identity.
 type IdentityDescription struct {
 	// Date on which the identity was created.
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -895,25 +1256,35 @@ type metadataIdentityDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityDescription) GoString() string {
+	return s.String()
+}
+
 // An object representing a Cognito identity pool.
 type IdentityPool struct {
 	// TRUE if the identity pool supports unauthenticated logins.
 	AllowUnauthenticatedIdentities *bool `type:"boolean" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders map[string]*string `type:"map"`
+	SupportedLoginProviders map[string]*string `type:"map" max:"10"`
 
 	metadataIdentityPool `json:"-" xml:"-"`
 }
@@ -922,13 +1293,23 @@ type metadataIdentityPool struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityPool) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityPool) GoString() string {
+	return s.String()
+}
+
 // A description of the identity pool.
 type IdentityPoolShortDescription struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+"`
 
 	metadataIdentityPoolShortDescription `json:"-" xml:"-"`
 }
@@ -937,6 +1318,16 @@ type metadataIdentityPoolShortDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityPoolShortDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityPoolShortDescription) GoString() string {
+	return s.String()
+}
+
 // Input to the ListIdentities action.
 type ListIdentitiesInput struct {
 	// An optional boolean parameter that allows you to hide disabled identities.
@@ -945,13 +1336,13 @@ type ListIdentitiesInput struct {
 	HideDisabled *bool `type:"boolean"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentitiesInput `json:"-" xml:"-"`
 }
@@ -960,16 +1351,26 @@ type metadataListIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentitiesInput) GoString() string {
+	return s.String()
+}
+
 // The response to a ListIdentities request.
 type ListIdentitiesOutput struct {
 	// An object containing a set of identities and associated mappings.
 	Identities []*IdentityDescription `type:"list"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentitiesOutput `json:"-" xml:"-"`
 }
@@ -978,13 +1379,23 @@ type metadataListIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentitiesOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the ListIdentityPools action.
 type ListIdentityPoolsInput struct {
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentityPoolsInput `json:"-" xml:"-"`
 }
@@ -993,13 +1404,23 @@ type metadataListIdentityPoolsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentityPoolsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentityPoolsInput) GoString() string {
+	return s.String()
+}
+
 // The result of a successful ListIdentityPools action.
 type ListIdentityPoolsOutput struct {
 	// The identity pools returned by the ListIdentityPools action.
 	IdentityPools []*IdentityPoolShortDescription `type:"list"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentityPoolsOutput `json:"-" xml:"-"`
 }
@@ -1008,21 +1429,31 @@ type metadataListIdentityPoolsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentityPoolsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentityPoolsOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the LookupDeveloperIdentityInput action.
 type LookupDeveloperIdentityInput struct {
 	// A unique ID used by your backend authentication process to identify a user.
 	// Typically, a developer identity provider would issue many developer user
 	// identifiers, in keeping with the number of users.
-	DeveloperUserIdentifier *string `type:"string"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -1030,7 +1461,7 @@ type LookupDeveloperIdentityInput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataLookupDeveloperIdentityInput `json:"-" xml:"-"`
 }
@@ -1039,6 +1470,16 @@ type metadataLookupDeveloperIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LookupDeveloperIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LookupDeveloperIdentityInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a successful LookupDeveloperIdentity action.
 type LookupDeveloperIdentityOutput struct {
 	// This is the list of developer user identifiers associated with an identity
@@ -1047,7 +1488,7 @@ type LookupDeveloperIdentityOutput struct {
 	DeveloperUserIdentifierList []*string `type:"list"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -1055,7 +1496,7 @@ type LookupDeveloperIdentityOutput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataLookupDeveloperIdentityOutput `json:"-" xml:"-"`
 }
@@ -1064,23 +1505,33 @@ type metadataLookupDeveloperIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LookupDeveloperIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LookupDeveloperIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesInput struct {
 	// User identifier for the destination user. The value should be a DeveloperUserIdentifier.
-	DestinationUserIdentifier *string `type:"string" required:"true"`
+	DestinationUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users. This is a (pseudo)
 	// domain name that you provide while creating an identity pool. This name acts
 	// as a placeholder that allows your backend and the Cognito service to communicate
 	// about the developer provider. For the DeveloperProviderName, you can use
 	// letters as well as period (.), underscore (_), and dash (-).
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// User identifier for the source user. The value should be a DeveloperUserIdentifier.
-	SourceUserIdentifier *string `type:"string" required:"true"`
+	SourceUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	metadataMergeDeveloperIdentitiesInput `json:"-" xml:"-"`
 }
@@ -1089,10 +1540,20 @@ type metadataMergeDeveloperIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MergeDeveloperIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MergeDeveloperIdentitiesInput) GoString() string {
+	return s.String()
+}
+
 // Returned in response to a successful MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataMergeDeveloperIdentitiesOutput `json:"-" xml:"-"`
 }
@@ -1101,15 +1562,25 @@ type metadataMergeDeveloperIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MergeDeveloperIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MergeDeveloperIdentitiesOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the SetIdentityPoolRoles action.
 type SetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The map of roles associated with this pool. For a given role, the key will
 	// be either "authenticated" or "unauthenticated" and the value will be the
 	// Role ARN.
-	Roles map[string]*string `type:"map" required:"true"`
+	Roles map[string]*string `type:"map" max:"2" required:"true"`
 
 	metadataSetIdentityPoolRolesInput `json:"-" xml:"-"`
 }
@@ -1118,6 +1589,16 @@ type metadataSetIdentityPoolRolesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityPoolRolesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityPoolRolesInput) GoString() string {
+	return s.String()
+}
+
 type SetIdentityPoolRolesOutput struct {
 	metadataSetIdentityPoolRolesOutput `json:"-" xml:"-"`
 }
@@ -1126,19 +1607,29 @@ type metadataSetIdentityPoolRolesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityPoolRolesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityPoolRolesOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the UnlinkDeveloperIdentity action.
 type UnlinkDeveloperIdentityInput struct {
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+" required:"true"`
 
 	// A unique ID used by your backend authentication process to identify a user.
-	DeveloperUserIdentifier *string `type:"string" required:"true"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataUnlinkDeveloperIdentityInput `json:"-" xml:"-"`
 }
@@ -1147,6 +1638,16 @@ type metadataUnlinkDeveloperIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnlinkDeveloperIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnlinkDeveloperIdentityInput) GoString() string {
+	return s.String()
+}
+
 type UnlinkDeveloperIdentityOutput struct {
 	metadataUnlinkDeveloperIdentityOutput `json:"-" xml:"-"`
 }
@@ -1155,13 +1656,23 @@ type metadataUnlinkDeveloperIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnlinkDeveloperIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnlinkDeveloperIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the UnlinkIdentity action.
 type UnlinkIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins map[string]*string `type:"map" required:"true"`
+	Logins map[string]*string `type:"map" max:"10" required:"true"`
 
 	// Provider names to unlink from this identity.
 	LoginsToRemove []*string `type:"list" required:"true"`
@@ -1173,6 +1684,16 @@ type metadataUnlinkIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnlinkIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnlinkIdentityInput) GoString() string {
+	return s.String()
+}
+
 type UnlinkIdentityOutput struct {
 	metadataUnlinkIdentityOutput `json:"-" xml:"-"`
 }
@@ -1181,14 +1702,26 @@ type metadataUnlinkIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnlinkIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnlinkIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // An array of UnprocessedIdentityId objects, each of which contains an ErrorCode
 // and IdentityId.
 type UnprocessedIdentityID struct {
 	// The error code indicating the type of error that occurred.
-	ErrorCode *string `type:"string"`
+	//
+	// See the ErrorCode* constants for valid values.
+	ErrorCode *string `type:"string" enum:"AccessDenied,InternalServerError"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataUnprocessedIdentityID `json:"-" xml:"-"`
 }
@@ -1196,3 +1729,20 @@ type UnprocessedIdentityID struct {
 type metadataUnprocessedIdentityID struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UnprocessedIdentityID) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnprocessedIdentityID) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ErrorCode
+	ErrorCodeAccessDenied = "AccessDenied"
+	// @enum ErrorCode
+	ErrorCodeInternalServerError = "InternalServerError"
+)
@@ -9,12 +9,12 @@ import (
 )
 
 var svc = cognitoidentity.New(&aws.Config{
-	Region: "mock-region",
+	Region: aws.String("mock-region"),
 })
 
 func TestUnsignedRequest_GetID(t *testing.T) {
 	req, _ := svc.GetIDRequest(&cognitoidentity.GetIDInput{
-		IdentityPoolID: aws.String("IdentityPoolId"),
+		IdentityPoolID: aws.String("us-east-1:12345678-1234-1234-1234-123456789012"),
 	})
 
 	err := req.Sign()
@@ -24,7 +24,7 @@ func TestUnsignedRequest_GetID(t *testing.T) {
 
 func TestUnsignedRequest_GetOpenIDToken(t *testing.T) {
 	req, _ := svc.GetOpenIDTokenRequest(&cognitoidentity.GetOpenIDTokenInput{
-		IdentityID: aws.String("IdentityId"),
+		IdentityID: aws.String("us-east-1:87654321-4321-4321-4321-210987654321"),
 	})
 
 	err := req.Sign()
@@ -34,7 +34,7 @@ func TestUnsignedRequest_GetOpenIDToken(t *testing.T) {
 
 func TestUnsignedRequest_GetCredentialsForIdentity(t *testing.T) {
 	req, _ := svc.GetCredentialsForIdentityRequest(&cognitoidentity.GetCredentialsForIdentityInput{
-		IdentityID: aws.String("IdentityId"),
+		IdentityID: aws.String("us-east-1:87654321-4321-4321-4321-210987654321"),
 	})
 
 	err := req.Sign()
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opBulkPublish = "BulkPublish"
@@ -42,6 +43,16 @@ func (c *CognitoSync) BulkPublish(input *BulkPublishInput) (*BulkPublishOutput,
 	return out, err
 }
 
+// BulkPublishWithContext is the same as BulkPublish with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) BulkPublishWithContext(ctx aws.Context, input *BulkPublishInput) (*BulkPublishOutput, error) {
+	req, out := c.BulkPublishRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDataset = "DeleteDataset"
 
 // DeleteDatasetRequest generates a request for the DeleteDataset operation.
@@ -75,6 +86,16 @@ func (c *CognitoSync) DeleteDataset(input *DeleteDatasetInput) (*DeleteDatasetOu
 	return out, err
 }
 
+// DeleteDatasetWithContext is the same as DeleteDataset with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) DeleteDatasetWithContext(ctx aws.Context, input *DeleteDatasetInput) (*DeleteDatasetOutput, error) {
+	req, out := c.DeleteDatasetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDataset = "DescribeDataset"
 
 // DescribeDatasetRequest generates a request for the DescribeDataset operation.
@@ -108,6 +129,16 @@ func (c *CognitoSync) DescribeDataset(input *DescribeDatasetInput) (*DescribeDat
 	return out, err
 }
 
+// DescribeDatasetWithContext is the same as DescribeDataset with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) DescribeDatasetWithContext(ctx aws.Context, input *DescribeDatasetInput) (*DescribeDatasetOutput, error) {
+	req, out := c.DescribeDatasetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIdentityPoolUsage = "DescribeIdentityPoolUsage"
 
 // DescribeIdentityPoolUsageRequest generates a request for the DescribeIdentityPoolUsage operation.
@@ -139,6 +170,16 @@ func (c *CognitoSync) DescribeIdentityPoolUsage(input *DescribeIdentityPoolUsage
 	return out, err
 }
 
+// DescribeIdentityPoolUsageWithContext is the same as DescribeIdentityPoolUsage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) DescribeIdentityPoolUsageWithContext(ctx aws.Context, input *DescribeIdentityPoolUsageInput) (*DescribeIdentityPoolUsageOutput, error) {
+	req, out := c.DescribeIdentityPoolUsageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeIdentityUsage = "DescribeIdentityUsage"
 
 // DescribeIdentityUsageRequest generates a request for the DescribeIdentityUsage operation.
@@ -170,6 +211,16 @@ func (c *CognitoSync) DescribeIdentityUsage(input *DescribeIdentityUsageInput) (
 	return out, err
 }
 
+// DescribeIdentityUsageWithContext is the same as DescribeIdentityUsage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) DescribeIdentityUsageWithContext(ctx aws.Context, input *DescribeIdentityUsageInput) (*DescribeIdentityUsageOutput, error) {
+	req, out := c.DescribeIdentityUsageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetBulkPublishDetails = "GetBulkPublishDetails"
 
 // GetBulkPublishDetailsRequest generates a request for the GetBulkPublishDetails operation.
@@ -200,6 +251,16 @@ func (c *CognitoSync) GetBulkPublishDetails(input *GetBulkPublishDetailsInput) (
 	return out, err
 }
 
+// GetBulkPublishDetailsWithContext is the same as GetBulkPublishDetails with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) GetBulkPublishDetailsWithContext(ctx aws.Context, input *GetBulkPublishDetailsInput) (*GetBulkPublishDetailsOutput, error) {
+	req, out := c.GetBulkPublishDetailsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCognitoEvents = "GetCognitoEvents"
 
 // GetCognitoEventsRequest generates a request for the GetCognitoEvents operation.
@@ -231,6 +292,16 @@ func (c *CognitoSync) GetCognitoEvents(input *GetCognitoEventsInput) (*GetCognit
 	return out, err
 }
 
+// GetCognitoEventsWithContext is the same as GetCognitoEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) GetCognitoEventsWithContext(ctx aws.Context, input *GetCognitoEventsInput) (*GetCognitoEventsOutput, error) {
+	req, out := c.GetCognitoEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetIdentityPoolConfiguration = "GetIdentityPoolConfiguration"
 
 // GetIdentityPoolConfigurationRequest generates a request for the GetIdentityPoolConfiguration operation.
@@ -261,6 +332,16 @@ func (c *CognitoSync) GetIdentityPoolConfiguration(input *GetIdentityPoolConfigu
 	return out, err
 }
 
+// GetIdentityPoolConfigurationWithContext is the same as GetIdentityPoolConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) GetIdentityPoolConfigurationWithContext(ctx aws.Context, input *GetIdentityPoolConfigurationInput) (*GetIdentityPoolConfigurationOutput, error) {
+	req, out := c.GetIdentityPoolConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListDatasets = "ListDatasets"
 
 // ListDatasetsRequest generates a request for the ListDatasets operation.
@@ -294,6 +375,16 @@ func (c *CognitoSync) ListDatasets(input *ListDatasetsInput) (*ListDatasetsOutpu
 	return out, err
 }
 
+// ListDatasetsWithContext is the same as ListDatasets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) ListDatasetsWithContext(ctx aws.Context, input *ListDatasetsInput) (*ListDatasetsOutput, error) {
+	req, out := c.ListDatasetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListIdentityPoolUsage = "ListIdentityPoolUsage"
 
 // ListIdentityPoolUsageRequest generates a request for the ListIdentityPoolUsage operation.
@@ -325,6 +416,16 @@ func (c *CognitoSync) ListIdentityPoolUsage(input *ListIdentityPoolUsageInput) (
 	return out, err
 }
 
+// ListIdentityPoolUsageWithContext is the same as ListIdentityPoolUsage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) ListIdentityPoolUsageWithContext(ctx aws.Context, input *ListIdentityPoolUsageInput) (*ListIdentityPoolUsageOutput, error) {
+	req, out := c.ListIdentityPoolUsageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListRecords = "ListRecords"
 
 // ListRecordsRequest generates a request for the ListRecords operation.
@@ -359,6 +460,16 @@ func (c *CognitoSync) ListRecords(input *ListRecordsInput) (*ListRecordsOutput,
 	return out, err
 }
 
+// ListRecordsWithContext is the same as ListRecords with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) ListRecordsWithContext(ctx aws.Context, input *ListRecordsInput) (*ListRecordsOutput, error) {
+	req, out := c.ListRecordsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterDevice = "RegisterDevice"
 
 // RegisterDeviceRequest generates a request for the RegisterDevice operation.
@@ -389,6 +500,16 @@ func (c *CognitoSync) RegisterDevice(input *RegisterDeviceInput) (*RegisterDevic
 	return out, err
 }
 
+// RegisterDeviceWithContext is the same as RegisterDevice with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) RegisterDeviceWithContext(ctx aws.Context, input *RegisterDeviceInput) (*RegisterDeviceOutput, error) {
+	req, out := c.RegisterDeviceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetCognitoEvents = "SetCognitoEvents"
 
 // SetCognitoEventsRequest generates a request for the SetCognitoEvents operation.
@@ -422,6 +543,16 @@ func (c *CognitoSync) SetCognitoEvents(input *SetCognitoEventsInput) (*SetCognit
 	return out, err
 }
 
+// SetCognitoEventsWithContext is the same as SetCognitoEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) SetCognitoEventsWithContext(ctx aws.Context, input *SetCognitoEventsInput) (*SetCognitoEventsOutput, error) {
+	req, out := c.SetCognitoEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetIdentityPoolConfiguration = "SetIdentityPoolConfiguration"
 
 // SetIdentityPoolConfigurationRequest generates a request for the SetIdentityPoolConfiguration operation.
@@ -452,6 +583,16 @@ func (c *CognitoSync) SetIdentityPoolConfiguration(input *SetIdentityPoolConfigu
 	return out, err
 }
 
+// SetIdentityPoolConfigurationWithContext is the same as SetIdentityPoolConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) SetIdentityPoolConfigurationWithContext(ctx aws.Context, input *SetIdentityPoolConfigurationInput) (*SetIdentityPoolConfigurationOutput, error) {
+	req, out := c.SetIdentityPoolConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSubscribeToDataset = "SubscribeToDataset"
 
 // SubscribeToDatasetRequest generates a request for the SubscribeToDataset operation.
@@ -483,6 +624,16 @@ func (c *CognitoSync) SubscribeToDataset(input *SubscribeToDatasetInput) (*Subsc
 	return out, err
 }
 
+// SubscribeToDatasetWithContext is the same as SubscribeToDataset with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) SubscribeToDatasetWithContext(ctx aws.Context, input *SubscribeToDatasetInput) (*SubscribeToDatasetOutput, error) {
+	req, out := c.SubscribeToDatasetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnsubscribeFromDataset = "UnsubscribeFromDataset"
 
 // UnsubscribeFromDatasetRequest generates a request for the UnsubscribeFromDataset operation.
@@ -514,6 +665,16 @@ func (c *CognitoSync) UnsubscribeFromDataset(input *UnsubscribeFromDatasetInput)
 	return out, err
 }
 
+// UnsubscribeFromDatasetWithContext is the same as UnsubscribeFromDataset with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) UnsubscribeFromDatasetWithContext(ctx aws.Context, input *UnsubscribeFromDatasetInput) (*UnsubscribeFromDatasetOutput, error) {
+	req, out := c.UnsubscribeFromDatasetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateRecords = "UpdateRecords"
 
 // UpdateRecordsRequest generates a request for the UpdateRecords operation.
@@ -556,11 +717,21 @@ func (c *CognitoSync) UpdateRecords(input *UpdateRecordsInput) (*UpdateRecordsOu
 	return out, err
 }
 
+// UpdateRecordsWithContext is the same as UpdateRecords with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *CognitoSync) UpdateRecordsWithContext(ctx aws.Context, input *UpdateRecordsInput) (*UpdateRecordsOutput, error) {
+	req, out := c.UpdateRecordsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // The input for the BulkPublish operation.
 type BulkPublishInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataBulkPublishInput `json:"-" xml:"-"`
 }
@@ -569,11 +740,21 @@ type metadataBulkPublishInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BulkPublishInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BulkPublishInput) GoString() string {
+	return s.String()
+}
+
 // The output for the BulkPublish operation.
 type BulkPublishOutput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataBulkPublishOutput `json:"-" xml:"-"`
 }
@@ -582,23 +763,35 @@ type metadataBulkPublishOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BulkPublishOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BulkPublishOutput) GoString() string {
+	return s.String()
+}
+
 // Configuration options for configure Cognito streams.
 type CognitoStreams struct {
 	// The ARN of the role Amazon Cognito can assume in order to publish to the
 	// stream. This role must grant access to Amazon Cognito (cognito-sync) to invoke
 	// PutRecord on your Cognito stream.
-	RoleARN *string `locationName:"RoleArn" type:"string"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" pattern:"arn:aws:iam::\\d+:role/.*"`
 
 	// The name of the Cognito stream to receive updates. This stream must be in
 	// the developers account and in the same region as the identity pool.
-	StreamName *string `type:"string"`
+	StreamName *string `type:"string" min:"1" max:"128"`
 
 	// Status of the Cognito streams. Valid values are: ENABLED - Streaming of updates
 	// to identity pool is enabled.
 	//
 	// DISABLED - Streaming of updates to identity pool is disabled. Bulk publish
 	// will also fail if StreamingStatus is DISABLED.
-	StreamingStatus *string `type:"string"`
+	//
+	// See the StreamingStatus* constants for valid values.
+	StreamingStatus *string `type:"string" enum:"ENABLED,DISABLED"`
 
 	metadataCognitoStreams `json:"-" xml:"-"`
 }
@@ -607,6 +800,16 @@ type metadataCognitoStreams struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CognitoStreams) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CognitoStreams) GoString() string {
+	return s.String()
+}
+
 // A collection of data for an identity pool. An identity pool can have multiple
 // datasets. A dataset is per identity and can be general or associated with
 // a particular entity in an application (like a saved game). Datasets are automatically
@@ -621,11 +824,11 @@ type Dataset struct {
 
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `type:"string"`
+	DatasetName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// The device that made the last change to this dataset.
 	LastModifiedBy *string `type:"string"`
@@ -643,19 +846,29 @@ type metadataDataset struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Dataset) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Dataset) GoString() string {
+	return s.String()
+}
+
 // A request to delete the specific dataset.
 type DeleteDatasetInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDeleteDatasetInput `json:"-" xml:"-"`
 }
@@ -664,6 +877,16 @@ type metadataDeleteDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDatasetInput) GoString() string {
+	return s.String()
+}
+
 // Response to a successful DeleteDataset request.
 type DeleteDatasetOutput struct {
 	// A collection of data for an identity pool. An identity pool can have multiple
@@ -680,20 +903,30 @@ type metadataDeleteDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDatasetOutput) GoString() string {
+	return s.String()
+}
+
 // A request for meta data about a dataset (creation date, number of records,
 // size) by owner and dataset name.
 type DescribeDatasetInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeDatasetInput `json:"-" xml:"-"`
 }
@@ -702,6 +935,16 @@ type metadataDescribeDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDatasetInput) GoString() string {
+	return s.String()
+}
+
 // Response to a successful DescribeDataset request.
 type DescribeDatasetOutput struct {
 	// Meta data for a collection of data for an identity. An identity can have
@@ -718,11 +961,21 @@ type metadataDescribeDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDatasetOutput) GoString() string {
+	return s.String()
+}
+
 // A request for usage information about the identity pool.
 type DescribeIdentityPoolUsageInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityPoolUsageInput `json:"-" xml:"-"`
 }
@@ -731,6 +984,16 @@ type metadataDescribeIdentityPoolUsageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIdentityPoolUsageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIdentityPoolUsageInput) GoString() string {
+	return s.String()
+}
+
 // Response to a successful DescribeIdentityPoolUsage request.
 type DescribeIdentityPoolUsageOutput struct {
 	// Information about the usage of the identity pool.
@@ -743,15 +1006,25 @@ type metadataDescribeIdentityPoolUsageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIdentityPoolUsageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIdentityPoolUsageOutput) GoString() string {
+	return s.String()
+}
+
 // A request for information about the usage of an identity pool.
 type DescribeIdentityUsageInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityUsageInput `json:"-" xml:"-"`
 }
@@ -760,6 +1033,16 @@ type metadataDescribeIdentityUsageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIdentityUsageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIdentityUsageInput) GoString() string {
+	return s.String()
+}
+
 // The response to a successful DescribeIdentityUsage request.
 type DescribeIdentityUsageOutput struct {
 	// Usage information for the identity.
@@ -772,11 +1055,21 @@ type metadataDescribeIdentityUsageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeIdentityUsageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeIdentityUsageOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the GetBulkPublishDetails operation.
 type GetBulkPublishDetailsInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetBulkPublishDetailsInput `json:"-" xml:"-"`
 }
@@ -785,6 +1078,16 @@ type metadataGetBulkPublishDetailsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetBulkPublishDetailsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetBulkPublishDetailsInput) GoString() string {
+	return s.String()
+}
+
 // The output for the GetBulkPublishDetails operation.
 type GetBulkPublishDetailsOutput struct {
 	// If BulkPublishStatus is SUCCEEDED, the time the last bulk publish operation
@@ -804,7 +1107,9 @@ type GetBulkPublishDetailsOutput struct {
 	//
 	// FAILED - Some portion of the data has failed to publish, check FailureMessage
 	// for the cause.
-	BulkPublishStatus *string `type:"string"`
+	//
+	// See the BulkPublishStatus* constants for valid values.
+	BulkPublishStatus *string `type:"string" enum:"NOT_STARTED,IN_PROGRESS,FAILED,SUCCEEDED"`
 
 	// If BulkPublishStatus is FAILED this field will contain the error message
 	// that caused the bulk publish to fail.
@@ -812,7 +1117,7 @@ type GetBulkPublishDetailsOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetBulkPublishDetailsOutput `json:"-" xml:"-"`
 }
@@ -821,10 +1126,20 @@ type metadataGetBulkPublishDetailsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetBulkPublishDetailsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetBulkPublishDetailsOutput) GoString() string {
+	return s.String()
+}
+
 // A request for a list of the configured Cognito Events
 type GetCognitoEventsInput struct {
 	// The Cognito Identity Pool ID for the request
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetCognitoEventsInput `json:"-" xml:"-"`
 }
@@ -833,10 +1148,20 @@ type metadataGetCognitoEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCognitoEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCognitoEventsInput) GoString() string {
+	return s.String()
+}
+
 // The response from the GetCognitoEvents request
 type GetCognitoEventsOutput struct {
 	// The Cognito Events returned from the GetCognitoEvents request
-	Events map[string]*string `type:"map"`
+	Events map[string]*string `type:"map" max:"1"`
 
 	metadataGetCognitoEventsOutput `json:"-" xml:"-"`
 }
@@ -845,12 +1170,22 @@ type metadataGetCognitoEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCognitoEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCognitoEventsOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the GetIdentityPoolConfiguration operation.
 type GetIdentityPoolConfigurationInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. This is the ID of the pool for which to return
 	// a configuration.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetIdentityPoolConfigurationInput `json:"-" xml:"-"`
 }
@@ -859,6 +1194,16 @@ type metadataGetIdentityPoolConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityPoolConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityPoolConfigurationInput) GoString() string {
+	return s.String()
+}
+
 // The output for the GetIdentityPoolConfiguration operation.
 type GetIdentityPoolConfigurationOutput struct {
 	// Options to apply to this identity pool for Amazon Cognito streams.
@@ -866,7 +1211,7 @@ type GetIdentityPoolConfigurationOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -878,6 +1223,16 @@ type metadataGetIdentityPoolConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityPoolConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityPoolConfigurationOutput) GoString() string {
+	return s.String()
+}
+
 // Usage information for the identity pool.
 type IdentityPoolUsage struct {
 	// Data storage information for the identity pool.
@@ -885,7 +1240,7 @@ type IdentityPoolUsage struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity pool was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -900,6 +1255,16 @@ type metadataIdentityPoolUsage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityPoolUsage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityPoolUsage) GoString() string {
+	return s.String()
+}
+
 // Usage information for the identity.
 type IdentityUsage struct {
 	// Total data storage for this identity.
@@ -910,11 +1275,11 @@ type IdentityUsage struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -926,15 +1291,25 @@ type metadataIdentityUsage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityUsage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityUsage) GoString() string {
+	return s.String()
+}
+
 // Request for a list of datasets for an identity.
 type ListDatasetsInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of results to be returned.
 	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
@@ -949,6 +1324,16 @@ type metadataListDatasetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDatasetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDatasetsInput) GoString() string {
+	return s.String()
+}
+
 // Returned for a successful ListDatasets request.
 type ListDatasetsOutput struct {
 	// Number of datasets returned.
@@ -967,6 +1352,16 @@ type metadataListDatasetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDatasetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDatasetsOutput) GoString() string {
+	return s.String()
+}
+
 // A request for usage information on an identity pool.
 type ListIdentityPoolUsageInput struct {
 	// The maximum number of results to be returned.
@@ -982,6 +1377,16 @@ type metadataListIdentityPoolUsageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentityPoolUsageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentityPoolUsageInput) GoString() string {
+	return s.String()
+}
+
 // Returned for a successful ListIdentityPoolUsage request.
 type ListIdentityPoolUsageOutput struct {
 	// Total number of identities for the identity pool.
@@ -1003,19 +1408,29 @@ type metadataListIdentityPoolUsageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentityPoolUsageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentityPoolUsageOutput) GoString() string {
+	return s.String()
+}
+
 // A request for a list of records.
 type ListRecordsInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The last server sync count for this record.
 	LastSyncCount *int64 `location:"querystring" locationName:"lastSyncCount" type:"long"`
@@ -1036,6 +1451,16 @@ type metadataListRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListRecordsInput) GoString() string {
+	return s.String()
+}
+
 // Returned for a successful ListRecordsRequest.
 type ListRecordsOutput struct {
 	// Total number of records.
@@ -1072,13 +1497,23 @@ type metadataListRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListRecordsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListRecordsOutput) GoString() string {
+	return s.String()
+}
+
 // Configuration options to be applied to the identity pool.
 type PushSync struct {
 	// List of SNS platform application ARNs that could be used by clients.
 	ApplicationARNs []*string `locationName:"ApplicationArns" type:"list"`
 
 	// A role configured to allow Cognito to call SNS on behalf of the developer.
-	RoleARN *string `locationName:"RoleArn" type:"string"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" pattern:"arn:aws:iam::\\d+:role/.*"`
 
 	metadataPushSync `json:"-" xml:"-"`
 }
@@ -1087,13 +1522,23 @@ type metadataPushSync struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PushSync) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PushSync) GoString() string {
+	return s.String()
+}
+
 // The basic data structure of a dataset.
 type Record struct {
 	// The last modified date of the client device.
 	DeviceLastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The key for the record.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"1024"`
 
 	// The user/device that made the last change to this record.
 	LastModifiedBy *string `type:"string"`
@@ -1105,7 +1550,7 @@ type Record struct {
 	SyncCount *int64 `type:"long"`
 
 	// The value for the record.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"1048575"`
 
 	metadataRecord `json:"-" xml:"-"`
 }
@@ -1114,22 +1559,34 @@ type metadataRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Record) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Record) GoString() string {
+	return s.String()
+}
+
 // An update operation for a record.
 type RecordPatch struct {
 	// The last modified date of the client device.
 	DeviceLastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The key associated with the record patch.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"1024" required:"true"`
 
 	// An operation, either replace or remove.
-	Op *string `type:"string" required:"true"`
+	//
+	// See the Operation* constants for valid values.
+	Op *string `type:"string" enum:"replace,remove" required:"true"`
 
 	// Last known server sync count for this record. Set to 0 if unknown.
 	SyncCount *int64 `type:"long" required:"true"`
 
 	// The value associated with the record patch.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"1048575"`
 
 	metadataRecordPatch `json:"-" xml:"-"`
 }
@@ -1138,18 +1595,30 @@ type metadataRecordPatch struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordPatch) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordPatch) GoString() string {
+	return s.String()
+}
+
 // A request to RegisterDevice.
 type RegisterDeviceInput struct {
 	// The unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. Here, the ID of the pool that the identity belongs
 	// to.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The SNS platform type (e.g. GCM, SDM, APNS, APNS_SANDBOX).
-	Platform *string `type:"string" required:"true"`
+	//
+	// See the Platform* constants for valid values.
+	Platform *string `type:"string" enum:"APNS,APNS_SANDBOX,GCM,ADM" required:"true"`
 
 	// The push token.
 	Token *string `type:"string" required:"true"`
@@ -1161,10 +1630,20 @@ type metadataRegisterDeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterDeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterDeviceInput) GoString() string {
+	return s.String()
+}
+
 // Response to a RegisterDevice request.
 type RegisterDeviceOutput struct {
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `locationName:"DeviceId" type:"string"`
+	DeviceID *string `locationName:"DeviceId" type:"string" min:"1" max:"256"`
 
 	metadataRegisterDeviceOutput `json:"-" xml:"-"`
 }
@@ -1173,15 +1652,25 @@ type metadataRegisterDeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterDeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterDeviceOutput) GoString() string {
+	return s.String()
+}
+
 // A request to configure Cognito Events"
 //
 // "
 type SetCognitoEventsInput struct {
 	// The events to configure
-	Events map[string]*string `type:"map" required:"true"`
+	Events map[string]*string `type:"map" max:"1" required:"true"`
 
 	// The Cognito Identity Pool to use when configuring Cognito Events
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataSetCognitoEventsInput `json:"-" xml:"-"`
 }
@@ -1190,6 +1679,16 @@ type metadataSetCognitoEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetCognitoEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetCognitoEventsInput) GoString() string {
+	return s.String()
+}
+
 type SetCognitoEventsOutput struct {
 	metadataSetCognitoEventsOutput `json:"-" xml:"-"`
 }
@@ -1198,6 +1697,16 @@ type metadataSetCognitoEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetCognitoEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetCognitoEventsOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the SetIdentityPoolConfiguration operation.
 type SetIdentityPoolConfigurationInput struct {
 	// Options to apply to this identity pool for Amazon Cognito streams.
@@ -1205,7 +1714,7 @@ type SetIdentityPoolConfigurationInput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. This is the ID of the pool to modify.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -1217,6 +1726,16 @@ type metadataSetIdentityPoolConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityPoolConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityPoolConfigurationInput) GoString() string {
+	return s.String()
+}
+
 // The output for the SetIdentityPoolConfiguration operation
 type SetIdentityPoolConfigurationOutput struct {
 	// Options to apply to this identity pool for Amazon Cognito streams.
@@ -1224,7 +1743,7 @@ type SetIdentityPoolConfigurationOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -1236,20 +1755,30 @@ type metadataSetIdentityPoolConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityPoolConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityPoolConfigurationOutput) GoString() string {
+	return s.String()
+}
+
 // A request to SubscribeToDatasetRequest.
 type SubscribeToDatasetInput struct {
 	// The name of the dataset to subcribe to.
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" required:"true"`
+	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. The ID of the pool to which the identity belongs.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataSubscribeToDatasetInput `json:"-" xml:"-"`
 }
@@ -1258,6 +1787,16 @@ type metadataSubscribeToDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubscribeToDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubscribeToDatasetInput) GoString() string {
+	return s.String()
+}
+
 // Response to a SubscribeToDataset request.
 type SubscribeToDatasetOutput struct {
 	metadataSubscribeToDatasetOutput `json:"-" xml:"-"`
@@ -1267,20 +1806,30 @@ type metadataSubscribeToDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubscribeToDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubscribeToDatasetOutput) GoString() string {
+	return s.String()
+}
+
 // A request to UnsubscribeFromDataset.
 type UnsubscribeFromDatasetInput struct {
 	// The name of the dataset from which to unsubcribe.
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" required:"true"`
+	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. The ID of the pool to which this identity belongs.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataUnsubscribeFromDatasetInput `json:"-" xml:"-"`
 }
@@ -1289,6 +1838,16 @@ type metadataUnsubscribeFromDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnsubscribeFromDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnsubscribeFromDatasetInput) GoString() string {
+	return s.String()
+}
+
 // Response to an UnsubscribeFromDataset request.
 type UnsubscribeFromDatasetOutput struct {
 	metadataUnsubscribeFromDatasetOutput `json:"-" xml:"-"`
@@ -1298,6 +1857,16 @@ type metadataUnsubscribeFromDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnsubscribeFromDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnsubscribeFromDatasetOutput) GoString() string {
+	return s.String()
+}
+
 // A request to post updates to records or add and delete records for a dataset
 // and user.
 type UpdateRecordsInput struct {
@@ -1307,18 +1876,18 @@ type UpdateRecordsInput struct {
 
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `locationName:"DeviceId" type:"string"`
+	DeviceID *string `locationName:"DeviceId" type:"string" min:"1" max:"256"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A list of patch operations.
 	RecordPatches []*RecordPatch `type:"list"`
@@ -1334,6 +1903,16 @@ type metadataUpdateRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateRecordsInput) GoString() string {
+	return s.String()
+}
+
 // Returned for a successful UpdateRecordsRequest.
 type UpdateRecordsOutput struct {
 	// A list of records that have been updated.
@@ -1345,3 +1924,49 @@ type UpdateRecordsOutput struct {
 type metadataUpdateRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateRecordsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateRecordsOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum BulkPublishStatus
+	BulkPublishStatusNotStarted = "NOT_STARTED"
+	// @enum BulkPublishStatus
+	BulkPublishStatusInProgress = "IN_PROGRESS"
+	// @enum BulkPublishStatus
+	BulkPublishStatusFailed = "FAILED"
+	// @enum BulkPublishStatus
+	BulkPublishStatusSucceeded = "SUCCEEDED"
+)
+
+const (
+	// @enum Operation
+	OperationReplace = "replace"
+	// @enum Operation
+	OperationRemove = "remove"
+)
+
+const (
+	// @enum Platform
+	PlatformApns = "APNS"
+	// @enum Platform
+	PlatformApnsSandbox = "APNS_SANDBOX"
+	// @enum Platform
+	PlatformGcm = "GCM"
+	// @enum Platform
+	PlatformAdm = "ADM"
+)
+
+const (
+	// @enum StreamingStatus
+	StreamingStatusEnabled = "ENABLED"
+	// @enum StreamingStatus
+	StreamingStatusDisabled = "DISABLED"
+)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opDeleteDeliveryChannel = "DeleteDeliveryChannel"
@@ -41,6 +42,16 @@ func (c *ConfigService) DeleteDeliveryChannel(input *DeleteDeliveryChannelInput)
 	return out, err
 }
 
+// DeleteDeliveryChannelWithContext is the same as DeleteDeliveryChannel with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) DeleteDeliveryChannelWithContext(ctx aws.Context, input *DeleteDeliveryChannelInput) (*DeleteDeliveryChannelOutput, error) {
+	req, out := c.DeleteDeliveryChannelRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeliverConfigSnapshot = "DeliverConfigSnapshot"
 
 // DeliverConfigSnapshotRequest generates a request for the DeliverConfigSnapshot operation.
@@ -74,6 +85,16 @@ func (c *ConfigService) DeliverConfigSnapshot(input *DeliverConfigSnapshotInput)
 	return out, err
 }
 
+// DeliverConfigSnapshotWithContext is the same as DeliverConfigSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) DeliverConfigSnapshotWithContext(ctx aws.Context, input *DeliverConfigSnapshotInput) (*DeliverConfigSnapshotOutput, error) {
+	req, out := c.DeliverConfigSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationRecorderStatus = "DescribeConfigurationRecorderStatus"
 
 // DescribeConfigurationRecorderStatusRequest generates a request for the DescribeConfigurationRecorderStatus operation.
@@ -105,6 +126,16 @@ func (c *ConfigService) DescribeConfigurationRecorderStatus(input *DescribeConfi
 	return out, err
 }
 
+// DescribeConfigurationRecorderStatusWithContext is the same as DescribeConfigurationRecorderStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) DescribeConfigurationRecorderStatusWithContext(ctx aws.Context, input *DescribeConfigurationRecorderStatusInput) (*DescribeConfigurationRecorderStatusOutput, error) {
+	req, out := c.DescribeConfigurationRecorderStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationRecorders = "DescribeConfigurationRecorders"
 
 // DescribeConfigurationRecordersRequest generates a request for the DescribeConfigurationRecorders operation.
@@ -136,6 +167,16 @@ func (c *ConfigService) DescribeConfigurationRecorders(input *DescribeConfigurat
 	return out, err
 }
 
+// DescribeConfigurationRecordersWithContext is the same as DescribeConfigurationRecorders with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) DescribeConfigurationRecordersWithContext(ctx aws.Context, input *DescribeConfigurationRecordersInput) (*DescribeConfigurationRecordersOutput, error) {
+	req, out := c.DescribeConfigurationRecordersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDeliveryChannelStatus = "DescribeDeliveryChannelStatus"
 
 // DescribeDeliveryChannelStatusRequest generates a request for the DescribeDeliveryChannelStatus operation.
@@ -167,6 +208,16 @@ func (c *ConfigService) DescribeDeliveryChannelStatus(input *DescribeDeliveryCha
 	return out, err
 }
 
+// DescribeDeliveryChannelStatusWithContext is the same as DescribeDeliveryChannelStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) DescribeDeliveryChannelStatusWithContext(ctx aws.Context, input *DescribeDeliveryChannelStatusInput) (*DescribeDeliveryChannelStatusOutput, error) {
+	req, out := c.DescribeDeliveryChannelStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDeliveryChannels = "DescribeDeliveryChannels"
 
 // DescribeDeliveryChannelsRequest generates a request for the DescribeDeliveryChannels operation.
@@ -198,6 +249,16 @@ func (c *ConfigService) DescribeDeliveryChannels(input *DescribeDeliveryChannels
 	return out, err
 }
 
+// DescribeDeliveryChannelsWithContext is the same as DescribeDeliveryChannels with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) DescribeDeliveryChannelsWithContext(ctx aws.Context, input *DescribeDeliveryChannelsInput) (*DescribeDeliveryChannelsOutput, error) {
+	req, out := c.DescribeDeliveryChannelsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetResourceConfigHistory = "GetResourceConfigHistory"
 
 // GetResourceConfigHistoryRequest generates a request for the GetResourceConfigHistory operation.
@@ -239,6 +300,16 @@ func (c *ConfigService) GetResourceConfigHistory(input *GetResourceConfigHistory
 	return out, err
 }
 
+// GetResourceConfigHistoryWithContext is the same as GetResourceConfigHistory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) GetResourceConfigHistoryWithContext(ctx aws.Context, input *GetResourceConfigHistoryInput) (*GetResourceConfigHistoryOutput, error) {
+	req, out := c.GetResourceConfigHistoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ConfigService) GetResourceConfigHistoryPages(input *GetResourceConfigHistoryInput, fn func(p *GetResourceConfigHistoryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetResourceConfigHistoryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -282,6 +353,16 @@ func (c *ConfigService) PutConfigurationRecorder(input *PutConfigurationRecorder
 	return out, err
 }
 
+// PutConfigurationRecorderWithContext is the same as PutConfigurationRecorder with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) PutConfigurationRecorderWithContext(ctx aws.Context, input *PutConfigurationRecorderInput) (*PutConfigurationRecorderOutput, error) {
+	req, out := c.PutConfigurationRecorderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutDeliveryChannel = "PutDeliveryChannel"
 
 // PutDeliveryChannelRequest generates a request for the PutDeliveryChannel operation.
@@ -319,6 +400,16 @@ func (c *ConfigService) PutDeliveryChannel(input *PutDeliveryChannelInput) (*Put
 	return out, err
 }
 
+// PutDeliveryChannelWithContext is the same as PutDeliveryChannel with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) PutDeliveryChannelWithContext(ctx aws.Context, input *PutDeliveryChannelInput) (*PutDeliveryChannelOutput, error) {
+	req, out := c.PutDeliveryChannelRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStartConfigurationRecorder = "StartConfigurationRecorder"
 
 // StartConfigurationRecorderRequest generates a request for the StartConfigurationRecorder operation.
@@ -350,6 +441,16 @@ func (c *ConfigService) StartConfigurationRecorder(input *StartConfigurationReco
 	return out, err
 }
 
+// StartConfigurationRecorderWithContext is the same as StartConfigurationRecorder with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) StartConfigurationRecorderWithContext(ctx aws.Context, input *StartConfigurationRecorderInput) (*StartConfigurationRecorderOutput, error) {
+	req, out := c.StartConfigurationRecorderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStopConfigurationRecorder = "StopConfigurationRecorder"
 
 // StopConfigurationRecorderRequest generates a request for the StopConfigurationRecorder operation.
@@ -378,6 +479,16 @@ func (c *ConfigService) StopConfigurationRecorder(input *StopConfigurationRecord
 	return out, err
 }
 
+// StopConfigurationRecorderWithContext is the same as StopConfigurationRecorder with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ConfigService) StopConfigurationRecorderWithContext(ctx aws.Context, input *StopConfigurationRecorderInput) (*StopConfigurationRecorderOutput, error) {
+	req, out := c.StopConfigurationRecorderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // A list that contains the status of the delivery of either the snapshot or
 // the configuration history to the specified Amazon S3 bucket.
 type ConfigExportDeliveryInfo struct {
@@ -391,7 +502,9 @@ type ConfigExportDeliveryInfo struct {
 	LastErrorMessage *string `locationName:"lastErrorMessage" type:"string"`
 
 	// Status of the last attempted delivery.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	//
+	// See the DeliveryStatus* constants for valid values.
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Success,Failure,Not_Applicable"`
 
 	// The time of the last successful delivery.
 	LastSuccessfulTime *time.Time `locationName:"lastSuccessfulTime" type:"timestamp" timestampFormat:"unix"`
@@ -403,6 +516,16 @@ type metadataConfigExportDeliveryInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigExportDeliveryInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigExportDeliveryInfo) GoString() string {
+	return s.String()
+}
+
 // A list that contains the status of the delivery of the configuration stream
 // notification to the Amazon SNS topic.
 type ConfigStreamDeliveryInfo struct {
@@ -417,7 +540,9 @@ type ConfigStreamDeliveryInfo struct {
 	// Note Providing an SNS topic on a DeliveryChannel (http://docs.aws.amazon.com/config/latest/APIReference/API_DeliveryChannel.html)
 	// for AWS Config is optional. If the SNS delivery is turned off, the last status
 	// will be Not_Applicable.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	//
+	// See the DeliveryStatus* constants for valid values.
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Success,Failure,Not_Applicable"`
 
 	// The time from the last status change.
 	LastStatusChangeTime *time.Time `locationName:"lastStatusChangeTime" type:"timestamp" timestampFormat:"unix"`
@@ -429,6 +554,16 @@ type metadataConfigStreamDeliveryInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigStreamDeliveryInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigStreamDeliveryInfo) GoString() string {
+	return s.String()
+}
+
 // A list that contains detailed configurations of a specified resource.
 //
 //  Currently, the list does not contain information about non-AWS components
@@ -456,7 +591,9 @@ type ConfigurationItem struct {
 	ConfigurationItemMD5Hash *string `locationName:"configurationItemMD5Hash" type:"string"`
 
 	// The configuration item status.
-	ConfigurationItemStatus *string `locationName:"configurationItemStatus" type:"string"`
+	//
+	// See the ConfigurationItemStatus* constants for valid values.
+	ConfigurationItemStatus *string `locationName:"configurationItemStatus" type:"string" enum:"Ok,Failed,Discovered,Deleted"`
 
 	// An identifier that indicates the ordering of the configuration items of a
 	// resource.
@@ -482,7 +619,9 @@ type ConfigurationItem struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The type of AWS resource.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	//
+	// See the ResourceType* constants for valid values.
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway"`
 
 	// A mapping of key value tags associated with the resource.
 	Tags map[string]*string `locationName:"tags" type:"map"`
@@ -497,13 +636,23 @@ type metadataConfigurationItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigurationItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigurationItem) GoString() string {
+	return s.String()
+}
+
 // An object that represents the recording of configuration changes of an AWS
 // resource.
 type ConfigurationRecorder struct {
 	// The name of the recorder. By default, AWS Config automatically assigns the
 	// name "default" when creating the configuration recorder. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// The recording group specifies either to record configurations for all supported
 	// resources or to provide a list of resource types to record. The list of resource
@@ -521,6 +670,16 @@ type metadataConfigurationRecorder struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigurationRecorder) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigurationRecorder) GoString() string {
+	return s.String()
+}
+
 // The current status of the configuration recorder.
 type ConfigurationRecorderStatus struct {
 	// The error code indicating that the recording failed.
@@ -533,7 +692,9 @@ type ConfigurationRecorderStatus struct {
 	LastStartTime *time.Time `locationName:"lastStartTime" type:"timestamp" timestampFormat:"unix"`
 
 	// The last (previous) status of the recorder.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	//
+	// See the RecorderStatus* constants for valid values.
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Pending,Success,Failure"`
 
 	// The time when the status was last changed.
 	LastStatusChangeTime *time.Time `locationName:"lastStatusChangeTime" type:"timestamp" timestampFormat:"unix"`
@@ -554,11 +715,21 @@ type metadataConfigurationRecorderStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigurationRecorderStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigurationRecorderStatus) GoString() string {
+	return s.String()
+}
+
 // The input for the DeleteDeliveryChannel action. The action accepts the following
 // data in JSON format.
 type DeleteDeliveryChannelInput struct {
 	// The name of the delivery channel to delete.
-	DeliveryChannelName *string `type:"string" required:"true"`
+	DeliveryChannelName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeleteDeliveryChannelInput `json:"-" xml:"-"`
 }
@@ -567,6 +738,16 @@ type metadataDeleteDeliveryChannelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDeliveryChannelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDeliveryChannelInput) GoString() string {
+	return s.String()
+}
+
 type DeleteDeliveryChannelOutput struct {
 	metadataDeleteDeliveryChannelOutput `json:"-" xml:"-"`
 }
@@ -575,10 +756,20 @@ type metadataDeleteDeliveryChannelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDeliveryChannelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDeliveryChannelOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the DeliverConfigSnapshot action.
 type DeliverConfigSnapshotInput struct {
 	// The name of the delivery channel through which the snapshot is delivered.
-	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" required:"true"`
+	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeliverConfigSnapshotInput `json:"-" xml:"-"`
 }
@@ -587,6 +778,16 @@ type metadataDeliverConfigSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeliverConfigSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeliverConfigSnapshotInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DeliverConfigSnapshot action in JSON format.
 type DeliverConfigSnapshotOutput struct {
 	// The ID of the snapshot that is being created.
@@ -599,13 +800,23 @@ type metadataDeliverConfigSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeliverConfigSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeliverConfigSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // A logical container used for storing the configuration changes of an AWS
 // resource.
 type DeliveryChannel struct {
 	// The name of the delivery channel. By default, AWS Config automatically assigns
 	// the name "default" when creating the delivery channel. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// The name of the Amazon S3 bucket used to store configuration history for
 	// the delivery channel.
@@ -625,6 +836,16 @@ type metadataDeliveryChannel struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeliveryChannel) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeliveryChannel) GoString() string {
+	return s.String()
+}
+
 // The status of a specified delivery channel.
 //
 // Valid values: Success | Failure
@@ -651,6 +872,16 @@ type metadataDeliveryChannelStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeliveryChannelStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeliveryChannelStatus) GoString() string {
+	return s.String()
+}
+
 // The input for the DescribeConfigurationRecorderStatus action.
 type DescribeConfigurationRecorderStatusInput struct {
 	// The name(s) of the configuration recorder. If the name is not specified,
@@ -665,6 +896,16 @@ type metadataDescribeConfigurationRecorderStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationRecorderStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationRecorderStatusInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeConfigurationRecorderStatus action in JSON format.
 type DescribeConfigurationRecorderStatusOutput struct {
 	// A list that contains status of the specified recorders.
@@ -677,6 +918,16 @@ type metadataDescribeConfigurationRecorderStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationRecorderStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationRecorderStatusOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the DescribeConfigurationRecorders action.
 type DescribeConfigurationRecordersInput struct {
 	// A list of configuration recorder names.
@@ -689,6 +940,16 @@ type metadataDescribeConfigurationRecordersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationRecordersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationRecordersInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeConfigurationRecorders action.
 type DescribeConfigurationRecordersOutput struct {
 	// A list that contains the descriptions of the specified configuration recorders.
@@ -701,6 +962,16 @@ type metadataDescribeConfigurationRecordersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationRecordersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationRecordersOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the DeliveryChannelStatus action.
 type DescribeDeliveryChannelStatusInput struct {
 	// A list of delivery channel names.
@@ -713,6 +984,16 @@ type metadataDescribeDeliveryChannelStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDeliveryChannelStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDeliveryChannelStatusInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeDeliveryChannelStatus action.
 type DescribeDeliveryChannelStatusOutput struct {
 	// A list that contains the status of a specified delivery channel.
@@ -725,6 +1006,16 @@ type metadataDescribeDeliveryChannelStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDeliveryChannelStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDeliveryChannelStatusOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the DescribeDeliveryChannels action.
 type DescribeDeliveryChannelsInput struct {
 	// A list of delivery channel names.
@@ -737,6 +1028,16 @@ type metadataDescribeDeliveryChannelsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDeliveryChannelsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDeliveryChannelsInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeDeliveryChannels action.
 type DescribeDeliveryChannelsOutput struct {
 	// A list that contains the descriptions of the specified delivery channel.
@@ -749,11 +1050,23 @@ type metadataDescribeDeliveryChannelsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDeliveryChannelsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDeliveryChannelsOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the GetResourceConfigHistory action.
 type GetResourceConfigHistoryInput struct {
 	// The chronological order for configuration items listed. By default the results
 	// are listed in reverse chronological order.
-	ChronologicalOrder *string `locationName:"chronologicalOrder" type:"string"`
+	//
+	// See the ChronologicalOrder* constants for valid values.
+	ChronologicalOrder *string `locationName:"chronologicalOrder" type:"string" enum:"Reverse,Forward"`
 
 	// The time stamp that indicates an earlier time. If not specified, the action
 	// returns paginated results that contain configuration items that start from
@@ -766,7 +1079,7 @@ type GetResourceConfigHistoryInput struct {
 
 	// The maximum number of configuration items returned in each page. The default
 	// is 10. You cannot specify a limit greater than 100.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"0" max:"100"`
 
 	// An optional parameter used for pagination of the results.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -775,7 +1088,9 @@ type GetResourceConfigHistoryInput struct {
 	ResourceID *string `locationName:"resourceId" type:"string" required:"true"`
 
 	// The resource type.
-	ResourceType *string `locationName:"resourceType" type:"string" required:"true"`
+	//
+	// See the ResourceType* constants for valid values.
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway" required:"true"`
 
 	metadataGetResourceConfigHistoryInput `json:"-" xml:"-"`
 }
@@ -784,6 +1099,16 @@ type metadataGetResourceConfigHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetResourceConfigHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetResourceConfigHistoryInput) GoString() string {
+	return s.String()
+}
+
 // The output for the GetResourceConfigHistory action.
 type GetResourceConfigHistoryOutput struct {
 	// A list that contains the configuration history of one or more resources.
@@ -799,6 +1124,16 @@ type metadataGetResourceConfigHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetResourceConfigHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetResourceConfigHistoryOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the PutConfigurationRecorder action.
 type PutConfigurationRecorderInput struct {
 	// The configuration recorder object that records each configuration change
@@ -812,6 +1147,16 @@ type metadataPutConfigurationRecorderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutConfigurationRecorderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutConfigurationRecorderInput) GoString() string {
+	return s.String()
+}
+
 type PutConfigurationRecorderOutput struct {
 	metadataPutConfigurationRecorderOutput `json:"-" xml:"-"`
 }
@@ -820,6 +1165,16 @@ type metadataPutConfigurationRecorderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutConfigurationRecorderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutConfigurationRecorderOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the PutDeliveryChannel action.
 type PutDeliveryChannelInput struct {
 	// The configuration delivery channel object that delivers the configuration
@@ -833,6 +1188,16 @@ type metadataPutDeliveryChannelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutDeliveryChannelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutDeliveryChannelInput) GoString() string {
+	return s.String()
+}
+
 type PutDeliveryChannelOutput struct {
 	metadataPutDeliveryChannelOutput `json:"-" xml:"-"`
 }
@@ -841,6 +1206,16 @@ type metadataPutDeliveryChannelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutDeliveryChannelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutDeliveryChannelOutput) GoString() string {
+	return s.String()
+}
+
 // The group of AWS resource types that AWS Config records when starting the
 // configuration recorder.
 //
@@ -866,6 +1241,16 @@ type metadataRecordingGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordingGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordingGroup) GoString() string {
+	return s.String()
+}
+
 // The relationship of the related resource to the main resource.
 type Relationship struct {
 	// The name of the related resource.
@@ -875,7 +1260,9 @@ type Relationship struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The resource type of the related resource.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	//
+	// See the ResourceType* constants for valid values.
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway"`
 
 	metadataRelationship `json:"-" xml:"-"`
 }
@@ -884,11 +1271,21 @@ type metadataRelationship struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Relationship) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Relationship) GoString() string {
+	return s.String()
+}
+
 // The input for the StartConfigurationRecorder action.
 type StartConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartConfigurationRecorderInput `json:"-" xml:"-"`
 }
@@ -897,6 +1294,16 @@ type metadataStartConfigurationRecorderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartConfigurationRecorderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartConfigurationRecorderInput) GoString() string {
+	return s.String()
+}
+
 type StartConfigurationRecorderOutput struct {
 	metadataStartConfigurationRecorderOutput `json:"-" xml:"-"`
 }
@@ -905,11 +1312,21 @@ type metadataStartConfigurationRecorderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartConfigurationRecorderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartConfigurationRecorderOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the StopConfigurationRecorder action.
 type StopConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStopConfigurationRecorderInput `json:"-" xml:"-"`
 }
@@ -918,6 +1335,16 @@ type metadataStopConfigurationRecorderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopConfigurationRecorderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopConfigurationRecorderInput) GoString() string {
+	return s.String()
+}
+
 type StopConfigurationRecorderOutput struct {
 	metadataStopConfigurationRecorderOutput `json:"-" xml:"-"`
 }
@@ -925,3 +1352,80 @@ type StopConfigurationRecorderOutput struct {
 type metadataStopConfigurationRecorderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s StopConfigurationRecorderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopConfigurationRecorderOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ChronologicalOrder
+	ChronologicalOrderReverse = "Reverse"
+	// @enum ChronologicalOrder
+	ChronologicalOrderForward = "Forward"
+)
+
+const (
+	// @enum ConfigurationItemStatus
+	ConfigurationItemStatusOk = "Ok"
+	// @enum ConfigurationItemStatus
+	ConfigurationItemStatusFailed = "Failed"
+	// @enum ConfigurationItemStatus
+	ConfigurationItemStatusDiscovered = "Discovered"
+	// @enum ConfigurationItemStatus
+	ConfigurationItemStatusDeleted = "Deleted"
+)
+
+const (
+	// @enum DeliveryStatus
+	DeliveryStatusSuccess = "Success"
+	// @enum DeliveryStatus
+	DeliveryStatusFailure = "Failure"
+	// @enum DeliveryStatus
+	DeliveryStatusNotApplicable = "Not_Applicable"
+)
+
+const (
+	// @enum RecorderStatus
+	RecorderStatusPending = "Pending"
+	// @enum RecorderStatus
+	RecorderStatusSuccess = "Success"
+	// @enum RecorderStatus
+	RecorderStatusFailure = "Failure"
+)
+
+const (
+	// @enum ResourceType
+	ResourceTypeAwsEc2CustomerGateway = "AWS::EC2::CustomerGateway"
+	// @enum ResourceType
+	ResourceTypeAwsEc2Eip = "AWS::EC2::EIP"
+	// @enum ResourceType
+	ResourceTypeAwsEc2Instance = "AWS::EC2::Instance"
+	// @enum ResourceType
+	ResourceTypeAwsEc2InternetGateway = "AWS::EC2::InternetGateway"
+	// @enum ResourceType
+	ResourceTypeAwsEc2NetworkAcl = "AWS::EC2::NetworkAcl"
+	// @enum ResourceType
+	ResourceTypeAwsEc2NetworkInterface = "AWS::EC2::NetworkInterface"
+	// @enum ResourceType
+	ResourceTypeAwsEc2RouteTable = "AWS::EC2::RouteTable"
+	// @enum ResourceType
+	ResourceTypeAwsEc2SecurityGroup = "AWS::EC2::SecurityGroup"
+	// @enum ResourceType
+	ResourceTypeAwsEc2Subnet = "AWS::EC2::Subnet"
+	// @enum ResourceType
+	ResourceTypeAwsCloudTrailTrail = "AWS::CloudTrail::Trail"
+	// @enum ResourceType
+	ResourceTypeAwsEc2Volume = "AWS::EC2::Volume"
+	// @enum ResourceType
+	ResourceTypeAwsEc2Vpc = "AWS::EC2::VPC"
+	// @enum ResourceType
+	ResourceTypeAwsEc2VPNConnection = "AWS::EC2::VPNConnection"
+	// @enum ResourceType
+	ResourceTypeAwsEc2VPNGateway = "AWS::EC2::VPNGateway"
+)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opActivatePipeline = "ActivatePipeline"
@@ -43,6 +44,16 @@ func (c *DataPipeline) ActivatePipeline(input *ActivatePipelineInput) (*Activate
 	return out, err
 }
 
+// ActivatePipelineWithContext is the same as ActivatePipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) ActivatePipelineWithContext(ctx aws.Context, input *ActivatePipelineInput) (*ActivatePipelineOutput, error) {
+	req, out := c.ActivatePipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddTags = "AddTags"
 
 // AddTagsRequest generates a request for the AddTags operation.
@@ -70,6 +81,16 @@ func (c *DataPipeline) AddTags(input *AddTagsInput) (*AddTagsOutput, error) {
 	return out, err
 }
 
+// AddTagsWithContext is the same as AddTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) AddTagsWithContext(ctx aws.Context, input *AddTagsInput) (*AddTagsOutput, error) {
+	req, out := c.AddTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePipeline = "CreatePipeline"
 
 // CreatePipelineRequest generates a request for the CreatePipeline operation.
@@ -98,6 +119,16 @@ func (c *DataPipeline) CreatePipeline(input *CreatePipelineInput) (*CreatePipeli
 	return out, err
 }
 
+// CreatePipelineWithContext is the same as CreatePipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) CreatePipelineWithContext(ctx aws.Context, input *CreatePipelineInput) (*CreatePipelineOutput, error) {
+	req, out := c.CreatePipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeactivatePipeline = "DeactivatePipeline"
 
 // DeactivatePipelineRequest generates a request for the DeactivatePipeline operation.
@@ -130,6 +161,16 @@ func (c *DataPipeline) DeactivatePipeline(input *DeactivatePipelineInput) (*Deac
 	return out, err
 }
 
+// DeactivatePipelineWithContext is the same as DeactivatePipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) DeactivatePipelineWithContext(ctx aws.Context, input *DeactivatePipelineInput) (*DeactivatePipelineOutput, error) {
+	req, out := c.DeactivatePipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePipeline = "DeletePipeline"
 
 // DeletePipelineRequest generates a request for the DeletePipeline operation.
@@ -164,6 +205,16 @@ func (c *DataPipeline) DeletePipeline(input *DeletePipelineInput) (*DeletePipeli
 	return out, err
 }
 
+// DeletePipelineWithContext is the same as DeletePipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) DeletePipelineWithContext(ctx aws.Context, input *DeletePipelineInput) (*DeletePipelineOutput, error) {
+	req, out := c.DeletePipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeObjects = "DescribeObjects"
 
 // DescribeObjectsRequest generates a request for the DescribeObjects operation.
@@ -199,6 +250,16 @@ func (c *DataPipeline) DescribeObjects(input *DescribeObjectsInput) (*DescribeOb
 	return out, err
 }
 
+// DescribeObjectsWithContext is the same as DescribeObjects with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) DescribeObjectsWithContext(ctx aws.Context, input *DescribeObjectsInput) (*DescribeObjectsOutput, error) {
+	req, out := c.DescribeObjectsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *DataPipeline) DescribeObjectsPages(input *DescribeObjectsInput, fn func(p *DescribeObjectsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeObjectsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -241,6 +302,16 @@ func (c *DataPipeline) DescribePipelines(input *DescribePipelinesInput) (*Descri
 	return out, err
 }
 
+// DescribePipelinesWithContext is the same as DescribePipelines with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) DescribePipelinesWithContext(ctx aws.Context, input *DescribePipelinesInput) (*DescribePipelinesOutput, error) {
+	req, out := c.DescribePipelinesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEvaluateExpression = "EvaluateExpression"
 
 // EvaluateExpressionRequest generates a request for the EvaluateExpression operation.
@@ -270,6 +341,16 @@ func (c *DataPipeline) EvaluateExpression(input *EvaluateExpressionInput) (*Eval
 	return out, err
 }
 
+// EvaluateExpressionWithContext is the same as EvaluateExpression with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) EvaluateExpressionWithContext(ctx aws.Context, input *EvaluateExpressionInput) (*EvaluateExpressionOutput, error) {
+	req, out := c.EvaluateExpressionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPipelineDefinition = "GetPipelineDefinition"
 
 // GetPipelineDefinitionRequest generates a request for the GetPipelineDefinition operation.
@@ -298,6 +379,16 @@ func (c *DataPipeline) GetPipelineDefinition(input *GetPipelineDefinitionInput)
 	return out, err
 }
 
+// GetPipelineDefinitionWithContext is the same as GetPipelineDefinition with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) GetPipelineDefinitionWithContext(ctx aws.Context, input *GetPipelineDefinitionInput) (*GetPipelineDefinitionOutput, error) {
+	req, out := c.GetPipelineDefinitionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListPipelines = "ListPipelines"
 
 // ListPipelinesRequest generates a request for the ListPipelines operation.
@@ -332,6 +423,16 @@ func (c *DataPipeline) ListPipelines(input *ListPipelinesInput) (*ListPipelinesO
 	return out, err
 }
 
+// ListPipelinesWithContext is the same as ListPipelines with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) ListPipelinesWithContext(ctx aws.Context, input *ListPipelinesInput) (*ListPipelinesOutput, error) {
+	req, out := c.ListPipelinesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *DataPipeline) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPipelinesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -379,6 +480,16 @@ func (c *DataPipeline) PollForTask(input *PollForTaskInput) (*PollForTaskOutput,
 	return out, err
 }
 
+// PollForTaskWithContext is the same as PollForTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) PollForTaskWithContext(ctx aws.Context, input *PollForTaskInput) (*PollForTaskOutput, error) {
+	req, out := c.PollForTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutPipelineDefinition = "PutPipelineDefinition"
 
 // PutPipelineDefinitionRequest generates a request for the PutPipelineDefinition operation.
@@ -417,6 +528,16 @@ func (c *DataPipeline) PutPipelineDefinition(input *PutPipelineDefinitionInput)
 	return out, err
 }
 
+// PutPipelineDefinitionWithContext is the same as PutPipelineDefinition with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) PutPipelineDefinitionWithContext(ctx aws.Context, input *PutPipelineDefinitionInput) (*PutPipelineDefinitionOutput, error) {
+	req, out := c.PutPipelineDefinitionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opQueryObjects = "QueryObjects"
 
 // QueryObjectsRequest generates a request for the QueryObjects operation.
@@ -451,6 +572,16 @@ func (c *DataPipeline) QueryObjects(input *QueryObjectsInput) (*QueryObjectsOutp
 	return out, err
 }
 
+// QueryObjectsWithContext is the same as QueryObjects with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) QueryObjectsWithContext(ctx aws.Context, input *QueryObjectsInput) (*QueryObjectsOutput, error) {
+	req, out := c.QueryObjectsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *DataPipeline) QueryObjectsPages(input *QueryObjectsInput, fn func(p *QueryObjectsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.QueryObjectsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -485,6 +616,16 @@ func (c *DataPipeline) RemoveTags(input *RemoveTagsInput) (*RemoveTagsOutput, er
 	return out, err
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) RemoveTagsWithContext(ctx aws.Context, input *RemoveTagsInput) (*RemoveTagsOutput, error) {
+	req, out := c.RemoveTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReportTaskProgress = "ReportTaskProgress"
 
 // ReportTaskProgressRequest generates a request for the ReportTaskProgress operation.
@@ -523,6 +664,16 @@ func (c *DataPipeline) ReportTaskProgress(input *ReportTaskProgressInput) (*Repo
 	return out, err
 }
 
+// ReportTaskProgressWithContext is the same as ReportTaskProgress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) ReportTaskProgressWithContext(ctx aws.Context, input *ReportTaskProgressInput) (*ReportTaskProgressOutput, error) {
+	req, out := c.ReportTaskProgressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReportTaskRunnerHeartbeat = "ReportTaskRunnerHeartbeat"
 
 // ReportTaskRunnerHeartbeatRequest generates a request for the ReportTaskRunnerHeartbeat operation.
@@ -554,6 +705,16 @@ func (c *DataPipeline) ReportTaskRunnerHeartbeat(input *ReportTaskRunnerHeartbea
 	return out, err
 }
 
+// ReportTaskRunnerHeartbeatWithContext is the same as ReportTaskRunnerHeartbeat with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) ReportTaskRunnerHeartbeatWithContext(ctx aws.Context, input *ReportTaskRunnerHeartbeatInput) (*ReportTaskRunnerHeartbeatOutput, error) {
+	req, out := c.ReportTaskRunnerHeartbeatRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetStatus = "SetStatus"
 
 // SetStatusRequest generates a request for the SetStatus operation.
@@ -585,6 +746,16 @@ func (c *DataPipeline) SetStatus(input *SetStatusInput) (*SetStatusOutput, error
 	return out, err
 }
 
+// SetStatusWithContext is the same as SetStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) SetStatusWithContext(ctx aws.Context, input *SetStatusInput) (*SetStatusOutput, error) {
+	req, out := c.SetStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetTaskStatus = "SetTaskStatus"
 
 // SetTaskStatusRequest generates a request for the SetTaskStatus operation.
@@ -616,6 +787,16 @@ func (c *DataPipeline) SetTaskStatus(input *SetTaskStatusInput) (*SetTaskStatusO
 	return out, err
 }
 
+// SetTaskStatusWithContext is the same as SetTaskStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) SetTaskStatusWithContext(ctx aws.Context, input *SetTaskStatusInput) (*SetTaskStatusOutput, error) {
+	req, out := c.SetTaskStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opValidatePipelineDefinition = "ValidatePipelineDefinition"
 
 // ValidatePipelineDefinitionRequest generates a request for the ValidatePipelineDefinition operation.
@@ -644,13 +825,23 @@ func (c *DataPipeline) ValidatePipelineDefinition(input *ValidatePipelineDefinit
 	return out, err
 }
 
+// ValidatePipelineDefinitionWithContext is the same as ValidatePipelineDefinition with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DataPipeline) ValidatePipelineDefinitionWithContext(ctx aws.Context, input *ValidatePipelineDefinitionInput) (*ValidatePipelineDefinitionOutput, error) {
+	req, out := c.ValidatePipelineDefinitionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Contains the parameters for ActivatePipeline.
 type ActivatePipelineInput struct {
 	// A list of parameter values to pass to the pipeline at activation.
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The date and time to resume the pipeline. By default, the pipeline resumes
 	// from the last completed execution.
@@ -663,6 +854,16 @@ type metadataActivatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivatePipelineInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of ActivatePipeline.
 type ActivatePipelineOutput struct {
 	metadataActivatePipelineOutput `json:"-" xml:"-"`
@@ -672,13 +873,23 @@ type metadataActivatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivatePipelineOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for AddTags.
 type AddTagsInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The tags to add, as key/value pairs.
-	Tags []*Tag `locationName:"tags" type:"list" required:"true"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10" required:"true"`
 
 	metadataAddTagsInput `json:"-" xml:"-"`
 }
@@ -687,6 +898,16 @@ type metadataAddTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of AddTags.
 type AddTagsOutput struct {
 	metadataAddTagsOutput `json:"-" xml:"-"`
@@ -696,21 +917,31 @@ type metadataAddTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for CreatePipeline.
 type CreatePipelineInput struct {
 	// The description for the pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" min:"0" max:"1024"`
 
 	// The name for the pipeline. You can use the same name for multiple pipelines
 	// associated with your AWS account, because AWS Data Pipeline assigns each
 	// pipeline a unique pipeline identifier.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	// A list of tags to associate with the pipeline at creation. Tags let you control
 	// access to pipelines. For more information, see Controlling User Access to
 	// Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10"`
 
 	// A unique identifier. This identifier is not the same as the pipeline identifier
 	// assigned by AWS Data Pipeline. You are responsible for defining the format
@@ -723,7 +954,7 @@ type CreatePipelineInput struct {
 	// Instead, you'll receive the pipeline identifier from the previous attempt.
 	// The uniqueness of the name and unique identifier combination is scoped to
 	// the AWS account or IAM user credentials.
-	UniqueID *string `locationName:"uniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"uniqueId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataCreatePipelineInput `json:"-" xml:"-"`
 }
@@ -732,11 +963,21 @@ type metadataCreatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePipelineInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of CreatePipeline.
 type CreatePipelineOutput struct {
 	// The ID that AWS Data Pipeline assigns the newly created pipeline. For example,
 	// df-06372391ZG65EXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataCreatePipelineOutput `json:"-" xml:"-"`
 }
@@ -745,6 +986,16 @@ type metadataCreatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePipelineOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DeactivatePipeline.
 type DeactivatePipelineInput struct {
 	// Indicates whether to cancel any running objects. The default is true, which
@@ -753,7 +1004,7 @@ type DeactivatePipelineInput struct {
 	CancelActive *bool `locationName:"cancelActive" type:"boolean"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDeactivatePipelineInput `json:"-" xml:"-"`
 }
@@ -762,6 +1013,16 @@ type metadataDeactivatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeactivatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeactivatePipelineInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DeactivatePipeline.
 type DeactivatePipelineOutput struct {
 	metadataDeactivatePipelineOutput `json:"-" xml:"-"`
@@ -771,10 +1032,20 @@ type metadataDeactivatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeactivatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeactivatePipelineOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DeletePipeline.
 type DeletePipelineInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDeletePipelineInput `json:"-" xml:"-"`
 }
@@ -783,6 +1054,16 @@ type metadataDeletePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePipelineInput) GoString() string {
+	return s.String()
+}
+
 type DeletePipelineOutput struct {
 	metadataDeletePipelineOutput `json:"-" xml:"-"`
 }
@@ -791,6 +1072,16 @@ type metadataDeletePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePipelineOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribeObjects.
 type DescribeObjectsInput struct {
 	// Indicates whether any expressions in the object should be evaluated when
@@ -801,14 +1092,14 @@ type DescribeObjectsInput struct {
 	// value should be empty. As long as there are more results, continue to call
 	// DescribeObjects with the marker value from the previous call to retrieve
 	// the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// The IDs of the pipeline objects that contain the definitions to be described.
 	// You can pass as many as 25 identifiers in a single call to DescribeObjects.
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// The ID of the pipeline that contains the object definitions.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataDescribeObjectsInput `json:"-" xml:"-"`
 }
@@ -817,6 +1108,16 @@ type metadataDescribeObjectsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeObjectsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribeObjects.
 type DescribeObjectsOutput struct {
 	// Indicates whether there are more results to return.
@@ -825,7 +1126,7 @@ type DescribeObjectsOutput struct {
 	// The starting point for the next page of results. To view the next page of
 	// results, call DescribeObjects again with this marker value. If the value
 	// is null, there are no more results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// An array of object definitions.
 	PipelineObjects []*PipelineObject `locationName:"pipelineObjects" type:"list" required:"true"`
@@ -837,6 +1138,16 @@ type metadataDescribeObjectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeObjectsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribePipelines.
 type DescribePipelinesInput struct {
 	// The IDs of the pipelines to describe. You can pass as many as 25 identifiers
@@ -850,6 +1161,16 @@ type metadataDescribePipelinesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePipelinesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePipelinesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribePipelines.
 type DescribePipelinesOutput struct {
 	// An array of descriptions for the specified pipelines.
@@ -862,16 +1183,26 @@ type metadataDescribePipelinesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePipelinesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePipelinesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for EvaluateExpression.
 type EvaluateExpressionInput struct {
 	// The expression to evaluate.
-	Expression *string `locationName:"expression" type:"string" required:"true"`
+	Expression *string `locationName:"expression" type:"string" min:"0" max:"20971520" required:"true"`
 
 	// The ID of the object.
-	ObjectID *string `locationName:"objectId" type:"string" required:"true"`
+	ObjectID *string `locationName:"objectId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataEvaluateExpressionInput `json:"-" xml:"-"`
 }
@@ -880,10 +1211,20 @@ type metadataEvaluateExpressionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EvaluateExpressionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EvaluateExpressionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of EvaluateExpression.
 type EvaluateExpressionOutput struct {
 	// The evaluated expression.
-	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" required:"true"`
+	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" min:"0" max:"20971520" required:"true"`
 
 	metadataEvaluateExpressionOutput `json:"-" xml:"-"`
 }
@@ -892,18 +1233,28 @@ type metadataEvaluateExpressionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EvaluateExpressionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EvaluateExpressionOutput) GoString() string {
+	return s.String()
+}
+
 // A key-value pair that describes a property of a pipeline object. The value
 // is specified as either a string value (StringValue) or a reference to another
 // object (RefValue) but not as both.
 type Field struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as the identifier of another object.
-	RefValue *string `locationName:"refValue" type:"string"`
+	RefValue *string `locationName:"refValue" type:"string" min:"1" max:"256"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240"`
 
 	metadataField `json:"-" xml:"-"`
 }
@@ -912,15 +1263,25 @@ type metadataField struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Field) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Field) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for GetPipelineDefinition.
 type GetPipelineDefinitionInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The version of the pipeline definition to retrieve. Set this parameter to
 	// latest (default) to use the last definition saved to the pipeline or active
 	// to use the last definition that was activated.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" min:"0" max:"1024"`
 
 	metadataGetPipelineDefinitionInput `json:"-" xml:"-"`
 }
@@ -929,6 +1290,16 @@ type metadataGetPipelineDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPipelineDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPipelineDefinitionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of GetPipelineDefinition.
 type GetPipelineDefinitionOutput struct {
 	// The parameter objects used in the pipeline definition.
@@ -947,6 +1318,16 @@ type metadataGetPipelineDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPipelineDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPipelineDefinitionOutput) GoString() string {
+	return s.String()
+}
+
 // Identity information for the EC2 instance that is hosting the task runner.
 // You can get this value by calling a metadata URI from the EC2 instance. For
 // more information, see Instance Metadata (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/AESDG-chapter-instancedata.html)
@@ -957,11 +1338,11 @@ type InstanceIdentity struct {
 	// A description of an EC2 instance that is generated when the instance is launched
 	// and exposed to the instance via the instance metadata service in the form
 	// of a JSON representation of an object.
-	Document *string `locationName:"document" type:"string"`
+	Document *string `locationName:"document" type:"string" min:"0" max:"1024"`
 
 	// A signature which can be used to verify the accuracy and authenticity of
 	// the information provided in the instance identity document.
-	Signature *string `locationName:"signature" type:"string"`
+	Signature *string `locationName:"signature" type:"string" min:"0" max:"1024"`
 
 	metadataInstanceIdentity `json:"-" xml:"-"`
 }
@@ -970,13 +1351,23 @@ type metadataInstanceIdentity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceIdentity) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceIdentity) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for ListPipelines.
 type ListPipelinesInput struct {
 	// The starting point for the results to be returned. For the first call, this
 	// value should be empty. As long as there are more results, continue to call
 	// ListPipelines with the marker value from the previous call to retrieve the
 	// next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	metadataListPipelinesInput `json:"-" xml:"-"`
 }
@@ -985,6 +1376,16 @@ type metadataListPipelinesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPipelinesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPipelinesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of ListPipelines.
 type ListPipelinesOutput struct {
 	// Indicates whether there are more results that can be obtained by a subsequent
@@ -994,7 +1395,7 @@ type ListPipelinesOutput struct {
 	// The starting point for the next page of results. To view the next page of
 	// results, call ListPipelinesOutput again with this marker value. If the value
 	// is null, there are no more results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// The pipeline identifiers. If you require additional information about the
 	// pipelines, you can use these identifiers to call DescribePipelines and GetPipelineDefinition.
@@ -1007,6 +1408,16 @@ type metadataListPipelinesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPipelinesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPipelinesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains a logical operation for comparing the value of a field with a specified
 // value.
 type Operator struct {
@@ -1028,7 +1439,9 @@ type Operator struct {
 	// only alpha-numeric values, as symbols may be reserved by AWS Data Pipeline.
 	// User-defined fields that you add to a pipeline should prefix their name with
 	// the string "my".
-	Type *string `locationName:"type" type:"string"`
+	//
+	// See the OperatorType* constants for valid values.
+	Type *string `locationName:"type" type:"string" enum:"EQ,REF_EQ,LE,GE,BETWEEN"`
 
 	// The value that the actual field value will be compared with.
 	Values []*string `locationName:"values" type:"list"`
@@ -1040,13 +1453,23 @@ type metadataOperator struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Operator) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Operator) GoString() string {
+	return s.String()
+}
+
 // The attributes allowed or specified with a parameter object.
 type ParameterAttribute struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240" required:"true"`
 
 	metadataParameterAttribute `json:"-" xml:"-"`
 }
@@ -1055,13 +1478,23 @@ type metadataParameterAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ParameterAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ParameterAttribute) GoString() string {
+	return s.String()
+}
+
 // Contains information about a parameter object.
 type ParameterObject struct {
 	// The attributes of the parameter object.
 	Attributes []*ParameterAttribute `locationName:"attributes" type:"list" required:"true"`
 
 	// The ID of the parameter object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataParameterObject `json:"-" xml:"-"`
 }
@@ -1070,13 +1503,23 @@ type metadataParameterObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ParameterObject) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ParameterObject) GoString() string {
+	return s.String()
+}
+
 // A value or list of parameter values.
 type ParameterValue struct {
 	// The ID of the parameter value.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" min:"0" max:"10240" required:"true"`
 
 	metadataParameterValue `json:"-" xml:"-"`
 }
@@ -1085,27 +1528,37 @@ type metadataParameterValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ParameterValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ParameterValue) GoString() string {
+	return s.String()
+}
+
 // Contains pipeline metadata.
 type PipelineDescription struct {
 	// Description of the pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" min:"0" max:"1024"`
 
 	// A list of read-only fields that contain metadata about the pipeline: @userId,
 	// @accountId, and @pipelineState.
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// The name of the pipeline.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The pipeline identifier that was assigned by AWS Data Pipeline. This is a
 	// string of the form df-297EG78HU43EEXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// A list of tags to associated with a pipeline. Tags let you control access
 	// to pipelines. For more information, see Controlling User Access to Pipelines
 	// (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" min:"0" max:"10"`
 
 	metadataPipelineDescription `json:"-" xml:"-"`
 }
@@ -1114,14 +1567,24 @@ type metadataPipelineDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PipelineDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PipelineDescription) GoString() string {
+	return s.String()
+}
+
 // Contains the name and identifier of a pipeline.
 type PipelineIDName struct {
 	// The ID of the pipeline that was assigned by AWS Data Pipeline. This is a
 	// string of the form df-297EG78HU43EEXAMPLE.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	// The name of the pipeline.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024"`
 
 	metadataPipelineIDName `json:"-" xml:"-"`
 }
@@ -1130,6 +1593,16 @@ type metadataPipelineIDName struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PipelineIDName) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PipelineIDName) GoString() string {
+	return s.String()
+}
+
 // Contains information about a pipeline object. This can be a logical, physical,
 // or physical attempt pipeline object. The complete set of components of a
 // pipeline defines the pipeline.
@@ -1138,10 +1611,10 @@ type PipelineObject struct {
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// The ID of the object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The name of the object.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataPipelineObject `json:"-" xml:"-"`
 }
@@ -1150,10 +1623,20 @@ type metadataPipelineObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PipelineObject) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PipelineObject) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for PollForTask.
 type PollForTaskInput struct {
 	// The public DNS name of the calling task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024"`
 
 	// Identity information for the EC2 instance that is hosting the task runner.
 	// You can get this value from the instance using http://169.254.169.254/latest/meta-data/instance-id.
@@ -1168,7 +1651,7 @@ type PollForTaskInput struct {
 	// You can only specify a single value for workerGroup in the call to PollForTask.
 	// There are no wildcard values permitted in workerGroup; the string must be
 	// an exact, case-sensitive, match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string" required:"true"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataPollForTaskInput `json:"-" xml:"-"`
 }
@@ -1177,6 +1660,16 @@ type metadataPollForTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PollForTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PollForTaskInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of PollForTask.
 type PollForTaskOutput struct {
 	// The information needed to complete the task that is being assigned to the
@@ -1192,6 +1685,16 @@ type metadataPollForTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PollForTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PollForTaskOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for PutPipelineDefinition.
 type PutPipelineDefinitionInput struct {
 	// The parameter objects used with the pipeline.
@@ -1201,7 +1704,7 @@ type PutPipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The objects that define the pipeline. These objects overwrite the existing
 	// pipeline definition.
@@ -1214,6 +1717,16 @@ type metadataPutPipelineDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutPipelineDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutPipelineDefinitionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of PutPipelineDefinition.
 type PutPipelineDefinitionOutput struct {
 	// Indicates whether there were validation errors, and the pipeline definition
@@ -1234,6 +1747,16 @@ type metadataPutPipelineDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutPipelineDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutPipelineDefinitionOutput) GoString() string {
+	return s.String()
+}
+
 // Defines the query to run against an object.
 type Query struct {
 	// List of selectors that define the query. An object must satisfy all of the
@@ -1247,6 +1770,16 @@ type metadataQuery struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Query) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Query) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for QueryObjects.
 type QueryObjectsInput struct {
 	// The maximum number of object names that QueryObjects will return in a single
@@ -1257,10 +1790,10 @@ type QueryObjectsInput struct {
 	// value should be empty. As long as there are more results, continue to call
 	// QueryObjects with the marker value from the previous call to retrieve the
 	// next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The query that defines the objects to be returned. The Query object can contain
 	// a maximum of ten selectors. The conditions in the query are limited to top-level
@@ -1270,7 +1803,7 @@ type QueryObjectsInput struct {
 
 	// Indicates whether the query applies to components or instances. The possible
 	// values are: COMPONENT, INSTANCE, and ATTEMPT.
-	Sphere *string `locationName:"sphere" type:"string" required:"true"`
+	Sphere *string `locationName:"sphere" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataQueryObjectsInput `json:"-" xml:"-"`
 }
@@ -1279,6 +1812,16 @@ type metadataQueryObjectsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s QueryObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s QueryObjectsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of QueryObjects.
 type QueryObjectsOutput struct {
 	// Indicates whether there are more results that can be obtained by a subsequent
@@ -1291,7 +1834,7 @@ type QueryObjectsOutput struct {
 	// The starting point for the next page of results. To view the next page of
 	// results, call QueryObjects again with this marker value. If the value is
 	// null, there are no more results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" min:"0" max:"1024"`
 
 	metadataQueryObjectsOutput `json:"-" xml:"-"`
 }
@@ -1300,10 +1843,20 @@ type metadataQueryObjectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s QueryObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s QueryObjectsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for RemoveTags.
 type RemoveTagsInput struct {
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The keys of the tags to remove.
 	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
@@ -1315,6 +1868,16 @@ type metadataRemoveTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of RemoveTags.
 type RemoveTagsOutput struct {
 	metadataRemoveTagsOutput `json:"-" xml:"-"`
@@ -1324,6 +1887,16 @@ type metadataRemoveTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for ReportTaskProgress.
 type ReportTaskProgressInput struct {
 	// Key-value pairs that define the properties of the ReportTaskProgressInput
@@ -1332,7 +1905,7 @@ type ReportTaskProgressInput struct {
 
 	// The ID of the task assigned to the task runner. This value is provided in
 	// the response for PollForTask.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" required:"true"`
 
 	metadataReportTaskProgressInput `json:"-" xml:"-"`
 }
@@ -1341,6 +1914,16 @@ type metadataReportTaskProgressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReportTaskProgressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReportTaskProgressInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of ReportTaskProgress.
 type ReportTaskProgressOutput struct {
 	// If true, the calling task runner should cancel processing of the task. The
@@ -1354,24 +1937,34 @@ type metadataReportTaskProgressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReportTaskProgressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReportTaskProgressOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for ReportTaskRunnerHeartbeat.
 type ReportTaskRunnerHeartbeatInput struct {
 	// The public DNS name of the task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024"`
 
 	// The ID of the task runner. This value should be unique across your AWS account.
 	// In the case of AWS Data Pipeline Task Runner launched on a resource managed
 	// by AWS Data Pipeline, the web service provides a unique identifier when it
 	// launches the application. If you have written a custom task runner, you should
 	// assign a unique identifier for the task runner.
-	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" required:"true"`
+	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The type of task the task runner is configured to accept and process. The
 	// worker group is set as a field on objects in the pipeline when they are created.
 	// You can only specify a single value for workerGroup. There are no wildcard
 	// values permitted in workerGroup; the string must be an exact, case-sensitive,
 	// match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" min:"0" max:"1024"`
 
 	metadataReportTaskRunnerHeartbeatInput `json:"-" xml:"-"`
 }
@@ -1380,6 +1973,16 @@ type metadataReportTaskRunnerHeartbeatInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReportTaskRunnerHeartbeatInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReportTaskRunnerHeartbeatInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of ReportTaskRunnerHeartbeat.
 type ReportTaskRunnerHeartbeatOutput struct {
 	// Indicates whether the calling task runner should terminate.
@@ -1392,6 +1995,16 @@ type metadataReportTaskRunnerHeartbeatOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReportTaskRunnerHeartbeatOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReportTaskRunnerHeartbeatOutput) GoString() string {
+	return s.String()
+}
+
 // A comparision that is used to determine whether a query should return this
 // object.
 type Selector struct {
@@ -1399,7 +2012,7 @@ type Selector struct {
 	// is the "key" portion of the field definition in the pipeline definition syntax
 	// that is used by the AWS Data Pipeline API. If the field is not set on the
 	// object, the condition fails.
-	FieldName *string `locationName:"fieldName" type:"string"`
+	FieldName *string `locationName:"fieldName" type:"string" min:"0" max:"1024"`
 
 	// Contains a logical operation for comparing the value of a field with a specified
 	// value.
@@ -1412,6 +2025,16 @@ type metadataSelector struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Selector) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Selector) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for SetStatus.
 type SetStatusInput struct {
 	// The IDs of the objects. The corresponding objects can be either physical
@@ -1419,11 +2042,11 @@ type SetStatusInput struct {
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// The ID of the pipeline that contains the objects.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The status to be set on all the objects specified in objectIds. For components,
 	// use PAUSE or RESUME. For instances, use TRY_CANCEL, RERUN, or MARK_FINISHED.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" min:"0" max:"1024" required:"true"`
 
 	metadataSetStatusInput `json:"-" xml:"-"`
 }
@@ -1432,6 +2055,16 @@ type metadataSetStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetStatusInput) GoString() string {
+	return s.String()
+}
+
 type SetStatusOutput struct {
 	metadataSetStatusOutput `json:"-" xml:"-"`
 }
@@ -1440,13 +2073,23 @@ type metadataSetStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetStatusOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for SetTaskStatus.
 type SetTaskStatusInput struct {
 	// If an error occurred during the task, this value specifies the error code.
 	// This value is set on the physical attempt object. It is used to display error
 	// information to the user. It should not start with string "Service_" which
 	// is reserved by the system.
-	ErrorID *string `locationName:"errorId" type:"string"`
+	ErrorID *string `locationName:"errorId" type:"string" min:"0" max:"1024"`
 
 	// If an error occurred during the task, this value specifies a text description
 	// of the error. This value is set on the physical attempt object. It is used
@@ -1458,15 +2101,17 @@ type SetTaskStatusInput struct {
 	// associated with the error. This value is set on the physical attempt object.
 	// It is used to display error information to the user. The web service does
 	// not parse this value.
-	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string"`
+	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string" min:"0" max:"1024"`
 
 	// The ID of the task assigned to the task runner. This value is provided in
 	// the response for PollForTask.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" required:"true"`
 
 	// If FINISHED, the task successfully completed. If FAILED, the task ended unsuccessfully.
 	// Preconditions use false.
-	TaskStatus *string `locationName:"taskStatus" type:"string" required:"true"`
+	//
+	// See the TaskStatus* constants for valid values.
+	TaskStatus *string `locationName:"taskStatus" type:"string" enum:"FINISHED,FAILED,FALSE" required:"true"`
 
 	metadataSetTaskStatusInput `json:"-" xml:"-"`
 }
@@ -1475,6 +2120,16 @@ type metadataSetTaskStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTaskStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTaskStatusInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of SetTaskStatus.
 type SetTaskStatusOutput struct {
 	metadataSetTaskStatusOutput `json:"-" xml:"-"`
@@ -1484,6 +2139,16 @@ type metadataSetTaskStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTaskStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTaskStatusOutput) GoString() string {
+	return s.String()
+}
+
 // Tags are key/value pairs defined by a user and associated with a pipeline
 // to control access. AWS Data Pipeline allows you to associate ten tags per
 // pipeline. For more information, see Controlling User Access to Pipelines
@@ -1493,12 +2158,12 @@ type Tag struct {
 	// The key name of a tag defined by a user. For more information, see Controlling
 	// User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"128" required:"true"`
 
 	// The optional value portion of a tag defined by a user. For more information,
 	// see Controlling User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -1507,22 +2172,32 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // Contains information about a pipeline task that is assigned to a task runner.
 type TaskObject struct {
 	// The ID of the pipeline task attempt object. AWS Data Pipeline uses this value
 	// to track how many times a task is attempted.
-	AttemptID *string `locationName:"attemptId" type:"string"`
+	AttemptID *string `locationName:"attemptId" type:"string" min:"1" max:"1024"`
 
 	// Connection information for the location where the task runner will publish
 	// the output of the task.
 	Objects map[string]*PipelineObject `locationName:"objects" type:"map"`
 
 	// The ID of the pipeline that provided the task.
-	PipelineID *string `locationName:"pipelineId" type:"string"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024"`
 
 	// An internal identifier for the task. This ID is passed to the SetTaskStatus
 	// and ReportTaskProgress actions.
-	TaskID *string `locationName:"taskId" type:"string"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048"`
 
 	metadataTaskObject `json:"-" xml:"-"`
 }
@@ -1531,6 +2206,16 @@ type metadataTaskObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TaskObject) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TaskObject) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for ValidatePipelineDefinition.
 type ValidatePipelineDefinitionInput struct {
 	// The parameter objects used with the pipeline.
@@ -1540,7 +2225,7 @@ type ValidatePipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The ID of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The objects that define the pipeline changes to validate against the pipeline.
 	PipelineObjects []*PipelineObject `locationName:"pipelineObjects" type:"list" required:"true"`
@@ -1552,6 +2237,16 @@ type metadataValidatePipelineDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ValidatePipelineDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidatePipelineDefinitionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of ValidatePipelineDefinition.
 type ValidatePipelineDefinitionOutput struct {
 	// Indicates whether there were validation errors.
@@ -1570,6 +2265,16 @@ type metadataValidatePipelineDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ValidatePipelineDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidatePipelineDefinitionOutput) GoString() string {
+	return s.String()
+}
+
 // Defines a validation error. Validation errors prevent pipeline activation.
 // The set of validation errors that can be returned are defined by AWS Data
 // Pipeline.
@@ -1578,7 +2283,7 @@ type ValidationError struct {
 	Errors []*string `locationName:"errors" type:"list"`
 
 	// The identifier of the object that contains the validation error.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	metadataValidationError `json:"-" xml:"-"`
 }
@@ -1587,12 +2292,22 @@ type metadataValidationError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ValidationError) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidationError) GoString() string {
+	return s.String()
+}
+
 // Defines a validation warning. Validation warnings do not prevent pipeline
 // activation. The set of validation warnings that can be returned are defined
 // by AWS Data Pipeline.
 type ValidationWarning struct {
 	// The identifier of the object that contains the validation warning.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024"`
 
 	// A description of the validation warning.
 	Warnings []*string `locationName:"warnings" type:"list"`
@@ -1603,3 +2318,35 @@ type ValidationWarning struct {
 type metadataValidationWarning struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s ValidationWarning) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidationWarning) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum OperatorType
+	OperatorTypeEq = "EQ"
+	// @enum OperatorType
+	OperatorTypeRefEq = "REF_EQ"
+	// @enum OperatorType
+	OperatorTypeLe = "LE"
+	// @enum OperatorType
+	OperatorTypeGe = "GE"
+	// @enum OperatorType
+	OperatorTypeBetween = "BETWEEN"
+)
+
+const (
+	// @enum TaskStatus
+	TaskStatusFinished = "FINISHED"
+	// @enum TaskStatus
+	TaskStatusFailed = "FAILED"
+	// @enum TaskStatus
+	TaskStatusFalse = "FALSE"
+)
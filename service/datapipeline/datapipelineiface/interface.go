@@ -4,6 +4,7 @@
 package datapipelineiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 )
 
@@ -11,39 +12,77 @@ import (
 type DataPipelineAPI interface {
 	ActivatePipeline(*datapipeline.ActivatePipelineInput) (*datapipeline.ActivatePipelineOutput, error)
 
+	ActivatePipelineWithContext(aws.Context, *datapipeline.ActivatePipelineInput) (*datapipeline.ActivatePipelineOutput, error)
+
 	AddTags(*datapipeline.AddTagsInput) (*datapipeline.AddTagsOutput, error)
 
+	AddTagsWithContext(aws.Context, *datapipeline.AddTagsInput) (*datapipeline.AddTagsOutput, error)
+
 	CreatePipeline(*datapipeline.CreatePipelineInput) (*datapipeline.CreatePipelineOutput, error)
 
+	CreatePipelineWithContext(aws.Context, *datapipeline.CreatePipelineInput) (*datapipeline.CreatePipelineOutput, error)
+
 	DeactivatePipeline(*datapipeline.DeactivatePipelineInput) (*datapipeline.DeactivatePipelineOutput, error)
 
+	DeactivatePipelineWithContext(aws.Context, *datapipeline.DeactivatePipelineInput) (*datapipeline.DeactivatePipelineOutput, error)
+
 	DeletePipeline(*datapipeline.DeletePipelineInput) (*datapipeline.DeletePipelineOutput, error)
 
+	DeletePipelineWithContext(aws.Context, *datapipeline.DeletePipelineInput) (*datapipeline.DeletePipelineOutput, error)
+
 	DescribeObjects(*datapipeline.DescribeObjectsInput) (*datapipeline.DescribeObjectsOutput, error)
 
+	DescribeObjectsWithContext(aws.Context, *datapipeline.DescribeObjectsInput) (*datapipeline.DescribeObjectsOutput, error)
+
 	DescribePipelines(*datapipeline.DescribePipelinesInput) (*datapipeline.DescribePipelinesOutput, error)
 
+	DescribePipelinesWithContext(aws.Context, *datapipeline.DescribePipelinesInput) (*datapipeline.DescribePipelinesOutput, error)
+
 	EvaluateExpression(*datapipeline.EvaluateExpressionInput) (*datapipeline.EvaluateExpressionOutput, error)
 
+	EvaluateExpressionWithContext(aws.Context, *datapipeline.EvaluateExpressionInput) (*datapipeline.EvaluateExpressionOutput, error)
+
 	GetPipelineDefinition(*datapipeline.GetPipelineDefinitionInput) (*datapipeline.GetPipelineDefinitionOutput, error)
 
+	GetPipelineDefinitionWithContext(aws.Context, *datapipeline.GetPipelineDefinitionInput) (*datapipeline.GetPipelineDefinitionOutput, error)
+
 	ListPipelines(*datapipeline.ListPipelinesInput) (*datapipeline.ListPipelinesOutput, error)
 
+	ListPipelinesWithContext(aws.Context, *datapipeline.ListPipelinesInput) (*datapipeline.ListPipelinesOutput, error)
+
 	PollForTask(*datapipeline.PollForTaskInput) (*datapipeline.PollForTaskOutput, error)
 
+	PollForTaskWithContext(aws.Context, *datapipeline.PollForTaskInput) (*datapipeline.PollForTaskOutput, error)
+
 	PutPipelineDefinition(*datapipeline.PutPipelineDefinitionInput) (*datapipeline.PutPipelineDefinitionOutput, error)
 
+	PutPipelineDefinitionWithContext(aws.Context, *datapipeline.PutPipelineDefinitionInput) (*datapipeline.PutPipelineDefinitionOutput, error)
+
 	QueryObjects(*datapipeline.QueryObjectsInput) (*datapipeline.QueryObjectsOutput, error)
 
+	QueryObjectsWithContext(aws.Context, *datapipeline.QueryObjectsInput) (*datapipeline.QueryObjectsOutput, error)
+
 	RemoveTags(*datapipeline.RemoveTagsInput) (*datapipeline.RemoveTagsOutput, error)
 
+	RemoveTagsWithContext(aws.Context, *datapipeline.RemoveTagsInput) (*datapipeline.RemoveTagsOutput, error)
+
 	ReportTaskProgress(*datapipeline.ReportTaskProgressInput) (*datapipeline.ReportTaskProgressOutput, error)
 
+	ReportTaskProgressWithContext(aws.Context, *datapipeline.ReportTaskProgressInput) (*datapipeline.ReportTaskProgressOutput, error)
+
 	ReportTaskRunnerHeartbeat(*datapipeline.ReportTaskRunnerHeartbeatInput) (*datapipeline.ReportTaskRunnerHeartbeatOutput, error)
 
+	ReportTaskRunnerHeartbeatWithContext(aws.Context, *datapipeline.ReportTaskRunnerHeartbeatInput) (*datapipeline.ReportTaskRunnerHeartbeatOutput, error)
+
 	SetStatus(*datapipeline.SetStatusInput) (*datapipeline.SetStatusOutput, error)
 
+	SetStatusWithContext(aws.Context, *datapipeline.SetStatusInput) (*datapipeline.SetStatusOutput, error)
+
 	SetTaskStatus(*datapipeline.SetTaskStatusInput) (*datapipeline.SetTaskStatusOutput, error)
 
+	SetTaskStatusWithContext(aws.Context, *datapipeline.SetTaskStatusInput) (*datapipeline.SetTaskStatusOutput, error)
+
 	ValidatePipelineDefinition(*datapipeline.ValidatePipelineDefinitionInput) (*datapipeline.ValidatePipelineDefinitionOutput, error)
+
+	ValidatePipelineDefinitionWithContext(aws.Context, *datapipeline.ValidatePipelineDefinitionInput) (*datapipeline.ValidatePipelineDefinitionOutput, error)
 }
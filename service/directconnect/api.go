@@ -5,6 +5,7 @@ package directconnect
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAllocateConnectionOnInterconnect = "AllocateConnectionOnInterconnect"
@@ -37,6 +38,16 @@ func (c *DirectConnect) AllocateConnectionOnInterconnect(input *AllocateConnecti
 	return out, err
 }
 
+// AllocateConnectionOnInterconnectWithContext is the same as AllocateConnectionOnInterconnect with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) AllocateConnectionOnInterconnectWithContext(ctx aws.Context, input *AllocateConnectionOnInterconnectInput) (*Connection, error) {
+	req, out := c.AllocateConnectionOnInterconnectRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAllocatePrivateVirtualInterface = "AllocatePrivateVirtualInterface"
 
 // AllocatePrivateVirtualInterfaceRequest generates a request for the AllocatePrivateVirtualInterface operation.
@@ -72,6 +83,16 @@ func (c *DirectConnect) AllocatePrivateVirtualInterface(input *AllocatePrivateVi
 	return out, err
 }
 
+// AllocatePrivateVirtualInterfaceWithContext is the same as AllocatePrivateVirtualInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) AllocatePrivateVirtualInterfaceWithContext(ctx aws.Context, input *AllocatePrivateVirtualInterfaceInput) (*VirtualInterface, error) {
+	req, out := c.AllocatePrivateVirtualInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAllocatePublicVirtualInterface = "AllocatePublicVirtualInterface"
 
 // AllocatePublicVirtualInterfaceRequest generates a request for the AllocatePublicVirtualInterface operation.
@@ -107,6 +128,16 @@ func (c *DirectConnect) AllocatePublicVirtualInterface(input *AllocatePublicVirt
 	return out, err
 }
 
+// AllocatePublicVirtualInterfaceWithContext is the same as AllocatePublicVirtualInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) AllocatePublicVirtualInterfaceWithContext(ctx aws.Context, input *AllocatePublicVirtualInterfaceInput) (*VirtualInterface, error) {
+	req, out := c.AllocatePublicVirtualInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opConfirmConnection = "ConfirmConnection"
 
 // ConfirmConnectionRequest generates a request for the ConfirmConnection operation.
@@ -138,6 +169,16 @@ func (c *DirectConnect) ConfirmConnection(input *ConfirmConnectionInput) (*Confi
 	return out, err
 }
 
+// ConfirmConnectionWithContext is the same as ConfirmConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) ConfirmConnectionWithContext(ctx aws.Context, input *ConfirmConnectionInput) (*ConfirmConnectionOutput, error) {
+	req, out := c.ConfirmConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opConfirmPrivateVirtualInterface = "ConfirmPrivateVirtualInterface"
 
 // ConfirmPrivateVirtualInterfaceRequest generates a request for the ConfirmPrivateVirtualInterface operation.
@@ -169,6 +210,16 @@ func (c *DirectConnect) ConfirmPrivateVirtualInterface(input *ConfirmPrivateVirt
 	return out, err
 }
 
+// ConfirmPrivateVirtualInterfaceWithContext is the same as ConfirmPrivateVirtualInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) ConfirmPrivateVirtualInterfaceWithContext(ctx aws.Context, input *ConfirmPrivateVirtualInterfaceInput) (*ConfirmPrivateVirtualInterfaceOutput, error) {
+	req, out := c.ConfirmPrivateVirtualInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opConfirmPublicVirtualInterface = "ConfirmPublicVirtualInterface"
 
 // ConfirmPublicVirtualInterfaceRequest generates a request for the ConfirmPublicVirtualInterface operation.
@@ -199,6 +250,16 @@ func (c *DirectConnect) ConfirmPublicVirtualInterface(input *ConfirmPublicVirtua
 	return out, err
 }
 
+// ConfirmPublicVirtualInterfaceWithContext is the same as ConfirmPublicVirtualInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) ConfirmPublicVirtualInterfaceWithContext(ctx aws.Context, input *ConfirmPublicVirtualInterfaceInput) (*ConfirmPublicVirtualInterfaceOutput, error) {
+	req, out := c.ConfirmPublicVirtualInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateConnection = "CreateConnection"
 
 // CreateConnectionRequest generates a request for the CreateConnection operation.
@@ -235,6 +296,16 @@ func (c *DirectConnect) CreateConnection(input *CreateConnectionInput) (*Connect
 	return out, err
 }
 
+// CreateConnectionWithContext is the same as CreateConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) CreateConnectionWithContext(ctx aws.Context, input *CreateConnectionInput) (*Connection, error) {
+	req, out := c.CreateConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInterconnect = "CreateInterconnect"
 
 // CreateInterconnectRequest generates a request for the CreateInterconnect operation.
@@ -277,6 +348,16 @@ func (c *DirectConnect) CreateInterconnect(input *CreateInterconnectInput) (*Int
 	return out, err
 }
 
+// CreateInterconnectWithContext is the same as CreateInterconnect with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) CreateInterconnectWithContext(ctx aws.Context, input *CreateInterconnectInput) (*Interconnect, error) {
+	req, out := c.CreateInterconnectRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePrivateVirtualInterface = "CreatePrivateVirtualInterface"
 
 // CreatePrivateVirtualInterfaceRequest generates a request for the CreatePrivateVirtualInterface operation.
@@ -306,6 +387,16 @@ func (c *DirectConnect) CreatePrivateVirtualInterface(input *CreatePrivateVirtua
 	return out, err
 }
 
+// CreatePrivateVirtualInterfaceWithContext is the same as CreatePrivateVirtualInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) CreatePrivateVirtualInterfaceWithContext(ctx aws.Context, input *CreatePrivateVirtualInterfaceInput) (*VirtualInterface, error) {
+	req, out := c.CreatePrivateVirtualInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePublicVirtualInterface = "CreatePublicVirtualInterface"
 
 // CreatePublicVirtualInterfaceRequest generates a request for the CreatePublicVirtualInterface operation.
@@ -336,6 +427,16 @@ func (c *DirectConnect) CreatePublicVirtualInterface(input *CreatePublicVirtualI
 	return out, err
 }
 
+// CreatePublicVirtualInterfaceWithContext is the same as CreatePublicVirtualInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) CreatePublicVirtualInterfaceWithContext(ctx aws.Context, input *CreatePublicVirtualInterfaceInput) (*VirtualInterface, error) {
+	req, out := c.CreatePublicVirtualInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteConnection = "DeleteConnection"
 
 // DeleteConnectionRequest generates a request for the DeleteConnection operation.
@@ -368,6 +469,16 @@ func (c *DirectConnect) DeleteConnection(input *DeleteConnectionInput) (*Connect
 	return out, err
 }
 
+// DeleteConnectionWithContext is the same as DeleteConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DeleteConnectionWithContext(ctx aws.Context, input *DeleteConnectionInput) (*Connection, error) {
+	req, out := c.DeleteConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteInterconnect = "DeleteInterconnect"
 
 // DeleteInterconnectRequest generates a request for the DeleteInterconnect operation.
@@ -395,6 +506,16 @@ func (c *DirectConnect) DeleteInterconnect(input *DeleteInterconnectInput) (*Del
 	return out, err
 }
 
+// DeleteInterconnectWithContext is the same as DeleteInterconnect with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DeleteInterconnectWithContext(ctx aws.Context, input *DeleteInterconnectInput) (*DeleteInterconnectOutput, error) {
+	req, out := c.DeleteInterconnectRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVirtualInterface = "DeleteVirtualInterface"
 
 // DeleteVirtualInterfaceRequest generates a request for the DeleteVirtualInterface operation.
@@ -422,6 +543,16 @@ func (c *DirectConnect) DeleteVirtualInterface(input *DeleteVirtualInterfaceInpu
 	return out, err
 }
 
+// DeleteVirtualInterfaceWithContext is the same as DeleteVirtualInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DeleteVirtualInterfaceWithContext(ctx aws.Context, input *DeleteVirtualInterfaceInput) (*DeleteVirtualInterfaceOutput, error) {
+	req, out := c.DeleteVirtualInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConnections = "DescribeConnections"
 
 // DescribeConnectionsRequest generates a request for the DescribeConnections operation.
@@ -451,6 +582,16 @@ func (c *DirectConnect) DescribeConnections(input *DescribeConnectionsInput) (*C
 	return out, err
 }
 
+// DescribeConnectionsWithContext is the same as DescribeConnections with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DescribeConnectionsWithContext(ctx aws.Context, input *DescribeConnectionsInput) (*Connections, error) {
+	req, out := c.DescribeConnectionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConnectionsOnInterconnect = "DescribeConnectionsOnInterconnect"
 
 // DescribeConnectionsOnInterconnectRequest generates a request for the DescribeConnectionsOnInterconnect operation.
@@ -478,6 +619,16 @@ func (c *DirectConnect) DescribeConnectionsOnInterconnect(input *DescribeConnect
 	return out, err
 }
 
+// DescribeConnectionsOnInterconnectWithContext is the same as DescribeConnectionsOnInterconnect with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DescribeConnectionsOnInterconnectWithContext(ctx aws.Context, input *DescribeConnectionsOnInterconnectInput) (*Connections, error) {
+	req, out := c.DescribeConnectionsOnInterconnectRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeInterconnects = "DescribeInterconnects"
 
 // DescribeInterconnectsRequest generates a request for the DescribeInterconnects operation.
@@ -507,6 +658,16 @@ func (c *DirectConnect) DescribeInterconnects(input *DescribeInterconnectsInput)
 	return out, err
 }
 
+// DescribeInterconnectsWithContext is the same as DescribeInterconnects with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DescribeInterconnectsWithContext(ctx aws.Context, input *DescribeInterconnectsInput) (*DescribeInterconnectsOutput, error) {
+	req, out := c.DescribeInterconnectsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLocations = "DescribeLocations"
 
 // DescribeLocationsRequest generates a request for the DescribeLocations operation.
@@ -536,6 +697,16 @@ func (c *DirectConnect) DescribeLocations(input *DescribeLocationsInput) (*Descr
 	return out, err
 }
 
+// DescribeLocationsWithContext is the same as DescribeLocations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DescribeLocationsWithContext(ctx aws.Context, input *DescribeLocationsInput) (*DescribeLocationsOutput, error) {
+	req, out := c.DescribeLocationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVirtualGateways = "DescribeVirtualGateways"
 
 // DescribeVirtualGatewaysRequest generates a request for the DescribeVirtualGateways operation.
@@ -569,6 +740,16 @@ func (c *DirectConnect) DescribeVirtualGateways(input *DescribeVirtualGatewaysIn
 	return out, err
 }
 
+// DescribeVirtualGatewaysWithContext is the same as DescribeVirtualGateways with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DescribeVirtualGatewaysWithContext(ctx aws.Context, input *DescribeVirtualGatewaysInput) (*DescribeVirtualGatewaysOutput, error) {
+	req, out := c.DescribeVirtualGatewaysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVirtualInterfaces = "DescribeVirtualInterfaces"
 
 // DescribeVirtualInterfacesRequest generates a request for the DescribeVirtualInterfaces operation.
@@ -607,6 +788,16 @@ func (c *DirectConnect) DescribeVirtualInterfaces(input *DescribeVirtualInterfac
 	return out, err
 }
 
+// DescribeVirtualInterfacesWithContext is the same as DescribeVirtualInterfaces with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectConnect) DescribeVirtualInterfacesWithContext(ctx aws.Context, input *DescribeVirtualInterfacesInput) (*DescribeVirtualInterfacesOutput, error) {
+	req, out := c.DescribeVirtualInterfacesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Container for the parameters to the AllocateConnectionOnInterconnect operation.
 type AllocateConnectionOnInterconnectInput struct {
 	// Bandwidth of the connection.
@@ -651,6 +842,16 @@ type metadataAllocateConnectionOnInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AllocateConnectionOnInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AllocateConnectionOnInterconnectInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the AllocatePrivateVirtualInterface operation.
 type AllocatePrivateVirtualInterfaceInput struct {
 	// The connection ID on which the private virtual interface is provisioned.
@@ -675,6 +876,16 @@ type metadataAllocatePrivateVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AllocatePrivateVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AllocatePrivateVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the AllocatePublicVirtualInterface operation.
 type AllocatePublicVirtualInterfaceInput struct {
 	// The connection ID on which the public virtual interface is provisioned.
@@ -699,6 +910,16 @@ type metadataAllocatePublicVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AllocatePublicVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AllocatePublicVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the ConfirmConnection operation.
 type ConfirmConnectionInput struct {
 	// ID of the connection.
@@ -715,6 +936,16 @@ type metadataConfirmConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmConnectionInput) GoString() string {
+	return s.String()
+}
+
 // The response received when ConfirmConnection is called.
 type ConfirmConnectionOutput struct {
 	// State of the connection.   Ordering: The initial state of a hosted connection
@@ -727,7 +958,9 @@ type ConfirmConnectionOutput struct {
 	// for use.  Down: The network link is down.  Deleted: The connection has been
 	// deleted.  Rejected: A hosted connection in the 'Ordering' state will enter
 	// the 'Rejected' state if it is deleted by the end customer.
-	ConnectionState *string `locationName:"connectionState" type:"string"`
+	//
+	// See the ConnectionState* constants for valid values.
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ordering,requested,pending,available,down,deleting,deleted,rejected"`
 
 	metadataConfirmConnectionOutput `json:"-" xml:"-"`
 }
@@ -736,6 +969,16 @@ type metadataConfirmConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmConnectionOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the ConfirmPrivateVirtualInterface operation.
 type ConfirmPrivateVirtualInterfaceInput struct {
 	// ID of the virtual private gateway that will be attached to the virtual interface.
@@ -761,6 +1004,16 @@ type metadataConfirmPrivateVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmPrivateVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmPrivateVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
 // The response received when ConfirmPrivateVirtualInterface is called.
 type ConfirmPrivateVirtualInterfaceOutput struct {
 	// State of the virtual interface.   Confirming: The creation of the virtual
@@ -779,7 +1032,9 @@ type ConfirmPrivateVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	//
+	// See the VirtualInterfaceState* constants for valid values.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataConfirmPrivateVirtualInterfaceOutput `json:"-" xml:"-"`
 }
@@ -788,6 +1043,16 @@ type metadataConfirmPrivateVirtualInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmPrivateVirtualInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmPrivateVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the ConfirmPublicVirtualInterface operation.
 type ConfirmPublicVirtualInterfaceInput struct {
 	// ID of the virtual interface.
@@ -804,6 +1069,16 @@ type metadataConfirmPublicVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmPublicVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmPublicVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
 // The response received when ConfirmPublicVirtualInterface is called.
 type ConfirmPublicVirtualInterfaceOutput struct {
 	// State of the virtual interface.   Confirming: The creation of the virtual
@@ -822,7 +1097,9 @@ type ConfirmPublicVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	//
+	// See the VirtualInterfaceState* constants for valid values.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataConfirmPublicVirtualInterfaceOutput `json:"-" xml:"-"`
 }
@@ -831,6 +1108,16 @@ type metadataConfirmPublicVirtualInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmPublicVirtualInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmPublicVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
 // A connection represents the physical network connection between the AWS Direct
 // Connect location and the customer.
 type Connection struct {
@@ -865,7 +1152,9 @@ type Connection struct {
 	// for use.  Down: The network link is down.  Deleted: The connection has been
 	// deleted.  Rejected: A hosted connection in the 'Ordering' state will enter
 	// the 'Rejected' state if it is deleted by the end customer.
-	ConnectionState *string `locationName:"connectionState" type:"string"`
+	//
+	// See the ConnectionState* constants for valid values.
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ordering,requested,pending,available,down,deleting,deleted,rejected"`
 
 	// Where the connection is located.
 	//
@@ -897,6 +1186,16 @@ type metadataConnection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Connection) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Connection) GoString() string {
+	return s.String()
+}
+
 // A structure containing a list of connections.
 type Connections struct {
 	// A list of connections.
@@ -909,6 +1208,16 @@ type metadataConnections struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Connections) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Connections) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the CreateConnection operation.
 type CreateConnectionInput struct {
 	// Bandwidth of the connection.
@@ -939,6 +1248,16 @@ type metadataCreateConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateConnectionInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the CreateInterconnect operation.
 type CreateInterconnectInput struct {
 	// The port bandwidth
@@ -971,6 +1290,16 @@ type metadataCreateInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInterconnectInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the CreatePrivateVirtualInterface operation.
 type CreatePrivateVirtualInterfaceInput struct {
 	// ID of the connection.
@@ -992,6 +1321,16 @@ type metadataCreatePrivateVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePrivateVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePrivateVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the CreatePublicVirtualInterface operation.
 type CreatePublicVirtualInterfaceInput struct {
 	// ID of the connection.
@@ -1013,6 +1352,16 @@ type metadataCreatePublicVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePublicVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePublicVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteConnection operation.
 type DeleteConnectionInput struct {
 	// ID of the connection.
@@ -1029,6 +1378,16 @@ type metadataDeleteConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteConnectionInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteInterconnect operation.
 type DeleteInterconnectInput struct {
 	// The ID of the interconnect.
@@ -1043,6 +1402,16 @@ type metadataDeleteInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInterconnectInput) GoString() string {
+	return s.String()
+}
+
 // The response received when DeleteInterconnect is called.
 type DeleteInterconnectOutput struct {
 	// State of the interconnect.   Requested: The initial state of an interconnect.
@@ -1051,7 +1420,9 @@ type DeleteInterconnectOutput struct {
 	// and is being initialized.  Available: The network link is up, and the interconnect
 	// is ready for use.  Down: The network link is down.  Deleted: The interconnect
 	// has been deleted.
-	InterconnectState *string `locationName:"interconnectState" type:"string"`
+	//
+	// See the InterconnectState* constants for valid values.
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"requested,pending,available,down,deleting,deleted"`
 
 	metadataDeleteInterconnectOutput `json:"-" xml:"-"`
 }
@@ -1060,6 +1431,16 @@ type metadataDeleteInterconnectOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInterconnectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInterconnectOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DeleteVirtualInterface operation.
 type DeleteVirtualInterfaceInput struct {
 	// ID of the virtual interface.
@@ -1076,6 +1457,16 @@ type metadataDeleteVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVirtualInterfaceInput) GoString() string {
+	return s.String()
+}
+
 // The response received when DeleteVirtualInterface is called.
 type DeleteVirtualInterfaceOutput struct {
 	// State of the virtual interface.   Confirming: The creation of the virtual
@@ -1094,7 +1485,9 @@ type DeleteVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	//
+	// See the VirtualInterfaceState* constants for valid values.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataDeleteVirtualInterfaceOutput `json:"-" xml:"-"`
 }
@@ -1103,6 +1496,16 @@ type metadataDeleteVirtualInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVirtualInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVirtualInterfaceOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeConnections operation.
 type DescribeConnectionsInput struct {
 	// ID of the connection.
@@ -1119,6 +1522,16 @@ type metadataDescribeConnectionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConnectionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConnectionsInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeConnectionsOnInterconnect operation.
 type DescribeConnectionsOnInterconnectInput struct {
 	// ID of the interconnect on which a list of connection is provisioned.
@@ -1135,6 +1548,16 @@ type metadataDescribeConnectionsOnInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConnectionsOnInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConnectionsOnInterconnectInput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeInterconnects operation.
 type DescribeInterconnectsInput struct {
 	// The ID of the interconnect.
@@ -1149,6 +1572,16 @@ type metadataDescribeInterconnectsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInterconnectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInterconnectsInput) GoString() string {
+	return s.String()
+}
+
 // A structure containing a list of interconnects.
 type DescribeInterconnectsOutput struct {
 	// A list of interconnects.
@@ -1161,6 +1594,16 @@ type metadataDescribeInterconnectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInterconnectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInterconnectsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLocationsInput struct {
 	metadataDescribeLocationsInput `json:"-" xml:"-"`
 }
@@ -1169,6 +1612,16 @@ type metadataDescribeLocationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLocationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLocationsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLocationsOutput struct {
 	Locations []*Location `locationName:"locations" type:"list"`
 
@@ -1179,6 +1632,16 @@ type metadataDescribeLocationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLocationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLocationsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVirtualGatewaysInput struct {
 	metadataDescribeVirtualGatewaysInput `json:"-" xml:"-"`
 }
@@ -1187,6 +1650,16 @@ type metadataDescribeVirtualGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVirtualGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVirtualGatewaysInput) GoString() string {
+	return s.String()
+}
+
 // A structure containing a list of virtual private gateways.
 type DescribeVirtualGatewaysOutput struct {
 	// A list of virtual private gateways.
@@ -1199,6 +1672,16 @@ type metadataDescribeVirtualGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVirtualGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVirtualGatewaysOutput) GoString() string {
+	return s.String()
+}
+
 // Container for the parameters to the DescribeVirtualInterfaces operation.
 type DescribeVirtualInterfacesInput struct {
 	// ID of the connection.
@@ -1222,6 +1705,16 @@ type metadataDescribeVirtualInterfacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVirtualInterfacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVirtualInterfacesInput) GoString() string {
+	return s.String()
+}
+
 // A structure containing a list of virtual interfaces.
 type DescribeVirtualInterfacesOutput struct {
 	// A list of virtual interfaces.
@@ -1234,6 +1727,16 @@ type metadataDescribeVirtualInterfacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVirtualInterfacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVirtualInterfacesOutput) GoString() string {
+	return s.String()
+}
+
 // An interconnect is a connection that can host other connections.
 //
 // Like a standard AWS Direct Connect connection, an interconnect represents
@@ -1270,7 +1773,9 @@ type Interconnect struct {
 	// and is being initialized.  Available: The network link is up, and the interconnect
 	// is ready for use.  Down: The network link is down.  Deleted: The interconnect
 	// has been deleted.
-	InterconnectState *string `locationName:"interconnectState" type:"string"`
+	//
+	// See the InterconnectState* constants for valid values.
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"requested,pending,available,down,deleting,deleted"`
 
 	// Where the connection is located.
 	//
@@ -1293,6 +1798,16 @@ type metadataInterconnect struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Interconnect) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Interconnect) GoString() string {
+	return s.String()
+}
+
 // An AWS Direct Connect location where connections and interconnects can be
 // requested.
 type Location struct {
@@ -1310,6 +1825,16 @@ type metadataLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Location) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Location) GoString() string {
+	return s.String()
+}
+
 // A structure containing information about a new private virtual interface.
 type NewPrivateVirtualInterface struct {
 	// Autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
@@ -1355,6 +1880,16 @@ type metadataNewPrivateVirtualInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NewPrivateVirtualInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NewPrivateVirtualInterface) GoString() string {
+	return s.String()
+}
+
 // A structure containing information about a private virtual interface that
 // will be provisioned on a connection.
 type NewPrivateVirtualInterfaceAllocation struct {
@@ -1395,6 +1930,16 @@ type metadataNewPrivateVirtualInterfaceAllocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NewPrivateVirtualInterfaceAllocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NewPrivateVirtualInterfaceAllocation) GoString() string {
+	return s.String()
+}
+
 // A structure containing information about a new public virtual interface.
 type NewPublicVirtualInterface struct {
 	// Autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
@@ -1438,6 +1983,16 @@ type metadataNewPublicVirtualInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NewPublicVirtualInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NewPublicVirtualInterface) GoString() string {
+	return s.String()
+}
+
 // A structure containing information about a public virtual interface that
 // will be provisioned on a connection.
 type NewPublicVirtualInterfaceAllocation struct {
@@ -1482,6 +2037,16 @@ type metadataNewPublicVirtualInterfaceAllocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NewPublicVirtualInterfaceAllocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NewPublicVirtualInterfaceAllocation) GoString() string {
+	return s.String()
+}
+
 // A route filter prefix that the customer can advertise through Border Gateway
 // Protocol (BGP) over a public virtual interface.
 type RouteFilterPrefix struct {
@@ -1498,6 +2063,16 @@ type metadataRouteFilterPrefix struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RouteFilterPrefix) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RouteFilterPrefix) GoString() string {
+	return s.String()
+}
+
 // You can create one or more AWS Direct Connect private virtual interfaces
 // linking to your virtual private gateway.
 //
@@ -1524,6 +2099,16 @@ type metadataVirtualGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VirtualGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VirtualGateway) GoString() string {
+	return s.String()
+}
+
 // A virtual interface (VLAN) transmits the traffic between the AWS Direct Connect
 // location and the customer.
 type VirtualInterface struct {
@@ -1609,7 +2194,9 @@ type VirtualInterface struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	//
+	// See the VirtualInterfaceState* constants for valid values.
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	// The type of virtual interface.
 	//
@@ -1623,3 +2210,64 @@ type VirtualInterface struct {
 type metadataVirtualInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VirtualInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VirtualInterface) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ConnectionState
+	ConnectionStateOrdering = "ordering"
+	// @enum ConnectionState
+	ConnectionStateRequested = "requested"
+	// @enum ConnectionState
+	ConnectionStatePending = "pending"
+	// @enum ConnectionState
+	ConnectionStateAvailable = "available"
+	// @enum ConnectionState
+	ConnectionStateDown = "down"
+	// @enum ConnectionState
+	ConnectionStateDeleting = "deleting"
+	// @enum ConnectionState
+	ConnectionStateDeleted = "deleted"
+	// @enum ConnectionState
+	ConnectionStateRejected = "rejected"
+)
+
+const (
+	// @enum InterconnectState
+	InterconnectStateRequested = "requested"
+	// @enum InterconnectState
+	InterconnectStatePending = "pending"
+	// @enum InterconnectState
+	InterconnectStateAvailable = "available"
+	// @enum InterconnectState
+	InterconnectStateDown = "down"
+	// @enum InterconnectState
+	InterconnectStateDeleting = "deleting"
+	// @enum InterconnectState
+	InterconnectStateDeleted = "deleted"
+)
+
+const (
+	// @enum VirtualInterfaceState
+	VirtualInterfaceStateConfirming = "confirming"
+	// @enum VirtualInterfaceState
+	VirtualInterfaceStateVerifying = "verifying"
+	// @enum VirtualInterfaceState
+	VirtualInterfaceStatePending = "pending"
+	// @enum VirtualInterfaceState
+	VirtualInterfaceStateAvailable = "available"
+	// @enum VirtualInterfaceState
+	VirtualInterfaceStateDeleting = "deleting"
+	// @enum VirtualInterfaceState
+	VirtualInterfaceStateDeleted = "deleted"
+	// @enum VirtualInterfaceState
+	VirtualInterfaceStateRejected = "rejected"
+)
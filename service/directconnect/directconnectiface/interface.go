@@ -4,6 +4,7 @@
 package directconnectiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 )
 
@@ -11,39 +12,77 @@ import (
 type DirectConnectAPI interface {
 	AllocateConnectionOnInterconnect(*directconnect.AllocateConnectionOnInterconnectInput) (*directconnect.Connection, error)
 
+	AllocateConnectionOnInterconnectWithContext(aws.Context, *directconnect.AllocateConnectionOnInterconnectInput) (*directconnect.Connection, error)
+
 	AllocatePrivateVirtualInterface(*directconnect.AllocatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	AllocatePrivateVirtualInterfaceWithContext(aws.Context, *directconnect.AllocatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	AllocatePublicVirtualInterface(*directconnect.AllocatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	AllocatePublicVirtualInterfaceWithContext(aws.Context, *directconnect.AllocatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	ConfirmConnection(*directconnect.ConfirmConnectionInput) (*directconnect.ConfirmConnectionOutput, error)
 
+	ConfirmConnectionWithContext(aws.Context, *directconnect.ConfirmConnectionInput) (*directconnect.ConfirmConnectionOutput, error)
+
 	ConfirmPrivateVirtualInterface(*directconnect.ConfirmPrivateVirtualInterfaceInput) (*directconnect.ConfirmPrivateVirtualInterfaceOutput, error)
 
+	ConfirmPrivateVirtualInterfaceWithContext(aws.Context, *directconnect.ConfirmPrivateVirtualInterfaceInput) (*directconnect.ConfirmPrivateVirtualInterfaceOutput, error)
+
 	ConfirmPublicVirtualInterface(*directconnect.ConfirmPublicVirtualInterfaceInput) (*directconnect.ConfirmPublicVirtualInterfaceOutput, error)
 
+	ConfirmPublicVirtualInterfaceWithContext(aws.Context, *directconnect.ConfirmPublicVirtualInterfaceInput) (*directconnect.ConfirmPublicVirtualInterfaceOutput, error)
+
 	CreateConnection(*directconnect.CreateConnectionInput) (*directconnect.Connection, error)
 
+	CreateConnectionWithContext(aws.Context, *directconnect.CreateConnectionInput) (*directconnect.Connection, error)
+
 	CreateInterconnect(*directconnect.CreateInterconnectInput) (*directconnect.Interconnect, error)
 
+	CreateInterconnectWithContext(aws.Context, *directconnect.CreateInterconnectInput) (*directconnect.Interconnect, error)
+
 	CreatePrivateVirtualInterface(*directconnect.CreatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	CreatePrivateVirtualInterfaceWithContext(aws.Context, *directconnect.CreatePrivateVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	CreatePublicVirtualInterface(*directconnect.CreatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
 
+	CreatePublicVirtualInterfaceWithContext(aws.Context, *directconnect.CreatePublicVirtualInterfaceInput) (*directconnect.VirtualInterface, error)
+
 	DeleteConnection(*directconnect.DeleteConnectionInput) (*directconnect.Connection, error)
 
+	DeleteConnectionWithContext(aws.Context, *directconnect.DeleteConnectionInput) (*directconnect.Connection, error)
+
 	DeleteInterconnect(*directconnect.DeleteInterconnectInput) (*directconnect.DeleteInterconnectOutput, error)
 
+	DeleteInterconnectWithContext(aws.Context, *directconnect.DeleteInterconnectInput) (*directconnect.DeleteInterconnectOutput, error)
+
 	DeleteVirtualInterface(*directconnect.DeleteVirtualInterfaceInput) (*directconnect.DeleteVirtualInterfaceOutput, error)
 
+	DeleteVirtualInterfaceWithContext(aws.Context, *directconnect.DeleteVirtualInterfaceInput) (*directconnect.DeleteVirtualInterfaceOutput, error)
+
 	DescribeConnections(*directconnect.DescribeConnectionsInput) (*directconnect.Connections, error)
 
+	DescribeConnectionsWithContext(aws.Context, *directconnect.DescribeConnectionsInput) (*directconnect.Connections, error)
+
 	DescribeConnectionsOnInterconnect(*directconnect.DescribeConnectionsOnInterconnectInput) (*directconnect.Connections, error)
 
+	DescribeConnectionsOnInterconnectWithContext(aws.Context, *directconnect.DescribeConnectionsOnInterconnectInput) (*directconnect.Connections, error)
+
 	DescribeInterconnects(*directconnect.DescribeInterconnectsInput) (*directconnect.DescribeInterconnectsOutput, error)
 
+	DescribeInterconnectsWithContext(aws.Context, *directconnect.DescribeInterconnectsInput) (*directconnect.DescribeInterconnectsOutput, error)
+
 	DescribeLocations(*directconnect.DescribeLocationsInput) (*directconnect.DescribeLocationsOutput, error)
 
+	DescribeLocationsWithContext(aws.Context, *directconnect.DescribeLocationsInput) (*directconnect.DescribeLocationsOutput, error)
+
 	DescribeVirtualGateways(*directconnect.DescribeVirtualGatewaysInput) (*directconnect.DescribeVirtualGatewaysOutput, error)
 
+	DescribeVirtualGatewaysWithContext(aws.Context, *directconnect.DescribeVirtualGatewaysInput) (*directconnect.DescribeVirtualGatewaysOutput, error)
+
 	DescribeVirtualInterfaces(*directconnect.DescribeVirtualInterfacesInput) (*directconnect.DescribeVirtualInterfacesOutput, error)
+
+	DescribeVirtualInterfacesWithContext(aws.Context, *directconnect.DescribeVirtualInterfacesInput) (*directconnect.DescribeVirtualInterfacesOutput, error)
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opConnectDirectory = "ConnectDirectory"
@@ -36,6 +37,16 @@ func (c *DirectoryService) ConnectDirectory(input *ConnectDirectoryInput) (*Conn
 	return out, err
 }
 
+// ConnectDirectoryWithContext is the same as ConnectDirectory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) ConnectDirectoryWithContext(ctx aws.Context, input *ConnectDirectoryInput) (*ConnectDirectoryOutput, error) {
+	req, out := c.ConnectDirectoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAlias = "CreateAlias"
 
 // CreateAliasRequest generates a request for the CreateAlias operation.
@@ -68,6 +79,16 @@ func (c *DirectoryService) CreateAlias(input *CreateAliasInput) (*CreateAliasOut
 	return out, err
 }
 
+// CreateAliasWithContext is the same as CreateAlias with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) CreateAliasWithContext(ctx aws.Context, input *CreateAliasInput) (*CreateAliasOutput, error) {
+	req, out := c.CreateAliasRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateComputer = "CreateComputer"
 
 // CreateComputerRequest generates a request for the CreateComputer operation.
@@ -96,6 +117,16 @@ func (c *DirectoryService) CreateComputer(input *CreateComputerInput) (*CreateCo
 	return out, err
 }
 
+// CreateComputerWithContext is the same as CreateComputer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) CreateComputerWithContext(ctx aws.Context, input *CreateComputerInput) (*CreateComputerOutput, error) {
+	req, out := c.CreateComputerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDirectory = "CreateDirectory"
 
 // CreateDirectoryRequest generates a request for the CreateDirectory operation.
@@ -123,6 +154,16 @@ func (c *DirectoryService) CreateDirectory(input *CreateDirectoryInput) (*Create
 	return out, err
 }
 
+// CreateDirectoryWithContext is the same as CreateDirectory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) CreateDirectoryWithContext(ctx aws.Context, input *CreateDirectoryInput) (*CreateDirectoryOutput, error) {
+	req, out := c.CreateDirectoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshot = "CreateSnapshot"
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -152,6 +193,16 @@ func (c *DirectoryService) CreateSnapshot(input *CreateSnapshotInput) (*CreateSn
 	return out, err
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) CreateSnapshotWithContext(ctx aws.Context, input *CreateSnapshotInput) (*CreateSnapshotOutput, error) {
+	req, out := c.CreateSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDirectory = "DeleteDirectory"
 
 // DeleteDirectoryRequest generates a request for the DeleteDirectory operation.
@@ -179,6 +230,16 @@ func (c *DirectoryService) DeleteDirectory(input *DeleteDirectoryInput) (*Delete
 	return out, err
 }
 
+// DeleteDirectoryWithContext is the same as DeleteDirectory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) DeleteDirectoryWithContext(ctx aws.Context, input *DeleteDirectoryInput) (*DeleteDirectoryOutput, error) {
+	req, out := c.DeleteDirectoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSnapshot = "DeleteSnapshot"
 
 // DeleteSnapshotRequest generates a request for the DeleteSnapshot operation.
@@ -206,6 +267,16 @@ func (c *DirectoryService) DeleteSnapshot(input *DeleteSnapshotInput) (*DeleteSn
 	return out, err
 }
 
+// DeleteSnapshotWithContext is the same as DeleteSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) DeleteSnapshotWithContext(ctx aws.Context, input *DeleteSnapshotInput) (*DeleteSnapshotOutput, error) {
+	req, out := c.DeleteSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDirectories = "DescribeDirectories"
 
 // DescribeDirectoriesRequest generates a request for the DescribeDirectories operation.
@@ -244,6 +315,16 @@ func (c *DirectoryService) DescribeDirectories(input *DescribeDirectoriesInput)
 	return out, err
 }
 
+// DescribeDirectoriesWithContext is the same as DescribeDirectories with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) DescribeDirectoriesWithContext(ctx aws.Context, input *DescribeDirectoriesInput) (*DescribeDirectoriesOutput, error) {
+	req, out := c.DescribeDirectoriesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSnapshots = "DescribeSnapshots"
 
 // DescribeSnapshotsRequest generates a request for the DescribeSnapshots operation.
@@ -278,6 +359,16 @@ func (c *DirectoryService) DescribeSnapshots(input *DescribeSnapshotsInput) (*De
 	return out, err
 }
 
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) DescribeSnapshotsWithContext(ctx aws.Context, input *DescribeSnapshotsInput) (*DescribeSnapshotsOutput, error) {
+	req, out := c.DescribeSnapshotsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableRadius = "DisableRadius"
 
 // DisableRadiusRequest generates a request for the DisableRadius operation.
@@ -306,6 +397,16 @@ func (c *DirectoryService) DisableRadius(input *DisableRadiusInput) (*DisableRad
 	return out, err
 }
 
+// DisableRadiusWithContext is the same as DisableRadius with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) DisableRadiusWithContext(ctx aws.Context, input *DisableRadiusInput) (*DisableRadiusOutput, error) {
+	req, out := c.DisableRadiusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableSSO = "DisableSso"
 
 // DisableSSORequest generates a request for the DisableSSO operation.
@@ -333,6 +434,16 @@ func (c *DirectoryService) DisableSSO(input *DisableSSOInput) (*DisableSSOOutput
 	return out, err
 }
 
+// DisableSSOWithContext is the same as DisableSSO with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) DisableSSOWithContext(ctx aws.Context, input *DisableSSOInput) (*DisableSSOOutput, error) {
+	req, out := c.DisableSSORequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableRadius = "EnableRadius"
 
 // EnableRadiusRequest generates a request for the EnableRadius operation.
@@ -361,6 +472,16 @@ func (c *DirectoryService) EnableRadius(input *EnableRadiusInput) (*EnableRadius
 	return out, err
 }
 
+// EnableRadiusWithContext is the same as EnableRadius with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) EnableRadiusWithContext(ctx aws.Context, input *EnableRadiusInput) (*EnableRadiusOutput, error) {
+	req, out := c.EnableRadiusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableSSO = "EnableSso"
 
 // EnableSSORequest generates a request for the EnableSSO operation.
@@ -388,6 +509,16 @@ func (c *DirectoryService) EnableSSO(input *EnableSSOInput) (*EnableSSOOutput, e
 	return out, err
 }
 
+// EnableSSOWithContext is the same as EnableSSO with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) EnableSSOWithContext(ctx aws.Context, input *EnableSSOInput) (*EnableSSOOutput, error) {
+	req, out := c.EnableSSORequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDirectoryLimits = "GetDirectoryLimits"
 
 // GetDirectoryLimitsRequest generates a request for the GetDirectoryLimits operation.
@@ -415,6 +546,16 @@ func (c *DirectoryService) GetDirectoryLimits(input *GetDirectoryLimitsInput) (*
 	return out, err
 }
 
+// GetDirectoryLimitsWithContext is the same as GetDirectoryLimits with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) GetDirectoryLimitsWithContext(ctx aws.Context, input *GetDirectoryLimitsInput) (*GetDirectoryLimitsOutput, error) {
+	req, out := c.GetDirectoryLimitsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSnapshotLimits = "GetSnapshotLimits"
 
 // GetSnapshotLimitsRequest generates a request for the GetSnapshotLimits operation.
@@ -442,6 +583,16 @@ func (c *DirectoryService) GetSnapshotLimits(input *GetSnapshotLimitsInput) (*Ge
 	return out, err
 }
 
+// GetSnapshotLimitsWithContext is the same as GetSnapshotLimits with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) GetSnapshotLimitsWithContext(ctx aws.Context, input *GetSnapshotLimitsInput) (*GetSnapshotLimitsOutput, error) {
+	req, out := c.GetSnapshotLimitsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRestoreFromSnapshot = "RestoreFromSnapshot"
 
 // RestoreFromSnapshotRequest generates a request for the RestoreFromSnapshot operation.
@@ -477,6 +628,16 @@ func (c *DirectoryService) RestoreFromSnapshot(input *RestoreFromSnapshotInput)
 	return out, err
 }
 
+// RestoreFromSnapshotWithContext is the same as RestoreFromSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) RestoreFromSnapshotWithContext(ctx aws.Context, input *RestoreFromSnapshotInput) (*RestoreFromSnapshotOutput, error) {
+	req, out := c.RestoreFromSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateRadius = "UpdateRadius"
 
 // UpdateRadiusRequest generates a request for the UpdateRadius operation.
@@ -505,10 +666,20 @@ func (c *DirectoryService) UpdateRadius(input *UpdateRadiusInput) (*UpdateRadius
 	return out, err
 }
 
+// UpdateRadiusWithContext is the same as UpdateRadius with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DirectoryService) UpdateRadiusWithContext(ctx aws.Context, input *UpdateRadiusInput) (*UpdateRadiusOutput, error) {
+	req, out := c.UpdateRadiusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Represents a named directory attribute.
 type Attribute struct {
 	// The name of the attribute.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1"`
 
 	// The value of the attribute.
 	Value *string `type:"string"`
@@ -520,6 +691,16 @@ type metadataAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Attribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Attribute) GoString() string {
+	return s.String()
+}
+
 // Contains information about a computer account in a directory.
 type Computer struct {
 	// An array of Attribute objects that contain the LDAP attributes that belong
@@ -527,10 +708,10 @@ type Computer struct {
 	ComputerAttributes []*Attribute `type:"list"`
 
 	// The identifier of the computer.
-	ComputerID *string `locationName:"ComputerId" type:"string"`
+	ComputerID *string `locationName:"ComputerId" type:"string" min:"1" max:"256" pattern:"[&\\w+-.@]+"`
 
 	// The computer name.
-	ComputerName *string `type:"string"`
+	ComputerName *string `type:"string" min:"1" max:"15"`
 
 	metadataComputer `json:"-" xml:"-"`
 }
@@ -539,6 +720,16 @@ type metadataComputer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Computer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Computer) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the ConnectDirectory operation.
 type ConnectDirectoryInput struct {
 	// A DirectoryConnectSettings object that contains additional information for
@@ -546,19 +737,21 @@ type ConnectDirectoryInput struct {
 	ConnectSettings *DirectoryConnectSettings `type:"structure" required:"true"`
 
 	// A textual description for the directory.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"128" pattern:"^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"`
 
 	// The fully-qualified name of the on-premises directory, such as corp.example.com.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" pattern:"^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$" required:"true"`
 
 	// The password for the on-premises user account.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"1" max:"128" sensitive:"true" required:"true"`
 
 	// The NetBIOS name of the on-premises directory, such as CORP.
-	ShortName *string `type:"string"`
+	ShortName *string `type:"string" pattern:"^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$"`
 
 	// The size of the directory.
-	Size *string `type:"string" required:"true"`
+	//
+	// See the DirectorySize* constants for valid values.
+	Size *string `type:"string" enum:"Small,Large" required:"true"`
 
 	metadataConnectDirectoryInput `json:"-" xml:"-"`
 }
@@ -567,10 +760,20 @@ type metadataConnectDirectoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConnectDirectoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConnectDirectoryInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the ConnectDirectory operation.
 type ConnectDirectoryOutput struct {
 	// The identifier of the new directory.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$"`
 
 	metadataConnectDirectoryOutput `json:"-" xml:"-"`
 }
@@ -579,16 +782,26 @@ type metadataConnectDirectoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConnectDirectoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConnectDirectoryOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the CreateAlias operation.
 type CreateAliasInput struct {
 	// The requested alias.
 	//
 	// The alias must be unique amongst all aliases in AWS. This operation will
 	// throw an EntityAlreadyExistsException if this alias already exists.
-	Alias *string `type:"string" required:"true"`
+	Alias *string `type:"string" min:"1" max:"62" required:"true"`
 
 	// The identifier of the directory to create the alias for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	metadataCreateAliasInput `json:"-" xml:"-"`
 }
@@ -597,13 +810,23 @@ type metadataCreateAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAliasInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the CreateAlias operation.
 type CreateAliasOutput struct {
 	// The alias for the directory.
-	Alias *string `type:"string"`
+	Alias *string `type:"string" min:"1" max:"62"`
 
 	// The identifier of the directory.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$"`
 
 	metadataCreateAliasOutput `json:"-" xml:"-"`
 }
@@ -612,6 +835,16 @@ type metadataCreateAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAliasOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the CreateComputer operation.
 type CreateComputerInput struct {
 	// An array of Attribute objects that contain any LDAP attributes to apply to
@@ -619,18 +852,18 @@ type CreateComputerInput struct {
 	ComputerAttributes []*Attribute `type:"list"`
 
 	// The name of the computer account.
-	ComputerName *string `type:"string" required:"true"`
+	ComputerName *string `type:"string" min:"1" max:"15" required:"true"`
 
 	// The identifier of the directory to create the computer account in.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	// The fully-qualified distinguished name of the organizational unit to place
 	// the computer account in.
-	OrganizationalUnitDistinguishedName *string `type:"string"`
+	OrganizationalUnitDistinguishedName *string `type:"string" min:"1" max:"2000"`
 
 	// A one-time password that is used to join the computer to the directory. You
 	// should generate a random, strong password to use for this parameter.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"8" max:"64" pattern:"[\\x{0020}-\\x{00FF}]+" sensitive:"true" required:"true"`
 
 	metadataCreateComputerInput `json:"-" xml:"-"`
 }
@@ -639,6 +872,16 @@ type metadataCreateComputerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateComputerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateComputerInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results for the CreateComputer operation.
 type CreateComputerOutput struct {
 	// A Computer object the represents the computer account.
@@ -651,24 +894,36 @@ type metadataCreateComputerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateComputerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateComputerOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the CreateDirectory operation.
 type CreateDirectoryInput struct {
 	// A textual description for the directory.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"128" pattern:"^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"`
 
 	// The fully qualified name for the directory, such as corp.example.com.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" pattern:"^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$" required:"true"`
 
 	// The password for the directory administrator. The directory creation process
 	// creates a directory administrator account with the username Administrator
 	// and this password.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" sensitive:"true" required:"true"`
 
 	// The short name of the directory, such as CORP.
-	ShortName *string `type:"string"`
+	ShortName *string `type:"string" pattern:"^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$"`
 
 	// The size of the directory.
-	Size *string `type:"string" required:"true"`
+	//
+	// See the DirectorySize* constants for valid values.
+	Size *string `type:"string" enum:"Small,Large" required:"true"`
 
 	// A DirectoryVpcSettings object that contains additional information for the
 	// operation.
@@ -681,10 +936,20 @@ type metadataCreateDirectoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDirectoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDirectoryInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the CreateDirectory operation.
 type CreateDirectoryOutput struct {
 	// The identifier of the directory that was created.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$"`
 
 	metadataCreateDirectoryOutput `json:"-" xml:"-"`
 }
@@ -693,13 +958,23 @@ type metadataCreateDirectoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDirectoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDirectoryOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the CreateSnapshot operation.
 type CreateSnapshotInput struct {
 	// The identifier of the directory to take a snapshot of.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	// The descriptive name to apply to the snapshot.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"128" pattern:"^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"`
 
 	metadataCreateSnapshotInput `json:"-" xml:"-"`
 }
@@ -708,10 +983,20 @@ type metadataCreateSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the CreateSnapshot operation.
 type CreateSnapshotOutput struct {
 	// The identifier of the snapshot that was created.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"^s-[0-9a-f]{10}$"`
 
 	metadataCreateSnapshotOutput `json:"-" xml:"-"`
 }
@@ -720,10 +1005,20 @@ type metadataCreateSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DeleteDirectory operation.
 type DeleteDirectoryInput struct {
 	// The identifier of the directory to delete.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	metadataDeleteDirectoryInput `json:"-" xml:"-"`
 }
@@ -732,10 +1027,20 @@ type metadataDeleteDirectoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDirectoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDirectoryInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DeleteDirectory operation.
 type DeleteDirectoryOutput struct {
 	// The directory identifier.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$"`
 
 	metadataDeleteDirectoryOutput `json:"-" xml:"-"`
 }
@@ -744,10 +1049,20 @@ type metadataDeleteDirectoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDirectoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDirectoryOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DeleteSnapshot operation.
 type DeleteSnapshotInput struct {
 	// The identifier of the directory snapshot to be deleted.
-	SnapshotID *string `locationName:"SnapshotId" type:"string" required:"true"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"^s-[0-9a-f]{10}$" required:"true"`
 
 	metadataDeleteSnapshotInput `json:"-" xml:"-"`
 }
@@ -756,10 +1071,20 @@ type metadataDeleteSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DeleteSnapshot operation.
 type DeleteSnapshotOutput struct {
 	// The identifier of the directory snapshot that was deleted.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"^s-[0-9a-f]{10}$"`
 
 	metadataDeleteSnapshotOutput `json:"-" xml:"-"`
 }
@@ -768,6 +1093,16 @@ type metadataDeleteSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DescribeDirectories operation.
 type DescribeDirectoriesInput struct {
 	// A list of identifiers of the directories to obtain the information for. If
@@ -779,7 +1114,7 @@ type DescribeDirectoriesInput struct {
 
 	// The maximum number of items to return. If this value is zero, the maximum
 	// number of items is specified by the limitations of the operation.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"0"`
 
 	// The DescribeDirectoriesResult.NextToken value from a previous call to DescribeDirectories.
 	// Pass null if this is the first call.
@@ -792,6 +1127,16 @@ type metadataDescribeDirectoriesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDirectoriesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDirectoriesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DescribeDirectories operation.
 type DescribeDirectoriesOutput struct {
 	// The list of DirectoryDescription objects that were retrieved.
@@ -814,13 +1159,23 @@ type metadataDescribeDirectoriesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDirectoriesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDirectoriesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DescribeSnapshots operation.
 type DescribeSnapshotsInput struct {
 	// The identifier of the directory to retrieve snapshot information for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$"`
 
 	// The maximum number of objects to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"0"`
 
 	// The DescribeSnapshotsResult.NextToken value from a previous call to DescribeSnapshots.
 	// Pass null if this is the first call.
@@ -838,6 +1193,16 @@ type metadataDescribeSnapshotsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DescribeSnapshots operation.
 type DescribeSnapshotsOutput struct {
 	// If not null, more results are available. Pass this value in the NextToken
@@ -859,6 +1224,16 @@ type metadataDescribeSnapshotsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information for the ConnectDirectory operation when an AD Connector
 // directory is being created.
 type DirectoryConnectSettings struct {
@@ -870,14 +1245,14 @@ type DirectoryConnectSettings struct {
 	// to the directory. This account must have the following privileges:
 	//
 	//  Read users and groups Create computer objects Join computers to the domain
-	CustomerUserName *string `type:"string" required:"true"`
+	CustomerUserName *string `type:"string" min:"1" pattern:"[a-zA-Z0-9._-]+" required:"true"`
 
 	// A list of subnet identifiers in the VPC that the AD Connector is created
 	// in.
 	SubnetIDs []*string `locationName:"SubnetIds" type:"list" required:"true"`
 
 	// The identifier of the VPC that the AD Connector is created in.
-	VPCID *string `locationName:"VpcId" type:"string" required:"true"`
+	VPCID *string `locationName:"VpcId" type:"string" pattern:"^(vpc-[0-9a-f]{8})$" required:"true"`
 
 	metadataDirectoryConnectSettings `json:"-" xml:"-"`
 }
@@ -886,6 +1261,16 @@ type metadataDirectoryConnectSettings struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DirectoryConnectSettings) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DirectoryConnectSettings) GoString() string {
+	return s.String()
+}
+
 // Contains information about an AD Connector directory.
 type DirectoryConnectSettingsDescription struct {
 	// A list of the Availability Zones that the directory is in.
@@ -895,16 +1280,16 @@ type DirectoryConnectSettingsDescription struct {
 	ConnectIPs []*string `locationName:"ConnectIps" type:"list"`
 
 	// The username of the service account in the on-premises directory.
-	CustomerUserName *string `type:"string"`
+	CustomerUserName *string `type:"string" min:"1" pattern:"[a-zA-Z0-9._-]+"`
 
 	// The security group identifier for the AD Connector directory.
-	SecurityGroupID *string `locationName:"SecurityGroupId" type:"string"`
+	SecurityGroupID *string `locationName:"SecurityGroupId" type:"string" pattern:"^(sg-[0-9a-f]{8})$"`
 
 	// A list of subnet identifiers in the VPC that the AD connector is in.
 	SubnetIDs []*string `locationName:"SubnetIds" type:"list"`
 
 	// The identifier of the VPC that the AD Connector is in.
-	VPCID *string `locationName:"VpcId" type:"string"`
+	VPCID *string `locationName:"VpcId" type:"string" pattern:"^(vpc-[0-9a-f]{8})$"`
 
 	metadataDirectoryConnectSettingsDescription `json:"-" xml:"-"`
 }
@@ -913,13 +1298,23 @@ type metadataDirectoryConnectSettingsDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DirectoryConnectSettingsDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DirectoryConnectSettingsDescription) GoString() string {
+	return s.String()
+}
+
 // Contains information about an AWS Directory Service directory.
 type DirectoryDescription struct {
 	// The access URL for the directory, such as http://<alias>.awsapps.com.
-	AccessURL *string `locationName:"AccessUrl" type:"string"`
+	AccessURL *string `locationName:"AccessUrl" type:"string" min:"1" max:"128"`
 
 	// The alias for the directory.
-	Alias *string `type:"string"`
+	Alias *string `type:"string" min:"1" max:"62"`
 
 	// A DirectoryConnectSettingsDescription object that contains additional information
 	// about an AD Connector directory. This member is only present if the directory
@@ -934,36 +1329,42 @@ type DirectoryDescription struct {
 	DNSIPAddrs []*string `locationName:"DnsIpAddrs" type:"list"`
 
 	// The textual description for the directory.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"128" pattern:"^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"`
 
 	// The directory identifier.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$"`
 
 	// Specifies when the directory was created.
 	LaunchTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The fully-qualified name of the directory.
-	Name *string `type:"string"`
+	Name *string `type:"string" pattern:"^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$"`
 
 	// A RadiusSettings object that contains information about the RADIUS server
 	// configured for this directory.
 	RadiusSettings *RadiusSettings `type:"structure"`
 
 	// The status of the RADIUS MFA server connection.
-	RadiusStatus *string `type:"string"`
+	//
+	// See the RadiusStatus* constants for valid values.
+	RadiusStatus *string `type:"string" enum:"Creating,Completed,Failed"`
 
 	// Indicates if single-sign on is enabled for the directory. For more information,
 	// see EnableSso and DisableSso.
 	SSOEnabled *bool `locationName:"SsoEnabled" type:"boolean"`
 
 	// The short name of the directory.
-	ShortName *string `type:"string"`
+	ShortName *string `type:"string" pattern:"^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$"`
 
 	// The directory size.
-	Size *string `type:"string"`
+	//
+	// See the DirectorySize* constants for valid values.
+	Size *string `type:"string" enum:"Small,Large"`
 
 	// The current stage of the directory.
-	Stage *string `type:"string"`
+	//
+	// See the DirectoryStage* constants for valid values.
+	Stage *string `type:"string" enum:"Requested,Creating,Created,Active,Inoperable,Impaired,Restoring,RestoreFailed,Deleting,Deleted,Failed"`
 
 	// The date and time that the stage was last updated.
 	StageLastUpdatedDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -972,7 +1373,9 @@ type DirectoryDescription struct {
 	StageReason *string `type:"string"`
 
 	// The directory size.
-	Type *string `type:"string"`
+	//
+	// See the DirectoryType* constants for valid values.
+	Type *string `type:"string" enum:"SimpleAD,ADConnector"`
 
 	// A DirectoryVpcSettingsDescription object that contains additional information
 	// about a Simple AD directory. This member is only present if the directory
@@ -986,22 +1389,32 @@ type metadataDirectoryDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DirectoryDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DirectoryDescription) GoString() string {
+	return s.String()
+}
+
 // Contains directory limit information for a region.
 type DirectoryLimits struct {
 	// The current number of cloud directories in the region.
-	CloudOnlyDirectoriesCurrentCount *int64 `type:"integer"`
+	CloudOnlyDirectoriesCurrentCount *int64 `type:"integer" min:"0"`
 
 	// The maximum number of cloud directories allowed in the region.
-	CloudOnlyDirectoriesLimit *int64 `type:"integer"`
+	CloudOnlyDirectoriesLimit *int64 `type:"integer" min:"0"`
 
 	// Indicates if the cloud directory limit has been reached.
 	CloudOnlyDirectoriesLimitReached *bool `type:"boolean"`
 
 	// The current number of connected directories in the region.
-	ConnectedDirectoriesCurrentCount *int64 `type:"integer"`
+	ConnectedDirectoriesCurrentCount *int64 `type:"integer" min:"0"`
 
 	// The maximum number of connected directories allowed in the region.
-	ConnectedDirectoriesLimit *int64 `type:"integer"`
+	ConnectedDirectoriesLimit *int64 `type:"integer" min:"0"`
 
 	// Indicates if the connected directory limit has been reached.
 	ConnectedDirectoriesLimitReached *bool `type:"boolean"`
@@ -1013,6 +1426,16 @@ type metadataDirectoryLimits struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DirectoryLimits) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DirectoryLimits) GoString() string {
+	return s.String()
+}
+
 // Contains information for the CreateDirectory operation when a Simple AD directory
 // is being created.
 type DirectoryVPCSettings struct {
@@ -1022,7 +1445,7 @@ type DirectoryVPCSettings struct {
 	SubnetIDs []*string `locationName:"SubnetIds" type:"list" required:"true"`
 
 	// The identifier of the VPC to create the Simple AD directory in.
-	VPCID *string `locationName:"VpcId" type:"string" required:"true"`
+	VPCID *string `locationName:"VpcId" type:"string" pattern:"^(vpc-[0-9a-f]{8})$" required:"true"`
 
 	metadataDirectoryVPCSettings `json:"-" xml:"-"`
 }
@@ -1031,19 +1454,29 @@ type metadataDirectoryVPCSettings struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DirectoryVPCSettings) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DirectoryVPCSettings) GoString() string {
+	return s.String()
+}
+
 // Contains information about a Simple AD directory.
 type DirectoryVPCSettingsDescription struct {
 	// The list of Availability Zones that the directory is in.
 	AvailabilityZones []*string `type:"list"`
 
 	// The security group identifier for the directory.
-	SecurityGroupID *string `locationName:"SecurityGroupId" type:"string"`
+	SecurityGroupID *string `locationName:"SecurityGroupId" type:"string" pattern:"^(sg-[0-9a-f]{8})$"`
 
 	// The identifiers of the subnets for the directory servers.
 	SubnetIDs []*string `locationName:"SubnetIds" type:"list"`
 
 	// The identifier of the VPC that the directory is in.
-	VPCID *string `locationName:"VpcId" type:"string"`
+	VPCID *string `locationName:"VpcId" type:"string" pattern:"^(vpc-[0-9a-f]{8})$"`
 
 	metadataDirectoryVPCSettingsDescription `json:"-" xml:"-"`
 }
@@ -1052,10 +1485,20 @@ type metadataDirectoryVPCSettingsDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DirectoryVPCSettingsDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DirectoryVPCSettingsDescription) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DisableRadius operation.
 type DisableRadiusInput struct {
 	// The identifier of the directory to disable MFA for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	metadataDisableRadiusInput `json:"-" xml:"-"`
 }
@@ -1064,6 +1507,16 @@ type metadataDisableRadiusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableRadiusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableRadiusInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DisableRadius operation.
 type DisableRadiusOutput struct {
 	metadataDisableRadiusOutput `json:"-" xml:"-"`
@@ -1073,15 +1526,25 @@ type metadataDisableRadiusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableRadiusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableRadiusOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DisableSso operation.
 type DisableSSOInput struct {
 	// The identifier of the directory to disable single-sign on for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	// The password of an alternate account to use to disable single-sign on. This
 	// is only used for AD Connector directories. See the UserName parameter for
 	// more information.
-	Password *string `type:"string"`
+	Password *string `type:"string" min:"1" max:"128" sensitive:"true"`
 
 	// The username of an alternate account to use to disable single-sign on. This
 	// is only used for AD Connector directories. This account must have privileges
@@ -1092,7 +1555,7 @@ type DisableSSOInput struct {
 	// and Password parameters. These credentials are only used to disable single
 	// sign-on and are not stored by the service. The AD Connector service account
 	// is not changed.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" pattern:"[a-zA-Z0-9._-]+"`
 
 	metadataDisableSSOInput `json:"-" xml:"-"`
 }
@@ -1101,6 +1564,16 @@ type metadataDisableSSOInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableSSOInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableSSOInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DisableSso operation.
 type DisableSSOOutput struct {
 	metadataDisableSSOOutput `json:"-" xml:"-"`
@@ -1110,10 +1583,20 @@ type metadataDisableSSOOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableSSOOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableSSOOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the EnableRadius operation.
 type EnableRadiusInput struct {
 	// The identifier of the directory to enable MFA for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	// A RadiusSettings object that contains information about the RADIUS server.
 	RadiusSettings *RadiusSettings `type:"structure" required:"true"`
@@ -1125,6 +1608,16 @@ type metadataEnableRadiusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableRadiusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableRadiusInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the EnableRadius operation.
 type EnableRadiusOutput struct {
 	metadataEnableRadiusOutput `json:"-" xml:"-"`
@@ -1134,15 +1627,25 @@ type metadataEnableRadiusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableRadiusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableRadiusOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the EnableSso operation.
 type EnableSSOInput struct {
 	// The identifier of the directory to enable single-sign on for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	// The password of an alternate account to use to enable single-sign on. This
 	// is only used for AD Connector directories. See the UserName parameter for
 	// more information.
-	Password *string `type:"string"`
+	Password *string `type:"string" min:"1" max:"128" sensitive:"true"`
 
 	// The username of an alternate account to use to enable single-sign on. This
 	// is only used for AD Connector directories. This account must have privileges
@@ -1153,7 +1656,7 @@ type EnableSSOInput struct {
 	// Password parameters. These credentials are only used to enable single sign-on
 	// and are not stored by the service. The AD Connector service account is not
 	// changed.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" pattern:"[a-zA-Z0-9._-]+"`
 
 	metadataEnableSSOInput `json:"-" xml:"-"`
 }
@@ -1162,6 +1665,16 @@ type metadataEnableSSOInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableSSOInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableSSOInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the EnableSso operation.
 type EnableSSOOutput struct {
 	metadataEnableSSOOutput `json:"-" xml:"-"`
@@ -1171,6 +1684,16 @@ type metadataEnableSSOOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableSSOOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableSSOOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the GetDirectoryLimits operation.
 type GetDirectoryLimitsInput struct {
 	metadataGetDirectoryLimitsInput `json:"-" xml:"-"`
@@ -1180,6 +1703,16 @@ type metadataGetDirectoryLimitsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDirectoryLimitsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDirectoryLimitsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the GetDirectoryLimits operation.
 type GetDirectoryLimitsOutput struct {
 	// A DirectoryLimits object that contains the directory limits for the current
@@ -1193,10 +1726,20 @@ type metadataGetDirectoryLimitsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDirectoryLimitsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDirectoryLimitsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the GetSnapshotLimits operation.
 type GetSnapshotLimitsInput struct {
 	// Contains the identifier of the directory to obtain the limits for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	metadataGetSnapshotLimitsInput `json:"-" xml:"-"`
 }
@@ -1205,6 +1748,16 @@ type metadataGetSnapshotLimitsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSnapshotLimitsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSnapshotLimitsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the GetSnapshotLimits operation.
 type GetSnapshotLimitsOutput struct {
 	// A SnapshotLimits object that contains the manual snapshot limits for the
@@ -1218,34 +1771,46 @@ type metadataGetSnapshotLimitsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSnapshotLimitsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSnapshotLimitsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about a Remote Authentication Dial In User Service (RADIUS)
 // server.
 type RadiusSettings struct {
 	// The protocol specified for your RADIUS endpoints.
-	AuthenticationProtocol *string `type:"string"`
+	//
+	// See the RadiusAuthenticationProtocol* constants for valid values.
+	AuthenticationProtocol *string `type:"string" enum:"PAP,CHAP,MS-CHAPv1,MS-CHAPv2"`
 
 	// Not currently used.
-	DisplayLabel *string `type:"string"`
+	DisplayLabel *string `type:"string" min:"1" max:"64"`
 
 	// The port that your RADIUS server is using for communications. Your on-premises
 	// network must allow inbound traffic over this port from the AWS Directory
 	// Service servers.
-	RadiusPort *int64 `type:"integer"`
+	RadiusPort *int64 `type:"integer" min:"1025" max:"65535"`
 
 	// The maximum number of times that communication with the RADIUS server is
 	// attempted.
-	RadiusRetries *int64 `type:"integer"`
+	RadiusRetries *int64 `type:"integer" min:"0" max:"10"`
 
 	// An array of strings that contains the IP addresses of the RADIUS server endpoints,
 	// or the IP addresses of your RADIUS server load balancer.
 	RadiusServers []*string `type:"list"`
 
 	// The amount of time, in seconds, to wait for the RADIUS server to respond.
-	RadiusTimeout *int64 `type:"integer"`
+	RadiusTimeout *int64 `type:"integer" min:"1" max:"20"`
 
 	// The shared secret code that was specified when your RADIUS endpoints were
 	// created.
-	SharedSecret *string `type:"string"`
+	SharedSecret *string `type:"string" min:"8" max:"512" sensitive:"true"`
 
 	// Not currently used.
 	UseSameUsername *bool `type:"boolean"`
@@ -1257,10 +1822,20 @@ type metadataRadiusSettings struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RadiusSettings) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RadiusSettings) GoString() string {
+	return s.String()
+}
+
 // An object representing the inputs for the RestoreFromSnapshot operation.
 type RestoreFromSnapshotInput struct {
 	// The identifier of the snapshot to restore from.
-	SnapshotID *string `locationName:"SnapshotId" type:"string" required:"true"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"^s-[0-9a-f]{10}$" required:"true"`
 
 	metadataRestoreFromSnapshotInput `json:"-" xml:"-"`
 }
@@ -1269,6 +1844,16 @@ type metadataRestoreFromSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RestoreFromSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RestoreFromSnapshotInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the RestoreFromSnapshot operation.
 type RestoreFromSnapshotOutput struct {
 	metadataRestoreFromSnapshotOutput `json:"-" xml:"-"`
@@ -1278,25 +1863,39 @@ type metadataRestoreFromSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RestoreFromSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RestoreFromSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a directory snapshot.
 type Snapshot struct {
 	// The directory identifier.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$"`
 
 	// The descriptive name of the snapshot.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"128" pattern:"^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"`
 
 	// The snapshot identifier.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"^s-[0-9a-f]{10}$"`
 
 	// The date and time that the snapshot was taken.
 	StartTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The snapshot status.
-	Status *string `type:"string"`
+	//
+	// See the SnapshotStatus* constants for valid values.
+	Status *string `type:"string" enum:"Creating,Completed,Failed"`
 
 	// The snapshot type.
-	Type *string `type:"string"`
+	//
+	// See the SnapshotType* constants for valid values.
+	Type *string `type:"string" enum:"Auto,Manual"`
 
 	metadataSnapshot `json:"-" xml:"-"`
 }
@@ -1305,13 +1904,23 @@ type metadataSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Snapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Snapshot) GoString() string {
+	return s.String()
+}
+
 // Contains manual snapshot limit information for a directory.
 type SnapshotLimits struct {
 	// The current number of manual snapshots of the directory.
-	ManualSnapshotsCurrentCount *int64 `type:"integer"`
+	ManualSnapshotsCurrentCount *int64 `type:"integer" min:"0"`
 
 	// The maximum number of manual snapshots allowed.
-	ManualSnapshotsLimit *int64 `type:"integer"`
+	ManualSnapshotsLimit *int64 `type:"integer" min:"0"`
 
 	// Indicates if the manual snapshot limit has been reached.
 	ManualSnapshotsLimitReached *bool `type:"boolean"`
@@ -1323,10 +1932,20 @@ type metadataSnapshotLimits struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SnapshotLimits) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SnapshotLimits) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the UpdateRadius operation.
 type UpdateRadiusInput struct {
 	// The identifier of the directory to update the RADIUS server information for.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{10}$" required:"true"`
 
 	// A RadiusSettings object that contains information about the RADIUS server.
 	RadiusSettings *RadiusSettings `type:"structure" required:"true"`
@@ -1338,6 +1957,16 @@ type metadataUpdateRadiusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateRadiusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateRadiusInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the UpdateRadius operation.
 type UpdateRadiusOutput struct {
 	metadataUpdateRadiusOutput `json:"-" xml:"-"`
@@ -1346,3 +1975,88 @@ type UpdateRadiusOutput struct {
 type metadataUpdateRadiusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateRadiusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateRadiusOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum DirectorySize
+	DirectorySizeSmall = "Small"
+	// @enum DirectorySize
+	DirectorySizeLarge = "Large"
+)
+
+const (
+	// @enum DirectoryStage
+	DirectoryStageRequested = "Requested"
+	// @enum DirectoryStage
+	DirectoryStageCreating = "Creating"
+	// @enum DirectoryStage
+	DirectoryStageCreated = "Created"
+	// @enum DirectoryStage
+	DirectoryStageActive = "Active"
+	// @enum DirectoryStage
+	DirectoryStageInoperable = "Inoperable"
+	// @enum DirectoryStage
+	DirectoryStageImpaired = "Impaired"
+	// @enum DirectoryStage
+	DirectoryStageRestoring = "Restoring"
+	// @enum DirectoryStage
+	DirectoryStageRestoreFailed = "RestoreFailed"
+	// @enum DirectoryStage
+	DirectoryStageDeleting = "Deleting"
+	// @enum DirectoryStage
+	DirectoryStageDeleted = "Deleted"
+	// @enum DirectoryStage
+	DirectoryStageFailed = "Failed"
+)
+
+const (
+	// @enum DirectoryType
+	DirectoryTypeSimpleAD = "SimpleAD"
+	// @enum DirectoryType
+	DirectoryTypeADConnector = "ADConnector"
+)
+
+const (
+	// @enum RadiusAuthenticationProtocol
+	RadiusAuthenticationProtocolPap = "PAP"
+	// @enum RadiusAuthenticationProtocol
+	RadiusAuthenticationProtocolChap = "CHAP"
+	// @enum RadiusAuthenticationProtocol
+	RadiusAuthenticationProtocolMsCHAPv1 = "MS-CHAPv1"
+	// @enum RadiusAuthenticationProtocol
+	RadiusAuthenticationProtocolMsCHAPv2 = "MS-CHAPv2"
+)
+
+const (
+	// @enum RadiusStatus
+	RadiusStatusCreating = "Creating"
+	// @enum RadiusStatus
+	RadiusStatusCompleted = "Completed"
+	// @enum RadiusStatus
+	RadiusStatusFailed = "Failed"
+)
+
+const (
+	// @enum SnapshotStatus
+	SnapshotStatusCreating = "Creating"
+	// @enum SnapshotStatus
+	SnapshotStatusCompleted = "Completed"
+	// @enum SnapshotStatus
+	SnapshotStatusFailed = "Failed"
+)
+
+const (
+	// @enum SnapshotType
+	SnapshotTypeAuto = "Auto"
+	// @enum SnapshotType
+	SnapshotTypeManual = "Manual"
+)
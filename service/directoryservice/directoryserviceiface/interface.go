@@ -4,6 +4,7 @@
 package directoryserviceiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 )
 
@@ -11,35 +12,69 @@ import (
 type DirectoryServiceAPI interface {
 	ConnectDirectory(*directoryservice.ConnectDirectoryInput) (*directoryservice.ConnectDirectoryOutput, error)
 
+	ConnectDirectoryWithContext(aws.Context, *directoryservice.ConnectDirectoryInput) (*directoryservice.ConnectDirectoryOutput, error)
+
 	CreateAlias(*directoryservice.CreateAliasInput) (*directoryservice.CreateAliasOutput, error)
 
+	CreateAliasWithContext(aws.Context, *directoryservice.CreateAliasInput) (*directoryservice.CreateAliasOutput, error)
+
 	CreateComputer(*directoryservice.CreateComputerInput) (*directoryservice.CreateComputerOutput, error)
 
+	CreateComputerWithContext(aws.Context, *directoryservice.CreateComputerInput) (*directoryservice.CreateComputerOutput, error)
+
 	CreateDirectory(*directoryservice.CreateDirectoryInput) (*directoryservice.CreateDirectoryOutput, error)
 
+	CreateDirectoryWithContext(aws.Context, *directoryservice.CreateDirectoryInput) (*directoryservice.CreateDirectoryOutput, error)
+
 	CreateSnapshot(*directoryservice.CreateSnapshotInput) (*directoryservice.CreateSnapshotOutput, error)
 
+	CreateSnapshotWithContext(aws.Context, *directoryservice.CreateSnapshotInput) (*directoryservice.CreateSnapshotOutput, error)
+
 	DeleteDirectory(*directoryservice.DeleteDirectoryInput) (*directoryservice.DeleteDirectoryOutput, error)
 
+	DeleteDirectoryWithContext(aws.Context, *directoryservice.DeleteDirectoryInput) (*directoryservice.DeleteDirectoryOutput, error)
+
 	DeleteSnapshot(*directoryservice.DeleteSnapshotInput) (*directoryservice.DeleteSnapshotOutput, error)
 
+	DeleteSnapshotWithContext(aws.Context, *directoryservice.DeleteSnapshotInput) (*directoryservice.DeleteSnapshotOutput, error)
+
 	DescribeDirectories(*directoryservice.DescribeDirectoriesInput) (*directoryservice.DescribeDirectoriesOutput, error)
 
+	DescribeDirectoriesWithContext(aws.Context, *directoryservice.DescribeDirectoriesInput) (*directoryservice.DescribeDirectoriesOutput, error)
+
 	DescribeSnapshots(*directoryservice.DescribeSnapshotsInput) (*directoryservice.DescribeSnapshotsOutput, error)
 
+	DescribeSnapshotsWithContext(aws.Context, *directoryservice.DescribeSnapshotsInput) (*directoryservice.DescribeSnapshotsOutput, error)
+
 	DisableRadius(*directoryservice.DisableRadiusInput) (*directoryservice.DisableRadiusOutput, error)
 
+	DisableRadiusWithContext(aws.Context, *directoryservice.DisableRadiusInput) (*directoryservice.DisableRadiusOutput, error)
+
 	DisableSSO(*directoryservice.DisableSSOInput) (*directoryservice.DisableSSOOutput, error)
 
+	DisableSSOWithContext(aws.Context, *directoryservice.DisableSSOInput) (*directoryservice.DisableSSOOutput, error)
+
 	EnableRadius(*directoryservice.EnableRadiusInput) (*directoryservice.EnableRadiusOutput, error)
 
+	EnableRadiusWithContext(aws.Context, *directoryservice.EnableRadiusInput) (*directoryservice.EnableRadiusOutput, error)
+
 	EnableSSO(*directoryservice.EnableSSOInput) (*directoryservice.EnableSSOOutput, error)
 
+	EnableSSOWithContext(aws.Context, *directoryservice.EnableSSOInput) (*directoryservice.EnableSSOOutput, error)
+
 	GetDirectoryLimits(*directoryservice.GetDirectoryLimitsInput) (*directoryservice.GetDirectoryLimitsOutput, error)
 
+	GetDirectoryLimitsWithContext(aws.Context, *directoryservice.GetDirectoryLimitsInput) (*directoryservice.GetDirectoryLimitsOutput, error)
+
 	GetSnapshotLimits(*directoryservice.GetSnapshotLimitsInput) (*directoryservice.GetSnapshotLimitsOutput, error)
 
+	GetSnapshotLimitsWithContext(aws.Context, *directoryservice.GetSnapshotLimitsInput) (*directoryservice.GetSnapshotLimitsOutput, error)
+
 	RestoreFromSnapshot(*directoryservice.RestoreFromSnapshotInput) (*directoryservice.RestoreFromSnapshotOutput, error)
 
+	RestoreFromSnapshotWithContext(aws.Context, *directoryservice.RestoreFromSnapshotInput) (*directoryservice.RestoreFromSnapshotOutput, error)
+
 	UpdateRadius(*directoryservice.UpdateRadiusInput) (*directoryservice.UpdateRadiusOutput, error)
+
+	UpdateRadiusWithContext(aws.Context, *directoryservice.UpdateRadiusInput) (*directoryservice.UpdateRadiusOutput, error)
 }
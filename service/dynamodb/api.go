@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opBatchGetItem = "BatchGetItem"
@@ -87,6 +88,16 @@ func (c *DynamoDB) BatchGetItem(input *BatchGetItemInput) (*BatchGetItemOutput,
 	return out, err
 }
 
+// BatchGetItemWithContext is the same as BatchGetItem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) BatchGetItemWithContext(ctx aws.Context, input *BatchGetItemInput) (*BatchGetItemOutput, error) {
+	req, out := c.BatchGetItemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *DynamoDB) BatchGetItemPages(input *BatchGetItemInput, fn func(p *BatchGetItemOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.BatchGetItemRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -190,6 +201,16 @@ func (c *DynamoDB) BatchWriteItem(input *BatchWriteItemInput) (*BatchWriteItemOu
 	return out, err
 }
 
+// BatchWriteItemWithContext is the same as BatchWriteItem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) BatchWriteItemWithContext(ctx aws.Context, input *BatchWriteItemInput) (*BatchWriteItemOutput, error) {
+	req, out := c.BatchWriteItemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTable = "CreateTable"
 
 // CreateTableRequest generates a request for the CreateTable operation.
@@ -231,6 +252,16 @@ func (c *DynamoDB) CreateTable(input *CreateTableInput) (*CreateTableOutput, err
 	return out, err
 }
 
+// CreateTableWithContext is the same as CreateTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) CreateTableWithContext(ctx aws.Context, input *CreateTableInput) (*CreateTableOutput, error) {
+	req, out := c.CreateTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteItem = "DeleteItem"
 
 // DeleteItemRequest generates a request for the DeleteItem operation.
@@ -271,6 +302,16 @@ func (c *DynamoDB) DeleteItem(input *DeleteItemInput) (*DeleteItemOutput, error)
 	return out, err
 }
 
+// DeleteItemWithContext is the same as DeleteItem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) DeleteItemWithContext(ctx aws.Context, input *DeleteItemInput) (*DeleteItemOutput, error) {
+	req, out := c.DeleteItemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTable = "DeleteTable"
 
 // DeleteTableRequest generates a request for the DeleteTable operation.
@@ -311,6 +352,16 @@ func (c *DynamoDB) DeleteTable(input *DeleteTableInput) (*DeleteTableOutput, err
 	return out, err
 }
 
+// DeleteTableWithContext is the same as DeleteTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) DeleteTableWithContext(ctx aws.Context, input *DeleteTableInput) (*DeleteTableOutput, error) {
+	req, out := c.DeleteTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTable = "DescribeTable"
 
 // DescribeTableRequest generates a request for the DescribeTable operation.
@@ -346,6 +397,16 @@ func (c *DynamoDB) DescribeTable(input *DescribeTableInput) (*DescribeTableOutpu
 	return out, err
 }
 
+// DescribeTableWithContext is the same as DescribeTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) DescribeTableWithContext(ctx aws.Context, input *DescribeTableInput) (*DescribeTableOutput, error) {
+	req, out := c.DescribeTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetItem = "GetItem"
 
 // GetItemRequest generates a request for the GetItem operation.
@@ -379,6 +440,16 @@ func (c *DynamoDB) GetItem(input *GetItemInput) (*GetItemOutput, error) {
 	return out, err
 }
 
+// GetItemWithContext is the same as GetItem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) GetItemWithContext(ctx aws.Context, input *GetItemInput) (*GetItemOutput, error) {
+	req, out := c.GetItemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListTables = "ListTables"
 
 // ListTablesRequest generates a request for the ListTables operation.
@@ -414,6 +485,16 @@ func (c *DynamoDB) ListTables(input *ListTablesInput) (*ListTablesOutput, error)
 	return out, err
 }
 
+// ListTablesWithContext is the same as ListTables with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) ListTablesWithContext(ctx aws.Context, input *ListTablesInput) (*ListTablesOutput, error) {
+	req, out := c.ListTablesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *DynamoDB) ListTablesPages(input *ListTablesInput, fn func(p *ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTablesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -472,6 +553,16 @@ func (c *DynamoDB) PutItem(input *PutItemInput) (*PutItemOutput, error) {
 	return out, err
 }
 
+// PutItemWithContext is the same as PutItem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) PutItemWithContext(ctx aws.Context, input *PutItemInput) (*PutItemOutput, error) {
+	req, out := c.PutItemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opQuery = "Query"
 
 // QueryRequest generates a request for the Query operation.
@@ -529,6 +620,16 @@ func (c *DynamoDB) Query(input *QueryInput) (*QueryOutput, error) {
 	return out, err
 }
 
+// QueryWithContext is the same as Query with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) QueryWithContext(ctx aws.Context, input *QueryInput) (*QueryOutput, error) {
+	req, out := c.QueryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *DynamoDB) QueryPages(input *QueryInput, fn func(p *QueryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.QueryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -585,6 +686,16 @@ func (c *DynamoDB) Scan(input *ScanInput) (*ScanOutput, error) {
 	return out, err
 }
 
+// ScanWithContext is the same as Scan with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) ScanWithContext(ctx aws.Context, input *ScanInput) (*ScanOutput, error) {
+	req, out := c.ScanRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *DynamoDB) ScanPages(input *ScanInput, fn func(p *ScanOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ScanRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -628,6 +739,16 @@ func (c *DynamoDB) UpdateItem(input *UpdateItemInput) (*UpdateItemOutput, error)
 	return out, err
 }
 
+// UpdateItemWithContext is the same as UpdateItem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) UpdateItemWithContext(ctx aws.Context, input *UpdateItemInput) (*UpdateItemOutput, error) {
+	req, out := c.UpdateItemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateTable = "UpdateTable"
 
 // UpdateTableRequest generates a request for the UpdateTable operation.
@@ -672,13 +793,25 @@ func (c *DynamoDB) UpdateTable(input *UpdateTableInput) (*UpdateTableOutput, err
 	return out, err
 }
 
+// UpdateTableWithContext is the same as UpdateTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *DynamoDB) UpdateTableWithContext(ctx aws.Context, input *UpdateTableInput) (*UpdateTableOutput, error) {
+	req, out := c.UpdateTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Represents an attribute for describing the key schema for the table and indexes.
 type AttributeDefinition struct {
 	// A name for the attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The data type for the attribute.
-	AttributeType *string `type:"string" required:"true"`
+	//
+	// See the ScalarAttributeType* constants for valid values.
+	AttributeType *string `type:"string" enum:"S,N,B" required:"true"`
 
 	metadataAttributeDefinition `json:"-" xml:"-"`
 }
@@ -687,6 +820,16 @@ type metadataAttributeDefinition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttributeDefinition) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttributeDefinition) GoString() string {
+	return s.String()
+}
+
 // Represents the data for an attribute. You can set one, and only one, of the
 // elements.
 //
@@ -732,6 +875,16 @@ type metadataAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttributeValue) GoString() string {
+	return s.String()
+}
+
 // For the UpdateItem operation, represents the attributes to be modified, the
 // action to perform on each, and the new value for each.
 //
@@ -805,7 +958,9 @@ type AttributeValueUpdate struct {
 	//   ADD - DynamoDB creates an item with the supplied primary key and number
 	// (or set of numbers) for the attribute value. The only data types allowed
 	// are number and number set; no other data types can be specified.
-	Action *string `type:"string"`
+	//
+	// See the AttributeAction* constants for valid values.
+	Action *string `type:"string" enum:"ADD,PUT,DELETE"`
 
 	// Represents the data for an attribute. You can set one, and only one, of the
 	// elements.
@@ -823,6 +978,16 @@ type metadataAttributeValueUpdate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttributeValueUpdate) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttributeValueUpdate) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a BatchGetItem operation.
 type BatchGetItemInput struct {
 	// A map of one or more table names and, for each table, a map that describes
@@ -905,13 +1070,15 @@ type BatchGetItemInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	RequestItems map[string]*KeysAndAttributes `type:"map" required:"true"`
+	RequestItems map[string]*KeysAndAttributes `type:"map" min:"1" max:"100" required:"true"`
 
 	// A value that if set to TOTAL, the response includes ConsumedCapacity data
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	metadataBatchGetItemInput `json:"-" xml:"-"`
 }
@@ -920,6 +1087,16 @@ type metadataBatchGetItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetItemInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a BatchGetItem operation.
 type BatchGetItemOutput struct {
 	// The read capacity units consumed by the operation.
@@ -956,7 +1133,7 @@ type BatchGetItemOutput struct {
 	//
 	//   If there are no unprocessed keys remaining, the response contains an empty
 	// UnprocessedKeys map.
-	UnprocessedKeys map[string]*KeysAndAttributes `type:"map"`
+	UnprocessedKeys map[string]*KeysAndAttributes `type:"map" min:"1" max:"100"`
 
 	metadataBatchGetItemOutput `json:"-" xml:"-"`
 }
@@ -965,6 +1142,16 @@ type metadataBatchGetItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchGetItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchGetItemOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a BatchWriteItem operation.
 type BatchWriteItemInput struct {
 	// A map of one or more table names and, for each table, a list of operations
@@ -993,18 +1180,22 @@ type BatchWriteItemInput struct {
 	// If you specify any attributes that are part of an index key, then the data
 	// types for those attributes must match those of the schema in the table's
 	// attribute definition.
-	RequestItems map[string][]*WriteRequest `type:"map" required:"true"`
+	RequestItems map[string][]*WriteRequest `type:"map" min:"1" max:"25" required:"true"`
 
 	// A value that if set to TOTAL, the response includes ConsumedCapacity data
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	//
+	// See the ReturnItemCollectionMetrics* constants for valid values.
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	metadataBatchWriteItemInput `json:"-" xml:"-"`
 }
@@ -1013,6 +1204,16 @@ type metadataBatchWriteItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchWriteItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchWriteItemInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a BatchWriteItem operation.
 type BatchWriteItemOutput struct {
 	// The capacity units consumed by the operation.
@@ -1074,7 +1275,7 @@ type BatchWriteItemOutput struct {
 	//
 	//     If there are no unprocessed items remaining, the response contains an
 	// empty UnprocessedItems map.
-	UnprocessedItems map[string][]*WriteRequest `type:"map"`
+	UnprocessedItems map[string][]*WriteRequest `type:"map" min:"1" max:"25"`
 
 	metadataBatchWriteItemOutput `json:"-" xml:"-"`
 }
@@ -1083,6 +1284,16 @@ type metadataBatchWriteItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchWriteItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchWriteItemOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the amount of provisioned throughput capacity consumed on a table
 // or an index.
 type Capacity struct {
@@ -1096,6 +1307,16 @@ type metadataCapacity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Capacity) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Capacity) GoString() string {
+	return s.String()
+}
+
 // Represents the selection criteria for a Query or Scan operation:
 //
 //   For a Query operation, Condition is used for specifying the KeyConditions
@@ -1255,7 +1476,9 @@ type Condition struct {
 	//   For usage examples of AttributeValueList and ComparisonOperator, see Legacy
 	// Conditional Parameters (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/LegacyConditionalParameters.html)
 	// in the Amazon DynamoDB Developer Guide.
-	ComparisonOperator *string `type:"string" required:"true"`
+	//
+	// See the ComparisonOperator* constants for valid values.
+	ComparisonOperator *string `type:"string" enum:"EQ,NE,IN,LE,LT,GE,GT,BETWEEN,NOT_NULL,NULL,CONTAINS,NOT_CONTAINS,BEGINS_WITH" required:"true"`
 
 	metadataCondition `json:"-" xml:"-"`
 }
@@ -1264,6 +1487,16 @@ type metadataCondition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Condition) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Condition) GoString() string {
+	return s.String()
+}
+
 // The capacity units consumed by an operation. The data returned includes the
 // total provisioned throughput consumed, along with statistics for the table
 // and any indexes involved in the operation. ConsumedCapacity is only returned
@@ -1284,7 +1517,7 @@ type ConsumedCapacity struct {
 	Table *Capacity `type:"structure"`
 
 	// The name of the table that was affected by the operation.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataConsumedCapacity `json:"-" xml:"-"`
 }
@@ -1293,13 +1526,23 @@ type metadataConsumedCapacity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConsumedCapacity) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConsumedCapacity) GoString() string {
+	return s.String()
+}
+
 // Represents a new global secondary index to be added to an existing table.
 type CreateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be created.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The key schema for the global secondary index.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -1321,6 +1564,16 @@ type metadataCreateGlobalSecondaryIndexAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateGlobalSecondaryIndexAction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateGlobalSecondaryIndexAction) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CreateTable operation.
 type CreateTableInput struct {
 	// An array of attributes that describe the key schema for the table and indexes.
@@ -1378,7 +1631,7 @@ type CreateTableInput struct {
 	//
 	// For more information, see Specifying the Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#WorkingWithTables.primary.key)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// One or more local secondary indexes (the maximum is five) to be created on
 	// the table. Each index is scoped to a given hash key value. There is a 10
@@ -1423,7 +1676,7 @@ type CreateTableInput struct {
 	ProvisionedThroughput *ProvisionedThroughput `type:"structure" required:"true"`
 
 	// The name of the table to create.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateTableInput `json:"-" xml:"-"`
 }
@@ -1432,6 +1685,16 @@ type metadataCreateTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTableInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateTable operation.
 type CreateTableOutput struct {
 	// Represents the properties of a table.
@@ -1444,10 +1707,20 @@ type metadataCreateTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTableOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a global secondary index to be deleted from an existing table.
 type DeleteGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be deleted.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteGlobalSecondaryIndexAction `json:"-" xml:"-"`
 }
@@ -1456,6 +1729,16 @@ type metadataDeleteGlobalSecondaryIndexAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteGlobalSecondaryIndexAction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteGlobalSecondaryIndexAction) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteItem operation.
 type DeleteItemInput struct {
 	// A condition that must be satisfied in order for a conditional DeleteItem
@@ -1498,7 +1781,9 @@ type DeleteItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	//
+	// See the ConditionalOperator* constants for valid values.
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use ConditionExpression instead. Do not combine legacy parameters
@@ -1766,12 +2051,16 @@ type DeleteItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	//
+	// See the ReturnItemCollectionMetrics* constants for valid values.
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// before they were deleted. For DeleteItem, the valid values are:
@@ -1780,10 +2069,12 @@ type DeleteItemInput struct {
 	// nothing is returned. (This setting is the default for ReturnValues.)
 	//
 	//   ALL_OLD - The content of the old item is returned.
-	ReturnValues *string `type:"string"`
+	//
+	// See the ReturnValue* constants for valid values.
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table from which to delete the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteItemInput `json:"-" xml:"-"`
 }
@@ -1792,6 +2083,16 @@ type metadataDeleteItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteItemInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DeleteItem operation.
 type DeleteItemOutput struct {
 	// A map of attribute names to AttributeValue objects, representing the item
@@ -1835,6 +2136,16 @@ type metadataDeleteItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteItemOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request to perform a DeleteItem operation on an item.
 type DeleteRequest struct {
 	// A map of attribute name to attribute values, representing the primary key
@@ -1849,10 +2160,20 @@ type metadataDeleteRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRequest) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteTable operation.
 type DeleteTableInput struct {
 	// The name of the table to delete.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteTableInput `json:"-" xml:"-"`
 }
@@ -1861,6 +2182,16 @@ type metadataDeleteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTableInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DeleteTable operation.
 type DeleteTableOutput struct {
 	// Represents the properties of a table.
@@ -1873,10 +2204,20 @@ type metadataDeleteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTableOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeTable operation.
 type DescribeTableInput struct {
 	// The name of the table to describe.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDescribeTableInput `json:"-" xml:"-"`
 }
@@ -1885,6 +2226,16 @@ type metadataDescribeTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTableInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeTable operation.
 type DescribeTableOutput struct {
 	// Represents the properties of a table.
@@ -1897,6 +2248,16 @@ type metadataDescribeTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTableOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a condition to be compared with an attribute value. This condition
 // can be used with DeleteItem, PutItem or UpdateItem operations; if the comparison
 // evaluates to true, the operation succeeds; if not, the operation fails. You
@@ -2062,7 +2423,9 @@ type ExpectedAttributeValue struct {
 	// element of a different type than the one provided in the request, the value
 	// does not match. For example, {"S":"6"} does not compare to {"N":"6"}. Also,
 	// {"N":"6"} does not compare to {"NS":["6", "2", "1"]}
-	ComparisonOperator *string `type:"string"`
+	//
+	// See the ComparisonOperator* constants for valid values.
+	ComparisonOperator *string `type:"string" enum:"EQ,NE,IN,LE,LT,GE,GT,BETWEEN,NOT_NULL,NULL,CONTAINS,NOT_CONTAINS,BEGINS_WITH"`
 
 	// Causes DynamoDB to evaluate the value before attempting a conditional operation:
 	//
@@ -2104,6 +2467,16 @@ type metadataExpectedAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExpectedAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExpectedAttributeValue) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a GetItem operation.
 type GetItemInput struct {
 	// This is a legacy parameter, for backward compatibility. New applications
@@ -2121,7 +2494,7 @@ type GetItemInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// A value that if set to true, then the operation uses strongly consistent
 	// reads; otherwise, eventually consistent reads are used.
@@ -2190,10 +2563,12 @@ type GetItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// The name of the table containing the requested item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetItemInput `json:"-" xml:"-"`
 }
@@ -2202,6 +2577,16 @@ type metadataGetItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetItemInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a GetItem operation.
 type GetItemOutput struct {
 	// The capacity units consumed by an operation. The data returned includes the
@@ -2222,15 +2607,25 @@ type metadataGetItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetItemOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the properties of a global secondary index.
 type GlobalSecondaryIndex struct {
 	// The name of the global secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The complete key schema for a global secondary index, which consists of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2252,6 +2647,16 @@ type metadataGlobalSecondaryIndex struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GlobalSecondaryIndex) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GlobalSecondaryIndex) GoString() string {
+	return s.String()
+}
+
 // Represents the properties of a global secondary index.
 type GlobalSecondaryIndexDescription struct {
 	// Indicates whether the index is currently backfilling. Backfilling is the
@@ -2266,7 +2671,7 @@ type GlobalSecondaryIndexDescription struct {
 	Backfilling *bool `type:"boolean"`
 
 	// The name of the global secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2282,7 +2687,9 @@ type GlobalSecondaryIndexDescription struct {
 	//   DELETING - The index is being deleted.
 	//
 	//   ACTIVE - The index is ready for use.
-	IndexStatus *string `type:"string"`
+	//
+	// See the IndexStatus* constants for valid values.
+	IndexStatus *string `type:"string" enum:"CREATING,UPDATING,DELETING,ACTIVE"`
 
 	// The number of items in the specified index. DynamoDB updates this value approximately
 	// every six hours. Recent changes might not be reflected in this value.
@@ -2290,7 +2697,7 @@ type GlobalSecondaryIndexDescription struct {
 
 	// The complete key schema for the global secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2308,6 +2715,16 @@ type metadataGlobalSecondaryIndexDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GlobalSecondaryIndexDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GlobalSecondaryIndexDescription) GoString() string {
+	return s.String()
+}
+
 // Represents one of the following:
 //
 //  A new global secondary index to be added to an existing table.
@@ -2344,6 +2761,16 @@ type metadataGlobalSecondaryIndexUpdate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GlobalSecondaryIndexUpdate) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GlobalSecondaryIndexUpdate) GoString() string {
+	return s.String()
+}
+
 // Information about item collections, if any, that were affected by the operation.
 // ItemCollectionMetrics is only returned if the request asked for it. If the
 // table does not have any local secondary indexes, this information is not
@@ -2371,6 +2798,16 @@ type metadataItemCollectionMetrics struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ItemCollectionMetrics) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ItemCollectionMetrics) GoString() string {
+	return s.String()
+}
+
 // Represents a single element of a key schema. A key schema specifies the attributes
 // that make up the primary key of a table, or the key attributes of an index.
 //
@@ -2380,10 +2817,12 @@ type metadataItemCollectionMetrics struct {
 // the hash attribute, and another KeySchemaElement for the range attribute.
 type KeySchemaElement struct {
 	// The name of a key attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The attribute data, consisting of the data type and the attribute value itself.
-	KeyType *string `type:"string" required:"true"`
+	//
+	// See the KeyType* constants for valid values.
+	KeyType *string `type:"string" enum:"HASH,RANGE" required:"true"`
 
 	metadataKeySchemaElement `json:"-" xml:"-"`
 }
@@ -2392,6 +2831,16 @@ type metadataKeySchemaElement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeySchemaElement) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeySchemaElement) GoString() string {
+	return s.String()
+}
+
 // Represents a set of primary keys and, for each key, the attributes to retrieve
 // from the table.
 //
@@ -2403,7 +2852,7 @@ type KeysAndAttributes struct {
 	// One or more attributes to retrieve from the table or index. If no attribute
 	// names are specified then all attributes will be returned. If any of the specified
 	// attributes are not found, they will not appear in the result.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// The consistency of a read operation. If set to true, then a strongly consistent
 	// read is used; otherwise, an eventually consistent read is used.
@@ -2447,7 +2896,7 @@ type KeysAndAttributes struct {
 
 	// The primary key attribute values that define the items and the attributes
 	// associated with the items.
-	Keys []map[string]*AttributeValue `type:"list" required:"true"`
+	Keys []map[string]*AttributeValue `type:"list" min:"1" max:"100" required:"true"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -2470,16 +2919,26 @@ type metadataKeysAndAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeysAndAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeysAndAttributes) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a ListTables operation.
 type ListTablesInput struct {
 	// The first table name that this operation will evaluate. Use the value that
 	// was returned for LastEvaluatedTableName in a previous operation, so that
 	// you can obtain the next page of results.
-	ExclusiveStartTableName *string `type:"string"`
+	ExclusiveStartTableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A maximum number of table names to return. If this parameter is not specified,
 	// the limit is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	metadataListTablesInput `json:"-" xml:"-"`
 }
@@ -2488,6 +2947,16 @@ type metadataListTablesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTablesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTablesInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a ListTables operation.
 type ListTablesOutput struct {
 	// The name of the last table in the current page of results. Use this value
@@ -2496,7 +2965,7 @@ type ListTablesOutput struct {
 	//
 	// If you do not receive a LastEvaluatedTableName value in the response, this
 	// means that there are no more table names to be retrieved.
-	LastEvaluatedTableName *string `type:"string"`
+	LastEvaluatedTableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The names of the tables associated with the current account at the current
 	// endpoint. The maximum size of this array is 100.
@@ -2513,15 +2982,25 @@ type metadataListTablesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTablesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTablesOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the properties of a local secondary index.
 type LocalSecondaryIndex struct {
 	// The name of the local secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The complete key schema for the local secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2535,10 +3014,20 @@ type metadataLocalSecondaryIndex struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LocalSecondaryIndex) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LocalSecondaryIndex) GoString() string {
+	return s.String()
+}
+
 // Represents the properties of a local secondary index.
 type LocalSecondaryIndexDescription struct {
 	// Represents the name of the local secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2551,7 +3040,7 @@ type LocalSecondaryIndexDescription struct {
 
 	// The complete index key schema, which consists of one or more pairs of attribute
 	// names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2565,6 +3054,16 @@ type metadataLocalSecondaryIndexDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LocalSecondaryIndexDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LocalSecondaryIndexDescription) GoString() string {
+	return s.String()
+}
+
 // Represents attributes that are copied (projected) from the table into an
 // index. These are in addition to the primary key attributes and index key
 // attributes, which are automatically projected.
@@ -2575,7 +3074,7 @@ type Projection struct {
 	// across all of the local secondary indexes, must not exceed 20. If you project
 	// the same attribute into two different indexes, this counts as two distinct
 	// attributes when determining the total.
-	NonKeyAttributes []*string `type:"list"`
+	NonKeyAttributes []*string `type:"list" min:"1" max:"20"`
 
 	// The set of attributes that are projected into the index:
 	//
@@ -2585,7 +3084,9 @@ type Projection struct {
 	// The list of projected attributes are in NonKeyAttributes.
 	//
 	//   ALL - All of the table attributes are projected into the index.
-	ProjectionType *string `type:"string"`
+	//
+	// See the ProjectionType* constants for valid values.
+	ProjectionType *string `type:"string" enum:"ALL,KEYS_ONLY,INCLUDE"`
 
 	metadataProjection `json:"-" xml:"-"`
 }
@@ -2594,6 +3095,16 @@ type metadataProjection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Projection) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Projection) GoString() string {
+	return s.String()
+}
+
 // Represents the provisioned throughput settings for a specified table or index.
 // The settings can be modified using the UpdateTable operation.
 //
@@ -2605,13 +3116,13 @@ type ProvisionedThroughput struct {
 	// DynamoDB returns a ThrottlingException. For more information, see Specifying
 	// Read and Write Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	ReadCapacityUnits *int64 `type:"long" required:"true"`
+	ReadCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException. For more information, see Specifying Read and Write
 	// Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	WriteCapacityUnits *int64 `type:"long" required:"true"`
+	WriteCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	metadataProvisionedThroughput `json:"-" xml:"-"`
 }
@@ -2620,6 +3131,16 @@ type metadataProvisionedThroughput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ProvisionedThroughput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ProvisionedThroughput) GoString() string {
+	return s.String()
+}
+
 // Represents the provisioned throughput settings for the table, consisting
 // of read and write capacity units, along with data about increases and decreases.
 type ProvisionedThroughputDescription struct {
@@ -2633,17 +3154,17 @@ type ProvisionedThroughputDescription struct {
 	// UTC calendar day. For current maximums on provisioned throughput decreases,
 	// see Limits (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
-	NumberOfDecreasesToday *int64 `type:"long"`
+	NumberOfDecreasesToday *int64 `type:"long" min:"1"`
 
 	// The maximum number of strongly consistent reads consumed per second before
 	// DynamoDB returns a ThrottlingException. Eventually consistent reads require
 	// less effort than strongly consistent reads, so a setting of 50 ReadCapacityUnits
 	// per second provides 100 eventually consistent ReadCapacityUnits per second.
-	ReadCapacityUnits *int64 `type:"long"`
+	ReadCapacityUnits *int64 `type:"long" min:"1"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException.
-	WriteCapacityUnits *int64 `type:"long"`
+	WriteCapacityUnits *int64 `type:"long" min:"1"`
 
 	metadataProvisionedThroughputDescription `json:"-" xml:"-"`
 }
@@ -2652,6 +3173,16 @@ type metadataProvisionedThroughputDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ProvisionedThroughputDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ProvisionedThroughputDescription) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a PutItem operation.
 type PutItemInput struct {
 	// A condition that must be satisfied in order for a conditional PutItem operation
@@ -2694,7 +3225,9 @@ type PutItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	//
+	// See the ConditionalOperator* constants for valid values.
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use ConditionExpression instead. Do not combine legacy parameters
@@ -2972,12 +3505,16 @@ type PutItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	//
+	// See the ReturnItemCollectionMetrics* constants for valid values.
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// before they were updated with the PutItem request. For PutItem, the valid
@@ -2988,10 +3525,12 @@ type PutItemInput struct {
 	//
 	//   ALL_OLD - If PutItem overwrote an attribute name-value pair, then the
 	// content of the old item is returned.
-	ReturnValues *string `type:"string"`
+	//
+	// See the ReturnValue* constants for valid values.
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table to contain the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutItemInput `json:"-" xml:"-"`
 }
@@ -3000,6 +3539,16 @@ type metadataPutItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutItemInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a PutItem operation.
 type PutItemOutput struct {
 	// The attribute values as they appeared before the PutItem operation, but only
@@ -3043,6 +3592,16 @@ type metadataPutItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutItemOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request to perform a PutItem operation on an item.
 type PutRequest struct {
 	// A map of attribute name to attribute values, representing the primary key
@@ -3059,6 +3618,16 @@ type metadataPutRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRequest) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a Query operation.
 type QueryInput struct {
 	// This is a legacy parameter, for backward compatibility. New applications
@@ -3091,7 +3660,7 @@ type QueryInput struct {
 	// If you query a global secondary index, you can only request attributes that
 	// are projected into the index. Global secondary index queries cannot fetch
 	// attributes from the parent table.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use FilterExpression instead. Do not combine legacy parameters and
@@ -3111,7 +3680,9 @@ type QueryInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	//
+	// See the ConditionalOperator* constants for valid values.
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// A value that if set to true, then the operation uses strongly consistent
 	// reads; otherwise, eventually consistent reads are used.
@@ -3203,7 +3774,7 @@ type QueryInput struct {
 	// The name of an index to query. This index can be any local secondary index
 	// or global secondary index on the table. Note that if you use the IndexName
 	// parameter, you must also provide TableName.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The condition that specifies the key value(s) for items to be retrieved by
 	// the Query action.
@@ -3384,7 +3955,7 @@ type QueryInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -3459,7 +4030,9 @@ type QueryInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that specifies ascending (true) or descending (false) traversal of
 	// the index. DynamoDB returns results reflecting the requested order determined
@@ -3515,10 +4088,12 @@ type QueryInput struct {
 	// If you use the ProjectionExpression parameter, then the value for Select
 	// can only be SPECIFIC_ATTRIBUTES. Any other value for Select will return an
 	// error.
-	Select *string `type:"string"`
+	//
+	// See the Select* constants for valid values.
+	Select *string `type:"string" enum:"ALL_ATTRIBUTES,ALL_PROJECTED_ATTRIBUTES,SPECIFIC_ATTRIBUTES,COUNT"`
 
 	// The name of the table containing the requested items.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataQueryInput `json:"-" xml:"-"`
 }
@@ -3527,6 +4102,16 @@ type metadataQueryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s QueryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s QueryInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a Query operation.
 type QueryOutput struct {
 	// The capacity units consumed by an operation. The data returned includes the
@@ -3579,6 +4164,16 @@ type metadataQueryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s QueryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s QueryOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a Scan operation.
 type ScanInput struct {
 	// This is a legacy parameter, for backward compatibility. New applications
@@ -3596,7 +4191,7 @@ type ScanInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use FilterExpression instead. Do not combine legacy parameters and
@@ -3616,7 +4211,9 @@ type ScanInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	//
+	// See the ConditionalOperator* constants for valid values.
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// The primary key of the first item that this operation will evaluate. Use
 	// the value that was returned for LastEvaluatedKey in the previous operation.
@@ -3704,7 +4301,7 @@ type ScanInput struct {
 	// The name of a secondary index to scan. This index can be any local secondary
 	// index or global secondary index. Note that if you use the IndexName parameter,
 	// you must also provide TableName.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of items to evaluate (not necessarily the number of matching
 	// items). If DynamoDB processes the number of items up to the limit while processing
@@ -3715,7 +4312,7 @@ type ScanInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the specified
 	// table or index. These attributes can include scalars, sets, or elements of
@@ -3735,7 +4332,9 @@ type ScanInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use FilterExpression instead. Do not combine legacy parameters and
@@ -3800,7 +4399,7 @@ type ScanInput struct {
 	// the value provided for TotalSegments.
 	//
 	// If you provide Segment, you must also provide TotalSegments.
-	Segment *int64 `type:"integer"`
+	Segment *int64 `type:"integer" min:"0" max:"999999"`
 
 	// The attributes to be returned in the result. You can retrieve all item attributes,
 	// specific item attributes, or the count of matching items.
@@ -3819,11 +4418,13 @@ type ScanInput struct {
 	// in a single request, unless the value for Select is SPECIFIC_ATTRIBUTES.
 	// (This usage is equivalent to specifying AttributesToGet without any value
 	// for Select.)
-	Select *string `type:"string"`
+	//
+	// See the Select* constants for valid values.
+	Select *string `type:"string" enum:"ALL_ATTRIBUTES,ALL_PROJECTED_ATTRIBUTES,SPECIFIC_ATTRIBUTES,COUNT"`
 
 	// The name of the table containing the requested items; or, if you provide
 	// IndexName, the name of the table to which that index belongs.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// For a parallel Scan request, TotalSegments represents the total number of
 	// segments into which the Scan operation will be divided. The value of TotalSegments
@@ -3836,7 +4437,7 @@ type ScanInput struct {
 	// Scan operation will be sequential rather than parallel.
 	//
 	// If you specify TotalSegments, you must also specify Segment.
-	TotalSegments *int64 `type:"integer"`
+	TotalSegments *int64 `type:"integer" min:"1" max:"1000000"`
 
 	metadataScanInput `json:"-" xml:"-"`
 }
@@ -3845,6 +4446,16 @@ type metadataScanInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScanInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScanInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a Scan operation.
 type ScanOutput struct {
 	// The capacity units consumed by an operation. The data returned includes the
@@ -3896,6 +4507,16 @@ type metadataScanOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScanOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScanOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the properties of a table.
 type TableDescription struct {
 	// An array of AttributeDefinition objects. Each of these objects describes
@@ -3985,7 +4606,7 @@ type TableDescription struct {
 	//
 	//   For more information about primary keys, see Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/DataModel.html#DataModelPrimaryKey)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents one or more local secondary indexes on the table. Each index is
 	// scoped to a given hash key value. Tables with one or more local secondary
@@ -4036,7 +4657,7 @@ type TableDescription struct {
 	ProvisionedThroughput *ProvisionedThroughputDescription `type:"structure"`
 
 	// The name of the table.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified table, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -4052,7 +4673,9 @@ type TableDescription struct {
 	//   DELETING - The table is being deleted.
 	//
 	//   ACTIVE - The table is ready for use.
-	TableStatus *string `type:"string"`
+	//
+	// See the TableStatus* constants for valid values.
+	TableStatus *string `type:"string" enum:"CREATING,UPDATING,DELETING,ACTIVE"`
 
 	metadataTableDescription `json:"-" xml:"-"`
 }
@@ -4061,11 +4684,21 @@ type metadataTableDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TableDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TableDescription) GoString() string {
+	return s.String()
+}
+
 // Represents the new provisioned throughput settings to be applied to a global
 // secondary index.
 type UpdateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be updated.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Represents the provisioned throughput settings for a specified table or index.
 	// The settings can be modified using the UpdateTable operation.
@@ -4082,6 +4715,16 @@ type metadataUpdateGlobalSecondaryIndexAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateGlobalSecondaryIndexAction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateGlobalSecondaryIndexAction) GoString() string {
+	return s.String()
+}
+
 // Represents the input of an UpdateItem operation.
 type UpdateItemInput struct {
 	// This is a legacy parameter, for backward compatibility. New applications
@@ -4213,7 +4856,9 @@ type UpdateItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	//
+	// See the ConditionalOperator* constants for valid values.
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// This is a legacy parameter, for backward compatibility. New applications
 	// should use  ConditionExpression  instead. Do not combine legacy parameters
@@ -4481,12 +5126,16 @@ type UpdateItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	//
+	// See the ReturnConsumedCapacity* constants for valid values.
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	//
+	// See the ReturnItemCollectionMetrics* constants for valid values.
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// either before or after they were updated. For UpdateItem, the valid values
@@ -4503,10 +5152,12 @@ type UpdateItemInput struct {
 	//   ALL_NEW - All of the attributes of the new version of the item are returned.
 	//
 	//   UPDATED_NEW - The new versions of only the updated attributes are returned.
-	ReturnValues *string `type:"string"`
+	//
+	// See the ReturnValue* constants for valid values.
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table containing the item to update.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// An expression that defines one or more attributes to be updated, the action
 	// to be performed on them, and new value(s) for them.
@@ -4590,6 +5241,16 @@ type metadataUpdateItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateItemInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an UpdateItem operation.
 type UpdateItemOutput struct {
 	// A map of attribute values as they appeared before the UpdateItem operation.
@@ -4618,6 +5279,16 @@ type metadataUpdateItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateItemOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of an UpdateTable operation.
 type UpdateTableInput struct {
 	// An array of attributes that describe the key schema for the table and indexes.
@@ -4645,7 +5316,7 @@ type UpdateTableInput struct {
 	ProvisionedThroughput *ProvisionedThroughput `type:"structure"`
 
 	// The name of the table to be updated.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataUpdateTableInput `json:"-" xml:"-"`
 }
@@ -4654,6 +5325,16 @@ type metadataUpdateTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateTableInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an UpdateTable operation.
 type UpdateTableOutput struct {
 	// Represents the properties of a table.
@@ -4666,6 +5347,16 @@ type metadataUpdateTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateTableOutput) GoString() string {
+	return s.String()
+}
+
 // Represents an operation to perform - either DeleteItem or PutItem. You can
 // only request one of these operations, not both, in a single WriteRequest.
 // If you do need to perform both of these operations, you will need to provide
@@ -4683,3 +5374,145 @@ type WriteRequest struct {
 type metadataWriteRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s WriteRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WriteRequest) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum AttributeAction
+	AttributeActionAdd = "ADD"
+	// @enum AttributeAction
+	AttributeActionPut = "PUT"
+	// @enum AttributeAction
+	AttributeActionDelete = "DELETE"
+)
+
+const (
+	// @enum ComparisonOperator
+	ComparisonOperatorEq = "EQ"
+	// @enum ComparisonOperator
+	ComparisonOperatorNe = "NE"
+	// @enum ComparisonOperator
+	ComparisonOperatorIn = "IN"
+	// @enum ComparisonOperator
+	ComparisonOperatorLe = "LE"
+	// @enum ComparisonOperator
+	ComparisonOperatorLt = "LT"
+	// @enum ComparisonOperator
+	ComparisonOperatorGe = "GE"
+	// @enum ComparisonOperator
+	ComparisonOperatorGt = "GT"
+	// @enum ComparisonOperator
+	ComparisonOperatorBetween = "BETWEEN"
+	// @enum ComparisonOperator
+	ComparisonOperatorNotNull = "NOT_NULL"
+	// @enum ComparisonOperator
+	ComparisonOperatorNull = "NULL"
+	// @enum ComparisonOperator
+	ComparisonOperatorContains = "CONTAINS"
+	// @enum ComparisonOperator
+	ComparisonOperatorNotContains = "NOT_CONTAINS"
+	// @enum ComparisonOperator
+	ComparisonOperatorBeginsWith = "BEGINS_WITH"
+)
+
+const (
+	// @enum ConditionalOperator
+	ConditionalOperatorAnd = "AND"
+	// @enum ConditionalOperator
+	ConditionalOperatorOr = "OR"
+)
+
+const (
+	// @enum IndexStatus
+	IndexStatusCreating = "CREATING"
+	// @enum IndexStatus
+	IndexStatusUpdating = "UPDATING"
+	// @enum IndexStatus
+	IndexStatusDeleting = "DELETING"
+	// @enum IndexStatus
+	IndexStatusActive = "ACTIVE"
+)
+
+const (
+	// @enum KeyType
+	KeyTypeHash = "HASH"
+	// @enum KeyType
+	KeyTypeRange = "RANGE"
+)
+
+const (
+	// @enum ProjectionType
+	ProjectionTypeAll = "ALL"
+	// @enum ProjectionType
+	ProjectionTypeKeysOnly = "KEYS_ONLY"
+	// @enum ProjectionType
+	ProjectionTypeInclude = "INCLUDE"
+)
+
+const (
+	// @enum ReturnConsumedCapacity
+	ReturnConsumedCapacityIndexes = "INDEXES"
+	// @enum ReturnConsumedCapacity
+	ReturnConsumedCapacityTotal = "TOTAL"
+	// @enum ReturnConsumedCapacity
+	ReturnConsumedCapacityNone = "NONE"
+)
+
+const (
+	// @enum ReturnItemCollectionMetrics
+	ReturnItemCollectionMetricsSize = "SIZE"
+	// @enum ReturnItemCollectionMetrics
+	ReturnItemCollectionMetricsNone = "NONE"
+)
+
+const (
+	// @enum ReturnValue
+	ReturnValueNone = "NONE"
+	// @enum ReturnValue
+	ReturnValueAllOld = "ALL_OLD"
+	// @enum ReturnValue
+	ReturnValueUpdatedOld = "UPDATED_OLD"
+	// @enum ReturnValue
+	ReturnValueAllNew = "ALL_NEW"
+	// @enum ReturnValue
+	ReturnValueUpdatedNew = "UPDATED_NEW"
+)
+
+const (
+	// @enum ScalarAttributeType
+	ScalarAttributeTypeS = "S"
+	// @enum ScalarAttributeType
+	ScalarAttributeTypeN = "N"
+	// @enum ScalarAttributeType
+	ScalarAttributeTypeB = "B"
+)
+
+const (
+	// @enum Select
+	SelectAllAttributes = "ALL_ATTRIBUTES"
+	// @enum Select
+	SelectAllProjectedAttributes = "ALL_PROJECTED_ATTRIBUTES"
+	// @enum Select
+	SelectSpecificAttributes = "SPECIFIC_ATTRIBUTES"
+	// @enum Select
+	SelectCount = "COUNT"
+)
+
+const (
+	// @enum TableStatus
+	TableStatusCreating = "CREATING"
+	// @enum TableStatus
+	TableStatusUpdating = "UPDATING"
+	// @enum TableStatus
+	TableStatusDeleting = "DELETING"
+	// @enum TableStatus
+	TableStatusActive = "ACTIVE"
+)
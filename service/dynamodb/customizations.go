@@ -5,7 +5,6 @@ import (
 	"hash/crc32"
 	"io"
 	"io/ioutil"
-	"math"
 	"strconv"
 	"time"
 
@@ -13,16 +12,23 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
+// Named request handlers of the DynamoDB customizations. These can be used to
+// remove, or swap out, an individual customization from a service's handler
+// lists.
+var (
+	DisableCompressionHandler = aws.NamedHandler{Name: "dynamodb.DisableCompressionHandler", Fn: disableCompression}
+	ValidateCRC32Handler      = aws.NamedHandler{Name: "dynamodb.ValidateCRC32Handler", Fn: validateCRC32}
+)
+
 func init() {
 	initService = func(s *aws.Service) {
-		s.DefaultMaxRetries = 10
-		s.RetryRules = func(r *aws.Request) time.Duration {
-			delay := time.Duration(math.Pow(2, float64(r.RetryCount))) * 50
-			return delay * time.Millisecond
+		s.Retryer = aws.DefaultRetryer{
+			NumMaxRetries: 10,
+			MinRetryDelay: 50 * time.Millisecond,
 		}
 
-		s.Handlers.Build.PushBack(disableCompression)
-		s.Handlers.Unmarshal.PushFront(validateCRC32)
+		s.Handlers.Build.PushBackNamed(DisableCompressionHandler)
+		s.Handlers.Unmarshal.PushFrontNamed(ValidateCRC32Handler)
 	}
 }
 
@@ -47,7 +53,7 @@ func validateCRC32(r *aws.Request) {
 	}
 
 	// Checksum validation is off, skip
-	if r.Service.Config.DisableComputeChecksums {
+	if aws.BooleanValue(r.Service.Config.DisableComputeChecksums) {
 		return
 	}
 
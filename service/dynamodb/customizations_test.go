@@ -19,7 +19,7 @@ var db *dynamodb.DynamoDB
 
 func TestMain(m *testing.M) {
 	db = dynamodb.New(&aws.Config{
-		MaxRetries: 2,
+		MaxRetries: aws.Int(2),
 	})
 	db.Handlers.Send.Clear() // mock sending
 
@@ -43,7 +43,7 @@ func mockCRCResponse(svc *dynamodb.DynamoDB, status int, body, crc string) (req
 }
 
 func TestCustomRetryRules(t *testing.T) {
-	d := dynamodb.New(&aws.Config{MaxRetries: -1})
+	d := dynamodb.New(&aws.Config{MaxRetries: aws.Int(-1)})
 	assert.Equal(t, d.MaxRetries(), uint(10))
 }
 
@@ -83,8 +83,8 @@ func TestValidateCRC32DoesNotMatch(t *testing.T) {
 
 func TestValidateCRC32DoesNotMatchNoComputeChecksum(t *testing.T) {
 	svc := dynamodb.New(&aws.Config{
-		MaxRetries:              2,
-		DisableComputeChecksums: true,
+		MaxRetries:              aws.Int(2),
+		DisableComputeChecksums: aws.Boolean(true),
 	})
 	svc.Handlers.Send.Clear() // mock sending
 
@@ -97,3 +97,13 @@ func TestValidateCRC32DoesNotMatchNoComputeChecksum(t *testing.T) {
 	out := req.Data.(*dynamodb.ListTablesOutput)
 	assert.Equal(t, "A", *out.TableNames[0])
 }
+
+func TestValidateCRC32HandlerRemoved(t *testing.T) {
+	svc := dynamodb.New(&aws.Config{MaxRetries: aws.Int(2)})
+	svc.Handlers.Send.Clear() // mock sending
+	svc.Handlers.Unmarshal.Remove(dynamodb.ValidateCRC32Handler.Name)
+
+	req := mockCRCResponse(svc, 200, `{"TableNames":["A"]}`, "1234")
+	assert.NoError(t, req.Error)
+	assert.Equal(t, 0, int(req.RetryCount))
+}
@@ -4,6 +4,7 @@
 package dynamodbiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 )
 
@@ -11,27 +12,57 @@ import (
 type DynamoDBAPI interface {
 	BatchGetItem(*dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error)
 
+	BatchGetItemWithContext(aws.Context, *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error)
+
 	BatchWriteItem(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
 
+	BatchWriteItemWithContext(aws.Context, *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
+
 	CreateTable(*dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error)
 
+	CreateTableWithContext(aws.Context, *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error)
+
 	DeleteItem(*dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
 
+	DeleteItemWithContext(aws.Context, *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
+
 	DeleteTable(*dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error)
 
+	DeleteTableWithContext(aws.Context, *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error)
+
 	DescribeTable(*dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
 
+	DescribeTableWithContext(aws.Context, *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
+
 	GetItem(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
 
+	GetItemWithContext(aws.Context, *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+
 	ListTables(*dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error)
 
+	ListTablesWithContext(aws.Context, *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error)
+
 	PutItem(*dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
 
+	PutItemWithContext(aws.Context, *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+
 	Query(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
 
+	QueryWithContext(aws.Context, *dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+
 	Scan(*dynamodb.ScanInput) (*dynamodb.ScanOutput, error)
 
+	ScanWithContext(aws.Context, *dynamodb.ScanInput) (*dynamodb.ScanOutput, error)
+
 	UpdateItem(*dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error)
 
+	UpdateItemWithContext(aws.Context, *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error)
+
 	UpdateTable(*dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error)
+
+	UpdateTableWithContext(aws.Context, *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error)
+
+	WaitUntilTableExists(*dynamodb.DescribeTableInput) error
+
+	WaitUntilTableNotExists(*dynamodb.DescribeTableInput) error
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAcceptVPCPeeringConnection = "AcceptVpcPeeringConnection"
@@ -39,6 +40,16 @@ func (c *EC2) AcceptVPCPeeringConnection(input *AcceptVPCPeeringConnectionInput)
 	return out, err
 }
 
+// AcceptVPCPeeringConnectionWithContext is the same as AcceptVPCPeeringConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AcceptVPCPeeringConnectionWithContext(ctx aws.Context, input *AcceptVPCPeeringConnectionInput) (*AcceptVPCPeeringConnectionOutput, error) {
+	req, out := c.AcceptVPCPeeringConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAllocateAddress = "AllocateAddress"
 
 // AllocateAddressRequest generates a request for the AllocateAddress operation.
@@ -70,6 +81,16 @@ func (c *EC2) AllocateAddress(input *AllocateAddressInput) (*AllocateAddressOutp
 	return out, err
 }
 
+// AllocateAddressWithContext is the same as AllocateAddress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AllocateAddressWithContext(ctx aws.Context, input *AllocateAddressInput) (*AllocateAddressOutput, error) {
+	req, out := c.AllocateAddressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssignPrivateIPAddresses = "AssignPrivateIpAddresses"
 
 // AssignPrivateIPAddressesRequest generates a request for the AssignPrivateIPAddresses operation.
@@ -107,6 +128,16 @@ func (c *EC2) AssignPrivateIPAddresses(input *AssignPrivateIPAddressesInput) (*A
 	return out, err
 }
 
+// AssignPrivateIPAddressesWithContext is the same as AssignPrivateIPAddresses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AssignPrivateIPAddressesWithContext(ctx aws.Context, input *AssignPrivateIPAddressesInput) (*AssignPrivateIPAddressesOutput, error) {
+	req, out := c.AssignPrivateIPAddressesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssociateAddress = "AssociateAddress"
 
 // AssociateAddressRequest generates a request for the AssociateAddress operation.
@@ -150,6 +181,16 @@ func (c *EC2) AssociateAddress(input *AssociateAddressInput) (*AssociateAddressO
 	return out, err
 }
 
+// AssociateAddressWithContext is the same as AssociateAddress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AssociateAddressWithContext(ctx aws.Context, input *AssociateAddressInput) (*AssociateAddressOutput, error) {
+	req, out := c.AssociateAddressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssociateDHCPOptions = "AssociateDhcpOptions"
 
 // AssociateDHCPOptionsRequest generates a request for the AssociateDHCPOptions operation.
@@ -188,6 +229,16 @@ func (c *EC2) AssociateDHCPOptions(input *AssociateDHCPOptionsInput) (*Associate
 	return out, err
 }
 
+// AssociateDHCPOptionsWithContext is the same as AssociateDHCPOptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AssociateDHCPOptionsWithContext(ctx aws.Context, input *AssociateDHCPOptionsInput) (*AssociateDHCPOptionsOutput, error) {
+	req, out := c.AssociateDHCPOptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssociateRouteTable = "AssociateRouteTable"
 
 // AssociateRouteTableRequest generates a request for the AssociateRouteTable operation.
@@ -222,6 +273,16 @@ func (c *EC2) AssociateRouteTable(input *AssociateRouteTableInput) (*AssociateRo
 	return out, err
 }
 
+// AssociateRouteTableWithContext is the same as AssociateRouteTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AssociateRouteTableWithContext(ctx aws.Context, input *AssociateRouteTableInput) (*AssociateRouteTableOutput, error) {
+	req, out := c.AssociateRouteTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachClassicLinkVPC = "AttachClassicLinkVpc"
 
 // AttachClassicLinkVPCRequest generates a request for the AttachClassicLinkVPC operation.
@@ -260,6 +321,16 @@ func (c *EC2) AttachClassicLinkVPC(input *AttachClassicLinkVPCInput) (*AttachCla
 	return out, err
 }
 
+// AttachClassicLinkVPCWithContext is the same as AttachClassicLinkVPC with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AttachClassicLinkVPCWithContext(ctx aws.Context, input *AttachClassicLinkVPCInput) (*AttachClassicLinkVPCOutput, error) {
+	req, out := c.AttachClassicLinkVPCRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachInternetGateway = "AttachInternetGateway"
 
 // AttachInternetGatewayRequest generates a request for the AttachInternetGateway operation.
@@ -289,6 +360,16 @@ func (c *EC2) AttachInternetGateway(input *AttachInternetGatewayInput) (*AttachI
 	return out, err
 }
 
+// AttachInternetGatewayWithContext is the same as AttachInternetGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AttachInternetGatewayWithContext(ctx aws.Context, input *AttachInternetGatewayInput) (*AttachInternetGatewayOutput, error) {
+	req, out := c.AttachInternetGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachNetworkInterface = "AttachNetworkInterface"
 
 // AttachNetworkInterfaceRequest generates a request for the AttachNetworkInterface operation.
@@ -316,6 +397,16 @@ func (c *EC2) AttachNetworkInterface(input *AttachNetworkInterfaceInput) (*Attac
 	return out, err
 }
 
+// AttachNetworkInterfaceWithContext is the same as AttachNetworkInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AttachNetworkInterfaceWithContext(ctx aws.Context, input *AttachNetworkInterfaceInput) (*AttachNetworkInterfaceOutput, error) {
+	req, out := c.AttachNetworkInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachVPNGateway = "AttachVpnGateway"
 
 // AttachVPNGatewayRequest generates a request for the AttachVPNGateway operation.
@@ -345,6 +436,16 @@ func (c *EC2) AttachVPNGateway(input *AttachVPNGatewayInput) (*AttachVPNGatewayO
 	return out, err
 }
 
+// AttachVPNGatewayWithContext is the same as AttachVPNGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AttachVPNGatewayWithContext(ctx aws.Context, input *AttachVPNGatewayInput) (*AttachVPNGatewayOutput, error) {
+	req, out := c.AttachVPNGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachVolume = "AttachVolume"
 
 // AttachVolumeRequest generates a request for the AttachVolume operation.
@@ -396,6 +497,16 @@ func (c *EC2) AttachVolume(input *AttachVolumeInput) (*VolumeAttachment, error)
 	return out, err
 }
 
+// AttachVolumeWithContext is the same as AttachVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AttachVolumeWithContext(ctx aws.Context, input *AttachVolumeInput) (*VolumeAttachment, error) {
+	req, out := c.AttachVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAuthorizeSecurityGroupEgress = "AuthorizeSecurityGroupEgress"
 
 // AuthorizeSecurityGroupEgressRequest generates a request for the AuthorizeSecurityGroupEgress operation.
@@ -443,6 +554,16 @@ func (c *EC2) AuthorizeSecurityGroupEgress(input *AuthorizeSecurityGroupEgressIn
 	return out, err
 }
 
+// AuthorizeSecurityGroupEgressWithContext is the same as AuthorizeSecurityGroupEgress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AuthorizeSecurityGroupEgressWithContext(ctx aws.Context, input *AuthorizeSecurityGroupEgressInput) (*AuthorizeSecurityGroupEgressOutput, error) {
+	req, out := c.AuthorizeSecurityGroupEgressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAuthorizeSecurityGroupIngress = "AuthorizeSecurityGroupIngress"
 
 // AuthorizeSecurityGroupIngressRequest generates a request for the AuthorizeSecurityGroupIngress operation.
@@ -488,6 +609,16 @@ func (c *EC2) AuthorizeSecurityGroupIngress(input *AuthorizeSecurityGroupIngress
 	return out, err
 }
 
+// AuthorizeSecurityGroupIngressWithContext is the same as AuthorizeSecurityGroupIngress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) AuthorizeSecurityGroupIngressWithContext(ctx aws.Context, input *AuthorizeSecurityGroupIngressInput) (*AuthorizeSecurityGroupIngressOutput, error) {
+	req, out := c.AuthorizeSecurityGroupIngressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opBundleInstance = "BundleInstance"
 
 // BundleInstanceRequest generates a request for the BundleInstance operation.
@@ -524,6 +655,16 @@ func (c *EC2) BundleInstance(input *BundleInstanceInput) (*BundleInstanceOutput,
 	return out, err
 }
 
+// BundleInstanceWithContext is the same as BundleInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) BundleInstanceWithContext(ctx aws.Context, input *BundleInstanceInput) (*BundleInstanceOutput, error) {
+	req, out := c.BundleInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelBundleTask = "CancelBundleTask"
 
 // CancelBundleTaskRequest generates a request for the CancelBundleTask operation.
@@ -551,6 +692,16 @@ func (c *EC2) CancelBundleTask(input *CancelBundleTaskInput) (*CancelBundleTaskO
 	return out, err
 }
 
+// CancelBundleTaskWithContext is the same as CancelBundleTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CancelBundleTaskWithContext(ctx aws.Context, input *CancelBundleTaskInput) (*CancelBundleTaskOutput, error) {
+	req, out := c.CancelBundleTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelConversionTask = "CancelConversionTask"
 
 // CancelConversionTaskRequest generates a request for the CancelConversionTask operation.
@@ -586,6 +737,16 @@ func (c *EC2) CancelConversionTask(input *CancelConversionTaskInput) (*CancelCon
 	return out, err
 }
 
+// CancelConversionTaskWithContext is the same as CancelConversionTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CancelConversionTaskWithContext(ctx aws.Context, input *CancelConversionTaskInput) (*CancelConversionTaskOutput, error) {
+	req, out := c.CancelConversionTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelExportTask = "CancelExportTask"
 
 // CancelExportTaskRequest generates a request for the CancelExportTask operation.
@@ -616,6 +777,16 @@ func (c *EC2) CancelExportTask(input *CancelExportTaskInput) (*CancelExportTaskO
 	return out, err
 }
 
+// CancelExportTaskWithContext is the same as CancelExportTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CancelExportTaskWithContext(ctx aws.Context, input *CancelExportTaskInput) (*CancelExportTaskOutput, error) {
+	req, out := c.CancelExportTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelImportTask = "CancelImportTask"
 
 // CancelImportTaskRequest generates a request for the CancelImportTask operation.
@@ -643,6 +814,16 @@ func (c *EC2) CancelImportTask(input *CancelImportTaskInput) (*CancelImportTaskO
 	return out, err
 }
 
+// CancelImportTaskWithContext is the same as CancelImportTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CancelImportTaskWithContext(ctx aws.Context, input *CancelImportTaskInput) (*CancelImportTaskOutput, error) {
+	req, out := c.CancelImportTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelReservedInstancesListing = "CancelReservedInstancesListing"
 
 // CancelReservedInstancesListingRequest generates a request for the CancelReservedInstancesListing operation.
@@ -674,6 +855,16 @@ func (c *EC2) CancelReservedInstancesListing(input *CancelReservedInstancesListi
 	return out, err
 }
 
+// CancelReservedInstancesListingWithContext is the same as CancelReservedInstancesListing with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CancelReservedInstancesListingWithContext(ctx aws.Context, input *CancelReservedInstancesListingInput) (*CancelReservedInstancesListingOutput, error) {
+	req, out := c.CancelReservedInstancesListingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelSpotFleetRequests = "CancelSpotFleetRequests"
 
 // CancelSpotFleetRequestsRequest generates a request for the CancelSpotFleetRequests operation.
@@ -701,6 +892,16 @@ func (c *EC2) CancelSpotFleetRequests(input *CancelSpotFleetRequestsInput) (*Can
 	return out, err
 }
 
+// CancelSpotFleetRequestsWithContext is the same as CancelSpotFleetRequests with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CancelSpotFleetRequestsWithContext(ctx aws.Context, input *CancelSpotFleetRequestsInput) (*CancelSpotFleetRequestsOutput, error) {
+	req, out := c.CancelSpotFleetRequestsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelSpotInstanceRequests = "CancelSpotInstanceRequests"
 
 // CancelSpotInstanceRequestsRequest generates a request for the CancelSpotInstanceRequests operation.
@@ -736,6 +937,16 @@ func (c *EC2) CancelSpotInstanceRequests(input *CancelSpotInstanceRequestsInput)
 	return out, err
 }
 
+// CancelSpotInstanceRequestsWithContext is the same as CancelSpotInstanceRequests with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CancelSpotInstanceRequestsWithContext(ctx aws.Context, input *CancelSpotInstanceRequestsInput) (*CancelSpotInstanceRequestsOutput, error) {
+	req, out := c.CancelSpotInstanceRequestsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opConfirmProductInstance = "ConfirmProductInstance"
 
 // ConfirmProductInstanceRequest generates a request for the ConfirmProductInstance operation.
@@ -766,6 +977,16 @@ func (c *EC2) ConfirmProductInstance(input *ConfirmProductInstanceInput) (*Confi
 	return out, err
 }
 
+// ConfirmProductInstanceWithContext is the same as ConfirmProductInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ConfirmProductInstanceWithContext(ctx aws.Context, input *ConfirmProductInstanceInput) (*ConfirmProductInstanceOutput, error) {
+	req, out := c.ConfirmProductInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCopyImage = "CopyImage"
 
 // CopyImageRequest generates a request for the CopyImage operation.
@@ -799,6 +1020,16 @@ func (c *EC2) CopyImage(input *CopyImageInput) (*CopyImageOutput, error) {
 	return out, err
 }
 
+// CopyImageWithContext is the same as CopyImage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CopyImageWithContext(ctx aws.Context, input *CopyImageInput) (*CopyImageOutput, error) {
+	req, out := c.CopyImageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCopySnapshot = "CopySnapshot"
 
 // CopySnapshotRequest generates a request for the CopySnapshot operation.
@@ -839,6 +1070,16 @@ func (c *EC2) CopySnapshot(input *CopySnapshotInput) (*CopySnapshotOutput, error
 	return out, err
 }
 
+// CopySnapshotWithContext is the same as CopySnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CopySnapshotWithContext(ctx aws.Context, input *CopySnapshotInput) (*CopySnapshotOutput, error) {
+	req, out := c.CopySnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCustomerGateway = "CreateCustomerGateway"
 
 // CreateCustomerGatewayRequest generates a request for the CreateCustomerGateway operation.
@@ -890,6 +1131,16 @@ func (c *EC2) CreateCustomerGateway(input *CreateCustomerGatewayInput) (*CreateC
 	return out, err
 }
 
+// CreateCustomerGatewayWithContext is the same as CreateCustomerGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateCustomerGatewayWithContext(ctx aws.Context, input *CreateCustomerGatewayInput) (*CreateCustomerGatewayOutput, error) {
+	req, out := c.CreateCustomerGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDHCPOptions = "CreateDhcpOptions"
 
 // CreateDHCPOptionsRequest generates a request for the CreateDHCPOptions operation.
@@ -945,6 +1196,16 @@ func (c *EC2) CreateDHCPOptions(input *CreateDHCPOptionsInput) (*CreateDHCPOptio
 	return out, err
 }
 
+// CreateDHCPOptionsWithContext is the same as CreateDHCPOptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateDHCPOptionsWithContext(ctx aws.Context, input *CreateDHCPOptionsInput) (*CreateDHCPOptionsOutput, error) {
+	req, out := c.CreateDHCPOptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateFlowLogs = "CreateFlowLogs"
 
 // CreateFlowLogsRequest generates a request for the CreateFlowLogs operation.
@@ -981,6 +1242,16 @@ func (c *EC2) CreateFlowLogs(input *CreateFlowLogsInput) (*CreateFlowLogsOutput,
 	return out, err
 }
 
+// CreateFlowLogsWithContext is the same as CreateFlowLogs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateFlowLogsWithContext(ctx aws.Context, input *CreateFlowLogsInput) (*CreateFlowLogsOutput, error) {
+	req, out := c.CreateFlowLogsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateImage = "CreateImage"
 
 // CreateImageRequest generates a request for the CreateImage operation.
@@ -1017,6 +1288,16 @@ func (c *EC2) CreateImage(input *CreateImageInput) (*CreateImageOutput, error) {
 	return out, err
 }
 
+// CreateImageWithContext is the same as CreateImage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateImageWithContext(ctx aws.Context, input *CreateImageInput) (*CreateImageOutput, error) {
+	req, out := c.CreateImageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInstanceExportTask = "CreateInstanceExportTask"
 
 // CreateInstanceExportTaskRequest generates a request for the CreateInstanceExportTask operation.
@@ -1049,6 +1330,16 @@ func (c *EC2) CreateInstanceExportTask(input *CreateInstanceExportTaskInput) (*C
 	return out, err
 }
 
+// CreateInstanceExportTaskWithContext is the same as CreateInstanceExportTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateInstanceExportTaskWithContext(ctx aws.Context, input *CreateInstanceExportTaskInput) (*CreateInstanceExportTaskOutput, error) {
+	req, out := c.CreateInstanceExportTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInternetGateway = "CreateInternetGateway"
 
 // CreateInternetGatewayRequest generates a request for the CreateInternetGateway operation.
@@ -1080,6 +1371,16 @@ func (c *EC2) CreateInternetGateway(input *CreateInternetGatewayInput) (*CreateI
 	return out, err
 }
 
+// CreateInternetGatewayWithContext is the same as CreateInternetGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateInternetGatewayWithContext(ctx aws.Context, input *CreateInternetGatewayInput) (*CreateInternetGatewayOutput, error) {
+	req, out := c.CreateInternetGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateKeyPair = "CreateKeyPair"
 
 // CreateKeyPairRequest generates a request for the CreateKeyPair operation.
@@ -1118,6 +1419,16 @@ func (c *EC2) CreateKeyPair(input *CreateKeyPairInput) (*CreateKeyPairOutput, er
 	return out, err
 }
 
+// CreateKeyPairWithContext is the same as CreateKeyPair with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateKeyPairWithContext(ctx aws.Context, input *CreateKeyPairInput) (*CreateKeyPairOutput, error) {
+	req, out := c.CreateKeyPairRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateNetworkACL = "CreateNetworkAcl"
 
 // CreateNetworkACLRequest generates a request for the CreateNetworkACL operation.
@@ -1149,6 +1460,16 @@ func (c *EC2) CreateNetworkACL(input *CreateNetworkACLInput) (*CreateNetworkACLO
 	return out, err
 }
 
+// CreateNetworkACLWithContext is the same as CreateNetworkACL with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateNetworkACLWithContext(ctx aws.Context, input *CreateNetworkACLInput) (*CreateNetworkACLOutput, error) {
+	req, out := c.CreateNetworkACLRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateNetworkACLEntry = "CreateNetworkAclEntry"
 
 // CreateNetworkACLEntryRequest generates a request for the CreateNetworkACLEntry operation.
@@ -1192,6 +1513,16 @@ func (c *EC2) CreateNetworkACLEntry(input *CreateNetworkACLEntryInput) (*CreateN
 	return out, err
 }
 
+// CreateNetworkACLEntryWithContext is the same as CreateNetworkACLEntry with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateNetworkACLEntryWithContext(ctx aws.Context, input *CreateNetworkACLEntryInput) (*CreateNetworkACLEntryOutput, error) {
+	req, out := c.CreateNetworkACLEntryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateNetworkInterface = "CreateNetworkInterface"
 
 // CreateNetworkInterfaceRequest generates a request for the CreateNetworkInterface operation.
@@ -1223,6 +1554,16 @@ func (c *EC2) CreateNetworkInterface(input *CreateNetworkInterfaceInput) (*Creat
 	return out, err
 }
 
+// CreateNetworkInterfaceWithContext is the same as CreateNetworkInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateNetworkInterfaceWithContext(ctx aws.Context, input *CreateNetworkInterfaceInput) (*CreateNetworkInterfaceOutput, error) {
+	req, out := c.CreateNetworkInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePlacementGroup = "CreatePlacementGroup"
 
 // CreatePlacementGroupRequest generates a request for the CreatePlacementGroup operation.
@@ -1255,6 +1596,16 @@ func (c *EC2) CreatePlacementGroup(input *CreatePlacementGroupInput) (*CreatePla
 	return out, err
 }
 
+// CreatePlacementGroupWithContext is the same as CreatePlacementGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreatePlacementGroupWithContext(ctx aws.Context, input *CreatePlacementGroupInput) (*CreatePlacementGroupOutput, error) {
+	req, out := c.CreatePlacementGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateReservedInstancesListing = "CreateReservedInstancesListing"
 
 // CreateReservedInstancesListingRequest generates a request for the CreateReservedInstancesListing operation.
@@ -1301,6 +1652,16 @@ func (c *EC2) CreateReservedInstancesListing(input *CreateReservedInstancesListi
 	return out, err
 }
 
+// CreateReservedInstancesListingWithContext is the same as CreateReservedInstancesListing with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateReservedInstancesListingWithContext(ctx aws.Context, input *CreateReservedInstancesListingInput) (*CreateReservedInstancesListingOutput, error) {
+	req, out := c.CreateReservedInstancesListingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateRoute = "CreateRoute"
 
 // CreateRouteRequest generates a request for the CreateRoute operation.
@@ -1346,6 +1707,16 @@ func (c *EC2) CreateRoute(input *CreateRouteInput) (*CreateRouteOutput, error) {
 	return out, err
 }
 
+// CreateRouteWithContext is the same as CreateRoute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateRouteWithContext(ctx aws.Context, input *CreateRouteInput) (*CreateRouteOutput, error) {
+	req, out := c.CreateRouteRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateRouteTable = "CreateRouteTable"
 
 // CreateRouteTableRequest generates a request for the CreateRouteTable operation.
@@ -1377,6 +1748,16 @@ func (c *EC2) CreateRouteTable(input *CreateRouteTableInput) (*CreateRouteTableO
 	return out, err
 }
 
+// CreateRouteTableWithContext is the same as CreateRouteTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateRouteTableWithContext(ctx aws.Context, input *CreateRouteTableInput) (*CreateRouteTableOutput, error) {
+	req, out := c.CreateRouteTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSecurityGroup = "CreateSecurityGroup"
 
 // CreateSecurityGroupRequest generates a request for the CreateSecurityGroup operation.
@@ -1430,6 +1811,16 @@ func (c *EC2) CreateSecurityGroup(input *CreateSecurityGroupInput) (*CreateSecur
 	return out, err
 }
 
+// CreateSecurityGroupWithContext is the same as CreateSecurityGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateSecurityGroupWithContext(ctx aws.Context, input *CreateSecurityGroupInput) (*CreateSecurityGroupOutput, error) {
+	req, out := c.CreateSecurityGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshot = "CreateSnapshot"
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -1484,6 +1875,16 @@ func (c *EC2) CreateSnapshot(input *CreateSnapshotInput) (*Snapshot, error) {
 	return out, err
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateSnapshotWithContext(ctx aws.Context, input *CreateSnapshotInput) (*Snapshot, error) {
+	req, out := c.CreateSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSpotDatafeedSubscription = "CreateSpotDatafeedSubscription"
 
 // CreateSpotDatafeedSubscriptionRequest generates a request for the CreateSpotDatafeedSubscription operation.
@@ -1514,6 +1915,16 @@ func (c *EC2) CreateSpotDatafeedSubscription(input *CreateSpotDatafeedSubscripti
 	return out, err
 }
 
+// CreateSpotDatafeedSubscriptionWithContext is the same as CreateSpotDatafeedSubscription with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateSpotDatafeedSubscriptionWithContext(ctx aws.Context, input *CreateSpotDatafeedSubscriptionInput) (*CreateSpotDatafeedSubscriptionOutput, error) {
+	req, out := c.CreateSpotDatafeedSubscriptionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSubnet = "CreateSubnet"
 
 // CreateSubnetRequest generates a request for the CreateSubnet operation.
@@ -1565,6 +1976,16 @@ func (c *EC2) CreateSubnet(input *CreateSubnetInput) (*CreateSubnetOutput, error
 	return out, err
 }
 
+// CreateSubnetWithContext is the same as CreateSubnet with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateSubnetWithContext(ctx aws.Context, input *CreateSubnetInput) (*CreateSubnetOutput, error) {
+	req, out := c.CreateSubnetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTags = "CreateTags"
 
 // CreateTagsRequest generates a request for the CreateTags operation.
@@ -1597,6 +2018,16 @@ func (c *EC2) CreateTags(input *CreateTagsInput) (*CreateTagsOutput, error) {
 	return out, err
 }
 
+// CreateTagsWithContext is the same as CreateTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateTagsWithContext(ctx aws.Context, input *CreateTagsInput) (*CreateTagsOutput, error) {
+	req, out := c.CreateTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVPC = "CreateVpc"
 
 // CreateVPCRequest generates a request for the CreateVPC operation.
@@ -1634,6 +2065,16 @@ func (c *EC2) CreateVPC(input *CreateVPCInput) (*CreateVPCOutput, error) {
 	return out, err
 }
 
+// CreateVPCWithContext is the same as CreateVPC with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateVPCWithContext(ctx aws.Context, input *CreateVPCInput) (*CreateVPCOutput, error) {
+	req, out := c.CreateVPCRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVPCEndpoint = "CreateVpcEndpoint"
 
 // CreateVPCEndpointRequest generates a request for the CreateVPCEndpoint operation.
@@ -1667,6 +2108,16 @@ func (c *EC2) CreateVPCEndpoint(input *CreateVPCEndpointInput) (*CreateVPCEndpoi
 	return out, err
 }
 
+// CreateVPCEndpointWithContext is the same as CreateVPCEndpoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateVPCEndpointWithContext(ctx aws.Context, input *CreateVPCEndpointInput) (*CreateVPCEndpointOutput, error) {
+	req, out := c.CreateVPCEndpointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVPCPeeringConnection = "CreateVpcPeeringConnection"
 
 // CreateVPCPeeringConnectionRequest generates a request for the CreateVPCPeeringConnection operation.
@@ -1704,6 +2155,16 @@ func (c *EC2) CreateVPCPeeringConnection(input *CreateVPCPeeringConnectionInput)
 	return out, err
 }
 
+// CreateVPCPeeringConnectionWithContext is the same as CreateVPCPeeringConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateVPCPeeringConnectionWithContext(ctx aws.Context, input *CreateVPCPeeringConnectionInput) (*CreateVPCPeeringConnectionOutput, error) {
+	req, out := c.CreateVPCPeeringConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVPNConnection = "CreateVpnConnection"
 
 // CreateVPNConnectionRequest generates a request for the CreateVPNConnection operation.
@@ -1747,6 +2208,16 @@ func (c *EC2) CreateVPNConnection(input *CreateVPNConnectionInput) (*CreateVPNCo
 	return out, err
 }
 
+// CreateVPNConnectionWithContext is the same as CreateVPNConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateVPNConnectionWithContext(ctx aws.Context, input *CreateVPNConnectionInput) (*CreateVPNConnectionOutput, error) {
+	req, out := c.CreateVPNConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVPNConnectionRoute = "CreateVpnConnectionRoute"
 
 // CreateVPNConnectionRouteRequest generates a request for the CreateVPNConnectionRoute operation.
@@ -1781,6 +2252,16 @@ func (c *EC2) CreateVPNConnectionRoute(input *CreateVPNConnectionRouteInput) (*C
 	return out, err
 }
 
+// CreateVPNConnectionRouteWithContext is the same as CreateVPNConnectionRoute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateVPNConnectionRouteWithContext(ctx aws.Context, input *CreateVPNConnectionRouteInput) (*CreateVPNConnectionRouteOutput, error) {
+	req, out := c.CreateVPNConnectionRouteRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVPNGateway = "CreateVpnGateway"
 
 // CreateVPNGatewayRequest generates a request for the CreateVPNGateway operation.
@@ -1814,6 +2295,16 @@ func (c *EC2) CreateVPNGateway(input *CreateVPNGatewayInput) (*CreateVPNGatewayO
 	return out, err
 }
 
+// CreateVPNGatewayWithContext is the same as CreateVPNGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateVPNGatewayWithContext(ctx aws.Context, input *CreateVPNGatewayInput) (*CreateVPNGatewayOutput, error) {
+	req, out := c.CreateVPNGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVolume = "CreateVolume"
 
 // CreateVolumeRequest generates a request for the CreateVolume operation.
@@ -1856,6 +2347,16 @@ func (c *EC2) CreateVolume(input *CreateVolumeInput) (*Volume, error) {
 	return out, err
 }
 
+// CreateVolumeWithContext is the same as CreateVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) CreateVolumeWithContext(ctx aws.Context, input *CreateVolumeInput) (*Volume, error) {
+	req, out := c.CreateVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCustomerGateway = "DeleteCustomerGateway"
 
 // DeleteCustomerGatewayRequest generates a request for the DeleteCustomerGateway operation.
@@ -1884,6 +2385,16 @@ func (c *EC2) DeleteCustomerGateway(input *DeleteCustomerGatewayInput) (*DeleteC
 	return out, err
 }
 
+// DeleteCustomerGatewayWithContext is the same as DeleteCustomerGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteCustomerGatewayWithContext(ctx aws.Context, input *DeleteCustomerGatewayInput) (*DeleteCustomerGatewayOutput, error) {
+	req, out := c.DeleteCustomerGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDHCPOptions = "DeleteDhcpOptions"
 
 // DeleteDHCPOptionsRequest generates a request for the DeleteDHCPOptions operation.
@@ -1914,7 +2425,17 @@ func (c *EC2) DeleteDHCPOptions(input *DeleteDHCPOptionsInput) (*DeleteDHCPOptio
 	return out, err
 }
 
-const opDeleteFlowLogs = "DeleteFlowLogs"
+// DeleteDHCPOptionsWithContext is the same as DeleteDHCPOptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteDHCPOptionsWithContext(ctx aws.Context, input *DeleteDHCPOptionsInput) (*DeleteDHCPOptionsOutput, error) {
+	req, out := c.DeleteDHCPOptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opDeleteFlowLogs = "DeleteFlowLogs"
 
 // DeleteFlowLogsRequest generates a request for the DeleteFlowLogs operation.
 func (c *EC2) DeleteFlowLogsRequest(input *DeleteFlowLogsInput) (req *aws.Request, output *DeleteFlowLogsOutput) {
@@ -1941,6 +2462,16 @@ func (c *EC2) DeleteFlowLogs(input *DeleteFlowLogsInput) (*DeleteFlowLogsOutput,
 	return out, err
 }
 
+// DeleteFlowLogsWithContext is the same as DeleteFlowLogs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteFlowLogsWithContext(ctx aws.Context, input *DeleteFlowLogsInput) (*DeleteFlowLogsOutput, error) {
+	req, out := c.DeleteFlowLogsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteInternetGateway = "DeleteInternetGateway"
 
 // DeleteInternetGatewayRequest generates a request for the DeleteInternetGateway operation.
@@ -1969,6 +2500,16 @@ func (c *EC2) DeleteInternetGateway(input *DeleteInternetGatewayInput) (*DeleteI
 	return out, err
 }
 
+// DeleteInternetGatewayWithContext is the same as DeleteInternetGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteInternetGatewayWithContext(ctx aws.Context, input *DeleteInternetGatewayInput) (*DeleteInternetGatewayOutput, error) {
+	req, out := c.DeleteInternetGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteKeyPair = "DeleteKeyPair"
 
 // DeleteKeyPairRequest generates a request for the DeleteKeyPair operation.
@@ -1996,6 +2537,16 @@ func (c *EC2) DeleteKeyPair(input *DeleteKeyPairInput) (*DeleteKeyPairOutput, er
 	return out, err
 }
 
+// DeleteKeyPairWithContext is the same as DeleteKeyPair with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteKeyPairWithContext(ctx aws.Context, input *DeleteKeyPairInput) (*DeleteKeyPairOutput, error) {
+	req, out := c.DeleteKeyPairRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteNetworkACL = "DeleteNetworkAcl"
 
 // DeleteNetworkACLRequest generates a request for the DeleteNetworkACL operation.
@@ -2024,6 +2575,16 @@ func (c *EC2) DeleteNetworkACL(input *DeleteNetworkACLInput) (*DeleteNetworkACLO
 	return out, err
 }
 
+// DeleteNetworkACLWithContext is the same as DeleteNetworkACL with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteNetworkACLWithContext(ctx aws.Context, input *DeleteNetworkACLInput) (*DeleteNetworkACLOutput, error) {
+	req, out := c.DeleteNetworkACLRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteNetworkACLEntry = "DeleteNetworkAclEntry"
 
 // DeleteNetworkACLEntryRequest generates a request for the DeleteNetworkACLEntry operation.
@@ -2052,6 +2613,16 @@ func (c *EC2) DeleteNetworkACLEntry(input *DeleteNetworkACLEntryInput) (*DeleteN
 	return out, err
 }
 
+// DeleteNetworkACLEntryWithContext is the same as DeleteNetworkACLEntry with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteNetworkACLEntryWithContext(ctx aws.Context, input *DeleteNetworkACLEntryInput) (*DeleteNetworkACLEntryOutput, error) {
+	req, out := c.DeleteNetworkACLEntryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteNetworkInterface = "DeleteNetworkInterface"
 
 // DeleteNetworkInterfaceRequest generates a request for the DeleteNetworkInterface operation.
@@ -2080,6 +2651,16 @@ func (c *EC2) DeleteNetworkInterface(input *DeleteNetworkInterfaceInput) (*Delet
 	return out, err
 }
 
+// DeleteNetworkInterfaceWithContext is the same as DeleteNetworkInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteNetworkInterfaceWithContext(ctx aws.Context, input *DeleteNetworkInterfaceInput) (*DeleteNetworkInterfaceOutput, error) {
+	req, out := c.DeleteNetworkInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePlacementGroup = "DeletePlacementGroup"
 
 // DeletePlacementGroupRequest generates a request for the DeletePlacementGroup operation.
@@ -2110,6 +2691,16 @@ func (c *EC2) DeletePlacementGroup(input *DeletePlacementGroupInput) (*DeletePla
 	return out, err
 }
 
+// DeletePlacementGroupWithContext is the same as DeletePlacementGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeletePlacementGroupWithContext(ctx aws.Context, input *DeletePlacementGroupInput) (*DeletePlacementGroupOutput, error) {
+	req, out := c.DeletePlacementGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRoute = "DeleteRoute"
 
 // DeleteRouteRequest generates a request for the DeleteRoute operation.
@@ -2137,6 +2728,16 @@ func (c *EC2) DeleteRoute(input *DeleteRouteInput) (*DeleteRouteOutput, error) {
 	return out, err
 }
 
+// DeleteRouteWithContext is the same as DeleteRoute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteRouteWithContext(ctx aws.Context, input *DeleteRouteInput) (*DeleteRouteOutput, error) {
+	req, out := c.DeleteRouteRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRouteTable = "DeleteRouteTable"
 
 // DeleteRouteTableRequest generates a request for the DeleteRouteTable operation.
@@ -2166,6 +2767,16 @@ func (c *EC2) DeleteRouteTable(input *DeleteRouteTableInput) (*DeleteRouteTableO
 	return out, err
 }
 
+// DeleteRouteTableWithContext is the same as DeleteRouteTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteRouteTableWithContext(ctx aws.Context, input *DeleteRouteTableInput) (*DeleteRouteTableOutput, error) {
+	req, out := c.DeleteRouteTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSecurityGroup = "DeleteSecurityGroup"
 
 // DeleteSecurityGroupRequest generates a request for the DeleteSecurityGroup operation.
@@ -2197,6 +2808,16 @@ func (c *EC2) DeleteSecurityGroup(input *DeleteSecurityGroupInput) (*DeleteSecur
 	return out, err
 }
 
+// DeleteSecurityGroupWithContext is the same as DeleteSecurityGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteSecurityGroupWithContext(ctx aws.Context, input *DeleteSecurityGroupInput) (*DeleteSecurityGroupOutput, error) {
+	req, out := c.DeleteSecurityGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSnapshot = "DeleteSnapshot"
 
 // DeleteSnapshotRequest generates a request for the DeleteSnapshot operation.
@@ -2238,6 +2859,16 @@ func (c *EC2) DeleteSnapshot(input *DeleteSnapshotInput) (*DeleteSnapshotOutput,
 	return out, err
 }
 
+// DeleteSnapshotWithContext is the same as DeleteSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteSnapshotWithContext(ctx aws.Context, input *DeleteSnapshotInput) (*DeleteSnapshotOutput, error) {
+	req, out := c.DeleteSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSpotDatafeedSubscription = "DeleteSpotDatafeedSubscription"
 
 // DeleteSpotDatafeedSubscriptionRequest generates a request for the DeleteSpotDatafeedSubscription operation.
@@ -2267,6 +2898,16 @@ func (c *EC2) DeleteSpotDatafeedSubscription(input *DeleteSpotDatafeedSubscripti
 	return out, err
 }
 
+// DeleteSpotDatafeedSubscriptionWithContext is the same as DeleteSpotDatafeedSubscription with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteSpotDatafeedSubscriptionWithContext(ctx aws.Context, input *DeleteSpotDatafeedSubscriptionInput) (*DeleteSpotDatafeedSubscriptionOutput, error) {
+	req, out := c.DeleteSpotDatafeedSubscriptionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSubnet = "DeleteSubnet"
 
 // DeleteSubnetRequest generates a request for the DeleteSubnet operation.
@@ -2295,6 +2936,16 @@ func (c *EC2) DeleteSubnet(input *DeleteSubnetInput) (*DeleteSubnetOutput, error
 	return out, err
 }
 
+// DeleteSubnetWithContext is the same as DeleteSubnet with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteSubnetWithContext(ctx aws.Context, input *DeleteSubnetInput) (*DeleteSubnetOutput, error) {
+	req, out := c.DeleteSubnetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTags = "DeleteTags"
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -2326,6 +2977,16 @@ func (c *EC2) DeleteTags(input *DeleteTagsInput) (*DeleteTagsOutput, error) {
 	return out, err
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteTagsWithContext(ctx aws.Context, input *DeleteTagsInput) (*DeleteTagsOutput, error) {
+	req, out := c.DeleteTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVPC = "DeleteVpc"
 
 // DeleteVPCRequest generates a request for the DeleteVPC operation.
@@ -2357,6 +3018,16 @@ func (c *EC2) DeleteVPC(input *DeleteVPCInput) (*DeleteVPCOutput, error) {
 	return out, err
 }
 
+// DeleteVPCWithContext is the same as DeleteVPC with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteVPCWithContext(ctx aws.Context, input *DeleteVPCInput) (*DeleteVPCOutput, error) {
+	req, out := c.DeleteVPCRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVPCEndpoints = "DeleteVpcEndpoints"
 
 // DeleteVPCEndpointsRequest generates a request for the DeleteVPCEndpoints operation.
@@ -2385,6 +3056,16 @@ func (c *EC2) DeleteVPCEndpoints(input *DeleteVPCEndpointsInput) (*DeleteVPCEndp
 	return out, err
 }
 
+// DeleteVPCEndpointsWithContext is the same as DeleteVPCEndpoints with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteVPCEndpointsWithContext(ctx aws.Context, input *DeleteVPCEndpointsInput) (*DeleteVPCEndpointsOutput, error) {
+	req, out := c.DeleteVPCEndpointsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVPCPeeringConnection = "DeleteVpcPeeringConnection"
 
 // DeleteVPCPeeringConnectionRequest generates a request for the DeleteVPCPeeringConnection operation.
@@ -2415,6 +3096,16 @@ func (c *EC2) DeleteVPCPeeringConnection(input *DeleteVPCPeeringConnectionInput)
 	return out, err
 }
 
+// DeleteVPCPeeringConnectionWithContext is the same as DeleteVPCPeeringConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteVPCPeeringConnectionWithContext(ctx aws.Context, input *DeleteVPCPeeringConnectionInput) (*DeleteVPCPeeringConnectionOutput, error) {
+	req, out := c.DeleteVPCPeeringConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVPNConnection = "DeleteVpnConnection"
 
 // DeleteVPNConnectionRequest generates a request for the DeleteVPNConnection operation.
@@ -2451,6 +3142,16 @@ func (c *EC2) DeleteVPNConnection(input *DeleteVPNConnectionInput) (*DeleteVPNCo
 	return out, err
 }
 
+// DeleteVPNConnectionWithContext is the same as DeleteVPNConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteVPNConnectionWithContext(ctx aws.Context, input *DeleteVPNConnectionInput) (*DeleteVPNConnectionOutput, error) {
+	req, out := c.DeleteVPNConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVPNConnectionRoute = "DeleteVpnConnectionRoute"
 
 // DeleteVPNConnectionRouteRequest generates a request for the DeleteVPNConnectionRoute operation.
@@ -2481,6 +3182,16 @@ func (c *EC2) DeleteVPNConnectionRoute(input *DeleteVPNConnectionRouteInput) (*D
 	return out, err
 }
 
+// DeleteVPNConnectionRouteWithContext is the same as DeleteVPNConnectionRoute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteVPNConnectionRouteWithContext(ctx aws.Context, input *DeleteVPNConnectionRouteInput) (*DeleteVPNConnectionRouteOutput, error) {
+	req, out := c.DeleteVPNConnectionRouteRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVPNGateway = "DeleteVpnGateway"
 
 // DeleteVPNGatewayRequest generates a request for the DeleteVPNGateway operation.
@@ -2512,6 +3223,16 @@ func (c *EC2) DeleteVPNGateway(input *DeleteVPNGatewayInput) (*DeleteVPNGatewayO
 	return out, err
 }
 
+// DeleteVPNGatewayWithContext is the same as DeleteVPNGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteVPNGatewayWithContext(ctx aws.Context, input *DeleteVPNGatewayInput) (*DeleteVPNGatewayOutput, error) {
+	req, out := c.DeleteVPNGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVolume = "DeleteVolume"
 
 // DeleteVolumeRequest generates a request for the DeleteVolume operation.
@@ -2545,6 +3266,16 @@ func (c *EC2) DeleteVolume(input *DeleteVolumeInput) (*DeleteVolumeOutput, error
 	return out, err
 }
 
+// DeleteVolumeWithContext is the same as DeleteVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeleteVolumeWithContext(ctx aws.Context, input *DeleteVolumeInput) (*DeleteVolumeOutput, error) {
+	req, out := c.DeleteVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterImage = "DeregisterImage"
 
 // DeregisterImageRequest generates a request for the DeregisterImage operation.
@@ -2575,6 +3306,16 @@ func (c *EC2) DeregisterImage(input *DeregisterImageInput) (*DeregisterImageOutp
 	return out, err
 }
 
+// DeregisterImageWithContext is the same as DeregisterImage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DeregisterImageWithContext(ctx aws.Context, input *DeregisterImageInput) (*DeregisterImageOutput, error) {
+	req, out := c.DeregisterImageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAccountAttributes = "DescribeAccountAttributes"
 
 // DescribeAccountAttributesRequest generates a request for the DescribeAccountAttributes operation.
@@ -2620,6 +3361,16 @@ func (c *EC2) DescribeAccountAttributes(input *DescribeAccountAttributesInput) (
 	return out, err
 }
 
+// DescribeAccountAttributesWithContext is the same as DescribeAccountAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeAccountAttributesWithContext(ctx aws.Context, input *DescribeAccountAttributesInput) (*DescribeAccountAttributesOutput, error) {
+	req, out := c.DescribeAccountAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAddresses = "DescribeAddresses"
 
 // DescribeAddressesRequest generates a request for the DescribeAddresses operation.
@@ -2651,6 +3402,16 @@ func (c *EC2) DescribeAddresses(input *DescribeAddressesInput) (*DescribeAddress
 	return out, err
 }
 
+// DescribeAddressesWithContext is the same as DescribeAddresses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeAddressesWithContext(ctx aws.Context, input *DescribeAddressesInput) (*DescribeAddressesOutput, error) {
+	req, out := c.DescribeAddressesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAvailabilityZones = "DescribeAvailabilityZones"
 
 // DescribeAvailabilityZonesRequest generates a request for the DescribeAvailabilityZones operation.
@@ -2684,6 +3445,16 @@ func (c *EC2) DescribeAvailabilityZones(input *DescribeAvailabilityZonesInput) (
 	return out, err
 }
 
+// DescribeAvailabilityZonesWithContext is the same as DescribeAvailabilityZones with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeAvailabilityZonesWithContext(ctx aws.Context, input *DescribeAvailabilityZonesInput) (*DescribeAvailabilityZonesOutput, error) {
+	req, out := c.DescribeAvailabilityZonesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeBundleTasks = "DescribeBundleTasks"
 
 // DescribeBundleTasksRequest generates a request for the DescribeBundleTasks operation.
@@ -2716,6 +3487,16 @@ func (c *EC2) DescribeBundleTasks(input *DescribeBundleTasksInput) (*DescribeBun
 	return out, err
 }
 
+// DescribeBundleTasksWithContext is the same as DescribeBundleTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeBundleTasksWithContext(ctx aws.Context, input *DescribeBundleTasksInput) (*DescribeBundleTasksOutput, error) {
+	req, out := c.DescribeBundleTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeClassicLinkInstances = "DescribeClassicLinkInstances"
 
 // DescribeClassicLinkInstancesRequest generates a request for the DescribeClassicLinkInstances operation.
@@ -2746,6 +3527,16 @@ func (c *EC2) DescribeClassicLinkInstances(input *DescribeClassicLinkInstancesIn
 	return out, err
 }
 
+// DescribeClassicLinkInstancesWithContext is the same as DescribeClassicLinkInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeClassicLinkInstancesWithContext(ctx aws.Context, input *DescribeClassicLinkInstancesInput) (*DescribeClassicLinkInstancesOutput, error) {
+	req, out := c.DescribeClassicLinkInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConversionTasks = "DescribeConversionTasks"
 
 // DescribeConversionTasksRequest generates a request for the DescribeConversionTasks operation.
@@ -2776,6 +3567,16 @@ func (c *EC2) DescribeConversionTasks(input *DescribeConversionTasksInput) (*Des
 	return out, err
 }
 
+// DescribeConversionTasksWithContext is the same as DescribeConversionTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeConversionTasksWithContext(ctx aws.Context, input *DescribeConversionTasksInput) (*DescribeConversionTasksOutput, error) {
+	req, out := c.DescribeConversionTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCustomerGateways = "DescribeCustomerGateways"
 
 // DescribeCustomerGatewaysRequest generates a request for the DescribeCustomerGateways operation.
@@ -2807,6 +3608,16 @@ func (c *EC2) DescribeCustomerGateways(input *DescribeCustomerGatewaysInput) (*D
 	return out, err
 }
 
+// DescribeCustomerGatewaysWithContext is the same as DescribeCustomerGateways with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeCustomerGatewaysWithContext(ctx aws.Context, input *DescribeCustomerGatewaysInput) (*DescribeCustomerGatewaysOutput, error) {
+	req, out := c.DescribeCustomerGatewaysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDHCPOptions = "DescribeDhcpOptions"
 
 // DescribeDHCPOptionsRequest generates a request for the DescribeDHCPOptions operation.
@@ -2837,6 +3648,16 @@ func (c *EC2) DescribeDHCPOptions(input *DescribeDHCPOptionsInput) (*DescribeDHC
 	return out, err
 }
 
+// DescribeDHCPOptionsWithContext is the same as DescribeDHCPOptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeDHCPOptionsWithContext(ctx aws.Context, input *DescribeDHCPOptionsInput) (*DescribeDHCPOptionsOutput, error) {
+	req, out := c.DescribeDHCPOptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeExportTasks = "DescribeExportTasks"
 
 // DescribeExportTasksRequest generates a request for the DescribeExportTasks operation.
@@ -2864,6 +3685,16 @@ func (c *EC2) DescribeExportTasks(input *DescribeExportTasksInput) (*DescribeExp
 	return out, err
 }
 
+// DescribeExportTasksWithContext is the same as DescribeExportTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeExportTasksWithContext(ctx aws.Context, input *DescribeExportTasksInput) (*DescribeExportTasksOutput, error) {
+	req, out := c.DescribeExportTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeFlowLogs = "DescribeFlowLogs"
 
 // DescribeFlowLogsRequest generates a request for the DescribeFlowLogs operation.
@@ -2893,6 +3724,16 @@ func (c *EC2) DescribeFlowLogs(input *DescribeFlowLogsInput) (*DescribeFlowLogsO
 	return out, err
 }
 
+// DescribeFlowLogsWithContext is the same as DescribeFlowLogs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeFlowLogsWithContext(ctx aws.Context, input *DescribeFlowLogsInput) (*DescribeFlowLogsOutput, error) {
+	req, out := c.DescribeFlowLogsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeImageAttribute = "DescribeImageAttribute"
 
 // DescribeImageAttributeRequest generates a request for the DescribeImageAttribute operation.
@@ -2921,6 +3762,16 @@ func (c *EC2) DescribeImageAttribute(input *DescribeImageAttributeInput) (*Descr
 	return out, err
 }
 
+// DescribeImageAttributeWithContext is the same as DescribeImageAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeImageAttributeWithContext(ctx aws.Context, input *DescribeImageAttributeInput) (*DescribeImageAttributeOutput, error) {
+	req, out := c.DescribeImageAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeImages = "DescribeImages"
 
 // DescribeImagesRequest generates a request for the DescribeImages operation.
@@ -2954,6 +3805,16 @@ func (c *EC2) DescribeImages(input *DescribeImagesInput) (*DescribeImagesOutput,
 	return out, err
 }
 
+// DescribeImagesWithContext is the same as DescribeImages with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeImagesWithContext(ctx aws.Context, input *DescribeImagesInput) (*DescribeImagesOutput, error) {
+	req, out := c.DescribeImagesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeImportImageTasks = "DescribeImportImageTasks"
 
 // DescribeImportImageTasksRequest generates a request for the DescribeImportImageTasks operation.
@@ -2982,6 +3843,16 @@ func (c *EC2) DescribeImportImageTasks(input *DescribeImportImageTasksInput) (*D
 	return out, err
 }
 
+// DescribeImportImageTasksWithContext is the same as DescribeImportImageTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeImportImageTasksWithContext(ctx aws.Context, input *DescribeImportImageTasksInput) (*DescribeImportImageTasksOutput, error) {
+	req, out := c.DescribeImportImageTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeImportSnapshotTasks = "DescribeImportSnapshotTasks"
 
 // DescribeImportSnapshotTasksRequest generates a request for the DescribeImportSnapshotTasks operation.
@@ -3009,6 +3880,16 @@ func (c *EC2) DescribeImportSnapshotTasks(input *DescribeImportSnapshotTasksInpu
 	return out, err
 }
 
+// DescribeImportSnapshotTasksWithContext is the same as DescribeImportSnapshotTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeImportSnapshotTasksWithContext(ctx aws.Context, input *DescribeImportSnapshotTasksInput) (*DescribeImportSnapshotTasksOutput, error) {
+	req, out := c.DescribeImportSnapshotTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeInstanceAttribute = "DescribeInstanceAttribute"
 
 // DescribeInstanceAttributeRequest generates a request for the DescribeInstanceAttribute operation.
@@ -3040,6 +3921,16 @@ func (c *EC2) DescribeInstanceAttribute(input *DescribeInstanceAttributeInput) (
 	return out, err
 }
 
+// DescribeInstanceAttributeWithContext is the same as DescribeInstanceAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeInstanceAttributeWithContext(ctx aws.Context, input *DescribeInstanceAttributeInput) (*DescribeInstanceAttributeOutput, error) {
+	req, out := c.DescribeInstanceAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeInstanceStatus = "DescribeInstanceStatus"
 
 // DescribeInstanceStatusRequest generates a request for the DescribeInstanceStatus operation.
@@ -3092,6 +3983,16 @@ func (c *EC2) DescribeInstanceStatus(input *DescribeInstanceStatusInput) (*Descr
 	return out, err
 }
 
+// DescribeInstanceStatusWithContext is the same as DescribeInstanceStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeInstanceStatusWithContext(ctx aws.Context, input *DescribeInstanceStatusInput) (*DescribeInstanceStatusOutput, error) {
+	req, out := c.DescribeInstanceStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeInstanceStatusPages(input *DescribeInstanceStatusInput, fn func(p *DescribeInstanceStatusOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeInstanceStatusRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3141,6 +4042,16 @@ func (c *EC2) DescribeInstances(input *DescribeInstancesInput) (*DescribeInstanc
 	return out, err
 }
 
+// DescribeInstancesWithContext is the same as DescribeInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeInstancesWithContext(ctx aws.Context, input *DescribeInstancesInput) (*DescribeInstancesOutput, error) {
+	req, out := c.DescribeInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeInstancesPages(input *DescribeInstancesInput, fn func(p *DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3175,6 +4086,16 @@ func (c *EC2) DescribeInternetGateways(input *DescribeInternetGatewaysInput) (*D
 	return out, err
 }
 
+// DescribeInternetGatewaysWithContext is the same as DescribeInternetGateways with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeInternetGatewaysWithContext(ctx aws.Context, input *DescribeInternetGatewaysInput) (*DescribeInternetGatewaysOutput, error) {
+	req, out := c.DescribeInternetGatewaysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeKeyPairs = "DescribeKeyPairs"
 
 // DescribeKeyPairsRequest generates a request for the DescribeKeyPairs operation.
@@ -3205,6 +4126,16 @@ func (c *EC2) DescribeKeyPairs(input *DescribeKeyPairsInput) (*DescribeKeyPairsO
 	return out, err
 }
 
+// DescribeKeyPairsWithContext is the same as DescribeKeyPairs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeKeyPairsWithContext(ctx aws.Context, input *DescribeKeyPairsInput) (*DescribeKeyPairsOutput, error) {
+	req, out := c.DescribeKeyPairsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMovingAddresses = "DescribeMovingAddresses"
 
 // DescribeMovingAddressesRequest generates a request for the DescribeMovingAddresses operation.
@@ -3234,6 +4165,16 @@ func (c *EC2) DescribeMovingAddresses(input *DescribeMovingAddressesInput) (*Des
 	return out, err
 }
 
+// DescribeMovingAddressesWithContext is the same as DescribeMovingAddresses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeMovingAddressesWithContext(ctx aws.Context, input *DescribeMovingAddressesInput) (*DescribeMovingAddressesOutput, error) {
+	req, out := c.DescribeMovingAddressesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeNetworkACLs = "DescribeNetworkAcls"
 
 // DescribeNetworkACLsRequest generates a request for the DescribeNetworkACLs operation.
@@ -3264,6 +4205,16 @@ func (c *EC2) DescribeNetworkACLs(input *DescribeNetworkACLsInput) (*DescribeNet
 	return out, err
 }
 
+// DescribeNetworkACLsWithContext is the same as DescribeNetworkACLs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeNetworkACLsWithContext(ctx aws.Context, input *DescribeNetworkACLsInput) (*DescribeNetworkACLsOutput, error) {
+	req, out := c.DescribeNetworkACLsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeNetworkInterfaceAttribute = "DescribeNetworkInterfaceAttribute"
 
 // DescribeNetworkInterfaceAttributeRequest generates a request for the DescribeNetworkInterfaceAttribute operation.
@@ -3292,6 +4243,16 @@ func (c *EC2) DescribeNetworkInterfaceAttribute(input *DescribeNetworkInterfaceA
 	return out, err
 }
 
+// DescribeNetworkInterfaceAttributeWithContext is the same as DescribeNetworkInterfaceAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeNetworkInterfaceAttributeWithContext(ctx aws.Context, input *DescribeNetworkInterfaceAttributeInput) (*DescribeNetworkInterfaceAttributeOutput, error) {
+	req, out := c.DescribeNetworkInterfaceAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeNetworkInterfaces = "DescribeNetworkInterfaces"
 
 // DescribeNetworkInterfacesRequest generates a request for the DescribeNetworkInterfaces operation.
@@ -3319,6 +4280,16 @@ func (c *EC2) DescribeNetworkInterfaces(input *DescribeNetworkInterfacesInput) (
 	return out, err
 }
 
+// DescribeNetworkInterfacesWithContext is the same as DescribeNetworkInterfaces with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeNetworkInterfacesWithContext(ctx aws.Context, input *DescribeNetworkInterfacesInput) (*DescribeNetworkInterfacesOutput, error) {
+	req, out := c.DescribeNetworkInterfacesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribePlacementGroups = "DescribePlacementGroups"
 
 // DescribePlacementGroupsRequest generates a request for the DescribePlacementGroups operation.
@@ -3348,6 +4319,16 @@ func (c *EC2) DescribePlacementGroups(input *DescribePlacementGroupsInput) (*Des
 	return out, err
 }
 
+// DescribePlacementGroupsWithContext is the same as DescribePlacementGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribePlacementGroupsWithContext(ctx aws.Context, input *DescribePlacementGroupsInput) (*DescribePlacementGroupsOutput, error) {
+	req, out := c.DescribePlacementGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribePrefixLists = "DescribePrefixLists"
 
 // DescribePrefixListsRequest generates a request for the DescribePrefixLists operation.
@@ -3379,6 +4360,16 @@ func (c *EC2) DescribePrefixLists(input *DescribePrefixListsInput) (*DescribePre
 	return out, err
 }
 
+// DescribePrefixListsWithContext is the same as DescribePrefixLists with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribePrefixListsWithContext(ctx aws.Context, input *DescribePrefixListsInput) (*DescribePrefixListsOutput, error) {
+	req, out := c.DescribePrefixListsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeRegions = "DescribeRegions"
 
 // DescribeRegionsRequest generates a request for the DescribeRegions operation.
@@ -3409,6 +4400,16 @@ func (c *EC2) DescribeRegions(input *DescribeRegionsInput) (*DescribeRegionsOutp
 	return out, err
 }
 
+// DescribeRegionsWithContext is the same as DescribeRegions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeRegionsWithContext(ctx aws.Context, input *DescribeRegionsInput) (*DescribeRegionsOutput, error) {
+	req, out := c.DescribeRegionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeReservedInstances = "DescribeReservedInstances"
 
 // DescribeReservedInstancesRequest generates a request for the DescribeReservedInstances operation.
@@ -3439,6 +4440,16 @@ func (c *EC2) DescribeReservedInstances(input *DescribeReservedInstancesInput) (
 	return out, err
 }
 
+// DescribeReservedInstancesWithContext is the same as DescribeReservedInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeReservedInstancesWithContext(ctx aws.Context, input *DescribeReservedInstancesInput) (*DescribeReservedInstancesOutput, error) {
+	req, out := c.DescribeReservedInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeReservedInstancesListings = "DescribeReservedInstancesListings"
 
 // DescribeReservedInstancesListingsRequest generates a request for the DescribeReservedInstancesListings operation.
@@ -3487,6 +4498,16 @@ func (c *EC2) DescribeReservedInstancesListings(input *DescribeReservedInstances
 	return out, err
 }
 
+// DescribeReservedInstancesListingsWithContext is the same as DescribeReservedInstancesListings with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeReservedInstancesListingsWithContext(ctx aws.Context, input *DescribeReservedInstancesListingsInput) (*DescribeReservedInstancesListingsOutput, error) {
+	req, out := c.DescribeReservedInstancesListingsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeReservedInstancesModifications = "DescribeReservedInstancesModifications"
 
 // DescribeReservedInstancesModificationsRequest generates a request for the DescribeReservedInstancesModifications operation.
@@ -3526,6 +4547,16 @@ func (c *EC2) DescribeReservedInstancesModifications(input *DescribeReservedInst
 	return out, err
 }
 
+// DescribeReservedInstancesModificationsWithContext is the same as DescribeReservedInstancesModifications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeReservedInstancesModificationsWithContext(ctx aws.Context, input *DescribeReservedInstancesModificationsInput) (*DescribeReservedInstancesModificationsOutput, error) {
+	req, out := c.DescribeReservedInstancesModificationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeReservedInstancesModificationsPages(input *DescribeReservedInstancesModificationsInput, fn func(p *DescribeReservedInstancesModificationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeReservedInstancesModificationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3573,6 +4604,16 @@ func (c *EC2) DescribeReservedInstancesOfferings(input *DescribeReservedInstance
 	return out, err
 }
 
+// DescribeReservedInstancesOfferingsWithContext is the same as DescribeReservedInstancesOfferings with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeReservedInstancesOfferingsWithContext(ctx aws.Context, input *DescribeReservedInstancesOfferingsInput) (*DescribeReservedInstancesOfferingsOutput, error) {
+	req, out := c.DescribeReservedInstancesOfferingsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeReservedInstancesOfferingsPages(input *DescribeReservedInstancesOfferingsInput, fn func(p *DescribeReservedInstancesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeReservedInstancesOfferingsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3610,6 +4651,16 @@ func (c *EC2) DescribeRouteTables(input *DescribeRouteTablesInput) (*DescribeRou
 	return out, err
 }
 
+// DescribeRouteTablesWithContext is the same as DescribeRouteTables with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeRouteTablesWithContext(ctx aws.Context, input *DescribeRouteTablesInput) (*DescribeRouteTablesOutput, error) {
+	req, out := c.DescribeRouteTablesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSecurityGroups = "DescribeSecurityGroups"
 
 // DescribeSecurityGroupsRequest generates a request for the DescribeSecurityGroups operation.
@@ -3644,6 +4695,16 @@ func (c *EC2) DescribeSecurityGroups(input *DescribeSecurityGroupsInput) (*Descr
 	return out, err
 }
 
+// DescribeSecurityGroupsWithContext is the same as DescribeSecurityGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSecurityGroupsWithContext(ctx aws.Context, input *DescribeSecurityGroupsInput) (*DescribeSecurityGroupsOutput, error) {
+	req, out := c.DescribeSecurityGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSnapshotAttribute = "DescribeSnapshotAttribute"
 
 // DescribeSnapshotAttributeRequest generates a request for the DescribeSnapshotAttribute operation.
@@ -3675,6 +4736,16 @@ func (c *EC2) DescribeSnapshotAttribute(input *DescribeSnapshotAttributeInput) (
 	return out, err
 }
 
+// DescribeSnapshotAttributeWithContext is the same as DescribeSnapshotAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSnapshotAttributeWithContext(ctx aws.Context, input *DescribeSnapshotAttributeInput) (*DescribeSnapshotAttributeOutput, error) {
+	req, out := c.DescribeSnapshotAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSnapshots = "DescribeSnapshots"
 
 // DescribeSnapshotsRequest generates a request for the DescribeSnapshots operation.
@@ -3747,6 +4818,16 @@ func (c *EC2) DescribeSnapshots(input *DescribeSnapshotsInput) (*DescribeSnapsho
 	return out, err
 }
 
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSnapshotsWithContext(ctx aws.Context, input *DescribeSnapshotsInput) (*DescribeSnapshotsOutput, error) {
+	req, out := c.DescribeSnapshotsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeSnapshotsPages(input *DescribeSnapshotsInput, fn func(p *DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeSnapshotsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3783,6 +4864,16 @@ func (c *EC2) DescribeSpotDatafeedSubscription(input *DescribeSpotDatafeedSubscr
 	return out, err
 }
 
+// DescribeSpotDatafeedSubscriptionWithContext is the same as DescribeSpotDatafeedSubscription with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSpotDatafeedSubscriptionWithContext(ctx aws.Context, input *DescribeSpotDatafeedSubscriptionInput) (*DescribeSpotDatafeedSubscriptionOutput, error) {
+	req, out := c.DescribeSpotDatafeedSubscriptionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSpotFleetInstances = "DescribeSpotFleetInstances"
 
 // DescribeSpotFleetInstancesRequest generates a request for the DescribeSpotFleetInstances operation.
@@ -3810,6 +4901,16 @@ func (c *EC2) DescribeSpotFleetInstances(input *DescribeSpotFleetInstancesInput)
 	return out, err
 }
 
+// DescribeSpotFleetInstancesWithContext is the same as DescribeSpotFleetInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSpotFleetInstancesWithContext(ctx aws.Context, input *DescribeSpotFleetInstancesInput) (*DescribeSpotFleetInstancesOutput, error) {
+	req, out := c.DescribeSpotFleetInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSpotFleetRequestHistory = "DescribeSpotFleetRequestHistory"
 
 // DescribeSpotFleetRequestHistoryRequest generates a request for the DescribeSpotFleetRequestHistory operation.
@@ -3842,6 +4943,16 @@ func (c *EC2) DescribeSpotFleetRequestHistory(input *DescribeSpotFleetRequestHis
 	return out, err
 }
 
+// DescribeSpotFleetRequestHistoryWithContext is the same as DescribeSpotFleetRequestHistory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSpotFleetRequestHistoryWithContext(ctx aws.Context, input *DescribeSpotFleetRequestHistoryInput) (*DescribeSpotFleetRequestHistoryOutput, error) {
+	req, out := c.DescribeSpotFleetRequestHistoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSpotFleetRequests = "DescribeSpotFleetRequests"
 
 // DescribeSpotFleetRequestsRequest generates a request for the DescribeSpotFleetRequests operation.
@@ -3869,6 +4980,16 @@ func (c *EC2) DescribeSpotFleetRequests(input *DescribeSpotFleetRequestsInput) (
 	return out, err
 }
 
+// DescribeSpotFleetRequestsWithContext is the same as DescribeSpotFleetRequests with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSpotFleetRequestsWithContext(ctx aws.Context, input *DescribeSpotFleetRequestsInput) (*DescribeSpotFleetRequestsOutput, error) {
+	req, out := c.DescribeSpotFleetRequestsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSpotInstanceRequests = "DescribeSpotInstanceRequests"
 
 // DescribeSpotInstanceRequestsRequest generates a request for the DescribeSpotInstanceRequests operation.
@@ -3907,6 +5028,16 @@ func (c *EC2) DescribeSpotInstanceRequests(input *DescribeSpotInstanceRequestsIn
 	return out, err
 }
 
+// DescribeSpotInstanceRequestsWithContext is the same as DescribeSpotInstanceRequests with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSpotInstanceRequestsWithContext(ctx aws.Context, input *DescribeSpotInstanceRequestsInput) (*DescribeSpotInstanceRequestsOutput, error) {
+	req, out := c.DescribeSpotInstanceRequestsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSpotPriceHistory = "DescribeSpotPriceHistory"
 
 // DescribeSpotPriceHistoryRequest generates a request for the DescribeSpotPriceHistory operation.
@@ -3948,6 +5079,16 @@ func (c *EC2) DescribeSpotPriceHistory(input *DescribeSpotPriceHistoryInput) (*D
 	return out, err
 }
 
+// DescribeSpotPriceHistoryWithContext is the same as DescribeSpotPriceHistory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSpotPriceHistoryWithContext(ctx aws.Context, input *DescribeSpotPriceHistoryInput) (*DescribeSpotPriceHistoryOutput, error) {
+	req, out := c.DescribeSpotPriceHistoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeSpotPriceHistoryPages(input *DescribeSpotPriceHistoryInput, fn func(p *DescribeSpotPriceHistoryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeSpotPriceHistoryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3985,6 +5126,16 @@ func (c *EC2) DescribeSubnets(input *DescribeSubnetsInput) (*DescribeSubnetsOutp
 	return out, err
 }
 
+// DescribeSubnetsWithContext is the same as DescribeSubnets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeSubnetsWithContext(ctx aws.Context, input *DescribeSubnetsInput) (*DescribeSubnetsOutput, error) {
+	req, out := c.DescribeSubnetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTags = "DescribeTags"
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -4015,6 +5166,16 @@ func (c *EC2) DescribeTags(input *DescribeTagsInput) (*DescribeTagsOutput, error
 	return out, err
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeTagsWithContext(ctx aws.Context, input *DescribeTagsInput) (*DescribeTagsOutput, error) {
+	req, out := c.DescribeTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPCAttribute = "DescribeVpcAttribute"
 
 // DescribeVPCAttributeRequest generates a request for the DescribeVPCAttribute operation.
@@ -4043,6 +5204,16 @@ func (c *EC2) DescribeVPCAttribute(input *DescribeVPCAttributeInput) (*DescribeV
 	return out, err
 }
 
+// DescribeVPCAttributeWithContext is the same as DescribeVPCAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPCAttributeWithContext(ctx aws.Context, input *DescribeVPCAttributeInput) (*DescribeVPCAttributeOutput, error) {
+	req, out := c.DescribeVPCAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPCClassicLink = "DescribeVpcClassicLink"
 
 // DescribeVPCClassicLinkRequest generates a request for the DescribeVPCClassicLink operation.
@@ -4070,6 +5241,16 @@ func (c *EC2) DescribeVPCClassicLink(input *DescribeVPCClassicLinkInput) (*Descr
 	return out, err
 }
 
+// DescribeVPCClassicLinkWithContext is the same as DescribeVPCClassicLink with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPCClassicLinkWithContext(ctx aws.Context, input *DescribeVPCClassicLinkInput) (*DescribeVPCClassicLinkOutput, error) {
+	req, out := c.DescribeVPCClassicLinkRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPCEndpointServices = "DescribeVpcEndpointServices"
 
 // DescribeVPCEndpointServicesRequest generates a request for the DescribeVPCEndpointServices operation.
@@ -4098,6 +5279,16 @@ func (c *EC2) DescribeVPCEndpointServices(input *DescribeVPCEndpointServicesInpu
 	return out, err
 }
 
+// DescribeVPCEndpointServicesWithContext is the same as DescribeVPCEndpointServices with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPCEndpointServicesWithContext(ctx aws.Context, input *DescribeVPCEndpointServicesInput) (*DescribeVPCEndpointServicesOutput, error) {
+	req, out := c.DescribeVPCEndpointServicesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPCEndpoints = "DescribeVpcEndpoints"
 
 // DescribeVPCEndpointsRequest generates a request for the DescribeVPCEndpoints operation.
@@ -4125,6 +5316,16 @@ func (c *EC2) DescribeVPCEndpoints(input *DescribeVPCEndpointsInput) (*DescribeV
 	return out, err
 }
 
+// DescribeVPCEndpointsWithContext is the same as DescribeVPCEndpoints with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPCEndpointsWithContext(ctx aws.Context, input *DescribeVPCEndpointsInput) (*DescribeVPCEndpointsOutput, error) {
+	req, out := c.DescribeVPCEndpointsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPCPeeringConnections = "DescribeVpcPeeringConnections"
 
 // DescribeVPCPeeringConnectionsRequest generates a request for the DescribeVPCPeeringConnections operation.
@@ -4152,6 +5353,16 @@ func (c *EC2) DescribeVPCPeeringConnections(input *DescribeVPCPeeringConnections
 	return out, err
 }
 
+// DescribeVPCPeeringConnectionsWithContext is the same as DescribeVPCPeeringConnections with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPCPeeringConnectionsWithContext(ctx aws.Context, input *DescribeVPCPeeringConnectionsInput) (*DescribeVPCPeeringConnectionsOutput, error) {
+	req, out := c.DescribeVPCPeeringConnectionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPCs = "DescribeVpcs"
 
 // DescribeVPCsRequest generates a request for the DescribeVPCs operation.
@@ -4179,6 +5390,16 @@ func (c *EC2) DescribeVPCs(input *DescribeVPCsInput) (*DescribeVPCsOutput, error
 	return out, err
 }
 
+// DescribeVPCsWithContext is the same as DescribeVPCs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPCsWithContext(ctx aws.Context, input *DescribeVPCsInput) (*DescribeVPCsOutput, error) {
+	req, out := c.DescribeVPCsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPNConnections = "DescribeVpnConnections"
 
 // DescribeVPNConnectionsRequest generates a request for the DescribeVPNConnections operation.
@@ -4210,6 +5431,16 @@ func (c *EC2) DescribeVPNConnections(input *DescribeVPNConnectionsInput) (*Descr
 	return out, err
 }
 
+// DescribeVPNConnectionsWithContext is the same as DescribeVPNConnections with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPNConnectionsWithContext(ctx aws.Context, input *DescribeVPNConnectionsInput) (*DescribeVPNConnectionsOutput, error) {
+	req, out := c.DescribeVPNConnectionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVPNGateways = "DescribeVpnGateways"
 
 // DescribeVPNGatewaysRequest generates a request for the DescribeVPNGateways operation.
@@ -4241,6 +5472,16 @@ func (c *EC2) DescribeVPNGateways(input *DescribeVPNGatewaysInput) (*DescribeVPN
 	return out, err
 }
 
+// DescribeVPNGatewaysWithContext is the same as DescribeVPNGateways with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVPNGatewaysWithContext(ctx aws.Context, input *DescribeVPNGatewaysInput) (*DescribeVPNGatewaysOutput, error) {
+	req, out := c.DescribeVPNGatewaysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVolumeAttribute = "DescribeVolumeAttribute"
 
 // DescribeVolumeAttributeRequest generates a request for the DescribeVolumeAttribute operation.
@@ -4272,6 +5513,16 @@ func (c *EC2) DescribeVolumeAttribute(input *DescribeVolumeAttributeInput) (*Des
 	return out, err
 }
 
+// DescribeVolumeAttributeWithContext is the same as DescribeVolumeAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVolumeAttributeWithContext(ctx aws.Context, input *DescribeVolumeAttributeInput) (*DescribeVolumeAttributeOutput, error) {
+	req, out := c.DescribeVolumeAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVolumeStatus = "DescribeVolumeStatus"
 
 // DescribeVolumeStatusRequest generates a request for the DescribeVolumeStatus operation.
@@ -4339,6 +5590,16 @@ func (c *EC2) DescribeVolumeStatus(input *DescribeVolumeStatusInput) (*DescribeV
 	return out, err
 }
 
+// DescribeVolumeStatusWithContext is the same as DescribeVolumeStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVolumeStatusWithContext(ctx aws.Context, input *DescribeVolumeStatusInput) (*DescribeVolumeStatusOutput, error) {
+	req, out := c.DescribeVolumeStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeVolumeStatusPages(input *DescribeVolumeStatusInput, fn func(p *DescribeVolumeStatusOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeVolumeStatusRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -4389,6 +5650,16 @@ func (c *EC2) DescribeVolumes(input *DescribeVolumesInput) (*DescribeVolumesOutp
 	return out, err
 }
 
+// DescribeVolumesWithContext is the same as DescribeVolumes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DescribeVolumesWithContext(ctx aws.Context, input *DescribeVolumesInput) (*DescribeVolumesOutput, error) {
+	req, out := c.DescribeVolumesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EC2) DescribeVolumesPages(input *DescribeVolumesInput, fn func(p *DescribeVolumesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeVolumesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -4425,6 +5696,16 @@ func (c *EC2) DetachClassicLinkVPC(input *DetachClassicLinkVPCInput) (*DetachCla
 	return out, err
 }
 
+// DetachClassicLinkVPCWithContext is the same as DetachClassicLinkVPC with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DetachClassicLinkVPCWithContext(ctx aws.Context, input *DetachClassicLinkVPCInput) (*DetachClassicLinkVPCOutput, error) {
+	req, out := c.DetachClassicLinkVPCRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachInternetGateway = "DetachInternetGateway"
 
 // DetachInternetGatewayRequest generates a request for the DetachInternetGateway operation.
@@ -4454,6 +5735,16 @@ func (c *EC2) DetachInternetGateway(input *DetachInternetGatewayInput) (*DetachI
 	return out, err
 }
 
+// DetachInternetGatewayWithContext is the same as DetachInternetGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DetachInternetGatewayWithContext(ctx aws.Context, input *DetachInternetGatewayInput) (*DetachInternetGatewayOutput, error) {
+	req, out := c.DetachInternetGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachNetworkInterface = "DetachNetworkInterface"
 
 // DetachNetworkInterfaceRequest generates a request for the DetachNetworkInterface operation.
@@ -4481,6 +5772,16 @@ func (c *EC2) DetachNetworkInterface(input *DetachNetworkInterfaceInput) (*Detac
 	return out, err
 }
 
+// DetachNetworkInterfaceWithContext is the same as DetachNetworkInterface with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DetachNetworkInterfaceWithContext(ctx aws.Context, input *DetachNetworkInterfaceInput) (*DetachNetworkInterfaceOutput, error) {
+	req, out := c.DetachNetworkInterfaceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachVPNGateway = "DetachVpnGateway"
 
 // DetachVPNGatewayRequest generates a request for the DetachVPNGateway operation.
@@ -4515,6 +5816,16 @@ func (c *EC2) DetachVPNGateway(input *DetachVPNGatewayInput) (*DetachVPNGatewayO
 	return out, err
 }
 
+// DetachVPNGatewayWithContext is the same as DetachVPNGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DetachVPNGatewayWithContext(ctx aws.Context, input *DetachVPNGatewayInput) (*DetachVPNGatewayOutput, error) {
+	req, out := c.DetachVPNGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachVolume = "DetachVolume"
 
 // DetachVolumeRequest generates a request for the DetachVolume operation.
@@ -4554,6 +5865,16 @@ func (c *EC2) DetachVolume(input *DetachVolumeInput) (*VolumeAttachment, error)
 	return out, err
 }
 
+// DetachVolumeWithContext is the same as DetachVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DetachVolumeWithContext(ctx aws.Context, input *DetachVolumeInput) (*VolumeAttachment, error) {
+	req, out := c.DetachVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableVGWRoutePropagation = "DisableVgwRoutePropagation"
 
 // DisableVGWRoutePropagationRequest generates a request for the DisableVGWRoutePropagation operation.
@@ -4582,6 +5903,16 @@ func (c *EC2) DisableVGWRoutePropagation(input *DisableVGWRoutePropagationInput)
 	return out, err
 }
 
+// DisableVGWRoutePropagationWithContext is the same as DisableVGWRoutePropagation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DisableVGWRoutePropagationWithContext(ctx aws.Context, input *DisableVGWRoutePropagationInput) (*DisableVGWRoutePropagationOutput, error) {
+	req, out := c.DisableVGWRoutePropagationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableVPCClassicLink = "DisableVpcClassicLink"
 
 // DisableVPCClassicLinkRequest generates a request for the DisableVPCClassicLink operation.
@@ -4610,6 +5941,16 @@ func (c *EC2) DisableVPCClassicLink(input *DisableVPCClassicLinkInput) (*Disable
 	return out, err
 }
 
+// DisableVPCClassicLinkWithContext is the same as DisableVPCClassicLink with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DisableVPCClassicLinkWithContext(ctx aws.Context, input *DisableVPCClassicLinkInput) (*DisableVPCClassicLinkOutput, error) {
+	req, out := c.DisableVPCClassicLinkRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisassociateAddress = "DisassociateAddress"
 
 // DisassociateAddressRequest generates a request for the DisassociateAddress operation.
@@ -4645,6 +5986,16 @@ func (c *EC2) DisassociateAddress(input *DisassociateAddressInput) (*Disassociat
 	return out, err
 }
 
+// DisassociateAddressWithContext is the same as DisassociateAddress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DisassociateAddressWithContext(ctx aws.Context, input *DisassociateAddressInput) (*DisassociateAddressOutput, error) {
+	req, out := c.DisassociateAddressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisassociateRouteTable = "DisassociateRouteTable"
 
 // DisassociateRouteTableRequest generates a request for the DisassociateRouteTable operation.
@@ -4677,6 +6028,16 @@ func (c *EC2) DisassociateRouteTable(input *DisassociateRouteTableInput) (*Disas
 	return out, err
 }
 
+// DisassociateRouteTableWithContext is the same as DisassociateRouteTable with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) DisassociateRouteTableWithContext(ctx aws.Context, input *DisassociateRouteTableInput) (*DisassociateRouteTableOutput, error) {
+	req, out := c.DisassociateRouteTableRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableVGWRoutePropagation = "EnableVgwRoutePropagation"
 
 // EnableVGWRoutePropagationRequest generates a request for the EnableVGWRoutePropagation operation.
@@ -4705,6 +6066,16 @@ func (c *EC2) EnableVGWRoutePropagation(input *EnableVGWRoutePropagationInput) (
 	return out, err
 }
 
+// EnableVGWRoutePropagationWithContext is the same as EnableVGWRoutePropagation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) EnableVGWRoutePropagationWithContext(ctx aws.Context, input *EnableVGWRoutePropagationInput) (*EnableVGWRoutePropagationOutput, error) {
+	req, out := c.EnableVGWRoutePropagationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableVPCClassicLink = "EnableVpcClassicLink"
 
 // EnableVPCClassicLinkRequest generates a request for the EnableVPCClassicLink operation.
@@ -4738,6 +6109,16 @@ func (c *EC2) EnableVPCClassicLink(input *EnableVPCClassicLinkInput) (*EnableVPC
 	return out, err
 }
 
+// EnableVPCClassicLinkWithContext is the same as EnableVPCClassicLink with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) EnableVPCClassicLinkWithContext(ctx aws.Context, input *EnableVPCClassicLinkInput) (*EnableVPCClassicLinkOutput, error) {
+	req, out := c.EnableVPCClassicLinkRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableVolumeIO = "EnableVolumeIO"
 
 // EnableVolumeIORequest generates a request for the EnableVolumeIO operation.
@@ -4766,6 +6147,16 @@ func (c *EC2) EnableVolumeIO(input *EnableVolumeIOInput) (*EnableVolumeIOOutput,
 	return out, err
 }
 
+// EnableVolumeIOWithContext is the same as EnableVolumeIO with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) EnableVolumeIOWithContext(ctx aws.Context, input *EnableVolumeIOInput) (*EnableVolumeIOOutput, error) {
+	req, out := c.EnableVolumeIORequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetConsoleOutput = "GetConsoleOutput"
 
 // GetConsoleOutputRequest generates a request for the GetConsoleOutput operation.
@@ -4810,6 +6201,16 @@ func (c *EC2) GetConsoleOutput(input *GetConsoleOutputInput) (*GetConsoleOutputO
 	return out, err
 }
 
+// GetConsoleOutputWithContext is the same as GetConsoleOutput with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) GetConsoleOutputWithContext(ctx aws.Context, input *GetConsoleOutputInput) (*GetConsoleOutputOutput, error) {
+	req, out := c.GetConsoleOutputRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPasswordData = "GetPasswordData"
 
 // GetPasswordDataRequest generates a request for the GetPasswordData operation.
@@ -4850,6 +6251,16 @@ func (c *EC2) GetPasswordData(input *GetPasswordDataInput) (*GetPasswordDataOutp
 	return out, err
 }
 
+// GetPasswordDataWithContext is the same as GetPasswordData with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) GetPasswordDataWithContext(ctx aws.Context, input *GetPasswordDataInput) (*GetPasswordDataOutput, error) {
+	req, out := c.GetPasswordDataRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opImportImage = "ImportImage"
 
 // ImportImageRequest generates a request for the ImportImage operation.
@@ -4878,6 +6289,16 @@ func (c *EC2) ImportImage(input *ImportImageInput) (*ImportImageOutput, error) {
 	return out, err
 }
 
+// ImportImageWithContext is the same as ImportImage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ImportImageWithContext(ctx aws.Context, input *ImportImageInput) (*ImportImageOutput, error) {
+	req, out := c.ImportImageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opImportInstance = "ImportInstance"
 
 // ImportInstanceRequest generates a request for the ImportInstance operation.
@@ -4911,6 +6332,16 @@ func (c *EC2) ImportInstance(input *ImportInstanceInput) (*ImportInstanceOutput,
 	return out, err
 }
 
+// ImportInstanceWithContext is the same as ImportInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ImportInstanceWithContext(ctx aws.Context, input *ImportInstanceInput) (*ImportInstanceOutput, error) {
+	req, out := c.ImportInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opImportKeyPair = "ImportKeyPair"
 
 // ImportKeyPairRequest generates a request for the ImportKeyPair operation.
@@ -4945,6 +6376,16 @@ func (c *EC2) ImportKeyPair(input *ImportKeyPairInput) (*ImportKeyPairOutput, er
 	return out, err
 }
 
+// ImportKeyPairWithContext is the same as ImportKeyPair with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ImportKeyPairWithContext(ctx aws.Context, input *ImportKeyPairInput) (*ImportKeyPairOutput, error) {
+	req, out := c.ImportKeyPairRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opImportSnapshot = "ImportSnapshot"
 
 // ImportSnapshotRequest generates a request for the ImportSnapshot operation.
@@ -4972,6 +6413,16 @@ func (c *EC2) ImportSnapshot(input *ImportSnapshotInput) (*ImportSnapshotOutput,
 	return out, err
 }
 
+// ImportSnapshotWithContext is the same as ImportSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ImportSnapshotWithContext(ctx aws.Context, input *ImportSnapshotInput) (*ImportSnapshotOutput, error) {
+	req, out := c.ImportSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opImportVolume = "ImportVolume"
 
 // ImportVolumeRequest generates a request for the ImportVolume operation.
@@ -5004,6 +6455,16 @@ func (c *EC2) ImportVolume(input *ImportVolumeInput) (*ImportVolumeOutput, error
 	return out, err
 }
 
+// ImportVolumeWithContext is the same as ImportVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ImportVolumeWithContext(ctx aws.Context, input *ImportVolumeInput) (*ImportVolumeOutput, error) {
+	req, out := c.ImportVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyImageAttribute = "ModifyImageAttribute"
 
 // ModifyImageAttributeRequest generates a request for the ModifyImageAttribute operation.
@@ -5035,6 +6496,16 @@ func (c *EC2) ModifyImageAttribute(input *ModifyImageAttributeInput) (*ModifyIma
 	return out, err
 }
 
+// ModifyImageAttributeWithContext is the same as ModifyImageAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifyImageAttributeWithContext(ctx aws.Context, input *ModifyImageAttributeInput) (*ModifyImageAttributeOutput, error) {
+	req, out := c.ModifyImageAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyInstanceAttribute = "ModifyInstanceAttribute"
 
 // ModifyInstanceAttributeRequest generates a request for the ModifyInstanceAttribute operation.
@@ -5067,6 +6538,16 @@ func (c *EC2) ModifyInstanceAttribute(input *ModifyInstanceAttributeInput) (*Mod
 	return out, err
 }
 
+// ModifyInstanceAttributeWithContext is the same as ModifyInstanceAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifyInstanceAttributeWithContext(ctx aws.Context, input *ModifyInstanceAttributeInput) (*ModifyInstanceAttributeOutput, error) {
+	req, out := c.ModifyInstanceAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyNetworkInterfaceAttribute = "ModifyNetworkInterfaceAttribute"
 
 // ModifyNetworkInterfaceAttributeRequest generates a request for the ModifyNetworkInterfaceAttribute operation.
@@ -5095,6 +6576,16 @@ func (c *EC2) ModifyNetworkInterfaceAttribute(input *ModifyNetworkInterfaceAttri
 	return out, err
 }
 
+// ModifyNetworkInterfaceAttributeWithContext is the same as ModifyNetworkInterfaceAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifyNetworkInterfaceAttributeWithContext(ctx aws.Context, input *ModifyNetworkInterfaceAttributeInput) (*ModifyNetworkInterfaceAttributeOutput, error) {
+	req, out := c.ModifyNetworkInterfaceAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyReservedInstances = "ModifyReservedInstances"
 
 // ModifyReservedInstancesRequest generates a request for the ModifyReservedInstances operation.
@@ -5128,6 +6619,16 @@ func (c *EC2) ModifyReservedInstances(input *ModifyReservedInstancesInput) (*Mod
 	return out, err
 }
 
+// ModifyReservedInstancesWithContext is the same as ModifyReservedInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifyReservedInstancesWithContext(ctx aws.Context, input *ModifyReservedInstancesInput) (*ModifyReservedInstancesOutput, error) {
+	req, out := c.ModifyReservedInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifySnapshotAttribute = "ModifySnapshotAttribute"
 
 // ModifySnapshotAttributeRequest generates a request for the ModifySnapshotAttribute operation.
@@ -5165,6 +6666,16 @@ func (c *EC2) ModifySnapshotAttribute(input *ModifySnapshotAttributeInput) (*Mod
 	return out, err
 }
 
+// ModifySnapshotAttributeWithContext is the same as ModifySnapshotAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifySnapshotAttributeWithContext(ctx aws.Context, input *ModifySnapshotAttributeInput) (*ModifySnapshotAttributeOutput, error) {
+	req, out := c.ModifySnapshotAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifySubnetAttribute = "ModifySubnetAttribute"
 
 // ModifySubnetAttributeRequest generates a request for the ModifySubnetAttribute operation.
@@ -5192,6 +6703,16 @@ func (c *EC2) ModifySubnetAttribute(input *ModifySubnetAttributeInput) (*ModifyS
 	return out, err
 }
 
+// ModifySubnetAttributeWithContext is the same as ModifySubnetAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifySubnetAttributeWithContext(ctx aws.Context, input *ModifySubnetAttributeInput) (*ModifySubnetAttributeOutput, error) {
+	req, out := c.ModifySubnetAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyVPCAttribute = "ModifyVpcAttribute"
 
 // ModifyVPCAttributeRequest generates a request for the ModifyVPCAttribute operation.
@@ -5219,6 +6740,16 @@ func (c *EC2) ModifyVPCAttribute(input *ModifyVPCAttributeInput) (*ModifyVPCAttr
 	return out, err
 }
 
+// ModifyVPCAttributeWithContext is the same as ModifyVPCAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifyVPCAttributeWithContext(ctx aws.Context, input *ModifyVPCAttributeInput) (*ModifyVPCAttributeOutput, error) {
+	req, out := c.ModifyVPCAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyVPCEndpoint = "ModifyVpcEndpoint"
 
 // ModifyVPCEndpointRequest generates a request for the ModifyVPCEndpoint operation.
@@ -5248,7 +6779,17 @@ func (c *EC2) ModifyVPCEndpoint(input *ModifyVPCEndpointInput) (*ModifyVPCEndpoi
 	return out, err
 }
 
-const opModifyVolumeAttribute = "ModifyVolumeAttribute"
+// ModifyVPCEndpointWithContext is the same as ModifyVPCEndpoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifyVPCEndpointWithContext(ctx aws.Context, input *ModifyVPCEndpointInput) (*ModifyVPCEndpointOutput, error) {
+	req, out := c.ModifyVPCEndpointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opModifyVolumeAttribute = "ModifyVolumeAttribute"
 
 // ModifyVolumeAttributeRequest generates a request for the ModifyVolumeAttribute operation.
 func (c *EC2) ModifyVolumeAttributeRequest(input *ModifyVolumeAttributeInput) (req *aws.Request, output *ModifyVolumeAttributeOutput) {
@@ -5284,6 +6825,16 @@ func (c *EC2) ModifyVolumeAttribute(input *ModifyVolumeAttributeInput) (*ModifyV
 	return out, err
 }
 
+// ModifyVolumeAttributeWithContext is the same as ModifyVolumeAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ModifyVolumeAttributeWithContext(ctx aws.Context, input *ModifyVolumeAttributeInput) (*ModifyVolumeAttributeOutput, error) {
+	req, out := c.ModifyVolumeAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opMonitorInstances = "MonitorInstances"
 
 // MonitorInstancesRequest generates a request for the MonitorInstances operation.
@@ -5313,6 +6864,16 @@ func (c *EC2) MonitorInstances(input *MonitorInstancesInput) (*MonitorInstancesO
 	return out, err
 }
 
+// MonitorInstancesWithContext is the same as MonitorInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) MonitorInstancesWithContext(ctx aws.Context, input *MonitorInstancesInput) (*MonitorInstancesOutput, error) {
+	req, out := c.MonitorInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opMoveAddressToVPC = "MoveAddressToVpc"
 
 // MoveAddressToVPCRequest generates a request for the MoveAddressToVPC operation.
@@ -5346,6 +6907,16 @@ func (c *EC2) MoveAddressToVPC(input *MoveAddressToVPCInput) (*MoveAddressToVPCO
 	return out, err
 }
 
+// MoveAddressToVPCWithContext is the same as MoveAddressToVPC with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) MoveAddressToVPCWithContext(ctx aws.Context, input *MoveAddressToVPCInput) (*MoveAddressToVPCOutput, error) {
+	req, out := c.MoveAddressToVPCRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPurchaseReservedInstancesOffering = "PurchaseReservedInstancesOffering"
 
 // PurchaseReservedInstancesOfferingRequest generates a request for the PurchaseReservedInstancesOffering operation.
@@ -5384,6 +6955,16 @@ func (c *EC2) PurchaseReservedInstancesOffering(input *PurchaseReservedInstances
 	return out, err
 }
 
+// PurchaseReservedInstancesOfferingWithContext is the same as PurchaseReservedInstancesOffering with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) PurchaseReservedInstancesOfferingWithContext(ctx aws.Context, input *PurchaseReservedInstancesOfferingInput) (*PurchaseReservedInstancesOfferingOutput, error) {
+	req, out := c.PurchaseReservedInstancesOfferingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRebootInstances = "RebootInstances"
 
 // RebootInstancesRequest generates a request for the RebootInstances operation.
@@ -5421,6 +7002,16 @@ func (c *EC2) RebootInstances(input *RebootInstancesInput) (*RebootInstancesOutp
 	return out, err
 }
 
+// RebootInstancesWithContext is the same as RebootInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RebootInstancesWithContext(ctx aws.Context, input *RebootInstancesInput) (*RebootInstancesOutput, error) {
+	req, out := c.RebootInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterImage = "RegisterImage"
 
 // RegisterImageRequest generates a request for the RegisterImage operation.
@@ -5467,6 +7058,16 @@ func (c *EC2) RegisterImage(input *RegisterImageInput) (*RegisterImageOutput, er
 	return out, err
 }
 
+// RegisterImageWithContext is the same as RegisterImage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RegisterImageWithContext(ctx aws.Context, input *RegisterImageInput) (*RegisterImageOutput, error) {
+	req, out := c.RegisterImageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRejectVPCPeeringConnection = "RejectVpcPeeringConnection"
 
 // RejectVPCPeeringConnectionRequest generates a request for the RejectVPCPeeringConnection operation.
@@ -5498,6 +7099,16 @@ func (c *EC2) RejectVPCPeeringConnection(input *RejectVPCPeeringConnectionInput)
 	return out, err
 }
 
+// RejectVPCPeeringConnectionWithContext is the same as RejectVPCPeeringConnection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RejectVPCPeeringConnectionWithContext(ctx aws.Context, input *RejectVPCPeeringConnectionInput) (*RejectVPCPeeringConnectionOutput, error) {
+	req, out := c.RejectVPCPeeringConnectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReleaseAddress = "ReleaseAddress"
 
 // ReleaseAddressRequest generates a request for the ReleaseAddress operation.
@@ -5539,6 +7150,16 @@ func (c *EC2) ReleaseAddress(input *ReleaseAddressInput) (*ReleaseAddressOutput,
 	return out, err
 }
 
+// ReleaseAddressWithContext is the same as ReleaseAddress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ReleaseAddressWithContext(ctx aws.Context, input *ReleaseAddressInput) (*ReleaseAddressOutput, error) {
+	req, out := c.ReleaseAddressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReplaceNetworkACLAssociation = "ReplaceNetworkAclAssociation"
 
 // ReplaceNetworkACLAssociationRequest generates a request for the ReplaceNetworkACLAssociation operation.
@@ -5569,6 +7190,16 @@ func (c *EC2) ReplaceNetworkACLAssociation(input *ReplaceNetworkACLAssociationIn
 	return out, err
 }
 
+// ReplaceNetworkACLAssociationWithContext is the same as ReplaceNetworkACLAssociation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ReplaceNetworkACLAssociationWithContext(ctx aws.Context, input *ReplaceNetworkACLAssociationInput) (*ReplaceNetworkACLAssociationOutput, error) {
+	req, out := c.ReplaceNetworkACLAssociationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReplaceNetworkACLEntry = "ReplaceNetworkAclEntry"
 
 // ReplaceNetworkACLEntryRequest generates a request for the ReplaceNetworkACLEntry operation.
@@ -5598,6 +7229,16 @@ func (c *EC2) ReplaceNetworkACLEntry(input *ReplaceNetworkACLEntryInput) (*Repla
 	return out, err
 }
 
+// ReplaceNetworkACLEntryWithContext is the same as ReplaceNetworkACLEntry with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ReplaceNetworkACLEntryWithContext(ctx aws.Context, input *ReplaceNetworkACLEntryInput) (*ReplaceNetworkACLEntryOutput, error) {
+	req, out := c.ReplaceNetworkACLEntryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReplaceRoute = "ReplaceRoute"
 
 // ReplaceRouteRequest generates a request for the ReplaceRoute operation.
@@ -5630,6 +7271,16 @@ func (c *EC2) ReplaceRoute(input *ReplaceRouteInput) (*ReplaceRouteOutput, error
 	return out, err
 }
 
+// ReplaceRouteWithContext is the same as ReplaceRoute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ReplaceRouteWithContext(ctx aws.Context, input *ReplaceRouteInput) (*ReplaceRouteOutput, error) {
+	req, out := c.ReplaceRouteRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReplaceRouteTableAssociation = "ReplaceRouteTableAssociation"
 
 // ReplaceRouteTableAssociationRequest generates a request for the ReplaceRouteTableAssociation operation.
@@ -5665,6 +7316,16 @@ func (c *EC2) ReplaceRouteTableAssociation(input *ReplaceRouteTableAssociationIn
 	return out, err
 }
 
+// ReplaceRouteTableAssociationWithContext is the same as ReplaceRouteTableAssociation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ReplaceRouteTableAssociationWithContext(ctx aws.Context, input *ReplaceRouteTableAssociationInput) (*ReplaceRouteTableAssociationOutput, error) {
+	req, out := c.ReplaceRouteTableAssociationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReportInstanceStatus = "ReportInstanceStatus"
 
 // ReportInstanceStatusRequest generates a request for the ReportInstanceStatus operation.
@@ -5698,6 +7359,16 @@ func (c *EC2) ReportInstanceStatus(input *ReportInstanceStatusInput) (*ReportIns
 	return out, err
 }
 
+// ReportInstanceStatusWithContext is the same as ReportInstanceStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ReportInstanceStatusWithContext(ctx aws.Context, input *ReportInstanceStatusInput) (*ReportInstanceStatusOutput, error) {
+	req, out := c.ReportInstanceStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRequestSpotFleet = "RequestSpotFleet"
 
 // RequestSpotFleetRequest generates a request for the RequestSpotFleet operation.
@@ -5728,6 +7399,16 @@ func (c *EC2) RequestSpotFleet(input *RequestSpotFleetInput) (*RequestSpotFleetO
 	return out, err
 }
 
+// RequestSpotFleetWithContext is the same as RequestSpotFleet with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RequestSpotFleetWithContext(ctx aws.Context, input *RequestSpotFleetInput) (*RequestSpotFleetOutput, error) {
+	req, out := c.RequestSpotFleetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRequestSpotInstances = "RequestSpotInstances"
 
 // RequestSpotInstancesRequest generates a request for the RequestSpotInstances operation.
@@ -5760,6 +7441,16 @@ func (c *EC2) RequestSpotInstances(input *RequestSpotInstancesInput) (*RequestSp
 	return out, err
 }
 
+// RequestSpotInstancesWithContext is the same as RequestSpotInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RequestSpotInstancesWithContext(ctx aws.Context, input *RequestSpotInstancesInput) (*RequestSpotInstancesOutput, error) {
+	req, out := c.RequestSpotInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResetImageAttribute = "ResetImageAttribute"
 
 // ResetImageAttributeRequest generates a request for the ResetImageAttribute operation.
@@ -5789,6 +7480,16 @@ func (c *EC2) ResetImageAttribute(input *ResetImageAttributeInput) (*ResetImageA
 	return out, err
 }
 
+// ResetImageAttributeWithContext is the same as ResetImageAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ResetImageAttributeWithContext(ctx aws.Context, input *ResetImageAttributeInput) (*ResetImageAttributeOutput, error) {
+	req, out := c.ResetImageAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResetInstanceAttribute = "ResetInstanceAttribute"
 
 // ResetInstanceAttributeRequest generates a request for the ResetInstanceAttribute operation.
@@ -5824,6 +7525,16 @@ func (c *EC2) ResetInstanceAttribute(input *ResetInstanceAttributeInput) (*Reset
 	return out, err
 }
 
+// ResetInstanceAttributeWithContext is the same as ResetInstanceAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ResetInstanceAttributeWithContext(ctx aws.Context, input *ResetInstanceAttributeInput) (*ResetInstanceAttributeOutput, error) {
+	req, out := c.ResetInstanceAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResetNetworkInterfaceAttribute = "ResetNetworkInterfaceAttribute"
 
 // ResetNetworkInterfaceAttributeRequest generates a request for the ResetNetworkInterfaceAttribute operation.
@@ -5852,6 +7563,16 @@ func (c *EC2) ResetNetworkInterfaceAttribute(input *ResetNetworkInterfaceAttribu
 	return out, err
 }
 
+// ResetNetworkInterfaceAttributeWithContext is the same as ResetNetworkInterfaceAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ResetNetworkInterfaceAttributeWithContext(ctx aws.Context, input *ResetNetworkInterfaceAttributeInput) (*ResetNetworkInterfaceAttributeOutput, error) {
+	req, out := c.ResetNetworkInterfaceAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResetSnapshotAttribute = "ResetSnapshotAttribute"
 
 // ResetSnapshotAttributeRequest generates a request for the ResetSnapshotAttribute operation.
@@ -5883,6 +7604,16 @@ func (c *EC2) ResetSnapshotAttribute(input *ResetSnapshotAttributeInput) (*Reset
 	return out, err
 }
 
+// ResetSnapshotAttributeWithContext is the same as ResetSnapshotAttribute with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) ResetSnapshotAttributeWithContext(ctx aws.Context, input *ResetSnapshotAttributeInput) (*ResetSnapshotAttributeOutput, error) {
+	req, out := c.ResetSnapshotAttributeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRestoreAddressToClassic = "RestoreAddressToClassic"
 
 // RestoreAddressToClassicRequest generates a request for the RestoreAddressToClassic operation.
@@ -5913,6 +7644,16 @@ func (c *EC2) RestoreAddressToClassic(input *RestoreAddressToClassicInput) (*Res
 	return out, err
 }
 
+// RestoreAddressToClassicWithContext is the same as RestoreAddressToClassic with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RestoreAddressToClassicWithContext(ctx aws.Context, input *RestoreAddressToClassicInput) (*RestoreAddressToClassicOutput, error) {
+	req, out := c.RestoreAddressToClassicRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRevokeSecurityGroupEgress = "RevokeSecurityGroupEgress"
 
 // RevokeSecurityGroupEgressRequest generates a request for the RevokeSecurityGroupEgress operation.
@@ -5950,6 +7691,16 @@ func (c *EC2) RevokeSecurityGroupEgress(input *RevokeSecurityGroupEgressInput) (
 	return out, err
 }
 
+// RevokeSecurityGroupEgressWithContext is the same as RevokeSecurityGroupEgress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RevokeSecurityGroupEgressWithContext(ctx aws.Context, input *RevokeSecurityGroupEgressInput) (*RevokeSecurityGroupEgressOutput, error) {
+	req, out := c.RevokeSecurityGroupEgressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRevokeSecurityGroupIngress = "RevokeSecurityGroupIngress"
 
 // RevokeSecurityGroupIngressRequest generates a request for the RevokeSecurityGroupIngress operation.
@@ -5987,6 +7738,16 @@ func (c *EC2) RevokeSecurityGroupIngress(input *RevokeSecurityGroupIngressInput)
 	return out, err
 }
 
+// RevokeSecurityGroupIngressWithContext is the same as RevokeSecurityGroupIngress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RevokeSecurityGroupIngressWithContext(ctx aws.Context, input *RevokeSecurityGroupIngressInput) (*RevokeSecurityGroupIngressOutput, error) {
+	req, out := c.RevokeSecurityGroupIngressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRunInstances = "RunInstances"
 
 // RunInstancesRequest generates a request for the RunInstances operation.
@@ -6046,6 +7807,16 @@ func (c *EC2) RunInstances(input *RunInstancesInput) (*Reservation, error) {
 	return out, err
 }
 
+// RunInstancesWithContext is the same as RunInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) RunInstancesWithContext(ctx aws.Context, input *RunInstancesInput) (*Reservation, error) {
+	req, out := c.RunInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStartInstances = "StartInstances"
 
 // StartInstancesRequest generates a request for the StartInstances operation.
@@ -6091,6 +7862,16 @@ func (c *EC2) StartInstances(input *StartInstancesInput) (*StartInstancesOutput,
 	return out, err
 }
 
+// StartInstancesWithContext is the same as StartInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) StartInstancesWithContext(ctx aws.Context, input *StartInstancesInput) (*StartInstancesOutput, error) {
+	req, out := c.StartInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStopInstances = "StopInstances"
 
 // StopInstancesRequest generates a request for the StopInstances operation.
@@ -6148,6 +7929,16 @@ func (c *EC2) StopInstances(input *StopInstancesInput) (*StopInstancesOutput, er
 	return out, err
 }
 
+// StopInstancesWithContext is the same as StopInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) StopInstancesWithContext(ctx aws.Context, input *StopInstancesInput) (*StopInstancesOutput, error) {
+	req, out := c.StopInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateInstances = "TerminateInstances"
 
 // TerminateInstancesRequest generates a request for the TerminateInstances operation.
@@ -6195,6 +7986,16 @@ func (c *EC2) TerminateInstances(input *TerminateInstancesInput) (*TerminateInst
 	return out, err
 }
 
+// TerminateInstancesWithContext is the same as TerminateInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) TerminateInstancesWithContext(ctx aws.Context, input *TerminateInstancesInput) (*TerminateInstancesOutput, error) {
+	req, out := c.TerminateInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnassignPrivateIPAddresses = "UnassignPrivateIpAddresses"
 
 // UnassignPrivateIPAddressesRequest generates a request for the UnassignPrivateIPAddresses operation.
@@ -6222,6 +8023,16 @@ func (c *EC2) UnassignPrivateIPAddresses(input *UnassignPrivateIPAddressesInput)
 	return out, err
 }
 
+// UnassignPrivateIPAddressesWithContext is the same as UnassignPrivateIPAddresses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) UnassignPrivateIPAddressesWithContext(ctx aws.Context, input *UnassignPrivateIPAddressesInput) (*UnassignPrivateIPAddressesOutput, error) {
+	req, out := c.UnassignPrivateIPAddressesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnmonitorInstances = "UnmonitorInstances"
 
 // UnmonitorInstancesRequest generates a request for the UnmonitorInstances operation.
@@ -6251,6 +8062,16 @@ func (c *EC2) UnmonitorInstances(input *UnmonitorInstancesInput) (*UnmonitorInst
 	return out, err
 }
 
+// UnmonitorInstancesWithContext is the same as UnmonitorInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EC2) UnmonitorInstancesWithContext(ctx aws.Context, input *UnmonitorInstancesInput) (*UnmonitorInstancesOutput, error) {
+	req, out := c.UnmonitorInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type AcceptVPCPeeringConnectionInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -6268,6 +8089,16 @@ type metadataAcceptVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AcceptVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AcceptVPCPeeringConnectionInput) GoString() string {
+	return s.String()
+}
+
 type AcceptVPCPeeringConnectionOutput struct {
 	// Information about the VPC peering connection.
 	VPCPeeringConnection *VPCPeeringConnection `locationName:"vpcPeeringConnection" type:"structure"`
@@ -6279,6 +8110,16 @@ type metadataAcceptVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AcceptVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AcceptVPCPeeringConnectionOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an account attribute.
 type AccountAttribute struct {
 	// The name of the account attribute.
@@ -6294,6 +8135,16 @@ type metadataAccountAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AccountAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AccountAttribute) GoString() string {
+	return s.String()
+}
+
 // Describes a value of an account attribute.
 type AccountAttributeValue struct {
 	// The value of the attribute.
@@ -6306,6 +8157,16 @@ type metadataAccountAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AccountAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AccountAttributeValue) GoString() string {
+	return s.String()
+}
+
 // Describes a running instance in a Spot fleet.
 type ActiveInstance struct {
 	// The ID of the instance.
@@ -6324,6 +8185,16 @@ type metadataActiveInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActiveInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActiveInstance) GoString() string {
+	return s.String()
+}
+
 // Describes an Elastic IP address.
 type Address struct {
 	// The ID representing the allocation of the address for use with EC2-VPC.
@@ -6335,7 +8206,9 @@ type Address struct {
 
 	// Indicates whether this Elastic IP address is for use with instances in EC2-Classic
 	// (standard) or instances in a VPC (vpc).
-	Domain *string `locationName:"domain" type:"string"`
+	//
+	// See the DomainType* constants for valid values.
+	Domain *string `locationName:"domain" type:"string" enum:"vpc,standard"`
 
 	// The ID of the instance that the address is associated with (if any).
 	InstanceID *string `locationName:"instanceId" type:"string"`
@@ -6359,11 +8232,23 @@ type metadataAddress struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Address) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Address) GoString() string {
+	return s.String()
+}
+
 type AllocateAddressInput struct {
 	// Set to vpc to allocate the address for use with instances in a VPC.
 	//
 	// Default: The address is for use with instances in EC2-Classic.
-	Domain *string `type:"string"`
+	//
+	// See the DomainType* constants for valid values.
+	Domain *string `type:"string" enum:"vpc,standard"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -6378,6 +8263,16 @@ type metadataAllocateAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AllocateAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AllocateAddressInput) GoString() string {
+	return s.String()
+}
+
 type AllocateAddressOutput struct {
 	// [EC2-VPC] The ID that AWS assigns to represent the allocation of the Elastic
 	// IP address for use with instances in a VPC.
@@ -6385,7 +8280,9 @@ type AllocateAddressOutput struct {
 
 	// Indicates whether this Elastic IP address is for use with instances in EC2-Classic
 	// (standard) or instances in a VPC (vpc).
-	Domain *string `locationName:"domain" type:"string"`
+	//
+	// See the DomainType* constants for valid values.
+	Domain *string `locationName:"domain" type:"string" enum:"vpc,standard"`
 
 	// The Elastic IP address.
 	PublicIP *string `locationName:"publicIp" type:"string"`
@@ -6397,6 +8294,16 @@ type metadataAllocateAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AllocateAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AllocateAddressOutput) GoString() string {
+	return s.String()
+}
+
 type AssignPrivateIPAddressesInput struct {
 	// Indicates whether to allow an IP address that is already assigned to another
 	// network interface or instance to be reassigned to the specified network interface.
@@ -6424,6 +8331,16 @@ type metadataAssignPrivateIPAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssignPrivateIPAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssignPrivateIPAddressesInput) GoString() string {
+	return s.String()
+}
+
 type AssignPrivateIPAddressesOutput struct {
 	metadataAssignPrivateIPAddressesOutput `json:"-" xml:"-"`
 }
@@ -6432,6 +8349,16 @@ type metadataAssignPrivateIPAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssignPrivateIPAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssignPrivateIPAddressesOutput) GoString() string {
+	return s.String()
+}
+
 type AssociateAddressInput struct {
 	// [EC2-VPC] The allocation ID. This is required for EC2-VPC.
 	AllocationID *string `locationName:"AllocationId" type:"string"`
@@ -6474,6 +8401,16 @@ type metadataAssociateAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateAddressInput) GoString() string {
+	return s.String()
+}
+
 type AssociateAddressOutput struct {
 	// [EC2-VPC] The ID that represents the association of the Elastic IP address
 	// with an instance.
@@ -6486,6 +8423,16 @@ type metadataAssociateAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateAddressOutput) GoString() string {
+	return s.String()
+}
+
 type AssociateDHCPOptionsInput struct {
 	// The ID of the DHCP options set, or default to associate no DHCP options with
 	// the VPC.
@@ -6507,6 +8454,16 @@ type metadataAssociateDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateDHCPOptionsInput) GoString() string {
+	return s.String()
+}
+
 type AssociateDHCPOptionsOutput struct {
 	metadataAssociateDHCPOptionsOutput `json:"-" xml:"-"`
 }
@@ -6515,6 +8472,16 @@ type metadataAssociateDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateDHCPOptionsOutput) GoString() string {
+	return s.String()
+}
+
 type AssociateRouteTableInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -6535,6 +8502,16 @@ type metadataAssociateRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateRouteTableInput) GoString() string {
+	return s.String()
+}
+
 type AssociateRouteTableOutput struct {
 	// The route table association ID (needed to disassociate the route table).
 	AssociationID *string `locationName:"associationId" type:"string"`
@@ -6546,6 +8523,16 @@ type metadataAssociateRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateRouteTableOutput) GoString() string {
+	return s.String()
+}
+
 type AttachClassicLinkVPCInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -6570,6 +8557,16 @@ type metadataAttachClassicLinkVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachClassicLinkVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachClassicLinkVPCInput) GoString() string {
+	return s.String()
+}
+
 type AttachClassicLinkVPCOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -6581,6 +8578,16 @@ type metadataAttachClassicLinkVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachClassicLinkVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachClassicLinkVPCOutput) GoString() string {
+	return s.String()
+}
+
 type AttachInternetGatewayInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -6601,6 +8608,16 @@ type metadataAttachInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachInternetGatewayInput) GoString() string {
+	return s.String()
+}
+
 type AttachInternetGatewayOutput struct {
 	metadataAttachInternetGatewayOutput `json:"-" xml:"-"`
 }
@@ -6609,6 +8626,16 @@ type metadataAttachInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachInternetGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type AttachNetworkInterfaceInput struct {
 	// The index of the device for the network interface attachment.
 	DeviceIndex *int64 `locationName:"deviceIndex" type:"integer" required:"true"`
@@ -6632,6 +8659,16 @@ type metadataAttachNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachNetworkInterfaceInput) GoString() string {
+	return s.String()
+}
+
 type AttachNetworkInterfaceOutput struct {
 	// The ID of the network interface attachment.
 	AttachmentID *string `locationName:"attachmentId" type:"string"`
@@ -6643,6 +8680,16 @@ type metadataAttachNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachNetworkInterfaceOutput) GoString() string {
+	return s.String()
+}
+
 type AttachVPNGatewayInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -6663,6 +8710,16 @@ type metadataAttachVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachVPNGatewayInput) GoString() string {
+	return s.String()
+}
+
 type AttachVPNGatewayOutput struct {
 	// Information about the attachment.
 	VPCAttachment *VPCAttachment `locationName:"attachment" type:"structure"`
@@ -6674,6 +8731,16 @@ type metadataAttachVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachVPNGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type AttachVolumeInput struct {
 	// The device name to expose to the instance (for example, /dev/sdh or xvdh).
 	Device *string `type:"string" required:"true"`
@@ -6698,6 +8765,16 @@ type metadataAttachVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachVolumeInput) GoString() string {
+	return s.String()
+}
+
 // The value to use when a resource attribute accepts a Boolean value.
 type AttributeBooleanValue struct {
 	// Valid values are true or false.
@@ -6710,6 +8787,16 @@ type metadataAttributeBooleanValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttributeBooleanValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttributeBooleanValue) GoString() string {
+	return s.String()
+}
+
 // The value to use for a resource attribute.
 type AttributeValue struct {
 	// Valid values are case-sensitive and vary by action.
@@ -6722,9 +8809,19 @@ type metadataAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type AuthorizeSecurityGroupEgressInput struct {
-	// The CIDR IP address range. You can't specify this parameter when specifying
-	// a source security group.
+// String returns the string representation
+func (s AttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttributeValue) GoString() string {
+	return s.String()
+}
+
+type AuthorizeSecurityGroupEgressInput struct {
+	// The CIDR IP address range. You can't specify this parameter when specifying
+	// a source security group.
 	CIDRIP *string `locationName:"cidrIp" type:"string"`
 
 	// Checks whether you have the required permissions for the action, without
@@ -6767,6 +8864,16 @@ type metadataAuthorizeSecurityGroupEgressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AuthorizeSecurityGroupEgressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AuthorizeSecurityGroupEgressInput) GoString() string {
+	return s.String()
+}
+
 type AuthorizeSecurityGroupEgressOutput struct {
 	metadataAuthorizeSecurityGroupEgressOutput `json:"-" xml:"-"`
 }
@@ -6775,6 +8882,16 @@ type metadataAuthorizeSecurityGroupEgressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AuthorizeSecurityGroupEgressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AuthorizeSecurityGroupEgressOutput) GoString() string {
+	return s.String()
+}
+
 type AuthorizeSecurityGroupIngressInput struct {
 	// The CIDR IP address range. You can't specify this parameter when specifying
 	// a source security group.
@@ -6826,6 +8943,16 @@ type metadataAuthorizeSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AuthorizeSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AuthorizeSecurityGroupIngressInput) GoString() string {
+	return s.String()
+}
+
 type AuthorizeSecurityGroupIngressOutput struct {
 	metadataAuthorizeSecurityGroupIngressOutput `json:"-" xml:"-"`
 }
@@ -6834,6 +8961,16 @@ type metadataAuthorizeSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AuthorizeSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AuthorizeSecurityGroupIngressOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an Availability Zone.
 type AvailabilityZone struct {
 	// Any messages about the Availability Zone.
@@ -6843,7 +8980,9 @@ type AvailabilityZone struct {
 	RegionName *string `locationName:"regionName" type:"string"`
 
 	// The state of the Availability Zone (available | impaired | unavailable).
-	State *string `locationName:"zoneState" type:"string"`
+	//
+	// See the AvailabilityZoneState* constants for valid values.
+	State *string `locationName:"zoneState" type:"string" enum:"available"`
 
 	// The name of the Availability Zone.
 	ZoneName *string `locationName:"zoneName" type:"string"`
@@ -6855,6 +8994,16 @@ type metadataAvailabilityZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AvailabilityZone) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AvailabilityZone) GoString() string {
+	return s.String()
+}
+
 // Describes a message about an Availability Zone.
 type AvailabilityZoneMessage struct {
 	// The message about the Availability Zone.
@@ -6867,6 +9016,16 @@ type metadataAvailabilityZoneMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AvailabilityZoneMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AvailabilityZoneMessage) GoString() string {
+	return s.String()
+}
+
 type BlobAttributeValue struct {
 	Value []byte `locationName:"value" type:"blob"`
 
@@ -6877,6 +9036,16 @@ type metadataBlobAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BlobAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BlobAttributeValue) GoString() string {
+	return s.String()
+}
+
 // Describes a block device mapping.
 type BlockDeviceMapping struct {
 	// The device name exposed to the instance (for example, /dev/sdh or xvdh).
@@ -6909,6 +9078,16 @@ type metadataBlockDeviceMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BlockDeviceMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BlockDeviceMapping) GoString() string {
+	return s.String()
+}
+
 type BundleInstanceInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -6937,6 +9116,16 @@ type metadataBundleInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BundleInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BundleInstanceInput) GoString() string {
+	return s.String()
+}
+
 type BundleInstanceOutput struct {
 	// Information about the bundle task.
 	BundleTask *BundleTask `locationName:"bundleInstanceTask" type:"structure"`
@@ -6948,6 +9137,16 @@ type metadataBundleInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BundleInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BundleInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a bundle task.
 type BundleTask struct {
 	// The ID of the bundle task.
@@ -6966,7 +9165,9 @@ type BundleTask struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state of the task.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the BundleTaskState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"pending,waiting-for-shutdown,bundling,storing,cancelling,complete,failed"`
 
 	// The Amazon S3 storage locations.
 	Storage *Storage `locationName:"storage" type:"structure"`
@@ -6981,6 +9182,16 @@ type metadataBundleTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BundleTask) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BundleTask) GoString() string {
+	return s.String()
+}
+
 // Describes an error for BundleInstance.
 type BundleTaskError struct {
 	// The error code.
@@ -6996,6 +9207,16 @@ type metadataBundleTaskError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BundleTaskError) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BundleTaskError) GoString() string {
+	return s.String()
+}
+
 type CancelBundleTaskInput struct {
 	// The ID of the bundle task.
 	BundleID *string `locationName:"BundleId" type:"string" required:"true"`
@@ -7013,6 +9234,16 @@ type metadataCancelBundleTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelBundleTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelBundleTaskInput) GoString() string {
+	return s.String()
+}
+
 type CancelBundleTaskOutput struct {
 	// Information about the bundle task.
 	BundleTask *BundleTask `locationName:"bundleInstanceTask" type:"structure"`
@@ -7024,6 +9255,16 @@ type metadataCancelBundleTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelBundleTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelBundleTaskOutput) GoString() string {
+	return s.String()
+}
+
 type CancelConversionTaskInput struct {
 	// The ID of the conversion task.
 	ConversionTaskID *string `locationName:"conversionTaskId" type:"string" required:"true"`
@@ -7044,6 +9285,16 @@ type metadataCancelConversionTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelConversionTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelConversionTaskInput) GoString() string {
+	return s.String()
+}
+
 type CancelConversionTaskOutput struct {
 	metadataCancelConversionTaskOutput `json:"-" xml:"-"`
 }
@@ -7052,6 +9303,16 @@ type metadataCancelConversionTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelConversionTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelConversionTaskOutput) GoString() string {
+	return s.String()
+}
+
 type CancelExportTaskInput struct {
 	// The ID of the export task. This is the ID returned by CreateInstanceExportTask.
 	ExportTaskID *string `locationName:"exportTaskId" type:"string" required:"true"`
@@ -7063,6 +9324,16 @@ type metadataCancelExportTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelExportTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelExportTaskInput) GoString() string {
+	return s.String()
+}
+
 type CancelExportTaskOutput struct {
 	metadataCancelExportTaskOutput `json:"-" xml:"-"`
 }
@@ -7071,6 +9342,16 @@ type metadataCancelExportTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelExportTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelExportTaskOutput) GoString() string {
+	return s.String()
+}
+
 type CancelImportTaskInput struct {
 	// The reason for canceling the task.
 	CancelReason *string `type:"string"`
@@ -7091,6 +9372,16 @@ type metadataCancelImportTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelImportTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelImportTaskInput) GoString() string {
+	return s.String()
+}
+
 type CancelImportTaskOutput struct {
 	// The ID of the task being canceled.
 	ImportTaskID *string `locationName:"importTaskId" type:"string"`
@@ -7108,6 +9399,16 @@ type metadataCancelImportTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelImportTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelImportTaskOutput) GoString() string {
+	return s.String()
+}
+
 type CancelReservedInstancesListingInput struct {
 	// The ID of the Reserved Instance listing.
 	ReservedInstancesListingID *string `locationName:"reservedInstancesListingId" type:"string" required:"true"`
@@ -7119,6 +9420,16 @@ type metadataCancelReservedInstancesListingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelReservedInstancesListingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelReservedInstancesListingInput) GoString() string {
+	return s.String()
+}
+
 type CancelReservedInstancesListingOutput struct {
 	// The Reserved Instance listing.
 	ReservedInstancesListings []*ReservedInstancesListing `locationName:"reservedInstancesListingsSet" locationNameList:"item" type:"list"`
@@ -7130,10 +9441,22 @@ type metadataCancelReservedInstancesListingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelReservedInstancesListingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelReservedInstancesListingOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a Spot fleet error.
 type CancelSpotFleetRequestsError struct {
 	// The error code.
-	Code *string `locationName:"code" type:"string" required:"true"`
+	//
+	// See the CancelBatchErrorCode* constants for valid values.
+	Code *string `locationName:"code" type:"string" enum:"fleetRequestIdDoesNotExist,fleetRequestIdMalformed,fleetRequestNotInCancellableState,unexpectedError" required:"true"`
 
 	// The description for the error code.
 	Message *string `locationName:"message" type:"string" required:"true"`
@@ -7145,6 +9468,16 @@ type metadataCancelSpotFleetRequestsError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelSpotFleetRequestsError) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelSpotFleetRequestsError) GoString() string {
+	return s.String()
+}
+
 // Describes a Spot fleet request that was not successfully canceled.
 type CancelSpotFleetRequestsErrorItem struct {
 	// The error.
@@ -7160,6 +9493,16 @@ type metadataCancelSpotFleetRequestsErrorItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelSpotFleetRequestsErrorItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelSpotFleetRequestsErrorItem) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for CancelSpotFleetRequests.
 type CancelSpotFleetRequestsInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -7182,6 +9525,16 @@ type metadataCancelSpotFleetRequestsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelSpotFleetRequestsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelSpotFleetRequestsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of CancelSpotFleetRequests.
 type CancelSpotFleetRequestsOutput struct {
 	// Information about the Spot fleet requests that are successfully canceled.
@@ -7197,13 +9550,27 @@ type metadataCancelSpotFleetRequestsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelSpotFleetRequestsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelSpotFleetRequestsOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a Spot fleet request that was successfully canceled.
 type CancelSpotFleetRequestsSuccessItem struct {
 	// The current state of the Spot fleet request.
-	CurrentSpotFleetRequestState *string `locationName:"currentSpotFleetRequestState" type:"string" required:"true"`
+	//
+	// See the BatchState* constants for valid values.
+	CurrentSpotFleetRequestState *string `locationName:"currentSpotFleetRequestState" type:"string" enum:"submitted,active,cancelled,failed,cancelled_running,cancelled_terminating" required:"true"`
 
 	// The previous state of the Spot fleet request.
-	PreviousSpotFleetRequestState *string `locationName:"previousSpotFleetRequestState" type:"string" required:"true"`
+	//
+	// See the BatchState* constants for valid values.
+	PreviousSpotFleetRequestState *string `locationName:"previousSpotFleetRequestState" type:"string" enum:"submitted,active,cancelled,failed,cancelled_running,cancelled_terminating" required:"true"`
 
 	// The ID of the Spot fleet request.
 	SpotFleetRequestID *string `locationName:"spotFleetRequestId" type:"string" required:"true"`
@@ -7215,6 +9582,16 @@ type metadataCancelSpotFleetRequestsSuccessItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelSpotFleetRequestsSuccessItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelSpotFleetRequestsSuccessItem) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for CancelSpotInstanceRequests.
 type CancelSpotInstanceRequestsInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -7233,6 +9610,16 @@ type metadataCancelSpotInstanceRequestsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelSpotInstanceRequestsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelSpotInstanceRequestsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of CancelSpotInstanceRequests.
 type CancelSpotInstanceRequestsOutput struct {
 	// One or more Spot Instance requests.
@@ -7245,13 +9632,25 @@ type metadataCancelSpotInstanceRequestsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelSpotInstanceRequestsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelSpotInstanceRequestsOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a request to cancel a Spot Instance.
 type CancelledSpotInstanceRequest struct {
 	// The ID of the Spot Instance request.
 	SpotInstanceRequestID *string `locationName:"spotInstanceRequestId" type:"string"`
 
 	// The state of the Spot Instance request.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the CancelSpotInstanceRequestState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"active,open,closed,cancelled,completed"`
 
 	metadataCancelledSpotInstanceRequest `json:"-" xml:"-"`
 }
@@ -7260,6 +9659,16 @@ type metadataCancelledSpotInstanceRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelledSpotInstanceRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelledSpotInstanceRequest) GoString() string {
+	return s.String()
+}
+
 // Describes a linked EC2-Classic instance.
 type ClassicLinkInstance struct {
 	// A list of security groups.
@@ -7281,6 +9690,16 @@ type metadataClassicLinkInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ClassicLinkInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ClassicLinkInstance) GoString() string {
+	return s.String()
+}
+
 // Describes the client-specific data.
 type ClientData struct {
 	// A user-defined comment about the disk upload.
@@ -7302,6 +9721,16 @@ type metadataClientData struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ClientData) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ClientData) GoString() string {
+	return s.String()
+}
+
 type ConfirmProductInstanceInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -7322,6 +9751,16 @@ type metadataConfirmProductInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmProductInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmProductInstanceInput) GoString() string {
+	return s.String()
+}
+
 type ConfirmProductInstanceOutput struct {
 	// The AWS account ID of the instance owner. This is only present if the product
 	// code is attached to the instance.
@@ -7334,6 +9773,16 @@ type metadataConfirmProductInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmProductInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmProductInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a conversion task.
 type ConversionTask struct {
 	// The ID of the conversion task.
@@ -7352,7 +9801,9 @@ type ConversionTask struct {
 	ImportVolume *ImportVolumeTaskDetails `locationName:"importVolume" type:"structure"`
 
 	// The state of the conversion task.
-	State *string `locationName:"state" type:"string" required:"true"`
+	//
+	// See the ConversionTaskState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"active,cancelling,cancelled,completed" required:"true"`
 
 	// The status message related to the conversion task.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -7367,6 +9818,16 @@ type metadataConversionTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConversionTask) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConversionTask) GoString() string {
+	return s.String()
+}
+
 type CopyImageInput struct {
 	// Unique, case-sensitive identifier you provide to ensure idempotency of the
 	// request. For more information, see How to Ensure Idempotency (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Run_Instance_Idempotency.html)
@@ -7398,6 +9859,16 @@ type metadataCopyImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CopyImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CopyImageInput) GoString() string {
+	return s.String()
+}
+
 type CopyImageOutput struct {
 	// The ID of the new AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -7409,6 +9880,16 @@ type metadataCopyImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CopyImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CopyImageOutput) GoString() string {
+	return s.String()
+}
+
 type CopySnapshotInput struct {
 	// A description for the EBS snapshot.
 	Description *string `type:"string"`
@@ -7456,6 +9937,16 @@ type metadataCopySnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CopySnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CopySnapshotInput) GoString() string {
+	return s.String()
+}
+
 type CopySnapshotOutput struct {
 	// The ID of the new snapshot.
 	SnapshotID *string `locationName:"snapshotId" type:"string"`
@@ -7467,6 +9958,16 @@ type metadataCopySnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CopySnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CopySnapshotOutput) GoString() string {
+	return s.String()
+}
+
 type CreateCustomerGatewayInput struct {
 	// For devices that support BGP, the customer gateway's BGP ASN.
 	//
@@ -7484,7 +9985,9 @@ type CreateCustomerGatewayInput struct {
 	PublicIP *string `locationName:"IpAddress" type:"string" required:"true"`
 
 	// The type of VPN connection that this customer gateway supports (ipsec.1).
-	Type *string `type:"string" required:"true"`
+	//
+	// See the GatewayType* constants for valid values.
+	Type *string `type:"string" enum:"ipsec.1" required:"true"`
 
 	metadataCreateCustomerGatewayInput `json:"-" xml:"-"`
 }
@@ -7493,6 +9996,16 @@ type metadataCreateCustomerGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCustomerGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCustomerGatewayInput) GoString() string {
+	return s.String()
+}
+
 type CreateCustomerGatewayOutput struct {
 	// Information about the customer gateway.
 	CustomerGateway *CustomerGateway `locationName:"customerGateway" type:"structure"`
@@ -7504,6 +10017,16 @@ type metadataCreateCustomerGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCustomerGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCustomerGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type CreateDHCPOptionsInput struct {
 	// A DHCP configuration option.
 	DHCPConfigurations []*NewDHCPConfiguration `locationName:"dhcpConfiguration" locationNameList:"item" type:"list" required:"true"`
@@ -7521,6 +10044,16 @@ type metadataCreateDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDHCPOptionsInput) GoString() string {
+	return s.String()
+}
+
 type CreateDHCPOptionsOutput struct {
 	// A set of DHCP options.
 	DHCPOptions *DHCPOptions `locationName:"dhcpOptions" type:"structure"`
@@ -7532,6 +10065,16 @@ type metadataCreateDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDHCPOptionsOutput) GoString() string {
+	return s.String()
+}
+
 type CreateFlowLogsInput struct {
 	// Unique, case-sensitive identifier you provide to ensure the idempotency of
 	// the request. For more information, see How to Ensure Idempotency (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Run_Instance_Idempotency.html).
@@ -7548,10 +10091,14 @@ type CreateFlowLogsInput struct {
 	ResourceIDs []*string `locationName:"ResourceId" locationNameList:"item" type:"list" required:"true"`
 
 	// The type of resource on which to create the flow log.
-	ResourceType *string `type:"string" required:"true"`
+	//
+	// See the FlowLogsResourceType* constants for valid values.
+	ResourceType *string `type:"string" enum:"VPC,Subnet,NetworkInterface" required:"true"`
 
 	// The type of traffic to log.
-	TrafficType *string `type:"string" required:"true"`
+	//
+	// See the TrafficType* constants for valid values.
+	TrafficType *string `type:"string" enum:"ACCEPT,REJECT,ALL" required:"true"`
 
 	metadataCreateFlowLogsInput `json:"-" xml:"-"`
 }
@@ -7560,6 +10107,16 @@ type metadataCreateFlowLogsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateFlowLogsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateFlowLogsInput) GoString() string {
+	return s.String()
+}
+
 type CreateFlowLogsOutput struct {
 	// Unique, case-sensitive identifier you provide to ensure the idempotency of
 	// the request.
@@ -7578,6 +10135,16 @@ type metadataCreateFlowLogsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateFlowLogsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateFlowLogsOutput) GoString() string {
+	return s.String()
+}
+
 type CreateImageInput struct {
 	// Information about one or more block device mappings.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"BlockDeviceMapping" type:"list"`
@@ -7615,6 +10182,16 @@ type metadataCreateImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateImageInput) GoString() string {
+	return s.String()
+}
+
 type CreateImageOutput struct {
 	// The ID of the new AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -7626,6 +10203,16 @@ type metadataCreateImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateImageOutput) GoString() string {
+	return s.String()
+}
+
 type CreateInstanceExportTaskInput struct {
 	// A description for the conversion task or the resource being exported. The
 	// maximum length is 255 bytes.
@@ -7638,7 +10225,9 @@ type CreateInstanceExportTaskInput struct {
 	InstanceID *string `locationName:"instanceId" type:"string" required:"true"`
 
 	// The target virtualization environment.
-	TargetEnvironment *string `locationName:"targetEnvironment" type:"string"`
+	//
+	// See the ExportEnvironment* constants for valid values.
+	TargetEnvironment *string `locationName:"targetEnvironment" type:"string" enum:"citrix,vmware,microsoft"`
 
 	metadataCreateInstanceExportTaskInput `json:"-" xml:"-"`
 }
@@ -7647,6 +10236,16 @@ type metadataCreateInstanceExportTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInstanceExportTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInstanceExportTaskInput) GoString() string {
+	return s.String()
+}
+
 type CreateInstanceExportTaskOutput struct {
 	// Information about the instance export task.
 	ExportTask *ExportTask `locationName:"exportTask" type:"structure"`
@@ -7658,6 +10257,16 @@ type metadataCreateInstanceExportTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInstanceExportTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInstanceExportTaskOutput) GoString() string {
+	return s.String()
+}
+
 type CreateInternetGatewayInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -7672,6 +10281,16 @@ type metadataCreateInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInternetGatewayInput) GoString() string {
+	return s.String()
+}
+
 type CreateInternetGatewayOutput struct {
 	// Information about the Internet gateway.
 	InternetGateway *InternetGateway `locationName:"internetGateway" type:"structure"`
@@ -7683,6 +10302,16 @@ type metadataCreateInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInternetGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type CreateKeyPairInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -7702,6 +10331,16 @@ type metadataCreateKeyPairInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateKeyPairInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateKeyPairInput) GoString() string {
+	return s.String()
+}
+
 // Describes a key pair.
 type CreateKeyPairOutput struct {
 	// The SHA-1 digest of the DER encoded private key.
@@ -7720,6 +10359,16 @@ type metadataCreateKeyPairOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateKeyPairOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateKeyPairOutput) GoString() string {
+	return s.String()
+}
+
 type CreateNetworkACLEntryInput struct {
 	// The network range to allow or deny, in CIDR notation (for example 172.16.0.0/24).
 	CIDRBlock *string `locationName:"cidrBlock" type:"string" required:"true"`
@@ -7748,7 +10397,9 @@ type CreateNetworkACLEntryInput struct {
 	Protocol *string `locationName:"protocol" type:"string" required:"true"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string" required:"true"`
+	//
+	// See the RuleAction* constants for valid values.
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny" required:"true"`
 
 	// The rule number for the entry (for example, 100). ACL entries are processed
 	// in ascending order by rule number.
@@ -7763,6 +10414,16 @@ type metadataCreateNetworkACLEntryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateNetworkACLEntryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateNetworkACLEntryInput) GoString() string {
+	return s.String()
+}
+
 type CreateNetworkACLEntryOutput struct {
 	metadataCreateNetworkACLEntryOutput `json:"-" xml:"-"`
 }
@@ -7771,6 +10432,16 @@ type metadataCreateNetworkACLEntryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateNetworkACLEntryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateNetworkACLEntryOutput) GoString() string {
+	return s.String()
+}
+
 type CreateNetworkACLInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -7788,6 +10459,16 @@ type metadataCreateNetworkACLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateNetworkACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateNetworkACLInput) GoString() string {
+	return s.String()
+}
+
 type CreateNetworkACLOutput struct {
 	// Information about the network ACL.
 	NetworkACL *NetworkACL `locationName:"networkAcl" type:"structure"`
@@ -7799,6 +10480,16 @@ type metadataCreateNetworkACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateNetworkACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateNetworkACLOutput) GoString() string {
+	return s.String()
+}
+
 type CreateNetworkInterfaceInput struct {
 	// A description for the network interface.
 	Description *string `locationName:"description" type:"string"`
@@ -7842,6 +10533,16 @@ type metadataCreateNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateNetworkInterfaceInput) GoString() string {
+	return s.String()
+}
+
 type CreateNetworkInterfaceOutput struct {
 	// Information about the network interface.
 	NetworkInterface *NetworkInterface `locationName:"networkInterface" type:"structure"`
@@ -7853,7 +10554,17 @@ type metadataCreateNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type CreatePlacementGroupInput struct {
+// String returns the string representation
+func (s CreateNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateNetworkInterfaceOutput) GoString() string {
+	return s.String()
+}
+
+type CreatePlacementGroupInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
 	// the required permissions, the error response is DryRunOperation. Otherwise,
@@ -7866,7 +10577,9 @@ type CreatePlacementGroupInput struct {
 	GroupName *string `locationName:"groupName" type:"string" required:"true"`
 
 	// The placement strategy.
-	Strategy *string `locationName:"strategy" type:"string" required:"true"`
+	//
+	// See the PlacementStrategy* constants for valid values.
+	Strategy *string `locationName:"strategy" type:"string" enum:"cluster" required:"true"`
 
 	metadataCreatePlacementGroupInput `json:"-" xml:"-"`
 }
@@ -7875,6 +10588,16 @@ type metadataCreatePlacementGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePlacementGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePlacementGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreatePlacementGroupOutput struct {
 	metadataCreatePlacementGroupOutput `json:"-" xml:"-"`
 }
@@ -7883,6 +10606,16 @@ type metadataCreatePlacementGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePlacementGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePlacementGroupOutput) GoString() string {
+	return s.String()
+}
+
 type CreateReservedInstancesListingInput struct {
 	// Unique, case-sensitive identifier you provide to ensure idempotency of your
 	// listings. This helps avoid duplicate listings. For more information, see
@@ -7909,6 +10642,16 @@ type metadataCreateReservedInstancesListingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateReservedInstancesListingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateReservedInstancesListingInput) GoString() string {
+	return s.String()
+}
+
 type CreateReservedInstancesListingOutput struct {
 	// Information about the Reserved Instances listing.
 	ReservedInstancesListings []*ReservedInstancesListing `locationName:"reservedInstancesListingsSet" locationNameList:"item" type:"list"`
@@ -7920,6 +10663,16 @@ type metadataCreateReservedInstancesListingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateReservedInstancesListingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateReservedInstancesListingOutput) GoString() string {
+	return s.String()
+}
+
 type CreateRouteInput struct {
 	// Unique, case-sensitive identifier you provide to ensure the idempotency of
 	// the request. For more information, see How to Ensure Idempotency (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/Run_Instance_Idempotency.html).
@@ -7959,6 +10712,16 @@ type metadataCreateRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRouteInput) GoString() string {
+	return s.String()
+}
+
 type CreateRouteOutput struct {
 	// Unique, case-sensitive identifier you provide to ensure the idempotency of
 	// the request.
@@ -7974,6 +10737,16 @@ type metadataCreateRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRouteOutput) GoString() string {
+	return s.String()
+}
+
 type CreateRouteTableInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -7991,6 +10764,16 @@ type metadataCreateRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRouteTableInput) GoString() string {
+	return s.String()
+}
+
 type CreateRouteTableOutput struct {
 	// Information about the route table.
 	RouteTable *RouteTable `locationName:"routeTable" type:"structure"`
@@ -8002,6 +10785,16 @@ type metadataCreateRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRouteTableOutput) GoString() string {
+	return s.String()
+}
+
 type CreateSecurityGroupInput struct {
 	// A description for the security group. This is informational only.
 	//
@@ -8037,6 +10830,16 @@ type metadataCreateSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSecurityGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreateSecurityGroupOutput struct {
 	// The ID of the security group.
 	GroupID *string `locationName:"groupId" type:"string"`
@@ -8048,6 +10851,16 @@ type metadataCreateSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSecurityGroupOutput) GoString() string {
+	return s.String()
+}
+
 type CreateSnapshotInput struct {
 	// A description for the snapshot.
 	Description *string `type:"string"`
@@ -8068,6 +10881,16 @@ type metadataCreateSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotInput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for CreateSpotDatafeedSubscription.
 type CreateSpotDatafeedSubscriptionInput struct {
 	// The Amazon S3 bucket in which to store the Spot Instance data feed.
@@ -8089,6 +10912,16 @@ type metadataCreateSpotDatafeedSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSpotDatafeedSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSpotDatafeedSubscriptionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of CreateSpotDatafeedSubscription.
 type CreateSpotDatafeedSubscriptionOutput struct {
 	// The Spot Instance data feed subscription.
@@ -8101,6 +10934,16 @@ type metadataCreateSpotDatafeedSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSpotDatafeedSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSpotDatafeedSubscriptionOutput) GoString() string {
+	return s.String()
+}
+
 type CreateSubnetInput struct {
 	// The Availability Zone for the subnet.
 	//
@@ -8126,6 +10969,16 @@ type metadataCreateSubnetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSubnetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSubnetInput) GoString() string {
+	return s.String()
+}
+
 type CreateSubnetOutput struct {
 	// Information about the subnet.
 	Subnet *Subnet `locationName:"subnet" type:"structure"`
@@ -8137,6 +10990,16 @@ type metadataCreateSubnetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSubnetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSubnetOutput) GoString() string {
+	return s.String()
+}
+
 type CreateTagsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8159,6 +11022,16 @@ type metadataCreateTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTagsInput) GoString() string {
+	return s.String()
+}
+
 type CreateTagsOutput struct {
 	metadataCreateTagsOutput `json:"-" xml:"-"`
 }
@@ -8167,6 +11040,16 @@ type metadataCreateTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTagsOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVPCEndpointInput struct {
 	// Unique, case-sensitive identifier you provide to ensure the idempotency of
 	// the request. For more information, see How to Ensure Idempotency (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/Run_Instance_Idempotency.html).
@@ -8200,6 +11083,16 @@ type metadataCreateVPCEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPCEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPCEndpointInput) GoString() string {
+	return s.String()
+}
+
 type CreateVPCEndpointOutput struct {
 	// Unique, case-sensitive identifier you provide to ensure the idempotency of
 	// the request.
@@ -8215,6 +11108,16 @@ type metadataCreateVPCEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPCEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPCEndpointOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVPCInput struct {
 	// The network range for the VPC, in CIDR notation. For example, 10.0.0.0/16.
 	CIDRBlock *string `locationName:"CidrBlock" type:"string" required:"true"`
@@ -8232,7 +11135,9 @@ type CreateVPCInput struct {
 	// Dedicated tenancy instances run on single-tenant hardware.
 	//
 	// Default: default
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	//
+	// See the Tenancy* constants for valid values.
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	metadataCreateVPCInput `json:"-" xml:"-"`
 }
@@ -8241,6 +11146,16 @@ type metadataCreateVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPCInput) GoString() string {
+	return s.String()
+}
+
 type CreateVPCOutput struct {
 	// Information about the VPC.
 	VPC *VPC `locationName:"vpc" type:"structure"`
@@ -8252,6 +11167,16 @@ type metadataCreateVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPCOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVPCPeeringConnectionInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8277,6 +11202,16 @@ type metadataCreateVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPCPeeringConnectionInput) GoString() string {
+	return s.String()
+}
+
 type CreateVPCPeeringConnectionOutput struct {
 	// Information about the VPC peering connection.
 	VPCPeeringConnection *VPCPeeringConnection `locationName:"vpcPeeringConnection" type:"structure"`
@@ -8288,6 +11223,16 @@ type metadataCreateVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPCPeeringConnectionOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVPNConnectionInput struct {
 	// The ID of the customer gateway.
 	CustomerGatewayID *string `locationName:"CustomerGatewayId" type:"string" required:"true"`
@@ -8318,6 +11263,16 @@ type metadataCreateVPNConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPNConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPNConnectionInput) GoString() string {
+	return s.String()
+}
+
 type CreateVPNConnectionOutput struct {
 	// Information about the VPN connection.
 	VPNConnection *VPNConnection `locationName:"vpnConnection" type:"structure"`
@@ -8329,6 +11284,16 @@ type metadataCreateVPNConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPNConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPNConnectionOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVPNConnectionRouteInput struct {
 	// The CIDR block associated with the local subnet of the customer network.
 	DestinationCIDRBlock *string `locationName:"DestinationCidrBlock" type:"string" required:"true"`
@@ -8343,6 +11308,16 @@ type metadataCreateVPNConnectionRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPNConnectionRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPNConnectionRouteInput) GoString() string {
+	return s.String()
+}
+
 type CreateVPNConnectionRouteOutput struct {
 	metadataCreateVPNConnectionRouteOutput `json:"-" xml:"-"`
 }
@@ -8351,6 +11326,16 @@ type metadataCreateVPNConnectionRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPNConnectionRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPNConnectionRouteOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVPNGatewayInput struct {
 	// The Availability Zone for the virtual private gateway.
 	AvailabilityZone *string `type:"string"`
@@ -8362,7 +11347,9 @@ type CreateVPNGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
 	// The type of VPN connection this virtual private gateway supports.
-	Type *string `type:"string" required:"true"`
+	//
+	// See the GatewayType* constants for valid values.
+	Type *string `type:"string" enum:"ipsec.1" required:"true"`
 
 	metadataCreateVPNGatewayInput `json:"-" xml:"-"`
 }
@@ -8371,6 +11358,16 @@ type metadataCreateVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPNGatewayInput) GoString() string {
+	return s.String()
+}
+
 type CreateVPNGatewayOutput struct {
 	// Information about the virtual private gateway.
 	VPNGateway *VPNGateway `locationName:"vpnGateway" type:"structure"`
@@ -8382,6 +11379,16 @@ type metadataCreateVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVPNGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVolumeInput struct {
 	// The Availability Zone in which to create the volume. Use DescribeAvailabilityZones
 	// to list the Availability Zones that are currently available to you.
@@ -8434,7 +11441,9 @@ type CreateVolumeInput struct {
 	// Provisioned IOPS (SSD) volumes, or standard for Magnetic volumes.
 	//
 	// Default: standard
-	VolumeType *string `type:"string"`
+	//
+	// See the VolumeType* constants for valid values.
+	VolumeType *string `type:"string" enum:"standard,io1,gp2"`
 
 	metadataCreateVolumeInput `json:"-" xml:"-"`
 }
@@ -8443,12 +11452,24 @@ type metadataCreateVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVolumeInput) GoString() string {
+	return s.String()
+}
+
 // Describes the user or group to be added or removed from the permissions for
 // a volume.
 type CreateVolumePermission struct {
 	// The specific group that is to be added or removed from a volume's list of
 	// create volume permissions.
-	Group *string `locationName:"group" type:"string"`
+	//
+	// See the PermissionGroup* constants for valid values.
+	Group *string `locationName:"group" type:"string" enum:"all"`
 
 	// The specific AWS account ID that is to be added or removed from a volume's
 	// list of create volume permissions.
@@ -8461,6 +11482,16 @@ type metadataCreateVolumePermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVolumePermission) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVolumePermission) GoString() string {
+	return s.String()
+}
+
 // Describes modifications to the permissions for a volume.
 type CreateVolumePermissionModifications struct {
 	// Adds a specific AWS account ID or group to a volume's list of create volume
@@ -8478,6 +11509,16 @@ type metadataCreateVolumePermissionModifications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVolumePermissionModifications) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVolumePermissionModifications) GoString() string {
+	return s.String()
+}
+
 // Describes a customer gateway.
 type CustomerGateway struct {
 	// The customer gateway's Border Gateway Protocol (BGP) Autonomous System Number
@@ -8507,6 +11548,16 @@ type metadataCustomerGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CustomerGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CustomerGateway) GoString() string {
+	return s.String()
+}
+
 // Describes a DHCP configuration option.
 type DHCPConfiguration struct {
 	// The name of a DHCP option.
@@ -8522,6 +11573,16 @@ type metadataDHCPConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DHCPConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DHCPConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes a set of DHCP options.
 type DHCPOptions struct {
 	// One or more DHCP options in the set.
@@ -8540,6 +11601,16 @@ type metadataDHCPOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DHCPOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DHCPOptions) GoString() string {
+	return s.String()
+}
+
 type DeleteCustomerGatewayInput struct {
 	// The ID of the customer gateway.
 	CustomerGatewayID *string `locationName:"CustomerGatewayId" type:"string" required:"true"`
@@ -8557,6 +11628,16 @@ type metadataDeleteCustomerGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCustomerGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCustomerGatewayInput) GoString() string {
+	return s.String()
+}
+
 type DeleteCustomerGatewayOutput struct {
 	metadataDeleteCustomerGatewayOutput `json:"-" xml:"-"`
 }
@@ -8565,6 +11646,16 @@ type metadataDeleteCustomerGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCustomerGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCustomerGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteDHCPOptionsInput struct {
 	// The ID of the DHCP options set.
 	DHCPOptionsID *string `locationName:"DhcpOptionsId" type:"string" required:"true"`
@@ -8582,6 +11673,16 @@ type metadataDeleteDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDHCPOptionsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteDHCPOptionsOutput struct {
 	metadataDeleteDHCPOptionsOutput `json:"-" xml:"-"`
 }
@@ -8590,6 +11691,16 @@ type metadataDeleteDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDHCPOptionsOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteFlowLogsInput struct {
 	// One or more flow log IDs.
 	FlowLogIDs []*string `locationName:"FlowLogId" locationNameList:"item" type:"list" required:"true"`
@@ -8601,6 +11712,16 @@ type metadataDeleteFlowLogsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteFlowLogsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteFlowLogsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteFlowLogsOutput struct {
 	// Information about the flow logs that could not be deleted successfully.
 	Unsuccessful []*UnsuccessfulItem `locationName:"unsuccessful" locationNameList:"item" type:"list"`
@@ -8612,6 +11733,16 @@ type metadataDeleteFlowLogsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteFlowLogsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteFlowLogsOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteInternetGatewayInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8629,6 +11760,16 @@ type metadataDeleteInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInternetGatewayInput) GoString() string {
+	return s.String()
+}
+
 type DeleteInternetGatewayOutput struct {
 	metadataDeleteInternetGatewayOutput `json:"-" xml:"-"`
 }
@@ -8637,6 +11778,16 @@ type metadataDeleteInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInternetGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteKeyPairInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8654,6 +11805,16 @@ type metadataDeleteKeyPairInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteKeyPairInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteKeyPairInput) GoString() string {
+	return s.String()
+}
+
 type DeleteKeyPairOutput struct {
 	metadataDeleteKeyPairOutput `json:"-" xml:"-"`
 }
@@ -8662,6 +11823,16 @@ type metadataDeleteKeyPairOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteKeyPairOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteKeyPairOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteNetworkACLEntryInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8685,6 +11856,16 @@ type metadataDeleteNetworkACLEntryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNetworkACLEntryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNetworkACLEntryInput) GoString() string {
+	return s.String()
+}
+
 type DeleteNetworkACLEntryOutput struct {
 	metadataDeleteNetworkACLEntryOutput `json:"-" xml:"-"`
 }
@@ -8693,6 +11874,16 @@ type metadataDeleteNetworkACLEntryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNetworkACLEntryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNetworkACLEntryOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteNetworkACLInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8710,6 +11901,16 @@ type metadataDeleteNetworkACLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNetworkACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNetworkACLInput) GoString() string {
+	return s.String()
+}
+
 type DeleteNetworkACLOutput struct {
 	metadataDeleteNetworkACLOutput `json:"-" xml:"-"`
 }
@@ -8718,6 +11919,16 @@ type metadataDeleteNetworkACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNetworkACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNetworkACLOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteNetworkInterfaceInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8735,6 +11946,16 @@ type metadataDeleteNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNetworkInterfaceInput) GoString() string {
+	return s.String()
+}
+
 type DeleteNetworkInterfaceOutput struct {
 	metadataDeleteNetworkInterfaceOutput `json:"-" xml:"-"`
 }
@@ -8743,6 +11964,16 @@ type metadataDeleteNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteNetworkInterfaceOutput) GoString() string {
+	return s.String()
+}
+
 type DeletePlacementGroupInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8760,6 +11991,16 @@ type metadataDeletePlacementGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePlacementGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePlacementGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeletePlacementGroupOutput struct {
 	metadataDeletePlacementGroupOutput `json:"-" xml:"-"`
 }
@@ -8768,6 +12009,16 @@ type metadataDeletePlacementGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePlacementGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePlacementGroupOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteRouteInput struct {
 	// The CIDR range for the route. The value you specify must match the CIDR for
 	// the route exactly.
@@ -8789,6 +12040,16 @@ type metadataDeleteRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRouteInput) GoString() string {
+	return s.String()
+}
+
 type DeleteRouteOutput struct {
 	metadataDeleteRouteOutput `json:"-" xml:"-"`
 }
@@ -8797,6 +12058,16 @@ type metadataDeleteRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRouteOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteRouteTableInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8814,6 +12085,16 @@ type metadataDeleteRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRouteTableInput) GoString() string {
+	return s.String()
+}
+
 type DeleteRouteTableOutput struct {
 	metadataDeleteRouteTableOutput `json:"-" xml:"-"`
 }
@@ -8822,6 +12103,16 @@ type metadataDeleteRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRouteTableOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteSecurityGroupInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8843,6 +12134,16 @@ type metadataDeleteSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSecurityGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSecurityGroupOutput struct {
 	metadataDeleteSecurityGroupOutput `json:"-" xml:"-"`
 }
@@ -8851,6 +12152,16 @@ type metadataDeleteSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSecurityGroupOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteSnapshotInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8868,6 +12179,16 @@ type metadataDeleteSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSnapshotOutput struct {
 	metadataDeleteSnapshotOutput `json:"-" xml:"-"`
 }
@@ -8876,6 +12197,16 @@ type metadataDeleteSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DeleteSpotDatafeedSubscription.
 type DeleteSpotDatafeedSubscriptionInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -8891,7 +12222,17 @@ type metadataDeleteSpotDatafeedSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DeleteSpotDatafeedSubscriptionOutput struct {
+// String returns the string representation
+func (s DeleteSpotDatafeedSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSpotDatafeedSubscriptionInput) GoString() string {
+	return s.String()
+}
+
+type DeleteSpotDatafeedSubscriptionOutput struct {
 	metadataDeleteSpotDatafeedSubscriptionOutput `json:"-" xml:"-"`
 }
 
@@ -8899,6 +12240,16 @@ type metadataDeleteSpotDatafeedSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSpotDatafeedSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSpotDatafeedSubscriptionOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteSubnetInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8916,6 +12267,16 @@ type metadataDeleteSubnetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSubnetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSubnetInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSubnetOutput struct {
 	metadataDeleteSubnetOutput `json:"-" xml:"-"`
 }
@@ -8924,6 +12285,16 @@ type metadataDeleteSubnetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSubnetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSubnetOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteTagsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8947,6 +12318,16 @@ type metadataDeleteTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteTagsOutput struct {
 	metadataDeleteTagsOutput `json:"-" xml:"-"`
 }
@@ -8955,6 +12336,16 @@ type metadataDeleteTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPCEndpointsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -8972,6 +12363,16 @@ type metadataDeleteVPCEndpointsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPCEndpointsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPCEndpointsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPCEndpointsOutput struct {
 	// Information about the endpoints that were not successfully deleted.
 	Unsuccessful []*UnsuccessfulItem `locationName:"unsuccessful" locationNameList:"item" type:"list"`
@@ -8983,6 +12384,16 @@ type metadataDeleteVPCEndpointsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPCEndpointsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPCEndpointsOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPCInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9000,6 +12411,16 @@ type metadataDeleteVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPCInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPCOutput struct {
 	metadataDeleteVPCOutput `json:"-" xml:"-"`
 }
@@ -9008,6 +12429,16 @@ type metadataDeleteVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPCOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPCPeeringConnectionInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9025,6 +12456,16 @@ type metadataDeleteVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPCPeeringConnectionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPCPeeringConnectionOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -9036,6 +12477,16 @@ type metadataDeleteVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPCPeeringConnectionOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPNConnectionInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9053,6 +12504,16 @@ type metadataDeleteVPNConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPNConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPNConnectionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPNConnectionOutput struct {
 	metadataDeleteVPNConnectionOutput `json:"-" xml:"-"`
 }
@@ -9061,6 +12522,16 @@ type metadataDeleteVPNConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPNConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPNConnectionOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPNConnectionRouteInput struct {
 	// The CIDR block associated with the local subnet of the customer network.
 	DestinationCIDRBlock *string `locationName:"DestinationCidrBlock" type:"string" required:"true"`
@@ -9075,6 +12546,16 @@ type metadataDeleteVPNConnectionRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPNConnectionRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPNConnectionRouteInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPNConnectionRouteOutput struct {
 	metadataDeleteVPNConnectionRouteOutput `json:"-" xml:"-"`
 }
@@ -9083,6 +12564,16 @@ type metadataDeleteVPNConnectionRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPNConnectionRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPNConnectionRouteOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPNGatewayInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9100,6 +12591,16 @@ type metadataDeleteVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPNGatewayInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVPNGatewayOutput struct {
 	metadataDeleteVPNGatewayOutput `json:"-" xml:"-"`
 }
@@ -9108,6 +12609,16 @@ type metadataDeleteVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVPNGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVolumeInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9125,6 +12636,16 @@ type metadataDeleteVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVolumeInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVolumeOutput struct {
 	metadataDeleteVolumeOutput `json:"-" xml:"-"`
 }
@@ -9133,6 +12654,16 @@ type metadataDeleteVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVolumeOutput) GoString() string {
+	return s.String()
+}
+
 type DeregisterImageInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9150,6 +12681,16 @@ type metadataDeregisterImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterImageInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterImageOutput struct {
 	metadataDeregisterImageOutput `json:"-" xml:"-"`
 }
@@ -9158,6 +12699,16 @@ type metadataDeregisterImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterImageOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAccountAttributesInput struct {
 	// One or more account attribute names.
 	AttributeNames []*string `locationName:"attributeName" locationNameList:"attributeName" type:"list"`
@@ -9175,6 +12726,16 @@ type metadataDescribeAccountAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAccountAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAccountAttributesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAccountAttributesOutput struct {
 	// Information about one or more account attributes.
 	AccountAttributes []*AccountAttribute `locationName:"accountAttributeSet" locationNameList:"item" type:"list"`
@@ -9186,6 +12747,16 @@ type metadataDescribeAccountAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAccountAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAccountAttributesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAddressesInput struct {
 	// [EC2-VPC] One or more allocation IDs.
 	//
@@ -9233,6 +12804,16 @@ type metadataDescribeAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAddressesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAddressesOutput struct {
 	// Information about one or more Elastic IP addresses.
 	Addresses []*Address `locationName:"addressesSet" locationNameList:"item" type:"list"`
@@ -9244,6 +12825,16 @@ type metadataDescribeAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAddressesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAvailabilityZonesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9273,6 +12864,16 @@ type metadataDescribeAvailabilityZonesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAvailabilityZonesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAvailabilityZonesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAvailabilityZonesOutput struct {
 	// Information about one or more Availability Zones.
 	AvailabilityZones []*AvailabilityZone `locationName:"availabilityZoneInfo" locationNameList:"item" type:"list"`
@@ -9284,6 +12885,16 @@ type metadataDescribeAvailabilityZonesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAvailabilityZonesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAvailabilityZonesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeBundleTasksInput struct {
 	// One or more bundle task IDs.
 	//
@@ -9328,6 +12939,16 @@ type metadataDescribeBundleTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeBundleTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeBundleTasksInput) GoString() string {
+	return s.String()
+}
+
 type DescribeBundleTasksOutput struct {
 	// Information about one or more bundle tasks.
 	BundleTasks []*BundleTask `locationName:"bundleInstanceTasksSet" locationNameList:"item" type:"list"`
@@ -9339,6 +12960,16 @@ type metadataDescribeBundleTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeBundleTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeBundleTasksOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeClassicLinkInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9390,6 +13021,16 @@ type metadataDescribeClassicLinkInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeClassicLinkInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeClassicLinkInstancesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeClassicLinkInstancesOutput struct {
 	// Information about one or more linked EC2-Classic instances.
 	Instances []*ClassicLinkInstance `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -9405,6 +13046,16 @@ type metadataDescribeClassicLinkInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeClassicLinkInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeClassicLinkInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeConversionTasksInput struct {
 	// One or more conversion task IDs.
 	ConversionTaskIDs []*string `locationName:"conversionTaskId" locationNameList:"item" type:"list"`
@@ -9425,6 +13076,16 @@ type metadataDescribeConversionTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConversionTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConversionTasksInput) GoString() string {
+	return s.String()
+}
+
 type DescribeConversionTasksOutput struct {
 	// Information about the conversion tasks.
 	ConversionTasks []*ConversionTask `locationName:"conversionTasks" locationNameList:"item" type:"list"`
@@ -9436,6 +13097,16 @@ type metadataDescribeConversionTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConversionTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConversionTasksOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeCustomerGatewaysInput struct {
 	// One or more customer gateway IDs.
 	//
@@ -9484,6 +13155,16 @@ type metadataDescribeCustomerGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCustomerGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCustomerGatewaysInput) GoString() string {
+	return s.String()
+}
+
 type DescribeCustomerGatewaysOutput struct {
 	// Information about one or more customer gateways.
 	CustomerGateways []*CustomerGateway `locationName:"customerGatewaySet" locationNameList:"item" type:"list"`
@@ -9495,6 +13176,16 @@ type metadataDescribeCustomerGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCustomerGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCustomerGatewaysOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeDHCPOptionsInput struct {
 	// The IDs of one or more DHCP options sets.
 	//
@@ -9535,6 +13226,16 @@ type metadataDescribeDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDHCPOptionsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeDHCPOptionsOutput struct {
 	// Information about one or more DHCP options sets.
 	DHCPOptions []*DHCPOptions `locationName:"dhcpOptionsSet" locationNameList:"item" type:"list"`
@@ -9546,6 +13247,16 @@ type metadataDescribeDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDHCPOptionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeExportTasksInput struct {
 	// One or more export task IDs.
 	ExportTaskIDs []*string `locationName:"exportTaskId" locationNameList:"ExportTaskId" type:"list"`
@@ -9557,6 +13268,16 @@ type metadataDescribeExportTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeExportTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeExportTasksInput) GoString() string {
+	return s.String()
+}
+
 type DescribeExportTasksOutput struct {
 	// Information about the export tasks.
 	ExportTasks []*ExportTask `locationName:"exportTaskSet" locationNameList:"item" type:"list"`
@@ -9568,6 +13289,16 @@ type metadataDescribeExportTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeExportTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeExportTasksOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeFlowLogsInput struct {
 	// One or more filters.
 	//
@@ -9602,6 +13333,16 @@ type metadataDescribeFlowLogsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeFlowLogsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeFlowLogsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeFlowLogsOutput struct {
 	// Information about the flow logs.
 	FlowLogs []*FlowLog `locationName:"flowLogSet" locationNameList:"item" type:"list"`
@@ -9617,13 +13358,25 @@ type metadataDescribeFlowLogsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeFlowLogsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeFlowLogsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeImageAttributeInput struct {
 	// The AMI attribute.
 	//
 	// Note: Depending on your account privileges, the blockDeviceMapping attribute
 	// may return a Client.AuthFailure error. If this happens, use DescribeImages
 	// to get information about the block device mapping for the AMI.
-	Attribute *string `type:"string" required:"true"`
+	//
+	// See the ImageAttributeName* constants for valid values.
+	Attribute *string `type:"string" enum:"description,kernel,ramdisk,launchPermission,productCodes,blockDeviceMapping,sriovNetSupport" required:"true"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9641,6 +13394,16 @@ type metadataDescribeImageAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImageAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImageAttributeInput) GoString() string {
+	return s.String()
+}
+
 // Describes an image attribute.
 type DescribeImageAttributeOutput struct {
 	// One or more block device mapping entries.
@@ -9674,6 +13437,16 @@ type metadataDescribeImageAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImageAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImageAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeImagesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9775,6 +13548,16 @@ type metadataDescribeImagesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImagesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImagesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeImagesOutput struct {
 	// Information about one or more images.
 	Images []*Image `locationName:"imagesSet" locationNameList:"item" type:"list"`
@@ -9786,6 +13569,16 @@ type metadataDescribeImagesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImagesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImagesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeImportImageTasksInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9812,6 +13605,16 @@ type metadataDescribeImportImageTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImportImageTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImportImageTasksInput) GoString() string {
+	return s.String()
+}
+
 type DescribeImportImageTasksOutput struct {
 	// A list of zero or more import image tasks that are currently active or were
 	// completed or canceled in the previous 7 days.
@@ -9828,6 +13631,16 @@ type metadataDescribeImportImageTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImportImageTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImportImageTasksOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeImportSnapshotTasksInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9854,6 +13667,16 @@ type metadataDescribeImportSnapshotTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImportSnapshotTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImportSnapshotTasksInput) GoString() string {
+	return s.String()
+}
+
 type DescribeImportSnapshotTasksOutput struct {
 	// A list of zero or more import snapshot tasks that are currently active or
 	// were completed or canceled in the previous 7 days.
@@ -9870,9 +13693,21 @@ type metadataDescribeImportSnapshotTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeImportSnapshotTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeImportSnapshotTasksOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstanceAttributeInput struct {
 	// The instance attribute.
-	Attribute *string `locationName:"attribute" type:"string" required:"true"`
+	//
+	// See the InstanceAttributeName* constants for valid values.
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport" required:"true"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -9890,6 +13725,16 @@ type metadataDescribeInstanceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstanceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstanceAttributeInput) GoString() string {
+	return s.String()
+}
+
 // Describes an instance attribute.
 type DescribeInstanceAttributeOutput struct {
 	// The block device mapping of the instance.
@@ -9945,6 +13790,16 @@ type metadataDescribeInstanceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstanceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstanceAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstanceStatusInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10020,6 +13875,16 @@ type metadataDescribeInstanceStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstanceStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstanceStatusInput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstanceStatusOutput struct {
 	// One or more instance status descriptions.
 	InstanceStatuses []*InstanceStatus `locationName:"instanceStatusSet" locationNameList:"item" type:"list"`
@@ -10035,6 +13900,16 @@ type metadataDescribeInstanceStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstanceStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstanceStatusOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10290,6 +14165,16 @@ type metadataDescribeInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstancesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstancesOutput struct {
 	// The token to use to retrieve the next page of results. This value is null
 	// when there are no more results to return.
@@ -10305,6 +14190,16 @@ type metadataDescribeInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeInternetGatewaysInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10346,6 +14241,16 @@ type metadataDescribeInternetGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInternetGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInternetGatewaysInput) GoString() string {
+	return s.String()
+}
+
 type DescribeInternetGatewaysOutput struct {
 	// Information about one or more Internet gateways.
 	InternetGateways []*InternetGateway `locationName:"internetGatewaySet" locationNameList:"item" type:"list"`
@@ -10357,6 +14262,16 @@ type metadataDescribeInternetGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInternetGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInternetGatewaysOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeKeyPairsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10383,6 +14298,16 @@ type metadataDescribeKeyPairsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeKeyPairsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeKeyPairsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeKeyPairsOutput struct {
 	// Information about one or more key pairs.
 	KeyPairs []*KeyPairInfo `locationName:"keySet" locationNameList:"item" type:"list"`
@@ -10394,6 +14319,16 @@ type metadataDescribeKeyPairsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeKeyPairsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeKeyPairsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeMovingAddressesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10427,6 +14362,16 @@ type metadataDescribeMovingAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMovingAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMovingAddressesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeMovingAddressesOutput struct {
 	// The status for each Elastic IP address.
 	MovingAddressStatuses []*MovingAddressStatus `locationName:"movingAddressStatusSet" locationNameList:"item" type:"list"`
@@ -10442,6 +14387,16 @@ type metadataDescribeMovingAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMovingAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMovingAddressesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeNetworkACLsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10510,6 +14465,16 @@ type metadataDescribeNetworkACLsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeNetworkACLsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNetworkACLsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeNetworkACLsOutput struct {
 	// Information about one or more network ACLs.
 	NetworkACLs []*NetworkACL `locationName:"networkAclSet" locationNameList:"item" type:"list"`
@@ -10521,9 +14486,21 @@ type metadataDescribeNetworkACLsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DescribeNetworkInterfaceAttributeInput struct {
+// String returns the string representation
+func (s DescribeNetworkACLsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNetworkACLsOutput) GoString() string {
+	return s.String()
+}
+
+type DescribeNetworkInterfaceAttributeInput struct {
 	// The attribute of the network interface.
-	Attribute *string `locationName:"attribute" type:"string"`
+	//
+	// See the NetworkInterfaceAttribute* constants for valid values.
+	Attribute *string `locationName:"attribute" type:"string" enum:"description,groupSet,sourceDestCheck,attachment"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10541,6 +14518,16 @@ type metadataDescribeNetworkInterfaceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeNetworkInterfaceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNetworkInterfaceAttributeInput) GoString() string {
+	return s.String()
+}
+
 type DescribeNetworkInterfaceAttributeOutput struct {
 	// The attachment (if any) of the network interface.
 	Attachment *NetworkInterfaceAttachment `locationName:"attachment" type:"structure"`
@@ -10564,6 +14551,16 @@ type metadataDescribeNetworkInterfaceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeNetworkInterfaceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNetworkInterfaceAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeNetworkInterfacesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10684,6 +14681,16 @@ type metadataDescribeNetworkInterfacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeNetworkInterfacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNetworkInterfacesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeNetworkInterfacesOutput struct {
 	// Information about one or more network interfaces.
 	NetworkInterfaces []*NetworkInterface `locationName:"networkInterfaceSet" locationNameList:"item" type:"list"`
@@ -10695,6 +14702,16 @@ type metadataDescribeNetworkInterfacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeNetworkInterfacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeNetworkInterfacesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribePlacementGroupsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10724,6 +14741,16 @@ type metadataDescribePlacementGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePlacementGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePlacementGroupsInput) GoString() string {
+	return s.String()
+}
+
 type DescribePlacementGroupsOutput struct {
 	// One or more placement groups.
 	PlacementGroups []*PlacementGroup `locationName:"placementGroupSet" locationNameList:"item" type:"list"`
@@ -10735,6 +14762,16 @@ type metadataDescribePlacementGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePlacementGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePlacementGroupsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribePrefixListsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10771,6 +14808,16 @@ type metadataDescribePrefixListsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePrefixListsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePrefixListsInput) GoString() string {
+	return s.String()
+}
+
 type DescribePrefixListsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -10786,6 +14833,16 @@ type metadataDescribePrefixListsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePrefixListsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePrefixListsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeRegionsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10810,6 +14867,16 @@ type metadataDescribeRegionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRegionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRegionsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeRegionsOutput struct {
 	// Information about one or more regions.
 	Regions []*Region `locationName:"regionInfo" locationNameList:"item" type:"list"`
@@ -10821,6 +14888,16 @@ type metadataDescribeRegionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRegionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRegionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -10880,7 +14957,9 @@ type DescribeReservedInstancesInput struct {
 	// The Reserved Instance offering type. If you are using tools that predate
 	// the 2011-11-01 API version, you only have access to the Medium Utilization
 	// Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	//
+	// See the OfferingTypeValues* constants for valid values.
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// One or more Reserved Instance IDs.
 	//
@@ -10895,6 +14974,16 @@ type metadataDescribeReservedInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesListingsInput struct {
 	// One or more filters.
 	//
@@ -10921,6 +15010,16 @@ type metadataDescribeReservedInstancesListingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesListingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesListingsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesListingsOutput struct {
 	// Information about the Reserved Instance listing.
 	ReservedInstancesListings []*ReservedInstancesListing `locationName:"reservedInstancesListingsSet" locationNameList:"item" type:"list"`
@@ -10932,6 +15031,16 @@ type metadataDescribeReservedInstancesListingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesListingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesListingsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesModificationsInput struct {
 	// One or more filters.
 	//
@@ -10982,6 +15091,16 @@ type metadataDescribeReservedInstancesModificationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesModificationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesModificationsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesModificationsOutput struct {
 	// The token to use to retrieve the next page of results. This value is null
 	// when there are no more results to return.
@@ -10997,6 +15116,16 @@ type metadataDescribeReservedInstancesModificationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesModificationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesModificationsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesOfferingsInput struct {
 	// The Availability Zone in which the Reserved Instance can be used.
 	AvailabilityZone *string `type:"string"`
@@ -11048,12 +15177,16 @@ type DescribeReservedInstancesOfferingsInput struct {
 	// VPC.
 	//
 	// Default: default
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	//
+	// See the Tenancy* constants for valid values.
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used. For more information,
 	// see Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	InstanceType *string `type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// The maximum duration (in seconds) to filter when searching for offerings.
 	//
@@ -11083,11 +15216,15 @@ type DescribeReservedInstancesOfferingsInput struct {
 	// The Reserved Instance offering type. If you are using tools that predate
 	// the 2011-11-01 API version, you only have access to the Medium Utilization
 	// Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	//
+	// See the OfferingTypeValues* constants for valid values.
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The Reserved Instance product platform description. Instances that include
 	// (Amazon VPC) in the description are for use with Amazon VPC.
-	ProductDescription *string `type:"string"`
+	//
+	// See the RIProductDescription* constants for valid values.
+	ProductDescription *string `type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// One or more Reserved Instances offering IDs.
 	ReservedInstancesOfferingIDs []*string `locationName:"ReservedInstancesOfferingId" type:"list"`
@@ -11099,6 +15236,16 @@ type metadataDescribeReservedInstancesOfferingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesOfferingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesOfferingsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesOfferingsOutput struct {
 	// The token to use to retrieve the next page of results. This value is null
 	// when there are no more results to return.
@@ -11114,6 +15261,16 @@ type metadataDescribeReservedInstancesOfferingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesOfferingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesOfferingsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeReservedInstancesOutput struct {
 	// A list of Reserved Instances.
 	ReservedInstances []*ReservedInstances `locationName:"reservedInstancesSet" locationNameList:"item" type:"list"`
@@ -11125,6 +15282,16 @@ type metadataDescribeReservedInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeRouteTablesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -11199,6 +15366,16 @@ type metadataDescribeRouteTablesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRouteTablesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRouteTablesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeRouteTablesOutput struct {
 	// Information about one or more route tables.
 	RouteTables []*RouteTable `locationName:"routeTableSet" locationNameList:"item" type:"list"`
@@ -11210,6 +15387,16 @@ type metadataDescribeRouteTablesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRouteTablesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRouteTablesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeSecurityGroupsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -11278,6 +15465,16 @@ type metadataDescribeSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSecurityGroupsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeSecurityGroupsOutput struct {
 	// Information about one or more security groups.
 	SecurityGroups []*SecurityGroup `locationName:"securityGroupInfo" locationNameList:"item" type:"list"`
@@ -11289,9 +15486,21 @@ type metadataDescribeSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSecurityGroupsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeSnapshotAttributeInput struct {
 	// The snapshot attribute you would like to view.
-	Attribute *string `type:"string" required:"true"`
+	//
+	// See the SnapshotAttributeName* constants for valid values.
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission" required:"true"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -11309,6 +15518,16 @@ type metadataDescribeSnapshotAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotAttributeInput) GoString() string {
+	return s.String()
+}
+
 type DescribeSnapshotAttributeOutput struct {
 	// A list of permissions for creating volumes from the snapshot.
 	CreateVolumePermissions []*CreateVolumePermission `locationName:"createVolumePermission" locationNameList:"item" type:"list"`
@@ -11326,6 +15545,16 @@ type metadataDescribeSnapshotAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeSnapshotsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -11405,6 +15634,16 @@ type metadataDescribeSnapshotsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeSnapshotsOutput struct {
 	// The NextToken value to include in a future DescribeSnapshots request. When
 	// the results of a DescribeSnapshots request exceed MaxResults, this value
@@ -11422,6 +15661,16 @@ type metadataDescribeSnapshotsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribeSpotDatafeedSubscription.
 type DescribeSpotDatafeedSubscriptionInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -11437,6 +15686,16 @@ type metadataDescribeSpotDatafeedSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotDatafeedSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotDatafeedSubscriptionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribeSpotDatafeedSubscription.
 type DescribeSpotDatafeedSubscriptionOutput struct {
 	// The Spot Instance data feed subscription.
@@ -11449,6 +15708,16 @@ type metadataDescribeSpotDatafeedSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotDatafeedSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotDatafeedSubscriptionOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribeSpotFleetInstances.
 type DescribeSpotFleetInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -11475,6 +15744,16 @@ type metadataDescribeSpotFleetInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotFleetInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotFleetInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribeSpotFleetInstances.
 type DescribeSpotFleetInstancesOutput struct {
 	// The running instances. Note that this list is refreshed periodically and
@@ -11495,6 +15774,16 @@ type metadataDescribeSpotFleetInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotFleetInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotFleetInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribeSpotFleetRequestHistory.
 type DescribeSpotFleetRequestHistoryInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -11504,7 +15793,9 @@ type DescribeSpotFleetRequestHistoryInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
 	// The type of events to describe. By default, all events are described.
-	EventType *string `locationName:"eventType" type:"string"`
+	//
+	// See the EventType* constants for valid values.
+	EventType *string `locationName:"eventType" type:"string" enum:"instanceChange,fleetRequestChange,error"`
 
 	// The maximum number of results to return in a single call. Specify a value
 	// between 1 and 1000. The default value is 1000. To retrieve the remaining
@@ -11527,6 +15818,16 @@ type metadataDescribeSpotFleetRequestHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotFleetRequestHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotFleetRequestHistoryInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribeSpotFleetRequestHistory.
 type DescribeSpotFleetRequestHistoryOutput struct {
 	// Information about the events in the history of the Spot fleet request.
@@ -11555,6 +15856,16 @@ type metadataDescribeSpotFleetRequestHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotFleetRequestHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotFleetRequestHistoryOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribeSpotFleetRequests.
 type DescribeSpotFleetRequestsInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -11581,6 +15892,16 @@ type metadataDescribeSpotFleetRequestsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotFleetRequestsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotFleetRequestsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribeSpotFleetRequests.
 type DescribeSpotFleetRequestsOutput struct {
 	// The token required to retrieve the next set of results. This value is null
@@ -11597,6 +15918,16 @@ type metadataDescribeSpotFleetRequestsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotFleetRequestsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotFleetRequestsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribeSpotInstanceRequests.
 type DescribeSpotInstanceRequestsInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -11725,6 +16056,16 @@ type metadataDescribeSpotInstanceRequestsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotInstanceRequestsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotInstanceRequestsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribeSpotInstanceRequests.
 type DescribeSpotInstanceRequestsOutput struct {
 	// One or more Spot Instance requests.
@@ -11737,6 +16078,16 @@ type metadataDescribeSpotInstanceRequestsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotInstanceRequestsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotInstanceRequestsOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for DescribeSpotPriceHistory.
 type DescribeSpotPriceHistoryInput struct {
 	// Filters the results by the specified Availability Zone.
@@ -11795,6 +16146,16 @@ type metadataDescribeSpotPriceHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotPriceHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotPriceHistoryInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of DescribeSpotPriceHistory.
 type DescribeSpotPriceHistoryOutput struct {
 	// The token required to retrieve the next set of results. This value is null
@@ -11811,6 +16172,16 @@ type metadataDescribeSpotPriceHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSpotPriceHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSpotPriceHistoryOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeSubnetsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -11864,6 +16235,16 @@ type metadataDescribeSubnetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSubnetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSubnetsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeSubnetsOutput struct {
 	// Information about one or more subnets.
 	Subnets []*Subnet `locationName:"subnetSet" locationNameList:"item" type:"list"`
@@ -11875,6 +16256,16 @@ type metadataDescribeSubnetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSubnetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSubnetsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -11913,6 +16304,16 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsOutput struct {
 	// The token to use to retrieve the next page of results. This value is null
 	// when there are no more results to return..
@@ -11928,9 +16329,21 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCAttributeInput struct {
 	// The VPC attribute.
-	Attribute *string `type:"string"`
+	//
+	// See the VpcAttributeName* constants for valid values.
+	Attribute *string `type:"string" enum:"enableDnsSupport,enableDnsHostnames"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -11948,6 +16361,16 @@ type metadataDescribeVPCAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCAttributeInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCAttributeOutput struct {
 	// Indicates whether the instances launched in the VPC get DNS hostnames. If
 	// this attribute is true, instances in the VPC get DNS hostnames; otherwise,
@@ -11969,6 +16392,16 @@ type metadataDescribeVPCAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCClassicLinkInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12004,6 +16437,16 @@ type metadataDescribeVPCClassicLinkInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCClassicLinkInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCClassicLinkInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCClassicLinkOutput struct {
 	// The ClassicLink status of one or more VPCs.
 	VPCs []*VPCClassicLink `locationName:"vpcSet" locationNameList:"item" type:"list"`
@@ -12015,6 +16458,16 @@ type metadataDescribeVPCClassicLinkOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCClassicLinkOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCClassicLinkOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCEndpointServicesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12040,6 +16493,16 @@ type metadataDescribeVPCEndpointServicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCEndpointServicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCEndpointServicesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCEndpointServicesOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -12055,6 +16518,16 @@ type metadataDescribeVPCEndpointServicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCEndpointServicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCEndpointServicesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCEndpointsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12095,6 +16568,16 @@ type metadataDescribeVPCEndpointsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCEndpointsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCEndpointsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCEndpointsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -12110,6 +16593,16 @@ type metadataDescribeVPCEndpointsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCEndpointsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCEndpointsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCPeeringConnectionsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12168,6 +16661,16 @@ type metadataDescribeVPCPeeringConnectionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCPeeringConnectionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCPeeringConnectionsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCPeeringConnectionsOutput struct {
 	// Information about the VPC peering connections.
 	VPCPeeringConnections []*VPCPeeringConnection `locationName:"vpcPeeringConnectionSet" locationNameList:"item" type:"list"`
@@ -12179,6 +16682,16 @@ type metadataDescribeVPCPeeringConnectionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCPeeringConnectionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCPeeringConnectionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12225,6 +16738,16 @@ type metadataDescribeVPCsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPCsOutput struct {
 	// Information about one or more VPCs.
 	VPCs []*VPC `locationName:"vpcSet" locationNameList:"item" type:"list"`
@@ -12236,6 +16759,16 @@ type metadataDescribeVPCsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPCsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPCsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPNConnectionsInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12297,6 +16830,16 @@ type metadataDescribeVPNConnectionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPNConnectionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPNConnectionsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPNConnectionsOutput struct {
 	// Information about one or more VPN connections.
 	VPNConnections []*VPNConnection `locationName:"vpnConnectionSet" locationNameList:"item" type:"list"`
@@ -12308,6 +16851,16 @@ type metadataDescribeVPNConnectionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPNConnectionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPNConnectionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPNGatewaysInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12357,6 +16910,16 @@ type metadataDescribeVPNGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPNGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPNGatewaysInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVPNGatewaysOutput struct {
 	// Information about one or more virtual private gateways.
 	VPNGateways []*VPNGateway `locationName:"vpnGatewaySet" locationNameList:"item" type:"list"`
@@ -12368,9 +16931,21 @@ type metadataDescribeVPNGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVPNGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVPNGatewaysOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVolumeAttributeInput struct {
 	// The instance attribute.
-	Attribute *string `type:"string"`
+	//
+	// See the VolumeAttributeName* constants for valid values.
+	Attribute *string `type:"string" enum:"autoEnableIO,productCodes"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12388,6 +16963,16 @@ type metadataDescribeVolumeAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVolumeAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumeAttributeInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVolumeAttributeOutput struct {
 	// The state of autoEnableIO attribute.
 	AutoEnableIO *AttributeBooleanValue `locationName:"autoEnableIO" type:"structure"`
@@ -12405,8 +16990,18 @@ type metadataDescribeVolumeAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DescribeVolumeStatusInput struct {
-	// Checks whether you have the required permissions for the action, without
+// String returns the string representation
+func (s DescribeVolumeAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumeAttributeOutput) GoString() string {
+	return s.String()
+}
+
+type DescribeVolumeStatusInput struct {
+	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
 	// the required permissions, the error response is DryRunOperation. Otherwise,
 	// it is UnauthorizedOperation.
@@ -12473,6 +17068,16 @@ type metadataDescribeVolumeStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVolumeStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumeStatusInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVolumeStatusOutput struct {
 	// The token to use to retrieve the next page of results. This value is null
 	// when there are no more results to return.
@@ -12488,6 +17093,16 @@ type metadataDescribeVolumeStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVolumeStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumeStatusOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVolumesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12570,6 +17185,16 @@ type metadataDescribeVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeVolumesOutput struct {
 	// The NextToken value to include in a future DescribeVolumes request. When
 	// the results of a DescribeVolumes request exceed MaxResults, this value can
@@ -12587,6 +17212,16 @@ type metadataDescribeVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumesOutput) GoString() string {
+	return s.String()
+}
+
 type DetachClassicLinkVPCInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12607,6 +17242,16 @@ type metadataDetachClassicLinkVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachClassicLinkVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachClassicLinkVPCInput) GoString() string {
+	return s.String()
+}
+
 type DetachClassicLinkVPCOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -12618,6 +17263,16 @@ type metadataDetachClassicLinkVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachClassicLinkVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachClassicLinkVPCOutput) GoString() string {
+	return s.String()
+}
+
 type DetachInternetGatewayInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12638,6 +17293,16 @@ type metadataDetachInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachInternetGatewayInput) GoString() string {
+	return s.String()
+}
+
 type DetachInternetGatewayOutput struct {
 	metadataDetachInternetGatewayOutput `json:"-" xml:"-"`
 }
@@ -12646,6 +17311,16 @@ type metadataDetachInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachInternetGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type DetachNetworkInterfaceInput struct {
 	// The ID of the attachment.
 	AttachmentID *string `locationName:"attachmentId" type:"string" required:"true"`
@@ -12666,6 +17341,16 @@ type metadataDetachNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachNetworkInterfaceInput) GoString() string {
+	return s.String()
+}
+
 type DetachNetworkInterfaceOutput struct {
 	metadataDetachNetworkInterfaceOutput `json:"-" xml:"-"`
 }
@@ -12674,6 +17359,16 @@ type metadataDetachNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachNetworkInterfaceOutput) GoString() string {
+	return s.String()
+}
+
 type DetachVPNGatewayInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12694,6 +17389,16 @@ type metadataDetachVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachVPNGatewayInput) GoString() string {
+	return s.String()
+}
+
 type DetachVPNGatewayOutput struct {
 	metadataDetachVPNGatewayOutput `json:"-" xml:"-"`
 }
@@ -12702,6 +17407,16 @@ type metadataDetachVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachVPNGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type DetachVolumeInput struct {
 	// The device name.
 	Device *string `type:"string"`
@@ -12734,6 +17449,16 @@ type metadataDetachVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachVolumeInput) GoString() string {
+	return s.String()
+}
+
 type DisableVGWRoutePropagationInput struct {
 	// The ID of the virtual private gateway.
 	GatewayID *string `locationName:"GatewayId" type:"string" required:"true"`
@@ -12748,6 +17473,16 @@ type metadataDisableVGWRoutePropagationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableVGWRoutePropagationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableVGWRoutePropagationInput) GoString() string {
+	return s.String()
+}
+
 type DisableVGWRoutePropagationOutput struct {
 	metadataDisableVGWRoutePropagationOutput `json:"-" xml:"-"`
 }
@@ -12756,6 +17491,16 @@ type metadataDisableVGWRoutePropagationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableVGWRoutePropagationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableVGWRoutePropagationOutput) GoString() string {
+	return s.String()
+}
+
 type DisableVPCClassicLinkInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -12773,6 +17518,16 @@ type metadataDisableVPCClassicLinkInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableVPCClassicLinkInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableVPCClassicLinkInput) GoString() string {
+	return s.String()
+}
+
 type DisableVPCClassicLinkOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -12784,6 +17539,16 @@ type metadataDisableVPCClassicLinkOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableVPCClassicLinkOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableVPCClassicLinkOutput) GoString() string {
+	return s.String()
+}
+
 type DisassociateAddressInput struct {
 	// [EC2-VPC] The association ID. Required for EC2-VPC.
 	AssociationID *string `locationName:"AssociationId" type:"string"`
@@ -12804,6 +17569,16 @@ type metadataDisassociateAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisassociateAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateAddressInput) GoString() string {
+	return s.String()
+}
+
 type DisassociateAddressOutput struct {
 	metadataDisassociateAddressOutput `json:"-" xml:"-"`
 }
@@ -12812,6 +17587,16 @@ type metadataDisassociateAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisassociateAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateAddressOutput) GoString() string {
+	return s.String()
+}
+
 type DisassociateRouteTableInput struct {
 	// The association ID representing the current association between the route
 	// table and subnet.
@@ -12830,6 +17615,16 @@ type metadataDisassociateRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisassociateRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateRouteTableInput) GoString() string {
+	return s.String()
+}
+
 type DisassociateRouteTableOutput struct {
 	metadataDisassociateRouteTableOutput `json:"-" xml:"-"`
 }
@@ -12838,6 +17633,16 @@ type metadataDisassociateRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisassociateRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateRouteTableOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a disk image.
 type DiskImage struct {
 	// A description of the disk image.
@@ -12856,13 +17661,25 @@ type metadataDiskImage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DiskImage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DiskImage) GoString() string {
+	return s.String()
+}
+
 // Describes a disk image.
 type DiskImageDescription struct {
 	// The checksum computed for the disk image.
 	Checksum *string `locationName:"checksum" type:"string"`
 
 	// The disk image format.
-	Format *string `locationName:"format" type:"string" required:"true"`
+	//
+	// See the DiskImageFormat* constants for valid values.
+	Format *string `locationName:"format" type:"string" enum:"VMDK,RAW,VHD" required:"true"`
 
 	// A presigned URL for the import manifest stored in Amazon S3. For information
 	// about creating a presigned URL for an Amazon S3 object, read the "Query String
@@ -12881,13 +17698,25 @@ type metadataDiskImageDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DiskImageDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DiskImageDescription) GoString() string {
+	return s.String()
+}
+
 // Describes a disk image.
 type DiskImageDetail struct {
 	// The size of the disk image, in GiB.
 	Bytes *int64 `locationName:"bytes" type:"long" required:"true"`
 
 	// The disk image format.
-	Format *string `locationName:"format" type:"string" required:"true"`
+	//
+	// See the DiskImageFormat* constants for valid values.
+	Format *string `locationName:"format" type:"string" enum:"VMDK,RAW,VHD" required:"true"`
 
 	// A presigned URL for the import manifest stored in Amazon S3 and presented
 	// here as an Amazon S3 presigned URL. For information about creating a presigned
@@ -12903,6 +17732,16 @@ type metadataDiskImageDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DiskImageDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DiskImageDetail) GoString() string {
+	return s.String()
+}
+
 // Describes a disk image volume.
 type DiskImageVolumeDescription struct {
 	// The volume identifier.
@@ -12918,6 +17757,16 @@ type metadataDiskImageVolumeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DiskImageVolumeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DiskImageVolumeDescription) GoString() string {
+	return s.String()
+}
+
 // Describes a block device for an EBS volume.
 type EBSBlockDevice struct {
 	// Indicates whether the EBS volume is deleted on instance termination.
@@ -12959,7 +17808,9 @@ type EBSBlockDevice struct {
 	// IOPS (SSD) volumes, and standard for Magnetic volumes.
 	//
 	// Default: standard
-	VolumeType *string `locationName:"volumeType" type:"string"`
+	//
+	// See the VolumeType* constants for valid values.
+	VolumeType *string `locationName:"volumeType" type:"string" enum:"standard,io1,gp2"`
 
 	metadataEBSBlockDevice `json:"-" xml:"-"`
 }
@@ -12968,6 +17819,16 @@ type metadataEBSBlockDevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EBSBlockDevice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EBSBlockDevice) GoString() string {
+	return s.String()
+}
+
 // Describes a parameter used to set up an EBS volume in a block device mapping.
 type EBSInstanceBlockDevice struct {
 	// The time stamp when the attachment initiated.
@@ -12977,7 +17838,9 @@ type EBSInstanceBlockDevice struct {
 	DeleteOnTermination *bool `locationName:"deleteOnTermination" type:"boolean"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the AttachmentStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the EBS volume.
 	VolumeID *string `locationName:"volumeId" type:"string"`
@@ -12989,6 +17852,16 @@ type metadataEBSInstanceBlockDevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EBSInstanceBlockDevice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EBSInstanceBlockDevice) GoString() string {
+	return s.String()
+}
+
 type EBSInstanceBlockDeviceSpecification struct {
 	// Indicates whether the volume is deleted on instance termination.
 	DeleteOnTermination *bool `locationName:"deleteOnTermination" type:"boolean"`
@@ -13003,6 +17876,16 @@ type metadataEBSInstanceBlockDeviceSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EBSInstanceBlockDeviceSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EBSInstanceBlockDeviceSpecification) GoString() string {
+	return s.String()
+}
+
 type EnableVGWRoutePropagationInput struct {
 	// The ID of the virtual private gateway.
 	GatewayID *string `locationName:"GatewayId" type:"string" required:"true"`
@@ -13017,6 +17900,16 @@ type metadataEnableVGWRoutePropagationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableVGWRoutePropagationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableVGWRoutePropagationInput) GoString() string {
+	return s.String()
+}
+
 type EnableVGWRoutePropagationOutput struct {
 	metadataEnableVGWRoutePropagationOutput `json:"-" xml:"-"`
 }
@@ -13025,6 +17918,16 @@ type metadataEnableVGWRoutePropagationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableVGWRoutePropagationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableVGWRoutePropagationOutput) GoString() string {
+	return s.String()
+}
+
 type EnableVPCClassicLinkInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -13042,6 +17945,16 @@ type metadataEnableVPCClassicLinkInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableVPCClassicLinkInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableVPCClassicLinkInput) GoString() string {
+	return s.String()
+}
+
 type EnableVPCClassicLinkOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -13053,6 +17966,16 @@ type metadataEnableVPCClassicLinkOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableVPCClassicLinkOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableVPCClassicLinkOutput) GoString() string {
+	return s.String()
+}
+
 type EnableVolumeIOInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -13070,6 +17993,16 @@ type metadataEnableVolumeIOInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableVolumeIOInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableVolumeIOInput) GoString() string {
+	return s.String()
+}
+
 type EnableVolumeIOOutput struct {
 	metadataEnableVolumeIOOutput `json:"-" xml:"-"`
 }
@@ -13078,6 +18011,16 @@ type metadataEnableVolumeIOOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableVolumeIOOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableVolumeIOOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a Spot fleet event.
 type EventInformation struct {
 	// The description of the event.
@@ -13137,6 +18080,16 @@ type metadataEventInformation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EventInformation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EventInformation) GoString() string {
+	return s.String()
+}
+
 // Describes an instance export task.
 type ExportTask struct {
 	// A description of the resource being exported.
@@ -13152,7 +18105,9 @@ type ExportTask struct {
 	InstanceExportDetails *InstanceExportDetails `locationName:"instanceExport" type:"structure"`
 
 	// The state of the export task.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the ExportTaskState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"active,cancelling,cancelled,completed"`
 
 	// The status message related to the export task.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -13164,14 +18119,28 @@ type metadataExportTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExportTask) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExportTask) GoString() string {
+	return s.String()
+}
+
 // Describes the format and location for an instance export task.
 type ExportToS3Task struct {
 	// The container format used to combine disk images with metadata (such as OVF).
 	// If absent, only the disk image is exported.
-	ContainerFormat *string `locationName:"containerFormat" type:"string"`
+	//
+	// See the ContainerFormat* constants for valid values.
+	ContainerFormat *string `locationName:"containerFormat" type:"string" enum:"ova"`
 
 	// The format for the exported image.
-	DiskImageFormat *string `locationName:"diskImageFormat" type:"string"`
+	//
+	// See the DiskImageFormat* constants for valid values.
+	DiskImageFormat *string `locationName:"diskImageFormat" type:"string" enum:"VMDK,RAW,VHD"`
 
 	// The S3 bucket for the destination image. The destination bucket must exist
 	// and grant WRITE and READ_ACP permissions to the AWS account vm-import-export@amazon.com.
@@ -13187,14 +18156,28 @@ type metadataExportToS3Task struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExportToS3Task) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExportToS3Task) GoString() string {
+	return s.String()
+}
+
 // Describes an instance export task.
 type ExportToS3TaskSpecification struct {
 	// The container format used to combine disk images with metadata (such as OVF).
 	// If absent, only the disk image is exported.
-	ContainerFormat *string `locationName:"containerFormat" type:"string"`
+	//
+	// See the ContainerFormat* constants for valid values.
+	ContainerFormat *string `locationName:"containerFormat" type:"string" enum:"ova"`
 
 	// The format for the exported image.
-	DiskImageFormat *string `locationName:"diskImageFormat" type:"string"`
+	//
+	// See the DiskImageFormat* constants for valid values.
+	DiskImageFormat *string `locationName:"diskImageFormat" type:"string" enum:"VMDK,RAW,VHD"`
 
 	// The S3 bucket for the destination image. The destination bucket must exist
 	// and grant WRITE and READ_ACP permissions to the AWS account vm-import-export@amazon.com.
@@ -13211,6 +18194,16 @@ type metadataExportToS3TaskSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExportToS3TaskSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExportToS3TaskSpecification) GoString() string {
+	return s.String()
+}
+
 // A filter name and value pair that is used to return a more specific list
 // of results. Filters can be used to match a set of resources by various criteria,
 // such as tags, attributes, or IDs.
@@ -13228,6 +18221,16 @@ type metadataFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Filter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Filter) GoString() string {
+	return s.String()
+}
+
 // Describes a flow log.
 type FlowLog struct {
 	// The date and time the flow log was created.
@@ -13259,7 +18262,9 @@ type FlowLog struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The type of traffic captured for the flow log.
-	TrafficType *string `locationName:"trafficType" type:"string"`
+	//
+	// See the TrafficType* constants for valid values.
+	TrafficType *string `locationName:"trafficType" type:"string" enum:"ACCEPT,REJECT,ALL"`
 
 	metadataFlowLog `json:"-" xml:"-"`
 }
@@ -13268,6 +18273,16 @@ type metadataFlowLog struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FlowLog) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FlowLog) GoString() string {
+	return s.String()
+}
+
 type GetConsoleOutputInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -13285,6 +18300,16 @@ type metadataGetConsoleOutputInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetConsoleOutputInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetConsoleOutputInput) GoString() string {
+	return s.String()
+}
+
 type GetConsoleOutputOutput struct {
 	// The ID of the instance.
 	InstanceID *string `locationName:"instanceId" type:"string"`
@@ -13302,6 +18327,16 @@ type metadataGetConsoleOutputOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetConsoleOutputOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetConsoleOutputOutput) GoString() string {
+	return s.String()
+}
+
 type GetPasswordDataInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -13319,6 +18354,16 @@ type metadataGetPasswordDataInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPasswordDataInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPasswordDataInput) GoString() string {
+	return s.String()
+}
+
 type GetPasswordDataOutput struct {
 	// The ID of the Windows instance.
 	InstanceID *string `locationName:"instanceId" type:"string"`
@@ -13336,6 +18381,16 @@ type metadataGetPasswordDataOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPasswordDataOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPasswordDataOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a security group.
 type GroupIdentifier struct {
 	// The ID of the security group.
@@ -13351,6 +18406,16 @@ type metadataGroupIdentifier struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GroupIdentifier) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GroupIdentifier) GoString() string {
+	return s.String()
+}
+
 // Describes an event in the history of the Spot fleet request.
 type HistoryRecord struct {
 	// Information about the event.
@@ -13364,7 +18429,9 @@ type HistoryRecord struct {
 	// of the Spot fleet request.
 	//
 	//   instanceChange - Indicates that an instance was launched or terminated.
-	EventType *string `locationName:"eventType" type:"string" required:"true"`
+	//
+	// See the EventType* constants for valid values.
+	EventType *string `locationName:"eventType" type:"string" enum:"instanceChange,fleetRequestChange,error" required:"true"`
 
 	// The date and time of the event, in UTC format (for example, YYYY-MM-DDTHH:MM:SSZ).
 	Timestamp *time.Time `locationName:"timestamp" type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -13376,6 +18443,16 @@ type metadataHistoryRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HistoryRecord) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HistoryRecord) GoString() string {
+	return s.String()
+}
+
 // Describes an IAM instance profile.
 type IAMInstanceProfile struct {
 	// The Amazon Resource Name (ARN) of the instance profile.
@@ -13391,6 +18468,16 @@ type metadataIAMInstanceProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IAMInstanceProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IAMInstanceProfile) GoString() string {
+	return s.String()
+}
+
 // Describes an IAM instance profile.
 type IAMInstanceProfileSpecification struct {
 	// The Amazon Resource Name (ARN) of the instance profile.
@@ -13406,6 +18493,16 @@ type metadataIAMInstanceProfileSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IAMInstanceProfileSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IAMInstanceProfileSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes the ICMP type and code.
 type ICMPTypeCode struct {
 	// The ICMP type. A value of -1 means all types.
@@ -13421,6 +18518,16 @@ type metadataICMPTypeCode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ICMPTypeCode) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ICMPTypeCode) GoString() string {
+	return s.String()
+}
+
 // Describes a security group rule.
 type IPPermission struct {
 	// The start of port range for the TCP and UDP protocols, or an ICMP type number.
@@ -13460,6 +18567,16 @@ type metadataIPPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IPPermission) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IPPermission) GoString() string {
+	return s.String()
+}
+
 // Describes an IP range.
 type IPRange struct {
 	// The CIDR range. You can either specify a CIDR range or a source security
@@ -13473,10 +18590,22 @@ type metadataIPRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IPRange) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IPRange) GoString() string {
+	return s.String()
+}
+
 // Describes an image.
 type Image struct {
 	// The architecture of the image.
-	Architecture *string `locationName:"architecture" type:"string"`
+	//
+	// See the ArchitectureValues* constants for valid values.
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// Any block device mapping entries.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"item" type:"list"`
@@ -13488,7 +18617,9 @@ type Image struct {
 	Description *string `locationName:"description" type:"string"`
 
 	// The hypervisor type of the image.
-	Hypervisor *string `locationName:"hypervisor" type:"string"`
+	//
+	// See the HypervisorType* constants for valid values.
+	Hypervisor *string `locationName:"hypervisor" type:"string" enum:"ovm,xen"`
 
 	// The ID of the AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -13501,7 +18632,9 @@ type Image struct {
 	ImageOwnerAlias *string `locationName:"imageOwnerAlias" type:"string"`
 
 	// The type of image.
-	ImageType *string `locationName:"imageType" type:"string"`
+	//
+	// See the ImageTypeValues* constants for valid values.
+	ImageType *string `locationName:"imageType" type:"string" enum:"machine,kernel,ramdisk"`
 
 	// The kernel associated with the image, if any. Only applicable for machine
 	// images.
@@ -13514,7 +18647,9 @@ type Image struct {
 	OwnerID *string `locationName:"imageOwnerId" type:"string"`
 
 	// The value is Windows for Windows AMIs; otherwise blank.
-	Platform *string `locationName:"platform" type:"string"`
+	//
+	// See the PlatformValues* constants for valid values.
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	// Any product codes associated with the AMI.
 	ProductCodes []*ProductCode `locationName:"productCodes" locationNameList:"item" type:"list"`
@@ -13533,14 +18668,18 @@ type Image struct {
 
 	// The type of root device used by the AMI. The AMI can use an EBS volume or
 	// an instance store volume.
-	RootDeviceType *string `locationName:"rootDeviceType" type:"string"`
+	//
+	// See the DeviceType* constants for valid values.
+	RootDeviceType *string `locationName:"rootDeviceType" type:"string" enum:"ebs,instance-store"`
 
 	// Specifies whether enhanced networking is enabled.
 	SRIOVNetSupport *string `locationName:"sriovNetSupport" type:"string"`
 
 	// The current state of the AMI. If the state is available, the image is successfully
 	// registered and can be used to launch an instance.
-	State *string `locationName:"imageState" type:"string"`
+	//
+	// See the ImageState* constants for valid values.
+	State *string `locationName:"imageState" type:"string" enum:"pending,available,invalid,deregistered,transient,failed,error"`
 
 	// The reason for the state change.
 	StateReason *StateReason `locationName:"stateReason" type:"structure"`
@@ -13549,7 +18688,9 @@ type Image struct {
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of virtualization of the AMI.
-	VirtualizationType *string `locationName:"virtualizationType" type:"string"`
+	//
+	// See the VirtualizationType* constants for valid values.
+	VirtualizationType *string `locationName:"virtualizationType" type:"string" enum:"hvm,paravirtual"`
 
 	metadataImage `json:"-" xml:"-"`
 }
@@ -13558,6 +18699,16 @@ type metadataImage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Image) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Image) GoString() string {
+	return s.String()
+}
+
 // Describes the disk container object for an import image task.
 type ImageDiskContainer struct {
 	// The description of the disk image.
@@ -13588,6 +18739,16 @@ type metadataImageDiskContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImageDiskContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImageDiskContainer) GoString() string {
+	return s.String()
+}
+
 type ImportImageInput struct {
 	// The architecture of the virtual machine.
 	//
@@ -13642,6 +18803,16 @@ type metadataImportImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportImageInput) GoString() string {
+	return s.String()
+}
+
 type ImportImageOutput struct {
 	// The architecture of the virtual machine.
 	Architecture *string `locationName:"architecture" type:"string"`
@@ -13683,6 +18854,16 @@ type metadataImportImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportImageOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an import image task.
 type ImportImageTask struct {
 	// The architecture of the virtual machine.
@@ -13729,6 +18910,16 @@ type metadataImportImageTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportImageTask) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportImageTask) GoString() string {
+	return s.String()
+}
+
 type ImportInstanceInput struct {
 	// A description for the instance being imported.
 	Description *string `locationName:"description" type:"string"`
@@ -13746,7 +18937,9 @@ type ImportInstanceInput struct {
 	LaunchSpecification *ImportInstanceLaunchSpecification `locationName:"launchSpecification" type:"structure"`
 
 	// The instance operating system.
-	Platform *string `locationName:"platform" type:"string" required:"true"`
+	//
+	// See the PlatformValues* constants for valid values.
+	Platform *string `locationName:"platform" type:"string" enum:"Windows" required:"true"`
 
 	metadataImportInstanceInput `json:"-" xml:"-"`
 }
@@ -13755,13 +18948,25 @@ type metadataImportInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Describes the launch specification for VM import.
+// String returns the string representation
+func (s ImportInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportInstanceInput) GoString() string {
+	return s.String()
+}
+
+// Describes the launch specification for VM import.
 type ImportInstanceLaunchSpecification struct {
 	// Reserved.
 	AdditionalInfo *string `locationName:"additionalInfo" type:"string"`
 
 	// The architecture of the instance.
-	Architecture *string `locationName:"architecture" type:"string"`
+	//
+	// See the ArchitectureValues* constants for valid values.
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// One or more security group IDs.
 	GroupIDs []*string `locationName:"GroupId" locationNameList:"SecurityGroupId" type:"list"`
@@ -13771,12 +18976,16 @@ type ImportInstanceLaunchSpecification struct {
 
 	// Indicates whether an instance stops or terminates when you initiate shutdown
 	// from the instance (using the operating system command for system shutdown).
-	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string"`
+	//
+	// See the ShutdownBehavior* constants for valid values.
+	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string" enum:"stop,terminate"`
 
 	// The instance type. For more information about the instance types that you
 	// can import, see Before You Get Started (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/VMImportPrerequisites.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// Indicates whether monitoring is enabled.
 	Monitoring *bool `locationName:"monitoring" type:"boolean"`
@@ -13800,6 +19009,16 @@ type metadataImportInstanceLaunchSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportInstanceLaunchSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportInstanceLaunchSpecification) GoString() string {
+	return s.String()
+}
+
 type ImportInstanceOutput struct {
 	// Information about the conversion task.
 	ConversionTask *ConversionTask `locationName:"conversionTask" type:"structure"`
@@ -13811,6 +19030,16 @@ type metadataImportInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an import instance task.
 type ImportInstanceTaskDetails struct {
 	// A description of the task.
@@ -13820,7 +19049,9 @@ type ImportInstanceTaskDetails struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The instance operating system.
-	Platform *string `locationName:"platform" type:"string"`
+	//
+	// See the PlatformValues* constants for valid values.
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	// One or more volumes.
 	Volumes []*ImportInstanceVolumeDetailItem `locationName:"volumes" locationNameList:"item" type:"list" required:"true"`
@@ -13832,6 +19063,16 @@ type metadataImportInstanceTaskDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportInstanceTaskDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportInstanceTaskDetails) GoString() string {
+	return s.String()
+}
+
 // Describes an import volume task.
 type ImportInstanceVolumeDetailItem struct {
 	// The Availability Zone where the resulting instance will reside.
@@ -13862,6 +19103,16 @@ type metadataImportInstanceVolumeDetailItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportInstanceVolumeDetailItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportInstanceVolumeDetailItem) GoString() string {
+	return s.String()
+}
+
 type ImportKeyPairInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -13883,6 +19134,16 @@ type metadataImportKeyPairInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportKeyPairInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportKeyPairInput) GoString() string {
+	return s.String()
+}
+
 type ImportKeyPairOutput struct {
 	// The MD5 public key fingerprint as specified in section 4 of RFC 4716.
 	KeyFingerprint *string `locationName:"keyFingerprint" type:"string"`
@@ -13897,6 +19158,16 @@ type metadataImportKeyPairOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportKeyPairOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportKeyPairOutput) GoString() string {
+	return s.String()
+}
+
 type ImportSnapshotInput struct {
 	// The client-specific data.
 	ClientData *ClientData `type:"structure"`
@@ -13926,6 +19197,16 @@ type metadataImportSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportSnapshotInput) GoString() string {
+	return s.String()
+}
+
 type ImportSnapshotOutput struct {
 	// A description of the import snapshot task.
 	Description *string `locationName:"description" type:"string"`
@@ -13943,6 +19224,16 @@ type metadataImportSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an import snapshot task.
 type ImportSnapshotTask struct {
 	// A description of the import snapshot task.
@@ -13961,6 +19252,16 @@ type metadataImportSnapshotTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportSnapshotTask) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportSnapshotTask) GoString() string {
+	return s.String()
+}
+
 type ImportVolumeInput struct {
 	// The Availability Zone for the resulting EBS volume.
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string" required:"true"`
@@ -13987,6 +19288,16 @@ type metadataImportVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportVolumeInput) GoString() string {
+	return s.String()
+}
+
 type ImportVolumeOutput struct {
 	// Information about the conversion task.
 	ConversionTask *ConversionTask `locationName:"conversionTask" type:"structure"`
@@ -13998,6 +19309,16 @@ type metadataImportVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportVolumeOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an import volume task.
 type ImportVolumeTaskDetails struct {
 	// The Availability Zone where the resulting volume will reside.
@@ -14022,6 +19343,16 @@ type metadataImportVolumeTaskDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ImportVolumeTaskDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ImportVolumeTaskDetails) GoString() string {
+	return s.String()
+}
+
 // Describes an instance.
 type Instance struct {
 	// The AMI launch index, which can be used to find this instance in the launch
@@ -14029,7 +19360,9 @@ type Instance struct {
 	AMILaunchIndex *int64 `locationName:"amiLaunchIndex" type:"integer"`
 
 	// The architecture of the image.
-	Architecture *string `locationName:"architecture" type:"string"`
+	//
+	// See the ArchitectureValues* constants for valid values.
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// Any block device mapping entries for the instance.
 	BlockDeviceMappings []*InstanceBlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"item" type:"list"`
@@ -14045,7 +19378,9 @@ type Instance struct {
 	EBSOptimized *bool `locationName:"ebsOptimized" type:"boolean"`
 
 	// The hypervisor type of the instance.
-	Hypervisor *string `locationName:"hypervisor" type:"string"`
+	//
+	// See the HypervisorType* constants for valid values.
+	Hypervisor *string `locationName:"hypervisor" type:"string" enum:"ovm,xen"`
 
 	// The IAM instance profile associated with the instance.
 	IAMInstanceProfile *IAMInstanceProfile `locationName:"iamInstanceProfile" type:"structure"`
@@ -14057,10 +19392,14 @@ type Instance struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// Indicates whether this is a Spot Instance.
-	InstanceLifecycle *string `locationName:"instanceLifecycle" type:"string"`
+	//
+	// See the InstanceLifecycleType* constants for valid values.
+	InstanceLifecycle *string `locationName:"instanceLifecycle" type:"string" enum:"spot"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// The kernel associated with this instance.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -14082,7 +19421,9 @@ type Instance struct {
 	Placement *Placement `locationName:"placement" type:"structure"`
 
 	// The value is Windows for Windows instances; otherwise blank.
-	Platform *string `locationName:"platform" type:"string"`
+	//
+	// See the PlatformValues* constants for valid values.
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	// The private DNS name assigned to the instance. This DNS name can only be
 	// used inside the Amazon EC2 network. This name is not available until the
@@ -14110,7 +19451,9 @@ type Instance struct {
 
 	// The root device type used by the AMI. The AMI can use an EBS volume or an
 	// instance store volume.
-	RootDeviceType *string `locationName:"rootDeviceType" type:"string"`
+	//
+	// See the DeviceType* constants for valid values.
+	RootDeviceType *string `locationName:"rootDeviceType" type:"string" enum:"ebs,instance-store"`
 
 	// Specifies whether enhanced networking is enabled.
 	SRIOVNetSupport *string `locationName:"sriovNetSupport" type:"string"`
@@ -14148,7 +19491,9 @@ type Instance struct {
 	VPCID *string `locationName:"vpcId" type:"string"`
 
 	// The virtualization type of the instance.
-	VirtualizationType *string `locationName:"virtualizationType" type:"string"`
+	//
+	// See the VirtualizationType* constants for valid values.
+	VirtualizationType *string `locationName:"virtualizationType" type:"string" enum:"hvm,paravirtual"`
 
 	metadataInstance `json:"-" xml:"-"`
 }
@@ -14157,6 +19502,16 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Instance) GoString() string {
+	return s.String()
+}
+
 // Describes a block device mapping.
 type InstanceBlockDeviceMapping struct {
 	// The device name exposed to the instance (for example, /dev/sdh or xvdh).
@@ -14173,6 +19528,16 @@ type metadataInstanceBlockDeviceMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceBlockDeviceMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceBlockDeviceMapping) GoString() string {
+	return s.String()
+}
+
 // Describes a block device mapping entry.
 type InstanceBlockDeviceMappingSpecification struct {
 	// The device name exposed to the instance (for example, /dev/sdh or xvdh).
@@ -14195,13 +19560,25 @@ type metadataInstanceBlockDeviceMappingSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceBlockDeviceMappingSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceBlockDeviceMappingSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes a Reserved Instance listing state.
 type InstanceCount struct {
 	// The number of listed Reserved Instances in the state specified by the state.
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The states of the listed Reserved Instances.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the ListingState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"available,sold,cancelled,pending"`
 
 	metadataInstanceCount `json:"-" xml:"-"`
 }
@@ -14210,13 +19587,25 @@ type metadataInstanceCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceCount) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceCount) GoString() string {
+	return s.String()
+}
+
 // Describes an instance to export.
 type InstanceExportDetails struct {
 	// The ID of the resource being exported.
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The target virtualization environment.
-	TargetEnvironment *string `locationName:"targetEnvironment" type:"string"`
+	//
+	// See the ExportEnvironment* constants for valid values.
+	TargetEnvironment *string `locationName:"targetEnvironment" type:"string" enum:"citrix,vmware,microsoft"`
 
 	metadataInstanceExportDetails `json:"-" xml:"-"`
 }
@@ -14225,6 +19614,16 @@ type metadataInstanceExportDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceExportDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceExportDetails) GoString() string {
+	return s.String()
+}
+
 // Describes the monitoring information of the instance.
 type InstanceMonitoring struct {
 	// The ID of the instance.
@@ -14240,6 +19639,16 @@ type metadataInstanceMonitoring struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceMonitoring) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceMonitoring) GoString() string {
+	return s.String()
+}
+
 // Describes a network interface.
 type InstanceNetworkInterface struct {
 	// The association information for an Elastic IP associated with the network
@@ -14277,7 +19686,9 @@ type InstanceNetworkInterface struct {
 	SourceDestCheck *bool `locationName:"sourceDestCheck" type:"boolean"`
 
 	// The status of the network interface.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the NetworkInterfaceStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"available,attaching,in-use,detaching"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -14292,6 +19703,16 @@ type metadataInstanceNetworkInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceNetworkInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceNetworkInterface) GoString() string {
+	return s.String()
+}
+
 // Describes association information for an Elastic IP address.
 type InstanceNetworkInterfaceAssociation struct {
 	// The ID of the owner of the Elastic IP address.
@@ -14310,6 +19731,16 @@ type metadataInstanceNetworkInterfaceAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceNetworkInterfaceAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceNetworkInterfaceAssociation) GoString() string {
+	return s.String()
+}
+
 // Describes a network interface attachment.
 type InstanceNetworkInterfaceAttachment struct {
 	// The time stamp when the attachment initiated.
@@ -14325,7 +19756,9 @@ type InstanceNetworkInterfaceAttachment struct {
 	DeviceIndex *int64 `locationName:"deviceIndex" type:"integer"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the AttachmentStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	metadataInstanceNetworkInterfaceAttachment `json:"-" xml:"-"`
 }
@@ -14334,6 +19767,16 @@ type metadataInstanceNetworkInterfaceAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceNetworkInterfaceAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceNetworkInterfaceAttachment) GoString() string {
+	return s.String()
+}
+
 // Describes a network interface.
 type InstanceNetworkInterfaceSpecification struct {
 	// Indicates whether to assign a public IP address to an instance you launch
@@ -14388,6 +19831,16 @@ type metadataInstanceNetworkInterfaceSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceNetworkInterfaceSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceNetworkInterfaceSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes a private IP address.
 type InstancePrivateIPAddress struct {
 	// The association information for an Elastic IP address for the network interface.
@@ -14410,6 +19863,16 @@ type metadataInstancePrivateIPAddress struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstancePrivateIPAddress) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstancePrivateIPAddress) GoString() string {
+	return s.String()
+}
+
 // Describes the current state of the instance.
 type InstanceState struct {
 	// The low byte represents the state. The high byte is an opaque internal value
@@ -14429,7 +19892,9 @@ type InstanceState struct {
 	Code *int64 `locationName:"code" type:"integer"`
 
 	// The current state of the instance.
-	Name *string `locationName:"name" type:"string"`
+	//
+	// See the InstanceStateName* constants for valid values.
+	Name *string `locationName:"name" type:"string" enum:"pending,running,shutting-down,terminated,stopping,stopped"`
 
 	metadataInstanceState `json:"-" xml:"-"`
 }
@@ -14438,6 +19903,16 @@ type metadataInstanceState struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceState) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceState) GoString() string {
+	return s.String()
+}
+
 // Describes an instance state change.
 type InstanceStateChange struct {
 	// The current state of the instance.
@@ -14456,6 +19931,16 @@ type metadataInstanceStateChange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceStateChange) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceStateChange) GoString() string {
+	return s.String()
+}
+
 // Describes the status of an instance.
 type InstanceStatus struct {
 	// The Availability Zone of the instance.
@@ -14487,6 +19972,16 @@ type metadataInstanceStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceStatus) GoString() string {
+	return s.String()
+}
+
 // Describes the instance status.
 type InstanceStatusDetails struct {
 	// The time when a status check failed. For an instance that was launched and
@@ -14494,10 +19989,14 @@ type InstanceStatusDetails struct {
 	ImpairedSince *time.Time `locationName:"impairedSince" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The type of instance status.
-	Name *string `locationName:"name" type:"string"`
+	//
+	// See the StatusName* constants for valid values.
+	Name *string `locationName:"name" type:"string" enum:"reachability"`
 
 	// The status.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the StatusType* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"passed,failed,insufficient-data,initializing"`
 
 	metadataInstanceStatusDetails `json:"-" xml:"-"`
 }
@@ -14506,10 +20005,22 @@ type metadataInstanceStatusDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceStatusDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceStatusDetails) GoString() string {
+	return s.String()
+}
+
 // Describes a scheduled event for an instance.
 type InstanceStatusEvent struct {
 	// The event code.
-	Code *string `locationName:"code" type:"string"`
+	//
+	// See the EventCode* constants for valid values.
+	Code *string `locationName:"code" type:"string" enum:"instance-reboot,system-reboot,system-maintenance,instance-retirement,instance-stop"`
 
 	// A description of the event.
 	//
@@ -14531,13 +20042,25 @@ type metadataInstanceStatusEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceStatusEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceStatusEvent) GoString() string {
+	return s.String()
+}
+
 // Describes the status of an instance.
 type InstanceStatusSummary struct {
 	// The system instance health or application instance health.
 	Details []*InstanceStatusDetails `locationName:"details" locationNameList:"item" type:"list"`
 
 	// The status.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the SummaryStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired,insufficient-data,not-applicable,initializing"`
 
 	metadataInstanceStatusSummary `json:"-" xml:"-"`
 }
@@ -14546,6 +20069,16 @@ type metadataInstanceStatusSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceStatusSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceStatusSummary) GoString() string {
+	return s.String()
+}
+
 // Describes an Internet gateway.
 type InternetGateway struct {
 	// Any VPCs attached to the Internet gateway.
@@ -14564,10 +20097,22 @@ type metadataInternetGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InternetGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InternetGateway) GoString() string {
+	return s.String()
+}
+
 // Describes the attachment of a VPC to an Internet gateway.
 type InternetGatewayAttachment struct {
 	// The current state of the attachment.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the AttachmentStatus* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the VPC.
 	VPCID *string `locationName:"vpcId" type:"string"`
@@ -14579,6 +20124,16 @@ type metadataInternetGatewayAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InternetGatewayAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InternetGatewayAttachment) GoString() string {
+	return s.String()
+}
+
 // Describes a key pair.
 type KeyPairInfo struct {
 	// If you used CreateKeyPair to create the key pair, this is the SHA-1 digest
@@ -14597,10 +20152,22 @@ type metadataKeyPairInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeyPairInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeyPairInfo) GoString() string {
+	return s.String()
+}
+
 // Describes a launch permission.
 type LaunchPermission struct {
 	// The name of the group.
-	Group *string `locationName:"group" type:"string"`
+	//
+	// See the PermissionGroup* constants for valid values.
+	Group *string `locationName:"group" type:"string" enum:"all"`
 
 	// The AWS account ID.
 	UserID *string `locationName:"userId" type:"string"`
@@ -14612,6 +20179,16 @@ type metadataLaunchPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LaunchPermission) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LaunchPermission) GoString() string {
+	return s.String()
+}
+
 // Describes a launch permission modification.
 type LaunchPermissionModifications struct {
 	// The AWS account ID to add to the list of launch permissions for the AMI.
@@ -14628,6 +20205,16 @@ type metadataLaunchPermissionModifications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LaunchPermissionModifications) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LaunchPermissionModifications) GoString() string {
+	return s.String()
+}
+
 // Describes the launch specification for an instance.
 type LaunchSpecification struct {
 	// Deprecated.
@@ -14652,7 +20239,9 @@ type LaunchSpecification struct {
 	ImageID *string `locationName:"imageId" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// The ID of the kernel.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -14691,6 +20280,16 @@ type metadataLaunchSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LaunchSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LaunchSpecification) GoString() string {
+	return s.String()
+}
+
 type ModifyImageAttributeInput struct {
 	// The name of the attribute to modify.
 	Attribute *string `type:"string"`
@@ -14736,6 +20335,16 @@ type metadataModifyImageAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyImageAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyImageAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ModifyImageAttributeOutput struct {
 	metadataModifyImageAttributeOutput `json:"-" xml:"-"`
 }
@@ -14744,9 +20353,21 @@ type metadataModifyImageAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyImageAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyImageAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyInstanceAttributeInput struct {
 	// The name of the attribute.
-	Attribute *string `locationName:"attribute" type:"string"`
+	//
+	// See the InstanceAttributeName* constants for valid values.
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport"`
 
 	// Modifies the DeleteOnTermination attribute for volumes that are currently
 	// attached. The volume must be owned by the caller. If no value is specified
@@ -14830,6 +20451,16 @@ type metadataModifyInstanceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyInstanceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyInstanceAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ModifyInstanceAttributeOutput struct {
 	metadataModifyInstanceAttributeOutput `json:"-" xml:"-"`
 }
@@ -14838,6 +20469,16 @@ type metadataModifyInstanceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyInstanceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyInstanceAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyNetworkInterfaceAttributeInput struct {
 	// Information about the interface attachment. If modifying the 'delete on termination'
 	// attribute, you must specify the ID of the interface attachment.
@@ -14875,6 +20516,16 @@ type metadataModifyNetworkInterfaceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyNetworkInterfaceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyNetworkInterfaceAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ModifyNetworkInterfaceAttributeOutput struct {
 	metadataModifyNetworkInterfaceAttributeOutput `json:"-" xml:"-"`
 }
@@ -14883,6 +20534,16 @@ type metadataModifyNetworkInterfaceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyNetworkInterfaceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyNetworkInterfaceAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyReservedInstancesInput struct {
 	// A unique, case-sensitive token you provide to ensure idempotency of your
 	// modification request. For more information, see Ensuring Idempotency (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/Run_Instance_Idempotency.html).
@@ -14901,6 +20562,16 @@ type metadataModifyReservedInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyReservedInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyReservedInstancesInput) GoString() string {
+	return s.String()
+}
+
 type ModifyReservedInstancesOutput struct {
 	// The ID for the modification.
 	ReservedInstancesModificationID *string `locationName:"reservedInstancesModificationId" type:"string"`
@@ -14912,9 +20583,21 @@ type metadataModifyReservedInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyReservedInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyReservedInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type ModifySnapshotAttributeInput struct {
 	// The snapshot attribute to modify.
-	Attribute *string `type:"string"`
+	//
+	// See the SnapshotAttributeName* constants for valid values.
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission"`
 
 	// A JSON representation of the snapshot attribute modification.
 	CreateVolumePermission *CreateVolumePermissionModifications `type:"structure"`
@@ -14944,6 +20627,16 @@ type metadataModifySnapshotAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifySnapshotAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifySnapshotAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ModifySnapshotAttributeOutput struct {
 	metadataModifySnapshotAttributeOutput `json:"-" xml:"-"`
 }
@@ -14952,6 +20645,16 @@ type metadataModifySnapshotAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifySnapshotAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifySnapshotAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ModifySubnetAttributeInput struct {
 	// Specify true to indicate that instances launched into the specified subnet
 	// should be assigned public IP address.
@@ -14967,6 +20670,16 @@ type metadataModifySubnetAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifySubnetAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifySubnetAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ModifySubnetAttributeOutput struct {
 	metadataModifySubnetAttributeOutput `json:"-" xml:"-"`
 }
@@ -14975,6 +20688,16 @@ type metadataModifySubnetAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifySubnetAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifySubnetAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyVPCAttributeInput struct {
 	// Indicates whether the instances launched in the VPC get DNS hostnames. If
 	// enabled, instances in the VPC get DNS hostnames; otherwise, they do not.
@@ -14999,6 +20722,16 @@ type metadataModifyVPCAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyVPCAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyVPCAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ModifyVPCAttributeOutput struct {
 	metadataModifyVPCAttributeOutput `json:"-" xml:"-"`
 }
@@ -15007,6 +20740,16 @@ type metadataModifyVPCAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyVPCAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyVPCAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyVPCEndpointInput struct {
 	// One or more route tables IDs to associate with the endpoint.
 	AddRouteTableIDs []*string `locationName:"AddRouteTableId" locationNameList:"item" type:"list"`
@@ -15038,6 +20781,16 @@ type metadataModifyVPCEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyVPCEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyVPCEndpointInput) GoString() string {
+	return s.String()
+}
+
 type ModifyVPCEndpointOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -15049,6 +20802,16 @@ type metadataModifyVPCEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyVPCEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyVPCEndpointOutput) GoString() string {
+	return s.String()
+}
+
 type ModifyVolumeAttributeInput struct {
 	// Indicates whether the volume should be auto-enabled for I/O operations.
 	AutoEnableIO *AttributeBooleanValue `type:"structure"`
@@ -15069,14 +20832,34 @@ type metadataModifyVolumeAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type ModifyVolumeAttributeOutput struct {
-	metadataModifyVolumeAttributeOutput `json:"-" xml:"-"`
+// String returns the string representation
+func (s ModifyVolumeAttributeInput) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataModifyVolumeAttributeOutput struct {
+// GoString returns the string representation
+func (s ModifyVolumeAttributeInput) GoString() string {
+	return s.String()
+}
+
+type ModifyVolumeAttributeOutput struct {
+	metadataModifyVolumeAttributeOutput `json:"-" xml:"-"`
+}
+
+type metadataModifyVolumeAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyVolumeAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyVolumeAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type MonitorInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -15094,6 +20877,16 @@ type metadataMonitorInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MonitorInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MonitorInstancesInput) GoString() string {
+	return s.String()
+}
+
 type MonitorInstancesOutput struct {
 	// Monitoring information for one or more instances.
 	InstanceMonitorings []*InstanceMonitoring `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -15105,10 +20898,22 @@ type metadataMonitorInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MonitorInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MonitorInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the monitoring for the instance.
 type Monitoring struct {
 	// Indicates whether monitoring is enabled for the instance.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the MonitoringState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"disabled,disabling,enabled,pending"`
 
 	metadataMonitoring `json:"-" xml:"-"`
 }
@@ -15117,6 +20922,16 @@ type metadataMonitoring struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Monitoring) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Monitoring) GoString() string {
+	return s.String()
+}
+
 type MoveAddressToVPCInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -15134,12 +20949,24 @@ type metadataMoveAddressToVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MoveAddressToVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MoveAddressToVPCInput) GoString() string {
+	return s.String()
+}
+
 type MoveAddressToVPCOutput struct {
 	// The allocation ID for the Elastic IP address.
 	AllocationID *string `locationName:"allocationId" type:"string"`
 
 	// The status of the move of the IP address.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the Status* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"MoveInProgress,InVpc,InClassic"`
 
 	metadataMoveAddressToVPCOutput `json:"-" xml:"-"`
 }
@@ -15148,11 +20975,23 @@ type metadataMoveAddressToVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MoveAddressToVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MoveAddressToVPCOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the status of a moving Elastic IP address.
 type MovingAddressStatus struct {
 	// The status of the Elastic IP address that's being moved to the EC2-VPC platform,
 	// or restored to the EC2-Classic platform.
-	MoveStatus *string `locationName:"moveStatus" type:"string"`
+	//
+	// See the MoveStatus* constants for valid values.
+	MoveStatus *string `locationName:"moveStatus" type:"string" enum:"movingToVpc,restoringToClassic"`
 
 	// The Elastic IP address.
 	PublicIP *string `locationName:"publicIp" type:"string"`
@@ -15164,6 +21003,16 @@ type metadataMovingAddressStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MovingAddressStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MovingAddressStatus) GoString() string {
+	return s.String()
+}
+
 // Describes a network ACL.
 type NetworkACL struct {
 	// Any associations between the network ACL and one or more subnets
@@ -15191,6 +21040,16 @@ type metadataNetworkACL struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkACL) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkACL) GoString() string {
+	return s.String()
+}
+
 // Describes an association between a network ACL and a subnet.
 type NetworkACLAssociation struct {
 	// The ID of the association between a network ACL and a subnet.
@@ -15209,6 +21068,16 @@ type metadataNetworkACLAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkACLAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkACLAssociation) GoString() string {
+	return s.String()
+}
+
 // Describes an entry in a network ACL.
 type NetworkACLEntry struct {
 	// The network range to allow or deny, in CIDR notation.
@@ -15228,7 +21097,9 @@ type NetworkACLEntry struct {
 	Protocol *string `locationName:"protocol" type:"string"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string"`
+	//
+	// See the RuleAction* constants for valid values.
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny"`
 
 	// The rule number for the entry. ACL entries are processed in ascending order
 	// by rule number.
@@ -15241,6 +21112,16 @@ type metadataNetworkACLEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkACLEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkACLEntry) GoString() string {
+	return s.String()
+}
+
 // Describes a network interface.
 type NetworkInterface struct {
 	// The association information for an Elastic IP associated with the network
@@ -15288,7 +21169,9 @@ type NetworkInterface struct {
 	SourceDestCheck *bool `locationName:"sourceDestCheck" type:"boolean"`
 
 	// The status of the network interface.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the NetworkInterfaceStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"available,attaching,in-use,detaching"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -15306,6 +21189,16 @@ type metadataNetworkInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkInterface) GoString() string {
+	return s.String()
+}
+
 // Describes association information for an Elastic IP address.
 type NetworkInterfaceAssociation struct {
 	// The allocation ID.
@@ -15330,6 +21223,16 @@ type metadataNetworkInterfaceAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkInterfaceAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkInterfaceAssociation) GoString() string {
+	return s.String()
+}
+
 // Describes a network interface attachment.
 type NetworkInterfaceAttachment struct {
 	// The timestamp indicating when the attachment initiated.
@@ -15351,7 +21254,9 @@ type NetworkInterfaceAttachment struct {
 	InstanceOwnerID *string `locationName:"instanceOwnerId" type:"string"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the AttachmentStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	metadataNetworkInterfaceAttachment `json:"-" xml:"-"`
 }
@@ -15360,6 +21265,16 @@ type metadataNetworkInterfaceAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkInterfaceAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkInterfaceAttachment) GoString() string {
+	return s.String()
+}
+
 // Describes an attachment change.
 type NetworkInterfaceAttachmentChanges struct {
 	// The ID of the network interface attachment.
@@ -15375,6 +21290,16 @@ type metadataNetworkInterfaceAttachmentChanges struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkInterfaceAttachmentChanges) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkInterfaceAttachmentChanges) GoString() string {
+	return s.String()
+}
+
 // Describes the private IP address of a network interface.
 type NetworkInterfacePrivateIPAddress struct {
 	// The association information for an Elastic IP address associated with the
@@ -15398,6 +21323,16 @@ type metadataNetworkInterfacePrivateIPAddress struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkInterfacePrivateIPAddress) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkInterfacePrivateIPAddress) GoString() string {
+	return s.String()
+}
+
 type NewDHCPConfiguration struct {
 	Key *string `locationName:"key" type:"string"`
 
@@ -15410,6 +21345,16 @@ type metadataNewDHCPConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NewDHCPConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NewDHCPConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes the placement for the instance.
 type Placement struct {
 	// The Availability Zone of the instance.
@@ -15420,7 +21365,9 @@ type Placement struct {
 
 	// The tenancy of the instance (if the instance is running in a VPC). An instance
 	// with a tenancy of dedicated runs on single-tenant hardware.
-	Tenancy *string `locationName:"tenancy" type:"string"`
+	//
+	// See the Tenancy* constants for valid values.
+	Tenancy *string `locationName:"tenancy" type:"string" enum:"default,dedicated"`
 
 	metadataPlacement `json:"-" xml:"-"`
 }
@@ -15429,16 +21376,30 @@ type metadataPlacement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Placement) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Placement) GoString() string {
+	return s.String()
+}
+
 // Describes a placement group.
 type PlacementGroup struct {
 	// The name of the placement group.
 	GroupName *string `locationName:"groupName" type:"string"`
 
 	// The state of the placement group.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the PlacementGroupState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// The placement strategy.
-	Strategy *string `locationName:"strategy" type:"string"`
+	//
+	// See the PlacementStrategy* constants for valid values.
+	Strategy *string `locationName:"strategy" type:"string" enum:"cluster"`
 
 	metadataPlacementGroup `json:"-" xml:"-"`
 }
@@ -15447,6 +21408,16 @@ type metadataPlacementGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PlacementGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PlacementGroup) GoString() string {
+	return s.String()
+}
+
 // Describes a range of ports.
 type PortRange struct {
 	// The first port in the range.
@@ -15462,6 +21433,16 @@ type metadataPortRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PortRange) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PortRange) GoString() string {
+	return s.String()
+}
+
 // Describes prefixes for AWS services.
 type PrefixList struct {
 	// The IP address range of the AWS service.
@@ -15480,6 +21461,16 @@ type metadataPrefixList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PrefixList) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PrefixList) GoString() string {
+	return s.String()
+}
+
 // The ID of the prefix.
 type PrefixListID struct {
 	// The ID of the prefix.
@@ -15492,6 +21483,16 @@ type metadataPrefixListID struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PrefixListID) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PrefixListID) GoString() string {
+	return s.String()
+}
+
 // Describes the price for a Reserved Instance.
 type PriceSchedule struct {
 	// The current price schedule, as determined by the term remaining for the Reserved
@@ -15508,7 +21509,9 @@ type PriceSchedule struct {
 
 	// The currency for transacting the Reserved Instance resale. At this time,
 	// the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	//
+	// See the CurrencyCodeValues* constants for valid values.
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The fixed price for the term.
 	Price *float64 `locationName:"price" type:"double"`
@@ -15524,11 +21527,23 @@ type metadataPriceSchedule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PriceSchedule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PriceSchedule) GoString() string {
+	return s.String()
+}
+
 // Describes the price for a Reserved Instance.
 type PriceScheduleSpecification struct {
 	// The currency for transacting the Reserved Instance resale. At this time,
 	// the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	//
+	// See the CurrencyCodeValues* constants for valid values.
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The fixed price for the term.
 	Price *float64 `locationName:"price" type:"double"`
@@ -15544,6 +21559,16 @@ type metadataPriceScheduleSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PriceScheduleSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PriceScheduleSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes a Reserved Instance offering.
 type PricingDetail struct {
 	// The number of instances available for the price.
@@ -15559,6 +21584,16 @@ type metadataPricingDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PricingDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PricingDetail) GoString() string {
+	return s.String()
+}
+
 // Describes a secondary private IP address for a network interface.
 type PrivateIPAddressSpecification struct {
 	// Indicates whether the private IP address is the primary private IP address.
@@ -15575,13 +21610,25 @@ type metadataPrivateIPAddressSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PrivateIPAddressSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PrivateIPAddressSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes a product code.
 type ProductCode struct {
 	// The product code.
 	ProductCodeID *string `locationName:"productCode" type:"string"`
 
 	// The type of product code.
-	ProductCodeType *string `locationName:"type" type:"string"`
+	//
+	// See the ProductCodeValues* constants for valid values.
+	ProductCodeType *string `locationName:"type" type:"string" enum:"devpay,marketplace"`
 
 	metadataProductCode `json:"-" xml:"-"`
 }
@@ -15590,6 +21637,16 @@ type metadataProductCode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ProductCode) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ProductCode) GoString() string {
+	return s.String()
+}
+
 // Describes a virtual private gateway propagating route.
 type PropagatingVGW struct {
 	// The ID of the virtual private gateway (VGW).
@@ -15602,6 +21659,16 @@ type metadataPropagatingVGW struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PropagatingVGW) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PropagatingVGW) GoString() string {
+	return s.String()
+}
+
 type PurchaseReservedInstancesOfferingInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -15627,6 +21694,16 @@ type metadataPurchaseReservedInstancesOfferingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PurchaseReservedInstancesOfferingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PurchaseReservedInstancesOfferingInput) GoString() string {
+	return s.String()
+}
+
 type PurchaseReservedInstancesOfferingOutput struct {
 	// The IDs of the purchased Reserved Instances.
 	ReservedInstancesID *string `locationName:"reservedInstancesId" type:"string"`
@@ -15638,6 +21715,16 @@ type metadataPurchaseReservedInstancesOfferingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PurchaseReservedInstancesOfferingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PurchaseReservedInstancesOfferingOutput) GoString() string {
+	return s.String()
+}
+
 type RebootInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -15655,6 +21742,16 @@ type metadataRebootInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootInstancesInput) GoString() string {
+	return s.String()
+}
+
 type RebootInstancesOutput struct {
 	metadataRebootInstancesOutput `json:"-" xml:"-"`
 }
@@ -15663,13 +21760,25 @@ type metadataRebootInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a recurring charge.
 type RecurringCharge struct {
 	// The amount of the recurring charge.
 	Amount *float64 `locationName:"amount" type:"double"`
 
 	// The frequency of the recurring charge.
-	Frequency *string `locationName:"frequency" type:"string"`
+	//
+	// See the RecurringChargeFrequency* constants for valid values.
+	Frequency *string `locationName:"frequency" type:"string" enum:"Hourly"`
 
 	metadataRecurringCharge `json:"-" xml:"-"`
 }
@@ -15678,6 +21787,16 @@ type metadataRecurringCharge struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecurringCharge) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecurringCharge) GoString() string {
+	return s.String()
+}
+
 // Describes a region.
 type Region struct {
 	// The region service endpoint.
@@ -15693,12 +21812,24 @@ type metadataRegion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Region) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Region) GoString() string {
+	return s.String()
+}
+
 type RegisterImageInput struct {
 	// The architecture of the AMI.
 	//
 	// Default: For Amazon EBS-backed AMIs, i386. For instance store-backed AMIs,
 	// the architecture specified in the manifest file.
-	Architecture *string `locationName:"architecture" type:"string"`
+	//
+	// See the ArchitectureValues* constants for valid values.
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// One or more block device mapping entries.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"BlockDeviceMapping" locationNameList:"BlockDeviceMapping" type:"list"`
@@ -15752,6 +21883,16 @@ type metadataRegisterImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterImageInput) GoString() string {
+	return s.String()
+}
+
 type RegisterImageOutput struct {
 	// The ID of the newly registered AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -15763,6 +21904,16 @@ type metadataRegisterImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterImageOutput) GoString() string {
+	return s.String()
+}
+
 type RejectVPCPeeringConnectionInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -15780,6 +21931,16 @@ type metadataRejectVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RejectVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RejectVPCPeeringConnectionInput) GoString() string {
+	return s.String()
+}
+
 type RejectVPCPeeringConnectionOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -15791,6 +21952,16 @@ type metadataRejectVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RejectVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RejectVPCPeeringConnectionOutput) GoString() string {
+	return s.String()
+}
+
 type ReleaseAddressInput struct {
 	// [EC2-VPC] The allocation ID. Required for EC2-VPC.
 	AllocationID *string `locationName:"AllocationId" type:"string"`
@@ -15811,6 +21982,16 @@ type metadataReleaseAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReleaseAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReleaseAddressInput) GoString() string {
+	return s.String()
+}
+
 type ReleaseAddressOutput struct {
 	metadataReleaseAddressOutput `json:"-" xml:"-"`
 }
@@ -15819,6 +22000,16 @@ type metadataReleaseAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReleaseAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReleaseAddressOutput) GoString() string {
+	return s.String()
+}
+
 type ReplaceNetworkACLAssociationInput struct {
 	// The ID of the current association between the original network ACL and the
 	// subnet.
@@ -15840,6 +22031,16 @@ type metadataReplaceNetworkACLAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceNetworkACLAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceNetworkACLAssociationInput) GoString() string {
+	return s.String()
+}
+
 type ReplaceNetworkACLAssociationOutput struct {
 	// The ID of the new association.
 	NewAssociationID *string `locationName:"newAssociationId" type:"string"`
@@ -15851,6 +22052,16 @@ type metadataReplaceNetworkACLAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceNetworkACLAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceNetworkACLAssociationOutput) GoString() string {
+	return s.String()
+}
+
 type ReplaceNetworkACLEntryInput struct {
 	// The network range to allow or deny, in CIDR notation.
 	CIDRBlock *string `locationName:"cidrBlock" type:"string" required:"true"`
@@ -15881,7 +22092,9 @@ type ReplaceNetworkACLEntryInput struct {
 	Protocol *string `locationName:"protocol" type:"string" required:"true"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string" required:"true"`
+	//
+	// See the RuleAction* constants for valid values.
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny" required:"true"`
 
 	// The rule number of the entry to replace.
 	RuleNumber *int64 `locationName:"ruleNumber" type:"integer" required:"true"`
@@ -15893,6 +22106,16 @@ type metadataReplaceNetworkACLEntryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceNetworkACLEntryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceNetworkACLEntryInput) GoString() string {
+	return s.String()
+}
+
 type ReplaceNetworkACLEntryOutput struct {
 	metadataReplaceNetworkACLEntryOutput `json:"-" xml:"-"`
 }
@@ -15901,6 +22124,16 @@ type metadataReplaceNetworkACLEntryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceNetworkACLEntryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceNetworkACLEntryOutput) GoString() string {
+	return s.String()
+}
+
 type ReplaceRouteInput struct {
 	// The CIDR address block used for the destination match. The value you provide
 	// must match the CIDR of an existing route in the table.
@@ -15934,6 +22167,16 @@ type metadataReplaceRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceRouteInput) GoString() string {
+	return s.String()
+}
+
 type ReplaceRouteOutput struct {
 	metadataReplaceRouteOutput `json:"-" xml:"-"`
 }
@@ -15942,6 +22185,16 @@ type metadataReplaceRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceRouteOutput) GoString() string {
+	return s.String()
+}
+
 type ReplaceRouteTableAssociationInput struct {
 	// The association ID.
 	AssociationID *string `locationName:"associationId" type:"string" required:"true"`
@@ -15962,6 +22215,16 @@ type metadataReplaceRouteTableAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceRouteTableAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceRouteTableAssociationInput) GoString() string {
+	return s.String()
+}
+
 type ReplaceRouteTableAssociationOutput struct {
 	// The ID of the new association.
 	NewAssociationID *string `locationName:"newAssociationId" type:"string"`
@@ -15973,6 +22236,16 @@ type metadataReplaceRouteTableAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplaceRouteTableAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplaceRouteTableAssociationOutput) GoString() string {
+	return s.String()
+}
+
 type ReportInstanceStatusInput struct {
 	// Descriptive text about the health state of your instance.
 	Description *string `locationName:"description" type:"string"`
@@ -16017,7 +22290,9 @@ type ReportInstanceStatusInput struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The status of all instances listed.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	//
+	// See the ReportStatusType* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired" required:"true"`
 
 	metadataReportInstanceStatusInput `json:"-" xml:"-"`
 }
@@ -16026,6 +22301,16 @@ type metadataReportInstanceStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReportInstanceStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReportInstanceStatusInput) GoString() string {
+	return s.String()
+}
+
 type ReportInstanceStatusOutput struct {
 	metadataReportInstanceStatusOutput `json:"-" xml:"-"`
 }
@@ -16034,6 +22319,16 @@ type metadataReportInstanceStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReportInstanceStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReportInstanceStatusOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for RequestSpotFleet.
 type RequestSpotFleetInput struct {
 	// Checks whether you have the required permissions for the action, without
@@ -16052,6 +22347,16 @@ type metadataRequestSpotFleetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestSpotFleetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestSpotFleetInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of RequestSpotFleet.
 type RequestSpotFleetOutput struct {
 	// The ID of the Spot fleet request.
@@ -16064,6 +22369,16 @@ type metadataRequestSpotFleetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestSpotFleetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestSpotFleetOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the parameters for RequestSpotInstances.
 type RequestSpotInstancesInput struct {
 	// The user-specified name for a logical grouping of bids.
@@ -16119,7 +22434,9 @@ type RequestSpotInstancesInput struct {
 	// The Spot Instance request type.
 	//
 	// Default: one-time
-	Type *string `locationName:"type" type:"string"`
+	//
+	// See the SpotInstanceType* constants for valid values.
+	Type *string `locationName:"type" type:"string" enum:"one-time,persistent"`
 
 	// The start date of the request. If this is a one-time request, the request
 	// becomes active at this date and time and remains active until all instances
@@ -16145,6 +22462,16 @@ type metadataRequestSpotInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestSpotInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestSpotInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the output of RequestSpotInstances.
 type RequestSpotInstancesOutput struct {
 	// One or more Spot Instance requests.
@@ -16157,6 +22484,16 @@ type metadataRequestSpotInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestSpotInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestSpotInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the launch specification for an instance.
 type RequestSpotLaunchSpecification struct {
 	// Deprecated.
@@ -16181,7 +22518,9 @@ type RequestSpotLaunchSpecification struct {
 	ImageID *string `locationName:"imageId" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// The ID of the kernel.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -16218,6 +22557,16 @@ type metadataRequestSpotLaunchSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestSpotLaunchSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestSpotLaunchSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes a reservation.
 type Reservation struct {
 	// One or more security groups.
@@ -16243,6 +22592,16 @@ type metadataReservation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Reservation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Reservation) GoString() string {
+	return s.String()
+}
+
 // Describes the limit price of a Reserved Instance offering.
 type ReservedInstanceLimitPrice struct {
 	// Used for Reserved Instance Marketplace offerings. Specifies the limit price
@@ -16251,7 +22610,9 @@ type ReservedInstanceLimitPrice struct {
 
 	// The currency in which the limitPrice amount is specified. At this time, the
 	// only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	//
+	// See the CurrencyCodeValues* constants for valid values.
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	metadataReservedInstanceLimitPrice `json:"-" xml:"-"`
 }
@@ -16260,14 +22621,26 @@ type metadataReservedInstanceLimitPrice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Describes a Reserved Instance.
+// String returns the string representation
+func (s ReservedInstanceLimitPrice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstanceLimitPrice) GoString() string {
+	return s.String()
+}
+
+// Describes a Reserved Instance.
 type ReservedInstances struct {
 	// The Availability Zone in which the Reserved Instance can be used.
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
 
 	// The currency of the Reserved Instance. It's specified using ISO 4217 standard
 	// currency codes. At this time, the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	//
+	// See the CurrencyCodeValues* constants for valid values.
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The duration of the Reserved Instance, in seconds.
 	Duration *int64 `locationName:"duration" type:"long"`
@@ -16282,16 +22655,24 @@ type ReservedInstances struct {
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The tenancy of the reserved instance.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	//
+	// See the Tenancy* constants for valid values.
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// The Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	//
+	// See the OfferingTypeValues* constants for valid values.
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The Reserved Instance product platform description.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	//
+	// See the RIProductDescription* constants for valid values.
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The recurring charge tag assigned to the resource.
 	RecurringCharges []*RecurringCharge `locationName:"recurringCharges" locationNameList:"item" type:"list"`
@@ -16303,7 +22684,9 @@ type ReservedInstances struct {
 	Start *time.Time `locationName:"start" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state of the Reserved Instance purchase.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the ReservedInstanceState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"payment-pending,active,payment-failed,retired"`
 
 	// Any tags assigned to the resource.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -16318,6 +22701,16 @@ type metadataReservedInstances struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedInstances) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstances) GoString() string {
+	return s.String()
+}
+
 // Describes the configuration settings for the modified Reserved Instances.
 type ReservedInstancesConfiguration struct {
 	// The Availability Zone for the modified Reserved Instances.
@@ -16327,7 +22720,9 @@ type ReservedInstancesConfiguration struct {
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The instance type for the modified Reserved Instances.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// The network platform of the modified Reserved Instances, which is either
 	// EC2-Classic or EC2-VPC.
@@ -16340,6 +22735,16 @@ type metadataReservedInstancesConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedInstancesConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstancesConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes the ID of a Reserved Instance.
 type ReservedInstancesID struct {
 	// The ID of the Reserved Instance.
@@ -16352,6 +22757,16 @@ type metadataReservedInstancesID struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedInstancesID) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstancesID) GoString() string {
+	return s.String()
+}
+
 // Describes a Reserved Instance listing.
 type ReservedInstancesListing struct {
 	// A unique, case-sensitive key supplied by the client to ensure that the request
@@ -16374,7 +22789,9 @@ type ReservedInstancesListing struct {
 	ReservedInstancesListingID *string `locationName:"reservedInstancesListingId" type:"string"`
 
 	// The status of the Reserved Instance listing.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the ListingStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"active,pending,cancelled,closed"`
 
 	// The reason for the current status of the Reserved Instance listing. The response
 	// can be blank.
@@ -16393,6 +22810,16 @@ type metadataReservedInstancesListing struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedInstancesListing) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstancesListing) GoString() string {
+	return s.String()
+}
+
 // Describes a Reserved Instance modification.
 type ReservedInstancesModification struct {
 	// A unique, case-sensitive key supplied by the client to ensure that the request
@@ -16431,6 +22858,16 @@ type metadataReservedInstancesModification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedInstancesModification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstancesModification) GoString() string {
+	return s.String()
+}
+
 type ReservedInstancesModificationResult struct {
 	// The ID for the Reserved Instances that were created as part of the modification
 	// request. This field is only available when the modification is fulfilled.
@@ -16447,6 +22884,16 @@ type metadataReservedInstancesModificationResult struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedInstancesModificationResult) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstancesModificationResult) GoString() string {
+	return s.String()
+}
+
 // Describes a Reserved Instance offering.
 type ReservedInstancesOffering struct {
 	// The Availability Zone in which the Reserved Instance can be used.
@@ -16455,7 +22902,9 @@ type ReservedInstancesOffering struct {
 	// The currency of the Reserved Instance offering you are purchasing. It's specified
 	// using ISO 4217 standard currency codes. At this time, the only supported
 	// currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	//
+	// See the CurrencyCodeValues* constants for valid values.
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The duration of the Reserved Instance, in seconds.
 	Duration *int64 `locationName:"duration" type:"long"`
@@ -16464,10 +22913,14 @@ type ReservedInstancesOffering struct {
 	FixedPrice *float64 `locationName:"fixedPrice" type:"float"`
 
 	// The tenancy of the reserved instance.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	//
+	// See the Tenancy* constants for valid values.
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// Indicates whether the offering is available through the Reserved Instance
 	// Marketplace (resale) or AWS. If it's a Reserved Instance Marketplace offering,
@@ -16475,13 +22928,17 @@ type ReservedInstancesOffering struct {
 	Marketplace *bool `locationName:"marketplace" type:"boolean"`
 
 	// The Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	//
+	// See the OfferingTypeValues* constants for valid values.
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The pricing details of the Reserved Instance offering.
 	PricingDetails []*PricingDetail `locationName:"pricingDetailsSet" locationNameList:"item" type:"list"`
 
 	// The Reserved Instance product platform description.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	//
+	// See the RIProductDescription* constants for valid values.
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The recurring charge tag assigned to the resource.
 	RecurringCharges []*RecurringCharge `locationName:"recurringCharges" locationNameList:"item" type:"list"`
@@ -16499,10 +22956,22 @@ type metadataReservedInstancesOffering struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedInstancesOffering) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedInstancesOffering) GoString() string {
+	return s.String()
+}
+
 type ResetImageAttributeInput struct {
 	// The attribute to reset (currently you can only reset the launch permission
 	// attribute).
-	Attribute *string `type:"string" required:"true"`
+	//
+	// See the ResetImageAttributeName* constants for valid values.
+	Attribute *string `type:"string" enum:"launchPermission" required:"true"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -16520,6 +22989,16 @@ type metadataResetImageAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetImageAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetImageAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ResetImageAttributeOutput struct {
 	metadataResetImageAttributeOutput `json:"-" xml:"-"`
 }
@@ -16528,9 +23007,21 @@ type metadataResetImageAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetImageAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetImageAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ResetInstanceAttributeInput struct {
 	// The attribute to reset.
-	Attribute *string `locationName:"attribute" type:"string" required:"true"`
+	//
+	// See the InstanceAttributeName* constants for valid values.
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport" required:"true"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -16548,6 +23039,16 @@ type metadataResetInstanceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetInstanceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetInstanceAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ResetInstanceAttributeOutput struct {
 	metadataResetInstanceAttributeOutput `json:"-" xml:"-"`
 }
@@ -16556,6 +23057,16 @@ type metadataResetInstanceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetInstanceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetInstanceAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ResetNetworkInterfaceAttributeInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -16576,6 +23087,16 @@ type metadataResetNetworkInterfaceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetNetworkInterfaceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetNetworkInterfaceAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ResetNetworkInterfaceAttributeOutput struct {
 	metadataResetNetworkInterfaceAttributeOutput `json:"-" xml:"-"`
 }
@@ -16584,10 +23105,22 @@ type metadataResetNetworkInterfaceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetNetworkInterfaceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetNetworkInterfaceAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type ResetSnapshotAttributeInput struct {
 	// The attribute to reset (currently only the attribute for permission to create
 	// volumes can be reset).
-	Attribute *string `type:"string" required:"true"`
+	//
+	// See the SnapshotAttributeName* constants for valid values.
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission" required:"true"`
 
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -16605,6 +23138,16 @@ type metadataResetSnapshotAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetSnapshotAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetSnapshotAttributeInput) GoString() string {
+	return s.String()
+}
+
 type ResetSnapshotAttributeOutput struct {
 	metadataResetSnapshotAttributeOutput `json:"-" xml:"-"`
 }
@@ -16613,6 +23156,16 @@ type metadataResetSnapshotAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetSnapshotAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetSnapshotAttributeOutput) GoString() string {
+	return s.String()
+}
+
 type RestoreAddressToClassicInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -16630,12 +23183,24 @@ type metadataRestoreAddressToClassicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RestoreAddressToClassicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RestoreAddressToClassicInput) GoString() string {
+	return s.String()
+}
+
 type RestoreAddressToClassicOutput struct {
 	// The Elastic IP address.
 	PublicIP *string `locationName:"publicIp" type:"string"`
 
 	// The move status for the IP address.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the Status* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"MoveInProgress,InVpc,InClassic"`
 
 	metadataRestoreAddressToClassicOutput `json:"-" xml:"-"`
 }
@@ -16644,6 +23209,16 @@ type metadataRestoreAddressToClassicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RestoreAddressToClassicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RestoreAddressToClassicOutput) GoString() string {
+	return s.String()
+}
+
 type RevokeSecurityGroupEgressInput struct {
 	// The CIDR IP address range. You can't specify this parameter when specifying
 	// a source security group.
@@ -16689,6 +23264,16 @@ type metadataRevokeSecurityGroupEgressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeSecurityGroupEgressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeSecurityGroupEgressInput) GoString() string {
+	return s.String()
+}
+
 type RevokeSecurityGroupEgressOutput struct {
 	metadataRevokeSecurityGroupEgressOutput `json:"-" xml:"-"`
 }
@@ -16697,6 +23282,16 @@ type metadataRevokeSecurityGroupEgressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeSecurityGroupEgressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeSecurityGroupEgressOutput) GoString() string {
+	return s.String()
+}
+
 type RevokeSecurityGroupIngressInput struct {
 	// The CIDR IP address range. You can't specify this parameter when specifying
 	// a source security group.
@@ -16748,6 +23343,16 @@ type metadataRevokeSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeSecurityGroupIngressInput) GoString() string {
+	return s.String()
+}
+
 type RevokeSecurityGroupIngressOutput struct {
 	metadataRevokeSecurityGroupIngressOutput `json:"-" xml:"-"`
 }
@@ -16756,6 +23361,16 @@ type metadataRevokeSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeSecurityGroupIngressOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a route in a route table.
 type Route struct {
 	// The CIDR block used for the destination match.
@@ -16782,12 +23397,16 @@ type Route struct {
 	// route table was created.  CreateRoute indicates that the route was manually
 	// added to the route table.  EnableVgwRoutePropagation indicates that the route
 	// was propagated by route propagation.
-	Origin *string `locationName:"origin" type:"string"`
+	//
+	// See the RouteOrigin* constants for valid values.
+	Origin *string `locationName:"origin" type:"string" enum:"CreateRouteTable,CreateRoute,EnableVgwRoutePropagation"`
 
 	// The state of the route. The blackhole state indicates that the route's target
 	// isn't available (for example, the specified gateway isn't attached to the
 	// VPC, or the specified NAT instance has been terminated).
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the RouteState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"active,blackhole"`
 
 	// The ID of the VPC peering connection.
 	VPCPeeringConnectionID *string `locationName:"vpcPeeringConnectionId" type:"string"`
@@ -16799,6 +23418,16 @@ type metadataRoute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Route) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Route) GoString() string {
+	return s.String()
+}
+
 // Describes a route table.
 type RouteTable struct {
 	// The associations between the route table and one or more subnets.
@@ -16826,6 +23455,16 @@ type metadataRouteTable struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RouteTable) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RouteTable) GoString() string {
+	return s.String()
+}
+
 // Describes an association between a route table and a subnet.
 type RouteTableAssociation struct {
 	// Indicates whether this is the main route table.
@@ -16847,6 +23486,16 @@ type metadataRouteTableAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RouteTableAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RouteTableAssociation) GoString() string {
+	return s.String()
+}
+
 type RunInstancesInput struct {
 	// Reserved.
 	AdditionalInfo *string `locationName:"additionalInfo" type:"string"`
@@ -16896,13 +23545,17 @@ type RunInstancesInput struct {
 	// from the instance (using the operating system command for system shutdown).
 	//
 	// Default: stop
-	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string"`
+	//
+	// See the ShutdownBehavior* constants for valid values.
+	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string" enum:"stop,terminate"`
 
 	// The instance type. For more information, see Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
 	//
 	// Default: m1.small
-	InstanceType *string `type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// The ID of the kernel.
 	//
@@ -16988,6 +23641,16 @@ type metadataRunInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RunInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RunInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Describes the monitoring for the instance.
 type RunInstancesMonitoringEnabled struct {
 	// Indicates whether monitoring is enabled for the instance.
@@ -17000,6 +23663,16 @@ type metadataRunInstancesMonitoringEnabled struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RunInstancesMonitoringEnabled) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RunInstancesMonitoringEnabled) GoString() string {
+	return s.String()
+}
+
 // Describes the storage parameters for S3 and S3 buckets for an instance store-backed
 // AMI.
 type S3Storage struct {
@@ -17030,6 +23703,16 @@ type metadataS3Storage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s S3Storage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s S3Storage) GoString() string {
+	return s.String()
+}
+
 // Describes a security group
 type SecurityGroup struct {
 	// A description of the security group.
@@ -17063,6 +23746,16 @@ type metadataSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SecurityGroup) GoString() string {
+	return s.String()
+}
+
 // Describes a snapshot.
 type Snapshot struct {
 	// The description for the snapshot.
@@ -17092,7 +23785,9 @@ type Snapshot struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The snapshot state.
-	State *string `locationName:"status" type:"string"`
+	//
+	// See the SnapshotState* constants for valid values.
+	State *string `locationName:"status" type:"string" enum:"pending,completed,error"`
 
 	// Any tags assigned to the snapshot.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -17110,6 +23805,16 @@ type metadataSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Snapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Snapshot) GoString() string {
+	return s.String()
+}
+
 // Describes the snapshot created from the imported disk.
 type SnapshotDetail struct {
 	// A description for the snapshot.
@@ -17149,6 +23854,16 @@ type metadataSnapshotDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SnapshotDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SnapshotDetail) GoString() string {
+	return s.String()
+}
+
 // The disk container object for the import snapshot request.
 type SnapshotDiskContainer struct {
 	// The description of the disk image being imported.
@@ -17173,6 +23888,16 @@ type metadataSnapshotDiskContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SnapshotDiskContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SnapshotDiskContainer) GoString() string {
+	return s.String()
+}
+
 // Details about the import snapshot task.
 type SnapshotTaskDetail struct {
 	// The description of the snapshot.
@@ -17209,6 +23934,16 @@ type metadataSnapshotTaskDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SnapshotTaskDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SnapshotTaskDetail) GoString() string {
+	return s.String()
+}
+
 // Describes the data feed for a Spot Instance.
 type SpotDatafeedSubscription struct {
 	// The Amazon S3 bucket where the Spot Instance data feed is located.
@@ -17224,7 +23959,9 @@ type SpotDatafeedSubscription struct {
 	Prefix *string `locationName:"prefix" type:"string"`
 
 	// The state of the Spot Instance data feed subscription.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the DatafeedSubscriptionState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"Active,Inactive"`
 
 	metadataSpotDatafeedSubscription `json:"-" xml:"-"`
 }
@@ -17233,6 +23970,16 @@ type metadataSpotDatafeedSubscription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotDatafeedSubscription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotDatafeedSubscription) GoString() string {
+	return s.String()
+}
+
 // Describes a Spot fleet request.
 type SpotFleetRequestConfig struct {
 	// Information about the configuration of the Spot fleet request.
@@ -17242,7 +23989,9 @@ type SpotFleetRequestConfig struct {
 	SpotFleetRequestID *string `locationName:"spotFleetRequestId" type:"string" required:"true"`
 
 	// The state of the Spot fleet request.
-	SpotFleetRequestState *string `locationName:"spotFleetRequestState" type:"string" required:"true"`
+	//
+	// See the BatchState* constants for valid values.
+	SpotFleetRequestState *string `locationName:"spotFleetRequestState" type:"string" enum:"submitted,active,cancelled,failed,cancelled_running,cancelled_terminating" required:"true"`
 
 	metadataSpotFleetRequestConfig `json:"-" xml:"-"`
 }
@@ -17251,6 +24000,16 @@ type metadataSpotFleetRequestConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotFleetRequestConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotFleetRequestConfig) GoString() string {
+	return s.String()
+}
+
 // Describes the configuration of a Spot fleet request.
 type SpotFleetRequestConfigData struct {
 	// A unique, case-sensitive identifier you provide to ensure idempotency of
@@ -17264,7 +24023,7 @@ type SpotFleetRequestConfigData struct {
 	IAMFleetRole *string `locationName:"iamFleetRole" type:"string" required:"true"`
 
 	// Information about the launch specifications for the instances.
-	LaunchSpecifications []*LaunchSpecification `locationName:"launchSpecifications" locationNameList:"item" type:"list" required:"true"`
+	LaunchSpecifications []*LaunchSpecification `locationName:"launchSpecifications" locationNameList:"item" type:"list" min:"1" required:"true"`
 
 	// The maximum hourly price (bid) for any Spot Instance launched to fulfill
 	// the request.
@@ -17293,6 +24052,16 @@ type metadataSpotFleetRequestConfigData struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotFleetRequestConfigData) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotFleetRequestConfigData) GoString() string {
+	return s.String()
+}
+
 // Describe a Spot Instance request.
 type SpotInstanceRequest struct {
 	// The Availability Zone group. If you specify the same Availability Zone group
@@ -17322,7 +24091,9 @@ type SpotInstanceRequest struct {
 	LaunchedAvailabilityZone *string `locationName:"launchedAvailabilityZone" type:"string"`
 
 	// The product description associated with the Spot Instance.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	//
+	// See the RIProductDescription* constants for valid values.
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The ID of the Spot Instance request.
 	SpotInstanceRequestID *string `locationName:"spotInstanceRequestId" type:"string"`
@@ -17335,7 +24106,9 @@ type SpotInstanceRequest struct {
 	// you track your Spot Instance requests. For more information, see Spot Bid
 	// Status (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-bid-status.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the SpotInstanceState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"open,active,closed,cancelled,failed"`
 
 	// The status code and status message describing the Spot Instance request.
 	Status *SpotInstanceStatus `locationName:"status" type:"structure"`
@@ -17344,7 +24117,9 @@ type SpotInstanceRequest struct {
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The Spot Instance request type.
-	Type *string `locationName:"type" type:"string"`
+	//
+	// See the SpotInstanceType* constants for valid values.
+	Type *string `locationName:"type" type:"string" enum:"one-time,persistent"`
 
 	// The start date of the request, in UTC format (for example, YYYY-MM-DDTHH:MM:SSZ).
 	// If this is a one-time request, the request becomes active at this date and
@@ -17366,6 +24141,16 @@ type metadataSpotInstanceRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotInstanceRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotInstanceRequest) GoString() string {
+	return s.String()
+}
+
 // Describes a Spot Instance state change.
 type SpotInstanceStateFault struct {
 	// The reason code for the Spot Instance state change.
@@ -17381,6 +24166,16 @@ type metadataSpotInstanceStateFault struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotInstanceStateFault) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotInstanceStateFault) GoString() string {
+	return s.String()
+}
+
 // Describes the status of a Spot Instance request.
 type SpotInstanceStatus struct {
 	// The status code.
@@ -17400,6 +24195,16 @@ type metadataSpotInstanceStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotInstanceStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotInstanceStatus) GoString() string {
+	return s.String()
+}
+
 // Describes Spot Instance placement.
 type SpotPlacement struct {
 	// The Availability Zone.
@@ -17415,6 +24220,16 @@ type metadataSpotPlacement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotPlacement) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotPlacement) GoString() string {
+	return s.String()
+}
+
 // Describes the maximum hourly price (bid) for any Spot Instance launched to
 // fulfill the request.
 type SpotPrice struct {
@@ -17422,10 +24237,14 @@ type SpotPrice struct {
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	//
+	// See the InstanceType* constants for valid values.
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,m4.large,m4.xlarge,m4.2xlarge,m4.4xlarge,m4.10xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge,d2.xlarge,d2.2xlarge,d2.4xlarge,d2.8xlarge"`
 
 	// A general description of the AMI.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	//
+	// See the RIProductDescription* constants for valid values.
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The maximum price (bid) that you are willing to pay for a Spot Instance.
 	SpotPrice *string `locationName:"spotPrice" type:"string"`
@@ -17440,6 +24259,16 @@ type metadataSpotPrice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SpotPrice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SpotPrice) GoString() string {
+	return s.String()
+}
+
 type StartInstancesInput struct {
 	// Reserved.
 	AdditionalInfo *string `locationName:"additionalInfo" type:"string"`
@@ -17460,6 +24289,16 @@ type metadataStartInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartInstancesInput) GoString() string {
+	return s.String()
+}
+
 type StartInstancesOutput struct {
 	// Information about one or more started instances.
 	StartingInstances []*InstanceStateChange `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -17471,6 +24310,16 @@ type metadataStartInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a state change.
 type StateReason struct {
 	// The reason code for the state change.
@@ -17508,6 +24357,16 @@ type metadataStateReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StateReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StateReason) GoString() string {
+	return s.String()
+}
+
 type StopInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -17533,6 +24392,16 @@ type metadataStopInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopInstancesInput) GoString() string {
+	return s.String()
+}
+
 type StopInstancesOutput struct {
 	// Information about one or more stopped instances.
 	StoppingInstances []*InstanceStateChange `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -17544,6 +24413,16 @@ type metadataStopInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the storage location for an instance store-backed AMI.
 type Storage struct {
 	// An Amazon S3 storage location.
@@ -17556,6 +24435,16 @@ type metadataStorage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Storage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Storage) GoString() string {
+	return s.String()
+}
+
 // Describes a subnet.
 type Subnet struct {
 	// The Availability Zone of the subnet.
@@ -17575,7 +24464,9 @@ type Subnet struct {
 	MapPublicIPOnLaunch *bool `locationName:"mapPublicIpOnLaunch" type:"boolean"`
 
 	// The current state of the subnet.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the SubnetState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"pending,available"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -17593,6 +24484,16 @@ type metadataSubnet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Subnet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Subnet) GoString() string {
+	return s.String()
+}
+
 // Describes a tag.
 type Tag struct {
 	// The key of the tag.
@@ -17614,6 +24515,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // Describes a tag.
 type TagDescription struct {
 	// The tag key.
@@ -17623,7 +24534,9 @@ type TagDescription struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The resource type.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	//
+	// See the ResourceType* constants for valid values.
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"customer-gateway,dhcp-options,image,instance,internet-gateway,network-acl,network-interface,reserved-instances,route-table,snapshot,spot-instances-request,subnet,security-group,volume,vpc,vpn-connection,vpn-gateway"`
 
 	// The tag value.
 	Value *string `locationName:"value" type:"string"`
@@ -17635,6 +24548,16 @@ type metadataTagDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TagDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TagDescription) GoString() string {
+	return s.String()
+}
+
 type TerminateInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -17652,6 +24575,16 @@ type metadataTerminateInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateInstancesInput) GoString() string {
+	return s.String()
+}
+
 type TerminateInstancesOutput struct {
 	// Information about one or more terminated instances.
 	TerminatingInstances []*InstanceStateChange `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -17663,6 +24596,16 @@ type metadataTerminateInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type UnassignPrivateIPAddressesInput struct {
 	// The ID of the network interface.
 	NetworkInterfaceID *string `locationName:"networkInterfaceId" type:"string" required:"true"`
@@ -17678,6 +24621,16 @@ type metadataUnassignPrivateIPAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnassignPrivateIPAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnassignPrivateIPAddressesInput) GoString() string {
+	return s.String()
+}
+
 type UnassignPrivateIPAddressesOutput struct {
 	metadataUnassignPrivateIPAddressesOutput `json:"-" xml:"-"`
 }
@@ -17686,6 +24639,16 @@ type metadataUnassignPrivateIPAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnassignPrivateIPAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnassignPrivateIPAddressesOutput) GoString() string {
+	return s.String()
+}
+
 type UnmonitorInstancesInput struct {
 	// Checks whether you have the required permissions for the action, without
 	// actually making the request, and provides an error response. If you have
@@ -17703,6 +24666,16 @@ type metadataUnmonitorInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnmonitorInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnmonitorInstancesInput) GoString() string {
+	return s.String()
+}
+
 type UnmonitorInstancesOutput struct {
 	// Monitoring information for one or more instances.
 	InstanceMonitorings []*InstanceMonitoring `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -17714,6 +24687,16 @@ type metadataUnmonitorInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnmonitorInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnmonitorInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // Information about items that were not successfully processed in a batch call.
 type UnsuccessfulItem struct {
 	// Information about the error.
@@ -17729,6 +24712,16 @@ type metadataUnsuccessfulItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnsuccessfulItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnsuccessfulItem) GoString() string {
+	return s.String()
+}
+
 // Information about the error that occured. For more information about errors,
 // see Error Codes (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/errors-overview.html).
 type UnsuccessfulItemError struct {
@@ -17745,6 +24738,16 @@ type metadataUnsuccessfulItemError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnsuccessfulItemError) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnsuccessfulItemError) GoString() string {
+	return s.String()
+}
+
 // Describes the S3 bucket for the disk image.
 type UserBucket struct {
 	// The name of the S3 bucket where the disk image is located.
@@ -17760,6 +24763,16 @@ type metadataUserBucket struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UserBucket) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UserBucket) GoString() string {
+	return s.String()
+}
+
 // Describes the S3 bucket for the disk image.
 type UserBucketDetails struct {
 	// The S3 bucket from which the disk image was created.
@@ -17775,6 +24788,16 @@ type metadataUserBucketDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UserBucketDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UserBucketDetails) GoString() string {
+	return s.String()
+}
+
 // Describes the user data to be made available to an instance.
 type UserData struct {
 	// The Base64-encoded MIME user data for the instance.
@@ -17787,6 +24810,16 @@ type metadataUserData struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UserData) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UserData) GoString() string {
+	return s.String()
+}
+
 // Describes a security group and AWS account ID pair.
 type UserIDGroupPair struct {
 	// The ID of the security group.
@@ -17807,6 +24840,16 @@ type metadataUserIDGroupPair struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UserIDGroupPair) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UserIDGroupPair) GoString() string {
+	return s.String()
+}
+
 // Describes telemetry for a VPN tunnel.
 type VGWTelemetry struct {
 	// The number of accepted routes.
@@ -17820,7 +24863,9 @@ type VGWTelemetry struct {
 	OutsideIPAddress *string `locationName:"outsideIpAddress" type:"string"`
 
 	// The status of the VPN tunnel.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the TelemetryStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"UP,DOWN"`
 
 	// If an error occurs, a description of the error.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -17832,6 +24877,16 @@ type metadataVGWTelemetry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VGWTelemetry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VGWTelemetry) GoString() string {
+	return s.String()
+}
+
 // Describes a VPC.
 type VPC struct {
 	// The CIDR block for the VPC.
@@ -17842,13 +24897,17 @@ type VPC struct {
 	DHCPOptionsID *string `locationName:"dhcpOptionsId" type:"string"`
 
 	// The allowed tenancy of instances launched into the VPC.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	//
+	// See the Tenancy* constants for valid values.
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// Indicates whether the VPC is the default VPC.
 	IsDefault *bool `locationName:"isDefault" type:"boolean"`
 
 	// The current state of the VPC.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the VpcState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"pending,available"`
 
 	// Any tags assigned to the VPC.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -17863,10 +24922,22 @@ type metadataVPC struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPC) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPC) GoString() string {
+	return s.String()
+}
+
 // Describes an attachment between a virtual private gateway and a VPC.
 type VPCAttachment struct {
 	// The current state of the attachment.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the AttachmentStatus* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the VPC.
 	VPCID *string `locationName:"vpcId" type:"string"`
@@ -17878,6 +24949,16 @@ type metadataVPCAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPCAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPCAttachment) GoString() string {
+	return s.String()
+}
+
 // Describes whether a VPC is enabled for ClassicLink.
 type VPCClassicLink struct {
 	// Indicates whether the VPC is enabled for ClassicLink.
@@ -17896,6 +24977,16 @@ type metadataVPCClassicLink struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPCClassicLink) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPCClassicLink) GoString() string {
+	return s.String()
+}
+
 // Describes a VPC endpoint.
 type VPCEndpoint struct {
 	// The date and time the VPC endpoint was created.
@@ -17911,7 +25002,9 @@ type VPCEndpoint struct {
 	ServiceName *string `locationName:"serviceName" type:"string"`
 
 	// The state of the VPC endpoint.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the State* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"Pending,Available,Deleting,Deleted"`
 
 	// The ID of the VPC endpoint.
 	VPCEndpointID *string `locationName:"vpcEndpointId" type:"string"`
@@ -17926,6 +25019,16 @@ type metadataVPCEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPCEndpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPCEndpoint) GoString() string {
+	return s.String()
+}
+
 // Describes a VPC peering connection.
 type VPCPeeringConnection struct {
 	// The information of the peer VPC.
@@ -17953,6 +25056,16 @@ type metadataVPCPeeringConnection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPCPeeringConnection) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPCPeeringConnection) GoString() string {
+	return s.String()
+}
+
 // Describes the status of a VPC peering connection.
 type VPCPeeringConnectionStateReason struct {
 	// The status of the VPC peering connection.
@@ -17968,6 +25081,16 @@ type metadataVPCPeeringConnectionStateReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPCPeeringConnectionStateReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPCPeeringConnectionStateReason) GoString() string {
+	return s.String()
+}
+
 // Describes a VPC in a VPC peering connection.
 type VPCPeeringConnectionVPCInfo struct {
 	// The CIDR block for the VPC.
@@ -17986,6 +25109,16 @@ type metadataVPCPeeringConnectionVPCInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPCPeeringConnectionVPCInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPCPeeringConnectionVPCInfo) GoString() string {
+	return s.String()
+}
+
 // Describes a VPN connection.
 type VPNConnection struct {
 	// The configuration information for the VPN connection's customer gateway (in
@@ -18004,13 +25137,17 @@ type VPNConnection struct {
 	Routes []*VPNStaticRoute `locationName:"routes" locationNameList:"item" type:"list"`
 
 	// The current state of the VPN connection.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the VpnState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// Any tags assigned to the VPN connection.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of VPN connection.
-	Type *string `locationName:"type" type:"string"`
+	//
+	// See the GatewayType* constants for valid values.
+	Type *string `locationName:"type" type:"string" enum:"ipsec.1"`
 
 	// Information about the VPN tunnel.
 	VGWTelemetry []*VGWTelemetry `locationName:"vgwTelemetry" locationNameList:"item" type:"list"`
@@ -18028,6 +25165,16 @@ type metadataVPNConnection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPNConnection) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPNConnection) GoString() string {
+	return s.String()
+}
+
 // Describes VPN connection options.
 type VPNConnectionOptions struct {
 	// Indicates whether the VPN connection uses static routes only. Static routes
@@ -18041,6 +25188,16 @@ type metadataVPNConnectionOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPNConnectionOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPNConnectionOptions) GoString() string {
+	return s.String()
+}
+
 // Describes VPN connection options.
 type VPNConnectionOptionsSpecification struct {
 	// Indicates whether the VPN connection uses static routes only. Static routes
@@ -18054,19 +25211,33 @@ type metadataVPNConnectionOptionsSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPNConnectionOptionsSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPNConnectionOptionsSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes a virtual private gateway.
 type VPNGateway struct {
 	// The Availability Zone where the virtual private gateway was created.
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
 
 	// The current state of the virtual private gateway.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the VpnState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// Any tags assigned to the virtual private gateway.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of VPN connection the virtual private gateway supports.
-	Type *string `locationName:"type" type:"string"`
+	//
+	// See the GatewayType* constants for valid values.
+	Type *string `locationName:"type" type:"string" enum:"ipsec.1"`
 
 	// Any VPCs attached to the virtual private gateway.
 	VPCAttachments []*VPCAttachment `locationName:"attachments" locationNameList:"item" type:"list"`
@@ -18081,16 +25252,30 @@ type metadataVPNGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPNGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPNGateway) GoString() string {
+	return s.String()
+}
+
 // Describes a static route for a VPN connection.
 type VPNStaticRoute struct {
 	// The CIDR block associated with the local subnet of the customer data center.
 	DestinationCIDRBlock *string `locationName:"destinationCidrBlock" type:"string"`
 
 	// Indicates how the routes were provided.
-	Source *string `locationName:"source" type:"string"`
+	//
+	// See the VpnStaticRouteSource* constants for valid values.
+	Source *string `locationName:"source" type:"string" enum:"Static"`
 
 	// The current state of the static route.
-	State *string `locationName:"state" type:"string"`
+	//
+	// See the VpnState* constants for valid values.
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	metadataVPNStaticRoute `json:"-" xml:"-"`
 }
@@ -18099,6 +25284,16 @@ type metadataVPNStaticRoute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VPNStaticRoute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPNStaticRoute) GoString() string {
+	return s.String()
+}
+
 // Describes a volume.
 type Volume struct {
 	// Information about the volume attachments.
@@ -18139,7 +25334,9 @@ type Volume struct {
 	SnapshotID *string `locationName:"snapshotId" type:"string"`
 
 	// The volume state.
-	State *string `locationName:"status" type:"string"`
+	//
+	// See the VolumeState* constants for valid values.
+	State *string `locationName:"status" type:"string" enum:"creating,available,in-use,deleting,deleted,error"`
 
 	// Any tags assigned to the volume.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -18149,7 +25346,9 @@ type Volume struct {
 
 	// The volume type. This can be gp2 for General Purpose (SSD) volumes, io1 for
 	// Provisioned IOPS (SSD) volumes, or standard for Magnetic volumes.
-	VolumeType *string `locationName:"volumeType" type:"string"`
+	//
+	// See the VolumeType* constants for valid values.
+	VolumeType *string `locationName:"volumeType" type:"string" enum:"standard,io1,gp2"`
 
 	metadataVolume `json:"-" xml:"-"`
 }
@@ -18158,6 +25357,16 @@ type metadataVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Volume) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Volume) GoString() string {
+	return s.String()
+}
+
 // Describes volume attachment details.
 type VolumeAttachment struct {
 	// The time stamp when the attachment initiated.
@@ -18173,7 +25382,9 @@ type VolumeAttachment struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The attachment state of the volume.
-	State *string `locationName:"status" type:"string"`
+	//
+	// See the VolumeAttachmentState* constants for valid values.
+	State *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the volume.
 	VolumeID *string `locationName:"volumeId" type:"string"`
@@ -18185,6 +25396,16 @@ type metadataVolumeAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeAttachment) GoString() string {
+	return s.String()
+}
+
 // Describes an EBS volume.
 type VolumeDetail struct {
 	// The size of the volume, in GiB.
@@ -18197,6 +25418,16 @@ type metadataVolumeDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeDetail) GoString() string {
+	return s.String()
+}
+
 // Describes a volume status operation code.
 type VolumeStatusAction struct {
 	// The code identifying the operation, for example, enable-volume-io.
@@ -18218,10 +25449,22 @@ type metadataVolumeStatusAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeStatusAction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeStatusAction) GoString() string {
+	return s.String()
+}
+
 // Describes a volume status.
 type VolumeStatusDetails struct {
 	// The name of the volume status.
-	Name *string `locationName:"name" type:"string"`
+	//
+	// See the VolumeStatusName* constants for valid values.
+	Name *string `locationName:"name" type:"string" enum:"io-enabled,io-performance"`
 
 	// The intended status of the volume status.
 	Status *string `locationName:"status" type:"string"`
@@ -18233,6 +25476,16 @@ type metadataVolumeStatusDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeStatusDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeStatusDetails) GoString() string {
+	return s.String()
+}
+
 // Describes a volume status event.
 type VolumeStatusEvent struct {
 	// A description of the event.
@@ -18257,13 +25510,25 @@ type metadataVolumeStatusEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeStatusEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeStatusEvent) GoString() string {
+	return s.String()
+}
+
 // Describes the status of a volume.
 type VolumeStatusInfo struct {
 	// The details of the volume status.
 	Details []*VolumeStatusDetails `locationName:"details" locationNameList:"item" type:"list"`
 
 	// The status of the volume.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the VolumeStatusInfoStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired,insufficient-data"`
 
 	metadataVolumeStatusInfo `json:"-" xml:"-"`
 }
@@ -18272,6 +25537,16 @@ type metadataVolumeStatusInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeStatusInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeStatusInfo) GoString() string {
+	return s.String()
+}
+
 // Describes the volume status.
 type VolumeStatusItem struct {
 	// The details of the operation.
@@ -18295,3 +25570,859 @@ type VolumeStatusItem struct {
 type metadataVolumeStatusItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VolumeStatusItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeStatusItem) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum AccountAttributeName
+	AccountAttributeNameSupportedPlatforms = "supported-platforms"
+	// @enum AccountAttributeName
+	AccountAttributeNameDefaultVpc = "default-vpc"
+)
+
+const (
+	// @enum ArchitectureValues
+	ArchitectureValuesI386 = "i386"
+	// @enum ArchitectureValues
+	ArchitectureValuesX8664 = "x86_64"
+)
+
+const (
+	// @enum AttachmentStatus
+	AttachmentStatusAttaching = "attaching"
+	// @enum AttachmentStatus
+	AttachmentStatusAttached = "attached"
+	// @enum AttachmentStatus
+	AttachmentStatusDetaching = "detaching"
+	// @enum AttachmentStatus
+	AttachmentStatusDetached = "detached"
+)
+
+const (
+	// @enum AvailabilityZoneState
+	AvailabilityZoneStateAvailable = "available"
+)
+
+const (
+	// @enum BatchState
+	BatchStateSubmitted = "submitted"
+	// @enum BatchState
+	BatchStateActive = "active"
+	// @enum BatchState
+	BatchStateCancelled = "cancelled"
+	// @enum BatchState
+	BatchStateFailed = "failed"
+	// @enum BatchState
+	BatchStateCancelledRunning = "cancelled_running"
+	// @enum BatchState
+	BatchStateCancelledTerminating = "cancelled_terminating"
+)
+
+const (
+	// @enum BundleTaskState
+	BundleTaskStatePending = "pending"
+	// @enum BundleTaskState
+	BundleTaskStateWaitingForShutdown = "waiting-for-shutdown"
+	// @enum BundleTaskState
+	BundleTaskStateBundling = "bundling"
+	// @enum BundleTaskState
+	BundleTaskStateStoring = "storing"
+	// @enum BundleTaskState
+	BundleTaskStateCancelling = "cancelling"
+	// @enum BundleTaskState
+	BundleTaskStateComplete = "complete"
+	// @enum BundleTaskState
+	BundleTaskStateFailed = "failed"
+)
+
+const (
+	// @enum CancelBatchErrorCode
+	CancelBatchErrorCodeFleetRequestIdDoesNotExist = "fleetRequestIdDoesNotExist"
+	// @enum CancelBatchErrorCode
+	CancelBatchErrorCodeFleetRequestIdMalformed = "fleetRequestIdMalformed"
+	// @enum CancelBatchErrorCode
+	CancelBatchErrorCodeFleetRequestNotInCancellableState = "fleetRequestNotInCancellableState"
+	// @enum CancelBatchErrorCode
+	CancelBatchErrorCodeUnexpectedError = "unexpectedError"
+)
+
+const (
+	// @enum CancelSpotInstanceRequestState
+	CancelSpotInstanceRequestStateActive = "active"
+	// @enum CancelSpotInstanceRequestState
+	CancelSpotInstanceRequestStateOpen = "open"
+	// @enum CancelSpotInstanceRequestState
+	CancelSpotInstanceRequestStateClosed = "closed"
+	// @enum CancelSpotInstanceRequestState
+	CancelSpotInstanceRequestStateCancelled = "cancelled"
+	// @enum CancelSpotInstanceRequestState
+	CancelSpotInstanceRequestStateCompleted = "completed"
+)
+
+const (
+	// @enum ContainerFormat
+	ContainerFormatOva = "ova"
+)
+
+const (
+	// @enum ConversionTaskState
+	ConversionTaskStateActive = "active"
+	// @enum ConversionTaskState
+	ConversionTaskStateCancelling = "cancelling"
+	// @enum ConversionTaskState
+	ConversionTaskStateCancelled = "cancelled"
+	// @enum ConversionTaskState
+	ConversionTaskStateCompleted = "completed"
+)
+
+const (
+	// @enum CurrencyCodeValues
+	CurrencyCodeValuesUsd = "USD"
+)
+
+const (
+	// @enum DatafeedSubscriptionState
+	DatafeedSubscriptionStateActive = "Active"
+	// @enum DatafeedSubscriptionState
+	DatafeedSubscriptionStateInactive = "Inactive"
+)
+
+const (
+	// @enum DeviceType
+	DeviceTypeEbs = "ebs"
+	// @enum DeviceType
+	DeviceTypeInstanceStore = "instance-store"
+)
+
+const (
+	// @enum DiskImageFormat
+	DiskImageFormatVmdk = "VMDK"
+	// @enum DiskImageFormat
+	DiskImageFormatRaw = "RAW"
+	// @enum DiskImageFormat
+	DiskImageFormatVhd = "VHD"
+)
+
+const (
+	// @enum DomainType
+	DomainTypeVpc = "vpc"
+	// @enum DomainType
+	DomainTypeStandard = "standard"
+)
+
+const (
+	// @enum EventCode
+	EventCodeInstanceReboot = "instance-reboot"
+	// @enum EventCode
+	EventCodeSystemReboot = "system-reboot"
+	// @enum EventCode
+	EventCodeSystemMaintenance = "system-maintenance"
+	// @enum EventCode
+	EventCodeInstanceRetirement = "instance-retirement"
+	// @enum EventCode
+	EventCodeInstanceStop = "instance-stop"
+)
+
+const (
+	// @enum EventType
+	EventTypeInstanceChange = "instanceChange"
+	// @enum EventType
+	EventTypeFleetRequestChange = "fleetRequestChange"
+	// @enum EventType
+	EventTypeError = "error"
+)
+
+const (
+	// @enum ExportEnvironment
+	ExportEnvironmentCitrix = "citrix"
+	// @enum ExportEnvironment
+	ExportEnvironmentVmware = "vmware"
+	// @enum ExportEnvironment
+	ExportEnvironmentMicrosoft = "microsoft"
+)
+
+const (
+	// @enum ExportTaskState
+	ExportTaskStateActive = "active"
+	// @enum ExportTaskState
+	ExportTaskStateCancelling = "cancelling"
+	// @enum ExportTaskState
+	ExportTaskStateCancelled = "cancelled"
+	// @enum ExportTaskState
+	ExportTaskStateCompleted = "completed"
+)
+
+const (
+	// @enum FlowLogsResourceType
+	FlowLogsResourceTypeVpc = "VPC"
+	// @enum FlowLogsResourceType
+	FlowLogsResourceTypeSubnet = "Subnet"
+	// @enum FlowLogsResourceType
+	FlowLogsResourceTypeNetworkInterface = "NetworkInterface"
+)
+
+const (
+	// @enum GatewayType
+	GatewayTypeIpsec1 = "ipsec.1"
+)
+
+const (
+	// @enum HypervisorType
+	HypervisorTypeOvm = "ovm"
+	// @enum HypervisorType
+	HypervisorTypeXen = "xen"
+)
+
+const (
+	// @enum ImageAttributeName
+	ImageAttributeNameDescription = "description"
+	// @enum ImageAttributeName
+	ImageAttributeNameKernel = "kernel"
+	// @enum ImageAttributeName
+	ImageAttributeNameRamdisk = "ramdisk"
+	// @enum ImageAttributeName
+	ImageAttributeNameLaunchPermission = "launchPermission"
+	// @enum ImageAttributeName
+	ImageAttributeNameProductCodes = "productCodes"
+	// @enum ImageAttributeName
+	ImageAttributeNameBlockDeviceMapping = "blockDeviceMapping"
+	// @enum ImageAttributeName
+	ImageAttributeNameSriovNetSupport = "sriovNetSupport"
+)
+
+const (
+	// @enum ImageState
+	ImageStatePending = "pending"
+	// @enum ImageState
+	ImageStateAvailable = "available"
+	// @enum ImageState
+	ImageStateInvalid = "invalid"
+	// @enum ImageState
+	ImageStateDeregistered = "deregistered"
+	// @enum ImageState
+	ImageStateTransient = "transient"
+	// @enum ImageState
+	ImageStateFailed = "failed"
+	// @enum ImageState
+	ImageStateError = "error"
+)
+
+const (
+	// @enum ImageTypeValues
+	ImageTypeValuesMachine = "machine"
+	// @enum ImageTypeValues
+	ImageTypeValuesKernel = "kernel"
+	// @enum ImageTypeValues
+	ImageTypeValuesRamdisk = "ramdisk"
+)
+
+const (
+	// @enum InstanceAttributeName
+	InstanceAttributeNameInstanceType = "instanceType"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameKernel = "kernel"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameRamdisk = "ramdisk"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameUserData = "userData"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameDisableApiTermination = "disableApiTermination"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameInstanceInitiatedShutdownBehavior = "instanceInitiatedShutdownBehavior"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameRootDeviceName = "rootDeviceName"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameBlockDeviceMapping = "blockDeviceMapping"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameProductCodes = "productCodes"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameSourceDestCheck = "sourceDestCheck"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameGroupSet = "groupSet"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameEbsOptimized = "ebsOptimized"
+	// @enum InstanceAttributeName
+	InstanceAttributeNameSriovNetSupport = "sriovNetSupport"
+)
+
+const (
+	// @enum InstanceLifecycleType
+	InstanceLifecycleTypeSpot = "spot"
+)
+
+const (
+	// @enum InstanceStateName
+	InstanceStateNamePending = "pending"
+	// @enum InstanceStateName
+	InstanceStateNameRunning = "running"
+	// @enum InstanceStateName
+	InstanceStateNameShuttingDown = "shutting-down"
+	// @enum InstanceStateName
+	InstanceStateNameTerminated = "terminated"
+	// @enum InstanceStateName
+	InstanceStateNameStopping = "stopping"
+	// @enum InstanceStateName
+	InstanceStateNameStopped = "stopped"
+)
+
+const (
+	// @enum InstanceType
+	InstanceTypeT1Micro = "t1.micro"
+	// @enum InstanceType
+	InstanceTypeM1Small = "m1.small"
+	// @enum InstanceType
+	InstanceTypeM1Medium = "m1.medium"
+	// @enum InstanceType
+	InstanceTypeM1Large = "m1.large"
+	// @enum InstanceType
+	InstanceTypeM1Xlarge = "m1.xlarge"
+	// @enum InstanceType
+	InstanceTypeM3Medium = "m3.medium"
+	// @enum InstanceType
+	InstanceTypeM3Large = "m3.large"
+	// @enum InstanceType
+	InstanceTypeM3Xlarge = "m3.xlarge"
+	// @enum InstanceType
+	InstanceTypeM32xlarge = "m3.2xlarge"
+	// @enum InstanceType
+	InstanceTypeM4Large = "m4.large"
+	// @enum InstanceType
+	InstanceTypeM4Xlarge = "m4.xlarge"
+	// @enum InstanceType
+	InstanceTypeM42xlarge = "m4.2xlarge"
+	// @enum InstanceType
+	InstanceTypeM44xlarge = "m4.4xlarge"
+	// @enum InstanceType
+	InstanceTypeM410xlarge = "m4.10xlarge"
+	// @enum InstanceType
+	InstanceTypeT2Micro = "t2.micro"
+	// @enum InstanceType
+	InstanceTypeT2Small = "t2.small"
+	// @enum InstanceType
+	InstanceTypeT2Medium = "t2.medium"
+	// @enum InstanceType
+	InstanceTypeM2Xlarge = "m2.xlarge"
+	// @enum InstanceType
+	InstanceTypeM22xlarge = "m2.2xlarge"
+	// @enum InstanceType
+	InstanceTypeM24xlarge = "m2.4xlarge"
+	// @enum InstanceType
+	InstanceTypeCr18xlarge = "cr1.8xlarge"
+	// @enum InstanceType
+	InstanceTypeI2Xlarge = "i2.xlarge"
+	// @enum InstanceType
+	InstanceTypeI22xlarge = "i2.2xlarge"
+	// @enum InstanceType
+	InstanceTypeI24xlarge = "i2.4xlarge"
+	// @enum InstanceType
+	InstanceTypeI28xlarge = "i2.8xlarge"
+	// @enum InstanceType
+	InstanceTypeHi14xlarge = "hi1.4xlarge"
+	// @enum InstanceType
+	InstanceTypeHs18xlarge = "hs1.8xlarge"
+	// @enum InstanceType
+	InstanceTypeC1Medium = "c1.medium"
+	// @enum InstanceType
+	InstanceTypeC1Xlarge = "c1.xlarge"
+	// @enum InstanceType
+	InstanceTypeC3Large = "c3.large"
+	// @enum InstanceType
+	InstanceTypeC3Xlarge = "c3.xlarge"
+	// @enum InstanceType
+	InstanceTypeC32xlarge = "c3.2xlarge"
+	// @enum InstanceType
+	InstanceTypeC34xlarge = "c3.4xlarge"
+	// @enum InstanceType
+	InstanceTypeC38xlarge = "c3.8xlarge"
+	// @enum InstanceType
+	InstanceTypeC4Large = "c4.large"
+	// @enum InstanceType
+	InstanceTypeC4Xlarge = "c4.xlarge"
+	// @enum InstanceType
+	InstanceTypeC42xlarge = "c4.2xlarge"
+	// @enum InstanceType
+	InstanceTypeC44xlarge = "c4.4xlarge"
+	// @enum InstanceType
+	InstanceTypeC48xlarge = "c4.8xlarge"
+	// @enum InstanceType
+	InstanceTypeCc14xlarge = "cc1.4xlarge"
+	// @enum InstanceType
+	InstanceTypeCc28xlarge = "cc2.8xlarge"
+	// @enum InstanceType
+	InstanceTypeG22xlarge = "g2.2xlarge"
+	// @enum InstanceType
+	InstanceTypeCg14xlarge = "cg1.4xlarge"
+	// @enum InstanceType
+	InstanceTypeR3Large = "r3.large"
+	// @enum InstanceType
+	InstanceTypeR3Xlarge = "r3.xlarge"
+	// @enum InstanceType
+	InstanceTypeR32xlarge = "r3.2xlarge"
+	// @enum InstanceType
+	InstanceTypeR34xlarge = "r3.4xlarge"
+	// @enum InstanceType
+	InstanceTypeR38xlarge = "r3.8xlarge"
+	// @enum InstanceType
+	InstanceTypeD2Xlarge = "d2.xlarge"
+	// @enum InstanceType
+	InstanceTypeD22xlarge = "d2.2xlarge"
+	// @enum InstanceType
+	InstanceTypeD24xlarge = "d2.4xlarge"
+	// @enum InstanceType
+	InstanceTypeD28xlarge = "d2.8xlarge"
+)
+
+const (
+	// @enum ListingState
+	ListingStateAvailable = "available"
+	// @enum ListingState
+	ListingStateSold = "sold"
+	// @enum ListingState
+	ListingStateCancelled = "cancelled"
+	// @enum ListingState
+	ListingStatePending = "pending"
+)
+
+const (
+	// @enum ListingStatus
+	ListingStatusActive = "active"
+	// @enum ListingStatus
+	ListingStatusPending = "pending"
+	// @enum ListingStatus
+	ListingStatusCancelled = "cancelled"
+	// @enum ListingStatus
+	ListingStatusClosed = "closed"
+)
+
+const (
+	// @enum MonitoringState
+	MonitoringStateDisabled = "disabled"
+	// @enum MonitoringState
+	MonitoringStateDisabling = "disabling"
+	// @enum MonitoringState
+	MonitoringStateEnabled = "enabled"
+	// @enum MonitoringState
+	MonitoringStatePending = "pending"
+)
+
+const (
+	// @enum MoveStatus
+	MoveStatusMovingToVpc = "movingToVpc"
+	// @enum MoveStatus
+	MoveStatusRestoringToClassic = "restoringToClassic"
+)
+
+const (
+	// @enum NetworkInterfaceAttribute
+	NetworkInterfaceAttributeDescription = "description"
+	// @enum NetworkInterfaceAttribute
+	NetworkInterfaceAttributeGroupSet = "groupSet"
+	// @enum NetworkInterfaceAttribute
+	NetworkInterfaceAttributeSourceDestCheck = "sourceDestCheck"
+	// @enum NetworkInterfaceAttribute
+	NetworkInterfaceAttributeAttachment = "attachment"
+)
+
+const (
+	// @enum NetworkInterfaceStatus
+	NetworkInterfaceStatusAvailable = "available"
+	// @enum NetworkInterfaceStatus
+	NetworkInterfaceStatusAttaching = "attaching"
+	// @enum NetworkInterfaceStatus
+	NetworkInterfaceStatusInUse = "in-use"
+	// @enum NetworkInterfaceStatus
+	NetworkInterfaceStatusDetaching = "detaching"
+)
+
+const (
+	// @enum OfferingTypeValues
+	OfferingTypeValuesHeavyUtilization = "Heavy Utilization"
+	// @enum OfferingTypeValues
+	OfferingTypeValuesMediumUtilization = "Medium Utilization"
+	// @enum OfferingTypeValues
+	OfferingTypeValuesLightUtilization = "Light Utilization"
+	// @enum OfferingTypeValues
+	OfferingTypeValuesNoUpfront = "No Upfront"
+	// @enum OfferingTypeValues
+	OfferingTypeValuesPartialUpfront = "Partial Upfront"
+	// @enum OfferingTypeValues
+	OfferingTypeValuesAllUpfront = "All Upfront"
+)
+
+const (
+	// @enum PermissionGroup
+	PermissionGroupAll = "all"
+)
+
+const (
+	// @enum PlacementGroupState
+	PlacementGroupStatePending = "pending"
+	// @enum PlacementGroupState
+	PlacementGroupStateAvailable = "available"
+	// @enum PlacementGroupState
+	PlacementGroupStateDeleting = "deleting"
+	// @enum PlacementGroupState
+	PlacementGroupStateDeleted = "deleted"
+)
+
+const (
+	// @enum PlacementStrategy
+	PlacementStrategyCluster = "cluster"
+)
+
+const (
+	// @enum PlatformValues
+	PlatformValuesWindows = "Windows"
+)
+
+const (
+	// @enum ProductCodeValues
+	ProductCodeValuesDevpay = "devpay"
+	// @enum ProductCodeValues
+	ProductCodeValuesMarketplace = "marketplace"
+)
+
+const (
+	// @enum RIProductDescription
+	RIProductDescriptionLinuxUnix = "Linux/UNIX"
+	// @enum RIProductDescription
+	RIProductDescriptionLinuxUnixAmazonVpc = "Linux/UNIX (Amazon VPC)"
+	// @enum RIProductDescription
+	RIProductDescriptionWindows = "Windows"
+	// @enum RIProductDescription
+	RIProductDescriptionWindowsAmazonVpc = "Windows (Amazon VPC)"
+)
+
+const (
+	// @enum RecurringChargeFrequency
+	RecurringChargeFrequencyHourly = "Hourly"
+)
+
+const (
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesInstanceStuckInState = "instance-stuck-in-state"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesUnresponsive = "unresponsive"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesNotAcceptingCredentials = "not-accepting-credentials"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesPasswordNotAvailable = "password-not-available"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesPerformanceNetwork = "performance-network"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesPerformanceInstanceStore = "performance-instance-store"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesPerformanceEbsVolume = "performance-ebs-volume"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesPerformanceOther = "performance-other"
+	// @enum ReportInstanceReasonCodes
+	ReportInstanceReasonCodesOther = "other"
+)
+
+const (
+	// @enum ReportStatusType
+	ReportStatusTypeOk = "ok"
+	// @enum ReportStatusType
+	ReportStatusTypeImpaired = "impaired"
+)
+
+const (
+	// @enum ReservedInstanceState
+	ReservedInstanceStatePaymentPending = "payment-pending"
+	// @enum ReservedInstanceState
+	ReservedInstanceStateActive = "active"
+	// @enum ReservedInstanceState
+	ReservedInstanceStatePaymentFailed = "payment-failed"
+	// @enum ReservedInstanceState
+	ReservedInstanceStateRetired = "retired"
+)
+
+const (
+	// @enum ResetImageAttributeName
+	ResetImageAttributeNameLaunchPermission = "launchPermission"
+)
+
+const (
+	// @enum ResourceType
+	ResourceTypeCustomerGateway = "customer-gateway"
+	// @enum ResourceType
+	ResourceTypeDhcpOptions = "dhcp-options"
+	// @enum ResourceType
+	ResourceTypeImage = "image"
+	// @enum ResourceType
+	ResourceTypeInstance = "instance"
+	// @enum ResourceType
+	ResourceTypeInternetGateway = "internet-gateway"
+	// @enum ResourceType
+	ResourceTypeNetworkAcl = "network-acl"
+	// @enum ResourceType
+	ResourceTypeNetworkInterface = "network-interface"
+	// @enum ResourceType
+	ResourceTypeReservedInstances = "reserved-instances"
+	// @enum ResourceType
+	ResourceTypeRouteTable = "route-table"
+	// @enum ResourceType
+	ResourceTypeSnapshot = "snapshot"
+	// @enum ResourceType
+	ResourceTypeSpotInstancesRequest = "spot-instances-request"
+	// @enum ResourceType
+	ResourceTypeSubnet = "subnet"
+	// @enum ResourceType
+	ResourceTypeSecurityGroup = "security-group"
+	// @enum ResourceType
+	ResourceTypeVolume = "volume"
+	// @enum ResourceType
+	ResourceTypeVpc = "vpc"
+	// @enum ResourceType
+	ResourceTypeVpnConnection = "vpn-connection"
+	// @enum ResourceType
+	ResourceTypeVpnGateway = "vpn-gateway"
+)
+
+const (
+	// @enum RouteOrigin
+	RouteOriginCreateRouteTable = "CreateRouteTable"
+	// @enum RouteOrigin
+	RouteOriginCreateRoute = "CreateRoute"
+	// @enum RouteOrigin
+	RouteOriginEnableVgwRoutePropagation = "EnableVgwRoutePropagation"
+)
+
+const (
+	// @enum RouteState
+	RouteStateActive = "active"
+	// @enum RouteState
+	RouteStateBlackhole = "blackhole"
+)
+
+const (
+	// @enum RuleAction
+	RuleActionAllow = "allow"
+	// @enum RuleAction
+	RuleActionDeny = "deny"
+)
+
+const (
+	// @enum ShutdownBehavior
+	ShutdownBehaviorStop = "stop"
+	// @enum ShutdownBehavior
+	ShutdownBehaviorTerminate = "terminate"
+)
+
+const (
+	// @enum SnapshotAttributeName
+	SnapshotAttributeNameProductCodes = "productCodes"
+	// @enum SnapshotAttributeName
+	SnapshotAttributeNameCreateVolumePermission = "createVolumePermission"
+)
+
+const (
+	// @enum SnapshotState
+	SnapshotStatePending = "pending"
+	// @enum SnapshotState
+	SnapshotStateCompleted = "completed"
+	// @enum SnapshotState
+	SnapshotStateError = "error"
+)
+
+const (
+	// @enum SpotInstanceState
+	SpotInstanceStateOpen = "open"
+	// @enum SpotInstanceState
+	SpotInstanceStateActive = "active"
+	// @enum SpotInstanceState
+	SpotInstanceStateClosed = "closed"
+	// @enum SpotInstanceState
+	SpotInstanceStateCancelled = "cancelled"
+	// @enum SpotInstanceState
+	SpotInstanceStateFailed = "failed"
+)
+
+const (
+	// @enum SpotInstanceType
+	SpotInstanceTypeOneTime = "one-time"
+	// @enum SpotInstanceType
+	SpotInstanceTypePersistent = "persistent"
+)
+
+const (
+	// @enum State
+	StatePending = "Pending"
+	// @enum State
+	StateAvailable = "Available"
+	// @enum State
+	StateDeleting = "Deleting"
+	// @enum State
+	StateDeleted = "Deleted"
+)
+
+const (
+	// @enum Status
+	StatusMoveInProgress = "MoveInProgress"
+	// @enum Status
+	StatusInVpc = "InVpc"
+	// @enum Status
+	StatusInClassic = "InClassic"
+)
+
+const (
+	// @enum StatusName
+	StatusNameReachability = "reachability"
+)
+
+const (
+	// @enum StatusType
+	StatusTypePassed = "passed"
+	// @enum StatusType
+	StatusTypeFailed = "failed"
+	// @enum StatusType
+	StatusTypeInsufficientData = "insufficient-data"
+	// @enum StatusType
+	StatusTypeInitializing = "initializing"
+)
+
+const (
+	// @enum SubnetState
+	SubnetStatePending = "pending"
+	// @enum SubnetState
+	SubnetStateAvailable = "available"
+)
+
+const (
+	// @enum SummaryStatus
+	SummaryStatusOk = "ok"
+	// @enum SummaryStatus
+	SummaryStatusImpaired = "impaired"
+	// @enum SummaryStatus
+	SummaryStatusInsufficientData = "insufficient-data"
+	// @enum SummaryStatus
+	SummaryStatusNotApplicable = "not-applicable"
+	// @enum SummaryStatus
+	SummaryStatusInitializing = "initializing"
+)
+
+const (
+	// @enum TelemetryStatus
+	TelemetryStatusUp = "UP"
+	// @enum TelemetryStatus
+	TelemetryStatusDown = "DOWN"
+)
+
+const (
+	// @enum Tenancy
+	TenancyDefault = "default"
+	// @enum Tenancy
+	TenancyDedicated = "dedicated"
+)
+
+const (
+	// @enum TrafficType
+	TrafficTypeAccept = "ACCEPT"
+	// @enum TrafficType
+	TrafficTypeReject = "REJECT"
+	// @enum TrafficType
+	TrafficTypeAll = "ALL"
+)
+
+const (
+	// @enum VirtualizationType
+	VirtualizationTypeHvm = "hvm"
+	// @enum VirtualizationType
+	VirtualizationTypeParavirtual = "paravirtual"
+)
+
+const (
+	// @enum VolumeAttachmentState
+	VolumeAttachmentStateAttaching = "attaching"
+	// @enum VolumeAttachmentState
+	VolumeAttachmentStateAttached = "attached"
+	// @enum VolumeAttachmentState
+	VolumeAttachmentStateDetaching = "detaching"
+	// @enum VolumeAttachmentState
+	VolumeAttachmentStateDetached = "detached"
+)
+
+const (
+	// @enum VolumeAttributeName
+	VolumeAttributeNameAutoEnableIO = "autoEnableIO"
+	// @enum VolumeAttributeName
+	VolumeAttributeNameProductCodes = "productCodes"
+)
+
+const (
+	// @enum VolumeState
+	VolumeStateCreating = "creating"
+	// @enum VolumeState
+	VolumeStateAvailable = "available"
+	// @enum VolumeState
+	VolumeStateInUse = "in-use"
+	// @enum VolumeState
+	VolumeStateDeleting = "deleting"
+	// @enum VolumeState
+	VolumeStateDeleted = "deleted"
+	// @enum VolumeState
+	VolumeStateError = "error"
+)
+
+const (
+	// @enum VolumeStatusInfoStatus
+	VolumeStatusInfoStatusOk = "ok"
+	// @enum VolumeStatusInfoStatus
+	VolumeStatusInfoStatusImpaired = "impaired"
+	// @enum VolumeStatusInfoStatus
+	VolumeStatusInfoStatusInsufficientData = "insufficient-data"
+)
+
+const (
+	// @enum VolumeStatusName
+	VolumeStatusNameIoEnabled = "io-enabled"
+	// @enum VolumeStatusName
+	VolumeStatusNameIoPerformance = "io-performance"
+)
+
+const (
+	// @enum VolumeType
+	VolumeTypeStandard = "standard"
+	// @enum VolumeType
+	VolumeTypeIo1 = "io1"
+	// @enum VolumeType
+	VolumeTypeGp2 = "gp2"
+)
+
+const (
+	// @enum VpcAttributeName
+	VpcAttributeNameEnableDnsSupport = "enableDnsSupport"
+	// @enum VpcAttributeName
+	VpcAttributeNameEnableDnsHostnames = "enableDnsHostnames"
+)
+
+const (
+	// @enum VpcState
+	VpcStatePending = "pending"
+	// @enum VpcState
+	VpcStateAvailable = "available"
+)
+
+const (
+	// @enum VpnState
+	VpnStatePending = "pending"
+	// @enum VpnState
+	VpnStateAvailable = "available"
+	// @enum VpnState
+	VpnStateDeleting = "deleting"
+	// @enum VpnState
+	VpnStateDeleted = "deleted"
+)
+
+const (
+	// @enum VpnStaticRouteSource
+	VpnStaticRouteSourceStatic = "Static"
+)
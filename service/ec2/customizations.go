@@ -7,10 +7,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
+// FillPresignedURLHandler is a named request handler which fills in the
+// PresignedURL parameter of CopySnapshot requests.
+var FillPresignedURLHandler = aws.NamedHandler{Name: "ec2.FillPresignedURLHandler", Fn: fillPresignedURL}
+
 func init() {
 	initRequest = func(r *aws.Request) {
 		if r.Operation.Name == opCopySnapshot { // fill the PresignedURL parameter
-			r.Handlers.Build.PushFront(fillPresignedURL)
+			r.Handlers.Build.PushFrontNamed(FillPresignedURLHandler)
 		}
 	}
 }
@@ -33,15 +37,15 @@ func fillPresignedURL(r *aws.Request) {
 
 	// Set destination region. Avoids infinite handler loop.
 	// Also needed to sign sub-request.
-	params.DestinationRegion = &r.Service.Config.Region
+	params.DestinationRegion = aws.String(aws.StringValue(r.Service.Config.Region))
 
 	// Create a new client pointing at source region.
 	// We will use this to presign the CopySnapshot request against
 	// the source region
 	config := r.Service.Config.Copy()
 
-	config.Endpoint = ""
-	config.Region = *params.SourceRegion
+	config.Endpoint = aws.String("")
+	config.Region = params.SourceRegion
 	client := New(&config)
 
 	// Presign a CopySnapshot request with modified params
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateCluster = "CreateCluster"
@@ -38,6 +39,16 @@ func (c *ECS) CreateCluster(input *CreateClusterInput) (*CreateClusterOutput, er
 	return out, err
 }
 
+// CreateClusterWithContext is the same as CreateCluster with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) CreateClusterWithContext(ctx aws.Context, input *CreateClusterInput) (*CreateClusterOutput, error) {
+	req, out := c.CreateClusterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateService = "CreateService"
 
 // CreateServiceRequest generates a request for the CreateService operation.
@@ -67,6 +78,16 @@ func (c *ECS) CreateService(input *CreateServiceInput) (*CreateServiceOutput, er
 	return out, err
 }
 
+// CreateServiceWithContext is the same as CreateService with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) CreateServiceWithContext(ctx aws.Context, input *CreateServiceInput) (*CreateServiceOutput, error) {
+	req, out := c.CreateServiceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCluster = "DeleteCluster"
 
 // DeleteClusterRequest generates a request for the DeleteCluster operation.
@@ -96,6 +117,16 @@ func (c *ECS) DeleteCluster(input *DeleteClusterInput) (*DeleteClusterOutput, er
 	return out, err
 }
 
+// DeleteClusterWithContext is the same as DeleteCluster with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DeleteClusterWithContext(ctx aws.Context, input *DeleteClusterInput) (*DeleteClusterOutput, error) {
+	req, out := c.DeleteClusterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteService = "DeleteService"
 
 // DeleteServiceRequest generates a request for the DeleteService operation.
@@ -123,6 +154,16 @@ func (c *ECS) DeleteService(input *DeleteServiceInput) (*DeleteServiceOutput, er
 	return out, err
 }
 
+// DeleteServiceWithContext is the same as DeleteService with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DeleteServiceWithContext(ctx aws.Context, input *DeleteServiceInput) (*DeleteServiceOutput, error) {
+	req, out := c.DeleteServiceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterContainerInstance = "DeregisterContainerInstance"
 
 // DeregisterContainerInstanceRequest generates a request for the DeregisterContainerInstance operation.
@@ -151,6 +192,16 @@ func (c *ECS) DeregisterContainerInstance(input *DeregisterContainerInstanceInpu
 	return out, err
 }
 
+// DeregisterContainerInstanceWithContext is the same as DeregisterContainerInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DeregisterContainerInstanceWithContext(ctx aws.Context, input *DeregisterContainerInstanceInput) (*DeregisterContainerInstanceOutput, error) {
+	req, out := c.DeregisterContainerInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterTaskDefinition = "DeregisterTaskDefinition"
 
 // DeregisterTaskDefinitionRequest generates a request for the DeregisterTaskDefinition operation.
@@ -187,6 +238,16 @@ func (c *ECS) DeregisterTaskDefinition(input *DeregisterTaskDefinitionInput) (*D
 	return out, err
 }
 
+// DeregisterTaskDefinitionWithContext is the same as DeregisterTaskDefinition with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DeregisterTaskDefinitionWithContext(ctx aws.Context, input *DeregisterTaskDefinitionInput) (*DeregisterTaskDefinitionOutput, error) {
+	req, out := c.DeregisterTaskDefinitionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeClusters = "DescribeClusters"
 
 // DescribeClustersRequest generates a request for the DescribeClusters operation.
@@ -214,6 +275,16 @@ func (c *ECS) DescribeClusters(input *DescribeClustersInput) (*DescribeClustersO
 	return out, err
 }
 
+// DescribeClustersWithContext is the same as DescribeClusters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DescribeClustersWithContext(ctx aws.Context, input *DescribeClustersInput) (*DescribeClustersOutput, error) {
+	req, out := c.DescribeClustersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeContainerInstances = "DescribeContainerInstances"
 
 // DescribeContainerInstancesRequest generates a request for the DescribeContainerInstances operation.
@@ -242,6 +313,16 @@ func (c *ECS) DescribeContainerInstances(input *DescribeContainerInstancesInput)
 	return out, err
 }
 
+// DescribeContainerInstancesWithContext is the same as DescribeContainerInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DescribeContainerInstancesWithContext(ctx aws.Context, input *DescribeContainerInstancesInput) (*DescribeContainerInstancesOutput, error) {
+	req, out := c.DescribeContainerInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeServices = "DescribeServices"
 
 // DescribeServicesRequest generates a request for the DescribeServices operation.
@@ -269,6 +350,16 @@ func (c *ECS) DescribeServices(input *DescribeServicesInput) (*DescribeServicesO
 	return out, err
 }
 
+// DescribeServicesWithContext is the same as DescribeServices with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DescribeServicesWithContext(ctx aws.Context, input *DescribeServicesInput) (*DescribeServicesOutput, error) {
+	req, out := c.DescribeServicesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTaskDefinition = "DescribeTaskDefinition"
 
 // DescribeTaskDefinitionRequest generates a request for the DescribeTaskDefinition operation.
@@ -301,6 +392,16 @@ func (c *ECS) DescribeTaskDefinition(input *DescribeTaskDefinitionInput) (*Descr
 	return out, err
 }
 
+// DescribeTaskDefinitionWithContext is the same as DescribeTaskDefinition with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DescribeTaskDefinitionWithContext(ctx aws.Context, input *DescribeTaskDefinitionInput) (*DescribeTaskDefinitionOutput, error) {
+	req, out := c.DescribeTaskDefinitionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTasks = "DescribeTasks"
 
 // DescribeTasksRequest generates a request for the DescribeTasks operation.
@@ -328,6 +429,16 @@ func (c *ECS) DescribeTasks(input *DescribeTasksInput) (*DescribeTasksOutput, er
 	return out, err
 }
 
+// DescribeTasksWithContext is the same as DescribeTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DescribeTasksWithContext(ctx aws.Context, input *DescribeTasksInput) (*DescribeTasksOutput, error) {
+	req, out := c.DescribeTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDiscoverPollEndpoint = "DiscoverPollEndpoint"
 
 // DiscoverPollEndpointRequest generates a request for the DiscoverPollEndpoint operation.
@@ -359,6 +470,16 @@ func (c *ECS) DiscoverPollEndpoint(input *DiscoverPollEndpointInput) (*DiscoverP
 	return out, err
 }
 
+// DiscoverPollEndpointWithContext is the same as DiscoverPollEndpoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) DiscoverPollEndpointWithContext(ctx aws.Context, input *DiscoverPollEndpointInput) (*DiscoverPollEndpointOutput, error) {
+	req, out := c.DiscoverPollEndpointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListClusters = "ListClusters"
 
 // ListClustersRequest generates a request for the ListClusters operation.
@@ -392,6 +513,16 @@ func (c *ECS) ListClusters(input *ListClustersInput) (*ListClustersOutput, error
 	return out, err
 }
 
+// ListClustersWithContext is the same as ListClusters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) ListClustersWithContext(ctx aws.Context, input *ListClustersInput) (*ListClustersOutput, error) {
+	req, out := c.ListClustersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ECS) ListClustersPages(input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListClustersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -432,6 +563,16 @@ func (c *ECS) ListContainerInstances(input *ListContainerInstancesInput) (*ListC
 	return out, err
 }
 
+// ListContainerInstancesWithContext is the same as ListContainerInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) ListContainerInstancesWithContext(ctx aws.Context, input *ListContainerInstancesInput) (*ListContainerInstancesOutput, error) {
+	req, out := c.ListContainerInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ECS) ListContainerInstancesPages(input *ListContainerInstancesInput, fn func(p *ListContainerInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListContainerInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -472,6 +613,16 @@ func (c *ECS) ListServices(input *ListServicesInput) (*ListServicesOutput, error
 	return out, err
 }
 
+// ListServicesWithContext is the same as ListServices with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) ListServicesWithContext(ctx aws.Context, input *ListServicesInput) (*ListServicesOutput, error) {
+	req, out := c.ListServicesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ECS) ListServicesPages(input *ListServicesInput, fn func(p *ListServicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListServicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -514,6 +665,16 @@ func (c *ECS) ListTaskDefinitionFamilies(input *ListTaskDefinitionFamiliesInput)
 	return out, err
 }
 
+// ListTaskDefinitionFamiliesWithContext is the same as ListTaskDefinitionFamilies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) ListTaskDefinitionFamiliesWithContext(ctx aws.Context, input *ListTaskDefinitionFamiliesInput) (*ListTaskDefinitionFamiliesOutput, error) {
+	req, out := c.ListTaskDefinitionFamiliesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ECS) ListTaskDefinitionFamiliesPages(input *ListTaskDefinitionFamiliesInput, fn func(p *ListTaskDefinitionFamiliesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTaskDefinitionFamiliesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -556,6 +717,16 @@ func (c *ECS) ListTaskDefinitions(input *ListTaskDefinitionsInput) (*ListTaskDef
 	return out, err
 }
 
+// ListTaskDefinitionsWithContext is the same as ListTaskDefinitions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) ListTaskDefinitionsWithContext(ctx aws.Context, input *ListTaskDefinitionsInput) (*ListTaskDefinitionsOutput, error) {
+	req, out := c.ListTaskDefinitionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ECS) ListTaskDefinitionsPages(input *ListTaskDefinitionsInput, fn func(p *ListTaskDefinitionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTaskDefinitionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -598,6 +769,16 @@ func (c *ECS) ListTasks(input *ListTasksInput) (*ListTasksOutput, error) {
 	return out, err
 }
 
+// ListTasksWithContext is the same as ListTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) ListTasksWithContext(ctx aws.Context, input *ListTasksInput) (*ListTasksOutput, error) {
+	req, out := c.ListTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ECS) ListTasksPages(input *ListTasksInput, fn func(p *ListTasksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTasksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -636,6 +817,16 @@ func (c *ECS) RegisterContainerInstance(input *RegisterContainerInstanceInput) (
 	return out, err
 }
 
+// RegisterContainerInstanceWithContext is the same as RegisterContainerInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) RegisterContainerInstanceWithContext(ctx aws.Context, input *RegisterContainerInstanceInput) (*RegisterContainerInstanceOutput, error) {
+	req, out := c.RegisterContainerInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterTaskDefinition = "RegisterTaskDefinition"
 
 // RegisterTaskDefinitionRequest generates a request for the RegisterTaskDefinition operation.
@@ -667,6 +858,16 @@ func (c *ECS) RegisterTaskDefinition(input *RegisterTaskDefinitionInput) (*Regis
 	return out, err
 }
 
+// RegisterTaskDefinitionWithContext is the same as RegisterTaskDefinition with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) RegisterTaskDefinitionWithContext(ctx aws.Context, input *RegisterTaskDefinitionInput) (*RegisterTaskDefinitionOutput, error) {
+	req, out := c.RegisterTaskDefinitionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRunTask = "RunTask"
 
 // RunTaskRequest generates a request for the RunTask operation.
@@ -698,6 +899,16 @@ func (c *ECS) RunTask(input *RunTaskInput) (*RunTaskOutput, error) {
 	return out, err
 }
 
+// RunTaskWithContext is the same as RunTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) RunTaskWithContext(ctx aws.Context, input *RunTaskInput) (*RunTaskOutput, error) {
+	req, out := c.RunTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStartTask = "StartTask"
 
 // StartTaskRequest generates a request for the StartTask operation.
@@ -729,6 +940,16 @@ func (c *ECS) StartTask(input *StartTaskInput) (*StartTaskOutput, error) {
 	return out, err
 }
 
+// StartTaskWithContext is the same as StartTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) StartTaskWithContext(ctx aws.Context, input *StartTaskInput) (*StartTaskOutput, error) {
+	req, out := c.StartTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStopTask = "StopTask"
 
 // StopTaskRequest generates a request for the StopTask operation.
@@ -756,6 +977,16 @@ func (c *ECS) StopTask(input *StopTaskInput) (*StopTaskOutput, error) {
 	return out, err
 }
 
+// StopTaskWithContext is the same as StopTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) StopTaskWithContext(ctx aws.Context, input *StopTaskInput) (*StopTaskOutput, error) {
+	req, out := c.StopTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSubmitContainerStateChange = "SubmitContainerStateChange"
 
 // SubmitContainerStateChangeRequest generates a request for the SubmitContainerStateChange operation.
@@ -786,6 +1017,16 @@ func (c *ECS) SubmitContainerStateChange(input *SubmitContainerStateChangeInput)
 	return out, err
 }
 
+// SubmitContainerStateChangeWithContext is the same as SubmitContainerStateChange with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) SubmitContainerStateChangeWithContext(ctx aws.Context, input *SubmitContainerStateChangeInput) (*SubmitContainerStateChangeOutput, error) {
+	req, out := c.SubmitContainerStateChangeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSubmitTaskStateChange = "SubmitTaskStateChange"
 
 // SubmitTaskStateChangeRequest generates a request for the SubmitTaskStateChange operation.
@@ -816,6 +1057,16 @@ func (c *ECS) SubmitTaskStateChange(input *SubmitTaskStateChangeInput) (*SubmitT
 	return out, err
 }
 
+// SubmitTaskStateChangeWithContext is the same as SubmitTaskStateChange with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) SubmitTaskStateChangeWithContext(ctx aws.Context, input *SubmitTaskStateChangeInput) (*SubmitTaskStateChangeOutput, error) {
+	req, out := c.SubmitTaskStateChangeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateContainerAgent = "UpdateContainerAgent"
 
 // UpdateContainerAgentRequest generates a request for the UpdateContainerAgent operation.
@@ -843,6 +1094,16 @@ func (c *ECS) UpdateContainerAgent(input *UpdateContainerAgentInput) (*UpdateCon
 	return out, err
 }
 
+// UpdateContainerAgentWithContext is the same as UpdateContainerAgent with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) UpdateContainerAgentWithContext(ctx aws.Context, input *UpdateContainerAgentInput) (*UpdateContainerAgentOutput, error) {
+	req, out := c.UpdateContainerAgentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateService = "UpdateService"
 
 // UpdateServiceRequest generates a request for the UpdateService operation.
@@ -884,6 +1145,16 @@ func (c *ECS) UpdateService(input *UpdateServiceInput) (*UpdateServiceOutput, er
 	return out, err
 }
 
+// UpdateServiceWithContext is the same as UpdateService with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ECS) UpdateServiceWithContext(ctx aws.Context, input *UpdateServiceInput) (*UpdateServiceOutput, error) {
+	req, out := c.UpdateServiceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // A regional grouping of one or more container instances on which you can run
 // task requests. Each account receives a default cluster the first time you
 // use the Amazon ECS service, but you may also create other clusters. Clusters
@@ -923,6 +1194,16 @@ type metadataCluster struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Cluster) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Cluster) GoString() string {
+	return s.String()
+}
+
 type Container struct {
 	// The Amazon Resource Name (ARN) of the container.
 	ContainerARN *string `locationName:"containerArn" type:"string"`
@@ -952,6 +1233,16 @@ type metadataContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Container) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Container) GoString() string {
+	return s.String()
+}
+
 // Container definitions are used in task definitions to describe the different
 // containers that are launched as part of a task.
 type ContainerDefinition struct {
@@ -1052,6 +1343,16 @@ type metadataContainerDefinition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ContainerDefinition) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ContainerDefinition) GoString() string {
+	return s.String()
+}
+
 // An Amazon EC2 instance that is running the Amazon ECS agent and has been
 // registered with a cluster.
 type ContainerInstance struct {
@@ -1063,7 +1364,9 @@ type ContainerInstance struct {
 
 	// The status of the most recent agent update. If an update has never been requested,
 	// this value is NULL.
-	AgentUpdateStatus *string `locationName:"agentUpdateStatus" type:"string"`
+	//
+	// See the AgentUpdateStatus* constants for valid values.
+	AgentUpdateStatus *string `locationName:"agentUpdateStatus" type:"string" enum:"PENDING,STAGING,STAGED,UPDATING,UPDATED,FAILED"`
 
 	// The Amazon Resource Name (ARN) of the container instance. The ARN contains
 	// the arn:aws:ecs namespace, followed by the region of the container instance,
@@ -1103,6 +1406,16 @@ type metadataContainerInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ContainerInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ContainerInstance) GoString() string {
+	return s.String()
+}
+
 // The name of a container in a task definition and the command it should run
 // instead of its default.
 type ContainerOverride struct {
@@ -1125,6 +1438,16 @@ type metadataContainerOverride struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ContainerOverride) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ContainerOverride) GoString() string {
+	return s.String()
+}
+
 type CreateClusterInput struct {
 	// The name of your cluster. If you do not specify a name for your cluster,
 	// you will create a cluster named default. Up to 255 letters (uppercase and
@@ -1138,6 +1461,16 @@ type metadataCreateClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateClusterInput) GoString() string {
+	return s.String()
+}
+
 type CreateClusterOutput struct {
 	// The full description of your new cluster.
 	Cluster *Cluster `locationName:"cluster" type:"structure"`
@@ -1149,6 +1482,16 @@ type metadataCreateClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateClusterOutput) GoString() string {
+	return s.String()
+}
+
 type CreateServiceInput struct {
 	// Unique, case-sensitive identifier you provide to ensure the idempotency of
 	// the request. Up to 32 ASCII characters are allowed.
@@ -1190,6 +1533,16 @@ type metadataCreateServiceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateServiceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateServiceInput) GoString() string {
+	return s.String()
+}
+
 type CreateServiceOutput struct {
 	// The full description of your service following the create call.
 	Service *Service `locationName:"service" type:"structure"`
@@ -1201,6 +1554,16 @@ type metadataCreateServiceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateServiceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateServiceOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteClusterInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to delete.
@@ -1213,6 +1576,16 @@ type metadataDeleteClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteClusterInput) GoString() string {
+	return s.String()
+}
+
 type DeleteClusterOutput struct {
 	// The full description of the deleted cluster.
 	Cluster *Cluster `locationName:"cluster" type:"structure"`
@@ -1224,6 +1597,16 @@ type metadataDeleteClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteClusterOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteServiceInput struct {
 	// The name of the cluster that hosts the service you want to delete.
 	Cluster *string `locationName:"cluster" type:"string"`
@@ -1238,6 +1621,16 @@ type metadataDeleteServiceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteServiceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteServiceInput) GoString() string {
+	return s.String()
+}
+
 type DeleteServiceOutput struct {
 	Service *Service `locationName:"service" type:"structure"`
 
@@ -1248,6 +1641,16 @@ type metadataDeleteServiceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteServiceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteServiceOutput) GoString() string {
+	return s.String()
+}
+
 type Deployment struct {
 	// The Unix time in seconds and milliseconds when the service was created.
 	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" timestampFormat:"unix"`
@@ -1284,6 +1687,16 @@ type metadataDeployment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Deployment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Deployment) GoString() string {
+	return s.String()
+}
+
 type DeregisterContainerInstanceInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container instance you want to deregister. If you do not specify a cluster,
@@ -1310,6 +1723,16 @@ type metadataDeregisterContainerInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterContainerInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterContainerInstanceInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterContainerInstanceOutput struct {
 	// An Amazon EC2 instance that is running the Amazon ECS agent and has been
 	// registered with a cluster.
@@ -1322,6 +1745,16 @@ type metadataDeregisterContainerInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterContainerInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterContainerInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type DeregisterTaskDefinitionInput struct {
 	// The family and revision (family:revision) or full Amazon Resource Name (ARN)
 	// of the task definition that you want to deregister. You must specify a revision.
@@ -1334,6 +1767,16 @@ type metadataDeregisterTaskDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterTaskDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterTaskDefinitionInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterTaskDefinitionOutput struct {
 	// The full description of the deregistered task.
 	TaskDefinition *TaskDefinition `locationName:"taskDefinition" type:"structure"`
@@ -1345,6 +1788,16 @@ type metadataDeregisterTaskDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterTaskDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterTaskDefinitionOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeClustersInput struct {
 	// A space-separated list of cluster names or full cluster Amazon Resource Name
 	// (ARN) entries. If you do not specify a cluster, the default cluster is assumed.
@@ -1357,6 +1810,16 @@ type metadataDescribeClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeClustersInput) GoString() string {
+	return s.String()
+}
+
 type DescribeClustersOutput struct {
 	// The list of clusters.
 	Clusters []*Cluster `locationName:"clusters" type:"list"`
@@ -1370,6 +1833,16 @@ type metadataDescribeClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeClustersOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeContainerInstancesInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container instances you want to describe. If you do not specify a cluster,
@@ -1387,6 +1860,16 @@ type metadataDescribeContainerInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeContainerInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeContainerInstancesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeContainerInstancesOutput struct {
 	// The list of container instances.
 	ContainerInstances []*ContainerInstance `locationName:"containerInstances" type:"list"`
@@ -1400,6 +1883,16 @@ type metadataDescribeContainerInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeContainerInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeContainerInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeServicesInput struct {
 	// The name of the cluster that hosts the service you want to describe.
 	Cluster *string `locationName:"cluster" type:"string"`
@@ -1414,6 +1907,16 @@ type metadataDescribeServicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServicesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeServicesOutput struct {
 	// Any failures associated with the call.
 	Failures []*Failure `locationName:"failures" type:"list"`
@@ -1428,6 +1931,16 @@ type metadataDescribeServicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServicesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTaskDefinitionInput struct {
 	// The family for the latest ACTIVE revision, family and revision (family:revision)
 	// for a specific revision in the family, or full Amazon Resource Name (ARN)
@@ -1441,6 +1954,16 @@ type metadataDescribeTaskDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTaskDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTaskDefinitionInput) GoString() string {
+	return s.String()
+}
+
 type DescribeTaskDefinitionOutput struct {
 	// The full task definition description.
 	TaskDefinition *TaskDefinition `locationName:"taskDefinition" type:"structure"`
@@ -1452,6 +1975,16 @@ type metadataDescribeTaskDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTaskDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTaskDefinitionOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTasksInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the task you want to describe. If you do not specify a cluster, the default
@@ -1468,6 +2001,16 @@ type metadataDescribeTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTasksInput) GoString() string {
+	return s.String()
+}
+
 type DescribeTasksOutput struct {
 	Failures []*Failure `locationName:"failures" type:"list"`
 
@@ -1481,6 +2024,16 @@ type metadataDescribeTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTasksOutput) GoString() string {
+	return s.String()
+}
+
 type DiscoverPollEndpointInput struct {
 	// The cluster that the container instance belongs to.
 	Cluster *string `locationName:"cluster" type:"string"`
@@ -1499,6 +2052,16 @@ type metadataDiscoverPollEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DiscoverPollEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DiscoverPollEndpointInput) GoString() string {
+	return s.String()
+}
+
 type DiscoverPollEndpointOutput struct {
 	// The endpoint for the Amazon ECS agent to poll.
 	Endpoint *string `locationName:"endpoint" type:"string"`
@@ -1513,6 +2076,16 @@ type metadataDiscoverPollEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DiscoverPollEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DiscoverPollEndpointOutput) GoString() string {
+	return s.String()
+}
+
 type Failure struct {
 	// The Amazon Resource Name (ARN) of the failed resource.
 	ARN *string `locationName:"arn" type:"string"`
@@ -1527,6 +2100,16 @@ type metadataFailure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Failure) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Failure) GoString() string {
+	return s.String()
+}
+
 type HostVolumeProperties struct {
 	// The path on the host container instance that is presented to the container.
 	// If this parameter is empty, then the Docker daemon has assigned a host path
@@ -1540,6 +2123,16 @@ type metadataHostVolumeProperties struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HostVolumeProperties) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HostVolumeProperties) GoString() string {
+	return s.String()
+}
+
 // A key and value pair object.
 type KeyValuePair struct {
 	// The name of the key value pair. For environment variables, this is the name
@@ -1557,6 +2150,16 @@ type metadataKeyValuePair struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeyValuePair) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeyValuePair) GoString() string {
+	return s.String()
+}
+
 type ListClustersInput struct {
 	// The maximum number of cluster results returned by ListClusters in paginated
 	// output. When this parameter is used, ListClusters only returns maxResults
@@ -1580,6 +2183,16 @@ type metadataListClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListClustersInput) GoString() string {
+	return s.String()
+}
+
 type ListClustersOutput struct {
 	// The list of full Amazon Resource Name (ARN) entries for each cluster associated
 	// with your account.
@@ -1598,6 +2211,16 @@ type metadataListClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListClustersOutput) GoString() string {
+	return s.String()
+}
+
 type ListContainerInstancesInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container instances you want to list. If you do not specify a cluster,
@@ -1628,6 +2251,16 @@ type metadataListContainerInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListContainerInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListContainerInstancesInput) GoString() string {
+	return s.String()
+}
+
 type ListContainerInstancesOutput struct {
 	// The list of container instance full Amazon Resource Name (ARN) entries for
 	// each container instance associated with the specified cluster.
@@ -1646,6 +2279,16 @@ type metadataListContainerInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListContainerInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListContainerInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type ListServicesInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the services you want to list. If you do not specify a cluster, the default
@@ -1674,6 +2317,16 @@ type metadataListServicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListServicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListServicesInput) GoString() string {
+	return s.String()
+}
+
 type ListServicesOutput struct {
 	// The nextToken value to include in a future ListServices request. When the
 	// results of a ListServices request exceed maxResults, this value can be used
@@ -1692,6 +2345,16 @@ type metadataListServicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListServicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListServicesOutput) GoString() string {
+	return s.String()
+}
+
 type ListTaskDefinitionFamiliesInput struct {
 	// The familyPrefix is a string that is used to filter the results of ListTaskDefinitionFamilies.
 	// If you specify a familyPrefix, only task definition family names that begin
@@ -1722,6 +2385,16 @@ type metadataListTaskDefinitionFamiliesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTaskDefinitionFamiliesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTaskDefinitionFamiliesInput) GoString() string {
+	return s.String()
+}
+
 type ListTaskDefinitionFamiliesOutput struct {
 	// The list of task definition family names that match the ListTaskDefinitionFamilies
 	// request.
@@ -1740,6 +2413,16 @@ type metadataListTaskDefinitionFamiliesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTaskDefinitionFamiliesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTaskDefinitionFamiliesOutput) GoString() string {
+	return s.String()
+}
+
 type ListTaskDefinitionsInput struct {
 	// The full family name that you want to filter the ListTaskDefinitions results
 	// with. Specifying a familyPrefix will limit the listed task definitions to
@@ -1768,7 +2451,9 @@ type ListTaskDefinitionsInput struct {
 	// in a family are listed last. Setting this parameter to DESC reverses the
 	// sort order on family name and revision so that the newest task definitions
 	// in a family are listed first.
-	Sort *string `locationName:"sort" type:"string"`
+	//
+	// See the SortOrder* constants for valid values.
+	Sort *string `locationName:"sort" type:"string" enum:"ASC,DESC"`
 
 	// The task definition status that you want to filter the ListTaskDefinitions
 	// results with. By default, only ACTIVE task definitions are listed. By setting
@@ -1776,7 +2461,9 @@ type ListTaskDefinitionsInput struct {
 	// as long as an active task or service still references them. If you paginate
 	// the resulting output, be sure to keep the status value constant in each subsequent
 	// request.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the TaskDefinitionStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"ACTIVE,INACTIVE"`
 
 	metadataListTaskDefinitionsInput `json:"-" xml:"-"`
 }
@@ -1785,6 +2472,16 @@ type metadataListTaskDefinitionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTaskDefinitionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTaskDefinitionsInput) GoString() string {
+	return s.String()
+}
+
 type ListTaskDefinitionsOutput struct {
 	// The nextToken value to include in a future ListTaskDefinitions request. When
 	// the results of a ListTaskDefinitions request exceed maxResults, this value
@@ -1803,6 +2500,16 @@ type metadataListTaskDefinitionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTaskDefinitionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTaskDefinitionsOutput) GoString() string {
+	return s.String()
+}
+
 type ListTasksInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the tasks you want to list. If you do not specify a cluster, the default
@@ -1819,7 +2526,9 @@ type ListTasksInput struct {
 	// a desiredStatus of STOPPED will limit the results to tasks that are in the
 	// STOPPED status, which can be useful for debugging tasks that are not starting
 	// properly or have died or finished. The default status filter is RUNNING.
-	DesiredStatus *string `locationName:"desiredStatus" type:"string"`
+	//
+	// See the DesiredStatus* constants for valid values.
+	DesiredStatus *string `locationName:"desiredStatus" type:"string" enum:"RUNNING,PENDING,STOPPED"`
 
 	// The name of the family that you want to filter the ListTasks results with.
 	// Specifying a family will limit the results to tasks that belong to that family.
@@ -1857,6 +2566,16 @@ type metadataListTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTasksInput) GoString() string {
+	return s.String()
+}
+
 type ListTasksOutput struct {
 	// The nextToken value to include in a future ListTasks request. When the results
 	// of a ListTasks request exceed maxResults, this value can be used to retrieve
@@ -1874,6 +2593,16 @@ type metadataListTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTasksOutput) GoString() string {
+	return s.String()
+}
+
 type LoadBalancer struct {
 	// The name of the container to associate with the load balancer.
 	ContainerName *string `locationName:"containerName" type:"string"`
@@ -1894,6 +2623,16 @@ type metadataLoadBalancer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoadBalancer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoadBalancer) GoString() string {
+	return s.String()
+}
+
 type MountPoint struct {
 	// The path on the container to mount the host volume at.
 	ContainerPath *string `locationName:"containerPath" type:"string"`
@@ -1913,6 +2652,16 @@ type metadataMountPoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MountPoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MountPoint) GoString() string {
+	return s.String()
+}
+
 type NetworkBinding struct {
 	// The IP address that the container is bound to on the container instance.
 	BindIP *string `locationName:"bindIP" type:"string"`
@@ -1924,7 +2673,9 @@ type NetworkBinding struct {
 	HostPort *int64 `locationName:"hostPort" type:"integer"`
 
 	// The protocol used for the network binding.
-	Protocol *string `locationName:"protocol" type:"string"`
+	//
+	// See the TransportProtocol* constants for valid values.
+	Protocol *string `locationName:"protocol" type:"string" enum:"tcp,udp"`
 
 	metadataNetworkBinding `json:"-" xml:"-"`
 }
@@ -1933,6 +2684,16 @@ type metadataNetworkBinding struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkBinding) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkBinding) GoString() string {
+	return s.String()
+}
+
 // Port mappings allow containers to access ports on the host container instance
 // to send or receive traffic. Port mappings are specified as part of the container
 // definition.
@@ -1968,7 +2729,9 @@ type PortMapping struct {
 
 	// The protocol used for the port mapping. Valid values are tcp and udp. The
 	// default is tcp.
-	Protocol *string `locationName:"protocol" type:"string"`
+	//
+	// See the TransportProtocol* constants for valid values.
+	Protocol *string `locationName:"protocol" type:"string" enum:"tcp,udp"`
 
 	metadataPortMapping `json:"-" xml:"-"`
 }
@@ -1977,6 +2740,16 @@ type metadataPortMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PortMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PortMapping) GoString() string {
+	return s.String()
+}
+
 type RegisterContainerInstanceInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to register your container instance with. If you do not specify a cluster,
@@ -2007,6 +2780,16 @@ type metadataRegisterContainerInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterContainerInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterContainerInstanceInput) GoString() string {
+	return s.String()
+}
+
 type RegisterContainerInstanceOutput struct {
 	// An Amazon EC2 instance that is running the Amazon ECS agent and has been
 	// registered with a cluster.
@@ -2019,6 +2802,16 @@ type metadataRegisterContainerInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterContainerInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterContainerInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type RegisterTaskDefinitionInput struct {
 	// A list of container definitions in JSON format that describe the different
 	// containers that make up your task.
@@ -2041,6 +2834,16 @@ type metadataRegisterTaskDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterTaskDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterTaskDefinitionInput) GoString() string {
+	return s.String()
+}
+
 type RegisterTaskDefinitionOutput struct {
 	TaskDefinition *TaskDefinition `locationName:"taskDefinition" type:"structure"`
 
@@ -2051,6 +2854,16 @@ type metadataRegisterTaskDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterTaskDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterTaskDefinitionOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the resources available for a container instance.
 type Resource struct {
 	// When the doubleValue type is set, the value of the resource must be a double
@@ -2081,6 +2894,16 @@ type metadataResource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Resource) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Resource) GoString() string {
+	return s.String()
+}
+
 type RunTaskInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to run your task on. If you do not specify a cluster, the default cluster
@@ -2127,6 +2950,16 @@ type metadataRunTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RunTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RunTaskInput) GoString() string {
+	return s.String()
+}
+
 type RunTaskOutput struct {
 	// Any failed tasks from your RunTask action are listed here.
 	Failures []*Failure `locationName:"failures" type:"list"`
@@ -2142,6 +2975,16 @@ type metadataRunTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RunTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RunTaskOutput) GoString() string {
+	return s.String()
+}
+
 type Service struct {
 	// The Amazon Resource Name (ARN) of the of the cluster that hosts the service.
 	ClusterARN *string `locationName:"clusterArn" type:"string"`
@@ -2198,6 +3041,16 @@ type metadataService struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Service) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Service) GoString() string {
+	return s.String()
+}
+
 type ServiceEvent struct {
 	// The Unix time in seconds and milliseconds when the event was triggered.
 	CreatedAt *time.Time `locationName:"createdAt" type:"timestamp" timestampFormat:"unix"`
@@ -2215,6 +3068,16 @@ type metadataServiceEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ServiceEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ServiceEvent) GoString() string {
+	return s.String()
+}
+
 type StartTaskInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to start your task on. If you do not specify a cluster, the default
@@ -2261,6 +3124,16 @@ type metadataStartTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartTaskInput) GoString() string {
+	return s.String()
+}
+
 type StartTaskOutput struct {
 	// Any failed tasks from your StartTask action are listed here.
 	Failures []*Failure `locationName:"failures" type:"list"`
@@ -2276,6 +3149,16 @@ type metadataStartTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartTaskOutput) GoString() string {
+	return s.String()
+}
+
 type StopTaskInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the task you want to stop. If you do not specify a cluster, the default cluster
@@ -2293,6 +3176,16 @@ type metadataStopTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopTaskInput) GoString() string {
+	return s.String()
+}
+
 type StopTaskOutput struct {
 	Task *Task `locationName:"task" type:"structure"`
 
@@ -2303,6 +3196,16 @@ type metadataStopTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopTaskOutput) GoString() string {
+	return s.String()
+}
+
 type SubmitContainerStateChangeInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container.
@@ -2334,6 +3237,16 @@ type metadataSubmitContainerStateChangeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubmitContainerStateChangeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubmitContainerStateChangeInput) GoString() string {
+	return s.String()
+}
+
 type SubmitContainerStateChangeOutput struct {
 	// Acknowledgement of the state change.
 	Acknowledgment *string `locationName:"acknowledgment" type:"string"`
@@ -2345,6 +3258,16 @@ type metadataSubmitContainerStateChangeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubmitContainerStateChangeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubmitContainerStateChangeOutput) GoString() string {
+	return s.String()
+}
+
 type SubmitTaskStateChangeInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the task.
@@ -2367,6 +3290,16 @@ type metadataSubmitTaskStateChangeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubmitTaskStateChangeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubmitTaskStateChangeInput) GoString() string {
+	return s.String()
+}
+
 type SubmitTaskStateChangeOutput struct {
 	// Acknowledgement of the state change.
 	Acknowledgment *string `locationName:"acknowledgment" type:"string"`
@@ -2378,6 +3311,16 @@ type metadataSubmitTaskStateChangeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubmitTaskStateChangeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubmitTaskStateChangeOutput) GoString() string {
+	return s.String()
+}
+
 type Task struct {
 	// The Amazon Resource Name (ARN) of the of the cluster that hosts the task.
 	ClusterARN *string `locationName:"clusterArn" type:"string"`
@@ -2416,6 +3359,16 @@ type metadataTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Task) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Task) GoString() string {
+	return s.String()
+}
+
 type TaskDefinition struct {
 	// A list of container definitions in JSON format that describe the different
 	// containers that make up your task. For more information on container definition
@@ -2436,7 +3389,9 @@ type TaskDefinition struct {
 	Revision *int64 `locationName:"revision" type:"integer"`
 
 	// The status of the task definition.
-	Status *string `locationName:"status" type:"string"`
+	//
+	// See the TaskDefinitionStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"ACTIVE,INACTIVE"`
 
 	// The full Amazon Resource Name (ARN) of the of the task definition.
 	TaskDefinitionARN *string `locationName:"taskDefinitionArn" type:"string"`
@@ -2453,6 +3408,16 @@ type metadataTaskDefinition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TaskDefinition) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TaskDefinition) GoString() string {
+	return s.String()
+}
+
 // A list of container overrides in JSON format that specify the name of a container
 // in a task definition and the command it should run instead of its default.
 type TaskOverride struct {
@@ -2466,6 +3431,16 @@ type metadataTaskOverride struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TaskOverride) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TaskOverride) GoString() string {
+	return s.String()
+}
+
 type UpdateContainerAgentInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that your
 	// container instance is running on. If you do not specify a cluster, the default
@@ -2484,6 +3459,16 @@ type metadataUpdateContainerAgentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateContainerAgentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateContainerAgentInput) GoString() string {
+	return s.String()
+}
+
 type UpdateContainerAgentOutput struct {
 	// An Amazon EC2 instance that is running the Amazon ECS agent and has been
 	// registered with a cluster.
@@ -2496,6 +3481,16 @@ type metadataUpdateContainerAgentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateContainerAgentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateContainerAgentOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateServiceInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that your
 	// service is running on. If you do not specify a cluster, the default cluster
@@ -2524,6 +3519,16 @@ type metadataUpdateServiceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateServiceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateServiceInput) GoString() string {
+	return s.String()
+}
+
 type UpdateServiceOutput struct {
 	// The full description of your service following the update call.
 	Service *Service `locationName:"service" type:"structure"`
@@ -2535,6 +3540,16 @@ type metadataUpdateServiceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateServiceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateServiceOutput) GoString() string {
+	return s.String()
+}
+
 type VersionInfo struct {
 	// The Git commit hash for the Amazon ECS container agent build on the amazon-ecs-agent
 	//  (https://github.com/aws/amazon-ecs-agent/commits/master) GitHub repository.
@@ -2553,6 +3568,16 @@ type metadataVersionInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VersionInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VersionInfo) GoString() string {
+	return s.String()
+}
+
 type Volume struct {
 	// The path on the host container instance that is presented to the containers
 	// which access the volume. If this parameter is empty, then the Docker daemon
@@ -2570,6 +3595,16 @@ type metadataVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Volume) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Volume) GoString() string {
+	return s.String()
+}
+
 type VolumeFrom struct {
 	// If this value is true, the container has read-only access to the volume.
 	// If this value is false, then the container can write to the volume. The default
@@ -2585,3 +3620,58 @@ type VolumeFrom struct {
 type metadataVolumeFrom struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VolumeFrom) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeFrom) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum AgentUpdateStatus
+	AgentUpdateStatusPending = "PENDING"
+	// @enum AgentUpdateStatus
+	AgentUpdateStatusStaging = "STAGING"
+	// @enum AgentUpdateStatus
+	AgentUpdateStatusStaged = "STAGED"
+	// @enum AgentUpdateStatus
+	AgentUpdateStatusUpdating = "UPDATING"
+	// @enum AgentUpdateStatus
+	AgentUpdateStatusUpdated = "UPDATED"
+	// @enum AgentUpdateStatus
+	AgentUpdateStatusFailed = "FAILED"
+)
+
+const (
+	// @enum DesiredStatus
+	DesiredStatusRunning = "RUNNING"
+	// @enum DesiredStatus
+	DesiredStatusPending = "PENDING"
+	// @enum DesiredStatus
+	DesiredStatusStopped = "STOPPED"
+)
+
+const (
+	// @enum SortOrder
+	SortOrderAsc = "ASC"
+	// @enum SortOrder
+	SortOrderDesc = "DESC"
+)
+
+const (
+	// @enum TaskDefinitionStatus
+	TaskDefinitionStatusActive = "ACTIVE"
+	// @enum TaskDefinitionStatus
+	TaskDefinitionStatusInactive = "INACTIVE"
+)
+
+const (
+	// @enum TransportProtocol
+	TransportProtocolTcp = "tcp"
+	// @enum TransportProtocol
+	TransportProtocolUdp = "udp"
+)
@@ -4,6 +4,7 @@
 package ecsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
@@ -11,55 +12,115 @@ import (
 type ECSAPI interface {
 	CreateCluster(*ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error)
 
+	CreateClusterWithContext(aws.Context, *ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error)
+
 	CreateService(*ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error)
 
+	CreateServiceWithContext(aws.Context, *ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error)
+
 	DeleteCluster(*ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error)
 
+	DeleteClusterWithContext(aws.Context, *ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error)
+
 	DeleteService(*ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error)
 
+	DeleteServiceWithContext(aws.Context, *ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error)
+
 	DeregisterContainerInstance(*ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error)
 
+	DeregisterContainerInstanceWithContext(aws.Context, *ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error)
+
 	DeregisterTaskDefinition(*ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
 
+	DeregisterTaskDefinitionWithContext(aws.Context, *ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
+
 	DescribeClusters(*ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
 
+	DescribeClustersWithContext(aws.Context, *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
+
 	DescribeContainerInstances(*ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error)
 
+	DescribeContainerInstancesWithContext(aws.Context, *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error)
+
 	DescribeServices(*ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 
+	DescribeServicesWithContext(aws.Context, *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
+
 	DescribeTaskDefinition(*ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
 
+	DescribeTaskDefinitionWithContext(aws.Context, *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+
 	DescribeTasks(*ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 
+	DescribeTasksWithContext(aws.Context, *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
+
 	DiscoverPollEndpoint(*ecs.DiscoverPollEndpointInput) (*ecs.DiscoverPollEndpointOutput, error)
 
+	DiscoverPollEndpointWithContext(aws.Context, *ecs.DiscoverPollEndpointInput) (*ecs.DiscoverPollEndpointOutput, error)
+
 	ListClusters(*ecs.ListClustersInput) (*ecs.ListClustersOutput, error)
 
+	ListClustersWithContext(aws.Context, *ecs.ListClustersInput) (*ecs.ListClustersOutput, error)
+
 	ListContainerInstances(*ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error)
 
+	ListContainerInstancesWithContext(aws.Context, *ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error)
+
 	ListServices(*ecs.ListServicesInput) (*ecs.ListServicesOutput, error)
 
+	ListServicesWithContext(aws.Context, *ecs.ListServicesInput) (*ecs.ListServicesOutput, error)
+
 	ListTaskDefinitionFamilies(*ecs.ListTaskDefinitionFamiliesInput) (*ecs.ListTaskDefinitionFamiliesOutput, error)
 
+	ListTaskDefinitionFamiliesWithContext(aws.Context, *ecs.ListTaskDefinitionFamiliesInput) (*ecs.ListTaskDefinitionFamiliesOutput, error)
+
 	ListTaskDefinitions(*ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
 
+	ListTaskDefinitionsWithContext(aws.Context, *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
+
 	ListTasks(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 
+	ListTasksWithContext(aws.Context, *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+
 	RegisterContainerInstance(*ecs.RegisterContainerInstanceInput) (*ecs.RegisterContainerInstanceOutput, error)
 
+	RegisterContainerInstanceWithContext(aws.Context, *ecs.RegisterContainerInstanceInput) (*ecs.RegisterContainerInstanceOutput, error)
+
 	RegisterTaskDefinition(*ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
 
+	RegisterTaskDefinitionWithContext(aws.Context, *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
+
 	RunTask(*ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 
+	RunTaskWithContext(aws.Context, *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
+
 	StartTask(*ecs.StartTaskInput) (*ecs.StartTaskOutput, error)
 
+	StartTaskWithContext(aws.Context, *ecs.StartTaskInput) (*ecs.StartTaskOutput, error)
+
 	StopTask(*ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
 
+	StopTaskWithContext(aws.Context, *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
+
 	SubmitContainerStateChange(*ecs.SubmitContainerStateChangeInput) (*ecs.SubmitContainerStateChangeOutput, error)
 
+	SubmitContainerStateChangeWithContext(aws.Context, *ecs.SubmitContainerStateChangeInput) (*ecs.SubmitContainerStateChangeOutput, error)
+
 	SubmitTaskStateChange(*ecs.SubmitTaskStateChangeInput) (*ecs.SubmitTaskStateChangeOutput, error)
 
+	SubmitTaskStateChangeWithContext(aws.Context, *ecs.SubmitTaskStateChangeInput) (*ecs.SubmitTaskStateChangeOutput, error)
+
 	UpdateContainerAgent(*ecs.UpdateContainerAgentInput) (*ecs.UpdateContainerAgentOutput, error)
 
+	UpdateContainerAgentWithContext(aws.Context, *ecs.UpdateContainerAgentInput) (*ecs.UpdateContainerAgentOutput, error)
+
 	UpdateService(*ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
+
+	UpdateServiceWithContext(aws.Context, *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
+
+	WaitUntilServicesInactive(*ecs.DescribeServicesInput) error
+
+	WaitUntilTasksRunning(*ecs.DescribeTasksInput) error
+
+	WaitUntilTasksStopped(*ecs.DescribeTasksInput) error
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateFileSystem = "CreateFileSystem"
@@ -66,6 +67,16 @@ func (c *EFS) CreateFileSystem(input *CreateFileSystemInput) (*FileSystemDescrip
 	return out, err
 }
 
+// CreateFileSystemWithContext is the same as CreateFileSystem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) CreateFileSystemWithContext(ctx aws.Context, input *CreateFileSystemInput) (*FileSystemDescription, error) {
+	req, out := c.CreateFileSystemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateMountTarget = "CreateMountTarget"
 
 // CreateMountTargetRequest generates a request for the CreateMountTarget operation.
@@ -168,6 +179,16 @@ func (c *EFS) CreateMountTarget(input *CreateMountTargetInput) (*MountTargetDesc
 	return out, err
 }
 
+// CreateMountTargetWithContext is the same as CreateMountTarget with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) CreateMountTargetWithContext(ctx aws.Context, input *CreateMountTargetInput) (*MountTargetDescription, error) {
+	req, out := c.CreateMountTargetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTags = "CreateTags"
 
 // CreateTagsRequest generates a request for the CreateTags operation.
@@ -202,6 +223,16 @@ func (c *EFS) CreateTags(input *CreateTagsInput) (*CreateTagsOutput, error) {
 	return out, err
 }
 
+// CreateTagsWithContext is the same as CreateTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) CreateTagsWithContext(ctx aws.Context, input *CreateTagsInput) (*CreateTagsOutput, error) {
+	req, out := c.CreateTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteFileSystem = "DeleteFileSystem"
 
 // DeleteFileSystemRequest generates a request for the DeleteFileSystem operation.
@@ -242,6 +273,16 @@ func (c *EFS) DeleteFileSystem(input *DeleteFileSystemInput) (*DeleteFileSystemO
 	return out, err
 }
 
+// DeleteFileSystemWithContext is the same as DeleteFileSystem with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) DeleteFileSystemWithContext(ctx aws.Context, input *DeleteFileSystemInput) (*DeleteFileSystemOutput, error) {
+	req, out := c.DeleteFileSystemRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMountTarget = "DeleteMountTarget"
 
 // DeleteMountTargetRequest generates a request for the DeleteMountTarget operation.
@@ -290,6 +331,16 @@ func (c *EFS) DeleteMountTarget(input *DeleteMountTargetInput) (*DeleteMountTarg
 	return out, err
 }
 
+// DeleteMountTargetWithContext is the same as DeleteMountTarget with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) DeleteMountTargetWithContext(ctx aws.Context, input *DeleteMountTargetInput) (*DeleteMountTargetOutput, error) {
+	req, out := c.DeleteMountTargetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTags = "DeleteTags"
 
 // DeleteTagsRequest generates a request for the DeleteTags operation.
@@ -324,6 +375,16 @@ func (c *EFS) DeleteTags(input *DeleteTagsInput) (*DeleteTagsOutput, error) {
 	return out, err
 }
 
+// DeleteTagsWithContext is the same as DeleteTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) DeleteTagsWithContext(ctx aws.Context, input *DeleteTagsInput) (*DeleteTagsOutput, error) {
+	req, out := c.DeleteTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeFileSystems = "DescribeFileSystems"
 
 // DescribeFileSystemsRequest generates a request for the DescribeFileSystems operation.
@@ -376,6 +437,16 @@ func (c *EFS) DescribeFileSystems(input *DescribeFileSystemsInput) (*DescribeFil
 	return out, err
 }
 
+// DescribeFileSystemsWithContext is the same as DescribeFileSystems with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) DescribeFileSystemsWithContext(ctx aws.Context, input *DescribeFileSystemsInput) (*DescribeFileSystemsOutput, error) {
+	req, out := c.DescribeFileSystemsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMountTargetSecurityGroups = "DescribeMountTargetSecurityGroups"
 
 // DescribeMountTargetSecurityGroupsRequest generates a request for the DescribeMountTargetSecurityGroups operation.
@@ -411,6 +482,16 @@ func (c *EFS) DescribeMountTargetSecurityGroups(input *DescribeMountTargetSecuri
 	return out, err
 }
 
+// DescribeMountTargetSecurityGroupsWithContext is the same as DescribeMountTargetSecurityGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) DescribeMountTargetSecurityGroupsWithContext(ctx aws.Context, input *DescribeMountTargetSecurityGroupsInput) (*DescribeMountTargetSecurityGroupsOutput, error) {
+	req, out := c.DescribeMountTargetSecurityGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMountTargets = "DescribeMountTargets"
 
 // DescribeMountTargetsRequest generates a request for the DescribeMountTargets operation.
@@ -442,6 +523,16 @@ func (c *EFS) DescribeMountTargets(input *DescribeMountTargetsInput) (*DescribeM
 	return out, err
 }
 
+// DescribeMountTargetsWithContext is the same as DescribeMountTargets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) DescribeMountTargetsWithContext(ctx aws.Context, input *DescribeMountTargetsInput) (*DescribeMountTargetsOutput, error) {
+	req, out := c.DescribeMountTargetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTags = "DescribeTags"
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -475,6 +566,16 @@ func (c *EFS) DescribeTags(input *DescribeTagsInput) (*DescribeTagsOutput, error
 	return out, err
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) DescribeTagsWithContext(ctx aws.Context, input *DescribeTagsInput) (*DescribeTagsOutput, error) {
+	req, out := c.DescribeTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyMountTargetSecurityGroups = "ModifyMountTargetSecurityGroups"
 
 // ModifyMountTargetSecurityGroupsRequest generates a request for the ModifyMountTargetSecurityGroups operation.
@@ -515,10 +616,20 @@ func (c *EFS) ModifyMountTargetSecurityGroups(input *ModifyMountTargetSecurityGr
 	return out, err
 }
 
+// ModifyMountTargetSecurityGroupsWithContext is the same as ModifyMountTargetSecurityGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EFS) ModifyMountTargetSecurityGroupsWithContext(ctx aws.Context, input *ModifyMountTargetSecurityGroupsInput) (*ModifyMountTargetSecurityGroupsOutput, error) {
+	req, out := c.ModifyMountTargetSecurityGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type CreateFileSystemInput struct {
 	// String of up to 64 ASCII characters. Amazon EFS uses this to ensure idempotent
 	// creation.
-	CreationToken *string `type:"string" required:"true"`
+	CreationToken *string `type:"string" min:"1" max:"64" required:"true"`
 
 	metadataCreateFileSystemInput `json:"-" xml:"-"`
 }
@@ -527,19 +638,29 @@ type metadataCreateFileSystemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateFileSystemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateFileSystemInput) GoString() string {
+	return s.String()
+}
+
 type CreateMountTargetInput struct {
 	// The ID of the file system for which to create the mount target.
-	FileSystemID *string `locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	// A valid IPv4 address within the address range of the specified subnet.
-	IPAddress *string `locationName:"IpAddress" type:"string"`
+	IPAddress *string `locationName:"IpAddress" type:"string" pattern:"[0-9]{1,3}\\.[0-9]{1,3}\\.[0-9]{1,3}\\.[0-9]{1,3}"`
 
 	// Up to 5 VPC security group IDs, of the form "sg-xxxxxxxx". These must be
 	// for the same VPC as subnet specified.
-	SecurityGroups []*string `type:"list"`
+	SecurityGroups []*string `type:"list" max:"5"`
 
 	// The ID of the subnet to add the mount target in.
-	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}" required:"true"`
 
 	metadataCreateMountTargetInput `json:"-" xml:"-"`
 }
@@ -548,10 +669,20 @@ type metadataCreateMountTargetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateMountTargetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateMountTargetInput) GoString() string {
+	return s.String()
+}
+
 type CreateTagsInput struct {
 	// String. The ID of the file system whose tags you want to modify. This operation
 	// modifies only the tags and not the file system.
-	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	// An array of Tag objects to add. Each Tag object is a key-value pair.
 	Tags []*Tag `type:"list" required:"true"`
@@ -563,6 +694,16 @@ type metadataCreateTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTagsInput) GoString() string {
+	return s.String()
+}
+
 type CreateTagsOutput struct {
 	metadataCreateTagsOutput `json:"-" xml:"-"`
 }
@@ -571,9 +712,19 @@ type metadataCreateTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteFileSystemInput struct {
 	// The ID of the file system you want to delete.
-	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteFileSystemInput `json:"-" xml:"-"`
 }
@@ -582,6 +733,16 @@ type metadataDeleteFileSystemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteFileSystemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteFileSystemInput) GoString() string {
+	return s.String()
+}
+
 type DeleteFileSystemOutput struct {
 	metadataDeleteFileSystemOutput `json:"-" xml:"-"`
 }
@@ -590,9 +751,19 @@ type metadataDeleteFileSystemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteFileSystemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteFileSystemOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteMountTargetInput struct {
 	// String. The ID of the mount target to delete.
-	MountTargetID *string `location:"uri" locationName:"MountTargetId" type:"string" required:"true"`
+	MountTargetID *string `location:"uri" locationName:"MountTargetId" type:"string" pattern:"fsmt-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteMountTargetInput `json:"-" xml:"-"`
 }
@@ -601,6 +772,16 @@ type metadataDeleteMountTargetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMountTargetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMountTargetInput) GoString() string {
+	return s.String()
+}
+
 type DeleteMountTargetOutput struct {
 	metadataDeleteMountTargetOutput `json:"-" xml:"-"`
 }
@@ -609,9 +790,19 @@ type metadataDeleteMountTargetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMountTargetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMountTargetOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteTagsInput struct {
 	// String. The ID of the file system whose tags you want to delete.
-	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	// A list of tag keys to delete.
 	TagKeys []*string `type:"list" required:"true"`
@@ -623,6 +814,16 @@ type metadataDeleteTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteTagsOutput struct {
 	metadataDeleteTagsOutput `json:"-" xml:"-"`
 }
@@ -631,14 +832,24 @@ type metadataDeleteTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeFileSystemsInput struct {
 	// Optional string. Restricts the list to the file system with this creation
 	// token (you specify a creation token at the time of creating an Amazon EFS
 	// file system).
-	CreationToken *string `location:"querystring" locationName:"CreationToken" type:"string"`
+	CreationToken *string `location:"querystring" locationName:"CreationToken" type:"string" min:"1" max:"64"`
 
 	// Optional string. File system ID whose description you want to retrieve.
-	FileSystemID *string `location:"querystring" locationName:"FileSystemId" type:"string"`
+	FileSystemID *string `location:"querystring" locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}"`
 
 	// Optional string. Opaque pagination token returned from a previous DescribeFileSystems
 	// operation. If present, specifies to continue the list from where the returning
@@ -650,7 +861,7 @@ type DescribeFileSystemsInput struct {
 	// of items Amazon EFS returns will be the minimum of the MaxItems parameter
 	// specified in the request and the service's internal maximum number of items
 	// per page.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1"`
 
 	metadataDescribeFileSystemsInput `json:"-" xml:"-"`
 }
@@ -659,6 +870,16 @@ type metadataDescribeFileSystemsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeFileSystemsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeFileSystemsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeFileSystemsOutput struct {
 	// An array of file system descriptions.
 	FileSystems []*FileSystemDescription `type:"list"`
@@ -677,9 +898,19 @@ type metadataDescribeFileSystemsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeFileSystemsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeFileSystemsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeMountTargetSecurityGroupsInput struct {
 	// The ID of the mount target whose security groups you want to retrieve.
-	MountTargetID *string `location:"uri" locationName:"MountTargetId" type:"string" required:"true"`
+	MountTargetID *string `location:"uri" locationName:"MountTargetId" type:"string" pattern:"fsmt-[0-9a-f]{8}" required:"true"`
 
 	metadataDescribeMountTargetSecurityGroupsInput `json:"-" xml:"-"`
 }
@@ -688,9 +919,19 @@ type metadataDescribeMountTargetSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMountTargetSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMountTargetSecurityGroupsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeMountTargetSecurityGroupsOutput struct {
 	// An array of security groups.
-	SecurityGroups []*string `type:"list" required:"true"`
+	SecurityGroups []*string `type:"list" max:"5" required:"true"`
 
 	metadataDescribeMountTargetSecurityGroupsOutput `json:"-" xml:"-"`
 }
@@ -699,9 +940,19 @@ type metadataDescribeMountTargetSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMountTargetSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMountTargetSecurityGroupsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeMountTargetsInput struct {
 	// String. The ID of the file system whose mount targets you want to list.
-	FileSystemID *string `location:"querystring" locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `location:"querystring" locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	// Optional. String. Opaque pagination token returned from a previous DescribeMountTargets
 	// operation. If present, it specifies to continue the list from where the previous
@@ -710,7 +961,7 @@ type DescribeMountTargetsInput struct {
 
 	// Optional. Maximum number of mount targets to return in the response. It must
 	// be an integer with a value greater than zero.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1"`
 
 	metadataDescribeMountTargetsInput `json:"-" xml:"-"`
 }
@@ -719,6 +970,16 @@ type metadataDescribeMountTargetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMountTargetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMountTargetsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeMountTargetsOutput struct {
 	// If the request included the Marker, the response returns that value in this
 	// field.
@@ -740,9 +1001,19 @@ type metadataDescribeMountTargetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMountTargetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMountTargetsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsInput struct {
 	// The ID of the file system whose tag set you want to retrieve.
-	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `location:"uri" locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	// Optional. String. Opaque pagination token returned from a previous DescribeTags
 	// operation. If present, it specifies to continue the list from where the previous
@@ -751,7 +1022,7 @@ type DescribeTagsInput struct {
 
 	// Optional. Maximum number of file system tags to return in the response. It
 	// must be an integer with a value greater than zero.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1"`
 
 	metadataDescribeTagsInput `json:"-" xml:"-"`
 }
@@ -760,6 +1031,16 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsOutput struct {
 	// If the request included a Marker, the response returns that value in this
 	// field.
@@ -780,33 +1061,45 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsOutput) GoString() string {
+	return s.String()
+}
+
 // This object provides description of a file system.
 type FileSystemDescription struct {
 	// The time at which the file system was created, in seconds, since 1970-01-01T00:00:00Z.
 	CreationTime *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// Opaque string specified in the request.
-	CreationToken *string `type:"string" required:"true"`
+	CreationToken *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The file system ID assigned by Amazon EFS.
-	FileSystemID *string `locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	// A predefined string value that indicates the lifecycle phase of the file
 	// system.
-	LifeCycleState *string `type:"string" required:"true"`
+	//
+	// See the LifeCycleState* constants for valid values.
+	LifeCycleState *string `type:"string" enum:"creating,available,deleting,deleted" required:"true"`
 
 	// You can add tags to a file system (see CreateTags) including a "Name" tag.
 	// If the file system has a "Name" tag, Amazon EFS returns the value in this
 	// field.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"256" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-%@]*)$"`
 
 	// The current number of mount targets (see CreateMountTarget) the file system
 	// has.
-	NumberOfMountTargets *int64 `type:"integer" required:"true"`
+	NumberOfMountTargets *int64 `type:"integer" min:"0" required:"true"`
 
 	// The AWS account that created the file system. If the file system was created
 	// by an IAM user, the parent account to which the user belongs is the owner.
-	OwnerID *string `locationName:"OwnerId" type:"string" required:"true"`
+	OwnerID *string `locationName:"OwnerId" type:"string" pattern:"[0-9]{12}" required:"true"`
 
 	// This object provides the latest known metered size of data stored in the
 	// file system, in bytes, in its Value field, and the time at which that size
@@ -826,6 +1119,16 @@ type metadataFileSystemDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FileSystemDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FileSystemDescription) GoString() string {
+	return s.String()
+}
+
 // This object provides the latest known metered size, in bytes, of data stored
 // in the file system, in its Value field, and the time at which that size was
 // determined in its Timestamp field. Note that the value does not represent
@@ -840,7 +1143,7 @@ type FileSystemSize struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The latest known metered size, in bytes, of data stored in the file system.
-	Value *int64 `type:"long" required:"true"`
+	Value *int64 `type:"long" min:"0" required:"true"`
 
 	metadataFileSystemSize `json:"-" xml:"-"`
 }
@@ -849,12 +1152,22 @@ type metadataFileSystemSize struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FileSystemSize) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FileSystemSize) GoString() string {
+	return s.String()
+}
+
 type ModifyMountTargetSecurityGroupsInput struct {
 	// The ID of the mount target whose security groups you want to modify.
-	MountTargetID *string `location:"uri" locationName:"MountTargetId" type:"string" required:"true"`
+	MountTargetID *string `location:"uri" locationName:"MountTargetId" type:"string" pattern:"fsmt-[0-9a-f]{8}" required:"true"`
 
 	// An array of up to five VPC security group IDs.
-	SecurityGroups []*string `type:"list"`
+	SecurityGroups []*string `type:"list" max:"5"`
 
 	metadataModifyMountTargetSecurityGroupsInput `json:"-" xml:"-"`
 }
@@ -863,6 +1176,16 @@ type metadataModifyMountTargetSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyMountTargetSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyMountTargetSecurityGroupsInput) GoString() string {
+	return s.String()
+}
+
 type ModifyMountTargetSecurityGroupsOutput struct {
 	metadataModifyMountTargetSecurityGroupsOutput `json:"-" xml:"-"`
 }
@@ -871,29 +1194,41 @@ type metadataModifyMountTargetSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyMountTargetSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyMountTargetSecurityGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // This object provides description of a mount target.
 type MountTargetDescription struct {
 	// The ID of the file system for which the mount target is intended.
-	FileSystemID *string `locationName:"FileSystemId" type:"string" required:"true"`
+	FileSystemID *string `locationName:"FileSystemId" type:"string" pattern:"fs-[0-9a-f]{8}" required:"true"`
 
 	// The address at which the file system may be mounted via the mount target.
-	IPAddress *string `locationName:"IpAddress" type:"string"`
+	IPAddress *string `locationName:"IpAddress" type:"string" pattern:"[0-9]{1,3}\\.[0-9]{1,3}\\.[0-9]{1,3}\\.[0-9]{1,3}"`
 
 	// The lifecycle state the mount target is in.
-	LifeCycleState *string `type:"string" required:"true"`
+	//
+	// See the LifeCycleState* constants for valid values.
+	LifeCycleState *string `type:"string" enum:"creating,available,deleting,deleted" required:"true"`
 
 	// The system-assigned mount target ID.
-	MountTargetID *string `locationName:"MountTargetId" type:"string" required:"true"`
+	MountTargetID *string `locationName:"MountTargetId" type:"string" pattern:"fsmt-[0-9a-f]{8}" required:"true"`
 
 	// The ID of the network interface that Amazon EFS created when it created the
 	// mount target.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"eni-[0-9a-f]{8}"`
 
 	// The AWS account ID that owns the resource.
-	OwnerID *string `locationName:"OwnerId" type:"string"`
+	OwnerID *string `locationName:"OwnerId" type:"string" pattern:"[0-9]{12}"`
 
 	// The ID of the subnet that the mount target is in.
-	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}" required:"true"`
 
 	metadataMountTargetDescription `json:"-" xml:"-"`
 }
@@ -902,15 +1237,25 @@ type metadataMountTargetDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MountTargetDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MountTargetDescription) GoString() string {
+	return s.String()
+}
+
 // A tag is a pair of key and value. The allowed characters in keys and values
 // are letters, whitespace, and numbers, representable in UTF-8, and the characters
 // '+', '-', '=', '.', '_', ':', and '/'.
 type Tag struct {
 	// Tag key, a string. The key must not start with "aws:".
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-%@]*)$" required:"true"`
 
 	// Value of the tag key.
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"256" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-%@]*)$" required:"true"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -918,3 +1263,24 @@ type Tag struct {
 type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum LifeCycleState
+	LifeCycleStateCreating = "creating"
+	// @enum LifeCycleState
+	LifeCycleStateAvailable = "available"
+	// @enum LifeCycleState
+	LifeCycleStateDeleting = "deleting"
+	// @enum LifeCycleState
+	LifeCycleStateDeleted = "deleted"
+)
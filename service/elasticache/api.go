@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddTagsToResource = "AddTagsToResource"
@@ -46,6 +47,16 @@ func (c *ElastiCache) AddTagsToResource(input *AddTagsToResourceInput) (*TagList
 	return out, err
 }
 
+// AddTagsToResourceWithContext is the same as AddTagsToResource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) AddTagsToResourceWithContext(ctx aws.Context, input *AddTagsToResourceInput) (*TagListMessage, error) {
+	req, out := c.AddTagsToResourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAuthorizeCacheSecurityGroupIngress = "AuthorizeCacheSecurityGroupIngress"
 
 // AuthorizeCacheSecurityGroupIngressRequest generates a request for the AuthorizeCacheSecurityGroupIngress operation.
@@ -78,6 +89,16 @@ func (c *ElastiCache) AuthorizeCacheSecurityGroupIngress(input *AuthorizeCacheSe
 	return out, err
 }
 
+// AuthorizeCacheSecurityGroupIngressWithContext is the same as AuthorizeCacheSecurityGroupIngress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) AuthorizeCacheSecurityGroupIngressWithContext(ctx aws.Context, input *AuthorizeCacheSecurityGroupIngressInput) (*AuthorizeCacheSecurityGroupIngressOutput, error) {
+	req, out := c.AuthorizeCacheSecurityGroupIngressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCopySnapshot = "CopySnapshot"
 
 // CopySnapshotRequest generates a request for the CopySnapshot operation.
@@ -105,6 +126,16 @@ func (c *ElastiCache) CopySnapshot(input *CopySnapshotInput) (*CopySnapshotOutpu
 	return out, err
 }
 
+// CopySnapshotWithContext is the same as CopySnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) CopySnapshotWithContext(ctx aws.Context, input *CopySnapshotInput) (*CopySnapshotOutput, error) {
+	req, out := c.CopySnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCacheCluster = "CreateCacheCluster"
 
 // CreateCacheClusterRequest generates a request for the CreateCacheCluster operation.
@@ -134,6 +165,16 @@ func (c *ElastiCache) CreateCacheCluster(input *CreateCacheClusterInput) (*Creat
 	return out, err
 }
 
+// CreateCacheClusterWithContext is the same as CreateCacheCluster with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) CreateCacheClusterWithContext(ctx aws.Context, input *CreateCacheClusterInput) (*CreateCacheClusterOutput, error) {
+	req, out := c.CreateCacheClusterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCacheParameterGroup = "CreateCacheParameterGroup"
 
 // CreateCacheParameterGroupRequest generates a request for the CreateCacheParameterGroup operation.
@@ -163,6 +204,16 @@ func (c *ElastiCache) CreateCacheParameterGroup(input *CreateCacheParameterGroup
 	return out, err
 }
 
+// CreateCacheParameterGroupWithContext is the same as CreateCacheParameterGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) CreateCacheParameterGroupWithContext(ctx aws.Context, input *CreateCacheParameterGroupInput) (*CreateCacheParameterGroupOutput, error) {
+	req, out := c.CreateCacheParameterGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCacheSecurityGroup = "CreateCacheSecurityGroup"
 
 // CreateCacheSecurityGroupRequest generates a request for the CreateCacheSecurityGroup operation.
@@ -196,6 +247,16 @@ func (c *ElastiCache) CreateCacheSecurityGroup(input *CreateCacheSecurityGroupIn
 	return out, err
 }
 
+// CreateCacheSecurityGroupWithContext is the same as CreateCacheSecurityGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) CreateCacheSecurityGroupWithContext(ctx aws.Context, input *CreateCacheSecurityGroupInput) (*CreateCacheSecurityGroupOutput, error) {
+	req, out := c.CreateCacheSecurityGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCacheSubnetGroup = "CreateCacheSubnetGroup"
 
 // CreateCacheSubnetGroupRequest generates a request for the CreateCacheSubnetGroup operation.
@@ -226,6 +287,16 @@ func (c *ElastiCache) CreateCacheSubnetGroup(input *CreateCacheSubnetGroupInput)
 	return out, err
 }
 
+// CreateCacheSubnetGroupWithContext is the same as CreateCacheSubnetGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) CreateCacheSubnetGroupWithContext(ctx aws.Context, input *CreateCacheSubnetGroupInput) (*CreateCacheSubnetGroupOutput, error) {
+	req, out := c.CreateCacheSubnetGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateReplicationGroup = "CreateReplicationGroup"
 
 // CreateReplicationGroupRequest generates a request for the CreateReplicationGroup operation.
@@ -263,6 +334,16 @@ func (c *ElastiCache) CreateReplicationGroup(input *CreateReplicationGroupInput)
 	return out, err
 }
 
+// CreateReplicationGroupWithContext is the same as CreateReplicationGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) CreateReplicationGroupWithContext(ctx aws.Context, input *CreateReplicationGroupInput) (*CreateReplicationGroupOutput, error) {
+	req, out := c.CreateReplicationGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshot = "CreateSnapshot"
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -291,6 +372,16 @@ func (c *ElastiCache) CreateSnapshot(input *CreateSnapshotInput) (*CreateSnapsho
 	return out, err
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) CreateSnapshotWithContext(ctx aws.Context, input *CreateSnapshotInput) (*CreateSnapshotOutput, error) {
+	req, out := c.CreateSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCacheCluster = "DeleteCacheCluster"
 
 // DeleteCacheClusterRequest generates a request for the DeleteCacheCluster operation.
@@ -325,6 +416,16 @@ func (c *ElastiCache) DeleteCacheCluster(input *DeleteCacheClusterInput) (*Delet
 	return out, err
 }
 
+// DeleteCacheClusterWithContext is the same as DeleteCacheCluster with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DeleteCacheClusterWithContext(ctx aws.Context, input *DeleteCacheClusterInput) (*DeleteCacheClusterOutput, error) {
+	req, out := c.DeleteCacheClusterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCacheParameterGroup = "DeleteCacheParameterGroup"
 
 // DeleteCacheParameterGroupRequest generates a request for the DeleteCacheParameterGroup operation.
@@ -354,6 +455,16 @@ func (c *ElastiCache) DeleteCacheParameterGroup(input *DeleteCacheParameterGroup
 	return out, err
 }
 
+// DeleteCacheParameterGroupWithContext is the same as DeleteCacheParameterGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DeleteCacheParameterGroupWithContext(ctx aws.Context, input *DeleteCacheParameterGroupInput) (*DeleteCacheParameterGroupOutput, error) {
+	req, out := c.DeleteCacheParameterGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCacheSecurityGroup = "DeleteCacheSecurityGroup"
 
 // DeleteCacheSecurityGroupRequest generates a request for the DeleteCacheSecurityGroup operation.
@@ -384,6 +495,16 @@ func (c *ElastiCache) DeleteCacheSecurityGroup(input *DeleteCacheSecurityGroupIn
 	return out, err
 }
 
+// DeleteCacheSecurityGroupWithContext is the same as DeleteCacheSecurityGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DeleteCacheSecurityGroupWithContext(ctx aws.Context, input *DeleteCacheSecurityGroupInput) (*DeleteCacheSecurityGroupOutput, error) {
+	req, out := c.DeleteCacheSecurityGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteCacheSubnetGroup = "DeleteCacheSubnetGroup"
 
 // DeleteCacheSubnetGroupRequest generates a request for the DeleteCacheSubnetGroup operation.
@@ -414,6 +535,16 @@ func (c *ElastiCache) DeleteCacheSubnetGroup(input *DeleteCacheSubnetGroupInput)
 	return out, err
 }
 
+// DeleteCacheSubnetGroupWithContext is the same as DeleteCacheSubnetGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DeleteCacheSubnetGroupWithContext(ctx aws.Context, input *DeleteCacheSubnetGroupInput) (*DeleteCacheSubnetGroupOutput, error) {
+	req, out := c.DeleteCacheSubnetGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteReplicationGroup = "DeleteReplicationGroup"
 
 // DeleteReplicationGroupRequest generates a request for the DeleteReplicationGroup operation.
@@ -448,6 +579,16 @@ func (c *ElastiCache) DeleteReplicationGroup(input *DeleteReplicationGroupInput)
 	return out, err
 }
 
+// DeleteReplicationGroupWithContext is the same as DeleteReplicationGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DeleteReplicationGroupWithContext(ctx aws.Context, input *DeleteReplicationGroupInput) (*DeleteReplicationGroupOutput, error) {
+	req, out := c.DeleteReplicationGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSnapshot = "DeleteSnapshot"
 
 // DeleteSnapshotRequest generates a request for the DeleteSnapshot operation.
@@ -477,6 +618,16 @@ func (c *ElastiCache) DeleteSnapshot(input *DeleteSnapshotInput) (*DeleteSnapsho
 	return out, err
 }
 
+// DeleteSnapshotWithContext is the same as DeleteSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DeleteSnapshotWithContext(ctx aws.Context, input *DeleteSnapshotInput) (*DeleteSnapshotOutput, error) {
+	req, out := c.DeleteSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCacheClusters = "DescribeCacheClusters"
 
 // DescribeCacheClustersRequest generates a request for the DescribeCacheClusters operation.
@@ -531,6 +682,16 @@ func (c *ElastiCache) DescribeCacheClusters(input *DescribeCacheClustersInput) (
 	return out, err
 }
 
+// DescribeCacheClustersWithContext is the same as DescribeCacheClusters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeCacheClustersWithContext(ctx aws.Context, input *DescribeCacheClustersInput) (*DescribeCacheClustersOutput, error) {
+	req, out := c.DescribeCacheClustersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeCacheClustersPages(input *DescribeCacheClustersInput, fn func(p *DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCacheClustersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -572,6 +733,16 @@ func (c *ElastiCache) DescribeCacheEngineVersions(input *DescribeCacheEngineVers
 	return out, err
 }
 
+// DescribeCacheEngineVersionsWithContext is the same as DescribeCacheEngineVersions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeCacheEngineVersionsWithContext(ctx aws.Context, input *DescribeCacheEngineVersionsInput) (*DescribeCacheEngineVersionsOutput, error) {
+	req, out := c.DescribeCacheEngineVersionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeCacheEngineVersionsPages(input *DescribeCacheEngineVersionsInput, fn func(p *DescribeCacheEngineVersionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCacheEngineVersionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -614,6 +785,16 @@ func (c *ElastiCache) DescribeCacheParameterGroups(input *DescribeCacheParameter
 	return out, err
 }
 
+// DescribeCacheParameterGroupsWithContext is the same as DescribeCacheParameterGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeCacheParameterGroupsWithContext(ctx aws.Context, input *DescribeCacheParameterGroupsInput) (*DescribeCacheParameterGroupsOutput, error) {
+	req, out := c.DescribeCacheParameterGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeCacheParameterGroupsPages(input *DescribeCacheParameterGroupsInput, fn func(p *DescribeCacheParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCacheParameterGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -655,6 +836,16 @@ func (c *ElastiCache) DescribeCacheParameters(input *DescribeCacheParametersInpu
 	return out, err
 }
 
+// DescribeCacheParametersWithContext is the same as DescribeCacheParameters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeCacheParametersWithContext(ctx aws.Context, input *DescribeCacheParametersInput) (*DescribeCacheParametersOutput, error) {
+	req, out := c.DescribeCacheParametersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeCacheParametersPages(input *DescribeCacheParametersInput, fn func(p *DescribeCacheParametersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCacheParametersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -697,6 +888,16 @@ func (c *ElastiCache) DescribeCacheSecurityGroups(input *DescribeCacheSecurityGr
 	return out, err
 }
 
+// DescribeCacheSecurityGroupsWithContext is the same as DescribeCacheSecurityGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeCacheSecurityGroupsWithContext(ctx aws.Context, input *DescribeCacheSecurityGroupsInput) (*DescribeCacheSecurityGroupsOutput, error) {
+	req, out := c.DescribeCacheSecurityGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeCacheSecurityGroupsPages(input *DescribeCacheSecurityGroupsInput, fn func(p *DescribeCacheSecurityGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCacheSecurityGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -739,6 +940,16 @@ func (c *ElastiCache) DescribeCacheSubnetGroups(input *DescribeCacheSubnetGroups
 	return out, err
 }
 
+// DescribeCacheSubnetGroupsWithContext is the same as DescribeCacheSubnetGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeCacheSubnetGroupsWithContext(ctx aws.Context, input *DescribeCacheSubnetGroupsInput) (*DescribeCacheSubnetGroupsOutput, error) {
+	req, out := c.DescribeCacheSubnetGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeCacheSubnetGroupsPages(input *DescribeCacheSubnetGroupsInput, fn func(p *DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCacheSubnetGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -780,6 +991,16 @@ func (c *ElastiCache) DescribeEngineDefaultParameters(input *DescribeEngineDefau
 	return out, err
 }
 
+// DescribeEngineDefaultParametersWithContext is the same as DescribeEngineDefaultParameters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeEngineDefaultParametersWithContext(ctx aws.Context, input *DescribeEngineDefaultParametersInput) (*DescribeEngineDefaultParametersOutput, error) {
+	req, out := c.DescribeEngineDefaultParametersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeEngineDefaultParametersPages(input *DescribeEngineDefaultParametersInput, fn func(p *DescribeEngineDefaultParametersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeEngineDefaultParametersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -826,6 +1047,16 @@ func (c *ElastiCache) DescribeEvents(input *DescribeEventsInput) (*DescribeEvent
 	return out, err
 }
 
+// DescribeEventsWithContext is the same as DescribeEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeEventsWithContext(ctx aws.Context, input *DescribeEventsInput) (*DescribeEventsOutput, error) {
+	req, out := c.DescribeEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeEventsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -868,6 +1099,16 @@ func (c *ElastiCache) DescribeReplicationGroups(input *DescribeReplicationGroups
 	return out, err
 }
 
+// DescribeReplicationGroupsWithContext is the same as DescribeReplicationGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeReplicationGroupsWithContext(ctx aws.Context, input *DescribeReplicationGroupsInput) (*DescribeReplicationGroupsOutput, error) {
+	req, out := c.DescribeReplicationGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeReplicationGroupsPages(input *DescribeReplicationGroupsInput, fn func(p *DescribeReplicationGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeReplicationGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -909,6 +1150,16 @@ func (c *ElastiCache) DescribeReservedCacheNodes(input *DescribeReservedCacheNod
 	return out, err
 }
 
+// DescribeReservedCacheNodesWithContext is the same as DescribeReservedCacheNodes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeReservedCacheNodesWithContext(ctx aws.Context, input *DescribeReservedCacheNodesInput) (*DescribeReservedCacheNodesOutput, error) {
+	req, out := c.DescribeReservedCacheNodesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeReservedCacheNodesPages(input *DescribeReservedCacheNodesInput, fn func(p *DescribeReservedCacheNodesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeReservedCacheNodesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -950,6 +1201,16 @@ func (c *ElastiCache) DescribeReservedCacheNodesOfferings(input *DescribeReserve
 	return out, err
 }
 
+// DescribeReservedCacheNodesOfferingsWithContext is the same as DescribeReservedCacheNodesOfferings with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeReservedCacheNodesOfferingsWithContext(ctx aws.Context, input *DescribeReservedCacheNodesOfferingsInput) (*DescribeReservedCacheNodesOfferingsOutput, error) {
+	req, out := c.DescribeReservedCacheNodesOfferingsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeReservedCacheNodesOfferingsPages(input *DescribeReservedCacheNodesOfferingsInput, fn func(p *DescribeReservedCacheNodesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeReservedCacheNodesOfferingsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -993,6 +1254,16 @@ func (c *ElastiCache) DescribeSnapshots(input *DescribeSnapshotsInput) (*Describ
 	return out, err
 }
 
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) DescribeSnapshotsWithContext(ctx aws.Context, input *DescribeSnapshotsInput) (*DescribeSnapshotsOutput, error) {
+	req, out := c.DescribeSnapshotsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElastiCache) DescribeSnapshotsPages(input *DescribeSnapshotsInput, fn func(p *DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeSnapshotsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1034,6 +1305,16 @@ func (c *ElastiCache) ListTagsForResource(input *ListTagsForResourceInput) (*Tag
 	return out, err
 }
 
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput) (*TagListMessage, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyCacheCluster = "ModifyCacheCluster"
 
 // ModifyCacheClusterRequest generates a request for the ModifyCacheCluster operation.
@@ -1063,6 +1344,16 @@ func (c *ElastiCache) ModifyCacheCluster(input *ModifyCacheClusterInput) (*Modif
 	return out, err
 }
 
+// ModifyCacheClusterWithContext is the same as ModifyCacheCluster with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) ModifyCacheClusterWithContext(ctx aws.Context, input *ModifyCacheClusterInput) (*ModifyCacheClusterOutput, error) {
+	req, out := c.ModifyCacheClusterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyCacheParameterGroup = "ModifyCacheParameterGroup"
 
 // ModifyCacheParameterGroupRequest generates a request for the ModifyCacheParameterGroup operation.
@@ -1092,6 +1383,16 @@ func (c *ElastiCache) ModifyCacheParameterGroup(input *ModifyCacheParameterGroup
 	return out, err
 }
 
+// ModifyCacheParameterGroupWithContext is the same as ModifyCacheParameterGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) ModifyCacheParameterGroupWithContext(ctx aws.Context, input *ModifyCacheParameterGroupInput) (*CacheParameterGroupNameMessage, error) {
+	req, out := c.ModifyCacheParameterGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyCacheSubnetGroup = "ModifyCacheSubnetGroup"
 
 // ModifyCacheSubnetGroupRequest generates a request for the ModifyCacheSubnetGroup operation.
@@ -1119,6 +1420,16 @@ func (c *ElastiCache) ModifyCacheSubnetGroup(input *ModifyCacheSubnetGroupInput)
 	return out, err
 }
 
+// ModifyCacheSubnetGroupWithContext is the same as ModifyCacheSubnetGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) ModifyCacheSubnetGroupWithContext(ctx aws.Context, input *ModifyCacheSubnetGroupInput) (*ModifyCacheSubnetGroupOutput, error) {
+	req, out := c.ModifyCacheSubnetGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyReplicationGroup = "ModifyReplicationGroup"
 
 // ModifyReplicationGroupRequest generates a request for the ModifyReplicationGroup operation.
@@ -1147,6 +1458,16 @@ func (c *ElastiCache) ModifyReplicationGroup(input *ModifyReplicationGroupInput)
 	return out, err
 }
 
+// ModifyReplicationGroupWithContext is the same as ModifyReplicationGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) ModifyReplicationGroupWithContext(ctx aws.Context, input *ModifyReplicationGroupInput) (*ModifyReplicationGroupOutput, error) {
+	req, out := c.ModifyReplicationGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPurchaseReservedCacheNodesOffering = "PurchaseReservedCacheNodesOffering"
 
 // PurchaseReservedCacheNodesOfferingRequest generates a request for the PurchaseReservedCacheNodesOffering operation.
@@ -1175,6 +1496,16 @@ func (c *ElastiCache) PurchaseReservedCacheNodesOffering(input *PurchaseReserved
 	return out, err
 }
 
+// PurchaseReservedCacheNodesOfferingWithContext is the same as PurchaseReservedCacheNodesOffering with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) PurchaseReservedCacheNodesOfferingWithContext(ctx aws.Context, input *PurchaseReservedCacheNodesOfferingInput) (*PurchaseReservedCacheNodesOfferingOutput, error) {
+	req, out := c.PurchaseReservedCacheNodesOfferingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRebootCacheCluster = "RebootCacheCluster"
 
 // RebootCacheClusterRequest generates a request for the RebootCacheCluster operation.
@@ -1211,6 +1542,16 @@ func (c *ElastiCache) RebootCacheCluster(input *RebootCacheClusterInput) (*Reboo
 	return out, err
 }
 
+// RebootCacheClusterWithContext is the same as RebootCacheCluster with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) RebootCacheClusterWithContext(ctx aws.Context, input *RebootCacheClusterInput) (*RebootCacheClusterOutput, error) {
+	req, out := c.RebootCacheClusterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveTagsFromResource = "RemoveTagsFromResource"
 
 // RemoveTagsFromResourceRequest generates a request for the RemoveTagsFromResource operation.
@@ -1239,6 +1580,16 @@ func (c *ElastiCache) RemoveTagsFromResource(input *RemoveTagsFromResourceInput)
 	return out, err
 }
 
+// RemoveTagsFromResourceWithContext is the same as RemoveTagsFromResource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) RemoveTagsFromResourceWithContext(ctx aws.Context, input *RemoveTagsFromResourceInput) (*TagListMessage, error) {
+	req, out := c.RemoveTagsFromResourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResetCacheParameterGroup = "ResetCacheParameterGroup"
 
 // ResetCacheParameterGroupRequest generates a request for the ResetCacheParameterGroup operation.
@@ -1269,6 +1620,16 @@ func (c *ElastiCache) ResetCacheParameterGroup(input *ResetCacheParameterGroupIn
 	return out, err
 }
 
+// ResetCacheParameterGroupWithContext is the same as ResetCacheParameterGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) ResetCacheParameterGroupWithContext(ctx aws.Context, input *ResetCacheParameterGroupInput) (*CacheParameterGroupNameMessage, error) {
+	req, out := c.ResetCacheParameterGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRevokeCacheSecurityGroupIngress = "RevokeCacheSecurityGroupIngress"
 
 // RevokeCacheSecurityGroupIngressRequest generates a request for the RevokeCacheSecurityGroupIngress operation.
@@ -1298,6 +1659,16 @@ func (c *ElastiCache) RevokeCacheSecurityGroupIngress(input *RevokeCacheSecurity
 	return out, err
 }
 
+// RevokeCacheSecurityGroupIngressWithContext is the same as RevokeCacheSecurityGroupIngress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElastiCache) RevokeCacheSecurityGroupIngressWithContext(ctx aws.Context, input *RevokeCacheSecurityGroupIngressInput) (*RevokeCacheSecurityGroupIngressOutput, error) {
+	req, out := c.RevokeCacheSecurityGroupIngressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the input of an AddTagsToResource action.
 type AddTagsToResourceInput struct {
 	// The name of the resource to which the tags are to be added, for example arn:aws:elasticache:us-west-2:0123456789:cluster:myCluster.
@@ -1314,6 +1685,16 @@ type metadataAddTagsToResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsToResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsToResourceInput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of an AuthorizeCacheSecurityGroupIngress action.
 type AuthorizeCacheSecurityGroupIngressInput struct {
 	// The cache security group which will allow network ingress.
@@ -1335,6 +1716,16 @@ type metadataAuthorizeCacheSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AuthorizeCacheSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AuthorizeCacheSecurityGroupIngressInput) GoString() string {
+	return s.String()
+}
+
 type AuthorizeCacheSecurityGroupIngressOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -1348,6 +1739,16 @@ type metadataAuthorizeCacheSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AuthorizeCacheSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AuthorizeCacheSecurityGroupIngressOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an Availability Zone in which the cache cluster is launched.
 type AvailabilityZone struct {
 	// The name of the Availability Zone.
@@ -1360,6 +1761,16 @@ type metadataAvailabilityZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AvailabilityZone) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AvailabilityZone) GoString() string {
+	return s.String()
+}
+
 // Contains all of the attributes of a specific cache cluster.
 type CacheCluster struct {
 	// This parameter is currently disabled.
@@ -1481,6 +1892,16 @@ type metadataCacheCluster struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheCluster) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheCluster) GoString() string {
+	return s.String()
+}
+
 // Provides all of the details about a particular cache engine version.
 type CacheEngineVersion struct {
 	// The description of the cache engine.
@@ -1505,6 +1926,16 @@ type metadataCacheEngineVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheEngineVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheEngineVersion) GoString() string {
+	return s.String()
+}
+
 // Represents an individual cache node within a cache cluster. Each cache node
 // runs its own instance of the cluster's protocol-compliant caching software
 // - either Memcached or Redis.
@@ -1559,6 +1990,16 @@ type metadataCacheNode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheNode) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheNode) GoString() string {
+	return s.String()
+}
+
 // A parameter that has a different value for each cache node type it is applied
 // to. For example, in a Redis cache cluster, a cache.m1.large cache node type
 // would have a larger maxmemory value than a cache.m1.small type.
@@ -1596,6 +2037,16 @@ type metadataCacheNodeTypeSpecificParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheNodeTypeSpecificParameter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheNodeTypeSpecificParameter) GoString() string {
+	return s.String()
+}
+
 // A value that applies only to a certain cache node type.
 type CacheNodeTypeSpecificValue struct {
 	// The cache node type for which this value applies.
@@ -1611,6 +2062,16 @@ type metadataCacheNodeTypeSpecificValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheNodeTypeSpecificValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheNodeTypeSpecificValue) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateCacheParameterGroup action.
 type CacheParameterGroup struct {
 	// The name of the cache parameter group family that this cache parameter group
@@ -1630,6 +2091,16 @@ type metadataCacheParameterGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheParameterGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheParameterGroup) GoString() string {
+	return s.String()
+}
+
 // Represents the output of one of the following actions:
 //
 //   ModifyCacheParameterGroup   ResetCacheParameterGroup
@@ -1644,6 +2115,16 @@ type metadataCacheParameterGroupNameMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheParameterGroupNameMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheParameterGroupNameMessage) GoString() string {
+	return s.String()
+}
+
 // The status of the cache parameter group.
 type CacheParameterGroupStatus struct {
 	// A list of the cache node IDs which need to be rebooted for parameter changes
@@ -1663,6 +2144,16 @@ type metadataCacheParameterGroupStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheParameterGroupStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheParameterGroupStatus) GoString() string {
+	return s.String()
+}
+
 // Represents the output of one of the following actions:
 //
 //   AuthorizeCacheSecurityGroupIngress   CreateCacheSecurityGroup   RevokeCacheSecurityGroupIngress
@@ -1687,6 +2178,16 @@ type metadataCacheSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheSecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheSecurityGroup) GoString() string {
+	return s.String()
+}
+
 // Represents a cache cluster's status within a particular cache security group.
 type CacheSecurityGroupMembership struct {
 	// The name of the cache security group.
@@ -1704,6 +2205,16 @@ type metadataCacheSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheSecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheSecurityGroupMembership) GoString() string {
+	return s.String()
+}
+
 // Represents the output of one of the following actions:
 //
 //   CreateCacheSubnetGroup   ModifyCacheSubnetGroup
@@ -1728,6 +2239,16 @@ type metadataCacheSubnetGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CacheSubnetGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CacheSubnetGroup) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CopySnapshotMessage action.
 type CopySnapshotInput struct {
 	// The name of an existing snapshot from which to copy.
@@ -1743,6 +2264,16 @@ type metadataCopySnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CopySnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CopySnapshotInput) GoString() string {
+	return s.String()
+}
+
 type CopySnapshotOutput struct {
 	// Represents a copy of an entire cache cluster as of the time when the snapshot
 	// was taken.
@@ -1755,6 +2286,16 @@ type metadataCopySnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CopySnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CopySnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CreateCacheCluster action.
 type CreateCacheClusterInput struct {
 	// Specifies whether the nodes in this Memcached node group are created in a
@@ -1765,7 +2306,9 @@ type CreateCacheClusterInput struct {
 	//
 	// If the AZMode and PreferredAvailabilityZones are not specified, ElastiCache
 	// assumes single-az mode.
-	AZMode *string `type:"string"`
+	//
+	// See the AZMode* constants for valid values.
+	AZMode *string `type:"string" enum:"single-az,cross-az"`
 
 	// This parameter is currently disabled.
 	AutoMinorVersionUpgrade *bool `type:"boolean"`
@@ -1954,8 +2497,18 @@ type metadataCreateCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type CreateCacheClusterOutput struct {
-	// Contains all of the attributes of a specific cache cluster.
+// String returns the string representation
+func (s CreateCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheClusterInput) GoString() string {
+	return s.String()
+}
+
+type CreateCacheClusterOutput struct {
+	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
 
 	metadataCreateCacheClusterOutput `json:"-" xml:"-"`
@@ -1965,6 +2518,16 @@ type metadataCreateCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheClusterOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CreateCacheParameterGroup action.
 type CreateCacheParameterGroupInput struct {
 	// The name of the cache parameter group family the cache parameter group can
@@ -1986,6 +2549,16 @@ type metadataCreateCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheParameterGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreateCacheParameterGroupOutput struct {
 	// Represents the output of a CreateCacheParameterGroup action.
 	CacheParameterGroup *CacheParameterGroup `type:"structure"`
@@ -1997,6 +2570,16 @@ type metadataCreateCacheParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCacheParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheParameterGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CreateCacheSecurityGroup action.
 type CreateCacheSecurityGroupInput struct {
 	// A name for the cache security group. This value is stored as a lowercase
@@ -2018,6 +2601,16 @@ type metadataCreateCacheSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCacheSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheSecurityGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreateCacheSecurityGroupOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -2031,6 +2624,16 @@ type metadataCreateCacheSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCacheSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheSecurityGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CreateCacheSubnetGroup action.
 type CreateCacheSubnetGroupInput struct {
 	// A description for the cache subnet group.
@@ -2053,6 +2656,16 @@ type metadataCreateCacheSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCacheSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheSubnetGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreateCacheSubnetGroupOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -2066,6 +2679,16 @@ type metadataCreateCacheSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCacheSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCacheSubnetGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CreateReplicationGroup action.
 type CreateReplicationGroupInput struct {
 	// This parameter is currently disabled.
@@ -2244,6 +2867,16 @@ type metadataCreateReplicationGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateReplicationGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateReplicationGroupInput) GoString() string {
+	return s.String()
+}
+
 type CreateReplicationGroupOutput struct {
 	// Contains all of the attributes of a specific replication group.
 	ReplicationGroup *ReplicationGroup `type:"structure"`
@@ -2255,6 +2888,16 @@ type metadataCreateReplicationGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateReplicationGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateReplicationGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a CreateSnapshot action.
 type CreateSnapshotInput struct {
 	// The identifier of an existing cache cluster. The snapshot will be created
@@ -2271,6 +2914,16 @@ type metadataCreateSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotInput) GoString() string {
+	return s.String()
+}
+
 type CreateSnapshotOutput struct {
 	// Represents a copy of an entire cache cluster as of the time when the snapshot
 	// was taken.
@@ -2283,6 +2936,16 @@ type metadataCreateSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteCacheCluster action.
 type DeleteCacheClusterInput struct {
 	// The cache cluster identifier for the cluster to be deleted. This parameter
@@ -2301,6 +2964,16 @@ type metadataDeleteCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheClusterInput) GoString() string {
+	return s.String()
+}
+
 type DeleteCacheClusterOutput struct {
 	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
@@ -2312,6 +2985,16 @@ type metadataDeleteCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheClusterOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteCacheParameterGroup action.
 type DeleteCacheParameterGroupInput struct {
 	// The name of the cache parameter group to delete.
@@ -2327,6 +3010,16 @@ type metadataDeleteCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheParameterGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteCacheParameterGroupOutput struct {
 	metadataDeleteCacheParameterGroupOutput `json:"-" xml:"-"`
 }
@@ -2335,6 +3028,16 @@ type metadataDeleteCacheParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheParameterGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteCacheSecurityGroup action.
 type DeleteCacheSecurityGroupInput struct {
 	// The name of the cache security group to delete.
@@ -2349,6 +3052,16 @@ type metadataDeleteCacheSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheSecurityGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteCacheSecurityGroupOutput struct {
 	metadataDeleteCacheSecurityGroupOutput `json:"-" xml:"-"`
 }
@@ -2357,6 +3070,16 @@ type metadataDeleteCacheSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheSecurityGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteCacheSubnetGroup action.
 type DeleteCacheSubnetGroupInput struct {
 	// The name of the cache subnet group to delete.
@@ -2371,6 +3094,16 @@ type metadataDeleteCacheSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheSubnetGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteCacheSubnetGroupOutput struct {
 	metadataDeleteCacheSubnetGroupOutput `json:"-" xml:"-"`
 }
@@ -2379,6 +3112,16 @@ type metadataDeleteCacheSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteCacheSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteCacheSubnetGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteReplicationGroup action.
 type DeleteReplicationGroupInput struct {
 	// The name of a final node group snapshot. ElastiCache creates the snapshot
@@ -2402,6 +3145,16 @@ type metadataDeleteReplicationGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteReplicationGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteReplicationGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteReplicationGroupOutput struct {
 	// Contains all of the attributes of a specific replication group.
 	ReplicationGroup *ReplicationGroup `type:"structure"`
@@ -2413,6 +3166,16 @@ type metadataDeleteReplicationGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteReplicationGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteReplicationGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DeleteSnapshot action.
 type DeleteSnapshotInput struct {
 	// The name of the snapshot to be deleted.
@@ -2425,6 +3188,16 @@ type metadataDeleteSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSnapshotOutput struct {
 	// Represents a copy of an entire cache cluster as of the time when the snapshot
 	// was taken.
@@ -2437,6 +3210,16 @@ type metadataDeleteSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeCacheClusters action.
 type DescribeCacheClustersInput struct {
 	// The user-supplied cluster identifier. If this parameter is specified, only
@@ -2469,6 +3252,16 @@ type metadataDescribeCacheClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheClustersInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeCacheClusters action.
 type DescribeCacheClustersOutput struct {
 	// A list of cache clusters. Each item in the list contains detailed information
@@ -2485,6 +3278,16 @@ type metadataDescribeCacheClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheClustersOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeCacheEngineVersions action.
 type DescribeCacheEngineVersionsInput struct {
 	// The name of a specific cache parameter group family to return details for.
@@ -2528,6 +3331,16 @@ type metadataDescribeCacheEngineVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheEngineVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheEngineVersionsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeCacheEngineVersions action.
 type DescribeCacheEngineVersionsOutput struct {
 	// A list of cache engine version details. Each element in the list contains
@@ -2544,6 +3357,16 @@ type metadataDescribeCacheEngineVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheEngineVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheEngineVersionsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeCacheParameterGroups action.
 type DescribeCacheParameterGroupsInput struct {
 	// The name of a specific cache parameter group to return details for.
@@ -2570,6 +3393,16 @@ type metadataDescribeCacheParameterGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheParameterGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheParameterGroupsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeCacheParameterGroups action.
 type DescribeCacheParameterGroupsOutput struct {
 	// A list of cache parameter groups. Each element in the list contains detailed
@@ -2586,6 +3419,16 @@ type metadataDescribeCacheParameterGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheParameterGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheParameterGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeCacheParameters action.
 type DescribeCacheParametersInput struct {
 	// The name of a specific cache parameter group to return details for.
@@ -2617,6 +3460,16 @@ type metadataDescribeCacheParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheParametersInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeCacheParameters action.
 type DescribeCacheParametersOutput struct {
 	// A list of parameters specific to a particular cache node type. Each element
@@ -2636,6 +3489,16 @@ type metadataDescribeCacheParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheParametersOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeCacheSecurityGroups action.
 type DescribeCacheSecurityGroupsInput struct {
 	// The name of the cache security group to return details for.
@@ -2662,6 +3525,16 @@ type metadataDescribeCacheSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheSecurityGroupsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeCacheSecurityGroups action.
 type DescribeCacheSecurityGroupsOutput struct {
 	// A list of cache security groups. Each element in the list contains detailed
@@ -2678,6 +3551,16 @@ type metadataDescribeCacheSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheSecurityGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeCacheSubnetGroups action.
 type DescribeCacheSubnetGroupsInput struct {
 	// The name of the cache subnet group to return details for.
@@ -2704,6 +3587,16 @@ type metadataDescribeCacheSubnetGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheSubnetGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheSubnetGroupsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeCacheSubnetGroups action.
 type DescribeCacheSubnetGroupsOutput struct {
 	// A list of cache subnet groups. Each element in the list contains detailed
@@ -2720,6 +3613,16 @@ type metadataDescribeCacheSubnetGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheSubnetGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheSubnetGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeEngineDefaultParameters action.
 type DescribeEngineDefaultParametersInput struct {
 	// The name of the cache parameter group family. Valid values are: memcached1.4
@@ -2747,6 +3650,16 @@ type metadataDescribeEngineDefaultParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEngineDefaultParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEngineDefaultParametersInput) GoString() string {
+	return s.String()
+}
+
 type DescribeEngineDefaultParametersOutput struct {
 	// Represents the output of a DescribeEngineDefaultParameters action.
 	EngineDefaults *EngineDefaults `type:"structure"`
@@ -2758,6 +3671,16 @@ type metadataDescribeEngineDefaultParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEngineDefaultParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEngineDefaultParametersOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeEvents action.
 type DescribeEventsInput struct {
 	// The number of minutes' worth of events to retrieve.
@@ -2790,7 +3713,9 @@ type DescribeEventsInput struct {
 	//
 	// Valid values are: cache-cluster | cache-parameter-group | cache-security-group
 	// | cache-subnet-group
-	SourceType *string `type:"string"`
+	//
+	// See the SourceType* constants for valid values.
+	SourceType *string `type:"string" enum:"cache-cluster,cache-parameter-group,cache-security-group,cache-subnet-group"`
 
 	// The beginning of the time interval to retrieve events for, specified in ISO
 	// 8601 format.
@@ -2803,6 +3728,16 @@ type metadataDescribeEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEventsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeEvents action.
 type DescribeEventsOutput struct {
 	// A list of events. Each element in the list contains detailed information
@@ -2819,6 +3754,16 @@ type metadataDescribeEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEventsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeReplicationGroups action.
 type DescribeReplicationGroupsInput struct {
 	// An optional marker returned from a prior request. Use this marker for pagination
@@ -2849,6 +3794,16 @@ type metadataDescribeReplicationGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReplicationGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReplicationGroupsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeReplicationGroups action.
 type DescribeReplicationGroupsOutput struct {
 	// Provides an identifier to allow retrieval of paginated results.
@@ -2865,6 +3820,16 @@ type metadataDescribeReplicationGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReplicationGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReplicationGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeReservedCacheNodes action.
 type DescribeReservedCacheNodesInput struct {
 	// The cache node type filter value. Use this parameter to show only those reservations
@@ -2934,6 +3899,16 @@ type metadataDescribeReservedCacheNodesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedCacheNodesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedCacheNodesInput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeReservedCacheNodesOfferings action.
 type DescribeReservedCacheNodesOfferingsInput struct {
 	// The cache node type filter value. Use this parameter to show only the available
@@ -3001,6 +3976,16 @@ type metadataDescribeReservedCacheNodesOfferingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedCacheNodesOfferingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedCacheNodesOfferingsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeReservedCacheNodesOfferings action.
 type DescribeReservedCacheNodesOfferingsOutput struct {
 	// Provides an identifier to allow retrieval of paginated results.
@@ -3017,6 +4002,16 @@ type metadataDescribeReservedCacheNodesOfferingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedCacheNodesOfferingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedCacheNodesOfferingsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeReservedCacheNodes action.
 type DescribeReservedCacheNodesOutput struct {
 	// Provides an identifier to allow retrieval of paginated results.
@@ -3033,6 +4028,16 @@ type metadataDescribeReservedCacheNodesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeReservedCacheNodesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeReservedCacheNodesOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a DescribeSnapshotsMessage action.
 type DescribeSnapshotsInput struct {
 	// A user-supplied cluster identifier. If this parameter is specified, only
@@ -3070,6 +4075,16 @@ type metadataDescribeSnapshotsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeSnapshots action.
 type DescribeSnapshotsOutput struct {
 	// An optional marker returned from a prior request. Use this marker for pagination
@@ -3088,6 +4103,16 @@ type metadataDescribeSnapshotsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotsOutput) GoString() string {
+	return s.String()
+}
+
 // Provides ownership and status information for an Amazon EC2 security group.
 type EC2SecurityGroup struct {
 	// The name of the Amazon EC2 security group.
@@ -3106,6 +4131,16 @@ type metadataEC2SecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EC2SecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EC2SecurityGroup) GoString() string {
+	return s.String()
+}
+
 // Represents the information required for client programs to connect to a cache
 // node.
 type Endpoint struct {
@@ -3122,6 +4157,16 @@ type metadataEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Endpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Endpoint) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeEngineDefaultParameters action.
 type EngineDefaults struct {
 	// A list of parameters specific to a particular cache node type. Each element
@@ -3145,6 +4190,16 @@ type metadataEngineDefaults struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EngineDefaults) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EngineDefaults) GoString() string {
+	return s.String()
+}
+
 // Represents a single occurrence of something interesting within the system.
 // Some examples of events are creating a cache cluster, adding or removing
 // a cache node, or rebooting a node.
@@ -3162,7 +4217,9 @@ type Event struct {
 
 	// Specifies the origin of this event - a cache cluster, a parameter group,
 	// a security group, etc.
-	SourceType *string `type:"string"`
+	//
+	// See the SourceType* constants for valid values.
+	SourceType *string `type:"string" enum:"cache-cluster,cache-parameter-group,cache-security-group,cache-subnet-group"`
 
 	metadataEvent `json:"-" xml:"-"`
 }
@@ -3171,6 +4228,16 @@ type metadataEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Event) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Event) GoString() string {
+	return s.String()
+}
+
 // The input parameters for the ListTagsForResource action.
 type ListTagsForResourceInput struct {
 	// The name of the resource for which you want the list of tags, for example
@@ -3184,6 +4251,16 @@ type metadataListTagsForResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a ModifyCacheCluster action.
 type ModifyCacheClusterInput struct {
 	// Specifies whether the new nodes in this Memcached cache cluster are all created
@@ -3201,7 +4278,9 @@ type ModifyCacheClusterInput struct {
 	// For instructions on how to move existing Memcached nodes to different Availability
 	// Zones, see the Availability Zone Considerations section of Cache Node Considerations
 	// for Memcached (http://docs.aws.amazon.com/AmazonElastiCache/latest/UserGuide/CacheNode.Memcached.html).
-	AZMode *string `type:"string"`
+	//
+	// See the AZMode* constants for valid values.
+	AZMode *string `type:"string" enum:"single-az,cross-az"`
 
 	// If true, this parameter causes the modifications in this request and any
 	// pending modifications to be applied, asynchronously and as soon as possible,
@@ -3371,6 +4450,16 @@ type metadataModifyCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyCacheClusterInput) GoString() string {
+	return s.String()
+}
+
 type ModifyCacheClusterOutput struct {
 	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
@@ -3382,6 +4471,16 @@ type metadataModifyCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyCacheClusterOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a ModifyCacheParameterGroup action.
 type ModifyCacheParameterGroupInput struct {
 	// The name of the cache parameter group to modify.
@@ -3399,6 +4498,16 @@ type metadataModifyCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyCacheParameterGroupInput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a ModifyCacheSubnetGroup action.
 type ModifyCacheSubnetGroupInput struct {
 	// A description for the cache subnet group.
@@ -3422,6 +4531,16 @@ type metadataModifyCacheSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyCacheSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyCacheSubnetGroupInput) GoString() string {
+	return s.String()
+}
+
 type ModifyCacheSubnetGroupOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -3435,6 +4554,16 @@ type metadataModifyCacheSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyCacheSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyCacheSubnetGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a ModifyReplicationGroups action.
 type ModifyReplicationGroupInput struct {
 	// If true, this parameter causes the modifications in this request and any
@@ -3551,6 +4680,16 @@ type metadataModifyReplicationGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyReplicationGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyReplicationGroupInput) GoString() string {
+	return s.String()
+}
+
 type ModifyReplicationGroupOutput struct {
 	// Contains all of the attributes of a specific replication group.
 	ReplicationGroup *ReplicationGroup `type:"structure"`
@@ -3562,6 +4701,16 @@ type metadataModifyReplicationGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyReplicationGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyReplicationGroupOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a collection of cache nodes in a replication group.
 type NodeGroup struct {
 	// The identifier for the node group. A replication group contains only one
@@ -3585,6 +4734,16 @@ type metadataNodeGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NodeGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NodeGroup) GoString() string {
+	return s.String()
+}
+
 // Represents a single node within a node group.
 type NodeGroupMember struct {
 	// The ID of the cache cluster to which the node belongs.
@@ -3611,6 +4770,16 @@ type metadataNodeGroupMember struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NodeGroupMember) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NodeGroupMember) GoString() string {
+	return s.String()
+}
+
 // Represents an individual cache node in a snapshot of a cache cluster.
 type NodeSnapshot struct {
 	// The date and time when the cache node was created in the source cache cluster.
@@ -3633,6 +4802,16 @@ type metadataNodeSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NodeSnapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NodeSnapshot) GoString() string {
+	return s.String()
+}
+
 // Describes a notification topic and its status. Notification topics are used
 // for publishing ElastiCache events to subscribers using Amazon Simple Notification
 // Service (SNS).
@@ -3650,6 +4829,16 @@ type metadataNotificationConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NotificationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NotificationConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes an individual setting that controls some aspect of ElastiCache
 // behavior.
 type Parameter struct {
@@ -3686,6 +4875,16 @@ type metadataParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Parameter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Parameter) GoString() string {
+	return s.String()
+}
+
 // Describes a name-value pair that is used to update the value of a parameter.
 type ParameterNameValue struct {
 	// The name of the parameter.
@@ -3701,6 +4900,16 @@ type metadataParameterNameValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ParameterNameValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ParameterNameValue) GoString() string {
+	return s.String()
+}
+
 // A group of settings that will be applied to the cache cluster in the future,
 // or that are currently being applied.
 type PendingModifiedValues struct {
@@ -3724,6 +4933,16 @@ type metadataPendingModifiedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PendingModifiedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PendingModifiedValues) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a PurchaseReservedCacheNodesOffering action.
 type PurchaseReservedCacheNodesOfferingInput struct {
 	// The number of cache node instances to reserve.
@@ -3748,6 +4967,16 @@ type metadataPurchaseReservedCacheNodesOfferingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PurchaseReservedCacheNodesOfferingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PurchaseReservedCacheNodesOfferingInput) GoString() string {
+	return s.String()
+}
+
 type PurchaseReservedCacheNodesOfferingOutput struct {
 	// Represents the output of a PurchaseReservedCacheNodesOffering action.
 	ReservedCacheNode *ReservedCacheNode `type:"structure"`
@@ -3759,6 +4988,16 @@ type metadataPurchaseReservedCacheNodesOfferingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PurchaseReservedCacheNodesOfferingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PurchaseReservedCacheNodesOfferingOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a RebootCacheCluster action.
 type RebootCacheClusterInput struct {
 	// The cache cluster identifier. This parameter is stored as a lowercase string.
@@ -3776,6 +5015,16 @@ type metadataRebootCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootCacheClusterInput) GoString() string {
+	return s.String()
+}
+
 type RebootCacheClusterOutput struct {
 	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
@@ -3787,6 +5036,16 @@ type metadataRebootCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootCacheClusterOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the specific price and frequency of a recurring charges for a reserved
 // cache node, or for a reserved cache node offering.
 type RecurringCharge struct {
@@ -3803,6 +5062,16 @@ type metadataRecurringCharge struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecurringCharge) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecurringCharge) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a RemoveTagsFromResource action.
 type RemoveTagsFromResourceInput struct {
 	// The name of the ElastiCache resource from which you want the listed tags
@@ -3821,6 +5090,16 @@ type metadataRemoveTagsFromResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsFromResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsFromResourceInput) GoString() string {
+	return s.String()
+}
+
 // Contains all of the attributes of a specific replication group.
 type ReplicationGroup struct {
 	// Indicates the status of Multi-AZ for this replication group.
@@ -3828,7 +5107,9 @@ type ReplicationGroup struct {
 	// ElastiCache Multi-AZ replication groups are not supported on:
 	//
 	//  Redis versions earlier than 2.8.6. T1 and T2 cache node types.
-	AutomaticFailover *string `type:"string"`
+	//
+	// See the AutomaticFailoverStatus* constants for valid values.
+	AutomaticFailover *string `type:"string" enum:"enabled,disabled,enabling,disabling"`
 
 	// The description of the replication group.
 	Description *string `type:"string"`
@@ -3861,6 +5142,16 @@ type metadataReplicationGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplicationGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplicationGroup) GoString() string {
+	return s.String()
+}
+
 // The settings to be applied to the replication group, either immediately or
 // during the next maintenance window.
 type ReplicationGroupPendingModifiedValues struct {
@@ -3869,7 +5160,9 @@ type ReplicationGroupPendingModifiedValues struct {
 	// ElastiCache Multi-AZ replication groups are not supported on:
 	//
 	//  Redis versions earlier than 2.8.6. T1 and T2 cache node types.
-	AutomaticFailoverStatus *string `type:"string"`
+	//
+	// See the PendingAutomaticFailoverStatus* constants for valid values.
+	AutomaticFailoverStatus *string `type:"string" enum:"enabled,disabled"`
 
 	// The primary cluster ID which will be applied immediately (if --apply-immediately
 	// was specified), or during the next maintenance window.
@@ -3882,6 +5175,16 @@ type metadataReplicationGroupPendingModifiedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReplicationGroupPendingModifiedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReplicationGroupPendingModifiedValues) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a PurchaseReservedCacheNodesOffering action.
 type ReservedCacheNode struct {
 	// The number of cache nodes that have been reserved.
@@ -3945,6 +5248,16 @@ type metadataReservedCacheNode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedCacheNode) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedCacheNode) GoString() string {
+	return s.String()
+}
+
 // Describes all of the attributes of a reserved cache node offering.
 type ReservedCacheNodesOffering struct {
 	// The cache node type for the reserved cache node.
@@ -3996,6 +5309,16 @@ type metadataReservedCacheNodesOffering struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReservedCacheNodesOffering) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReservedCacheNodesOffering) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a ResetCacheParameterGroup action.
 type ResetCacheParameterGroupInput struct {
 	// The name of the cache parameter group to reset.
@@ -4018,6 +5341,16 @@ type metadataResetCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetCacheParameterGroupInput) GoString() string {
+	return s.String()
+}
+
 // Represents the input of a RevokeCacheSecurityGroupIngress action.
 type RevokeCacheSecurityGroupIngressInput struct {
 	// The name of the cache security group to revoke ingress from.
@@ -4038,6 +5371,16 @@ type metadataRevokeCacheSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeCacheSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeCacheSecurityGroupIngressInput) GoString() string {
+	return s.String()
+}
+
 type RevokeCacheSecurityGroupIngressOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -4051,6 +5394,16 @@ type metadataRevokeCacheSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeCacheSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeCacheSecurityGroupIngressOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a single cache security group and its status.
 type SecurityGroupMembership struct {
 	// The identifier of the cache security group.
@@ -4068,6 +5421,16 @@ type metadataSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SecurityGroupMembership) GoString() string {
+	return s.String()
+}
+
 // Represents a copy of an entire cache cluster as of the time when the snapshot
 // was taken.
 type Snapshot struct {
@@ -4182,6 +5545,16 @@ type metadataSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Snapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Snapshot) GoString() string {
+	return s.String()
+}
+
 // Represents the subnet associated with a cache cluster. This parameter refers
 // to subnets defined in Amazon Virtual Private Cloud (Amazon VPC) and used
 // with ElastiCache.
@@ -4199,6 +5572,16 @@ type metadataSubnet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Subnet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Subnet) GoString() string {
+	return s.String()
+}
+
 // A cost allocation Tag that can be added to an ElastiCache cluster or replication
 // group. Tags are composed of a Key/Value pair. A tag with a null Value is
 // permitted.
@@ -4216,6 +5599,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // Represents the output from the AddTagsToResource, ListTagsOnResource, and
 // RemoveTagsFromResource actions.
 type TagListMessage struct {
@@ -4228,3 +5621,49 @@ type TagListMessage struct {
 type metadataTagListMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s TagListMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TagListMessage) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum AZMode
+	AZModeSingleAz = "single-az"
+	// @enum AZMode
+	AZModeCrossAz = "cross-az"
+)
+
+const (
+	// @enum AutomaticFailoverStatus
+	AutomaticFailoverStatusEnabled = "enabled"
+	// @enum AutomaticFailoverStatus
+	AutomaticFailoverStatusDisabled = "disabled"
+	// @enum AutomaticFailoverStatus
+	AutomaticFailoverStatusEnabling = "enabling"
+	// @enum AutomaticFailoverStatus
+	AutomaticFailoverStatusDisabling = "disabling"
+)
+
+const (
+	// @enum PendingAutomaticFailoverStatus
+	PendingAutomaticFailoverStatusEnabled = "enabled"
+	// @enum PendingAutomaticFailoverStatus
+	PendingAutomaticFailoverStatusDisabled = "disabled"
+)
+
+const (
+	// @enum SourceType
+	SourceTypeCacheCluster = "cache-cluster"
+	// @enum SourceType
+	SourceTypeCacheParameterGroup = "cache-parameter-group"
+	// @enum SourceType
+	SourceTypeCacheSecurityGroup = "cache-security-group"
+	// @enum SourceType
+	SourceTypeCacheSubnetGroup = "cache-subnet-group"
+)
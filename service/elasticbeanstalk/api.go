@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAbortEnvironmentUpdate = "AbortEnvironmentUpdate"
@@ -37,6 +38,16 @@ func (c *ElasticBeanstalk) AbortEnvironmentUpdate(input *AbortEnvironmentUpdateI
 	return out, err
 }
 
+// AbortEnvironmentUpdateWithContext is the same as AbortEnvironmentUpdate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) AbortEnvironmentUpdateWithContext(ctx aws.Context, input *AbortEnvironmentUpdateInput) (*AbortEnvironmentUpdateOutput, error) {
+	req, out := c.AbortEnvironmentUpdateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCheckDNSAvailability = "CheckDNSAvailability"
 
 // CheckDNSAvailabilityRequest generates a request for the CheckDNSAvailability operation.
@@ -64,6 +75,16 @@ func (c *ElasticBeanstalk) CheckDNSAvailability(input *CheckDNSAvailabilityInput
 	return out, err
 }
 
+// CheckDNSAvailabilityWithContext is the same as CheckDNSAvailability with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) CheckDNSAvailabilityWithContext(ctx aws.Context, input *CheckDNSAvailabilityInput) (*CheckDNSAvailabilityOutput, error) {
+	req, out := c.CheckDNSAvailabilityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateApplication = "CreateApplication"
 
 // CreateApplicationRequest generates a request for the CreateApplication operation.
@@ -92,6 +113,16 @@ func (c *ElasticBeanstalk) CreateApplication(input *CreateApplicationInput) (*Ap
 	return out, err
 }
 
+// CreateApplicationWithContext is the same as CreateApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) CreateApplicationWithContext(ctx aws.Context, input *CreateApplicationInput) (*ApplicationDescriptionMessage, error) {
+	req, out := c.CreateApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateApplicationVersion = "CreateApplicationVersion"
 
 // CreateApplicationVersionRequest generates a request for the CreateApplicationVersion operation.
@@ -124,6 +155,16 @@ func (c *ElasticBeanstalk) CreateApplicationVersion(input *CreateApplicationVers
 	return out, err
 }
 
+// CreateApplicationVersionWithContext is the same as CreateApplicationVersion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) CreateApplicationVersionWithContext(ctx aws.Context, input *CreateApplicationVersionInput) (*ApplicationVersionDescriptionMessage, error) {
+	req, out := c.CreateApplicationVersionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateConfigurationTemplate = "CreateConfigurationTemplate"
 
 // CreateConfigurationTemplateRequest generates a request for the CreateConfigurationTemplate operation.
@@ -157,6 +198,16 @@ func (c *ElasticBeanstalk) CreateConfigurationTemplate(input *CreateConfiguratio
 	return out, err
 }
 
+// CreateConfigurationTemplateWithContext is the same as CreateConfigurationTemplate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) CreateConfigurationTemplateWithContext(ctx aws.Context, input *CreateConfigurationTemplateInput) (*ConfigurationSettingsDescription, error) {
+	req, out := c.CreateConfigurationTemplateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateEnvironment = "CreateEnvironment"
 
 // CreateEnvironmentRequest generates a request for the CreateEnvironment operation.
@@ -185,6 +236,16 @@ func (c *ElasticBeanstalk) CreateEnvironment(input *CreateEnvironmentInput) (*En
 	return out, err
 }
 
+// CreateEnvironmentWithContext is the same as CreateEnvironment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) CreateEnvironmentWithContext(ctx aws.Context, input *CreateEnvironmentInput) (*EnvironmentDescription, error) {
+	req, out := c.CreateEnvironmentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStorageLocation = "CreateStorageLocation"
 
 // CreateStorageLocationRequest generates a request for the CreateStorageLocation operation.
@@ -214,6 +275,16 @@ func (c *ElasticBeanstalk) CreateStorageLocation(input *CreateStorageLocationInp
 	return out, err
 }
 
+// CreateStorageLocationWithContext is the same as CreateStorageLocation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) CreateStorageLocationWithContext(ctx aws.Context, input *CreateStorageLocationInput) (*CreateStorageLocationOutput, error) {
+	req, out := c.CreateStorageLocationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteApplication = "DeleteApplication"
 
 // DeleteApplicationRequest generates a request for the DeleteApplication operation.
@@ -245,6 +316,16 @@ func (c *ElasticBeanstalk) DeleteApplication(input *DeleteApplicationInput) (*De
 	return out, err
 }
 
+// DeleteApplicationWithContext is the same as DeleteApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DeleteApplicationWithContext(ctx aws.Context, input *DeleteApplicationInput) (*DeleteApplicationOutput, error) {
+	req, out := c.DeleteApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteApplicationVersion = "DeleteApplicationVersion"
 
 // DeleteApplicationVersionRequest generates a request for the DeleteApplicationVersion operation.
@@ -275,6 +356,16 @@ func (c *ElasticBeanstalk) DeleteApplicationVersion(input *DeleteApplicationVers
 	return out, err
 }
 
+// DeleteApplicationVersionWithContext is the same as DeleteApplicationVersion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DeleteApplicationVersionWithContext(ctx aws.Context, input *DeleteApplicationVersionInput) (*DeleteApplicationVersionOutput, error) {
+	req, out := c.DeleteApplicationVersionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteConfigurationTemplate = "DeleteConfigurationTemplate"
 
 // DeleteConfigurationTemplateRequest generates a request for the DeleteConfigurationTemplate operation.
@@ -306,6 +397,16 @@ func (c *ElasticBeanstalk) DeleteConfigurationTemplate(input *DeleteConfiguratio
 	return out, err
 }
 
+// DeleteConfigurationTemplateWithContext is the same as DeleteConfigurationTemplate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DeleteConfigurationTemplateWithContext(ctx aws.Context, input *DeleteConfigurationTemplateInput) (*DeleteConfigurationTemplateOutput, error) {
+	req, out := c.DeleteConfigurationTemplateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteEnvironmentConfiguration = "DeleteEnvironmentConfiguration"
 
 // DeleteEnvironmentConfigurationRequest generates a request for the DeleteEnvironmentConfiguration operation.
@@ -340,6 +441,16 @@ func (c *ElasticBeanstalk) DeleteEnvironmentConfiguration(input *DeleteEnvironme
 	return out, err
 }
 
+// DeleteEnvironmentConfigurationWithContext is the same as DeleteEnvironmentConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DeleteEnvironmentConfigurationWithContext(ctx aws.Context, input *DeleteEnvironmentConfigurationInput) (*DeleteEnvironmentConfigurationOutput, error) {
+	req, out := c.DeleteEnvironmentConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeApplicationVersions = "DescribeApplicationVersions"
 
 // DescribeApplicationVersionsRequest generates a request for the DescribeApplicationVersions operation.
@@ -367,6 +478,16 @@ func (c *ElasticBeanstalk) DescribeApplicationVersions(input *DescribeApplicatio
 	return out, err
 }
 
+// DescribeApplicationVersionsWithContext is the same as DescribeApplicationVersions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DescribeApplicationVersionsWithContext(ctx aws.Context, input *DescribeApplicationVersionsInput) (*DescribeApplicationVersionsOutput, error) {
+	req, out := c.DescribeApplicationVersionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeApplications = "DescribeApplications"
 
 // DescribeApplicationsRequest generates a request for the DescribeApplications operation.
@@ -394,6 +515,16 @@ func (c *ElasticBeanstalk) DescribeApplications(input *DescribeApplicationsInput
 	return out, err
 }
 
+// DescribeApplicationsWithContext is the same as DescribeApplications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DescribeApplicationsWithContext(ctx aws.Context, input *DescribeApplicationsInput) (*DescribeApplicationsOutput, error) {
+	req, out := c.DescribeApplicationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationOptions = "DescribeConfigurationOptions"
 
 // DescribeConfigurationOptionsRequest generates a request for the DescribeConfigurationOptions operation.
@@ -425,6 +556,16 @@ func (c *ElasticBeanstalk) DescribeConfigurationOptions(input *DescribeConfigura
 	return out, err
 }
 
+// DescribeConfigurationOptionsWithContext is the same as DescribeConfigurationOptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DescribeConfigurationOptionsWithContext(ctx aws.Context, input *DescribeConfigurationOptionsInput) (*DescribeConfigurationOptionsOutput, error) {
+	req, out := c.DescribeConfigurationOptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeConfigurationSettings = "DescribeConfigurationSettings"
 
 // DescribeConfigurationSettingsRequest generates a request for the DescribeConfigurationSettings operation.
@@ -464,6 +605,16 @@ func (c *ElasticBeanstalk) DescribeConfigurationSettings(input *DescribeConfigur
 	return out, err
 }
 
+// DescribeConfigurationSettingsWithContext is the same as DescribeConfigurationSettings with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DescribeConfigurationSettingsWithContext(ctx aws.Context, input *DescribeConfigurationSettingsInput) (*DescribeConfigurationSettingsOutput, error) {
+	req, out := c.DescribeConfigurationSettingsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeEnvironmentResources = "DescribeEnvironmentResources"
 
 // DescribeEnvironmentResourcesRequest generates a request for the DescribeEnvironmentResources operation.
@@ -491,6 +642,16 @@ func (c *ElasticBeanstalk) DescribeEnvironmentResources(input *DescribeEnvironme
 	return out, err
 }
 
+// DescribeEnvironmentResourcesWithContext is the same as DescribeEnvironmentResources with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DescribeEnvironmentResourcesWithContext(ctx aws.Context, input *DescribeEnvironmentResourcesInput) (*DescribeEnvironmentResourcesOutput, error) {
+	req, out := c.DescribeEnvironmentResourcesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeEnvironments = "DescribeEnvironments"
 
 // DescribeEnvironmentsRequest generates a request for the DescribeEnvironments operation.
@@ -518,6 +679,16 @@ func (c *ElasticBeanstalk) DescribeEnvironments(input *DescribeEnvironmentsInput
 	return out, err
 }
 
+// DescribeEnvironmentsWithContext is the same as DescribeEnvironments with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DescribeEnvironmentsWithContext(ctx aws.Context, input *DescribeEnvironmentsInput) (*DescribeEnvironmentsOutput, error) {
+	req, out := c.DescribeEnvironmentsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeEvents = "DescribeEvents"
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -553,6 +724,16 @@ func (c *ElasticBeanstalk) DescribeEvents(input *DescribeEventsInput) (*Describe
 	return out, err
 }
 
+// DescribeEventsWithContext is the same as DescribeEvents with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) DescribeEventsWithContext(ctx aws.Context, input *DescribeEventsInput) (*DescribeEventsOutput, error) {
+	req, out := c.DescribeEventsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElasticBeanstalk) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeEventsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -587,6 +768,16 @@ func (c *ElasticBeanstalk) ListAvailableSolutionStacks(input *ListAvailableSolut
 	return out, err
 }
 
+// ListAvailableSolutionStacksWithContext is the same as ListAvailableSolutionStacks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) ListAvailableSolutionStacksWithContext(ctx aws.Context, input *ListAvailableSolutionStacksInput) (*ListAvailableSolutionStacksOutput, error) {
+	req, out := c.ListAvailableSolutionStacksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRebuildEnvironment = "RebuildEnvironment"
 
 // RebuildEnvironmentRequest generates a request for the RebuildEnvironment operation.
@@ -615,6 +806,16 @@ func (c *ElasticBeanstalk) RebuildEnvironment(input *RebuildEnvironmentInput) (*
 	return out, err
 }
 
+// RebuildEnvironmentWithContext is the same as RebuildEnvironment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) RebuildEnvironmentWithContext(ctx aws.Context, input *RebuildEnvironmentInput) (*RebuildEnvironmentOutput, error) {
+	req, out := c.RebuildEnvironmentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRequestEnvironmentInfo = "RequestEnvironmentInfo"
 
 // RequestEnvironmentInfoRequest generates a request for the RequestEnvironmentInfo operation.
@@ -656,6 +857,16 @@ func (c *ElasticBeanstalk) RequestEnvironmentInfo(input *RequestEnvironmentInfoI
 	return out, err
 }
 
+// RequestEnvironmentInfoWithContext is the same as RequestEnvironmentInfo with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) RequestEnvironmentInfoWithContext(ctx aws.Context, input *RequestEnvironmentInfoInput) (*RequestEnvironmentInfoOutput, error) {
+	req, out := c.RequestEnvironmentInfoRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRestartAppServer = "RestartAppServer"
 
 // RestartAppServerRequest generates a request for the RestartAppServer operation.
@@ -684,6 +895,16 @@ func (c *ElasticBeanstalk) RestartAppServer(input *RestartAppServerInput) (*Rest
 	return out, err
 }
 
+// RestartAppServerWithContext is the same as RestartAppServer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) RestartAppServerWithContext(ctx aws.Context, input *RestartAppServerInput) (*RestartAppServerOutput, error) {
+	req, out := c.RestartAppServerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveEnvironmentInfo = "RetrieveEnvironmentInfo"
 
 // RetrieveEnvironmentInfoRequest generates a request for the RetrieveEnvironmentInfo operation.
@@ -715,6 +936,16 @@ func (c *ElasticBeanstalk) RetrieveEnvironmentInfo(input *RetrieveEnvironmentInf
 	return out, err
 }
 
+// RetrieveEnvironmentInfoWithContext is the same as RetrieveEnvironmentInfo with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) RetrieveEnvironmentInfoWithContext(ctx aws.Context, input *RetrieveEnvironmentInfoInput) (*RetrieveEnvironmentInfoOutput, error) {
+	req, out := c.RetrieveEnvironmentInfoRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSwapEnvironmentCNAMEs = "SwapEnvironmentCNAMEs"
 
 // SwapEnvironmentCNAMEsRequest generates a request for the SwapEnvironmentCNAMEs operation.
@@ -742,6 +973,16 @@ func (c *ElasticBeanstalk) SwapEnvironmentCNAMEs(input *SwapEnvironmentCNAMEsInp
 	return out, err
 }
 
+// SwapEnvironmentCNAMEsWithContext is the same as SwapEnvironmentCNAMEs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) SwapEnvironmentCNAMEsWithContext(ctx aws.Context, input *SwapEnvironmentCNAMEsInput) (*SwapEnvironmentCNAMEsOutput, error) {
+	req, out := c.SwapEnvironmentCNAMEsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateEnvironment = "TerminateEnvironment"
 
 // TerminateEnvironmentRequest generates a request for the TerminateEnvironment operation.
@@ -769,6 +1010,16 @@ func (c *ElasticBeanstalk) TerminateEnvironment(input *TerminateEnvironmentInput
 	return out, err
 }
 
+// TerminateEnvironmentWithContext is the same as TerminateEnvironment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) TerminateEnvironmentWithContext(ctx aws.Context, input *TerminateEnvironmentInput) (*EnvironmentDescription, error) {
+	req, out := c.TerminateEnvironmentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateApplication = "UpdateApplication"
 
 // UpdateApplicationRequest generates a request for the UpdateApplication operation.
@@ -799,6 +1050,16 @@ func (c *ElasticBeanstalk) UpdateApplication(input *UpdateApplicationInput) (*Ap
 	return out, err
 }
 
+// UpdateApplicationWithContext is the same as UpdateApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) UpdateApplicationWithContext(ctx aws.Context, input *UpdateApplicationInput) (*ApplicationDescriptionMessage, error) {
+	req, out := c.UpdateApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateApplicationVersion = "UpdateApplicationVersion"
 
 // UpdateApplicationVersionRequest generates a request for the UpdateApplicationVersion operation.
@@ -829,6 +1090,16 @@ func (c *ElasticBeanstalk) UpdateApplicationVersion(input *UpdateApplicationVers
 	return out, err
 }
 
+// UpdateApplicationVersionWithContext is the same as UpdateApplicationVersion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) UpdateApplicationVersionWithContext(ctx aws.Context, input *UpdateApplicationVersionInput) (*ApplicationVersionDescriptionMessage, error) {
+	req, out := c.UpdateApplicationVersionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateConfigurationTemplate = "UpdateConfigurationTemplate"
 
 // UpdateConfigurationTemplateRequest generates a request for the UpdateConfigurationTemplate operation.
@@ -863,6 +1134,16 @@ func (c *ElasticBeanstalk) UpdateConfigurationTemplate(input *UpdateConfiguratio
 	return out, err
 }
 
+// UpdateConfigurationTemplateWithContext is the same as UpdateConfigurationTemplate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) UpdateConfigurationTemplateWithContext(ctx aws.Context, input *UpdateConfigurationTemplateInput) (*ConfigurationSettingsDescription, error) {
+	req, out := c.UpdateConfigurationTemplateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateEnvironment = "UpdateEnvironment"
 
 // UpdateEnvironmentRequest generates a request for the UpdateEnvironment operation.
@@ -900,6 +1181,16 @@ func (c *ElasticBeanstalk) UpdateEnvironment(input *UpdateEnvironmentInput) (*En
 	return out, err
 }
 
+// UpdateEnvironmentWithContext is the same as UpdateEnvironment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) UpdateEnvironmentWithContext(ctx aws.Context, input *UpdateEnvironmentInput) (*EnvironmentDescription, error) {
+	req, out := c.UpdateEnvironmentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opValidateConfigurationSettings = "ValidateConfigurationSettings"
 
 // ValidateConfigurationSettingsRequest generates a request for the ValidateConfigurationSettings operation.
@@ -931,6 +1222,16 @@ func (c *ElasticBeanstalk) ValidateConfigurationSettings(input *ValidateConfigur
 	return out, err
 }
 
+// ValidateConfigurationSettingsWithContext is the same as ValidateConfigurationSettings with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticBeanstalk) ValidateConfigurationSettingsWithContext(ctx aws.Context, input *ValidateConfigurationSettingsInput) (*ValidateConfigurationSettingsOutput, error) {
+	req, out := c.ValidateConfigurationSettingsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type AbortEnvironmentUpdateInput struct {
 	// This specifies the ID of the environment with the in-progress update that
 	// you want to cancel.
@@ -938,7 +1239,7 @@ type AbortEnvironmentUpdateInput struct {
 
 	// This specifies the name of the environment with the in-progress update that
 	// you want to cancel.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataAbortEnvironmentUpdateInput `json:"-" xml:"-"`
 }
@@ -947,6 +1248,16 @@ type metadataAbortEnvironmentUpdateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AbortEnvironmentUpdateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AbortEnvironmentUpdateInput) GoString() string {
+	return s.String()
+}
+
 type AbortEnvironmentUpdateOutput struct {
 	metadataAbortEnvironmentUpdateOutput `json:"-" xml:"-"`
 }
@@ -955,10 +1266,20 @@ type metadataAbortEnvironmentUpdateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AbortEnvironmentUpdateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AbortEnvironmentUpdateOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the properties of an application.
 type ApplicationDescription struct {
 	// The name of the application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The names of the configuration templates associated with this application.
 	ConfigurationTemplates []*string `type:"list"`
@@ -970,7 +1291,7 @@ type ApplicationDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// User-defined description of the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The names of the versions for this application.
 	Versions []*string `type:"list"`
@@ -982,6 +1303,16 @@ type metadataApplicationDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ApplicationDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ApplicationDescription) GoString() string {
+	return s.String()
+}
+
 // Result message containing a single description of an application.
 type ApplicationDescriptionMessage struct {
 	// The ApplicationDescription of the application.
@@ -994,10 +1325,20 @@ type metadataApplicationDescriptionMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ApplicationDescriptionMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ApplicationDescriptionMessage) GoString() string {
+	return s.String()
+}
+
 // Describes the properties of an application version.
 type ApplicationVersionDescription struct {
 	// The name of the application associated with this release.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The creation date of the application version.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1006,13 +1347,13 @@ type ApplicationVersionDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description of this application version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The location where the source bundle is located for this version.
 	SourceBundle *S3Location `type:"structure"`
 
 	// A label uniquely identifying the version for the associated application.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataApplicationVersionDescription `json:"-" xml:"-"`
 }
@@ -1021,6 +1362,16 @@ type metadataApplicationVersionDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ApplicationVersionDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ApplicationVersionDescription) GoString() string {
+	return s.String()
+}
+
 // Result message wrapping a single description of an application version.
 type ApplicationVersionDescriptionMessage struct {
 	// The ApplicationVersionDescription of the application version.
@@ -1033,6 +1384,16 @@ type metadataApplicationVersionDescriptionMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ApplicationVersionDescriptionMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ApplicationVersionDescriptionMessage) GoString() string {
+	return s.String()
+}
+
 // Describes an Auto Scaling launch configuration.
 type AutoScalingGroup struct {
 	// The name of the AutoScalingGroup .
@@ -1045,10 +1406,20 @@ type metadataAutoScalingGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AutoScalingGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AutoScalingGroup) GoString() string {
+	return s.String()
+}
+
 // Results message indicating whether a CNAME is available.
 type CheckDNSAvailabilityInput struct {
 	// The prefix used when this CNAME is reserved.
-	CNAMEPrefix *string `type:"string" required:"true"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63" required:"true"`
 
 	metadataCheckDNSAvailabilityInput `json:"-" xml:"-"`
 }
@@ -1057,6 +1428,16 @@ type metadataCheckDNSAvailabilityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CheckDNSAvailabilityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CheckDNSAvailabilityInput) GoString() string {
+	return s.String()
+}
+
 // Indicates if the specified CNAME is available.
 type CheckDNSAvailabilityOutput struct {
 	// Indicates if the specified CNAME is available:
@@ -1070,7 +1451,7 @@ type CheckDNSAvailabilityOutput struct {
 
 	// The fully qualified CNAME to reserve when CreateEnvironment is called with
 	// the provided prefix.
-	FullyQualifiedCNAME *string `type:"string"`
+	FullyQualifiedCNAME *string `type:"string" min:"1" max:"255"`
 
 	metadataCheckDNSAvailabilityOutput `json:"-" xml:"-"`
 }
@@ -1079,6 +1460,16 @@ type metadataCheckDNSAvailabilityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CheckDNSAvailabilityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CheckDNSAvailabilityOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the possible values for a configuration option.
 type ConfigurationOptionDescription struct {
 	// An indication of which action is required if the value for this configuration
@@ -1167,7 +1558,9 @@ type ConfigurationOptionDescription struct {
 	// constraints.   List : Values for this option are multiple selections from
 	// the possible values.   Boolean : Values for this option are either true or
 	// false .
-	ValueType *string `type:"string"`
+	//
+	// See the ConfigurationOptionValueType* constants for valid values.
+	ValueType *string `type:"string" enum:"Scalar,List"`
 
 	metadataConfigurationOptionDescription `json:"-" xml:"-"`
 }
@@ -1176,6 +1569,16 @@ type metadataConfigurationOptionDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigurationOptionDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigurationOptionDescription) GoString() string {
+	return s.String()
+}
+
 // A specification identifying an individual configuration option along with
 // its current value. For a list of possible option values, go to Option Values
 // (http://docs.aws.amazon.com/elasticbeanstalk/latest/dg/command-options.html)
@@ -1188,7 +1591,7 @@ type ConfigurationOptionSetting struct {
 	OptionName *string `type:"string"`
 
 	// A unique resource name for a time-based scaling configuration option.
-	ResourceName *string `type:"string"`
+	ResourceName *string `type:"string" min:"1" max:"256"`
 
 	// The current value for the configuration option.
 	Value *string `type:"string"`
@@ -1200,10 +1603,20 @@ type metadataConfigurationOptionSetting struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigurationOptionSetting) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigurationOptionSetting) GoString() string {
+	return s.String()
+}
+
 // Describes the settings for a configuration set.
 type ConfigurationSettingsDescription struct {
 	// The name of the application associated with this configuration set.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The date (in UTC time) when this configuration set was created.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1229,24 +1642,26 @@ type ConfigurationSettingsDescription struct {
 	// environment but is in the process of deploying.   deployed: This is the configuration
 	// that is currently deployed to the associated running environment.   failed:
 	// This is a draft configuration that failed to successfully deploy.
-	DeploymentStatus *string `type:"string"`
+	//
+	// See the ConfigurationDeploymentStatus* constants for valid values.
+	DeploymentStatus *string `type:"string" enum:"deployed,pending,failed"`
 
 	// Describes this configuration set.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// If not null, the name of the environment for this configuration set.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the configuration options and their values in this configuration
 	// set.
 	OptionSettings []*ConfigurationOptionSetting `type:"list"`
 
 	// The name of the solution stack this configuration set uses.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If not null, the name of the configuration template for this configuration
 	// set.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataConfigurationSettingsDescription `json:"-" xml:"-"`
 }
@@ -1255,16 +1670,26 @@ type metadataConfigurationSettingsDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigurationSettingsDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigurationSettingsDescription) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type CreateApplicationInput struct {
 	// The name of the application.
 	//
 	//  Constraint: This name must be unique within your account. If the specified
 	// name already exists, the action returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataCreateApplicationInput `json:"-" xml:"-"`
 }
@@ -1273,10 +1698,20 @@ type metadataCreateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateApplicationInput) GoString() string {
+	return s.String()
+}
+
 type CreateApplicationVersionInput struct {
 	// The name of the application. If no application is found with this name, and
 	// AutoCreateApplication is false, returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Determines how the system behaves if the specified application for this version
 	// does not already exist:
@@ -1296,7 +1731,7 @@ type CreateApplicationVersionInput struct {
 	AutoCreateApplication *bool `type:"boolean"`
 
 	// Describes this version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The Amazon S3 bucket and key that identify the location of the source bundle
 	// for this version.
@@ -1316,7 +1751,7 @@ type CreateApplicationVersionInput struct {
 	// Constraint: Must be unique per application. If an application version already
 	// exists with this label for the specified application, AWS Elastic Beanstalk
 	// returns an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateApplicationVersionInput `json:"-" xml:"-"`
 }
@@ -1325,15 +1760,25 @@ type metadataCreateApplicationVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateApplicationVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateApplicationVersionInput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type CreateConfigurationTemplateInput struct {
 	// The name of the application to associate with this configuration template.
 	// If no application is found with this name, AWS Elastic Beanstalk returns
 	// an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes this configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment used with this configuration template.
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
@@ -1357,7 +1802,7 @@ type CreateConfigurationTemplateInput struct {
 	//  If a solution stack name is not specified and the source configuration
 	// parameter is specified, AWS Elastic Beanstalk uses the same solution stack
 	// as the source configuration template.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk uses the configuration values from the
 	// specified configuration template to create a new configuration.
@@ -1380,7 +1825,7 @@ type CreateConfigurationTemplateInput struct {
 	//
 	// Default: If a configuration template already exists with this name, AWS
 	// Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateConfigurationTemplateInput `json:"-" xml:"-"`
 }
@@ -1389,20 +1834,30 @@ type metadataCreateConfigurationTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateConfigurationTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateConfigurationTemplateInput) GoString() string {
+	return s.String()
+}
+
 type CreateEnvironmentInput struct {
 	// The name of the application that contains the version to be deployed.
 	//
 	//  If no application is found with this name, CreateEnvironment returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// If specified, the environment attempts to use this value as the prefix for
 	// the CNAME. If not specified, the CNAME is generated automatically by appending
 	// a random alphanumeric string to the environment name.
-	CNAMEPrefix *string `type:"string"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A unique name for the deployment environment. Used in the application URL.
 	//
@@ -1413,7 +1868,7 @@ type CreateEnvironmentInput struct {
 	//
 	// Default: If the CNAME parameter is not specified, the environment name becomes
 	// part of the CNAME, and therefore part of the visible URL for your application.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	// If specified, AWS Elastic Beanstalk sets the specified configuration options
 	// to the requested value in the configuration set for the new environment.
@@ -1433,7 +1888,7 @@ type CreateEnvironmentInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// This specifies the tags applied to resources in the environment.
 	Tags []*Tag `type:"list"`
@@ -1446,7 +1901,7 @@ type CreateEnvironmentInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use for creating this environment.
 	Tier *EnvironmentTier `type:"structure"`
@@ -1458,7 +1913,7 @@ type CreateEnvironmentInput struct {
 	//
 	//  Default: If not specified, AWS Elastic Beanstalk attempts to launch the
 	// sample application in the container.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataCreateEnvironmentInput `json:"-" xml:"-"`
 }
@@ -1467,6 +1922,16 @@ type metadataCreateEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateEnvironmentInput) GoString() string {
+	return s.String()
+}
+
 type CreateStorageLocationInput struct {
 	metadataCreateStorageLocationInput `json:"-" xml:"-"`
 }
@@ -1475,10 +1940,20 @@ type metadataCreateStorageLocationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStorageLocationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStorageLocationInput) GoString() string {
+	return s.String()
+}
+
 // Results of a CreateStorageLocationResult call.
 type CreateStorageLocationOutput struct {
 	// The name of the Amazon S3 bucket created.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	metadataCreateStorageLocationOutput `json:"-" xml:"-"`
 }
@@ -1487,10 +1962,20 @@ type metadataCreateStorageLocationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStorageLocationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStorageLocationOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteApplicationInput struct {
 	// The name of the application to delete.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// When set to true, running environments will be terminated before deleting
 	// the application.
@@ -1503,6 +1988,16 @@ type metadataDeleteApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteApplicationInput) GoString() string {
+	return s.String()
+}
+
 type DeleteApplicationOutput struct {
 	metadataDeleteApplicationOutput `json:"-" xml:"-"`
 }
@@ -1511,10 +2006,20 @@ type metadataDeleteApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteApplicationOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteApplicationVersionInput struct {
 	// The name of the application to delete releases from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Indicates whether to delete the associated source bundle from Amazon S3:
 	//
@@ -1524,7 +2029,7 @@ type DeleteApplicationVersionInput struct {
 	DeleteSourceBundle *bool `type:"boolean"`
 
 	// The label of the version to delete.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteApplicationVersionInput `json:"-" xml:"-"`
 }
@@ -1533,6 +2038,16 @@ type metadataDeleteApplicationVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteApplicationVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteApplicationVersionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteApplicationVersionOutput struct {
 	metadataDeleteApplicationVersionOutput `json:"-" xml:"-"`
 }
@@ -1541,13 +2056,23 @@ type metadataDeleteApplicationVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteApplicationVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteApplicationVersionOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteConfigurationTemplateInput struct {
 	// The name of the application to delete the configuration template from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the configuration template to delete.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteConfigurationTemplateInput `json:"-" xml:"-"`
 }
@@ -1556,6 +2081,16 @@ type metadataDeleteConfigurationTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteConfigurationTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteConfigurationTemplateInput) GoString() string {
+	return s.String()
+}
+
 type DeleteConfigurationTemplateOutput struct {
 	metadataDeleteConfigurationTemplateOutput `json:"-" xml:"-"`
 }
@@ -1564,13 +2099,23 @@ type metadataDeleteConfigurationTemplateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteConfigurationTemplateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteConfigurationTemplateOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteEnvironmentConfigurationInput struct {
 	// The name of the application the environment is associated with.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to delete the draft configuration from.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	metadataDeleteEnvironmentConfigurationInput `json:"-" xml:"-"`
 }
@@ -1579,6 +2124,16 @@ type metadataDeleteEnvironmentConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteEnvironmentConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteEnvironmentConfigurationInput) GoString() string {
+	return s.String()
+}
+
 type DeleteEnvironmentConfigurationOutput struct {
 	metadataDeleteEnvironmentConfigurationOutput `json:"-" xml:"-"`
 }
@@ -1587,11 +2142,21 @@ type metadataDeleteEnvironmentConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteEnvironmentConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteEnvironmentConfigurationOutput) GoString() string {
+	return s.String()
+}
+
 // Result message containing a list of configuration descriptions.
 type DescribeApplicationVersionsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// only include ones that are associated with the specified application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, restricts the returned descriptions to only include ones that
 	// have the specified version labels.
@@ -1604,6 +2169,16 @@ type metadataDescribeApplicationVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeApplicationVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeApplicationVersionsInput) GoString() string {
+	return s.String()
+}
+
 // Result message wrapping a list of application version descriptions.
 type DescribeApplicationVersionsOutput struct {
 	// A list of ApplicationVersionDescription .
@@ -1616,6 +2191,16 @@ type metadataDescribeApplicationVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeApplicationVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeApplicationVersionsOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeApplicationsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
@@ -1629,6 +2214,16 @@ type metadataDescribeApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeApplicationsInput) GoString() string {
+	return s.String()
+}
+
 // Result message containing a list of application descriptions.
 type DescribeApplicationsOutput struct {
 	// This parameter contains a list of ApplicationDescription.
@@ -1641,25 +2236,35 @@ type metadataDescribeApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeApplicationsOutput) GoString() string {
+	return s.String()
+}
+
 // Result message containig a list of application version descriptions.
 type DescribeConfigurationOptionsInput struct {
 	// The name of the application associated with the configuration template or
 	// environment. Only needed if you want to describe the configuration options
 	// associated with either the configuration template or environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment whose configuration options you want to describe.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, restricts the descriptions to only the specified options.
 	Options []*OptionSpecification `type:"list"`
 
 	// The name of the solution stack whose configuration options you want to describe.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The name of the configuration template whose configuration options you want
 	// to describe.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationOptionsInput `json:"-" xml:"-"`
 }
@@ -1668,13 +2273,23 @@ type metadataDescribeConfigurationOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationOptionsInput) GoString() string {
+	return s.String()
+}
+
 // Describes the settings for a specified configuration set.
 type DescribeConfigurationOptionsOutput struct {
 	// A list of ConfigurationOptionDescription.
 	Options []*ConfigurationOptionDescription `type:"list"`
 
 	// The name of the solution stack these configuration options belong to.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataDescribeConfigurationOptionsOutput `json:"-" xml:"-"`
 }
@@ -1683,11 +2298,21 @@ type metadataDescribeConfigurationOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationOptionsOutput) GoString() string {
+	return s.String()
+}
+
 // Result message containing all of the configuration settings for a specified
 // solution stack or configuration template.
 type DescribeConfigurationSettingsInput struct {
 	// The application for the environment or configuration template.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to describe.
 	//
@@ -1695,7 +2320,7 @@ type DescribeConfigurationSettingsInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The name of the configuration template to describe.
 	//
@@ -1703,7 +2328,7 @@ type DescribeConfigurationSettingsInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationSettingsInput `json:"-" xml:"-"`
 }
@@ -1712,6 +2337,16 @@ type metadataDescribeConfigurationSettingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationSettingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationSettingsInput) GoString() string {
+	return s.String()
+}
+
 // The results from a request to change the configuration settings of an environment.
 type DescribeConfigurationSettingsOutput struct {
 	// A list of ConfigurationSettingsDescription.
@@ -1724,6 +2359,16 @@ type metadataDescribeConfigurationSettingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeConfigurationSettingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeConfigurationSettingsOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeEnvironmentResourcesInput struct {
 	// The ID of the environment to retrieve AWS resource usage data.
@@ -1738,7 +2383,7 @@ type DescribeEnvironmentResourcesInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataDescribeEnvironmentResourcesInput `json:"-" xml:"-"`
 }
@@ -1747,6 +2392,16 @@ type metadataDescribeEnvironmentResourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEnvironmentResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEnvironmentResourcesInput) GoString() string {
+	return s.String()
+}
+
 // Result message containing a list of environment resource descriptions.
 type DescribeEnvironmentResourcesOutput struct {
 	// A list of EnvironmentResourceDescription.
@@ -1759,11 +2414,21 @@ type metadataDescribeEnvironmentResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEnvironmentResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEnvironmentResourcesOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeEnvironmentsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that have the specified IDs.
@@ -1787,7 +2452,7 @@ type DescribeEnvironmentsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEnvironmentsInput `json:"-" xml:"-"`
 }
@@ -1796,6 +2461,16 @@ type metadataDescribeEnvironmentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEnvironmentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEnvironmentsInput) GoString() string {
+	return s.String()
+}
+
 // Result message containing a list of environment descriptions.
 type DescribeEnvironmentsOutput struct {
 	// Returns an EnvironmentDescription list.
@@ -1808,11 +2483,21 @@ type metadataDescribeEnvironmentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEnvironmentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEnvironmentsOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeEventsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that occur up to, but not including, the EndTime.
@@ -1824,11 +2509,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Specifies the maximum number of events that can be returned, beginning with
 	// the most recent event.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Pagination token. If specified, the events return the next batch of results.
 	NextToken *string `type:"string"`
@@ -1839,7 +2524,9 @@ type DescribeEventsInput struct {
 
 	// If specified, limits the events returned from this call to include only those
 	// with the specified severity or higher.
-	Severity *string `type:"string"`
+	//
+	// See the EventSeverity* constants for valid values.
+	Severity *string `type:"string" enum:"TRACE,DEBUG,INFO,WARN,ERROR,FATAL"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that occur on or after this time.
@@ -1847,11 +2534,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that are associated with this environment configuration.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEventsInput `json:"-" xml:"-"`
 }
@@ -1860,6 +2547,16 @@ type metadataDescribeEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEventsInput) GoString() string {
+	return s.String()
+}
+
 // Result message wrapping a list of event descriptions.
 type DescribeEventsOutput struct {
 	// A list of EventDescription.
@@ -1876,6 +2573,16 @@ type metadataDescribeEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEventsOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the properties of an environment.
 type EnvironmentDescription struct {
 	// Indicates if there is an in-progress environment configuration update or
@@ -1887,10 +2594,10 @@ type EnvironmentDescription struct {
 	AbortableOperationInProgress *bool `type:"boolean"`
 
 	// The name of the application associated with this environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The URL to the CNAME for this environment.
-	CNAME *string `type:"string"`
+	CNAME *string `type:"string" min:"1" max:"255"`
 
 	// The creation date for this environment.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1899,7 +2606,7 @@ type EnvironmentDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// For load-balanced, autoscaling environments, the URL to the LoadBalancer.
 	// For single-instance environments, the IP address of the instance.
@@ -1909,7 +2616,7 @@ type EnvironmentDescription struct {
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
 
 	// The name of this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Describes the health status of the environment. AWS Elastic Beanstalk indicates
 	// the failure levels for a running environment:
@@ -1928,13 +2635,15 @@ type EnvironmentDescription struct {
 	//   Grey: Default health for a new environment. The environment is not fully
 	// launched and health checks have not started or health checks are suspended
 	// during an UpdateEnvironment or RestartEnvironement request.    Default: Grey
-	Health *string `type:"string"`
+	//
+	// See the EnvironmentHealth* constants for valid values.
+	Health *string `type:"string" enum:"Green,Yellow,Red,Grey"`
 
 	// The description of the AWS resources used by this environment.
 	Resources *EnvironmentResourcesDescription `type:"structure"`
 
 	// The name of the SolutionStack deployed with this environment.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The current operational status of the environment:
 	//
@@ -1943,16 +2652,18 @@ type EnvironmentDescription struct {
 	// version.   Ready: Environment is available to have an action performed on
 	// it, such as update or terminate.   Terminating: Environment is in the shut-down
 	// process.   Terminated: Environment is not running.
-	Status *string `type:"string"`
+	//
+	// See the EnvironmentStatus* constants for valid values.
+	Status *string `type:"string" enum:"Launching,Updating,Ready,Terminating,Terminated"`
 
 	// The name of the configuration template used to originally launch this environment.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// Describes the current tier of this environment.
 	Tier *EnvironmentTier `type:"structure"`
 
 	// The application version deployed in this environment.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEnvironmentDescription `json:"-" xml:"-"`
 }
@@ -1961,13 +2672,25 @@ type metadataEnvironmentDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnvironmentDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnvironmentDescription) GoString() string {
+	return s.String()
+}
+
 // The information retrieved from the Amazon EC2 instances.
 type EnvironmentInfoDescription struct {
 	// The Amazon EC2 Instance ID for this information.
 	EC2InstanceID *string `locationName:"Ec2InstanceId" type:"string"`
 
 	// The type of information retrieved.
-	InfoType *string `type:"string"`
+	//
+	// See the EnvironmentInfoType* constants for valid values.
+	InfoType *string `type:"string" enum:"tail,bundle"`
 
 	// The retrieved information.
 	Message *string `type:"string"`
@@ -1982,13 +2705,23 @@ type metadataEnvironmentInfoDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnvironmentInfoDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnvironmentInfoDescription) GoString() string {
+	return s.String()
+}
+
 // Describes the AWS resources in use by this environment. This data is live.
 type EnvironmentResourceDescription struct {
 	// The AutoScalingGroups used by this environment.
 	AutoScalingGroups []*AutoScalingGroup `type:"list"`
 
 	// The name of the environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The Amazon EC2 instances used by this environment.
 	Instances []*Instance `type:"list"`
@@ -2012,6 +2745,16 @@ type metadataEnvironmentResourceDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnvironmentResourceDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnvironmentResourceDescription) GoString() string {
+	return s.String()
+}
+
 // Describes the AWS resources in use by this environment. This data is not
 // live data.
 type EnvironmentResourcesDescription struct {
@@ -2025,6 +2768,16 @@ type metadataEnvironmentResourcesDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnvironmentResourcesDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnvironmentResourcesDescription) GoString() string {
+	return s.String()
+}
+
 // Describes the properties of an environment tier
 type EnvironmentTier struct {
 	// The name of this environment tier.
@@ -2043,13 +2796,23 @@ type metadataEnvironmentTier struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnvironmentTier) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnvironmentTier) GoString() string {
+	return s.String()
+}
+
 // Describes an event.
 type EventDescription struct {
 	// The application associated with the event.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment associated with this event.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The date when the event occurred.
 	EventDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -2061,13 +2824,15 @@ type EventDescription struct {
 	RequestID *string `locationName:"RequestId" type:"string"`
 
 	// The severity level of this event.
-	Severity *string `type:"string"`
+	//
+	// See the EventSeverity* constants for valid values.
+	Severity *string `type:"string" enum:"TRACE,DEBUG,INFO,WARN,ERROR,FATAL"`
 
 	// The name of the configuration associated with this event.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// The release label for the application version associated with this event.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEventDescription `json:"-" xml:"-"`
 }
@@ -2076,6 +2841,16 @@ type metadataEventDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EventDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EventDescription) GoString() string {
+	return s.String()
+}
+
 // The description of an Amazon EC2 instance.
 type Instance struct {
 	// The ID of the Amazon EC2 instance.
@@ -2088,6 +2863,16 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Instance) GoString() string {
+	return s.String()
+}
+
 // Describes an Auto Scaling launch configuration.
 type LaunchConfiguration struct {
 	// The name of the launch configuration.
@@ -2100,6 +2885,16 @@ type metadataLaunchConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LaunchConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LaunchConfiguration) GoString() string {
+	return s.String()
+}
+
 type ListAvailableSolutionStacksInput struct {
 	metadataListAvailableSolutionStacksInput `json:"-" xml:"-"`
 }
@@ -2108,6 +2903,16 @@ type metadataListAvailableSolutionStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAvailableSolutionStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAvailableSolutionStacksInput) GoString() string {
+	return s.String()
+}
+
 // A list of available AWS Elastic Beanstalk solution stacks.
 type ListAvailableSolutionStacksOutput struct {
 	// A list of available solution stacks and their SolutionStackDescription.
@@ -2123,6 +2928,16 @@ type metadataListAvailableSolutionStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAvailableSolutionStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAvailableSolutionStacksOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the properties of a Listener for the LoadBalancer.
 type Listener struct {
 	// The port that is used by the Listener.
@@ -2138,6 +2953,16 @@ type metadataListener struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Listener) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Listener) GoString() string {
+	return s.String()
+}
+
 // Describes a LoadBalancer.
 type LoadBalancer struct {
 	// The name of the LoadBalancer.
@@ -2150,6 +2975,16 @@ type metadataLoadBalancer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoadBalancer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoadBalancer) GoString() string {
+	return s.String()
+}
+
 // Describes the details of a LoadBalancer.
 type LoadBalancerDescription struct {
 	// The domain name of the LoadBalancer.
@@ -2168,6 +3003,16 @@ type metadataLoadBalancerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoadBalancerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoadBalancerDescription) GoString() string {
+	return s.String()
+}
+
 // A regular expression representing a restriction on a string configuration
 // option value.
 type OptionRestrictionRegex struct {
@@ -2185,6 +3030,16 @@ type metadataOptionRestrictionRegex struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OptionRestrictionRegex) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OptionRestrictionRegex) GoString() string {
+	return s.String()
+}
+
 // A specification identifying an individual configuration option.
 type OptionSpecification struct {
 	// A unique namespace identifying the option's associated AWS resource.
@@ -2194,7 +3049,7 @@ type OptionSpecification struct {
 	OptionName *string `type:"string"`
 
 	// A unique resource name for a time-based scaling configuration option.
-	ResourceName *string `type:"string"`
+	ResourceName *string `type:"string" min:"1" max:"256"`
 
 	metadataOptionSpecification `json:"-" xml:"-"`
 }
@@ -2203,6 +3058,16 @@ type metadataOptionSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OptionSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OptionSpecification) GoString() string {
+	return s.String()
+}
+
 // Describes a queue.
 type Queue struct {
 	// The name of the queue.
@@ -2218,6 +3083,16 @@ type metadataQueue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Queue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Queue) GoString() string {
+	return s.String()
+}
+
 type RebuildEnvironmentInput struct {
 	// The ID of the environment to rebuild.
 	//
@@ -2231,7 +3106,7 @@ type RebuildEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRebuildEnvironmentInput `json:"-" xml:"-"`
 }
@@ -2240,6 +3115,16 @@ type metadataRebuildEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebuildEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebuildEnvironmentInput) GoString() string {
+	return s.String()
+}
+
 type RebuildEnvironmentOutput struct {
 	metadataRebuildEnvironmentOutput `json:"-" xml:"-"`
 }
@@ -2248,6 +3133,16 @@ type metadataRebuildEnvironmentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebuildEnvironmentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebuildEnvironmentOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type RequestEnvironmentInfoInput struct {
 	// The ID of the environment of the requested data.
@@ -2268,10 +3163,12 @@ type RequestEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to request.
-	InfoType *string `type:"string" required:"true"`
+	//
+	// See the EnvironmentInfoType* constants for valid values.
+	InfoType *string `type:"string" enum:"tail,bundle" required:"true"`
 
 	metadataRequestEnvironmentInfoInput `json:"-" xml:"-"`
 }
@@ -2280,6 +3177,16 @@ type metadataRequestEnvironmentInfoInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestEnvironmentInfoInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestEnvironmentInfoInput) GoString() string {
+	return s.String()
+}
+
 type RequestEnvironmentInfoOutput struct {
 	metadataRequestEnvironmentInfoOutput `json:"-" xml:"-"`
 }
@@ -2288,6 +3195,16 @@ type metadataRequestEnvironmentInfoOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestEnvironmentInfoOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestEnvironmentInfoOutput) GoString() string {
+	return s.String()
+}
+
 type RestartAppServerInput struct {
 	// The ID of the environment to restart the server for.
 	//
@@ -2301,7 +3218,7 @@ type RestartAppServerInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRestartAppServerInput `json:"-" xml:"-"`
 }
@@ -2310,6 +3227,16 @@ type metadataRestartAppServerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RestartAppServerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RestartAppServerInput) GoString() string {
+	return s.String()
+}
+
 type RestartAppServerOutput struct {
 	metadataRestartAppServerOutput `json:"-" xml:"-"`
 }
@@ -2318,6 +3245,16 @@ type metadataRestartAppServerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RestartAppServerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RestartAppServerOutput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type RetrieveEnvironmentInfoInput struct {
 	// The ID of the data's environment.
@@ -2336,10 +3273,12 @@ type RetrieveEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to retrieve.
-	InfoType *string `type:"string" required:"true"`
+	//
+	// See the EnvironmentInfoType* constants for valid values.
+	InfoType *string `type:"string" enum:"tail,bundle" required:"true"`
 
 	metadataRetrieveEnvironmentInfoInput `json:"-" xml:"-"`
 }
@@ -2348,6 +3287,16 @@ type metadataRetrieveEnvironmentInfoInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveEnvironmentInfoInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveEnvironmentInfoInput) GoString() string {
+	return s.String()
+}
+
 // Result message containing a description of the requested environment info.
 type RetrieveEnvironmentInfoOutput struct {
 	// The EnvironmentInfoDescription of the environment.
@@ -2360,13 +3309,23 @@ type metadataRetrieveEnvironmentInfoOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveEnvironmentInfoOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveEnvironmentInfoOutput) GoString() string {
+	return s.String()
+}
+
 // A specification of a location in Amazon S3.
 type S3Location struct {
 	// The Amazon S3 bucket where the data is located.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	// The Amazon S3 key where the data is located.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" max:"1024"`
 
 	metadataS3Location `json:"-" xml:"-"`
 }
@@ -2375,13 +3334,23 @@ type metadataS3Location struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s S3Location) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s S3Location) GoString() string {
+	return s.String()
+}
+
 // Describes the solution stack.
 type SolutionStackDescription struct {
 	// The permitted file types allowed for a solution stack.
 	PermittedFileTypes []*string `type:"list"`
 
 	// The name of the solution stack.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataSolutionStackDescription `json:"-" xml:"-"`
 }
@@ -2390,13 +3359,23 @@ type metadataSolutionStackDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SolutionStackDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SolutionStackDescription) GoString() string {
+	return s.String()
+}
+
 // A specification for an environment configuration
 type SourceConfiguration struct {
 	// The name of the application associated with the configuration.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the configuration template.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataSourceConfiguration `json:"-" xml:"-"`
 }
@@ -2405,6 +3384,16 @@ type metadataSourceConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SourceConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SourceConfiguration) GoString() string {
+	return s.String()
+}
+
 // Swaps the CNAMEs of two environments.
 type SwapEnvironmentCNAMEsInput struct {
 	// The ID of the destination environment.
@@ -2419,7 +3408,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the DestinationEnvironmentID or the
 	// DestinationEnvironmentName. You may also specify both. You must specify the
 	// SourceEnvironmentName with the DestinationEnvironmentName.
-	DestinationEnvironmentName *string `type:"string"`
+	DestinationEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The ID of the source environment.
 	//
@@ -2433,7 +3422,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the SourceEnvironmentID or the SourceEnvironmentName.
 	// You may also specify both. If you specify the SourceEnvironmentName, you
 	// must specify the DestinationEnvironmentName.
-	SourceEnvironmentName *string `type:"string"`
+	SourceEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataSwapEnvironmentCNAMEsInput `json:"-" xml:"-"`
 }
@@ -2442,6 +3431,16 @@ type metadataSwapEnvironmentCNAMEsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SwapEnvironmentCNAMEsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SwapEnvironmentCNAMEsInput) GoString() string {
+	return s.String()
+}
+
 type SwapEnvironmentCNAMEsOutput struct {
 	metadataSwapEnvironmentCNAMEsOutput `json:"-" xml:"-"`
 }
@@ -2450,13 +3449,23 @@ type metadataSwapEnvironmentCNAMEsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SwapEnvironmentCNAMEsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SwapEnvironmentCNAMEsOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a tag applied to a resource in an environment.
 type Tag struct {
 	// The key of the tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128"`
 
 	// The value of the tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -2465,6 +3474,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type TerminateEnvironmentInput struct {
 	// The ID of the environment to terminate.
@@ -2479,7 +3498,7 @@ type TerminateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Indicates whether the associated AWS resources should shut down when the
 	// environment is terminated:
@@ -2508,6 +3527,16 @@ type metadataTerminateEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateEnvironmentInput) GoString() string {
+	return s.String()
+}
+
 // Describes a trigger.
 type Trigger struct {
 	// The name of the trigger.
@@ -2520,16 +3549,26 @@ type metadataTrigger struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Trigger) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Trigger) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type UpdateApplicationInput struct {
 	// The name of the application to update. If no such application is found, UpdateApplication
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the application.
 	//
 	// Default: If not specified, AWS Elastic Beanstalk does not update the description.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataUpdateApplicationInput `json:"-" xml:"-"`
 }
@@ -2538,21 +3577,31 @@ type metadataUpdateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateApplicationInput) GoString() string {
+	return s.String()
+}
+
 type UpdateApplicationVersionInput struct {
 	// The name of the application associated with this version.
 	//
 	//  If no application is found with this name, UpdateApplication returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for this release.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The name of the version to update.
 	//
 	//  If no application version is found with this label, UpdateApplication returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateApplicationVersionInput `json:"-" xml:"-"`
 }
@@ -2561,6 +3610,16 @@ type metadataUpdateApplicationVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateApplicationVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateApplicationVersionInput) GoString() string {
+	return s.String()
+}
+
 // The result message containing the options for the specified solution stack.
 type UpdateConfigurationTemplateInput struct {
 	// The name of the application associated with the configuration template to
@@ -2568,10 +3627,10 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no application is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A list of configuration option settings to update with the new specified
 	// option value.
@@ -2586,7 +3645,7 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no configuration template is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateConfigurationTemplateInput `json:"-" xml:"-"`
 }
@@ -2595,11 +3654,21 @@ type metadataUpdateConfigurationTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateConfigurationTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateConfigurationTemplateInput) GoString() string {
+	return s.String()
+}
+
 // This documentation target is not reported in the API reference.
 type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk updates the description
 	// of this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment to update.
 	//
@@ -2617,7 +3686,7 @@ type UpdateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, AWS Elastic Beanstalk updates the configuration set associated
 	// with the running environment and sets the specified configuration options
@@ -2630,12 +3699,12 @@ type UpdateEnvironmentInput struct {
 
 	// This specifies the platform version that the environment will run after the
 	// environment is updated.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If this parameter is specified, AWS Elastic Beanstalk deploys this configuration
 	// template to the environment. If no such configuration template is found,
 	// AWS Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use to update the environment.
 	//
@@ -2646,7 +3715,7 @@ type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk deploys the named application
 	// version to the environment. If no such application version is found, returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataUpdateEnvironmentInput `json:"-" xml:"-"`
 }
@@ -2655,16 +3724,26 @@ type metadataUpdateEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateEnvironmentInput) GoString() string {
+	return s.String()
+}
+
 // A list of validation messages for a specified configuration template.
 type ValidateConfigurationSettingsInput struct {
 	// The name of the application that the configuration template or environment
 	// belongs to.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and a configuration template name.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the options and desired values to evaluate.
 	OptionSettings []*ConfigurationOptionSetting `type:"list" required:"true"`
@@ -2672,7 +3751,7 @@ type ValidateConfigurationSettingsInput struct {
 	// The name of the configuration template to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and an environment name.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataValidateConfigurationSettingsInput `json:"-" xml:"-"`
 }
@@ -2681,6 +3760,16 @@ type metadataValidateConfigurationSettingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ValidateConfigurationSettingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidateConfigurationSettingsInput) GoString() string {
+	return s.String()
+}
+
 // Provides a list of validation messages.
 type ValidateConfigurationSettingsOutput struct {
 	// A list of ValidationMessage.
@@ -2693,6 +3782,16 @@ type metadataValidateConfigurationSettingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ValidateConfigurationSettingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidateConfigurationSettingsOutput) GoString() string {
+	return s.String()
+}
+
 // An error or warning for a desired configuration option value.
 type ValidationMessage struct {
 	// A message describing the error or warning.
@@ -2712,7 +3811,9 @@ type ValidationMessage struct {
 	//     error: This message indicates that this is not a valid setting for an
 	// option.   warning: This message is providing information you should take
 	// into account.
-	Severity *string `type:"string"`
+	//
+	// See the ValidationSeverity* constants for valid values.
+	Severity *string `type:"string" enum:"error,warning"`
 
 	metadataValidationMessage `json:"-" xml:"-"`
 }
@@ -2720,3 +3821,82 @@ type ValidationMessage struct {
 type metadataValidationMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s ValidationMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ValidationMessage) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ConfigurationDeploymentStatus
+	ConfigurationDeploymentStatusDeployed = "deployed"
+	// @enum ConfigurationDeploymentStatus
+	ConfigurationDeploymentStatusPending = "pending"
+	// @enum ConfigurationDeploymentStatus
+	ConfigurationDeploymentStatusFailed = "failed"
+)
+
+const (
+	// @enum ConfigurationOptionValueType
+	ConfigurationOptionValueTypeScalar = "Scalar"
+	// @enum ConfigurationOptionValueType
+	ConfigurationOptionValueTypeList = "List"
+)
+
+const (
+	// @enum EnvironmentHealth
+	EnvironmentHealthGreen = "Green"
+	// @enum EnvironmentHealth
+	EnvironmentHealthYellow = "Yellow"
+	// @enum EnvironmentHealth
+	EnvironmentHealthRed = "Red"
+	// @enum EnvironmentHealth
+	EnvironmentHealthGrey = "Grey"
+)
+
+const (
+	// @enum EnvironmentInfoType
+	EnvironmentInfoTypeTail = "tail"
+	// @enum EnvironmentInfoType
+	EnvironmentInfoTypeBundle = "bundle"
+)
+
+const (
+	// @enum EnvironmentStatus
+	EnvironmentStatusLaunching = "Launching"
+	// @enum EnvironmentStatus
+	EnvironmentStatusUpdating = "Updating"
+	// @enum EnvironmentStatus
+	EnvironmentStatusReady = "Ready"
+	// @enum EnvironmentStatus
+	EnvironmentStatusTerminating = "Terminating"
+	// @enum EnvironmentStatus
+	EnvironmentStatusTerminated = "Terminated"
+)
+
+const (
+	// @enum EventSeverity
+	EventSeverityTrace = "TRACE"
+	// @enum EventSeverity
+	EventSeverityDebug = "DEBUG"
+	// @enum EventSeverity
+	EventSeverityInfo = "INFO"
+	// @enum EventSeverity
+	EventSeverityWarn = "WARN"
+	// @enum EventSeverity
+	EventSeverityError = "ERROR"
+	// @enum EventSeverity
+	EventSeverityFatal = "FATAL"
+)
+
+const (
+	// @enum ValidationSeverity
+	ValidationSeverityError = "error"
+	// @enum ValidationSeverity
+	ValidationSeverityWarning = "warning"
+)
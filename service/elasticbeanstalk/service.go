@@ -8,7 +8,27 @@ import (
 	"github.com/aws/aws-sdk-go/internal/signer/v4"
 )
 
-// ElasticBeanstalk is a client for Elastic Beanstalk.
+// This is the AWS Elastic Beanstalk API Reference. This guide provides detailed
+// information about AWS Elastic Beanstalk actions, data types, parameters,
+// and errors.
+//
+// AWS Elastic Beanstalk is a tool that makes it easy for you to create, deploy,
+// and manage scalable, fault-tolerant applications running on Amazon Web Services
+// cloud resources.
+//
+//  For more information about this product, go to the AWS Elastic Beanstalk
+// (http://aws.amazon.com/elasticbeanstalk/) details page. The location of the
+// latest AWS Elastic Beanstalk WSDL is http://elasticbeanstalk.s3.amazonaws.com/doc/2010-12-01/AWSElasticBeanstalk.wsdl
+// (http://elasticbeanstalk.s3.amazonaws.com/doc/2010-12-01/AWSElasticBeanstalk.wsdl).
+// To install the Software Development Kits (SDKs), Integrated Development Environment
+// (IDE) Toolkits, and command line tools that enable you to access the API,
+// go to Tools for Amazon Web Services (https://aws.amazon.com/tools/).
+//
+// Endpoints
+//
+// For a list of region-specific endpoints that AWS Elastic Beanstalk supports,
+// go to Regions and Endpoints (http://docs.aws.amazon.com/general/latest/gr/rande.html#elasticbeanstalk_region)
+// in the Amazon Web Services Glossary.
 type ElasticBeanstalk struct {
 	*aws.Service
 }
@@ -29,11 +49,11 @@ func New(config *aws.Config) *ElasticBeanstalk {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(query.Build)
-	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(query.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(query.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(query.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(query.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(query.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
@@ -5,6 +5,7 @@ package elastictranscoder
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCancelJob = "CancelJob"
@@ -38,6 +39,16 @@ func (c *ElasticTranscoder) CancelJob(input *CancelJobInput) (*CancelJobOutput,
 	return out, err
 }
 
+// CancelJobWithContext is the same as CancelJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) CancelJobWithContext(ctx aws.Context, input *CancelJobInput) (*CancelJobOutput, error) {
+	req, out := c.CancelJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateJob = "CreateJob"
 
 // CreateJobRequest generates a request for the CreateJob operation.
@@ -71,6 +82,16 @@ func (c *ElasticTranscoder) CreateJob(input *CreateJobInput) (*CreateJobResponse
 	return out, err
 }
 
+// CreateJobWithContext is the same as CreateJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) CreateJobWithContext(ctx aws.Context, input *CreateJobInput) (*CreateJobResponse, error) {
+	req, out := c.CreateJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePipeline = "CreatePipeline"
 
 // CreatePipelineRequest generates a request for the CreatePipeline operation.
@@ -98,6 +119,16 @@ func (c *ElasticTranscoder) CreatePipeline(input *CreatePipelineInput) (*CreateP
 	return out, err
 }
 
+// CreatePipelineWithContext is the same as CreatePipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) CreatePipelineWithContext(ctx aws.Context, input *CreatePipelineInput) (*CreatePipelineOutput, error) {
+	req, out := c.CreatePipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePreset = "CreatePreset"
 
 // CreatePresetRequest generates a request for the CreatePreset operation.
@@ -139,6 +170,16 @@ func (c *ElasticTranscoder) CreatePreset(input *CreatePresetInput) (*CreatePrese
 	return out, err
 }
 
+// CreatePresetWithContext is the same as CreatePreset with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) CreatePresetWithContext(ctx aws.Context, input *CreatePresetInput) (*CreatePresetOutput, error) {
+	req, out := c.CreatePresetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePipeline = "DeletePipeline"
 
 // DeletePipelineRequest generates a request for the DeletePipeline operation.
@@ -170,6 +211,16 @@ func (c *ElasticTranscoder) DeletePipeline(input *DeletePipelineInput) (*DeleteP
 	return out, err
 }
 
+// DeletePipelineWithContext is the same as DeletePipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) DeletePipelineWithContext(ctx aws.Context, input *DeletePipelineInput) (*DeletePipelineOutput, error) {
+	req, out := c.DeletePipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePreset = "DeletePreset"
 
 // DeletePresetRequest generates a request for the DeletePreset operation.
@@ -199,6 +250,16 @@ func (c *ElasticTranscoder) DeletePreset(input *DeletePresetInput) (*DeletePrese
 	return out, err
 }
 
+// DeletePresetWithContext is the same as DeletePreset with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) DeletePresetWithContext(ctx aws.Context, input *DeletePresetInput) (*DeletePresetOutput, error) {
+	req, out := c.DeletePresetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListJobsByPipeline = "ListJobsByPipeline"
 
 // ListJobsByPipelineRequest generates a request for the ListJobsByPipeline operation.
@@ -236,6 +297,16 @@ func (c *ElasticTranscoder) ListJobsByPipeline(input *ListJobsByPipelineInput) (
 	return out, err
 }
 
+// ListJobsByPipelineWithContext is the same as ListJobsByPipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) ListJobsByPipelineWithContext(ctx aws.Context, input *ListJobsByPipelineInput) (*ListJobsByPipelineOutput, error) {
+	req, out := c.ListJobsByPipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElasticTranscoder) ListJobsByPipelinePages(input *ListJobsByPipelineInput, fn func(p *ListJobsByPipelineOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListJobsByPipelineRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -278,6 +349,16 @@ func (c *ElasticTranscoder) ListJobsByStatus(input *ListJobsByStatusInput) (*Lis
 	return out, err
 }
 
+// ListJobsByStatusWithContext is the same as ListJobsByStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) ListJobsByStatusWithContext(ctx aws.Context, input *ListJobsByStatusInput) (*ListJobsByStatusOutput, error) {
+	req, out := c.ListJobsByStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElasticTranscoder) ListJobsByStatusPages(input *ListJobsByStatusInput, fn func(p *ListJobsByStatusOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListJobsByStatusRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -319,6 +400,16 @@ func (c *ElasticTranscoder) ListPipelines(input *ListPipelinesInput) (*ListPipel
 	return out, err
 }
 
+// ListPipelinesWithContext is the same as ListPipelines with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) ListPipelinesWithContext(ctx aws.Context, input *ListPipelinesInput) (*ListPipelinesOutput, error) {
+	req, out := c.ListPipelinesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElasticTranscoder) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPipelinesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -360,6 +451,16 @@ func (c *ElasticTranscoder) ListPresets(input *ListPresetsInput) (*ListPresetsOu
 	return out, err
 }
 
+// ListPresetsWithContext is the same as ListPresets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) ListPresetsWithContext(ctx aws.Context, input *ListPresetsInput) (*ListPresetsOutput, error) {
+	req, out := c.ListPresetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ElasticTranscoder) ListPresetsPages(input *ListPresetsInput, fn func(p *ListPresetsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPresetsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -394,6 +495,16 @@ func (c *ElasticTranscoder) ReadJob(input *ReadJobInput) (*ReadJobOutput, error)
 	return out, err
 }
 
+// ReadJobWithContext is the same as ReadJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) ReadJobWithContext(ctx aws.Context, input *ReadJobInput) (*ReadJobOutput, error) {
+	req, out := c.ReadJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReadPipeline = "ReadPipeline"
 
 // ReadPipelineRequest generates a request for the ReadPipeline operation.
@@ -421,6 +532,16 @@ func (c *ElasticTranscoder) ReadPipeline(input *ReadPipelineInput) (*ReadPipelin
 	return out, err
 }
 
+// ReadPipelineWithContext is the same as ReadPipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) ReadPipelineWithContext(ctx aws.Context, input *ReadPipelineInput) (*ReadPipelineOutput, error) {
+	req, out := c.ReadPipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReadPreset = "ReadPreset"
 
 // ReadPresetRequest generates a request for the ReadPreset operation.
@@ -448,6 +569,16 @@ func (c *ElasticTranscoder) ReadPreset(input *ReadPresetInput) (*ReadPresetOutpu
 	return out, err
 }
 
+// ReadPresetWithContext is the same as ReadPreset with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) ReadPresetWithContext(ctx aws.Context, input *ReadPresetInput) (*ReadPresetOutput, error) {
+	req, out := c.ReadPresetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTestRole = "TestRole"
 
 // TestRoleRequest generates a request for the TestRole operation.
@@ -481,6 +612,16 @@ func (c *ElasticTranscoder) TestRole(input *TestRoleInput) (*TestRoleOutput, err
 	return out, err
 }
 
+// TestRoleWithContext is the same as TestRole with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) TestRoleWithContext(ctx aws.Context, input *TestRoleInput) (*TestRoleOutput, error) {
+	req, out := c.TestRoleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdatePipeline = "UpdatePipeline"
 
 // UpdatePipelineRequest generates a request for the UpdatePipeline operation.
@@ -512,6 +653,16 @@ func (c *ElasticTranscoder) UpdatePipeline(input *UpdatePipelineInput) (*UpdateP
 	return out, err
 }
 
+// UpdatePipelineWithContext is the same as UpdatePipeline with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) UpdatePipelineWithContext(ctx aws.Context, input *UpdatePipelineInput) (*UpdatePipelineOutput, error) {
+	req, out := c.UpdatePipelineRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdatePipelineNotifications = "UpdatePipelineNotifications"
 
 // UpdatePipelineNotificationsRequest generates a request for the UpdatePipelineNotifications operation.
@@ -543,6 +694,16 @@ func (c *ElasticTranscoder) UpdatePipelineNotifications(input *UpdatePipelineNot
 	return out, err
 }
 
+// UpdatePipelineNotificationsWithContext is the same as UpdatePipelineNotifications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) UpdatePipelineNotificationsWithContext(ctx aws.Context, input *UpdatePipelineNotificationsInput) (*UpdatePipelineNotificationsOutput, error) {
+	req, out := c.UpdatePipelineNotificationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdatePipelineStatus = "UpdatePipelineStatus"
 
 // UpdatePipelineStatusRequest generates a request for the UpdatePipelineStatus operation.
@@ -577,6 +738,16 @@ func (c *ElasticTranscoder) UpdatePipelineStatus(input *UpdatePipelineStatusInpu
 	return out, err
 }
 
+// UpdatePipelineStatusWithContext is the same as UpdatePipelineStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ElasticTranscoder) UpdatePipelineStatusWithContext(ctx aws.Context, input *UpdatePipelineStatusInput) (*UpdatePipelineStatusOutput, error) {
+	req, out := c.UpdatePipelineStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // The file to be used as album art. There can be multiple artworks associated
 // with an audio file, to a maximum of 20.
 //
@@ -587,7 +758,7 @@ func (c *ElasticTranscoder) UpdatePipelineStatus(input *UpdatePipelineStatusInpu
 // "Append", or "Fallback", and use an empty Artwork array.
 type Artwork struct {
 	// The format of album art, if any. Valid formats are .jpg and .png.
-	AlbumArtFormat *string `type:"string"`
+	AlbumArtFormat *string `type:"string" pattern:"(^jpg$)|(^png$)"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your artwork.
@@ -601,23 +772,23 @@ type Artwork struct {
 	// If the file name includes a prefix, for example, cooking/pie.jpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255" pattern:"(^.{1,}.jpg$)|(^.{1,}.jpeg$)|(^.{1,}.png$)"`
 
 	// The maximum height of the output album art in pixels. If you specify auto,
 	// Elastic Transcoder uses 600 as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 3072, inclusive.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of the output album art in pixels. If you specify auto,
 	// Elastic Transcoder uses 600 as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 4096, inclusive.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add white bars
 	// to the top and bottom and/or left and right sides of the output album art
 	// to make the total size of the output art match the values that you specified
 	// for MaxWidth and MaxHeight.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// Specify one of the following values to control scaling of the output album
 	// art:
@@ -641,7 +812,7 @@ type Artwork struct {
 	// that its dimensions match the values that you specified for at least one
 	// of MaxWidth and MaxHeight without dropping below either value. If you specify
 	// this option, Elastic Transcoder does not scale the art up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	metadataArtwork `json:"-" xml:"-"`
 }
@@ -650,6 +821,16 @@ type metadataArtwork struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Artwork) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Artwork) GoString() string {
+	return s.String()
+}
+
 // Options associated with your audio codec.
 type AudioCodecOptions struct {
 	// You can only choose an audio bit depth when you specify flac or pcm for the
@@ -662,7 +843,7 @@ type AudioCodecOptions struct {
 	// Valid values are 16 and 24.
 	//
 	// The most common bit depth is 24.
-	BitDepth *string `type:"string"`
+	BitDepth *string `type:"string" pattern:"(^16$)|(^24$)"`
 
 	// You can only choose an audio bit order when you specify pcm for the value
 	// of Audio:Codec.
@@ -670,7 +851,7 @@ type AudioCodecOptions struct {
 	// The order the bits of a PCM sample are stored in.
 	//
 	// The supported value is LittleEndian.
-	BitOrder *string `type:"string"`
+	BitOrder *string `type:"string" pattern:"(^LittleEndian$)"`
 
 	// You can only choose an audio profile when you specify AAC for the value of
 	// Audio:Codec.
@@ -689,7 +870,7 @@ type AudioCodecOptions struct {
 	// If you created any presets before AAC profiles were added, Elastic Transcoder
 	// automatically updated your presets to use AAC-LC. You can change the value
 	// as required.
-	Profile *string `type:"string"`
+	Profile *string `type:"string" pattern:"(^auto$)|(^AAC-LC$)|(^HE-AAC$)|(^HE-AACv2$)"`
 
 	// You can only choose whether an audio sample is signed when you specify pcm
 	// for the value of Audio:Codec.
@@ -698,7 +879,7 @@ type AudioCodecOptions struct {
 	// (signed) or only positive numbers (unsigned).
 	//
 	// The supported value is Signed.
-	Signed *string `type:"string"`
+	Signed *string `type:"string" pattern:"(^Signed$)"`
 
 	metadataAudioCodecOptions `json:"-" xml:"-"`
 }
@@ -707,6 +888,16 @@ type metadataAudioCodecOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AudioCodecOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AudioCodecOptions) GoString() string {
+	return s.String()
+}
+
 // Parameters required for transcoding audio.
 type AudioParameters struct {
 	// The method of organizing audio channels and tracks. Use Audio:Channels to
@@ -771,11 +962,11 @@ type AudioParameters struct {
 	// one channel, plus seven MOS tracks  auto  channels with one track with multiple
 	// channels: Up to eight tracks with one channel each, plus MOS tracks until
 	// there are eight tracks in all
-	AudioPackingMode *string `type:"string"`
+	AudioPackingMode *string `type:"string" pattern:"(^SingleTrack$)|(^OneChannelPerTrack$)|(^OneChannelPerTrackWithMosTo8Tracks$)"`
 
 	// The bit rate of the audio stream in the output file, in kilobits/second.
 	// Enter an integer between 64 and 320, inclusive.
-	BitRate *string `type:"string"`
+	BitRate *string `type:"string" pattern:"^\\d{1,3}$"`
 
 	// The number of audio channels in the output file. The following values are
 	// valid:
@@ -797,11 +988,11 @@ type AudioParameters struct {
 	// specified, with any input: Two identical mono channels or stereo. For more
 	// information about tracks, see Audio:AudioPackingMode.    For more information
 	// about how Elastic Transcoder organizes channels and tracks, see Audio:AudioPackingMode.
-	Channels *string `type:"string"`
+	Channels *string `type:"string" pattern:"(^auto$)|(^0$)|(^1$)|(^2$)"`
 
 	// The audio codec for the output file. Valid values include aac, flac, mp2,
 	// mp3, pcm, and vorbis.
-	Codec *string `type:"string"`
+	Codec *string `type:"string" pattern:"(^AAC$)|(^vorbis$)|(^mp3$)|(^mp2$)|(^pcm$)|(^flac$)"`
 
 	// If you specified AAC for Audio:Codec, this is the AAC compression profile
 	// to use. Valid values include:
@@ -819,7 +1010,7 @@ type AudioParameters struct {
 	//
 	// If you specify auto, Elastic Transcoder automatically detects the sample
 	// rate.
-	SampleRate *string `type:"string"`
+	SampleRate *string `type:"string" pattern:"(^auto$)|(^22050$)|(^32000$)|(^44100$)|(^48000$)|(^96000$)|(^192000$)"`
 
 	metadataAudioParameters `json:"-" xml:"-"`
 }
@@ -828,13 +1019,23 @@ type metadataAudioParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AudioParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AudioParameters) GoString() string {
+	return s.String()
+}
+
 // The CancelJobRequest structure.
 type CancelJobInput struct {
 	// The identifier of the job that you want to cancel.
 	//
 	// To get a list of the jobs (including their jobId) that have a status of
 	// Submitted, use the ListJobsByStatus API action.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataCancelJobInput `json:"-" xml:"-"`
 }
@@ -843,6 +1044,16 @@ type metadataCancelJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelJobInput) GoString() string {
+	return s.String()
+}
+
 // The response body contains a JSON object. If the job is successfully canceled,
 // the value of Success is true.
 type CancelJobOutput struct {
@@ -853,6 +1064,16 @@ type metadataCancelJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelJobOutput) GoString() string {
+	return s.String()
+}
+
 // The file format of the output captions. If you leave this value blank, Elastic
 // Transcoder returns an error.
 type CaptionFormat struct {
@@ -886,7 +1107,7 @@ type CaptionFormat struct {
 	// Non-FMP4 outputs: All sidecar types
 	//
 	//  fmp4 captions have an extension of .ismt
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^mov-text$)|(^srt$)|(^scc$)|(^webvtt$)|(^dfxp$)"`
 
 	// The prefix for caption filenames, in the form description-{language}, where:
 	//
@@ -900,7 +1121,7 @@ type CaptionFormat struct {
 	// For example, suppose you're transcoding into srt format. When you enter
 	// "Sydney-{language}-sunrise", and the language of the captions is English
 	// (en), the name of the first caption file will be Sydney-en-sunrise00000.srt.
-	Pattern *string `type:"string"`
+	Pattern *string `type:"string" pattern:"(^$)|(^.*\\{language\\}.*$)"`
 
 	metadataCaptionFormat `json:"-" xml:"-"`
 }
@@ -909,6 +1130,16 @@ type metadataCaptionFormat struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CaptionFormat) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CaptionFormat) GoString() string {
+	return s.String()
+}
+
 // A source file for the input sidecar captions used during the transcoding
 // process.
 type CaptionSource struct {
@@ -918,12 +1149,12 @@ type CaptionSource struct {
 
 	// The name of the sidecar caption file that you want Elastic Transcoder to
 	// include in the output file.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The label of the caption shown in the player when choosing a language. We
 	// recommend that you put the caption language name here, in the language of
 	// the captions.
-	Label *string `type:"string"`
+	Label *string `type:"string" min:"1" max:"40"`
 
 	// A string that specifies the language of the caption. Specify this as one
 	// of:
@@ -934,14 +1165,14 @@ type CaptionSource struct {
 	//
 	//  For more information on ISO language codes and language names, see the
 	// List of ISO 639-1 codes.
-	Language *string `type:"string"`
+	Language *string `type:"string" min:"1" max:"255"`
 
 	// For clip generation or captions that do not start at the same time as the
 	// associated video file, the TimeOffset tells Elastic Transcoder how much of
 	// the video to encode before including captions.
 	//
 	// Specify the TimeOffset in the form [+-]SS.sss or [+-]HH:mm:SS.ss.
-	TimeOffset *string `type:"string"`
+	TimeOffset *string `type:"string" pattern:"(^[+-]?\\d{1,5}(\\.\\d{0,3})?$)|(^[+-]?([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	metadataCaptionSource `json:"-" xml:"-"`
 }
@@ -950,15 +1181,25 @@ type metadataCaptionSource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CaptionSource) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CaptionSource) GoString() string {
+	return s.String()
+}
+
 // The captions to be created, if any.
 type Captions struct {
 	// The array of file formats for the output captions. If you leave this value
 	// blank, Elastic Transcoder returns an error.
-	CaptionFormats []*CaptionFormat `type:"list"`
+	CaptionFormats []*CaptionFormat `type:"list" max:"4"`
 
 	// Source files for the input sidecar captions used during the transcoding process.
 	// To omit all sidecar captions, leave CaptionSources blank.
-	CaptionSources []*CaptionSource `type:"list"`
+	CaptionSources []*CaptionSource `type:"list" max:"20"`
 
 	// A policy that determines how Elastic Transcoder handles the existence of
 	// multiple captions.
@@ -978,7 +1219,7 @@ type Captions struct {
 	// specify in CaptionSources.
 	//
 	//  MergePolicy cannot be null.
-	MergePolicy *string `type:"string"`
+	MergePolicy *string `type:"string" pattern:"(^MergeOverride$)|(^MergeRetain$)|(^Override$)"`
 
 	metadataCaptions `json:"-" xml:"-"`
 }
@@ -987,6 +1228,16 @@ type metadataCaptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Captions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Captions) GoString() string {
+	return s.String()
+}
+
 // Settings for one clip in a composition. All jobs in a playlist must have
 // the same clip settings.
 type Clip struct {
@@ -1000,6 +1251,16 @@ type metadataClip struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Clip) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Clip) GoString() string {
+	return s.String()
+}
+
 // The CreateJobRequest structure.
 type CreateJobInput struct {
 	// A section of the request body that provides information about the file that
@@ -1012,25 +1273,25 @@ type CreateJobInput struct {
 	// The value, if any, that you want Elastic Transcoder to prepend to the names
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// A section of the request body that provides information about the transcoded
 	// (target) files. We recommend that you use the Outputs syntax instead of the
 	// Output syntax.
-	Outputs []*CreateJobOutput `type:"list"`
+	Outputs []*CreateJobOutput `type:"list" max:"30"`
 
 	// The Id of the pipeline that you want Elastic Transcoder to use for transcoding.
 	// The pipeline determines several settings, including the Amazon S3 bucket
 	// from which Elastic Transcoder gets the files to transcode and the bucket
 	// into which Elastic Transcoder puts the transcoded files.
-	PipelineID *string `locationName:"PipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// If you specify a preset in PresetId for which the value of Container is fmp4
 	// (Fragmented MP4) or ts (MPEG-TS), Playlists contains information about the
 	// master playlists that you want Elastic Transcoder to create.
 	//
 	// The maximum number of master playlists in a job is 30.
-	Playlists []*CreateJobPlaylist `type:"list"`
+	Playlists []*CreateJobPlaylist `type:"list" max:"30"`
 
 	// User-defined metadata that you want to associate with an Elastic Transcoder
 	// job. You specify metadata in key/value pairs, and you can add up to 10 key/value
@@ -1045,6 +1306,16 @@ type metadataCreateJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateJobInput) GoString() string {
+	return s.String()
+}
+
 // The CreateJobOutput structure.
 type CreateJobOutput struct {
 	// Information about the album art that you want Elastic Transcoder to add to
@@ -1114,17 +1385,17 @@ type CreateJobOutput struct {
 	// in the Amazon S3 bucket specified by the OutputBucket object in the pipeline
 	// that is specified by the pipeline ID. If a file with the specified name already
 	// exists in the output bucket, the job fails.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The Id of the preset to use for this job. The preset determines the audio,
 	// video, and thumbnail settings that Elastic Transcoder uses for transcoding.
-	PresetID *string `locationName:"PresetId" type:"string"`
+	PresetID *string `locationName:"PresetId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The number of degrees clockwise by which you want Elastic Transcoder to rotate
 	// the output relative to the input. Enter one of the following values: auto,
 	// 0, 90, 180, 270. The value auto generally works only if the file that you're
 	// transcoding contains rotation metadata.
-	Rotate *string `type:"string"`
+	Rotate *string `type:"string" pattern:"(^auto$)|(^0$)|(^90$)|(^180$)|(^270$)"`
 
 	// (Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -1142,7 +1413,7 @@ type CreateJobOutput struct {
 	// Elastic Transcoder creates an output-specific playlist for each output HLS
 	// output that you specify in OutputKeys. To add an output to the master playlist
 	// for this job, include it in the OutputKeys of the associated playlist.
-	SegmentDuration *string `type:"string"`
+	SegmentDuration *string `type:"string" pattern:"^\\d{1,5}(\\.\\d{0,5})?$"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your thumbnail.
@@ -1177,7 +1448,7 @@ type CreateJobOutput struct {
 	// in the format (.jpg or .png) that appears in the preset that you specified
 	// in the PresetID value of CreateJobOutput. Elastic Transcoder also appends
 	// the applicable file name extension.
-	ThumbnailPattern *string `type:"string"`
+	ThumbnailPattern *string `type:"string" pattern:"(^$)|(^.*\\{count\\}.*$)"`
 
 	// Information about the watermarks that you want Elastic Transcoder to add
 	// to the video during transcoding. You can specify up to four watermarks for
@@ -1192,11 +1463,21 @@ type metadataCreateJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateJobOutput) GoString() string {
+	return s.String()
+}
+
 // Information about the master playlist.
 type CreateJobPlaylist struct {
 	// The format of the output playlist. Valid formats include HLSv3, HLSv4, and
 	// Smooth.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^HLSv3$)|(^HLSv4$)|(^Smooth$)"`
 
 	// The HLS content protection settings, if any, that you want Elastic Transcoder
 	// to apply to the output files associated with this playlist.
@@ -1212,7 +1493,7 @@ type CreateJobPlaylist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -1251,7 +1532,7 @@ type CreateJobPlaylist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	// The DRM settings, if any, that you want Elastic Transcoder to apply to the
 	// output files associated with this playlist.
@@ -1264,6 +1545,16 @@ type metadataCreateJobPlaylist struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateJobPlaylist) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateJobPlaylist) GoString() string {
+	return s.String()
+}
+
 // The CreateJobResponse structure.
 type CreateJobResponse struct {
 	// A section of the response body that provides information about the job that
@@ -1277,6 +1568,16 @@ type metadataCreateJobResponse struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateJobResponse) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateJobResponse) GoString() string {
+	return s.String()
+}
+
 // The CreatePipelineRequest structure.
 type CreatePipelineInput struct {
 	// The AWS Key Management Service (AWS KMS) key that you want to use with this
@@ -1287,7 +1588,7 @@ type CreatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -1338,13 +1639,13 @@ type CreatePipelineInput struct {
 
 	// The Amazon S3 bucket in which you saved the media files that you want to
 	// transcode.
-	InputBucket *string `type:"string" required:"true"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -1383,11 +1684,11 @@ type CreatePipelineInput struct {
 	// in another bucket, specify which users can access the transcoded files or
 	// the permissions the users have, or change the Amazon S3 storage class, omit
 	// OutputBucket and specify values for ContentConfig and ThumbnailConfig instead.
-	OutputBucket *string `type:"string"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to use to create the pipeline.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$" required:"true"`
 
 	// The ThumbnailConfig object specifies several values, including the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save thumbnail files, which
@@ -1437,6 +1738,16 @@ type metadataCreatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePipelineInput) GoString() string {
+	return s.String()
+}
+
 // When you create a pipeline, Elastic Transcoder returns the values that you
 // specified in the request.
 type CreatePipelineOutput struct {
@@ -1459,6 +1770,16 @@ type metadataCreatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePipelineOutput) GoString() string {
+	return s.String()
+}
+
 // The CreatePresetRequest structure.
 type CreatePresetInput struct {
 	// A section of the request body that specifies the audio parameters.
@@ -1466,14 +1787,14 @@ type CreatePresetInput struct {
 
 	// The container type for the output file. Valid values include flac, flv, fmp4,
 	// gif, mp3, mp4, mpg, mxf, oga, ogg, ts, and webm.
-	Container *string `type:"string" required:"true"`
+	Container *string `type:"string" pattern:"(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^flac$)|(^oga$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)|(^mxf$)" required:"true"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"255"`
 
 	// The name of the preset. We recommend that the name be unique within the AWS
 	// account, but uniqueness is not enforced.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// A section of the request body that specifies the thumbnail parameters, if
 	// any.
@@ -1489,6 +1810,16 @@ type metadataCreatePresetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePresetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePresetInput) GoString() string {
+	return s.String()
+}
+
 // The CreatePresetResponse structure.
 type CreatePresetOutput struct {
 	// A section of the response body that provides information about the preset
@@ -1508,10 +1839,20 @@ type metadataCreatePresetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePresetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePresetOutput) GoString() string {
+	return s.String()
+}
+
 // The DeletePipelineRequest structure.
 type DeletePipelineInput struct {
 	// The identifier of the pipeline that you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataDeletePipelineInput `json:"-" xml:"-"`
 }
@@ -1520,6 +1861,16 @@ type metadataDeletePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePipelineInput) GoString() string {
+	return s.String()
+}
+
 // The DeletePipelineResponse structure.
 type DeletePipelineOutput struct {
 	metadataDeletePipelineOutput `json:"-" xml:"-"`
@@ -1529,10 +1880,20 @@ type metadataDeletePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePipelineOutput) GoString() string {
+	return s.String()
+}
+
 // The DeletePresetRequest structure.
 type DeletePresetInput struct {
 	// The identifier of the preset for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataDeletePresetInput `json:"-" xml:"-"`
 }
@@ -1541,6 +1902,16 @@ type metadataDeletePresetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePresetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePresetInput) GoString() string {
+	return s.String()
+}
+
 // The DeletePresetResponse structure.
 type DeletePresetOutput struct {
 	metadataDeletePresetOutput `json:"-" xml:"-"`
@@ -1550,6 +1921,16 @@ type metadataDeletePresetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePresetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePresetOutput) GoString() string {
+	return s.String()
+}
+
 // The detected properties of the input file. Elastic Transcoder identifies
 // these values from the input file.
 type DetectedProperties struct {
@@ -1560,7 +1941,7 @@ type DetectedProperties struct {
 	FileSize *int64 `type:"long"`
 
 	// The detected frame rate of the input file, in frames per second.
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"^\\d{1,5}(\\.\\d{0,5})?$"`
 
 	// The detected height of the input file, in pixels.
 	Height *int64 `type:"integer"`
@@ -1575,6 +1956,16 @@ type metadataDetectedProperties struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetectedProperties) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetectedProperties) GoString() string {
+	return s.String()
+}
+
 // The encryption settings, if any, that are used for decrypting your input
 // files or encrypting your output files. If your input file is encrypted, you
 // must specify the mode that Elastic Transcoder will use to decrypt your file,
@@ -1586,7 +1977,7 @@ type Encryption struct {
 	// want Elastic Transcoder to use to encrypt your output files. The initialization
 	// vector must be base64-encoded, and it must be exactly 16 bytes long before
 	// being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// The data encryption key that you want Elastic Transcoder to use to encrypt
 	// your output file, or that was used to encrypt your input file. The key must
@@ -1596,14 +1987,14 @@ type Encryption struct {
 	// 128, 192, or 256.
 	//
 	// The key must also be encrypted by using the Amazon Key Management Service.
-	Key *string `type:"string"`
+	Key *string `type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The MD5 digest of the key that you used to encrypt your input file, or that
 	// you want Elastic Transcoder to use to encrypt your output file. Elastic Transcoder
 	// uses the key digest as a checksum to make sure your key was not corrupted
 	// in transit. The key MD5 must be base64-encoded, and it must be exactly 16
 	// bytes long before being base64-encoded.
-	KeyMD5 *string `locationName:"KeyMd5" type:"string"`
+	KeyMD5 *string `locationName:"KeyMd5" type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The specific server-side encryption mode that you want Elastic Transcoder
 	// to use when decrypting your input files or encrypting your output files.
@@ -1638,7 +2029,7 @@ type Encryption struct {
 	// are never stored by AWS; therefore, it is important that you safely manage
 	// your encryption keys. If you lose them, you won't be able to unencrypt your
 	// data.
-	Mode *string `type:"string"`
+	Mode *string `type:"string" pattern:"(^s3$)|(^s3-aws-kms$)|(^aes-cbc-pkcs7$)|(^aes-ctr$)|(^aes-gcm$)"`
 
 	metadataEncryption `json:"-" xml:"-"`
 }
@@ -1647,6 +2038,16 @@ type metadataEncryption struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Encryption) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Encryption) GoString() string {
+	return s.String()
+}
+
 // The HLS content protection settings, if any, that you want Elastic Transcoder
 // to apply to your output files.
 type HLSContentProtection struct {
@@ -1657,7 +2058,7 @@ type HLSContentProtection struct {
 	// every encryption operation, that you want Elastic Transcoder to use to encrypt
 	// your output files. The initialization vector must be base64-encoded, and
 	// it must be exactly 16 bytes before being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// If you want Elastic Transcoder to generate a key for you, leave this field
 	// blank.
@@ -1667,7 +2068,7 @@ type HLSContentProtection struct {
 	// bit lengths before being base64-encoded:
 	//
 	// 128, 192, or 256.
-	Key *string `type:"string"`
+	Key *string `type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// If Elastic Transcoder is generating your key for you, you must leave this
 	// field blank.
@@ -1676,24 +2077,24 @@ type HLSContentProtection struct {
 	// your output file, and that you want Elastic Transcoder to use as a checksum
 	// to make sure your key was not corrupted in transit. The key MD5 must be base64-encoded,
 	// and it must be exactly 16 bytes before being base64- encoded.
-	KeyMD5 *string `locationName:"KeyMd5" type:"string"`
+	KeyMD5 *string `locationName:"KeyMd5" type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// Specify whether you want Elastic Transcoder to write your HLS license key
 	// to an Amazon S3 bucket. If you choose WithVariantPlaylists, LicenseAcquisitionUrl
 	// must be left blank and Elastic Transcoder writes your data key into the same
 	// bucket as the associated playlist.
-	KeyStoragePolicy *string `type:"string"`
+	KeyStoragePolicy *string `type:"string" pattern:"(^NoStore$)|(^WithVariantPlaylists$)"`
 
 	// The location of the license key required to decrypt your HLS playlist. The
 	// URL must be an absolute path, and is referenced in the URI attribute of the
 	// EXT-X-KEY metadata tag in the playlist file.
-	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string"`
+	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string" min:"0" max:"512"`
 
 	// The content protection method for your output. The only valid value is: aes-128.
 	//
 	// This value will be written into the method attribute of the EXT-X-KEY metadata
 	// tag in the output playlist.
-	Method *string `type:"string"`
+	Method *string `type:"string" pattern:"(^aes-128$)"`
 
 	metadataHLSContentProtection `json:"-" xml:"-"`
 }
@@ -1702,6 +2103,16 @@ type metadataHLSContentProtection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HLSContentProtection) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HLSContentProtection) GoString() string {
+	return s.String()
+}
+
 // A section of the response body that provides information about the job that
 // is created.
 type Job struct {
@@ -1710,7 +2121,7 @@ type Job struct {
 
 	// The identifier that Elastic Transcoder assigned to the job. You use this
 	// value to get settings for the job or to delete the job.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// A section of the request or response body that provides information about
 	// the file that is being transcoded.
@@ -1729,7 +2140,7 @@ type Job struct {
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists. We recommend that you add a / or some other delimiter to the end
 	// of the OutputKeyPrefix.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// Information about the output files. We recommend that you use the Outputs
 	// syntax for all jobs, even when you want Elastic Transcoder to transcode a
@@ -1744,7 +2155,7 @@ type Job struct {
 	// The pipeline determines several settings, including the Amazon S3 bucket
 	// from which Elastic Transcoder gets the files to transcode and the bucket
 	// into which Elastic Transcoder puts the transcoded files.
-	PipelineID *string `locationName:"PipelineId" type:"string"`
+	PipelineID *string `locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -1755,7 +2166,7 @@ type Job struct {
 	Playlists []*Playlist `type:"list"`
 
 	// The status of the job: Submitted, Progressing, Complete, Canceled, or Error.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// Details about the timing of a job.
 	Timing *Timing `type:"structure"`
@@ -1783,6 +2194,16 @@ type metadataJob struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Job) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Job) GoString() string {
+	return s.String()
+}
+
 // The .jpg or .png file associated with an audio file.
 type JobAlbumArt struct {
 	// The file to be used as album art. There can be multiple artworks associated
@@ -1799,7 +2220,7 @@ type JobAlbumArt struct {
 	// Transcoder will use that artwork for the output. If the original input does
 	// not contain artwork, Elastic Transcoder will use the specified album art
 	// file.
-	MergePolicy *string `type:"string"`
+	MergePolicy *string `type:"string" pattern:"(^Replace$)|(^Prepend$)|(^Append$)|(^Fallback$)"`
 
 	metadataJobAlbumArt `json:"-" xml:"-"`
 }
@@ -1808,6 +2229,16 @@ type metadataJobAlbumArt struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobAlbumArt) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobAlbumArt) GoString() string {
+	return s.String()
+}
+
 // Information about the file that you're transcoding.
 type JobInput struct {
 	// The aspect ratio of the input file. If you want Elastic Transcoder to automatically
@@ -1818,7 +2249,7 @@ type JobInput struct {
 	//
 	//  If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of the aspect ratio.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The container type for the input file. If you want Elastic Transcoder to
 	// automatically detect the container type of the input file, specify auto.
@@ -1827,7 +2258,7 @@ type JobInput struct {
 	//
 	//  3gp, aac, asf, avi, divx, flv, m4a, mkv, mov, mp3, mp4, mpeg, mpeg-ps,
 	// mpeg-ts, mxf, ogg, vob, wav, webm
-	Container *string `type:"string"`
+	Container *string `type:"string" pattern:"(^auto$)|(^3gp$)|(^asf$)|(^avi$)|(^divx$)|(^flv$)|(^mkv$)|(^mov$)|(^mp4$)|(^mpeg$)|(^mpeg-ps$)|(^mpeg-ts$)|(^mxf$)|(^ogg$)|(^ts$)|(^vob$)|(^wav$)|(^webm$)|(^mp3$)|(^m4a$)|(^aac$)"`
 
 	// The detected properties of the input file.
 	DetectedProperties *DetectedProperties `type:"structure"`
@@ -1845,7 +2276,7 @@ type JobInput struct {
 	//
 	// If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of the frame rate.
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// Whether the input file is interlaced. If you want Elastic Transcoder to automatically
 	// detect whether the input file is interlaced, specify auto. If you want to
@@ -1856,7 +2287,7 @@ type JobInput struct {
 	//
 	// If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of interlacing.
-	Interlaced *string `type:"string"`
+	Interlaced *string `type:"string" pattern:"(^auto$)|(^true$)|(^false$)"`
 
 	// The name of the file to transcode. Elsewhere in the body of the JSON block
 	// is the the ID of the pipeline to use for processing the job. The InputBucket
@@ -1866,11 +2297,11 @@ type JobInput struct {
 	// If the file name includes a prefix, such as cooking/lasagna.mpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// This value must be auto, which causes Elastic Transcoder to automatically
 	// detect the resolution of the input file.
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"(^auto$)|(^\\d{1,5}x\\d{1,5}$)"`
 
 	metadataJobInput `json:"-" xml:"-"`
 }
@@ -1879,6 +2310,16 @@ type metadataJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobInput) GoString() string {
+	return s.String()
+}
+
 // Outputs recommended instead.If you specified one output for a job, information
 // about that output. If you specified multiple outputs for a job, the Output
 // object lists information about the first output. This duplicates the information
@@ -1960,7 +2401,7 @@ type JobOutput struct {
 	FileSize *int64 `type:"long"`
 
 	// Frame rate of the output file, in frames per second.
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"^\\d{1,5}(\\.\\d{0,5})?$"`
 
 	// Height of the output file, in pixels.
 	Height *int64 `type:"integer"`
@@ -1973,7 +2414,7 @@ type JobOutput struct {
 	// The name to assign to the transcoded file. Elastic Transcoder saves the file
 	// in the Amazon S3 bucket specified by the OutputBucket object in the pipeline
 	// that is specified by the pipeline ID.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The value of the Id object for the preset that you want to use for this job.
 	// The preset determines the audio, video, and thumbnail settings that Elastic
@@ -1981,7 +2422,7 @@ type JobOutput struct {
 	// the preset ID that Elastic Transcoder returned in the response when you created
 	// the preset. You can also use the Elastic Transcoder system presets, which
 	// you can get with ListPresets.
-	PresetID *string `locationName:"PresetId" type:"string"`
+	PresetID *string `locationName:"PresetId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The number of degrees clockwise by which you want Elastic Transcoder to rotate
 	// the output relative to the input. Enter one of the following values:
@@ -1990,7 +2431,7 @@ type JobOutput struct {
 	//
 	//  The value auto generally works only if the file that you're transcoding
 	// contains rotation metadata.
-	Rotate *string `type:"string"`
+	Rotate *string `type:"string" pattern:"(^auto$)|(^0$)|(^90$)|(^180$)|(^270$)"`
 
 	// (Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -2008,7 +2449,7 @@ type JobOutput struct {
 	// Elastic Transcoder creates an output-specific playlist for each output HLS
 	// output that you specify in OutputKeys. To add an output to the master playlist
 	// for this job, include it in the OutputKeys of the associated playlist.
-	SegmentDuration *string `type:"string"`
+	SegmentDuration *string `type:"string" pattern:"^\\d{1,5}(\\.\\d{0,5})?$"`
 
 	// The status of one output in a job. If you specified only one output for the
 	// job, Outputs:Status is always the same as Job:Status. If you specified more
@@ -2024,10 +2465,10 @@ type JobOutput struct {
 	// is Error, the terminal status for Job:Status is also Error.  The value of
 	// Status is one of the following: Submitted, Progressing, Complete, Canceled,
 	// or Error.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// Information that further explains Status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" min:"0" max:"255"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your thumbnail.
@@ -2062,7 +2503,7 @@ type JobOutput struct {
 	// in the format (.jpg or .png) that appears in the preset that you specified
 	// in the PresetID value of CreateJobOutput. Elastic Transcoder also appends
 	// the applicable file name extension.
-	ThumbnailPattern *string `type:"string"`
+	ThumbnailPattern *string `type:"string" pattern:"(^$)|(^.*\\{count\\}.*$)"`
 
 	// Information about the watermarks that you want Elastic Transcoder to add
 	// to the video during transcoding. You can specify up to four watermarks for
@@ -2088,6 +2529,16 @@ type metadataJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobOutput) GoString() string {
+	return s.String()
+}
+
 // Watermarks can be in .png or .jpg format. If you want to display a watermark
 // that is not rectangular, use the .png format, which supports transparency.
 type JobWatermark struct {
@@ -2103,13 +2554,13 @@ type JobWatermark struct {
 	//  If the file name includes a prefix, for example, logos/128x64.png, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255" pattern:"(^.{1,}.jpg$)|(^.{1,}.jpeg$)|(^.{1,}.png$)"`
 
 	// The ID of the watermark settings that Elastic Transcoder uses to add watermarks
 	// to the video during transcoding. The settings are in the preset specified
 	// by Preset for the current output. In that preset, the value of Watermarks
 	// Id tells Elastic Transcoder which settings to use.
-	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string"`
+	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string" min:"1" max:"40"`
 
 	metadataJobWatermark `json:"-" xml:"-"`
 }
@@ -2118,18 +2569,28 @@ type metadataJobWatermark struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobWatermark) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobWatermark) GoString() string {
+	return s.String()
+}
+
 // The ListJobsByPipelineRequest structure.
 type ListJobsByPipelineInput struct {
 	// To list jobs in chronological order by the date and time that they were submitted,
 	// enter true. To list jobs in reverse chronological order, enter false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The ID of the pipeline for which you want to get job information.
-	PipelineID *string `location:"uri" locationName:"PipelineId" type:"string" required:"true"`
+	PipelineID *string `location:"uri" locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataListJobsByPipelineInput `json:"-" xml:"-"`
 }
@@ -2138,6 +2599,16 @@ type metadataListJobsByPipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListJobsByPipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsByPipelineInput) GoString() string {
+	return s.String()
+}
+
 // The ListJobsByPipelineResponse structure.
 type ListJobsByPipelineOutput struct {
 	// An array of Job objects that are in the specified pipeline.
@@ -2146,7 +2617,7 @@ type ListJobsByPipelineOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the jobs in the specified pipeline fit on one page or when you've
 	// reached the last page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListJobsByPipelineOutput `json:"-" xml:"-"`
 }
@@ -2155,20 +2626,30 @@ type metadataListJobsByPipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListJobsByPipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsByPipelineOutput) GoString() string {
+	return s.String()
+}
+
 // The ListJobsByStatusRequest structure.
 type ListJobsByStatusInput struct {
 	// To list jobs in chronological order by the date and time that they were submitted,
 	// enter true. To list jobs in reverse chronological order, enter false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// To get information about all of the jobs associated with the current AWS
 	// account that have a given status, specify the following status: Submitted,
 	// Progressing, Complete, Canceled, or Error.
-	Status *string `location:"uri" locationName:"Status" type:"string" required:"true"`
+	Status *string `location:"uri" locationName:"Status" type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)" required:"true"`
 
 	metadataListJobsByStatusInput `json:"-" xml:"-"`
 }
@@ -2177,6 +2658,16 @@ type metadataListJobsByStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListJobsByStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsByStatusInput) GoString() string {
+	return s.String()
+}
+
 // The ListJobsByStatusResponse structure.
 type ListJobsByStatusOutput struct {
 	// An array of Job objects that have the specified status.
@@ -2185,7 +2676,7 @@ type ListJobsByStatusOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the jobs in the specified pipeline fit on one page or when you've
 	// reached the last page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListJobsByStatusOutput `json:"-" xml:"-"`
 }
@@ -2194,16 +2685,26 @@ type metadataListJobsByStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListJobsByStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsByStatusOutput) GoString() string {
+	return s.String()
+}
+
 // The ListPipelineRequest structure.
 type ListPipelinesInput struct {
 	// To list pipelines in chronological order by the date and time that they were
 	// created, enter true. To list pipelines in reverse chronological order, enter
 	// false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListPipelinesInput `json:"-" xml:"-"`
 }
@@ -2212,12 +2713,22 @@ type metadataListPipelinesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPipelinesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPipelinesInput) GoString() string {
+	return s.String()
+}
+
 // A list of the pipelines associated with the current AWS account.
 type ListPipelinesOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the pipelines fit on one page or when you've reached the last
 	// page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// An array of Pipeline objects.
 	Pipelines []*Pipeline `type:"list"`
@@ -2229,16 +2740,26 @@ type metadataListPipelinesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPipelinesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPipelinesOutput) GoString() string {
+	return s.String()
+}
+
 // The ListPresetsRequest structure.
 type ListPresetsInput struct {
 	// To list presets in chronological order by the date and time that they were
 	// created, enter true. To list presets in reverse chronological order, enter
 	// false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListPresetsInput `json:"-" xml:"-"`
 }
@@ -2247,12 +2768,22 @@ type metadataListPresetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPresetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPresetsInput) GoString() string {
+	return s.String()
+}
+
 // The ListPresetsResponse structure.
 type ListPresetsOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the presets fit on one page or when you've reached the last
 	// page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// An array of Preset objects.
 	Presets []*Preset `type:"list"`
@@ -2264,6 +2795,16 @@ type metadataListPresetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPresetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPresetsOutput) GoString() string {
+	return s.String()
+}
+
 // The Amazon Simple Notification Service (Amazon SNS) topic or topics to notify
 // in order to report job status.
 //
@@ -2272,19 +2813,19 @@ type metadataListPresetsOutput struct {
 type Notifications struct {
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder has
 	// finished processing the job.
-	Completed *string `type:"string"`
+	Completed *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder encounters
 	// an error condition.
-	Error *string `type:"string"`
+	Error *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify when Elastic Transcoder has started to process the job.
-	Progressing *string `type:"string"`
+	Progressing *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder encounters
 	// a warning condition.
-	Warning *string `type:"string"`
+	Warning *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	metadataNotifications `json:"-" xml:"-"`
 }
@@ -2293,6 +2834,16 @@ type metadataNotifications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Notifications) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Notifications) GoString() string {
+	return s.String()
+}
+
 // The Permission structure.
 type Permission struct {
 	// The permission that you want to give to the AWS user that is listed in Grantee.
@@ -2303,14 +2854,14 @@ type Permission struct {
 	// the thumbnails that Elastic Transcoder adds to the Amazon S3 bucket.  FULL_CONTROL:
 	// The grantee has READ, READ_ACP, and WRITE_ACP permissions for the thumbnails
 	// that Elastic Transcoder adds to the Amazon S3 bucket.
-	Access []*string `type:"list"`
+	Access []*string `type:"list" max:"30"`
 
 	// The AWS user or group that you want to have access to transcoded files and
 	// playlists. To identify the user or group, you can specify the canonical user
 	// ID for an AWS account, an origin access identity for a CloudFront distribution,
 	// the registered email address of an AWS account, or a predefined Amazon S3
 	// group.
-	Grantee *string `type:"string"`
+	Grantee *string `type:"string" min:"1" max:"255"`
 
 	// The type of value that appears in the Grantee object:   Canonical: Either
 	// the canonical user ID for an AWS account or an origin access identity for
@@ -2318,7 +2869,7 @@ type Permission struct {
 	// an AWS account number.   Email: The registered email address of an AWS account.
 	//  Group: One of the following predefined Amazon S3 groups: AllUsers, AuthenticatedUsers,
 	// or LogDelivery.
-	GranteeType *string `type:"string"`
+	GranteeType *string `type:"string" pattern:"(^Canonical$)|(^Email$)|(^Group$)"`
 
 	metadataPermission `json:"-" xml:"-"`
 }
@@ -2327,6 +2878,16 @@ type metadataPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Permission) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Permission) GoString() string {
+	return s.String()
+}
+
 // The pipeline (queue) that is used to manage jobs.
 type Pipeline struct {
 	// The Amazon Resource Name (ARN) for the pipeline.
@@ -2340,7 +2901,7 @@ type Pipeline struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// Information about the Amazon S3 bucket in which you want Elastic Transcoder
 	// to save transcoded files and playlists. Either you specify both ContentConfig
@@ -2372,17 +2933,17 @@ type Pipeline struct {
 	// The identifier for the pipeline. You use this value to identify the pipeline
 	// in which you want to perform a variety of operations, such as creating a
 	// job or a preset.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The Amazon S3 bucket from which Elastic Transcoder gets media files for transcoding
 	// and the graphics files, if any, that you want to use for watermarks.
-	InputBucket *string `type:"string"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -2401,17 +2962,17 @@ type Pipeline struct {
 	// The Amazon S3 bucket in which you want Elastic Transcoder to save transcoded
 	// files, thumbnails, and playlists. Either you specify this value, or you specify
 	// both ContentConfig and ThumbnailConfig.
-	OutputBucket *string `type:"string"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that Elastic Transcoder uses
 	// to transcode jobs for this pipeline.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$"`
 
 	// The current status of the pipeline:
 	//
 	//   Active: The pipeline is processing jobs.  Paused: The pipeline is not
 	// currently processing jobs.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Active$)|(^Paused$)"`
 
 	// Information about the Amazon S3 bucket in which you want Elastic Transcoder
 	// to save thumbnail files. Either you specify both ContentConfig and ThumbnailConfig,
@@ -2447,6 +3008,16 @@ type metadataPipeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Pipeline) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Pipeline) GoString() string {
+	return s.String()
+}
+
 // The PipelineOutputConfig structure.
 type PipelineOutputConfig struct {
 	// The Amazon S3 bucket in which you want Elastic Transcoder to save the transcoded
@@ -2461,7 +3032,7 @@ type PipelineOutputConfig struct {
 	// transcoded files or the permissions the users have, or change the Amazon
 	// S3 storage class, omit OutputBucket and specify values for ContentConfig
 	// and ThumbnailConfig instead.
-	Bucket *string `type:"string"`
+	Bucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// Optional. The Permissions object specifies which users and/or predefined
 	// Amazon S3 groups you want to have access to transcoded files and playlists,
@@ -2476,12 +3047,12 @@ type PipelineOutputConfig struct {
 	//  If you omit Permissions, Elastic Transcoder grants full control over the
 	// transcoded files and playlists to the owner of the role specified by Role,
 	// and grants no other permissions to any other user or group.
-	Permissions []*Permission `type:"list"`
+	Permissions []*Permission `type:"list" max:"30"`
 
 	// The Amazon S3 storage class, Standard or ReducedRedundancy, that you want
 	// Elastic Transcoder to assign to the video files and playlists that it stores
 	// in your Amazon S3 bucket.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" pattern:"(^ReducedRedundancy$)|(^Standard$)"`
 
 	metadataPipelineOutputConfig `json:"-" xml:"-"`
 }
@@ -2490,6 +3061,16 @@ type metadataPipelineOutputConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PipelineOutputConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PipelineOutputConfig) GoString() string {
+	return s.String()
+}
+
 // The PlayReady DRM settings, if any, that you want Elastic Transcoder to apply
 // to the output files associated with this playlist.
 //
@@ -2499,14 +3080,14 @@ type metadataPipelineOutputConfig struct {
 type PlayReadyDRM struct {
 	// The type of DRM, if any, that you want Elastic Transcoder to apply to the
 	// output files associated with this playlist.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^microsoft$)|(^discretix-3.0$)"`
 
 	// The series of random bits created by a random bit generator, unique for every
 	// encryption operation, that you want Elastic Transcoder to use to encrypt
 	// your files. The initialization vector must be base64-encoded, and it must
 	// be exactly 8 bytes long before being base64-encoded. If no initialization
 	// vector is provided, Elastic Transcoder generates one for you.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" min:"0" max:"255"`
 
 	// The DRM key for your file, provided by your DRM license provider. The key
 	// must be base64-encoded, and it must be one of the following bit lengths before
@@ -2515,7 +3096,7 @@ type PlayReadyDRM struct {
 	// 128, 192, or 256.
 	//
 	// The key must also be encrypted by using AWS KMS.
-	Key *string `type:"string"`
+	Key *string `type:"string" pattern:"(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The ID for your DRM key, so that your DRM license provider knows which key
 	// to provide.
@@ -2524,20 +3105,20 @@ type PlayReadyDRM struct {
 	// it to little endian before inserting it into the PlayReady DRM headers. If
 	// you are unsure whether your license server provides your key ID in big or
 	// little endian, check with your DRM provider.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" pattern:"(^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$)|(^[0-9A-Fa-f]{32}$)"`
 
 	// The MD5 digest of the key used for DRM on your file, and that you want Elastic
 	// Transcoder to use as a checksum to make sure your key was not corrupted in
 	// transit. The key MD5 must be base64-encoded, and it must be exactly 16 bytes
 	// before being base64-encoded.
-	KeyMD5 *string `locationName:"KeyMd5" type:"string"`
+	KeyMD5 *string `locationName:"KeyMd5" type:"string" pattern:"(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The location of the license key required to play DRM content. The URL must
 	// be an absolute path, and is referenced by the PlayReady header. The PlayReady
 	// header is referenced in the protection header of the client manifest for
 	// Smooth Streaming outputs, and in the EXT-X-DXDRM and EXT-XDXDRMINFO metadata
 	// tags for HLS playlist outputs. An example URL looks like this: https://www.example.com/exampleKey/
-	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string"`
+	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string" min:"1" max:"512"`
 
 	metadataPlayReadyDRM `json:"-" xml:"-"`
 }
@@ -2546,6 +3127,16 @@ type metadataPlayReadyDRM struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PlayReadyDRM) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PlayReadyDRM) GoString() string {
+	return s.String()
+}
+
 // Use Only for Fragmented MP4 or MPEG-TS Outputs. If you specify a preset for
 // which the value of Container is fmp4 (Fragmented MP4) or ts (MPEG-TS), Playlists
 // contains information about the master playlists that you want Elastic Transcoder
@@ -2554,7 +3145,7 @@ type metadataPlayReadyDRM struct {
 type Playlist struct {
 	// The format of the output playlist. Valid formats include HLSv3, HLSv4, and
 	// Smooth.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^HLSv3$)|(^HLSv4$)|(^Smooth$)"`
 
 	// The HLS content protection settings, if any, that you want Elastic Transcoder
 	// to apply to the output files associated with this playlist.
@@ -2570,7 +3161,7 @@ type Playlist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -2609,17 +3200,17 @@ type Playlist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	// The DRM settings, if any, that you want Elastic Transcoder to apply to the
 	// output files associated with this playlist.
 	PlayReadyDRM *PlayReadyDRM `locationName:"PlayReadyDrm" type:"structure"`
 
 	// The status of the job with which the playlist is associated.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// Information that further explains the status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" min:"0" max:"255"`
 
 	metadataPlaylist `json:"-" xml:"-"`
 }
@@ -2628,6 +3219,16 @@ type metadataPlaylist struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Playlist) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Playlist) GoString() string {
+	return s.String()
+}
+
 // Presets are templates that contain most of the settings for transcoding media
 // files from one format to another. Elastic Transcoder includes some default
 // presets for common formats, for example, several iPod and iPhone versions.
@@ -2644,17 +3245,17 @@ type Preset struct {
 
 	// The container type for the output file. Valid values include flac, flv, fmp4,
 	// gif, mp3, mp4, mpg, mxf, oga, ogg, ts, and webm.
-	Container *string `type:"string"`
+	Container *string `type:"string" pattern:"(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^flac$)|(^oga$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)|(^mxf$)"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"255"`
 
 	// Identifier for the new preset. You use this value to get settings for the
 	// preset or to delete it.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The name of the preset.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// A section of the response body that provides information about the thumbnail
 	// preset values, if any.
@@ -2662,7 +3263,7 @@ type Preset struct {
 
 	// Whether the preset is a default preset provided by Elastic Transcoder (System)
 	// or a preset that you have defined (Custom).
-	Type *string `type:"string"`
+	Type *string `type:"string" pattern:"(^System$)|(^Custom$)"`
 
 	// A section of the response body that provides information about the video
 	// preset values.
@@ -2675,6 +3276,16 @@ type metadataPreset struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Preset) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Preset) GoString() string {
+	return s.String()
+}
+
 // Settings for the size, location, and opacity of graphics that you want Elastic
 // Transcoder to overlay over videos that are transcoded using this preset.
 // You can specify settings for up to four watermarks. Watermarks appear in
@@ -2695,7 +3306,7 @@ type PresetWatermark struct {
 	// the left border of the video.  Right: The right edge of the watermark is
 	// aligned with the right border of the video.  Center: The watermark is centered
 	// between the left and right borders.
-	HorizontalAlign *string `type:"string"`
+	HorizontalAlign *string `type:"string" pattern:"(^Left$)|(^Right$)|(^Center$)"`
 
 	// The amount by which you want the horizontal position of the watermark to
 	// be offset from the position specified by HorizontalAlign:  number of pixels
@@ -2714,11 +3325,11 @@ type PresetWatermark struct {
 	//
 	// Use the value of Target to specify whether you want to include the black
 	// bars that are added by Elastic Transcoder, if any, in the offset calculation.
-	HorizontalOffset *string `type:"string"`
+	HorizontalOffset *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// A unique identifier for the settings for one watermark. The value of Id can
 	// be up to 40 characters long.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" min:"1" max:"40"`
 
 	// The maximum height of the watermark in one of the following formats:  number
 	// of pixels (px): The minimum value is 16 pixels, and the maximum value is
@@ -2727,7 +3338,7 @@ type PresetWatermark struct {
 	// Transcoder to include the black bars that are added by Elastic Transcoder,
 	// if any, in the calculation.  If you specify the value in pixels, it must
 	// be less than or equal to the value of MaxHeight.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// The maximum width of the watermark in one of the following formats:  number
 	// of pixels (px): The minimum value is 16 pixels, and the maximum value is
@@ -2736,7 +3347,7 @@ type PresetWatermark struct {
 	// Transcoder to include the black bars that are added by Elastic Transcoder,
 	// if any, in the calculation. If you specify the value in pixels, it must be
 	// less than or equal to the value of MaxWidth.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// A percentage that indicates how much you want a watermark to obscure the
 	// video in the location where it appears. Valid values are 0 (the watermark
@@ -2746,7 +3357,7 @@ type PresetWatermark struct {
 	// Elastic Transcoder supports transparent .png graphics. If you use a transparent
 	// .png, the transparent portion of the video appears as if you had specified
 	// a value of 0 for Opacity. The .jpg file format doesn't support transparency.
-	Opacity *string `type:"string"`
+	Opacity *string `type:"string" pattern:"^\\d{1,3}(\\.\\d{0,20})?$"`
 
 	// A value that controls scaling of the watermark:   Fit: Elastic Transcoder
 	// scales the watermark so it matches the value that you specified in either
@@ -2758,7 +3369,7 @@ type PresetWatermark struct {
 	// so that its dimensions match the values that you specified for at least one
 	// of MaxWidth and MaxHeight without exceeding either value. If you specify
 	// this option, Elastic Transcoder does not scale the watermark up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Stretch$)|(^ShrinkToFit$)"`
 
 	// A value that determines how Elastic Transcoder interprets values that you
 	// specified for HorizontalOffset, VerticalOffset, MaxWidth, and MaxHeight:
@@ -2771,14 +3382,14 @@ type PresetWatermark struct {
 	// added by Elastic Transcoder, if any. In addition, MaxWidth and MaxHeight,
 	// if specified as a percentage, are calculated based on the borders of the
 	// video including black bars added by Elastic Transcoder, if any.
-	Target *string `type:"string"`
+	Target *string `type:"string" pattern:"(^Content$)|(^Frame$)"`
 
 	// The vertical position of the watermark unless you specify a non-zero value
 	// for VerticalOffset:   Top: The top edge of the watermark is aligned with
 	// the top border of the video.  Bottom: The bottom edge of the watermark is
 	// aligned with the bottom border of the video.  Center: The watermark is centered
 	// between the top and bottom borders.
-	VerticalAlign *string `type:"string"`
+	VerticalAlign *string `type:"string" pattern:"(^Top$)|(^Bottom$)|(^Center$)"`
 
 	// VerticalOffset The amount by which you want the vertical position of the
 	// watermark to be offset from the position specified by VerticalAlign: number
@@ -2799,7 +3410,7 @@ type PresetWatermark struct {
 	// Use the value of Target to specify whether you want Elastic Transcoder to
 	// include the black bars that are added by Elastic Transcoder, if any, in the
 	// offset calculation.
-	VerticalOffset *string `type:"string"`
+	VerticalOffset *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	metadataPresetWatermark `json:"-" xml:"-"`
 }
@@ -2808,10 +3419,20 @@ type metadataPresetWatermark struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PresetWatermark) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PresetWatermark) GoString() string {
+	return s.String()
+}
+
 // The ReadJobRequest structure.
 type ReadJobInput struct {
 	// The identifier of the job for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadJobInput `json:"-" xml:"-"`
 }
@@ -2820,6 +3441,16 @@ type metadataReadJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReadJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReadJobInput) GoString() string {
+	return s.String()
+}
+
 // The ReadJobResponse structure.
 type ReadJobOutput struct {
 	// A section of the response body that provides information about the job.
@@ -2832,10 +3463,20 @@ type metadataReadJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReadJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReadJobOutput) GoString() string {
+	return s.String()
+}
+
 // The ReadPipelineRequest structure.
 type ReadPipelineInput struct {
 	// The identifier of the pipeline to read.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadPipelineInput `json:"-" xml:"-"`
 }
@@ -2844,6 +3485,16 @@ type metadataReadPipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReadPipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReadPipelineInput) GoString() string {
+	return s.String()
+}
+
 // The ReadPipelineResponse structure.
 type ReadPipelineOutput struct {
 	// A section of the response body that provides information about the pipeline.
@@ -2864,10 +3515,20 @@ type metadataReadPipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReadPipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReadPipelineOutput) GoString() string {
+	return s.String()
+}
+
 // The ReadPresetRequest structure.
 type ReadPresetInput struct {
 	// The identifier of the preset for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadPresetInput `json:"-" xml:"-"`
 }
@@ -2876,6 +3537,16 @@ type metadataReadPresetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReadPresetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReadPresetInput) GoString() string {
+	return s.String()
+}
+
 // The ReadPresetResponse structure.
 type ReadPresetOutput struct {
 	// A section of the response body that provides information about the preset.
@@ -2888,23 +3559,33 @@ type metadataReadPresetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReadPresetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReadPresetOutput) GoString() string {
+	return s.String()
+}
+
 // The TestRoleRequest structure.
 type TestRoleInput struct {
 	// The Amazon S3 bucket that contains media files to be transcoded. The action
 	// attempts to read from this bucket.
-	InputBucket *string `type:"string" required:"true"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The Amazon S3 bucket that Elastic Transcoder will write transcoded media
 	// files to. The action attempts to read from this bucket.
-	OutputBucket *string `type:"string" required:"true"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to test.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$" required:"true"`
 
 	// The ARNs of one or more Amazon Simple Notification Service (Amazon SNS) topics
 	// that you want the action to send a test notification to.
-	Topics []*string `type:"list" required:"true"`
+	Topics []*string `type:"list" max:"30" required:"true"`
 
 	metadataTestRoleInput `json:"-" xml:"-"`
 }
@@ -2913,6 +3594,16 @@ type metadataTestRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TestRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TestRoleInput) GoString() string {
+	return s.String()
+}
+
 // The TestRoleResponse structure.
 type TestRoleOutput struct {
 	// If the Success element contains false, this value is an array of one or more
@@ -2921,7 +3612,7 @@ type TestRoleOutput struct {
 
 	// If the operation is successful, this value is true; otherwise, the value
 	// is false.
-	Success *string `type:"string"`
+	Success *string `type:"string" pattern:"(^true$)|(^false$)"`
 
 	metadataTestRoleOutput `json:"-" xml:"-"`
 }
@@ -2930,6 +3621,16 @@ type metadataTestRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TestRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TestRoleOutput) GoString() string {
+	return s.String()
+}
+
 // Thumbnails for videos.
 type Thumbnails struct {
 	// To better control resolution and aspect ratio of thumbnails, we recommend
@@ -2943,33 +3644,33 @@ type Thumbnails struct {
 	//
 	// If you specify auto, Elastic Transcoder tries to preserve the aspect ratio
 	// of the video in the output file.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The format of thumbnails, if any. Valid values are jpg and png.
 	//
 	// You specify whether you want Elastic Transcoder to create thumbnails when
 	// you create a job.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^jpg$)|(^png$)"`
 
 	// The approximate number of seconds between thumbnails. Specify an integer
 	// value.
-	Interval *string `type:"string"`
+	Interval *string `type:"string" pattern:"^\\d{1,5}$"`
 
 	// The maximum height of thumbnails in pixels. If you specify auto, Elastic
 	// Transcoder uses 1080 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 3072.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of thumbnails in pixels. If you specify auto, Elastic Transcoder
 	// uses 1920 (Full HD) as the default value. If you specify a numeric value,
 	// enter an even integer between 32 and 4096.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add black bars
 	// to the top and bottom and/or left and right sides of thumbnails to make the
 	// total size of the thumbnails match the values that you specified for thumbnail
 	// MaxWidth and MaxHeight settings.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// To better control resolution and aspect ratio of thumbnails, we recommend
 	// that you use the values MaxWidth, MaxHeight, SizingPolicy, and PaddingPolicy
@@ -2979,7 +3680,7 @@ type Thumbnails struct {
 	//  The width and height of thumbnail files in pixels. Specify a value in the
 	// format width x height where both values are even integers. The values cannot
 	// exceed the width and height that you specified in the Video:Resolution object.
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"^\\d{1,5}x\\d{1,5}$"`
 
 	// Specify one of the following values to control scaling of thumbnails:
 	//
@@ -3003,7 +3704,7 @@ type Thumbnails struct {
 	// the values that you specified for at least one of MaxWidth and MaxHeight
 	// without dropping below either value. If you specify this option, Elastic
 	// Transcoder does not scale thumbnails up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	metadataThumbnails `json:"-" xml:"-"`
 }
@@ -3012,6 +3713,16 @@ type metadataThumbnails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Thumbnails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Thumbnails) GoString() string {
+	return s.String()
+}
+
 // Settings that determine when a clip begins and how long it lasts.
 type TimeSpan struct {
 	// The duration of the clip. The format can be either HH:mm:ss.SSS (maximum
@@ -3021,13 +3732,13 @@ type TimeSpan struct {
 	//
 	// If you specify a value longer than the duration of the input file, Elastic
 	// Transcoder transcodes the file and returns a warning message.
-	Duration *string `type:"string"`
+	Duration *string `type:"string" pattern:"(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	// The place in the input file where you want a clip to start. The format can
 	// be either HH:mm:ss.SSS (maximum value: 23:59:59.999; SSS is thousandths of
 	// a second) or sssss.SSS (maximum value: 86399.999). If you don't specify a
 	// value, Elastic Transcoder starts at the beginning of the input file.
-	StartTime *string `type:"string"`
+	StartTime *string `type:"string" pattern:"(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	metadataTimeSpan `json:"-" xml:"-"`
 }
@@ -3036,6 +3747,16 @@ type metadataTimeSpan struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TimeSpan) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TimeSpan) GoString() string {
+	return s.String()
+}
+
 // Details about the timing of a job.
 type Timing struct {
 	// The time the job finished transcoding, in epoch milliseconds.
@@ -3054,6 +3775,16 @@ type metadataTiming struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Timing) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Timing) GoString() string {
+	return s.String()
+}
+
 // The UpdatePipelineRequest structure.
 type UpdatePipelineInput struct {
 	// The AWS Key Management Service (AWS KMS) key that you want to use with this
@@ -3064,7 +3795,7 @@ type UpdatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" min:"0" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -3114,17 +3845,17 @@ type UpdatePipelineInput struct {
 	ContentConfig *PipelineOutputConfig `type:"structure"`
 
 	// The ID of the pipeline that you want to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The Amazon S3 bucket in which you saved the media files that you want to
 	// transcode and the graphics that you want to use as watermarks.
-	InputBucket *string `type:"string"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic or topics to notify
 	// in order to report job status.
@@ -3135,7 +3866,7 @@ type UpdatePipelineInput struct {
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to use to transcode jobs for this pipeline.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$"`
 
 	// The ThumbnailConfig object specifies several values, including the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save thumbnail files, which
@@ -3185,11 +3916,21 @@ type metadataUpdatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdatePipelineInput) GoString() string {
+	return s.String()
+}
+
 // The UpdatePipelineNotificationsRequest structure.
 type UpdatePipelineNotificationsInput struct {
 	// The identifier of the pipeline for which you want to change notification
 	// settings.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The topic ARN for the Amazon Simple Notification Service (Amazon SNS) topic
 	// that you want to notify to report job status.
@@ -3216,6 +3957,16 @@ type metadataUpdatePipelineNotificationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdatePipelineNotificationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdatePipelineNotificationsInput) GoString() string {
+	return s.String()
+}
+
 // The UpdatePipelineNotificationsResponse structure.
 type UpdatePipelineNotificationsOutput struct {
 	// A section of the response body that provides information about the pipeline.
@@ -3228,6 +3979,16 @@ type metadataUpdatePipelineNotificationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdatePipelineNotificationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdatePipelineNotificationsOutput) GoString() string {
+	return s.String()
+}
+
 // When you update a pipeline, Elastic Transcoder returns the values that you
 // specified in the request.
 type UpdatePipelineOutput struct {
@@ -3249,16 +4010,26 @@ type metadataUpdatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdatePipelineOutput) GoString() string {
+	return s.String()
+}
+
 // The UpdatePipelineStatusRequest structure.
 type UpdatePipelineStatusInput struct {
 	// The identifier of the pipeline to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The desired status of the pipeline:
 	//
 	//   Active: The pipeline is processing jobs.  Paused: The pipeline is not
 	// currently processing jobs.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"(^Active$)|(^Paused$)" required:"true"`
 
 	metadataUpdatePipelineStatusInput `json:"-" xml:"-"`
 }
@@ -3267,6 +4038,16 @@ type metadataUpdatePipelineStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdatePipelineStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdatePipelineStatusInput) GoString() string {
+	return s.String()
+}
+
 // When you update status for a pipeline, Elastic Transcoder returns the values
 // that you specified in the request.
 type UpdatePipelineStatusOutput struct {
@@ -3280,6 +4061,16 @@ type metadataUpdatePipelineStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdatePipelineStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdatePipelineStatusOutput) GoString() string {
+	return s.String()
+}
+
 // The VideoParameters structure.
 type VideoParameters struct {
 	// To better control resolution and aspect ratio of output videos, we recommend
@@ -3299,7 +4090,7 @@ type VideoParameters struct {
 	// ratio of the input file, Elastic Transcoder adds pillarboxing (black bars
 	// on the sides) or letterboxing (black bars on the top and bottom) to maintain
 	// the aspect ratio of the active region of the video.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The bit rate of the video stream in the output file, in kilobits/second.
 	// Valid values depend on the values of Level and Profile. If you specify auto,
@@ -3314,12 +4105,12 @@ type VideoParameters struct {
 	//  1 - 64 : 80 1b - 128 : 160 1.1 - 192 : 240 1.2 - 384 : 480 1.3 - 768 :
 	// 960 2 - 2000 : 2500 3 - 10000 : 12500 3.1 - 14000 : 17500 3.2 - 20000 : 25000
 	// 4 - 20000 : 25000 4.1 - 50000 : 62500
-	BitRate *string `type:"string"`
+	BitRate *string `type:"string" pattern:"(^\\d{2,5}$)|(^auto$)"`
 
 	// The video codec for the output file. Valid values include gif, H.264, mpeg2,
 	// and vp8. You can only specify vp8 when the container type is webm, gif when
 	// the container type is gif, and mpeg2 when the container type is mpg.
-	Codec *string `type:"string"`
+	Codec *string `type:"string" pattern:"(^H\\.264$)|(^vp8$)|(^mpeg2$)|(^gif$)"`
 
 	// Profile (H.264/VP8 Only)
 	//
@@ -3423,10 +4214,10 @@ type VideoParameters struct {
 	//
 	// The number of times you want the output gif to loop. Valid values include
 	// Infinite and integers between 0 and 100, inclusive.
-	CodecOptions map[string]*string `type:"map"`
+	CodecOptions map[string]*string `type:"map" max:"30"`
 
 	// The value that Elastic Transcoder adds to the metadata in the output file.
-	DisplayAspectRatio *string `type:"string"`
+	DisplayAspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// Applicable only when the value of Video:Codec is one of H.264, MPEG2, or
 	// VP8.
@@ -3437,7 +4228,7 @@ type VideoParameters struct {
 	// between key frames (the number of frames in a group of pictures, or GOP).
 	//  false: The distance between key frames can vary.  FixedGOP must be set to
 	// true for fmp4 containers.
-	FixedGOP *string `type:"string"`
+	FixedGOP *string `type:"string" pattern:"(^true$)|(^false$)"`
 
 	// The frames per second for the video stream in the output file. Valid values
 	// include:
@@ -3463,7 +4254,7 @@ type VideoParameters struct {
 	//  1 - 380160 1b - 380160 1.1 - 76800 1.2 - 1536000 1.3 - 3041280 2 - 3041280
 	// 2.1 - 5068800 2.2 - 5184000 3 - 10368000 3.1 - 27648000 3.2 - 55296000 4
 	// - 62914560 4.1 - 62914560
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// Applicable only when the value of Video:Codec is one of H.264, MPEG2, or
 	// VP8.
@@ -3486,30 +4277,30 @@ type VideoParameters struct {
 	//
 	// Alternately, this can be achieved by setting FrameRate to auto and having
 	// the same values for MaxFrameRate and KeyframesMaxDist.
-	KeyframesMaxDist *string `type:"string"`
+	KeyframesMaxDist *string `type:"string" pattern:"^\\d{1,6}$"`
 
 	// If you specify auto for FrameRate, Elastic Transcoder uses the frame rate
 	// of the input video for the frame rate of the output video. Specify the maximum
 	// frame rate that you want Elastic Transcoder to use when the frame rate of
 	// the input video is greater than the desired maximum frame rate of the output
 	// video. Valid values include: 10, 15, 23.97, 24, 25, 29.97, 30, 60.
-	MaxFrameRate *string `type:"string"`
+	MaxFrameRate *string `type:"string" pattern:"(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// The maximum height of the output video in pixels. If you specify auto, Elastic
 	// Transcoder uses 1080 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 96 and 3072.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of the output video in pixels. If you specify auto, Elastic
 	// Transcoder uses 1920 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 128 and 4096.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add black bars
 	// to the top and bottom and/or left and right sides of the output video to
 	// make the total size of the output video match the values that you specified
 	// for MaxWidth and MaxHeight.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// To better control resolution and aspect ratio of output videos, we recommend
 	// that you use the values MaxWidth, MaxHeight, SizingPolicy, PaddingPolicy,
@@ -3535,7 +4326,7 @@ type VideoParameters struct {
 	// x height value):  1 - 25344 1b - 25344 1.1 - 101376 1.2 - 101376 1.3 - 101376
 	// 2 - 101376 2.1 - 202752 2.2 - 404720 3 - 404720 3.1 - 921600 3.2 - 1310720
 	// 4 - 2097152 4.1 - 2097152
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"(^auto$)|(^\\d{1,5}x\\d{1,5}$)"`
 
 	// Specify one of the following values to control scaling of the output video:
 	//
@@ -3559,7 +4350,7 @@ type VideoParameters struct {
 	// for at least one of MaxWidth and MaxHeight without dropping below either
 	// value. If you specify this option, Elastic Transcoder does not scale the
 	// video up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	// Settings for the size, location, and opacity of graphics that you want Elastic
 	// Transcoder to overlay over videos that are transcoded using this preset.
@@ -3584,6 +4375,16 @@ type metadataVideoParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VideoParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VideoParameters) GoString() string {
+	return s.String()
+}
+
 // Elastic Transcoder returns a warning if the resources used by your pipeline
 // are not in the same region as the pipeline.
 //
@@ -3606,3 +4407,13 @@ type Warning struct {
 type metadataWarning struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s Warning) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Warning) GoString() string {
+	return s.String()
+}
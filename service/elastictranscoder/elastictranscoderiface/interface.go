@@ -4,6 +4,7 @@
 package elastictranscoderiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elastictranscoder"
 )
 
@@ -11,35 +12,71 @@ import (
 type ElasticTranscoderAPI interface {
 	CancelJob(*elastictranscoder.CancelJobInput) (*elastictranscoder.CancelJobOutput, error)
 
+	CancelJobWithContext(aws.Context, *elastictranscoder.CancelJobInput) (*elastictranscoder.CancelJobOutput, error)
+
 	CreateJob(*elastictranscoder.CreateJobInput) (*elastictranscoder.CreateJobResponse, error)
 
+	CreateJobWithContext(aws.Context, *elastictranscoder.CreateJobInput) (*elastictranscoder.CreateJobResponse, error)
+
 	CreatePipeline(*elastictranscoder.CreatePipelineInput) (*elastictranscoder.CreatePipelineOutput, error)
 
+	CreatePipelineWithContext(aws.Context, *elastictranscoder.CreatePipelineInput) (*elastictranscoder.CreatePipelineOutput, error)
+
 	CreatePreset(*elastictranscoder.CreatePresetInput) (*elastictranscoder.CreatePresetOutput, error)
 
+	CreatePresetWithContext(aws.Context, *elastictranscoder.CreatePresetInput) (*elastictranscoder.CreatePresetOutput, error)
+
 	DeletePipeline(*elastictranscoder.DeletePipelineInput) (*elastictranscoder.DeletePipelineOutput, error)
 
+	DeletePipelineWithContext(aws.Context, *elastictranscoder.DeletePipelineInput) (*elastictranscoder.DeletePipelineOutput, error)
+
 	DeletePreset(*elastictranscoder.DeletePresetInput) (*elastictranscoder.DeletePresetOutput, error)
 
+	DeletePresetWithContext(aws.Context, *elastictranscoder.DeletePresetInput) (*elastictranscoder.DeletePresetOutput, error)
+
 	ListJobsByPipeline(*elastictranscoder.ListJobsByPipelineInput) (*elastictranscoder.ListJobsByPipelineOutput, error)
 
+	ListJobsByPipelineWithContext(aws.Context, *elastictranscoder.ListJobsByPipelineInput) (*elastictranscoder.ListJobsByPipelineOutput, error)
+
 	ListJobsByStatus(*elastictranscoder.ListJobsByStatusInput) (*elastictranscoder.ListJobsByStatusOutput, error)
 
+	ListJobsByStatusWithContext(aws.Context, *elastictranscoder.ListJobsByStatusInput) (*elastictranscoder.ListJobsByStatusOutput, error)
+
 	ListPipelines(*elastictranscoder.ListPipelinesInput) (*elastictranscoder.ListPipelinesOutput, error)
 
+	ListPipelinesWithContext(aws.Context, *elastictranscoder.ListPipelinesInput) (*elastictranscoder.ListPipelinesOutput, error)
+
 	ListPresets(*elastictranscoder.ListPresetsInput) (*elastictranscoder.ListPresetsOutput, error)
 
+	ListPresetsWithContext(aws.Context, *elastictranscoder.ListPresetsInput) (*elastictranscoder.ListPresetsOutput, error)
+
 	ReadJob(*elastictranscoder.ReadJobInput) (*elastictranscoder.ReadJobOutput, error)
 
+	ReadJobWithContext(aws.Context, *elastictranscoder.ReadJobInput) (*elastictranscoder.ReadJobOutput, error)
+
 	ReadPipeline(*elastictranscoder.ReadPipelineInput) (*elastictranscoder.ReadPipelineOutput, error)
 
+	ReadPipelineWithContext(aws.Context, *elastictranscoder.ReadPipelineInput) (*elastictranscoder.ReadPipelineOutput, error)
+
 	ReadPreset(*elastictranscoder.ReadPresetInput) (*elastictranscoder.ReadPresetOutput, error)
 
+	ReadPresetWithContext(aws.Context, *elastictranscoder.ReadPresetInput) (*elastictranscoder.ReadPresetOutput, error)
+
 	TestRole(*elastictranscoder.TestRoleInput) (*elastictranscoder.TestRoleOutput, error)
 
+	TestRoleWithContext(aws.Context, *elastictranscoder.TestRoleInput) (*elastictranscoder.TestRoleOutput, error)
+
 	UpdatePipeline(*elastictranscoder.UpdatePipelineInput) (*elastictranscoder.UpdatePipelineOutput, error)
 
+	UpdatePipelineWithContext(aws.Context, *elastictranscoder.UpdatePipelineInput) (*elastictranscoder.UpdatePipelineOutput, error)
+
 	UpdatePipelineNotifications(*elastictranscoder.UpdatePipelineNotificationsInput) (*elastictranscoder.UpdatePipelineNotificationsOutput, error)
 
+	UpdatePipelineNotificationsWithContext(aws.Context, *elastictranscoder.UpdatePipelineNotificationsInput) (*elastictranscoder.UpdatePipelineNotificationsOutput, error)
+
 	UpdatePipelineStatus(*elastictranscoder.UpdatePipelineStatusInput) (*elastictranscoder.UpdatePipelineStatusOutput, error)
+
+	UpdatePipelineStatusWithContext(aws.Context, *elastictranscoder.UpdatePipelineStatusInput) (*elastictranscoder.UpdatePipelineStatusOutput, error)
+
+	WaitUntilJobComplete(*elastictranscoder.ReadJobInput) error
 }
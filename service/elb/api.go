@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddTags = "AddTags"
@@ -43,6 +44,16 @@ func (c *ELB) AddTags(input *AddTagsInput) (*AddTagsOutput, error) {
 	return out, err
 }
 
+// AddTagsWithContext is the same as AddTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) AddTagsWithContext(ctx aws.Context, input *AddTagsInput) (*AddTagsOutput, error) {
+	req, out := c.AddTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opApplySecurityGroupsToLoadBalancer = "ApplySecurityGroupsToLoadBalancer"
 
 // ApplySecurityGroupsToLoadBalancerRequest generates a request for the ApplySecurityGroupsToLoadBalancer operation.
@@ -75,6 +86,16 @@ func (c *ELB) ApplySecurityGroupsToLoadBalancer(input *ApplySecurityGroupsToLoad
 	return out, err
 }
 
+// ApplySecurityGroupsToLoadBalancerWithContext is the same as ApplySecurityGroupsToLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) ApplySecurityGroupsToLoadBalancerWithContext(ctx aws.Context, input *ApplySecurityGroupsToLoadBalancerInput) (*ApplySecurityGroupsToLoadBalancerOutput, error) {
+	req, out := c.ApplySecurityGroupsToLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachLoadBalancerToSubnets = "AttachLoadBalancerToSubnets"
 
 // AttachLoadBalancerToSubnetsRequest generates a request for the AttachLoadBalancerToSubnets operation.
@@ -108,6 +129,16 @@ func (c *ELB) AttachLoadBalancerToSubnets(input *AttachLoadBalancerToSubnetsInpu
 	return out, err
 }
 
+// AttachLoadBalancerToSubnetsWithContext is the same as AttachLoadBalancerToSubnets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) AttachLoadBalancerToSubnetsWithContext(ctx aws.Context, input *AttachLoadBalancerToSubnetsInput) (*AttachLoadBalancerToSubnetsOutput, error) {
+	req, out := c.AttachLoadBalancerToSubnetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opConfigureHealthCheck = "ConfigureHealthCheck"
 
 // ConfigureHealthCheckRequest generates a request for the ConfigureHealthCheck operation.
@@ -139,6 +170,16 @@ func (c *ELB) ConfigureHealthCheck(input *ConfigureHealthCheckInput) (*Configure
 	return out, err
 }
 
+// ConfigureHealthCheckWithContext is the same as ConfigureHealthCheck with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) ConfigureHealthCheckWithContext(ctx aws.Context, input *ConfigureHealthCheckInput) (*ConfigureHealthCheckOutput, error) {
+	req, out := c.ConfigureHealthCheckRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAppCookieStickinessPolicy = "CreateAppCookieStickinessPolicy"
 
 // CreateAppCookieStickinessPolicyRequest generates a request for the CreateAppCookieStickinessPolicy operation.
@@ -180,6 +221,16 @@ func (c *ELB) CreateAppCookieStickinessPolicy(input *CreateAppCookieStickinessPo
 	return out, err
 }
 
+// CreateAppCookieStickinessPolicyWithContext is the same as CreateAppCookieStickinessPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) CreateAppCookieStickinessPolicyWithContext(ctx aws.Context, input *CreateAppCookieStickinessPolicyInput) (*CreateAppCookieStickinessPolicyOutput, error) {
+	req, out := c.CreateAppCookieStickinessPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLBCookieStickinessPolicy = "CreateLBCookieStickinessPolicy"
 
 // CreateLBCookieStickinessPolicyRequest generates a request for the CreateLBCookieStickinessPolicy operation.
@@ -223,6 +274,16 @@ func (c *ELB) CreateLBCookieStickinessPolicy(input *CreateLBCookieStickinessPoli
 	return out, err
 }
 
+// CreateLBCookieStickinessPolicyWithContext is the same as CreateLBCookieStickinessPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) CreateLBCookieStickinessPolicyWithContext(ctx aws.Context, input *CreateLBCookieStickinessPolicyInput) (*CreateLBCookieStickinessPolicyOutput, error) {
+	req, out := c.CreateLBCookieStickinessPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLoadBalancer = "CreateLoadBalancer"
 
 // CreateLoadBalancerRequest generates a request for the CreateLoadBalancer operation.
@@ -261,6 +322,16 @@ func (c *ELB) CreateLoadBalancer(input *CreateLoadBalancerInput) (*CreateLoadBal
 	return out, err
 }
 
+// CreateLoadBalancerWithContext is the same as CreateLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) CreateLoadBalancerWithContext(ctx aws.Context, input *CreateLoadBalancerInput) (*CreateLoadBalancerOutput, error) {
+	req, out := c.CreateLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLoadBalancerListeners = "CreateLoadBalancerListeners"
 
 // CreateLoadBalancerListenersRequest generates a request for the CreateLoadBalancerListeners operation.
@@ -294,6 +365,16 @@ func (c *ELB) CreateLoadBalancerListeners(input *CreateLoadBalancerListenersInpu
 	return out, err
 }
 
+// CreateLoadBalancerListenersWithContext is the same as CreateLoadBalancerListeners with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) CreateLoadBalancerListenersWithContext(ctx aws.Context, input *CreateLoadBalancerListenersInput) (*CreateLoadBalancerListenersOutput, error) {
+	req, out := c.CreateLoadBalancerListenersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLoadBalancerPolicy = "CreateLoadBalancerPolicy"
 
 // CreateLoadBalancerPolicyRequest generates a request for the CreateLoadBalancerPolicy operation.
@@ -325,6 +406,16 @@ func (c *ELB) CreateLoadBalancerPolicy(input *CreateLoadBalancerPolicyInput) (*C
 	return out, err
 }
 
+// CreateLoadBalancerPolicyWithContext is the same as CreateLoadBalancerPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) CreateLoadBalancerPolicyWithContext(ctx aws.Context, input *CreateLoadBalancerPolicyInput) (*CreateLoadBalancerPolicyOutput, error) {
+	req, out := c.CreateLoadBalancerPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLoadBalancer = "DeleteLoadBalancer"
 
 // DeleteLoadBalancerRequest generates a request for the DeleteLoadBalancer operation.
@@ -361,6 +452,16 @@ func (c *ELB) DeleteLoadBalancer(input *DeleteLoadBalancerInput) (*DeleteLoadBal
 	return out, err
 }
 
+// DeleteLoadBalancerWithContext is the same as DeleteLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DeleteLoadBalancerWithContext(ctx aws.Context, input *DeleteLoadBalancerInput) (*DeleteLoadBalancerOutput, error) {
+	req, out := c.DeleteLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLoadBalancerListeners = "DeleteLoadBalancerListeners"
 
 // DeleteLoadBalancerListenersRequest generates a request for the DeleteLoadBalancerListeners operation.
@@ -388,6 +489,16 @@ func (c *ELB) DeleteLoadBalancerListeners(input *DeleteLoadBalancerListenersInpu
 	return out, err
 }
 
+// DeleteLoadBalancerListenersWithContext is the same as DeleteLoadBalancerListeners with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DeleteLoadBalancerListenersWithContext(ctx aws.Context, input *DeleteLoadBalancerListenersInput) (*DeleteLoadBalancerListenersOutput, error) {
+	req, out := c.DeleteLoadBalancerListenersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLoadBalancerPolicy = "DeleteLoadBalancerPolicy"
 
 // DeleteLoadBalancerPolicyRequest generates a request for the DeleteLoadBalancerPolicy operation.
@@ -416,6 +527,16 @@ func (c *ELB) DeleteLoadBalancerPolicy(input *DeleteLoadBalancerPolicyInput) (*D
 	return out, err
 }
 
+// DeleteLoadBalancerPolicyWithContext is the same as DeleteLoadBalancerPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DeleteLoadBalancerPolicyWithContext(ctx aws.Context, input *DeleteLoadBalancerPolicyInput) (*DeleteLoadBalancerPolicyOutput, error) {
+	req, out := c.DeleteLoadBalancerPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterInstancesFromLoadBalancer = "DeregisterInstancesFromLoadBalancer"
 
 // DeregisterInstancesFromLoadBalancerRequest generates a request for the DeregisterInstancesFromLoadBalancer operation.
@@ -451,6 +572,16 @@ func (c *ELB) DeregisterInstancesFromLoadBalancer(input *DeregisterInstancesFrom
 	return out, err
 }
 
+// DeregisterInstancesFromLoadBalancerWithContext is the same as DeregisterInstancesFromLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DeregisterInstancesFromLoadBalancerWithContext(ctx aws.Context, input *DeregisterInstancesFromLoadBalancerInput) (*DeregisterInstancesFromLoadBalancerOutput, error) {
+	req, out := c.DeregisterInstancesFromLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeInstanceHealth = "DescribeInstanceHealth"
 
 // DescribeInstanceHealthRequest generates a request for the DescribeInstanceHealth operation.
@@ -481,6 +612,16 @@ func (c *ELB) DescribeInstanceHealth(input *DescribeInstanceHealthInput) (*Descr
 	return out, err
 }
 
+// DescribeInstanceHealthWithContext is the same as DescribeInstanceHealth with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DescribeInstanceHealthWithContext(ctx aws.Context, input *DescribeInstanceHealthInput) (*DescribeInstanceHealthOutput, error) {
+	req, out := c.DescribeInstanceHealthRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLoadBalancerAttributes = "DescribeLoadBalancerAttributes"
 
 // DescribeLoadBalancerAttributesRequest generates a request for the DescribeLoadBalancerAttributes operation.
@@ -508,6 +649,16 @@ func (c *ELB) DescribeLoadBalancerAttributes(input *DescribeLoadBalancerAttribut
 	return out, err
 }
 
+// DescribeLoadBalancerAttributesWithContext is the same as DescribeLoadBalancerAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DescribeLoadBalancerAttributesWithContext(ctx aws.Context, input *DescribeLoadBalancerAttributesInput) (*DescribeLoadBalancerAttributesOutput, error) {
+	req, out := c.DescribeLoadBalancerAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLoadBalancerPolicies = "DescribeLoadBalancerPolicies"
 
 // DescribeLoadBalancerPoliciesRequest generates a request for the DescribeLoadBalancerPolicies operation.
@@ -542,6 +693,16 @@ func (c *ELB) DescribeLoadBalancerPolicies(input *DescribeLoadBalancerPoliciesIn
 	return out, err
 }
 
+// DescribeLoadBalancerPoliciesWithContext is the same as DescribeLoadBalancerPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DescribeLoadBalancerPoliciesWithContext(ctx aws.Context, input *DescribeLoadBalancerPoliciesInput) (*DescribeLoadBalancerPoliciesOutput, error) {
+	req, out := c.DescribeLoadBalancerPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLoadBalancerPolicyTypes = "DescribeLoadBalancerPolicyTypes"
 
 // DescribeLoadBalancerPolicyTypesRequest generates a request for the DescribeLoadBalancerPolicyTypes operation.
@@ -572,6 +733,16 @@ func (c *ELB) DescribeLoadBalancerPolicyTypes(input *DescribeLoadBalancerPolicyT
 	return out, err
 }
 
+// DescribeLoadBalancerPolicyTypesWithContext is the same as DescribeLoadBalancerPolicyTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DescribeLoadBalancerPolicyTypesWithContext(ctx aws.Context, input *DescribeLoadBalancerPolicyTypesInput) (*DescribeLoadBalancerPolicyTypesOutput, error) {
+	req, out := c.DescribeLoadBalancerPolicyTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLoadBalancers = "DescribeLoadBalancers"
 
 // DescribeLoadBalancersRequest generates a request for the DescribeLoadBalancers operation.
@@ -606,6 +777,16 @@ func (c *ELB) DescribeLoadBalancers(input *DescribeLoadBalancersInput) (*Describ
 	return out, err
 }
 
+// DescribeLoadBalancersWithContext is the same as DescribeLoadBalancers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DescribeLoadBalancersWithContext(ctx aws.Context, input *DescribeLoadBalancersInput) (*DescribeLoadBalancersOutput, error) {
+	req, out := c.DescribeLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *ELB) DescribeLoadBalancersPages(input *DescribeLoadBalancersInput, fn func(p *DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeLoadBalancersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -640,6 +821,16 @@ func (c *ELB) DescribeTags(input *DescribeTagsInput) (*DescribeTagsOutput, error
 	return out, err
 }
 
+// DescribeTagsWithContext is the same as DescribeTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DescribeTagsWithContext(ctx aws.Context, input *DescribeTagsInput) (*DescribeTagsOutput, error) {
+	req, out := c.DescribeTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachLoadBalancerFromSubnets = "DetachLoadBalancerFromSubnets"
 
 // DetachLoadBalancerFromSubnetsRequest generates a request for the DetachLoadBalancerFromSubnets operation.
@@ -672,6 +863,16 @@ func (c *ELB) DetachLoadBalancerFromSubnets(input *DetachLoadBalancerFromSubnets
 	return out, err
 }
 
+// DetachLoadBalancerFromSubnetsWithContext is the same as DetachLoadBalancerFromSubnets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DetachLoadBalancerFromSubnetsWithContext(ctx aws.Context, input *DetachLoadBalancerFromSubnetsInput) (*DetachLoadBalancerFromSubnetsOutput, error) {
+	req, out := c.DetachLoadBalancerFromSubnetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableAvailabilityZonesForLoadBalancer = "DisableAvailabilityZonesForLoadBalancer"
 
 // DisableAvailabilityZonesForLoadBalancerRequest generates a request for the DisableAvailabilityZonesForLoadBalancer operation.
@@ -710,6 +911,16 @@ func (c *ELB) DisableAvailabilityZonesForLoadBalancer(input *DisableAvailability
 	return out, err
 }
 
+// DisableAvailabilityZonesForLoadBalancerWithContext is the same as DisableAvailabilityZonesForLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) DisableAvailabilityZonesForLoadBalancerWithContext(ctx aws.Context, input *DisableAvailabilityZonesForLoadBalancerInput) (*DisableAvailabilityZonesForLoadBalancerOutput, error) {
+	req, out := c.DisableAvailabilityZonesForLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableAvailabilityZonesForLoadBalancer = "EnableAvailabilityZonesForLoadBalancer"
 
 // EnableAvailabilityZonesForLoadBalancerRequest generates a request for the EnableAvailabilityZonesForLoadBalancer operation.
@@ -744,6 +955,16 @@ func (c *ELB) EnableAvailabilityZonesForLoadBalancer(input *EnableAvailabilityZo
 	return out, err
 }
 
+// EnableAvailabilityZonesForLoadBalancerWithContext is the same as EnableAvailabilityZonesForLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) EnableAvailabilityZonesForLoadBalancerWithContext(ctx aws.Context, input *EnableAvailabilityZonesForLoadBalancerInput) (*EnableAvailabilityZonesForLoadBalancerOutput, error) {
+	req, out := c.EnableAvailabilityZonesForLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opModifyLoadBalancerAttributes = "ModifyLoadBalancerAttributes"
 
 // ModifyLoadBalancerAttributesRequest generates a request for the ModifyLoadBalancerAttributes operation.
@@ -784,6 +1005,16 @@ func (c *ELB) ModifyLoadBalancerAttributes(input *ModifyLoadBalancerAttributesIn
 	return out, err
 }
 
+// ModifyLoadBalancerAttributesWithContext is the same as ModifyLoadBalancerAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) ModifyLoadBalancerAttributesWithContext(ctx aws.Context, input *ModifyLoadBalancerAttributesInput) (*ModifyLoadBalancerAttributesOutput, error) {
+	req, out := c.ModifyLoadBalancerAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterInstancesWithLoadBalancer = "RegisterInstancesWithLoadBalancer"
 
 // RegisterInstancesWithLoadBalancerRequest generates a request for the RegisterInstancesWithLoadBalancer operation.
@@ -837,6 +1068,16 @@ func (c *ELB) RegisterInstancesWithLoadBalancer(input *RegisterInstancesWithLoad
 	return out, err
 }
 
+// RegisterInstancesWithLoadBalancerWithContext is the same as RegisterInstancesWithLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) RegisterInstancesWithLoadBalancerWithContext(ctx aws.Context, input *RegisterInstancesWithLoadBalancerInput) (*RegisterInstancesWithLoadBalancerOutput, error) {
+	req, out := c.RegisterInstancesWithLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveTags = "RemoveTags"
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -864,6 +1105,16 @@ func (c *ELB) RemoveTags(input *RemoveTagsInput) (*RemoveTagsOutput, error) {
 	return out, err
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) RemoveTagsWithContext(ctx aws.Context, input *RemoveTagsInput) (*RemoveTagsOutput, error) {
+	req, out := c.RemoveTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetLoadBalancerListenerSSLCertificate = "SetLoadBalancerListenerSSLCertificate"
 
 // SetLoadBalancerListenerSSLCertificateRequest generates a request for the SetLoadBalancerListenerSSLCertificate operation.
@@ -897,6 +1148,16 @@ func (c *ELB) SetLoadBalancerListenerSSLCertificate(input *SetLoadBalancerListen
 	return out, err
 }
 
+// SetLoadBalancerListenerSSLCertificateWithContext is the same as SetLoadBalancerListenerSSLCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) SetLoadBalancerListenerSSLCertificateWithContext(ctx aws.Context, input *SetLoadBalancerListenerSSLCertificateInput) (*SetLoadBalancerListenerSSLCertificateOutput, error) {
+	req, out := c.SetLoadBalancerListenerSSLCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetLoadBalancerPoliciesForBackendServer = "SetLoadBalancerPoliciesForBackendServer"
 
 // SetLoadBalancerPoliciesForBackendServerRequest generates a request for the SetLoadBalancerPoliciesForBackendServer operation.
@@ -934,6 +1195,16 @@ func (c *ELB) SetLoadBalancerPoliciesForBackendServer(input *SetLoadBalancerPoli
 	return out, err
 }
 
+// SetLoadBalancerPoliciesForBackendServerWithContext is the same as SetLoadBalancerPoliciesForBackendServer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) SetLoadBalancerPoliciesForBackendServerWithContext(ctx aws.Context, input *SetLoadBalancerPoliciesForBackendServerInput) (*SetLoadBalancerPoliciesForBackendServerOutput, error) {
+	req, out := c.SetLoadBalancerPoliciesForBackendServerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetLoadBalancerPoliciesOfListener = "SetLoadBalancerPoliciesOfListener"
 
 // SetLoadBalancerPoliciesOfListenerRequest generates a request for the SetLoadBalancerPoliciesOfListener operation.
@@ -962,6 +1233,16 @@ func (c *ELB) SetLoadBalancerPoliciesOfListener(input *SetLoadBalancerPoliciesOf
 	return out, err
 }
 
+// SetLoadBalancerPoliciesOfListenerWithContext is the same as SetLoadBalancerPoliciesOfListener with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ELB) SetLoadBalancerPoliciesOfListenerWithContext(ctx aws.Context, input *SetLoadBalancerPoliciesOfListenerInput) (*SetLoadBalancerPoliciesOfListenerOutput, error) {
+	req, out := c.SetLoadBalancerPoliciesOfListenerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Information about the AccessLog attribute.
 type AccessLog struct {
 	// The interval for publishing the access logs. You can specify an interval
@@ -988,12 +1269,22 @@ type metadataAccessLog struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AccessLog) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AccessLog) GoString() string {
+	return s.String()
+}
+
 type AddTagsInput struct {
 	// The name of the load balancer. You can specify one load balancer only.
 	LoadBalancerNames []*string `type:"list" required:"true"`
 
 	// The tags.
-	Tags []*Tag `type:"list" required:"true"`
+	Tags []*Tag `type:"list" min:"1" required:"true"`
 
 	metadataAddTagsInput `json:"-" xml:"-"`
 }
@@ -1002,6 +1293,16 @@ type metadataAddTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsInput) GoString() string {
+	return s.String()
+}
+
 type AddTagsOutput struct {
 	metadataAddTagsOutput `json:"-" xml:"-"`
 }
@@ -1010,6 +1311,16 @@ type metadataAddTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsOutput) GoString() string {
+	return s.String()
+}
+
 // This data type is reserved.
 type AdditionalAttribute struct {
 	// This parameter is reserved.
@@ -1025,6 +1336,16 @@ type metadataAdditionalAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AdditionalAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AdditionalAttribute) GoString() string {
+	return s.String()
+}
+
 // Information about a policy for application-controlled session stickiness.
 type AppCookieStickinessPolicy struct {
 	// The name of the application cookie used for stickiness.
@@ -1041,6 +1362,16 @@ type metadataAppCookieStickinessPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AppCookieStickinessPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AppCookieStickinessPolicy) GoString() string {
+	return s.String()
+}
+
 type ApplySecurityGroupsToLoadBalancerInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1056,6 +1387,16 @@ type metadataApplySecurityGroupsToLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ApplySecurityGroupsToLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ApplySecurityGroupsToLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type ApplySecurityGroupsToLoadBalancerOutput struct {
 	// The IDs of the security groups associated with the load balancer.
 	SecurityGroups []*string `type:"list"`
@@ -1067,6 +1408,16 @@ type metadataApplySecurityGroupsToLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ApplySecurityGroupsToLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ApplySecurityGroupsToLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 type AttachLoadBalancerToSubnetsInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1082,6 +1433,16 @@ type metadataAttachLoadBalancerToSubnetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachLoadBalancerToSubnetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachLoadBalancerToSubnetsInput) GoString() string {
+	return s.String()
+}
+
 type AttachLoadBalancerToSubnetsOutput struct {
 	// The IDs of the subnets attached to the load balancer.
 	Subnets []*string `type:"list"`
@@ -1093,10 +1454,20 @@ type metadataAttachLoadBalancerToSubnetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachLoadBalancerToSubnetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachLoadBalancerToSubnetsOutput) GoString() string {
+	return s.String()
+}
+
 // Information about the configuration of a back-end server.
 type BackendServerDescription struct {
 	// The port on which the back-end server is listening.
-	InstancePort *int64 `type:"integer"`
+	InstancePort *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The names of the policies enabled for the back-end server.
 	PolicyNames []*string `type:"list"`
@@ -1108,6 +1479,16 @@ type metadataBackendServerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BackendServerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BackendServerDescription) GoString() string {
+	return s.String()
+}
+
 type ConfigureHealthCheckInput struct {
 	// The configuration information for the new health check.
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
@@ -1122,6 +1503,16 @@ type metadataConfigureHealthCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigureHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigureHealthCheckInput) GoString() string {
+	return s.String()
+}
+
 type ConfigureHealthCheckOutput struct {
 	// The updated health check.
 	HealthCheck *HealthCheck `type:"structure"`
@@ -1133,6 +1524,16 @@ type metadataConfigureHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfigureHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfigureHealthCheckOutput) GoString() string {
+	return s.String()
+}
+
 // Information about the ConnectionDraining attribute.
 type ConnectionDraining struct {
 	// Specifies whether connection draining is enabled for the load balancer.
@@ -1149,11 +1550,21 @@ type metadataConnectionDraining struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConnectionDraining) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConnectionDraining) GoString() string {
+	return s.String()
+}
+
 // Information about the ConnectionSettings attribute.
 type ConnectionSettings struct {
 	// The time, in seconds, that the connection is allowed to be idle (no data
 	// has been sent over the connection) before it is closed by the load balancer.
-	IdleTimeout *int64 `type:"integer" required:"true"`
+	IdleTimeout *int64 `type:"integer" min:"1" max:"3600" required:"true"`
 
 	metadataConnectionSettings `json:"-" xml:"-"`
 }
@@ -1162,6 +1573,16 @@ type metadataConnectionSettings struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConnectionSettings) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConnectionSettings) GoString() string {
+	return s.String()
+}
+
 type CreateAppCookieStickinessPolicyInput struct {
 	// The name of the application cookie used for stickiness.
 	CookieName *string `type:"string" required:"true"`
@@ -1180,6 +1601,16 @@ type metadataCreateAppCookieStickinessPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAppCookieStickinessPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAppCookieStickinessPolicyInput) GoString() string {
+	return s.String()
+}
+
 type CreateAppCookieStickinessPolicyOutput struct {
 	metadataCreateAppCookieStickinessPolicyOutput `json:"-" xml:"-"`
 }
@@ -1188,6 +1619,16 @@ type metadataCreateAppCookieStickinessPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAppCookieStickinessPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAppCookieStickinessPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLBCookieStickinessPolicyInput struct {
 	// The time period, in seconds, after which the cookie should be considered
 	// stale. If you do not specify this parameter, the sticky session lasts for
@@ -1208,6 +1649,16 @@ type metadataCreateLBCookieStickinessPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLBCookieStickinessPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLBCookieStickinessPolicyInput) GoString() string {
+	return s.String()
+}
+
 type CreateLBCookieStickinessPolicyOutput struct {
 	metadataCreateLBCookieStickinessPolicyOutput `json:"-" xml:"-"`
 }
@@ -1216,6 +1667,16 @@ type metadataCreateLBCookieStickinessPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLBCookieStickinessPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLBCookieStickinessPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLoadBalancerInput struct {
 	// One or more Availability Zones from the same region as the load balancer.
 	// Traffic is equally distributed across all specified Availability Zones.
@@ -1262,7 +1723,7 @@ type CreateLoadBalancerInput struct {
 	//
 	// For more information about tagging your load balancer, see Tagging (http://docs.aws.amazon.com/ElasticLoadBalancing/latest/DeveloperGuide/TerminologyandKeyConcepts.html#tagging-elb)
 	// in the Elastic Load Balancing Developer Guide.
-	Tags []*Tag `type:"list"`
+	Tags []*Tag `type:"list" min:"1"`
 
 	metadataCreateLoadBalancerInput `json:"-" xml:"-"`
 }
@@ -1271,6 +1732,16 @@ type metadataCreateLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type CreateLoadBalancerListenersInput struct {
 	// The listeners.
 	Listeners []*Listener `type:"list" required:"true"`
@@ -1285,6 +1756,16 @@ type metadataCreateLoadBalancerListenersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoadBalancerListenersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoadBalancerListenersInput) GoString() string {
+	return s.String()
+}
+
 type CreateLoadBalancerListenersOutput struct {
 	metadataCreateLoadBalancerListenersOutput `json:"-" xml:"-"`
 }
@@ -1293,6 +1774,16 @@ type metadataCreateLoadBalancerListenersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoadBalancerListenersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoadBalancerListenersOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLoadBalancerOutput struct {
 	// The DNS name of the load balancer.
 	DNSName *string `type:"string"`
@@ -1304,6 +1795,16 @@ type metadataCreateLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLoadBalancerPolicyInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1325,6 +1826,16 @@ type metadataCreateLoadBalancerPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoadBalancerPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoadBalancerPolicyInput) GoString() string {
+	return s.String()
+}
+
 type CreateLoadBalancerPolicyOutput struct {
 	metadataCreateLoadBalancerPolicyOutput `json:"-" xml:"-"`
 }
@@ -1333,6 +1844,16 @@ type metadataCreateLoadBalancerPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoadBalancerPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoadBalancerPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Information about the CrossZoneLoadBalancing attribute.
 type CrossZoneLoadBalancing struct {
 	// Specifies whether cross-zone load balancing is enabled for the load balancer.
@@ -1345,6 +1866,16 @@ type metadataCrossZoneLoadBalancing struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CrossZoneLoadBalancing) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CrossZoneLoadBalancing) GoString() string {
+	return s.String()
+}
+
 type DeleteLoadBalancerInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1356,6 +1887,16 @@ type metadataDeleteLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLoadBalancerListenersInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1370,6 +1911,16 @@ type metadataDeleteLoadBalancerListenersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoadBalancerListenersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoadBalancerListenersInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLoadBalancerListenersOutput struct {
 	metadataDeleteLoadBalancerListenersOutput `json:"-" xml:"-"`
 }
@@ -1378,6 +1929,16 @@ type metadataDeleteLoadBalancerListenersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoadBalancerListenersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoadBalancerListenersOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLoadBalancerOutput struct {
 	metadataDeleteLoadBalancerOutput `json:"-" xml:"-"`
 }
@@ -1386,6 +1947,16 @@ type metadataDeleteLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 // =
 type DeleteLoadBalancerPolicyInput struct {
 	// The name of the load balancer.
@@ -1401,6 +1972,16 @@ type metadataDeleteLoadBalancerPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoadBalancerPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoadBalancerPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLoadBalancerPolicyOutput struct {
 	metadataDeleteLoadBalancerPolicyOutput `json:"-" xml:"-"`
 }
@@ -1409,6 +1990,16 @@ type metadataDeleteLoadBalancerPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoadBalancerPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoadBalancerPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeregisterInstancesFromLoadBalancerInput struct {
 	// The IDs of the instances.
 	Instances []*Instance `type:"list" required:"true"`
@@ -1423,6 +2014,16 @@ type metadataDeregisterInstancesFromLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterInstancesFromLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterInstancesFromLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterInstancesFromLoadBalancerOutput struct {
 	// The remaining instances registered with the load balancer.
 	Instances []*Instance `type:"list"`
@@ -1434,6 +2035,16 @@ type metadataDeregisterInstancesFromLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterInstancesFromLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterInstancesFromLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstanceHealthInput struct {
 	// The IDs of the instances.
 	Instances []*Instance `type:"list"`
@@ -1448,6 +2059,16 @@ type metadataDescribeInstanceHealthInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstanceHealthInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstanceHealthInput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstanceHealthOutput struct {
 	// Information about the health of the instances.
 	InstanceStates []*InstanceState `type:"list"`
@@ -1459,6 +2080,16 @@ type metadataDescribeInstanceHealthOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstanceHealthOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstanceHealthOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancerAttributesInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1470,6 +2101,16 @@ type metadataDescribeLoadBalancerAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancerAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancerAttributesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancerAttributesOutput struct {
 	// Information about the load balancer attributes.
 	LoadBalancerAttributes *LoadBalancerAttributes `type:"structure"`
@@ -1481,6 +2122,16 @@ type metadataDescribeLoadBalancerAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancerAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancerAttributesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancerPoliciesInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string"`
@@ -1495,6 +2146,16 @@ type metadataDescribeLoadBalancerPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancerPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancerPoliciesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancerPoliciesOutput struct {
 	// Information about the policies.
 	PolicyDescriptions []*PolicyDescription `type:"list"`
@@ -1506,6 +2167,16 @@ type metadataDescribeLoadBalancerPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancerPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancerPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancerPolicyTypesInput struct {
 	// The names of the policy types. If no names are specified, describes all policy
 	// types defined by Elastic Load Balancing.
@@ -1518,6 +2189,16 @@ type metadataDescribeLoadBalancerPolicyTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancerPolicyTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancerPolicyTypesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancerPolicyTypesOutput struct {
 	// Information about the policy types.
 	PolicyTypeDescriptions []*PolicyTypeDescription `type:"list"`
@@ -1529,6 +2210,16 @@ type metadataDescribeLoadBalancerPolicyTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancerPolicyTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancerPolicyTypesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancersInput struct {
 	// The names of the load balancers.
 	LoadBalancerNames []*string `type:"list"`
@@ -1539,7 +2230,7 @@ type DescribeLoadBalancersInput struct {
 
 	// The maximum number of results to return with this call (a number from 1 to
 	// 400). The default is 400.
-	PageSize *int64 `type:"integer"`
+	PageSize *int64 `type:"integer" min:"1" max:"400"`
 
 	metadataDescribeLoadBalancersInput `json:"-" xml:"-"`
 }
@@ -1548,6 +2239,16 @@ type metadataDescribeLoadBalancersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancersInput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBalancersOutput struct {
 	// Information about the load balancers.
 	LoadBalancerDescriptions []*LoadBalancerDescription `type:"list"`
@@ -1563,9 +2264,19 @@ type metadataDescribeLoadBalancersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBalancersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBalancersOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsInput struct {
 	// The names of the load balancers.
-	LoadBalancerNames []*string `type:"list" required:"true"`
+	LoadBalancerNames []*string `type:"list" min:"1" max:"20" required:"true"`
 
 	metadataDescribeTagsInput `json:"-" xml:"-"`
 }
@@ -1574,6 +2285,16 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsInput) GoString() string {
+	return s.String()
+}
+
 type DescribeTagsOutput struct {
 	// Information about the tags.
 	TagDescriptions []*TagDescription `type:"list"`
@@ -1585,6 +2306,16 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTagsOutput) GoString() string {
+	return s.String()
+}
+
 type DetachLoadBalancerFromSubnetsInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1599,6 +2330,16 @@ type metadataDetachLoadBalancerFromSubnetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachLoadBalancerFromSubnetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachLoadBalancerFromSubnetsInput) GoString() string {
+	return s.String()
+}
+
 type DetachLoadBalancerFromSubnetsOutput struct {
 	// The IDs of the remaining subnets for the load balancer.
 	Subnets []*string `type:"list"`
@@ -1610,6 +2351,16 @@ type metadataDetachLoadBalancerFromSubnetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachLoadBalancerFromSubnetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachLoadBalancerFromSubnetsOutput) GoString() string {
+	return s.String()
+}
+
 type DisableAvailabilityZonesForLoadBalancerInput struct {
 	// The Availability Zones.
 	AvailabilityZones []*string `type:"list" required:"true"`
@@ -1624,6 +2375,16 @@ type metadataDisableAvailabilityZonesForLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableAvailabilityZonesForLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableAvailabilityZonesForLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type DisableAvailabilityZonesForLoadBalancerOutput struct {
 	// The remaining Availability Zones for the load balancer.
 	AvailabilityZones []*string `type:"list"`
@@ -1635,6 +2396,16 @@ type metadataDisableAvailabilityZonesForLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableAvailabilityZonesForLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableAvailabilityZonesForLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 type EnableAvailabilityZonesForLoadBalancerInput struct {
 	// The Availability Zones. These must be in the same region as the load balancer.
 	AvailabilityZones []*string `type:"list" required:"true"`
@@ -1649,6 +2420,16 @@ type metadataEnableAvailabilityZonesForLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableAvailabilityZonesForLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableAvailabilityZonesForLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type EnableAvailabilityZonesForLoadBalancerOutput struct {
 	// The updated list of Availability Zones for the load balancer.
 	AvailabilityZones []*string `type:"list"`
@@ -1660,15 +2441,25 @@ type metadataEnableAvailabilityZonesForLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableAvailabilityZonesForLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableAvailabilityZonesForLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 // Information about a health check.
 type HealthCheck struct {
 	// The number of consecutive health checks successes required before moving
 	// the instance to the Healthy state.
-	HealthyThreshold *int64 `type:"integer" required:"true"`
+	HealthyThreshold *int64 `type:"integer" min:"2" max:"10" required:"true"`
 
 	// The approximate interval, in seconds, between health checks of an individual
 	// instance.
-	Interval *int64 `type:"integer" required:"true"`
+	Interval *int64 `type:"integer" min:"1" max:"300" required:"true"`
 
 	// The instance being checked. The protocol is either TCP, HTTP, HTTPS, or SSL.
 	// The range of valid ports is one (1) through 65535.
@@ -1694,11 +2485,11 @@ type HealthCheck struct {
 	// check.
 	//
 	// This value must be less than the Interval value.
-	Timeout *int64 `type:"integer" required:"true"`
+	Timeout *int64 `type:"integer" min:"1" max:"300" required:"true"`
 
 	// The number of consecutive health check failures required before moving the
 	// instance to the Unhealthy state.
-	UnhealthyThreshold *int64 `type:"integer" required:"true"`
+	UnhealthyThreshold *int64 `type:"integer" min:"2" max:"10" required:"true"`
 
 	metadataHealthCheck `json:"-" xml:"-"`
 }
@@ -1707,6 +2498,16 @@ type metadataHealthCheck struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HealthCheck) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HealthCheck) GoString() string {
+	return s.String()
+}
+
 // The ID of a back-end instance.
 type Instance struct {
 	// The ID of the instance.
@@ -1719,6 +2520,16 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Instance) GoString() string {
+	return s.String()
+}
+
 // Information about the state of a back-end instance.
 type InstanceState struct {
 	// A description of the instance state. This string can contain one or more
@@ -1773,6 +2584,16 @@ type metadataInstanceState struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceState) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceState) GoString() string {
+	return s.String()
+}
+
 // Information about a policy for duration-based session stickiness.
 type LBCookieStickinessPolicy struct {
 	// The time period, in seconds, after which the cookie should be considered
@@ -1791,6 +2612,16 @@ type metadataLBCookieStickinessPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LBCookieStickinessPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LBCookieStickinessPolicy) GoString() string {
+	return s.String()
+}
+
 // Information about a listener.
 //
 // For information about the protocols and the ports supported by Elastic Load
@@ -1798,7 +2629,7 @@ type metadataLBCookieStickinessPolicy struct {
 // in the Elastic Load Balancing Developer Guide.
 type Listener struct {
 	// The port on which the instance is listening.
-	InstancePort *int64 `type:"integer" required:"true"`
+	InstancePort *int64 `type:"integer" min:"1" max:"65535" required:"true"`
 
 	// The protocol to use for routing traffic to back-end instances: HTTP, HTTPS,
 	// TCP, or SSL.
@@ -1831,6 +2662,16 @@ type metadataListener struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Listener) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Listener) GoString() string {
+	return s.String()
+}
+
 // The policies enabled for a listener.
 type ListenerDescription struct {
 	// Information about a listener.
@@ -1850,6 +2691,16 @@ type metadataListenerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListenerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListenerDescription) GoString() string {
+	return s.String()
+}
+
 // The attributes for a load balancer.
 type LoadBalancerAttributes struct {
 	// If enabled, the load balancer captures detailed information of all requests
@@ -1893,6 +2744,16 @@ type metadataLoadBalancerAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoadBalancerAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoadBalancerAttributes) GoString() string {
+	return s.String()
+}
+
 // Information about a load balancer.
 type LoadBalancerDescription struct {
 	// The Availability Zones for the load balancer.
@@ -1964,6 +2825,16 @@ type metadataLoadBalancerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoadBalancerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoadBalancerDescription) GoString() string {
+	return s.String()
+}
+
 type ModifyLoadBalancerAttributesInput struct {
 	// The attributes of the load balancer.
 	LoadBalancerAttributes *LoadBalancerAttributes `type:"structure" required:"true"`
@@ -1978,6 +2849,16 @@ type metadataModifyLoadBalancerAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyLoadBalancerAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyLoadBalancerAttributesInput) GoString() string {
+	return s.String()
+}
+
 type ModifyLoadBalancerAttributesOutput struct {
 	// The attributes for a load balancer.
 	LoadBalancerAttributes *LoadBalancerAttributes `type:"structure"`
@@ -1992,6 +2873,16 @@ type metadataModifyLoadBalancerAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyLoadBalancerAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyLoadBalancerAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // The policies for a load balancer.
 type Policies struct {
 	// The stickiness policies created using CreateAppCookieStickinessPolicy.
@@ -2010,6 +2901,16 @@ type metadataPolicies struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Policies) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Policies) GoString() string {
+	return s.String()
+}
+
 // Information about a policy attribute.
 type PolicyAttribute struct {
 	// The name of the attribute.
@@ -2025,6 +2926,16 @@ type metadataPolicyAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyAttribute) GoString() string {
+	return s.String()
+}
+
 // Information about a policy attribute.
 type PolicyAttributeDescription struct {
 	// The name of the attribute.
@@ -2040,6 +2951,16 @@ type metadataPolicyAttributeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyAttributeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyAttributeDescription) GoString() string {
+	return s.String()
+}
+
 // Information about a policy attribute type.
 type PolicyAttributeTypeDescription struct {
 	// The name of the attribute.
@@ -2070,6 +2991,16 @@ type metadataPolicyAttributeTypeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyAttributeTypeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyAttributeTypeDescription) GoString() string {
+	return s.String()
+}
+
 // Information about a policy.
 type PolicyDescription struct {
 	// The policy attributes.
@@ -2088,6 +3019,16 @@ type metadataPolicyDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyDescription) GoString() string {
+	return s.String()
+}
+
 // Information about a policy type.
 type PolicyTypeDescription struct {
 	// A description of the policy type.
@@ -2107,6 +3048,16 @@ type metadataPolicyTypeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyTypeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyTypeDescription) GoString() string {
+	return s.String()
+}
+
 type RegisterInstancesWithLoadBalancerInput struct {
 	// The IDs of the instances.
 	Instances []*Instance `type:"list" required:"true"`
@@ -2121,6 +3072,16 @@ type metadataRegisterInstancesWithLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterInstancesWithLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterInstancesWithLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type RegisterInstancesWithLoadBalancerOutput struct {
 	// The updated list of instances for the load balancer.
 	Instances []*Instance `type:"list"`
@@ -2132,13 +3093,23 @@ type metadataRegisterInstancesWithLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterInstancesWithLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterInstancesWithLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 type RemoveTagsInput struct {
 	// The name of the load balancer. You can specify a maximum of one load balancer
 	// name.
 	LoadBalancerNames []*string `type:"list" required:"true"`
 
 	// The list of tag keys to remove.
-	Tags []*TagKeyOnly `type:"list" required:"true"`
+	Tags []*TagKeyOnly `type:"list" min:"1" required:"true"`
 
 	metadataRemoveTagsInput `json:"-" xml:"-"`
 }
@@ -2147,6 +3118,16 @@ type metadataRemoveTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsInput) GoString() string {
+	return s.String()
+}
+
 type RemoveTagsOutput struct {
 	metadataRemoveTagsOutput `json:"-" xml:"-"`
 }
@@ -2155,6 +3136,16 @@ type metadataRemoveTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsOutput) GoString() string {
+	return s.String()
+}
+
 type SetLoadBalancerListenerSSLCertificateInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -2172,6 +3163,16 @@ type metadataSetLoadBalancerListenerSSLCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBalancerListenerSSLCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBalancerListenerSSLCertificateInput) GoString() string {
+	return s.String()
+}
+
 type SetLoadBalancerListenerSSLCertificateOutput struct {
 	metadataSetLoadBalancerListenerSSLCertificateOutput `json:"-" xml:"-"`
 }
@@ -2180,6 +3181,16 @@ type metadataSetLoadBalancerListenerSSLCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBalancerListenerSSLCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBalancerListenerSSLCertificateOutput) GoString() string {
+	return s.String()
+}
+
 type SetLoadBalancerPoliciesForBackendServerInput struct {
 	// The port number associated with the back-end server.
 	InstancePort *int64 `type:"integer" required:"true"`
@@ -2198,6 +3209,16 @@ type metadataSetLoadBalancerPoliciesForBackendServerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBalancerPoliciesForBackendServerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBalancerPoliciesForBackendServerInput) GoString() string {
+	return s.String()
+}
+
 type SetLoadBalancerPoliciesForBackendServerOutput struct {
 	metadataSetLoadBalancerPoliciesForBackendServerOutput `json:"-" xml:"-"`
 }
@@ -2206,6 +3227,16 @@ type metadataSetLoadBalancerPoliciesForBackendServerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBalancerPoliciesForBackendServerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBalancerPoliciesForBackendServerOutput) GoString() string {
+	return s.String()
+}
+
 type SetLoadBalancerPoliciesOfListenerInput struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -2224,6 +3255,16 @@ type metadataSetLoadBalancerPoliciesOfListenerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBalancerPoliciesOfListenerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBalancerPoliciesOfListenerInput) GoString() string {
+	return s.String()
+}
+
 type SetLoadBalancerPoliciesOfListenerOutput struct {
 	metadataSetLoadBalancerPoliciesOfListenerOutput `json:"-" xml:"-"`
 }
@@ -2232,6 +3273,16 @@ type metadataSetLoadBalancerPoliciesOfListenerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBalancerPoliciesOfListenerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBalancerPoliciesOfListenerOutput) GoString() string {
+	return s.String()
+}
+
 // Information about a source security group.
 type SourceSecurityGroup struct {
 	// The name of the security group.
@@ -2247,13 +3298,23 @@ type metadataSourceSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SourceSecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SourceSecurityGroup) GoString() string {
+	return s.String()
+}
+
 // Information about a tag.
 type Tag struct {
 	// The key of the tag.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$" required:"true"`
 
 	// The value of the tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -2262,13 +3323,23 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // The tags associated with a load balancer.
 type TagDescription struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string"`
 
 	// The tags.
-	Tags []*Tag `type:"list"`
+	Tags []*Tag `type:"list" min:"1"`
 
 	metadataTagDescription `json:"-" xml:"-"`
 }
@@ -2277,10 +3348,20 @@ type metadataTagDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TagDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TagDescription) GoString() string {
+	return s.String()
+}
+
 // The key of a tag.
 type TagKeyOnly struct {
 	// The name of the key.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"`
 
 	metadataTagKeyOnly `json:"-" xml:"-"`
 }
@@ -2288,3 +3369,13 @@ type TagKeyOnly struct {
 type metadataTagKeyOnly struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s TagKeyOnly) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TagKeyOnly) GoString() string {
+	return s.String()
+}
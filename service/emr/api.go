@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddInstanceGroups = "AddInstanceGroups"
@@ -36,6 +37,16 @@ func (c *EMR) AddInstanceGroups(input *AddInstanceGroupsInput) (*AddInstanceGrou
 	return out, err
 }
 
+// AddInstanceGroupsWithContext is the same as AddInstanceGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) AddInstanceGroupsWithContext(ctx aws.Context, input *AddInstanceGroupsInput) (*AddInstanceGroupsOutput, error) {
+	req, out := c.AddInstanceGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddJobFlowSteps = "AddJobFlowSteps"
 
 // AddJobFlowStepsRequest generates a request for the AddJobFlowSteps operation.
@@ -86,6 +97,16 @@ func (c *EMR) AddJobFlowSteps(input *AddJobFlowStepsInput) (*AddJobFlowStepsOutp
 	return out, err
 }
 
+// AddJobFlowStepsWithContext is the same as AddJobFlowSteps with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) AddJobFlowStepsWithContext(ctx aws.Context, input *AddJobFlowStepsInput) (*AddJobFlowStepsOutput, error) {
+	req, out := c.AddJobFlowStepsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddTags = "AddTags"
 
 // AddTagsRequest generates a request for the AddTags operation.
@@ -116,6 +137,16 @@ func (c *EMR) AddTags(input *AddTagsInput) (*AddTagsOutput, error) {
 	return out, err
 }
 
+// AddTagsWithContext is the same as AddTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) AddTagsWithContext(ctx aws.Context, input *AddTagsInput) (*AddTagsOutput, error) {
+	req, out := c.AddTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCluster = "DescribeCluster"
 
 // DescribeClusterRequest generates a request for the DescribeCluster operation.
@@ -144,6 +175,16 @@ func (c *EMR) DescribeCluster(input *DescribeClusterInput) (*DescribeClusterOutp
 	return out, err
 }
 
+// DescribeClusterWithContext is the same as DescribeCluster with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) DescribeClusterWithContext(ctx aws.Context, input *DescribeClusterInput) (*DescribeClusterOutput, error) {
+	req, out := c.DescribeClusterRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeJobFlows = "DescribeJobFlows"
 
 // DescribeJobFlowsRequest generates a request for the DescribeJobFlows operation.
@@ -188,6 +229,16 @@ func (c *EMR) DescribeJobFlows(input *DescribeJobFlowsInput) (*DescribeJobFlowsO
 	return out, err
 }
 
+// DescribeJobFlowsWithContext is the same as DescribeJobFlows with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) DescribeJobFlowsWithContext(ctx aws.Context, input *DescribeJobFlowsInput) (*DescribeJobFlowsOutput, error) {
+	req, out := c.DescribeJobFlowsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStep = "DescribeStep"
 
 // DescribeStepRequest generates a request for the DescribeStep operation.
@@ -215,6 +266,16 @@ func (c *EMR) DescribeStep(input *DescribeStepInput) (*DescribeStepOutput, error
 	return out, err
 }
 
+// DescribeStepWithContext is the same as DescribeStep with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) DescribeStepWithContext(ctx aws.Context, input *DescribeStepInput) (*DescribeStepOutput, error) {
+	req, out := c.DescribeStepRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListBootstrapActions = "ListBootstrapActions"
 
 // ListBootstrapActionsRequest generates a request for the ListBootstrapActions operation.
@@ -248,6 +309,16 @@ func (c *EMR) ListBootstrapActions(input *ListBootstrapActionsInput) (*ListBoots
 	return out, err
 }
 
+// ListBootstrapActionsWithContext is the same as ListBootstrapActions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) ListBootstrapActionsWithContext(ctx aws.Context, input *ListBootstrapActionsInput) (*ListBootstrapActionsOutput, error) {
+	req, out := c.ListBootstrapActionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EMR) ListBootstrapActionsPages(input *ListBootstrapActionsInput, fn func(p *ListBootstrapActionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListBootstrapActionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -292,6 +363,16 @@ func (c *EMR) ListClusters(input *ListClustersInput) (*ListClustersOutput, error
 	return out, err
 }
 
+// ListClustersWithContext is the same as ListClusters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) ListClustersWithContext(ctx aws.Context, input *ListClustersInput) (*ListClustersOutput, error) {
+	req, out := c.ListClustersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EMR) ListClustersPages(input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListClustersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -332,6 +413,16 @@ func (c *EMR) ListInstanceGroups(input *ListInstanceGroupsInput) (*ListInstanceG
 	return out, err
 }
 
+// ListInstanceGroupsWithContext is the same as ListInstanceGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) ListInstanceGroupsWithContext(ctx aws.Context, input *ListInstanceGroupsInput) (*ListInstanceGroupsOutput, error) {
+	req, out := c.ListInstanceGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EMR) ListInstanceGroupsPages(input *ListInstanceGroupsInput, fn func(p *ListInstanceGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstanceGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -376,6 +467,16 @@ func (c *EMR) ListInstances(input *ListInstancesInput) (*ListInstancesOutput, er
 	return out, err
 }
 
+// ListInstancesWithContext is the same as ListInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) ListInstancesWithContext(ctx aws.Context, input *ListInstancesInput) (*ListInstancesOutput, error) {
+	req, out := c.ListInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EMR) ListInstancesPages(input *ListInstancesInput, fn func(p *ListInstancesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstancesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -416,6 +517,16 @@ func (c *EMR) ListSteps(input *ListStepsInput) (*ListStepsOutput, error) {
 	return out, err
 }
 
+// ListStepsWithContext is the same as ListSteps with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) ListStepsWithContext(ctx aws.Context, input *ListStepsInput) (*ListStepsOutput, error) {
+	req, out := c.ListStepsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *EMR) ListStepsPages(input *ListStepsInput, fn func(p *ListStepsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStepsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -453,6 +564,16 @@ func (c *EMR) ModifyInstanceGroups(input *ModifyInstanceGroupsInput) (*ModifyIns
 	return out, err
 }
 
+// ModifyInstanceGroupsWithContext is the same as ModifyInstanceGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) ModifyInstanceGroupsWithContext(ctx aws.Context, input *ModifyInstanceGroupsInput) (*ModifyInstanceGroupsOutput, error) {
+	req, out := c.ModifyInstanceGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveTags = "RemoveTags"
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -485,6 +606,16 @@ func (c *EMR) RemoveTags(input *RemoveTagsInput) (*RemoveTagsOutput, error) {
 	return out, err
 }
 
+// RemoveTagsWithContext is the same as RemoveTags with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) RemoveTagsWithContext(ctx aws.Context, input *RemoveTagsInput) (*RemoveTagsOutput, error) {
+	req, out := c.RemoveTagsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRunJobFlow = "RunJobFlow"
 
 // RunJobFlowRequest generates a request for the RunJobFlow operation.
@@ -534,6 +665,16 @@ func (c *EMR) RunJobFlow(input *RunJobFlowInput) (*RunJobFlowOutput, error) {
 	return out, err
 }
 
+// RunJobFlowWithContext is the same as RunJobFlow with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) RunJobFlowWithContext(ctx aws.Context, input *RunJobFlowInput) (*RunJobFlowOutput, error) {
+	req, out := c.RunJobFlowRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetTerminationProtection = "SetTerminationProtection"
 
 // SetTerminationProtectionRequest generates a request for the SetTerminationProtection operation.
@@ -577,6 +718,16 @@ func (c *EMR) SetTerminationProtection(input *SetTerminationProtectionInput) (*S
 	return out, err
 }
 
+// SetTerminationProtectionWithContext is the same as SetTerminationProtection with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) SetTerminationProtectionWithContext(ctx aws.Context, input *SetTerminationProtectionInput) (*SetTerminationProtectionOutput, error) {
+	req, out := c.SetTerminationProtectionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetVisibleToAllUsers = "SetVisibleToAllUsers"
 
 // SetVisibleToAllUsersRequest generates a request for the SetVisibleToAllUsers operation.
@@ -609,6 +760,16 @@ func (c *EMR) SetVisibleToAllUsers(input *SetVisibleToAllUsersInput) (*SetVisibl
 	return out, err
 }
 
+// SetVisibleToAllUsersWithContext is the same as SetVisibleToAllUsers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) SetVisibleToAllUsersWithContext(ctx aws.Context, input *SetVisibleToAllUsersInput) (*SetVisibleToAllUsersOutput, error) {
+	req, out := c.SetVisibleToAllUsersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateJobFlows = "TerminateJobFlows"
 
 // TerminateJobFlowsRequest generates a request for the TerminateJobFlows operation.
@@ -644,13 +805,23 @@ func (c *EMR) TerminateJobFlows(input *TerminateJobFlowsInput) (*TerminateJobFlo
 	return out, err
 }
 
+// TerminateJobFlowsWithContext is the same as TerminateJobFlows with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *EMR) TerminateJobFlowsWithContext(ctx aws.Context, input *TerminateJobFlowsInput) (*TerminateJobFlowsOutput, error) {
+	req, out := c.TerminateJobFlowsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Input to an AddInstanceGroups call.
 type AddInstanceGroupsInput struct {
 	// Instance Groups to add.
 	InstanceGroups []*InstanceGroupConfig `type:"list" required:"true"`
 
 	// Job flow in which to add the instance groups.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataAddInstanceGroupsInput `json:"-" xml:"-"`
 }
@@ -659,13 +830,23 @@ type metadataAddInstanceGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddInstanceGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddInstanceGroupsInput) GoString() string {
+	return s.String()
+}
+
 // Output from an AddInstanceGroups call.
 type AddInstanceGroupsOutput struct {
 	// Instance group IDs of the newly created instance groups.
 	InstanceGroupIDs []*string `locationName:"InstanceGroupIds" type:"list"`
 
 	// The job flow ID in which the instance groups are added.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256"`
 
 	metadataAddInstanceGroupsOutput `json:"-" xml:"-"`
 }
@@ -674,11 +855,21 @@ type metadataAddInstanceGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddInstanceGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddInstanceGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // The input argument to the AddJobFlowSteps operation.
 type AddJobFlowStepsInput struct {
 	// A string that uniquely identifies the job flow. This identifier is returned
 	// by RunJobFlow and can also be obtained from ListClusters.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	// A list of StepConfig to be executed by the job flow.
 	Steps []*StepConfig `type:"list" required:"true"`
@@ -690,6 +881,16 @@ type metadataAddJobFlowStepsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddJobFlowStepsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddJobFlowStepsInput) GoString() string {
+	return s.String()
+}
+
 // The output for the AddJobFlowSteps operation.
 type AddJobFlowStepsOutput struct {
 	// The identifiers of the list of steps added to the job flow.
@@ -702,6 +903,16 @@ type metadataAddJobFlowStepsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddJobFlowStepsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddJobFlowStepsOutput) GoString() string {
+	return s.String()
+}
+
 // This input identifies a cluster and a list of tags to attach.
 type AddTagsInput struct {
 	// The Amazon EMR resource identifier to which tags will be added. This value
@@ -721,6 +932,16 @@ type metadataAddTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsInput) GoString() string {
+	return s.String()
+}
+
 // This output indicates the result of adding tags to a resource.
 type AddTagsOutput struct {
 	metadataAddTagsOutput `json:"-" xml:"-"`
@@ -730,6 +951,16 @@ type metadataAddTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsOutput) GoString() string {
+	return s.String()
+}
+
 // An application is any Amazon or third-party software that you can add to
 // the cluster. This structure contains a list of strings that indicates the
 // software to use with the cluster and accepts a user argument list. Amazon
@@ -763,10 +994,20 @@ type metadataApplication struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Application) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Application) GoString() string {
+	return s.String()
+}
+
 // Configuration of a bootstrap action.
 type BootstrapActionConfig struct {
 	// The name of the bootstrap action.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// The script run by the bootstrap action.
 	ScriptBootstrapAction *ScriptBootstrapActionConfig `type:"structure" required:"true"`
@@ -778,6 +1019,16 @@ type metadataBootstrapActionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BootstrapActionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BootstrapActionConfig) GoString() string {
+	return s.String()
+}
+
 // Reports the configuration of a bootstrap action in a job flow.
 type BootstrapActionDetail struct {
 	// A description of the bootstrap action.
@@ -790,6 +1041,16 @@ type metadataBootstrapActionDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BootstrapActionDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BootstrapActionDetail) GoString() string {
+	return s.String()
+}
+
 // The detailed description of the cluster.
 type Cluster struct {
 	// The applications installed on this cluster.
@@ -859,10 +1120,22 @@ type metadataCluster struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Cluster) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Cluster) GoString() string {
+	return s.String()
+}
+
 // The reason that the cluster changed to its current state.
 type ClusterStateChangeReason struct {
 	// The programmatic code for the state change reason.
-	Code *string `type:"string"`
+	//
+	// See the ClusterStateChangeReasonCode* constants for valid values.
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,BOOTSTRAP_FAILURE,USER_REQUEST,STEP_FAILURE,ALL_STEPS_COMPLETED"`
 
 	// The descriptive message for the state change reason.
 	Message *string `type:"string"`
@@ -874,10 +1147,22 @@ type metadataClusterStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ClusterStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ClusterStateChangeReason) GoString() string {
+	return s.String()
+}
+
 // The detailed status of the cluster.
 type ClusterStatus struct {
 	// The current state of the cluster.
-	State *string `type:"string"`
+	//
+	// See the ClusterState* constants for valid values.
+	State *string `type:"string" enum:"STARTING,BOOTSTRAPPING,RUNNING,WAITING,TERMINATING,TERMINATED,TERMINATED_WITH_ERRORS"`
 
 	// The reason for the cluster status change.
 	StateChangeReason *ClusterStateChangeReason `type:"structure"`
@@ -893,6 +1178,16 @@ type metadataClusterStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ClusterStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ClusterStatus) GoString() string {
+	return s.String()
+}
+
 // The summary description of the cluster.
 type ClusterSummary struct {
 	// The unique identifier for the cluster.
@@ -919,6 +1214,16 @@ type metadataClusterSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ClusterSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ClusterSummary) GoString() string {
+	return s.String()
+}
+
 // Represents the timeline of the cluster's lifecycle.
 type ClusterTimeline struct {
 	// The creation date and time of the cluster.
@@ -937,6 +1242,16 @@ type metadataClusterTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ClusterTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ClusterTimeline) GoString() string {
+	return s.String()
+}
+
 // An entity describing an executable that runs on a cluster.
 type Command struct {
 	// Arguments for Amazon EMR to pass to the command for execution.
@@ -955,6 +1270,16 @@ type metadataCommand struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Command) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Command) GoString() string {
+	return s.String()
+}
+
 // This input determines which cluster to describe.
 type DescribeClusterInput struct {
 	// The identifier of the cluster to describe.
@@ -967,6 +1292,16 @@ type metadataDescribeClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeClusterInput) GoString() string {
+	return s.String()
+}
+
 // This output contains the description of the cluster.
 type DescribeClusterOutput struct {
 	// This output contains the details for the requested cluster.
@@ -979,6 +1314,16 @@ type metadataDescribeClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeClusterOutput) GoString() string {
+	return s.String()
+}
+
 // The input for the DescribeJobFlows operation.
 type DescribeJobFlowsInput struct {
 	// Return only job flows created after this date and time.
@@ -1000,6 +1345,16 @@ type metadataDescribeJobFlowsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeJobFlowsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeJobFlowsInput) GoString() string {
+	return s.String()
+}
+
 // The output for the DescribeJobFlows operation.
 type DescribeJobFlowsOutput struct {
 	// A list of job flows matching the parameters supplied.
@@ -1012,6 +1367,16 @@ type metadataDescribeJobFlowsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeJobFlowsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeJobFlowsOutput) GoString() string {
+	return s.String()
+}
+
 // This input determines which step to describe.
 type DescribeStepInput struct {
 	// The identifier of the cluster with steps to describe.
@@ -1027,6 +1392,16 @@ type metadataDescribeStepInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStepInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStepInput) GoString() string {
+	return s.String()
+}
+
 // This output contains the description of the cluster step.
 type DescribeStepOutput struct {
 	// The step details for the requested step identifier.
@@ -1039,6 +1414,16 @@ type metadataDescribeStepOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStepOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStepOutput) GoString() string {
+	return s.String()
+}
+
 // Provides information about the EC2 instances in a cluster grouped by category.
 // For example, key name, subnet ID, IAM instance profile, and so on.
 type EC2InstanceAttributes struct {
@@ -1084,6 +1469,16 @@ type metadataEC2InstanceAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EC2InstanceAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EC2InstanceAttributes) GoString() string {
+	return s.String()
+}
+
 // A job flow step consisting of a JAR file whose main function will be executed.
 // The main function submits a job for Hadoop to execute and waits for the job
 // to finish or fail.
@@ -1093,11 +1488,11 @@ type HadoopJARStepConfig struct {
 	Args []*string `type:"list"`
 
 	// A path to a JAR file run during the step.
-	JAR *string `locationName:"Jar" type:"string" required:"true"`
+	JAR *string `locationName:"Jar" type:"string" min:"0" max:"10280" required:"true"`
 
 	// The name of the main class in the specified Java file. If not specified,
 	// the JAR file should specify a Main-Class in its manifest file.
-	MainClass *string `type:"string"`
+	MainClass *string `type:"string" min:"0" max:"10280"`
 
 	// A list of Java properties that are set when the step runs. You can use these
 	// properties to pass key value pairs to your main function.
@@ -1110,6 +1505,16 @@ type metadataHadoopJARStepConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HadoopJARStepConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HadoopJARStepConfig) GoString() string {
+	return s.String()
+}
+
 // A cluster step consisting of a JAR file whose main function will be executed.
 // The main function submits a job for Hadoop to execute and waits for the job
 // to finish or fail.
@@ -1136,6 +1541,16 @@ type metadataHadoopStepConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HadoopStepConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HadoopStepConfig) GoString() string {
+	return s.String()
+}
+
 // Represents an EC2 instance provisioned as part of cluster.
 type Instance struct {
 	// The unique identifier of the instance in Amazon EC2.
@@ -1166,6 +1581,16 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Instance) GoString() string {
+	return s.String()
+}
+
 // This entity represents an instance group, which is a group of instances that
 // have common purpose. For example, CORE instance group is used for HDFS.
 type InstanceGroup struct {
@@ -1177,14 +1602,18 @@ type InstanceGroup struct {
 	ID *string `locationName:"Id" type:"string"`
 
 	// The type of the instance group. Valid values are MASTER, CORE or TASK.
-	InstanceGroupType *string `type:"string"`
+	//
+	// See the InstanceGroupType* constants for valid values.
+	InstanceGroupType *string `type:"string" enum:"MASTER,CORE,TASK"`
 
 	// The EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"256"`
 
 	// The marketplace to provision instances for this group. Valid values are ON_DEMAND
 	// or SPOT.
-	Market *string `type:"string"`
+	//
+	// See the MarketType* constants for valid values.
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT"`
 
 	// The name of the instance group.
 	Name *string `type:"string"`
@@ -1205,26 +1634,40 @@ type metadataInstanceGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceGroup) GoString() string {
+	return s.String()
+}
+
 // Configuration defining a new instance group.
 type InstanceGroupConfig struct {
 	// Bid price for each Amazon EC2 instance in the instance group when launching
 	// nodes as Spot Instances, expressed in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" min:"0" max:"256"`
 
 	// Target number of instances for the instance group.
 	InstanceCount *int64 `type:"integer" required:"true"`
 
 	// The role of the instance group in the cluster.
-	InstanceRole *string `type:"string" required:"true"`
+	//
+	// See the InstanceRoleType* constants for valid values.
+	InstanceRole *string `type:"string" enum:"MASTER,CORE,TASK" required:"true"`
 
 	// The Amazon EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
-	Market *string `type:"string"`
+	//
+	// See the MarketType* constants for valid values.
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT"`
 
 	// Friendly name given to the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	metadataInstanceGroupConfig `json:"-" xml:"-"`
 }
@@ -1233,11 +1676,21 @@ type metadataInstanceGroupConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceGroupConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceGroupConfig) GoString() string {
+	return s.String()
+}
+
 // Detailed information about an instance group.
 type InstanceGroupDetail struct {
 	// Bid price for EC2 Instances when launching nodes as Spot Instances, expressed
 	// in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" min:"0" max:"256"`
 
 	// The date/time the instance group was created.
 	CreationDateTime *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
@@ -1246,28 +1699,32 @@ type InstanceGroupDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Unique identifier for the instance group.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" min:"0" max:"256"`
 
 	// Target number of instances to run in the instance group.
 	InstanceRequestCount *int64 `type:"integer" required:"true"`
 
 	// Instance group role in the cluster
-	InstanceRole *string `type:"string" required:"true"`
+	//
+	// See the InstanceRoleType* constants for valid values.
+	InstanceRole *string `type:"string" enum:"MASTER,CORE,TASK" required:"true"`
 
 	// Actual count of running instances.
 	InstanceRunningCount *int64 `type:"integer" required:"true"`
 
 	// Amazon EC2 Instance type.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Details regarding the state of the instance group.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
-	Market *string `type:"string" required:"true"`
+	//
+	// See the MarketType* constants for valid values.
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT" required:"true"`
 
 	// Friendly name for the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	// The date/time the instance group was available to the cluster.
 	ReadyDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1277,7 +1734,9 @@ type InstanceGroupDetail struct {
 
 	// State of instance group. The following values are deprecated: STARTING, TERMINATED,
 	// and FAILED.
-	State *string `type:"string" required:"true"`
+	//
+	// See the InstanceGroupState* constants for valid values.
+	State *string `type:"string" enum:"PROVISIONING,BOOTSTRAPPING,RUNNING,RESIZING,SUSPENDED,TERMINATING,TERMINATED,ARRESTED,SHUTTING_DOWN,ENDED" required:"true"`
 
 	metadataInstanceGroupDetail `json:"-" xml:"-"`
 }
@@ -1286,6 +1745,16 @@ type metadataInstanceGroupDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceGroupDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceGroupDetail) GoString() string {
+	return s.String()
+}
+
 // Modify an instance group size.
 type InstanceGroupModifyConfig struct {
 	// The EC2 InstanceIds to terminate. For advanced users only. Once you terminate
@@ -1297,7 +1766,7 @@ type InstanceGroupModifyConfig struct {
 	InstanceCount *int64 `type:"integer"`
 
 	// Unique ID of the instance group to expand or shrink.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" required:"true"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" min:"0" max:"256" required:"true"`
 
 	metadataInstanceGroupModifyConfig `json:"-" xml:"-"`
 }
@@ -1306,10 +1775,22 @@ type metadataInstanceGroupModifyConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceGroupModifyConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceGroupModifyConfig) GoString() string {
+	return s.String()
+}
+
 // The status change reason details for the instance group.
 type InstanceGroupStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	//
+	// See the InstanceGroupStateChangeReasonCode* constants for valid values.
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,CLUSTER_TERMINATED"`
 
 	// The status change reason description.
 	Message *string `type:"string"`
@@ -1321,10 +1802,22 @@ type metadataInstanceGroupStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceGroupStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceGroupStateChangeReason) GoString() string {
+	return s.String()
+}
+
 // The details of the instance group status.
 type InstanceGroupStatus struct {
 	// The current state of the instance group.
-	State *string `type:"string"`
+	//
+	// See the InstanceGroupState* constants for valid values.
+	State *string `type:"string" enum:"PROVISIONING,BOOTSTRAPPING,RUNNING,RESIZING,SUSPENDED,TERMINATING,TERMINATED,ARRESTED,SHUTTING_DOWN,ENDED"`
 
 	// The status change reason details for the instance group.
 	StateChangeReason *InstanceGroupStateChangeReason `type:"structure"`
@@ -1339,6 +1832,16 @@ type metadataInstanceGroupStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceGroupStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceGroupStatus) GoString() string {
+	return s.String()
+}
+
 // The timeline of the instance group lifecycle.
 type InstanceGroupTimeline struct {
 	// The creation date and time of the instance group.
@@ -1357,10 +1860,22 @@ type metadataInstanceGroupTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceGroupTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceGroupTimeline) GoString() string {
+	return s.String()
+}
+
 // The details of the status change reason for the instance.
 type InstanceStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	//
+	// See the InstanceStateChangeReasonCode* constants for valid values.
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,BOOTSTRAP_FAILURE,CLUSTER_TERMINATED"`
 
 	// The status change reason description.
 	Message *string `type:"string"`
@@ -1372,10 +1887,22 @@ type metadataInstanceStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceStateChangeReason) GoString() string {
+	return s.String()
+}
+
 // The instance status details.
 type InstanceStatus struct {
 	// The current state of the instance.
-	State *string `type:"string"`
+	//
+	// See the InstanceState* constants for valid values.
+	State *string `type:"string" enum:"AWAITING_FULFILLMENT,PROVISIONING,BOOTSTRAPPING,RUNNING,TERMINATED"`
 
 	// The details of the status change reason for the instance.
 	StateChangeReason *InstanceStateChangeReason `type:"structure"`
@@ -1390,6 +1917,16 @@ type metadataInstanceStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceStatus) GoString() string {
+	return s.String()
+}
+
 // The timeline of the instance lifecycle.
 type InstanceTimeline struct {
 	// The creation date and time of the instance.
@@ -1408,13 +1945,23 @@ type metadataInstanceTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceTimeline) GoString() string {
+	return s.String()
+}
+
 // A description of a job flow.
 type JobFlowDetail struct {
 	// The version of the AMI used to initialize Amazon EC2 instances in the job
 	// flow. For a list of AMI versions currently supported by Amazon ElasticMapReduce,
 	// go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" min:"0" max:"256"`
 
 	// A list of the bootstrap actions run by the job flow.
 	BootstrapActions []*BootstrapActionDetail `type:"list"`
@@ -1426,21 +1973,21 @@ type JobFlowDetail struct {
 	Instances *JobFlowInstancesDetail `type:"structure" required:"true"`
 
 	// The job flow identifier.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256" required:"true"`
 
 	// The IAM role that was specified when the job flow was launched. The EC2 instances
 	// of the job flow assume this role.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" min:"0" max:"10280"`
 
 	// The location in Amazon S3 where log files for the job are stored.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" min:"0" max:"10280"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"0" max:"10280"`
 
 	// A list of steps run by the job flow.
 	Steps []*StepDetail `type:"list"`
@@ -1465,6 +2012,16 @@ type metadataJobFlowDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobFlowDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobFlowDetail) GoString() string {
+	return s.String()
+}
+
 // Describes the status of the job flow.
 type JobFlowExecutionStatusDetail struct {
 	// The creation date and time of the job flow.
@@ -1474,7 +2031,7 @@ type JobFlowExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Description of the job flow last changed state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// The date and time when the job flow was ready to start running bootstrap
 	// actions.
@@ -1484,7 +2041,9 @@ type JobFlowExecutionStatusDetail struct {
 	StartDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The state of the job flow.
-	State *string `type:"string" required:"true"`
+	//
+	// See the JobFlowExecutionState* constants for valid values.
+	State *string `type:"string" enum:"STARTING,BOOTSTRAPPING,RUNNING,WAITING,SHUTTING_DOWN,TERMINATED,COMPLETED,FAILED" required:"true"`
 
 	metadataJobFlowExecutionStatusDetail `json:"-" xml:"-"`
 }
@@ -1493,6 +2052,16 @@ type metadataJobFlowExecutionStatusDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobFlowExecutionStatusDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobFlowExecutionStatusDetail) GoString() string {
+	return s.String()
+}
+
 // A description of the Amazon EC2 instance running the job flow. A valid JobFlowInstancesConfig
 // must contain at least InstanceGroups, which is the recommended configuration.
 // However, a valid alternative is to have MasterInstanceType, SlaveInstanceType,
@@ -1506,7 +2075,7 @@ type JobFlowInstancesConfig struct {
 
 	// The name of the Amazon EC2 key pair that can be used to ssh to the master
 	// node as the user called "hadoop."
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" min:"0" max:"256"`
 
 	// To launch the job flow in Amazon Virtual Private Cloud (Amazon VPC), set
 	// this parameter to the identifier of the Amazon VPC subnet where you want
@@ -1516,21 +2085,21 @@ type JobFlowInstancesConfig struct {
 	//  Amazon VPC currently does not support cluster compute quadruple extra large
 	// (cc1.4xlarge) instances. Thus you cannot specify the cc1.4xlarge instance
 	// type for nodes of a job flow launched in a Amazon VPC.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" min:"0" max:"256"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the master node.
-	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string"`
+	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string" min:"0" max:"256"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the slave nodes.
-	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string"`
+	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string" min:"0" max:"256"`
 
 	// The Hadoop version for the job flow. Valid inputs are "0.18", "0.20", "0.20.205",
 	// "1.0.3", "2.2.0", or "2.4.0". If you do not set this value, the default of
 	// 0.18 is used, unless the AmiVersion parameter is set in the RunJobFlow call,
 	// in which case the default version of Hadoop for that AMI version is used.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" min:"0" max:"256"`
 
 	// The number of Amazon EC2 instances used to execute the job flow.
 	InstanceCount *int64 `type:"integer"`
@@ -1542,13 +2111,13 @@ type JobFlowInstancesConfig struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The EC2 instance type of the master node.
-	MasterInstanceType *string `type:"string"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256"`
 
 	// The Availability Zone the job flow will run in.
 	Placement *PlacementType `type:"structure"`
 
 	// The EC2 instance type of the slave nodes.
-	SlaveInstanceType *string `type:"string"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256"`
 
 	// Specifies whether to lock the job flow to prevent the Amazon EC2 instances
 	// from being terminated by API call, user intervention, or in the event of
@@ -1562,18 +2131,28 @@ type metadataJobFlowInstancesConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobFlowInstancesConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobFlowInstancesConfig) GoString() string {
+	return s.String()
+}
+
 // Specify the type of Amazon EC2 instances to run the job flow on.
 type JobFlowInstancesDetail struct {
 	// The name of an Amazon EC2 key pair that can be used to ssh to the master
 	// node of job flow.
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" min:"0" max:"256"`
 
 	// For job flows launched within Amazon Virtual Private Cloud, this value specifies
 	// the identifier of the subnet where the job flow was launched.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" min:"0" max:"256"`
 
 	// The Hadoop version for the job flow.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" min:"0" max:"256"`
 
 	// The number of Amazon EC2 instances in the cluster. If the value is 1, the
 	// same instance serves as both the master and slave node. If the value is greater
@@ -1587,13 +2166,13 @@ type JobFlowInstancesDetail struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The Amazon EC2 instance identifier of the master node.
-	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string"`
+	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string" min:"0" max:"10280"`
 
 	// The Amazon EC2 master node instance type.
-	MasterInstanceType *string `type:"string" required:"true"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// The DNS name of the master node.
-	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string"`
+	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string" min:"0" max:"10280"`
 
 	// An approximation of the cost of the job flow, represented in m1.small/hours.
 	// This value is incremented once for every hour an m1.small runs. Larger instances
@@ -1607,7 +2186,7 @@ type JobFlowInstancesDetail struct {
 	Placement *PlacementType `type:"structure"`
 
 	// The Amazon EC2 slave node instance type.
-	SlaveInstanceType *string `type:"string" required:"true"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Specifies whether the Amazon EC2 instances in the cluster are protected from
 	// termination by API calls, user intervention, or in the event of a job flow
@@ -1621,13 +2200,23 @@ type metadataJobFlowInstancesDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobFlowInstancesDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobFlowInstancesDetail) GoString() string {
+	return s.String()
+}
+
 // A key value pair.
 type KeyValue struct {
 	// The unique identifier of a key value pair.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"0" max:"10280"`
 
 	// The value part of the identified key.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"10280"`
 
 	metadataKeyValue `json:"-" xml:"-"`
 }
@@ -1636,6 +2225,16 @@ type metadataKeyValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeyValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeyValue) GoString() string {
+	return s.String()
+}
+
 // This input determines which bootstrap actions to retrieve.
 type ListBootstrapActionsInput struct {
 	// The cluster identifier for the bootstrap actions to list .
@@ -1651,6 +2250,16 @@ type metadataListBootstrapActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListBootstrapActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListBootstrapActionsInput) GoString() string {
+	return s.String()
+}
+
 // This output contains the boostrap actions detail .
 type ListBootstrapActionsOutput struct {
 	// The bootstrap actions associated with the cluster .
@@ -1666,6 +2275,16 @@ type metadataListBootstrapActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListBootstrapActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListBootstrapActionsOutput) GoString() string {
+	return s.String()
+}
+
 // This input determines how the ListClusters action filters the list of clusters
 // that it returns.
 type ListClustersInput struct {
@@ -1688,6 +2307,16 @@ type metadataListClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListClustersInput) GoString() string {
+	return s.String()
+}
+
 // This contains a ClusterSummaryList with the cluster details; for example,
 // the cluster IDs, names, and status.
 type ListClustersOutput struct {
@@ -1704,6 +2333,16 @@ type metadataListClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListClustersOutput) GoString() string {
+	return s.String()
+}
+
 // This input determines which instance groups to retrieve.
 type ListInstanceGroupsInput struct {
 	// The identifier of the cluster for which to list the instance groups.
@@ -1719,6 +2358,16 @@ type metadataListInstanceGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstanceGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstanceGroupsInput) GoString() string {
+	return s.String()
+}
+
 // This input determines which instance groups to retrieve.
 type ListInstanceGroupsOutput struct {
 	// The list of instance groups for the cluster and given filters.
@@ -1734,6 +2383,16 @@ type metadataListInstanceGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstanceGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstanceGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // This input determines which instances to list.
 type ListInstancesInput struct {
 	// The identifier of the cluster for which to list the instances.
@@ -1755,6 +2414,16 @@ type metadataListInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstancesInput) GoString() string {
+	return s.String()
+}
+
 // This output contains the list of instances.
 type ListInstancesOutput struct {
 	// The list of instances for the cluster and given filters.
@@ -1770,6 +2439,16 @@ type metadataListInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstancesOutput) GoString() string {
+	return s.String()
+}
+
 // This input determines which steps to list.
 type ListStepsInput struct {
 	// The identifier of the cluster for which to list the steps.
@@ -1791,6 +2470,16 @@ type metadataListStepsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStepsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStepsInput) GoString() string {
+	return s.String()
+}
+
 // This output contains the list of steps.
 type ListStepsOutput struct {
 	// The pagination token that indicates the next set of results to retrieve.
@@ -1806,6 +2495,16 @@ type metadataListStepsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStepsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStepsOutput) GoString() string {
+	return s.String()
+}
+
 // Change the size of some instance groups.
 type ModifyInstanceGroupsInput struct {
 	// Instance groups to change.
@@ -1818,6 +2517,16 @@ type metadataModifyInstanceGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyInstanceGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyInstanceGroupsInput) GoString() string {
+	return s.String()
+}
+
 type ModifyInstanceGroupsOutput struct {
 	metadataModifyInstanceGroupsOutput `json:"-" xml:"-"`
 }
@@ -1826,10 +2535,20 @@ type metadataModifyInstanceGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ModifyInstanceGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ModifyInstanceGroupsOutput) GoString() string {
+	return s.String()
+}
+
 // The Amazon EC2 location for the job flow.
 type PlacementType struct {
 	// The Amazon EC2 Availability Zone for the job flow.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"0" max:"10280" required:"true"`
 
 	metadataPlacementType `json:"-" xml:"-"`
 }
@@ -1838,6 +2557,16 @@ type metadataPlacementType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PlacementType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PlacementType) GoString() string {
+	return s.String()
+}
+
 // This input identifies a cluster and a list of tags to remove.
 type RemoveTagsInput struct {
 	// The Amazon EMR resource identifier from which tags will be removed. This
@@ -1854,6 +2583,16 @@ type metadataRemoveTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsInput) GoString() string {
+	return s.String()
+}
+
 // This output indicates the result of removing tags from a resource.
 type RemoveTagsOutput struct {
 	metadataRemoveTagsOutput `json:"-" xml:"-"`
@@ -1863,6 +2602,16 @@ type metadataRemoveTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsOutput) GoString() string {
+	return s.String()
+}
+
 // Input to the RunJobFlow operation.
 type RunJobFlowInput struct {
 	// The version of the Amazon Machine Image (AMI) to use when launching Amazon
@@ -1877,10 +2626,10 @@ type RunJobFlowInput struct {
 	// For details about the AMI versions currently supported by Amazon Elastic
 	// MapReduce, go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" min:"0" max:"256"`
 
 	// A JSON string for selecting additional features.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" min:"0" max:"10280"`
 
 	// A list of bootstrap actions that will be run before Hadoop is started on
 	// the cluster nodes.
@@ -1893,14 +2642,14 @@ type RunJobFlowInput struct {
 	// An IAM role for the job flow. The EC2 instances of the job flow assume this
 	// role. The default role is EMRJobflowDefault. In order to use the default
 	// role, you must have already created it using the CLI.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" min:"0" max:"10280"`
 
 	// The location in Amazon S3 to write the log files of the job flow. If a value
 	// is not provided, logs are not created.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" min:"0" max:"10280"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	// A list of strings that indicates third-party software to use with the job
 	// flow that accepts a user argument list. EMR accepts and forwards the argument
@@ -1917,7 +2666,7 @@ type RunJobFlowInput struct {
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"0" max:"10280"`
 
 	// A list of steps to be executed by the job flow.
 	Steps []*StepConfig `type:"list"`
@@ -1948,10 +2697,20 @@ type metadataRunJobFlowInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RunJobFlowInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RunJobFlowInput) GoString() string {
+	return s.String()
+}
+
 // The result of the RunJobFlow operation.
 type RunJobFlowOutput struct {
 	// An unique identifier for the job flow.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" min:"0" max:"256"`
 
 	metadataRunJobFlowOutput `json:"-" xml:"-"`
 }
@@ -1960,6 +2719,16 @@ type metadataRunJobFlowOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RunJobFlowOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RunJobFlowOutput) GoString() string {
+	return s.String()
+}
+
 // Configuration of the script to run during a bootstrap action.
 type ScriptBootstrapActionConfig struct {
 	// A list of command line arguments to pass to the bootstrap action script.
@@ -1967,7 +2736,7 @@ type ScriptBootstrapActionConfig struct {
 
 	// Location of the script to run during a bootstrap action. Can be either a
 	// location in Amazon S3 or on a local file system.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"0" max:"10280" required:"true"`
 
 	metadataScriptBootstrapActionConfig `json:"-" xml:"-"`
 }
@@ -1976,6 +2745,16 @@ type metadataScriptBootstrapActionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScriptBootstrapActionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScriptBootstrapActionConfig) GoString() string {
+	return s.String()
+}
+
 // The input argument to the TerminationProtection operation.
 type SetTerminationProtectionInput struct {
 	// A list of strings that uniquely identify the job flows to protect. This identifier
@@ -1995,6 +2774,16 @@ type metadataSetTerminationProtectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTerminationProtectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTerminationProtectionInput) GoString() string {
+	return s.String()
+}
+
 type SetTerminationProtectionOutput struct {
 	metadataSetTerminationProtectionOutput `json:"-" xml:"-"`
 }
@@ -2003,6 +2792,16 @@ type metadataSetTerminationProtectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTerminationProtectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTerminationProtectionOutput) GoString() string {
+	return s.String()
+}
+
 // The input to the SetVisibleToAllUsers action.
 type SetVisibleToAllUsersInput struct {
 	// Identifiers of the job flows to receive the new visibility setting.
@@ -2022,6 +2821,16 @@ type metadataSetVisibleToAllUsersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetVisibleToAllUsersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetVisibleToAllUsersInput) GoString() string {
+	return s.String()
+}
+
 type SetVisibleToAllUsersOutput struct {
 	metadataSetVisibleToAllUsersOutput `json:"-" xml:"-"`
 }
@@ -2030,11 +2839,23 @@ type metadataSetVisibleToAllUsersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetVisibleToAllUsersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetVisibleToAllUsersOutput) GoString() string {
+	return s.String()
+}
+
 // This represents a step in a cluster.
 type Step struct {
 	// This specifies what action to take when the cluster step fails. Possible
 	// values are TERMINATE_CLUSTER, CANCEL_AND_WAIT, and CONTINUE.
-	ActionOnFailure *string `type:"string"`
+	//
+	// See the ActionOnFailure* constants for valid values.
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The Hadoop job configuration of the cluster step.
 	Config *HadoopStepConfig `type:"structure"`
@@ -2055,16 +2876,28 @@ type metadataStep struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Step) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Step) GoString() string {
+	return s.String()
+}
+
 // Specification of a job flow step.
 type StepConfig struct {
 	// The action to take if the job flow step fails.
-	ActionOnFailure *string `type:"string"`
+	//
+	// See the ActionOnFailure* constants for valid values.
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The JAR file used for the job flow step.
 	HadoopJARStep *HadoopJARStepConfig `locationName:"HadoopJarStep" type:"structure" required:"true"`
 
 	// The name of the job flow step.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"0" max:"256" required:"true"`
 
 	metadataStepConfig `json:"-" xml:"-"`
 }
@@ -2073,6 +2906,16 @@ type metadataStepConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StepConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StepConfig) GoString() string {
+	return s.String()
+}
+
 // Combines the execution state and configuration of a step.
 type StepDetail struct {
 	// The description of the step status.
@@ -2088,6 +2931,16 @@ type metadataStepDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StepDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StepDetail) GoString() string {
+	return s.String()
+}
+
 // The execution state of a step.
 type StepExecutionStatusDetail struct {
 	// The creation date and time of the step.
@@ -2097,13 +2950,15 @@ type StepExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A description of the step's current state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" min:"0" max:"10280"`
 
 	// The start date and time of the step.
 	StartDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The state of the job flow step.
-	State *string `type:"string" required:"true"`
+	//
+	// See the StepExecutionState* constants for valid values.
+	State *string `type:"string" enum:"PENDING,RUNNING,CONTINUE,COMPLETED,CANCELLED,FAILED,INTERRUPTED" required:"true"`
 
 	metadataStepExecutionStatusDetail `json:"-" xml:"-"`
 }
@@ -2112,10 +2967,22 @@ type metadataStepExecutionStatusDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StepExecutionStatusDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StepExecutionStatusDetail) GoString() string {
+	return s.String()
+}
+
 // The details of the step state change reason.
 type StepStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	//
+	// See the StepStateChangeReasonCode* constants for valid values.
+	Code *string `type:"string" enum:"NONE"`
 
 	// The descriptive message for the state change reason.
 	Message *string `type:"string"`
@@ -2127,10 +2994,22 @@ type metadataStepStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StepStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StepStateChangeReason) GoString() string {
+	return s.String()
+}
+
 // The execution status details of the cluster step.
 type StepStatus struct {
 	// The execution state of the cluster step.
-	State *string `type:"string"`
+	//
+	// See the StepState* constants for valid values.
+	State *string `type:"string" enum:"PENDING,RUNNING,COMPLETED,CANCELLED,FAILED,INTERRUPTED"`
 
 	// The reason for the step execution status change.
 	StateChangeReason *StepStateChangeReason `type:"structure"`
@@ -2145,11 +3024,23 @@ type metadataStepStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StepStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StepStatus) GoString() string {
+	return s.String()
+}
+
 // The summary of the cluster step.
 type StepSummary struct {
 	// This specifies what action to take when the cluster step fails. Possible
 	// values are TERMINATE_CLUSTER, CANCEL_AND_WAIT, and CONTINUE.
-	ActionOnFailure *string `type:"string"`
+	//
+	// See the ActionOnFailure* constants for valid values.
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The Hadoop job configuration of the cluster step.
 	Config *HadoopStepConfig `type:"structure"`
@@ -2170,6 +3061,16 @@ type metadataStepSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StepSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StepSummary) GoString() string {
+	return s.String()
+}
+
 // The timeline of the cluster step lifecycle.
 type StepTimeline struct {
 	// The date and time when the cluster step was created.
@@ -2188,6 +3089,16 @@ type metadataStepTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StepTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StepTimeline) GoString() string {
+	return s.String()
+}
+
 // The list of supported product configurations which allow user-supplied arguments.
 // EMR accepts these arguments and forwards them to the corresponding installation
 // script as bootstrap action arguments.
@@ -2196,7 +3107,7 @@ type SupportedProductConfig struct {
 	Args []*string `type:"list"`
 
 	// The name of the product configuration.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"0" max:"256"`
 
 	metadataSupportedProductConfig `json:"-" xml:"-"`
 }
@@ -2205,6 +3116,16 @@ type metadataSupportedProductConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SupportedProductConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SupportedProductConfig) GoString() string {
+	return s.String()
+}
+
 // A key/value pair containing user-defined metadata that you can associate
 // with an Amazon EMR resource. Tags make it easier to associate clusters in
 // various ways, such as grouping clu\ sters to track your Amazon EMR resource
@@ -2226,6 +3147,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // Input to the TerminateJobFlows operation.
 type TerminateJobFlowsInput struct {
 	// A list of job flows to be shutdown.
@@ -2238,6 +3169,16 @@ type metadataTerminateJobFlowsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateJobFlowsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateJobFlowsInput) GoString() string {
+	return s.String()
+}
+
 type TerminateJobFlowsOutput struct {
 	metadataTerminateJobFlowsOutput `json:"-" xml:"-"`
 }
@@ -2245,3 +3186,199 @@ type TerminateJobFlowsOutput struct {
 type metadataTerminateJobFlowsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s TerminateJobFlowsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateJobFlowsOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ActionOnFailure
+	ActionOnFailureTerminateJobFlow = "TERMINATE_JOB_FLOW"
+	// @enum ActionOnFailure
+	ActionOnFailureTerminateCluster = "TERMINATE_CLUSTER"
+	// @enum ActionOnFailure
+	ActionOnFailureCancelAndWait = "CANCEL_AND_WAIT"
+	// @enum ActionOnFailure
+	ActionOnFailureContinue = "CONTINUE"
+)
+
+const (
+	// @enum ClusterState
+	ClusterStateStarting = "STARTING"
+	// @enum ClusterState
+	ClusterStateBootstrapping = "BOOTSTRAPPING"
+	// @enum ClusterState
+	ClusterStateRunning = "RUNNING"
+	// @enum ClusterState
+	ClusterStateWaiting = "WAITING"
+	// @enum ClusterState
+	ClusterStateTerminating = "TERMINATING"
+	// @enum ClusterState
+	ClusterStateTerminated = "TERMINATED"
+	// @enum ClusterState
+	ClusterStateTerminatedWithErrors = "TERMINATED_WITH_ERRORS"
+)
+
+const (
+	// @enum ClusterStateChangeReasonCode
+	ClusterStateChangeReasonCodeInternalError = "INTERNAL_ERROR"
+	// @enum ClusterStateChangeReasonCode
+	ClusterStateChangeReasonCodeValidationError = "VALIDATION_ERROR"
+	// @enum ClusterStateChangeReasonCode
+	ClusterStateChangeReasonCodeInstanceFailure = "INSTANCE_FAILURE"
+	// @enum ClusterStateChangeReasonCode
+	ClusterStateChangeReasonCodeBootstrapFailure = "BOOTSTRAP_FAILURE"
+	// @enum ClusterStateChangeReasonCode
+	ClusterStateChangeReasonCodeUserRequest = "USER_REQUEST"
+	// @enum ClusterStateChangeReasonCode
+	ClusterStateChangeReasonCodeStepFailure = "STEP_FAILURE"
+	// @enum ClusterStateChangeReasonCode
+	ClusterStateChangeReasonCodeAllStepsCompleted = "ALL_STEPS_COMPLETED"
+)
+
+const (
+	// @enum InstanceGroupState
+	InstanceGroupStateProvisioning = "PROVISIONING"
+	// @enum InstanceGroupState
+	InstanceGroupStateBootstrapping = "BOOTSTRAPPING"
+	// @enum InstanceGroupState
+	InstanceGroupStateRunning = "RUNNING"
+	// @enum InstanceGroupState
+	InstanceGroupStateResizing = "RESIZING"
+	// @enum InstanceGroupState
+	InstanceGroupStateSuspended = "SUSPENDED"
+	// @enum InstanceGroupState
+	InstanceGroupStateTerminating = "TERMINATING"
+	// @enum InstanceGroupState
+	InstanceGroupStateTerminated = "TERMINATED"
+	// @enum InstanceGroupState
+	InstanceGroupStateArrested = "ARRESTED"
+	// @enum InstanceGroupState
+	InstanceGroupStateShuttingDown = "SHUTTING_DOWN"
+	// @enum InstanceGroupState
+	InstanceGroupStateEnded = "ENDED"
+)
+
+const (
+	// @enum InstanceGroupStateChangeReasonCode
+	InstanceGroupStateChangeReasonCodeInternalError = "INTERNAL_ERROR"
+	// @enum InstanceGroupStateChangeReasonCode
+	InstanceGroupStateChangeReasonCodeValidationError = "VALIDATION_ERROR"
+	// @enum InstanceGroupStateChangeReasonCode
+	InstanceGroupStateChangeReasonCodeInstanceFailure = "INSTANCE_FAILURE"
+	// @enum InstanceGroupStateChangeReasonCode
+	InstanceGroupStateChangeReasonCodeClusterTerminated = "CLUSTER_TERMINATED"
+)
+
+const (
+	// @enum InstanceGroupType
+	InstanceGroupTypeMaster = "MASTER"
+	// @enum InstanceGroupType
+	InstanceGroupTypeCore = "CORE"
+	// @enum InstanceGroupType
+	InstanceGroupTypeTask = "TASK"
+)
+
+const (
+	// @enum InstanceRoleType
+	InstanceRoleTypeMaster = "MASTER"
+	// @enum InstanceRoleType
+	InstanceRoleTypeCore = "CORE"
+	// @enum InstanceRoleType
+	InstanceRoleTypeTask = "TASK"
+)
+
+const (
+	// @enum InstanceState
+	InstanceStateAwaitingFulfillment = "AWAITING_FULFILLMENT"
+	// @enum InstanceState
+	InstanceStateProvisioning = "PROVISIONING"
+	// @enum InstanceState
+	InstanceStateBootstrapping = "BOOTSTRAPPING"
+	// @enum InstanceState
+	InstanceStateRunning = "RUNNING"
+	// @enum InstanceState
+	InstanceStateTerminated = "TERMINATED"
+)
+
+const (
+	// @enum InstanceStateChangeReasonCode
+	InstanceStateChangeReasonCodeInternalError = "INTERNAL_ERROR"
+	// @enum InstanceStateChangeReasonCode
+	InstanceStateChangeReasonCodeValidationError = "VALIDATION_ERROR"
+	// @enum InstanceStateChangeReasonCode
+	InstanceStateChangeReasonCodeInstanceFailure = "INSTANCE_FAILURE"
+	// @enum InstanceStateChangeReasonCode
+	InstanceStateChangeReasonCodeBootstrapFailure = "BOOTSTRAP_FAILURE"
+	// @enum InstanceStateChangeReasonCode
+	InstanceStateChangeReasonCodeClusterTerminated = "CLUSTER_TERMINATED"
+)
+
+const (
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateStarting = "STARTING"
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateBootstrapping = "BOOTSTRAPPING"
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateRunning = "RUNNING"
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateWaiting = "WAITING"
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateShuttingDown = "SHUTTING_DOWN"
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateTerminated = "TERMINATED"
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateCompleted = "COMPLETED"
+	// @enum JobFlowExecutionState
+	JobFlowExecutionStateFailed = "FAILED"
+)
+
+const (
+	// @enum MarketType
+	MarketTypeOnDemand = "ON_DEMAND"
+	// @enum MarketType
+	MarketTypeSpot = "SPOT"
+)
+
+const (
+	// @enum StepExecutionState
+	StepExecutionStatePending = "PENDING"
+	// @enum StepExecutionState
+	StepExecutionStateRunning = "RUNNING"
+	// @enum StepExecutionState
+	StepExecutionStateContinue = "CONTINUE"
+	// @enum StepExecutionState
+	StepExecutionStateCompleted = "COMPLETED"
+	// @enum StepExecutionState
+	StepExecutionStateCancelled = "CANCELLED"
+	// @enum StepExecutionState
+	StepExecutionStateFailed = "FAILED"
+	// @enum StepExecutionState
+	StepExecutionStateInterrupted = "INTERRUPTED"
+)
+
+const (
+	// @enum StepState
+	StepStatePending = "PENDING"
+	// @enum StepState
+	StepStateRunning = "RUNNING"
+	// @enum StepState
+	StepStateCompleted = "COMPLETED"
+	// @enum StepState
+	StepStateCancelled = "CANCELLED"
+	// @enum StepState
+	StepStateFailed = "FAILED"
+	// @enum StepState
+	StepStateInterrupted = "INTERRUPTED"
+)
+
+const (
+	// @enum StepStateChangeReasonCode
+	StepStateChangeReasonCodeNone = "NONE"
+)
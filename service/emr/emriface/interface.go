@@ -4,6 +4,7 @@
 package emriface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emr"
 )
 
@@ -11,35 +12,73 @@ import (
 type EMRAPI interface {
 	AddInstanceGroups(*emr.AddInstanceGroupsInput) (*emr.AddInstanceGroupsOutput, error)
 
+	AddInstanceGroupsWithContext(aws.Context, *emr.AddInstanceGroupsInput) (*emr.AddInstanceGroupsOutput, error)
+
 	AddJobFlowSteps(*emr.AddJobFlowStepsInput) (*emr.AddJobFlowStepsOutput, error)
 
+	AddJobFlowStepsWithContext(aws.Context, *emr.AddJobFlowStepsInput) (*emr.AddJobFlowStepsOutput, error)
+
 	AddTags(*emr.AddTagsInput) (*emr.AddTagsOutput, error)
 
+	AddTagsWithContext(aws.Context, *emr.AddTagsInput) (*emr.AddTagsOutput, error)
+
 	DescribeCluster(*emr.DescribeClusterInput) (*emr.DescribeClusterOutput, error)
 
+	DescribeClusterWithContext(aws.Context, *emr.DescribeClusterInput) (*emr.DescribeClusterOutput, error)
+
 	DescribeJobFlows(*emr.DescribeJobFlowsInput) (*emr.DescribeJobFlowsOutput, error)
 
+	DescribeJobFlowsWithContext(aws.Context, *emr.DescribeJobFlowsInput) (*emr.DescribeJobFlowsOutput, error)
+
 	DescribeStep(*emr.DescribeStepInput) (*emr.DescribeStepOutput, error)
 
+	DescribeStepWithContext(aws.Context, *emr.DescribeStepInput) (*emr.DescribeStepOutput, error)
+
 	ListBootstrapActions(*emr.ListBootstrapActionsInput) (*emr.ListBootstrapActionsOutput, error)
 
+	ListBootstrapActionsWithContext(aws.Context, *emr.ListBootstrapActionsInput) (*emr.ListBootstrapActionsOutput, error)
+
 	ListClusters(*emr.ListClustersInput) (*emr.ListClustersOutput, error)
 
+	ListClustersWithContext(aws.Context, *emr.ListClustersInput) (*emr.ListClustersOutput, error)
+
 	ListInstanceGroups(*emr.ListInstanceGroupsInput) (*emr.ListInstanceGroupsOutput, error)
 
+	ListInstanceGroupsWithContext(aws.Context, *emr.ListInstanceGroupsInput) (*emr.ListInstanceGroupsOutput, error)
+
 	ListInstances(*emr.ListInstancesInput) (*emr.ListInstancesOutput, error)
 
+	ListInstancesWithContext(aws.Context, *emr.ListInstancesInput) (*emr.ListInstancesOutput, error)
+
 	ListSteps(*emr.ListStepsInput) (*emr.ListStepsOutput, error)
 
+	ListStepsWithContext(aws.Context, *emr.ListStepsInput) (*emr.ListStepsOutput, error)
+
 	ModifyInstanceGroups(*emr.ModifyInstanceGroupsInput) (*emr.ModifyInstanceGroupsOutput, error)
 
+	ModifyInstanceGroupsWithContext(aws.Context, *emr.ModifyInstanceGroupsInput) (*emr.ModifyInstanceGroupsOutput, error)
+
 	RemoveTags(*emr.RemoveTagsInput) (*emr.RemoveTagsOutput, error)
 
+	RemoveTagsWithContext(aws.Context, *emr.RemoveTagsInput) (*emr.RemoveTagsOutput, error)
+
 	RunJobFlow(*emr.RunJobFlowInput) (*emr.RunJobFlowOutput, error)
 
+	RunJobFlowWithContext(aws.Context, *emr.RunJobFlowInput) (*emr.RunJobFlowOutput, error)
+
 	SetTerminationProtection(*emr.SetTerminationProtectionInput) (*emr.SetTerminationProtectionOutput, error)
 
+	SetTerminationProtectionWithContext(aws.Context, *emr.SetTerminationProtectionInput) (*emr.SetTerminationProtectionOutput, error)
+
 	SetVisibleToAllUsers(*emr.SetVisibleToAllUsersInput) (*emr.SetVisibleToAllUsersOutput, error)
 
+	SetVisibleToAllUsersWithContext(aws.Context, *emr.SetVisibleToAllUsersInput) (*emr.SetVisibleToAllUsersOutput, error)
+
 	TerminateJobFlows(*emr.TerminateJobFlowsInput) (*emr.TerminateJobFlowsOutput, error)
+
+	TerminateJobFlowsWithContext(aws.Context, *emr.TerminateJobFlowsInput) (*emr.TerminateJobFlowsOutput, error)
+
+	WaitUntilClusterRunning(*emr.DescribeClusterInput) error
+
+	WaitUntilStepComplete(*emr.DescribeStepInput) error
 }
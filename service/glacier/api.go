@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAbortMultipartUpload = "AbortMultipartUpload"
@@ -55,6 +56,16 @@ func (c *Glacier) AbortMultipartUpload(input *AbortMultipartUploadInput) (*Abort
 	return out, err
 }
 
+// AbortMultipartUploadWithContext is the same as AbortMultipartUpload with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) AbortMultipartUploadWithContext(ctx aws.Context, input *AbortMultipartUploadInput) (*AbortMultipartUploadOutput, error) {
+	req, out := c.AbortMultipartUploadRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddTagsToVault = "AddTagsToVault"
 
 // AddTagsToVaultRequest generates a request for the AddTagsToVault operation.
@@ -87,6 +98,16 @@ func (c *Glacier) AddTagsToVault(input *AddTagsToVaultInput) (*AddTagsToVaultOut
 	return out, err
 }
 
+// AddTagsToVaultWithContext is the same as AddTagsToVault with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) AddTagsToVaultWithContext(ctx aws.Context, input *AddTagsToVaultInput) (*AddTagsToVaultOutput, error) {
+	req, out := c.AddTagsToVaultRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCompleteMultipartUpload = "CompleteMultipartUpload"
 
 // CompleteMultipartUploadRequest generates a request for the CompleteMultipartUpload operation.
@@ -157,6 +178,16 @@ func (c *Glacier) CompleteMultipartUpload(input *CompleteMultipartUploadInput) (
 	return out, err
 }
 
+// CompleteMultipartUploadWithContext is the same as CompleteMultipartUpload with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) CompleteMultipartUploadWithContext(ctx aws.Context, input *CompleteMultipartUploadInput) (*ArchiveCreationOutput, error) {
+	req, out := c.CompleteMultipartUploadRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVault = "CreateVault"
 
 // CreateVaultRequest generates a request for the CreateVault operation.
@@ -207,6 +238,16 @@ func (c *Glacier) CreateVault(input *CreateVaultInput) (*CreateVaultOutput, erro
 	return out, err
 }
 
+// CreateVaultWithContext is the same as CreateVault with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) CreateVaultWithContext(ctx aws.Context, input *CreateVaultInput) (*CreateVaultOutput, error) {
+	req, out := c.CreateVaultRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteArchive = "DeleteArchive"
 
 // DeleteArchiveRequest generates a request for the DeleteArchive operation.
@@ -254,6 +295,16 @@ func (c *Glacier) DeleteArchive(input *DeleteArchiveInput) (*DeleteArchiveOutput
 	return out, err
 }
 
+// DeleteArchiveWithContext is the same as DeleteArchive with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) DeleteArchiveWithContext(ctx aws.Context, input *DeleteArchiveInput) (*DeleteArchiveOutput, error) {
+	req, out := c.DeleteArchiveRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVault = "DeleteVault"
 
 // DeleteVaultRequest generates a request for the DeleteVault operation.
@@ -303,6 +354,16 @@ func (c *Glacier) DeleteVault(input *DeleteVaultInput) (*DeleteVaultOutput, erro
 	return out, err
 }
 
+// DeleteVaultWithContext is the same as DeleteVault with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) DeleteVaultWithContext(ctx aws.Context, input *DeleteVaultInput) (*DeleteVaultOutput, error) {
+	req, out := c.DeleteVaultRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVaultAccessPolicy = "DeleteVaultAccessPolicy"
 
 // DeleteVaultAccessPolicyRequest generates a request for the DeleteVaultAccessPolicy operation.
@@ -339,6 +400,16 @@ func (c *Glacier) DeleteVaultAccessPolicy(input *DeleteVaultAccessPolicyInput) (
 	return out, err
 }
 
+// DeleteVaultAccessPolicyWithContext is the same as DeleteVaultAccessPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) DeleteVaultAccessPolicyWithContext(ctx aws.Context, input *DeleteVaultAccessPolicyInput) (*DeleteVaultAccessPolicyOutput, error) {
+	req, out := c.DeleteVaultAccessPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVaultNotifications = "DeleteVaultNotifications"
 
 // DeleteVaultNotificationsRequest generates a request for the DeleteVaultNotifications operation.
@@ -380,6 +451,16 @@ func (c *Glacier) DeleteVaultNotifications(input *DeleteVaultNotificationsInput)
 	return out, err
 }
 
+// DeleteVaultNotificationsWithContext is the same as DeleteVaultNotifications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) DeleteVaultNotificationsWithContext(ctx aws.Context, input *DeleteVaultNotificationsInput) (*DeleteVaultNotificationsOutput, error) {
+	req, out := c.DeleteVaultNotificationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeJob = "DescribeJob"
 
 // DescribeJobRequest generates a request for the DescribeJob operation.
@@ -428,6 +509,16 @@ func (c *Glacier) DescribeJob(input *DescribeJobInput) (*JobDescription, error)
 	return out, err
 }
 
+// DescribeJobWithContext is the same as DescribeJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) DescribeJobWithContext(ctx aws.Context, input *DescribeJobInput) (*JobDescription, error) {
+	req, out := c.DescribeJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVault = "DescribeVault"
 
 // DescribeVaultRequest generates a request for the DescribeVault operation.
@@ -474,6 +565,16 @@ func (c *Glacier) DescribeVault(input *DescribeVaultInput) (*DescribeVaultOutput
 	return out, err
 }
 
+// DescribeVaultWithContext is the same as DescribeVault with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) DescribeVaultWithContext(ctx aws.Context, input *DescribeVaultInput) (*DescribeVaultOutput, error) {
+	req, out := c.DescribeVaultRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDataRetrievalPolicy = "GetDataRetrievalPolicy"
 
 // GetDataRetrievalPolicyRequest generates a request for the GetDataRetrievalPolicy operation.
@@ -503,6 +604,16 @@ func (c *Glacier) GetDataRetrievalPolicy(input *GetDataRetrievalPolicyInput) (*G
 	return out, err
 }
 
+// GetDataRetrievalPolicyWithContext is the same as GetDataRetrievalPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) GetDataRetrievalPolicyWithContext(ctx aws.Context, input *GetDataRetrievalPolicyInput) (*GetDataRetrievalPolicyOutput, error) {
+	req, out := c.GetDataRetrievalPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetJobOutput = "GetJobOutput"
 
 // GetJobOutputRequest generates a request for the GetJobOutput operation.
@@ -573,6 +684,16 @@ func (c *Glacier) GetJobOutput(input *GetJobOutputInput) (*GetJobOutputOutput, e
 	return out, err
 }
 
+// GetJobOutputWithContext is the same as GetJobOutput with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) GetJobOutputWithContext(ctx aws.Context, input *GetJobOutputInput) (*GetJobOutputOutput, error) {
+	req, out := c.GetJobOutputRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetVaultAccessPolicy = "GetVaultAccessPolicy"
 
 // GetVaultAccessPolicyRequest generates a request for the GetVaultAccessPolicy operation.
@@ -605,6 +726,16 @@ func (c *Glacier) GetVaultAccessPolicy(input *GetVaultAccessPolicyInput) (*GetVa
 	return out, err
 }
 
+// GetVaultAccessPolicyWithContext is the same as GetVaultAccessPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) GetVaultAccessPolicyWithContext(ctx aws.Context, input *GetVaultAccessPolicyInput) (*GetVaultAccessPolicyOutput, error) {
+	req, out := c.GetVaultAccessPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetVaultNotifications = "GetVaultNotifications"
 
 // GetVaultNotificationsRequest generates a request for the GetVaultNotifications operation.
@@ -650,6 +781,16 @@ func (c *Glacier) GetVaultNotifications(input *GetVaultNotificationsInput) (*Get
 	return out, err
 }
 
+// GetVaultNotificationsWithContext is the same as GetVaultNotifications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) GetVaultNotificationsWithContext(ctx aws.Context, input *GetVaultNotificationsInput) (*GetVaultNotificationsOutput, error) {
+	req, out := c.GetVaultNotificationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInitiateJob = "InitiateJob"
 
 // InitiateJobRequest generates a request for the InitiateJob operation.
@@ -792,6 +933,16 @@ func (c *Glacier) InitiateJob(input *InitiateJobInput) (*InitiateJobOutput, erro
 	return out, err
 }
 
+// InitiateJobWithContext is the same as InitiateJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) InitiateJobWithContext(ctx aws.Context, input *InitiateJobInput) (*InitiateJobOutput, error) {
+	req, out := c.InitiateJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInitiateMultipartUpload = "InitiateMultipartUpload"
 
 // InitiateMultipartUploadRequest generates a request for the InitiateMultipartUpload operation.
@@ -853,6 +1004,16 @@ func (c *Glacier) InitiateMultipartUpload(input *InitiateMultipartUploadInput) (
 	return out, err
 }
 
+// InitiateMultipartUploadWithContext is the same as InitiateMultipartUpload with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) InitiateMultipartUploadWithContext(ctx aws.Context, input *InitiateMultipartUploadInput) (*InitiateMultipartUploadOutput, error) {
+	req, out := c.InitiateMultipartUploadRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListJobs = "ListJobs"
 
 // ListJobsRequest generates a request for the ListJobs operation.
@@ -925,6 +1086,16 @@ func (c *Glacier) ListJobs(input *ListJobsInput) (*ListJobsOutput, error) {
 	return out, err
 }
 
+// ListJobsWithContext is the same as ListJobs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) ListJobsWithContext(ctx aws.Context, input *ListJobsInput) (*ListJobsOutput, error) {
+	req, out := c.ListJobsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Glacier) ListJobsPages(input *ListJobsInput, fn func(p *ListJobsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListJobsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -994,6 +1165,16 @@ func (c *Glacier) ListMultipartUploads(input *ListMultipartUploadsInput) (*ListM
 	return out, err
 }
 
+// ListMultipartUploadsWithContext is the same as ListMultipartUploads with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) ListMultipartUploadsWithContext(ctx aws.Context, input *ListMultipartUploadsInput) (*ListMultipartUploadsOutput, error) {
+	req, out := c.ListMultipartUploadsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Glacier) ListMultipartUploadsPages(input *ListMultipartUploadsInput, fn func(p *ListMultipartUploadsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListMultipartUploadsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1057,6 +1238,16 @@ func (c *Glacier) ListParts(input *ListPartsInput) (*ListPartsOutput, error) {
 	return out, err
 }
 
+// ListPartsWithContext is the same as ListParts with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) ListPartsWithContext(ctx aws.Context, input *ListPartsInput) (*ListPartsOutput, error) {
+	req, out := c.ListPartsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Glacier) ListPartsPages(input *ListPartsInput, fn func(p *ListPartsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPartsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1093,6 +1284,16 @@ func (c *Glacier) ListTagsForVault(input *ListTagsForVaultInput) (*ListTagsForVa
 	return out, err
 }
 
+// ListTagsForVaultWithContext is the same as ListTagsForVault with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) ListTagsForVaultWithContext(ctx aws.Context, input *ListTagsForVaultInput) (*ListTagsForVaultOutput, error) {
+	req, out := c.ListTagsForVaultRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListVaults = "ListVaults"
 
 // ListVaultsRequest generates a request for the ListVaults operation.
@@ -1147,6 +1348,16 @@ func (c *Glacier) ListVaults(input *ListVaultsInput) (*ListVaultsOutput, error)
 	return out, err
 }
 
+// ListVaultsWithContext is the same as ListVaults with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) ListVaultsWithContext(ctx aws.Context, input *ListVaultsInput) (*ListVaultsOutput, error) {
+	req, out := c.ListVaultsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Glacier) ListVaultsPages(input *ListVaultsInput, fn func(p *ListVaultsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListVaultsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1185,6 +1396,16 @@ func (c *Glacier) RemoveTagsFromVault(input *RemoveTagsFromVaultInput) (*RemoveT
 	return out, err
 }
 
+// RemoveTagsFromVaultWithContext is the same as RemoveTagsFromVault with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) RemoveTagsFromVaultWithContext(ctx aws.Context, input *RemoveTagsFromVaultInput) (*RemoveTagsFromVaultOutput, error) {
+	req, out := c.RemoveTagsFromVaultRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetDataRetrievalPolicy = "SetDataRetrievalPolicy"
 
 // SetDataRetrievalPolicyRequest generates a request for the SetDataRetrievalPolicy operation.
@@ -1218,6 +1439,16 @@ func (c *Glacier) SetDataRetrievalPolicy(input *SetDataRetrievalPolicyInput) (*S
 	return out, err
 }
 
+// SetDataRetrievalPolicyWithContext is the same as SetDataRetrievalPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) SetDataRetrievalPolicyWithContext(ctx aws.Context, input *SetDataRetrievalPolicyInput) (*SetDataRetrievalPolicyOutput, error) {
+	req, out := c.SetDataRetrievalPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetVaultAccessPolicy = "SetVaultAccessPolicy"
 
 // SetVaultAccessPolicyRequest generates a request for the SetVaultAccessPolicy operation.
@@ -1251,6 +1482,16 @@ func (c *Glacier) SetVaultAccessPolicy(input *SetVaultAccessPolicyInput) (*SetVa
 	return out, err
 }
 
+// SetVaultAccessPolicyWithContext is the same as SetVaultAccessPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) SetVaultAccessPolicyWithContext(ctx aws.Context, input *SetVaultAccessPolicyInput) (*SetVaultAccessPolicyOutput, error) {
+	req, out := c.SetVaultAccessPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetVaultNotifications = "SetVaultNotifications"
 
 // SetVaultNotificationsRequest generates a request for the SetVaultNotifications operation.
@@ -1306,6 +1547,16 @@ func (c *Glacier) SetVaultNotifications(input *SetVaultNotificationsInput) (*Set
 	return out, err
 }
 
+// SetVaultNotificationsWithContext is the same as SetVaultNotifications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) SetVaultNotificationsWithContext(ctx aws.Context, input *SetVaultNotificationsInput) (*SetVaultNotificationsOutput, error) {
+	req, out := c.SetVaultNotificationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadArchive = "UploadArchive"
 
 // UploadArchiveRequest generates a request for the UploadArchive operation.
@@ -1368,6 +1619,16 @@ func (c *Glacier) UploadArchive(input *UploadArchiveInput) (*ArchiveCreationOutp
 	return out, err
 }
 
+// UploadArchiveWithContext is the same as UploadArchive with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) UploadArchiveWithContext(ctx aws.Context, input *UploadArchiveInput) (*ArchiveCreationOutput, error) {
+	req, out := c.UploadArchiveRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadMultipartPart = "UploadMultipartPart"
 
 // UploadMultipartPartRequest generates a request for the UploadMultipartPart operation.
@@ -1437,6 +1698,16 @@ func (c *Glacier) UploadMultipartPart(input *UploadMultipartPartInput) (*UploadM
 	return out, err
 }
 
+// UploadMultipartPartWithContext is the same as UploadMultipartPart with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Glacier) UploadMultipartPartWithContext(ctx aws.Context, input *UploadMultipartPartInput) (*UploadMultipartPartOutput, error) {
+	req, out := c.UploadMultipartPartRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Provides options to abort a multipart upload identified by the upload ID.
 //
 // For information about the underlying REST API, go to Abort Multipart Upload
@@ -1464,6 +1735,16 @@ type metadataAbortMultipartUploadInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AbortMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AbortMultipartUploadInput) GoString() string {
+	return s.String()
+}
+
 type AbortMultipartUploadOutput struct {
 	metadataAbortMultipartUploadOutput `json:"-" xml:"-"`
 }
@@ -1472,6 +1753,16 @@ type metadataAbortMultipartUploadOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AbortMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AbortMultipartUploadOutput) GoString() string {
+	return s.String()
+}
+
 // The input value for AddTagsToVault.
 type AddTagsToVaultInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1495,6 +1786,16 @@ type metadataAddTagsToVaultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsToVaultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsToVaultInput) GoString() string {
+	return s.String()
+}
+
 type AddTagsToVaultOutput struct {
 	metadataAddTagsToVaultOutput `json:"-" xml:"-"`
 }
@@ -1503,6 +1804,16 @@ type metadataAddTagsToVaultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsToVaultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsToVaultOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 //
 // For information about the underlying REST API, go to Upload Archive (http://docs.aws.amazon.com/amazonglacier/latest/dev/api-archive-post.html).
@@ -1525,6 +1836,16 @@ type metadataArchiveCreationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ArchiveCreationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ArchiveCreationOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options to complete a multipart upload operation. This informs Amazon
 // Glacier that all the archive parts have been uploaded and Amazon Glacier
 // can now assemble the archive from the uploaded parts. After assembling and
@@ -1561,6 +1882,16 @@ type metadataCompleteMultipartUploadInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CompleteMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CompleteMultipartUploadInput) GoString() string {
+	return s.String()
+}
+
 // Provides options to create a vault.
 type CreateVaultInput struct {
 	// The AccountId value is the AWS account ID. This value must match the AWS
@@ -1581,6 +1912,16 @@ type metadataCreateVaultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVaultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVaultInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type CreateVaultOutput struct {
 	// The URI of the vault that was created.
@@ -1593,6 +1934,16 @@ type metadataCreateVaultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVaultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVaultOutput) GoString() string {
+	return s.String()
+}
+
 // Data retrieval policy.
 type DataRetrievalPolicy struct {
 	// The policy rule. Although this is a list type, currently there must be only
@@ -1606,6 +1957,16 @@ type metadataDataRetrievalPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DataRetrievalPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DataRetrievalPolicy) GoString() string {
+	return s.String()
+}
+
 // Data retrieval policy rule.
 type DataRetrievalRule struct {
 	// The maximum number of bytes that can be retrieved in an hour.
@@ -1627,6 +1988,16 @@ type metadataDataRetrievalRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DataRetrievalRule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DataRetrievalRule) GoString() string {
+	return s.String()
+}
+
 // Provides options for deleting an archive from an Amazon Glacier vault.
 type DeleteArchiveInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1649,6 +2020,16 @@ type metadataDeleteArchiveInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteArchiveInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteArchiveInput) GoString() string {
+	return s.String()
+}
+
 type DeleteArchiveOutput struct {
 	metadataDeleteArchiveOutput `json:"-" xml:"-"`
 }
@@ -1657,6 +2038,16 @@ type metadataDeleteArchiveOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteArchiveOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteArchiveOutput) GoString() string {
+	return s.String()
+}
+
 // DeleteVaultAccessPolicy input.
 type DeleteVaultAccessPolicyInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1676,6 +2067,16 @@ type metadataDeleteVaultAccessPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVaultAccessPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVaultAccessPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVaultAccessPolicyOutput struct {
 	metadataDeleteVaultAccessPolicyOutput `json:"-" xml:"-"`
 }
@@ -1684,6 +2085,16 @@ type metadataDeleteVaultAccessPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVaultAccessPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVaultAccessPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for deleting a vault from Amazon Glacier.
 type DeleteVaultInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1703,6 +2114,16 @@ type metadataDeleteVaultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVaultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVaultInput) GoString() string {
+	return s.String()
+}
+
 // Provides options for deleting a vault notification configuration from an
 // Amazon Glacier vault.
 type DeleteVaultNotificationsInput struct {
@@ -1723,6 +2144,16 @@ type metadataDeleteVaultNotificationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVaultNotificationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVaultNotificationsInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVaultNotificationsOutput struct {
 	metadataDeleteVaultNotificationsOutput `json:"-" xml:"-"`
 }
@@ -1731,6 +2162,16 @@ type metadataDeleteVaultNotificationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVaultNotificationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVaultNotificationsOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVaultOutput struct {
 	metadataDeleteVaultOutput `json:"-" xml:"-"`
 }
@@ -1739,6 +2180,16 @@ type metadataDeleteVaultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVaultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVaultOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for retrieving a job description.
 type DescribeJobInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1761,6 +2212,16 @@ type metadataDescribeJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeJobInput) GoString() string {
+	return s.String()
+}
+
 // Provides options for retrieving metadata for a specific vault in Amazon Glacier.
 type DescribeVaultInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1780,6 +2241,16 @@ type metadataDescribeVaultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVaultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVaultInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type DescribeVaultOutput struct {
 	// The UTC date when the vault was created. A string representation of ISO 8601
@@ -1813,6 +2284,16 @@ type metadataDescribeVaultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVaultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVaultOutput) GoString() string {
+	return s.String()
+}
+
 // Input for GetDataRetrievalPolicy.
 type GetDataRetrievalPolicyInput struct {
 	// The AccountId value is the AWS account ID. This value must match the AWS
@@ -1830,6 +2311,16 @@ type metadataGetDataRetrievalPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDataRetrievalPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDataRetrievalPolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to the GetDataRetrievalPolicy request.
 type GetDataRetrievalPolicyOutput struct {
 	// Contains the returned data retrieval policy in JSON format.
@@ -1842,6 +2333,16 @@ type metadataGetDataRetrievalPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDataRetrievalPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDataRetrievalPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for downloading output of an Amazon Glacier job.
 type GetJobOutputInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1869,6 +2370,16 @@ type metadataGetJobOutputInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetJobOutputInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetJobOutputInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type GetJobOutputOutput struct {
 	// Indicates the range units accepted. For more information, go to RFC2616 (http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html).
@@ -1916,6 +2427,16 @@ type metadataGetJobOutputOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation
+func (s GetJobOutputOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetJobOutputOutput) GoString() string {
+	return s.String()
+}
+
 // Input for GetVaultAccessPolicy.
 type GetVaultAccessPolicyInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -1935,6 +2456,16 @@ type metadataGetVaultAccessPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetVaultAccessPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetVaultAccessPolicyInput) GoString() string {
+	return s.String()
+}
+
 // Output for GetVaultAccessPolicy.
 type GetVaultAccessPolicyOutput struct {
 	// Contains the returned vault access policy as a JSON string.
@@ -1947,6 +2478,16 @@ type metadataGetVaultAccessPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Policy"`
 }
 
+// String returns the string representation
+func (s GetVaultAccessPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetVaultAccessPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for retrieving the notification configuration set on an
 // Amazon Glacier vault.
 type GetVaultNotificationsInput struct {
@@ -1967,6 +2508,16 @@ type metadataGetVaultNotificationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetVaultNotificationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetVaultNotificationsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type GetVaultNotificationsOutput struct {
 	// Returns the notification configuration set on the vault.
@@ -1979,6 +2530,16 @@ type metadataGetVaultNotificationsOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"VaultNotificationConfig"`
 }
 
+// String returns the string representation
+func (s GetVaultNotificationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetVaultNotificationsOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for initiating an Amazon Glacier job.
 type InitiateJobInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2001,6 +2562,16 @@ type metadataInitiateJobInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"JobParameters"`
 }
 
+// String returns the string representation
+func (s InitiateJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InitiateJobInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type InitiateJobOutput struct {
 	// The ID of the job.
@@ -2016,6 +2587,16 @@ type metadataInitiateJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InitiateJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InitiateJobOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for initiating a multipart upload to an Amazon Glacier vault.
 type InitiateMultipartUploadInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2047,6 +2628,16 @@ type metadataInitiateMultipartUploadInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InitiateMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InitiateMultipartUploadInput) GoString() string {
+	return s.String()
+}
+
 // The Amazon Glacier response to your request.
 type InitiateMultipartUploadOutput struct {
 	// The relative URI path of the multipart upload ID Amazon Glacier created.
@@ -2063,6 +2654,16 @@ type metadataInitiateMultipartUploadOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InitiateMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InitiateMultipartUploadOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the options for a range inventory retrieval job.
 type InventoryRetrievalJobDescription struct {
 	// The end of the date range in UTC for vault inventory retrieval that includes
@@ -2099,6 +2700,16 @@ type metadataInventoryRetrievalJobDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InventoryRetrievalJobDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InventoryRetrievalJobDescription) GoString() string {
+	return s.String()
+}
+
 // Provides options for specifying a range inventory retrieval job.
 type InventoryRetrievalJobInput struct {
 	// The end of the date range in UTC for vault inventory retrieval that includes
@@ -2128,10 +2739,22 @@ type metadataInventoryRetrievalJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InventoryRetrievalJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InventoryRetrievalJobInput) GoString() string {
+	return s.String()
+}
+
 // Describes an Amazon Glacier job.
 type JobDescription struct {
 	// The job type. It is either ArchiveRetrieval or InventoryRetrieval.
-	Action *string `type:"string"`
+	//
+	// See the ActionCode* constants for valid values.
+	Action *string `type:"string" enum:"ArchiveRetrieval,InventoryRetrieval"`
 
 	// For an ArchiveRetrieval job, this is the archive ID requested for download.
 	// Otherwise, this field is null.
@@ -2199,7 +2822,9 @@ type JobDescription struct {
 
 	// The status code can be InProgress, Succeeded, or Failed, and indicates the
 	// status of the job.
-	StatusCode *string `type:"string"`
+	//
+	// See the StatusCode* constants for valid values.
+	StatusCode *string `type:"string" enum:"InProgress,Succeeded,Failed"`
 
 	// A friendly message that describes the job status.
 	StatusMessage *string `type:"string"`
@@ -2215,6 +2840,16 @@ type metadataJobDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobDescription) GoString() string {
+	return s.String()
+}
+
 // Provides options for defining a job.
 type JobParameters struct {
 	// The ID of the archive that you want to retrieve. This field is required only
@@ -2264,6 +2899,16 @@ type metadataJobParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s JobParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s JobParameters) GoString() string {
+	return s.String()
+}
+
 // Provides options for retrieving a job list for an Amazon Glacier vault.
 type ListJobsInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2300,6 +2945,16 @@ type metadataListJobsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListJobsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type ListJobsOutput struct {
 	// A list of job objects. Each job object contains metadata describing the job.
@@ -2317,6 +2972,16 @@ type metadataListJobsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListJobsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for retrieving list of in-progress multipart uploads for
 // an Amazon Glacier vault.
 type ListMultipartUploadsInput struct {
@@ -2348,6 +3013,16 @@ type metadataListMultipartUploadsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListMultipartUploadsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListMultipartUploadsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type ListMultipartUploadsOutput struct {
 	// An opaque string that represents where to continue pagination of the results.
@@ -2365,6 +3040,16 @@ type metadataListMultipartUploadsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListMultipartUploadsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListMultipartUploadsOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options for retrieving a list of parts of an archive that have been
 // uploaded in a specific multipart upload.
 type ListPartsInput struct {
@@ -2399,6 +3084,16 @@ type metadataListPartsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPartsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPartsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type ListPartsOutput struct {
 	// The description of the archive that was specified in the Initiate Multipart
@@ -2433,6 +3128,16 @@ type metadataListPartsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPartsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPartsOutput) GoString() string {
+	return s.String()
+}
+
 // The input value for ListTagsForVaultInput.
 type ListTagsForVaultInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2452,6 +3157,16 @@ type metadataListTagsForVaultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForVaultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForVaultInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type ListTagsForVaultOutput struct {
 	// The tags attached to the vault. Each tag is composed of a key and a value.
@@ -2464,6 +3179,16 @@ type metadataListTagsForVaultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForVaultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForVaultOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options to retrieve the vault list owned by the calling user's account.
 // The list provides metadata information for each vault.
 type ListVaultsInput struct {
@@ -2490,6 +3215,16 @@ type metadataListVaultsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVaultsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVaultsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type ListVaultsOutput struct {
 	// The vault ARN at which to continue pagination of the results. You use the
@@ -2506,6 +3241,16 @@ type metadataListVaultsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVaultsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVaultsOutput) GoString() string {
+	return s.String()
+}
+
 // A list of the part sizes of the multipart upload.
 type PartListElement struct {
 	// The byte range of a part, inclusive of the upper value of the range.
@@ -2522,6 +3267,16 @@ type metadataPartListElement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PartListElement) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PartListElement) GoString() string {
+	return s.String()
+}
+
 // The input value for RemoveTagsFromVaultInput.
 type RemoveTagsFromVaultInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2544,6 +3299,16 @@ type metadataRemoveTagsFromVaultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsFromVaultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsFromVaultInput) GoString() string {
+	return s.String()
+}
+
 type RemoveTagsFromVaultOutput struct {
 	metadataRemoveTagsFromVaultOutput `json:"-" xml:"-"`
 }
@@ -2552,6 +3317,16 @@ type metadataRemoveTagsFromVaultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsFromVaultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsFromVaultOutput) GoString() string {
+	return s.String()
+}
+
 // SetDataRetrievalPolicy input.
 type SetDataRetrievalPolicyInput struct {
 	// The AccountId value is the AWS account ID. This value must match the AWS
@@ -2572,6 +3347,16 @@ type metadataSetDataRetrievalPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetDataRetrievalPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetDataRetrievalPolicyInput) GoString() string {
+	return s.String()
+}
+
 type SetDataRetrievalPolicyOutput struct {
 	metadataSetDataRetrievalPolicyOutput `json:"-" xml:"-"`
 }
@@ -2580,6 +3365,16 @@ type metadataSetDataRetrievalPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetDataRetrievalPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetDataRetrievalPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // SetVaultAccessPolicy input.
 type SetVaultAccessPolicyInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2602,6 +3397,16 @@ type metadataSetVaultAccessPolicyInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Policy"`
 }
 
+// String returns the string representation
+func (s SetVaultAccessPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetVaultAccessPolicyInput) GoString() string {
+	return s.String()
+}
+
 type SetVaultAccessPolicyOutput struct {
 	metadataSetVaultAccessPolicyOutput `json:"-" xml:"-"`
 }
@@ -2610,6 +3415,16 @@ type metadataSetVaultAccessPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetVaultAccessPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetVaultAccessPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options to configure notifications that will be sent when specific
 // events happen to a vault.
 type SetVaultNotificationsInput struct {
@@ -2633,6 +3448,16 @@ type metadataSetVaultNotificationsInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"VaultNotificationConfig"`
 }
 
+// String returns the string representation
+func (s SetVaultNotificationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetVaultNotificationsInput) GoString() string {
+	return s.String()
+}
+
 type SetVaultNotificationsOutput struct {
 	metadataSetVaultNotificationsOutput `json:"-" xml:"-"`
 }
@@ -2641,6 +3466,16 @@ type metadataSetVaultNotificationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetVaultNotificationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetVaultNotificationsOutput) GoString() string {
+	return s.String()
+}
+
 // Provides options to add an archive to a vault.
 type UploadArchiveInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2669,6 +3504,16 @@ type metadataUploadArchiveInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation
+func (s UploadArchiveInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadArchiveInput) GoString() string {
+	return s.String()
+}
+
 // A list of in-progress multipart uploads for a vault.
 type UploadListElement struct {
 	// The description of the archive that was specified in the Initiate Multipart
@@ -2696,6 +3541,16 @@ type metadataUploadListElement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UploadListElement) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadListElement) GoString() string {
+	return s.String()
+}
+
 // Provides options to upload a part of an archive in a multipart upload operation.
 type UploadMultipartPartInput struct {
 	// The AccountId value is the AWS account ID of the account that owns the vault.
@@ -2730,6 +3585,16 @@ type metadataUploadMultipartPartInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation
+func (s UploadMultipartPartInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadMultipartPartInput) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Glacier response to your request.
 type UploadMultipartPartOutput struct {
 	// The SHA256 tree hash that Amazon Glacier computed for the uploaded part.
@@ -2742,6 +3607,16 @@ type metadataUploadMultipartPartOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UploadMultipartPartOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadMultipartPartOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the vault access policy.
 type VaultAccessPolicy struct {
 	// The vault access policy.
@@ -2754,6 +3629,16 @@ type metadataVaultAccessPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VaultAccessPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VaultAccessPolicy) GoString() string {
+	return s.String()
+}
+
 // Represents a vault's notification configuration.
 type VaultNotificationConfig struct {
 	// A list of one or more events for which Amazon Glacier will send a notification
@@ -2770,3 +3655,29 @@ type VaultNotificationConfig struct {
 type metadataVaultNotificationConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VaultNotificationConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VaultNotificationConfig) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ActionCode
+	ActionCodeArchiveRetrieval = "ArchiveRetrieval"
+	// @enum ActionCode
+	ActionCodeInventoryRetrieval = "InventoryRetrieval"
+)
+
+const (
+	// @enum StatusCode
+	StatusCodeInProgress = "InProgress"
+	// @enum StatusCode
+	StatusCodeSucceeded = "Succeeded"
+	// @enum StatusCode
+	StatusCodeFailed = "Failed"
+)
@@ -12,12 +12,22 @@ var (
 	defaultAccountID = "-"
 )
 
+// Named request handlers of the Glacier customizations. These can be used to
+// remove, or swap out, an individual customization from a request's handler
+// lists.
+var (
+	CopyParamsHandler    = aws.NamedHandler{Name: "glacier.CopyParamsHandler", Fn: copyParams}
+	AddAccountIDHandler  = aws.NamedHandler{Name: "glacier.AddAccountIDHandler", Fn: addAccountID}
+	AddChecksumHandler   = aws.NamedHandler{Name: "glacier.AddChecksumHandler", Fn: addChecksum}
+	AddAPIVersionHandler = aws.NamedHandler{Name: "glacier.AddAPIVersionHandler", Fn: addAPIVersion}
+)
+
 func init() {
 	initRequest = func(r *aws.Request) {
-		r.Handlers.Validate.PushFront(addAccountID)
-		r.Handlers.Validate.PushFront(copyParams) // this happens first
-		r.Handlers.Build.PushBack(addChecksum)
-		r.Handlers.Build.PushBack(addAPIVersion)
+		r.Handlers.Validate.PushFrontNamed(AddAccountIDHandler)
+		r.Handlers.Validate.PushFrontNamed(CopyParamsHandler) // this happens first
+		r.Handlers.Build.PushBackNamed(AddChecksumHandler)
+		r.Handlers.Build.PushBackNamed(AddAPIVersionHandler)
 	}
 }
 
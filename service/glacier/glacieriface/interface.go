@@ -4,6 +4,7 @@
 package glacieriface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
 )
 
@@ -11,55 +12,113 @@ import (
 type GlacierAPI interface {
 	AbortMultipartUpload(*glacier.AbortMultipartUploadInput) (*glacier.AbortMultipartUploadOutput, error)
 
+	AbortMultipartUploadWithContext(aws.Context, *glacier.AbortMultipartUploadInput) (*glacier.AbortMultipartUploadOutput, error)
+
 	AddTagsToVault(*glacier.AddTagsToVaultInput) (*glacier.AddTagsToVaultOutput, error)
 
+	AddTagsToVaultWithContext(aws.Context, *glacier.AddTagsToVaultInput) (*glacier.AddTagsToVaultOutput, error)
+
 	CompleteMultipartUpload(*glacier.CompleteMultipartUploadInput) (*glacier.ArchiveCreationOutput, error)
 
+	CompleteMultipartUploadWithContext(aws.Context, *glacier.CompleteMultipartUploadInput) (*glacier.ArchiveCreationOutput, error)
+
 	CreateVault(*glacier.CreateVaultInput) (*glacier.CreateVaultOutput, error)
 
+	CreateVaultWithContext(aws.Context, *glacier.CreateVaultInput) (*glacier.CreateVaultOutput, error)
+
 	DeleteArchive(*glacier.DeleteArchiveInput) (*glacier.DeleteArchiveOutput, error)
 
+	DeleteArchiveWithContext(aws.Context, *glacier.DeleteArchiveInput) (*glacier.DeleteArchiveOutput, error)
+
 	DeleteVault(*glacier.DeleteVaultInput) (*glacier.DeleteVaultOutput, error)
 
+	DeleteVaultWithContext(aws.Context, *glacier.DeleteVaultInput) (*glacier.DeleteVaultOutput, error)
+
 	DeleteVaultAccessPolicy(*glacier.DeleteVaultAccessPolicyInput) (*glacier.DeleteVaultAccessPolicyOutput, error)
 
+	DeleteVaultAccessPolicyWithContext(aws.Context, *glacier.DeleteVaultAccessPolicyInput) (*glacier.DeleteVaultAccessPolicyOutput, error)
+
 	DeleteVaultNotifications(*glacier.DeleteVaultNotificationsInput) (*glacier.DeleteVaultNotificationsOutput, error)
 
+	DeleteVaultNotificationsWithContext(aws.Context, *glacier.DeleteVaultNotificationsInput) (*glacier.DeleteVaultNotificationsOutput, error)
+
 	DescribeJob(*glacier.DescribeJobInput) (*glacier.JobDescription, error)
 
+	DescribeJobWithContext(aws.Context, *glacier.DescribeJobInput) (*glacier.JobDescription, error)
+
 	DescribeVault(*glacier.DescribeVaultInput) (*glacier.DescribeVaultOutput, error)
 
+	DescribeVaultWithContext(aws.Context, *glacier.DescribeVaultInput) (*glacier.DescribeVaultOutput, error)
+
 	GetDataRetrievalPolicy(*glacier.GetDataRetrievalPolicyInput) (*glacier.GetDataRetrievalPolicyOutput, error)
 
+	GetDataRetrievalPolicyWithContext(aws.Context, *glacier.GetDataRetrievalPolicyInput) (*glacier.GetDataRetrievalPolicyOutput, error)
+
 	GetJobOutput(*glacier.GetJobOutputInput) (*glacier.GetJobOutputOutput, error)
 
+	GetJobOutputWithContext(aws.Context, *glacier.GetJobOutputInput) (*glacier.GetJobOutputOutput, error)
+
 	GetVaultAccessPolicy(*glacier.GetVaultAccessPolicyInput) (*glacier.GetVaultAccessPolicyOutput, error)
 
+	GetVaultAccessPolicyWithContext(aws.Context, *glacier.GetVaultAccessPolicyInput) (*glacier.GetVaultAccessPolicyOutput, error)
+
 	GetVaultNotifications(*glacier.GetVaultNotificationsInput) (*glacier.GetVaultNotificationsOutput, error)
 
+	GetVaultNotificationsWithContext(aws.Context, *glacier.GetVaultNotificationsInput) (*glacier.GetVaultNotificationsOutput, error)
+
 	InitiateJob(*glacier.InitiateJobInput) (*glacier.InitiateJobOutput, error)
 
+	InitiateJobWithContext(aws.Context, *glacier.InitiateJobInput) (*glacier.InitiateJobOutput, error)
+
 	InitiateMultipartUpload(*glacier.InitiateMultipartUploadInput) (*glacier.InitiateMultipartUploadOutput, error)
 
+	InitiateMultipartUploadWithContext(aws.Context, *glacier.InitiateMultipartUploadInput) (*glacier.InitiateMultipartUploadOutput, error)
+
 	ListJobs(*glacier.ListJobsInput) (*glacier.ListJobsOutput, error)
 
+	ListJobsWithContext(aws.Context, *glacier.ListJobsInput) (*glacier.ListJobsOutput, error)
+
 	ListMultipartUploads(*glacier.ListMultipartUploadsInput) (*glacier.ListMultipartUploadsOutput, error)
 
+	ListMultipartUploadsWithContext(aws.Context, *glacier.ListMultipartUploadsInput) (*glacier.ListMultipartUploadsOutput, error)
+
 	ListParts(*glacier.ListPartsInput) (*glacier.ListPartsOutput, error)
 
+	ListPartsWithContext(aws.Context, *glacier.ListPartsInput) (*glacier.ListPartsOutput, error)
+
 	ListTagsForVault(*glacier.ListTagsForVaultInput) (*glacier.ListTagsForVaultOutput, error)
 
+	ListTagsForVaultWithContext(aws.Context, *glacier.ListTagsForVaultInput) (*glacier.ListTagsForVaultOutput, error)
+
 	ListVaults(*glacier.ListVaultsInput) (*glacier.ListVaultsOutput, error)
 
+	ListVaultsWithContext(aws.Context, *glacier.ListVaultsInput) (*glacier.ListVaultsOutput, error)
+
 	RemoveTagsFromVault(*glacier.RemoveTagsFromVaultInput) (*glacier.RemoveTagsFromVaultOutput, error)
 
+	RemoveTagsFromVaultWithContext(aws.Context, *glacier.RemoveTagsFromVaultInput) (*glacier.RemoveTagsFromVaultOutput, error)
+
 	SetDataRetrievalPolicy(*glacier.SetDataRetrievalPolicyInput) (*glacier.SetDataRetrievalPolicyOutput, error)
 
+	SetDataRetrievalPolicyWithContext(aws.Context, *glacier.SetDataRetrievalPolicyInput) (*glacier.SetDataRetrievalPolicyOutput, error)
+
 	SetVaultAccessPolicy(*glacier.SetVaultAccessPolicyInput) (*glacier.SetVaultAccessPolicyOutput, error)
 
+	SetVaultAccessPolicyWithContext(aws.Context, *glacier.SetVaultAccessPolicyInput) (*glacier.SetVaultAccessPolicyOutput, error)
+
 	SetVaultNotifications(*glacier.SetVaultNotificationsInput) (*glacier.SetVaultNotificationsOutput, error)
 
+	SetVaultNotificationsWithContext(aws.Context, *glacier.SetVaultNotificationsInput) (*glacier.SetVaultNotificationsOutput, error)
+
 	UploadArchive(*glacier.UploadArchiveInput) (*glacier.ArchiveCreationOutput, error)
 
+	UploadArchiveWithContext(aws.Context, *glacier.UploadArchiveInput) (*glacier.ArchiveCreationOutput, error)
+
 	UploadMultipartPart(*glacier.UploadMultipartPartInput) (*glacier.UploadMultipartPartOutput, error)
+
+	UploadMultipartPartWithContext(aws.Context, *glacier.UploadMultipartPartInput) (*glacier.UploadMultipartPartOutput, error)
+
+	WaitUntilVaultExists(*glacier.DescribeVaultInput) error
+
+	WaitUntilVaultNotExists(*glacier.DescribeVaultInput) error
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddClientIDToOpenIDConnectProvider = "AddClientIDToOpenIDConnectProvider"
@@ -40,6 +41,16 @@ func (c *IAM) AddClientIDToOpenIDConnectProvider(input *AddClientIDToOpenIDConne
 	return out, err
 }
 
+// AddClientIDToOpenIDConnectProviderWithContext is the same as AddClientIDToOpenIDConnectProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) AddClientIDToOpenIDConnectProviderWithContext(ctx aws.Context, input *AddClientIDToOpenIDConnectProviderInput) (*AddClientIDToOpenIDConnectProviderOutput, error) {
+	req, out := c.AddClientIDToOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddRoleToInstanceProfile = "AddRoleToInstanceProfile"
 
 // AddRoleToInstanceProfileRequest generates a request for the AddRoleToInstanceProfile operation.
@@ -70,6 +81,16 @@ func (c *IAM) AddRoleToInstanceProfile(input *AddRoleToInstanceProfileInput) (*A
 	return out, err
 }
 
+// AddRoleToInstanceProfileWithContext is the same as AddRoleToInstanceProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) AddRoleToInstanceProfileWithContext(ctx aws.Context, input *AddRoleToInstanceProfileInput) (*AddRoleToInstanceProfileOutput, error) {
+	req, out := c.AddRoleToInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddUserToGroup = "AddUserToGroup"
 
 // AddUserToGroupRequest generates a request for the AddUserToGroup operation.
@@ -97,6 +118,16 @@ func (c *IAM) AddUserToGroup(input *AddUserToGroupInput) (*AddUserToGroupOutput,
 	return out, err
 }
 
+// AddUserToGroupWithContext is the same as AddUserToGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) AddUserToGroupWithContext(ctx aws.Context, input *AddUserToGroupInput) (*AddUserToGroupOutput, error) {
+	req, out := c.AddUserToGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachGroupPolicy = "AttachGroupPolicy"
 
 // AttachGroupPolicyRequest generates a request for the AttachGroupPolicy operation.
@@ -131,6 +162,16 @@ func (c *IAM) AttachGroupPolicy(input *AttachGroupPolicyInput) (*AttachGroupPoli
 	return out, err
 }
 
+// AttachGroupPolicyWithContext is the same as AttachGroupPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) AttachGroupPolicyWithContext(ctx aws.Context, input *AttachGroupPolicyInput) (*AttachGroupPolicyOutput, error) {
+	req, out := c.AttachGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachRolePolicy = "AttachRolePolicy"
 
 // AttachRolePolicyRequest generates a request for the AttachRolePolicy operation.
@@ -169,6 +210,16 @@ func (c *IAM) AttachRolePolicy(input *AttachRolePolicyInput) (*AttachRolePolicyO
 	return out, err
 }
 
+// AttachRolePolicyWithContext is the same as AttachRolePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) AttachRolePolicyWithContext(ctx aws.Context, input *AttachRolePolicyInput) (*AttachRolePolicyOutput, error) {
+	req, out := c.AttachRolePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachUserPolicy = "AttachUserPolicy"
 
 // AttachUserPolicyRequest generates a request for the AttachUserPolicy operation.
@@ -203,6 +254,16 @@ func (c *IAM) AttachUserPolicy(input *AttachUserPolicyInput) (*AttachUserPolicyO
 	return out, err
 }
 
+// AttachUserPolicyWithContext is the same as AttachUserPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) AttachUserPolicyWithContext(ctx aws.Context, input *AttachUserPolicyInput) (*AttachUserPolicyOutput, error) {
+	req, out := c.AttachUserPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opChangePassword = "ChangePassword"
 
 // ChangePasswordRequest generates a request for the ChangePassword operation.
@@ -235,6 +296,16 @@ func (c *IAM) ChangePassword(input *ChangePasswordInput) (*ChangePasswordOutput,
 	return out, err
 }
 
+// ChangePasswordWithContext is the same as ChangePassword with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ChangePasswordWithContext(ctx aws.Context, input *ChangePasswordInput) (*ChangePasswordOutput, error) {
+	req, out := c.ChangePasswordRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAccessKey = "CreateAccessKey"
 
 // CreateAccessKeyRequest generates a request for the CreateAccessKey operation.
@@ -278,6 +349,16 @@ func (c *IAM) CreateAccessKey(input *CreateAccessKeyInput) (*CreateAccessKeyOutp
 	return out, err
 }
 
+// CreateAccessKeyWithContext is the same as CreateAccessKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateAccessKeyWithContext(ctx aws.Context, input *CreateAccessKeyInput) (*CreateAccessKeyOutput, error) {
+	req, out := c.CreateAccessKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAccountAlias = "CreateAccountAlias"
 
 // CreateAccountAliasRequest generates a request for the CreateAccountAlias operation.
@@ -307,6 +388,16 @@ func (c *IAM) CreateAccountAlias(input *CreateAccountAliasInput) (*CreateAccount
 	return out, err
 }
 
+// CreateAccountAliasWithContext is the same as CreateAccountAlias with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateAccountAliasWithContext(ctx aws.Context, input *CreateAccountAliasInput) (*CreateAccountAliasOutput, error) {
+	req, out := c.CreateAccountAliasRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateGroup = "CreateGroup"
 
 // CreateGroupRequest generates a request for the CreateGroup operation.
@@ -338,6 +429,16 @@ func (c *IAM) CreateGroup(input *CreateGroupInput) (*CreateGroupOutput, error) {
 	return out, err
 }
 
+// CreateGroupWithContext is the same as CreateGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateGroupWithContext(ctx aws.Context, input *CreateGroupInput) (*CreateGroupOutput, error) {
+	req, out := c.CreateGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInstanceProfile = "CreateInstanceProfile"
 
 // CreateInstanceProfileRequest generates a request for the CreateInstanceProfile operation.
@@ -370,6 +471,16 @@ func (c *IAM) CreateInstanceProfile(input *CreateInstanceProfileInput) (*CreateI
 	return out, err
 }
 
+// CreateInstanceProfileWithContext is the same as CreateInstanceProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateInstanceProfileWithContext(ctx aws.Context, input *CreateInstanceProfileInput) (*CreateInstanceProfileOutput, error) {
+	req, out := c.CreateInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLoginProfile = "CreateLoginProfile"
 
 // CreateLoginProfileRequest generates a request for the CreateLoginProfile operation.
@@ -400,6 +511,16 @@ func (c *IAM) CreateLoginProfile(input *CreateLoginProfileInput) (*CreateLoginPr
 	return out, err
 }
 
+// CreateLoginProfileWithContext is the same as CreateLoginProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateLoginProfileWithContext(ctx aws.Context, input *CreateLoginProfileInput) (*CreateLoginProfileOutput, error) {
+	req, out := c.CreateLoginProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateOpenIDConnectProvider = "CreateOpenIDConnectProvider"
 
 // CreateOpenIDConnectProviderRequest generates a request for the CreateOpenIDConnectProvider operation.
@@ -443,6 +564,16 @@ func (c *IAM) CreateOpenIDConnectProvider(input *CreateOpenIDConnectProviderInpu
 	return out, err
 }
 
+// CreateOpenIDConnectProviderWithContext is the same as CreateOpenIDConnectProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateOpenIDConnectProviderWithContext(ctx aws.Context, input *CreateOpenIDConnectProviderInput) (*CreateOpenIDConnectProviderOutput, error) {
+	req, out := c.CreateOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePolicy = "CreatePolicy"
 
 // CreatePolicyRequest generates a request for the CreatePolicy operation.
@@ -479,6 +610,16 @@ func (c *IAM) CreatePolicy(input *CreatePolicyInput) (*CreatePolicyOutput, error
 	return out, err
 }
 
+// CreatePolicyWithContext is the same as CreatePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreatePolicyWithContext(ctx aws.Context, input *CreatePolicyInput) (*CreatePolicyOutput, error) {
+	req, out := c.CreatePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePolicyVersion = "CreatePolicyVersion"
 
 // CreatePolicyVersionRequest generates a request for the CreatePolicyVersion operation.
@@ -518,6 +659,16 @@ func (c *IAM) CreatePolicyVersion(input *CreatePolicyVersionInput) (*CreatePolic
 	return out, err
 }
 
+// CreatePolicyVersionWithContext is the same as CreatePolicyVersion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreatePolicyVersionWithContext(ctx aws.Context, input *CreatePolicyVersionInput) (*CreatePolicyVersionOutput, error) {
+	req, out := c.CreatePolicyVersionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateRole = "CreateRole"
 
 // CreateRoleRequest generates a request for the CreateRole operation.
@@ -552,6 +703,16 @@ func (c *IAM) CreateRole(input *CreateRoleInput) (*CreateRoleOutput, error) {
 	return out, err
 }
 
+// CreateRoleWithContext is the same as CreateRole with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateRoleWithContext(ctx aws.Context, input *CreateRoleInput) (*CreateRoleOutput, error) {
+	req, out := c.CreateRoleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSAMLProvider = "CreateSAMLProvider"
 
 // CreateSAMLProviderRequest generates a request for the CreateSAMLProvider operation.
@@ -598,6 +759,16 @@ func (c *IAM) CreateSAMLProvider(input *CreateSAMLProviderInput) (*CreateSAMLPro
 	return out, err
 }
 
+// CreateSAMLProviderWithContext is the same as CreateSAMLProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateSAMLProviderWithContext(ctx aws.Context, input *CreateSAMLProviderInput) (*CreateSAMLProviderOutput, error) {
+	req, out := c.CreateSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateUser = "CreateUser"
 
 // CreateUserRequest generates a request for the CreateUser operation.
@@ -629,6 +800,16 @@ func (c *IAM) CreateUser(input *CreateUserInput) (*CreateUserOutput, error) {
 	return out, err
 }
 
+// CreateUserWithContext is the same as CreateUser with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateUserWithContext(ctx aws.Context, input *CreateUserInput) (*CreateUserOutput, error) {
+	req, out := c.CreateUserRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateVirtualMFADevice = "CreateVirtualMFADevice"
 
 // CreateVirtualMFADeviceRequest generates a request for the CreateVirtualMFADevice operation.
@@ -669,6 +850,16 @@ func (c *IAM) CreateVirtualMFADevice(input *CreateVirtualMFADeviceInput) (*Creat
 	return out, err
 }
 
+// CreateVirtualMFADeviceWithContext is the same as CreateVirtualMFADevice with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) CreateVirtualMFADeviceWithContext(ctx aws.Context, input *CreateVirtualMFADeviceInput) (*CreateVirtualMFADeviceOutput, error) {
+	req, out := c.CreateVirtualMFADeviceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeactivateMFADevice = "DeactivateMFADevice"
 
 // DeactivateMFADeviceRequest generates a request for the DeactivateMFADevice operation.
@@ -701,6 +892,16 @@ func (c *IAM) DeactivateMFADevice(input *DeactivateMFADeviceInput) (*DeactivateM
 	return out, err
 }
 
+// DeactivateMFADeviceWithContext is the same as DeactivateMFADevice with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeactivateMFADeviceWithContext(ctx aws.Context, input *DeactivateMFADeviceInput) (*DeactivateMFADeviceOutput, error) {
+	req, out := c.DeactivateMFADeviceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAccessKey = "DeleteAccessKey"
 
 // DeleteAccessKeyRequest generates a request for the DeleteAccessKey operation.
@@ -733,6 +934,16 @@ func (c *IAM) DeleteAccessKey(input *DeleteAccessKeyInput) (*DeleteAccessKeyOutp
 	return out, err
 }
 
+// DeleteAccessKeyWithContext is the same as DeleteAccessKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteAccessKeyWithContext(ctx aws.Context, input *DeleteAccessKeyInput) (*DeleteAccessKeyOutput, error) {
+	req, out := c.DeleteAccessKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAccountAlias = "DeleteAccountAlias"
 
 // DeleteAccountAliasRequest generates a request for the DeleteAccountAlias operation.
@@ -762,6 +973,16 @@ func (c *IAM) DeleteAccountAlias(input *DeleteAccountAliasInput) (*DeleteAccount
 	return out, err
 }
 
+// DeleteAccountAliasWithContext is the same as DeleteAccountAlias with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteAccountAliasWithContext(ctx aws.Context, input *DeleteAccountAliasInput) (*DeleteAccountAliasOutput, error) {
+	req, out := c.DeleteAccountAliasRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAccountPasswordPolicy = "DeleteAccountPasswordPolicy"
 
 // DeleteAccountPasswordPolicyRequest generates a request for the DeleteAccountPasswordPolicy operation.
@@ -789,6 +1010,16 @@ func (c *IAM) DeleteAccountPasswordPolicy(input *DeleteAccountPasswordPolicyInpu
 	return out, err
 }
 
+// DeleteAccountPasswordPolicyWithContext is the same as DeleteAccountPasswordPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteAccountPasswordPolicyWithContext(ctx aws.Context, input *DeleteAccountPasswordPolicyInput) (*DeleteAccountPasswordPolicyOutput, error) {
+	req, out := c.DeleteAccountPasswordPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteGroup = "DeleteGroup"
 
 // DeleteGroupRequest generates a request for the DeleteGroup operation.
@@ -817,6 +1048,16 @@ func (c *IAM) DeleteGroup(input *DeleteGroupInput) (*DeleteGroupOutput, error) {
 	return out, err
 }
 
+// DeleteGroupWithContext is the same as DeleteGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteGroupWithContext(ctx aws.Context, input *DeleteGroupInput) (*DeleteGroupOutput, error) {
+	req, out := c.DeleteGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteGroupPolicy = "DeleteGroupPolicy"
 
 // DeleteGroupPolicyRequest generates a request for the DeleteGroupPolicy operation.
@@ -849,6 +1090,16 @@ func (c *IAM) DeleteGroupPolicy(input *DeleteGroupPolicyInput) (*DeleteGroupPoli
 	return out, err
 }
 
+// DeleteGroupPolicyWithContext is the same as DeleteGroupPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteGroupPolicyWithContext(ctx aws.Context, input *DeleteGroupPolicyInput) (*DeleteGroupPolicyOutput, error) {
+	req, out := c.DeleteGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteInstanceProfile = "DeleteInstanceProfile"
 
 // DeleteInstanceProfileRequest generates a request for the DeleteInstanceProfile operation.
@@ -883,6 +1134,16 @@ func (c *IAM) DeleteInstanceProfile(input *DeleteInstanceProfileInput) (*DeleteI
 	return out, err
 }
 
+// DeleteInstanceProfileWithContext is the same as DeleteInstanceProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteInstanceProfileWithContext(ctx aws.Context, input *DeleteInstanceProfileInput) (*DeleteInstanceProfileOutput, error) {
+	req, out := c.DeleteInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLoginProfile = "DeleteLoginProfile"
 
 // DeleteLoginProfileRequest generates a request for the DeleteLoginProfile operation.
@@ -916,6 +1177,16 @@ func (c *IAM) DeleteLoginProfile(input *DeleteLoginProfileInput) (*DeleteLoginPr
 	return out, err
 }
 
+// DeleteLoginProfileWithContext is the same as DeleteLoginProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteLoginProfileWithContext(ctx aws.Context, input *DeleteLoginProfileInput) (*DeleteLoginProfileOutput, error) {
+	req, out := c.DeleteLoginProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteOpenIDConnectProvider = "DeleteOpenIDConnectProvider"
 
 // DeleteOpenIDConnectProviderRequest generates a request for the DeleteOpenIDConnectProvider operation.
@@ -950,6 +1221,16 @@ func (c *IAM) DeleteOpenIDConnectProvider(input *DeleteOpenIDConnectProviderInpu
 	return out, err
 }
 
+// DeleteOpenIDConnectProviderWithContext is the same as DeleteOpenIDConnectProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteOpenIDConnectProviderWithContext(ctx aws.Context, input *DeleteOpenIDConnectProviderInput) (*DeleteOpenIDConnectProviderOutput, error) {
+	req, out := c.DeleteOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePolicy = "DeletePolicy"
 
 // DeletePolicyRequest generates a request for the DeletePolicy operation.
@@ -994,6 +1275,16 @@ func (c *IAM) DeletePolicy(input *DeletePolicyInput) (*DeletePolicyOutput, error
 	return out, err
 }
 
+// DeletePolicyWithContext is the same as DeletePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeletePolicyWithContext(ctx aws.Context, input *DeletePolicyInput) (*DeletePolicyOutput, error) {
+	req, out := c.DeletePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePolicyVersion = "DeletePolicyVersion"
 
 // DeletePolicyVersionRequest generates a request for the DeletePolicyVersion operation.
@@ -1029,6 +1320,16 @@ func (c *IAM) DeletePolicyVersion(input *DeletePolicyVersionInput) (*DeletePolic
 	return out, err
 }
 
+// DeletePolicyVersionWithContext is the same as DeletePolicyVersion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeletePolicyVersionWithContext(ctx aws.Context, input *DeletePolicyVersionInput) (*DeletePolicyVersionOutput, error) {
+	req, out := c.DeletePolicyVersionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRole = "DeleteRole"
 
 // DeleteRoleRequest generates a request for the DeleteRole operation.
@@ -1061,6 +1362,16 @@ func (c *IAM) DeleteRole(input *DeleteRoleInput) (*DeleteRoleOutput, error) {
 	return out, err
 }
 
+// DeleteRoleWithContext is the same as DeleteRole with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteRoleWithContext(ctx aws.Context, input *DeleteRoleInput) (*DeleteRoleOutput, error) {
+	req, out := c.DeleteRoleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRolePolicy = "DeleteRolePolicy"
 
 // DeleteRolePolicyRequest generates a request for the DeleteRolePolicy operation.
@@ -1093,6 +1404,16 @@ func (c *IAM) DeleteRolePolicy(input *DeleteRolePolicyInput) (*DeleteRolePolicyO
 	return out, err
 }
 
+// DeleteRolePolicyWithContext is the same as DeleteRolePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteRolePolicyWithContext(ctx aws.Context, input *DeleteRolePolicyInput) (*DeleteRolePolicyOutput, error) {
+	req, out := c.DeleteRolePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSAMLProvider = "DeleteSAMLProvider"
 
 // DeleteSAMLProviderRequest generates a request for the DeleteSAMLProvider operation.
@@ -1126,6 +1447,16 @@ func (c *IAM) DeleteSAMLProvider(input *DeleteSAMLProviderInput) (*DeleteSAMLPro
 	return out, err
 }
 
+// DeleteSAMLProviderWithContext is the same as DeleteSAMLProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteSAMLProviderWithContext(ctx aws.Context, input *DeleteSAMLProviderInput) (*DeleteSAMLProviderOutput, error) {
+	req, out := c.DeleteSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteServerCertificate = "DeleteServerCertificate"
 
 // DeleteServerCertificateRequest generates a request for the DeleteServerCertificate operation.
@@ -1163,6 +1494,16 @@ func (c *IAM) DeleteServerCertificate(input *DeleteServerCertificateInput) (*Del
 	return out, err
 }
 
+// DeleteServerCertificateWithContext is the same as DeleteServerCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteServerCertificateWithContext(ctx aws.Context, input *DeleteServerCertificateInput) (*DeleteServerCertificateOutput, error) {
+	req, out := c.DeleteServerCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSigningCertificate = "DeleteSigningCertificate"
 
 // DeleteSigningCertificateRequest generates a request for the DeleteSigningCertificate operation.
@@ -1195,6 +1536,16 @@ func (c *IAM) DeleteSigningCertificate(input *DeleteSigningCertificateInput) (*D
 	return out, err
 }
 
+// DeleteSigningCertificateWithContext is the same as DeleteSigningCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteSigningCertificateWithContext(ctx aws.Context, input *DeleteSigningCertificateInput) (*DeleteSigningCertificateOutput, error) {
+	req, out := c.DeleteSigningCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteUser = "DeleteUser"
 
 // DeleteUserRequest generates a request for the DeleteUser operation.
@@ -1223,6 +1574,16 @@ func (c *IAM) DeleteUser(input *DeleteUserInput) (*DeleteUserOutput, error) {
 	return out, err
 }
 
+// DeleteUserWithContext is the same as DeleteUser with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteUserWithContext(ctx aws.Context, input *DeleteUserInput) (*DeleteUserOutput, error) {
+	req, out := c.DeleteUserRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteUserPolicy = "DeleteUserPolicy"
 
 // DeleteUserPolicyRequest generates a request for the DeleteUserPolicy operation.
@@ -1255,6 +1616,16 @@ func (c *IAM) DeleteUserPolicy(input *DeleteUserPolicyInput) (*DeleteUserPolicyO
 	return out, err
 }
 
+// DeleteUserPolicyWithContext is the same as DeleteUserPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteUserPolicyWithContext(ctx aws.Context, input *DeleteUserPolicyInput) (*DeleteUserPolicyOutput, error) {
+	req, out := c.DeleteUserPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVirtualMFADevice = "DeleteVirtualMFADevice"
 
 // DeleteVirtualMFADeviceRequest generates a request for the DeleteVirtualMFADevice operation.
@@ -1285,6 +1656,16 @@ func (c *IAM) DeleteVirtualMFADevice(input *DeleteVirtualMFADeviceInput) (*Delet
 	return out, err
 }
 
+// DeleteVirtualMFADeviceWithContext is the same as DeleteVirtualMFADevice with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DeleteVirtualMFADeviceWithContext(ctx aws.Context, input *DeleteVirtualMFADeviceInput) (*DeleteVirtualMFADeviceOutput, error) {
+	req, out := c.DeleteVirtualMFADeviceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachGroupPolicy = "DetachGroupPolicy"
 
 // DetachGroupPolicyRequest generates a request for the DetachGroupPolicy operation.
@@ -1317,6 +1698,16 @@ func (c *IAM) DetachGroupPolicy(input *DetachGroupPolicyInput) (*DetachGroupPoli
 	return out, err
 }
 
+// DetachGroupPolicyWithContext is the same as DetachGroupPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DetachGroupPolicyWithContext(ctx aws.Context, input *DetachGroupPolicyInput) (*DetachGroupPolicyOutput, error) {
+	req, out := c.DetachGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachRolePolicy = "DetachRolePolicy"
 
 // DetachRolePolicyRequest generates a request for the DetachRolePolicy operation.
@@ -1349,6 +1740,16 @@ func (c *IAM) DetachRolePolicy(input *DetachRolePolicyInput) (*DetachRolePolicyO
 	return out, err
 }
 
+// DetachRolePolicyWithContext is the same as DetachRolePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DetachRolePolicyWithContext(ctx aws.Context, input *DetachRolePolicyInput) (*DetachRolePolicyOutput, error) {
+	req, out := c.DetachRolePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachUserPolicy = "DetachUserPolicy"
 
 // DetachUserPolicyRequest generates a request for the DetachUserPolicy operation.
@@ -1381,6 +1782,16 @@ func (c *IAM) DetachUserPolicy(input *DetachUserPolicyInput) (*DetachUserPolicyO
 	return out, err
 }
 
+// DetachUserPolicyWithContext is the same as DetachUserPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) DetachUserPolicyWithContext(ctx aws.Context, input *DetachUserPolicyInput) (*DetachUserPolicyOutput, error) {
+	req, out := c.DetachUserPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableMFADevice = "EnableMFADevice"
 
 // EnableMFADeviceRequest generates a request for the EnableMFADevice operation.
@@ -1410,6 +1821,16 @@ func (c *IAM) EnableMFADevice(input *EnableMFADeviceInput) (*EnableMFADeviceOutp
 	return out, err
 }
 
+// EnableMFADeviceWithContext is the same as EnableMFADevice with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) EnableMFADeviceWithContext(ctx aws.Context, input *EnableMFADeviceInput) (*EnableMFADeviceOutput, error) {
+	req, out := c.EnableMFADeviceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateCredentialReport = "GenerateCredentialReport"
 
 // GenerateCredentialReportRequest generates a request for the GenerateCredentialReport operation.
@@ -1439,6 +1860,16 @@ func (c *IAM) GenerateCredentialReport(input *GenerateCredentialReportInput) (*G
 	return out, err
 }
 
+// GenerateCredentialReportWithContext is the same as GenerateCredentialReport with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GenerateCredentialReportWithContext(ctx aws.Context, input *GenerateCredentialReportInput) (*GenerateCredentialReportOutput, error) {
+	req, out := c.GenerateCredentialReportRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetAccessKeyLastUsed = "GetAccessKeyLastUsed"
 
 // GetAccessKeyLastUsedRequest generates a request for the GetAccessKeyLastUsed operation.
@@ -1469,6 +1900,16 @@ func (c *IAM) GetAccessKeyLastUsed(input *GetAccessKeyLastUsedInput) (*GetAccess
 	return out, err
 }
 
+// GetAccessKeyLastUsedWithContext is the same as GetAccessKeyLastUsed with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetAccessKeyLastUsedWithContext(ctx aws.Context, input *GetAccessKeyLastUsedInput) (*GetAccessKeyLastUsedOutput, error) {
+	req, out := c.GetAccessKeyLastUsedRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetAccountAuthorizationDetails = "GetAccountAuthorizationDetails"
 
 // GetAccountAuthorizationDetailsRequest generates a request for the GetAccountAuthorizationDetails operation.
@@ -1508,6 +1949,16 @@ func (c *IAM) GetAccountAuthorizationDetails(input *GetAccountAuthorizationDetai
 	return out, err
 }
 
+// GetAccountAuthorizationDetailsWithContext is the same as GetAccountAuthorizationDetails with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetAccountAuthorizationDetailsWithContext(ctx aws.Context, input *GetAccountAuthorizationDetailsInput) (*GetAccountAuthorizationDetailsOutput, error) {
+	req, out := c.GetAccountAuthorizationDetailsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) GetAccountAuthorizationDetailsPages(input *GetAccountAuthorizationDetailsInput, fn func(p *GetAccountAuthorizationDetailsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetAccountAuthorizationDetailsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1543,6 +1994,16 @@ func (c *IAM) GetAccountPasswordPolicy(input *GetAccountPasswordPolicyInput) (*G
 	return out, err
 }
 
+// GetAccountPasswordPolicyWithContext is the same as GetAccountPasswordPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetAccountPasswordPolicyWithContext(ctx aws.Context, input *GetAccountPasswordPolicyInput) (*GetAccountPasswordPolicyOutput, error) {
+	req, out := c.GetAccountPasswordPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetAccountSummary = "GetAccountSummary"
 
 // GetAccountSummaryRequest generates a request for the GetAccountSummary operation.
@@ -1574,6 +2035,16 @@ func (c *IAM) GetAccountSummary(input *GetAccountSummaryInput) (*GetAccountSumma
 	return out, err
 }
 
+// GetAccountSummaryWithContext is the same as GetAccountSummary with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetAccountSummaryWithContext(ctx aws.Context, input *GetAccountSummaryInput) (*GetAccountSummaryOutput, error) {
+	req, out := c.GetAccountSummaryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCredentialReport = "GetCredentialReport"
 
 // GetCredentialReportRequest generates a request for the GetCredentialReport operation.
@@ -1603,6 +2074,16 @@ func (c *IAM) GetCredentialReport(input *GetCredentialReportInput) (*GetCredenti
 	return out, err
 }
 
+// GetCredentialReportWithContext is the same as GetCredentialReport with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetCredentialReportWithContext(ctx aws.Context, input *GetCredentialReportInput) (*GetCredentialReportOutput, error) {
+	req, out := c.GetCredentialReportRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetGroup = "GetGroup"
 
 // GetGroupRequest generates a request for the GetGroup operation.
@@ -1637,6 +2118,16 @@ func (c *IAM) GetGroup(input *GetGroupInput) (*GetGroupOutput, error) {
 	return out, err
 }
 
+// GetGroupWithContext is the same as GetGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetGroupWithContext(ctx aws.Context, input *GetGroupInput) (*GetGroupOutput, error) {
+	req, out := c.GetGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) GetGroupPages(input *GetGroupInput, fn func(p *GetGroupOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetGroupRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1681,6 +2172,16 @@ func (c *IAM) GetGroupPolicy(input *GetGroupPolicyInput) (*GetGroupPolicyOutput,
 	return out, err
 }
 
+// GetGroupPolicyWithContext is the same as GetGroupPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetGroupPolicyWithContext(ctx aws.Context, input *GetGroupPolicyInput) (*GetGroupPolicyOutput, error) {
+	req, out := c.GetGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetInstanceProfile = "GetInstanceProfile"
 
 // GetInstanceProfileRequest generates a request for the GetInstanceProfile operation.
@@ -1711,6 +2212,16 @@ func (c *IAM) GetInstanceProfile(input *GetInstanceProfileInput) (*GetInstancePr
 	return out, err
 }
 
+// GetInstanceProfileWithContext is the same as GetInstanceProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetInstanceProfileWithContext(ctx aws.Context, input *GetInstanceProfileInput) (*GetInstanceProfileOutput, error) {
+	req, out := c.GetInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetLoginProfile = "GetLoginProfile"
 
 // GetLoginProfileRequest generates a request for the GetLoginProfile operation.
@@ -1740,7 +2251,17 @@ func (c *IAM) GetLoginProfile(input *GetLoginProfileInput) (*GetLoginProfileOutp
 	return out, err
 }
 
-const opGetOpenIDConnectProvider = "GetOpenIDConnectProvider"
+// GetLoginProfileWithContext is the same as GetLoginProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetLoginProfileWithContext(ctx aws.Context, input *GetLoginProfileInput) (*GetLoginProfileOutput, error) {
+	req, out := c.GetLoginProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opGetOpenIDConnectProvider = "GetOpenIDConnectProvider"
 
 // GetOpenIDConnectProviderRequest generates a request for the GetOpenIDConnectProvider operation.
 func (c *IAM) GetOpenIDConnectProviderRequest(input *GetOpenIDConnectProviderInput) (req *aws.Request, output *GetOpenIDConnectProviderOutput) {
@@ -1767,6 +2288,16 @@ func (c *IAM) GetOpenIDConnectProvider(input *GetOpenIDConnectProviderInput) (*G
 	return out, err
 }
 
+// GetOpenIDConnectProviderWithContext is the same as GetOpenIDConnectProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetOpenIDConnectProviderWithContext(ctx aws.Context, input *GetOpenIDConnectProviderInput) (*GetOpenIDConnectProviderOutput, error) {
+	req, out := c.GetOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPolicy = "GetPolicy"
 
 // GetPolicyRequest generates a request for the GetPolicy operation.
@@ -1807,6 +2338,16 @@ func (c *IAM) GetPolicy(input *GetPolicyInput) (*GetPolicyOutput, error) {
 	return out, err
 }
 
+// GetPolicyWithContext is the same as GetPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetPolicyWithContext(ctx aws.Context, input *GetPolicyInput) (*GetPolicyOutput, error) {
+	req, out := c.GetPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPolicyVersion = "GetPolicyVersion"
 
 // GetPolicyVersionRequest generates a request for the GetPolicyVersion operation.
@@ -1845,6 +2386,16 @@ func (c *IAM) GetPolicyVersion(input *GetPolicyVersionInput) (*GetPolicyVersionO
 	return out, err
 }
 
+// GetPolicyVersionWithContext is the same as GetPolicyVersion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetPolicyVersionWithContext(ctx aws.Context, input *GetPolicyVersionInput) (*GetPolicyVersionOutput, error) {
+	req, out := c.GetPolicyVersionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetRole = "GetRole"
 
 // GetRoleRequest generates a request for the GetRole operation.
@@ -1875,6 +2426,16 @@ func (c *IAM) GetRole(input *GetRoleInput) (*GetRoleOutput, error) {
 	return out, err
 }
 
+// GetRoleWithContext is the same as GetRole with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetRoleWithContext(ctx aws.Context, input *GetRoleInput) (*GetRoleOutput, error) {
+	req, out := c.GetRoleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetRolePolicy = "GetRolePolicy"
 
 // GetRolePolicyRequest generates a request for the GetRolePolicy operation.
@@ -1915,6 +2476,16 @@ func (c *IAM) GetRolePolicy(input *GetRolePolicyInput) (*GetRolePolicyOutput, er
 	return out, err
 }
 
+// GetRolePolicyWithContext is the same as GetRolePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetRolePolicyWithContext(ctx aws.Context, input *GetRolePolicyInput) (*GetRolePolicyOutput, error) {
+	req, out := c.GetRolePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSAMLProvider = "GetSAMLProvider"
 
 // GetSAMLProviderRequest generates a request for the GetSAMLProvider operation.
@@ -1945,6 +2516,16 @@ func (c *IAM) GetSAMLProvider(input *GetSAMLProviderInput) (*GetSAMLProviderOutp
 	return out, err
 }
 
+// GetSAMLProviderWithContext is the same as GetSAMLProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetSAMLProviderWithContext(ctx aws.Context, input *GetSAMLProviderInput) (*GetSAMLProviderOutput, error) {
+	req, out := c.GetSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetServerCertificate = "GetServerCertificate"
 
 // GetServerCertificateRequest generates a request for the GetServerCertificate operation.
@@ -1972,6 +2553,16 @@ func (c *IAM) GetServerCertificate(input *GetServerCertificateInput) (*GetServer
 	return out, err
 }
 
+// GetServerCertificateWithContext is the same as GetServerCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetServerCertificateWithContext(ctx aws.Context, input *GetServerCertificateInput) (*GetServerCertificateOutput, error) {
+	req, out := c.GetServerCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetUser = "GetUser"
 
 // GetUserRequest generates a request for the GetUser operation.
@@ -2003,6 +2594,16 @@ func (c *IAM) GetUser(input *GetUserInput) (*GetUserOutput, error) {
 	return out, err
 }
 
+// GetUserWithContext is the same as GetUser with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetUserWithContext(ctx aws.Context, input *GetUserInput) (*GetUserOutput, error) {
+	req, out := c.GetUserRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetUserPolicy = "GetUserPolicy"
 
 // GetUserPolicyRequest generates a request for the GetUserPolicy operation.
@@ -2040,6 +2641,16 @@ func (c *IAM) GetUserPolicy(input *GetUserPolicyInput) (*GetUserPolicyOutput, er
 	return out, err
 }
 
+// GetUserPolicyWithContext is the same as GetUserPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) GetUserPolicyWithContext(ctx aws.Context, input *GetUserPolicyInput) (*GetUserPolicyOutput, error) {
+	req, out := c.GetUserPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListAccessKeys = "ListAccessKeys"
 
 // ListAccessKeysRequest generates a request for the ListAccessKeys operation.
@@ -2085,6 +2696,16 @@ func (c *IAM) ListAccessKeys(input *ListAccessKeysInput) (*ListAccessKeysOutput,
 	return out, err
 }
 
+// ListAccessKeysWithContext is the same as ListAccessKeys with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListAccessKeysWithContext(ctx aws.Context, input *ListAccessKeysInput) (*ListAccessKeysOutput, error) {
+	req, out := c.ListAccessKeysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListAccessKeysPages(input *ListAccessKeysInput, fn func(p *ListAccessKeysOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAccessKeysRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2129,6 +2750,16 @@ func (c *IAM) ListAccountAliases(input *ListAccountAliasesInput) (*ListAccountAl
 	return out, err
 }
 
+// ListAccountAliasesWithContext is the same as ListAccountAliases with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListAccountAliasesWithContext(ctx aws.Context, input *ListAccountAliasesInput) (*ListAccountAliasesOutput, error) {
+	req, out := c.ListAccountAliasesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListAccountAliasesPages(input *ListAccountAliasesInput, fn func(p *ListAccountAliasesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAccountAliasesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2180,6 +2811,16 @@ func (c *IAM) ListAttachedGroupPolicies(input *ListAttachedGroupPoliciesInput) (
 	return out, err
 }
 
+// ListAttachedGroupPoliciesWithContext is the same as ListAttachedGroupPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListAttachedGroupPoliciesWithContext(ctx aws.Context, input *ListAttachedGroupPoliciesInput) (*ListAttachedGroupPoliciesOutput, error) {
+	req, out := c.ListAttachedGroupPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListAttachedGroupPoliciesPages(input *ListAttachedGroupPoliciesInput, fn func(p *ListAttachedGroupPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAttachedGroupPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2231,6 +2872,16 @@ func (c *IAM) ListAttachedRolePolicies(input *ListAttachedRolePoliciesInput) (*L
 	return out, err
 }
 
+// ListAttachedRolePoliciesWithContext is the same as ListAttachedRolePolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListAttachedRolePoliciesWithContext(ctx aws.Context, input *ListAttachedRolePoliciesInput) (*ListAttachedRolePoliciesOutput, error) {
+	req, out := c.ListAttachedRolePoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListAttachedRolePoliciesPages(input *ListAttachedRolePoliciesInput, fn func(p *ListAttachedRolePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAttachedRolePoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2282,6 +2933,16 @@ func (c *IAM) ListAttachedUserPolicies(input *ListAttachedUserPoliciesInput) (*L
 	return out, err
 }
 
+// ListAttachedUserPoliciesWithContext is the same as ListAttachedUserPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListAttachedUserPoliciesWithContext(ctx aws.Context, input *ListAttachedUserPoliciesInput) (*ListAttachedUserPoliciesOutput, error) {
+	req, out := c.ListAttachedUserPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListAttachedUserPoliciesPages(input *ListAttachedUserPoliciesInput, fn func(p *ListAttachedUserPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAttachedUserPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2330,6 +2991,16 @@ func (c *IAM) ListEntitiesForPolicy(input *ListEntitiesForPolicyInput) (*ListEnt
 	return out, err
 }
 
+// ListEntitiesForPolicyWithContext is the same as ListEntitiesForPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListEntitiesForPolicyWithContext(ctx aws.Context, input *ListEntitiesForPolicyInput) (*ListEntitiesForPolicyOutput, error) {
+	req, out := c.ListEntitiesForPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListEntitiesForPolicyPages(input *ListEntitiesForPolicyInput, fn func(p *ListEntitiesForPolicyOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListEntitiesForPolicyRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2381,6 +3052,16 @@ func (c *IAM) ListGroupPolicies(input *ListGroupPoliciesInput) (*ListGroupPolici
 	return out, err
 }
 
+// ListGroupPoliciesWithContext is the same as ListGroupPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListGroupPoliciesWithContext(ctx aws.Context, input *ListGroupPoliciesInput) (*ListGroupPoliciesOutput, error) {
+	req, out := c.ListGroupPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListGroupPoliciesPages(input *ListGroupPoliciesInput, fn func(p *ListGroupPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGroupPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2423,6 +3104,16 @@ func (c *IAM) ListGroups(input *ListGroupsInput) (*ListGroupsOutput, error) {
 	return out, err
 }
 
+// ListGroupsWithContext is the same as ListGroups with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListGroupsWithContext(ctx aws.Context, input *ListGroupsInput) (*ListGroupsOutput, error) {
+	req, out := c.ListGroupsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListGroupsPages(input *ListGroupsInput, fn func(p *ListGroupsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGroupsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2465,6 +3156,16 @@ func (c *IAM) ListGroupsForUser(input *ListGroupsForUserInput) (*ListGroupsForUs
 	return out, err
 }
 
+// ListGroupsForUserWithContext is the same as ListGroupsForUser with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListGroupsForUserWithContext(ctx aws.Context, input *ListGroupsForUserInput) (*ListGroupsForUserOutput, error) {
+	req, out := c.ListGroupsForUserRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListGroupsForUserPages(input *ListGroupsForUserInput, fn func(p *ListGroupsForUserOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGroupsForUserRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2509,6 +3210,16 @@ func (c *IAM) ListInstanceProfiles(input *ListInstanceProfilesInput) (*ListInsta
 	return out, err
 }
 
+// ListInstanceProfilesWithContext is the same as ListInstanceProfiles with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListInstanceProfilesWithContext(ctx aws.Context, input *ListInstanceProfilesInput) (*ListInstanceProfilesOutput, error) {
+	req, out := c.ListInstanceProfilesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListInstanceProfilesPages(input *ListInstanceProfilesInput, fn func(p *ListInstanceProfilesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstanceProfilesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2553,6 +3264,16 @@ func (c *IAM) ListInstanceProfilesForRole(input *ListInstanceProfilesForRoleInpu
 	return out, err
 }
 
+// ListInstanceProfilesForRoleWithContext is the same as ListInstanceProfilesForRole with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListInstanceProfilesForRoleWithContext(ctx aws.Context, input *ListInstanceProfilesForRoleInput) (*ListInstanceProfilesForRoleOutput, error) {
+	req, out := c.ListInstanceProfilesForRoleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListInstanceProfilesForRolePages(input *ListInstanceProfilesForRoleInput, fn func(p *ListInstanceProfilesForRoleOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListInstanceProfilesForRoleRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2598,6 +3319,16 @@ func (c *IAM) ListMFADevices(input *ListMFADevicesInput) (*ListMFADevicesOutput,
 	return out, err
 }
 
+// ListMFADevicesWithContext is the same as ListMFADevices with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListMFADevicesWithContext(ctx aws.Context, input *ListMFADevicesInput) (*ListMFADevicesOutput, error) {
+	req, out := c.ListMFADevicesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListMFADevicesPages(input *ListMFADevicesInput, fn func(p *ListMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListMFADevicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2632,6 +3363,16 @@ func (c *IAM) ListOpenIDConnectProviders(input *ListOpenIDConnectProvidersInput)
 	return out, err
 }
 
+// ListOpenIDConnectProvidersWithContext is the same as ListOpenIDConnectProviders with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListOpenIDConnectProvidersWithContext(ctx aws.Context, input *ListOpenIDConnectProvidersInput) (*ListOpenIDConnectProvidersOutput, error) {
+	req, out := c.ListOpenIDConnectProvidersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListPolicies = "ListPolicies"
 
 // ListPoliciesRequest generates a request for the ListPolicies operation.
@@ -2677,6 +3418,16 @@ func (c *IAM) ListPolicies(input *ListPoliciesInput) (*ListPoliciesOutput, error
 	return out, err
 }
 
+// ListPoliciesWithContext is the same as ListPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListPoliciesWithContext(ctx aws.Context, input *ListPoliciesInput) (*ListPoliciesOutput, error) {
+	req, out := c.ListPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListPoliciesPages(input *ListPoliciesInput, fn func(p *ListPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2716,6 +3467,16 @@ func (c *IAM) ListPolicyVersions(input *ListPolicyVersionsInput) (*ListPolicyVer
 	return out, err
 }
 
+// ListPolicyVersionsWithContext is the same as ListPolicyVersions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListPolicyVersionsWithContext(ctx aws.Context, input *ListPolicyVersionsInput) (*ListPolicyVersionsOutput, error) {
+	req, out := c.ListPolicyVersionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListRolePolicies = "ListRolePolicies"
 
 // ListRolePoliciesRequest generates a request for the ListRolePolicies operation.
@@ -2760,6 +3521,16 @@ func (c *IAM) ListRolePolicies(input *ListRolePoliciesInput) (*ListRolePoliciesO
 	return out, err
 }
 
+// ListRolePoliciesWithContext is the same as ListRolePolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListRolePoliciesWithContext(ctx aws.Context, input *ListRolePoliciesInput) (*ListRolePoliciesOutput, error) {
+	req, out := c.ListRolePoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListRolePoliciesPages(input *ListRolePoliciesInput, fn func(p *ListRolePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListRolePoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2804,6 +3575,16 @@ func (c *IAM) ListRoles(input *ListRolesInput) (*ListRolesOutput, error) {
 	return out, err
 }
 
+// ListRolesWithContext is the same as ListRoles with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListRolesWithContext(ctx aws.Context, input *ListRolesInput) (*ListRolesOutput, error) {
+	req, out := c.ListRolesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListRolesPages(input *ListRolesInput, fn func(p *ListRolesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListRolesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2840,6 +3621,16 @@ func (c *IAM) ListSAMLProviders(input *ListSAMLProvidersInput) (*ListSAMLProvide
 	return out, err
 }
 
+// ListSAMLProvidersWithContext is the same as ListSAMLProviders with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListSAMLProvidersWithContext(ctx aws.Context, input *ListSAMLProvidersInput) (*ListSAMLProvidersOutput, error) {
+	req, out := c.ListSAMLProvidersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListServerCertificates = "ListServerCertificates"
 
 // ListServerCertificatesRequest generates a request for the ListServerCertificates operation.
@@ -2876,6 +3667,16 @@ func (c *IAM) ListServerCertificates(input *ListServerCertificatesInput) (*ListS
 	return out, err
 }
 
+// ListServerCertificatesWithContext is the same as ListServerCertificates with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListServerCertificatesWithContext(ctx aws.Context, input *ListServerCertificatesInput) (*ListServerCertificatesOutput, error) {
+	req, out := c.ListServerCertificatesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListServerCertificatesPages(input *ListServerCertificatesInput, fn func(p *ListServerCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListServerCertificatesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2925,6 +3726,16 @@ func (c *IAM) ListSigningCertificates(input *ListSigningCertificatesInput) (*Lis
 	return out, err
 }
 
+// ListSigningCertificatesWithContext is the same as ListSigningCertificates with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListSigningCertificatesWithContext(ctx aws.Context, input *ListSigningCertificatesInput) (*ListSigningCertificatesOutput, error) {
+	req, out := c.ListSigningCertificatesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListSigningCertificatesPages(input *ListSigningCertificatesInput, fn func(p *ListSigningCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListSigningCertificatesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -2975,6 +3786,16 @@ func (c *IAM) ListUserPolicies(input *ListUserPoliciesInput) (*ListUserPoliciesO
 	return out, err
 }
 
+// ListUserPoliciesWithContext is the same as ListUserPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListUserPoliciesWithContext(ctx aws.Context, input *ListUserPoliciesInput) (*ListUserPoliciesOutput, error) {
+	req, out := c.ListUserPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListUserPoliciesPages(input *ListUserPoliciesInput, fn func(p *ListUserPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListUserPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3019,6 +3840,16 @@ func (c *IAM) ListUsers(input *ListUsersInput) (*ListUsersOutput, error) {
 	return out, err
 }
 
+// ListUsersWithContext is the same as ListUsers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListUsersWithContext(ctx aws.Context, input *ListUsersInput) (*ListUsersOutput, error) {
+	req, out := c.ListUsersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListUsersPages(input *ListUsersInput, fn func(p *ListUsersOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListUsersRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3064,6 +3895,16 @@ func (c *IAM) ListVirtualMFADevices(input *ListVirtualMFADevicesInput) (*ListVir
 	return out, err
 }
 
+// ListVirtualMFADevicesWithContext is the same as ListVirtualMFADevices with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ListVirtualMFADevicesWithContext(ctx aws.Context, input *ListVirtualMFADevicesInput) (*ListVirtualMFADevicesOutput, error) {
+	req, out := c.ListVirtualMFADevicesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *IAM) ListVirtualMFADevicesPages(input *ListVirtualMFADevicesInput, fn func(p *ListVirtualMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListVirtualMFADevicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -3114,6 +3955,16 @@ func (c *IAM) PutGroupPolicy(input *PutGroupPolicyInput) (*PutGroupPolicyOutput,
 	return out, err
 }
 
+// PutGroupPolicyWithContext is the same as PutGroupPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) PutGroupPolicyWithContext(ctx aws.Context, input *PutGroupPolicyInput) (*PutGroupPolicyOutput, error) {
+	req, out := c.PutGroupPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRolePolicy = "PutRolePolicy"
 
 // PutRolePolicyRequest generates a request for the PutRolePolicy operation.
@@ -3163,6 +4014,16 @@ func (c *IAM) PutRolePolicy(input *PutRolePolicyInput) (*PutRolePolicyOutput, er
 	return out, err
 }
 
+// PutRolePolicyWithContext is the same as PutRolePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) PutRolePolicyWithContext(ctx aws.Context, input *PutRolePolicyInput) (*PutRolePolicyOutput, error) {
+	req, out := c.PutRolePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutUserPolicy = "PutUserPolicy"
 
 // PutUserPolicyRequest generates a request for the PutUserPolicy operation.
@@ -3206,6 +4067,16 @@ func (c *IAM) PutUserPolicy(input *PutUserPolicyInput) (*PutUserPolicyOutput, er
 	return out, err
 }
 
+// PutUserPolicyWithContext is the same as PutUserPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) PutUserPolicyWithContext(ctx aws.Context, input *PutUserPolicyInput) (*PutUserPolicyOutput, error) {
+	req, out := c.PutUserPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveClientIDFromOpenIDConnectProvider = "RemoveClientIDFromOpenIDConnectProvider"
 
 // RemoveClientIDFromOpenIDConnectProviderRequest generates a request for the RemoveClientIDFromOpenIDConnectProvider operation.
@@ -3237,6 +4108,16 @@ func (c *IAM) RemoveClientIDFromOpenIDConnectProvider(input *RemoveClientIDFromO
 	return out, err
 }
 
+// RemoveClientIDFromOpenIDConnectProviderWithContext is the same as RemoveClientIDFromOpenIDConnectProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) RemoveClientIDFromOpenIDConnectProviderWithContext(ctx aws.Context, input *RemoveClientIDFromOpenIDConnectProviderInput) (*RemoveClientIDFromOpenIDConnectProviderOutput, error) {
+	req, out := c.RemoveClientIDFromOpenIDConnectProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveRoleFromInstanceProfile = "RemoveRoleFromInstanceProfile"
 
 // RemoveRoleFromInstanceProfileRequest generates a request for the RemoveRoleFromInstanceProfile operation.
@@ -3272,6 +4153,16 @@ func (c *IAM) RemoveRoleFromInstanceProfile(input *RemoveRoleFromInstanceProfile
 	return out, err
 }
 
+// RemoveRoleFromInstanceProfileWithContext is the same as RemoveRoleFromInstanceProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) RemoveRoleFromInstanceProfileWithContext(ctx aws.Context, input *RemoveRoleFromInstanceProfileInput) (*RemoveRoleFromInstanceProfileOutput, error) {
+	req, out := c.RemoveRoleFromInstanceProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveUserFromGroup = "RemoveUserFromGroup"
 
 // RemoveUserFromGroupRequest generates a request for the RemoveUserFromGroup operation.
@@ -3299,6 +4190,16 @@ func (c *IAM) RemoveUserFromGroup(input *RemoveUserFromGroupInput) (*RemoveUserF
 	return out, err
 }
 
+// RemoveUserFromGroupWithContext is the same as RemoveUserFromGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) RemoveUserFromGroupWithContext(ctx aws.Context, input *RemoveUserFromGroupInput) (*RemoveUserFromGroupOutput, error) {
+	req, out := c.RemoveUserFromGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResyncMFADevice = "ResyncMFADevice"
 
 // ResyncMFADeviceRequest generates a request for the ResyncMFADevice operation.
@@ -3330,6 +4231,16 @@ func (c *IAM) ResyncMFADevice(input *ResyncMFADeviceInput) (*ResyncMFADeviceOutp
 	return out, err
 }
 
+// ResyncMFADeviceWithContext is the same as ResyncMFADevice with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) ResyncMFADeviceWithContext(ctx aws.Context, input *ResyncMFADeviceInput) (*ResyncMFADeviceOutput, error) {
+	req, out := c.ResyncMFADeviceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetDefaultPolicyVersion = "SetDefaultPolicyVersion"
 
 // SetDefaultPolicyVersionRequest generates a request for the SetDefaultPolicyVersion operation.
@@ -3366,6 +4277,16 @@ func (c *IAM) SetDefaultPolicyVersion(input *SetDefaultPolicyVersionInput) (*Set
 	return out, err
 }
 
+// SetDefaultPolicyVersionWithContext is the same as SetDefaultPolicyVersion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) SetDefaultPolicyVersionWithContext(ctx aws.Context, input *SetDefaultPolicyVersionInput) (*SetDefaultPolicyVersionOutput, error) {
+	req, out := c.SetDefaultPolicyVersionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAccessKey = "UpdateAccessKey"
 
 // UpdateAccessKeyRequest generates a request for the UpdateAccessKey operation.
@@ -3404,6 +4325,16 @@ func (c *IAM) UpdateAccessKey(input *UpdateAccessKeyInput) (*UpdateAccessKeyOutp
 	return out, err
 }
 
+// UpdateAccessKeyWithContext is the same as UpdateAccessKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateAccessKeyWithContext(ctx aws.Context, input *UpdateAccessKeyInput) (*UpdateAccessKeyOutput, error) {
+	req, out := c.UpdateAccessKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAccountPasswordPolicy = "UpdateAccountPasswordPolicy"
 
 // UpdateAccountPasswordPolicyRequest generates a request for the UpdateAccountPasswordPolicy operation.
@@ -3440,6 +4371,16 @@ func (c *IAM) UpdateAccountPasswordPolicy(input *UpdateAccountPasswordPolicyInpu
 	return out, err
 }
 
+// UpdateAccountPasswordPolicyWithContext is the same as UpdateAccountPasswordPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateAccountPasswordPolicyWithContext(ctx aws.Context, input *UpdateAccountPasswordPolicyInput) (*UpdateAccountPasswordPolicyOutput, error) {
+	req, out := c.UpdateAccountPasswordPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAssumeRolePolicy = "UpdateAssumeRolePolicy"
 
 // UpdateAssumeRolePolicyRequest generates a request for the UpdateAssumeRolePolicy operation.
@@ -3469,6 +4410,16 @@ func (c *IAM) UpdateAssumeRolePolicy(input *UpdateAssumeRolePolicyInput) (*Updat
 	return out, err
 }
 
+// UpdateAssumeRolePolicyWithContext is the same as UpdateAssumeRolePolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateAssumeRolePolicyWithContext(ctx aws.Context, input *UpdateAssumeRolePolicyInput) (*UpdateAssumeRolePolicyOutput, error) {
+	req, out := c.UpdateAssumeRolePolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateGroup = "UpdateGroup"
 
 // UpdateGroupRequest generates a request for the UpdateGroup operation.
@@ -3505,6 +4456,16 @@ func (c *IAM) UpdateGroup(input *UpdateGroupInput) (*UpdateGroupOutput, error) {
 	return out, err
 }
 
+// UpdateGroupWithContext is the same as UpdateGroup with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateGroupWithContext(ctx aws.Context, input *UpdateGroupInput) (*UpdateGroupOutput, error) {
+	req, out := c.UpdateGroupRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateLoginProfile = "UpdateLoginProfile"
 
 // UpdateLoginProfileRequest generates a request for the UpdateLoginProfile operation.
@@ -3536,6 +4497,16 @@ func (c *IAM) UpdateLoginProfile(input *UpdateLoginProfileInput) (*UpdateLoginPr
 	return out, err
 }
 
+// UpdateLoginProfileWithContext is the same as UpdateLoginProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateLoginProfileWithContext(ctx aws.Context, input *UpdateLoginProfileInput) (*UpdateLoginProfileOutput, error) {
+	req, out := c.UpdateLoginProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateOpenIDConnectProviderThumbprint = "UpdateOpenIDConnectProviderThumbprint"
 
 // UpdateOpenIDConnectProviderThumbprintRequest generates a request for the UpdateOpenIDConnectProviderThumbprint operation.
@@ -3576,6 +4547,16 @@ func (c *IAM) UpdateOpenIDConnectProviderThumbprint(input *UpdateOpenIDConnectPr
 	return out, err
 }
 
+// UpdateOpenIDConnectProviderThumbprintWithContext is the same as UpdateOpenIDConnectProviderThumbprint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateOpenIDConnectProviderThumbprintWithContext(ctx aws.Context, input *UpdateOpenIDConnectProviderThumbprintInput) (*UpdateOpenIDConnectProviderThumbprintOutput, error) {
+	req, out := c.UpdateOpenIDConnectProviderThumbprintRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateSAMLProvider = "UpdateSAMLProvider"
 
 // UpdateSAMLProviderRequest generates a request for the UpdateSAMLProvider operation.
@@ -3605,6 +4586,16 @@ func (c *IAM) UpdateSAMLProvider(input *UpdateSAMLProviderInput) (*UpdateSAMLPro
 	return out, err
 }
 
+// UpdateSAMLProviderWithContext is the same as UpdateSAMLProvider with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateSAMLProviderWithContext(ctx aws.Context, input *UpdateSAMLProviderInput) (*UpdateSAMLProviderOutput, error) {
+	req, out := c.UpdateSAMLProviderRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateServerCertificate = "UpdateServerCertificate"
 
 // UpdateServerCertificateRequest generates a request for the UpdateServerCertificate operation.
@@ -3642,6 +4633,16 @@ func (c *IAM) UpdateServerCertificate(input *UpdateServerCertificateInput) (*Upd
 	return out, err
 }
 
+// UpdateServerCertificateWithContext is the same as UpdateServerCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateServerCertificateWithContext(ctx aws.Context, input *UpdateServerCertificateInput) (*UpdateServerCertificateOutput, error) {
+	req, out := c.UpdateServerCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateSigningCertificate = "UpdateSigningCertificate"
 
 // UpdateSigningCertificateRequest generates a request for the UpdateSigningCertificate operation.
@@ -3676,6 +4677,16 @@ func (c *IAM) UpdateSigningCertificate(input *UpdateSigningCertificateInput) (*U
 	return out, err
 }
 
+// UpdateSigningCertificateWithContext is the same as UpdateSigningCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateSigningCertificateWithContext(ctx aws.Context, input *UpdateSigningCertificateInput) (*UpdateSigningCertificateOutput, error) {
+	req, out := c.UpdateSigningCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateUser = "UpdateUser"
 
 // UpdateUserRequest generates a request for the UpdateUser operation.
@@ -3712,6 +4723,16 @@ func (c *IAM) UpdateUser(input *UpdateUserInput) (*UpdateUserOutput, error) {
 	return out, err
 }
 
+// UpdateUserWithContext is the same as UpdateUser with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UpdateUserWithContext(ctx aws.Context, input *UpdateUserInput) (*UpdateUserOutput, error) {
+	req, out := c.UpdateUserRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadServerCertificate = "UploadServerCertificate"
 
 // UploadServerCertificateRequest generates a request for the UploadServerCertificate operation.
@@ -3753,6 +4774,16 @@ func (c *IAM) UploadServerCertificate(input *UploadServerCertificateInput) (*Upl
 	return out, err
 }
 
+// UploadServerCertificateWithContext is the same as UploadServerCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UploadServerCertificateWithContext(ctx aws.Context, input *UploadServerCertificateInput) (*UploadServerCertificateOutput, error) {
+	req, out := c.UploadServerCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUploadSigningCertificate = "UploadSigningCertificate"
 
 // UploadSigningCertificateRequest generates a request for the UploadSigningCertificate operation.
@@ -3796,6 +4827,16 @@ func (c *IAM) UploadSigningCertificate(input *UploadSigningCertificateInput) (*U
 	return out, err
 }
 
+// UploadSigningCertificateWithContext is the same as UploadSigningCertificate with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *IAM) UploadSigningCertificateWithContext(ctx aws.Context, input *UploadSigningCertificateInput) (*UploadSigningCertificateOutput, error) {
+	req, out := c.UploadSigningCertificateRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Contains information about an AWS access key.
 //
 //  This data type is used as a response element in the CreateAccessKey and
@@ -3807,20 +4848,22 @@ func (c *IAM) UploadSigningCertificate(input *UploadSigningCertificateInput) (*U
 // key, you must create a new access key.
 type AccessKey struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The secret key used to sign requests.
-	SecretAccessKey *string `type:"string" required:"true"`
+	SecretAccessKey *string `type:"string" sensitive:"true" required:"true"`
 
 	// The status of the access key. Active means the key is valid for API calls,
 	// while Inactive means it is not.
-	Status *string `type:"string" required:"true"`
+	//
+	// See the StatusType* constants for valid values.
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the IAM user that the access key is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAccessKey `json:"-" xml:"-"`
 }
@@ -3829,6 +4872,16 @@ type metadataAccessKey struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AccessKey) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AccessKey) GoString() string {
+	return s.String()
+}
+
 // Contains information about the last time an AWS access key was used.
 //
 // This data type is used as a response element in the GetAccessKeyLastUsed
@@ -3877,22 +4930,34 @@ type metadataAccessKeyLastUsed struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AccessKeyLastUsed) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AccessKeyLastUsed) GoString() string {
+	return s.String()
+}
+
 // Contains information about an AWS access key, without its secret key.
 //
 // This data type is used as a response element in the ListAccessKeys action.
 type AccessKeyMetadata struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The status of the access key. Active means the key is valid for API calls;
 	// Inactive means it is not.
-	Status *string `type:"string"`
+	//
+	// See the StatusType* constants for valid values.
+	Status *string `type:"string" enum:"Active,Inactive"`
 
 	// The name of the IAM user that the key is associated with.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataAccessKeyMetadata `json:"-" xml:"-"`
 }
@@ -3901,14 +4966,24 @@ type metadataAccessKeyMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AccessKeyMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AccessKeyMetadata) GoString() string {
+	return s.String()
+}
+
 type AddClientIDToOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to add to the IAM OpenID Connect provider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to add the client ID to. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAddClientIDToOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -3917,6 +4992,16 @@ type metadataAddClientIDToOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddClientIDToOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddClientIDToOpenIDConnectProviderInput) GoString() string {
+	return s.String()
+}
+
 type AddClientIDToOpenIDConnectProviderOutput struct {
 	metadataAddClientIDToOpenIDConnectProviderOutput `json:"-" xml:"-"`
 }
@@ -3925,12 +5010,22 @@ type metadataAddClientIDToOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddClientIDToOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddClientIDToOpenIDConnectProviderOutput) GoString() string {
+	return s.String()
+}
+
 type AddRoleToInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to add.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAddRoleToInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -3939,6 +5034,16 @@ type metadataAddRoleToInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddRoleToInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddRoleToInstanceProfileInput) GoString() string {
+	return s.String()
+}
+
 type AddRoleToInstanceProfileOutput struct {
 	metadataAddRoleToInstanceProfileOutput `json:"-" xml:"-"`
 }
@@ -3947,12 +5052,22 @@ type metadataAddRoleToInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddRoleToInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddRoleToInstanceProfileOutput) GoString() string {
+	return s.String()
+}
+
 type AddUserToGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to add.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAddUserToGroupInput `json:"-" xml:"-"`
 }
@@ -3961,6 +5076,16 @@ type metadataAddUserToGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddUserToGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddUserToGroupInput) GoString() string {
+	return s.String()
+}
+
 type AddUserToGroupOutput struct {
 	metadataAddUserToGroupOutput `json:"-" xml:"-"`
 }
@@ -3969,16 +5094,26 @@ type metadataAddUserToGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddUserToGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddUserToGroupOutput) GoString() string {
+	return s.String()
+}
+
 type AttachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to attach the policy to.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAttachGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -3987,6 +5122,16 @@ type metadataAttachGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachGroupPolicyInput) GoString() string {
+	return s.String()
+}
+
 type AttachGroupPolicyOutput struct {
 	metadataAttachGroupPolicyOutput `json:"-" xml:"-"`
 }
@@ -3995,16 +5140,26 @@ type metadataAttachGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachGroupPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type AttachRolePolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to attach the policy to.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAttachRolePolicyInput `json:"-" xml:"-"`
 }
@@ -4013,6 +5168,16 @@ type metadataAttachRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachRolePolicyInput) GoString() string {
+	return s.String()
+}
+
 type AttachRolePolicyOutput struct {
 	metadataAttachRolePolicyOutput `json:"-" xml:"-"`
 }
@@ -4021,16 +5186,26 @@ type metadataAttachRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachRolePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type AttachUserPolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to attach the policy to.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAttachUserPolicyInput `json:"-" xml:"-"`
 }
@@ -4039,6 +5214,16 @@ type metadataAttachUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachUserPolicyInput) GoString() string {
+	return s.String()
+}
+
 type AttachUserPolicyOutput struct {
 	metadataAttachUserPolicyOutput `json:"-" xml:"-"`
 }
@@ -4047,6 +5232,16 @@ type metadataAttachUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachUserPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about an attached policy.
 //
 // An attached policy is a managed policy that has been attached to a user,
@@ -4063,10 +5258,10 @@ type AttachedPolicy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048"`
 
 	// The friendly name of the attached policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataAttachedPolicy `json:"-" xml:"-"`
 }
@@ -4075,13 +5270,23 @@ type metadataAttachedPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachedPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachedPolicy) GoString() string {
+	return s.String()
+}
+
 type ChangePasswordInput struct {
 	// The new password. The new password must conform to the AWS account's password
 	// policy, if one exists.
-	NewPassword *string `type:"string" required:"true"`
+	NewPassword *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" sensitive:"true" required:"true"`
 
 	// The IAM user's current password.
-	OldPassword *string `type:"string" required:"true"`
+	OldPassword *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" sensitive:"true" required:"true"`
 
 	metadataChangePasswordInput `json:"-" xml:"-"`
 }
@@ -4090,6 +5295,16 @@ type metadataChangePasswordInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangePasswordInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangePasswordInput) GoString() string {
+	return s.String()
+}
+
 type ChangePasswordOutput struct {
 	metadataChangePasswordOutput `json:"-" xml:"-"`
 }
@@ -4098,9 +5313,19 @@ type metadataChangePasswordOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangePasswordOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangePasswordOutput) GoString() string {
+	return s.String()
+}
+
 type CreateAccessKeyInput struct {
 	// The user name that the new key will belong to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataCreateAccessKeyInput `json:"-" xml:"-"`
 }
@@ -4109,6 +5334,16 @@ type metadataCreateAccessKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAccessKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAccessKeyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateAccessKey request.
 type CreateAccessKeyOutput struct {
 	// Information about the access key.
@@ -4121,9 +5356,19 @@ type metadataCreateAccessKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAccessKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAccessKeyOutput) GoString() string {
+	return s.String()
+}
+
 type CreateAccountAliasInput struct {
 	// The account alias to create.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" required:"true"`
 
 	metadataCreateAccountAliasInput `json:"-" xml:"-"`
 }
@@ -4132,6 +5377,16 @@ type metadataCreateAccountAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAccountAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAccountAliasInput) GoString() string {
+	return s.String()
+}
+
 type CreateAccountAliasOutput struct {
 	metadataCreateAccountAliasOutput `json:"-" xml:"-"`
 }
@@ -4140,9 +5395,19 @@ type metadataCreateAccountAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAccountAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAccountAliasOutput) GoString() string {
+	return s.String()
+}
+
 type CreateGroupInput struct {
 	// The name of the group to create. Do not include the path in this value.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4150,7 +5415,7 @@ type CreateGroupInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataCreateGroupInput `json:"-" xml:"-"`
 }
@@ -4159,6 +5424,16 @@ type metadataCreateGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateGroupInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateGroup request.
 type CreateGroupOutput struct {
 	// Information about the group.
@@ -4171,9 +5446,19 @@ type metadataCreateGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateGroupOutput) GoString() string {
+	return s.String()
+}
+
 type CreateInstanceProfileInput struct {
 	// The name of the instance profile to create.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4181,7 +5466,7 @@ type CreateInstanceProfileInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataCreateInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -4190,6 +5475,16 @@ type metadataCreateInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInstanceProfileInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateInstanceProfile request.
 type CreateInstanceProfileOutput struct {
 	// Information about the instance profile.
@@ -4202,15 +5497,25 @@ type metadataCreateInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInstanceProfileOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLoginProfileInput struct {
 	// The new password for the user.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" sensitive:"true" required:"true"`
 
 	// Specifies whether the user is required to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user to create a password for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateLoginProfileInput `json:"-" xml:"-"`
 }
@@ -4219,6 +5524,16 @@ type metadataCreateLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoginProfileInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateLoginProfile request.
 type CreateLoginProfileOutput struct {
 	// The user name and password create date.
@@ -4231,6 +5546,16 @@ type metadataCreateLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLoginProfileOutput) GoString() string {
+	return s.String()
+}
+
 type CreateOpenIDConnectProviderInput struct {
 	// A list of client IDs (also known as audiences). When a mobile or web app
 	// registers with an OpenID Connect provider, they establish a value that identifies
@@ -4275,7 +5600,7 @@ type CreateOpenIDConnectProviderInput struct {
 	// You cannot register the same provider multiple times in a single AWS account.
 	// If you try to submit a URL that has already been used for an OpenID Connect
 	// provider in the AWS account, you will get an error.
-	URL *string `locationName:"Url" type:"string" required:"true"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255" required:"true"`
 
 	metadataCreateOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -4284,11 +5609,21 @@ type metadataCreateOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateOpenIDConnectProviderInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateOpenIDConnectProvider request.
 type CreateOpenIDConnectProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider that was
 	// created. For more information, see OpenIDConnectProviderListEntry.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateOpenIDConnectProviderOutput `json:"-" xml:"-"`
 }
@@ -4297,6 +5632,16 @@ type metadataCreateOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateOpenIDConnectProviderOutput) GoString() string {
+	return s.String()
+}
+
 type CreatePolicyInput struct {
 	// A friendly description of the policy.
 	//
@@ -4305,7 +5650,7 @@ type CreatePolicyInput struct {
 	//
 	// The policy description is immutable. After a value is assigned, it cannot
 	// be changed.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// The path for the policy.
 	//
@@ -4314,13 +5659,13 @@ type CreatePolicyInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreatePolicyInput `json:"-" xml:"-"`
 }
@@ -4329,6 +5674,16 @@ type metadataCreatePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreatePolicy request.
 type CreatePolicyOutput struct {
 	// Information about the policy.
@@ -4341,16 +5696,26 @@ type metadataCreatePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type CreatePolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// Specifies whether to set this version as the policy's default version.
 	//
@@ -4370,6 +5735,16 @@ type metadataCreatePolicyVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePolicyVersionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreatePolicyVersion request.
 type CreatePolicyVersionOutput struct {
 	// Information about the policy version.
@@ -4382,9 +5757,19 @@ type metadataCreatePolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePolicyVersionOutput) GoString() string {
+	return s.String()
+}
+
 type CreateRoleInput struct {
 	// The policy that grants an entity permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string" required:"true"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4392,10 +5777,10 @@ type CreateRoleInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The name of the role to create.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateRoleInput `json:"-" xml:"-"`
 }
@@ -4404,6 +5789,16 @@ type metadataCreateRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRoleInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateRole request.
 type CreateRoleOutput struct {
 	// Information about the role.
@@ -4416,9 +5811,19 @@ type metadataCreateRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRoleOutput) GoString() string {
+	return s.String()
+}
+
 type CreateSAMLProviderInput struct {
 	// The name of the provider to create.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]*" required:"true"`
 
 	// An XML document generated by an identity provider (IdP) that supports SAML
 	// 2.0. The document includes the issuer's name, expiration information, and
@@ -4429,7 +5834,7 @@ type CreateSAMLProviderInput struct {
 	// For more information, see Creating Temporary Security Credentials for SAML
 	// Federation (http://docs.aws.amazon.com/STS/latest/UsingSTS/CreatingSAML.html)
 	// in the Using Temporary Security Credentials guide.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	metadataCreateSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -4438,10 +5843,20 @@ type metadataCreateSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSAMLProviderInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateSAMLProvider request.
 type CreateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateSAMLProviderOutput `json:"-" xml:"-"`
 }
@@ -4450,6 +5865,16 @@ type metadataCreateSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSAMLProviderOutput) GoString() string {
+	return s.String()
+}
+
 type CreateUserInput struct {
 	// The path for the user name. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4457,10 +5882,10 @@ type CreateUserInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The name of the user to create.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateUserInput `json:"-" xml:"-"`
 }
@@ -4469,6 +5894,16 @@ type metadataCreateUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateUserInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateUser request.
 type CreateUserOutput struct {
 	// Information about the user.
@@ -4481,6 +5916,16 @@ type metadataCreateUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateUserOutput) GoString() string {
+	return s.String()
+}
+
 type CreateVirtualMFADeviceInput struct {
 	// The path for the virtual MFA device. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4488,11 +5933,11 @@ type CreateVirtualMFADeviceInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The name of the virtual MFA device. Use with path to uniquely identify a
 	// virtual MFA device.
-	VirtualMFADeviceName *string `type:"string" required:"true"`
+	VirtualMFADeviceName *string `type:"string" min:"1" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateVirtualMFADeviceInput `json:"-" xml:"-"`
 }
@@ -4501,6 +5946,16 @@ type metadataCreateVirtualMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVirtualMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVirtualMFADeviceInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful CreateVirtualMFADevice request.
 type CreateVirtualMFADeviceOutput struct {
 	// A newly created virtual MFA device.
@@ -4513,13 +5968,23 @@ type metadataCreateVirtualMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateVirtualMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateVirtualMFADeviceOutput) GoString() string {
+	return s.String()
+}
+
 type DeactivateMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user whose MFA device you want to deactivate.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeactivateMFADeviceInput `json:"-" xml:"-"`
 }
@@ -4528,6 +5993,16 @@ type metadataDeactivateMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeactivateMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeactivateMFADeviceInput) GoString() string {
+	return s.String()
+}
+
 type DeactivateMFADeviceOutput struct {
 	metadataDeactivateMFADeviceOutput `json:"-" xml:"-"`
 }
@@ -4536,13 +6011,23 @@ type metadataDeactivateMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeactivateMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeactivateMFADeviceOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteAccessKeyInput struct {
 	// The access key ID for the access key ID and secret access key you want to
 	// delete.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name of the user whose key you want to delete.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataDeleteAccessKeyInput `json:"-" xml:"-"`
 }
@@ -4551,6 +6036,16 @@ type metadataDeleteAccessKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAccessKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAccessKeyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAccessKeyOutput struct {
 	metadataDeleteAccessKeyOutput `json:"-" xml:"-"`
 }
@@ -4559,9 +6054,19 @@ type metadataDeleteAccessKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAccessKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAccessKeyOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteAccountAliasInput struct {
 	// The name of the account alias to delete.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" required:"true"`
 
 	metadataDeleteAccountAliasInput `json:"-" xml:"-"`
 }
@@ -4570,6 +6075,16 @@ type metadataDeleteAccountAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAccountAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAccountAliasInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAccountAliasOutput struct {
 	metadataDeleteAccountAliasOutput `json:"-" xml:"-"`
 }
@@ -4578,6 +6093,16 @@ type metadataDeleteAccountAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAccountAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAccountAliasOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteAccountPasswordPolicyInput struct {
 	metadataDeleteAccountPasswordPolicyInput `json:"-" xml:"-"`
 }
@@ -4586,6 +6111,16 @@ type metadataDeleteAccountPasswordPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAccountPasswordPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAccountPasswordPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAccountPasswordPolicyOutput struct {
 	metadataDeleteAccountPasswordPolicyOutput `json:"-" xml:"-"`
 }
@@ -4594,9 +6129,19 @@ type metadataDeleteAccountPasswordPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAccountPasswordPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAccountPasswordPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteGroupInput struct {
 	// The name of the group to delete.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteGroupInput `json:"-" xml:"-"`
 }
@@ -4605,6 +6150,16 @@ type metadataDeleteGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteGroupInput) GoString() string {
+	return s.String()
+}
+
 type DeleteGroupOutput struct {
 	metadataDeleteGroupOutput `json:"-" xml:"-"`
 }
@@ -4613,13 +6168,23 @@ type metadataDeleteGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteGroupOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteGroupPolicyInput struct {
 	// The name (friendly name, not ARN) identifying the group that the policy is
 	// embedded in.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -4628,6 +6193,16 @@ type metadataDeleteGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteGroupPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteGroupPolicyOutput struct {
 	metadataDeleteGroupPolicyOutput `json:"-" xml:"-"`
 }
@@ -4636,9 +6211,19 @@ type metadataDeleteGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteGroupPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteInstanceProfileInput struct {
 	// The name of the instance profile to delete.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -4647,6 +6232,16 @@ type metadataDeleteInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInstanceProfileInput) GoString() string {
+	return s.String()
+}
+
 type DeleteInstanceProfileOutput struct {
 	metadataDeleteInstanceProfileOutput `json:"-" xml:"-"`
 }
@@ -4655,9 +6250,19 @@ type metadataDeleteInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInstanceProfileOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLoginProfileInput struct {
 	// The name of the user whose password you want to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteLoginProfileInput `json:"-" xml:"-"`
 }
@@ -4666,6 +6271,16 @@ type metadataDeleteLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoginProfileInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLoginProfileOutput struct {
 	metadataDeleteLoginProfileOutput `json:"-" xml:"-"`
 }
@@ -4674,11 +6289,21 @@ type metadataDeleteLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLoginProfileOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider to delete.
 	// You can get a list of OpenID Connect provider ARNs by using the ListOpenIDConnectProviders
 	// action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -4687,6 +6312,16 @@ type metadataDeleteOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteOpenIDConnectProviderInput) GoString() string {
+	return s.String()
+}
+
 type DeleteOpenIDConnectProviderOutput struct {
 	metadataDeleteOpenIDConnectProviderOutput `json:"-" xml:"-"`
 }
@@ -4695,13 +6330,23 @@ type metadataDeleteOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteOpenIDConnectProviderOutput) GoString() string {
+	return s.String()
+}
+
 type DeletePolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeletePolicyInput `json:"-" xml:"-"`
 }
@@ -4710,6 +6355,16 @@ type metadataDeletePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeletePolicyOutput struct {
 	metadataDeletePolicyOutput `json:"-" xml:"-"`
 }
@@ -4718,20 +6373,30 @@ type metadataDeletePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeletePolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy version to delete.
 	//
 	// For more information about managed policy versions, see Versioning for Managed
 	// Policies (http://docs.aws.amazon.com/IAM/latest/UserGuide/policies-managed-versions.html)
 	// in the Using IAM guide.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataDeletePolicyVersionInput `json:"-" xml:"-"`
 }
@@ -4740,6 +6405,16 @@ type metadataDeletePolicyVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePolicyVersionInput) GoString() string {
+	return s.String()
+}
+
 type DeletePolicyVersionOutput struct {
 	metadataDeletePolicyVersionOutput `json:"-" xml:"-"`
 }
@@ -4748,9 +6423,19 @@ type metadataDeletePolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePolicyVersionOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteRoleInput struct {
 	// The name of the role to delete.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteRoleInput `json:"-" xml:"-"`
 }
@@ -4759,6 +6444,16 @@ type metadataDeleteRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRoleInput) GoString() string {
+	return s.String()
+}
+
 type DeleteRoleOutput struct {
 	metadataDeleteRoleOutput `json:"-" xml:"-"`
 }
@@ -4767,13 +6462,23 @@ type metadataDeleteRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRoleOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteRolePolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the role that the policy is
 	// embedded in.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteRolePolicyInput `json:"-" xml:"-"`
 }
@@ -4782,6 +6487,16 @@ type metadataDeleteRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRolePolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteRolePolicyOutput struct {
 	metadataDeleteRolePolicyOutput `json:"-" xml:"-"`
 }
@@ -4790,9 +6505,19 @@ type metadataDeleteRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRolePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to delete.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -4801,6 +6526,16 @@ type metadataDeleteSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSAMLProviderInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSAMLProviderOutput struct {
 	metadataDeleteSAMLProviderOutput `json:"-" xml:"-"`
 }
@@ -4809,9 +6544,19 @@ type metadataDeleteSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSAMLProviderOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteServerCertificateInput struct {
 	// The name of the server certificate you want to delete.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteServerCertificateInput `json:"-" xml:"-"`
 }
@@ -4820,6 +6565,16 @@ type metadataDeleteServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteServerCertificateInput) GoString() string {
+	return s.String()
+}
+
 type DeleteServerCertificateOutput struct {
 	metadataDeleteServerCertificateOutput `json:"-" xml:"-"`
 }
@@ -4828,12 +6583,22 @@ type metadataDeleteServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteServerCertificateOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteSigningCertificateInput struct {
 	// The ID of the signing certificate to delete.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataDeleteSigningCertificateInput `json:"-" xml:"-"`
 }
@@ -4842,6 +6607,16 @@ type metadataDeleteSigningCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSigningCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSigningCertificateInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSigningCertificateOutput struct {
 	metadataDeleteSigningCertificateOutput `json:"-" xml:"-"`
 }
@@ -4850,9 +6625,19 @@ type metadataDeleteSigningCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSigningCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSigningCertificateOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteUserInput struct {
 	// The name of the user to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteUserInput `json:"-" xml:"-"`
 }
@@ -4861,6 +6646,16 @@ type metadataDeleteUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteUserInput) GoString() string {
+	return s.String()
+}
+
 type DeleteUserOutput struct {
 	metadataDeleteUserOutput `json:"-" xml:"-"`
 }
@@ -4869,13 +6664,23 @@ type metadataDeleteUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteUserOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteUserPolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the user that the policy is
 	// embedded in.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteUserPolicyInput `json:"-" xml:"-"`
 }
@@ -4884,6 +6689,16 @@ type metadataDeleteUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteUserPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DeleteUserPolicyOutput struct {
 	metadataDeleteUserPolicyOutput `json:"-" xml:"-"`
 }
@@ -4892,10 +6707,20 @@ type metadataDeleteUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteUserPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteVirtualMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the same as the ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	metadataDeleteVirtualMFADeviceInput `json:"-" xml:"-"`
 }
@@ -4904,6 +6729,16 @@ type metadataDeleteVirtualMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVirtualMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVirtualMFADeviceInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVirtualMFADeviceOutput struct {
 	metadataDeleteVirtualMFADeviceOutput `json:"-" xml:"-"`
 }
@@ -4912,16 +6747,26 @@ type metadataDeleteVirtualMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVirtualMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVirtualMFADeviceOutput) GoString() string {
+	return s.String()
+}
+
 type DetachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to detach the policy from.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDetachGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -4930,6 +6775,16 @@ type metadataDetachGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachGroupPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DetachGroupPolicyOutput struct {
 	metadataDetachGroupPolicyOutput `json:"-" xml:"-"`
 }
@@ -4938,16 +6793,26 @@ type metadataDetachGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachGroupPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DetachRolePolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to detach the policy from.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDetachRolePolicyInput `json:"-" xml:"-"`
 }
@@ -4956,6 +6821,16 @@ type metadataDetachRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachRolePolicyInput) GoString() string {
+	return s.String()
+}
+
 type DetachRolePolicyOutput struct {
 	metadataDetachRolePolicyOutput `json:"-" xml:"-"`
 }
@@ -4964,16 +6839,26 @@ type metadataDetachRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachRolePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type DetachUserPolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to detach the policy from.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDetachUserPolicyInput `json:"-" xml:"-"`
 }
@@ -4982,6 +6867,16 @@ type metadataDetachUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachUserPolicyInput) GoString() string {
+	return s.String()
+}
+
 type DetachUserPolicyOutput struct {
 	metadataDetachUserPolicyOutput `json:"-" xml:"-"`
 }
@@ -4990,19 +6885,29 @@ type metadataDetachUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachUserPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type EnableMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user for whom you want to enable the MFA device.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataEnableMFADeviceInput `json:"-" xml:"-"`
 }
@@ -5011,6 +6916,16 @@ type metadataEnableMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableMFADeviceInput) GoString() string {
+	return s.String()
+}
+
 type EnableMFADeviceOutput struct {
 	metadataEnableMFADeviceOutput `json:"-" xml:"-"`
 }
@@ -5019,6 +6934,16 @@ type metadataEnableMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableMFADeviceOutput) GoString() string {
+	return s.String()
+}
+
 type GenerateCredentialReportInput struct {
 	metadataGenerateCredentialReportInput `json:"-" xml:"-"`
 }
@@ -5027,13 +6952,25 @@ type metadataGenerateCredentialReportInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateCredentialReportInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateCredentialReportInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GenerateCredentialReport request.
 type GenerateCredentialReportOutput struct {
 	// Information about the credential report.
 	Description *string `type:"string"`
 
 	// Information about the state of the credential report.
-	State *string `type:"string"`
+	//
+	// See the ReportStateType* constants for valid values.
+	State *string `type:"string" enum:"STARTED,INPROGRESS,COMPLETE"`
 
 	metadataGenerateCredentialReportOutput `json:"-" xml:"-"`
 }
@@ -5042,9 +6979,19 @@ type metadataGenerateCredentialReportOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateCredentialReportOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateCredentialReportOutput) GoString() string {
+	return s.String()
+}
+
 type GetAccessKeyLastUsedInput struct {
 	// The identifier of an access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	metadataGetAccessKeyLastUsedInput `json:"-" xml:"-"`
 }
@@ -5053,6 +7000,16 @@ type metadataGetAccessKeyLastUsedInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccessKeyLastUsedInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccessKeyLastUsedInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetAccessKeyLastUsed request. It is
 // also returned as a member of the AccessKeyMetaData structure returned by
 // the ListAccessKeys action.
@@ -5061,7 +7018,7 @@ type GetAccessKeyLastUsedOutput struct {
 	AccessKeyLastUsed *AccessKeyLastUsed `type:"structure"`
 
 	// The name of the AWS IAM user that owns this access key.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataGetAccessKeyLastUsedOutput `json:"-" xml:"-"`
 }
@@ -5070,6 +7027,16 @@ type metadataGetAccessKeyLastUsedOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccessKeyLastUsedOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccessKeyLastUsedOutput) GoString() string {
+	return s.String()
+}
+
 type GetAccountAuthorizationDetailsInput struct {
 	// A list of entity types (user, group, role, local managed policy, or AWS managed
 	// policy) for filtering the results.
@@ -5078,13 +7045,13 @@ type GetAccountAuthorizationDetailsInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetAccountAuthorizationDetailsInput `json:"-" xml:"-"`
 }
@@ -5093,6 +7060,16 @@ type metadataGetAccountAuthorizationDetailsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccountAuthorizationDetailsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccountAuthorizationDetailsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetAccountAuthorizationDetails request.
 type GetAccountAuthorizationDetailsOutput struct {
 	// A list containing information about IAM groups.
@@ -5105,7 +7082,7 @@ type GetAccountAuthorizationDetailsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list containing information about managed policies.
 	Policies []*ManagedPolicyDetail `type:"list"`
@@ -5123,6 +7100,16 @@ type metadataGetAccountAuthorizationDetailsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccountAuthorizationDetailsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccountAuthorizationDetailsOutput) GoString() string {
+	return s.String()
+}
+
 type GetAccountPasswordPolicyInput struct {
 	metadataGetAccountPasswordPolicyInput `json:"-" xml:"-"`
 }
@@ -5131,6 +7118,16 @@ type metadataGetAccountPasswordPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccountPasswordPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccountPasswordPolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetAccountPasswordPolicy request.
 type GetAccountPasswordPolicyOutput struct {
 	// Contains information about the account password policy.
@@ -5146,6 +7143,16 @@ type metadataGetAccountPasswordPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccountPasswordPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccountPasswordPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type GetAccountSummaryInput struct {
 	metadataGetAccountSummaryInput `json:"-" xml:"-"`
 }
@@ -5154,6 +7161,16 @@ type metadataGetAccountSummaryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccountSummaryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccountSummaryInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetAccountSummary request.
 type GetAccountSummaryOutput struct {
 	// A set of key value pairs containing information about IAM entity usage and
@@ -5308,6 +7325,16 @@ type metadataGetAccountSummaryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetAccountSummaryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetAccountSummaryOutput) GoString() string {
+	return s.String()
+}
+
 type GetCredentialReportInput struct {
 	metadataGetCredentialReportInput `json:"-" xml:"-"`
 }
@@ -5316,6 +7343,16 @@ type metadataGetCredentialReportInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCredentialReportInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCredentialReportInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetCredentialReport request.
 type GetCredentialReportOutput struct {
 	// Contains the credential report. The report is Base64-encoded.
@@ -5326,7 +7363,9 @@ type GetCredentialReportOutput struct {
 	GeneratedTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The format (MIME type) of the credential report.
-	ReportFormat *string `type:"string"`
+	//
+	// See the ReportFormatType* constants for valid values.
+	ReportFormat *string `type:"string" enum:"text/csv"`
 
 	metadataGetCredentialReportOutput `json:"-" xml:"-"`
 }
@@ -5335,20 +7374,30 @@ type metadataGetCredentialReportOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCredentialReportOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCredentialReportOutput) GoString() string {
+	return s.String()
+}
+
 type GetGroupInput struct {
 	// The name of the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetGroupInput `json:"-" xml:"-"`
 }
@@ -5357,6 +7406,16 @@ type metadataGetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetGroupInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetGroup request.
 type GetGroupOutput struct {
 	// Information about the group.
@@ -5369,7 +7428,7 @@ type GetGroupOutput struct {
 
 	// If IsTruncated is true, then this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of users in the group.
 	Users []*User `type:"list" required:"true"`
@@ -5381,12 +7440,22 @@ type metadataGetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetGroupOutput) GoString() string {
+	return s.String()
+}
+
 type GetGroupPolicyInput struct {
 	// The name of the group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -5395,16 +7464,26 @@ type metadataGetGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetGroupPolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetGroupPolicy request.
 type GetGroupPolicyOutput struct {
 	// The group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetGroupPolicyOutput `json:"-" xml:"-"`
 }
@@ -5413,9 +7492,19 @@ type metadataGetGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetGroupPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type GetInstanceProfileInput struct {
 	// The name of the instance profile to get information about.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -5424,6 +7513,16 @@ type metadataGetInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetInstanceProfileInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetInstanceProfile request.
 type GetInstanceProfileOutput struct {
 	// Information about the instance profile.
@@ -5436,9 +7535,19 @@ type metadataGetInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetInstanceProfileOutput) GoString() string {
+	return s.String()
+}
+
 type GetLoginProfileInput struct {
 	// The name of the user whose login profile you want to retrieve.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetLoginProfileInput `json:"-" xml:"-"`
 }
@@ -5447,6 +7556,16 @@ type metadataGetLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetLoginProfileInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetLoginProfile request.
 type GetLoginProfileOutput struct {
 	// The user name and password create date for the user.
@@ -5459,11 +7578,21 @@ type metadataGetLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetLoginProfileOutput) GoString() string {
+	return s.String()
+}
+
 type GetOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to get information for. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -5472,6 +7601,16 @@ type metadataGetOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOpenIDConnectProviderInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetOpenIDConnectProvider request.
 type GetOpenIDConnectProviderOutput struct {
 	// A list of client IDs (also known as audiences) that are associated with the
@@ -5488,7 +7627,7 @@ type GetOpenIDConnectProviderOutput struct {
 
 	// The URL that the IAM OpenID Connect provider is associated with. For more
 	// information, see CreateOpenIDConnectProvider.
-	URL *string `locationName:"Url" type:"string"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255"`
 
 	metadataGetOpenIDConnectProviderOutput `json:"-" xml:"-"`
 }
@@ -5497,13 +7636,23 @@ type metadataGetOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOpenIDConnectProviderOutput) GoString() string {
+	return s.String()
+}
+
 type GetPolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetPolicyInput `json:"-" xml:"-"`
 }
@@ -5512,6 +7661,16 @@ type metadataGetPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetPolicy request.
 type GetPolicyOutput struct {
 	// Information about the policy.
@@ -5524,22 +7683,42 @@ type metadataGetPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type GetPolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// Identifies the policy version to retrieve.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataGetPolicyVersionInput `json:"-" xml:"-"`
 }
 
-type metadataGetPolicyVersionInput struct {
-	SDKShapeTraits bool `type:"structure"`
+type metadataGetPolicyVersionInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s GetPolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPolicyVersionInput) GoString() string {
+	return s.String()
 }
 
 // Contains the response to a successful GetPolicyVersion request.
@@ -5558,9 +7737,19 @@ type metadataGetPolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPolicyVersionOutput) GoString() string {
+	return s.String()
+}
+
 type GetRoleInput struct {
 	// The name of the role to get information about.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRoleInput `json:"-" xml:"-"`
 }
@@ -5569,6 +7758,16 @@ type metadataGetRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetRoleInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetRole request.
 type GetRoleOutput struct {
 	// Information about the role.
@@ -5581,12 +7780,22 @@ type metadataGetRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetRoleOutput) GoString() string {
+	return s.String()
+}
+
 type GetRolePolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role associated with the policy.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRolePolicyInput `json:"-" xml:"-"`
 }
@@ -5595,16 +7804,26 @@ type metadataGetRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetRolePolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetRolePolicy request.
 type GetRolePolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The role the policy is associated with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRolePolicyOutput `json:"-" xml:"-"`
 }
@@ -5613,9 +7832,19 @@ type metadataGetRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetRolePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type GetSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to get information about.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -5624,13 +7853,23 @@ type metadataGetSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSAMLProviderInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetSAMLProvider request.
 type GetSAMLProviderOutput struct {
 	// The date and time when the SAML provider was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The XML metadata document that includes information about an identity provider.
-	SAMLMetadataDocument *string `type:"string"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000"`
 
 	// The expiration date and time for the SAML provider.
 	ValidUntil *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -5642,9 +7881,19 @@ type metadataGetSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSAMLProviderOutput) GoString() string {
+	return s.String()
+}
+
 type GetServerCertificateInput struct {
 	// The name of the server certificate you want to retrieve information about.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetServerCertificateInput `json:"-" xml:"-"`
 }
@@ -5653,6 +7902,16 @@ type metadataGetServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetServerCertificateInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetServerCertificate request.
 type GetServerCertificateOutput struct {
 	// Information about the server certificate.
@@ -5665,12 +7924,22 @@ type metadataGetServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetServerCertificateOutput) GoString() string {
+	return s.String()
+}
+
 type GetUserInput struct {
 	// The name of the user to get information about.
 	//
 	// This parameter is optional. If it is not included, it defaults to the user
 	// making the request.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataGetUserInput `json:"-" xml:"-"`
 }
@@ -5679,6 +7948,16 @@ type metadataGetUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetUserInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetUser request.
 type GetUserOutput struct {
 	// Information about the user.
@@ -5691,12 +7970,22 @@ type metadataGetUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetUserOutput) GoString() string {
+	return s.String()
+}
+
 type GetUserPolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user who the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetUserPolicyInput `json:"-" xml:"-"`
 }
@@ -5705,16 +7994,26 @@ type metadataGetUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetUserPolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetUserPolicy request.
 type GetUserPolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The user the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetUserPolicyOutput `json:"-" xml:"-"`
 }
@@ -5723,6 +8022,16 @@ type metadataGetUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetUserPolicyOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about an IAM group entity.
 //
 //  This data type is used as a response element in the following actions:
@@ -5732,7 +8041,7 @@ type Group struct {
 	// The Amazon Resource Name (ARN) specifying the group. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the group was created.
@@ -5741,15 +8050,15 @@ type Group struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string" required:"true"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	metadataGroup `json:"-" xml:"-"`
 }
@@ -5758,6 +8067,16 @@ type metadataGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Group) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Group) GoString() string {
+	return s.String()
+}
+
 // Contains information about an IAM group, including all of the group's policies.
 //
 // This data type is used as a response element in the GetAccountAuthorizationDetails
@@ -5768,7 +8087,7 @@ type GroupDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// A list of the managed policies attached to the group.
 	AttachedManagedPolicies []*AttachedPolicy `type:"list"`
@@ -5780,10 +8099,10 @@ type GroupDetail struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the inline policies embedded in the group.
 	GroupPolicyList []*PolicyDetail `type:"list"`
@@ -5791,7 +8110,7 @@ type GroupDetail struct {
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataGroupDetail `json:"-" xml:"-"`
 }
@@ -5800,6 +8119,16 @@ type metadataGroupDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GroupDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GroupDetail) GoString() string {
+	return s.String()
+}
+
 // Contains information about an instance profile.
 //
 // This data type is used as a response element in the following actions:
@@ -5816,7 +8145,7 @@ type InstanceProfile struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date when the instance profile was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -5824,15 +8153,15 @@ type InstanceProfile struct {
 	// The stable and unique string identifying the instance profile. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" required:"true"`
+	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name identifying the instance profile.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The role associated with the instance profile.
 	Roles []*Role `type:"list" required:"true"`
@@ -5844,20 +8173,30 @@ type metadataInstanceProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceProfile) GoString() string {
+	return s.String()
+}
+
 type ListAccessKeysInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of keys you want in the response. If there are additional keys beyond the
 	// maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListAccessKeysInput `json:"-" xml:"-"`
 }
@@ -5866,6 +8205,16 @@ type metadataListAccessKeysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAccessKeysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAccessKeysInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListAccessKeys request.
 type ListAccessKeysOutput struct {
 	// A list of access key metadata.
@@ -5878,7 +8227,7 @@ type ListAccessKeysOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAccessKeysOutput `json:"-" xml:"-"`
 }
@@ -5887,17 +8236,27 @@ type metadataListAccessKeysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAccessKeysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAccessKeysOutput) GoString() string {
+	return s.String()
+}
+
 type ListAccountAliasesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of account
 	// aliases you want in the response. If there are additional account aliases
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListAccountAliasesInput `json:"-" xml:"-"`
 }
@@ -5906,6 +8265,16 @@ type metadataListAccountAliasesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAccountAliasesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAccountAliasesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListAccountAliases request.
 type ListAccountAliasesOutput struct {
 	// A list of aliases associated with the account.
@@ -5920,7 +8289,7 @@ type ListAccountAliasesOutput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAccountAliasesOutput `json:"-" xml:"-"`
 }
@@ -5929,25 +8298,35 @@ type metadataListAccountAliasesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAccountAliasesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAccountAliasesOutput) GoString() string {
+	return s.String()
+}
+
 type ListAttachedGroupPoliciesInput struct {
 	// The name (friendly name, not ARN) of the group to list attached policies
 	// for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	metadataListAttachedGroupPoliciesInput `json:"-" xml:"-"`
 }
@@ -5956,6 +8335,16 @@ type metadataListAttachedGroupPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAttachedGroupPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAttachedGroupPoliciesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListAttachedGroupPolicies request.
 type ListAttachedGroupPoliciesOutput struct {
 	// A list of the attached policies.
@@ -5968,7 +8357,7 @@ type ListAttachedGroupPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAttachedGroupPoliciesOutput `json:"-" xml:"-"`
 }
@@ -5977,24 +8366,34 @@ type metadataListAttachedGroupPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAttachedGroupPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAttachedGroupPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListAttachedRolePoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The name (friendly name, not ARN) of the role to list attached policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListAttachedRolePoliciesInput `json:"-" xml:"-"`
 }
@@ -6003,6 +8402,16 @@ type metadataListAttachedRolePoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAttachedRolePoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAttachedRolePoliciesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListAttachedRolePolicies request.
 type ListAttachedRolePoliciesOutput struct {
 	// A list of the attached policies.
@@ -6015,7 +8424,7 @@ type ListAttachedRolePoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAttachedRolePoliciesOutput `json:"-" xml:"-"`
 }
@@ -6024,24 +8433,34 @@ type metadataListAttachedRolePoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAttachedRolePoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAttachedRolePoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListAttachedUserPoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The name (friendly name, not ARN) of the user to list attached policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListAttachedUserPoliciesInput `json:"-" xml:"-"`
 }
@@ -6050,6 +8469,16 @@ type metadataListAttachedUserPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAttachedUserPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAttachedUserPoliciesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListAttachedUserPolicies request.
 type ListAttachedUserPoliciesOutput struct {
 	// A list of the attached policies.
@@ -6062,7 +8491,7 @@ type ListAttachedUserPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAttachedUserPoliciesOutput `json:"-" xml:"-"`
 }
@@ -6071,35 +8500,47 @@ type metadataListAttachedUserPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAttachedUserPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAttachedUserPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListEntitiesForPolicyInput struct {
 	// The entity type to use for filtering the results.
 	//
 	// For example, when EntityFilter is Role, only the roles that are attached
 	// to the specified policy are returned. This parameter is optional. If it is
 	// not included, all attached entities (users, groups, and roles) are returned.
-	EntityFilter *string `type:"string"`
+	//
+	// See the EntityType* constants for valid values.
+	EntityFilter *string `type:"string" enum:"User,Role,Group,LocalManagedPolicy,AWSManagedPolicy"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of entities
 	// you want in the response. If there are additional entities beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all entities.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListEntitiesForPolicyInput `json:"-" xml:"-"`
 }
@@ -6108,6 +8549,16 @@ type metadataListEntitiesForPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListEntitiesForPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListEntitiesForPolicyInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListEntitiesForPolicy request.
 type ListEntitiesForPolicyOutput struct {
 	// A flag that indicates whether there are more entities to list. If your results
@@ -6117,7 +8568,7 @@ type ListEntitiesForPolicyOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of groups that the policy is attached to.
 	PolicyGroups []*PolicyGroup `type:"list"`
@@ -6135,20 +8586,30 @@ type metadataListEntitiesForPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListEntitiesForPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListEntitiesForPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type ListGroupPoliciesInput struct {
 	// The name of the group to list policies for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policy
 	// names you want in the response. If there are additional policy names beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListGroupPoliciesInput `json:"-" xml:"-"`
 }
@@ -6157,6 +8618,16 @@ type metadataListGroupPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGroupPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGroupPoliciesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListGroupPolicies request.
 type ListGroupPoliciesOutput struct {
 	// A flag that indicates whether there are more policy names to list. If your
@@ -6166,7 +8637,7 @@ type ListGroupPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -6178,20 +8649,30 @@ type metadataListGroupPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGroupPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGroupPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListGroupsForUserInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list groups for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListGroupsForUserInput `json:"-" xml:"-"`
 }
@@ -6200,6 +8681,16 @@ type metadataListGroupsForUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGroupsForUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGroupsForUserInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListGroupsForUser request.
 type ListGroupsForUserOutput struct {
 	// A list of groups.
@@ -6212,7 +8703,7 @@ type ListGroupsForUserOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListGroupsForUserOutput `json:"-" xml:"-"`
 }
@@ -6221,24 +8712,34 @@ type metadataListGroupsForUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGroupsForUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGroupsForUserOutput) GoString() string {
+	return s.String()
+}
+
 type ListGroupsInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /division_abc/subdivision_xyz/
 	// gets all groups whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all groups.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListGroupsInput `json:"-" xml:"-"`
 }
@@ -6247,6 +8748,16 @@ type metadataListGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGroupsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListGroups request.
 type ListGroupsOutput struct {
 	// A list of groups.
@@ -6259,7 +8770,7 @@ type ListGroupsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListGroupsOutput `json:"-" xml:"-"`
 }
@@ -6268,21 +8779,31 @@ type metadataListGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGroupsOutput) GoString() string {
+	return s.String()
+}
+
 type ListInstanceProfilesForRoleInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of instance profiles you want in the response. If there are additional instance
 	// profiles beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list instance profiles for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListInstanceProfilesForRoleInput `json:"-" xml:"-"`
 }
@@ -6291,6 +8812,16 @@ type metadataListInstanceProfilesForRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstanceProfilesForRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstanceProfilesForRoleInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListInstanceProfilesForRole request.
 type ListInstanceProfilesForRoleOutput struct {
 	// A list of instance profiles.
@@ -6304,7 +8835,7 @@ type ListInstanceProfilesForRoleOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListInstanceProfilesForRoleOutput `json:"-" xml:"-"`
 }
@@ -6313,25 +8844,35 @@ type metadataListInstanceProfilesForRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstanceProfilesForRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstanceProfilesForRoleOutput) GoString() string {
+	return s.String()
+}
+
 type ListInstanceProfilesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of instance profiles you want in the response. If there are additional instance
 	// profiles beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all instance profiles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all instance profiles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListInstanceProfilesInput `json:"-" xml:"-"`
 }
@@ -6340,6 +8881,16 @@ type metadataListInstanceProfilesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstanceProfilesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstanceProfilesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListInstanceProfiles request.
 type ListInstanceProfilesOutput struct {
 	// A list of instance profiles.
@@ -6353,7 +8904,7 @@ type ListInstanceProfilesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListInstanceProfilesOutput `json:"-" xml:"-"`
 }
@@ -6362,20 +8913,30 @@ type metadataListInstanceProfilesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListInstanceProfilesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListInstanceProfilesOutput) GoString() string {
+	return s.String()
+}
+
 type ListMFADevicesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of MFA
 	// devices you want in the response. If there are additional MFA devices beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user whose MFA devices you want to list.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListMFADevicesInput `json:"-" xml:"-"`
 }
@@ -6384,6 +8945,16 @@ type metadataListMFADevicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListMFADevicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListMFADevicesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListMFADevices request.
 type ListMFADevicesOutput struct {
 	// A flag that indicates whether there are more MFA devices to list. If your
@@ -6396,7 +8967,7 @@ type ListMFADevicesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListMFADevicesOutput `json:"-" xml:"-"`
 }
@@ -6405,6 +8976,16 @@ type metadataListMFADevicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListMFADevicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListMFADevicesOutput) GoString() string {
+	return s.String()
+}
+
 type ListOpenIDConnectProvidersInput struct {
 	metadataListOpenIDConnectProvidersInput `json:"-" xml:"-"`
 }
@@ -6413,6 +8994,16 @@ type metadataListOpenIDConnectProvidersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListOpenIDConnectProvidersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListOpenIDConnectProvidersInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListOpenIDConnectProviders request.
 type ListOpenIDConnectProvidersOutput struct {
 	// The list of IAM OpenID Connect providers in the AWS account.
@@ -6425,17 +9016,27 @@ type metadataListOpenIDConnectProvidersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListOpenIDConnectProvidersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListOpenIDConnectProvidersOutput) GoString() string {
+	return s.String()
+}
+
 type ListPoliciesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of policies you want in the response. If there are additional policies beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// A flag to filter the results to only the attached policies.
 	//
@@ -6446,7 +9047,7 @@ type ListPoliciesInput struct {
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The scope to use for filtering the results.
 	//
@@ -6455,7 +9056,9 @@ type ListPoliciesInput struct {
 	//
 	// This parameter is optional. If it is not included, or if it is set to All,
 	// all policies are returned.
-	Scope *string `type:"string"`
+	//
+	// See the PolicyScopeType* constants for valid values.
+	Scope *string `type:"string" enum:"All,AWS,Local"`
 
 	metadataListPoliciesInput `json:"-" xml:"-"`
 }
@@ -6464,6 +9067,16 @@ type metadataListPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPoliciesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListPolicies request.
 type ListPoliciesOutput struct {
 	// A flag that indicates whether there are more policies to list. If your results
@@ -6473,7 +9086,7 @@ type ListPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policies.
 	Policies []*Policy `type:"list"`
@@ -6485,25 +9098,35 @@ type metadataListPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListPolicyVersionsInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of policy versions you want in the response. If there are additional policy
 	// versions beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListPolicyVersionsInput `json:"-" xml:"-"`
 }
@@ -6512,6 +9135,16 @@ type metadataListPolicyVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPolicyVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPolicyVersionsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListPolicyVersions request.
 type ListPolicyVersionsOutput struct {
 	// A flag that indicates whether there are more policy versions to list. If
@@ -6522,7 +9155,7 @@ type ListPolicyVersionsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy versions.
 	//
@@ -6538,20 +9171,30 @@ type metadataListPolicyVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPolicyVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPolicyVersionsOutput) GoString() string {
+	return s.String()
+}
+
 type ListRolePoliciesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of role policies you want in the response. If there are additional role policies
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListRolePoliciesInput `json:"-" xml:"-"`
 }
@@ -6560,6 +9203,16 @@ type metadataListRolePoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListRolePoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListRolePoliciesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListRolePolicies request.
 type ListRolePoliciesOutput struct {
 	// A flag that indicates whether there are more policy names to list. If your
@@ -6569,7 +9222,7 @@ type ListRolePoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -6581,24 +9234,34 @@ type metadataListRolePoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListRolePoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListRolePoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListRolesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of roles you want in the response. If there are additional roles beyond the
 	// maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all roles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all roles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListRolesInput `json:"-" xml:"-"`
 }
@@ -6607,6 +9270,16 @@ type metadataListRolesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListRolesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListRolesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListRoles request.
 type ListRolesOutput struct {
 	// A flag that indicates whether there are more roles to list. If your results
@@ -6616,7 +9289,7 @@ type ListRolesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of roles.
 	Roles []*Role `type:"list" required:"true"`
@@ -6628,6 +9301,16 @@ type metadataListRolesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListRolesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListRolesOutput) GoString() string {
+	return s.String()
+}
+
 type ListSAMLProvidersInput struct {
 	metadataListSAMLProvidersInput `json:"-" xml:"-"`
 }
@@ -6636,6 +9319,16 @@ type metadataListSAMLProvidersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSAMLProvidersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSAMLProvidersInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListSAMLProviders request.
 type ListSAMLProvidersOutput struct {
 	// The list of SAML providers for this account.
@@ -6648,25 +9341,35 @@ type metadataListSAMLProvidersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSAMLProvidersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSAMLProvidersOutput) GoString() string {
+	return s.String()
+}
+
 type ListServerCertificatesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of server
 	// certificates you want in the response. If there are additional server certificates
 	// beyond the maximum you specify, the IsTruncated response element will be
 	// set to true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /company/servercerts
 	// would get all server certificates for which the path starts with /company/servercerts.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all server certificates.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListServerCertificatesInput `json:"-" xml:"-"`
 }
@@ -6675,6 +9378,16 @@ type metadataListServerCertificatesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListServerCertificatesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListServerCertificatesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListServerCertificates request.
 type ListServerCertificatesOutput struct {
 	// A flag that indicates whether there are more server certificates to list.
@@ -6685,7 +9398,7 @@ type ListServerCertificatesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of server certificates.
 	ServerCertificateMetadataList []*ServerCertificateMetadata `type:"list" required:"true"`
@@ -6697,20 +9410,30 @@ type metadataListServerCertificatesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListServerCertificatesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListServerCertificatesOutput) GoString() string {
+	return s.String()
+}
+
 type ListSigningCertificatesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of certificate
 	// IDs you want in the response. If there are additional certificate IDs beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListSigningCertificatesInput `json:"-" xml:"-"`
 }
@@ -6719,6 +9442,16 @@ type metadataListSigningCertificatesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSigningCertificatesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSigningCertificatesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListSigningCertificates request.
 type ListSigningCertificatesOutput struct {
 	// A list of the user's signing certificate information.
@@ -6731,7 +9464,7 @@ type ListSigningCertificatesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListSigningCertificatesOutput `json:"-" xml:"-"`
 }
@@ -6740,20 +9473,30 @@ type metadataListSigningCertificatesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSigningCertificatesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSigningCertificatesOutput) GoString() string {
+	return s.String()
+}
+
 type ListUserPoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policy
 	// names you want in the response. If there are additional policy names beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListUserPoliciesInput `json:"-" xml:"-"`
 }
@@ -6762,6 +9505,16 @@ type metadataListUserPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListUserPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListUserPoliciesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListUserPolicies request.
 type ListUserPoliciesOutput struct {
 	// A flag that indicates whether there are more policy names to list. If your
@@ -6771,7 +9524,7 @@ type ListUserPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -6783,24 +9536,34 @@ type metadataListUserPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListUserPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListUserPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListUsersInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of user names you want in the response. If there are additional user names
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /division_abc/subdivision_xyz/,
 	// which would get all user names whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all user names.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\x{002F}[\\x{0021}-\\x{007F}]*"`
 
 	metadataListUsersInput `json:"-" xml:"-"`
 }
@@ -6809,6 +9572,16 @@ type metadataListUsersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListUsersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListUsersInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListUsers request.
 type ListUsersOutput struct {
 	// A flag that indicates whether there are more user names to list. If your
@@ -6818,7 +9591,7 @@ type ListUsersOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of users.
 	Users []*User `type:"list" required:"true"`
@@ -6830,22 +9603,34 @@ type metadataListUsersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListUsersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListUsersOutput) GoString() string {
+	return s.String()
+}
+
 type ListVirtualMFADevicesInput struct {
 	// The status (unassigned or assigned) of the devices to list. If you do not
 	// specify an AssignmentStatus, the action defaults to Any which lists both
 	// assigned and unassigned virtual MFA devices.
-	AssignmentStatus *string `type:"string"`
+	//
+	// See the AssignmentStatusType* constants for valid values.
+	AssignmentStatus *string `type:"string" enum:"Assigned,Unassigned,Any"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of MFA devices you want in the response. If there are additional MFA devices
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListVirtualMFADevicesInput `json:"-" xml:"-"`
 }
@@ -6854,6 +9639,16 @@ type metadataListVirtualMFADevicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVirtualMFADevicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVirtualMFADevicesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful ListVirtualMFADevices request.
 type ListVirtualMFADevicesOutput struct {
 	// A flag that indicates whether there are more items to list. If your results
@@ -6863,7 +9658,7 @@ type ListVirtualMFADevicesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// The list of virtual MFA devices in the current account that match the AssignmentStatus
 	// value that was passed in the request.
@@ -6876,6 +9671,16 @@ type metadataListVirtualMFADevicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVirtualMFADevicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVirtualMFADevicesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the user name and password create date for a user.
 //
 //  This data type is used as a response element in the CreateLoginProfile
@@ -6889,7 +9694,7 @@ type LoginProfile struct {
 
 	// The name of the user, which can be used for signing in to the AWS Management
 	// Console.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataLoginProfile `json:"-" xml:"-"`
 }
@@ -6898,6 +9703,16 @@ type metadataLoginProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoginProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoginProfile) GoString() string {
+	return s.String()
+}
+
 // Contains information about an MFA device.
 //
 // This data type is used as a response element in the ListMFADevices action.
@@ -6907,10 +9722,10 @@ type MFADevice struct {
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The user with whom the MFA device is associated.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataMFADevice `json:"-" xml:"-"`
 }
@@ -6919,6 +9734,16 @@ type metadataMFADevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MFADevice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MFADevice) GoString() string {
+	return s.String()
+}
+
 // Contains information about a managed policy, including the policy's ARN,
 // versions, and the number of principal entities (users, groups, and roles)
 // that the policy is attached to.
@@ -6935,7 +9760,7 @@ type ManagedPolicyDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The number of principal entities (users, groups, and roles) that the policy
 	// is attached to.
@@ -6951,10 +9776,10 @@ type ManagedPolicyDetail struct {
 	// For more information about policy versions, see Versioning for Managed Policies
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/policies-managed-versions.html)
 	// in the Using IAM guide.
-	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string"`
+	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"`
 
 	// A friendly description of the policy.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// Specifies whether the policy can be attached to an IAM user, group, or role.
 	IsAttachable *bool `type:"boolean"`
@@ -6963,16 +9788,16 @@ type ManagedPolicyDetail struct {
 	//
 	// For more information about paths, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The stable and unique string identifying the policy.
 	//
 	// For more information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	PolicyID *string `locationName:"PolicyId" type:"string"`
+	PolicyID *string `locationName:"PolicyId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name (not ARN) identifying the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// A list containing information about the versions of the policy.
 	PolicyVersionList []*PolicyVersion `type:"list"`
@@ -6993,6 +9818,16 @@ type metadataManagedPolicyDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ManagedPolicyDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ManagedPolicyDetail) GoString() string {
+	return s.String()
+}
+
 // Contains the Amazon Resource Name (ARN) for an IAM OpenID Connect provider.
 type OpenIDConnectProviderListEntry struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
@@ -7000,7 +9835,7 @@ type OpenIDConnectProviderListEntry struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	metadataOpenIDConnectProviderListEntry `json:"-" xml:"-"`
 }
@@ -7009,6 +9844,16 @@ type metadataOpenIDConnectProviderListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OpenIDConnectProviderListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OpenIDConnectProviderListEntry) GoString() string {
+	return s.String()
+}
+
 // Contains information about the account password policy.
 //
 //  This data type is used as a response element in the GetAccountPasswordPolicy
@@ -7026,14 +9871,14 @@ type PasswordPolicy struct {
 	HardExpiry *bool `type:"boolean"`
 
 	// The number of days that an IAM user password is valid.
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// Minimum length to require for IAM user passwords.
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing.
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether to require lowercase characters for IAM user passwords.
 	RequireLowercaseCharacters *bool `type:"boolean"`
@@ -7054,6 +9899,16 @@ type metadataPasswordPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PasswordPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PasswordPolicy) GoString() string {
+	return s.String()
+}
+
 // Contains information about a managed policy.
 //
 // This data type is used as a response element in the CreatePolicy, GetPolicy,
@@ -7068,7 +9923,7 @@ type Policy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The number of entities (users, groups, and roles) that the policy is attached
 	// to.
@@ -7079,13 +9934,13 @@ type Policy struct {
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The identifier for the version of the policy that is set as the default version.
-	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string"`
+	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"`
 
 	// A friendly description of the policy.
 	//
 	// This element is included in the response to the GetPolicy operation. It
 	// is not included in the response to the ListPolicies operation.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// Specifies whether the policy can be attached to an IAM user, group, or role.
 	IsAttachable *bool `type:"boolean"`
@@ -7094,16 +9949,16 @@ type Policy struct {
 	//
 	// For more information about paths, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The stable and unique string identifying the policy.
 	//
 	// For more information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	PolicyID *string `locationName:"PolicyId" type:"string"`
+	PolicyID *string `locationName:"PolicyId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name (not ARN) identifying the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the policy was last updated.
@@ -7121,16 +9976,26 @@ type metadataPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Policy) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Policy) GoString() string {
+	return s.String()
+}
+
 // Contains information about an IAM policy, including the policy document.
 //
 // This data type is used as a response element in the GetAccountAuthorizationDetails
 // action.
 type PolicyDetail struct {
 	// The policy document.
-	PolicyDocument *string `type:"string"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyDetail `json:"-" xml:"-"`
 }
@@ -7139,6 +10004,16 @@ type metadataPolicyDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyDetail) GoString() string {
+	return s.String()
+}
+
 // Contains information about a group that a managed policy is attached to.
 //
 // This data type is used as a response element in the ListEntitiesForPolicy
@@ -7149,7 +10024,7 @@ type metadataPolicyDetail struct {
 // in the Using IAM guide.
 type PolicyGroup struct {
 	// The name (friendly name, not ARN) identifying the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyGroup `json:"-" xml:"-"`
 }
@@ -7158,6 +10033,16 @@ type metadataPolicyGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyGroup) GoString() string {
+	return s.String()
+}
+
 // Contains information about a role that a managed policy is attached to.
 //
 // This data type is used as a response element in the ListEntitiesForPolicy
@@ -7168,7 +10053,7 @@ type metadataPolicyGroup struct {
 // in the Using IAM guide.
 type PolicyRole struct {
 	// The name (friendly name, not ARN) identifying the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyRole `json:"-" xml:"-"`
 }
@@ -7177,6 +10062,16 @@ type metadataPolicyRole struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyRole) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyRole) GoString() string {
+	return s.String()
+}
+
 // Contains information about a user that a managed policy is attached to.
 //
 // This data type is used as a response element in the ListEntitiesForPolicy
@@ -7187,7 +10082,7 @@ type metadataPolicyRole struct {
 // in the Using IAM guide.
 type PolicyUser struct {
 	// The name (friendly name, not ARN) identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyUser `json:"-" xml:"-"`
 }
@@ -7196,6 +10091,16 @@ type metadataPolicyUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyUser) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyUser) GoString() string {
+	return s.String()
+}
+
 // Contains information about a version of a managed policy.
 //
 // This data type is used as a response element in the CreatePolicyVersion,
@@ -7215,7 +10120,7 @@ type PolicyVersion struct {
 	// The policy document is returned in the response to the GetPolicyVersion
 	// and GetAccountAuthorizationDetails operations. It is not returned in the
 	// response to the CreatePolicyVersion or ListPolicyVersions operations.
-	Document *string `type:"string"`
+	Document *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// Specifies whether the policy version is set as the policy's default version.
 	IsDefaultVersion *bool `type:"boolean"`
@@ -7224,7 +10129,7 @@ type PolicyVersion struct {
 	//
 	// Policy version identifiers always begin with v (always lowercase). When
 	// a policy is created, the first policy version is v1.
-	VersionID *string `locationName:"VersionId" type:"string"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"`
 
 	metadataPolicyVersion `json:"-" xml:"-"`
 }
@@ -7233,15 +10138,25 @@ type metadataPolicyVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PolicyVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PolicyVersion) GoString() string {
+	return s.String()
+}
+
 type PutGroupPolicyInput struct {
 	// The name of the group to associate the policy with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutGroupPolicyInput `json:"-" xml:"-"`
 }
@@ -7250,6 +10165,16 @@ type metadataPutGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutGroupPolicyInput) GoString() string {
+	return s.String()
+}
+
 type PutGroupPolicyOutput struct {
 	metadataPutGroupPolicyOutput `json:"-" xml:"-"`
 }
@@ -7258,15 +10183,25 @@ type metadataPutGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutGroupPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type PutRolePolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to associate the policy with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutRolePolicyInput `json:"-" xml:"-"`
 }
@@ -7275,6 +10210,16 @@ type metadataPutRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRolePolicyInput) GoString() string {
+	return s.String()
+}
+
 type PutRolePolicyOutput struct {
 	metadataPutRolePolicyOutput `json:"-" xml:"-"`
 }
@@ -7283,15 +10228,25 @@ type metadataPutRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRolePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type PutUserPolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to associate the policy with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutUserPolicyInput `json:"-" xml:"-"`
 }
@@ -7300,6 +10255,16 @@ type metadataPutUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutUserPolicyInput) GoString() string {
+	return s.String()
+}
+
 type PutUserPolicyOutput struct {
 	metadataPutUserPolicyOutput `json:"-" xml:"-"`
 }
@@ -7308,15 +10273,25 @@ type metadataPutUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutUserPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type RemoveClientIDFromOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to remove from the IAM OpenID Connect
 	// provider. For more information about client IDs, see CreateOpenIDConnectProvider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to remove the client ID from. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataRemoveClientIDFromOpenIDConnectProviderInput `json:"-" xml:"-"`
 }
@@ -7325,6 +10300,16 @@ type metadataRemoveClientIDFromOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveClientIDFromOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveClientIDFromOpenIDConnectProviderInput) GoString() string {
+	return s.String()
+}
+
 type RemoveClientIDFromOpenIDConnectProviderOutput struct {
 	metadataRemoveClientIDFromOpenIDConnectProviderOutput `json:"-" xml:"-"`
 }
@@ -7333,12 +10318,22 @@ type metadataRemoveClientIDFromOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveClientIDFromOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveClientIDFromOpenIDConnectProviderOutput) GoString() string {
+	return s.String()
+}
+
 type RemoveRoleFromInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to remove.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRemoveRoleFromInstanceProfileInput `json:"-" xml:"-"`
 }
@@ -7347,6 +10342,16 @@ type metadataRemoveRoleFromInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveRoleFromInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveRoleFromInstanceProfileInput) GoString() string {
+	return s.String()
+}
+
 type RemoveRoleFromInstanceProfileOutput struct {
 	metadataRemoveRoleFromInstanceProfileOutput `json:"-" xml:"-"`
 }
@@ -7355,12 +10360,22 @@ type metadataRemoveRoleFromInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveRoleFromInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveRoleFromInstanceProfileOutput) GoString() string {
+	return s.String()
+}
+
 type RemoveUserFromGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to remove.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRemoveUserFromGroupInput `json:"-" xml:"-"`
 }
@@ -7369,6 +10384,16 @@ type metadataRemoveUserFromGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveUserFromGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveUserFromGroupInput) GoString() string {
+	return s.String()
+}
+
 type RemoveUserFromGroupOutput struct {
 	metadataRemoveUserFromGroupOutput `json:"-" xml:"-"`
 }
@@ -7377,18 +10402,28 @@ type metadataRemoveUserFromGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveUserFromGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveUserFromGroupOutput) GoString() string {
+	return s.String()
+}
+
 type ResyncMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// Serial number that uniquely identifies the MFA device.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user whose MFA device you want to resynchronize.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataResyncMFADeviceInput `json:"-" xml:"-"`
 }
@@ -7397,6 +10432,16 @@ type metadataResyncMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResyncMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResyncMFADeviceInput) GoString() string {
+	return s.String()
+}
+
 type ResyncMFADeviceOutput struct {
 	metadataResyncMFADeviceOutput `json:"-" xml:"-"`
 }
@@ -7405,6 +10450,16 @@ type metadataResyncMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResyncMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResyncMFADeviceOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about an IAM role.
 //
 //  This data type is used as a response element in the following actions:
@@ -7418,10 +10473,10 @@ type Role struct {
 	// The Amazon Resource Name (ARN) specifying the role. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy that grants an entity permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the role was created.
@@ -7430,15 +10485,15 @@ type Role struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string" required:"true"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRole `json:"-" xml:"-"`
 }
@@ -7447,6 +10502,16 @@ type metadataRole struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Role) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Role) GoString() string {
+	return s.String()
+}
+
 // Contains information about an IAM role, including all of the role's policies.
 //
 // This data type is used as a response element in the GetAccountAuthorizationDetails
@@ -7457,10 +10522,10 @@ type RoleDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The trust policy that grants permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// A list of managed policies attached to the role. These policies are the role's
 	// access (permissions) policies.
@@ -7476,15 +10541,15 @@ type RoleDetail struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// A list of inline policies embedded in the role. These policies are the role's
 	// access (permissions) policies.
@@ -7497,10 +10562,20 @@ type metadataRoleDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RoleDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RoleDetail) GoString() string {
+	return s.String()
+}
+
 // Contains the list of SAML providers for this account.
 type SAMLProviderListEntry struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time when the SAML provider was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7515,16 +10590,26 @@ type metadataSAMLProviderListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SAMLProviderListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SAMLProviderListEntry) GoString() string {
+	return s.String()
+}
+
 // Contains information about a server certificate.
 //
 //  This data type is used as a response element in the GetServerCertificate
 // action.
 type ServerCertificate struct {
 	// The contents of the public key certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The contents of the public key certificate chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]*"`
 
 	// The meta information of the server certificate, such as its name, path, ID,
 	// and ARN.
@@ -7537,6 +10622,16 @@ type metadataServerCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ServerCertificate) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ServerCertificate) GoString() string {
+	return s.String()
+}
+
 // Contains information about a server certificate without its certificate body,
 // certificate chain, and private key.
 //
@@ -7547,7 +10642,7 @@ type ServerCertificateMetadata struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date on which the certificate is set to expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7555,15 +10650,15 @@ type ServerCertificateMetadata struct {
 	// The path to the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The stable and unique string identifying the server certificate. For more
 	// information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" required:"true"`
+	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name that identifies the server certificate.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The date when the server certificate was uploaded.
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7575,20 +10670,30 @@ type metadataServerCertificateMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ServerCertificateMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ServerCertificateMetadata) GoString() string {
+	return s.String()
+}
+
 type SetDefaultPolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The version of the policy to set as the default (operative) version.
 	//
 	// For more information about managed policy versions, see Versioning for Managed
 	// Policies (http://docs.aws.amazon.com/IAM/latest/UserGuide/policies-managed-versions.html)
 	// in the Using IAM guide.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataSetDefaultPolicyVersionInput `json:"-" xml:"-"`
 }
@@ -7597,6 +10702,16 @@ type metadataSetDefaultPolicyVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetDefaultPolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetDefaultPolicyVersionInput) GoString() string {
+	return s.String()
+}
+
 type SetDefaultPolicyVersionOutput struct {
 	metadataSetDefaultPolicyVersionOutput `json:"-" xml:"-"`
 }
@@ -7605,26 +10720,38 @@ type metadataSetDefaultPolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetDefaultPolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetDefaultPolicyVersionOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about an X.509 signing certificate.
 //
 // This data type is used as a response element in the UploadSigningCertificate
 // and ListSigningCertificates actions.
 type SigningCertificate struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The ID for the signing certificate.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The status of the signing certificate. Active means the key is valid for
 	// API calls, while Inactive means it is not.
-	Status *string `type:"string" required:"true"`
+	//
+	// See the StatusType* constants for valid values.
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The date when the signing certificate was uploaded.
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The name of the user the signing certificate is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataSigningCertificate `json:"-" xml:"-"`
 }
@@ -7633,17 +10760,29 @@ type metadataSigningCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SigningCertificate) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SigningCertificate) GoString() string {
+	return s.String()
+}
+
 type UpdateAccessKeyInput struct {
 	// The access key ID of the secret access key you want to update.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The status you want to assign to the secret access key. Active means the
 	// key can be used for API calls to AWS, while Inactive means the key cannot
 	// be used.
-	Status *string `type:"string" required:"true"`
+	//
+	// See the StatusType* constants for valid values.
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the user whose key you want to update.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUpdateAccessKeyInput `json:"-" xml:"-"`
 }
@@ -7652,6 +10791,16 @@ type metadataUpdateAccessKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAccessKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAccessKeyInput) GoString() string {
+	return s.String()
+}
+
 type UpdateAccessKeyOutput struct {
 	metadataUpdateAccessKeyOutput `json:"-" xml:"-"`
 }
@@ -7660,6 +10809,16 @@ type metadataUpdateAccessKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAccessKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAccessKeyOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateAccountPasswordPolicyInput struct {
 	// Allows all IAM users in your account to use the AWS Management Console to
 	// change their own passwords. For more information, see Letting IAM Users Change
@@ -7678,19 +10837,19 @@ type UpdateAccountPasswordPolicyInput struct {
 	// of 0 means IAM user passwords never expire.
 	//
 	// Default value: 0
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// The minimum number of characters allowed in an IAM user password.
 	//
 	// Default value: 6
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing. The default value of 0 means IAM users are not prevented from reusing
 	// previous passwords.
 	//
 	// Default value: 0
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether IAM user passwords must contain at least one lowercase
 	// character from the ISO basic Latin alphabet (a to z).
@@ -7725,6 +10884,16 @@ type metadataUpdateAccountPasswordPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAccountPasswordPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAccountPasswordPolicyInput) GoString() string {
+	return s.String()
+}
+
 type UpdateAccountPasswordPolicyOutput struct {
 	metadataUpdateAccountPasswordPolicyOutput `json:"-" xml:"-"`
 }
@@ -7733,12 +10902,22 @@ type metadataUpdateAccountPasswordPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAccountPasswordPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAccountPasswordPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateAssumeRolePolicyInput struct {
 	// The policy that grants an entity permission to assume the role.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the role to update.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateAssumeRolePolicyInput `json:"-" xml:"-"`
 }
@@ -7747,6 +10926,16 @@ type metadataUpdateAssumeRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAssumeRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAssumeRolePolicyInput) GoString() string {
+	return s.String()
+}
+
 type UpdateAssumeRolePolicyOutput struct {
 	metadataUpdateAssumeRolePolicyOutput `json:"-" xml:"-"`
 }
@@ -7755,16 +10944,26 @@ type metadataUpdateAssumeRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAssumeRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAssumeRolePolicyOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateGroupInput struct {
 	// Name of the group to update. If you're changing the name of the group, this
 	// is the original name.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// New name for the group. Only include this if changing the group's name.
-	NewGroupName *string `type:"string"`
+	NewGroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// New path for the group. Only include this if changing the group's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	metadataUpdateGroupInput `json:"-" xml:"-"`
 }
@@ -7773,6 +10972,16 @@ type metadataUpdateGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateGroupInput) GoString() string {
+	return s.String()
+}
+
 type UpdateGroupOutput struct {
 	metadataUpdateGroupOutput `json:"-" xml:"-"`
 }
@@ -7781,15 +10990,25 @@ type metadataUpdateGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateGroupOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateLoginProfileInput struct {
 	// The new password for the specified user.
-	Password *string `type:"string"`
+	Password *string `type:"string" min:"1" max:"128" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" sensitive:"true"`
 
 	// Require the specified user to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user whose password you want to update.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateLoginProfileInput `json:"-" xml:"-"`
 }
@@ -7798,6 +11017,16 @@ type metadataUpdateLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateLoginProfileInput) GoString() string {
+	return s.String()
+}
+
 type UpdateLoginProfileOutput struct {
 	metadataUpdateLoginProfileOutput `json:"-" xml:"-"`
 }
@@ -7806,11 +11035,21 @@ type metadataUpdateLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateLoginProfileOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateOpenIDConnectProviderThumbprintInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to update the thumbprint for. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A list of certificate thumbprints that are associated with the specified
 	// IAM OpenID Connect provider. For more information, see CreateOpenIDConnectProvider.
@@ -7823,6 +11062,16 @@ type metadataUpdateOpenIDConnectProviderThumbprintInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateOpenIDConnectProviderThumbprintInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateOpenIDConnectProviderThumbprintInput) GoString() string {
+	return s.String()
+}
+
 type UpdateOpenIDConnectProviderThumbprintOutput struct {
 	metadataUpdateOpenIDConnectProviderThumbprintOutput `json:"-" xml:"-"`
 }
@@ -7831,16 +11080,26 @@ type metadataUpdateOpenIDConnectProviderThumbprintOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateOpenIDConnectProviderThumbprintOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateOpenIDConnectProviderThumbprintOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateSAMLProviderInput struct {
 	// An XML document generated by an identity provider (IdP) that supports SAML
 	// 2.0. The document includes the issuer's name, expiration information, and
 	// keys that can be used to validate the SAML authentication response (assertions)
 	// that are received from the IdP. You must generate the metadata document using
 	// the identity management software that is used as your organization's IdP.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider to update.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataUpdateSAMLProviderInput `json:"-" xml:"-"`
 }
@@ -7849,10 +11108,20 @@ type metadataUpdateSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateSAMLProviderInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful UpdateSAMLProvider request.
 type UpdateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider that was updated.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataUpdateSAMLProviderOutput `json:"-" xml:"-"`
 }
@@ -7861,18 +11130,28 @@ type metadataUpdateSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateSAMLProviderOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateServerCertificateInput struct {
 	// The new path for the server certificate. Include this only if you are updating
 	// the server certificate's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The new name for the server certificate. Include this only if you are updating
 	// the server certificate's name. The name of the certificate cannot contain
 	// any spaces.
-	NewServerCertificateName *string `type:"string"`
+	NewServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// The name of the server certificate that you want to update.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateServerCertificateInput `json:"-" xml:"-"`
 }
@@ -7881,6 +11160,16 @@ type metadataUpdateServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateServerCertificateInput) GoString() string {
+	return s.String()
+}
+
 type UpdateServerCertificateOutput struct {
 	metadataUpdateServerCertificateOutput `json:"-" xml:"-"`
 }
@@ -7889,17 +11178,29 @@ type metadataUpdateServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateServerCertificateOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateSigningCertificateInput struct {
 	// The ID of the signing certificate you want to update.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The status you want to assign to the certificate. Active means the certificate
 	// can be used for API calls to AWS, while Inactive means the certificate cannot
 	// be used.
-	Status *string `type:"string" required:"true"`
+	//
+	// See the StatusType* constants for valid values.
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUpdateSigningCertificateInput `json:"-" xml:"-"`
 }
@@ -7908,6 +11209,16 @@ type metadataUpdateSigningCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateSigningCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateSigningCertificateInput) GoString() string {
+	return s.String()
+}
+
 type UpdateSigningCertificateOutput struct {
 	metadataUpdateSigningCertificateOutput `json:"-" xml:"-"`
 }
@@ -7916,18 +11227,28 @@ type metadataUpdateSigningCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateSigningCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateSigningCertificateOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateUserInput struct {
 	// New path for the user. Include this parameter only if you're changing the
 	// user's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// New name for the user. Include this parameter only if you're changing the
 	// user's name.
-	NewUserName *string `type:"string"`
+	NewUserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// Name of the user to update. If you're changing the name of the user, this
 	// is the original user name.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateUserInput `json:"-" xml:"-"`
 }
@@ -7936,6 +11257,16 @@ type metadataUpdateUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateUserInput) GoString() string {
+	return s.String()
+}
+
 type UpdateUserOutput struct {
 	metadataUpdateUserOutput `json:"-" xml:"-"`
 }
@@ -7944,13 +11275,23 @@ type metadataUpdateUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateUserOutput) GoString() string {
+	return s.String()
+}
+
 type UploadServerCertificateInput struct {
 	// The contents of the public key certificate in PEM-encoded format.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The contents of the certificate chain. This is typically a concatenation
 	// of the PEM-encoded public key certificates of the chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]*"`
 
 	// The path for the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -7963,14 +11304,14 @@ type UploadServerCertificateInput struct {
 	// CloudFront distributions, you must specify a path using the --path option.
 	// The path must begin with /cloudfront and must include a trailing slash (for
 	// example, /cloudfront/test/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The contents of the private key in PEM-encoded format.
-	PrivateKey *string `type:"string" required:"true"`
+	PrivateKey *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]*" sensitive:"true" required:"true"`
 
 	// The name for the server certificate. Do not include the path in this value.
 	// The name of the certificate cannot contain any spaces.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUploadServerCertificateInput `json:"-" xml:"-"`
 }
@@ -7979,6 +11320,16 @@ type metadataUploadServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UploadServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadServerCertificateInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful UploadServerCertificate request.
 type UploadServerCertificateOutput struct {
 	// The meta information of the uploaded server certificate without its certificate
@@ -7992,12 +11343,22 @@ type metadataUploadServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UploadServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadServerCertificateOutput) GoString() string {
+	return s.String()
+}
+
 type UploadSigningCertificateInput struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// The name of the user the signing certificate is for.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUploadSigningCertificateInput `json:"-" xml:"-"`
 }
@@ -8006,6 +11367,16 @@ type metadataUploadSigningCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UploadSigningCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadSigningCertificateInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful UploadSigningCertificate request.
 type UploadSigningCertificateOutput struct {
 	// Information about the certificate.
@@ -8018,6 +11389,16 @@ type metadataUploadSigningCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UploadSigningCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UploadSigningCertificateOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about an IAM user entity.
 //
 //  This data type is used as a response element in the following actions:
@@ -8031,7 +11412,7 @@ type User struct {
 	// The Amazon Resource Name (ARN) that identifies the user. For more information
 	// about ARNs and how to use ARNs in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the user was created.
@@ -8058,15 +11439,15 @@ type User struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})" required:"true"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string" required:"true"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUser `json:"-" xml:"-"`
 }
@@ -8075,6 +11456,16 @@ type metadataUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s User) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s User) GoString() string {
+	return s.String()
+}
+
 // Contains information about an IAM user, including all the user's policies
 // and all the IAM groups the user is in.
 //
@@ -8086,7 +11477,7 @@ type UserDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// A list of the managed policies attached to the user.
 	AttachedManagedPolicies []*AttachedPolicy `type:"list"`
@@ -8101,15 +11492,15 @@ type UserDetail struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\x{002F})|(\\x{002F}[\\x{0021}-\\x{007F}]+\\x{002F})"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the inline policies embedded in the user.
 	UserPolicyList []*PolicyDetail `type:"list"`
@@ -8121,11 +11512,21 @@ type metadataUserDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UserDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UserDetail) GoString() string {
+	return s.String()
+}
+
 // Contains information about a virtual MFA device.
 type VirtualMFADevice struct {
 	// The Base32 seed defined as specified in RFC3548 (http://www.ietf.org/rfc/rfc3548.txt).
 	// The Base32StringSeed is Base64-encoded.
-	Base32StringSeed []byte `type:"blob"`
+	Base32StringSeed []byte `type:"blob" sensitive:"true"`
 
 	// The date and time on which the virtual MFA device was enabled.
 	EnableDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -8134,10 +11535,10 @@ type VirtualMFADevice struct {
 	// where $virtualMFADeviceName is one of the create call arguments, AccountName
 	// is the user name if set (otherwise, the account ID otherwise), and Base32String
 	// is the seed in Base32 format. The Base32String value is Base64-encoded.
-	QRCodePNG []byte `type:"blob"`
+	QRCodePNG []byte `type:"blob" sensitive:"true"`
 
 	// The serial number associated with VirtualMFADevice.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// Contains information about an IAM user entity.
 	//
@@ -8156,3 +11557,118 @@ type VirtualMFADevice struct {
 type metadataVirtualMFADevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VirtualMFADevice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VirtualMFADevice) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum EntityType
+	EntityTypeUser = "User"
+	// @enum EntityType
+	EntityTypeRole = "Role"
+	// @enum EntityType
+	EntityTypeGroup = "Group"
+	// @enum EntityType
+	EntityTypeLocalManagedPolicy = "LocalManagedPolicy"
+	// @enum EntityType
+	EntityTypeAWSManagedPolicy = "AWSManagedPolicy"
+)
+
+const (
+	// @enum ReportFormatType
+	ReportFormatTypeTextCsv = "text/csv"
+)
+
+const (
+	// @enum ReportStateType
+	ReportStateTypeStarted = "STARTED"
+	// @enum ReportStateType
+	ReportStateTypeInprogress = "INPROGRESS"
+	// @enum ReportStateType
+	ReportStateTypeComplete = "COMPLETE"
+)
+
+const (
+	// @enum assignmentStatusType
+	AssignmentStatusTypeAssigned = "Assigned"
+	// @enum assignmentStatusType
+	AssignmentStatusTypeUnassigned = "Unassigned"
+	// @enum assignmentStatusType
+	AssignmentStatusTypeAny = "Any"
+)
+
+const (
+	// @enum policyScopeType
+	PolicyScopeTypeAll = "All"
+	// @enum policyScopeType
+	PolicyScopeTypeAws = "AWS"
+	// @enum policyScopeType
+	PolicyScopeTypeLocal = "Local"
+)
+
+const (
+	// @enum statusType
+	StatusTypeActive = "Active"
+	// @enum statusType
+	StatusTypeInactive = "Inactive"
+)
+
+const (
+	// @enum summaryKeyType
+	SummaryKeyTypeUsers = "Users"
+	// @enum summaryKeyType
+	SummaryKeyTypeUsersQuota = "UsersQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeGroups = "Groups"
+	// @enum summaryKeyType
+	SummaryKeyTypeGroupsQuota = "GroupsQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeServerCertificates = "ServerCertificates"
+	// @enum summaryKeyType
+	SummaryKeyTypeServerCertificatesQuota = "ServerCertificatesQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeUserPolicySizeQuota = "UserPolicySizeQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeGroupPolicySizeQuota = "GroupPolicySizeQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeGroupsPerUserQuota = "GroupsPerUserQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeSigningCertificatesPerUserQuota = "SigningCertificatesPerUserQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeAccessKeysPerUserQuota = "AccessKeysPerUserQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeMFADevices = "MFADevices"
+	// @enum summaryKeyType
+	SummaryKeyTypeMFADevicesInUse = "MFADevicesInUse"
+	// @enum summaryKeyType
+	SummaryKeyTypeAccountMFAEnabled = "AccountMFAEnabled"
+	// @enum summaryKeyType
+	SummaryKeyTypeAccountAccessKeysPresent = "AccountAccessKeysPresent"
+	// @enum summaryKeyType
+	SummaryKeyTypeAccountSigningCertificatesPresent = "AccountSigningCertificatesPresent"
+	// @enum summaryKeyType
+	SummaryKeyTypeAttachedPoliciesPerGroupQuota = "AttachedPoliciesPerGroupQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeAttachedPoliciesPerRoleQuota = "AttachedPoliciesPerRoleQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeAttachedPoliciesPerUserQuota = "AttachedPoliciesPerUserQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypePolicies = "Policies"
+	// @enum summaryKeyType
+	SummaryKeyTypePoliciesQuota = "PoliciesQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypePolicySizeQuota = "PolicySizeQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypePolicyVersionsInUse = "PolicyVersionsInUse"
+	// @enum summaryKeyType
+	SummaryKeyTypePolicyVersionsInUseQuota = "PolicyVersionsInUseQuota"
+	// @enum summaryKeyType
+	SummaryKeyTypeVersionsPerPolicyQuota = "VersionsPerPolicyQuota"
+)
@@ -4,6 +4,7 @@
 package iamiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 )
 
@@ -11,209 +12,419 @@ import (
 type IAMAPI interface {
 	AddClientIDToOpenIDConnectProvider(*iam.AddClientIDToOpenIDConnectProviderInput) (*iam.AddClientIDToOpenIDConnectProviderOutput, error)
 
+	AddClientIDToOpenIDConnectProviderWithContext(aws.Context, *iam.AddClientIDToOpenIDConnectProviderInput) (*iam.AddClientIDToOpenIDConnectProviderOutput, error)
+
 	AddRoleToInstanceProfile(*iam.AddRoleToInstanceProfileInput) (*iam.AddRoleToInstanceProfileOutput, error)
 
+	AddRoleToInstanceProfileWithContext(aws.Context, *iam.AddRoleToInstanceProfileInput) (*iam.AddRoleToInstanceProfileOutput, error)
+
 	AddUserToGroup(*iam.AddUserToGroupInput) (*iam.AddUserToGroupOutput, error)
 
+	AddUserToGroupWithContext(aws.Context, *iam.AddUserToGroupInput) (*iam.AddUserToGroupOutput, error)
+
 	AttachGroupPolicy(*iam.AttachGroupPolicyInput) (*iam.AttachGroupPolicyOutput, error)
 
+	AttachGroupPolicyWithContext(aws.Context, *iam.AttachGroupPolicyInput) (*iam.AttachGroupPolicyOutput, error)
+
 	AttachRolePolicy(*iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error)
 
+	AttachRolePolicyWithContext(aws.Context, *iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error)
+
 	AttachUserPolicy(*iam.AttachUserPolicyInput) (*iam.AttachUserPolicyOutput, error)
 
+	AttachUserPolicyWithContext(aws.Context, *iam.AttachUserPolicyInput) (*iam.AttachUserPolicyOutput, error)
+
 	ChangePassword(*iam.ChangePasswordInput) (*iam.ChangePasswordOutput, error)
 
+	ChangePasswordWithContext(aws.Context, *iam.ChangePasswordInput) (*iam.ChangePasswordOutput, error)
+
 	CreateAccessKey(*iam.CreateAccessKeyInput) (*iam.CreateAccessKeyOutput, error)
 
+	CreateAccessKeyWithContext(aws.Context, *iam.CreateAccessKeyInput) (*iam.CreateAccessKeyOutput, error)
+
 	CreateAccountAlias(*iam.CreateAccountAliasInput) (*iam.CreateAccountAliasOutput, error)
 
+	CreateAccountAliasWithContext(aws.Context, *iam.CreateAccountAliasInput) (*iam.CreateAccountAliasOutput, error)
+
 	CreateGroup(*iam.CreateGroupInput) (*iam.CreateGroupOutput, error)
 
+	CreateGroupWithContext(aws.Context, *iam.CreateGroupInput) (*iam.CreateGroupOutput, error)
+
 	CreateInstanceProfile(*iam.CreateInstanceProfileInput) (*iam.CreateInstanceProfileOutput, error)
 
+	CreateInstanceProfileWithContext(aws.Context, *iam.CreateInstanceProfileInput) (*iam.CreateInstanceProfileOutput, error)
+
 	CreateLoginProfile(*iam.CreateLoginProfileInput) (*iam.CreateLoginProfileOutput, error)
 
+	CreateLoginProfileWithContext(aws.Context, *iam.CreateLoginProfileInput) (*iam.CreateLoginProfileOutput, error)
+
 	CreateOpenIDConnectProvider(*iam.CreateOpenIDConnectProviderInput) (*iam.CreateOpenIDConnectProviderOutput, error)
 
+	CreateOpenIDConnectProviderWithContext(aws.Context, *iam.CreateOpenIDConnectProviderInput) (*iam.CreateOpenIDConnectProviderOutput, error)
+
 	CreatePolicy(*iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error)
 
+	CreatePolicyWithContext(aws.Context, *iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error)
+
 	CreatePolicyVersion(*iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error)
 
+	CreatePolicyVersionWithContext(aws.Context, *iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error)
+
 	CreateRole(*iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
 
+	CreateRoleWithContext(aws.Context, *iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
+
 	CreateSAMLProvider(*iam.CreateSAMLProviderInput) (*iam.CreateSAMLProviderOutput, error)
 
+	CreateSAMLProviderWithContext(aws.Context, *iam.CreateSAMLProviderInput) (*iam.CreateSAMLProviderOutput, error)
+
 	CreateUser(*iam.CreateUserInput) (*iam.CreateUserOutput, error)
 
+	CreateUserWithContext(aws.Context, *iam.CreateUserInput) (*iam.CreateUserOutput, error)
+
 	CreateVirtualMFADevice(*iam.CreateVirtualMFADeviceInput) (*iam.CreateVirtualMFADeviceOutput, error)
 
+	CreateVirtualMFADeviceWithContext(aws.Context, *iam.CreateVirtualMFADeviceInput) (*iam.CreateVirtualMFADeviceOutput, error)
+
 	DeactivateMFADevice(*iam.DeactivateMFADeviceInput) (*iam.DeactivateMFADeviceOutput, error)
 
+	DeactivateMFADeviceWithContext(aws.Context, *iam.DeactivateMFADeviceInput) (*iam.DeactivateMFADeviceOutput, error)
+
 	DeleteAccessKey(*iam.DeleteAccessKeyInput) (*iam.DeleteAccessKeyOutput, error)
 
+	DeleteAccessKeyWithContext(aws.Context, *iam.DeleteAccessKeyInput) (*iam.DeleteAccessKeyOutput, error)
+
 	DeleteAccountAlias(*iam.DeleteAccountAliasInput) (*iam.DeleteAccountAliasOutput, error)
 
+	DeleteAccountAliasWithContext(aws.Context, *iam.DeleteAccountAliasInput) (*iam.DeleteAccountAliasOutput, error)
+
 	DeleteAccountPasswordPolicy(*iam.DeleteAccountPasswordPolicyInput) (*iam.DeleteAccountPasswordPolicyOutput, error)
 
+	DeleteAccountPasswordPolicyWithContext(aws.Context, *iam.DeleteAccountPasswordPolicyInput) (*iam.DeleteAccountPasswordPolicyOutput, error)
+
 	DeleteGroup(*iam.DeleteGroupInput) (*iam.DeleteGroupOutput, error)
 
+	DeleteGroupWithContext(aws.Context, *iam.DeleteGroupInput) (*iam.DeleteGroupOutput, error)
+
 	DeleteGroupPolicy(*iam.DeleteGroupPolicyInput) (*iam.DeleteGroupPolicyOutput, error)
 
+	DeleteGroupPolicyWithContext(aws.Context, *iam.DeleteGroupPolicyInput) (*iam.DeleteGroupPolicyOutput, error)
+
 	DeleteInstanceProfile(*iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error)
 
+	DeleteInstanceProfileWithContext(aws.Context, *iam.DeleteInstanceProfileInput) (*iam.DeleteInstanceProfileOutput, error)
+
 	DeleteLoginProfile(*iam.DeleteLoginProfileInput) (*iam.DeleteLoginProfileOutput, error)
 
+	DeleteLoginProfileWithContext(aws.Context, *iam.DeleteLoginProfileInput) (*iam.DeleteLoginProfileOutput, error)
+
 	DeleteOpenIDConnectProvider(*iam.DeleteOpenIDConnectProviderInput) (*iam.DeleteOpenIDConnectProviderOutput, error)
 
+	DeleteOpenIDConnectProviderWithContext(aws.Context, *iam.DeleteOpenIDConnectProviderInput) (*iam.DeleteOpenIDConnectProviderOutput, error)
+
 	DeletePolicy(*iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error)
 
+	DeletePolicyWithContext(aws.Context, *iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error)
+
 	DeletePolicyVersion(*iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error)
 
+	DeletePolicyVersionWithContext(aws.Context, *iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error)
+
 	DeleteRole(*iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 
+	DeleteRoleWithContext(aws.Context, *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
+
 	DeleteRolePolicy(*iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error)
 
+	DeleteRolePolicyWithContext(aws.Context, *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error)
+
 	DeleteSAMLProvider(*iam.DeleteSAMLProviderInput) (*iam.DeleteSAMLProviderOutput, error)
 
+	DeleteSAMLProviderWithContext(aws.Context, *iam.DeleteSAMLProviderInput) (*iam.DeleteSAMLProviderOutput, error)
+
 	DeleteServerCertificate(*iam.DeleteServerCertificateInput) (*iam.DeleteServerCertificateOutput, error)
 
+	DeleteServerCertificateWithContext(aws.Context, *iam.DeleteServerCertificateInput) (*iam.DeleteServerCertificateOutput, error)
+
 	DeleteSigningCertificate(*iam.DeleteSigningCertificateInput) (*iam.DeleteSigningCertificateOutput, error)
 
+	DeleteSigningCertificateWithContext(aws.Context, *iam.DeleteSigningCertificateInput) (*iam.DeleteSigningCertificateOutput, error)
+
 	DeleteUser(*iam.DeleteUserInput) (*iam.DeleteUserOutput, error)
 
+	DeleteUserWithContext(aws.Context, *iam.DeleteUserInput) (*iam.DeleteUserOutput, error)
+
 	DeleteUserPolicy(*iam.DeleteUserPolicyInput) (*iam.DeleteUserPolicyOutput, error)
 
+	DeleteUserPolicyWithContext(aws.Context, *iam.DeleteUserPolicyInput) (*iam.DeleteUserPolicyOutput, error)
+
 	DeleteVirtualMFADevice(*iam.DeleteVirtualMFADeviceInput) (*iam.DeleteVirtualMFADeviceOutput, error)
 
+	DeleteVirtualMFADeviceWithContext(aws.Context, *iam.DeleteVirtualMFADeviceInput) (*iam.DeleteVirtualMFADeviceOutput, error)
+
 	DetachGroupPolicy(*iam.DetachGroupPolicyInput) (*iam.DetachGroupPolicyOutput, error)
 
+	DetachGroupPolicyWithContext(aws.Context, *iam.DetachGroupPolicyInput) (*iam.DetachGroupPolicyOutput, error)
+
 	DetachRolePolicy(*iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error)
 
+	DetachRolePolicyWithContext(aws.Context, *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error)
+
 	DetachUserPolicy(*iam.DetachUserPolicyInput) (*iam.DetachUserPolicyOutput, error)
 
+	DetachUserPolicyWithContext(aws.Context, *iam.DetachUserPolicyInput) (*iam.DetachUserPolicyOutput, error)
+
 	EnableMFADevice(*iam.EnableMFADeviceInput) (*iam.EnableMFADeviceOutput, error)
 
+	EnableMFADeviceWithContext(aws.Context, *iam.EnableMFADeviceInput) (*iam.EnableMFADeviceOutput, error)
+
 	GenerateCredentialReport(*iam.GenerateCredentialReportInput) (*iam.GenerateCredentialReportOutput, error)
 
+	GenerateCredentialReportWithContext(aws.Context, *iam.GenerateCredentialReportInput) (*iam.GenerateCredentialReportOutput, error)
+
 	GetAccessKeyLastUsed(*iam.GetAccessKeyLastUsedInput) (*iam.GetAccessKeyLastUsedOutput, error)
 
+	GetAccessKeyLastUsedWithContext(aws.Context, *iam.GetAccessKeyLastUsedInput) (*iam.GetAccessKeyLastUsedOutput, error)
+
 	GetAccountAuthorizationDetails(*iam.GetAccountAuthorizationDetailsInput) (*iam.GetAccountAuthorizationDetailsOutput, error)
 
+	GetAccountAuthorizationDetailsWithContext(aws.Context, *iam.GetAccountAuthorizationDetailsInput) (*iam.GetAccountAuthorizationDetailsOutput, error)
+
 	GetAccountPasswordPolicy(*iam.GetAccountPasswordPolicyInput) (*iam.GetAccountPasswordPolicyOutput, error)
 
+	GetAccountPasswordPolicyWithContext(aws.Context, *iam.GetAccountPasswordPolicyInput) (*iam.GetAccountPasswordPolicyOutput, error)
+
 	GetAccountSummary(*iam.GetAccountSummaryInput) (*iam.GetAccountSummaryOutput, error)
 
+	GetAccountSummaryWithContext(aws.Context, *iam.GetAccountSummaryInput) (*iam.GetAccountSummaryOutput, error)
+
 	GetCredentialReport(*iam.GetCredentialReportInput) (*iam.GetCredentialReportOutput, error)
 
+	GetCredentialReportWithContext(aws.Context, *iam.GetCredentialReportInput) (*iam.GetCredentialReportOutput, error)
+
 	GetGroup(*iam.GetGroupInput) (*iam.GetGroupOutput, error)
 
+	GetGroupWithContext(aws.Context, *iam.GetGroupInput) (*iam.GetGroupOutput, error)
+
 	GetGroupPolicy(*iam.GetGroupPolicyInput) (*iam.GetGroupPolicyOutput, error)
 
+	GetGroupPolicyWithContext(aws.Context, *iam.GetGroupPolicyInput) (*iam.GetGroupPolicyOutput, error)
+
 	GetInstanceProfile(*iam.GetInstanceProfileInput) (*iam.GetInstanceProfileOutput, error)
 
+	GetInstanceProfileWithContext(aws.Context, *iam.GetInstanceProfileInput) (*iam.GetInstanceProfileOutput, error)
+
 	GetLoginProfile(*iam.GetLoginProfileInput) (*iam.GetLoginProfileOutput, error)
 
+	GetLoginProfileWithContext(aws.Context, *iam.GetLoginProfileInput) (*iam.GetLoginProfileOutput, error)
+
 	GetOpenIDConnectProvider(*iam.GetOpenIDConnectProviderInput) (*iam.GetOpenIDConnectProviderOutput, error)
 
+	GetOpenIDConnectProviderWithContext(aws.Context, *iam.GetOpenIDConnectProviderInput) (*iam.GetOpenIDConnectProviderOutput, error)
+
 	GetPolicy(*iam.GetPolicyInput) (*iam.GetPolicyOutput, error)
 
+	GetPolicyWithContext(aws.Context, *iam.GetPolicyInput) (*iam.GetPolicyOutput, error)
+
 	GetPolicyVersion(*iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error)
 
+	GetPolicyVersionWithContext(aws.Context, *iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error)
+
 	GetRole(*iam.GetRoleInput) (*iam.GetRoleOutput, error)
 
+	GetRoleWithContext(aws.Context, *iam.GetRoleInput) (*iam.GetRoleOutput, error)
+
 	GetRolePolicy(*iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error)
 
+	GetRolePolicyWithContext(aws.Context, *iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error)
+
 	GetSAMLProvider(*iam.GetSAMLProviderInput) (*iam.GetSAMLProviderOutput, error)
 
+	GetSAMLProviderWithContext(aws.Context, *iam.GetSAMLProviderInput) (*iam.GetSAMLProviderOutput, error)
+
 	GetServerCertificate(*iam.GetServerCertificateInput) (*iam.GetServerCertificateOutput, error)
 
+	GetServerCertificateWithContext(aws.Context, *iam.GetServerCertificateInput) (*iam.GetServerCertificateOutput, error)
+
 	GetUser(*iam.GetUserInput) (*iam.GetUserOutput, error)
 
+	GetUserWithContext(aws.Context, *iam.GetUserInput) (*iam.GetUserOutput, error)
+
 	GetUserPolicy(*iam.GetUserPolicyInput) (*iam.GetUserPolicyOutput, error)
 
+	GetUserPolicyWithContext(aws.Context, *iam.GetUserPolicyInput) (*iam.GetUserPolicyOutput, error)
+
 	ListAccessKeys(*iam.ListAccessKeysInput) (*iam.ListAccessKeysOutput, error)
 
+	ListAccessKeysWithContext(aws.Context, *iam.ListAccessKeysInput) (*iam.ListAccessKeysOutput, error)
+
 	ListAccountAliases(*iam.ListAccountAliasesInput) (*iam.ListAccountAliasesOutput, error)
 
+	ListAccountAliasesWithContext(aws.Context, *iam.ListAccountAliasesInput) (*iam.ListAccountAliasesOutput, error)
+
 	ListAttachedGroupPolicies(*iam.ListAttachedGroupPoliciesInput) (*iam.ListAttachedGroupPoliciesOutput, error)
 
+	ListAttachedGroupPoliciesWithContext(aws.Context, *iam.ListAttachedGroupPoliciesInput) (*iam.ListAttachedGroupPoliciesOutput, error)
+
 	ListAttachedRolePolicies(*iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error)
 
+	ListAttachedRolePoliciesWithContext(aws.Context, *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error)
+
 	ListAttachedUserPolicies(*iam.ListAttachedUserPoliciesInput) (*iam.ListAttachedUserPoliciesOutput, error)
 
+	ListAttachedUserPoliciesWithContext(aws.Context, *iam.ListAttachedUserPoliciesInput) (*iam.ListAttachedUserPoliciesOutput, error)
+
 	ListEntitiesForPolicy(*iam.ListEntitiesForPolicyInput) (*iam.ListEntitiesForPolicyOutput, error)
 
+	ListEntitiesForPolicyWithContext(aws.Context, *iam.ListEntitiesForPolicyInput) (*iam.ListEntitiesForPolicyOutput, error)
+
 	ListGroupPolicies(*iam.ListGroupPoliciesInput) (*iam.ListGroupPoliciesOutput, error)
 
+	ListGroupPoliciesWithContext(aws.Context, *iam.ListGroupPoliciesInput) (*iam.ListGroupPoliciesOutput, error)
+
 	ListGroups(*iam.ListGroupsInput) (*iam.ListGroupsOutput, error)
 
+	ListGroupsWithContext(aws.Context, *iam.ListGroupsInput) (*iam.ListGroupsOutput, error)
+
 	ListGroupsForUser(*iam.ListGroupsForUserInput) (*iam.ListGroupsForUserOutput, error)
 
+	ListGroupsForUserWithContext(aws.Context, *iam.ListGroupsForUserInput) (*iam.ListGroupsForUserOutput, error)
+
 	ListInstanceProfiles(*iam.ListInstanceProfilesInput) (*iam.ListInstanceProfilesOutput, error)
 
+	ListInstanceProfilesWithContext(aws.Context, *iam.ListInstanceProfilesInput) (*iam.ListInstanceProfilesOutput, error)
+
 	ListInstanceProfilesForRole(*iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error)
 
+	ListInstanceProfilesForRoleWithContext(aws.Context, *iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error)
+
 	ListMFADevices(*iam.ListMFADevicesInput) (*iam.ListMFADevicesOutput, error)
 
+	ListMFADevicesWithContext(aws.Context, *iam.ListMFADevicesInput) (*iam.ListMFADevicesOutput, error)
+
 	ListOpenIDConnectProviders(*iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
 
+	ListOpenIDConnectProvidersWithContext(aws.Context, *iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
+
 	ListPolicies(*iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
 
+	ListPoliciesWithContext(aws.Context, *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
+
 	ListPolicyVersions(*iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error)
 
+	ListPolicyVersionsWithContext(aws.Context, *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error)
+
 	ListRolePolicies(*iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error)
 
+	ListRolePoliciesWithContext(aws.Context, *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error)
+
 	ListRoles(*iam.ListRolesInput) (*iam.ListRolesOutput, error)
 
+	ListRolesWithContext(aws.Context, *iam.ListRolesInput) (*iam.ListRolesOutput, error)
+
 	ListSAMLProviders(*iam.ListSAMLProvidersInput) (*iam.ListSAMLProvidersOutput, error)
 
+	ListSAMLProvidersWithContext(aws.Context, *iam.ListSAMLProvidersInput) (*iam.ListSAMLProvidersOutput, error)
+
 	ListServerCertificates(*iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error)
 
+	ListServerCertificatesWithContext(aws.Context, *iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error)
+
 	ListSigningCertificates(*iam.ListSigningCertificatesInput) (*iam.ListSigningCertificatesOutput, error)
 
+	ListSigningCertificatesWithContext(aws.Context, *iam.ListSigningCertificatesInput) (*iam.ListSigningCertificatesOutput, error)
+
 	ListUserPolicies(*iam.ListUserPoliciesInput) (*iam.ListUserPoliciesOutput, error)
 
+	ListUserPoliciesWithContext(aws.Context, *iam.ListUserPoliciesInput) (*iam.ListUserPoliciesOutput, error)
+
 	ListUsers(*iam.ListUsersInput) (*iam.ListUsersOutput, error)
 
+	ListUsersWithContext(aws.Context, *iam.ListUsersInput) (*iam.ListUsersOutput, error)
+
 	ListVirtualMFADevices(*iam.ListVirtualMFADevicesInput) (*iam.ListVirtualMFADevicesOutput, error)
 
+	ListVirtualMFADevicesWithContext(aws.Context, *iam.ListVirtualMFADevicesInput) (*iam.ListVirtualMFADevicesOutput, error)
+
 	PutGroupPolicy(*iam.PutGroupPolicyInput) (*iam.PutGroupPolicyOutput, error)
 
+	PutGroupPolicyWithContext(aws.Context, *iam.PutGroupPolicyInput) (*iam.PutGroupPolicyOutput, error)
+
 	PutRolePolicy(*iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
 
+	PutRolePolicyWithContext(aws.Context, *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
+
 	PutUserPolicy(*iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error)
 
+	PutUserPolicyWithContext(aws.Context, *iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error)
+
 	RemoveClientIDFromOpenIDConnectProvider(*iam.RemoveClientIDFromOpenIDConnectProviderInput) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
 
+	RemoveClientIDFromOpenIDConnectProviderWithContext(aws.Context, *iam.RemoveClientIDFromOpenIDConnectProviderInput) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
+
 	RemoveRoleFromInstanceProfile(*iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error)
 
+	RemoveRoleFromInstanceProfileWithContext(aws.Context, *iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+
 	RemoveUserFromGroup(*iam.RemoveUserFromGroupInput) (*iam.RemoveUserFromGroupOutput, error)
 
+	RemoveUserFromGroupWithContext(aws.Context, *iam.RemoveUserFromGroupInput) (*iam.RemoveUserFromGroupOutput, error)
+
 	ResyncMFADevice(*iam.ResyncMFADeviceInput) (*iam.ResyncMFADeviceOutput, error)
 
+	ResyncMFADeviceWithContext(aws.Context, *iam.ResyncMFADeviceInput) (*iam.ResyncMFADeviceOutput, error)
+
 	SetDefaultPolicyVersion(*iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error)
 
+	SetDefaultPolicyVersionWithContext(aws.Context, *iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error)
+
 	UpdateAccessKey(*iam.UpdateAccessKeyInput) (*iam.UpdateAccessKeyOutput, error)
 
+	UpdateAccessKeyWithContext(aws.Context, *iam.UpdateAccessKeyInput) (*iam.UpdateAccessKeyOutput, error)
+
 	UpdateAccountPasswordPolicy(*iam.UpdateAccountPasswordPolicyInput) (*iam.UpdateAccountPasswordPolicyOutput, error)
 
+	UpdateAccountPasswordPolicyWithContext(aws.Context, *iam.UpdateAccountPasswordPolicyInput) (*iam.UpdateAccountPasswordPolicyOutput, error)
+
 	UpdateAssumeRolePolicy(*iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error)
 
+	UpdateAssumeRolePolicyWithContext(aws.Context, *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error)
+
 	UpdateGroup(*iam.UpdateGroupInput) (*iam.UpdateGroupOutput, error)
 
+	UpdateGroupWithContext(aws.Context, *iam.UpdateGroupInput) (*iam.UpdateGroupOutput, error)
+
 	UpdateLoginProfile(*iam.UpdateLoginProfileInput) (*iam.UpdateLoginProfileOutput, error)
 
+	UpdateLoginProfileWithContext(aws.Context, *iam.UpdateLoginProfileInput) (*iam.UpdateLoginProfileOutput, error)
+
 	UpdateOpenIDConnectProviderThumbprint(*iam.UpdateOpenIDConnectProviderThumbprintInput) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
 
+	UpdateOpenIDConnectProviderThumbprintWithContext(aws.Context, *iam.UpdateOpenIDConnectProviderThumbprintInput) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
+
 	UpdateSAMLProvider(*iam.UpdateSAMLProviderInput) (*iam.UpdateSAMLProviderOutput, error)
 
+	UpdateSAMLProviderWithContext(aws.Context, *iam.UpdateSAMLProviderInput) (*iam.UpdateSAMLProviderOutput, error)
+
 	UpdateServerCertificate(*iam.UpdateServerCertificateInput) (*iam.UpdateServerCertificateOutput, error)
 
+	UpdateServerCertificateWithContext(aws.Context, *iam.UpdateServerCertificateInput) (*iam.UpdateServerCertificateOutput, error)
+
 	UpdateSigningCertificate(*iam.UpdateSigningCertificateInput) (*iam.UpdateSigningCertificateOutput, error)
 
+	UpdateSigningCertificateWithContext(aws.Context, *iam.UpdateSigningCertificateInput) (*iam.UpdateSigningCertificateOutput, error)
+
 	UpdateUser(*iam.UpdateUserInput) (*iam.UpdateUserOutput, error)
 
+	UpdateUserWithContext(aws.Context, *iam.UpdateUserInput) (*iam.UpdateUserOutput, error)
+
 	UploadServerCertificate(*iam.UploadServerCertificateInput) (*iam.UploadServerCertificateOutput, error)
 
+	UploadServerCertificateWithContext(aws.Context, *iam.UploadServerCertificateInput) (*iam.UploadServerCertificateOutput, error)
+
 	UploadSigningCertificate(*iam.UploadSigningCertificateInput) (*iam.UploadSigningCertificateOutput, error)
+
+	UploadSigningCertificateWithContext(aws.Context, *iam.UploadSigningCertificateInput) (*iam.UploadSigningCertificateOutput, error)
+
+	WaitUntilUserExists(*iam.GetUserInput) error
 }
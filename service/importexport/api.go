@@ -0,0 +1,747 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+// Package importexport provides a client for AWS Import/Export.
+package importexport
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+)
+
+const opCancelJob = "CancelJob"
+
+// CancelJobRequest generates a request for the CancelJob operation.
+func (c *ImportExport) CancelJobRequest(input *CancelJobInput) (req *aws.Request, output *CancelJobOutput) {
+	op := &aws.Operation{
+		Name:       opCancelJob,
+		HTTPMethod: "POST",
+		HTTPPath:   "/?Operation=CancelJob",
+	}
+
+	if input == nil {
+		input = &CancelJobInput{}
+	}
+
+	req = c.newRequest(op, input, output)
+	output = &CancelJobOutput{}
+	req.Data = output
+	return
+}
+
+// This operation cancels a specified job. Only the job owner can cancel it.
+// The operation fails if the job has already started or is complete.
+func (c *ImportExport) CancelJob(input *CancelJobInput) (*CancelJobOutput, error) {
+	req, out := c.CancelJobRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+// CancelJobWithContext is the same as CancelJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ImportExport) CancelJobWithContext(ctx aws.Context, input *CancelJobInput) (*CancelJobOutput, error) {
+	req, out := c.CancelJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opCreateJob = "CreateJob"
+
+// CreateJobRequest generates a request for the CreateJob operation.
+func (c *ImportExport) CreateJobRequest(input *CreateJobInput) (req *aws.Request, output *CreateJobOutput) {
+	op := &aws.Operation{
+		Name:       opCreateJob,
+		HTTPMethod: "POST",
+		HTTPPath:   "/?Operation=CreateJob",
+	}
+
+	if input == nil {
+		input = &CreateJobInput{}
+	}
+
+	req = c.newRequest(op, input, output)
+	output = &CreateJobOutput{}
+	req.Data = output
+	return
+}
+
+// This operation initiates the process of scheduling an upload or download
+// of your data. You include in the request a manifest that describes the data
+// transfer specifics. The response to the request includes a job ID, which
+// you can use in other operations, a signature that you use to identify your
+// storage device, and the address where you should ship your storage device.
+func (c *ImportExport) CreateJob(input *CreateJobInput) (*CreateJobOutput, error) {
+	req, out := c.CreateJobRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+// CreateJobWithContext is the same as CreateJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ImportExport) CreateJobWithContext(ctx aws.Context, input *CreateJobInput) (*CreateJobOutput, error) {
+	req, out := c.CreateJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opGetShippingLabel = "GetShippingLabel"
+
+// GetShippingLabelRequest generates a request for the GetShippingLabel operation.
+func (c *ImportExport) GetShippingLabelRequest(input *GetShippingLabelInput) (req *aws.Request, output *GetShippingLabelOutput) {
+	op := &aws.Operation{
+		Name:       opGetShippingLabel,
+		HTTPMethod: "POST",
+		HTTPPath:   "/?Operation=GetShippingLabel",
+	}
+
+	if input == nil {
+		input = &GetShippingLabelInput{}
+	}
+
+	req = c.newRequest(op, input, output)
+	output = &GetShippingLabelOutput{}
+	req.Data = output
+	return
+}
+
+// This operation generates a pre-paid UPS shipping label that you will use
+// to ship your device to AWS for processing.
+func (c *ImportExport) GetShippingLabel(input *GetShippingLabelInput) (*GetShippingLabelOutput, error) {
+	req, out := c.GetShippingLabelRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+// GetShippingLabelWithContext is the same as GetShippingLabel with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ImportExport) GetShippingLabelWithContext(ctx aws.Context, input *GetShippingLabelInput) (*GetShippingLabelOutput, error) {
+	req, out := c.GetShippingLabelRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opGetStatus = "GetStatus"
+
+// GetStatusRequest generates a request for the GetStatus operation.
+func (c *ImportExport) GetStatusRequest(input *GetStatusInput) (req *aws.Request, output *GetStatusOutput) {
+	op := &aws.Operation{
+		Name:       opGetStatus,
+		HTTPMethod: "POST",
+		HTTPPath:   "/?Operation=GetStatus",
+	}
+
+	if input == nil {
+		input = &GetStatusInput{}
+	}
+
+	req = c.newRequest(op, input, output)
+	output = &GetStatusOutput{}
+	req.Data = output
+	return
+}
+
+// This operation returns information about a job, including where the job is
+// in the processing pipeline, the status of the results, and the signature
+// value associated with the job. You can only return information about jobs
+// you own.
+func (c *ImportExport) GetStatus(input *GetStatusInput) (*GetStatusOutput, error) {
+	req, out := c.GetStatusRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+// GetStatusWithContext is the same as GetStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ImportExport) GetStatusWithContext(ctx aws.Context, input *GetStatusInput) (*GetStatusOutput, error) {
+	req, out := c.GetStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opListJobs = "ListJobs"
+
+// ListJobsRequest generates a request for the ListJobs operation.
+func (c *ImportExport) ListJobsRequest(input *ListJobsInput) (req *aws.Request, output *ListJobsOutput) {
+	op := &aws.Operation{
+		Name:       opListJobs,
+		HTTPMethod: "POST",
+		HTTPPath:   "/?Operation=ListJobs",
+		Paginator: &aws.Paginator{
+			InputTokens:     []string{"Marker"},
+			OutputTokens:    []string{"Jobs[-1].JobId"},
+			LimitToken:      "MaxJobs",
+			TruncationToken: "IsTruncated",
+		},
+	}
+
+	if input == nil {
+		input = &ListJobsInput{}
+	}
+
+	req = c.newRequest(op, input, output)
+	output = &ListJobsOutput{}
+	req.Data = output
+	return
+}
+
+// This operation returns the jobs associated with the requester. AWS Import/Export
+// lists the jobs in reverse chronological order based on the date of creation.
+// For example if Job Test1 was created 2009Dec30 and Test2 was created 2010Feb05,
+// the ListJobs operation would return Test2 followed by Test1.
+func (c *ImportExport) ListJobs(input *ListJobsInput) (*ListJobsOutput, error) {
+	req, out := c.ListJobsRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+// ListJobsWithContext is the same as ListJobs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ImportExport) ListJobsWithContext(ctx aws.Context, input *ListJobsInput) (*ListJobsOutput, error) {
+	req, out := c.ListJobsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+func (c *ImportExport) ListJobsPages(input *ListJobsInput, fn func(p *ListJobsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListJobsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListJobsOutput), lastPage)
+	})
+}
+
+const opUpdateJob = "UpdateJob"
+
+// UpdateJobRequest generates a request for the UpdateJob operation.
+func (c *ImportExport) UpdateJobRequest(input *UpdateJobInput) (req *aws.Request, output *UpdateJobOutput) {
+	op := &aws.Operation{
+		Name:       opUpdateJob,
+		HTTPMethod: "POST",
+		HTTPPath:   "/?Operation=UpdateJob",
+	}
+
+	if input == nil {
+		input = &UpdateJobInput{}
+	}
+
+	req = c.newRequest(op, input, output)
+	output = &UpdateJobOutput{}
+	req.Data = output
+	return
+}
+
+// You use this operation to change the parameters specified in the original
+// manifest file by supplying a new manifest file. The manifest file attached
+// to this request replaces the original manifest file. You can only use the
+// operation after a CreateJob request but before the data transfer starts and
+// you can only use it on jobs you own.
+func (c *ImportExport) UpdateJob(input *UpdateJobInput) (*UpdateJobOutput, error) {
+	req, out := c.UpdateJobRequest(input)
+	err := req.Send()
+	return out, err
+}
+
+// UpdateJobWithContext is the same as UpdateJob with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *ImportExport) UpdateJobWithContext(ctx aws.Context, input *UpdateJobInput) (*UpdateJobOutput, error) {
+	req, out := c.UpdateJobRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+// A discrete item that contains the description and URL of an artifact (such
+// as a PDF).
+type Artifact struct {
+	// The associated description for this object.
+	Description *string `type:"string"`
+
+	// The URL for a given Artifact.
+	URL *string `type:"string"`
+
+	metadataArtifact `json:"-" xml:"-"`
+}
+
+type metadataArtifact struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s Artifact) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Artifact) GoString() string {
+	return s.String()
+}
+
+// Input structure for the CancelJob operation.
+type CancelJobInput struct {
+	// Specifies the version of the client tool.
+	APIVersion *string `type:"string"`
+
+	// A unique identifier which refers to a particular job.
+	JobID *string `locationName:"JobId" type:"string" required:"true"`
+
+	metadataCancelJobInput `json:"-" xml:"-"`
+}
+
+type metadataCancelJobInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s CancelJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelJobInput) GoString() string {
+	return s.String()
+}
+
+// Output structure for the CancelJob operation.
+type CancelJobOutput struct {
+	// Specifies whether (true) or not (false) AWS Import/Export updated your job.
+	Success *bool `type:"boolean"`
+
+	metadataCancelJobOutput `json:"-" xml:"-"`
+}
+
+type metadataCancelJobOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s CancelJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelJobOutput) GoString() string {
+	return s.String()
+}
+
+// Input structure for the CreateJob operation.
+type CreateJobInput struct {
+	// Specifies the version of the client tool.
+	APIVersion *string `type:"string"`
+
+	// Specifies whether the job to initiate is an import or export job.
+	//
+	// See the JobType* constants for valid values.
+	JobType *string `type:"string" enum:"Import,Export" required:"true"`
+
+	// The UTF-8 encoded text of the manifest file.
+	Manifest *string `type:"string" required:"true"`
+
+	// For internal use only.
+	ManifestAddendum *string `type:"string"`
+
+	// Validate the manifest and parameter values in the request but do not actually
+	// create a job.
+	ValidateOnly *bool `type:"boolean" required:"true"`
+
+	metadataCreateJobInput `json:"-" xml:"-"`
+}
+
+type metadataCreateJobInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s CreateJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateJobInput) GoString() string {
+	return s.String()
+}
+
+// Output structure for the CreateJob operation.
+type CreateJobOutput struct {
+	// A collection of artifacts.
+	ArtifactList []*Artifact `type:"list"`
+
+	// A unique identifier which refers to a particular job.
+	JobID *string `locationName:"JobId" type:"string"`
+
+	// Specifies whether the job to initiate is an import or export job.
+	//
+	// See the JobType* constants for valid values.
+	JobType *string `type:"string" enum:"Import,Export"`
+
+	// An encrypted code used to authenticate the request and response, for example,
+	// "DV+TpDfx1/TdSE9ktyK9k/bDTVI=". Only use this value is you want to create
+	// the signature file yourself. Generally you should use the SignatureFileContents
+	// value.
+	Signature *string `type:"string"`
+
+	// The actual text of the SIGNATURE file to be written to disk.
+	SignatureFileContents *string `type:"string"`
+
+	// An optional message notifying you of non-fatal issues with the job, such
+	// as use of an incompatible Amazon S3 bucket name.
+	WarningMessage *string `type:"string"`
+
+	metadataCreateJobOutput `json:"-" xml:"-"`
+}
+
+type metadataCreateJobOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s CreateJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateJobOutput) GoString() string {
+	return s.String()
+}
+
+type GetShippingLabelInput struct {
+	APIVersion *string `type:"string"`
+
+	City *string `locationName:"city" type:"string"`
+
+	Company *string `locationName:"company" type:"string"`
+
+	Country *string `locationName:"country" type:"string"`
+
+	JobIDs []*string `locationName:"jobIds" type:"list" required:"true"`
+
+	Name *string `locationName:"name" type:"string"`
+
+	PhoneNumber *string `locationName:"phoneNumber" type:"string"`
+
+	PostalCode *string `locationName:"postalCode" type:"string"`
+
+	StateOrProvince *string `locationName:"stateOrProvince" type:"string"`
+
+	Street1 *string `locationName:"street1" type:"string"`
+
+	Street2 *string `locationName:"street2" type:"string"`
+
+	Street3 *string `locationName:"street3" type:"string"`
+
+	metadataGetShippingLabelInput `json:"-" xml:"-"`
+}
+
+type metadataGetShippingLabelInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s GetShippingLabelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetShippingLabelInput) GoString() string {
+	return s.String()
+}
+
+type GetShippingLabelOutput struct {
+	ShippingLabelURL *string `type:"string"`
+
+	Warning *string `type:"string"`
+
+	metadataGetShippingLabelOutput `json:"-" xml:"-"`
+}
+
+type metadataGetShippingLabelOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s GetShippingLabelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetShippingLabelOutput) GoString() string {
+	return s.String()
+}
+
+// Input structure for the GetStatus operation.
+type GetStatusInput struct {
+	// Specifies the version of the client tool.
+	APIVersion *string `type:"string"`
+
+	// A unique identifier which refers to a particular job.
+	JobID *string `locationName:"JobId" type:"string" required:"true"`
+
+	metadataGetStatusInput `json:"-" xml:"-"`
+}
+
+type metadataGetStatusInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s GetStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStatusInput) GoString() string {
+	return s.String()
+}
+
+// Output structure for the GetStatus operation.
+type GetStatusOutput struct {
+	// A collection of artifacts.
+	ArtifactList []*Artifact `type:"list"`
+
+	// Name of the shipping company. This value is included when the LocationCode
+	// is "Returned".
+	Carrier *string `type:"string"`
+
+	// Timestamp of the CreateJob request in ISO8601 date format. For example "2010-03-28T20:27:35Z".
+	CreationDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	// The last manifest submitted, which will be used to process the job.
+	CurrentManifest *string `type:"string"`
+
+	// Number of errors. We return this value when the ProgressCode is Success or
+	// SuccessWithErrors.
+	ErrorCount *int64 `type:"integer"`
+
+	// A unique identifier which refers to a particular job.
+	JobID *string `locationName:"JobId" type:"string"`
+
+	// Specifies whether the job to initiate is an import or export job.
+	//
+	// See the JobType* constants for valid values.
+	JobType *string `type:"string" enum:"Import,Export"`
+
+	// A token representing the location of the storage device, such as "AtAWS".
+	LocationCode *string `type:"string"`
+
+	// A more human readable form of the physical location of the storage device.
+	LocationMessage *string `type:"string"`
+
+	// Amazon S3 bucket for user logs.
+	LogBucket *string `type:"string"`
+
+	// The key where the user logs were stored.
+	LogKey *string `type:"string"`
+
+	// A token representing the state of the job, such as "Started".
+	ProgressCode *string `type:"string"`
+
+	// A more human readable form of the job status.
+	ProgressMessage *string `type:"string"`
+
+	// An encrypted code used to authenticate the request and response, for example,
+	// "DV+TpDfx1/TdSE9ktyK9k/bDTVI=". Only use this value is you want to create
+	// the signature file yourself. Generally you should use the SignatureFileContents
+	// value.
+	Signature *string `type:"string"`
+
+	// An encrypted code used to authenticate the request and response, for example,
+	// "DV+TpDfx1/TdSE9ktyK9k/bDTVI=". Only use this value is you want to create
+	// the signature file yourself. Generally you should use the SignatureFileContents
+	// value.
+	SignatureFileContents *string `type:"string"`
+
+	// The shipping tracking number assigned by AWS Import/Export to the storage
+	// device when it's returned to you. We return this value when the LocationCode
+	// is "Returned".
+	TrackingNumber *string `type:"string"`
+
+	metadataGetStatusOutput `json:"-" xml:"-"`
+}
+
+type metadataGetStatusOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s GetStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetStatusOutput) GoString() string {
+	return s.String()
+}
+
+// Representation of a job returned by the ListJobs operation.
+type Job struct {
+	// Timestamp of the CreateJob request in ISO8601 date format. For example "2010-03-28T20:27:35Z".
+	CreationDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	// Indicates whether the job was canceled.
+	IsCanceled *bool `type:"boolean"`
+
+	// A unique identifier which refers to a particular job.
+	JobID *string `locationName:"JobId" type:"string"`
+
+	// Specifies whether the job to initiate is an import or export job.
+	//
+	// See the JobType* constants for valid values.
+	JobType *string `type:"string" enum:"Import,Export"`
+
+	metadataJob `json:"-" xml:"-"`
+}
+
+type metadataJob struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s Job) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Job) GoString() string {
+	return s.String()
+}
+
+// Input structure for the ListJobs operation.
+type ListJobsInput struct {
+	// Specifies the version of the client tool.
+	APIVersion *string `type:"string"`
+
+	// Specifies the JOBID to start after when listing the jobs created with your
+	// account. AWS Import/Export lists your jobs in reverse chronological order.
+	// See MaxJobs.
+	Marker *string `type:"string"`
+
+	// Sets the maximum number of jobs returned in the response. If there are additional
+	// jobs that were not returned because MaxJobs was exceeded, the response contains
+	// <IsTruncated>true</IsTruncated>. To return the additional jobs, see Marker.
+	MaxJobs *int64 `type:"integer"`
+
+	metadataListJobsInput `json:"-" xml:"-"`
+}
+
+type metadataListJobsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s ListJobsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsInput) GoString() string {
+	return s.String()
+}
+
+// Output structure for the ListJobs operation.
+type ListJobsOutput struct {
+	// Indicates whether the list of jobs was truncated. If true, then call ListJobs
+	// again using the last JobId element as the marker.
+	IsTruncated *bool `type:"boolean"`
+
+	// A list container for Jobs returned by the ListJobs operation.
+	Jobs []*Job `type:"list"`
+
+	metadataListJobsOutput `json:"-" xml:"-"`
+}
+
+type metadataListJobsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s ListJobsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListJobsOutput) GoString() string {
+	return s.String()
+}
+
+// Input structure for the UpateJob operation.
+type UpdateJobInput struct {
+	// Specifies the version of the client tool.
+	APIVersion *string `type:"string"`
+
+	// A unique identifier which refers to a particular job.
+	JobID *string `locationName:"JobId" type:"string" required:"true"`
+
+	// Specifies whether the job to initiate is an import or export job.
+	//
+	// See the JobType* constants for valid values.
+	JobType *string `type:"string" enum:"Import,Export" required:"true"`
+
+	// The UTF-8 encoded text of the manifest file.
+	Manifest *string `type:"string" required:"true"`
+
+	// Validate the manifest and parameter values in the request but do not actually
+	// create a job.
+	ValidateOnly *bool `type:"boolean" required:"true"`
+
+	metadataUpdateJobInput `json:"-" xml:"-"`
+}
+
+type metadataUpdateJobInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s UpdateJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateJobInput) GoString() string {
+	return s.String()
+}
+
+// Output structure for the UpateJob operation.
+type UpdateJobOutput struct {
+	// A collection of artifacts.
+	ArtifactList []*Artifact `type:"list"`
+
+	// Specifies whether (true) or not (false) AWS Import/Export updated your job.
+	Success *bool `type:"boolean"`
+
+	// An optional message notifying you of non-fatal issues with the job, such
+	// as use of an incompatible Amazon S3 bucket name.
+	WarningMessage *string `type:"string"`
+
+	metadataUpdateJobOutput `json:"-" xml:"-"`
+}
+
+type metadataUpdateJobOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation
+func (s UpdateJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateJobOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum JobType
+	JobTypeImport = "Import"
+	// @enum JobType
+	JobTypeExport = "Export"
+)
@@ -0,0 +1,36 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+// Package importexportiface provides an interface for the AWS Import/Export.
+package importexportiface
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/importexport"
+)
+
+// ImportExportAPI is the interface type for importexport.ImportExport.
+type ImportExportAPI interface {
+	CancelJob(*importexport.CancelJobInput) (*importexport.CancelJobOutput, error)
+
+	CancelJobWithContext(aws.Context, *importexport.CancelJobInput) (*importexport.CancelJobOutput, error)
+
+	CreateJob(*importexport.CreateJobInput) (*importexport.CreateJobOutput, error)
+
+	CreateJobWithContext(aws.Context, *importexport.CreateJobInput) (*importexport.CreateJobOutput, error)
+
+	GetShippingLabel(*importexport.GetShippingLabelInput) (*importexport.GetShippingLabelOutput, error)
+
+	GetShippingLabelWithContext(aws.Context, *importexport.GetShippingLabelInput) (*importexport.GetShippingLabelOutput, error)
+
+	GetStatus(*importexport.GetStatusInput) (*importexport.GetStatusOutput, error)
+
+	GetStatusWithContext(aws.Context, *importexport.GetStatusInput) (*importexport.GetStatusOutput, error)
+
+	ListJobs(*importexport.ListJobsInput) (*importexport.ListJobsOutput, error)
+
+	ListJobsWithContext(aws.Context, *importexport.ListJobsInput) (*importexport.ListJobsOutput, error)
+
+	UpdateJob(*importexport.UpdateJobInput) (*importexport.UpdateJobOutput, error)
+
+	UpdateJobWithContext(aws.Context, *importexport.UpdateJobInput) (*importexport.UpdateJobOutput, error)
+}
@@ -5,6 +5,7 @@ package kinesis
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddTagsToStream = "AddTagsToStream"
@@ -38,6 +39,16 @@ func (c *Kinesis) AddTagsToStream(input *AddTagsToStreamInput) (*AddTagsToStream
 	return out, err
 }
 
+// AddTagsToStreamWithContext is the same as AddTagsToStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) AddTagsToStreamWithContext(ctx aws.Context, input *AddTagsToStreamInput) (*AddTagsToStreamOutput, error) {
+	req, out := c.AddTagsToStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStream = "CreateStream"
 
 // CreateStreamRequest generates a request for the CreateStream operation.
@@ -100,6 +111,16 @@ func (c *Kinesis) CreateStream(input *CreateStreamInput) (*CreateStreamOutput, e
 	return out, err
 }
 
+// CreateStreamWithContext is the same as CreateStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) CreateStreamWithContext(ctx aws.Context, input *CreateStreamInput) (*CreateStreamOutput, error) {
+	req, out := c.CreateStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteStream = "DeleteStream"
 
 // DeleteStreamRequest generates a request for the DeleteStream operation.
@@ -145,6 +166,16 @@ func (c *Kinesis) DeleteStream(input *DeleteStreamInput) (*DeleteStreamOutput, e
 	return out, err
 }
 
+// DeleteStreamWithContext is the same as DeleteStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) DeleteStreamWithContext(ctx aws.Context, input *DeleteStreamInput) (*DeleteStreamOutput, error) {
+	req, out := c.DeleteStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStream = "DescribeStream"
 
 // DescribeStreamRequest generates a request for the DescribeStream operation.
@@ -199,6 +230,16 @@ func (c *Kinesis) DescribeStream(input *DescribeStreamInput) (*DescribeStreamOut
 	return out, err
 }
 
+// DescribeStreamWithContext is the same as DescribeStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) DescribeStreamWithContext(ctx aws.Context, input *DescribeStreamInput) (*DescribeStreamOutput, error) {
+	req, out := c.DescribeStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Kinesis) DescribeStreamPages(input *DescribeStreamInput, fn func(p *DescribeStreamOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeStreamRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -276,6 +317,16 @@ func (c *Kinesis) GetRecords(input *GetRecordsInput) (*GetRecordsOutput, error)
 	return out, err
 }
 
+// GetRecordsWithContext is the same as GetRecords with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) GetRecordsWithContext(ctx aws.Context, input *GetRecordsInput) (*GetRecordsOutput, error) {
+	req, out := c.GetRecordsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetShardIterator = "GetShardIterator"
 
 // GetShardIteratorRequest generates a request for the GetShardIterator operation.
@@ -339,6 +390,16 @@ func (c *Kinesis) GetShardIterator(input *GetShardIteratorInput) (*GetShardItera
 	return out, err
 }
 
+// GetShardIteratorWithContext is the same as GetShardIterator with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) GetShardIteratorWithContext(ctx aws.Context, input *GetShardIteratorInput) (*GetShardIteratorOutput, error) {
+	req, out := c.GetShardIteratorRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListStreams = "ListStreams"
 
 // ListStreamsRequest generates a request for the ListStreams operation.
@@ -387,6 +448,16 @@ func (c *Kinesis) ListStreams(input *ListStreamsInput) (*ListStreamsOutput, erro
 	return out, err
 }
 
+// ListStreamsWithContext is the same as ListStreams with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) ListStreamsWithContext(ctx aws.Context, input *ListStreamsInput) (*ListStreamsOutput, error) {
+	req, out := c.ListStreamsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Kinesis) ListStreamsPages(input *ListStreamsInput, fn func(p *ListStreamsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListStreamsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -421,6 +492,16 @@ func (c *Kinesis) ListTagsForStream(input *ListTagsForStreamInput) (*ListTagsFor
 	return out, err
 }
 
+// ListTagsForStreamWithContext is the same as ListTagsForStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) ListTagsForStreamWithContext(ctx aws.Context, input *ListTagsForStreamInput) (*ListTagsForStreamOutput, error) {
+	req, out := c.ListTagsForStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opMergeShards = "MergeShards"
 
 // MergeShardsRequest generates a request for the MergeShards operation.
@@ -482,6 +563,16 @@ func (c *Kinesis) MergeShards(input *MergeShardsInput) (*MergeShardsOutput, erro
 	return out, err
 }
 
+// MergeShardsWithContext is the same as MergeShards with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) MergeShardsWithContext(ctx aws.Context, input *MergeShardsInput) (*MergeShardsOutput, error) {
+	req, out := c.MergeShardsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRecord = "PutRecord"
 
 // PutRecordRequest generates a request for the PutRecord operation.
@@ -546,6 +637,16 @@ func (c *Kinesis) PutRecord(input *PutRecordInput) (*PutRecordOutput, error) {
 	return out, err
 }
 
+// PutRecordWithContext is the same as PutRecord with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) PutRecordWithContext(ctx aws.Context, input *PutRecordInput) (*PutRecordOutput, error) {
+	req, out := c.PutRecordRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPutRecords = "PutRecords"
 
 // PutRecordsRequest generates a request for the PutRecords operation.
@@ -627,6 +728,16 @@ func (c *Kinesis) PutRecords(input *PutRecordsInput) (*PutRecordsOutput, error)
 	return out, err
 }
 
+// PutRecordsWithContext is the same as PutRecords with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) PutRecordsWithContext(ctx aws.Context, input *PutRecordsInput) (*PutRecordsOutput, error) {
+	req, out := c.PutRecordsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemoveTagsFromStream = "RemoveTagsFromStream"
 
 // RemoveTagsFromStreamRequest generates a request for the RemoveTagsFromStream operation.
@@ -656,6 +767,16 @@ func (c *Kinesis) RemoveTagsFromStream(input *RemoveTagsFromStreamInput) (*Remov
 	return out, err
 }
 
+// RemoveTagsFromStreamWithContext is the same as RemoveTagsFromStream with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) RemoveTagsFromStreamWithContext(ctx aws.Context, input *RemoveTagsFromStreamInput) (*RemoveTagsFromStreamOutput, error) {
+	req, out := c.RemoveTagsFromStreamRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSplitShard = "SplitShard"
 
 // SplitShardRequest generates a request for the SplitShard operation.
@@ -728,13 +849,23 @@ func (c *Kinesis) SplitShard(input *SplitShardInput) (*SplitShardOutput, error)
 	return out, err
 }
 
+// SplitShardWithContext is the same as SplitShard with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Kinesis) SplitShardWithContext(ctx aws.Context, input *SplitShardInput) (*SplitShardOutput, error) {
+	req, out := c.SplitShardRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the input for AddTagsToStream.
 type AddTagsToStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The set of key-value pairs to use to create the tags.
-	Tags map[string]*string `type:"map" required:"true"`
+	Tags map[string]*string `type:"map" min:"1" max:"10" required:"true"`
 
 	metadataAddTagsToStreamInput `json:"-" xml:"-"`
 }
@@ -743,6 +874,16 @@ type metadataAddTagsToStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsToStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsToStreamInput) GoString() string {
+	return s.String()
+}
+
 type AddTagsToStreamOutput struct {
 	metadataAddTagsToStreamOutput `json:"-" xml:"-"`
 }
@@ -751,6 +892,16 @@ type metadataAddTagsToStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddTagsToStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddTagsToStreamOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for CreateStream.
 type CreateStreamInput struct {
 	// The number of shards that the stream will use. The throughput of the stream
@@ -758,14 +909,14 @@ type CreateStreamInput struct {
 	// provisioned throughput.
 	//
 	// DefaultShardLimit;
-	ShardCount *int64 `type:"integer" required:"true"`
+	ShardCount *int64 `type:"integer" min:"1" required:"true"`
 
 	// A name to identify the stream. The stream name is scoped to the AWS account
 	// used by the application that creates the stream. It is also scoped by region.
 	// That is, two streams in two different AWS accounts can have the same name,
 	// and two streams in the same AWS account, but in two different regions, can
 	// have the same name.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateStreamInput `json:"-" xml:"-"`
 }
@@ -774,6 +925,16 @@ type metadataCreateStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStreamInput) GoString() string {
+	return s.String()
+}
+
 type CreateStreamOutput struct {
 	metadataCreateStreamOutput `json:"-" xml:"-"`
 }
@@ -782,10 +943,20 @@ type metadataCreateStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStreamOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for DeleteStream.
 type DeleteStreamInput struct {
 	// The name of the stream to delete.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteStreamInput `json:"-" xml:"-"`
 }
@@ -794,6 +965,16 @@ type metadataDeleteStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStreamInput) GoString() string {
+	return s.String()
+}
+
 type DeleteStreamOutput struct {
 	metadataDeleteStreamOutput `json:"-" xml:"-"`
 }
@@ -802,16 +983,26 @@ type metadataDeleteStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStreamOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for DescribeStream.
 type DescribeStreamInput struct {
 	// The shard ID of the shard to start with.
-	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string"`
+	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of shards to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The name of the stream to describe.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDescribeStreamInput `json:"-" xml:"-"`
 }
@@ -820,6 +1011,16 @@ type metadataDescribeStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStreamInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for DescribeStream.
 type DescribeStreamOutput struct {
 	// The current status of the stream, the stream ARN, an array of shard objects
@@ -833,16 +1034,26 @@ type metadataDescribeStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStreamOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for GetRecords.
 type GetRecordsInput struct {
 	// The maximum number of records to return. Specify a value of up to 10,000.
 	// If you specify a value that is greater than 10,000, GetRecords throws InvalidArgumentException.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The position in the shard from which you want to start sequentially reading
 	// data records. A shard iterator specifies this position using the sequence
 	// number of a data record in the shard.
-	ShardIterator *string `type:"string" required:"true"`
+	ShardIterator *string `type:"string" min:"1" max:"512" required:"true"`
 
 	metadataGetRecordsInput `json:"-" xml:"-"`
 }
@@ -851,18 +1062,28 @@ type metadataGetRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetRecordsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for GetRecords.
 type GetRecordsOutput struct {
 	// The number of milliseconds the GetRecords response is from the tip of the
 	// stream, indicating how far behind current time the consumer is. A value of
 	// zero indicates record processing is caught up, and there are no new records
 	// to process at this moment.
-	MillisBehindLatest *int64 `type:"long"`
+	MillisBehindLatest *int64 `type:"long" min:"0"`
 
 	// The next position in the shard from which to start sequentially reading data
 	// records. If set to null, the shard has been closed and the requested iterator
 	// will not return any more data.
-	NextShardIterator *string `type:"string"`
+	NextShardIterator *string `type:"string" min:"1" max:"512"`
 
 	// The data records retrieved from the shard.
 	Records []*Record `type:"list" required:"true"`
@@ -874,10 +1095,20 @@ type metadataGetRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetRecordsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetRecordsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for GetShardIterator.
 type GetShardIteratorInput struct {
 	// The shard ID of the shard to get the iterator for.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Determines how the shard iterator is used to start reading data records from
 	// the shard.
@@ -891,14 +1122,16 @@ type GetShardIteratorInput struct {
 	// the oldest data record in the shard. LATEST - Start reading just after the
 	// most recent record in the shard, so that you always read the most recent
 	// data in the shard.
-	ShardIteratorType *string `type:"string" required:"true"`
+	//
+	// See the ShardIteratorType* constants for valid values.
+	ShardIteratorType *string `type:"string" enum:"AT_SEQUENCE_NUMBER,AFTER_SEQUENCE_NUMBER,TRIM_HORIZON,LATEST" required:"true"`
 
 	// The sequence number of the data record in the shard from which to start reading
 	// from.
-	StartingSequenceNumber *string `type:"string"`
+	StartingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetShardIteratorInput `json:"-" xml:"-"`
 }
@@ -907,12 +1140,22 @@ type metadataGetShardIteratorInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetShardIteratorInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetShardIteratorInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for GetShardIterator.
 type GetShardIteratorOutput struct {
 	// The position in the shard from which to start reading data records sequentially.
 	// A shard iterator specifies this position using the sequence number of a data
 	// record in a shard.
-	ShardIterator *string `type:"string"`
+	ShardIterator *string `type:"string" min:"1" max:"512"`
 
 	metadataGetShardIteratorOutput `json:"-" xml:"-"`
 }
@@ -921,14 +1164,24 @@ type metadataGetShardIteratorOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetShardIteratorOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetShardIteratorOutput) GoString() string {
+	return s.String()
+}
+
 // The range of possible hash key values for the shard, which is a set of ordered
 // contiguous positive integers.
 type HashKeyRange struct {
 	// The ending hash key of the hash key range.
-	EndingHashKey *string `type:"string" required:"true"`
+	EndingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	// The starting hash key of the hash key range.
-	StartingHashKey *string `type:"string" required:"true"`
+	StartingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	metadataHashKeyRange `json:"-" xml:"-"`
 }
@@ -937,13 +1190,23 @@ type metadataHashKeyRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HashKeyRange) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HashKeyRange) GoString() string {
+	return s.String()
+}
+
 // Represents the input for ListStreams.
 type ListStreamsInput struct {
 	// The name of the stream to start the list with.
-	ExclusiveStartStreamName *string `type:"string"`
+	ExclusiveStartStreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of streams to list.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	metadataListStreamsInput `json:"-" xml:"-"`
 }
@@ -952,6 +1215,16 @@ type metadataListStreamsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStreamsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStreamsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for ListStreams.
 type ListStreamsOutput struct {
 	// If set to true, there are more streams available to list.
@@ -968,19 +1241,29 @@ type metadataListStreamsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListStreamsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListStreamsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for ListTagsForStream.
 type ListTagsForStreamInput struct {
 	// The key to use as the starting point for the list of tags. If this parameter
 	// is set, ListTagsForStream gets all tags that occur after ExclusiveStartTagKey.
-	ExclusiveStartTagKey *string `type:"string"`
+	ExclusiveStartTagKey *string `type:"string" min:"1" max:"128"`
 
 	// The number of tags to return. If this number is less than the total number
 	// of tags associated with the stream, HasMoreTags is set to true. To list additional
 	// tags, set ExclusiveStartTagKey to the last key in the response.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataListTagsForStreamInput `json:"-" xml:"-"`
 }
@@ -989,6 +1272,16 @@ type metadataListTagsForStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForStreamInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for ListTagsForStream.
 type ListTagsForStreamOutput struct {
 	// If set to true, more tags are available. To request additional tags, set
@@ -997,7 +1290,7 @@ type ListTagsForStreamOutput struct {
 
 	// A list of tags associated with StreamName, starting with the first tag after
 	// ExclusiveStartTagKey and up to the specified Limit.
-	Tags []*Tag `type:"list" required:"true"`
+	Tags []*Tag `type:"list" min:"0" required:"true"`
 
 	metadataListTagsForStreamOutput `json:"-" xml:"-"`
 }
@@ -1006,16 +1299,26 @@ type metadataListTagsForStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForStreamOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for MergeShards.
 type MergeShardsInput struct {
 	// The shard ID of the adjacent shard for the merge.
-	AdjacentShardToMerge *string `type:"string" required:"true"`
+	AdjacentShardToMerge *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The shard ID of the shard to combine with the adjacent shard for the merge.
-	ShardToMerge *string `type:"string" required:"true"`
+	ShardToMerge *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The name of the stream for the merge.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataMergeShardsInput `json:"-" xml:"-"`
 }
@@ -1024,6 +1327,16 @@ type metadataMergeShardsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MergeShardsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MergeShardsInput) GoString() string {
+	return s.String()
+}
+
 type MergeShardsOutput struct {
 	metadataMergeShardsOutput `json:"-" xml:"-"`
 }
@@ -1032,16 +1345,26 @@ type metadataMergeShardsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MergeShardsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MergeShardsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the input for PutRecord.
 type PutRecordInput struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// The hash value used to explicitly determine the shard the data record is
 	// assigned to by overriding the partition key hash.
-	ExplicitHashKey *string `type:"string"`
+	ExplicitHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})"`
 
 	// Determines which shard in the stream the data record is assigned to. Partition
 	// keys are Unicode strings with a maximum length limit of 256 characters for
@@ -1051,17 +1374,17 @@ type PutRecordInput struct {
 	// and to map associated data records to shards. As a result of this hashing
 	// mechanism, all data records with the same partition key will map to the same
 	// shard within the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Guarantees strictly increasing sequence numbers, for puts from the same client
 	// and to the same partition key. Usage: set the SequenceNumberForOrdering of
 	// record n to the sequence number of record n-1 (as returned in the result
 	// when putting record n-1). If this parameter is not set, records will be coarsely
 	// ordered based on arrival time.
-	SequenceNumberForOrdering *string `type:"string"`
+	SequenceNumberForOrdering *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The name of the stream to put the data record into.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordInput `json:"-" xml:"-"`
 }
@@ -1070,16 +1393,26 @@ type metadataPutRecordInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRecordInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRecordInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for PutRecord.
 type PutRecordOutput struct {
 	// The sequence number identifier that was assigned to the put data record.
 	// The sequence number for the record is unique across all records in the stream.
 	// A sequence number is the identifier associated with every record put into
 	// the stream.
-	SequenceNumber *string `type:"string" required:"true"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	// The shard ID of the shard where the data record was placed.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordOutput `json:"-" xml:"-"`
 }
@@ -1088,13 +1421,23 @@ type metadataPutRecordOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRecordOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRecordOutput) GoString() string {
+	return s.String()
+}
+
 // A PutRecords request.
 type PutRecordsInput struct {
 	// The records associated with the request.
-	Records []*PutRecordsRequestEntry `type:"list" required:"true"`
+	Records []*PutRecordsRequestEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	// The stream name associated with the request.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordsInput `json:"-" xml:"-"`
 }
@@ -1103,17 +1446,27 @@ type metadataPutRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRecordsInput) GoString() string {
+	return s.String()
+}
+
 // PutRecords results.
 type PutRecordsOutput struct {
 	// The number of unsuccessfully processed records in a PutRecords request.
-	FailedRecordCount *int64 `type:"integer"`
+	FailedRecordCount *int64 `type:"integer" min:"1"`
 
 	// An array of successfully and unsuccessfully processed record results, correlated
 	// with the request by natural ordering. A record that is successfully added
 	// to your Amazon Kinesis stream includes SequenceNumber and ShardId in the
 	// result. A record that fails to be added to your Amazon Kinesis stream includes
 	// ErrorCode and ErrorMessage in the result.
-	Records []*PutRecordsResultEntry `type:"list" required:"true"`
+	Records []*PutRecordsResultEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	metadataPutRecordsOutput `json:"-" xml:"-"`
 }
@@ -1122,16 +1475,26 @@ type metadataPutRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRecordsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRecordsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for PutRecords.
 type PutRecordsRequestEntry struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// The hash value used to determine explicitly the shard that the data record
 	// is assigned to by overriding the partition key hash.
-	ExplicitHashKey *string `type:"string"`
+	ExplicitHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})"`
 
 	// Determines which shard in the stream the data record is assigned to. Partition
 	// keys are Unicode strings with a maximum length limit of 256 characters for
@@ -1141,7 +1504,7 @@ type PutRecordsRequestEntry struct {
 	// and to map associated data records to shards. As a result of this hashing
 	// mechanism, all data records with the same partition key map to the same shard
 	// within the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataPutRecordsRequestEntry `json:"-" xml:"-"`
 }
@@ -1150,6 +1513,16 @@ type metadataPutRecordsRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRecordsRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRecordsRequestEntry) GoString() string {
+	return s.String()
+}
+
 // Represents the result of an individual record from a PutRecords request.
 // A record that is successfully added to your Amazon Kinesis stream includes
 // SequenceNumber and ShardId in the result. A record that fails to be added
@@ -1167,10 +1540,10 @@ type PutRecordsResultEntry struct {
 	ErrorMessage *string `type:"string"`
 
 	// The sequence number for an individual record result.
-	SequenceNumber *string `type:"string"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The shard ID for an individual record result.
-	ShardID *string `locationName:"ShardId" type:"string"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataPutRecordsResultEntry `json:"-" xml:"-"`
 }
@@ -1179,6 +1552,16 @@ type metadataPutRecordsResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutRecordsResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutRecordsResultEntry) GoString() string {
+	return s.String()
+}
+
 // The unit of data of the Amazon Kinesis stream, which is composed of a sequence
 // number, a partition key, and a data blob.
 type Record struct {
@@ -1186,13 +1569,13 @@ type Record struct {
 	// Kinesis service, which does not inspect, interpret, or change the data in
 	// the blob in any way. The maximum size of the data blob (the payload before
 	// base64-encoding) is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" min:"0" max:"51200" required:"true"`
 
 	// Identifies which shard in the stream the data record is assigned to.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// The unique identifier for the record in the Amazon Kinesis stream.
-	SequenceNumber *string `type:"string" required:"true"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	metadataRecord `json:"-" xml:"-"`
 }
@@ -1201,13 +1584,23 @@ type metadataRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Record) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Record) GoString() string {
+	return s.String()
+}
+
 // Represents the input for RemoveTagsFromStream.
 type RemoveTagsFromStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A list of tag keys. Each corresponding tag is removed from the stream.
-	TagKeys []*string `type:"list" required:"true"`
+	TagKeys []*string `type:"list" min:"1" max:"10" required:"true"`
 
 	metadataRemoveTagsFromStreamInput `json:"-" xml:"-"`
 }
@@ -1216,6 +1609,16 @@ type metadataRemoveTagsFromStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsFromStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsFromStreamInput) GoString() string {
+	return s.String()
+}
+
 type RemoveTagsFromStreamOutput struct {
 	metadataRemoveTagsFromStreamOutput `json:"-" xml:"-"`
 }
@@ -1224,14 +1627,24 @@ type metadataRemoveTagsFromStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemoveTagsFromStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemoveTagsFromStreamOutput) GoString() string {
+	return s.String()
+}
+
 // The range of possible sequence numbers for the shard.
 type SequenceNumberRange struct {
 	// The ending sequence number for the range. Shards that are in the OPEN state
 	// have an ending sequence number of null.
-	EndingSequenceNumber *string `type:"string"`
+	EndingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The starting sequence number for the range.
-	StartingSequenceNumber *string `type:"string" required:"true"`
+	StartingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	metadataSequenceNumberRange `json:"-" xml:"-"`
 }
@@ -1240,23 +1653,33 @@ type metadataSequenceNumberRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SequenceNumberRange) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SequenceNumberRange) GoString() string {
+	return s.String()
+}
+
 // A uniquely identified group of data records in an Amazon Kinesis stream.
 type Shard struct {
 	// The shard Id of the shard adjacent to the shard's parent.
-	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string"`
+	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The range of possible hash key values for the shard, which is a set of ordered
 	// contiguous positive integers.
 	HashKeyRange *HashKeyRange `type:"structure" required:"true"`
 
 	// The shard Id of the shard's parent.
-	ParentShardID *string `locationName:"ParentShardId" type:"string"`
+	ParentShardID *string `locationName:"ParentShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The range of possible sequence numbers for the shard.
 	SequenceNumberRange *SequenceNumberRange `type:"structure" required:"true"`
 
 	// The unique identifier of the shard within the Amazon Kinesis stream.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataShard `json:"-" xml:"-"`
 }
@@ -1265,6 +1688,16 @@ type metadataShard struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Shard) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Shard) GoString() string {
+	return s.String()
+}
+
 // Represents the input for SplitShard.
 type SplitShardInput struct {
 	// A hash key value for the starting hash key of one of the child shards created
@@ -1274,13 +1707,13 @@ type SplitShardInput struct {
 	// hash key value and all higher hash key values in hash key range are distributed
 	// to one of the child shards. All the lower hash key values in the range are
 	// distributed to the other child shard.
-	NewStartingHashKey *string `type:"string" required:"true"`
+	NewStartingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	// The shard ID of the shard to split.
-	ShardToSplit *string `type:"string" required:"true"`
+	ShardToSplit *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The name of the stream for the shard split.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataSplitShardInput `json:"-" xml:"-"`
 }
@@ -1289,6 +1722,16 @@ type metadataSplitShardInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SplitShardInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SplitShardInput) GoString() string {
+	return s.String()
+}
+
 type SplitShardOutput struct {
 	metadataSplitShardOutput `json:"-" xml:"-"`
 }
@@ -1297,6 +1740,16 @@ type metadataSplitShardOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SplitShardOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SplitShardOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the output for DescribeStream.
 type StreamDescription struct {
 	// If set to true, more shards in the stream are available to describe.
@@ -1309,7 +1762,7 @@ type StreamDescription struct {
 	StreamARN *string `type:"string" required:"true"`
 
 	// The name of the stream being described.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The current status of the stream being described.
 	//
@@ -1323,7 +1776,9 @@ type StreamDescription struct {
 	// on an ACTIVE stream.  UPDATING - Shards in the stream are being merged or
 	// split. Read and write operations continue to work while the stream is in
 	// the UPDATING state.
-	StreamStatus *string `type:"string" required:"true"`
+	//
+	// See the StreamStatus* constants for valid values.
+	StreamStatus *string `type:"string" enum:"CREATING,DELETING,ACTIVE,UPDATING" required:"true"`
 
 	metadataStreamDescription `json:"-" xml:"-"`
 }
@@ -1332,16 +1787,26 @@ type metadataStreamDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StreamDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StreamDescription) GoString() string {
+	return s.String()
+}
+
 // Metadata assigned to the stream, consisting of a key-value pair.
 type Tag struct {
 	// A unique identifier for the tag. Maximum length: 128 characters. Valid characters:
 	// Unicode letters, digits, white space, _ . / = + - % @
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// An optional string, typically used to describe or define the tag. Maximum
 	// length: 256 characters. Valid characters: Unicode letters, digits, white
 	// space, _ . / = + - % @
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"0" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -1349,3 +1814,35 @@ type Tag struct {
 type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ShardIteratorType
+	ShardIteratorTypeAtSequenceNumber = "AT_SEQUENCE_NUMBER"
+	// @enum ShardIteratorType
+	ShardIteratorTypeAfterSequenceNumber = "AFTER_SEQUENCE_NUMBER"
+	// @enum ShardIteratorType
+	ShardIteratorTypeTrimHorizon = "TRIM_HORIZON"
+	// @enum ShardIteratorType
+	ShardIteratorTypeLatest = "LATEST"
+)
+
+const (
+	// @enum StreamStatus
+	StreamStatusCreating = "CREATING"
+	// @enum StreamStatus
+	StreamStatusDeleting = "DELETING"
+	// @enum StreamStatus
+	StreamStatusActive = "ACTIVE"
+	// @enum StreamStatus
+	StreamStatusUpdating = "UPDATING"
+)
@@ -4,6 +4,7 @@
 package kinesisiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 )
 
@@ -11,27 +12,55 @@ import (
 type KinesisAPI interface {
 	AddTagsToStream(*kinesis.AddTagsToStreamInput) (*kinesis.AddTagsToStreamOutput, error)
 
+	AddTagsToStreamWithContext(aws.Context, *kinesis.AddTagsToStreamInput) (*kinesis.AddTagsToStreamOutput, error)
+
 	CreateStream(*kinesis.CreateStreamInput) (*kinesis.CreateStreamOutput, error)
 
+	CreateStreamWithContext(aws.Context, *kinesis.CreateStreamInput) (*kinesis.CreateStreamOutput, error)
+
 	DeleteStream(*kinesis.DeleteStreamInput) (*kinesis.DeleteStreamOutput, error)
 
+	DeleteStreamWithContext(aws.Context, *kinesis.DeleteStreamInput) (*kinesis.DeleteStreamOutput, error)
+
 	DescribeStream(*kinesis.DescribeStreamInput) (*kinesis.DescribeStreamOutput, error)
 
+	DescribeStreamWithContext(aws.Context, *kinesis.DescribeStreamInput) (*kinesis.DescribeStreamOutput, error)
+
 	GetRecords(*kinesis.GetRecordsInput) (*kinesis.GetRecordsOutput, error)
 
+	GetRecordsWithContext(aws.Context, *kinesis.GetRecordsInput) (*kinesis.GetRecordsOutput, error)
+
 	GetShardIterator(*kinesis.GetShardIteratorInput) (*kinesis.GetShardIteratorOutput, error)
 
+	GetShardIteratorWithContext(aws.Context, *kinesis.GetShardIteratorInput) (*kinesis.GetShardIteratorOutput, error)
+
 	ListStreams(*kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error)
 
+	ListStreamsWithContext(aws.Context, *kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error)
+
 	ListTagsForStream(*kinesis.ListTagsForStreamInput) (*kinesis.ListTagsForStreamOutput, error)
 
+	ListTagsForStreamWithContext(aws.Context, *kinesis.ListTagsForStreamInput) (*kinesis.ListTagsForStreamOutput, error)
+
 	MergeShards(*kinesis.MergeShardsInput) (*kinesis.MergeShardsOutput, error)
 
+	MergeShardsWithContext(aws.Context, *kinesis.MergeShardsInput) (*kinesis.MergeShardsOutput, error)
+
 	PutRecord(*kinesis.PutRecordInput) (*kinesis.PutRecordOutput, error)
 
+	PutRecordWithContext(aws.Context, *kinesis.PutRecordInput) (*kinesis.PutRecordOutput, error)
+
 	PutRecords(*kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error)
 
+	PutRecordsWithContext(aws.Context, *kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error)
+
 	RemoveTagsFromStream(*kinesis.RemoveTagsFromStreamInput) (*kinesis.RemoveTagsFromStreamOutput, error)
 
+	RemoveTagsFromStreamWithContext(aws.Context, *kinesis.RemoveTagsFromStreamInput) (*kinesis.RemoveTagsFromStreamOutput, error)
+
 	SplitShard(*kinesis.SplitShardInput) (*kinesis.SplitShardOutput, error)
+
+	SplitShardWithContext(aws.Context, *kinesis.SplitShardInput) (*kinesis.SplitShardOutput, error)
+
+	WaitUntilStreamExists(*kinesis.DescribeStreamInput) error
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateAlias = "CreateAlias"
@@ -47,6 +48,16 @@ func (c *KMS) CreateAlias(input *CreateAliasInput) (*CreateAliasOutput, error) {
 	return out, err
 }
 
+// CreateAliasWithContext is the same as CreateAlias with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) CreateAliasWithContext(ctx aws.Context, input *CreateAliasInput) (*CreateAliasOutput, error) {
+	req, out := c.CreateAliasRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateGrant = "CreateGrant"
 
 // CreateGrantRequest generates a request for the CreateGrant operation.
@@ -78,6 +89,16 @@ func (c *KMS) CreateGrant(input *CreateGrantInput) (*CreateGrantOutput, error) {
 	return out, err
 }
 
+// CreateGrantWithContext is the same as CreateGrant with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) CreateGrantWithContext(ctx aws.Context, input *CreateGrantInput) (*CreateGrantOutput, error) {
+	req, out := c.CreateGrantRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateKey = "CreateKey"
 
 // CreateKeyRequest generates a request for the CreateKey operation.
@@ -108,6 +129,16 @@ func (c *KMS) CreateKey(input *CreateKeyInput) (*CreateKeyOutput, error) {
 	return out, err
 }
 
+// CreateKeyWithContext is the same as CreateKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) CreateKeyWithContext(ctx aws.Context, input *CreateKeyInput) (*CreateKeyOutput, error) {
+	req, out := c.CreateKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDecrypt = "Decrypt"
 
 // DecryptRequest generates a request for the Decrypt operation.
@@ -146,6 +177,16 @@ func (c *KMS) Decrypt(input *DecryptInput) (*DecryptOutput, error) {
 	return out, err
 }
 
+// DecryptWithContext is the same as Decrypt with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) DecryptWithContext(ctx aws.Context, input *DecryptInput) (*DecryptOutput, error) {
+	req, out := c.DecryptRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAlias = "DeleteAlias"
 
 // DeleteAliasRequest generates a request for the DeleteAlias operation.
@@ -174,6 +215,16 @@ func (c *KMS) DeleteAlias(input *DeleteAliasInput) (*DeleteAliasOutput, error) {
 	return out, err
 }
 
+// DeleteAliasWithContext is the same as DeleteAlias with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) DeleteAliasWithContext(ctx aws.Context, input *DeleteAliasInput) (*DeleteAliasOutput, error) {
+	req, out := c.DeleteAliasRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeKey = "DescribeKey"
 
 // DescribeKeyRequest generates a request for the DescribeKey operation.
@@ -201,6 +252,16 @@ func (c *KMS) DescribeKey(input *DescribeKeyInput) (*DescribeKeyOutput, error) {
 	return out, err
 }
 
+// DescribeKeyWithContext is the same as DescribeKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) DescribeKeyWithContext(ctx aws.Context, input *DescribeKeyInput) (*DescribeKeyOutput, error) {
+	req, out := c.DescribeKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableKey = "DisableKey"
 
 // DisableKeyRequest generates a request for the DisableKey operation.
@@ -228,6 +289,16 @@ func (c *KMS) DisableKey(input *DisableKeyInput) (*DisableKeyOutput, error) {
 	return out, err
 }
 
+// DisableKeyWithContext is the same as DisableKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) DisableKeyWithContext(ctx aws.Context, input *DisableKeyInput) (*DisableKeyOutput, error) {
+	req, out := c.DisableKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableKeyRotation = "DisableKeyRotation"
 
 // DisableKeyRotationRequest generates a request for the DisableKeyRotation operation.
@@ -255,6 +326,16 @@ func (c *KMS) DisableKeyRotation(input *DisableKeyRotationInput) (*DisableKeyRot
 	return out, err
 }
 
+// DisableKeyRotationWithContext is the same as DisableKeyRotation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) DisableKeyRotationWithContext(ctx aws.Context, input *DisableKeyRotationInput) (*DisableKeyRotationOutput, error) {
+	req, out := c.DisableKeyRotationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableKey = "EnableKey"
 
 // EnableKeyRequest generates a request for the EnableKey operation.
@@ -283,6 +364,16 @@ func (c *KMS) EnableKey(input *EnableKeyInput) (*EnableKeyOutput, error) {
 	return out, err
 }
 
+// EnableKeyWithContext is the same as EnableKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) EnableKeyWithContext(ctx aws.Context, input *EnableKeyInput) (*EnableKeyOutput, error) {
+	req, out := c.EnableKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableKeyRotation = "EnableKeyRotation"
 
 // EnableKeyRotationRequest generates a request for the EnableKeyRotation operation.
@@ -310,6 +401,16 @@ func (c *KMS) EnableKeyRotation(input *EnableKeyRotationInput) (*EnableKeyRotati
 	return out, err
 }
 
+// EnableKeyRotationWithContext is the same as EnableKeyRotation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) EnableKeyRotationWithContext(ctx aws.Context, input *EnableKeyRotationInput) (*EnableKeyRotationOutput, error) {
+	req, out := c.EnableKeyRotationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEncrypt = "Encrypt"
 
 // EncryptRequest generates a request for the Encrypt operation.
@@ -353,6 +454,16 @@ func (c *KMS) Encrypt(input *EncryptInput) (*EncryptOutput, error) {
 	return out, err
 }
 
+// EncryptWithContext is the same as Encrypt with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) EncryptWithContext(ctx aws.Context, input *EncryptInput) (*EncryptOutput, error) {
+	req, out := c.EncryptRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateDataKey = "GenerateDataKey"
 
 // GenerateDataKeyRequest generates a request for the GenerateDataKey operation.
@@ -409,6 +520,16 @@ func (c *KMS) GenerateDataKey(input *GenerateDataKeyInput) (*GenerateDataKeyOutp
 	return out, err
 }
 
+// GenerateDataKeyWithContext is the same as GenerateDataKey with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) GenerateDataKeyWithContext(ctx aws.Context, input *GenerateDataKeyInput) (*GenerateDataKeyOutput, error) {
+	req, out := c.GenerateDataKeyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateDataKeyWithoutPlaintext = "GenerateDataKeyWithoutPlaintext"
 
 // GenerateDataKeyWithoutPlaintextRequest generates a request for the GenerateDataKeyWithoutPlaintext operation.
@@ -440,6 +561,16 @@ func (c *KMS) GenerateDataKeyWithoutPlaintext(input *GenerateDataKeyWithoutPlain
 	return out, err
 }
 
+// GenerateDataKeyWithoutPlaintextWithContext is the same as GenerateDataKeyWithoutPlaintext with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) GenerateDataKeyWithoutPlaintextWithContext(ctx aws.Context, input *GenerateDataKeyWithoutPlaintextInput) (*GenerateDataKeyWithoutPlaintextOutput, error) {
+	req, out := c.GenerateDataKeyWithoutPlaintextRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGenerateRandom = "GenerateRandom"
 
 // GenerateRandomRequest generates a request for the GenerateRandom operation.
@@ -467,6 +598,16 @@ func (c *KMS) GenerateRandom(input *GenerateRandomInput) (*GenerateRandomOutput,
 	return out, err
 }
 
+// GenerateRandomWithContext is the same as GenerateRandom with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) GenerateRandomWithContext(ctx aws.Context, input *GenerateRandomInput) (*GenerateRandomOutput, error) {
+	req, out := c.GenerateRandomRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetKeyPolicy = "GetKeyPolicy"
 
 // GetKeyPolicyRequest generates a request for the GetKeyPolicy operation.
@@ -494,6 +635,16 @@ func (c *KMS) GetKeyPolicy(input *GetKeyPolicyInput) (*GetKeyPolicyOutput, error
 	return out, err
 }
 
+// GetKeyPolicyWithContext is the same as GetKeyPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) GetKeyPolicyWithContext(ctx aws.Context, input *GetKeyPolicyInput) (*GetKeyPolicyOutput, error) {
+	req, out := c.GetKeyPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetKeyRotationStatus = "GetKeyRotationStatus"
 
 // GetKeyRotationStatusRequest generates a request for the GetKeyRotationStatus operation.
@@ -522,6 +673,16 @@ func (c *KMS) GetKeyRotationStatus(input *GetKeyRotationStatusInput) (*GetKeyRot
 	return out, err
 }
 
+// GetKeyRotationStatusWithContext is the same as GetKeyRotationStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) GetKeyRotationStatusWithContext(ctx aws.Context, input *GetKeyRotationStatusInput) (*GetKeyRotationStatusOutput, error) {
+	req, out := c.GetKeyRotationStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListAliases = "ListAliases"
 
 // ListAliasesRequest generates a request for the ListAliases operation.
@@ -555,6 +716,16 @@ func (c *KMS) ListAliases(input *ListAliasesInput) (*ListAliasesOutput, error) {
 	return out, err
 }
 
+// ListAliasesWithContext is the same as ListAliases with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) ListAliasesWithContext(ctx aws.Context, input *ListAliasesInput) (*ListAliasesOutput, error) {
+	req, out := c.ListAliasesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *KMS) ListAliasesPages(input *ListAliasesInput, fn func(p *ListAliasesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListAliasesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -595,6 +766,16 @@ func (c *KMS) ListGrants(input *ListGrantsInput) (*ListGrantsOutput, error) {
 	return out, err
 }
 
+// ListGrantsWithContext is the same as ListGrants with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) ListGrantsWithContext(ctx aws.Context, input *ListGrantsInput) (*ListGrantsOutput, error) {
+	req, out := c.ListGrantsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *KMS) ListGrantsPages(input *ListGrantsInput, fn func(p *ListGrantsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGrantsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -635,6 +816,16 @@ func (c *KMS) ListKeyPolicies(input *ListKeyPoliciesInput) (*ListKeyPoliciesOutp
 	return out, err
 }
 
+// ListKeyPoliciesWithContext is the same as ListKeyPolicies with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) ListKeyPoliciesWithContext(ctx aws.Context, input *ListKeyPoliciesInput) (*ListKeyPoliciesOutput, error) {
+	req, out := c.ListKeyPoliciesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *KMS) ListKeyPoliciesPages(input *ListKeyPoliciesInput, fn func(p *ListKeyPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListKeyPoliciesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -675,6 +866,16 @@ func (c *KMS) ListKeys(input *ListKeysInput) (*ListKeysOutput, error) {
 	return out, err
 }
 
+// ListKeysWithContext is the same as ListKeys with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) ListKeysWithContext(ctx aws.Context, input *ListKeysInput) (*ListKeysOutput, error) {
+	req, out := c.ListKeysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *KMS) ListKeysPages(input *ListKeysInput, fn func(p *ListKeysOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListKeysRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -709,6 +910,16 @@ func (c *KMS) PutKeyPolicy(input *PutKeyPolicyInput) (*PutKeyPolicyOutput, error
 	return out, err
 }
 
+// PutKeyPolicyWithContext is the same as PutKeyPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) PutKeyPolicyWithContext(ctx aws.Context, input *PutKeyPolicyInput) (*PutKeyPolicyOutput, error) {
+	req, out := c.PutKeyPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReEncrypt = "ReEncrypt"
 
 // ReEncryptRequest generates a request for the ReEncrypt operation.
@@ -747,6 +958,16 @@ func (c *KMS) ReEncrypt(input *ReEncryptInput) (*ReEncryptOutput, error) {
 	return out, err
 }
 
+// ReEncryptWithContext is the same as ReEncrypt with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) ReEncryptWithContext(ctx aws.Context, input *ReEncryptInput) (*ReEncryptOutput, error) {
+	req, out := c.ReEncryptRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRetireGrant = "RetireGrant"
 
 // RetireGrantRequest generates a request for the RetireGrant operation.
@@ -782,6 +1003,16 @@ func (c *KMS) RetireGrant(input *RetireGrantInput) (*RetireGrantOutput, error) {
 	return out, err
 }
 
+// RetireGrantWithContext is the same as RetireGrant with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) RetireGrantWithContext(ctx aws.Context, input *RetireGrantInput) (*RetireGrantOutput, error) {
+	req, out := c.RetireGrantRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRevokeGrant = "RevokeGrant"
 
 // RevokeGrantRequest generates a request for the RevokeGrant operation.
@@ -810,6 +1041,16 @@ func (c *KMS) RevokeGrant(input *RevokeGrantInput) (*RevokeGrantOutput, error) {
 	return out, err
 }
 
+// RevokeGrantWithContext is the same as RevokeGrant with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) RevokeGrantWithContext(ctx aws.Context, input *RevokeGrantInput) (*RevokeGrantOutput, error) {
+	req, out := c.RevokeGrantRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAlias = "UpdateAlias"
 
 // UpdateAliasRequest generates a request for the UpdateAlias operation.
@@ -849,6 +1090,16 @@ func (c *KMS) UpdateAlias(input *UpdateAliasInput) (*UpdateAliasOutput, error) {
 	return out, err
 }
 
+// UpdateAliasWithContext is the same as UpdateAlias with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) UpdateAliasWithContext(ctx aws.Context, input *UpdateAliasInput) (*UpdateAliasOutput, error) {
+	req, out := c.UpdateAliasRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateKeyDescription = "UpdateKeyDescription"
 
 // UpdateKeyDescriptionRequest generates a request for the UpdateKeyDescription operation.
@@ -876,16 +1127,26 @@ func (c *KMS) UpdateKeyDescription(input *UpdateKeyDescriptionInput) (*UpdateKey
 	return out, err
 }
 
+// UpdateKeyDescriptionWithContext is the same as UpdateKeyDescription with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *KMS) UpdateKeyDescriptionWithContext(ctx aws.Context, input *UpdateKeyDescriptionInput) (*UpdateKeyDescriptionOutput, error) {
+	req, out := c.UpdateKeyDescriptionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Contains information about an alias.
 type AliasListEntry struct {
 	// String that contains the key ARN.
-	AliasARN *string `locationName:"AliasArn" type:"string"`
+	AliasARN *string `locationName:"AliasArn" type:"string" min:"20" max:"2048"`
 
 	// String that contains the alias.
-	AliasName *string `type:"string"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$"`
 
 	// String that contains the key identifier pointed to by the alias.
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256"`
 
 	metadataAliasListEntry `json:"-" xml:"-"`
 }
@@ -894,17 +1155,27 @@ type metadataAliasListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AliasListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AliasListEntry) GoString() string {
+	return s.String()
+}
+
 type CreateAliasInput struct {
 	// String that contains the display name. The name must start with the word
 	// "alias" followed by a forward slash (alias/). Aliases that begin with "alias/AWS"
 	// are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$" required:"true"`
 
 	// An identifier of the key for which you are creating the alias. This value
 	// cannot be another alias but can be a globally unique identifier or a fully
 	// specified ARN to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string" required:"true"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCreateAliasInput `json:"-" xml:"-"`
 }
@@ -913,6 +1184,16 @@ type metadataCreateAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAliasInput) GoString() string {
+	return s.String()
+}
+
 type CreateAliasOutput struct {
 	metadataCreateAliasOutput `json:"-" xml:"-"`
 }
@@ -921,23 +1202,33 @@ type metadataCreateAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAliasOutput) GoString() string {
+	return s.String()
+}
+
 type CreateGrantInput struct {
 	// Specifies the conditions under which the actions specified by the Operations
 	// parameter are allowed.
 	Constraints *GrantConstraints `type:"structure"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// Principal given permission by the grant to use the key identified by the
 	// keyId parameter.
-	GranteePrincipal *string `type:"string" required:"true"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -946,7 +1237,7 @@ type CreateGrantInput struct {
 
 	// Principal given permission to retire the grant. For more information, see
 	// RetireGrant.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataCreateGrantInput `json:"-" xml:"-"`
 }
@@ -955,12 +1246,22 @@ type metadataCreateGrantInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateGrantInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateGrantInput) GoString() string {
+	return s.String()
+}
+
 type CreateGrantOutput struct {
 	// Unique grant identifier. You can use the GrantId value to revoke a grant.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantToken *string `type:"string"`
+	GrantToken *string `type:"string" min:"1" max:"8192"`
 
 	metadataCreateGrantOutput `json:"-" xml:"-"`
 }
@@ -969,18 +1270,30 @@ type metadataCreateGrantOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateGrantOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateGrantOutput) GoString() string {
+	return s.String()
+}
+
 type CreateKeyInput struct {
 	// Description of the key. We recommend that you choose a description that helps
 	// your customer decide whether the key is appropriate for a task.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"8192"`
 
 	// Specifies the intended use of the key. Currently this defaults to ENCRYPT/DECRYPT,
 	// and only symmetric encryption and decryption are supported.
-	KeyUsage *string `type:"string"`
+	//
+	// See the KeyUsageType* constants for valid values.
+	KeyUsage *string `type:"string" enum:"ENCRYPT_DECRYPT"`
 
 	// Policy to be attached to the key. This is required and delegates back to
 	// the account. The key is the root of trust.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	metadataCreateKeyInput `json:"-" xml:"-"`
 }
@@ -989,6 +1302,16 @@ type metadataCreateKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateKeyInput) GoString() string {
+	return s.String()
+}
+
 type CreateKeyOutput struct {
 	// Metadata associated with the key.
 	KeyMetadata *KeyMetadata `type:"structure"`
@@ -1000,9 +1323,19 @@ type metadataCreateKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateKeyOutput) GoString() string {
+	return s.String()
+}
+
 type DecryptInput struct {
 	// Ciphertext to be decrypted. The blob includes metadata.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// The encryption context. If this was specified in the Encrypt function, it
 	// must be specified here or the decryption operation will fail. For more information,
@@ -1010,7 +1343,7 @@ type DecryptInput struct {
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	metadataDecryptInput `json:"-" xml:"-"`
 }
@@ -1019,14 +1352,24 @@ type metadataDecryptInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecryptInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecryptInput) GoString() string {
+	return s.String()
+}
+
 type DecryptOutput struct {
 	// ARN of the key used to perform the decryption. This value is returned if
 	// no errors are encountered during the operation.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Decrypted plaintext data. This value may not be returned if the customer
 	// master key is not available or if you didn't have permission to use it.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true"`
 
 	metadataDecryptOutput `json:"-" xml:"-"`
 }
@@ -1035,10 +1378,20 @@ type metadataDecryptOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecryptOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecryptOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteAliasInput struct {
 	// The alias to be deleted. The name must start with the word "alias" followed
 	// by a forward slash (alias/). Aliases that begin with "alias/AWS" are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$" required:"true"`
 
 	metadataDeleteAliasInput `json:"-" xml:"-"`
 }
@@ -1047,6 +1400,16 @@ type metadataDeleteAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAliasInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAliasOutput struct {
 	metadataDeleteAliasOutput `json:"-" xml:"-"`
 }
@@ -1055,6 +1418,16 @@ type metadataDeleteAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAliasOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeKeyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1062,7 +1435,7 @@ type DescribeKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeKeyInput `json:"-" xml:"-"`
 }
@@ -1071,6 +1444,16 @@ type metadataDescribeKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeKeyInput) GoString() string {
+	return s.String()
+}
+
 type DescribeKeyOutput struct {
 	// Metadata associated with the key.
 	KeyMetadata *KeyMetadata `type:"structure"`
@@ -1082,12 +1465,22 @@ type metadataDescribeKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeKeyOutput) GoString() string {
+	return s.String()
+}
+
 type DisableKeyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyInput `json:"-" xml:"-"`
 }
@@ -1096,6 +1489,16 @@ type metadataDisableKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableKeyInput) GoString() string {
+	return s.String()
+}
+
 type DisableKeyOutput struct {
 	metadataDisableKeyOutput `json:"-" xml:"-"`
 }
@@ -1104,12 +1507,22 @@ type metadataDisableKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableKeyOutput) GoString() string {
+	return s.String()
+}
+
 type DisableKeyRotationInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyRotationInput `json:"-" xml:"-"`
 }
@@ -1118,6 +1531,16 @@ type metadataDisableKeyRotationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableKeyRotationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableKeyRotationInput) GoString() string {
+	return s.String()
+}
+
 type DisableKeyRotationOutput struct {
 	metadataDisableKeyRotationOutput `json:"-" xml:"-"`
 }
@@ -1126,12 +1549,22 @@ type metadataDisableKeyRotationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableKeyRotationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableKeyRotationOutput) GoString() string {
+	return s.String()
+}
+
 type EnableKeyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyInput `json:"-" xml:"-"`
 }
@@ -1140,6 +1573,16 @@ type metadataEnableKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableKeyInput) GoString() string {
+	return s.String()
+}
+
 type EnableKeyOutput struct {
 	metadataEnableKeyOutput `json:"-" xml:"-"`
 }
@@ -1148,12 +1591,22 @@ type metadataEnableKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableKeyOutput) GoString() string {
+	return s.String()
+}
+
 type EnableKeyRotationInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyRotationInput `json:"-" xml:"-"`
 }
@@ -1162,6 +1615,16 @@ type metadataEnableKeyRotationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableKeyRotationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableKeyRotationInput) GoString() string {
+	return s.String()
+}
+
 type EnableKeyRotationOutput struct {
 	metadataEnableKeyRotationOutput `json:"-" xml:"-"`
 }
@@ -1170,6 +1633,16 @@ type metadataEnableKeyRotationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableKeyRotationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableKeyRotationOutput) GoString() string {
+	return s.String()
+}
+
 type EncryptInput struct {
 	// Name/value pair that specifies the encryption context to be used for authenticated
 	// encryption. If used here, the same value must be supplied to the Decrypt
@@ -1178,7 +1651,7 @@ type EncryptInput struct {
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1186,10 +1659,10 @@ type EncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to be encrypted.
-	Plaintext []byte `type:"blob" required:"true"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true" required:"true"`
 
 	metadataEncryptInput `json:"-" xml:"-"`
 }
@@ -1198,13 +1671,23 @@ type metadataEncryptInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EncryptInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EncryptInput) GoString() string {
+	return s.String()
+}
+
 type EncryptOutput struct {
 	// The encrypted plaintext. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// The ID of the key used during encryption.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataEncryptOutput `json:"-" xml:"-"`
 }
@@ -1213,6 +1696,16 @@ type metadataEncryptOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EncryptOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EncryptOutput) GoString() string {
+	return s.String()
+}
+
 type GenerateDataKeyInput struct {
 	// Name/value pair that contains additional data to be authenticated during
 	// the encryption and decryption processes that use the key. This value is logged
@@ -1220,7 +1713,7 @@ type GenerateDataKeyInput struct {
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1228,16 +1721,18 @@ type GenerateDataKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size to generate a
 	// data key for. Currently this can be AES_128 or AES_256.
-	KeySpec *string `type:"string"`
+	//
+	// See the DataKeySpec* constants for valid values.
+	KeySpec *string `type:"string" enum:"AES_256,AES_128"`
 
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, and 1024. 1024 is the current limit. We recommend that you
 	// use the KeySpec parameter instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyInput `json:"-" xml:"-"`
 }
@@ -1246,6 +1741,16 @@ type metadataGenerateDataKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateDataKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateDataKeyInput) GoString() string {
+	return s.String()
+}
+
 type GenerateDataKeyOutput struct {
 	// Ciphertext that contains the encrypted data key. You must store the blob
 	// and enough information to reconstruct the encryption context so that the
@@ -1255,15 +1760,15 @@ type GenerateDataKeyOutput struct {
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Plaintext that contains the data key. Use this for encryption and decryption
 	// and then remove it from memory as soon as possible.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true"`
 
 	metadataGenerateDataKeyOutput `json:"-" xml:"-"`
 }
@@ -1272,13 +1777,23 @@ type metadataGenerateDataKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateDataKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateDataKeyOutput) GoString() string {
+	return s.String()
+}
+
 type GenerateDataKeyWithoutPlaintextInput struct {
 	// Name:value pair that contains additional data to be authenticated during
 	// the encryption and decryption processes.
 	EncryptionContext map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1286,16 +1801,18 @@ type GenerateDataKeyWithoutPlaintextInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size. Currently this
 	// can be AES_128 or AES_256.
-	KeySpec *string `type:"string"`
+	//
+	// See the DataKeySpec* constants for valid values.
+	KeySpec *string `type:"string" enum:"AES_256,AES_128"`
 
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. We recommend that you use the KeySpec parameter
 	// instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyWithoutPlaintextInput `json:"-" xml:"-"`
 }
@@ -1304,17 +1821,27 @@ type metadataGenerateDataKeyWithoutPlaintextInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateDataKeyWithoutPlaintextInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateDataKeyWithoutPlaintextInput) GoString() string {
+	return s.String()
+}
+
 type GenerateDataKeyWithoutPlaintextOutput struct {
 	// Ciphertext that contains the wrapped data key. You must store the blob and
 	// encryption context so that the key can be used in a future decrypt operation.
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataGenerateDataKeyWithoutPlaintextOutput `json:"-" xml:"-"`
 }
@@ -1323,10 +1850,20 @@ type metadataGenerateDataKeyWithoutPlaintextOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateDataKeyWithoutPlaintextOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateDataKeyWithoutPlaintextOutput) GoString() string {
+	return s.String()
+}
+
 type GenerateRandomInput struct {
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. The current limit is 1024 bytes.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateRandomInput `json:"-" xml:"-"`
 }
@@ -1335,9 +1872,19 @@ type metadataGenerateRandomInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateRandomInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateRandomInput) GoString() string {
+	return s.String()
+}
+
 type GenerateRandomOutput struct {
 	// Plaintext that contains the unpredictable byte string.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true"`
 
 	metadataGenerateRandomOutput `json:"-" xml:"-"`
 }
@@ -1346,16 +1893,26 @@ type metadataGenerateRandomOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GenerateRandomOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GenerateRandomOutput) GoString() string {
+	return s.String()
+}
+
 type GetKeyPolicyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// String that contains the name of the policy. Currently, this must be "default".
 	// Policy names can be discovered by calling ListKeyPolicies.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w]+" required:"true"`
 
 	metadataGetKeyPolicyInput `json:"-" xml:"-"`
 }
@@ -1364,9 +1921,19 @@ type metadataGetKeyPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetKeyPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetKeyPolicyInput) GoString() string {
+	return s.String()
+}
+
 type GetKeyPolicyOutput struct {
 	// A policy document in JSON format.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	metadataGetKeyPolicyOutput `json:"-" xml:"-"`
 }
@@ -1375,12 +1942,22 @@ type metadataGetKeyPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetKeyPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetKeyPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type GetKeyRotationStatusInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataGetKeyRotationStatusInput `json:"-" xml:"-"`
 }
@@ -1389,6 +1966,16 @@ type metadataGetKeyRotationStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetKeyRotationStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetKeyRotationStatusInput) GoString() string {
+	return s.String()
+}
+
 type GetKeyRotationStatusOutput struct {
 	// A Boolean value that specifies whether key rotation is enabled.
 	KeyRotationEnabled *bool `type:"boolean"`
@@ -1400,6 +1987,16 @@ type metadataGetKeyRotationStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetKeyRotationStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetKeyRotationStatusOutput) GoString() string {
+	return s.String()
+}
+
 // Contains constraints on the grant.
 type GrantConstraints struct {
 	// The constraint contains additional key/value pairs that serve to further
@@ -1416,6 +2013,16 @@ type metadataGrantConstraints struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GrantConstraints) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GrantConstraints) GoString() string {
+	return s.String()
+}
+
 // Contains information about each entry in the grant list.
 type GrantListEntry struct {
 	// Specifies the conditions under which the actions specified by the Operations
@@ -1423,13 +2030,13 @@ type GrantListEntry struct {
 	Constraints *GrantConstraints `type:"structure"`
 
 	// Unique grant identifier.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// The principal that receives the grant permission.
-	GranteePrincipal *string `type:"string"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256"`
 
 	// The account under which the grant was issued.
-	IssuingAccount *string `type:"string"`
+	IssuingAccount *string `type:"string" min:"1" max:"256"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -1437,7 +2044,7 @@ type GrantListEntry struct {
 	Operations []*string `type:"list"`
 
 	// The principal that can retire the account.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataGrantListEntry `json:"-" xml:"-"`
 }
@@ -1446,13 +2053,23 @@ type metadataGrantListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GrantListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GrantListEntry) GoString() string {
+	return s.String()
+}
+
 // Contains information about each entry in the key list.
 type KeyListEntry struct {
 	// ARN of the key.
-	KeyARN *string `locationName:"KeyArn" type:"string"`
+	KeyARN *string `locationName:"KeyArn" type:"string" min:"20" max:"2048"`
 
 	// Unique identifier of the key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataKeyListEntry `json:"-" xml:"-"`
 }
@@ -1461,10 +2078,20 @@ type metadataKeyListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeyListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeyListEntry) GoString() string {
+	return s.String()
+}
+
 // Contains metadata associated with a specific key.
 type KeyMetadata struct {
 	// Key ARN (Amazon Resource Name).
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// Account ID number.
 	AWSAccountID *string `locationName:"AWSAccountId" type:"string"`
@@ -1473,16 +2100,18 @@ type KeyMetadata struct {
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the key.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"8192"`
 
 	// Value that specifies whether the key is enabled.
 	Enabled *bool `type:"boolean"`
 
 	// Unique identifier for the key.
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// A value that specifies what operation(s) the key can perform.
-	KeyUsage *string `type:"string"`
+	//
+	// See the KeyUsageType* constants for valid values.
+	KeyUsage *string `type:"string" enum:"ENCRYPT_DECRYPT"`
 
 	metadataKeyMetadata `json:"-" xml:"-"`
 }
@@ -1491,16 +2120,26 @@ type metadataKeyMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s KeyMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s KeyMetadata) GoString() string {
+	return s.String()
+}
+
 type ListAliasesInput struct {
 	// Specify this parameter when paginating results to indicate the maximum number
 	// of aliases you want in each response. If there are additional aliases beyond
 	// the maximum you specify, the Truncated response element will be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter when paginating results, and only in a subsequent request
 	// after you've received a response where the results are truncated. Set it
 	// to the value of the NextMarker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListAliasesInput `json:"-" xml:"-"`
 }
@@ -1509,13 +2148,23 @@ type metadataListAliasesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAliasesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAliasesInput) GoString() string {
+	return s.String()
+}
+
 type ListAliasesOutput struct {
 	// A list of key aliases in the user's account.
 	Aliases []*AliasListEntry `type:"list"`
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1529,23 +2178,33 @@ type metadataListAliasesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAliasesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAliasesOutput) GoString() string {
+	return s.String()
+}
+
 type ListGrantsInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of grants you want listed in the response. If there are additional
 	// grants beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListGrantsInput `json:"-" xml:"-"`
 }
@@ -1554,13 +2213,23 @@ type metadataListGrantsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGrantsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGrantsInput) GoString() string {
+	return s.String()
+}
+
 type ListGrantsOutput struct {
 	// A list of grants.
 	Grants []*GrantListEntry `type:"list"`
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1574,6 +2243,16 @@ type metadataListGrantsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGrantsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGrantsOutput) GoString() string {
+	return s.String()
+}
+
 type ListKeyPoliciesInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1581,18 +2260,18 @@ type ListKeyPoliciesInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of policies you want listed in the response. If there are additional
 	// policies beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListKeyPoliciesInput `json:"-" xml:"-"`
 }
@@ -1601,10 +2280,20 @@ type metadataListKeyPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListKeyPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListKeyPoliciesInput) GoString() string {
+	return s.String()
+}
+
 type ListKeyPoliciesOutput struct {
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A list of policy names. Currently, there is only one policy and it is named
 	// "Default".
@@ -1622,17 +2311,27 @@ type metadataListKeyPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListKeyPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListKeyPoliciesOutput) GoString() string {
+	return s.String()
+}
+
 type ListKeysInput struct {
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of keys you want listed in the response. If there are additional keys
 	// beyond the maximum you specify, the Truncated response element will be set
 	// to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	metadataListKeysInput `json:"-" xml:"-"`
 }
@@ -1641,13 +2340,23 @@ type metadataListKeysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListKeysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListKeysInput) GoString() string {
+	return s.String()
+}
+
 type ListKeysOutput struct {
 	// A list of keys.
 	Keys []*KeyListEntry `type:"list"`
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\x{0020}-\\x{00FF}]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1661,19 +2370,29 @@ type metadataListKeysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListKeysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListKeysOutput) GoString() string {
+	return s.String()
+}
+
 type PutKeyPolicyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The policy, in JSON format, to be attached to the key.
-	Policy *string `type:"string" required:"true"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+" required:"true"`
 
 	// Name of the policy to be attached. Currently, the only supported name is
 	// "default".
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w]+" required:"true"`
 
 	metadataPutKeyPolicyInput `json:"-" xml:"-"`
 }
@@ -1682,6 +2401,16 @@ type metadataPutKeyPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutKeyPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutKeyPolicyInput) GoString() string {
+	return s.String()
+}
+
 type PutKeyPolicyOutput struct {
 	metadataPutKeyPolicyOutput `json:"-" xml:"-"`
 }
@@ -1690,9 +2419,19 @@ type metadataPutKeyPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PutKeyPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PutKeyPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type ReEncryptInput struct {
 	// Ciphertext of the data to re-encrypt.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// Encryption context to be used when the data is re-encrypted.
 	DestinationEncryptionContext map[string]*string `type:"map"`
@@ -1704,10 +2443,10 @@ type ReEncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012 Alias
 	// Name Example - alias/MyAliasName
-	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" required:"true"`
+	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" min:"0" max:"10"`
 
 	// Encryption context used to encrypt and decrypt the data specified in the
 	// CiphertextBlob parameter.
@@ -1720,16 +2459,26 @@ type metadataReEncryptInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReEncryptInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReEncryptInput) GoString() string {
+	return s.String()
+}
+
 type ReEncryptOutput struct {
 	// The re-encrypted data. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// Unique identifier of the key used to re-encrypt the data.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Unique identifier of the key used to originally encrypt the data.
-	SourceKeyID *string `locationName:"SourceKeyId" type:"string"`
+	SourceKeyID *string `locationName:"SourceKeyId" type:"string" min:"1" max:"256"`
 
 	metadataReEncryptOutput `json:"-" xml:"-"`
 }
@@ -1738,19 +2487,29 @@ type metadataReEncryptOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReEncryptOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReEncryptOutput) GoString() string {
+	return s.String()
+}
+
 type RetireGrantInput struct {
 	// Unique identifier of the grant to be retired. The grant ID is returned by
 	// the CreateGrant function.  Grant ID Example - 0123456789012345678901234567890123456789012345678901234567890123
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// Token that identifies the grant to be retired.
-	GrantToken *string `type:"string"`
+	GrantToken *string `type:"string" min:"1" max:"8192"`
 
 	// A unique identifier for the customer master key associated with the grant.
 	// This value can be a globally unique identifier or a fully specified ARN of
 	// the key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataRetireGrantInput `json:"-" xml:"-"`
 }
@@ -1759,6 +2518,16 @@ type metadataRetireGrantInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetireGrantInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetireGrantInput) GoString() string {
+	return s.String()
+}
+
 type RetireGrantOutput struct {
 	metadataRetireGrantOutput `json:"-" xml:"-"`
 }
@@ -1767,15 +2536,25 @@ type metadataRetireGrantOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetireGrantOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetireGrantOutput) GoString() string {
+	return s.String()
+}
+
 type RevokeGrantInput struct {
 	// Identifier of the grant to be revoked.
-	GrantID *string `locationName:"GrantId" type:"string" required:"true"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128" required:"true"`
 
 	// A unique identifier for the customer master key associated with the grant.
 	// This value can be a globally unique identifier or the fully specified ARN
 	// to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRevokeGrantInput `json:"-" xml:"-"`
 }
@@ -1784,6 +2563,16 @@ type metadataRevokeGrantInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeGrantInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeGrantInput) GoString() string {
+	return s.String()
+}
+
 type RevokeGrantOutput struct {
 	metadataRevokeGrantOutput `json:"-" xml:"-"`
 }
@@ -1792,17 +2581,27 @@ type metadataRevokeGrantOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RevokeGrantOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RevokeGrantOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateAliasInput struct {
 	// String that contains the name of the alias to be modifed. The name must start
 	// with the word "alias" followed by a forward slash (alias/). Aliases that
 	// begin with "alias/AWS" are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$" required:"true"`
 
 	// Unique identifier of the customer master key to be associated with the alias.
 	// This value can be a globally unique identifier or the fully specified ARN
 	// of a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string" required:"true"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataUpdateAliasInput `json:"-" xml:"-"`
 }
@@ -1811,6 +2610,16 @@ type metadataUpdateAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAliasInput) GoString() string {
+	return s.String()
+}
+
 type UpdateAliasOutput struct {
 	metadataUpdateAliasOutput `json:"-" xml:"-"`
 }
@@ -1819,15 +2628,25 @@ type metadataUpdateAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAliasOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateKeyDescriptionInput struct {
 	// New description for the key.
-	Description *string `type:"string" required:"true"`
+	Description *string `type:"string" min:"0" max:"8192" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataUpdateKeyDescriptionInput `json:"-" xml:"-"`
 }
@@ -1836,6 +2655,16 @@ type metadataUpdateKeyDescriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateKeyDescriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateKeyDescriptionInput) GoString() string {
+	return s.String()
+}
+
 type UpdateKeyDescriptionOutput struct {
 	metadataUpdateKeyDescriptionOutput `json:"-" xml:"-"`
 }
@@ -1843,3 +2672,44 @@ type UpdateKeyDescriptionOutput struct {
 type metadataUpdateKeyDescriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateKeyDescriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateKeyDescriptionOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum DataKeySpec
+	DataKeySpecAes256 = "AES_256"
+	// @enum DataKeySpec
+	DataKeySpecAes128 = "AES_128"
+)
+
+const (
+	// @enum GrantOperation
+	GrantOperationDecrypt = "Decrypt"
+	// @enum GrantOperation
+	GrantOperationEncrypt = "Encrypt"
+	// @enum GrantOperation
+	GrantOperationGenerateDataKey = "GenerateDataKey"
+	// @enum GrantOperation
+	GrantOperationGenerateDataKeyWithoutPlaintext = "GenerateDataKeyWithoutPlaintext"
+	// @enum GrantOperation
+	GrantOperationReEncryptFrom = "ReEncryptFrom"
+	// @enum GrantOperation
+	GrantOperationReEncryptTo = "ReEncryptTo"
+	// @enum GrantOperation
+	GrantOperationCreateGrant = "CreateGrant"
+	// @enum GrantOperation
+	GrantOperationRetireGrant = "RetireGrant"
+)
+
+const (
+	// @enum KeyUsageType
+	KeyUsageTypeEncryptDecrypt = "ENCRYPT_DECRYPT"
+)
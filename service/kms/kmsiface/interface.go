@@ -4,6 +4,7 @@
 package kmsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 )
 
@@ -11,53 +12,105 @@ import (
 type KMSAPI interface {
 	CreateAlias(*kms.CreateAliasInput) (*kms.CreateAliasOutput, error)
 
+	CreateAliasWithContext(aws.Context, *kms.CreateAliasInput) (*kms.CreateAliasOutput, error)
+
 	CreateGrant(*kms.CreateGrantInput) (*kms.CreateGrantOutput, error)
 
+	CreateGrantWithContext(aws.Context, *kms.CreateGrantInput) (*kms.CreateGrantOutput, error)
+
 	CreateKey(*kms.CreateKeyInput) (*kms.CreateKeyOutput, error)
 
+	CreateKeyWithContext(aws.Context, *kms.CreateKeyInput) (*kms.CreateKeyOutput, error)
+
 	Decrypt(*kms.DecryptInput) (*kms.DecryptOutput, error)
 
+	DecryptWithContext(aws.Context, *kms.DecryptInput) (*kms.DecryptOutput, error)
+
 	DeleteAlias(*kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error)
 
+	DeleteAliasWithContext(aws.Context, *kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error)
+
 	DescribeKey(*kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error)
 
+	DescribeKeyWithContext(aws.Context, *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error)
+
 	DisableKey(*kms.DisableKeyInput) (*kms.DisableKeyOutput, error)
 
+	DisableKeyWithContext(aws.Context, *kms.DisableKeyInput) (*kms.DisableKeyOutput, error)
+
 	DisableKeyRotation(*kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error)
 
+	DisableKeyRotationWithContext(aws.Context, *kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error)
+
 	EnableKey(*kms.EnableKeyInput) (*kms.EnableKeyOutput, error)
 
+	EnableKeyWithContext(aws.Context, *kms.EnableKeyInput) (*kms.EnableKeyOutput, error)
+
 	EnableKeyRotation(*kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error)
 
+	EnableKeyRotationWithContext(aws.Context, *kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error)
+
 	Encrypt(*kms.EncryptInput) (*kms.EncryptOutput, error)
 
+	EncryptWithContext(aws.Context, *kms.EncryptInput) (*kms.EncryptOutput, error)
+
 	GenerateDataKey(*kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error)
 
+	GenerateDataKeyWithContext(aws.Context, *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error)
+
 	GenerateDataKeyWithoutPlaintext(*kms.GenerateDataKeyWithoutPlaintextInput) (*kms.GenerateDataKeyWithoutPlaintextOutput, error)
 
+	GenerateDataKeyWithoutPlaintextWithContext(aws.Context, *kms.GenerateDataKeyWithoutPlaintextInput) (*kms.GenerateDataKeyWithoutPlaintextOutput, error)
+
 	GenerateRandom(*kms.GenerateRandomInput) (*kms.GenerateRandomOutput, error)
 
+	GenerateRandomWithContext(aws.Context, *kms.GenerateRandomInput) (*kms.GenerateRandomOutput, error)
+
 	GetKeyPolicy(*kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error)
 
+	GetKeyPolicyWithContext(aws.Context, *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error)
+
 	GetKeyRotationStatus(*kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error)
 
+	GetKeyRotationStatusWithContext(aws.Context, *kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error)
+
 	ListAliases(*kms.ListAliasesInput) (*kms.ListAliasesOutput, error)
 
+	ListAliasesWithContext(aws.Context, *kms.ListAliasesInput) (*kms.ListAliasesOutput, error)
+
 	ListGrants(*kms.ListGrantsInput) (*kms.ListGrantsOutput, error)
 
+	ListGrantsWithContext(aws.Context, *kms.ListGrantsInput) (*kms.ListGrantsOutput, error)
+
 	ListKeyPolicies(*kms.ListKeyPoliciesInput) (*kms.ListKeyPoliciesOutput, error)
 
+	ListKeyPoliciesWithContext(aws.Context, *kms.ListKeyPoliciesInput) (*kms.ListKeyPoliciesOutput, error)
+
 	ListKeys(*kms.ListKeysInput) (*kms.ListKeysOutput, error)
 
+	ListKeysWithContext(aws.Context, *kms.ListKeysInput) (*kms.ListKeysOutput, error)
+
 	PutKeyPolicy(*kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error)
 
+	PutKeyPolicyWithContext(aws.Context, *kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error)
+
 	ReEncrypt(*kms.ReEncryptInput) (*kms.ReEncryptOutput, error)
 
+	ReEncryptWithContext(aws.Context, *kms.ReEncryptInput) (*kms.ReEncryptOutput, error)
+
 	RetireGrant(*kms.RetireGrantInput) (*kms.RetireGrantOutput, error)
 
+	RetireGrantWithContext(aws.Context, *kms.RetireGrantInput) (*kms.RetireGrantOutput, error)
+
 	RevokeGrant(*kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error)
 
+	RevokeGrantWithContext(aws.Context, *kms.RevokeGrantInput) (*kms.RevokeGrantOutput, error)
+
 	UpdateAlias(*kms.UpdateAliasInput) (*kms.UpdateAliasOutput, error)
 
+	UpdateAliasWithContext(aws.Context, *kms.UpdateAliasInput) (*kms.UpdateAliasOutput, error)
+
 	UpdateKeyDescription(*kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error)
+
+	UpdateKeyDescriptionWithContext(aws.Context, *kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error)
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddPermission = "AddPermission"
@@ -46,6 +47,16 @@ func (c *Lambda) AddPermission(input *AddPermissionInput) (*AddPermissionOutput,
 	return out, err
 }
 
+// AddPermissionWithContext is the same as AddPermission with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) AddPermissionWithContext(ctx aws.Context, input *AddPermissionInput) (*AddPermissionOutput, error) {
+	req, out := c.AddPermissionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateEventSourceMapping = "CreateEventSourceMapping"
 
 // CreateEventSourceMappingRequest generates a request for the CreateEventSourceMapping operation.
@@ -91,6 +102,16 @@ func (c *Lambda) CreateEventSourceMapping(input *CreateEventSourceMappingInput)
 	return out, err
 }
 
+// CreateEventSourceMappingWithContext is the same as CreateEventSourceMapping with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) CreateEventSourceMappingWithContext(ctx aws.Context, input *CreateEventSourceMappingInput) (*EventSourceMappingConfiguration, error) {
+	req, out := c.CreateEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateFunction = "CreateFunction"
 
 // CreateFunctionRequest generates a request for the CreateFunction operation.
@@ -123,6 +144,16 @@ func (c *Lambda) CreateFunction(input *CreateFunctionInput) (*FunctionConfigurat
 	return out, err
 }
 
+// CreateFunctionWithContext is the same as CreateFunction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) CreateFunctionWithContext(ctx aws.Context, input *CreateFunctionInput) (*FunctionConfiguration, error) {
+	req, out := c.CreateFunctionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteEventSourceMapping = "DeleteEventSourceMapping"
 
 // DeleteEventSourceMappingRequest generates a request for the DeleteEventSourceMapping operation.
@@ -154,6 +185,16 @@ func (c *Lambda) DeleteEventSourceMapping(input *DeleteEventSourceMappingInput)
 	return out, err
 }
 
+// DeleteEventSourceMappingWithContext is the same as DeleteEventSourceMapping with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) DeleteEventSourceMappingWithContext(ctx aws.Context, input *DeleteEventSourceMappingInput) (*EventSourceMappingConfiguration, error) {
+	req, out := c.DeleteEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteFunction = "DeleteFunction"
 
 // DeleteFunctionRequest generates a request for the DeleteFunction operation.
@@ -186,6 +227,16 @@ func (c *Lambda) DeleteFunction(input *DeleteFunctionInput) (*DeleteFunctionOutp
 	return out, err
 }
 
+// DeleteFunctionWithContext is the same as DeleteFunction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) DeleteFunctionWithContext(ctx aws.Context, input *DeleteFunctionInput) (*DeleteFunctionOutput, error) {
+	req, out := c.DeleteFunctionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetEventSourceMapping = "GetEventSourceMapping"
 
 // GetEventSourceMappingRequest generates a request for the GetEventSourceMapping operation.
@@ -217,6 +268,16 @@ func (c *Lambda) GetEventSourceMapping(input *GetEventSourceMappingInput) (*Even
 	return out, err
 }
 
+// GetEventSourceMappingWithContext is the same as GetEventSourceMapping with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) GetEventSourceMappingWithContext(ctx aws.Context, input *GetEventSourceMappingInput) (*EventSourceMappingConfiguration, error) {
+	req, out := c.GetEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetFunction = "GetFunction"
 
 // GetFunctionRequest generates a request for the GetFunction operation.
@@ -250,6 +311,16 @@ func (c *Lambda) GetFunction(input *GetFunctionInput) (*GetFunctionOutput, error
 	return out, err
 }
 
+// GetFunctionWithContext is the same as GetFunction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) GetFunctionWithContext(ctx aws.Context, input *GetFunctionInput) (*GetFunctionOutput, error) {
+	req, out := c.GetFunctionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetFunctionConfiguration = "GetFunctionConfiguration"
 
 // GetFunctionConfigurationRequest generates a request for the GetFunctionConfiguration operation.
@@ -282,6 +353,16 @@ func (c *Lambda) GetFunctionConfiguration(input *GetFunctionConfigurationInput)
 	return out, err
 }
 
+// GetFunctionConfigurationWithContext is the same as GetFunctionConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) GetFunctionConfigurationWithContext(ctx aws.Context, input *GetFunctionConfigurationInput) (*FunctionConfiguration, error) {
+	req, out := c.GetFunctionConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPolicy = "GetPolicy"
 
 // GetPolicyRequest generates a request for the GetPolicy operation.
@@ -312,6 +393,16 @@ func (c *Lambda) GetPolicy(input *GetPolicyInput) (*GetPolicyOutput, error) {
 	return out, err
 }
 
+// GetPolicyWithContext is the same as GetPolicy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) GetPolicyWithContext(ctx aws.Context, input *GetPolicyInput) (*GetPolicyOutput, error) {
+	req, out := c.GetPolicyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInvoke = "Invoke"
 
 // InvokeRequest generates a request for the Invoke operation.
@@ -341,6 +432,16 @@ func (c *Lambda) Invoke(input *InvokeInput) (*InvokeOutput, error) {
 	return out, err
 }
 
+// InvokeWithContext is the same as Invoke with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) InvokeWithContext(ctx aws.Context, input *InvokeInput) (*InvokeOutput, error) {
+	req, out := c.InvokeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opInvokeAsync = "InvokeAsync"
 
 // InvokeAsyncRequest generates a request for the InvokeAsync operation.
@@ -373,6 +474,16 @@ func (c *Lambda) InvokeAsync(input *InvokeAsyncInput) (*InvokeAsyncOutput, error
 	return out, err
 }
 
+// InvokeAsyncWithContext is the same as InvokeAsync with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) InvokeAsyncWithContext(ctx aws.Context, input *InvokeAsyncInput) (*InvokeAsyncOutput, error) {
+	req, out := c.InvokeAsyncRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListEventSourceMappings = "ListEventSourceMappings"
 
 // ListEventSourceMappingsRequest generates a request for the ListEventSourceMappings operation.
@@ -414,6 +525,16 @@ func (c *Lambda) ListEventSourceMappings(input *ListEventSourceMappingsInput) (*
 	return out, err
 }
 
+// ListEventSourceMappingsWithContext is the same as ListEventSourceMappings with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) ListEventSourceMappingsWithContext(ctx aws.Context, input *ListEventSourceMappingsInput) (*ListEventSourceMappingsOutput, error) {
+	req, out := c.ListEventSourceMappingsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Lambda) ListEventSourceMappingsPages(input *ListEventSourceMappingsInput, fn func(p *ListEventSourceMappingsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListEventSourceMappingsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -458,6 +579,16 @@ func (c *Lambda) ListFunctions(input *ListFunctionsInput) (*ListFunctionsOutput,
 	return out, err
 }
 
+// ListFunctionsWithContext is the same as ListFunctions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) ListFunctionsWithContext(ctx aws.Context, input *ListFunctionsInput) (*ListFunctionsOutput, error) {
+	req, out := c.ListFunctionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Lambda) ListFunctionsPages(input *ListFunctionsInput, fn func(p *ListFunctionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListFunctionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -498,6 +629,16 @@ func (c *Lambda) RemovePermission(input *RemovePermissionInput) (*RemovePermissi
 	return out, err
 }
 
+// RemovePermissionWithContext is the same as RemovePermission with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateEventSourceMapping = "UpdateEventSourceMapping"
 
 // UpdateEventSourceMappingRequest generates a request for the UpdateEventSourceMapping operation.
@@ -531,6 +672,16 @@ func (c *Lambda) UpdateEventSourceMapping(input *UpdateEventSourceMappingInput)
 	return out, err
 }
 
+// UpdateEventSourceMappingWithContext is the same as UpdateEventSourceMapping with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) UpdateEventSourceMappingWithContext(ctx aws.Context, input *UpdateEventSourceMappingInput) (*EventSourceMappingConfiguration, error) {
+	req, out := c.UpdateEventSourceMappingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateFunctionCode = "UpdateFunctionCode"
 
 // UpdateFunctionCodeRequest generates a request for the UpdateFunctionCode operation.
@@ -562,6 +713,16 @@ func (c *Lambda) UpdateFunctionCode(input *UpdateFunctionCodeInput) (*FunctionCo
 	return out, err
 }
 
+// UpdateFunctionCodeWithContext is the same as UpdateFunctionCode with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) UpdateFunctionCodeWithContext(ctx aws.Context, input *UpdateFunctionCodeInput) (*FunctionConfiguration, error) {
+	req, out := c.UpdateFunctionCodeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateFunctionConfiguration = "UpdateFunctionConfiguration"
 
 // UpdateFunctionConfigurationRequest generates a request for the UpdateFunctionConfiguration operation.
@@ -595,12 +756,22 @@ func (c *Lambda) UpdateFunctionConfiguration(input *UpdateFunctionConfigurationI
 	return out, err
 }
 
+// UpdateFunctionConfigurationWithContext is the same as UpdateFunctionConfiguration with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Lambda) UpdateFunctionConfigurationWithContext(ctx aws.Context, input *UpdateFunctionConfigurationInput) (*FunctionConfiguration, error) {
+	req, out := c.UpdateFunctionConfigurationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type AddPermissionInput struct {
 	// The AWS Lambda action you want to allow in this statement. Each Lambda action
 	// is a string starting with "lambda:" followed by the API name (see Operations).
 	// For example, "lambda:CreateFunction". You can use wildcard ("lambda:*") to
 	// grant permission for all AWS Lambda actions.
-	Action *string `type:"string" required:"true"`
+	Action *string `type:"string" pattern:"(lambda:[*]|lambda:[a-zA-Z]+|[*])" required:"true"`
 
 	// Name of the Lambda function whose access policy you are updating by adding
 	// a new permission.
@@ -611,7 +782,7 @@ type AddPermissionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// The principal who is getting this permission. It can be Amazon S3 service
 	// Principal ("s3.amazonaws.com") if you want Amazon S3 to invoke the function,
@@ -619,7 +790,7 @@ type AddPermissionInput struct {
 	// AWS service principal such as "sns.amazonaws.com". For example, you might
 	// want to allow a custom application in another AWS account to push events
 	// to AWS Lambda by invoking your function.
-	Principal *string `type:"string" required:"true"`
+	Principal *string `type:"string" pattern:".*" required:"true"`
 
 	// This is optional; however, when granting Amazon S3 permission to invoke your
 	// function, you should specify this field with the bucket Amazon Resource Name
@@ -629,7 +800,7 @@ type AddPermissionInput struct {
 	// If you add a permission for the Amazon S3 principal without providing the
 	// source ARN, any AWS account that creates a mapping to your function ARN can
 	// send events to invoke your Lambda function from Amazon S3.
-	SourceARN *string `locationName:"SourceArn" type:"string"`
+	SourceARN *string `locationName:"SourceArn" type:"string" pattern:"arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)"`
 
 	// The AWS account ID (without a hyphen) of the source owner. For example, if
 	// the SourceArn identifies a bucket, then this is the bucket owner's account
@@ -638,10 +809,10 @@ type AddPermissionInput struct {
 	// bucket and some other AWS account created the bucket). You can also use this
 	// condition to specify all sources (that is, you don't specify the SourceArn)
 	// owned by a specific account.
-	SourceAccount *string `type:"string"`
+	SourceAccount *string `type:"string" pattern:"\\d{12}"`
 
 	// A unique statement identifier.
-	StatementID *string `locationName:"StatementId" type:"string" required:"true"`
+	StatementID *string `locationName:"StatementId" type:"string" min:"1" max:"100" pattern:"([a-zA-Z0-9-_]+)" required:"true"`
 
 	metadataAddPermissionInput `json:"-" xml:"-"`
 }
@@ -650,6 +821,16 @@ type metadataAddPermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddPermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddPermissionInput) GoString() string {
+	return s.String()
+}
+
 type AddPermissionOutput struct {
 	// The permission statement you specified in the request. The response returns
 	// the same as a string using "\" as an escape character in the JSON.
@@ -662,11 +843,21 @@ type metadataAddPermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddPermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddPermissionOutput) GoString() string {
+	return s.String()
+}
+
 type CreateEventSourceMappingInput struct {
 	// The largest number of records that AWS Lambda will retrieve from your event
 	// source at the time of invoking your function. Your function receives an event
 	// with all the retrieved records. The default is 100 records.
-	BatchSize *int64 `type:"integer"`
+	BatchSize *int64 `type:"integer" min:"1" max:"10000"`
 
 	// Indicates whether AWS Lambda should begin polling the event source, the default
 	// is not enabled.
@@ -677,7 +868,7 @@ type CreateEventSourceMappingInput struct {
 	// AWS Lambda to invoke your Lambda function, it depends on the BatchSize. AWS
 	// Lambda POSTs the Amazon Kinesis event, containing records, to your Lambda
 	// function as JSON.
-	EventSourceARN *string `locationName:"EventSourceArn" type:"string" required:"true"`
+	EventSourceARN *string `locationName:"EventSourceArn" type:"string" pattern:"arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)" required:"true"`
 
 	// The Lambda function to invoke when AWS Lambda detects an event on the stream.
 	//
@@ -687,12 +878,14 @@ type CreateEventSourceMappingInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `type:"string" required:"true"`
+	FunctionName *string `type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// The position in the stream where AWS Lambda should start reading. For more
 	// information, go to ShardIteratorType (http://docs.aws.amazon.com/kinesis/latest/APIReference/API_GetShardIterator.html#Kinesis-GetShardIterator-request-ShardIteratorType)
 	// in the Amazon Kinesis API Reference.
-	StartingPosition *string `type:"string" required:"true"`
+	//
+	// See the EventSourcePosition* constants for valid values.
+	StartingPosition *string `type:"string" enum:"TRIM_HORIZON,LATEST" required:"true"`
 
 	metadataCreateEventSourceMappingInput `json:"-" xml:"-"`
 }
@@ -701,13 +894,23 @@ type metadataCreateEventSourceMappingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateEventSourceMappingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateEventSourceMappingInput) GoString() string {
+	return s.String()
+}
+
 type CreateFunctionInput struct {
 	// The code for the Lambda function.
 	Code *FunctionCode `type:"structure" required:"true"`
 
 	// A short, user-defined function description. Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"256"`
 
 	// The name you want to assign to the function you are uploading. You can specify
 	// an unqualified function name (for example, "Thumbnail") or you can specify
@@ -718,13 +921,13 @@ type CreateFunctionInput struct {
 	// 64 character in length. The function names appear in the console and are
 	// returned in the ListFunctions API. Function names are used to specify functions
 	// to other AWS Lambda APIs, such as Invoke.
-	FunctionName *string `type:"string" required:"true"`
+	FunctionName *string `type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// The function within your code that Lambda calls to begin execution. For Node.js,
 	// it is the module-name.export value in your function. For Java, it can be
 	// package.class-name::handler or package.class-name. For more information,
 	// see Lambda Function Handler (Java) (http://docs.aws.amazon.com/lambda/latest/dg/java-programming-model-handler-types.html).
-	Handler *string `type:"string" required:"true"`
+	Handler *string `type:"string" max:"128" pattern:"[^\\s]+" required:"true"`
 
 	// The amount of memory, in MB, your Lambda function is given. Lambda uses this
 	// memory size to infer the amount of CPU and memory allocated to your function.
@@ -732,21 +935,23 @@ type CreateFunctionInput struct {
 	// a database operation might need less memory compared to an image processing
 	// function. The default value is 128 MB. The value must be a multiple of 64
 	// MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1536"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
 	// For more information, see AWS Lambda: How it Works (http://docs.aws.amazon.com/lambda/latest/dg/lambda-introduction.html)
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+" required:"true"`
 
 	// The runtime environment for the Lambda function you are uploading. Currently,
 	// Lambda supports "java" and "nodejs" as the runtime.
-	Runtime *string `type:"string" required:"true"`
+	//
+	// See the Runtime* constants for valid values.
+	Runtime *string `type:"string" enum:"nodejs,java8" required:"true"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataCreateFunctionInput `json:"-" xml:"-"`
 }
@@ -755,6 +960,16 @@ type metadataCreateFunctionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateFunctionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateFunctionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteEventSourceMappingInput struct {
 	// The event source mapping ID.
 	UUID *string `location:"uri" locationName:"UUID" type:"string" required:"true"`
@@ -766,6 +981,16 @@ type metadataDeleteEventSourceMappingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteEventSourceMappingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteEventSourceMappingInput) GoString() string {
+	return s.String()
+}
+
 type DeleteFunctionInput struct {
 	// The Lambda function to delete.
 	//
@@ -775,7 +1000,7 @@ type DeleteFunctionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	metadataDeleteFunctionInput `json:"-" xml:"-"`
 }
@@ -784,6 +1009,16 @@ type metadataDeleteFunctionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteFunctionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteFunctionInput) GoString() string {
+	return s.String()
+}
+
 type DeleteFunctionOutput struct {
 	metadataDeleteFunctionOutput `json:"-" xml:"-"`
 }
@@ -792,19 +1027,29 @@ type metadataDeleteFunctionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteFunctionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteFunctionOutput) GoString() string {
+	return s.String()
+}
+
 // Describes mapping between an Amazon Kinesis stream and a Lambda function.
 type EventSourceMappingConfiguration struct {
 	// The largest number of records that AWS Lambda will retrieve from your event
 	// source at the time of invoking your function. Your function receives an event
 	// with all the retrieved records.
-	BatchSize *int64 `type:"integer"`
+	BatchSize *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Kinesis stream that is the source
 	// of events.
-	EventSourceARN *string `locationName:"EventSourceArn" type:"string"`
+	EventSourceARN *string `locationName:"EventSourceArn" type:"string" pattern:"arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)"`
 
 	// The Lambda function to invoke when AWS Lambda detects an event on the stream.
-	FunctionARN *string `locationName:"FunctionArn" type:"string"`
+	FunctionARN *string `locationName:"FunctionArn" type:"string" pattern:"arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(\\/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})?"`
 
 	// The UTC time string indicating the last time the event mapping was updated.
 	LastModified *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -830,18 +1075,28 @@ type metadataEventSourceMappingConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EventSourceMappingConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EventSourceMappingConfiguration) GoString() string {
+	return s.String()
+}
+
 // The code for the Lambda function.
 type FunctionCode struct {
 	// Amazon S3 bucket name where the .zip file containing your deployment package
 	// is stored. This bucket must reside in the same AWS region where you are creating
 	// the Lambda function.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" min:"3" max:"63"`
 
 	// The Amazon S3 object (the deployment package) key name you want to upload.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" min:"1" max:"1024"`
 
 	// The Amazon S3 object (the deployment package) version you want to upload.
-	S3ObjectVersion *string `type:"string"`
+	S3ObjectVersion *string `type:"string" min:"1" max:"1024"`
 
 	// A base64-encoded .zip file containing your deployment package. For more information
 	// about creating a .zip file, go to Execution Permissions (http://docs.aws.amazon.com/lambda/latest/dg/intro-permission-model.html#lambda-intro-execution-role.html)
@@ -855,6 +1110,16 @@ type metadataFunctionCode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FunctionCode) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FunctionCode) GoString() string {
+	return s.String()
+}
+
 // The object for the Lambda function location.
 type FunctionCodeLocation struct {
 	// The presigned URL you can use to download the function's .zip file that you
@@ -871,41 +1136,53 @@ type metadataFunctionCodeLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FunctionCodeLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FunctionCodeLocation) GoString() string {
+	return s.String()
+}
+
 // A complex type that describes function metadata.
 type FunctionConfiguration struct {
 	// The size, in bytes, of the function .zip file you uploaded.
 	CodeSize *int64 `type:"long"`
 
 	// The user-provided description.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"256"`
 
 	// The Amazon Resource Name (ARN) assigned to the function.
-	FunctionARN *string `locationName:"FunctionArn" type:"string"`
+	FunctionARN *string `locationName:"FunctionArn" type:"string" pattern:"arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(\\/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})?"`
 
 	// The name of the function.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)"`
 
 	// The function Lambda calls to begin executing your function.
-	Handler *string `type:"string"`
+	Handler *string `type:"string" max:"128" pattern:"[^\\s]+"`
 
 	// The timestamp of the last time you updated the function.
 	LastModified *string `type:"string"`
 
 	// The memory size, in MB, you configured for the function. Must be a multiple
 	// of 64 MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1536"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The runtime environment for the Lambda function.
-	Runtime *string `type:"string"`
+	//
+	// See the Runtime* constants for valid values.
+	Runtime *string `type:"string" enum:"nodejs,java8"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataFunctionConfiguration `json:"-" xml:"-"`
 }
@@ -914,6 +1191,16 @@ type metadataFunctionConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FunctionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FunctionConfiguration) GoString() string {
+	return s.String()
+}
+
 type GetEventSourceMappingInput struct {
 	// The AWS Lambda assigned ID of the event source mapping.
 	UUID *string `location:"uri" locationName:"UUID" type:"string" required:"true"`
@@ -925,6 +1212,16 @@ type metadataGetEventSourceMappingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetEventSourceMappingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetEventSourceMappingInput) GoString() string {
+	return s.String()
+}
+
 type GetFunctionConfigurationInput struct {
 	// The name of the Lambda function for which you want to retrieve the configuration
 	// information.
@@ -935,7 +1232,7 @@ type GetFunctionConfigurationInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	metadataGetFunctionConfigurationInput `json:"-" xml:"-"`
 }
@@ -944,6 +1241,16 @@ type metadataGetFunctionConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetFunctionConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetFunctionConfigurationInput) GoString() string {
+	return s.String()
+}
+
 type GetFunctionInput struct {
 	// The Lambda function name.
 	//
@@ -953,7 +1260,7 @@ type GetFunctionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	metadataGetFunctionInput `json:"-" xml:"-"`
 }
@@ -962,6 +1269,16 @@ type metadataGetFunctionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetFunctionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetFunctionInput) GoString() string {
+	return s.String()
+}
+
 // This response contains the object for the Lambda function location (see API_FunctionCodeLocation
 type GetFunctionOutput struct {
 	// The object for the Lambda function location.
@@ -977,6 +1294,16 @@ type metadataGetFunctionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetFunctionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetFunctionOutput) GoString() string {
+	return s.String()
+}
+
 type GetPolicyInput struct {
 	// Function name whose access policy you want to retrieve.
 	//
@@ -986,7 +1313,7 @@ type GetPolicyInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	metadataGetPolicyInput `json:"-" xml:"-"`
 }
@@ -995,6 +1322,16 @@ type metadataGetPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPolicyInput) GoString() string {
+	return s.String()
+}
+
 type GetPolicyOutput struct {
 	// The access policy associated with the specified function. The response returns
 	// the same as a string using "\" as an escape character in the JSON.
@@ -1007,9 +1344,19 @@ type metadataGetPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPolicyOutput) GoString() string {
+	return s.String()
+}
+
 type InvokeAsyncInput struct {
 	// The Lambda function name.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// JSON that you want to provide to your Lambda function as input.
 	InvokeArgs io.ReadSeeker `type:"blob" required:"true"`
@@ -1021,6 +1368,16 @@ type metadataInvokeAsyncInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"InvokeArgs"`
 }
 
+// String returns the string representation
+func (s InvokeAsyncInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InvokeAsyncInput) GoString() string {
+	return s.String()
+}
+
 // Upon success, it returns empty response. Otherwise, throws an exception.
 type InvokeAsyncOutput struct {
 	// It will be 202 upon success.
@@ -1033,6 +1390,16 @@ type metadataInvokeAsyncOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InvokeAsyncOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InvokeAsyncOutput) GoString() string {
+	return s.String()
+}
+
 type InvokeInput struct {
 	// Using the ClientContext you can pass client-specific information to the Lambda
 	// function you are invoking. You can then process the client information in
@@ -1051,7 +1418,7 @@ type InvokeInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// By default, the Invoke API assumes "RequestResponse" invocation type. You
 	// can optionally request asynchronous execution by specifying "Event" as the
@@ -1060,13 +1427,17 @@ type InvokeInput struct {
 	// is authorized to invoke the function and if the inputs are valid. You request
 	// this by specifying "DryRun" as the InvocationType. This is useful in a cross-account
 	// scenario when you want to verify access to a function without running it.
-	InvocationType *string `location:"header" locationName:"X-Amz-Invocation-Type" type:"string"`
+	//
+	// See the InvocationType* constants for valid values.
+	InvocationType *string `location:"header" locationName:"X-Amz-Invocation-Type" type:"string" enum:"Event,RequestResponse,DryRun"`
 
 	// You can set this optional parameter to "Tail" in the request only if you
 	// specify the InvocationType parameter with value "RequestResponse". In this
 	// case, AWS Lambda returns the base64-encoded last 4 KB of log data produced
 	// by your Lambda function in the x-amz-log-results header.
-	LogType *string `location:"header" locationName:"X-Amz-Log-Type" type:"string"`
+	//
+	// See the LogType* constants for valid values.
+	LogType *string `location:"header" locationName:"X-Amz-Log-Type" type:"string" enum:"None,Tail"`
 
 	// JSON that you want to provide to your Lambda function as input.
 	Payload []byte `type:"blob"`
@@ -1078,6 +1449,16 @@ type metadataInvokeInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Payload"`
 }
 
+// String returns the string representation
+func (s InvokeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InvokeInput) GoString() string {
+	return s.String()
+}
+
 // Upon success, returns an empty response. Otherwise, throws an exception.
 type InvokeOutput struct {
 	// Indicates whether an error occurred while executing the Lambda function.
@@ -1114,9 +1495,19 @@ type metadataInvokeOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Payload"`
 }
 
+// String returns the string representation
+func (s InvokeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InvokeOutput) GoString() string {
+	return s.String()
+}
+
 type ListEventSourceMappingsInput struct {
 	// The Amazon Resource Name (ARN) of the Amazon Kinesis stream.
-	EventSourceARN *string `location:"querystring" locationName:"EventSourceArn" type:"string"`
+	EventSourceARN *string `location:"querystring" locationName:"EventSourceArn" type:"string" pattern:"arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)"`
 
 	// The name of the Lambda function.
 	//
@@ -1126,7 +1517,7 @@ type ListEventSourceMappingsInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string"`
+	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)"`
 
 	// Optional string. An opaque pagination token returned from a previous ListEventSourceMappings
 	// operation. If present, specifies to continue the list from where the returning
@@ -1135,7 +1526,7 @@ type ListEventSourceMappingsInput struct {
 
 	// Optional integer. Specifies the maximum number of event sources to return
 	// in response. This value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListEventSourceMappingsInput `json:"-" xml:"-"`
 }
@@ -1144,6 +1535,16 @@ type metadataListEventSourceMappingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListEventSourceMappingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListEventSourceMappingsInput) GoString() string {
+	return s.String()
+}
+
 // Contains a list of event sources (see API_EventSourceMappingConfiguration)
 type ListEventSourceMappingsOutput struct {
 	// An array of EventSourceMappingConfiguration objects.
@@ -1159,6 +1560,16 @@ type metadataListEventSourceMappingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListEventSourceMappingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListEventSourceMappingsOutput) GoString() string {
+	return s.String()
+}
+
 type ListFunctionsInput struct {
 	// Optional string. An opaque pagination token returned from a previous ListFunctions
 	// operation. If present, indicates where to continue the listing.
@@ -1166,7 +1577,7 @@ type ListFunctionsInput struct {
 
 	// Optional integer. Specifies the maximum number of AWS Lambda functions to
 	// return in response. This parameter value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListFunctionsInput `json:"-" xml:"-"`
 }
@@ -1175,6 +1586,16 @@ type metadataListFunctionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListFunctionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListFunctionsInput) GoString() string {
+	return s.String()
+}
+
 // Contains a list of AWS Lambda function configurations (see FunctionConfiguration.
 type ListFunctionsOutput struct {
 	// A list of Lambda functions.
@@ -1190,6 +1611,16 @@ type metadataListFunctionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListFunctionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListFunctionsOutput) GoString() string {
+	return s.String()
+}
+
 type RemovePermissionInput struct {
 	// Lambda function whose access policy you want to remove a permission from.
 	//
@@ -1199,10 +1630,10 @@ type RemovePermissionInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// Statement ID of the permission to remove.
-	StatementID *string `location:"uri" locationName:"StatementId" type:"string" required:"true"`
+	StatementID *string `location:"uri" locationName:"StatementId" type:"string" min:"1" max:"100" pattern:"([a-zA-Z0-9-_]+)" required:"true"`
 
 	metadataRemovePermissionInput `json:"-" xml:"-"`
 }
@@ -1211,6 +1642,16 @@ type metadataRemovePermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemovePermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemovePermissionInput) GoString() string {
+	return s.String()
+}
+
 type RemovePermissionOutput struct {
 	metadataRemovePermissionOutput `json:"-" xml:"-"`
 }
@@ -1219,10 +1660,20 @@ type metadataRemovePermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemovePermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemovePermissionOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateEventSourceMappingInput struct {
 	// The maximum number of stream records that can be sent to your Lambda function
 	// for a single invocation.
-	BatchSize *int64 `type:"integer"`
+	BatchSize *int64 `type:"integer" min:"1" max:"10000"`
 
 	// Specifies whether AWS Lambda should actively poll the stream or not. If disabled,
 	// AWS Lambda will not poll the stream.
@@ -1236,7 +1687,7 @@ type UpdateEventSourceMappingInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)"`
 
 	// The event source mapping identifier.
 	UUID *string `location:"uri" locationName:"UUID" type:"string" required:"true"`
@@ -1248,6 +1699,16 @@ type metadataUpdateEventSourceMappingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateEventSourceMappingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateEventSourceMappingInput) GoString() string {
+	return s.String()
+}
+
 type UpdateFunctionCodeInput struct {
 	// The existing Lambda function name whose code you want to replace.
 	//
@@ -1257,18 +1718,18 @@ type UpdateFunctionCodeInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// Amazon S3 bucket name where the .zip file containing your deployment package
 	// is stored. This bucket must reside in the same AWS region where you are creating
 	// the Lambda function.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" min:"3" max:"63"`
 
 	// The Amazon S3 object (the deployment package) key name you want to upload.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" min:"1" max:"1024"`
 
 	// The Amazon S3 object (the deployment package) version you want to upload.
-	S3ObjectVersion *string `type:"string"`
+	S3ObjectVersion *string `type:"string" min:"1" max:"1024"`
 
 	// Based64-encoded .zip file containing your packaged source code.
 	ZipFile []byte `type:"blob"`
@@ -1280,10 +1741,20 @@ type metadataUpdateFunctionCodeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateFunctionCodeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateFunctionCodeInput) GoString() string {
+	return s.String()
+}
+
 type UpdateFunctionConfigurationInput struct {
 	// A short user-defined function description. AWS Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"0" max:"256"`
 
 	// The name of the Lambda function.
 	//
@@ -1293,11 +1764,11 @@ type UpdateFunctionConfigurationInput struct {
 	// allows you to specify only the account ID qualifier (for example, "account-id:Thumbnail").
 	// Note that the length constraint applies only to the ARN. If you specify only
 	// the function name, it is limited to 64 character in length.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"111" pattern:"(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)" required:"true"`
 
 	// The function that Lambda calls to begin executing your function. For Node.js,
 	// it is the module-name.export value in your function.
-	Handler *string `type:"string"`
+	Handler *string `type:"string" max:"128" pattern:"[^\\s]+"`
 
 	// The amount of memory, in MB, your Lambda function is given. AWS Lambda uses
 	// this memory size to infer the amount of CPU allocated to your function. Your
@@ -1305,16 +1776,16 @@ type UpdateFunctionConfigurationInput struct {
 	// a database operation might need less memory compared to an image processing
 	// function. The default value is 128 MB. The value must be a multiple of 64
 	// MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1536"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda will assume when
 	// it executes your function.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The function execution time at which AWS Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataUpdateFunctionConfigurationInput `json:"-" xml:"-"`
 }
@@ -1322,3 +1793,43 @@ type UpdateFunctionConfigurationInput struct {
 type metadataUpdateFunctionConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateFunctionConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateFunctionConfigurationInput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum EventSourcePosition
+	EventSourcePositionTrimHorizon = "TRIM_HORIZON"
+	// @enum EventSourcePosition
+	EventSourcePositionLatest = "LATEST"
+)
+
+const (
+	// @enum InvocationType
+	InvocationTypeEvent = "Event"
+	// @enum InvocationType
+	InvocationTypeRequestResponse = "RequestResponse"
+	// @enum InvocationType
+	InvocationTypeDryRun = "DryRun"
+)
+
+const (
+	// @enum LogType
+	LogTypeNone = "None"
+	// @enum LogType
+	LogTypeTail = "Tail"
+)
+
+const (
+	// @enum Runtime
+	RuntimeNodejs = "nodejs"
+	// @enum Runtime
+	RuntimeJava8 = "java8"
+)
@@ -4,6 +4,7 @@
 package lambdaiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 )
 
@@ -11,35 +12,69 @@ import (
 type LambdaAPI interface {
 	AddPermission(*lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error)
 
+	AddPermissionWithContext(aws.Context, *lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error)
+
 	CreateEventSourceMapping(*lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	CreateEventSourceMappingWithContext(aws.Context, *lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
+
 	CreateFunction(*lambda.CreateFunctionInput) (*lambda.FunctionConfiguration, error)
 
+	CreateFunctionWithContext(aws.Context, *lambda.CreateFunctionInput) (*lambda.FunctionConfiguration, error)
+
 	DeleteEventSourceMapping(*lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	DeleteEventSourceMappingWithContext(aws.Context, *lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
+
 	DeleteFunction(*lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error)
 
+	DeleteFunctionWithContext(aws.Context, *lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error)
+
 	GetEventSourceMapping(*lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	GetEventSourceMappingWithContext(aws.Context, *lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
+
 	GetFunction(*lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error)
 
+	GetFunctionWithContext(aws.Context, *lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error)
+
 	GetFunctionConfiguration(*lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
 
+	GetFunctionConfigurationWithContext(aws.Context, *lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
+
 	GetPolicy(*lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error)
 
+	GetPolicyWithContext(aws.Context, *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error)
+
 	Invoke(*lambda.InvokeInput) (*lambda.InvokeOutput, error)
 
+	InvokeWithContext(aws.Context, *lambda.InvokeInput) (*lambda.InvokeOutput, error)
+
 	InvokeAsync(*lambda.InvokeAsyncInput) (*lambda.InvokeAsyncOutput, error)
 
+	InvokeAsyncWithContext(aws.Context, *lambda.InvokeAsyncInput) (*lambda.InvokeAsyncOutput, error)
+
 	ListEventSourceMappings(*lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error)
 
+	ListEventSourceMappingsWithContext(aws.Context, *lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error)
+
 	ListFunctions(*lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error)
 
+	ListFunctionsWithContext(aws.Context, *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error)
+
 	RemovePermission(*lambda.RemovePermissionInput) (*lambda.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(aws.Context, *lambda.RemovePermissionInput) (*lambda.RemovePermissionOutput, error)
+
 	UpdateEventSourceMapping(*lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
 
+	UpdateEventSourceMappingWithContext(aws.Context, *lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
+
 	UpdateFunctionCode(*lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error)
 
+	UpdateFunctionCodeWithContext(aws.Context, *lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error)
+
 	UpdateFunctionConfiguration(*lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
+
+	UpdateFunctionConfigurationWithContext(aws.Context, *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error)
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateBatchPrediction = "CreateBatchPrediction"
@@ -49,6 +50,16 @@ func (c *MachineLearning) CreateBatchPrediction(input *CreateBatchPredictionInpu
 	return out, err
 }
 
+// CreateBatchPredictionWithContext is the same as CreateBatchPrediction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) CreateBatchPredictionWithContext(ctx aws.Context, input *CreateBatchPredictionInput) (*CreateBatchPredictionOutput, error) {
+	req, out := c.CreateBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDataSourceFromRDS = "CreateDataSourceFromRDS"
 
 // CreateDataSourceFromRDSRequest generates a request for the CreateDataSourceFromRDS operation.
@@ -89,6 +100,16 @@ func (c *MachineLearning) CreateDataSourceFromRDS(input *CreateDataSourceFromRDS
 	return out, err
 }
 
+// CreateDataSourceFromRDSWithContext is the same as CreateDataSourceFromRDS with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) CreateDataSourceFromRDSWithContext(ctx aws.Context, input *CreateDataSourceFromRDSInput) (*CreateDataSourceFromRDSOutput, error) {
+	req, out := c.CreateDataSourceFromRDSRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDataSourceFromRedshift = "CreateDataSourceFromRedshift"
 
 // CreateDataSourceFromRedshiftRequest generates a request for the CreateDataSourceFromRedshift operation.
@@ -145,6 +166,16 @@ func (c *MachineLearning) CreateDataSourceFromRedshift(input *CreateDataSourceFr
 	return out, err
 }
 
+// CreateDataSourceFromRedshiftWithContext is the same as CreateDataSourceFromRedshift with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) CreateDataSourceFromRedshiftWithContext(ctx aws.Context, input *CreateDataSourceFromRedshiftInput) (*CreateDataSourceFromRedshiftOutput, error) {
+	req, out := c.CreateDataSourceFromRedshiftRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDataSourceFromS3 = "CreateDataSourceFromS3"
 
 // CreateDataSourceFromS3Request generates a request for the CreateDataSourceFromS3 operation.
@@ -201,6 +232,16 @@ func (c *MachineLearning) CreateDataSourceFromS3(input *CreateDataSourceFromS3In
 	return out, err
 }
 
+// CreateDataSourceFromS3WithContext is the same as CreateDataSourceFromS3 with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) CreateDataSourceFromS3WithContext(ctx aws.Context, input *CreateDataSourceFromS3Input) (*CreateDataSourceFromS3Output, error) {
+	req, out := c.CreateDataSourceFromS3Request(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateEvaluation = "CreateEvaluation"
 
 // CreateEvaluationRequest generates a request for the CreateEvaluation operation.
@@ -243,6 +284,16 @@ func (c *MachineLearning) CreateEvaluation(input *CreateEvaluationInput) (*Creat
 	return out, err
 }
 
+// CreateEvaluationWithContext is the same as CreateEvaluation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) CreateEvaluationWithContext(ctx aws.Context, input *CreateEvaluationInput) (*CreateEvaluationOutput, error) {
+	req, out := c.CreateEvaluationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateMLModel = "CreateMLModel"
 
 // CreateMLModelRequest generates a request for the CreateMLModel operation.
@@ -286,6 +337,16 @@ func (c *MachineLearning) CreateMLModel(input *CreateMLModelInput) (*CreateMLMod
 	return out, err
 }
 
+// CreateMLModelWithContext is the same as CreateMLModel with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) CreateMLModelWithContext(ctx aws.Context, input *CreateMLModelInput) (*CreateMLModelOutput, error) {
+	req, out := c.CreateMLModelRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateRealtimeEndpoint = "CreateRealtimeEndpoint"
 
 // CreateRealtimeEndpointRequest generates a request for the CreateRealtimeEndpoint operation.
@@ -315,6 +376,16 @@ func (c *MachineLearning) CreateRealtimeEndpoint(input *CreateRealtimeEndpointIn
 	return out, err
 }
 
+// CreateRealtimeEndpointWithContext is the same as CreateRealtimeEndpoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) CreateRealtimeEndpointWithContext(ctx aws.Context, input *CreateRealtimeEndpointInput) (*CreateRealtimeEndpointOutput, error) {
+	req, out := c.CreateRealtimeEndpointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteBatchPrediction = "DeleteBatchPrediction"
 
 // DeleteBatchPredictionRequest generates a request for the DeleteBatchPrediction operation.
@@ -347,6 +418,16 @@ func (c *MachineLearning) DeleteBatchPrediction(input *DeleteBatchPredictionInpu
 	return out, err
 }
 
+// DeleteBatchPredictionWithContext is the same as DeleteBatchPrediction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DeleteBatchPredictionWithContext(ctx aws.Context, input *DeleteBatchPredictionInput) (*DeleteBatchPredictionOutput, error) {
+	req, out := c.DeleteBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDataSource = "DeleteDataSource"
 
 // DeleteDataSourceRequest generates a request for the DeleteDataSource operation.
@@ -379,6 +460,16 @@ func (c *MachineLearning) DeleteDataSource(input *DeleteDataSourceInput) (*Delet
 	return out, err
 }
 
+// DeleteDataSourceWithContext is the same as DeleteDataSource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DeleteDataSourceWithContext(ctx aws.Context, input *DeleteDataSourceInput) (*DeleteDataSourceOutput, error) {
+	req, out := c.DeleteDataSourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteEvaluation = "DeleteEvaluation"
 
 // DeleteEvaluationRequest generates a request for the DeleteEvaluation operation.
@@ -411,6 +502,16 @@ func (c *MachineLearning) DeleteEvaluation(input *DeleteEvaluationInput) (*Delet
 	return out, err
 }
 
+// DeleteEvaluationWithContext is the same as DeleteEvaluation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DeleteEvaluationWithContext(ctx aws.Context, input *DeleteEvaluationInput) (*DeleteEvaluationOutput, error) {
+	req, out := c.DeleteEvaluationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMLModel = "DeleteMLModel"
 
 // DeleteMLModelRequest generates a request for the DeleteMLModel operation.
@@ -443,6 +544,16 @@ func (c *MachineLearning) DeleteMLModel(input *DeleteMLModelInput) (*DeleteMLMod
 	return out, err
 }
 
+// DeleteMLModelWithContext is the same as DeleteMLModel with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DeleteMLModelWithContext(ctx aws.Context, input *DeleteMLModelInput) (*DeleteMLModelOutput, error) {
+	req, out := c.DeleteMLModelRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteRealtimeEndpoint = "DeleteRealtimeEndpoint"
 
 // DeleteRealtimeEndpointRequest generates a request for the DeleteRealtimeEndpoint operation.
@@ -470,6 +581,16 @@ func (c *MachineLearning) DeleteRealtimeEndpoint(input *DeleteRealtimeEndpointIn
 	return out, err
 }
 
+// DeleteRealtimeEndpointWithContext is the same as DeleteRealtimeEndpoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DeleteRealtimeEndpointWithContext(ctx aws.Context, input *DeleteRealtimeEndpointInput) (*DeleteRealtimeEndpointOutput, error) {
+	req, out := c.DeleteRealtimeEndpointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeBatchPredictions = "DescribeBatchPredictions"
 
 // DescribeBatchPredictionsRequest generates a request for the DescribeBatchPredictions operation.
@@ -504,6 +625,16 @@ func (c *MachineLearning) DescribeBatchPredictions(input *DescribeBatchPredictio
 	return out, err
 }
 
+// DescribeBatchPredictionsWithContext is the same as DescribeBatchPredictions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DescribeBatchPredictionsWithContext(ctx aws.Context, input *DescribeBatchPredictionsInput) (*DescribeBatchPredictionsOutput, error) {
+	req, out := c.DescribeBatchPredictionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *MachineLearning) DescribeBatchPredictionsPages(input *DescribeBatchPredictionsInput, fn func(p *DescribeBatchPredictionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeBatchPredictionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -544,6 +675,16 @@ func (c *MachineLearning) DescribeDataSources(input *DescribeDataSourcesInput) (
 	return out, err
 }
 
+// DescribeDataSourcesWithContext is the same as DescribeDataSources with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DescribeDataSourcesWithContext(ctx aws.Context, input *DescribeDataSourcesInput) (*DescribeDataSourcesOutput, error) {
+	req, out := c.DescribeDataSourcesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *MachineLearning) DescribeDataSourcesPages(input *DescribeDataSourcesInput, fn func(p *DescribeDataSourcesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeDataSourcesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -585,6 +726,16 @@ func (c *MachineLearning) DescribeEvaluations(input *DescribeEvaluationsInput) (
 	return out, err
 }
 
+// DescribeEvaluationsWithContext is the same as DescribeEvaluations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DescribeEvaluationsWithContext(ctx aws.Context, input *DescribeEvaluationsInput) (*DescribeEvaluationsOutput, error) {
+	req, out := c.DescribeEvaluationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *MachineLearning) DescribeEvaluationsPages(input *DescribeEvaluationsInput, fn func(p *DescribeEvaluationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeEvaluationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -625,6 +776,16 @@ func (c *MachineLearning) DescribeMLModels(input *DescribeMLModelsInput) (*Descr
 	return out, err
 }
 
+// DescribeMLModelsWithContext is the same as DescribeMLModels with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) DescribeMLModelsWithContext(ctx aws.Context, input *DescribeMLModelsInput) (*DescribeMLModelsOutput, error) {
+	req, out := c.DescribeMLModelsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *MachineLearning) DescribeMLModelsPages(input *DescribeMLModelsInput, fn func(p *DescribeMLModelsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeMLModelsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -660,6 +821,16 @@ func (c *MachineLearning) GetBatchPrediction(input *GetBatchPredictionInput) (*G
 	return out, err
 }
 
+// GetBatchPredictionWithContext is the same as GetBatchPrediction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) GetBatchPredictionWithContext(ctx aws.Context, input *GetBatchPredictionInput) (*GetBatchPredictionOutput, error) {
+	req, out := c.GetBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDataSource = "GetDataSource"
 
 // GetDataSourceRequest generates a request for the GetDataSource operation.
@@ -692,6 +863,16 @@ func (c *MachineLearning) GetDataSource(input *GetDataSourceInput) (*GetDataSour
 	return out, err
 }
 
+// GetDataSourceWithContext is the same as GetDataSource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) GetDataSourceWithContext(ctx aws.Context, input *GetDataSourceInput) (*GetDataSourceOutput, error) {
+	req, out := c.GetDataSourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetEvaluation = "GetEvaluation"
 
 // GetEvaluationRequest generates a request for the GetEvaluation operation.
@@ -720,6 +901,16 @@ func (c *MachineLearning) GetEvaluation(input *GetEvaluationInput) (*GetEvaluati
 	return out, err
 }
 
+// GetEvaluationWithContext is the same as GetEvaluation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) GetEvaluationWithContext(ctx aws.Context, input *GetEvaluationInput) (*GetEvaluationOutput, error) {
+	req, out := c.GetEvaluationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetMLModel = "GetMLModel"
 
 // GetMLModelRequest generates a request for the GetMLModel operation.
@@ -750,6 +941,16 @@ func (c *MachineLearning) GetMLModel(input *GetMLModelInput) (*GetMLModelOutput,
 	return out, err
 }
 
+// GetMLModelWithContext is the same as GetMLModel with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) GetMLModelWithContext(ctx aws.Context, input *GetMLModelInput) (*GetMLModelOutput, error) {
+	req, out := c.GetMLModelRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPredict = "Predict"
 
 // PredictRequest generates a request for the Predict operation.
@@ -780,6 +981,16 @@ func (c *MachineLearning) Predict(input *PredictInput) (*PredictOutput, error) {
 	return out, err
 }
 
+// PredictWithContext is the same as Predict with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) PredictWithContext(ctx aws.Context, input *PredictInput) (*PredictOutput, error) {
+	req, out := c.PredictRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateBatchPrediction = "UpdateBatchPrediction"
 
 // UpdateBatchPredictionRequest generates a request for the UpdateBatchPrediction operation.
@@ -810,6 +1021,16 @@ func (c *MachineLearning) UpdateBatchPrediction(input *UpdateBatchPredictionInpu
 	return out, err
 }
 
+// UpdateBatchPredictionWithContext is the same as UpdateBatchPrediction with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) UpdateBatchPredictionWithContext(ctx aws.Context, input *UpdateBatchPredictionInput) (*UpdateBatchPredictionOutput, error) {
+	req, out := c.UpdateBatchPredictionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDataSource = "UpdateDataSource"
 
 // UpdateDataSourceRequest generates a request for the UpdateDataSource operation.
@@ -840,6 +1061,16 @@ func (c *MachineLearning) UpdateDataSource(input *UpdateDataSourceInput) (*Updat
 	return out, err
 }
 
+// UpdateDataSourceWithContext is the same as UpdateDataSource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) UpdateDataSourceWithContext(ctx aws.Context, input *UpdateDataSourceInput) (*UpdateDataSourceOutput, error) {
+	req, out := c.UpdateDataSourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateEvaluation = "UpdateEvaluation"
 
 // UpdateEvaluationRequest generates a request for the UpdateEvaluation operation.
@@ -870,6 +1101,16 @@ func (c *MachineLearning) UpdateEvaluation(input *UpdateEvaluationInput) (*Updat
 	return out, err
 }
 
+// UpdateEvaluationWithContext is the same as UpdateEvaluation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) UpdateEvaluationWithContext(ctx aws.Context, input *UpdateEvaluationInput) (*UpdateEvaluationOutput, error) {
+	req, out := c.UpdateEvaluationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateMLModel = "UpdateMLModel"
 
 // UpdateMLModelRequest generates a request for the UpdateMLModel operation.
@@ -900,17 +1141,27 @@ func (c *MachineLearning) UpdateMLModel(input *UpdateMLModelInput) (*UpdateMLMod
 	return out, err
 }
 
+// UpdateMLModelWithContext is the same as UpdateMLModel with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *MachineLearning) UpdateMLModelWithContext(ctx aws.Context, input *UpdateMLModelInput) (*UpdateMLModelOutput, error) {
+	req, out := c.UpdateMLModelRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the output of GetBatchPrediction operation.
 //
 //  The content consists of the detailed metadata, the status, and the data
 // file information of a Batch Prediction.
 type BatchPrediction struct {
 	// The ID of the DataSource that points to the group of observations to predict.
-	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string"`
+	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The ID assigned to the BatchPrediction at creation. This value should be
 	// identical to the value of the BatchPredictionID in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The time that the BatchPrediction was created. The time is expressed in epoch
 	// time.
@@ -919,11 +1170,11 @@ type BatchPrediction struct {
 	// The AWS user account that invoked the BatchPrediction. The account type can
 	// be either an AWS root account or an AWS Identity and Access Management (IAM)
 	// user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The time of the most recent edit to the BatchPrediction. The time is expressed
 	// in epoch time.
@@ -931,19 +1182,19 @@ type BatchPrediction struct {
 
 	// The ID of the MLModel that generated predictions for the BatchPrediction
 	// request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A description of the most recent details about processing the batch prediction
 	// request.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the BatchPrediction.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The location of an Amazon S3 bucket or directory to receive the operation
 	// results. The following substrings are not allowed in the s3 key portion of
 	// the "outputURI" field: ':', '//', '/./', '/../'.
-	OutputURI *string `locationName:"OutputUri" type:"string"`
+	OutputURI *string `locationName:"OutputUri" type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The status of the BatchPrediction. This element can have one of the following
 	// values:
@@ -953,7 +1204,9 @@ type BatchPrediction struct {
 	//  FAILED - The request to peform a batch prediction did not run to completion.
 	// It is not usable.  COMPLETED - The batch prediction process completed successfully.
 	//  DELETED - The BatchPrediction is marked as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	metadataBatchPrediction `json:"-" xml:"-"`
 }
@@ -962,19 +1215,29 @@ type metadataBatchPrediction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchPrediction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchPrediction) GoString() string {
+	return s.String()
+}
+
 type CreateBatchPredictionInput struct {
 	// The ID of the DataSource that points to the group of observations to predict.
-	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" required:"true"`
+	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied ID that uniquely identifies the BatchPrediction.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied name or description of the BatchPrediction. BatchPredictionName
 	// can only use the UTF-8 character set.
-	BatchPredictionName *string `type:"string"`
+	BatchPredictionName *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The ID of the MLModel that will generate predictions for the group of observations.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The location of an Amazon Simple Storage Service (Amazon S3) bucket or directory
 	// to store the batch prediction results. The following substrings are not allowed
@@ -983,7 +1246,7 @@ type CreateBatchPredictionInput struct {
 	// Amazon ML needs permissions to store and retrieve the logs on your behalf.
 	// For information about how to set permissions, see the Amazon Machine Learning
 	// Developer Guide (http://docs.aws.amazon.com/machine-learning/latest/dg).
-	OutputURI *string `locationName:"OutputUri" type:"string" required:"true"`
+	OutputURI *string `locationName:"OutputUri" type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?" required:"true"`
 
 	metadataCreateBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -992,6 +1255,16 @@ type metadataCreateBatchPredictionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateBatchPredictionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateBatchPredictionInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateBatchPrediction operation, and is an acknowledgement
 // that Amazon ML received the request.
 //
@@ -1001,7 +1274,7 @@ type metadataCreateBatchPredictionInput struct {
 type CreateBatchPredictionOutput struct {
 	// A user-supplied ID that uniquely identifies the BatchPrediction. This value
 	// is identical to the value of the BatchPredictionId in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataCreateBatchPredictionOutput `json:"-" xml:"-"`
 }
@@ -1010,6 +1283,16 @@ type metadataCreateBatchPredictionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateBatchPredictionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateBatchPredictionOutput) GoString() string {
+	return s.String()
+}
+
 type CreateDataSourceFromRDSInput struct {
 	// The compute statistics for a DataSource. The statistics are generated from
 	// the observation data referenced by a DataSource. Amazon ML uses the statistics
@@ -1019,10 +1302,10 @@ type CreateDataSourceFromRDSInput struct {
 
 	// A user-supplied ID that uniquely identifies the DataSource. Typically, an
 	// Amazon Resource Number (ARN) becomes the ID for a DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied name or description of the DataSource.
-	DataSourceName *string `type:"string"`
+	DataSourceName *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The data specification of an Amazon RDS DataSource:
 	//
@@ -1068,7 +1351,7 @@ type CreateDataSourceFromRDSInput struct {
 	// The role that Amazon ML assumes on behalf of the user to create and activate
 	// a data pipeline in the user’s account and copy data (using the SelectSqlQuery)
 	// query from Amazon RDS to Amazon S3.
-	RoleARN *string `type:"string" required:"true"`
+	RoleARN *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateDataSourceFromRDSInput `json:"-" xml:"-"`
 }
@@ -1077,6 +1360,16 @@ type metadataCreateDataSourceFromRDSInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDataSourceFromRDSInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDataSourceFromRDSInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateDataSourceFromRDS operation, and is an acknowledgement
 // that Amazon ML received the request.
 //
@@ -1089,7 +1382,7 @@ type metadataCreateDataSourceFromRDSInput struct {
 type CreateDataSourceFromRDSOutput struct {
 	// A user-supplied ID that uniquely identifies the datasource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataCreateDataSourceFromRDSOutput `json:"-" xml:"-"`
 }
@@ -1098,6 +1391,16 @@ type metadataCreateDataSourceFromRDSOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDataSourceFromRDSOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDataSourceFromRDSOutput) GoString() string {
+	return s.String()
+}
+
 type CreateDataSourceFromRedshiftInput struct {
 	// The compute statistics for a DataSource. The statistics are generated from
 	// the observation data referenced by a DataSource. Amazon ML uses the statistics
@@ -1106,10 +1409,10 @@ type CreateDataSourceFromRedshiftInput struct {
 	ComputeStatistics *bool `type:"boolean"`
 
 	// A user-supplied ID that uniquely identifies the DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied name or description of the DataSource.
-	DataSourceName *string `type:"string"`
+	DataSourceName *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The data specification of an Amazon Redshift DataSource:
 	//
@@ -1145,7 +1448,7 @@ type CreateDataSourceFromRedshiftInput struct {
 	//
 	// An Amazon S3 bucket policy to grant Amazon ML read/write permissions on
 	// the S3StagingLocation
-	RoleARN *string `type:"string" required:"true"`
+	RoleARN *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateDataSourceFromRedshiftInput `json:"-" xml:"-"`
 }
@@ -1154,6 +1457,16 @@ type metadataCreateDataSourceFromRedshiftInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDataSourceFromRedshiftInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDataSourceFromRedshiftInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateDataSourceFromRedshift operation, and is
 // an acknowledgement that Amazon ML received the request.
 //
@@ -1163,7 +1476,7 @@ type metadataCreateDataSourceFromRedshiftInput struct {
 type CreateDataSourceFromRedshiftOutput struct {
 	// A user-supplied ID that uniquely identifies the datasource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataCreateDataSourceFromRedshiftOutput `json:"-" xml:"-"`
 }
@@ -1172,6 +1485,16 @@ type metadataCreateDataSourceFromRedshiftOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDataSourceFromRedshiftOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDataSourceFromRedshiftOutput) GoString() string {
+	return s.String()
+}
+
 type CreateDataSourceFromS3Input struct {
 	// The compute statistics for a DataSource. The statistics are generated from
 	// the observation data referenced by a DataSource. Amazon ML uses the statistics
@@ -1180,10 +1503,10 @@ type CreateDataSourceFromS3Input struct {
 	ComputeStatistics *bool `type:"boolean"`
 
 	// A user-supplied identifier that uniquely identifies the DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied name or description of the DataSource.
-	DataSourceName *string `type:"string"`
+	DataSourceName *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The data specification of a DataSource:
 	//
@@ -1208,6 +1531,16 @@ type metadataCreateDataSourceFromS3Input struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDataSourceFromS3Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDataSourceFromS3Input) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateDataSourceFromS3 operation, and is an acknowledgement
 // that Amazon ML received the request.
 //
@@ -1216,7 +1549,7 @@ type metadataCreateDataSourceFromS3Input struct {
 type CreateDataSourceFromS3Output struct {
 	// A user-supplied ID that uniquely identifies the datasource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataCreateDataSourceFromS3Output `json:"-" xml:"-"`
 }
@@ -1225,22 +1558,32 @@ type metadataCreateDataSourceFromS3Output struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDataSourceFromS3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDataSourceFromS3Output) GoString() string {
+	return s.String()
+}
+
 type CreateEvaluationInput struct {
 	// The ID of the DataSource for the evaluation. The schema of the DataSource
 	// must match the schema used to create the MLModel.
-	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" required:"true"`
+	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied ID that uniquely identifies the Evaluation.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied name or description of the Evaluation.
-	EvaluationName *string `type:"string"`
+	EvaluationName *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The ID of the MLModel to evaluate.
 	//
 	// The schema used in creating the MLModel must match the schema of the DataSource
 	// used in the Evaluation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateEvaluationInput `json:"-" xml:"-"`
 }
@@ -1249,6 +1592,16 @@ type metadataCreateEvaluationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateEvaluationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateEvaluationInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateEvaluation operation, and is an acknowledgement
 // that Amazon ML received the request.
 //
@@ -1257,7 +1610,7 @@ type metadataCreateEvaluationInput struct {
 type CreateEvaluationOutput struct {
 	// The user-supplied ID that uniquely identifies the Evaluation. This value
 	// should be identical to the value of the EvaluationId in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataCreateEvaluationOutput `json:"-" xml:"-"`
 }
@@ -1266,12 +1619,22 @@ type metadataCreateEvaluationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateEvaluationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateEvaluationOutput) GoString() string {
+	return s.String()
+}
+
 type CreateMLModelInput struct {
 	// A user-supplied ID that uniquely identifies the MLModel.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied name or description of the MLModel.
-	MLModelName *string `type:"string"`
+	MLModelName *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The category of supervised learning that this MLModel will address. Choose
 	// from the following types:
@@ -1280,7 +1643,9 @@ type CreateMLModelInput struct {
 	// Choose BINARY if the MLModel result has two possible values. Choose MULTICLASS
 	// if the MLModel result has a limited number of values.    For more information,
 	// see the Amazon Machine Learning Developer Guide (http://docs.aws.amazon.com/machine-learning/latest/dg).
-	MLModelType *string `type:"string" required:"true"`
+	//
+	// See the MLModelType* constants for valid values.
+	MLModelType *string `type:"string" enum:"REGRESSION,BINARY,MULTICLASS" required:"true"`
 
 	// A list of the training parameters in the MLModel. The list is implemented
 	// as a map of key/value pairs.
@@ -1319,15 +1684,15 @@ type CreateMLModelInput struct {
 	// The data recipe for creating MLModel. You must specify either the recipe
 	// or its URI. If you don’t specify a recipe or its URI, Amazon ML creates a
 	// default.
-	Recipe *string `type:"string"`
+	Recipe *string `type:"string" max:"131071"`
 
 	// The Amazon Simple Storage Service (Amazon S3) location and file name that
 	// contains the MLModel recipe. You must specify either the recipe or its URI.
 	// If you don’t specify a recipe or its URI, Amazon ML creates a default.
-	RecipeURI *string `locationName:"RecipeUri" type:"string"`
+	RecipeURI *string `locationName:"RecipeUri" type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The DataSource that points to the training data.
-	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" required:"true"`
+	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateMLModelInput `json:"-" xml:"-"`
 }
@@ -1336,6 +1701,16 @@ type metadataCreateMLModelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateMLModelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateMLModelInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a CreateMLModel operation, and is an acknowledgement
 // that Amazon ML received the request.
 //
@@ -1344,7 +1719,7 @@ type metadataCreateMLModelInput struct {
 type CreateMLModelOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataCreateMLModelOutput `json:"-" xml:"-"`
 }
@@ -1353,9 +1728,19 @@ type metadataCreateMLModelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateMLModelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateMLModelOutput) GoString() string {
+	return s.String()
+}
+
 type CreateRealtimeEndpointInput struct {
 	// The ID assigned to the MLModel during creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateRealtimeEndpointInput `json:"-" xml:"-"`
 }
@@ -1364,6 +1749,16 @@ type metadataCreateRealtimeEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRealtimeEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRealtimeEndpointInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an CreateRealtimeEndpoint operation.
 //
 // The result contains the MLModelId and the endpoint information for the MLModel.
@@ -1373,7 +1768,7 @@ type metadataCreateRealtimeEndpointInput struct {
 type CreateRealtimeEndpointOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The endpoint information of the MLModel
 	RealtimeEndpointInfo *RealtimeEndpointInfo `type:"structure"`
@@ -1385,6 +1780,16 @@ type metadataCreateRealtimeEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateRealtimeEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateRealtimeEndpointOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of the GetDataSource operation.
 //
 //  The content consists of the detailed metadata and data file information
@@ -1401,11 +1806,11 @@ type DataSource struct {
 	// The AWS user account from which the DataSource was created. The account type
 	// can be either an AWS root account or an AWS Identity and Access Management
 	// (IAM) user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The location and name of the data in Amazon Simple Storage Service (Amazon
 	// S3) that is used by a DataSource.
-	DataLocationS3 *string `type:"string"`
+	DataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// A JSON string that represents the splitting requirement of a Datasource.
 	DataRearrangement *string `type:"string"`
@@ -1415,17 +1820,17 @@ type DataSource struct {
 	DataSizeInBytes *int64 `type:"long"`
 
 	// The ID that is assigned to the DataSource during creation.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The time of the most recent edit to the BatchPrediction. The time is expressed
 	// in epoch time.
 	LastUpdatedAt *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A description of the most recent details about creating the DataSource.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the DataSource.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The number of data files referenced by the DataSource.
 	NumberOfFiles *int64 `type:"long"`
@@ -1438,7 +1843,7 @@ type DataSource struct {
 
 	// The Amazon Resource Name (ARN) of an AWS IAM Role (http://docs.aws.amazon.com/IAM/latest/UserGuide/roles-toplevel.html#roles-about-termsandconcepts)
 	// such as the following: arn:aws:iam::account:role/rolename.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"100"`
 
 	// The current status of the DataSource. This element can have one of the following
 	// values:
@@ -1448,7 +1853,9 @@ type DataSource struct {
 	// request to create a DataSource did not run to completion. It is not usable.
 	// COMPLETED - The creation process completed successfully. DELETED - The DataSource
 	// is marked as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	metadataDataSource `json:"-" xml:"-"`
 }
@@ -1457,9 +1864,19 @@ type metadataDataSource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DataSource) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DataSource) GoString() string {
+	return s.String()
+}
+
 type DeleteBatchPredictionInput struct {
 	// A user-supplied ID that uniquely identifies the BatchPrediction.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -1468,6 +1885,16 @@ type metadataDeleteBatchPredictionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteBatchPredictionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteBatchPredictionInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DeleteBatchPrediction operation.
 //
 // You can use the GetBatchPrediction operation and check the value of the
@@ -1475,7 +1902,7 @@ type metadataDeleteBatchPredictionInput struct {
 type DeleteBatchPredictionOutput struct {
 	// A user-supplied ID that uniquely identifies the BatchPrediction. This value
 	// should be identical to the value of the BatchPredictionID in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataDeleteBatchPredictionOutput `json:"-" xml:"-"`
 }
@@ -1484,9 +1911,19 @@ type metadataDeleteBatchPredictionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteBatchPredictionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteBatchPredictionOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteDataSourceInput struct {
 	// A user-supplied ID that uniquely identifies the DataSource.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteDataSourceInput `json:"-" xml:"-"`
 }
@@ -1495,11 +1932,21 @@ type metadataDeleteDataSourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDataSourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDataSourceInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DeleteDataSource operation.
 type DeleteDataSourceOutput struct {
 	// A user-supplied ID that uniquely identifies the DataSource. This value should
 	// be identical to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataDeleteDataSourceOutput `json:"-" xml:"-"`
 }
@@ -1508,9 +1955,19 @@ type metadataDeleteDataSourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDataSourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDataSourceOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteEvaluationInput struct {
 	// A user-supplied ID that uniquely identifies the Evaluation to delete.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteEvaluationInput `json:"-" xml:"-"`
 }
@@ -1519,6 +1976,16 @@ type metadataDeleteEvaluationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteEvaluationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteEvaluationInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DeleteEvaluation operation. The output indicates
 // that Amazon Machine Learning (Amazon ML) received the request.
 //
@@ -1527,7 +1994,7 @@ type metadataDeleteEvaluationInput struct {
 type DeleteEvaluationOutput struct {
 	// A user-supplied ID that uniquely identifies the Evaluation. This value should
 	// be identical to the value of the EvaluationId in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataDeleteEvaluationOutput `json:"-" xml:"-"`
 }
@@ -1536,9 +2003,19 @@ type metadataDeleteEvaluationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteEvaluationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteEvaluationOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteMLModelInput struct {
 	// A user-supplied ID that uniquely identifies the MLModel.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteMLModelInput `json:"-" xml:"-"`
 }
@@ -1547,6 +2024,16 @@ type metadataDeleteMLModelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMLModelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMLModelInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DeleteMLModel operation.
 //
 // You can use the GetMLModel operation and check the value of the Status parameter
@@ -1554,7 +2041,7 @@ type metadataDeleteMLModelInput struct {
 type DeleteMLModelOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelID in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataDeleteMLModelOutput `json:"-" xml:"-"`
 }
@@ -1563,9 +2050,19 @@ type metadataDeleteMLModelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMLModelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMLModelOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteRealtimeEndpointInput struct {
 	// The ID assigned to the MLModel during creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteRealtimeEndpointInput `json:"-" xml:"-"`
 }
@@ -1574,13 +2071,23 @@ type metadataDeleteRealtimeEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRealtimeEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRealtimeEndpointInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an DeleteRealtimeEndpoint operation.
 //
 // The result contains the MLModelId and the endpoint information for the MLModel.
 type DeleteRealtimeEndpointOutput struct {
 	// A user-supplied ID that uniquely identifies the MLModel. This value should
 	// be identical to the value of the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The endpoint information of the MLModel
 	RealtimeEndpointInfo *RealtimeEndpointInfo `type:"structure"`
@@ -1592,10 +2099,20 @@ type metadataDeleteRealtimeEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteRealtimeEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteRealtimeEndpointOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeBatchPredictionsInput struct {
 	// The equal to operator. The BatchPrediction results will have FilterVariable
 	// values that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// Use one of the following variables to filter a list of BatchPrediction:
 	//
@@ -1608,33 +2125,35 @@ type DescribeBatchPredictionsInput struct {
 	// used in the BatchPrediction.  DataURI - Sets the search criteria to the data
 	// file(s) used in the BatchPrediction. The URL can identify either a file or
 	// an Amazon Simple Storage Solution (Amazon S3) bucket or directory.
-	FilterVariable *string `type:"string"`
+	//
+	// See the BatchPredictionFilterVariable* constants for valid values.
+	FilterVariable *string `type:"string" enum:"CreatedAt,LastUpdatedAt,Status,Name,IAMUser,MLModelId,DataSourceId,DataURI"`
 
 	// The greater than or equal to operator. The BatchPrediction results will have
 	// FilterVariable values that are greater than or equal to the value specified
 	// with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The greater than operator. The BatchPrediction results will have FilterVariable
 	// values that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than or equal to operator. The BatchPrediction results will have
 	// FilterVariable values that are less than or equal to the value specified
 	// with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than operator. The BatchPrediction results will have FilterVariable
 	// values that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The number of pages of information to include in the result. The range of
 	// acceptable values is 1 through 100. The default value is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The BatchPrediction results will have FilterVariable
 	// values not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// An ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -1650,14 +2169,16 @@ type DescribeBatchPredictionsInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of MLModels.
 	//
 	//   asc - Arranges the list in ascending order (A-Z, 0-9).  dsc - Arranges
 	// the list in descending order (Z-A, 9-0).  Results are sorted by FilterVariable.
-	SortOrder *string `type:"string"`
+	//
+	// See the SortOrder* constants for valid values.
+	SortOrder *string `type:"string" enum:"asc,dsc"`
 
 	metadataDescribeBatchPredictionsInput `json:"-" xml:"-"`
 }
@@ -1666,6 +2187,16 @@ type metadataDescribeBatchPredictionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeBatchPredictionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeBatchPredictionsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeBatchPredictions operation. The content
 // is essentially a list of BatchPredictions.
 type DescribeBatchPredictionsOutput struct {
@@ -1683,10 +2214,20 @@ type metadataDescribeBatchPredictionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeBatchPredictionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeBatchPredictionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeDataSourcesInput struct {
 	// The equal to operator. The DataSource results will have FilterVariable values
 	// that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// Use one of the following variables to filter a list of DataSource:
 	//
@@ -1697,30 +2238,32 @@ type DescribeDataSourcesInput struct {
 	// can identify either a file or an Amazon Simple Storage Service (Amazon S3)
 	// bucket or directory.  IAMUser - Sets the search criteria to the user account
 	// that invoked the DataSource creation.
-	FilterVariable *string `type:"string"`
+	//
+	// See the DataSourceFilterVariable* constants for valid values.
+	FilterVariable *string `type:"string" enum:"CreatedAt,LastUpdatedAt,Status,Name,DataLocationS3,IAMUser"`
 
 	// The greater than or equal to operator. The DataSource results will have FilterVariable
 	// values that are greater than or equal to the value specified with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The greater than operator. The DataSource results will have FilterVariable
 	// values that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than or equal to operator. The DataSource results will have FilterVariable
 	// values that are less than or equal to the value specified with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than operator. The DataSource results will have FilterVariable values
 	// that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The maximum number of DataSource to include in the result.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The DataSource results will have FilterVariable
 	// values not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -1736,14 +2279,16 @@ type DescribeDataSourcesInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of DataSource.
 	//
 	//   asc - Arranges the list in ascending order (A-Z, 0-9).  dsc - Arranges
 	// the list in descending order (Z-A, 9-0).  Results are sorted by FilterVariable.
-	SortOrder *string `type:"string"`
+	//
+	// See the SortOrder* constants for valid values.
+	SortOrder *string `type:"string" enum:"asc,dsc"`
 
 	metadataDescribeDataSourcesInput `json:"-" xml:"-"`
 }
@@ -1752,6 +2297,16 @@ type metadataDescribeDataSourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDataSourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDataSourcesInput) GoString() string {
+	return s.String()
+}
+
 // Represents the query results from a DescribeDataSources operation. The content
 // is essentially a list of DataSource.
 type DescribeDataSourcesOutput struct {
@@ -1769,10 +2324,20 @@ type metadataDescribeDataSourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDataSourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDataSourcesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeEvaluationsInput struct {
 	// The equal to operator. The Evaluation results will have FilterVariable values
 	// that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// Use one of the following variable to filter a list of Evaluation objects:
 	//
@@ -1785,30 +2350,32 @@ type DescribeEvaluationsInput struct {
 	// - Sets the search criteria to the data file(s) used in Evaluation. The URL
 	// can identify either a file or an Amazon Simple Storage Solution (Amazon S3)
 	// bucket or directory.
-	FilterVariable *string `type:"string"`
+	//
+	// See the EvaluationFilterVariable* constants for valid values.
+	FilterVariable *string `type:"string" enum:"CreatedAt,LastUpdatedAt,Status,Name,IAMUser,MLModelId,DataSourceId,DataURI"`
 
 	// The greater than or equal to operator. The Evaluation results will have FilterVariable
 	// values that are greater than or equal to the value specified with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The greater than operator. The Evaluation results will have FilterVariable
 	// values that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than or equal to operator. The Evaluation results will have FilterVariable
 	// values that are less than or equal to the value specified with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than operator. The Evaluation results will have FilterVariable values
 	// that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The maximum number of Evaluation to include in the result.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The Evaluation results will have FilterVariable
 	// values not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -1824,14 +2391,16 @@ type DescribeEvaluationsInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of Evaluation.
 	//
 	//   asc - Arranges the list in ascending order (A-Z, 0-9).  dsc - Arranges
 	// the list in descending order (Z-A, 9-0).  Results are sorted by FilterVariable.
-	SortOrder *string `type:"string"`
+	//
+	// See the SortOrder* constants for valid values.
+	SortOrder *string `type:"string" enum:"asc,dsc"`
 
 	metadataDescribeEvaluationsInput `json:"-" xml:"-"`
 }
@@ -1840,6 +2409,16 @@ type metadataDescribeEvaluationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEvaluationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEvaluationsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the query results from a DescribeEvaluations operation. The content
 // is essentially a list of Evaluation.
 type DescribeEvaluationsOutput struct {
@@ -1857,10 +2436,20 @@ type metadataDescribeEvaluationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeEvaluationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEvaluationsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeMLModelsInput struct {
 	// The equal to operator. The MLModel results will have FilterVariable values
 	// that exactly match the value specified with EQ.
-	EQ *string `type:"string"`
+	EQ *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// Use one of the following variables to filter a list of MLModel:
 	//
@@ -1875,31 +2464,33 @@ type DescribeMLModelsInput struct {
 	// to the algorithm that the MLModel uses.  TrainingDataURI - Sets the search
 	// criteria to the data file(s) used in training a MLModel. The URL can identify
 	// either a file or an Amazon Simple Storage Service (Amazon S3) bucket or directory.
-	FilterVariable *string `type:"string"`
+	//
+	// See the MLModelFilterVariable* constants for valid values.
+	FilterVariable *string `type:"string" enum:"CreatedAt,LastUpdatedAt,Status,Name,IAMUser,TrainingDataSourceId,RealtimeEndpointStatus,MLModelType,Algorithm,TrainingDataURI"`
 
 	// The greater than or equal to operator. The MLModel results will have FilterVariable
 	// values that are greater than or equal to the value specified with GE.
-	GE *string `type:"string"`
+	GE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The greater than operator. The MLModel results will have FilterVariable values
 	// that are greater than the value specified with GT.
-	GT *string `type:"string"`
+	GT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than or equal to operator. The MLModel results will have FilterVariable
 	// values that are less than or equal to the value specified with LE.
-	LE *string `type:"string"`
+	LE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The less than operator. The MLModel results will have FilterVariable values
 	// that are less than the value specified with LT.
-	LT *string `type:"string"`
+	LT *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The number of pages of information to include in the result. The range of
 	// acceptable values is 1 through 100. The default value is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	// The not equal to operator. The MLModel results will have FilterVariable values
 	// not equal to the value specified with NE.
-	NE *string `type:"string"`
+	NE *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The ID of the page in the paginated results.
 	NextToken *string `type:"string"`
@@ -1915,14 +2506,16 @@ type DescribeMLModelsInput struct {
 	// 2014-09-09
 	//
 	// 2014-09-09-Holiday
-	Prefix *string `type:"string"`
+	Prefix *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// A two-value parameter that determines the sequence of the resulting list
 	// of MLModel.
 	//
 	//   asc - Arranges the list in ascending order (A-Z, 0-9).  dsc - Arranges
 	// the list in descending order (Z-A, 9-0).  Results are sorted by FilterVariable.
-	SortOrder *string `type:"string"`
+	//
+	// See the SortOrder* constants for valid values.
+	SortOrder *string `type:"string" enum:"asc,dsc"`
 
 	metadataDescribeMLModelsInput `json:"-" xml:"-"`
 }
@@ -1931,6 +2524,16 @@ type metadataDescribeMLModelsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMLModelsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMLModelsInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a DescribeMLModels operation. The content is essentially
 // a list of MLModel.
 type DescribeMLModelsOutput struct {
@@ -1948,6 +2551,16 @@ type metadataDescribeMLModelsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMLModelsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMLModelsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of GetEvaluation operation.
 //
 // The content consists of the detailed metadata and data file information
@@ -1960,30 +2573,30 @@ type Evaluation struct {
 	// The AWS user account that invoked the evaluation. The account type can be
 	// either an AWS root account or an AWS Identity and Access Management (IAM)
 	// user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The ID of the DataSource that is used to evaluate the MLModel.
-	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string"`
+	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The ID that is assigned to the Evaluation at creation.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The location and name of the data in Amazon Simple Storage Server (Amazon
 	// S3) that is used in the evaluation.
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The time of the most recent edit to the Evaluation. The time is expressed
 	// in epoch time.
 	LastUpdatedAt *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID of the MLModel that is the focus of the evaluation.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A description of the most recent details about evaluating the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the Evaluation.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// Measurements of how well the MLModel performed, using observations referenced
 	// by the DataSource. One of the following metrics is returned, based on the
@@ -2011,7 +2624,9 @@ type Evaluation struct {
 	// to evaluate an MLModel did not run to completion. It is not usable.  COMPLETED
 	// - The evaluation process completed successfully.  DELETED - The Evaluation
 	// is marked as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	metadataEvaluation `json:"-" xml:"-"`
 }
@@ -2020,9 +2635,19 @@ type metadataEvaluation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Evaluation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Evaluation) GoString() string {
+	return s.String()
+}
+
 type GetBatchPredictionInput struct {
 	// An ID assigned to the BatchPrediction at creation.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -2031,14 +2656,24 @@ type metadataGetBatchPredictionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetBatchPredictionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetBatchPredictionInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a GetBatchPrediction operation and describes a BatchPrediction.
 type GetBatchPredictionOutput struct {
 	// The ID of the DataSource that was used to create the BatchPrediction.
-	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string"`
+	BatchPredictionDataSourceID *string `locationName:"BatchPredictionDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// An ID assigned to the BatchPrediction at creation. This value should be identical
 	// to the value of the BatchPredictionID in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The time when the BatchPrediction was created. The time is expressed in epoch
 	// time.
@@ -2047,11 +2682,11 @@ type GetBatchPredictionOutput struct {
 	// The AWS user account that invoked the BatchPrediction. The account type can
 	// be either an AWS root account or an AWS Identity and Access Management (IAM)
 	// user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The time of the most recent edit to BatchPrediction. The time is expressed
 	// in epoch time.
@@ -2062,18 +2697,18 @@ type GetBatchPredictionOutput struct {
 
 	// The ID of the MLModel that generated predictions for the BatchPrediction
 	// request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A description of the most recent details about processing the batch prediction
 	// request.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the BatchPrediction.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The location of an Amazon S3 bucket or directory to receive the operation
 	// results.
-	OutputURI *string `locationName:"OutputUri" type:"string"`
+	OutputURI *string `locationName:"OutputUri" type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The status of the BatchPrediction, which can be one of the following values:
 	//
@@ -2082,7 +2717,9 @@ type GetBatchPredictionOutput struct {
 	// FAILED - The request to perform a batch prediction did not run to completion.
 	// It is not usable.  COMPLETED - The batch prediction process completed successfully.
 	//  DELETED - The BatchPrediction is marked as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	metadataGetBatchPredictionOutput `json:"-" xml:"-"`
 }
@@ -2091,9 +2728,19 @@ type metadataGetBatchPredictionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetBatchPredictionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetBatchPredictionOutput) GoString() string {
+	return s.String()
+}
+
 type GetDataSourceInput struct {
 	// The ID assigned to the DataSource at creation.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Specifies whether the GetDataSource operation should return DataSourceSchema.
 	//
@@ -2109,6 +2756,16 @@ type metadataGetDataSourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDataSourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDataSourceInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a GetDataSource operation and describes a DataSource.
 type GetDataSourceOutput struct {
 	// The parameter is true if statistics need to be generated from the observation
@@ -2122,11 +2779,11 @@ type GetDataSourceOutput struct {
 	// The AWS user account from which the DataSource was created. The account type
 	// can be either an AWS root account or an AWS Identity and Access Management
 	// (IAM) user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	DataLocationS3 *string `type:"string"`
+	DataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// A JSON string that captures the splitting rearrangement requirement of the
 	// DataSource.
@@ -2137,12 +2794,12 @@ type GetDataSourceOutput struct {
 
 	// The ID assigned to the DataSource at creation. This value should be identical
 	// to the value of the DataSourceId in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The schema used by all of the data files of this DataSource.
 	//
 	// Note This parameter is provided as part of the verbose format.
-	DataSourceSchema *string `type:"string"`
+	DataSourceSchema *string `type:"string" max:"131071"`
 
 	// The time of the most recent edit to the DataSource. The time is expressed
 	// in epoch time.
@@ -2152,10 +2809,10 @@ type GetDataSourceOutput struct {
 	LogURI *string `locationName:"LogUri" type:"string"`
 
 	// The description of the most recent details about creating the DataSource.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the DataSource.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The number of data files referenced by the DataSource.
 	NumberOfFiles *int64 `type:"long"`
@@ -2168,7 +2825,7 @@ type GetDataSourceOutput struct {
 
 	// The Amazon Resource Name (ARN) of an AWS IAM Role (http://docs.aws.amazon.com/IAM/latest/UserGuide/roles-toplevel.html#roles-about-termsandconcepts)
 	// such as the following: arn:aws:iam::account:role/rolename.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"100"`
 
 	// The current status of the DataSource. This element can have one of the following
 	// values:
@@ -2178,7 +2835,9 @@ type GetDataSourceOutput struct {
 	// request to create a DataSource did not run to completion. It is not usable.
 	//  COMPLETED - The creation process completed successfully.  DELETED - The
 	// DataSource is marked as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	metadataGetDataSourceOutput `json:"-" xml:"-"`
 }
@@ -2187,10 +2846,20 @@ type metadataGetDataSourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDataSourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDataSourceOutput) GoString() string {
+	return s.String()
+}
+
 type GetEvaluationInput struct {
 	// The ID of the Evaluation to retrieve. The evaluation of each MLModel is recorded
 	// and cataloged. The ID provides the means to access the information.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetEvaluationInput `json:"-" xml:"-"`
 }
@@ -2199,6 +2868,16 @@ type metadataGetEvaluationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetEvaluationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetEvaluationInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a GetEvaluation operation and describes an Evaluation.
 type GetEvaluationOutput struct {
 	// The time that the Evaluation was created. The time is expressed in epoch
@@ -2208,17 +2887,17 @@ type GetEvaluationOutput struct {
 	// The AWS user account that invoked the evaluation. The account type can be
 	// either an AWS root account or an AWS Identity and Access Management (IAM)
 	// user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The DataSource used for this evaluation.
-	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string"`
+	EvaluationDataSourceID *string `locationName:"EvaluationDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The evaluation ID which is same as the EvaluationId in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The time of the most recent edit to the BatchPrediction. The time is expressed
 	// in epoch time.
@@ -2228,13 +2907,13 @@ type GetEvaluationOutput struct {
 	LogURI *string `locationName:"LogUri" type:"string"`
 
 	// The ID of the MLModel that was the focus of the evaluation.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A description of the most recent details about evaluating the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the Evaluation.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// Measurements of how well the MLModel performed using observations referenced
 	// by the DataSource. One of the following metric is returned based on the type
@@ -2262,7 +2941,9 @@ type GetEvaluationOutput struct {
 	// to evaluate an MLModel did not run to completion. It is not usable.  COMPLETED
 	// - The evaluation process completed successfully.  DELETED - The Evaluation
 	// is marked as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	metadataGetEvaluationOutput `json:"-" xml:"-"`
 }
@@ -2271,9 +2952,19 @@ type metadataGetEvaluationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetEvaluationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetEvaluationOutput) GoString() string {
+	return s.String()
+}
+
 type GetMLModelInput struct {
 	// The ID assigned to the MLModel at creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Specifies whether the GetMLModel operation should return Recipe.
 	//
@@ -2289,6 +2980,16 @@ type metadataGetMLModelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetMLModelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetMLModelInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a GetMLModel operation, and provides detailed information
 // about a MLModel.
 type GetMLModelOutput struct {
@@ -2298,14 +2999,14 @@ type GetMLModelOutput struct {
 	// The AWS user account from which the MLModel was created. The account type
 	// can be either an AWS root account or an AWS Identity and Access Management
 	// (IAM) user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The current endpoint of the MLModel
 	EndpointInfo *RealtimeEndpointInfo `type:"structure"`
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The time of the most recent edit to the MLModel. The time is expressed in
 	// epoch time.
@@ -2315,7 +3016,7 @@ type GetMLModelOutput struct {
 	LogURI *string `locationName:"LogUri" type:"string"`
 
 	// The MLModel ID which is same as the MLModelId in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// Identifies the MLModel category. The following are the available types:
 	//
@@ -2323,25 +3024,27 @@ type GetMLModelOutput struct {
 	// should a house have?" BINARY -- Produces one of two possible results. For
 	// example, "Is this an e-commerce website?" MULTICLASS -- Produces more than
 	// two possible results. For example, "Is this a HIGH, LOW or MEDIUM risk trade?"
-	MLModelType *string `type:"string"`
+	//
+	// See the MLModelType* constants for valid values.
+	MLModelType *string `type:"string" enum:"REGRESSION,BINARY,MULTICLASS"`
 
 	// Description of the most recent details about accessing the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the MLModel.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	// The recipe to use when training the MLModel. The Recipe provides detailed
 	// information about the observation data to use during training, as well as
 	// manipulations to perform on the observation data during training.
 	//
 	// Note This parameter is provided as part of the verbose format.
-	Recipe *string `type:"string"`
+	Recipe *string `type:"string" max:"131071"`
 
 	// The schema used by all of the data files referenced by the DataSource.
 	//
 	// Note This parameter is provided as part of the verbose format.
-	Schema *string `type:"string"`
+	Schema *string `type:"string" max:"131071"`
 
 	// The scoring threshold is used in binary classification MLModels, and marks
 	// the boundary between a positive prediction and a negative prediction.
@@ -2365,10 +3068,12 @@ type GetMLModelOutput struct {
 	// a MLModel.  INPROGRESS - The request is processing.  FAILED - The request
 	// did not run to completion. It is not usable.  COMPLETED - The request completed
 	// successfully.  DELETED - The MLModel is marked as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	// The ID of the training DataSource.
-	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string"`
+	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A list of the training parameters in the MLModel. The list is implemented
 	// as a map of key/value pairs.
@@ -2411,6 +3116,16 @@ type metadataGetMLModelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetMLModelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetMLModelOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of a GetMLModel operation.
 //
 // The content consists of the detailed metadata and the current status of
@@ -2420,7 +3135,9 @@ type MLModel struct {
 	//
 	//  SGD -- Stochastic gradient descent. The goal of SGD is to minimize the
 	// gradient of the loss function.
-	Algorithm *string `type:"string"`
+	//
+	// See the Algorithm* constants for valid values.
+	Algorithm *string `type:"string" enum:"sgd"`
 
 	// The time that the MLModel was created. The time is expressed in epoch time.
 	CreatedAt *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -2428,21 +3145,21 @@ type MLModel struct {
 	// The AWS user account from which the MLModel was created. The account type
 	// can be either an AWS root account or an AWS Identity and Access Management
 	// (IAM) user account.
-	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string"`
+	CreatedByIAMUser *string `locationName:"CreatedByIamUser" type:"string" pattern:"arn:aws:iam::[0-9]+:((user/.+)|(root))"`
 
 	// The current endpoint of the MLModel.
 	EndpointInfo *RealtimeEndpointInfo `type:"structure"`
 
 	// The location of the data file or directory in Amazon Simple Storage Service
 	// (Amazon S3).
-	InputDataLocationS3 *string `type:"string"`
+	InputDataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The time of the most recent edit to the MLModel. The time is expressed in
 	// epoch time.
 	LastUpdatedAt *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID assigned to the MLModel at creation.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// Identifies the MLModel category. The following are the available types:
 	//
@@ -2451,13 +3168,15 @@ type MLModel struct {
 	// example, "Is this a child-friendly web site?". MULTICLASS - Produces more
 	// than two possible results. For example, "Is this a HIGH, LOW or MEDIUM risk
 	// trade?".
-	MLModelType *string `type:"string"`
+	//
+	// See the MLModelType* constants for valid values.
+	MLModelType *string `type:"string" enum:"REGRESSION,BINARY,MULTICLASS"`
 
 	// A description of the most recent details about accessing the MLModel.
-	Message *string `type:"string"`
+	Message *string `type:"string" max:"10240"`
 
 	// A user-supplied name or description of the MLModel.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"1024"`
 
 	ScoreThreshold *float64 `type:"float"`
 
@@ -2476,10 +3195,12 @@ type MLModel struct {
 	// to create an MLModel did not run to completion. It is not usable. COMPLETED
 	// - The creation process completed successfully. DELETED - The MLModel is marked
 	// as deleted. It is not usable.
-	Status *string `type:"string"`
+	//
+	// See the EntityStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INPROGRESS,FAILED,COMPLETED,DELETED"`
 
 	// The ID of the training DataSource. The CreateMLModel operation uses the TrainingDataSourceId.
-	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string"`
+	TrainingDataSourceID *string `locationName:"TrainingDataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A list of the training parameters in the MLModel. The list is implemented
 	// as a map of key/value pairs.
@@ -2522,6 +3243,16 @@ type metadataMLModel struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MLModel) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MLModel) GoString() string {
+	return s.String()
+}
+
 // Measurements of how well the MLModel performed on known observations. One
 // of the following metrics is returned, based on the type of the MLModel:
 //
@@ -2547,11 +3278,21 @@ type metadataPerformanceMetrics struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PerformanceMetrics) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PerformanceMetrics) GoString() string {
+	return s.String()
+}
+
 type PredictInput struct {
 	// A unique identifier of the MLModel.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
-	PredictEndpoint *string `type:"string" required:"true"`
+	PredictEndpoint *string `type:"string" max:"2048" pattern:"https://[a-zA-Z0-9-.]*\\.amazon(aws)?\\.com[/]?" required:"true"`
 
 	// A map of variable name-value pairs that represent an observation.
 	Record map[string]*string `type:"map" required:"true"`
@@ -2563,6 +3304,16 @@ type metadataPredictInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PredictInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PredictInput) GoString() string {
+	return s.String()
+}
+
 type PredictOutput struct {
 	// The output from a Predict operation:
 	//
@@ -2584,6 +3335,16 @@ type metadataPredictOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PredictOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PredictOutput) GoString() string {
+	return s.String()
+}
+
 // The output from a Predict operation:
 //
 //    Details - Contains the following attributes: DetailsAttributes.PREDICTIVE_MODEL_TYPE
@@ -2600,7 +3361,7 @@ type Prediction struct {
 	Details map[string]*string `locationName:"details" type:"map"`
 
 	// The prediction label for either a BINARY or MULTICLASS MLModel.
-	PredictedLabel *string `locationName:"predictedLabel" type:"string"`
+	PredictedLabel *string `locationName:"predictedLabel" type:"string" min:"1"`
 
 	// Provides the raw classification score corresponding to each label.
 	PredictedScores map[string]*float64 `locationName:"predictedScores" type:"map"`
@@ -2615,6 +3376,16 @@ type metadataPrediction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Prediction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Prediction) GoString() string {
+	return s.String()
+}
+
 // The data specification of an Amazon Relational Database Service (Amazon RDS)
 // DataSource.
 type RDSDataSpec struct {
@@ -2626,10 +3397,10 @@ type RDSDataSpec struct {
 
 	// A JSON string that represents the schema. This is not required if DataSchemaUri
 	// is specified.
-	DataSchema *string `type:"string"`
+	DataSchema *string `type:"string" max:"131071"`
 
 	// The Amazon S3 location of the DataSchema.
-	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string"`
+	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// The AWS Identity and Access Management (IAM) credentials that are used connect
 	// to the Amazon RDS database.
@@ -2642,11 +3413,11 @@ type RDSDataSpec struct {
 	// Cloud (Amazon EC2) instance to carry out the copy operation from Amazon RDS
 	// to an Amazon S3 task. For more information, see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ResourceRole *string `type:"string" required:"true"`
+	ResourceRole *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The Amazon S3 location for staging Amazon RDS data. The data retrieved from
 	// Amazon RDS using SelectSqlQuery is stored in this location.
-	S3StagingLocation *string `type:"string" required:"true"`
+	S3StagingLocation *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?" required:"true"`
 
 	// The security group IDs to be used to access a VPC-based RDS DB instance.
 	// Ensure that there are appropriate ingress rules set up to allow access to
@@ -2655,18 +3426,18 @@ type RDSDataSpec struct {
 	SecurityGroupIDs []*string `locationName:"SecurityGroupIds" type:"list" required:"true"`
 
 	// The query that is used to retrieve the observation data for the DataSource.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" required:"true"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216" required:"true"`
 
 	// The role (DataPipelineDefaultRole) assumed by AWS Data Pipeline service to
 	// monitor the progress of the copy task from Amazon RDS to Amazon S3. For more
 	// information, see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ServiceRole *string `type:"string" required:"true"`
+	ServiceRole *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The subnet ID to be used to access a VPC-based RDS DB instance. This attribute
 	// is used by Data Pipeline to carry out the copy task from Amazon RDS to Amazon
 	// S3.
-	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
+	SubnetID *string `locationName:"SubnetId" type:"string" min:"1" max:"255" required:"true"`
 
 	metadataRDSDataSpec `json:"-" xml:"-"`
 }
@@ -2675,13 +3446,23 @@ type metadataRDSDataSpec struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RDSDataSpec) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RDSDataSpec) GoString() string {
+	return s.String()
+}
+
 // The database details of an Amazon RDS database.
 type RDSDatabase struct {
 	// The name of a database hosted on an RDS DB instance.
-	DatabaseName *string `type:"string" required:"true"`
+	DatabaseName *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// The ID of an RDS DB instance.
-	InstanceIdentifier *string `type:"string" required:"true"`
+	InstanceIdentifier *string `type:"string" min:"1" max:"63" pattern:"[a-z0-9-]+" required:"true"`
 
 	metadataRDSDatabase `json:"-" xml:"-"`
 }
@@ -2690,17 +3471,27 @@ type metadataRDSDatabase struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RDSDatabase) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RDSDatabase) GoString() string {
+	return s.String()
+}
+
 // The database credentials to connect to a database on an RDS DB instance.
 type RDSDatabaseCredentials struct {
 	// The password to be used by Amazon ML to connect to a database on an RDS DB
 	// instance. The password should have sufficient permissions to execute the
 	// RDSSelectQuery query.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"8" max:"128" required:"true"`
 
 	// The username to be used by Amazon ML to connect to database on an Amazon
 	// RDS instance. The username should have sufficient permissions to execute
 	// an RDSSelectSqlQuery query.
-	Username *string `type:"string" required:"true"`
+	Username *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataRDSDatabaseCredentials `json:"-" xml:"-"`
 }
@@ -2709,12 +3500,22 @@ type metadataRDSDatabaseCredentials struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RDSDatabaseCredentials) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RDSDatabaseCredentials) GoString() string {
+	return s.String()
+}
+
 // The datasource details that are specific to Amazon RDS.
 type RDSMetadata struct {
 	// The ID of the Data Pipeline instance that is used to carry to copy data from
 	// Amazon RDS to Amazon S3. You can use the ID to find details about the instance
 	// in the Data Pipeline console.
-	DataPipelineID *string `locationName:"DataPipelineId" type:"string"`
+	DataPipelineID *string `locationName:"DataPipelineId" type:"string" min:"1" max:"1024"`
 
 	// The database details required to connect to an Amazon RDS.
 	Database *RDSDatabase `type:"structure"`
@@ -2722,23 +3523,23 @@ type RDSMetadata struct {
 	// The username to be used by Amazon ML to connect to database on an Amazon
 	// RDS instance. The username should have sufficient permissions to execute
 	// an RDSSelectSqlQuery query.
-	DatabaseUserName *string `type:"string"`
+	DatabaseUserName *string `type:"string" min:"1" max:"128"`
 
 	// The role (DataPipelineDefaultResourceRole) assumed by an Amazon EC2 instance
 	// to carry out the copy task from Amazon RDS to Amazon S3. For more information,
 	// see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ResourceRole *string `type:"string"`
+	ResourceRole *string `type:"string" min:"1" max:"64"`
 
 	// The SQL query that is supplied during CreateDataSourceFromRDS. Returns only
 	// if Verbose is true in GetDataSourceInput.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216"`
 
 	// The role (DataPipelineDefaultRole) assumed by the Data Pipeline service to
 	// monitor the progress of the copy task from Amazon RDS to Amazon S3. For more
 	// information, see Role templates (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-iam-roles.html)
 	// for data pipelines.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" min:"1" max:"64"`
 
 	metadataRDSMetadata `json:"-" xml:"-"`
 }
@@ -2747,6 +3548,16 @@ type metadataRDSMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RDSMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RDSMetadata) GoString() string {
+	return s.String()
+}
+
 // Describes the real-time endpoint information for an MLModel.
 type RealtimeEndpointInfo struct {
 	// The time that the request to create the real-time endpoint for the MLModel
@@ -2759,14 +3570,16 @@ type RealtimeEndpointInfo struct {
 	//  NONE - Endpoint does not exist or was previously deleted. READY - Endpoint
 	// is ready to be used for real-time predictions. UPDATING - Updating/creating
 	// the endpoint.
-	EndpointStatus *string `type:"string"`
+	//
+	// See the RealtimeEndpointStatus* constants for valid values.
+	EndpointStatus *string `type:"string" enum:"NONE,READY,UPDATING,FAILED"`
 
 	// The URI that specifies where to send real-time prediction requests for the
 	// MLModel.
 	//
 	// Note The application must wait until the real-time endpoint is ready before
 	// using this URI.
-	EndpointURL *string `locationName:"EndpointUrl" type:"string"`
+	EndpointURL *string `locationName:"EndpointUrl" type:"string" max:"2048" pattern:"https://[a-zA-Z0-9-.]*\\.amazon(aws)?\\.com[/]?"`
 
 	// The maximum processing rate for the real-time endpoint for MLModel, measured
 	// in incoming requests per second.
@@ -2779,16 +3592,26 @@ type metadataRealtimeEndpointInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RealtimeEndpointInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RealtimeEndpointInfo) GoString() string {
+	return s.String()
+}
+
 // Describes the data specification of an Amazon Redshift DataSource.
 type RedshiftDataSpec struct {
 	// Describes the splitting specifications for a DataSource.
 	DataRearrangement *string `type:"string"`
 
 	// Describes the schema for an Amazon Redshift DataSource.
-	DataSchema *string `type:"string"`
+	DataSchema *string `type:"string" max:"131071"`
 
 	// Describes the schema location for an Amazon Redshift DataSource.
-	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string"`
+	DataSchemaURI *string `locationName:"DataSchemaUri" type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	// Describes AWS Identity and Access Management (IAM) credentials that are used
 	// connect to the Amazon Redshift database.
@@ -2799,11 +3622,11 @@ type RedshiftDataSpec struct {
 
 	// Describes an Amazon S3 location to store the result set of the SelectSqlQuery
 	// query.
-	S3StagingLocation *string `type:"string" required:"true"`
+	S3StagingLocation *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?" required:"true"`
 
 	// Describes the SQL Query to execute on an Amazon Redshift database for an
 	// Amazon Redshift DataSource.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" required:"true"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216" required:"true"`
 
 	metadataRedshiftDataSpec `json:"-" xml:"-"`
 }
@@ -2812,14 +3635,24 @@ type metadataRedshiftDataSpec struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RedshiftDataSpec) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RedshiftDataSpec) GoString() string {
+	return s.String()
+}
+
 // Describes the database details required to connect to an Amazon Redshift
 // database.
 type RedshiftDatabase struct {
 	// The ID of an Amazon Redshift cluster.
-	ClusterIdentifier *string `type:"string" required:"true"`
+	ClusterIdentifier *string `type:"string" min:"1" max:"63" pattern:"[a-z0-9-]+" required:"true"`
 
 	// The name of a database hosted on an Amazon Redshift cluster.
-	DatabaseName *string `type:"string" required:"true"`
+	DatabaseName *string `type:"string" min:"1" max:"64" pattern:"[a-z0-9]+" required:"true"`
 
 	metadataRedshiftDatabase `json:"-" xml:"-"`
 }
@@ -2828,6 +3661,16 @@ type metadataRedshiftDatabase struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RedshiftDatabase) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RedshiftDatabase) GoString() string {
+	return s.String()
+}
+
 // Describes the database credentials for connecting to a database on an Amazon
 // Redshift cluster.
 type RedshiftDatabaseCredentials struct {
@@ -2835,13 +3678,13 @@ type RedshiftDatabaseCredentials struct {
 	// Redshift cluster. The password should have sufficient permissions to execute
 	// a RedshiftSelectSqlQuery query. The password should be valid for an Amazon
 	// Redshift USER (http://docs.aws.amazon.com/redshift/latest/dg/r_CREATE_USER.html).
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"8" max:"64" required:"true"`
 
 	// A username to be used by Amazon Machine Learning (Amazon ML)to connect to
 	// a database on an Amazon Redshift cluster. The username should have sufficient
 	// permissions to execute the RedshiftSelectSqlQuery query. The username should
 	// be valid for an Amazon Redshift USER (http://docs.aws.amazon.com/redshift/latest/dg/r_CREATE_USER.html).
-	Username *string `type:"string" required:"true"`
+	Username *string `type:"string" min:"1" max:"128" required:"true"`
 
 	metadataRedshiftDatabaseCredentials `json:"-" xml:"-"`
 }
@@ -2850,13 +3693,23 @@ type metadataRedshiftDatabaseCredentials struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RedshiftDatabaseCredentials) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RedshiftDatabaseCredentials) GoString() string {
+	return s.String()
+}
+
 // Describes the DataSource details specific to Amazon Redshift.
 type RedshiftMetadata struct {
 	// A username to be used by Amazon Machine Learning (Amazon ML)to connect to
 	// a database on an Amazon Redshift cluster. The username should have sufficient
 	// permissions to execute the RedshiftSelectSqlQuery query. The username should
 	// be valid for an Amazon Redshift USER (http://docs.aws.amazon.com/redshift/latest/dg/r_CREATE_USER.html).
-	DatabaseUserName *string `type:"string"`
+	DatabaseUserName *string `type:"string" min:"1" max:"128"`
 
 	// Describes the database details required to connect to an Amazon Redshift
 	// database.
@@ -2864,7 +3717,7 @@ type RedshiftMetadata struct {
 
 	// The SQL query that is specified during CreateDataSourceFromRedshift. Returns
 	// only if Verbose is true in GetDataSourceInput.
-	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string"`
+	SelectSQLQuery *string `locationName:"SelectSqlQuery" type:"string" min:"1" max:"16777216"`
 
 	metadataRedshiftMetadata `json:"-" xml:"-"`
 }
@@ -2873,21 +3726,31 @@ type metadataRedshiftMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RedshiftMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RedshiftMetadata) GoString() string {
+	return s.String()
+}
+
 // Describes the data specification of a DataSource.
 type S3DataSpec struct {
 	// The location of the data file(s) used by a DataSource. The URI specifies
 	// a data file or an Amazon Simple Storage Service (Amazon S3) directory or
 	// bucket containing data files.
-	DataLocationS3 *string `type:"string" required:"true"`
+	DataLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?" required:"true"`
 
 	// Describes the splitting requirement of a Datasource.
 	DataRearrangement *string `type:"string"`
 
 	// Describes the schema for an Amazon S3 DataSource.
-	DataSchema *string `type:"string"`
+	DataSchema *string `type:"string" max:"131071"`
 
 	// Describes the schema Location in Amazon S3.
-	DataSchemaLocationS3 *string `type:"string"`
+	DataSchemaLocationS3 *string `type:"string" max:"2048" pattern:"s3://([^/]+)(/.*)?"`
 
 	metadataS3DataSpec `json:"-" xml:"-"`
 }
@@ -2896,12 +3759,22 @@ type metadataS3DataSpec struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s S3DataSpec) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s S3DataSpec) GoString() string {
+	return s.String()
+}
+
 type UpdateBatchPredictionInput struct {
 	// The ID assigned to the BatchPrediction during creation.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" required:"true"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A new user-supplied name or description of the BatchPrediction.
-	BatchPredictionName *string `type:"string" required:"true"`
+	BatchPredictionName *string `type:"string" max:"1024" pattern:".*\\S.*|^$" required:"true"`
 
 	metadataUpdateBatchPredictionInput `json:"-" xml:"-"`
 }
@@ -2910,13 +3783,23 @@ type metadataUpdateBatchPredictionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateBatchPredictionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateBatchPredictionInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an UpdateBatchPrediction operation.
 //
 // You can see the updated content by using the GetBatchPrediction operation.
 type UpdateBatchPredictionOutput struct {
 	// The ID assigned to the BatchPrediction during creation. This value should
 	// be identical to the value of the BatchPredictionId in the request.
-	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string"`
+	BatchPredictionID *string `locationName:"BatchPredictionId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataUpdateBatchPredictionOutput `json:"-" xml:"-"`
 }
@@ -2925,13 +3808,23 @@ type metadataUpdateBatchPredictionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateBatchPredictionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateBatchPredictionOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateDataSourceInput struct {
 	// The ID assigned to the DataSource during creation.
-	DataSourceID *string `locationName:"DataSourceId" type:"string" required:"true"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A new user-supplied name or description of the DataSource that will replace
 	// the current description.
-	DataSourceName *string `type:"string" required:"true"`
+	DataSourceName *string `type:"string" max:"1024" pattern:".*\\S.*|^$" required:"true"`
 
 	metadataUpdateDataSourceInput `json:"-" xml:"-"`
 }
@@ -2940,13 +3833,23 @@ type metadataUpdateDataSourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDataSourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDataSourceInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an UpdateDataSource operation.
 //
 // You can see the updated content by using the GetBatchPrediction operation.
 type UpdateDataSourceOutput struct {
 	// The ID assigned to the DataSource during creation. This value should be identical
 	// to the value of the DataSourceID in the request.
-	DataSourceID *string `locationName:"DataSourceId" type:"string"`
+	DataSourceID *string `locationName:"DataSourceId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataUpdateDataSourceOutput `json:"-" xml:"-"`
 }
@@ -2955,13 +3858,23 @@ type metadataUpdateDataSourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDataSourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDataSourceOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateEvaluationInput struct {
 	// The ID assigned to the Evaluation during creation.
-	EvaluationID *string `locationName:"EvaluationId" type:"string" required:"true"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A new user-supplied name or description of the Evaluation that will replace
 	// the current content.
-	EvaluationName *string `type:"string" required:"true"`
+	EvaluationName *string `type:"string" max:"1024" pattern:".*\\S.*|^$" required:"true"`
 
 	metadataUpdateEvaluationInput `json:"-" xml:"-"`
 }
@@ -2970,13 +3883,23 @@ type metadataUpdateEvaluationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateEvaluationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateEvaluationInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an UpdateEvaluation operation.
 //
 // You can see the updated content by using the GetEvaluation operation.
 type UpdateEvaluationOutput struct {
 	// The ID assigned to the Evaluation during creation. This value should be identical
 	// to the value of the Evaluation in the request.
-	EvaluationID *string `locationName:"EvaluationId" type:"string"`
+	EvaluationID *string `locationName:"EvaluationId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataUpdateEvaluationOutput `json:"-" xml:"-"`
 }
@@ -2985,12 +3908,22 @@ type metadataUpdateEvaluationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateEvaluationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateEvaluationOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateMLModelInput struct {
 	// The ID assigned to the MLModel during creation.
-	MLModelID *string `locationName:"MLModelId" type:"string" required:"true"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A user-supplied name or description of the MLModel.
-	MLModelName *string `type:"string"`
+	MLModelName *string `type:"string" max:"1024" pattern:".*\\S.*|^$"`
 
 	// The ScoreThreshold used in binary classification MLModel that marks the boundary
 	// between a positive prediction and a negative prediction.
@@ -3007,13 +3940,23 @@ type metadataUpdateMLModelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateMLModelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateMLModelInput) GoString() string {
+	return s.String()
+}
+
 // Represents the output of an UpdateMLModel operation.
 //
 // You can see the updated content by using the GetMLModel operation.
 type UpdateMLModelOutput struct {
 	// The ID assigned to the MLModel during creation. This value should be identical
 	// to the value of the MLModelID in the request.
-	MLModelID *string `locationName:"MLModelId" type:"string"`
+	MLModelID *string `locationName:"MLModelId" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataUpdateMLModelOutput `json:"-" xml:"-"`
 }
@@ -3021,3 +3964,141 @@ type UpdateMLModelOutput struct {
 type metadataUpdateMLModelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateMLModelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateMLModelOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum Algorithm
+	AlgorithmSgd = "sgd"
+)
+
+const (
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableCreatedAt = "CreatedAt"
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableLastUpdatedAt = "LastUpdatedAt"
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableStatus = "Status"
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableName = "Name"
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableIAMUser = "IAMUser"
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableMLModelId = "MLModelId"
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableDataSourceId = "DataSourceId"
+	// @enum BatchPredictionFilterVariable
+	BatchPredictionFilterVariableDataURI = "DataURI"
+)
+
+const (
+	// @enum DataSourceFilterVariable
+	DataSourceFilterVariableCreatedAt = "CreatedAt"
+	// @enum DataSourceFilterVariable
+	DataSourceFilterVariableLastUpdatedAt = "LastUpdatedAt"
+	// @enum DataSourceFilterVariable
+	DataSourceFilterVariableStatus = "Status"
+	// @enum DataSourceFilterVariable
+	DataSourceFilterVariableName = "Name"
+	// @enum DataSourceFilterVariable
+	DataSourceFilterVariableDataLocationS3 = "DataLocationS3"
+	// @enum DataSourceFilterVariable
+	DataSourceFilterVariableIAMUser = "IAMUser"
+)
+
+const (
+	// @enum DetailsAttributes
+	DetailsAttributesPredictiveModelType = "PredictiveModelType"
+	// @enum DetailsAttributes
+	DetailsAttributesAlgorithm = "Algorithm"
+)
+
+const (
+	// @enum EntityStatus
+	EntityStatusPending = "PENDING"
+	// @enum EntityStatus
+	EntityStatusInprogress = "INPROGRESS"
+	// @enum EntityStatus
+	EntityStatusFailed = "FAILED"
+	// @enum EntityStatus
+	EntityStatusCompleted = "COMPLETED"
+	// @enum EntityStatus
+	EntityStatusDeleted = "DELETED"
+)
+
+const (
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableCreatedAt = "CreatedAt"
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableLastUpdatedAt = "LastUpdatedAt"
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableStatus = "Status"
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableName = "Name"
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableIAMUser = "IAMUser"
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableMLModelId = "MLModelId"
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableDataSourceId = "DataSourceId"
+	// @enum EvaluationFilterVariable
+	EvaluationFilterVariableDataURI = "DataURI"
+)
+
+const (
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableCreatedAt = "CreatedAt"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableLastUpdatedAt = "LastUpdatedAt"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableStatus = "Status"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableName = "Name"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableIAMUser = "IAMUser"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableTrainingDataSourceId = "TrainingDataSourceId"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableRealtimeEndpointStatus = "RealtimeEndpointStatus"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableMLModelType = "MLModelType"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableAlgorithm = "Algorithm"
+	// @enum MLModelFilterVariable
+	MLModelFilterVariableTrainingDataURI = "TrainingDataURI"
+)
+
+const (
+	// @enum MLModelType
+	MLModelTypeRegression = "REGRESSION"
+	// @enum MLModelType
+	MLModelTypeBinary = "BINARY"
+	// @enum MLModelType
+	MLModelTypeMulticlass = "MULTICLASS"
+)
+
+const (
+	// @enum RealtimeEndpointStatus
+	RealtimeEndpointStatusNone = "NONE"
+	// @enum RealtimeEndpointStatus
+	RealtimeEndpointStatusReady = "READY"
+	// @enum RealtimeEndpointStatus
+	RealtimeEndpointStatusUpdating = "UPDATING"
+	// @enum RealtimeEndpointStatus
+	RealtimeEndpointStatusFailed = "FAILED"
+)
+
+const (
+	// @enum SortOrder
+	SortOrderAsc = "asc"
+	// @enum SortOrder
+	SortOrderDsc = "dsc"
+)
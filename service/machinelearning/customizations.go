@@ -6,11 +6,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 )
 
+// UpdatePredictEndpointHandler is a named request handler which sends Predict
+// requests to the operation's PredictEndpoint parameter.
+var UpdatePredictEndpointHandler = aws.NamedHandler{Name: "machinelearning.UpdatePredictEndpointHandler", Fn: updatePredictEndpoint}
+
 func init() {
 	initRequest = func(r *aws.Request) {
 		switch r.Operation.Name {
 		case opPredict:
-			r.Handlers.Build.PushBack(updatePredictEndpoint)
+			r.Handlers.Build.PushBackNamed(UpdatePredictEndpointHandler)
 		}
 	}
 }
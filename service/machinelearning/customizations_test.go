@@ -26,12 +26,12 @@ func TestPredictEndpoint(t *testing.T) {
 	})
 
 	req, _ := ml.PredictRequest(&machinelearning.PredictInput{
-		PredictEndpoint: aws.String("https://localhost/endpoint"),
+		PredictEndpoint: aws.String("https://realtime.machinelearning.us-east-1.amazonaws.com/endpoint"),
 		MLModelID:       aws.String("id"),
 		Record:          map[string]*string{},
 	})
 	err := req.Send()
 
 	assert.Nil(t, err)
-	assert.Equal(t, "https://localhost/endpoint", req.HTTPRequest.URL.String())
+	assert.Equal(t, "https://realtime.machinelearning.us-east-1.amazonaws.com/endpoint", req.HTTPRequest.URL.String())
 }
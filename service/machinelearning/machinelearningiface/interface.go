@@ -4,6 +4,7 @@
 package machinelearningiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
 )
 
@@ -11,51 +12,101 @@ import (
 type MachineLearningAPI interface {
 	CreateBatchPrediction(*machinelearning.CreateBatchPredictionInput) (*machinelearning.CreateBatchPredictionOutput, error)
 
+	CreateBatchPredictionWithContext(aws.Context, *machinelearning.CreateBatchPredictionInput) (*machinelearning.CreateBatchPredictionOutput, error)
+
 	CreateDataSourceFromRDS(*machinelearning.CreateDataSourceFromRDSInput) (*machinelearning.CreateDataSourceFromRDSOutput, error)
 
+	CreateDataSourceFromRDSWithContext(aws.Context, *machinelearning.CreateDataSourceFromRDSInput) (*machinelearning.CreateDataSourceFromRDSOutput, error)
+
 	CreateDataSourceFromRedshift(*machinelearning.CreateDataSourceFromRedshiftInput) (*machinelearning.CreateDataSourceFromRedshiftOutput, error)
 
+	CreateDataSourceFromRedshiftWithContext(aws.Context, *machinelearning.CreateDataSourceFromRedshiftInput) (*machinelearning.CreateDataSourceFromRedshiftOutput, error)
+
 	CreateDataSourceFromS3(*machinelearning.CreateDataSourceFromS3Input) (*machinelearning.CreateDataSourceFromS3Output, error)
 
+	CreateDataSourceFromS3WithContext(aws.Context, *machinelearning.CreateDataSourceFromS3Input) (*machinelearning.CreateDataSourceFromS3Output, error)
+
 	CreateEvaluation(*machinelearning.CreateEvaluationInput) (*machinelearning.CreateEvaluationOutput, error)
 
+	CreateEvaluationWithContext(aws.Context, *machinelearning.CreateEvaluationInput) (*machinelearning.CreateEvaluationOutput, error)
+
 	CreateMLModel(*machinelearning.CreateMLModelInput) (*machinelearning.CreateMLModelOutput, error)
 
+	CreateMLModelWithContext(aws.Context, *machinelearning.CreateMLModelInput) (*machinelearning.CreateMLModelOutput, error)
+
 	CreateRealtimeEndpoint(*machinelearning.CreateRealtimeEndpointInput) (*machinelearning.CreateRealtimeEndpointOutput, error)
 
+	CreateRealtimeEndpointWithContext(aws.Context, *machinelearning.CreateRealtimeEndpointInput) (*machinelearning.CreateRealtimeEndpointOutput, error)
+
 	DeleteBatchPrediction(*machinelearning.DeleteBatchPredictionInput) (*machinelearning.DeleteBatchPredictionOutput, error)
 
+	DeleteBatchPredictionWithContext(aws.Context, *machinelearning.DeleteBatchPredictionInput) (*machinelearning.DeleteBatchPredictionOutput, error)
+
 	DeleteDataSource(*machinelearning.DeleteDataSourceInput) (*machinelearning.DeleteDataSourceOutput, error)
 
+	DeleteDataSourceWithContext(aws.Context, *machinelearning.DeleteDataSourceInput) (*machinelearning.DeleteDataSourceOutput, error)
+
 	DeleteEvaluation(*machinelearning.DeleteEvaluationInput) (*machinelearning.DeleteEvaluationOutput, error)
 
+	DeleteEvaluationWithContext(aws.Context, *machinelearning.DeleteEvaluationInput) (*machinelearning.DeleteEvaluationOutput, error)
+
 	DeleteMLModel(*machinelearning.DeleteMLModelInput) (*machinelearning.DeleteMLModelOutput, error)
 
+	DeleteMLModelWithContext(aws.Context, *machinelearning.DeleteMLModelInput) (*machinelearning.DeleteMLModelOutput, error)
+
 	DeleteRealtimeEndpoint(*machinelearning.DeleteRealtimeEndpointInput) (*machinelearning.DeleteRealtimeEndpointOutput, error)
 
+	DeleteRealtimeEndpointWithContext(aws.Context, *machinelearning.DeleteRealtimeEndpointInput) (*machinelearning.DeleteRealtimeEndpointOutput, error)
+
 	DescribeBatchPredictions(*machinelearning.DescribeBatchPredictionsInput) (*machinelearning.DescribeBatchPredictionsOutput, error)
 
+	DescribeBatchPredictionsWithContext(aws.Context, *machinelearning.DescribeBatchPredictionsInput) (*machinelearning.DescribeBatchPredictionsOutput, error)
+
 	DescribeDataSources(*machinelearning.DescribeDataSourcesInput) (*machinelearning.DescribeDataSourcesOutput, error)
 
+	DescribeDataSourcesWithContext(aws.Context, *machinelearning.DescribeDataSourcesInput) (*machinelearning.DescribeDataSourcesOutput, error)
+
 	DescribeEvaluations(*machinelearning.DescribeEvaluationsInput) (*machinelearning.DescribeEvaluationsOutput, error)
 
+	DescribeEvaluationsWithContext(aws.Context, *machinelearning.DescribeEvaluationsInput) (*machinelearning.DescribeEvaluationsOutput, error)
+
 	DescribeMLModels(*machinelearning.DescribeMLModelsInput) (*machinelearning.DescribeMLModelsOutput, error)
 
+	DescribeMLModelsWithContext(aws.Context, *machinelearning.DescribeMLModelsInput) (*machinelearning.DescribeMLModelsOutput, error)
+
 	GetBatchPrediction(*machinelearning.GetBatchPredictionInput) (*machinelearning.GetBatchPredictionOutput, error)
 
+	GetBatchPredictionWithContext(aws.Context, *machinelearning.GetBatchPredictionInput) (*machinelearning.GetBatchPredictionOutput, error)
+
 	GetDataSource(*machinelearning.GetDataSourceInput) (*machinelearning.GetDataSourceOutput, error)
 
+	GetDataSourceWithContext(aws.Context, *machinelearning.GetDataSourceInput) (*machinelearning.GetDataSourceOutput, error)
+
 	GetEvaluation(*machinelearning.GetEvaluationInput) (*machinelearning.GetEvaluationOutput, error)
 
+	GetEvaluationWithContext(aws.Context, *machinelearning.GetEvaluationInput) (*machinelearning.GetEvaluationOutput, error)
+
 	GetMLModel(*machinelearning.GetMLModelInput) (*machinelearning.GetMLModelOutput, error)
 
+	GetMLModelWithContext(aws.Context, *machinelearning.GetMLModelInput) (*machinelearning.GetMLModelOutput, error)
+
 	Predict(*machinelearning.PredictInput) (*machinelearning.PredictOutput, error)
 
+	PredictWithContext(aws.Context, *machinelearning.PredictInput) (*machinelearning.PredictOutput, error)
+
 	UpdateBatchPrediction(*machinelearning.UpdateBatchPredictionInput) (*machinelearning.UpdateBatchPredictionOutput, error)
 
+	UpdateBatchPredictionWithContext(aws.Context, *machinelearning.UpdateBatchPredictionInput) (*machinelearning.UpdateBatchPredictionOutput, error)
+
 	UpdateDataSource(*machinelearning.UpdateDataSourceInput) (*machinelearning.UpdateDataSourceOutput, error)
 
+	UpdateDataSourceWithContext(aws.Context, *machinelearning.UpdateDataSourceInput) (*machinelearning.UpdateDataSourceOutput, error)
+
 	UpdateEvaluation(*machinelearning.UpdateEvaluationInput) (*machinelearning.UpdateEvaluationOutput, error)
 
+	UpdateEvaluationWithContext(aws.Context, *machinelearning.UpdateEvaluationInput) (*machinelearning.UpdateEvaluationOutput, error)
+
 	UpdateMLModel(*machinelearning.UpdateMLModelInput) (*machinelearning.UpdateMLModelOutput, error)
+
+	UpdateMLModelWithContext(aws.Context, *machinelearning.UpdateMLModelInput) (*machinelearning.UpdateMLModelOutput, error)
 }
@@ -5,6 +5,7 @@ package opsworks
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAssignInstance = "AssignInstance"
@@ -41,6 +42,16 @@ func (c *OpsWorks) AssignInstance(input *AssignInstanceInput) (*AssignInstanceOu
 	return out, err
 }
 
+// AssignInstanceWithContext is the same as AssignInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) AssignInstanceWithContext(ctx aws.Context, input *AssignInstanceInput) (*AssignInstanceOutput, error) {
+	req, out := c.AssignInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssignVolume = "AssignVolume"
 
 // AssignVolumeRequest generates a request for the AssignVolume operation.
@@ -77,6 +88,16 @@ func (c *OpsWorks) AssignVolume(input *AssignVolumeInput) (*AssignVolumeOutput,
 	return out, err
 }
 
+// AssignVolumeWithContext is the same as AssignVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) AssignVolumeWithContext(ctx aws.Context, input *AssignVolumeInput) (*AssignVolumeOutput, error) {
+	req, out := c.AssignVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssociateElasticIP = "AssociateElasticIp"
 
 // AssociateElasticIPRequest generates a request for the AssociateElasticIP operation.
@@ -111,6 +132,16 @@ func (c *OpsWorks) AssociateElasticIP(input *AssociateElasticIPInput) (*Associat
 	return out, err
 }
 
+// AssociateElasticIPWithContext is the same as AssociateElasticIP with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) AssociateElasticIPWithContext(ctx aws.Context, input *AssociateElasticIPInput) (*AssociateElasticIPOutput, error) {
+	req, out := c.AssociateElasticIPRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAttachElasticLoadBalancer = "AttachElasticLoadBalancer"
 
 // AttachElasticLoadBalancerRequest generates a request for the AttachElasticLoadBalancer operation.
@@ -148,6 +179,16 @@ func (c *OpsWorks) AttachElasticLoadBalancer(input *AttachElasticLoadBalancerInp
 	return out, err
 }
 
+// AttachElasticLoadBalancerWithContext is the same as AttachElasticLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) AttachElasticLoadBalancerWithContext(ctx aws.Context, input *AttachElasticLoadBalancerInput) (*AttachElasticLoadBalancerOutput, error) {
+	req, out := c.AttachElasticLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCloneStack = "CloneStack"
 
 // CloneStackRequest generates a request for the CloneStack operation.
@@ -181,6 +222,16 @@ func (c *OpsWorks) CloneStack(input *CloneStackInput) (*CloneStackOutput, error)
 	return out, err
 }
 
+// CloneStackWithContext is the same as CloneStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) CloneStackWithContext(ctx aws.Context, input *CloneStackInput) (*CloneStackOutput, error) {
+	req, out := c.CloneStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateApp = "CreateApp"
 
 // CreateAppRequest generates a request for the CreateApp operation.
@@ -214,6 +265,16 @@ func (c *OpsWorks) CreateApp(input *CreateAppInput) (*CreateAppOutput, error) {
 	return out, err
 }
 
+// CreateAppWithContext is the same as CreateApp with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) CreateAppWithContext(ctx aws.Context, input *CreateAppInput) (*CreateAppOutput, error) {
+	req, out := c.CreateAppRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDeployment = "CreateDeployment"
 
 // CreateDeploymentRequest generates a request for the CreateDeployment operation.
@@ -248,6 +309,16 @@ func (c *OpsWorks) CreateDeployment(input *CreateDeploymentInput) (*CreateDeploy
 	return out, err
 }
 
+// CreateDeploymentWithContext is the same as CreateDeployment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) CreateDeploymentWithContext(ctx aws.Context, input *CreateDeploymentInput) (*CreateDeploymentOutput, error) {
+	req, out := c.CreateDeploymentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateInstance = "CreateInstance"
 
 // CreateInstanceRequest generates a request for the CreateInstance operation.
@@ -281,6 +352,16 @@ func (c *OpsWorks) CreateInstance(input *CreateInstanceInput) (*CreateInstanceOu
 	return out, err
 }
 
+// CreateInstanceWithContext is the same as CreateInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) CreateInstanceWithContext(ctx aws.Context, input *CreateInstanceInput) (*CreateInstanceOutput, error) {
+	req, out := c.CreateInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateLayer = "CreateLayer"
 
 // CreateLayerRequest generates a request for the CreateLayer operation.
@@ -320,6 +401,16 @@ func (c *OpsWorks) CreateLayer(input *CreateLayerInput) (*CreateLayerOutput, err
 	return out, err
 }
 
+// CreateLayerWithContext is the same as CreateLayer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) CreateLayerWithContext(ctx aws.Context, input *CreateLayerInput) (*CreateLayerOutput, error) {
+	req, out := c.CreateLayerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStack = "CreateStack"
 
 // CreateStackRequest generates a request for the CreateStack operation.
@@ -351,6 +442,16 @@ func (c *OpsWorks) CreateStack(input *CreateStackInput) (*CreateStackOutput, err
 	return out, err
 }
 
+// CreateStackWithContext is the same as CreateStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) CreateStackWithContext(ctx aws.Context, input *CreateStackInput) (*CreateStackOutput, error) {
+	req, out := c.CreateStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateUserProfile = "CreateUserProfile"
 
 // CreateUserProfileRequest generates a request for the CreateUserProfile operation.
@@ -382,6 +483,16 @@ func (c *OpsWorks) CreateUserProfile(input *CreateUserProfileInput) (*CreateUser
 	return out, err
 }
 
+// CreateUserProfileWithContext is the same as CreateUserProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) CreateUserProfileWithContext(ctx aws.Context, input *CreateUserProfileInput) (*CreateUserProfileOutput, error) {
+	req, out := c.CreateUserProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteApp = "DeleteApp"
 
 // DeleteAppRequest generates a request for the DeleteApp operation.
@@ -414,6 +525,16 @@ func (c *OpsWorks) DeleteApp(input *DeleteAppInput) (*DeleteAppOutput, error) {
 	return out, err
 }
 
+// DeleteAppWithContext is the same as DeleteApp with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeleteAppWithContext(ctx aws.Context, input *DeleteAppInput) (*DeleteAppOutput, error) {
+	req, out := c.DeleteAppRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteInstance = "DeleteInstance"
 
 // DeleteInstanceRequest generates a request for the DeleteInstance operation.
@@ -449,6 +570,16 @@ func (c *OpsWorks) DeleteInstance(input *DeleteInstanceInput) (*DeleteInstanceOu
 	return out, err
 }
 
+// DeleteInstanceWithContext is the same as DeleteInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeleteInstanceWithContext(ctx aws.Context, input *DeleteInstanceInput) (*DeleteInstanceOutput, error) {
+	req, out := c.DeleteInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteLayer = "DeleteLayer"
 
 // DeleteLayerRequest generates a request for the DeleteLayer operation.
@@ -483,6 +614,16 @@ func (c *OpsWorks) DeleteLayer(input *DeleteLayerInput) (*DeleteLayerOutput, err
 	return out, err
 }
 
+// DeleteLayerWithContext is the same as DeleteLayer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeleteLayerWithContext(ctx aws.Context, input *DeleteLayerInput) (*DeleteLayerOutput, error) {
+	req, out := c.DeleteLayerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteStack = "DeleteStack"
 
 // DeleteStackRequest generates a request for the DeleteStack operation.
@@ -517,6 +658,16 @@ func (c *OpsWorks) DeleteStack(input *DeleteStackInput) (*DeleteStackOutput, err
 	return out, err
 }
 
+// DeleteStackWithContext is the same as DeleteStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeleteStackWithContext(ctx aws.Context, input *DeleteStackInput) (*DeleteStackOutput, error) {
+	req, out := c.DeleteStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteUserProfile = "DeleteUserProfile"
 
 // DeleteUserProfileRequest generates a request for the DeleteUserProfile operation.
@@ -548,6 +699,16 @@ func (c *OpsWorks) DeleteUserProfile(input *DeleteUserProfileInput) (*DeleteUser
 	return out, err
 }
 
+// DeleteUserProfileWithContext is the same as DeleteUserProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeleteUserProfileWithContext(ctx aws.Context, input *DeleteUserProfileInput) (*DeleteUserProfileOutput, error) {
+	req, out := c.DeleteUserProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterElasticIP = "DeregisterElasticIp"
 
 // DeregisterElasticIPRequest generates a request for the DeregisterElasticIP operation.
@@ -581,6 +742,16 @@ func (c *OpsWorks) DeregisterElasticIP(input *DeregisterElasticIPInput) (*Deregi
 	return out, err
 }
 
+// DeregisterElasticIPWithContext is the same as DeregisterElasticIP with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeregisterElasticIPWithContext(ctx aws.Context, input *DeregisterElasticIPInput) (*DeregisterElasticIPOutput, error) {
+	req, out := c.DeregisterElasticIPRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterInstance = "DeregisterInstance"
 
 // DeregisterInstanceRequest generates a request for the DeregisterInstance operation.
@@ -615,6 +786,16 @@ func (c *OpsWorks) DeregisterInstance(input *DeregisterInstanceInput) (*Deregist
 	return out, err
 }
 
+// DeregisterInstanceWithContext is the same as DeregisterInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeregisterInstanceWithContext(ctx aws.Context, input *DeregisterInstanceInput) (*DeregisterInstanceOutput, error) {
+	req, out := c.DeregisterInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterRDSDBInstance = "DeregisterRdsDbInstance"
 
 // DeregisterRDSDBInstanceRequest generates a request for the DeregisterRDSDBInstance operation.
@@ -647,6 +828,16 @@ func (c *OpsWorks) DeregisterRDSDBInstance(input *DeregisterRDSDBInstanceInput)
 	return out, err
 }
 
+// DeregisterRDSDBInstanceWithContext is the same as DeregisterRDSDBInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeregisterRDSDBInstanceWithContext(ctx aws.Context, input *DeregisterRDSDBInstanceInput) (*DeregisterRDSDBInstanceOutput, error) {
+	req, out := c.DeregisterRDSDBInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeregisterVolume = "DeregisterVolume"
 
 // DeregisterVolumeRequest generates a request for the DeregisterVolume operation.
@@ -680,6 +871,16 @@ func (c *OpsWorks) DeregisterVolume(input *DeregisterVolumeInput) (*DeregisterVo
 	return out, err
 }
 
+// DeregisterVolumeWithContext is the same as DeregisterVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DeregisterVolumeWithContext(ctx aws.Context, input *DeregisterVolumeInput) (*DeregisterVolumeOutput, error) {
+	req, out := c.DeregisterVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAgentVersions = "DescribeAgentVersions"
 
 // DescribeAgentVersionsRequest generates a request for the DescribeAgentVersions operation.
@@ -709,6 +910,16 @@ func (c *OpsWorks) DescribeAgentVersions(input *DescribeAgentVersionsInput) (*De
 	return out, err
 }
 
+// DescribeAgentVersionsWithContext is the same as DescribeAgentVersions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeAgentVersionsWithContext(ctx aws.Context, input *DescribeAgentVersionsInput) (*DescribeAgentVersionsOutput, error) {
+	req, out := c.DescribeAgentVersionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeApps = "DescribeApps"
 
 // DescribeAppsRequest generates a request for the DescribeApps operation.
@@ -743,6 +954,16 @@ func (c *OpsWorks) DescribeApps(input *DescribeAppsInput) (*DescribeAppsOutput,
 	return out, err
 }
 
+// DescribeAppsWithContext is the same as DescribeApps with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeAppsWithContext(ctx aws.Context, input *DescribeAppsInput) (*DescribeAppsOutput, error) {
+	req, out := c.DescribeAppsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCommands = "DescribeCommands"
 
 // DescribeCommandsRequest generates a request for the DescribeCommands operation.
@@ -777,6 +998,16 @@ func (c *OpsWorks) DescribeCommands(input *DescribeCommandsInput) (*DescribeComm
 	return out, err
 }
 
+// DescribeCommandsWithContext is the same as DescribeCommands with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeCommandsWithContext(ctx aws.Context, input *DescribeCommandsInput) (*DescribeCommandsOutput, error) {
+	req, out := c.DescribeCommandsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDeployments = "DescribeDeployments"
 
 // DescribeDeploymentsRequest generates a request for the DescribeDeployments operation.
@@ -811,6 +1042,16 @@ func (c *OpsWorks) DescribeDeployments(input *DescribeDeploymentsInput) (*Descri
 	return out, err
 }
 
+// DescribeDeploymentsWithContext is the same as DescribeDeployments with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeDeploymentsWithContext(ctx aws.Context, input *DescribeDeploymentsInput) (*DescribeDeploymentsOutput, error) {
+	req, out := c.DescribeDeploymentsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeElasticIPs = "DescribeElasticIps"
 
 // DescribeElasticIPsRequest generates a request for the DescribeElasticIPs operation.
@@ -845,6 +1086,16 @@ func (c *OpsWorks) DescribeElasticIPs(input *DescribeElasticIPsInput) (*Describe
 	return out, err
 }
 
+// DescribeElasticIPsWithContext is the same as DescribeElasticIPs with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeElasticIPsWithContext(ctx aws.Context, input *DescribeElasticIPsInput) (*DescribeElasticIPsOutput, error) {
+	req, out := c.DescribeElasticIPsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeElasticLoadBalancers = "DescribeElasticLoadBalancers"
 
 // DescribeElasticLoadBalancersRequest generates a request for the DescribeElasticLoadBalancers operation.
@@ -879,6 +1130,16 @@ func (c *OpsWorks) DescribeElasticLoadBalancers(input *DescribeElasticLoadBalanc
 	return out, err
 }
 
+// DescribeElasticLoadBalancersWithContext is the same as DescribeElasticLoadBalancers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeElasticLoadBalancersWithContext(ctx aws.Context, input *DescribeElasticLoadBalancersInput) (*DescribeElasticLoadBalancersOutput, error) {
+	req, out := c.DescribeElasticLoadBalancersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeInstances = "DescribeInstances"
 
 // DescribeInstancesRequest generates a request for the DescribeInstances operation.
@@ -913,6 +1174,16 @@ func (c *OpsWorks) DescribeInstances(input *DescribeInstancesInput) (*DescribeIn
 	return out, err
 }
 
+// DescribeInstancesWithContext is the same as DescribeInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeInstancesWithContext(ctx aws.Context, input *DescribeInstancesInput) (*DescribeInstancesOutput, error) {
+	req, out := c.DescribeInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLayers = "DescribeLayers"
 
 // DescribeLayersRequest generates a request for the DescribeLayers operation.
@@ -947,6 +1218,16 @@ func (c *OpsWorks) DescribeLayers(input *DescribeLayersInput) (*DescribeLayersOu
 	return out, err
 }
 
+// DescribeLayersWithContext is the same as DescribeLayers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeLayersWithContext(ctx aws.Context, input *DescribeLayersInput) (*DescribeLayersOutput, error) {
+	req, out := c.DescribeLayersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeLoadBasedAutoScaling = "DescribeLoadBasedAutoScaling"
 
 // DescribeLoadBasedAutoScalingRequest generates a request for the DescribeLoadBasedAutoScaling operation.
@@ -981,6 +1262,16 @@ func (c *OpsWorks) DescribeLoadBasedAutoScaling(input *DescribeLoadBasedAutoScal
 	return out, err
 }
 
+// DescribeLoadBasedAutoScalingWithContext is the same as DescribeLoadBasedAutoScaling with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeLoadBasedAutoScalingWithContext(ctx aws.Context, input *DescribeLoadBasedAutoScalingInput) (*DescribeLoadBasedAutoScalingOutput, error) {
+	req, out := c.DescribeLoadBasedAutoScalingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMyUserProfile = "DescribeMyUserProfile"
 
 // DescribeMyUserProfileRequest generates a request for the DescribeMyUserProfile operation.
@@ -1012,6 +1303,16 @@ func (c *OpsWorks) DescribeMyUserProfile(input *DescribeMyUserProfileInput) (*De
 	return out, err
 }
 
+// DescribeMyUserProfileWithContext is the same as DescribeMyUserProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeMyUserProfileWithContext(ctx aws.Context, input *DescribeMyUserProfileInput) (*DescribeMyUserProfileOutput, error) {
+	req, out := c.DescribeMyUserProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribePermissions = "DescribePermissions"
 
 // DescribePermissionsRequest generates a request for the DescribePermissions operation.
@@ -1044,6 +1345,16 @@ func (c *OpsWorks) DescribePermissions(input *DescribePermissionsInput) (*Descri
 	return out, err
 }
 
+// DescribePermissionsWithContext is the same as DescribePermissions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribePermissionsWithContext(ctx aws.Context, input *DescribePermissionsInput) (*DescribePermissionsOutput, error) {
+	req, out := c.DescribePermissionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeRAIDArrays = "DescribeRaidArrays"
 
 // DescribeRAIDArraysRequest generates a request for the DescribeRAIDArrays operation.
@@ -1078,6 +1389,16 @@ func (c *OpsWorks) DescribeRAIDArrays(input *DescribeRAIDArraysInput) (*Describe
 	return out, err
 }
 
+// DescribeRAIDArraysWithContext is the same as DescribeRAIDArrays with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeRAIDArraysWithContext(ctx aws.Context, input *DescribeRAIDArraysInput) (*DescribeRAIDArraysOutput, error) {
+	req, out := c.DescribeRAIDArraysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeRDSDBInstances = "DescribeRdsDbInstances"
 
 // DescribeRDSDBInstancesRequest generates a request for the DescribeRDSDBInstances operation.
@@ -1110,6 +1431,16 @@ func (c *OpsWorks) DescribeRDSDBInstances(input *DescribeRDSDBInstancesInput) (*
 	return out, err
 }
 
+// DescribeRDSDBInstancesWithContext is the same as DescribeRDSDBInstances with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeRDSDBInstancesWithContext(ctx aws.Context, input *DescribeRDSDBInstancesInput) (*DescribeRDSDBInstancesOutput, error) {
+	req, out := c.DescribeRDSDBInstancesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeServiceErrors = "DescribeServiceErrors"
 
 // DescribeServiceErrorsRequest generates a request for the DescribeServiceErrors operation.
@@ -1142,6 +1473,16 @@ func (c *OpsWorks) DescribeServiceErrors(input *DescribeServiceErrorsInput) (*De
 	return out, err
 }
 
+// DescribeServiceErrorsWithContext is the same as DescribeServiceErrors with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeServiceErrorsWithContext(ctx aws.Context, input *DescribeServiceErrorsInput) (*DescribeServiceErrorsOutput, error) {
+	req, out := c.DescribeServiceErrorsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStackProvisioningParameters = "DescribeStackProvisioningParameters"
 
 // DescribeStackProvisioningParametersRequest generates a request for the DescribeStackProvisioningParameters operation.
@@ -1174,6 +1515,16 @@ func (c *OpsWorks) DescribeStackProvisioningParameters(input *DescribeStackProvi
 	return out, err
 }
 
+// DescribeStackProvisioningParametersWithContext is the same as DescribeStackProvisioningParameters with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeStackProvisioningParametersWithContext(ctx aws.Context, input *DescribeStackProvisioningParametersInput) (*DescribeStackProvisioningParametersOutput, error) {
+	req, out := c.DescribeStackProvisioningParametersRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStackSummary = "DescribeStackSummary"
 
 // DescribeStackSummaryRequest generates a request for the DescribeStackSummary operation.
@@ -1207,6 +1558,16 @@ func (c *OpsWorks) DescribeStackSummary(input *DescribeStackSummaryInput) (*Desc
 	return out, err
 }
 
+// DescribeStackSummaryWithContext is the same as DescribeStackSummary with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeStackSummaryWithContext(ctx aws.Context, input *DescribeStackSummaryInput) (*DescribeStackSummaryOutput, error) {
+	req, out := c.DescribeStackSummaryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStacks = "DescribeStacks"
 
 // DescribeStacksRequest generates a request for the DescribeStacks operation.
@@ -1239,6 +1600,16 @@ func (c *OpsWorks) DescribeStacks(input *DescribeStacksInput) (*DescribeStacksOu
 	return out, err
 }
 
+// DescribeStacksWithContext is the same as DescribeStacks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeStacksWithContext(ctx aws.Context, input *DescribeStacksInput) (*DescribeStacksOutput, error) {
+	req, out := c.DescribeStacksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTimeBasedAutoScaling = "DescribeTimeBasedAutoScaling"
 
 // DescribeTimeBasedAutoScalingRequest generates a request for the DescribeTimeBasedAutoScaling operation.
@@ -1273,6 +1644,16 @@ func (c *OpsWorks) DescribeTimeBasedAutoScaling(input *DescribeTimeBasedAutoScal
 	return out, err
 }
 
+// DescribeTimeBasedAutoScalingWithContext is the same as DescribeTimeBasedAutoScaling with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeTimeBasedAutoScalingWithContext(ctx aws.Context, input *DescribeTimeBasedAutoScalingInput) (*DescribeTimeBasedAutoScalingOutput, error) {
+	req, out := c.DescribeTimeBasedAutoScalingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeUserProfiles = "DescribeUserProfiles"
 
 // DescribeUserProfilesRequest generates a request for the DescribeUserProfiles operation.
@@ -1304,6 +1685,16 @@ func (c *OpsWorks) DescribeUserProfiles(input *DescribeUserProfilesInput) (*Desc
 	return out, err
 }
 
+// DescribeUserProfilesWithContext is the same as DescribeUserProfiles with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeUserProfilesWithContext(ctx aws.Context, input *DescribeUserProfilesInput) (*DescribeUserProfilesOutput, error) {
+	req, out := c.DescribeUserProfilesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVolumes = "DescribeVolumes"
 
 // DescribeVolumesRequest generates a request for the DescribeVolumes operation.
@@ -1338,6 +1729,16 @@ func (c *OpsWorks) DescribeVolumes(input *DescribeVolumesInput) (*DescribeVolume
 	return out, err
 }
 
+// DescribeVolumesWithContext is the same as DescribeVolumes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DescribeVolumesWithContext(ctx aws.Context, input *DescribeVolumesInput) (*DescribeVolumesOutput, error) {
+	req, out := c.DescribeVolumesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDetachElasticLoadBalancer = "DetachElasticLoadBalancer"
 
 // DetachElasticLoadBalancerRequest generates a request for the DetachElasticLoadBalancer operation.
@@ -1370,6 +1771,16 @@ func (c *OpsWorks) DetachElasticLoadBalancer(input *DetachElasticLoadBalancerInp
 	return out, err
 }
 
+// DetachElasticLoadBalancerWithContext is the same as DetachElasticLoadBalancer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DetachElasticLoadBalancerWithContext(ctx aws.Context, input *DetachElasticLoadBalancerInput) (*DetachElasticLoadBalancerOutput, error) {
+	req, out := c.DetachElasticLoadBalancerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisassociateElasticIP = "DisassociateElasticIp"
 
 // DisassociateElasticIPRequest generates a request for the DisassociateElasticIP operation.
@@ -1404,6 +1815,16 @@ func (c *OpsWorks) DisassociateElasticIP(input *DisassociateElasticIPInput) (*Di
 	return out, err
 }
 
+// DisassociateElasticIPWithContext is the same as DisassociateElasticIP with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) DisassociateElasticIPWithContext(ctx aws.Context, input *DisassociateElasticIPInput) (*DisassociateElasticIPOutput, error) {
+	req, out := c.DisassociateElasticIPRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHostnameSuggestion = "GetHostnameSuggestion"
 
 // GetHostnameSuggestionRequest generates a request for the GetHostnameSuggestion operation.
@@ -1437,6 +1858,16 @@ func (c *OpsWorks) GetHostnameSuggestion(input *GetHostnameSuggestionInput) (*Ge
 	return out, err
 }
 
+// GetHostnameSuggestionWithContext is the same as GetHostnameSuggestion with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) GetHostnameSuggestionWithContext(ctx aws.Context, input *GetHostnameSuggestionInput) (*GetHostnameSuggestionOutput, error) {
+	req, out := c.GetHostnameSuggestionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGrantAccess = "GrantAccess"
 
 // GrantAccessRequest generates a request for the GrantAccess operation.
@@ -1465,6 +1896,16 @@ func (c *OpsWorks) GrantAccess(input *GrantAccessInput) (*GrantAccessOutput, err
 	return out, err
 }
 
+// GrantAccessWithContext is the same as GrantAccess with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) GrantAccessWithContext(ctx aws.Context, input *GrantAccessInput) (*GrantAccessOutput, error) {
+	req, out := c.GrantAccessRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRebootInstance = "RebootInstance"
 
 // RebootInstanceRequest generates a request for the RebootInstance operation.
@@ -1498,6 +1939,16 @@ func (c *OpsWorks) RebootInstance(input *RebootInstanceInput) (*RebootInstanceOu
 	return out, err
 }
 
+// RebootInstanceWithContext is the same as RebootInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) RebootInstanceWithContext(ctx aws.Context, input *RebootInstanceInput) (*RebootInstanceOutput, error) {
+	req, out := c.RebootInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterElasticIP = "RegisterElasticIp"
 
 // RegisterElasticIPRequest generates a request for the RegisterElasticIP operation.
@@ -1533,6 +1984,16 @@ func (c *OpsWorks) RegisterElasticIP(input *RegisterElasticIPInput) (*RegisterEl
 	return out, err
 }
 
+// RegisterElasticIPWithContext is the same as RegisterElasticIP with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) RegisterElasticIPWithContext(ctx aws.Context, input *RegisterElasticIPInput) (*RegisterElasticIPOutput, error) {
+	req, out := c.RegisterElasticIPRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterInstance = "RegisterInstance"
 
 // RegisterInstanceRequest generates a request for the RegisterInstance operation.
@@ -1572,6 +2033,16 @@ func (c *OpsWorks) RegisterInstance(input *RegisterInstanceInput) (*RegisterInst
 	return out, err
 }
 
+// RegisterInstanceWithContext is the same as RegisterInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) RegisterInstanceWithContext(ctx aws.Context, input *RegisterInstanceInput) (*RegisterInstanceOutput, error) {
+	req, out := c.RegisterInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterRDSDBInstance = "RegisterRdsDbInstance"
 
 // RegisterRDSDBInstanceRequest generates a request for the RegisterRDSDBInstance operation.
@@ -1604,6 +2075,16 @@ func (c *OpsWorks) RegisterRDSDBInstance(input *RegisterRDSDBInstanceInput) (*Re
 	return out, err
 }
 
+// RegisterRDSDBInstanceWithContext is the same as RegisterRDSDBInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) RegisterRDSDBInstanceWithContext(ctx aws.Context, input *RegisterRDSDBInstanceInput) (*RegisterRDSDBInstanceOutput, error) {
+	req, out := c.RegisterRDSDBInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterVolume = "RegisterVolume"
 
 // RegisterVolumeRequest generates a request for the RegisterVolume operation.
@@ -1639,6 +2120,16 @@ func (c *OpsWorks) RegisterVolume(input *RegisterVolumeInput) (*RegisterVolumeOu
 	return out, err
 }
 
+// RegisterVolumeWithContext is the same as RegisterVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) RegisterVolumeWithContext(ctx aws.Context, input *RegisterVolumeInput) (*RegisterVolumeOutput, error) {
+	req, out := c.RegisterVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetLoadBasedAutoScaling = "SetLoadBasedAutoScaling"
 
 // SetLoadBasedAutoScalingRequest generates a request for the SetLoadBasedAutoScaling operation.
@@ -1678,6 +2169,16 @@ func (c *OpsWorks) SetLoadBasedAutoScaling(input *SetLoadBasedAutoScalingInput)
 	return out, err
 }
 
+// SetLoadBasedAutoScalingWithContext is the same as SetLoadBasedAutoScaling with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) SetLoadBasedAutoScalingWithContext(ctx aws.Context, input *SetLoadBasedAutoScalingInput) (*SetLoadBasedAutoScalingOutput, error) {
+	req, out := c.SetLoadBasedAutoScalingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetPermission = "SetPermission"
 
 // SetPermissionRequest generates a request for the SetPermission operation.
@@ -1711,6 +2212,16 @@ func (c *OpsWorks) SetPermission(input *SetPermissionInput) (*SetPermissionOutpu
 	return out, err
 }
 
+// SetPermissionWithContext is the same as SetPermission with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) SetPermissionWithContext(ctx aws.Context, input *SetPermissionInput) (*SetPermissionOutput, error) {
+	req, out := c.SetPermissionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetTimeBasedAutoScaling = "SetTimeBasedAutoScaling"
 
 // SetTimeBasedAutoScalingRequest generates a request for the SetTimeBasedAutoScaling operation.
@@ -1745,7 +2256,17 @@ func (c *OpsWorks) SetTimeBasedAutoScaling(input *SetTimeBasedAutoScalingInput)
 	return out, err
 }
 
-const opStartInstance = "StartInstance"
+// SetTimeBasedAutoScalingWithContext is the same as SetTimeBasedAutoScaling with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) SetTimeBasedAutoScalingWithContext(ctx aws.Context, input *SetTimeBasedAutoScalingInput) (*SetTimeBasedAutoScalingOutput, error) {
+	req, out := c.SetTimeBasedAutoScalingRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
+const opStartInstance = "StartInstance"
 
 // StartInstanceRequest generates a request for the StartInstance operation.
 func (c *OpsWorks) StartInstanceRequest(input *StartInstanceInput) (req *aws.Request, output *StartInstanceOutput) {
@@ -1778,6 +2299,16 @@ func (c *OpsWorks) StartInstance(input *StartInstanceInput) (*StartInstanceOutpu
 	return out, err
 }
 
+// StartInstanceWithContext is the same as StartInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) StartInstanceWithContext(ctx aws.Context, input *StartInstanceInput) (*StartInstanceOutput, error) {
+	req, out := c.StartInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStartStack = "StartStack"
 
 // StartStackRequest generates a request for the StartStack operation.
@@ -1810,6 +2341,16 @@ func (c *OpsWorks) StartStack(input *StartStackInput) (*StartStackOutput, error)
 	return out, err
 }
 
+// StartStackWithContext is the same as StartStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) StartStackWithContext(ctx aws.Context, input *StartStackInput) (*StartStackOutput, error) {
+	req, out := c.StartStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStopInstance = "StopInstance"
 
 // StopInstanceRequest generates a request for the StopInstance operation.
@@ -1845,6 +2386,16 @@ func (c *OpsWorks) StopInstance(input *StopInstanceInput) (*StopInstanceOutput,
 	return out, err
 }
 
+// StopInstanceWithContext is the same as StopInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) StopInstanceWithContext(ctx aws.Context, input *StopInstanceInput) (*StopInstanceOutput, error) {
+	req, out := c.StopInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStopStack = "StopStack"
 
 // StopStackRequest generates a request for the StopStack operation.
@@ -1877,6 +2428,16 @@ func (c *OpsWorks) StopStack(input *StopStackInput) (*StopStackOutput, error) {
 	return out, err
 }
 
+// StopStackWithContext is the same as StopStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) StopStackWithContext(ctx aws.Context, input *StopStackInput) (*StopStackOutput, error) {
+	req, out := c.StopStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnassignInstance = "UnassignInstance"
 
 // UnassignInstanceRequest generates a request for the UnassignInstance operation.
@@ -1912,6 +2473,16 @@ func (c *OpsWorks) UnassignInstance(input *UnassignInstanceInput) (*UnassignInst
 	return out, err
 }
 
+// UnassignInstanceWithContext is the same as UnassignInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UnassignInstanceWithContext(ctx aws.Context, input *UnassignInstanceInput) (*UnassignInstanceOutput, error) {
+	req, out := c.UnassignInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnassignVolume = "UnassignVolume"
 
 // UnassignVolumeRequest generates a request for the UnassignVolume operation.
@@ -1945,6 +2516,16 @@ func (c *OpsWorks) UnassignVolume(input *UnassignVolumeInput) (*UnassignVolumeOu
 	return out, err
 }
 
+// UnassignVolumeWithContext is the same as UnassignVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UnassignVolumeWithContext(ctx aws.Context, input *UnassignVolumeInput) (*UnassignVolumeOutput, error) {
+	req, out := c.UnassignVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateApp = "UpdateApp"
 
 // UpdateAppRequest generates a request for the UpdateApp operation.
@@ -1977,6 +2558,16 @@ func (c *OpsWorks) UpdateApp(input *UpdateAppInput) (*UpdateAppOutput, error) {
 	return out, err
 }
 
+// UpdateAppWithContext is the same as UpdateApp with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateAppWithContext(ctx aws.Context, input *UpdateAppInput) (*UpdateAppOutput, error) {
+	req, out := c.UpdateAppRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateElasticIP = "UpdateElasticIp"
 
 // UpdateElasticIPRequest generates a request for the UpdateElasticIP operation.
@@ -2010,6 +2601,16 @@ func (c *OpsWorks) UpdateElasticIP(input *UpdateElasticIPInput) (*UpdateElasticI
 	return out, err
 }
 
+// UpdateElasticIPWithContext is the same as UpdateElasticIP with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateElasticIPWithContext(ctx aws.Context, input *UpdateElasticIPInput) (*UpdateElasticIPOutput, error) {
+	req, out := c.UpdateElasticIPRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateInstance = "UpdateInstance"
 
 // UpdateInstanceRequest generates a request for the UpdateInstance operation.
@@ -2042,6 +2643,16 @@ func (c *OpsWorks) UpdateInstance(input *UpdateInstanceInput) (*UpdateInstanceOu
 	return out, err
 }
 
+// UpdateInstanceWithContext is the same as UpdateInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateInstanceWithContext(ctx aws.Context, input *UpdateInstanceInput) (*UpdateInstanceOutput, error) {
+	req, out := c.UpdateInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateLayer = "UpdateLayer"
 
 // UpdateLayerRequest generates a request for the UpdateLayer operation.
@@ -2074,6 +2685,16 @@ func (c *OpsWorks) UpdateLayer(input *UpdateLayerInput) (*UpdateLayerOutput, err
 	return out, err
 }
 
+// UpdateLayerWithContext is the same as UpdateLayer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateLayerWithContext(ctx aws.Context, input *UpdateLayerInput) (*UpdateLayerOutput, error) {
+	req, out := c.UpdateLayerRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateMyUserProfile = "UpdateMyUserProfile"
 
 // UpdateMyUserProfileRequest generates a request for the UpdateMyUserProfile operation.
@@ -2105,6 +2726,16 @@ func (c *OpsWorks) UpdateMyUserProfile(input *UpdateMyUserProfileInput) (*Update
 	return out, err
 }
 
+// UpdateMyUserProfileWithContext is the same as UpdateMyUserProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateMyUserProfileWithContext(ctx aws.Context, input *UpdateMyUserProfileInput) (*UpdateMyUserProfileOutput, error) {
+	req, out := c.UpdateMyUserProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateRDSDBInstance = "UpdateRdsDbInstance"
 
 // UpdateRDSDBInstanceRequest generates a request for the UpdateRDSDBInstance operation.
@@ -2137,6 +2768,16 @@ func (c *OpsWorks) UpdateRDSDBInstance(input *UpdateRDSDBInstanceInput) (*Update
 	return out, err
 }
 
+// UpdateRDSDBInstanceWithContext is the same as UpdateRDSDBInstance with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateRDSDBInstanceWithContext(ctx aws.Context, input *UpdateRDSDBInstanceInput) (*UpdateRDSDBInstanceOutput, error) {
+	req, out := c.UpdateRDSDBInstanceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateStack = "UpdateStack"
 
 // UpdateStackRequest generates a request for the UpdateStack operation.
@@ -2169,6 +2810,16 @@ func (c *OpsWorks) UpdateStack(input *UpdateStackInput) (*UpdateStackOutput, err
 	return out, err
 }
 
+// UpdateStackWithContext is the same as UpdateStack with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateStackWithContext(ctx aws.Context, input *UpdateStackInput) (*UpdateStackOutput, error) {
+	req, out := c.UpdateStackRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateUserProfile = "UpdateUserProfile"
 
 // UpdateUserProfileRequest generates a request for the UpdateUserProfile operation.
@@ -2200,6 +2851,16 @@ func (c *OpsWorks) UpdateUserProfile(input *UpdateUserProfileInput) (*UpdateUser
 	return out, err
 }
 
+// UpdateUserProfileWithContext is the same as UpdateUserProfile with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateUserProfileWithContext(ctx aws.Context, input *UpdateUserProfileInput) (*UpdateUserProfileOutput, error) {
+	req, out := c.UpdateUserProfileRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateVolume = "UpdateVolume"
 
 // UpdateVolumeRequest generates a request for the UpdateVolume operation.
@@ -2233,6 +2894,16 @@ func (c *OpsWorks) UpdateVolume(input *UpdateVolumeInput) (*UpdateVolumeOutput,
 	return out, err
 }
 
+// UpdateVolumeWithContext is the same as UpdateVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *OpsWorks) UpdateVolumeWithContext(ctx aws.Context, input *UpdateVolumeInput) (*UpdateVolumeOutput, error) {
+	req, out := c.UpdateVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Describes an agent version.
 type AgentVersion struct {
 	// The configuration manager.
@@ -2248,6 +2919,16 @@ type metadataAgentVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AgentVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AgentVersion) GoString() string {
+	return s.String()
+}
+
 // A description of the app.
 type App struct {
 	// The app ID.
@@ -2301,7 +2982,9 @@ type App struct {
 	StackID *string `locationName:"StackId" type:"string"`
 
 	// The app type.
-	Type *string `type:"string"`
+	//
+	// See the AppType* constants for valid values.
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other"`
 
 	metadataApp `json:"-" xml:"-"`
 }
@@ -2310,6 +2993,16 @@ type metadataApp struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s App) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s App) GoString() string {
+	return s.String()
+}
+
 type AssignInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -2325,6 +3018,16 @@ type metadataAssignInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssignInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssignInstanceInput) GoString() string {
+	return s.String()
+}
+
 type AssignInstanceOutput struct {
 	metadataAssignInstanceOutput `json:"-" xml:"-"`
 }
@@ -2333,6 +3036,16 @@ type metadataAssignInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssignInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssignInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type AssignVolumeInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string"`
@@ -2347,6 +3060,16 @@ type metadataAssignVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssignVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssignVolumeInput) GoString() string {
+	return s.String()
+}
+
 type AssignVolumeOutput struct {
 	metadataAssignVolumeOutput `json:"-" xml:"-"`
 }
@@ -2355,6 +3078,16 @@ type metadataAssignVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssignVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssignVolumeOutput) GoString() string {
+	return s.String()
+}
+
 type AssociateElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -2369,6 +3102,16 @@ type metadataAssociateElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateElasticIPInput) GoString() string {
+	return s.String()
+}
+
 type AssociateElasticIPOutput struct {
 	metadataAssociateElasticIPOutput `json:"-" xml:"-"`
 }
@@ -2377,6 +3120,16 @@ type metadataAssociateElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateElasticIPOutput) GoString() string {
+	return s.String()
+}
+
 type AttachElasticLoadBalancerInput struct {
 	// The Elastic Load Balancing instance's name.
 	ElasticLoadBalancerName *string `type:"string" required:"true"`
@@ -2392,6 +3145,16 @@ type metadataAttachElasticLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachElasticLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachElasticLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type AttachElasticLoadBalancerOutput struct {
 	metadataAttachElasticLoadBalancerOutput `json:"-" xml:"-"`
 }
@@ -2400,6 +3163,16 @@ type metadataAttachElasticLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachElasticLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachElasticLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a load-based auto scaling upscaling or downscaling threshold configuration,
 // which specifies when AWS OpsWorks starts or stops load-based instances.
 type AutoScalingThresholds struct {
@@ -2424,7 +3197,7 @@ type AutoScalingThresholds struct {
 	// which typically takes several minutes. IgnoreMetricsTime allows you to direct
 	// AWS OpsWorks to suppress scaling events long enough to get the new instances
 	// online.
-	IgnoreMetricsTime *int64 `type:"integer"`
+	IgnoreMetricsTime *int64 `type:"integer" min:"1" max:"100"`
 
 	// The number of instances to add or remove when the load exceeds a threshold.
 	InstanceCount *int64 `type:"integer"`
@@ -2438,7 +3211,7 @@ type AutoScalingThresholds struct {
 
 	// The amount of time, in minutes, that the load must exceed a threshold before
 	// more instances are added or removed.
-	ThresholdsWaitTime *int64 `type:"integer"`
+	ThresholdsWaitTime *int64 `type:"integer" min:"1" max:"100"`
 
 	metadataAutoScalingThresholds `json:"-" xml:"-"`
 }
@@ -2447,6 +3220,16 @@ type metadataAutoScalingThresholds struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AutoScalingThresholds) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AutoScalingThresholds) GoString() string {
+	return s.String()
+}
+
 // Describes a block device mapping. This data type maps directly to the Amazon
 // EC2 BlockDeviceMapping (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_BlockDeviceMapping.html)
 // data type.
@@ -2473,6 +3256,16 @@ type metadataBlockDeviceMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BlockDeviceMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BlockDeviceMapping) GoString() string {
+	return s.String()
+}
+
 // Describes the Chef configuration.
 type ChefConfiguration struct {
 	// The Berkshelf version.
@@ -2488,6 +3281,16 @@ type metadataChefConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChefConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChefConfiguration) GoString() string {
+	return s.String()
+}
+
 type CloneStackInput struct {
 	// The default AWS OpsWorks agent version. You have the following options:
 	//
@@ -2567,7 +3370,9 @@ type CloneStackInput struct {
 	// The default root device type. This value is used by default for all instances
 	// in the cloned stack, but you can override it when you create an instance.
 	// For more information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	//
+	// See the RootDeviceType* constants for valid values.
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default Amazon EC2 key pair name. The default value is none. If you specify
 	// a key pair name, AWS OpsWorks installs the public key on the instance and
@@ -2670,6 +3475,16 @@ type metadataCloneStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CloneStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CloneStackInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a CloneStack request.
 type CloneStackOutput struct {
 	// The cloned stack ID.
@@ -2682,6 +3497,16 @@ type metadataCloneStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CloneStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CloneStackOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a command.
 type Command struct {
 	// Date and time when the command was acknowledged.
@@ -2726,6 +3551,16 @@ type metadataCommand struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Command) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Command) GoString() string {
+	return s.String()
+}
+
 type CreateAppInput struct {
 	// A Source object that specifies the app repository.
 	AppSource *Source `type:"structure"`
@@ -2779,7 +3614,9 @@ type CreateAppInput struct {
 	// deploys an application to those instances that are members of the corresponding
 	// layer. If your app isn't one of the standard types, or you prefer to implement
 	// your own Deploy recipes, specify other.
-	Type *string `type:"string" required:"true"`
+	//
+	// See the AppType* constants for valid values.
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other" required:"true"`
 
 	metadataCreateAppInput `json:"-" xml:"-"`
 }
@@ -2788,6 +3625,16 @@ type metadataCreateAppInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAppInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAppInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a CreateApp request.
 type CreateAppOutput struct {
 	// The app ID.
@@ -2800,6 +3647,16 @@ type metadataCreateAppOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAppOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAppOutput) GoString() string {
+	return s.String()
+}
+
 type CreateDeploymentInput struct {
 	// The app ID. This parameter is required for app deployments, but not for other
 	// deployment commands.
@@ -2835,6 +3692,16 @@ type metadataCreateDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a CreateDeployment request.
 type CreateDeploymentOutput struct {
 	// The deployment ID, which can be used with other requests to identify the
@@ -2848,6 +3715,16 @@ type metadataCreateDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDeploymentOutput) GoString() string {
+	return s.String()
+}
+
 type CreateInstanceInput struct {
 	// A custom AMI ID to be used to create the instance. The AMI should be based
 	// on one of the supported operating systems. For more information, see Using
@@ -2871,11 +3748,15 @@ type CreateInstanceInput struct {
 	// not necessarily support both architectures. For a list of the architectures
 	// that are supported by the different instance types, see Instance Families
 	// and Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html).
-	Architecture *string `type:"string"`
+	//
+	// See the Architecture* constants for valid values.
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type. Windows stacks can use
 	// only time-based instances.
-	AutoScalingType *string `type:"string"`
+	//
+	// See the AutoScalingType* constants for valid values.
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// The instance Availability Zone. For more information, see Regions and Endpoints
 	// (http://docs.aws.amazon.com/general/latest/gr/rande.html).
@@ -2929,7 +3810,9 @@ type CreateInstanceInput struct {
 
 	// The instance root device type. For more information, see Storage for the
 	// Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	RootDeviceType *string `type:"string"`
+	//
+	// See the RootDeviceType* constants for valid values.
+	RootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// The instance's Amazon EC2 key-pair name.
 	SSHKeyName *string `locationName:"SshKeyName" type:"string"`
@@ -2952,6 +3835,16 @@ type metadataCreateInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInstanceInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a CreateInstance request.
 type CreateInstanceOutput struct {
 	// The instance ID.
@@ -2964,6 +3857,16 @@ type metadataCreateInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type CreateLayerInput struct {
 	// One or more user-defined key/value pairs to be added to the stack attributes.
 	Attributes map[string]*string `type:"map"`
@@ -3026,7 +3929,9 @@ type CreateLayerInput struct {
 
 	// The layer type. A stack cannot have more than one built-in layer of the same
 	// type. It can have any number of custom layers.
-	Type *string `type:"string" required:"true"`
+	//
+	// See the LayerType* constants for valid values.
+	Type *string `type:"string" enum:"java-app,lb,web,php-app,rails-app,nodejs-app,memcached,db-master,monitoring-master,custom" required:"true"`
 
 	// Whether to use Amazon EBS-optimized instances.
 	UseEBSOptimizedInstances *bool `locationName:"UseEbsOptimizedInstances" type:"boolean"`
@@ -3041,6 +3946,16 @@ type metadataCreateLayerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLayerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLayerInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a CreateLayer request.
 type CreateLayerOutput struct {
 	// The layer ID.
@@ -3053,6 +3968,16 @@ type metadataCreateLayerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateLayerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateLayerOutput) GoString() string {
+	return s.String()
+}
+
 type CreateStackInput struct {
 	// The default AWS OpsWorks agent version. You have the following options:
 	//
@@ -3126,7 +4051,9 @@ type CreateStackInput struct {
 	// in the stack, but you can override it when you create an instance. The default
 	// option is instance-store. For more information, see Storage for the Root
 	// Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	//
+	// See the RootDeviceType* constants for valid values.
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default Amazon EC2 key pair name. The default value is none. If you specify
 	// a key pair name, AWS OpsWorks installs the public key on the instance and
@@ -3220,6 +4147,16 @@ type metadataCreateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStackInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a CreateStack request.
 type CreateStackOutput struct {
 	// The stack ID, which is an opaque string that you use to identify the stack
@@ -3233,6 +4170,16 @@ type metadataCreateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStackOutput) GoString() string {
+	return s.String()
+}
+
 type CreateUserProfileInput struct {
 	// Whether users can specify their own SSH public key through the My Settings
 	// page. For more information, see Setting an IAM User's Public SSH Key (http://docs.aws.amazon.com/opsworks/latest/userguide/security-settingsshkey.html).
@@ -3258,6 +4205,16 @@ type metadataCreateUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateUserProfileInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a CreateUserProfile request.
 type CreateUserProfileOutput struct {
 	// The user's IAM ARN.
@@ -3270,6 +4227,16 @@ type metadataCreateUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateUserProfileOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an app's data source.
 type DataSource struct {
 	// The data source's ARN.
@@ -3289,6 +4256,16 @@ type metadataDataSource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DataSource) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DataSource) GoString() string {
+	return s.String()
+}
+
 type DeleteAppInput struct {
 	// The app ID.
 	AppID *string `locationName:"AppId" type:"string" required:"true"`
@@ -3300,6 +4277,16 @@ type metadataDeleteAppInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAppInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAppInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAppOutput struct {
 	metadataDeleteAppOutput `json:"-" xml:"-"`
 }
@@ -3308,6 +4295,16 @@ type metadataDeleteAppOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAppOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAppOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteInstanceInput struct {
 	// Whether to delete the instance Elastic IP address.
 	DeleteElasticIP *bool `locationName:"DeleteElasticIp" type:"boolean"`
@@ -3325,6 +4322,16 @@ type metadataDeleteInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInstanceInput) GoString() string {
+	return s.String()
+}
+
 type DeleteInstanceOutput struct {
 	metadataDeleteInstanceOutput `json:"-" xml:"-"`
 }
@@ -3333,6 +4340,16 @@ type metadataDeleteInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteLayerInput struct {
 	// The layer ID.
 	LayerID *string `locationName:"LayerId" type:"string" required:"true"`
@@ -3344,6 +4361,16 @@ type metadataDeleteLayerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLayerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLayerInput) GoString() string {
+	return s.String()
+}
+
 type DeleteLayerOutput struct {
 	metadataDeleteLayerOutput `json:"-" xml:"-"`
 }
@@ -3352,6 +4379,16 @@ type metadataDeleteLayerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteLayerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteLayerOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteStackInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -3363,6 +4400,16 @@ type metadataDeleteStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStackInput) GoString() string {
+	return s.String()
+}
+
 type DeleteStackOutput struct {
 	metadataDeleteStackOutput `json:"-" xml:"-"`
 }
@@ -3371,6 +4418,16 @@ type metadataDeleteStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteStackOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteUserProfileInput struct {
 	// The user's IAM ARN.
 	IAMUserARN *string `locationName:"IamUserArn" type:"string" required:"true"`
@@ -3382,6 +4439,16 @@ type metadataDeleteUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteUserProfileInput) GoString() string {
+	return s.String()
+}
+
 type DeleteUserProfileOutput struct {
 	metadataDeleteUserProfileOutput `json:"-" xml:"-"`
 }
@@ -3390,6 +4457,16 @@ type metadataDeleteUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteUserProfileOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a deployment of a stack or app.
 type Deployment struct {
 	// The app ID.
@@ -3445,6 +4522,16 @@ type metadataDeployment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Deployment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Deployment) GoString() string {
+	return s.String()
+}
+
 // Used to specify a stack or deployment command.
 type DeploymentCommand struct {
 	// The arguments of those commands that take arguments. It should be set to
@@ -3489,7 +4576,9 @@ type DeploymentCommand struct {
 	// or application server.  stop: Stop the app's web or application server.
 	// restart: Restart the app's web or application server.  undeploy: Undeploy
 	// the app.
-	Name *string `type:"string" required:"true"`
+	//
+	// See the DeploymentCommandName* constants for valid values.
+	Name *string `type:"string" enum:"install_dependencies,update_dependencies,update_custom_cookbooks,execute_recipes,configure,setup,deploy,rollback,start,stop,restart,undeploy" required:"true"`
 
 	metadataDeploymentCommand `json:"-" xml:"-"`
 }
@@ -3498,6 +4587,16 @@ type metadataDeploymentCommand struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeploymentCommand) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeploymentCommand) GoString() string {
+	return s.String()
+}
+
 type DeregisterElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -3509,6 +4608,16 @@ type metadataDeregisterElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterElasticIPInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterElasticIPOutput struct {
 	metadataDeregisterElasticIPOutput `json:"-" xml:"-"`
 }
@@ -3517,6 +4626,16 @@ type metadataDeregisterElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterElasticIPOutput) GoString() string {
+	return s.String()
+}
+
 type DeregisterInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -3528,6 +4647,16 @@ type metadataDeregisterInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterInstanceInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterInstanceOutput struct {
 	metadataDeregisterInstanceOutput `json:"-" xml:"-"`
 }
@@ -3536,6 +4665,16 @@ type metadataDeregisterInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type DeregisterRDSDBInstanceInput struct {
 	// The Amazon RDS instance's ARN.
 	RDSDBInstanceARN *string `locationName:"RdsDbInstanceArn" type:"string" required:"true"`
@@ -3547,6 +4686,16 @@ type metadataDeregisterRDSDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterRDSDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterRDSDBInstanceInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterRDSDBInstanceOutput struct {
 	metadataDeregisterRDSDBInstanceOutput `json:"-" xml:"-"`
 }
@@ -3555,6 +4704,16 @@ type metadataDeregisterRDSDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterRDSDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterRDSDBInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type DeregisterVolumeInput struct {
 	// The AWS OpsWorks volume ID, which is the GUID that AWS OpsWorks assigned
 	// to the instance when you registered the volume with the stack, not the Amazon
@@ -3568,6 +4727,16 @@ type metadataDeregisterVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterVolumeInput) GoString() string {
+	return s.String()
+}
+
 type DeregisterVolumeOutput struct {
 	metadataDeregisterVolumeOutput `json:"-" xml:"-"`
 }
@@ -3576,6 +4745,16 @@ type metadataDeregisterVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeregisterVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeregisterVolumeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAgentVersionsInput struct {
 	// The configuration manager.
 	ConfigurationManager *StackConfigurationManager `type:"structure"`
@@ -3590,6 +4769,16 @@ type metadataDescribeAgentVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAgentVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAgentVersionsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeAgentVersions request.
 type DescribeAgentVersionsOutput struct {
 	// The agent versions for the specified stack or configuration manager. Note
@@ -3604,6 +4793,16 @@ type metadataDescribeAgentVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAgentVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAgentVersionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAppsInput struct {
 	// An array of app IDs for the apps to be described. If you use this parameter,
 	// DescribeApps returns a description of the specified apps. Otherwise, it returns
@@ -3621,6 +4820,16 @@ type metadataDescribeAppsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAppsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAppsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeApps request.
 type DescribeAppsOutput struct {
 	// An array of App objects that describe the specified apps.
@@ -3633,6 +4842,16 @@ type metadataDescribeAppsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAppsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAppsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeCommandsInput struct {
 	// An array of command IDs. If you include this parameter, DescribeCommands
 	// returns a description of the specified commands. Otherwise, it returns a
@@ -3654,6 +4873,16 @@ type metadataDescribeCommandsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCommandsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCommandsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeCommands request.
 type DescribeCommandsOutput struct {
 	// An array of Command objects that describe each of the specified commands.
@@ -3666,6 +4895,16 @@ type metadataDescribeCommandsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCommandsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCommandsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeDeploymentsInput struct {
 	// The app ID. If you include this parameter, DescribeDeployments returns a
 	// description of the commands associated with the specified app.
@@ -3687,6 +4926,16 @@ type metadataDescribeDeploymentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDeploymentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDeploymentsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeDeployments request.
 type DescribeDeploymentsOutput struct {
 	// An array of Deployment objects that describe the deployments.
@@ -3699,6 +4948,16 @@ type metadataDescribeDeploymentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDeploymentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDeploymentsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeElasticIPsInput struct {
 	// An array of Elastic IP addresses to be described. If you include this parameter,
 	// DescribeElasticIps returns a description of the specified Elastic IP addresses.
@@ -3720,6 +4979,16 @@ type metadataDescribeElasticIPsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeElasticIPsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeElasticIPsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeElasticIps request.
 type DescribeElasticIPsOutput struct {
 	// An ElasticIps object that describes the specified Elastic IP addresses.
@@ -3732,6 +5001,16 @@ type metadataDescribeElasticIPsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeElasticIPsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeElasticIPsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeElasticLoadBalancersInput struct {
 	// A list of layer IDs. The action describes the Elastic Load Balancing instances
 	// for the specified layers.
@@ -3747,6 +5026,16 @@ type metadataDescribeElasticLoadBalancersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeElasticLoadBalancersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeElasticLoadBalancersInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeElasticLoadBalancers request.
 type DescribeElasticLoadBalancersOutput struct {
 	// A list of ElasticLoadBalancer objects that describe the specified Elastic
@@ -3760,6 +5049,16 @@ type metadataDescribeElasticLoadBalancersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeElasticLoadBalancersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeElasticLoadBalancersOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeInstancesInput struct {
 	// An array of instance IDs to be described. If you use this parameter, DescribeInstances
 	// returns a description of the specified instances. Otherwise, it returns a
@@ -3781,6 +5080,16 @@ type metadataDescribeInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeInstances request.
 type DescribeInstancesOutput struct {
 	// An array of Instance objects that describe the instances.
@@ -3793,6 +5102,16 @@ type metadataDescribeInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLayersInput struct {
 	// An array of layer IDs that specify the layers to be described. If you omit
 	// this parameter, DescribeLayers returns a description of every layer in the
@@ -3809,6 +5128,16 @@ type metadataDescribeLayersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLayersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLayersInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeLayers request.
 type DescribeLayersOutput struct {
 	// An array of Layer objects that describe the layers.
@@ -3821,6 +5150,16 @@ type metadataDescribeLayersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLayersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLayersOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeLoadBasedAutoScalingInput struct {
 	// An array of layer IDs.
 	LayerIDs []*string `locationName:"LayerIds" type:"list" required:"true"`
@@ -3832,6 +5171,16 @@ type metadataDescribeLoadBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBasedAutoScalingInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeLoadBasedAutoScaling request.
 type DescribeLoadBasedAutoScalingOutput struct {
 	// An array of LoadBasedAutoScalingConfiguration objects that describe each
@@ -3845,6 +5194,16 @@ type metadataDescribeLoadBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeLoadBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLoadBasedAutoScalingOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeMyUserProfileInput struct {
 	metadataDescribeMyUserProfileInput `json:"-" xml:"-"`
 }
@@ -3853,6 +5212,16 @@ type metadataDescribeMyUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMyUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMyUserProfileInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeMyUserProfile request.
 type DescribeMyUserProfileOutput struct {
 	// A UserProfile object that describes the user's SSH information.
@@ -3865,6 +5234,16 @@ type metadataDescribeMyUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMyUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMyUserProfileOutput) GoString() string {
+	return s.String()
+}
+
 type DescribePermissionsInput struct {
 	// The user's IAM ARN. For more information about IAM ARNs, see Using Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html).
@@ -3880,6 +5259,16 @@ type metadataDescribePermissionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePermissionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePermissionsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribePermissions request.
 type DescribePermissionsOutput struct {
 	// An array of Permission objects that describe the stack permissions.
@@ -3899,6 +5288,16 @@ type metadataDescribePermissionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribePermissionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribePermissionsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeRAIDArraysInput struct {
 	// The instance ID. If you use this parameter, DescribeRaidArrays returns descriptions
 	// of the RAID arrays associated with the specified instance.
@@ -3919,6 +5318,16 @@ type metadataDescribeRAIDArraysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRAIDArraysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRAIDArraysInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeRaidArrays request.
 type DescribeRAIDArraysOutput struct {
 	// A RaidArrays object that describes the specified RAID arrays.
@@ -3931,6 +5340,16 @@ type metadataDescribeRAIDArraysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRAIDArraysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRAIDArraysOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeRDSDBInstancesInput struct {
 	// An array containing the ARNs of the instances to be described.
 	RDSDBInstanceARNs []*string `locationName:"RdsDbInstanceArns" type:"list"`
@@ -3946,6 +5365,16 @@ type metadataDescribeRDSDBInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRDSDBInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRDSDBInstancesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeRdsDbInstances request.
 type DescribeRDSDBInstancesOutput struct {
 	// An a array of RdsDbInstance objects that describe the instances.
@@ -3958,6 +5387,16 @@ type metadataDescribeRDSDBInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeRDSDBInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeRDSDBInstancesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeServiceErrorsInput struct {
 	// The instance ID. If you use this parameter, DescribeServiceErrors returns
 	// descriptions of the errors associated with the specified instance.
@@ -3979,6 +5418,16 @@ type metadataDescribeServiceErrorsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServiceErrorsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServiceErrorsInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeServiceErrors request.
 type DescribeServiceErrorsOutput struct {
 	// An array of ServiceError objects that describe the specified service errors.
@@ -3991,6 +5440,16 @@ type metadataDescribeServiceErrorsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServiceErrorsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServiceErrorsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeStackProvisioningParametersInput struct {
 	// The stack ID
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -4002,6 +5461,16 @@ type metadataDescribeStackProvisioningParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackProvisioningParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackProvisioningParametersInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeStackProvisioningParameters request.
 type DescribeStackProvisioningParametersOutput struct {
 	// The AWS OpsWorks agent installer's URL.
@@ -4017,6 +5486,16 @@ type metadataDescribeStackProvisioningParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackProvisioningParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackProvisioningParametersOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeStackSummaryInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -4028,6 +5507,16 @@ type metadataDescribeStackSummaryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackSummaryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackSummaryInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeStackSummary request.
 type DescribeStackSummaryOutput struct {
 	// A StackSummary object that contains the results.
@@ -4040,6 +5529,16 @@ type metadataDescribeStackSummaryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStackSummaryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStackSummaryOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeStacksInput struct {
 	// An array of stack IDs that specify the stacks to be described. If you omit
 	// this parameter, DescribeStacks returns a description of every stack.
@@ -4052,6 +5551,16 @@ type metadataDescribeStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStacksInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeStacks request.
 type DescribeStacksOutput struct {
 	// An array of Stack objects that describe the stacks.
@@ -4064,6 +5573,16 @@ type metadataDescribeStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStacksOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTimeBasedAutoScalingInput struct {
 	// An array of instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list" required:"true"`
@@ -4075,6 +5594,16 @@ type metadataDescribeTimeBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTimeBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTimeBasedAutoScalingInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeTimeBasedAutoScaling request.
 type DescribeTimeBasedAutoScalingOutput struct {
 	// An array of TimeBasedAutoScalingConfiguration objects that describe the configuration
@@ -4088,6 +5617,16 @@ type metadataDescribeTimeBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTimeBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTimeBasedAutoScalingOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeUserProfilesInput struct {
 	// An array of IAM user ARNs that identify the users to be described.
 	IAMUserARNs []*string `locationName:"IamUserArns" type:"list"`
@@ -4099,6 +5638,16 @@ type metadataDescribeUserProfilesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeUserProfilesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeUserProfilesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeUserProfiles request.
 type DescribeUserProfilesOutput struct {
 	// A Users object that describes the specified users.
@@ -4111,6 +5660,16 @@ type metadataDescribeUserProfilesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeUserProfilesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeUserProfilesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeVolumesInput struct {
 	// The instance ID. If you use this parameter, DescribeVolumes returns descriptions
 	// of the volumes associated with the specified instance.
@@ -4135,6 +5694,16 @@ type metadataDescribeVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a DescribeVolumes request.
 type DescribeVolumesOutput struct {
 	// An array of volume IDs.
@@ -4147,6 +5716,16 @@ type metadataDescribeVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVolumesOutput) GoString() string {
+	return s.String()
+}
+
 type DetachElasticLoadBalancerInput struct {
 	// The Elastic Load Balancing instance's name.
 	ElasticLoadBalancerName *string `type:"string" required:"true"`
@@ -4162,6 +5741,16 @@ type metadataDetachElasticLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachElasticLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachElasticLoadBalancerInput) GoString() string {
+	return s.String()
+}
+
 type DetachElasticLoadBalancerOutput struct {
 	metadataDetachElasticLoadBalancerOutput `json:"-" xml:"-"`
 }
@@ -4170,6 +5759,16 @@ type metadataDetachElasticLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DetachElasticLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DetachElasticLoadBalancerOutput) GoString() string {
+	return s.String()
+}
+
 type DisassociateElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -4181,6 +5780,16 @@ type metadataDisassociateElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisassociateElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateElasticIPInput) GoString() string {
+	return s.String()
+}
+
 type DisassociateElasticIPOutput struct {
 	metadataDisassociateElasticIPOutput `json:"-" xml:"-"`
 }
@@ -4189,6 +5798,16 @@ type metadataDisassociateElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisassociateElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateElasticIPOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an Amazon EBS volume. This data type maps directly to the Amazon
 // EC2 EbsBlockDevice (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_EbsBlockDevice.html)
 // data type.
@@ -4208,7 +5827,9 @@ type EBSBlockDevice struct {
 
 	// The volume type. gp2 for General Purpose (SSD) volumes, io1 for Provisioned
 	// IOPS (SSD) volumes, and standard for Magnetic volumes.
-	VolumeType *string `type:"string"`
+	//
+	// See the VolumeType* constants for valid values.
+	VolumeType *string `type:"string" enum:"gp2,io1,standard"`
 
 	metadataEBSBlockDevice `json:"-" xml:"-"`
 }
@@ -4217,6 +5838,16 @@ type metadataEBSBlockDevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EBSBlockDevice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EBSBlockDevice) GoString() string {
+	return s.String()
+}
+
 // Describes an Elastic IP address.
 type ElasticIP struct {
 	// The domain.
@@ -4241,6 +5872,16 @@ type metadataElasticIP struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ElasticIP) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ElasticIP) GoString() string {
+	return s.String()
+}
+
 // Describes an Elastic Load Balancing instance.
 type ElasticLoadBalancer struct {
 	// A list of Availability Zones.
@@ -4278,6 +5919,16 @@ type metadataElasticLoadBalancer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ElasticLoadBalancer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ElasticLoadBalancer) GoString() string {
+	return s.String()
+}
+
 // Represents an app's environment variable.
 type EnvironmentVariable struct {
 	// (Required) The environment variable's name, which can consist of up to 64
@@ -4304,6 +5955,16 @@ type metadataEnvironmentVariable struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnvironmentVariable) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnvironmentVariable) GoString() string {
+	return s.String()
+}
+
 type GetHostnameSuggestionInput struct {
 	// The layer ID.
 	LayerID *string `locationName:"LayerId" type:"string" required:"true"`
@@ -4315,6 +5976,16 @@ type metadataGetHostnameSuggestionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHostnameSuggestionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHostnameSuggestionInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a GetHostnameSuggestion request.
 type GetHostnameSuggestionOutput struct {
 	// The generated host name.
@@ -4330,6 +6001,16 @@ type metadataGetHostnameSuggestionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHostnameSuggestionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHostnameSuggestionOutput) GoString() string {
+	return s.String()
+}
+
 type GrantAccessInput struct {
 	// The instance's AWS OpsWorks ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -4338,7 +6019,7 @@ type GrantAccessInput struct {
 	// at the end of this period, the user will no longer be able to use the credentials
 	// to log in. If the user is logged in at the time, he or she automatically
 	// will be logged out.
-	ValidForInMinutes *int64 `type:"integer"`
+	ValidForInMinutes *int64 `type:"integer" min:"60" max:"1440"`
 
 	metadataGrantAccessInput `json:"-" xml:"-"`
 }
@@ -4347,6 +6028,16 @@ type metadataGrantAccessInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GrantAccessInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GrantAccessInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a GrantAccess request.
 type GrantAccessOutput struct {
 	// A TemporaryCredential object that contains the data needed to log in to the
@@ -4360,6 +6051,16 @@ type metadataGrantAccessOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GrantAccessOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GrantAccessOutput) GoString() string {
+	return s.String()
+}
+
 // Describes an instance.
 type Instance struct {
 	// A custom AMI ID to be used to create the instance. For more information,
@@ -4371,10 +6072,14 @@ type Instance struct {
 	AgentVersion *string `type:"string"`
 
 	// The instance architecture: "i386" or "x86_64".
-	Architecture *string `type:"string"`
+	//
+	// See the Architecture* constants for valid values.
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type.
-	AutoScalingType *string `type:"string"`
+	//
+	// See the AutoScalingType* constants for valid values.
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// The instance Availability Zone. For more information, see Regions and Endpoints
 	// (http://docs.aws.amazon.com/general/latest/gr/rande.html).
@@ -4457,7 +6162,9 @@ type Instance struct {
 
 	// The instance's root device type. For more information, see Storage for the
 	// Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	RootDeviceType *string `type:"string"`
+	//
+	// See the RootDeviceType* constants for valid values.
+	RootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// The root device volume ID.
 	RootDeviceVolumeID *string `locationName:"RootDeviceVolumeId" type:"string"`
@@ -4488,7 +6195,9 @@ type Instance struct {
 	SubnetID *string `locationName:"SubnetId" type:"string"`
 
 	// The instance's virtualization type: paravirtual or hvm.
-	VirtualizationType *string `type:"string"`
+	//
+	// See the VirtualizationType* constants for valid values.
+	VirtualizationType *string `type:"string" enum:"paravirtual,hvm"`
 
 	metadataInstance `json:"-" xml:"-"`
 }
@@ -4497,6 +6206,16 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Instance) GoString() string {
+	return s.String()
+}
+
 // Contains a description of an Amazon EC2 instance from the Amazon EC2 metadata
 // service. For more information, see Instance Metadata and User Data (http://docs.aws.amazon.com/sdkfornet/latest/apidocs/Index.html).
 type InstanceIdentity struct {
@@ -4513,6 +6232,16 @@ type metadataInstanceIdentity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstanceIdentity) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstanceIdentity) GoString() string {
+	return s.String()
+}
+
 // Describes how many instances a stack has for each status.
 type InstancesCount struct {
 	// The number of instances in the Assigning state.
@@ -4579,6 +6308,16 @@ type metadataInstancesCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s InstancesCount) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s InstancesCount) GoString() string {
+	return s.String()
+}
+
 // Describes a layer.
 type Layer struct {
 	// The layer attributes.
@@ -4657,7 +6396,9 @@ type Layer struct {
 	StackID *string `locationName:"StackId" type:"string"`
 
 	// The layer type.
-	Type *string `type:"string"`
+	//
+	// See the LayerType* constants for valid values.
+	Type *string `type:"string" enum:"java-app,lb,web,php-app,rails-app,nodejs-app,memcached,db-master,monitoring-master,custom"`
 
 	// Whether the layer uses Amazon EBS-optimized instances.
 	UseEBSOptimizedInstances *bool `locationName:"UseEbsOptimizedInstances" type:"boolean"`
@@ -4672,6 +6413,16 @@ type metadataLayer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Layer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Layer) GoString() string {
+	return s.String()
+}
+
 // Specifies the lifecycle event configuration
 type LifecycleEventConfiguration struct {
 	// A ShutdownEventConfiguration object that specifies the Shutdown event configuration.
@@ -4684,6 +6435,16 @@ type metadataLifecycleEventConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LifecycleEventConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LifecycleEventConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes a layer's load-based auto scaling configuration.
 type LoadBasedAutoScalingConfiguration struct {
 	// An AutoScalingThresholds object that describes the downscaling configuration,
@@ -4707,6 +6468,16 @@ type metadataLoadBasedAutoScalingConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s LoadBasedAutoScalingConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s LoadBasedAutoScalingConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes stack or user permissions.
 type Permission struct {
 	// Whether the user can use SSH.
@@ -4735,6 +6506,16 @@ type metadataPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Permission) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Permission) GoString() string {
+	return s.String()
+}
+
 // Describes an instance's RAID array.
 type RAIDArray struct {
 	// The array's Availability Zone. For more information, see Regions and Endpoints
@@ -4784,6 +6565,16 @@ type metadataRAIDArray struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RAIDArray) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RAIDArray) GoString() string {
+	return s.String()
+}
+
 // Describes an Amazon RDS instance.
 type RDSDBInstance struct {
 	// The instance's address.
@@ -4822,6 +6613,16 @@ type metadataRDSDBInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RDSDBInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RDSDBInstance) GoString() string {
+	return s.String()
+}
+
 type RebootInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -4833,6 +6634,16 @@ type metadataRebootInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootInstanceInput) GoString() string {
+	return s.String()
+}
+
 type RebootInstanceOutput struct {
 	metadataRebootInstanceOutput `json:"-" xml:"-"`
 }
@@ -4841,6 +6652,16 @@ type metadataRebootInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootInstanceOutput) GoString() string {
+	return s.String()
+}
+
 // AWS OpsWorks supports five lifecycle events: setup, configuration, deploy,
 // undeploy, and shutdown. For each layer, AWS OpsWorks runs a set of standard
 // recipes for each event. In addition, you can provide custom recipes for any
@@ -4875,6 +6696,16 @@ type metadataRecipes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Recipes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Recipes) GoString() string {
+	return s.String()
+}
+
 type RegisterElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -4889,6 +6720,16 @@ type metadataRegisterElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterElasticIPInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a RegisterElasticIp request.
 type RegisterElasticIPOutput struct {
 	// The Elastic IP address.
@@ -4901,6 +6742,16 @@ type metadataRegisterElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterElasticIPOutput) GoString() string {
+	return s.String()
+}
+
 type RegisterInstanceInput struct {
 	// The instance's hostname.
 	Hostname *string `type:"string"`
@@ -4931,6 +6782,16 @@ type metadataRegisterInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterInstanceInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a RegisterInstanceResult request.
 type RegisterInstanceOutput struct {
 	// The registered instance's AWS OpsWorks ID.
@@ -4943,6 +6804,16 @@ type metadataRegisterInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type RegisterRDSDBInstanceInput struct {
 	// The database password.
 	DBPassword *string `locationName:"DbPassword" type:"string" required:"true"`
@@ -4963,6 +6834,16 @@ type metadataRegisterRDSDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterRDSDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterRDSDBInstanceInput) GoString() string {
+	return s.String()
+}
+
 type RegisterRDSDBInstanceOutput struct {
 	metadataRegisterRDSDBInstanceOutput `json:"-" xml:"-"`
 }
@@ -4971,6 +6852,16 @@ type metadataRegisterRDSDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterRDSDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterRDSDBInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type RegisterVolumeInput struct {
 	// The Amazon EBS volume ID.
 	EC2VolumeID *string `locationName:"Ec2VolumeId" type:"string"`
@@ -4985,6 +6876,16 @@ type metadataRegisterVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterVolumeInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a RegisterVolume request.
 type RegisterVolumeOutput struct {
 	// The volume ID.
@@ -4997,6 +6898,16 @@ type metadataRegisterVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterVolumeOutput) GoString() string {
+	return s.String()
+}
+
 // A registered instance's reported operating system.
 type ReportedOs struct {
 	// The operating system family.
@@ -5015,6 +6926,16 @@ type metadataReportedOs struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReportedOs) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReportedOs) GoString() string {
+	return s.String()
+}
+
 // Describes an app's SSL configuration.
 type SSLConfiguration struct {
 	// The contents of the certificate's domain.crt file.
@@ -5034,6 +6955,16 @@ type metadataSSLConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SSLConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SSLConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes a user's SSH information.
 type SelfUserProfile struct {
 	// The user's IAM ARN.
@@ -5055,6 +6986,16 @@ type metadataSelfUserProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SelfUserProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SelfUserProfile) GoString() string {
+	return s.String()
+}
+
 // Describes an AWS OpsWorks service error.
 type ServiceError struct {
 	// When the error occurred.
@@ -5082,6 +7023,16 @@ type metadataServiceError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ServiceError) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ServiceError) GoString() string {
+	return s.String()
+}
+
 type SetLoadBasedAutoScalingInput struct {
 	// An AutoScalingThresholds object with the downscaling threshold configuration.
 	// If the load falls below these thresholds for a specified amount of time,
@@ -5106,6 +7057,16 @@ type metadataSetLoadBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBasedAutoScalingInput) GoString() string {
+	return s.String()
+}
+
 type SetLoadBasedAutoScalingOutput struct {
 	metadataSetLoadBasedAutoScalingOutput `json:"-" xml:"-"`
 }
@@ -5114,6 +7075,16 @@ type metadataSetLoadBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetLoadBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetLoadBasedAutoScalingOutput) GoString() string {
+	return s.String()
+}
+
 type SetPermissionInput struct {
 	// The user is allowed to use SSH to communicate with the instance.
 	AllowSSH *bool `locationName:"AllowSsh" type:"boolean"`
@@ -5141,6 +7112,16 @@ type metadataSetPermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetPermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetPermissionInput) GoString() string {
+	return s.String()
+}
+
 type SetPermissionOutput struct {
 	metadataSetPermissionOutput `json:"-" xml:"-"`
 }
@@ -5149,6 +7130,16 @@ type metadataSetPermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetPermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetPermissionOutput) GoString() string {
+	return s.String()
+}
+
 type SetTimeBasedAutoScalingInput struct {
 	// An AutoScalingSchedule with the instance schedule.
 	AutoScalingSchedule *WeeklyAutoScalingSchedule `type:"structure"`
@@ -5163,6 +7154,16 @@ type metadataSetTimeBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTimeBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTimeBasedAutoScalingInput) GoString() string {
+	return s.String()
+}
+
 type SetTimeBasedAutoScalingOutput struct {
 	metadataSetTimeBasedAutoScalingOutput `json:"-" xml:"-"`
 }
@@ -5171,6 +7172,16 @@ type metadataSetTimeBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTimeBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTimeBasedAutoScalingOutput) GoString() string {
+	return s.String()
+}
+
 // The Shutdown event configuration.
 type ShutdownEventConfiguration struct {
 	// Whether to enable Elastic Load Balancing connection draining. For more information,
@@ -5188,6 +7199,16 @@ type metadataShutdownEventConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ShutdownEventConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ShutdownEventConfiguration) GoString() string {
+	return s.String()
+}
+
 // Contains the information required to retrieve an app or cookbook from a repository.
 // For more information, see Creating Apps (http://docs.aws.amazon.com/opsworks/latest/userguide/workingapps-creating.html)
 // or Custom Recipes and Cookbooks (http://docs.aws.amazon.com/opsworks/latest/userguide/workingcookbook.html).
@@ -5215,7 +7236,9 @@ type Source struct {
 	SSHKey *string `locationName:"SshKey" type:"string"`
 
 	// The repository type.
-	Type *string `type:"string"`
+	//
+	// See the SourceType* constants for valid values.
+	Type *string `type:"string" enum:"git,svn,archive,s3"`
 
 	// The source URL.
 	URL *string `locationName:"Url" type:"string"`
@@ -5234,6 +7257,16 @@ type metadataSource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Source) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Source) GoString() string {
+	return s.String()
+}
+
 // Describes a stack.
 type Stack struct {
 	// The stack's ARN.
@@ -5288,7 +7321,9 @@ type Stack struct {
 	// The default root device type. This value is used by default for all instances
 	// in the stack, but you can override it when you create an instance. For more
 	// information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	//
+	// See the RootDeviceType* constants for valid values.
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default Amazon EC2 key pair for the stack's instances. You can override
 	// this value when you create or update an instance.
@@ -5330,6 +7365,16 @@ type metadataStack struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Stack) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Stack) GoString() string {
+	return s.String()
+}
+
 // Describes the configuration manager.
 type StackConfigurationManager struct {
 	// The name. This parameter must be set to "Chef".
@@ -5346,6 +7391,16 @@ type metadataStackConfigurationManager struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StackConfigurationManager) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StackConfigurationManager) GoString() string {
+	return s.String()
+}
+
 // Summarizes the number of layers, instances, and apps in a stack.
 type StackSummary struct {
 	// The stack's ARN.
@@ -5373,6 +7428,16 @@ type metadataStackSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StackSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StackSummary) GoString() string {
+	return s.String()
+}
+
 type StartInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -5384,6 +7449,16 @@ type metadataStartInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartInstanceInput) GoString() string {
+	return s.String()
+}
+
 type StartInstanceOutput struct {
 	metadataStartInstanceOutput `json:"-" xml:"-"`
 }
@@ -5392,6 +7467,16 @@ type metadataStartInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type StartStackInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -5403,6 +7488,16 @@ type metadataStartStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartStackInput) GoString() string {
+	return s.String()
+}
+
 type StartStackOutput struct {
 	metadataStartStackOutput `json:"-" xml:"-"`
 }
@@ -5411,6 +7506,16 @@ type metadataStartStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartStackOutput) GoString() string {
+	return s.String()
+}
+
 type StopInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -5422,6 +7527,16 @@ type metadataStopInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopInstanceInput) GoString() string {
+	return s.String()
+}
+
 type StopInstanceOutput struct {
 	metadataStopInstanceOutput `json:"-" xml:"-"`
 }
@@ -5430,6 +7545,16 @@ type metadataStopInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type StopStackInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -5441,6 +7566,16 @@ type metadataStopStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopStackInput) GoString() string {
+	return s.String()
+}
+
 type StopStackOutput struct {
 	metadataStopStackOutput `json:"-" xml:"-"`
 }
@@ -5449,6 +7584,16 @@ type metadataStopStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StopStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StopStackOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the data needed by RDP clients such as the Microsoft Remote Desktop
 // Connection to log in to the instance.
 type TemporaryCredential struct {
@@ -5474,6 +7619,16 @@ type metadataTemporaryCredential struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TemporaryCredential) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TemporaryCredential) GoString() string {
+	return s.String()
+}
+
 // Describes an instance's time-based auto scaling configuration.
 type TimeBasedAutoScalingConfiguration struct {
 	// A WeeklyAutoScalingSchedule object with the instance schedule.
@@ -5489,6 +7644,16 @@ type metadataTimeBasedAutoScalingConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TimeBasedAutoScalingConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TimeBasedAutoScalingConfiguration) GoString() string {
+	return s.String()
+}
+
 type UnassignInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -5500,6 +7665,16 @@ type metadataUnassignInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnassignInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnassignInstanceInput) GoString() string {
+	return s.String()
+}
+
 type UnassignInstanceOutput struct {
 	metadataUnassignInstanceOutput `json:"-" xml:"-"`
 }
@@ -5508,6 +7683,16 @@ type metadataUnassignInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnassignInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnassignInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type UnassignVolumeInput struct {
 	// The volume ID.
 	VolumeID *string `locationName:"VolumeId" type:"string" required:"true"`
@@ -5519,6 +7704,16 @@ type metadataUnassignVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnassignVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnassignVolumeInput) GoString() string {
+	return s.String()
+}
+
 type UnassignVolumeOutput struct {
 	metadataUnassignVolumeOutput `json:"-" xml:"-"`
 }
@@ -5527,6 +7722,16 @@ type metadataUnassignVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnassignVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnassignVolumeOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateAppInput struct {
 	// The app ID.
 	AppID *string `locationName:"AppId" type:"string" required:"true"`
@@ -5573,7 +7778,9 @@ type UpdateAppInput struct {
 	SSLConfiguration *SSLConfiguration `locationName:"SslConfiguration" type:"structure"`
 
 	// The app type.
-	Type *string `type:"string"`
+	//
+	// See the AppType* constants for valid values.
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other"`
 
 	metadataUpdateAppInput `json:"-" xml:"-"`
 }
@@ -5582,6 +7789,16 @@ type metadataUpdateAppInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAppInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAppInput) GoString() string {
+	return s.String()
+}
+
 type UpdateAppOutput struct {
 	metadataUpdateAppOutput `json:"-" xml:"-"`
 }
@@ -5590,6 +7807,16 @@ type metadataUpdateAppOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAppOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAppOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateElasticIPInput struct {
 	// The address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -5604,6 +7831,16 @@ type metadataUpdateElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateElasticIPInput) GoString() string {
+	return s.String()
+}
+
 type UpdateElasticIPOutput struct {
 	metadataUpdateElasticIPOutput `json:"-" xml:"-"`
 }
@@ -5612,6 +7849,16 @@ type metadataUpdateElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateElasticIPOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateInstanceInput struct {
 	// A custom AMI ID to be used to create the instance. The AMI must be based
 	// on one of the supported operating systems. For more information, see Instances
@@ -5635,11 +7882,15 @@ type UpdateInstanceInput struct {
 	// The instance architecture. Instance types do not necessarily support both
 	// architectures. For a list of the architectures that are supported by the
 	// different instance types, see Instance Families and Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html).
-	Architecture *string `type:"string"`
+	//
+	// See the Architecture* constants for valid values.
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type. Windows stacks can use
 	// only time-based instances.
-	AutoScalingType *string `type:"string"`
+	//
+	// See the AutoScalingType* constants for valid values.
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// This property cannot be updated.
 	EBSOptimized *bool `locationName:"EbsOptimized" type:"boolean"`
@@ -5699,6 +7950,16 @@ type metadataUpdateInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateInstanceInput) GoString() string {
+	return s.String()
+}
+
 type UpdateInstanceOutput struct {
 	metadataUpdateInstanceOutput `json:"-" xml:"-"`
 }
@@ -5707,6 +7968,16 @@ type metadataUpdateInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateLayerInput struct {
 	// One or more user-defined key/value pairs to be added to the stack attributes.
 	Attributes map[string]*string `type:"map"`
@@ -5777,6 +8048,16 @@ type metadataUpdateLayerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateLayerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateLayerInput) GoString() string {
+	return s.String()
+}
+
 type UpdateLayerOutput struct {
 	metadataUpdateLayerOutput `json:"-" xml:"-"`
 }
@@ -5785,6 +8066,16 @@ type metadataUpdateLayerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateLayerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateLayerOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateMyUserProfileInput struct {
 	// The user's SSH public key.
 	SSHPublicKey *string `locationName:"SshPublicKey" type:"string"`
@@ -5796,6 +8087,16 @@ type metadataUpdateMyUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateMyUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateMyUserProfileInput) GoString() string {
+	return s.String()
+}
+
 type UpdateMyUserProfileOutput struct {
 	metadataUpdateMyUserProfileOutput `json:"-" xml:"-"`
 }
@@ -5804,6 +8105,16 @@ type metadataUpdateMyUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateMyUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateMyUserProfileOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateRDSDBInstanceInput struct {
 	// The database password.
 	DBPassword *string `locationName:"DbPassword" type:"string"`
@@ -5821,6 +8132,16 @@ type metadataUpdateRDSDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateRDSDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateRDSDBInstanceInput) GoString() string {
+	return s.String()
+}
+
 type UpdateRDSDBInstanceOutput struct {
 	metadataUpdateRDSDBInstanceOutput `json:"-" xml:"-"`
 }
@@ -5829,6 +8150,16 @@ type metadataUpdateRDSDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateRDSDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateRDSDBInstanceOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateStackInput struct {
 	// The default AWS OpsWorks agent version. You have the following options:
 	//
@@ -5899,7 +8230,9 @@ type UpdateStackInput struct {
 	// The default root device type. This value is used by default for all instances
 	// in the stack, but you can override it when you create an instance. For more
 	// information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	//
+	// See the RootDeviceType* constants for valid values.
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default Amazon EC2 key-pair name. The default value is none. If you specify
 	// a key-pair name, AWS OpsWorks installs the public key on the instance and
@@ -5973,6 +8306,16 @@ type metadataUpdateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateStackInput) GoString() string {
+	return s.String()
+}
+
 type UpdateStackOutput struct {
 	metadataUpdateStackOutput `json:"-" xml:"-"`
 }
@@ -5981,6 +8324,16 @@ type metadataUpdateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateStackOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateUserProfileInput struct {
 	// Whether users can specify their own SSH public key through the My Settings
 	// page. For more information, see Managing User Permissions (http://docs.aws.amazon.com/opsworks/latest/userguide/security-settingsshkey.html).
@@ -6006,6 +8359,16 @@ type metadataUpdateUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateUserProfileInput) GoString() string {
+	return s.String()
+}
+
 type UpdateUserProfileOutput struct {
 	metadataUpdateUserProfileOutput `json:"-" xml:"-"`
 }
@@ -6014,6 +8377,16 @@ type metadataUpdateUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateUserProfileOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateVolumeInput struct {
 	// The new mount point.
 	MountPoint *string `type:"string"`
@@ -6031,6 +8404,16 @@ type metadataUpdateVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateVolumeInput) GoString() string {
+	return s.String()
+}
+
 type UpdateVolumeOutput struct {
 	metadataUpdateVolumeOutput `json:"-" xml:"-"`
 }
@@ -6039,6 +8422,16 @@ type metadataUpdateVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateVolumeOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a user's SSH information.
 type UserProfile struct {
 	// Whether users can specify their own SSH public key through the My Settings
@@ -6064,6 +8457,16 @@ type metadataUserProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UserProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UserProfile) GoString() string {
+	return s.String()
+}
+
 // Describes an instance's Amazon EBS volume.
 type Volume struct {
 	// The volume Availability Zone. For more information, see Regions and Endpoints
@@ -6114,6 +8517,16 @@ type metadataVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Volume) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Volume) GoString() string {
+	return s.String()
+}
+
 // Describes an Amazon EBS volume configuration.
 type VolumeConfiguration struct {
 	// For PIOPS volumes, the IOPS per disk.
@@ -6144,6 +8557,16 @@ type metadataVolumeConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeConfiguration) GoString() string {
+	return s.String()
+}
+
 // Describes a time-based instance's auto scaling schedule. The schedule consists
 // of a set of key-value pairs.
 //
@@ -6186,3 +8609,191 @@ type WeeklyAutoScalingSchedule struct {
 type metadataWeeklyAutoScalingSchedule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s WeeklyAutoScalingSchedule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WeeklyAutoScalingSchedule) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum AppAttributesKeys
+	AppAttributesKeysDocumentRoot = "DocumentRoot"
+	// @enum AppAttributesKeys
+	AppAttributesKeysRailsEnv = "RailsEnv"
+	// @enum AppAttributesKeys
+	AppAttributesKeysAutoBundleOnDeploy = "AutoBundleOnDeploy"
+)
+
+const (
+	// @enum AppType
+	AppTypeJava = "java"
+	// @enum AppType
+	AppTypeRails = "rails"
+	// @enum AppType
+	AppTypePhp = "php"
+	// @enum AppType
+	AppTypeNodejs = "nodejs"
+	// @enum AppType
+	AppTypeStatic = "static"
+	// @enum AppType
+	AppTypeOther = "other"
+)
+
+const (
+	// @enum Architecture
+	ArchitectureX8664 = "x86_64"
+	// @enum Architecture
+	ArchitectureI386 = "i386"
+)
+
+const (
+	// @enum AutoScalingType
+	AutoScalingTypeLoad = "load"
+	// @enum AutoScalingType
+	AutoScalingTypeTimer = "timer"
+)
+
+const (
+	// @enum DeploymentCommandName
+	DeploymentCommandNameInstallDependencies = "install_dependencies"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameUpdateDependencies = "update_dependencies"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameUpdateCustomCookbooks = "update_custom_cookbooks"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameExecuteRecipes = "execute_recipes"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameConfigure = "configure"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameSetup = "setup"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameDeploy = "deploy"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameRollback = "rollback"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameStart = "start"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameStop = "stop"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameRestart = "restart"
+	// @enum DeploymentCommandName
+	DeploymentCommandNameUndeploy = "undeploy"
+)
+
+const (
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysEnableHaproxyStats = "EnableHaproxyStats"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysHaproxyStatsUrl = "HaproxyStatsUrl"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysHaproxyStatsUser = "HaproxyStatsUser"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysHaproxyStatsPassword = "HaproxyStatsPassword"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysHaproxyHealthCheckUrl = "HaproxyHealthCheckUrl"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysHaproxyHealthCheckMethod = "HaproxyHealthCheckMethod"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysMysqlRootPassword = "MysqlRootPassword"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysMysqlRootPasswordUbiquitous = "MysqlRootPasswordUbiquitous"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysGangliaUrl = "GangliaUrl"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysGangliaUser = "GangliaUser"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysGangliaPassword = "GangliaPassword"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysMemcachedMemory = "MemcachedMemory"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysNodejsVersion = "NodejsVersion"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysRubyVersion = "RubyVersion"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysRubygemsVersion = "RubygemsVersion"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysManageBundler = "ManageBundler"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysBundlerVersion = "BundlerVersion"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysRailsStack = "RailsStack"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysPassengerVersion = "PassengerVersion"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysJvm = "Jvm"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysJvmVersion = "JvmVersion"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysJvmOptions = "JvmOptions"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysJavaAppServer = "JavaAppServer"
+	// @enum LayerAttributesKeys
+	LayerAttributesKeysJavaAppServerVersion = "JavaAppServerVersion"
+)
+
+const (
+	// @enum LayerType
+	LayerTypeJavaApp = "java-app"
+	// @enum LayerType
+	LayerTypeLb = "lb"
+	// @enum LayerType
+	LayerTypeWeb = "web"
+	// @enum LayerType
+	LayerTypePhpApp = "php-app"
+	// @enum LayerType
+	LayerTypeRailsApp = "rails-app"
+	// @enum LayerType
+	LayerTypeNodejsApp = "nodejs-app"
+	// @enum LayerType
+	LayerTypeMemcached = "memcached"
+	// @enum LayerType
+	LayerTypeDbMaster = "db-master"
+	// @enum LayerType
+	LayerTypeMonitoringMaster = "monitoring-master"
+	// @enum LayerType
+	LayerTypeCustom = "custom"
+)
+
+const (
+	// @enum RootDeviceType
+	RootDeviceTypeEbs = "ebs"
+	// @enum RootDeviceType
+	RootDeviceTypeInstanceStore = "instance-store"
+)
+
+const (
+	// @enum SourceType
+	SourceTypeGit = "git"
+	// @enum SourceType
+	SourceTypeSvn = "svn"
+	// @enum SourceType
+	SourceTypeArchive = "archive"
+	// @enum SourceType
+	SourceTypeS3 = "s3"
+)
+
+const (
+	// @enum StackAttributesKeys
+	StackAttributesKeysColor = "Color"
+)
+
+const (
+	// @enum VirtualizationType
+	VirtualizationTypeParavirtual = "paravirtual"
+	// @enum VirtualizationType
+	VirtualizationTypeHvm = "hvm"
+)
+
+const (
+	// @enum VolumeType
+	VolumeTypeGp2 = "gp2"
+	// @enum VolumeType
+	VolumeTypeIo1 = "io1"
+	// @enum VolumeType
+	VolumeTypeStandard = "standard"
+)
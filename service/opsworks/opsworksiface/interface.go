@@ -4,6 +4,7 @@
 package opsworksiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 )
 
@@ -11,135 +12,275 @@ import (
 type OpsWorksAPI interface {
 	AssignInstance(*opsworks.AssignInstanceInput) (*opsworks.AssignInstanceOutput, error)
 
+	AssignInstanceWithContext(aws.Context, *opsworks.AssignInstanceInput) (*opsworks.AssignInstanceOutput, error)
+
 	AssignVolume(*opsworks.AssignVolumeInput) (*opsworks.AssignVolumeOutput, error)
 
+	AssignVolumeWithContext(aws.Context, *opsworks.AssignVolumeInput) (*opsworks.AssignVolumeOutput, error)
+
 	AssociateElasticIP(*opsworks.AssociateElasticIPInput) (*opsworks.AssociateElasticIPOutput, error)
 
+	AssociateElasticIPWithContext(aws.Context, *opsworks.AssociateElasticIPInput) (*opsworks.AssociateElasticIPOutput, error)
+
 	AttachElasticLoadBalancer(*opsworks.AttachElasticLoadBalancerInput) (*opsworks.AttachElasticLoadBalancerOutput, error)
 
+	AttachElasticLoadBalancerWithContext(aws.Context, *opsworks.AttachElasticLoadBalancerInput) (*opsworks.AttachElasticLoadBalancerOutput, error)
+
 	CloneStack(*opsworks.CloneStackInput) (*opsworks.CloneStackOutput, error)
 
+	CloneStackWithContext(aws.Context, *opsworks.CloneStackInput) (*opsworks.CloneStackOutput, error)
+
 	CreateApp(*opsworks.CreateAppInput) (*opsworks.CreateAppOutput, error)
 
+	CreateAppWithContext(aws.Context, *opsworks.CreateAppInput) (*opsworks.CreateAppOutput, error)
+
 	CreateDeployment(*opsworks.CreateDeploymentInput) (*opsworks.CreateDeploymentOutput, error)
 
+	CreateDeploymentWithContext(aws.Context, *opsworks.CreateDeploymentInput) (*opsworks.CreateDeploymentOutput, error)
+
 	CreateInstance(*opsworks.CreateInstanceInput) (*opsworks.CreateInstanceOutput, error)
 
+	CreateInstanceWithContext(aws.Context, *opsworks.CreateInstanceInput) (*opsworks.CreateInstanceOutput, error)
+
 	CreateLayer(*opsworks.CreateLayerInput) (*opsworks.CreateLayerOutput, error)
 
+	CreateLayerWithContext(aws.Context, *opsworks.CreateLayerInput) (*opsworks.CreateLayerOutput, error)
+
 	CreateStack(*opsworks.CreateStackInput) (*opsworks.CreateStackOutput, error)
 
+	CreateStackWithContext(aws.Context, *opsworks.CreateStackInput) (*opsworks.CreateStackOutput, error)
+
 	CreateUserProfile(*opsworks.CreateUserProfileInput) (*opsworks.CreateUserProfileOutput, error)
 
+	CreateUserProfileWithContext(aws.Context, *opsworks.CreateUserProfileInput) (*opsworks.CreateUserProfileOutput, error)
+
 	DeleteApp(*opsworks.DeleteAppInput) (*opsworks.DeleteAppOutput, error)
 
+	DeleteAppWithContext(aws.Context, *opsworks.DeleteAppInput) (*opsworks.DeleteAppOutput, error)
+
 	DeleteInstance(*opsworks.DeleteInstanceInput) (*opsworks.DeleteInstanceOutput, error)
 
+	DeleteInstanceWithContext(aws.Context, *opsworks.DeleteInstanceInput) (*opsworks.DeleteInstanceOutput, error)
+
 	DeleteLayer(*opsworks.DeleteLayerInput) (*opsworks.DeleteLayerOutput, error)
 
+	DeleteLayerWithContext(aws.Context, *opsworks.DeleteLayerInput) (*opsworks.DeleteLayerOutput, error)
+
 	DeleteStack(*opsworks.DeleteStackInput) (*opsworks.DeleteStackOutput, error)
 
+	DeleteStackWithContext(aws.Context, *opsworks.DeleteStackInput) (*opsworks.DeleteStackOutput, error)
+
 	DeleteUserProfile(*opsworks.DeleteUserProfileInput) (*opsworks.DeleteUserProfileOutput, error)
 
+	DeleteUserProfileWithContext(aws.Context, *opsworks.DeleteUserProfileInput) (*opsworks.DeleteUserProfileOutput, error)
+
 	DeregisterElasticIP(*opsworks.DeregisterElasticIPInput) (*opsworks.DeregisterElasticIPOutput, error)
 
+	DeregisterElasticIPWithContext(aws.Context, *opsworks.DeregisterElasticIPInput) (*opsworks.DeregisterElasticIPOutput, error)
+
 	DeregisterInstance(*opsworks.DeregisterInstanceInput) (*opsworks.DeregisterInstanceOutput, error)
 
+	DeregisterInstanceWithContext(aws.Context, *opsworks.DeregisterInstanceInput) (*opsworks.DeregisterInstanceOutput, error)
+
 	DeregisterRDSDBInstance(*opsworks.DeregisterRDSDBInstanceInput) (*opsworks.DeregisterRDSDBInstanceOutput, error)
 
+	DeregisterRDSDBInstanceWithContext(aws.Context, *opsworks.DeregisterRDSDBInstanceInput) (*opsworks.DeregisterRDSDBInstanceOutput, error)
+
 	DeregisterVolume(*opsworks.DeregisterVolumeInput) (*opsworks.DeregisterVolumeOutput, error)
 
+	DeregisterVolumeWithContext(aws.Context, *opsworks.DeregisterVolumeInput) (*opsworks.DeregisterVolumeOutput, error)
+
 	DescribeAgentVersions(*opsworks.DescribeAgentVersionsInput) (*opsworks.DescribeAgentVersionsOutput, error)
 
+	DescribeAgentVersionsWithContext(aws.Context, *opsworks.DescribeAgentVersionsInput) (*opsworks.DescribeAgentVersionsOutput, error)
+
 	DescribeApps(*opsworks.DescribeAppsInput) (*opsworks.DescribeAppsOutput, error)
 
+	DescribeAppsWithContext(aws.Context, *opsworks.DescribeAppsInput) (*opsworks.DescribeAppsOutput, error)
+
 	DescribeCommands(*opsworks.DescribeCommandsInput) (*opsworks.DescribeCommandsOutput, error)
 
+	DescribeCommandsWithContext(aws.Context, *opsworks.DescribeCommandsInput) (*opsworks.DescribeCommandsOutput, error)
+
 	DescribeDeployments(*opsworks.DescribeDeploymentsInput) (*opsworks.DescribeDeploymentsOutput, error)
 
+	DescribeDeploymentsWithContext(aws.Context, *opsworks.DescribeDeploymentsInput) (*opsworks.DescribeDeploymentsOutput, error)
+
 	DescribeElasticIPs(*opsworks.DescribeElasticIPsInput) (*opsworks.DescribeElasticIPsOutput, error)
 
+	DescribeElasticIPsWithContext(aws.Context, *opsworks.DescribeElasticIPsInput) (*opsworks.DescribeElasticIPsOutput, error)
+
 	DescribeElasticLoadBalancers(*opsworks.DescribeElasticLoadBalancersInput) (*opsworks.DescribeElasticLoadBalancersOutput, error)
 
+	DescribeElasticLoadBalancersWithContext(aws.Context, *opsworks.DescribeElasticLoadBalancersInput) (*opsworks.DescribeElasticLoadBalancersOutput, error)
+
 	DescribeInstances(*opsworks.DescribeInstancesInput) (*opsworks.DescribeInstancesOutput, error)
 
+	DescribeInstancesWithContext(aws.Context, *opsworks.DescribeInstancesInput) (*opsworks.DescribeInstancesOutput, error)
+
 	DescribeLayers(*opsworks.DescribeLayersInput) (*opsworks.DescribeLayersOutput, error)
 
+	DescribeLayersWithContext(aws.Context, *opsworks.DescribeLayersInput) (*opsworks.DescribeLayersOutput, error)
+
 	DescribeLoadBasedAutoScaling(*opsworks.DescribeLoadBasedAutoScalingInput) (*opsworks.DescribeLoadBasedAutoScalingOutput, error)
 
+	DescribeLoadBasedAutoScalingWithContext(aws.Context, *opsworks.DescribeLoadBasedAutoScalingInput) (*opsworks.DescribeLoadBasedAutoScalingOutput, error)
+
 	DescribeMyUserProfile(*opsworks.DescribeMyUserProfileInput) (*opsworks.DescribeMyUserProfileOutput, error)
 
+	DescribeMyUserProfileWithContext(aws.Context, *opsworks.DescribeMyUserProfileInput) (*opsworks.DescribeMyUserProfileOutput, error)
+
 	DescribePermissions(*opsworks.DescribePermissionsInput) (*opsworks.DescribePermissionsOutput, error)
 
+	DescribePermissionsWithContext(aws.Context, *opsworks.DescribePermissionsInput) (*opsworks.DescribePermissionsOutput, error)
+
 	DescribeRAIDArrays(*opsworks.DescribeRAIDArraysInput) (*opsworks.DescribeRAIDArraysOutput, error)
 
+	DescribeRAIDArraysWithContext(aws.Context, *opsworks.DescribeRAIDArraysInput) (*opsworks.DescribeRAIDArraysOutput, error)
+
 	DescribeRDSDBInstances(*opsworks.DescribeRDSDBInstancesInput) (*opsworks.DescribeRDSDBInstancesOutput, error)
 
+	DescribeRDSDBInstancesWithContext(aws.Context, *opsworks.DescribeRDSDBInstancesInput) (*opsworks.DescribeRDSDBInstancesOutput, error)
+
 	DescribeServiceErrors(*opsworks.DescribeServiceErrorsInput) (*opsworks.DescribeServiceErrorsOutput, error)
 
+	DescribeServiceErrorsWithContext(aws.Context, *opsworks.DescribeServiceErrorsInput) (*opsworks.DescribeServiceErrorsOutput, error)
+
 	DescribeStackProvisioningParameters(*opsworks.DescribeStackProvisioningParametersInput) (*opsworks.DescribeStackProvisioningParametersOutput, error)
 
+	DescribeStackProvisioningParametersWithContext(aws.Context, *opsworks.DescribeStackProvisioningParametersInput) (*opsworks.DescribeStackProvisioningParametersOutput, error)
+
 	DescribeStackSummary(*opsworks.DescribeStackSummaryInput) (*opsworks.DescribeStackSummaryOutput, error)
 
+	DescribeStackSummaryWithContext(aws.Context, *opsworks.DescribeStackSummaryInput) (*opsworks.DescribeStackSummaryOutput, error)
+
 	DescribeStacks(*opsworks.DescribeStacksInput) (*opsworks.DescribeStacksOutput, error)
 
+	DescribeStacksWithContext(aws.Context, *opsworks.DescribeStacksInput) (*opsworks.DescribeStacksOutput, error)
+
 	DescribeTimeBasedAutoScaling(*opsworks.DescribeTimeBasedAutoScalingInput) (*opsworks.DescribeTimeBasedAutoScalingOutput, error)
 
+	DescribeTimeBasedAutoScalingWithContext(aws.Context, *opsworks.DescribeTimeBasedAutoScalingInput) (*opsworks.DescribeTimeBasedAutoScalingOutput, error)
+
 	DescribeUserProfiles(*opsworks.DescribeUserProfilesInput) (*opsworks.DescribeUserProfilesOutput, error)
 
+	DescribeUserProfilesWithContext(aws.Context, *opsworks.DescribeUserProfilesInput) (*opsworks.DescribeUserProfilesOutput, error)
+
 	DescribeVolumes(*opsworks.DescribeVolumesInput) (*opsworks.DescribeVolumesOutput, error)
 
+	DescribeVolumesWithContext(aws.Context, *opsworks.DescribeVolumesInput) (*opsworks.DescribeVolumesOutput, error)
+
 	DetachElasticLoadBalancer(*opsworks.DetachElasticLoadBalancerInput) (*opsworks.DetachElasticLoadBalancerOutput, error)
 
+	DetachElasticLoadBalancerWithContext(aws.Context, *opsworks.DetachElasticLoadBalancerInput) (*opsworks.DetachElasticLoadBalancerOutput, error)
+
 	DisassociateElasticIP(*opsworks.DisassociateElasticIPInput) (*opsworks.DisassociateElasticIPOutput, error)
 
+	DisassociateElasticIPWithContext(aws.Context, *opsworks.DisassociateElasticIPInput) (*opsworks.DisassociateElasticIPOutput, error)
+
 	GetHostnameSuggestion(*opsworks.GetHostnameSuggestionInput) (*opsworks.GetHostnameSuggestionOutput, error)
 
+	GetHostnameSuggestionWithContext(aws.Context, *opsworks.GetHostnameSuggestionInput) (*opsworks.GetHostnameSuggestionOutput, error)
+
 	GrantAccess(*opsworks.GrantAccessInput) (*opsworks.GrantAccessOutput, error)
 
+	GrantAccessWithContext(aws.Context, *opsworks.GrantAccessInput) (*opsworks.GrantAccessOutput, error)
+
 	RebootInstance(*opsworks.RebootInstanceInput) (*opsworks.RebootInstanceOutput, error)
 
+	RebootInstanceWithContext(aws.Context, *opsworks.RebootInstanceInput) (*opsworks.RebootInstanceOutput, error)
+
 	RegisterElasticIP(*opsworks.RegisterElasticIPInput) (*opsworks.RegisterElasticIPOutput, error)
 
+	RegisterElasticIPWithContext(aws.Context, *opsworks.RegisterElasticIPInput) (*opsworks.RegisterElasticIPOutput, error)
+
 	RegisterInstance(*opsworks.RegisterInstanceInput) (*opsworks.RegisterInstanceOutput, error)
 
+	RegisterInstanceWithContext(aws.Context, *opsworks.RegisterInstanceInput) (*opsworks.RegisterInstanceOutput, error)
+
 	RegisterRDSDBInstance(*opsworks.RegisterRDSDBInstanceInput) (*opsworks.RegisterRDSDBInstanceOutput, error)
 
+	RegisterRDSDBInstanceWithContext(aws.Context, *opsworks.RegisterRDSDBInstanceInput) (*opsworks.RegisterRDSDBInstanceOutput, error)
+
 	RegisterVolume(*opsworks.RegisterVolumeInput) (*opsworks.RegisterVolumeOutput, error)
 
+	RegisterVolumeWithContext(aws.Context, *opsworks.RegisterVolumeInput) (*opsworks.RegisterVolumeOutput, error)
+
 	SetLoadBasedAutoScaling(*opsworks.SetLoadBasedAutoScalingInput) (*opsworks.SetLoadBasedAutoScalingOutput, error)
 
+	SetLoadBasedAutoScalingWithContext(aws.Context, *opsworks.SetLoadBasedAutoScalingInput) (*opsworks.SetLoadBasedAutoScalingOutput, error)
+
 	SetPermission(*opsworks.SetPermissionInput) (*opsworks.SetPermissionOutput, error)
 
+	SetPermissionWithContext(aws.Context, *opsworks.SetPermissionInput) (*opsworks.SetPermissionOutput, error)
+
 	SetTimeBasedAutoScaling(*opsworks.SetTimeBasedAutoScalingInput) (*opsworks.SetTimeBasedAutoScalingOutput, error)
 
+	SetTimeBasedAutoScalingWithContext(aws.Context, *opsworks.SetTimeBasedAutoScalingInput) (*opsworks.SetTimeBasedAutoScalingOutput, error)
+
 	StartInstance(*opsworks.StartInstanceInput) (*opsworks.StartInstanceOutput, error)
 
+	StartInstanceWithContext(aws.Context, *opsworks.StartInstanceInput) (*opsworks.StartInstanceOutput, error)
+
 	StartStack(*opsworks.StartStackInput) (*opsworks.StartStackOutput, error)
 
+	StartStackWithContext(aws.Context, *opsworks.StartStackInput) (*opsworks.StartStackOutput, error)
+
 	StopInstance(*opsworks.StopInstanceInput) (*opsworks.StopInstanceOutput, error)
 
+	StopInstanceWithContext(aws.Context, *opsworks.StopInstanceInput) (*opsworks.StopInstanceOutput, error)
+
 	StopStack(*opsworks.StopStackInput) (*opsworks.StopStackOutput, error)
 
+	StopStackWithContext(aws.Context, *opsworks.StopStackInput) (*opsworks.StopStackOutput, error)
+
 	UnassignInstance(*opsworks.UnassignInstanceInput) (*opsworks.UnassignInstanceOutput, error)
 
+	UnassignInstanceWithContext(aws.Context, *opsworks.UnassignInstanceInput) (*opsworks.UnassignInstanceOutput, error)
+
 	UnassignVolume(*opsworks.UnassignVolumeInput) (*opsworks.UnassignVolumeOutput, error)
 
+	UnassignVolumeWithContext(aws.Context, *opsworks.UnassignVolumeInput) (*opsworks.UnassignVolumeOutput, error)
+
 	UpdateApp(*opsworks.UpdateAppInput) (*opsworks.UpdateAppOutput, error)
 
+	UpdateAppWithContext(aws.Context, *opsworks.UpdateAppInput) (*opsworks.UpdateAppOutput, error)
+
 	UpdateElasticIP(*opsworks.UpdateElasticIPInput) (*opsworks.UpdateElasticIPOutput, error)
 
+	UpdateElasticIPWithContext(aws.Context, *opsworks.UpdateElasticIPInput) (*opsworks.UpdateElasticIPOutput, error)
+
 	UpdateInstance(*opsworks.UpdateInstanceInput) (*opsworks.UpdateInstanceOutput, error)
 
+	UpdateInstanceWithContext(aws.Context, *opsworks.UpdateInstanceInput) (*opsworks.UpdateInstanceOutput, error)
+
 	UpdateLayer(*opsworks.UpdateLayerInput) (*opsworks.UpdateLayerOutput, error)
 
+	UpdateLayerWithContext(aws.Context, *opsworks.UpdateLayerInput) (*opsworks.UpdateLayerOutput, error)
+
 	UpdateMyUserProfile(*opsworks.UpdateMyUserProfileInput) (*opsworks.UpdateMyUserProfileOutput, error)
 
+	UpdateMyUserProfileWithContext(aws.Context, *opsworks.UpdateMyUserProfileInput) (*opsworks.UpdateMyUserProfileOutput, error)
+
 	UpdateRDSDBInstance(*opsworks.UpdateRDSDBInstanceInput) (*opsworks.UpdateRDSDBInstanceOutput, error)
 
+	UpdateRDSDBInstanceWithContext(aws.Context, *opsworks.UpdateRDSDBInstanceInput) (*opsworks.UpdateRDSDBInstanceOutput, error)
+
 	UpdateStack(*opsworks.UpdateStackInput) (*opsworks.UpdateStackOutput, error)
 
+	UpdateStackWithContext(aws.Context, *opsworks.UpdateStackInput) (*opsworks.UpdateStackOutput, error)
+
 	UpdateUserProfile(*opsworks.UpdateUserProfileInput) (*opsworks.UpdateUserProfileOutput, error)
 
+	UpdateUserProfileWithContext(aws.Context, *opsworks.UpdateUserProfileInput) (*opsworks.UpdateUserProfileOutput, error)
+
 	UpdateVolume(*opsworks.UpdateVolumeInput) (*opsworks.UpdateVolumeOutput, error)
+
+	UpdateVolumeWithContext(aws.Context, *opsworks.UpdateVolumeInput) (*opsworks.UpdateVolumeOutput, error)
+
+	WaitUntilInstanceOnline(*opsworks.DescribeInstancesInput) error
+
+	WaitUntilInstanceStopped(*opsworks.DescribeInstancesInput) error
+
+	WaitUntilInstanceTerminated(*opsworks.DescribeInstancesInput) error
 }
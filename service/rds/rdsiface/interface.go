@@ -4,6 +4,7 @@
 package rdsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 )
 
@@ -11,117 +12,237 @@ import (
 type RDSAPI interface {
 	AddSourceIdentifierToSubscription(*rds.AddSourceIdentifierToSubscriptionInput) (*rds.AddSourceIdentifierToSubscriptionOutput, error)
 
+	AddSourceIdentifierToSubscriptionWithContext(aws.Context, *rds.AddSourceIdentifierToSubscriptionInput) (*rds.AddSourceIdentifierToSubscriptionOutput, error)
+
 	AddTagsToResource(*rds.AddTagsToResourceInput) (*rds.AddTagsToResourceOutput, error)
 
+	AddTagsToResourceWithContext(aws.Context, *rds.AddTagsToResourceInput) (*rds.AddTagsToResourceOutput, error)
+
 	ApplyPendingMaintenanceAction(*rds.ApplyPendingMaintenanceActionInput) (*rds.ApplyPendingMaintenanceActionOutput, error)
 
+	ApplyPendingMaintenanceActionWithContext(aws.Context, *rds.ApplyPendingMaintenanceActionInput) (*rds.ApplyPendingMaintenanceActionOutput, error)
+
 	AuthorizeDBSecurityGroupIngress(*rds.AuthorizeDBSecurityGroupIngressInput) (*rds.AuthorizeDBSecurityGroupIngressOutput, error)
 
+	AuthorizeDBSecurityGroupIngressWithContext(aws.Context, *rds.AuthorizeDBSecurityGroupIngressInput) (*rds.AuthorizeDBSecurityGroupIngressOutput, error)
+
 	CopyDBParameterGroup(*rds.CopyDBParameterGroupInput) (*rds.CopyDBParameterGroupOutput, error)
 
+	CopyDBParameterGroupWithContext(aws.Context, *rds.CopyDBParameterGroupInput) (*rds.CopyDBParameterGroupOutput, error)
+
 	CopyDBSnapshot(*rds.CopyDBSnapshotInput) (*rds.CopyDBSnapshotOutput, error)
 
+	CopyDBSnapshotWithContext(aws.Context, *rds.CopyDBSnapshotInput) (*rds.CopyDBSnapshotOutput, error)
+
 	CopyOptionGroup(*rds.CopyOptionGroupInput) (*rds.CopyOptionGroupOutput, error)
 
+	CopyOptionGroupWithContext(aws.Context, *rds.CopyOptionGroupInput) (*rds.CopyOptionGroupOutput, error)
+
 	CreateDBInstance(*rds.CreateDBInstanceInput) (*rds.CreateDBInstanceOutput, error)
 
+	CreateDBInstanceWithContext(aws.Context, *rds.CreateDBInstanceInput) (*rds.CreateDBInstanceOutput, error)
+
 	CreateDBInstanceReadReplica(*rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error)
 
+	CreateDBInstanceReadReplicaWithContext(aws.Context, *rds.CreateDBInstanceReadReplicaInput) (*rds.CreateDBInstanceReadReplicaOutput, error)
+
 	CreateDBParameterGroup(*rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error)
 
+	CreateDBParameterGroupWithContext(aws.Context, *rds.CreateDBParameterGroupInput) (*rds.CreateDBParameterGroupOutput, error)
+
 	CreateDBSecurityGroup(*rds.CreateDBSecurityGroupInput) (*rds.CreateDBSecurityGroupOutput, error)
 
+	CreateDBSecurityGroupWithContext(aws.Context, *rds.CreateDBSecurityGroupInput) (*rds.CreateDBSecurityGroupOutput, error)
+
 	CreateDBSnapshot(*rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error)
 
+	CreateDBSnapshotWithContext(aws.Context, *rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error)
+
 	CreateDBSubnetGroup(*rds.CreateDBSubnetGroupInput) (*rds.CreateDBSubnetGroupOutput, error)
 
+	CreateDBSubnetGroupWithContext(aws.Context, *rds.CreateDBSubnetGroupInput) (*rds.CreateDBSubnetGroupOutput, error)
+
 	CreateEventSubscription(*rds.CreateEventSubscriptionInput) (*rds.CreateEventSubscriptionOutput, error)
 
+	CreateEventSubscriptionWithContext(aws.Context, *rds.CreateEventSubscriptionInput) (*rds.CreateEventSubscriptionOutput, error)
+
 	CreateOptionGroup(*rds.CreateOptionGroupInput) (*rds.CreateOptionGroupOutput, error)
 
+	CreateOptionGroupWithContext(aws.Context, *rds.CreateOptionGroupInput) (*rds.CreateOptionGroupOutput, error)
+
 	DeleteDBInstance(*rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error)
 
+	DeleteDBInstanceWithContext(aws.Context, *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error)
+
 	DeleteDBParameterGroup(*rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error)
 
+	DeleteDBParameterGroupWithContext(aws.Context, *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error)
+
 	DeleteDBSecurityGroup(*rds.DeleteDBSecurityGroupInput) (*rds.DeleteDBSecurityGroupOutput, error)
 
+	DeleteDBSecurityGroupWithContext(aws.Context, *rds.DeleteDBSecurityGroupInput) (*rds.DeleteDBSecurityGroupOutput, error)
+
 	DeleteDBSnapshot(*rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error)
 
+	DeleteDBSnapshotWithContext(aws.Context, *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error)
+
 	DeleteDBSubnetGroup(*rds.DeleteDBSubnetGroupInput) (*rds.DeleteDBSubnetGroupOutput, error)
 
+	DeleteDBSubnetGroupWithContext(aws.Context, *rds.DeleteDBSubnetGroupInput) (*rds.DeleteDBSubnetGroupOutput, error)
+
 	DeleteEventSubscription(*rds.DeleteEventSubscriptionInput) (*rds.DeleteEventSubscriptionOutput, error)
 
+	DeleteEventSubscriptionWithContext(aws.Context, *rds.DeleteEventSubscriptionInput) (*rds.DeleteEventSubscriptionOutput, error)
+
 	DeleteOptionGroup(*rds.DeleteOptionGroupInput) (*rds.DeleteOptionGroupOutput, error)
 
+	DeleteOptionGroupWithContext(aws.Context, *rds.DeleteOptionGroupInput) (*rds.DeleteOptionGroupOutput, error)
+
 	DescribeAccountAttributes(*rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error)
 
+	DescribeAccountAttributesWithContext(aws.Context, *rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error)
+
 	DescribeCertificates(*rds.DescribeCertificatesInput) (*rds.DescribeCertificatesOutput, error)
 
+	DescribeCertificatesWithContext(aws.Context, *rds.DescribeCertificatesInput) (*rds.DescribeCertificatesOutput, error)
+
 	DescribeDBEngineVersions(*rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error)
 
+	DescribeDBEngineVersionsWithContext(aws.Context, *rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error)
+
 	DescribeDBInstances(*rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error)
 
+	DescribeDBInstancesWithContext(aws.Context, *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error)
+
 	DescribeDBLogFiles(*rds.DescribeDBLogFilesInput) (*rds.DescribeDBLogFilesOutput, error)
 
+	DescribeDBLogFilesWithContext(aws.Context, *rds.DescribeDBLogFilesInput) (*rds.DescribeDBLogFilesOutput, error)
+
 	DescribeDBParameterGroups(*rds.DescribeDBParameterGroupsInput) (*rds.DescribeDBParameterGroupsOutput, error)
 
+	DescribeDBParameterGroupsWithContext(aws.Context, *rds.DescribeDBParameterGroupsInput) (*rds.DescribeDBParameterGroupsOutput, error)
+
 	DescribeDBParameters(*rds.DescribeDBParametersInput) (*rds.DescribeDBParametersOutput, error)
 
+	DescribeDBParametersWithContext(aws.Context, *rds.DescribeDBParametersInput) (*rds.DescribeDBParametersOutput, error)
+
 	DescribeDBSecurityGroups(*rds.DescribeDBSecurityGroupsInput) (*rds.DescribeDBSecurityGroupsOutput, error)
 
+	DescribeDBSecurityGroupsWithContext(aws.Context, *rds.DescribeDBSecurityGroupsInput) (*rds.DescribeDBSecurityGroupsOutput, error)
+
 	DescribeDBSnapshots(*rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error)
 
+	DescribeDBSnapshotsWithContext(aws.Context, *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error)
+
 	DescribeDBSubnetGroups(*rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error)
 
+	DescribeDBSubnetGroupsWithContext(aws.Context, *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error)
+
 	DescribeEngineDefaultParameters(*rds.DescribeEngineDefaultParametersInput) (*rds.DescribeEngineDefaultParametersOutput, error)
 
+	DescribeEngineDefaultParametersWithContext(aws.Context, *rds.DescribeEngineDefaultParametersInput) (*rds.DescribeEngineDefaultParametersOutput, error)
+
 	DescribeEventCategories(*rds.DescribeEventCategoriesInput) (*rds.DescribeEventCategoriesOutput, error)
 
+	DescribeEventCategoriesWithContext(aws.Context, *rds.DescribeEventCategoriesInput) (*rds.DescribeEventCategoriesOutput, error)
+
 	DescribeEventSubscriptions(*rds.DescribeEventSubscriptionsInput) (*rds.DescribeEventSubscriptionsOutput, error)
 
+	DescribeEventSubscriptionsWithContext(aws.Context, *rds.DescribeEventSubscriptionsInput) (*rds.DescribeEventSubscriptionsOutput, error)
+
 	DescribeEvents(*rds.DescribeEventsInput) (*rds.DescribeEventsOutput, error)
 
+	DescribeEventsWithContext(aws.Context, *rds.DescribeEventsInput) (*rds.DescribeEventsOutput, error)
+
 	DescribeOptionGroupOptions(*rds.DescribeOptionGroupOptionsInput) (*rds.DescribeOptionGroupOptionsOutput, error)
 
+	DescribeOptionGroupOptionsWithContext(aws.Context, *rds.DescribeOptionGroupOptionsInput) (*rds.DescribeOptionGroupOptionsOutput, error)
+
 	DescribeOptionGroups(*rds.DescribeOptionGroupsInput) (*rds.DescribeOptionGroupsOutput, error)
 
+	DescribeOptionGroupsWithContext(aws.Context, *rds.DescribeOptionGroupsInput) (*rds.DescribeOptionGroupsOutput, error)
+
 	DescribeOrderableDBInstanceOptions(*rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error)
 
+	DescribeOrderableDBInstanceOptionsWithContext(aws.Context, *rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error)
+
 	DescribePendingMaintenanceActions(*rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error)
 
+	DescribePendingMaintenanceActionsWithContext(aws.Context, *rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error)
+
 	DescribeReservedDBInstances(*rds.DescribeReservedDBInstancesInput) (*rds.DescribeReservedDBInstancesOutput, error)
 
+	DescribeReservedDBInstancesWithContext(aws.Context, *rds.DescribeReservedDBInstancesInput) (*rds.DescribeReservedDBInstancesOutput, error)
+
 	DescribeReservedDBInstancesOfferings(*rds.DescribeReservedDBInstancesOfferingsInput) (*rds.DescribeReservedDBInstancesOfferingsOutput, error)
 
+	DescribeReservedDBInstancesOfferingsWithContext(aws.Context, *rds.DescribeReservedDBInstancesOfferingsInput) (*rds.DescribeReservedDBInstancesOfferingsOutput, error)
+
 	DownloadDBLogFilePortion(*rds.DownloadDBLogFilePortionInput) (*rds.DownloadDBLogFilePortionOutput, error)
 
+	DownloadDBLogFilePortionWithContext(aws.Context, *rds.DownloadDBLogFilePortionInput) (*rds.DownloadDBLogFilePortionOutput, error)
+
 	ListTagsForResource(*rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error)
 
+	ListTagsForResourceWithContext(aws.Context, *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error)
+
 	ModifyDBInstance(*rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error)
 
+	ModifyDBInstanceWithContext(aws.Context, *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error)
+
 	ModifyDBParameterGroup(*rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
 
+	ModifyDBParameterGroupWithContext(aws.Context, *rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
+
 	ModifyDBSubnetGroup(*rds.ModifyDBSubnetGroupInput) (*rds.ModifyDBSubnetGroupOutput, error)
 
+	ModifyDBSubnetGroupWithContext(aws.Context, *rds.ModifyDBSubnetGroupInput) (*rds.ModifyDBSubnetGroupOutput, error)
+
 	ModifyEventSubscription(*rds.ModifyEventSubscriptionInput) (*rds.ModifyEventSubscriptionOutput, error)
 
+	ModifyEventSubscriptionWithContext(aws.Context, *rds.ModifyEventSubscriptionInput) (*rds.ModifyEventSubscriptionOutput, error)
+
 	ModifyOptionGroup(*rds.ModifyOptionGroupInput) (*rds.ModifyOptionGroupOutput, error)
 
+	ModifyOptionGroupWithContext(aws.Context, *rds.ModifyOptionGroupInput) (*rds.ModifyOptionGroupOutput, error)
+
 	PromoteReadReplica(*rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error)
 
+	PromoteReadReplicaWithContext(aws.Context, *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error)
+
 	PurchaseReservedDBInstancesOffering(*rds.PurchaseReservedDBInstancesOfferingInput) (*rds.PurchaseReservedDBInstancesOfferingOutput, error)
 
+	PurchaseReservedDBInstancesOfferingWithContext(aws.Context, *rds.PurchaseReservedDBInstancesOfferingInput) (*rds.PurchaseReservedDBInstancesOfferingOutput, error)
+
 	RebootDBInstance(*rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error)
 
+	RebootDBInstanceWithContext(aws.Context, *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error)
+
 	RemoveSourceIdentifierFromSubscription(*rds.RemoveSourceIdentifierFromSubscriptionInput) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error)
 
+	RemoveSourceIdentifierFromSubscriptionWithContext(aws.Context, *rds.RemoveSourceIdentifierFromSubscriptionInput) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error)
+
 	RemoveTagsFromResource(*rds.RemoveTagsFromResourceInput) (*rds.RemoveTagsFromResourceOutput, error)
 
+	RemoveTagsFromResourceWithContext(aws.Context, *rds.RemoveTagsFromResourceInput) (*rds.RemoveTagsFromResourceOutput, error)
+
 	ResetDBParameterGroup(*rds.ResetDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
 
+	ResetDBParameterGroupWithContext(aws.Context, *rds.ResetDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error)
+
 	RestoreDBInstanceFromDBSnapshot(*rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error)
 
+	RestoreDBInstanceFromDBSnapshotWithContext(aws.Context, *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error)
+
 	RestoreDBInstanceToPointInTime(*rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error)
 
+	RestoreDBInstanceToPointInTimeWithContext(aws.Context, *rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error)
+
 	RevokeDBSecurityGroupIngress(*rds.RevokeDBSecurityGroupIngressInput) (*rds.RevokeDBSecurityGroupIngressOutput, error)
+
+	RevokeDBSecurityGroupIngressWithContext(aws.Context, *rds.RevokeDBSecurityGroupIngressInput) (*rds.RevokeDBSecurityGroupIngressOutput, error)
+
+	WaitUntilDBInstanceAvailable(*rds.DescribeDBInstancesInput) error
+
+	WaitUntilDBInstanceDeleted(*rds.DescribeDBInstancesInput) error
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAssociateVPCWithHostedZone = "AssociateVPCWithHostedZone"
@@ -43,6 +44,16 @@ func (c *Route53) AssociateVPCWithHostedZone(input *AssociateVPCWithHostedZoneIn
 	return out, err
 }
 
+// AssociateVPCWithHostedZoneWithContext is the same as AssociateVPCWithHostedZone with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) AssociateVPCWithHostedZoneWithContext(ctx aws.Context, input *AssociateVPCWithHostedZoneInput) (*AssociateVPCWithHostedZoneOutput, error) {
+	req, out := c.AssociateVPCWithHostedZoneRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opChangeResourceRecordSets = "ChangeResourceRecordSets"
 
 // ChangeResourceRecordSetsRequest generates a request for the ChangeResourceRecordSets operation.
@@ -97,6 +108,16 @@ func (c *Route53) ChangeResourceRecordSets(input *ChangeResourceRecordSetsInput)
 	return out, err
 }
 
+// ChangeResourceRecordSetsWithContext is the same as ChangeResourceRecordSets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ChangeResourceRecordSetsWithContext(ctx aws.Context, input *ChangeResourceRecordSetsInput) (*ChangeResourceRecordSetsOutput, error) {
+	req, out := c.ChangeResourceRecordSetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opChangeTagsForResource = "ChangeTagsForResource"
 
 // ChangeTagsForResourceRequest generates a request for the ChangeTagsForResource operation.
@@ -123,6 +144,16 @@ func (c *Route53) ChangeTagsForResource(input *ChangeTagsForResourceInput) (*Cha
 	return out, err
 }
 
+// ChangeTagsForResourceWithContext is the same as ChangeTagsForResource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ChangeTagsForResourceWithContext(ctx aws.Context, input *ChangeTagsForResourceInput) (*ChangeTagsForResourceOutput, error) {
+	req, out := c.ChangeTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateHealthCheck = "CreateHealthCheck"
 
 // CreateHealthCheckRequest generates a request for the CreateHealthCheck operation.
@@ -155,6 +186,16 @@ func (c *Route53) CreateHealthCheck(input *CreateHealthCheckInput) (*CreateHealt
 	return out, err
 }
 
+// CreateHealthCheckWithContext is the same as CreateHealthCheck with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) CreateHealthCheckWithContext(ctx aws.Context, input *CreateHealthCheckInput) (*CreateHealthCheckOutput, error) {
+	req, out := c.CreateHealthCheckRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateHostedZone = "CreateHostedZone"
 
 // CreateHostedZoneRequest generates a request for the CreateHostedZone operation.
@@ -202,6 +243,16 @@ func (c *Route53) CreateHostedZone(input *CreateHostedZoneInput) (*CreateHostedZ
 	return out, err
 }
 
+// CreateHostedZoneWithContext is the same as CreateHostedZone with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) CreateHostedZoneWithContext(ctx aws.Context, input *CreateHostedZoneInput) (*CreateHostedZoneOutput, error) {
+	req, out := c.CreateHostedZoneRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateReusableDelegationSet = "CreateReusableDelegationSet"
 
 // CreateReusableDelegationSetRequest generates a request for the CreateReusableDelegationSet operation.
@@ -237,6 +288,16 @@ func (c *Route53) CreateReusableDelegationSet(input *CreateReusableDelegationSet
 	return out, err
 }
 
+// CreateReusableDelegationSetWithContext is the same as CreateReusableDelegationSet with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) CreateReusableDelegationSetWithContext(ctx aws.Context, input *CreateReusableDelegationSetInput) (*CreateReusableDelegationSetOutput, error) {
+	req, out := c.CreateReusableDelegationSetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHealthCheck = "DeleteHealthCheck"
 
 // DeleteHealthCheckRequest generates a request for the DeleteHealthCheck operation.
@@ -273,6 +334,16 @@ func (c *Route53) DeleteHealthCheck(input *DeleteHealthCheckInput) (*DeleteHealt
 	return out, err
 }
 
+// DeleteHealthCheckWithContext is the same as DeleteHealthCheck with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) DeleteHealthCheckWithContext(ctx aws.Context, input *DeleteHealthCheckInput) (*DeleteHealthCheckOutput, error) {
+	req, out := c.DeleteHealthCheckRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteHostedZone = "DeleteHostedZone"
 
 // DeleteHostedZoneRequest generates a request for the DeleteHostedZone operation.
@@ -313,6 +384,16 @@ func (c *Route53) DeleteHostedZone(input *DeleteHostedZoneInput) (*DeleteHostedZ
 	return out, err
 }
 
+// DeleteHostedZoneWithContext is the same as DeleteHostedZone with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) DeleteHostedZoneWithContext(ctx aws.Context, input *DeleteHostedZoneInput) (*DeleteHostedZoneOutput, error) {
+	req, out := c.DeleteHostedZoneRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteReusableDelegationSet = "DeleteReusableDelegationSet"
 
 // DeleteReusableDelegationSetRequest generates a request for the DeleteReusableDelegationSet operation.
@@ -348,6 +429,16 @@ func (c *Route53) DeleteReusableDelegationSet(input *DeleteReusableDelegationSet
 	return out, err
 }
 
+// DeleteReusableDelegationSetWithContext is the same as DeleteReusableDelegationSet with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) DeleteReusableDelegationSetWithContext(ctx aws.Context, input *DeleteReusableDelegationSetInput) (*DeleteReusableDelegationSetOutput, error) {
+	req, out := c.DeleteReusableDelegationSetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisassociateVPCFromHostedZone = "DisassociateVPCFromHostedZone"
 
 // DisassociateVPCFromHostedZoneRequest generates a request for the DisassociateVPCFromHostedZone operation.
@@ -382,6 +473,16 @@ func (c *Route53) DisassociateVPCFromHostedZone(input *DisassociateVPCFromHosted
 	return out, err
 }
 
+// DisassociateVPCFromHostedZoneWithContext is the same as DisassociateVPCFromHostedZone with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) DisassociateVPCFromHostedZoneWithContext(ctx aws.Context, input *DisassociateVPCFromHostedZoneInput) (*DisassociateVPCFromHostedZoneOutput, error) {
+	req, out := c.DisassociateVPCFromHostedZoneRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetChange = "GetChange"
 
 // GetChangeRequest generates a request for the GetChange operation.
@@ -417,6 +518,16 @@ func (c *Route53) GetChange(input *GetChangeInput) (*GetChangeOutput, error) {
 	return out, err
 }
 
+// GetChangeWithContext is the same as GetChange with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetChangeWithContext(ctx aws.Context, input *GetChangeInput) (*GetChangeOutput, error) {
+	req, out := c.GetChangeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetCheckerIPRanges = "GetCheckerIpRanges"
 
 // GetCheckerIPRangesRequest generates a request for the GetCheckerIPRanges operation.
@@ -447,6 +558,16 @@ func (c *Route53) GetCheckerIPRanges(input *GetCheckerIPRangesInput) (*GetChecke
 	return out, err
 }
 
+// GetCheckerIPRangesWithContext is the same as GetCheckerIPRanges with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetCheckerIPRangesWithContext(ctx aws.Context, input *GetCheckerIPRangesInput) (*GetCheckerIPRangesOutput, error) {
+	req, out := c.GetCheckerIPRangesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetGeoLocation = "GetGeoLocation"
 
 // GetGeoLocationRequest generates a request for the GetGeoLocation operation.
@@ -476,6 +597,16 @@ func (c *Route53) GetGeoLocation(input *GetGeoLocationInput) (*GetGeoLocationOut
 	return out, err
 }
 
+// GetGeoLocationWithContext is the same as GetGeoLocation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetGeoLocationWithContext(ctx aws.Context, input *GetGeoLocationInput) (*GetGeoLocationOutput, error) {
+	req, out := c.GetGeoLocationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheck = "GetHealthCheck"
 
 // GetHealthCheckRequest generates a request for the GetHealthCheck operation.
@@ -504,6 +635,16 @@ func (c *Route53) GetHealthCheck(input *GetHealthCheckInput) (*GetHealthCheckOut
 	return out, err
 }
 
+// GetHealthCheckWithContext is the same as GetHealthCheck with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetHealthCheckWithContext(ctx aws.Context, input *GetHealthCheckInput) (*GetHealthCheckOutput, error) {
+	req, out := c.GetHealthCheckRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheckCount = "GetHealthCheckCount"
 
 // GetHealthCheckCountRequest generates a request for the GetHealthCheckCount operation.
@@ -532,6 +673,16 @@ func (c *Route53) GetHealthCheckCount(input *GetHealthCheckCountInput) (*GetHeal
 	return out, err
 }
 
+// GetHealthCheckCountWithContext is the same as GetHealthCheckCount with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetHealthCheckCountWithContext(ctx aws.Context, input *GetHealthCheckCountInput) (*GetHealthCheckCountOutput, error) {
+	req, out := c.GetHealthCheckCountRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheckLastFailureReason = "GetHealthCheckLastFailureReason"
 
 // GetHealthCheckLastFailureReasonRequest generates a request for the GetHealthCheckLastFailureReason operation.
@@ -562,6 +713,16 @@ func (c *Route53) GetHealthCheckLastFailureReason(input *GetHealthCheckLastFailu
 	return out, err
 }
 
+// GetHealthCheckLastFailureReasonWithContext is the same as GetHealthCheckLastFailureReason with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetHealthCheckLastFailureReasonWithContext(ctx aws.Context, input *GetHealthCheckLastFailureReasonInput) (*GetHealthCheckLastFailureReasonOutput, error) {
+	req, out := c.GetHealthCheckLastFailureReasonRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHealthCheckStatus = "GetHealthCheckStatus"
 
 // GetHealthCheckStatusRequest generates a request for the GetHealthCheckStatus operation.
@@ -591,6 +752,16 @@ func (c *Route53) GetHealthCheckStatus(input *GetHealthCheckStatusInput) (*GetHe
 	return out, err
 }
 
+// GetHealthCheckStatusWithContext is the same as GetHealthCheckStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetHealthCheckStatusWithContext(ctx aws.Context, input *GetHealthCheckStatusInput) (*GetHealthCheckStatusOutput, error) {
+	req, out := c.GetHealthCheckStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHostedZone = "GetHostedZone"
 
 // GetHostedZoneRequest generates a request for the GetHostedZone operation.
@@ -621,6 +792,16 @@ func (c *Route53) GetHostedZone(input *GetHostedZoneInput) (*GetHostedZoneOutput
 	return out, err
 }
 
+// GetHostedZoneWithContext is the same as GetHostedZone with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetHostedZoneWithContext(ctx aws.Context, input *GetHostedZoneInput) (*GetHostedZoneOutput, error) {
+	req, out := c.GetHostedZoneRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetHostedZoneCount = "GetHostedZoneCount"
 
 // GetHostedZoneCountRequest generates a request for the GetHostedZoneCount operation.
@@ -649,6 +830,16 @@ func (c *Route53) GetHostedZoneCount(input *GetHostedZoneCountInput) (*GetHosted
 	return out, err
 }
 
+// GetHostedZoneCountWithContext is the same as GetHostedZoneCount with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetHostedZoneCountWithContext(ctx aws.Context, input *GetHostedZoneCountInput) (*GetHostedZoneCountOutput, error) {
+	req, out := c.GetHostedZoneCountRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetReusableDelegationSet = "GetReusableDelegationSet"
 
 // GetReusableDelegationSetRequest generates a request for the GetReusableDelegationSet operation.
@@ -677,6 +868,16 @@ func (c *Route53) GetReusableDelegationSet(input *GetReusableDelegationSetInput)
 	return out, err
 }
 
+// GetReusableDelegationSetWithContext is the same as GetReusableDelegationSet with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) GetReusableDelegationSetWithContext(ctx aws.Context, input *GetReusableDelegationSetInput) (*GetReusableDelegationSetOutput, error) {
+	req, out := c.GetReusableDelegationSetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListGeoLocations = "ListGeoLocations"
 
 // ListGeoLocationsRequest generates a request for the ListGeoLocations operation.
@@ -716,6 +917,16 @@ func (c *Route53) ListGeoLocations(input *ListGeoLocationsInput) (*ListGeoLocati
 	return out, err
 }
 
+// ListGeoLocationsWithContext is the same as ListGeoLocations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListGeoLocationsWithContext(ctx aws.Context, input *ListGeoLocationsInput) (*ListGeoLocationsOutput, error) {
+	req, out := c.ListGeoLocationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListHealthChecks = "ListHealthChecks"
 
 // ListHealthChecksRequest generates a request for the ListHealthChecks operation.
@@ -757,6 +968,16 @@ func (c *Route53) ListHealthChecks(input *ListHealthChecksInput) (*ListHealthChe
 	return out, err
 }
 
+// ListHealthChecksWithContext is the same as ListHealthChecks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListHealthChecksWithContext(ctx aws.Context, input *ListHealthChecksInput) (*ListHealthChecksOutput, error) {
+	req, out := c.ListHealthChecksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Route53) ListHealthChecksPages(input *ListHealthChecksInput, fn func(p *ListHealthChecksOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListHealthChecksRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -805,6 +1026,16 @@ func (c *Route53) ListHostedZones(input *ListHostedZonesInput) (*ListHostedZones
 	return out, err
 }
 
+// ListHostedZonesWithContext is the same as ListHostedZones with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListHostedZonesWithContext(ctx aws.Context, input *ListHostedZonesInput) (*ListHostedZonesOutput, error) {
+	req, out := c.ListHostedZonesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Route53) ListHostedZonesPages(input *ListHostedZonesInput, fn func(p *ListHostedZonesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListHostedZonesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -849,6 +1080,16 @@ func (c *Route53) ListHostedZonesByName(input *ListHostedZonesByNameInput) (*Lis
 	return out, err
 }
 
+// ListHostedZonesByNameWithContext is the same as ListHostedZonesByName with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListHostedZonesByNameWithContext(ctx aws.Context, input *ListHostedZonesByNameInput) (*ListHostedZonesByNameOutput, error) {
+	req, out := c.ListHostedZonesByNameRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListResourceRecordSets = "ListResourceRecordSets"
 
 // ListResourceRecordSetsRequest generates a request for the ListResourceRecordSets operation.
@@ -919,6 +1160,16 @@ func (c *Route53) ListResourceRecordSets(input *ListResourceRecordSetsInput) (*L
 	return out, err
 }
 
+// ListResourceRecordSetsWithContext is the same as ListResourceRecordSets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListResourceRecordSetsWithContext(ctx aws.Context, input *ListResourceRecordSetsInput) (*ListResourceRecordSetsOutput, error) {
+	req, out := c.ListResourceRecordSetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Route53) ListResourceRecordSetsPages(input *ListResourceRecordSetsInput, fn func(p *ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListResourceRecordSetsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -962,6 +1213,16 @@ func (c *Route53) ListReusableDelegationSets(input *ListReusableDelegationSetsIn
 	return out, err
 }
 
+// ListReusableDelegationSetsWithContext is the same as ListReusableDelegationSets with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListReusableDelegationSetsWithContext(ctx aws.Context, input *ListReusableDelegationSetsInput) (*ListReusableDelegationSetsOutput, error) {
+	req, out := c.ListReusableDelegationSetsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListTagsForResource = "ListTagsForResource"
 
 // ListTagsForResourceRequest generates a request for the ListTagsForResource operation.
@@ -988,6 +1249,16 @@ func (c *Route53) ListTagsForResource(input *ListTagsForResourceInput) (*ListTag
 	return out, err
 }
 
+// ListTagsForResourceWithContext is the same as ListTagsForResource with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListTagsForResourceWithContext(ctx aws.Context, input *ListTagsForResourceInput) (*ListTagsForResourceOutput, error) {
+	req, out := c.ListTagsForResourceRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListTagsForResources = "ListTagsForResources"
 
 // ListTagsForResourcesRequest generates a request for the ListTagsForResources operation.
@@ -1014,6 +1285,16 @@ func (c *Route53) ListTagsForResources(input *ListTagsForResourcesInput) (*ListT
 	return out, err
 }
 
+// ListTagsForResourcesWithContext is the same as ListTagsForResources with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) ListTagsForResourcesWithContext(ctx aws.Context, input *ListTagsForResourcesInput) (*ListTagsForResourcesOutput, error) {
+	req, out := c.ListTagsForResourcesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateHealthCheck = "UpdateHealthCheck"
 
 // UpdateHealthCheckRequest generates a request for the UpdateHealthCheck operation.
@@ -1046,6 +1327,16 @@ func (c *Route53) UpdateHealthCheck(input *UpdateHealthCheckInput) (*UpdateHealt
 	return out, err
 }
 
+// UpdateHealthCheckWithContext is the same as UpdateHealthCheck with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) UpdateHealthCheckWithContext(ctx aws.Context, input *UpdateHealthCheckInput) (*UpdateHealthCheckOutput, error) {
+	req, out := c.UpdateHealthCheckRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateHostedZoneComment = "UpdateHostedZoneComment"
 
 // UpdateHostedZoneCommentRequest generates a request for the UpdateHostedZoneComment operation.
@@ -1077,6 +1368,16 @@ func (c *Route53) UpdateHostedZoneComment(input *UpdateHostedZoneCommentInput) (
 	return out, err
 }
 
+// UpdateHostedZoneCommentWithContext is the same as UpdateHostedZoneComment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53) UpdateHostedZoneCommentWithContext(ctx aws.Context, input *UpdateHostedZoneCommentInput) (*UpdateHostedZoneCommentOutput, error) {
+	req, out := c.UpdateHostedZoneCommentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Alias resource record sets only: Information about the domain to which you
 // are redirecting traffic.
 //
@@ -1094,7 +1395,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	DNSName *string `type:"string" required:"true"`
+	DNSName *string `type:"string" max:"1024" required:"true"`
 
 	// Alias resource record sets only: A boolean value that indicates whether this
 	// Resource Record Set should respect the health status of any health checks
@@ -1115,7 +1416,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string" required:"true"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32" required:"true"`
 
 	metadataAliasTarget `json:"-" xml:"-"`
 }
@@ -1124,6 +1425,16 @@ type metadataAliasTarget struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AliasTarget) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AliasTarget) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to associate a
 // VPC with an hosted zone.
 type AssociateVPCWithHostedZoneInput struct {
@@ -1134,7 +1445,7 @@ type AssociateVPCWithHostedZoneInput struct {
 	//
 	// Note that you cannot associate a VPC with a hosted zone that doesn't have
 	// an existing VPC association.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1146,6 +1457,16 @@ type metadataAssociateVPCWithHostedZoneInput struct {
 	SDKShapeTraits bool `locationName:"AssociateVPCWithHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s AssociateVPCWithHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateVPCWithHostedZoneInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the response information for the request.
 type AssociateVPCWithHostedZoneOutput struct {
 	// A complex type that contains the ID, the status, and the date and time of
@@ -1159,13 +1480,25 @@ type metadataAssociateVPCWithHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociateVPCWithHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociateVPCWithHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the information for each change in a change
 // batch request.
 type Change struct {
 	// The action to perform.
 	//
 	// Valid values: CREATE | DELETE | UPSERT
-	Action *string `type:"string" required:"true"`
+	//
+	// See the ChangeAction* constants for valid values.
+	Action *string `type:"string" enum:"CREATE,DELETE,UPSERT" required:"true"`
 
 	// Information about the resource record set to create or delete.
 	ResourceRecordSet *ResourceRecordSet `type:"structure" required:"true"`
@@ -1177,15 +1510,25 @@ type metadataChange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Change) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Change) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains an optional comment and the changes that you
 // want to make with a change batch request.
 type ChangeBatch struct {
 	// A complex type that contains one Change element for each resource record
 	// set that you want to create or delete.
-	Changes []*Change `locationNameList:"Change" type:"list" required:"true"`
+	Changes []*Change `locationNameList:"Change" type:"list" min:"1" required:"true"`
 
 	// Optional: Any comments you want to include about a change batch request.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	metadataChangeBatch `json:"-" xml:"-"`
 }
@@ -1194,6 +1537,16 @@ type metadataChangeBatch struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeBatch) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeBatch) GoString() string {
+	return s.String()
+}
+
 // A complex type that describes change information about changes made to your
 // hosted zone.
 //
@@ -1205,17 +1558,19 @@ type ChangeInfo struct {
 	//
 	// This element contains an ID that you use when performing a GetChange action
 	// to get detailed information about the change.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the request. Use this ID to track when the change has completed
 	// across all Amazon Route 53 DNS servers.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The current state of the request. PENDING indicates that this request has
 	// not yet been applied to all Amazon Route 53 DNS servers.
 	//
 	// Valid Values: PENDING | INSYNC
-	Status *string `type:"string" required:"true"`
+	//
+	// See the ChangeStatus* constants for valid values.
+	Status *string `type:"string" enum:"PENDING,INSYNC" required:"true"`
 
 	// The date and time the change was submitted, in the format YYYY-MM-DDThh:mm:ssZ,
 	// as specified in the ISO 8601 standard (for example, 2009-11-19T19:37:58Z).
@@ -1230,6 +1585,16 @@ type metadataChangeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeInfo) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains a change batch.
 type ChangeResourceRecordSetsInput struct {
 	// A complex type that contains an optional comment and the Changes element.
@@ -1237,7 +1602,7 @@ type ChangeResourceRecordSetsInput struct {
 
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to change.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataChangeResourceRecordSetsInput `json:"-" xml:"-"`
 }
@@ -1246,6 +1611,16 @@ type metadataChangeResourceRecordSetsInput struct {
 	SDKShapeTraits bool `locationName:"ChangeResourceRecordSetsRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s ChangeResourceRecordSetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeResourceRecordSetsInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the response for the request.
 type ChangeResourceRecordSetsOutput struct {
 	// A complex type that contains information about changes made to your hosted
@@ -1262,25 +1637,37 @@ type metadataChangeResourceRecordSetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeResourceRecordSetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeResourceRecordSetsOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about a request to add, change, or
 // delete the tags that are associated with a resource.
 type ChangeTagsForResourceInput struct {
 	// A complex type that contains a list of Tag elements. Each Tag element identifies
 	// a tag that you want to add or update for the specified resource.
-	AddTags []*Tag `locationNameList:"Tag" type:"list"`
+	AddTags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	// A list of Tag keys that you want to remove from the specified resource.
-	RemoveTagKeys []*string `locationNameList:"Key" type:"list"`
+	RemoveTagKeys []*string `locationNameList:"Key" type:"list" min:"1" max:"10"`
 
 	// The ID of the resource for which you want to add, change, or delete tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	//
+	// See the TagResourceType* constants for valid values.
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataChangeTagsForResourceInput `json:"-" xml:"-"`
 }
@@ -1289,6 +1676,16 @@ type metadataChangeTagsForResourceInput struct {
 	SDKShapeTraits bool `locationName:"ChangeTagsForResourceRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s ChangeTagsForResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
 // Empty response for the request.
 type ChangeTagsForResourceOutput struct {
 	metadataChangeTagsForResourceOutput `json:"-" xml:"-"`
@@ -1298,6 +1695,16 @@ type metadataChangeTagsForResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeTagsForResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeTagsForResourceOutput) GoString() string {
+	return s.String()
+}
+
 // >A complex type that contains information about the request to create a health
 // check.
 type CreateHealthCheckInput struct {
@@ -1309,7 +1716,7 @@ type CreateHealthCheckInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -1321,13 +1728,23 @@ type metadataCreateHealthCheckInput struct {
 	SDKShapeTraits bool `locationName:"CreateHealthCheckRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s CreateHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHealthCheckInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the response information for the new health check.
 type CreateHealthCheckOutput struct {
 	// A complex type that contains identifying information about the health check.
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
 
 	// The unique URL representing the new health check.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateHealthCheckOutput `json:"-" xml:"-"`
 }
@@ -1336,6 +1753,16 @@ type metadataCreateHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHealthCheckOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to create a hosted
 // zone.
 type CreateHostedZoneInput struct {
@@ -1347,11 +1774,11 @@ type CreateHostedZoneInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The delegation set id of the reusable delgation set whose NS records you
 	// want to assign to the new hosted zone.
-	DelegationSetID *string `locationName:"DelegationSetId" type:"string"`
+	DelegationSetID *string `locationName:"DelegationSetId" type:"string" max:"32"`
 
 	// A complex type that contains an optional comment about your hosted zone.
 	HostedZoneConfig *HostedZoneConfig `type:"structure"`
@@ -1364,7 +1791,7 @@ type CreateHostedZoneInput struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with. By providing
 	// this parameter, your newly created hosted cannot be resolved anywhere other
@@ -1378,6 +1805,16 @@ type metadataCreateHostedZoneInput struct {
 	SDKShapeTraits bool `locationName:"CreateHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s CreateHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHostedZoneInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the response information for the new hosted zone.
 type CreateHostedZoneOutput struct {
 	// A complex type that contains information about the request to create a hosted
@@ -1392,7 +1829,7 @@ type CreateHostedZoneOutput struct {
 	HostedZone *HostedZone `type:"structure" required:"true"`
 
 	// The unique URL representing the new hosted zone.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	VPC *VPC `type:"structure"`
 
@@ -1403,6 +1840,16 @@ type metadataCreateHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
 type CreateReusableDelegationSetInput struct {
 	// A unique string that identifies the request and that allows failed CreateReusableDelegationSet
 	// requests to be retried without the risk of executing the operation twice.
@@ -1412,11 +1859,11 @@ type CreateReusableDelegationSetInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The ID of the hosted zone whose delegation set you want to mark as reusable.
 	// It is an optional parameter.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	metadataCreateReusableDelegationSetInput `json:"-" xml:"-"`
 }
@@ -1425,12 +1872,22 @@ type metadataCreateReusableDelegationSetInput struct {
 	SDKShapeTraits bool `locationName:"CreateReusableDelegationSetRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s CreateReusableDelegationSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateReusableDelegationSetInput) GoString() string {
+	return s.String()
+}
+
 type CreateReusableDelegationSetOutput struct {
 	// A complex type that contains name server information.
 	DelegationSet *DelegationSet `type:"structure" required:"true"`
 
 	// The unique URL representing the new reusbale delegation set.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateReusableDelegationSetOutput `json:"-" xml:"-"`
 }
@@ -1439,16 +1896,26 @@ type metadataCreateReusableDelegationSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateReusableDelegationSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateReusableDelegationSetOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains name server information.
 type DelegationSet struct {
-	CallerReference *string `type:"string"`
+	CallerReference *string `type:"string" min:"1" max:"128"`
 
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" max:"32"`
 
 	// A complex type that contains the authoritative name servers for the hosted
 	// zone. Use the method provided by your domain registrar to add an NS record
 	// to your domain for each NameServer that is assigned to your hosted zone.
-	NameServers []*string `locationNameList:"NameServer" type:"list" required:"true"`
+	NameServers []*string `locationNameList:"NameServer" type:"list" min:"1" required:"true"`
 
 	metadataDelegationSet `json:"-" xml:"-"`
 }
@@ -1457,10 +1924,20 @@ type metadataDelegationSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DelegationSet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DelegationSet) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the request information for delete health check.
 type DeleteHealthCheckInput struct {
 	// The ID of the health check to delete.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataDeleteHealthCheckInput `json:"-" xml:"-"`
 }
@@ -1469,6 +1946,16 @@ type metadataDeleteHealthCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHealthCheckInput) GoString() string {
+	return s.String()
+}
+
 // Empty response for the request.
 type DeleteHealthCheckOutput struct {
 	metadataDeleteHealthCheckOutput `json:"-" xml:"-"`
@@ -1478,11 +1965,21 @@ type metadataDeleteHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHealthCheckOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the hosted zone that you want
 // to delete.
 type DeleteHostedZoneInput struct {
 	// The ID of the hosted zone you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteHostedZoneInput `json:"-" xml:"-"`
 }
@@ -1491,6 +1988,16 @@ type metadataDeleteHostedZoneInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHostedZoneInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the response information for the request.
 type DeleteHostedZoneOutput struct {
 	// A complex type that contains the ID, the status, and the date and time of
@@ -1504,10 +2011,20 @@ type metadataDeleteHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the information for the delete request.
 type DeleteReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteReusableDelegationSetInput `json:"-" xml:"-"`
 }
@@ -1516,6 +2033,16 @@ type metadataDeleteReusableDelegationSetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteReusableDelegationSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteReusableDelegationSetInput) GoString() string {
+	return s.String()
+}
+
 // Empty response for the request.
 type DeleteReusableDelegationSetOutput struct {
 	metadataDeleteReusableDelegationSetOutput `json:"-" xml:"-"`
@@ -1525,6 +2052,16 @@ type metadataDeleteReusableDelegationSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteReusableDelegationSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteReusableDelegationSetOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to disassociate
 // a VPC from an hosted zone.
 type DisassociateVPCFromHostedZoneInput struct {
@@ -1534,7 +2071,7 @@ type DisassociateVPCFromHostedZoneInput struct {
 	// The ID of the hosted zone you want to disassociate your VPC from.
 	//
 	// Note that you cannot disassociate the last VPC from a hosted zone.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be disassociated from.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1546,6 +2083,16 @@ type metadataDisassociateVPCFromHostedZoneInput struct {
 	SDKShapeTraits bool `locationName:"DisassociateVPCFromHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s DisassociateVPCFromHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateVPCFromHostedZoneInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing the response information for the request.
 type DisassociateVPCFromHostedZoneOutput struct {
 	// A complex type that contains the ID, the status, and the date and time of
@@ -1559,6 +2106,16 @@ type metadataDisassociateVPCFromHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisassociateVPCFromHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisassociateVPCFromHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about a geo location.
 type GeoLocation struct {
 	// The code for a continent geo location. Note: only continent locations have
@@ -1568,21 +2125,21 @@ type GeoLocation struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataGeoLocation `json:"-" xml:"-"`
 }
@@ -1591,34 +2148,44 @@ type metadataGeoLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GeoLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GeoLocation) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about a GeoLocation.
 type GeoLocationDetails struct {
 	// The code for a continent geo location. Note: only continent locations have
 	// a continent code.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The name of the continent. This element is only present if ContinentCode
 	// is also present.
-	ContinentName *string `type:"string"`
+	ContinentName *string `type:"string" min:"1" max:"32"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The name of the country. This element is only present if CountryCode is also
 	// present.
-	CountryName *string `type:"string"`
+	CountryName *string `type:"string" min:"1" max:"64"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	// The name of the subdivision. This element is only present if SubdivisionCode
 	// is also present.
-	SubdivisionName *string `type:"string"`
+	SubdivisionName *string `type:"string" min:"1" max:"64"`
 
 	metadataGeoLocationDetails `json:"-" xml:"-"`
 }
@@ -1627,12 +2194,22 @@ type metadataGeoLocationDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GeoLocationDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GeoLocationDetails) GoString() string {
+	return s.String()
+}
+
 // The input for a GetChange request.
 type GetChangeInput struct {
 	// The ID of the change batch request. The value that you specify here is the
 	// value that ChangeResourceRecordSets returned in the Id element when you submitted
 	// the request.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetChangeInput `json:"-" xml:"-"`
 }
@@ -1641,6 +2218,16 @@ type metadataGetChangeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetChangeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetChangeInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the ChangeInfo element.
 type GetChangeOutput struct {
 	// A complex type that contains information about the specified change batch,
@@ -1655,6 +2242,16 @@ type metadataGetChangeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetChangeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetChangeOutput) GoString() string {
+	return s.String()
+}
+
 // Empty request.
 type GetCheckerIPRangesInput struct {
 	metadataGetCheckerIPRangesInput `json:"-" xml:"-"`
@@ -1664,6 +2261,16 @@ type metadataGetCheckerIPRangesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCheckerIPRangesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCheckerIPRangesInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the CheckerIpRanges element.
 type GetCheckerIPRangesOutput struct {
 	// A complex type that contains sorted list of IP ranges in CIDR format for
@@ -1677,6 +2284,16 @@ type metadataGetCheckerIPRangesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetCheckerIPRangesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetCheckerIPRangesOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to get a geo location.
 type GetGeoLocationInput struct {
 	// The code for a continent geo location. Note: only continent locations have
@@ -1686,21 +2303,21 @@ type GetGeoLocationInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string"`
+	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string"`
+	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string"`
+	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataGetGeoLocationInput `json:"-" xml:"-"`
 }
@@ -1709,6 +2326,16 @@ type metadataGetGeoLocationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetGeoLocationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetGeoLocationInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about the specified geo location.
 type GetGeoLocationOutput struct {
 	// A complex type that contains the information about the specified geo location.
@@ -1721,6 +2348,16 @@ type metadataGetGeoLocationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetGeoLocationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetGeoLocationOutput) GoString() string {
+	return s.String()
+}
+
 // To retrieve a count of all your health checks, send a GET request to the
 // 2013-04-01/healthcheckcount resource.
 type GetHealthCheckCountInput struct {
@@ -1731,6 +2368,16 @@ type metadataGetHealthCheckCountInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckCountInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckCountInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the count of health checks associated with the
 // current AWS account.
 type GetHealthCheckCountOutput struct {
@@ -1744,11 +2391,21 @@ type metadataGetHealthCheckCountOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckCountOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckCountOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to get a health
 // check.
 type GetHealthCheckInput struct {
 	// The ID of the health check to retrieve.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckInput `json:"-" xml:"-"`
 }
@@ -1757,12 +2414,22 @@ type metadataGetHealthCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to get the most
 // recent failure reason for a health check.
 type GetHealthCheckLastFailureReasonInput struct {
 	// The ID of the health check for which you want to retrieve the reason for
 	// the most recent failure.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckLastFailureReasonInput `json:"-" xml:"-"`
 }
@@ -1771,6 +2438,16 @@ type metadataGetHealthCheckLastFailureReasonInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckLastFailureReasonInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckLastFailureReasonInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the most recent failure for
 // the specified health check.
 type GetHealthCheckLastFailureReasonOutput struct {
@@ -1785,6 +2462,16 @@ type metadataGetHealthCheckLastFailureReasonOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckLastFailureReasonOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckLastFailureReasonOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about the specified health check.
 type GetHealthCheckOutput struct {
 	// A complex type that contains the information about the specified health check.
@@ -1797,12 +2484,22 @@ type metadataGetHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to get health
 // check status for a health check.
 type GetHealthCheckStatusInput struct {
 	// The ID of the health check for which you want to retrieve the most recent
 	// status.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckStatusInput `json:"-" xml:"-"`
 }
@@ -1811,6 +2508,16 @@ type metadataGetHealthCheckStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckStatusInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the status of the specified
 // health check.
 type GetHealthCheckStatusOutput struct {
@@ -1825,6 +2532,16 @@ type metadataGetHealthCheckStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHealthCheckStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHealthCheckStatusOutput) GoString() string {
+	return s.String()
+}
+
 // To retrieve a count of all your hosted zones, send a GET request to the 2013-04-01/hostedzonecount
 // resource.
 type GetHostedZoneCountInput struct {
@@ -1835,6 +2552,16 @@ type metadataGetHostedZoneCountInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHostedZoneCountInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHostedZoneCountInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the count of hosted zones associated with the
 // current AWS account.
 type GetHostedZoneCountOutput struct {
@@ -1848,11 +2575,21 @@ type metadataGetHostedZoneCountOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHostedZoneCountOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHostedZoneCountOutput) GoString() string {
+	return s.String()
+}
+
 // The input for a GetHostedZone request.
 type GetHostedZoneInput struct {
 	// The ID of the hosted zone for which you want to get a list of the name servers
 	// in the delegation set.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetHostedZoneInput `json:"-" xml:"-"`
 }
@@ -1861,6 +2598,16 @@ type metadataGetHostedZoneInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHostedZoneInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about the specified hosted zone.
 type GetHostedZoneOutput struct {
 	// A complex type that contains information about the name servers for the specified
@@ -1872,7 +2619,7 @@ type GetHostedZoneOutput struct {
 
 	// A complex type that contains information about VPCs associated with the specified
 	// hosted zone.
-	VPCs []*VPC `locationNameList:"VPC" type:"list"`
+	VPCs []*VPC `locationNameList:"VPC" type:"list" min:"1"`
 
 	metadataGetHostedZoneOutput `json:"-" xml:"-"`
 }
@@ -1881,11 +2628,21 @@ type metadataGetHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetHostedZoneOutput) GoString() string {
+	return s.String()
+}
+
 // The input for a GetReusableDelegationSet request.
 type GetReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set for which you want to get a list of
 	// the name server.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetReusableDelegationSetInput `json:"-" xml:"-"`
 }
@@ -1894,6 +2651,16 @@ type metadataGetReusableDelegationSetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetReusableDelegationSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetReusableDelegationSetInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about the specified reusable delegation
 // set.
 type GetReusableDelegationSetOutput struct {
@@ -1908,10 +2675,20 @@ type metadataGetReusableDelegationSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetReusableDelegationSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetReusableDelegationSetOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains identifying information about the health check.
 type HealthCheck struct {
 	// A unique string that identifies the request to create the health check.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains the health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -1919,10 +2696,10 @@ type HealthCheck struct {
 	// The version of the health check. You can optionally pass this value in a
 	// call to UpdateHealthCheck to prevent overwriting another change to the health
 	// check.
-	HealthCheckVersion *int64 `type:"long" required:"true"`
+	HealthCheckVersion *int64 `type:"long" min:"1" required:"true"`
 
 	// The ID of the specified health check.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"64" required:"true"`
 
 	metadataHealthCheck `json:"-" xml:"-"`
 }
@@ -1931,6 +2708,16 @@ type metadataHealthCheck struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HealthCheck) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HealthCheck) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the health check configuration.
 type HealthCheckConfig struct {
 	// The number of consecutive health checks that an endpoint must pass or fail
@@ -1940,38 +2727,40 @@ type HealthCheckConfig struct {
 	// Valid values are integers between 1 and 10. For more information, see "How
 	// Amazon Route 53 Determines Whether an Endpoint Is Healthy" in the Amazon
 	// Route 53 Developer Guide.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// IP Address of the instance being checked.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// Port on which connection will be opened to the instance to health check.
 	// For HTTP and HTTP_STR_MATCH this defaults to 80 if the port is not specified.
 	// For HTTPS and HTTPS_STR_MATCH this defaults to 443 if the port is not specified.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The number of seconds between the time that Route 53 gets a response from
 	// your endpoint and the time that it sends the next health-check request.
 	//
 	// Each Route 53 health checker makes requests at this interval. Valid values
 	// are 10 and 30. The default value is 30.
-	RequestInterval *int64 `type:"integer"`
+	RequestInterval *int64 `type:"integer" min:"10" max:"30"`
 
 	// Path to ping on the instance to check the health. Required for HTTP, HTTPS,
 	// HTTP_STR_MATCH, and HTTPS_STR_MATCH health checks, HTTP request is issued
 	// to the instance on the given port and path.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// A string to search for in the body of a health check response. Required for
 	// HTTP_STR_MATCH and HTTPS_STR_MATCH health checks.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	// The type of health check to be performed. Currently supported types are TCP,
 	// HTTP, HTTPS, HTTP_STR_MATCH, and HTTPS_STR_MATCH.
-	Type *string `type:"string" required:"true"`
+	//
+	// See the HealthCheckType* constants for valid values.
+	Type *string `type:"string" enum:"HTTP,HTTPS,HTTP_STR_MATCH,HTTPS_STR_MATCH,TCP" required:"true"`
 
 	metadataHealthCheckConfig `json:"-" xml:"-"`
 }
@@ -1980,11 +2769,21 @@ type metadataHealthCheckConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HealthCheckConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HealthCheckConfig) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the IP address of a Route 53 health checker
 // and the reason for the health check status.
 type HealthCheckObservation struct {
 	// The IP address of the Route 53 health checker that performed the health check.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// A complex type that contains information about the health check status for
 	// the current observation.
@@ -1997,16 +2796,26 @@ type metadataHealthCheckObservation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HealthCheckObservation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HealthCheckObservation) GoString() string {
+	return s.String()
+}
+
 // A complex type that contain information about the specified hosted zone.
 type HostedZone struct {
 	// A unique string that identifies the request to create the hosted zone.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A complex type that contains the Comment element.
 	Config *HostedZoneConfig `type:"structure"`
 
 	// The ID of the specified hosted zone.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The name of the domain. This must be a fully-specified domain, for example,
 	// www.example.com. The trailing dot is optional; Route 53 assumes that the
@@ -2016,7 +2825,7 @@ type HostedZone struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Total number of resource record sets in the hosted zone.
 	ResourceRecordSetCount *int64 `type:"long"`
@@ -2028,6 +2837,16 @@ type metadataHostedZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HostedZone) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HostedZone) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains an optional comment about your hosted zone.
 // If you don't want to specify a comment, you can omit the HostedZoneConfig
 // and Comment elements from the XML document.
@@ -2035,7 +2854,7 @@ type HostedZoneConfig struct {
 	// An optional comment about your hosted zone. If you don't want to specify
 	// a comment, you can omit the HostedZoneConfig and Comment elements from the
 	// XML document.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// A value that indicates whether this is a private hosted zone. The value is
 	// returned in the response; do not specify it in the request.
@@ -2048,6 +2867,16 @@ type metadataHostedZoneConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HostedZoneConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HostedZoneConfig) GoString() string {
+	return s.String()
+}
+
 // The input for a ListGeoLocations request.
 type ListGeoLocationsInput struct {
 	// The maximum number of geo locations you want in the response body.
@@ -2061,21 +2890,21 @@ type ListGeoLocationsInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string"`
+	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string" min:"2" max:"2"`
 
 	// The first country code in the lexicographic ordering of geo locations that
 	// you want the ListGeoLocations request to list.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string"`
+	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string" min:"1" max:"2"`
 
 	// The first subdivision code in the lexicographic ordering of geo locations
 	// that you want the ListGeoLocations request to list.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string"`
+	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsInput `json:"-" xml:"-"`
 }
@@ -2084,6 +2913,16 @@ type metadataListGeoLocationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGeoLocationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGeoLocationsInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the geo locations that are
 // returned by the request and information about the response.
 type ListGeoLocationsOutput struct {
@@ -2107,17 +2946,17 @@ type ListGeoLocationsOutput struct {
 	// If the results were truncated, the continent code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is a continent location.
-	NextContinentCode *string `type:"string"`
+	NextContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// If the results were truncated, the country code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is not a continent location.
-	NextCountryCode *string `type:"string"`
+	NextCountryCode *string `type:"string" min:"1" max:"2"`
 
 	// If the results were truncated, the subdivision code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location has a subdivision.
-	NextSubdivisionCode *string `type:"string"`
+	NextSubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsOutput `json:"-" xml:"-"`
 }
@@ -2126,6 +2965,16 @@ type metadataListGeoLocationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGeoLocationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGeoLocationsOutput) GoString() string {
+	return s.String()
+}
+
 // To retrieve a list of your health checks, send a GET request to the 2013-04-01/healthcheck
 // resource. The response to this request includes a HealthChecks element with
 // zero or more HealthCheck child elements. By default, the list of health checks
@@ -2139,7 +2988,7 @@ type ListHealthChecksInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of health checks to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2151,6 +3000,16 @@ type metadataListHealthChecksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHealthChecksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHealthChecksInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the response for the request.
 type ListHealthChecksOutput struct {
 	// A complex type that contains information about the health checks associated
@@ -2167,7 +3026,7 @@ type ListHealthChecksOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of health checks to be included in the response body.
 	// If the number of health checks associated with this AWS account exceeds MaxItems,
@@ -2179,7 +3038,7 @@ type ListHealthChecksOutput struct {
 	// Indicates where to continue listing health checks. If ListHealthChecksResponse$IsTruncated
 	// is true, make another request to ListHealthChecks and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHealthChecksOutput `json:"-" xml:"-"`
 }
@@ -2188,6 +3047,16 @@ type metadataListHealthChecksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHealthChecksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHealthChecksOutput) GoString() string {
+	return s.String()
+}
+
 // To retrieve a list of your hosted zones in lexicographic order, send a GET
 // request to the 2013-04-01/hostedzonesbyname resource. The response to this
 // request includes a HostedZones element with zero or more HostedZone child
@@ -2207,12 +3076,12 @@ type ListHostedZonesByNameInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	DNSName *string `location:"querystring" locationName:"dnsname" type:"string"`
+	DNSName *string `location:"querystring" locationName:"dnsname" type:"string" max:"1024"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string"`
+	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string" max:"32"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2224,13 +3093,23 @@ type metadataListHostedZonesByNameInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHostedZonesByNameInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHostedZonesByNameInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the response for the request.
 type ListHostedZonesByNameOutput struct {
 	// The DNSName value sent in the request.
-	DNSName *string `type:"string"`
+	DNSName *string `type:"string" max:"1024"`
 
 	// The HostedZoneId value sent in the request.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	// A complex type that contains information about the hosted zones associated
 	// with the current AWS account.
@@ -2256,14 +3135,14 @@ type ListHostedZonesByNameOutput struct {
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextDNSName *string `type:"string"`
+	NextDNSName *string `type:"string" max:"1024"`
 
 	// If ListHostedZonesByNameResponse$IsTruncated is true, there are more hosted
 	// zones associated with the current AWS account. To get the next page of results,
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string"`
+	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string" max:"32"`
 
 	metadataListHostedZonesByNameOutput `json:"-" xml:"-"`
 }
@@ -2272,6 +3151,16 @@ type metadataListHostedZonesByNameOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHostedZonesByNameOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHostedZonesByNameOutput) GoString() string {
+	return s.String()
+}
+
 // To retrieve a list of your hosted zones, send a GET request to the 2013-04-01/hostedzone
 // resource. The response to this request includes a HostedZones element with
 // zero or more HostedZone child elements. By default, the list of hosted zones
@@ -2285,12 +3174,12 @@ type metadataListHostedZonesByNameOutput struct {
 //  Route 53 returns a maximum of 100 items. If you set MaxItems to a value
 // greater than 100, Route 53 returns only the first 100.
 type ListHostedZonesInput struct {
-	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string"`
+	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string" max:"32"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2302,6 +3191,16 @@ type metadataListHostedZonesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHostedZonesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHostedZonesInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the response for the request.
 type ListHostedZonesOutput struct {
 	// A complex type that contains information about the hosted zones associated
@@ -2318,7 +3217,7 @@ type ListHostedZonesOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of hosted zones to be included in the response body. If
 	// the number of hosted zones associated with this AWS account exceeds MaxItems,
@@ -2330,7 +3229,7 @@ type ListHostedZonesOutput struct {
 	// Indicates where to continue listing hosted zones. If ListHostedZonesResponse$IsTruncated
 	// is true, make another request to ListHostedZones and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHostedZonesOutput `json:"-" xml:"-"`
 }
@@ -2339,11 +3238,21 @@ type metadataListHostedZonesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListHostedZonesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListHostedZonesOutput) GoString() string {
+	return s.String()
+}
+
 // The input for a ListResourceRecordSets request.
 type ListResourceRecordSetsInput struct {
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to get.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The maximum number of records you want in the response body.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2352,11 +3261,11 @@ type ListResourceRecordSetsInput struct {
 	// DNS name and type, specify the value of ListResourceRecordSetsResponse$NextRecordIdentifier
 	// from the previous response to get the next resource record set that has the
 	// current DNS name and type.
-	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string"`
+	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string" min:"1" max:"128"`
 
 	// The first name in the lexicographic ordering of domain names that you want
 	// the ListResourceRecordSets request to list.
-	StartRecordName *string `location:"querystring" locationName:"name" type:"string"`
+	StartRecordName *string `location:"querystring" locationName:"name" type:"string" max:"1024"`
 
 	// The DNS type at which to begin the listing of resource record sets.
 	//
@@ -2370,7 +3279,9 @@ type ListResourceRecordSetsInput struct {
 	//
 	// Constraint: Specifying type without specifying name returns an InvalidInput
 	// error.
-	StartRecordType *string `location:"querystring" locationName:"type" type:"string"`
+	//
+	// See the RRType* constants for valid values.
+	StartRecordType *string `location:"querystring" locationName:"type" type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA"`
 
 	metadataListResourceRecordSetsInput `json:"-" xml:"-"`
 }
@@ -2379,6 +3290,16 @@ type metadataListResourceRecordSetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListResourceRecordSetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListResourceRecordSetsInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the resource record sets that
 // are returned by the request and information about the response.
 type ListResourceRecordSetsOutput struct {
@@ -2397,17 +3318,19 @@ type ListResourceRecordSetsOutput struct {
 	// Weighted resource record sets only: If results were truncated for a given
 	// DNS name and type, the value of SetIdentifier for the next resource record
 	// set that has the current DNS name and type.
-	NextRecordIdentifier *string `type:"string"`
+	NextRecordIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// If the results were truncated, the name of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
 	// true.
-	NextRecordName *string `type:"string"`
+	NextRecordName *string `type:"string" max:"1024"`
 
 	// If the results were truncated, the type of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
 	// true.
-	NextRecordType *string `type:"string"`
+	//
+	// See the RRType* constants for valid values.
+	NextRecordType *string `type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA"`
 
 	// A complex type that contains information about the resource record sets that
 	// are returned by the request.
@@ -2420,6 +3343,16 @@ type metadataListResourceRecordSetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListResourceRecordSetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListResourceRecordSetsOutput) GoString() string {
+	return s.String()
+}
+
 // To retrieve a list of your reusable delegation sets, send a GET request to
 // the 2013-04-01/delegationset resource. The response to this request includes
 // a DelegationSets element with zero or more DelegationSet child elements.
@@ -2434,7 +3367,7 @@ type ListReusableDelegationSetsInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of reusable delegation sets to return per page
 	// of results.
@@ -2447,6 +3380,16 @@ type metadataListReusableDelegationSetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListReusableDelegationSetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListReusableDelegationSetsInput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the response for the request.
 type ListReusableDelegationSetsOutput struct {
 	// A complex type that contains information about the reusable delegation sets
@@ -2463,7 +3406,7 @@ type ListReusableDelegationSetsOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of reusable delegation sets to be included in the response
 	// body. If the number of reusable delegation sets associated with this AWS
@@ -2477,7 +3420,7 @@ type ListReusableDelegationSetsOutput struct {
 	// is true, make another request to ListReusableDelegationSets and include the
 	// value of the NextMarker element in the Marker element to get the next page
 	// of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListReusableDelegationSetsOutput `json:"-" xml:"-"`
 }
@@ -2486,18 +3429,30 @@ type metadataListReusableDelegationSetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListReusableDelegationSetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListReusableDelegationSetsOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about a request for a list of the tags
 // that are associated with an individual resource.
 type ListTagsForResourceInput struct {
 	// The ID of the resource for which you want to retrieve tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	//
+	// See the TagResourceType* constants for valid values.
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataListTagsForResourceInput `json:"-" xml:"-"`
 }
@@ -2506,6 +3461,16 @@ type metadataListTagsForResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForResourceInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing tags for the specified resource.
 type ListTagsForResourceOutput struct {
 	// A ResourceTagSet containing tags associated with the specified resource.
@@ -2518,19 +3483,31 @@ type metadataListTagsForResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForResourceOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about a request for a list of the tags
 // that are associated with up to 10 specified resources.
 type ListTagsForResourcesInput struct {
 	// A complex type that contains the ResourceId element for each resource for
 	// which you want to get a list of tags.
-	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" required:"true"`
+	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" min:"1" max:"10" required:"true"`
 
 	// The type of the resources.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	//
+	// See the TagResourceType* constants for valid values.
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataListTagsForResourcesInput `json:"-" xml:"-"`
 }
@@ -2539,6 +3516,16 @@ type metadataListTagsForResourcesInput struct {
 	SDKShapeTraits bool `locationName:"ListTagsForResourcesRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s ListTagsForResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForResourcesInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing tags for the specified resources.
 type ListTagsForResourcesOutput struct {
 	// A list of ResourceTagSets containing tags associated with the specified resources.
@@ -2551,11 +3538,21 @@ type metadataListTagsForResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForResourcesOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains the value of the Value element for the current
 // resource record set.
 type ResourceRecord struct {
 	// The value of the Value element for the current resource record set.
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"4000" required:"true"`
 
 	metadataResourceRecord `json:"-" xml:"-"`
 }
@@ -2564,6 +3561,16 @@ type metadataResourceRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResourceRecord) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResourceRecord) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the current resource record
 // set.
 type ResourceRecordSet struct {
@@ -2587,7 +3594,9 @@ type ResourceRecordSet struct {
 	// set.
 	//
 	// Valid values: PRIMARY | SECONDARY
-	Failover *string `type:"string"`
+	//
+	// See the ResourceRecordSetFailover* constants for valid values.
+	Failover *string `type:"string" enum:"PRIMARY,SECONDARY"`
 
 	// Geo location resource record sets only: Among resource record sets that have
 	// the same combination of DNS name and type, a value that specifies the geo
@@ -2597,36 +3606,40 @@ type ResourceRecordSet struct {
 	// Health Check resource record sets only, not required for alias resource record
 	// sets: An identifier that is used to identify health check associated with
 	// the resource record set.
-	HealthCheckID *string `locationName:"HealthCheckId" type:"string"`
+	HealthCheckID *string `locationName:"HealthCheckId" type:"string" max:"64"`
 
 	// The domain name of the current resource record set.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Latency-based resource record sets only: Among resource record sets that
 	// have the same combination of DNS name and type, a value that specifies the
 	// AWS region for the current resource record set.
-	Region *string `type:"string"`
+	//
+	// See the ResourceRecordSetRegion* constants for valid values.
+	Region *string `type:"string" min:"1" max:"64" enum:"us-east-1,us-west-1,us-west-2,eu-west-1,eu-central-1,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1"`
 
 	// A complex type that contains the resource records for the current resource
 	// record set.
-	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list"`
+	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list" min:"1"`
 
 	// Weighted, Latency, Geo, and Failover resource record sets only: An identifier
 	// that differentiates among multiple resource record sets that have the same
 	// combination of DNS name and type.
-	SetIdentifier *string `type:"string"`
+	SetIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// The cache time to live for the current resource record set.
-	TTL *int64 `type:"long"`
+	TTL *int64 `type:"long" min:"0" max:"2147483647"`
 
 	// The type of the current resource record set.
-	Type *string `type:"string" required:"true"`
+	//
+	// See the RRType* constants for valid values.
+	Type *string `type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA" required:"true"`
 
 	// Weighted resource record sets only: Among resource record sets that have
 	// the same combination of DNS name and type, a value that determines what portion
 	// of traffic for the current resource record set is routed to the associated
 	// location.
-	Weight *int64 `type:"long"`
+	Weight *int64 `type:"long" min:"0" max:"255"`
 
 	metadataResourceRecordSet `json:"-" xml:"-"`
 }
@@ -2635,20 +3648,32 @@ type metadataResourceRecordSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResourceRecordSet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResourceRecordSet) GoString() string {
+	return s.String()
+}
+
 // A complex type containing a resource and its associated tags.
 type ResourceTagSet struct {
 	// The ID for the specified resource.
-	ResourceID *string `locationName:"ResourceId" type:"string"`
+	ResourceID *string `locationName:"ResourceId" type:"string" max:"64"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `type:"string"`
+	//
+	// See the TagResourceType* constants for valid values.
+	ResourceType *string `type:"string" enum:"healthcheck,hostedzone"`
 
 	// The tags associated with the specified resource.
-	Tags []*Tag `locationNameList:"Tag" type:"list"`
+	Tags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	metadataResourceTagSet `json:"-" xml:"-"`
 }
@@ -2657,6 +3682,16 @@ type metadataResourceTagSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResourceTagSet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResourceTagSet) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the health check status for
 // the current observation.
 type StatusReport struct {
@@ -2676,13 +3711,23 @@ type metadataStatusReport struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StatusReport) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StatusReport) GoString() string {
+	return s.String()
+}
+
 // A single tag containing a key and value.
 type Tag struct {
 	// The key for a Tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" max:"128"`
 
 	// The value for a Tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256"`
 
 	metadataTag `json:"-" xml:"-"`
 }
@@ -2691,6 +3736,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // >A complex type that contains information about the request to update a health
 // check.
 type UpdateHealthCheckInput struct {
@@ -2703,32 +3758,32 @@ type UpdateHealthCheckInput struct {
 	// Route 53 Developer Guide.
 	//
 	// Specify this value only if you want to change it.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
 	//
 	// Specify this value only if you want to change it.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// The ID of the health check to update.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	// Optional. When you specify a health check version, Route 53 compares this
 	// value with the current value in the health check, which prevents you from
 	// updating the health check when the versions don't match. Using HealthCheckVersion
 	// lets you prevent overwriting another change to the health check.
-	HealthCheckVersion *int64 `type:"long"`
+	HealthCheckVersion *int64 `type:"long" min:"1"`
 
 	// The IP address of the resource that you want to check.
 	//
 	// Specify this value only if you want to change it.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// The port on which you want Route 53 to open a connection to perform health
 	// checks.
 	//
 	// Specify this value only if you want to change it.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The path that you want Amazon Route 53 to request when performing health
 	// checks. The path can be any value for which your endpoint will return an
@@ -2736,7 +3791,7 @@ type UpdateHealthCheckInput struct {
 	// the file /docs/route53-health-check.html.
 	//
 	// Specify this value only if you want to change it.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// If the value of Type is HTTP_STR_MATCH or HTTP_STR_MATCH, the string that
 	// you want Route 53 to search for in the response body from the specified resource.
@@ -2744,7 +3799,7 @@ type UpdateHealthCheckInput struct {
 	// healthy.
 	//
 	// Specify this value only if you want to change it.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	metadataUpdateHealthCheckInput `json:"-" xml:"-"`
 }
@@ -2753,6 +3808,16 @@ type metadataUpdateHealthCheckInput struct {
 	SDKShapeTraits bool `locationName:"UpdateHealthCheckRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s UpdateHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateHealthCheckInput) GoString() string {
+	return s.String()
+}
+
 type UpdateHealthCheckOutput struct {
 	// A complex type that contains identifying information about the health check.
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
@@ -2764,14 +3829,24 @@ type metadataUpdateHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateHealthCheckOutput) GoString() string {
+	return s.String()
+}
+
 // A complex type that contains information about the request to update a hosted
 // zone comment.
 type UpdateHostedZoneCommentInput struct {
 	// A comment about your hosted zone.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the hosted zone you want to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataUpdateHostedZoneCommentInput `json:"-" xml:"-"`
 }
@@ -2780,6 +3855,16 @@ type metadataUpdateHostedZoneCommentInput struct {
 	SDKShapeTraits bool `locationName:"UpdateHostedZoneCommentRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation
+func (s UpdateHostedZoneCommentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateHostedZoneCommentInput) GoString() string {
+	return s.String()
+}
+
 // A complex type containing information about the specified hosted zone after
 // the update.
 type UpdateHostedZoneCommentOutput struct {
@@ -2793,11 +3878,22 @@ type metadataUpdateHostedZoneCommentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateHostedZoneCommentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateHostedZoneCommentOutput) GoString() string {
+	return s.String()
+}
+
 type VPC struct {
 	// A VPC ID
-	VPCID *string `locationName:"VPCId" type:"string"`
+	VPCID *string `locationName:"VPCId" type:"string" max:"1024"`
 
-	VPCRegion *string `type:"string"`
+	// See the VPCRegion* constants for valid values.
+	VPCRegion *string `type:"string" min:"1" max:"64" enum:"us-east-1,us-west-1,us-west-2,eu-west-1,eu-central-1,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1"`
 
 	metadataVPC `json:"-" xml:"-"`
 }
@@ -2805,3 +3901,125 @@ type VPC struct {
 type metadataVPC struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VPC) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VPC) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ChangeAction
+	ChangeActionCreate = "CREATE"
+	// @enum ChangeAction
+	ChangeActionDelete = "DELETE"
+	// @enum ChangeAction
+	ChangeActionUpsert = "UPSERT"
+)
+
+const (
+	// @enum ChangeStatus
+	ChangeStatusPending = "PENDING"
+	// @enum ChangeStatus
+	ChangeStatusInsync = "INSYNC"
+)
+
+const (
+	// @enum HealthCheckType
+	HealthCheckTypeHttp = "HTTP"
+	// @enum HealthCheckType
+	HealthCheckTypeHttps = "HTTPS"
+	// @enum HealthCheckType
+	HealthCheckTypeHttpStrMatch = "HTTP_STR_MATCH"
+	// @enum HealthCheckType
+	HealthCheckTypeHttpsStrMatch = "HTTPS_STR_MATCH"
+	// @enum HealthCheckType
+	HealthCheckTypeTcp = "TCP"
+)
+
+const (
+	// @enum RRType
+	RRTypeSoa = "SOA"
+	// @enum RRType
+	RRTypeA = "A"
+	// @enum RRType
+	RRTypeTxt = "TXT"
+	// @enum RRType
+	RRTypeNs = "NS"
+	// @enum RRType
+	RRTypeCname = "CNAME"
+	// @enum RRType
+	RRTypeMx = "MX"
+	// @enum RRType
+	RRTypePtr = "PTR"
+	// @enum RRType
+	RRTypeSrv = "SRV"
+	// @enum RRType
+	RRTypeSpf = "SPF"
+	// @enum RRType
+	RRTypeAaaa = "AAAA"
+)
+
+const (
+	// @enum ResourceRecordSetFailover
+	ResourceRecordSetFailoverPrimary = "PRIMARY"
+	// @enum ResourceRecordSetFailover
+	ResourceRecordSetFailoverSecondary = "SECONDARY"
+)
+
+const (
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionUsEast1 = "us-east-1"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionUsWest1 = "us-west-1"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionUsWest2 = "us-west-2"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionEuWest1 = "eu-west-1"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionEuCentral1 = "eu-central-1"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionApSoutheast1 = "ap-southeast-1"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionApSoutheast2 = "ap-southeast-2"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionApNortheast1 = "ap-northeast-1"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionSaEast1 = "sa-east-1"
+	// @enum ResourceRecordSetRegion
+	ResourceRecordSetRegionCnNorth1 = "cn-north-1"
+)
+
+const (
+	// @enum TagResourceType
+	TagResourceTypeHealthcheck = "healthcheck"
+	// @enum TagResourceType
+	TagResourceTypeHostedzone = "hostedzone"
+)
+
+const (
+	// @enum VPCRegion
+	VPCRegionUsEast1 = "us-east-1"
+	// @enum VPCRegion
+	VPCRegionUsWest1 = "us-west-1"
+	// @enum VPCRegion
+	VPCRegionUsWest2 = "us-west-2"
+	// @enum VPCRegion
+	VPCRegionEuWest1 = "eu-west-1"
+	// @enum VPCRegion
+	VPCRegionEuCentral1 = "eu-central-1"
+	// @enum VPCRegion
+	VPCRegionApSoutheast1 = "ap-southeast-1"
+	// @enum VPCRegion
+	VPCRegionApSoutheast2 = "ap-southeast-2"
+	// @enum VPCRegion
+	VPCRegionApNortheast1 = "ap-northeast-1"
+	// @enum VPCRegion
+	VPCRegionSaEast1 = "sa-east-1"
+	// @enum VPCRegion
+	VPCRegionCnNorth1 = "cn-north-1"
+)
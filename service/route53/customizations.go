@@ -6,9 +6,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 )
 
+// SanitizeURLHandler is a named request handler which removes the resource
+// type prefix from the IDs in the request's URL path.
+var SanitizeURLHandler = aws.NamedHandler{Name: "route53.SanitizeURLHandler", Fn: sanitizeURL}
+
 func init() {
 	initService = func(s *aws.Service) {
-		s.Handlers.Build.PushBack(sanitizeURL)
+		s.Handlers.Build.PushBackNamed(SanitizeURLHandler)
 	}
 }
 
@@ -29,11 +29,11 @@ func New(config *aws.Config) *Route53 {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
-	service.Handlers.Build.PushBack(restxml.Build)
-	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
-	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
-	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBackNamed(restxml.BuildHandler)
+	service.Handlers.Unmarshal.PushBackNamed(restxml.UnmarshalHandler)
+	service.Handlers.UnmarshalMeta.PushBackNamed(restxml.UnmarshalMetaHandler)
+	service.Handlers.UnmarshalError.PushBackNamed(restxml.UnmarshalErrorHandler)
 
 	// Run custom service initialization if present
 	if initService != nil {
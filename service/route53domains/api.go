@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCheckDomainAvailability = "CheckDomainAvailability"
@@ -39,6 +40,16 @@ func (c *Route53Domains) CheckDomainAvailability(input *CheckDomainAvailabilityI
 	return out, err
 }
 
+// CheckDomainAvailabilityWithContext is the same as CheckDomainAvailability with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) CheckDomainAvailabilityWithContext(ctx aws.Context, input *CheckDomainAvailabilityInput) (*CheckDomainAvailabilityOutput, error) {
+	req, out := c.CheckDomainAvailabilityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTagsForDomain = "DeleteTagsForDomain"
 
 // DeleteTagsForDomainRequest generates a request for the DeleteTagsForDomain operation.
@@ -69,6 +80,16 @@ func (c *Route53Domains) DeleteTagsForDomain(input *DeleteTagsForDomainInput) (*
 	return out, err
 }
 
+// DeleteTagsForDomainWithContext is the same as DeleteTagsForDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) DeleteTagsForDomainWithContext(ctx aws.Context, input *DeleteTagsForDomainInput) (*DeleteTagsForDomainOutput, error) {
+	req, out := c.DeleteTagsForDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableDomainAutoRenew = "DisableDomainAutoRenew"
 
 // DisableDomainAutoRenewRequest generates a request for the DisableDomainAutoRenew operation.
@@ -102,6 +123,16 @@ func (c *Route53Domains) DisableDomainAutoRenew(input *DisableDomainAutoRenewInp
 	return out, err
 }
 
+// DisableDomainAutoRenewWithContext is the same as DisableDomainAutoRenew with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) DisableDomainAutoRenewWithContext(ctx aws.Context, input *DisableDomainAutoRenewInput) (*DisableDomainAutoRenewOutput, error) {
+	req, out := c.DisableDomainAutoRenewRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableDomainTransferLock = "DisableDomainTransferLock"
 
 // DisableDomainTransferLockRequest generates a request for the DisableDomainTransferLock operation.
@@ -135,6 +166,16 @@ func (c *Route53Domains) DisableDomainTransferLock(input *DisableDomainTransferL
 	return out, err
 }
 
+// DisableDomainTransferLockWithContext is the same as DisableDomainTransferLock with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) DisableDomainTransferLockWithContext(ctx aws.Context, input *DisableDomainTransferLockInput) (*DisableDomainTransferLockOutput, error) {
+	req, out := c.DisableDomainTransferLockRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableDomainAutoRenew = "EnableDomainAutoRenew"
 
 // EnableDomainAutoRenewRequest generates a request for the EnableDomainAutoRenew operation.
@@ -171,6 +212,16 @@ func (c *Route53Domains) EnableDomainAutoRenew(input *EnableDomainAutoRenewInput
 	return out, err
 }
 
+// EnableDomainAutoRenewWithContext is the same as EnableDomainAutoRenew with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) EnableDomainAutoRenewWithContext(ctx aws.Context, input *EnableDomainAutoRenewInput) (*EnableDomainAutoRenewOutput, error) {
+	req, out := c.EnableDomainAutoRenewRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opEnableDomainTransferLock = "EnableDomainTransferLock"
 
 // EnableDomainTransferLockRequest generates a request for the EnableDomainTransferLock operation.
@@ -202,6 +253,16 @@ func (c *Route53Domains) EnableDomainTransferLock(input *EnableDomainTransferLoc
 	return out, err
 }
 
+// EnableDomainTransferLockWithContext is the same as EnableDomainTransferLock with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) EnableDomainTransferLockWithContext(ctx aws.Context, input *EnableDomainTransferLockInput) (*EnableDomainTransferLockOutput, error) {
+	req, out := c.EnableDomainTransferLockRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDomainDetail = "GetDomainDetail"
 
 // GetDomainDetailRequest generates a request for the GetDomainDetail operation.
@@ -230,6 +291,16 @@ func (c *Route53Domains) GetDomainDetail(input *GetDomainDetailInput) (*GetDomai
 	return out, err
 }
 
+// GetDomainDetailWithContext is the same as GetDomainDetail with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) GetDomainDetailWithContext(ctx aws.Context, input *GetDomainDetailInput) (*GetDomainDetailOutput, error) {
+	req, out := c.GetDomainDetailRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetOperationDetail = "GetOperationDetail"
 
 // GetOperationDetailRequest generates a request for the GetOperationDetail operation.
@@ -257,6 +328,16 @@ func (c *Route53Domains) GetOperationDetail(input *GetOperationDetailInput) (*Ge
 	return out, err
 }
 
+// GetOperationDetailWithContext is the same as GetOperationDetail with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) GetOperationDetailWithContext(ctx aws.Context, input *GetOperationDetailInput) (*GetOperationDetailOutput, error) {
+	req, out := c.GetOperationDetailRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListDomains = "ListDomains"
 
 // ListDomainsRequest generates a request for the ListDomains operation.
@@ -291,6 +372,16 @@ func (c *Route53Domains) ListDomains(input *ListDomainsInput) (*ListDomainsOutpu
 	return out, err
 }
 
+// ListDomainsWithContext is the same as ListDomains with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) ListDomainsWithContext(ctx aws.Context, input *ListDomainsInput) (*ListDomainsOutput, error) {
+	req, out := c.ListDomainsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Route53Domains) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDomainsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -331,6 +422,16 @@ func (c *Route53Domains) ListOperations(input *ListOperationsInput) (*ListOperat
 	return out, err
 }
 
+// ListOperationsWithContext is the same as ListOperations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) ListOperationsWithContext(ctx aws.Context, input *ListOperationsInput) (*ListOperationsOutput, error) {
+	req, out := c.ListOperationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Route53Domains) ListOperationsPages(input *ListOperationsInput, fn func(p *ListOperationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListOperationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -369,6 +470,16 @@ func (c *Route53Domains) ListTagsForDomain(input *ListTagsForDomainInput) (*List
 	return out, err
 }
 
+// ListTagsForDomainWithContext is the same as ListTagsForDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) ListTagsForDomainWithContext(ctx aws.Context, input *ListTagsForDomainInput) (*ListTagsForDomainOutput, error) {
+	req, out := c.ListTagsForDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterDomain = "RegisterDomain"
 
 // RegisterDomainRequest generates a request for the RegisterDomain operation.
@@ -414,6 +525,16 @@ func (c *Route53Domains) RegisterDomain(input *RegisterDomainInput) (*RegisterDo
 	return out, err
 }
 
+// RegisterDomainWithContext is the same as RegisterDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) RegisterDomainWithContext(ctx aws.Context, input *RegisterDomainInput) (*RegisterDomainOutput, error) {
+	req, out := c.RegisterDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveDomainAuthCode = "RetrieveDomainAuthCode"
 
 // RetrieveDomainAuthCodeRequest generates a request for the RetrieveDomainAuthCode operation.
@@ -442,6 +563,16 @@ func (c *Route53Domains) RetrieveDomainAuthCode(input *RetrieveDomainAuthCodeInp
 	return out, err
 }
 
+// RetrieveDomainAuthCodeWithContext is the same as RetrieveDomainAuthCode with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) RetrieveDomainAuthCodeWithContext(ctx aws.Context, input *RetrieveDomainAuthCodeInput) (*RetrieveDomainAuthCodeOutput, error) {
+	req, out := c.RetrieveDomainAuthCodeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTransferDomain = "TransferDomain"
 
 // TransferDomainRequest generates a request for the TransferDomain operation.
@@ -492,6 +623,16 @@ func (c *Route53Domains) TransferDomain(input *TransferDomainInput) (*TransferDo
 	return out, err
 }
 
+// TransferDomainWithContext is the same as TransferDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) TransferDomainWithContext(ctx aws.Context, input *TransferDomainInput) (*TransferDomainOutput, error) {
+	req, out := c.TransferDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDomainContact = "UpdateDomainContact"
 
 // UpdateDomainContactRequest generates a request for the UpdateDomainContact operation.
@@ -526,6 +667,16 @@ func (c *Route53Domains) UpdateDomainContact(input *UpdateDomainContactInput) (*
 	return out, err
 }
 
+// UpdateDomainContactWithContext is the same as UpdateDomainContact with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) UpdateDomainContactWithContext(ctx aws.Context, input *UpdateDomainContactInput) (*UpdateDomainContactOutput, error) {
+	req, out := c.UpdateDomainContactRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDomainContactPrivacy = "UpdateDomainContactPrivacy"
 
 // UpdateDomainContactPrivacyRequest generates a request for the UpdateDomainContactPrivacy operation.
@@ -563,6 +714,16 @@ func (c *Route53Domains) UpdateDomainContactPrivacy(input *UpdateDomainContactPr
 	return out, err
 }
 
+// UpdateDomainContactPrivacyWithContext is the same as UpdateDomainContactPrivacy with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) UpdateDomainContactPrivacyWithContext(ctx aws.Context, input *UpdateDomainContactPrivacyInput) (*UpdateDomainContactPrivacyOutput, error) {
+	req, out := c.UpdateDomainContactPrivacyRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateDomainNameservers = "UpdateDomainNameservers"
 
 // UpdateDomainNameserversRequest generates a request for the UpdateDomainNameservers operation.
@@ -597,6 +758,16 @@ func (c *Route53Domains) UpdateDomainNameservers(input *UpdateDomainNameserversI
 	return out, err
 }
 
+// UpdateDomainNameserversWithContext is the same as UpdateDomainNameservers with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) UpdateDomainNameserversWithContext(ctx aws.Context, input *UpdateDomainNameserversInput) (*UpdateDomainNameserversOutput, error) {
+	req, out := c.UpdateDomainNameserversRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateTagsForDomain = "UpdateTagsForDomain"
 
 // UpdateTagsForDomainRequest generates a request for the UpdateTagsForDomain operation.
@@ -627,6 +798,16 @@ func (c *Route53Domains) UpdateTagsForDomain(input *UpdateTagsForDomainInput) (*
 	return out, err
 }
 
+// UpdateTagsForDomainWithContext is the same as UpdateTagsForDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Route53Domains) UpdateTagsForDomainWithContext(ctx aws.Context, input *UpdateTagsForDomainInput) (*UpdateTagsForDomainOutput, error) {
+	req, out := c.UpdateTagsForDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // The CheckDomainAvailability request contains the following elements.
 type CheckDomainAvailabilityInput struct {
 	// The name of a domain.
@@ -640,10 +821,10 @@ type CheckDomainAvailabilityInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	metadataCheckDomainAvailabilityInput `json:"-" xml:"-"`
 }
@@ -652,6 +833,16 @@ type metadataCheckDomainAvailabilityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CheckDomainAvailabilityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CheckDomainAvailabilityInput) GoString() string {
+	return s.String()
+}
+
 // The CheckDomainAvailability response includes the following elements.
 type CheckDomainAvailabilityOutput struct {
 	// Whether the domain name is available for registering.
@@ -672,7 +863,9 @@ type CheckDomainAvailabilityOutput struct {
 	// name is available. Amazon Route 53 can return this response for a variety
 	// of reasons, for example, the registry is performing maintenance. Try again
 	// later.
-	Availability *string `type:"string" required:"true"`
+	//
+	// See the DomainAvailability* constants for valid values.
+	Availability *string `type:"string" enum:"AVAILABLE,AVAILABLE_RESERVED,AVAILABLE_PREORDER,UNAVAILABLE,UNAVAILABLE_PREMIUM,UNAVAILABLE_RESTRICTED,RESERVED,DONT_KNOW" required:"true"`
 
 	metadataCheckDomainAvailabilityOutput `json:"-" xml:"-"`
 }
@@ -681,6 +874,16 @@ type metadataCheckDomainAvailabilityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CheckDomainAvailabilityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CheckDomainAvailabilityOutput) GoString() string {
+	return s.String()
+}
+
 // ContactDetail includes the following elements.
 type ContactDetail struct {
 	// First line of the contact's address.
@@ -694,7 +897,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	AddressLine1 *string `type:"string"`
+	AddressLine1 *string `type:"string" max:"255"`
 
 	// Second line of contact's address, if any.
 	//
@@ -707,7 +910,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	AddressLine2 *string `type:"string"`
+	AddressLine2 *string `type:"string" max:"255"`
 
 	// The city of the contact's address.
 	//
@@ -720,7 +923,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	City *string `type:"string"`
+	City *string `type:"string" max:"255"`
 
 	// Indicates whether the contact is a person, company, association, or public
 	// organization. If you choose an option other than PERSON, you must enter an
@@ -737,7 +940,9 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	ContactType *string `type:"string"`
+	//
+	// See the ContactType* constants for valid values.
+	ContactType *string `type:"string" enum:"PERSON,COMPANY,ASSOCIATION,PUBLIC_BODY,RESELLER"`
 
 	// Code for the country of the contact's address.
 	//
@@ -750,7 +955,9 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	CountryCode *string `type:"string"`
+	//
+	// See the CountryCode* constants for valid values.
+	CountryCode *string `type:"string" enum:"AD,AE,AF,AG,AI,AL,AM,AN,AO,AQ,AR,AS,AT,AU,AW,AZ,BA,BB,BD,BE,BF,BG,BH,BI,BJ,BL,BM,BN,BO,BR,BS,BT,BW,BY,BZ,CA,CC,CD,CF,CG,CH,CI,CK,CL,CM,CN,CO,CR,CU,CV,CX,CY,CZ,DE,DJ,DK,DM,DO,DZ,EC,EE,EG,ER,ES,ET,FI,FJ,FK,FM,FO,FR,GA,GB,GD,GE,GH,GI,GL,GM,GN,GQ,GR,GT,GU,GW,GY,HK,HN,HR,HT,HU,ID,IE,IL,IM,IN,IQ,IR,IS,IT,JM,JO,JP,KE,KG,KH,KI,KM,KN,KP,KR,KW,KY,KZ,LA,LB,LC,LI,LK,LR,LS,LT,LU,LV,LY,MA,MC,MD,ME,MF,MG,MH,MK,ML,MM,MN,MO,MP,MR,MS,MT,MU,MV,MW,MX,MY,MZ,NA,NC,NE,NG,NI,NL,NO,NP,NR,NU,NZ,OM,PA,PE,PF,PG,PH,PK,PL,PM,PN,PR,PT,PW,PY,QA,RO,RS,RU,RW,SA,SB,SC,SD,SE,SG,SH,SI,SK,SL,SM,SN,SO,SR,ST,SV,SY,SZ,TC,TD,TG,TH,TJ,TK,TL,TM,TN,TO,TR,TT,TV,TW,TZ,UA,UG,US,UY,UZ,VA,VC,VE,VG,VI,VN,VU,WF,WS,YE,YT,ZA,ZM,ZW"`
 
 	// Email address of the contact.
 	//
@@ -763,7 +970,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	Email *string `type:"string"`
+	Email *string `type:"string" max:"254"`
 
 	// A list of name-value pairs for parameters required by certain top-level domains.
 	//
@@ -791,7 +998,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	Fax *string `type:"string"`
+	Fax *string `type:"string" max:"30"`
 
 	// First name of contact.
 	//
@@ -804,7 +1011,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	FirstName *string `type:"string"`
+	FirstName *string `type:"string" max:"255"`
 
 	// Last name of contact.
 	//
@@ -817,7 +1024,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	LastName *string `type:"string"`
+	LastName *string `type:"string" max:"255"`
 
 	// Name of the organization for contact types other than PERSON.
 	//
@@ -830,7 +1037,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	OrganizationName *string `type:"string"`
+	OrganizationName *string `type:"string" max:"255"`
 
 	// The phone number of the contact.
 	//
@@ -845,7 +1052,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	PhoneNumber *string `type:"string"`
+	PhoneNumber *string `type:"string" max:"30"`
 
 	// The state or province of the contact's city.
 	//
@@ -858,7 +1065,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	State *string `type:"string"`
+	State *string `type:"string" max:"255"`
 
 	// The zip or postal code of the contact's address.
 	//
@@ -871,13 +1078,23 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	ZipCode *string `type:"string"`
+	ZipCode *string `type:"string" max:"255"`
 
 	metadataContactDetail `json:"-" xml:"-"`
 }
 
 type metadataContactDetail struct {
-	SDKShapeTraits bool `type:"structure"`
+	SDKShapeTraits bool `type:"structure" sensitive:"true"`
+}
+
+// String returns the string representation
+func (s ContactDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ContactDetail) GoString() string {
+	return s.String()
 }
 
 // The DeleteTagsForDomainRequest includes the following elements.
@@ -897,7 +1114,7 @@ type DeleteTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// A list of tag keys to delete.
 	//
@@ -917,6 +1134,16 @@ type metadataDeleteTagsForDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsForDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsForDomainInput) GoString() string {
+	return s.String()
+}
+
 type DeleteTagsForDomainOutput struct {
 	metadataDeleteTagsForDomainOutput `json:"-" xml:"-"`
 }
@@ -925,8 +1152,18 @@ type metadataDeleteTagsForDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTagsForDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTagsForDomainOutput) GoString() string {
+	return s.String()
+}
+
 type DisableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataDisableDomainAutoRenewInput `json:"-" xml:"-"`
 }
@@ -935,6 +1172,16 @@ type metadataDisableDomainAutoRenewInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableDomainAutoRenewInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableDomainAutoRenewInput) GoString() string {
+	return s.String()
+}
+
 type DisableDomainAutoRenewOutput struct {
 	metadataDisableDomainAutoRenewOutput `json:"-" xml:"-"`
 }
@@ -943,6 +1190,16 @@ type metadataDisableDomainAutoRenewOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableDomainAutoRenewOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableDomainAutoRenewOutput) GoString() string {
+	return s.String()
+}
+
 // The DisableDomainTransferLock request includes the following element.
 type DisableDomainTransferLockInput struct {
 	// The name of a domain.
@@ -956,7 +1213,7 @@ type DisableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataDisableDomainTransferLockInput `json:"-" xml:"-"`
 }
@@ -965,6 +1222,16 @@ type metadataDisableDomainTransferLockInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableDomainTransferLockInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableDomainTransferLockInput) GoString() string {
+	return s.String()
+}
+
 // The DisableDomainTransferLock response includes the following element.
 type DisableDomainTransferLockOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -975,7 +1242,7 @@ type DisableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataDisableDomainTransferLockOutput `json:"-" xml:"-"`
 }
@@ -984,6 +1251,16 @@ type metadataDisableDomainTransferLockOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableDomainTransferLockOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableDomainTransferLockOutput) GoString() string {
+	return s.String()
+}
+
 type DomainSummary struct {
 	// Indicates whether the domain is automatically renewed upon expiration.
 	//
@@ -995,7 +1272,7 @@ type DomainSummary struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Expiration date of the domain in Coordinated Universal Time (UTC).
 	//
@@ -1017,8 +1294,18 @@ type metadataDomainSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DomainSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DomainSummary) GoString() string {
+	return s.String()
+}
+
 type EnableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataEnableDomainAutoRenewInput `json:"-" xml:"-"`
 }
@@ -1027,6 +1314,16 @@ type metadataEnableDomainAutoRenewInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableDomainAutoRenewInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableDomainAutoRenewInput) GoString() string {
+	return s.String()
+}
+
 type EnableDomainAutoRenewOutput struct {
 	metadataEnableDomainAutoRenewOutput `json:"-" xml:"-"`
 }
@@ -1035,6 +1332,16 @@ type metadataEnableDomainAutoRenewOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableDomainAutoRenewOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableDomainAutoRenewOutput) GoString() string {
+	return s.String()
+}
+
 // The EnableDomainTransferLock request includes the following element.
 type EnableDomainTransferLockInput struct {
 	// The name of a domain.
@@ -1048,7 +1355,7 @@ type EnableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataEnableDomainTransferLockInput `json:"-" xml:"-"`
 }
@@ -1057,6 +1364,16 @@ type metadataEnableDomainTransferLockInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableDomainTransferLockInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableDomainTransferLockInput) GoString() string {
+	return s.String()
+}
+
 // The EnableDomainTransferLock response includes the following elements.
 type EnableDomainTransferLockOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1067,7 +1384,7 @@ type EnableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataEnableDomainTransferLockOutput `json:"-" xml:"-"`
 }
@@ -1076,6 +1393,16 @@ type metadataEnableDomainTransferLockOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s EnableDomainTransferLockOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s EnableDomainTransferLockOutput) GoString() string {
+	return s.String()
+}
+
 // ExtraParam includes the following elements.
 type ExtraParam struct {
 	// Name of the additional parameter required by the top-level domain.
@@ -1093,7 +1420,9 @@ type ExtraParam struct {
 	// Parent: ExtraParams
 	//
 	// Required: Yes
-	Name *string `type:"string" required:"true"`
+	//
+	// See the ExtraParamName* constants for valid values.
+	Name *string `type:"string" enum:"DUNS_NUMBER,BRAND_NUMBER,BIRTH_DEPARTMENT,BIRTH_DATE_IN_YYYY_MM_DD,BIRTH_COUNTRY,BIRTH_CITY,DOCUMENT_NUMBER,AU_ID_NUMBER,AU_ID_TYPE,CA_LEGAL_TYPE,ES_IDENTIFICATION,ES_IDENTIFICATION_TYPE,ES_LEGAL_FORM,FI_BUSINESS_NUMBER,FI_ID_NUMBER,IT_PIN,RU_PASSPORT_DATA,SE_ID_NUMBER,SG_ID_NUMBER,VAT_NUMBER" required:"true"`
 
 	// Values corresponding to the additional parameter names required by some top-level
 	// domains.
@@ -1107,7 +1436,7 @@ type ExtraParam struct {
 	// Parent: ExtraParams
 	//
 	// Required: Yes
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"2048" required:"true"`
 
 	metadataExtraParam `json:"-" xml:"-"`
 }
@@ -1116,6 +1445,16 @@ type metadataExtraParam struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExtraParam) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExtraParam) GoString() string {
+	return s.String()
+}
+
 // The GetDomainDetail request includes the following element.
 type GetDomainDetailInput struct {
 	// The name of a domain.
@@ -1129,7 +1468,7 @@ type GetDomainDetailInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataGetDomainDetailInput `json:"-" xml:"-"`
 }
@@ -1138,6 +1477,16 @@ type metadataGetDomainDetailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDomainDetailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDomainDetailInput) GoString() string {
+	return s.String()
+}
+
 // The GetDomainDetail response includes the following elements.
 type GetDomainDetailOutput struct {
 	// Email address to contact to report incorrect contact information for a domain,
@@ -1145,12 +1494,12 @@ type GetDomainDetailOutput struct {
 	// is cybersquatting on a domain name, or report some other type of abuse.
 	//
 	// Type: String
-	AbuseContactEmail *string `type:"string"`
+	AbuseContactEmail *string `type:"string" max:"254"`
 
 	// Phone number for reporting abuse.
 	//
 	// Type: String
-	AbuseContactPhone *string `type:"string"`
+	AbuseContactPhone *string `type:"string" max:"30"`
 
 	// Provides details about the domain administrative contact.
 	//
@@ -1159,7 +1508,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the admin contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1184,7 +1533,7 @@ type GetDomainDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The date when the registration for the domain is set to expire. The date
 	// format is Unix time.
@@ -1202,7 +1551,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the registrant contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1257,7 +1606,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the tech contact is concealed from
 	// WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1284,6 +1633,16 @@ type metadataGetDomainDetailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDomainDetailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDomainDetailOutput) GoString() string {
+	return s.String()
+}
+
 // The GetOperationDetail request includes the following element.
 type GetOperationDetailInput struct {
 	// The identifier for the operation for which you want to get the status. Amazon
@@ -1294,7 +1653,7 @@ type GetOperationDetailInput struct {
 	// Default: None
 	//
 	// Required: Yes
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataGetOperationDetailInput `json:"-" xml:"-"`
 }
@@ -1303,12 +1662,22 @@ type metadataGetOperationDetailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOperationDetailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOperationDetailInput) GoString() string {
+	return s.String()
+}
+
 // The GetOperationDetail response includes the following elements.
 type GetOperationDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*"`
 
 	// Detailed information on the status including possible errors.
 	//
@@ -1318,12 +1687,14 @@ type GetOperationDetailOutput struct {
 	// The identifier for the operation.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255"`
 
 	// The current status of the requested operation in the system.
 	//
 	// Type: String
-	Status *string `type:"string"`
+	//
+	// See the OperationStatus* constants for valid values.
+	Status *string `type:"string" enum:"SUBMITTED,IN_PROGRESS,ERROR,SUCCESSFUL,FAILED"`
 
 	// The date when the request was submitted.
 	SubmittedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1331,7 +1702,9 @@ type GetOperationDetailOutput struct {
 	// The type of operation that was requested.
 	//
 	// Type: String
-	Type *string `type:"string"`
+	//
+	// See the OperationType* constants for valid values.
+	Type *string `type:"string" enum:"REGISTER_DOMAIN,DELETE_DOMAIN,TRANSFER_IN_DOMAIN,UPDATE_DOMAIN_CONTACT,UPDATE_NAMESERVER,CHANGE_PRIVACY_PROTECTION,DOMAIN_LOCK"`
 
 	metadataGetOperationDetailOutput `json:"-" xml:"-"`
 }
@@ -1340,6 +1713,16 @@ type metadataGetOperationDetailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetOperationDetailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetOperationDetailOutput) GoString() string {
+	return s.String()
+}
+
 // The ListDomains request includes the following elements.
 type ListDomainsInput struct {
 	// For an initial request for a list of domains, omit this element. If the number
@@ -1356,7 +1739,7 @@ type ListDomainsInput struct {
 	// Constraints: The marker must match the value specified in the previous request.
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1367,7 +1750,7 @@ type ListDomainsInput struct {
 	// Constraints: A numeral between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListDomainsInput `json:"-" xml:"-"`
 }
@@ -1376,6 +1759,16 @@ type metadataListDomainsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDomainsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDomainsInput) GoString() string {
+	return s.String()
+}
+
 // The ListDomains response includes the following elements.
 type ListDomainsOutput struct {
 	// A summary of domains.
@@ -1392,7 +1785,7 @@ type ListDomainsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	metadataListDomainsOutput `json:"-" xml:"-"`
 }
@@ -1401,6 +1794,16 @@ type metadataListDomainsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDomainsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDomainsOutput) GoString() string {
+	return s.String()
+}
+
 // The ListOperations request includes the following elements.
 type ListOperationsInput struct {
 	// For an initial request for a list of operations, omit this element. If the
@@ -1415,7 +1818,7 @@ type ListOperationsInput struct {
 	// Default: None
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1426,7 +1829,7 @@ type ListOperationsInput struct {
 	// Constraints: A value between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListOperationsInput `json:"-" xml:"-"`
 }
@@ -1435,6 +1838,16 @@ type metadataListOperationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListOperationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListOperationsInput) GoString() string {
+	return s.String()
+}
+
 // The ListOperations response includes the following elements.
 type ListOperationsOutput struct {
 	// If there are more operations than you specified for MaxItems in the request,
@@ -1444,7 +1857,7 @@ type ListOperationsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	// Lists summaries of the operations.
 	//
@@ -1460,10 +1873,20 @@ type metadataListOperationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListOperationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListOperationsOutput) GoString() string {
+	return s.String()
+}
+
 // The ListTagsForDomainRequest includes the following elements.
 type ListTagsForDomainInput struct {
 	// The domain for which you want to get a list of tags.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataListTagsForDomainInput `json:"-" xml:"-"`
 }
@@ -1472,6 +1895,16 @@ type metadataListTagsForDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForDomainInput) GoString() string {
+	return s.String()
+}
+
 // The ListTagsForDomain response includes the following elements.
 type ListTagsForDomainOutput struct {
 	// A list of the tags that are associated with the specified domain.
@@ -1500,6 +1933,16 @@ type metadataListTagsForDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTagsForDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTagsForDomainOutput) GoString() string {
+	return s.String()
+}
+
 // Nameserver includes the following elements.
 type Nameserver struct {
 	// Glue IP address of a name server entry. Glue IP addresses are required only
@@ -1521,7 +1964,7 @@ type Nameserver struct {
 	// Constraint: Maximum 255 characterss
 	//
 	// Parent: Nameservers
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataNameserver `json:"-" xml:"-"`
 }
@@ -1530,17 +1973,29 @@ type metadataNameserver struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Nameserver) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Nameserver) GoString() string {
+	return s.String()
+}
+
 // OperationSummary includes the following elements.
 type OperationSummary struct {
 	// Identifier returned to track the requested action.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	// The current status of the requested operation in the system.
 	//
 	// Type: String
-	Status *string `type:"string" required:"true"`
+	//
+	// See the OperationStatus* constants for valid values.
+	Status *string `type:"string" enum:"SUBMITTED,IN_PROGRESS,ERROR,SUCCESSFUL,FAILED" required:"true"`
 
 	// The date when the request was submitted.
 	SubmittedDate *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
@@ -1551,7 +2006,9 @@ type OperationSummary struct {
 	//
 	// Valid values: REGISTER_DOMAIN | DELETE_DOMAIN | TRANSFER_IN_DOMAIN | UPDATE_DOMAIN_CONTACT
 	// | UPDATE_NAMESERVER | CHANGE_PRIVACY_PROTECTION | DOMAIN_LOCK
-	Type *string `type:"string" required:"true"`
+	//
+	// See the OperationType* constants for valid values.
+	Type *string `type:"string" enum:"REGISTER_DOMAIN,DELETE_DOMAIN,TRANSFER_IN_DOMAIN,UPDATE_DOMAIN_CONTACT,UPDATE_NAMESERVER,CHANGE_PRIVACY_PROTECTION,DOMAIN_LOCK" required:"true"`
 
 	metadataOperationSummary `json:"-" xml:"-"`
 }
@@ -1560,6 +2017,16 @@ type metadataOperationSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s OperationSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s OperationSummary) GoString() string {
+	return s.String()
+}
+
 // The RegisterDomain request includes the following elements.
 type RegisterDomainInput struct {
 	// Provides detailed contact information.
@@ -1571,7 +2038,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -1596,7 +2063,7 @@ type RegisterDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -1608,10 +2075,10 @@ type RegisterDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -1664,7 +2131,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1675,7 +2142,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	metadataRegisterDomainInput `json:"-" xml:"-"`
 }
@@ -1684,6 +2151,16 @@ type metadataRegisterDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterDomainInput) GoString() string {
+	return s.String()
+}
+
 // The RegisterDomain response includes the following element.
 type RegisterDomainOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1694,7 +2171,7 @@ type RegisterDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataRegisterDomainOutput `json:"-" xml:"-"`
 }
@@ -1703,6 +2180,16 @@ type metadataRegisterDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterDomainOutput) GoString() string {
+	return s.String()
+}
+
 // The RetrieveDomainAuthCode request includes the following element.
 type RetrieveDomainAuthCodeInput struct {
 	// The name of a domain.
@@ -1716,7 +2203,7 @@ type RetrieveDomainAuthCodeInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataRetrieveDomainAuthCodeInput `json:"-" xml:"-"`
 }
@@ -1725,12 +2212,22 @@ type metadataRetrieveDomainAuthCodeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveDomainAuthCodeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveDomainAuthCodeInput) GoString() string {
+	return s.String()
+}
+
 // The RetrieveDomainAuthCode response includes the following element.
 type RetrieveDomainAuthCodeOutput struct {
 	// The authorization code for the domain.
 	//
 	// Type: String
-	AuthCode *string `type:"string" required:"true"`
+	AuthCode *string `type:"string" max:"1024" sensitive:"true" required:"true"`
 
 	metadataRetrieveDomainAuthCodeOutput `json:"-" xml:"-"`
 }
@@ -1739,6 +2236,16 @@ type metadataRetrieveDomainAuthCodeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveDomainAuthCodeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveDomainAuthCodeOutput) GoString() string {
+	return s.String()
+}
+
 // Each tag includes the following elements.
 type Tag struct {
 	// The key (name) of a tag.
@@ -1774,6 +2281,16 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tag) GoString() string {
+	return s.String()
+}
+
 // The TransferDomain request includes the following elements.
 type TransferDomainInput struct {
 	// Provides detailed contact information.
@@ -1785,7 +2302,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// The authorization code for the domain. You get this value from the current
 	// registrar.
@@ -1793,7 +2310,7 @@ type TransferDomainInput struct {
 	// Type: String
 	//
 	// Required: Yes
-	AuthCode *string `type:"string"`
+	AuthCode *string `type:"string" max:"1024" sensitive:"true"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -1818,7 +2335,7 @@ type TransferDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -1830,10 +2347,10 @@ type TransferDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Contains details for the host and glue IP addresses.
 	//
@@ -1895,7 +2412,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1906,7 +2423,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	metadataTransferDomainInput `json:"-" xml:"-"`
 }
@@ -1915,6 +2432,16 @@ type metadataTransferDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TransferDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TransferDomainInput) GoString() string {
+	return s.String()
+}
+
 // The TranserDomain response includes the following element.
 type TransferDomainOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1925,7 +2452,7 @@ type TransferDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataTransferDomainOutput `json:"-" xml:"-"`
 }
@@ -1934,6 +2461,16 @@ type metadataTransferDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TransferDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TransferDomainOutput) GoString() string {
+	return s.String()
+}
+
 // The UpdateDomainContact request includes the following elements.
 type UpdateDomainContactInput struct {
 	// Provides detailed contact information.
@@ -1945,7 +2482,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// The name of a domain.
 	//
@@ -1958,7 +2495,7 @@ type UpdateDomainContactInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1969,7 +2506,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1980,7 +2517,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	metadataUpdateDomainContactInput `json:"-" xml:"-"`
 }
@@ -1989,6 +2526,16 @@ type metadataUpdateDomainContactInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDomainContactInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDomainContactInput) GoString() string {
+	return s.String()
+}
+
 // The UpdateDomainContact response includes the following element.
 type UpdateDomainContactOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1999,7 +2546,7 @@ type UpdateDomainContactOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactOutput `json:"-" xml:"-"`
 }
@@ -2008,6 +2555,16 @@ type metadataUpdateDomainContactOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDomainContactOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDomainContactOutput) GoString() string {
+	return s.String()
+}
+
 // The UpdateDomainContactPrivacy request includes the following elements.
 type UpdateDomainContactPrivacyInput struct {
 	// Whether you want to conceal contact information from WHOIS queries. If you
@@ -2035,7 +2592,7 @@ type UpdateDomainContactPrivacyInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -2072,6 +2629,16 @@ type metadataUpdateDomainContactPrivacyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDomainContactPrivacyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDomainContactPrivacyInput) GoString() string {
+	return s.String()
+}
+
 // The UpdateDomainContactPrivacy response includes the following element.
 type UpdateDomainContactPrivacyOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -2082,7 +2649,7 @@ type UpdateDomainContactPrivacyOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactPrivacyOutput `json:"-" xml:"-"`
 }
@@ -2091,6 +2658,16 @@ type metadataUpdateDomainContactPrivacyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDomainContactPrivacyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDomainContactPrivacyOutput) GoString() string {
+	return s.String()
+}
+
 // The UpdateDomainNameserver request includes the following elements.
 type UpdateDomainNameserversInput struct {
 	// The name of a domain.
@@ -2104,7 +2681,7 @@ type UpdateDomainNameserversInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The authorization key for .fi domains
 	FIAuthKey *string `type:"string"`
@@ -2125,6 +2702,16 @@ type metadataUpdateDomainNameserversInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDomainNameserversInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDomainNameserversInput) GoString() string {
+	return s.String()
+}
+
 // The UpdateDomainNameservers response includes the following element.
 type UpdateDomainNameserversOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -2135,7 +2722,7 @@ type UpdateDomainNameserversOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainNameserversOutput `json:"-" xml:"-"`
 }
@@ -2144,6 +2731,16 @@ type metadataUpdateDomainNameserversOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateDomainNameserversOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateDomainNameserversOutput) GoString() string {
+	return s.String()
+}
+
 // The UpdateTagsForDomainRequest includes the following elements.
 type UpdateTagsForDomainInput struct {
 	// The domain for which you want to add or update tags.
@@ -2161,7 +2758,7 @@ type UpdateTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// A list of the tag keys and values that you want to add or update. If you
 	// specify a key that already exists, the corresponding value will be replaced.
@@ -2210,6 +2807,16 @@ type metadataUpdateTagsForDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateTagsForDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateTagsForDomainInput) GoString() string {
+	return s.String()
+}
+
 type UpdateTagsForDomainOutput struct {
 	metadataUpdateTagsForDomainOutput `json:"-" xml:"-"`
 }
@@ -2217,3 +2824,579 @@ type UpdateTagsForDomainOutput struct {
 type metadataUpdateTagsForDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateTagsForDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateTagsForDomainOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ContactType
+	ContactTypePerson = "PERSON"
+	// @enum ContactType
+	ContactTypeCompany = "COMPANY"
+	// @enum ContactType
+	ContactTypeAssociation = "ASSOCIATION"
+	// @enum ContactType
+	ContactTypePublicBody = "PUBLIC_BODY"
+	// @enum ContactType
+	ContactTypeReseller = "RESELLER"
+)
+
+const (
+	// @enum CountryCode
+	CountryCodeAd = "AD"
+	// @enum CountryCode
+	CountryCodeAe = "AE"
+	// @enum CountryCode
+	CountryCodeAf = "AF"
+	// @enum CountryCode
+	CountryCodeAg = "AG"
+	// @enum CountryCode
+	CountryCodeAi = "AI"
+	// @enum CountryCode
+	CountryCodeAl = "AL"
+	// @enum CountryCode
+	CountryCodeAm = "AM"
+	// @enum CountryCode
+	CountryCodeAn = "AN"
+	// @enum CountryCode
+	CountryCodeAo = "AO"
+	// @enum CountryCode
+	CountryCodeAq = "AQ"
+	// @enum CountryCode
+	CountryCodeAr = "AR"
+	// @enum CountryCode
+	CountryCodeAs = "AS"
+	// @enum CountryCode
+	CountryCodeAt = "AT"
+	// @enum CountryCode
+	CountryCodeAu = "AU"
+	// @enum CountryCode
+	CountryCodeAw = "AW"
+	// @enum CountryCode
+	CountryCodeAz = "AZ"
+	// @enum CountryCode
+	CountryCodeBa = "BA"
+	// @enum CountryCode
+	CountryCodeBb = "BB"
+	// @enum CountryCode
+	CountryCodeBd = "BD"
+	// @enum CountryCode
+	CountryCodeBe = "BE"
+	// @enum CountryCode
+	CountryCodeBf = "BF"
+	// @enum CountryCode
+	CountryCodeBg = "BG"
+	// @enum CountryCode
+	CountryCodeBh = "BH"
+	// @enum CountryCode
+	CountryCodeBi = "BI"
+	// @enum CountryCode
+	CountryCodeBj = "BJ"
+	// @enum CountryCode
+	CountryCodeBl = "BL"
+	// @enum CountryCode
+	CountryCodeBm = "BM"
+	// @enum CountryCode
+	CountryCodeBn = "BN"
+	// @enum CountryCode
+	CountryCodeBo = "BO"
+	// @enum CountryCode
+	CountryCodeBr = "BR"
+	// @enum CountryCode
+	CountryCodeBs = "BS"
+	// @enum CountryCode
+	CountryCodeBt = "BT"
+	// @enum CountryCode
+	CountryCodeBw = "BW"
+	// @enum CountryCode
+	CountryCodeBy = "BY"
+	// @enum CountryCode
+	CountryCodeBz = "BZ"
+	// @enum CountryCode
+	CountryCodeCa = "CA"
+	// @enum CountryCode
+	CountryCodeCc = "CC"
+	// @enum CountryCode
+	CountryCodeCd = "CD"
+	// @enum CountryCode
+	CountryCodeCf = "CF"
+	// @enum CountryCode
+	CountryCodeCg = "CG"
+	// @enum CountryCode
+	CountryCodeCh = "CH"
+	// @enum CountryCode
+	CountryCodeCi = "CI"
+	// @enum CountryCode
+	CountryCodeCk = "CK"
+	// @enum CountryCode
+	CountryCodeCl = "CL"
+	// @enum CountryCode
+	CountryCodeCm = "CM"
+	// @enum CountryCode
+	CountryCodeCn = "CN"
+	// @enum CountryCode
+	CountryCodeCo = "CO"
+	// @enum CountryCode
+	CountryCodeCr = "CR"
+	// @enum CountryCode
+	CountryCodeCu = "CU"
+	// @enum CountryCode
+	CountryCodeCv = "CV"
+	// @enum CountryCode
+	CountryCodeCx = "CX"
+	// @enum CountryCode
+	CountryCodeCy = "CY"
+	// @enum CountryCode
+	CountryCodeCz = "CZ"
+	// @enum CountryCode
+	CountryCodeDe = "DE"
+	// @enum CountryCode
+	CountryCodeDj = "DJ"
+	// @enum CountryCode
+	CountryCodeDk = "DK"
+	// @enum CountryCode
+	CountryCodeDm = "DM"
+	// @enum CountryCode
+	CountryCodeDo = "DO"
+	// @enum CountryCode
+	CountryCodeDz = "DZ"
+	// @enum CountryCode
+	CountryCodeEc = "EC"
+	// @enum CountryCode
+	CountryCodeEe = "EE"
+	// @enum CountryCode
+	CountryCodeEg = "EG"
+	// @enum CountryCode
+	CountryCodeEr = "ER"
+	// @enum CountryCode
+	CountryCodeEs = "ES"
+	// @enum CountryCode
+	CountryCodeEt = "ET"
+	// @enum CountryCode
+	CountryCodeFi = "FI"
+	// @enum CountryCode
+	CountryCodeFj = "FJ"
+	// @enum CountryCode
+	CountryCodeFk = "FK"
+	// @enum CountryCode
+	CountryCodeFm = "FM"
+	// @enum CountryCode
+	CountryCodeFo = "FO"
+	// @enum CountryCode
+	CountryCodeFr = "FR"
+	// @enum CountryCode
+	CountryCodeGa = "GA"
+	// @enum CountryCode
+	CountryCodeGb = "GB"
+	// @enum CountryCode
+	CountryCodeGd = "GD"
+	// @enum CountryCode
+	CountryCodeGe = "GE"
+	// @enum CountryCode
+	CountryCodeGh = "GH"
+	// @enum CountryCode
+	CountryCodeGi = "GI"
+	// @enum CountryCode
+	CountryCodeGl = "GL"
+	// @enum CountryCode
+	CountryCodeGm = "GM"
+	// @enum CountryCode
+	CountryCodeGn = "GN"
+	// @enum CountryCode
+	CountryCodeGq = "GQ"
+	// @enum CountryCode
+	CountryCodeGr = "GR"
+	// @enum CountryCode
+	CountryCodeGt = "GT"
+	// @enum CountryCode
+	CountryCodeGu = "GU"
+	// @enum CountryCode
+	CountryCodeGw = "GW"
+	// @enum CountryCode
+	CountryCodeGy = "GY"
+	// @enum CountryCode
+	CountryCodeHk = "HK"
+	// @enum CountryCode
+	CountryCodeHn = "HN"
+	// @enum CountryCode
+	CountryCodeHr = "HR"
+	// @enum CountryCode
+	CountryCodeHt = "HT"
+	// @enum CountryCode
+	CountryCodeHu = "HU"
+	// @enum CountryCode
+	CountryCodeId = "ID"
+	// @enum CountryCode
+	CountryCodeIe = "IE"
+	// @enum CountryCode
+	CountryCodeIl = "IL"
+	// @enum CountryCode
+	CountryCodeIm = "IM"
+	// @enum CountryCode
+	CountryCodeIn = "IN"
+	// @enum CountryCode
+	CountryCodeIq = "IQ"
+	// @enum CountryCode
+	CountryCodeIr = "IR"
+	// @enum CountryCode
+	CountryCodeIs = "IS"
+	// @enum CountryCode
+	CountryCodeIt = "IT"
+	// @enum CountryCode
+	CountryCodeJm = "JM"
+	// @enum CountryCode
+	CountryCodeJo = "JO"
+	// @enum CountryCode
+	CountryCodeJp = "JP"
+	// @enum CountryCode
+	CountryCodeKe = "KE"
+	// @enum CountryCode
+	CountryCodeKg = "KG"
+	// @enum CountryCode
+	CountryCodeKh = "KH"
+	// @enum CountryCode
+	CountryCodeKi = "KI"
+	// @enum CountryCode
+	CountryCodeKm = "KM"
+	// @enum CountryCode
+	CountryCodeKn = "KN"
+	// @enum CountryCode
+	CountryCodeKp = "KP"
+	// @enum CountryCode
+	CountryCodeKr = "KR"
+	// @enum CountryCode
+	CountryCodeKw = "KW"
+	// @enum CountryCode
+	CountryCodeKy = "KY"
+	// @enum CountryCode
+	CountryCodeKz = "KZ"
+	// @enum CountryCode
+	CountryCodeLa = "LA"
+	// @enum CountryCode
+	CountryCodeLb = "LB"
+	// @enum CountryCode
+	CountryCodeLc = "LC"
+	// @enum CountryCode
+	CountryCodeLi = "LI"
+	// @enum CountryCode
+	CountryCodeLk = "LK"
+	// @enum CountryCode
+	CountryCodeLr = "LR"
+	// @enum CountryCode
+	CountryCodeLs = "LS"
+	// @enum CountryCode
+	CountryCodeLt = "LT"
+	// @enum CountryCode
+	CountryCodeLu = "LU"
+	// @enum CountryCode
+	CountryCodeLv = "LV"
+	// @enum CountryCode
+	CountryCodeLy = "LY"
+	// @enum CountryCode
+	CountryCodeMa = "MA"
+	// @enum CountryCode
+	CountryCodeMc = "MC"
+	// @enum CountryCode
+	CountryCodeMd = "MD"
+	// @enum CountryCode
+	CountryCodeMe = "ME"
+	// @enum CountryCode
+	CountryCodeMf = "MF"
+	// @enum CountryCode
+	CountryCodeMg = "MG"
+	// @enum CountryCode
+	CountryCodeMh = "MH"
+	// @enum CountryCode
+	CountryCodeMk = "MK"
+	// @enum CountryCode
+	CountryCodeMl = "ML"
+	// @enum CountryCode
+	CountryCodeMm = "MM"
+	// @enum CountryCode
+	CountryCodeMn = "MN"
+	// @enum CountryCode
+	CountryCodeMo = "MO"
+	// @enum CountryCode
+	CountryCodeMp = "MP"
+	// @enum CountryCode
+	CountryCodeMr = "MR"
+	// @enum CountryCode
+	CountryCodeMs = "MS"
+	// @enum CountryCode
+	CountryCodeMt = "MT"
+	// @enum CountryCode
+	CountryCodeMu = "MU"
+	// @enum CountryCode
+	CountryCodeMv = "MV"
+	// @enum CountryCode
+	CountryCodeMw = "MW"
+	// @enum CountryCode
+	CountryCodeMx = "MX"
+	// @enum CountryCode
+	CountryCodeMy = "MY"
+	// @enum CountryCode
+	CountryCodeMz = "MZ"
+	// @enum CountryCode
+	CountryCodeNa = "NA"
+	// @enum CountryCode
+	CountryCodeNc = "NC"
+	// @enum CountryCode
+	CountryCodeNe = "NE"
+	// @enum CountryCode
+	CountryCodeNg = "NG"
+	// @enum CountryCode
+	CountryCodeNi = "NI"
+	// @enum CountryCode
+	CountryCodeNl = "NL"
+	// @enum CountryCode
+	CountryCodeNo = "NO"
+	// @enum CountryCode
+	CountryCodeNp = "NP"
+	// @enum CountryCode
+	CountryCodeNr = "NR"
+	// @enum CountryCode
+	CountryCodeNu = "NU"
+	// @enum CountryCode
+	CountryCodeNz = "NZ"
+	// @enum CountryCode
+	CountryCodeOm = "OM"
+	// @enum CountryCode
+	CountryCodePa = "PA"
+	// @enum CountryCode
+	CountryCodePe = "PE"
+	// @enum CountryCode
+	CountryCodePf = "PF"
+	// @enum CountryCode
+	CountryCodePg = "PG"
+	// @enum CountryCode
+	CountryCodePh = "PH"
+	// @enum CountryCode
+	CountryCodePk = "PK"
+	// @enum CountryCode
+	CountryCodePl = "PL"
+	// @enum CountryCode
+	CountryCodePm = "PM"
+	// @enum CountryCode
+	CountryCodePn = "PN"
+	// @enum CountryCode
+	CountryCodePr = "PR"
+	// @enum CountryCode
+	CountryCodePt = "PT"
+	// @enum CountryCode
+	CountryCodePw = "PW"
+	// @enum CountryCode
+	CountryCodePy = "PY"
+	// @enum CountryCode
+	CountryCodeQa = "QA"
+	// @enum CountryCode
+	CountryCodeRo = "RO"
+	// @enum CountryCode
+	CountryCodeRs = "RS"
+	// @enum CountryCode
+	CountryCodeRu = "RU"
+	// @enum CountryCode
+	CountryCodeRw = "RW"
+	// @enum CountryCode
+	CountryCodeSa = "SA"
+	// @enum CountryCode
+	CountryCodeSb = "SB"
+	// @enum CountryCode
+	CountryCodeSc = "SC"
+	// @enum CountryCode
+	CountryCodeSd = "SD"
+	// @enum CountryCode
+	CountryCodeSe = "SE"
+	// @enum CountryCode
+	CountryCodeSg = "SG"
+	// @enum CountryCode
+	CountryCodeSh = "SH"
+	// @enum CountryCode
+	CountryCodeSi = "SI"
+	// @enum CountryCode
+	CountryCodeSk = "SK"
+	// @enum CountryCode
+	CountryCodeSl = "SL"
+	// @enum CountryCode
+	CountryCodeSm = "SM"
+	// @enum CountryCode
+	CountryCodeSn = "SN"
+	// @enum CountryCode
+	CountryCodeSo = "SO"
+	// @enum CountryCode
+	CountryCodeSr = "SR"
+	// @enum CountryCode
+	CountryCodeSt = "ST"
+	// @enum CountryCode
+	CountryCodeSv = "SV"
+	// @enum CountryCode
+	CountryCodeSy = "SY"
+	// @enum CountryCode
+	CountryCodeSz = "SZ"
+	// @enum CountryCode
+	CountryCodeTc = "TC"
+	// @enum CountryCode
+	CountryCodeTd = "TD"
+	// @enum CountryCode
+	CountryCodeTg = "TG"
+	// @enum CountryCode
+	CountryCodeTh = "TH"
+	// @enum CountryCode
+	CountryCodeTj = "TJ"
+	// @enum CountryCode
+	CountryCodeTk = "TK"
+	// @enum CountryCode
+	CountryCodeTl = "TL"
+	// @enum CountryCode
+	CountryCodeTm = "TM"
+	// @enum CountryCode
+	CountryCodeTn = "TN"
+	// @enum CountryCode
+	CountryCodeTo = "TO"
+	// @enum CountryCode
+	CountryCodeTr = "TR"
+	// @enum CountryCode
+	CountryCodeTt = "TT"
+	// @enum CountryCode
+	CountryCodeTv = "TV"
+	// @enum CountryCode
+	CountryCodeTw = "TW"
+	// @enum CountryCode
+	CountryCodeTz = "TZ"
+	// @enum CountryCode
+	CountryCodeUa = "UA"
+	// @enum CountryCode
+	CountryCodeUg = "UG"
+	// @enum CountryCode
+	CountryCodeUs = "US"
+	// @enum CountryCode
+	CountryCodeUy = "UY"
+	// @enum CountryCode
+	CountryCodeUz = "UZ"
+	// @enum CountryCode
+	CountryCodeVa = "VA"
+	// @enum CountryCode
+	CountryCodeVc = "VC"
+	// @enum CountryCode
+	CountryCodeVe = "VE"
+	// @enum CountryCode
+	CountryCodeVg = "VG"
+	// @enum CountryCode
+	CountryCodeVi = "VI"
+	// @enum CountryCode
+	CountryCodeVn = "VN"
+	// @enum CountryCode
+	CountryCodeVu = "VU"
+	// @enum CountryCode
+	CountryCodeWf = "WF"
+	// @enum CountryCode
+	CountryCodeWs = "WS"
+	// @enum CountryCode
+	CountryCodeYe = "YE"
+	// @enum CountryCode
+	CountryCodeYt = "YT"
+	// @enum CountryCode
+	CountryCodeZa = "ZA"
+	// @enum CountryCode
+	CountryCodeZm = "ZM"
+	// @enum CountryCode
+	CountryCodeZw = "ZW"
+)
+
+const (
+	// @enum DomainAvailability
+	DomainAvailabilityAvailable = "AVAILABLE"
+	// @enum DomainAvailability
+	DomainAvailabilityAvailableReserved = "AVAILABLE_RESERVED"
+	// @enum DomainAvailability
+	DomainAvailabilityAvailablePreorder = "AVAILABLE_PREORDER"
+	// @enum DomainAvailability
+	DomainAvailabilityUnavailable = "UNAVAILABLE"
+	// @enum DomainAvailability
+	DomainAvailabilityUnavailablePremium = "UNAVAILABLE_PREMIUM"
+	// @enum DomainAvailability
+	DomainAvailabilityUnavailableRestricted = "UNAVAILABLE_RESTRICTED"
+	// @enum DomainAvailability
+	DomainAvailabilityReserved = "RESERVED"
+	// @enum DomainAvailability
+	DomainAvailabilityDontKnow = "DONT_KNOW"
+)
+
+const (
+	// @enum ExtraParamName
+	ExtraParamNameDunsNumber = "DUNS_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameBrandNumber = "BRAND_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameBirthDepartment = "BIRTH_DEPARTMENT"
+	// @enum ExtraParamName
+	ExtraParamNameBirthDateInYyyyMmDd = "BIRTH_DATE_IN_YYYY_MM_DD"
+	// @enum ExtraParamName
+	ExtraParamNameBirthCountry = "BIRTH_COUNTRY"
+	// @enum ExtraParamName
+	ExtraParamNameBirthCity = "BIRTH_CITY"
+	// @enum ExtraParamName
+	ExtraParamNameDocumentNumber = "DOCUMENT_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameAuIdNumber = "AU_ID_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameAuIdType = "AU_ID_TYPE"
+	// @enum ExtraParamName
+	ExtraParamNameCaLegalType = "CA_LEGAL_TYPE"
+	// @enum ExtraParamName
+	ExtraParamNameEsIdentification = "ES_IDENTIFICATION"
+	// @enum ExtraParamName
+	ExtraParamNameEsIdentificationType = "ES_IDENTIFICATION_TYPE"
+	// @enum ExtraParamName
+	ExtraParamNameEsLegalForm = "ES_LEGAL_FORM"
+	// @enum ExtraParamName
+	ExtraParamNameFiBusinessNumber = "FI_BUSINESS_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameFiIdNumber = "FI_ID_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameItPin = "IT_PIN"
+	// @enum ExtraParamName
+	ExtraParamNameRuPassportData = "RU_PASSPORT_DATA"
+	// @enum ExtraParamName
+	ExtraParamNameSeIdNumber = "SE_ID_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameSgIdNumber = "SG_ID_NUMBER"
+	// @enum ExtraParamName
+	ExtraParamNameVatNumber = "VAT_NUMBER"
+)
+
+const (
+	// @enum OperationStatus
+	OperationStatusSubmitted = "SUBMITTED"
+	// @enum OperationStatus
+	OperationStatusInProgress = "IN_PROGRESS"
+	// @enum OperationStatus
+	OperationStatusError = "ERROR"
+	// @enum OperationStatus
+	OperationStatusSuccessful = "SUCCESSFUL"
+	// @enum OperationStatus
+	OperationStatusFailed = "FAILED"
+)
+
+const (
+	// @enum OperationType
+	OperationTypeRegisterDomain = "REGISTER_DOMAIN"
+	// @enum OperationType
+	OperationTypeDeleteDomain = "DELETE_DOMAIN"
+	// @enum OperationType
+	OperationTypeTransferInDomain = "TRANSFER_IN_DOMAIN"
+	// @enum OperationType
+	OperationTypeUpdateDomainContact = "UPDATE_DOMAIN_CONTACT"
+	// @enum OperationType
+	OperationTypeUpdateNameserver = "UPDATE_NAMESERVER"
+	// @enum OperationType
+	OperationTypeChangePrivacyProtection = "CHANGE_PRIVACY_PROTECTION"
+	// @enum OperationType
+	OperationTypeDomainLock = "DOMAIN_LOCK"
+)
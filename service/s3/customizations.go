@@ -1,32 +1,59 @@
 package s3
 
-import "github.com/aws/aws-sdk-go/aws"
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/protocol/restxml"
+	"github.com/aws/aws-sdk-go/internal/signer/v4"
+)
+
+// Named request handlers of the S3 customizations. These can be used to
+// remove, or swap out, an individual customization from a service's or
+// request's handler lists.
+var (
+	UpdateHostWithBucketHandler       = aws.NamedHandler{Name: "s3.UpdateHostWithBucketHandler", Fn: updateHostWithBucket}
+	ValidateSSERequiresSSLHandler     = aws.NamedHandler{Name: "s3.ValidateSSERequiresSSLHandler", Fn: validateSSERequiresSSL}
+	ComputeSSEKeysHandler             = aws.NamedHandler{Name: "s3.ComputeSSEKeysHandler", Fn: computeSSEKeys}
+	UnmarshalErrorHandler             = aws.NamedHandler{Name: "s3.UnmarshalErrorHandler", Fn: unmarshalError}
+	ContentMD5Handler                 = aws.NamedHandler{Name: "s3.ContentMD5Handler", Fn: contentMD5}
+	BuildGetBucketLocationHandler     = aws.NamedHandler{Name: "s3.BuildGetBucketLocationHandler", Fn: buildGetBucketLocation}
+	PopulateLocationConstraintHandler = aws.NamedHandler{Name: "s3.PopulateLocationConstraintHandler", Fn: populateLocationConstraint}
+	SignLegacyHandler                 = aws.NamedHandler{Name: "s3.SignLegacyHandler", Fn: signLegacy}
+	BuildStreamingBodyHandler         = aws.NamedHandler{Name: "s3.BuildStreamingBodyHandler", Fn: buildStreamingBody}
+	DisableStreamingRetryHandler      = aws.NamedHandler{Name: "s3.DisableStreamingRetryHandler", Fn: disableStreamingRetry}
+)
 
 func init() {
 	initService = func(s *aws.Service) {
 		// Support building custom host-style bucket endpoints
-		s.Handlers.Build.PushFront(updateHostWithBucket)
+		s.Handlers.Build.PushFrontNamed(UpdateHostWithBucketHandler)
 
 		// Require SSL when using SSE keys
-		s.Handlers.Validate.PushBack(validateSSERequiresSSL)
-		s.Handlers.Build.PushBack(computeSSEKeys)
+		s.Handlers.Validate.PushBackNamed(ValidateSSERequiresSSLHandler)
+		s.Handlers.Build.PushBackNamed(ComputeSSEKeysHandler)
 
 		// S3 uses custom error unmarshaling logic
-		s.Handlers.UnmarshalError.Clear()
-		s.Handlers.UnmarshalError.PushBack(unmarshalError)
+		s.Handlers.UnmarshalError.Swap(restxml.UnmarshalErrorHandler.Name, UnmarshalErrorHandler)
+
+		// Sign with the legacy S3 signature if selected
+		if aws.BooleanValue(s.Config.S3UseLegacySignature) {
+			s.Handlers.Sign.Swap(v4.SignRequestHandler.Name, SignLegacyHandler)
+		}
 	}
 
 	initRequest = func(r *aws.Request) {
 		switch r.Operation.Name {
 		case opPutBucketCORS, opPutBucketLifecycle, opPutBucketPolicy, opPutBucketTagging, opDeleteObjects:
 			// These S3 operations require Content-MD5 to be set
-			r.Handlers.Build.PushBack(contentMD5)
+			r.Handlers.Build.PushBackNamed(ContentMD5Handler)
 		case opGetBucketLocation:
 			// GetBucketLocation has custom parsing logic
-			r.Handlers.Unmarshal.PushFront(buildGetBucketLocation)
+			r.Handlers.Unmarshal.PushFrontNamed(BuildGetBucketLocationHandler)
 		case opCreateBucket:
 			// Auto-populate LocationConstraint with current region
-			r.Handlers.Validate.PushFront(populateLocationConstraint)
+			r.Handlers.Validate.PushFrontNamed(PopulateLocationConstraintHandler)
+		case opPutObject, opUploadPart:
+			// Stream bodies which cannot be seeked as signed chunks
+			r.Handlers.Build.PushBackNamed(BuildStreamingBodyHandler)
 		}
 	}
 }
@@ -23,7 +23,7 @@ func dnsCompatibleBucketName(bucket string) bool {
 // the host. This is false if S3ForcePathStyle is explicitly set or if the
 // bucket is not DNS compatible.
 func hostStyleBucketName(r *aws.Request, bucket string) bool {
-	if r.Config.S3ForcePathStyle {
+	if aws.BooleanValue(r.Config.S3ForcePathStyle) {
 		return false
 	}
 
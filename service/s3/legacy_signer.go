@@ -0,0 +1,191 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// legacySubresources are the query parameters which identify a subresource,
+// or override a response header, and are part of the canonicalized resource
+// of the legacy S3 signature.
+var legacySubresources = map[string]bool{
+	"acl":                          true,
+	"cors":                         true,
+	"delete":                       true,
+	"lifecycle":                    true,
+	"location":                     true,
+	"logging":                      true,
+	"notification":                 true,
+	"partNumber":                   true,
+	"policy":                       true,
+	"requestPayment":               true,
+	"response-cache-control":       true,
+	"response-content-disposition": true,
+	"response-content-encoding":    true,
+	"response-content-language":    true,
+	"response-content-type":        true,
+	"response-expires":             true,
+	"restore":                      true,
+	"tagging":                      true,
+	"torrent":                      true,
+	"uploadId":                     true,
+	"uploads":                      true,
+	"versionId":                    true,
+	"versioning":                   true,
+	"versions":                     true,
+	"website":                      true,
+}
+
+// legacyPresignParams are the query parameters added to presigned URLs by
+// the legacy S3 signature.
+var legacyPresignParams = []string{"AWSAccessKeyId", "Expires", "Signature", "x-amz-security-token"}
+
+// signLegacy signs the request with the legacy HMAC-SHA1 S3 signature. The
+// signature is added to the Authorization header, or to the URL query if the
+// request is presigned.
+func signLegacy(r *aws.Request) {
+	if r.Config.Credentials == credentials.AnonymousCredentials {
+		return
+	}
+
+	creds, err := r.Config.Credentials.Get()
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	req := r.HTTPRequest
+	query := req.URL.Query()
+	for _, k := range legacyPresignParams {
+		query.Del(k)
+	}
+
+	amzHeaders := map[string][]string{}
+	for k, v := range req.Header {
+		if k = strings.ToLower(k); strings.HasPrefix(k, "x-amz-") {
+			amzHeaders[k] = v
+		}
+	}
+
+	var date string
+	if r.ExpireTime != 0 {
+		date = strconv.FormatInt(r.Time.Add(r.ExpireTime).Unix(), 10)
+		if creds.SessionToken != "" {
+			amzHeaders["x-amz-security-token"] = []string{creds.SessionToken}
+		}
+	} else {
+		req.Header.Set("Date", r.Time.UTC().Format(http.TimeFormat))
+		date = req.Header.Get("Date")
+		if creds.SessionToken != "" {
+			req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+			amzHeaders["x-amz-security-token"] = []string{creds.SessionToken}
+		}
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		date,
+		legacyCanonicalAmzHeaders(amzHeaders) + legacyCanonicalResource(r, query),
+	}, "\n")
+
+	hash := hmac.New(sha1.New, []byte(creds.SecretAccessKey))
+	hash.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(hash.Sum(nil))
+
+	if r.ExpireTime != 0 {
+		query.Set("AWSAccessKeyId", creds.AccessKeyID)
+		query.Set("Expires", date)
+		query.Set("Signature", signature)
+		if creds.SessionToken != "" {
+			query.Set("x-amz-security-token", creds.SessionToken)
+		}
+	} else {
+		req.Header.Set("Authorization", "AWS "+creds.AccessKeyID+":"+signature)
+	}
+	req.URL.RawQuery = strings.Replace(query.Encode(), "+", "%20", -1)
+	r.SignedHeaderVals = legacySignedHeaders(req.Header)
+
+	if r.Config.LogLevel.Value().Matches(aws.LogSigning) {
+		r.Config.Logger.Log("DEBUG: Request Signature:\n---[ STRING TO SIGN ]--------------------------------\n" +
+			stringToSign + "\n-----------------------------------------------------")
+	}
+}
+
+// legacySignedHeaders returns the values of the request's headers which are
+// part of its legacy signature.
+func legacySignedHeaders(header http.Header) http.Header {
+	signed := http.Header{}
+	for k, v := range header {
+		switch k = http.CanonicalHeaderKey(k); {
+		case k == "Content-Md5", k == "Content-Type", k == "Date", strings.HasPrefix(k, "X-Amz-"):
+			signed[k] = append([]string(nil), v...)
+		}
+	}
+	return signed
+}
+
+// legacyCanonicalAmzHeaders returns the sorted x-amz-* headers, one per
+// line, with their values joined by commas.
+func legacyCanonicalAmzHeaders(headers map[string][]string) string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf []string
+	for _, k := range keys {
+		buf = append(buf, k+":"+strings.Join(headers[k], ",")+"\n")
+	}
+	return strings.Join(buf, "")
+}
+
+// legacyCanonicalResource returns the request's path, prefixed with the
+// bucket if it is in the host, followed by its subresources sorted by name.
+func legacyCanonicalResource(r *aws.Request, query url.Values) string {
+	uri := r.HTTPRequest.URL.Opaque
+	if uri != "" {
+		uri = "/" + strings.Join(strings.Split(uri, "/")[3:], "/")
+	} else {
+		uri = r.HTTPRequest.URL.Path
+	}
+	if uri == "" {
+		uri = "/"
+	}
+
+	if b := awsutil.ValuesAtPath(r.Params, "Bucket"); len(b) > 0 {
+		if bucket := b[0].(string); bucket != "" && hostStyleBucketName(r, bucket) {
+			uri = "/" + bucket + uri
+		}
+	}
+
+	var subresources []string
+	for k := range query {
+		if legacySubresources[k] {
+			subresources = append(subresources, k)
+		}
+	}
+	if len(subresources) == 0 {
+		return uri
+	}
+	sort.Strings(subresources)
+
+	for i, k := range subresources {
+		if v := query.Get(k); v != "" {
+			subresources[i] = k + "=" + v
+		}
+	}
+	return uri + "?" + strings.Join(subresources, "&")
+}
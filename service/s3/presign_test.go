@@ -0,0 +1,103 @@
+package s3_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresignRequestSignedHeaders(t *testing.T) {
+	svc := s3.New(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithCredentials(credentials.NewStaticCredentials("AKID", "SECRET", "")))
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("key"),
+		ACL:                  aws.String("public-read"),
+		ContentType:          aws.String("text/plain"),
+		SSECustomerKey:       aws.String("12345678901234567890123456789012"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+	})
+
+	urlstr, header, err := req.PresignRequest(5 * time.Minute)
+	assert.NoError(t, err)
+
+	u, _ := url.Parse(urlstr)
+	assert.Equal(t, "content-type;host;x-amz-acl;x-amz-server-side-encryption-customer-algorithm;"+
+		"x-amz-server-side-encryption-customer-key;x-amz-server-side-encryption-customer-key-md5",
+		u.Query().Get("X-Amz-SignedHeaders"))
+	assert.NotEmpty(t, u.Query().Get("X-Amz-Signature"))
+
+	assert.Equal(t, http.Header{
+		"Content-Type": []string{"text/plain"},
+		"X-Amz-Acl":    []string{"public-read"},
+		"X-Amz-Server-Side-Encryption-Customer-Algorithm": []string{"AES256"},
+		"X-Amz-Server-Side-Encryption-Customer-Key":       []string{"MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI="},
+		"X-Amz-Server-Side-Encryption-Customer-Key-Md5":   []string{"dnF5x6K/8ZZRzpfSlMMM+w=="},
+	}, header)
+}
+
+func TestPresignRequestHeaderParameters(t *testing.T) {
+	svc := s3.New(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithCredentials(credentials.NewStaticCredentials("AKID", "SECRET", "")))
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:             aws.String("bucket"),
+		Key:                aws.String("key"),
+		CacheControl:       aws.String("max-age=60"),
+		ContentDisposition: aws.String("attachment"),
+	})
+	req.HTTPRequest.Header.Set("Content-MD5", "1B2M2Y8AsgTpgAmY7PhCfg==")
+
+	urlstr, header, err := req.PresignRequest(5 * time.Minute)
+	assert.NoError(t, err)
+
+	u, _ := url.Parse(urlstr)
+	assert.Equal(t, "cache-control;content-disposition;content-md5;host",
+		u.Query().Get("X-Amz-SignedHeaders"))
+	assert.Empty(t, u.Query().Get("Cache-Control"), "Expect headers to not be moved into the query")
+	assert.Empty(t, u.Query().Get("Content-Md5"), "Expect headers to not be moved into the query")
+
+	assert.Equal(t, http.Header{
+		"Cache-Control":       []string{"max-age=60"},
+		"Content-Disposition": []string{"attachment"},
+		"Content-Md5":         []string{"1B2M2Y8AsgTpgAmY7PhCfg=="},
+	}, header)
+}
+
+func TestPresignRequestNoSignedHeaders(t *testing.T) {
+	svc := s3.New(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithCredentials(credentials.NewStaticCredentials("AKID", "SECRET", "")))
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+
+	urlstr, header, err := req.PresignRequest(5 * time.Minute)
+	assert.NoError(t, err)
+	assert.Contains(t, urlstr, "X-Amz-SignedHeaders=host")
+	assert.Equal(t, http.Header{}, header)
+}
+
+func TestPresignRequestLegacySignedHeaders(t *testing.T) {
+	svc := newLegacySigningService("", true)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:      aws.String("bucket"),
+		Key:         aws.String("key"),
+		ACL:         aws.String("public-read"),
+		ContentType: aws.String("text/plain"),
+	})
+
+	urlstr, header, err := req.PresignRequest(5 * time.Minute)
+	assert.NoError(t, err)
+	assert.Contains(t, urlstr, "Signature=")
+	assert.Equal(t, "public-read", header.Get("X-Amz-Acl"))
+	assert.Equal(t, "text/plain", header.Get("Content-Type"))
+}
@@ -0,0 +1,42 @@
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// buildStreamingBody sets up PutObject and UploadPart requests whose body
+// cannot be seeked, such as a body wrapped with aws.ReadSeekCloser, to be
+// streamed as chunks which are signed as they are sent. The body is not
+// read before the request is sent, so its ContentLength must be set.
+//
+// A streamed body can only be read once, so the request is not retried.
+func buildStreamingBody(r *aws.Request) {
+	body, ok := r.Body.(aws.ReaderSeekerCloser)
+	if !ok || body.IsSeeker() || r.ExpireTime != 0 {
+		return
+	}
+	if aws.BooleanValue(r.Config.S3UseLegacySignature) {
+		// The legacy signature does not sign the body.
+		return
+	}
+
+	length := r.HTTPRequest.Header.Get("Content-Length")
+	if length == "" {
+		r.Error = awserr.New("MissingContentLength",
+			"ContentLength must be set to stream a body which cannot be seeked", nil)
+		return
+	}
+
+	r.HTTPRequest.Header.Set("X-Amz-Decoded-Content-Length", length)
+	r.HTTPRequest.Header.Set("X-Amz-Content-Sha256", v4.StreamingPayload)
+
+	r.Handlers.Retry.PushBackNamed(DisableStreamingRetryHandler)
+}
+
+// disableStreamingRetry prevents a request with a streamed body from being
+// retried, since its body has already been read.
+func disableStreamingRetry(r *aws.Request) {
+	r.Retryable.Set(false)
+}
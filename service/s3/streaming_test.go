@@ -0,0 +1,97 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStreamingService(status int, sent *[]byte) *s3.S3 {
+	svc := s3.New(aws.NewConfig().
+		WithRegion("us-east-1").
+		WithCredentials(credentials.NewStaticCredentials("AKID", "SECRET", "")))
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		*sent, _ = ioutil.ReadAll(r.HTTPRequest.Body)
+		r.HTTPResponse = &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}
+	})
+	return svc
+}
+
+func TestPutObjectStreamingBody(t *testing.T) {
+	var sent []byte
+	svc := newStreamingService(200, &sent)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:        aws.String("bucket"),
+		Key:           aws.String("key"),
+		Body:          aws.ReadSeekCloser(bytes.NewBufferString("hello")),
+		ContentLength: aws.Long(5),
+	})
+	assert.NoError(t, req.Send())
+
+	h := req.HTTPRequest.Header
+	assert.Equal(t, v4.StreamingPayload, h.Get("X-Amz-Content-Sha256"))
+	assert.Equal(t, "5", h.Get("X-Amz-Decoded-Content-Length"))
+	assert.Equal(t, "aws-chunked", h.Get("Content-Encoding"))
+	assert.Equal(t, v4.StreamingContentLength(5, v4.DefaultChunkSize), req.HTTPRequest.ContentLength)
+	assert.Equal(t, req.HTTPRequest.ContentLength, int64(len(sent)))
+	assert.True(t, strings.HasPrefix(string(sent), "5;chunk-signature="))
+	assert.Contains(t, string(sent), "\r\nhello\r\n0;chunk-signature=")
+}
+
+func TestPutObjectStreamingBodyRequiresContentLength(t *testing.T) {
+	var sent []byte
+	svc := newStreamingService(200, &sent)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   aws.ReadSeekCloser(bytes.NewBufferString("hello")),
+	})
+	err := req.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "MissingContentLength", err.(awserr.Error).Code())
+}
+
+func TestUploadPartStreamingBodyNotRetried(t *testing.T) {
+	var sent []byte
+	svc := newStreamingService(500, &sent)
+	req, _ := svc.UploadPartRequest(&s3.UploadPartInput{
+		Bucket:        aws.String("bucket"),
+		Key:           aws.String("key"),
+		PartNumber:    aws.Long(1),
+		UploadID:      aws.String("upload-id"),
+		Body:          aws.ReadSeekCloser(bytes.NewBufferString("hello")),
+		ContentLength: aws.Long(5),
+	})
+	assert.Error(t, req.Send())
+	assert.Equal(t, uint(0), req.RetryCount)
+}
+
+func TestPutObjectSeekableBodyNotStreamed(t *testing.T) {
+	var sent []byte
+	svc := newStreamingService(200, &sent)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   aws.ReadSeekCloser(strings.NewReader("hello")),
+	})
+	assert.NoError(t, req.Send())
+
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"))
+	assert.Empty(t, req.HTTPRequest.Header.Get("Content-Encoding"))
+	assert.Equal(t, "hello", string(sent))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opDeleteIdentity = "DeleteIdentity"
@@ -39,6 +40,16 @@ func (c *SES) DeleteIdentity(input *DeleteIdentityInput) (*DeleteIdentityOutput,
 	return out, err
 }
 
+// DeleteIdentityWithContext is the same as DeleteIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) DeleteIdentityWithContext(ctx aws.Context, input *DeleteIdentityInput) (*DeleteIdentityOutput, error) {
+	req, out := c.DeleteIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVerifiedEmailAddress = "DeleteVerifiedEmailAddress"
 
 // DeleteVerifiedEmailAddressRequest generates a request for the DeleteVerifiedEmailAddress operation.
@@ -70,6 +81,16 @@ func (c *SES) DeleteVerifiedEmailAddress(input *DeleteVerifiedEmailAddressInput)
 	return out, err
 }
 
+// DeleteVerifiedEmailAddressWithContext is the same as DeleteVerifiedEmailAddress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) DeleteVerifiedEmailAddressWithContext(ctx aws.Context, input *DeleteVerifiedEmailAddressInput) (*DeleteVerifiedEmailAddressOutput, error) {
+	req, out := c.DeleteVerifiedEmailAddressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetIdentityDKIMAttributes = "GetIdentityDkimAttributes"
 
 // GetIdentityDKIMAttributesRequest generates a request for the GetIdentityDKIMAttributes operation.
@@ -114,6 +135,16 @@ func (c *SES) GetIdentityDKIMAttributes(input *GetIdentityDKIMAttributesInput) (
 	return out, err
 }
 
+// GetIdentityDKIMAttributesWithContext is the same as GetIdentityDKIMAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) GetIdentityDKIMAttributesWithContext(ctx aws.Context, input *GetIdentityDKIMAttributesInput) (*GetIdentityDKIMAttributesOutput, error) {
+	req, out := c.GetIdentityDKIMAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetIdentityNotificationAttributes = "GetIdentityNotificationAttributes"
 
 // GetIdentityNotificationAttributesRequest generates a request for the GetIdentityNotificationAttributes operation.
@@ -148,6 +179,16 @@ func (c *SES) GetIdentityNotificationAttributes(input *GetIdentityNotificationAt
 	return out, err
 }
 
+// GetIdentityNotificationAttributesWithContext is the same as GetIdentityNotificationAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) GetIdentityNotificationAttributesWithContext(ctx aws.Context, input *GetIdentityNotificationAttributesInput) (*GetIdentityNotificationAttributesOutput, error) {
+	req, out := c.GetIdentityNotificationAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetIdentityVerificationAttributes = "GetIdentityVerificationAttributes"
 
 // GetIdentityVerificationAttributesRequest generates a request for the GetIdentityVerificationAttributes operation.
@@ -180,6 +221,16 @@ func (c *SES) GetIdentityVerificationAttributes(input *GetIdentityVerificationAt
 	return out, err
 }
 
+// GetIdentityVerificationAttributesWithContext is the same as GetIdentityVerificationAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) GetIdentityVerificationAttributesWithContext(ctx aws.Context, input *GetIdentityVerificationAttributesInput) (*GetIdentityVerificationAttributesOutput, error) {
+	req, out := c.GetIdentityVerificationAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSendQuota = "GetSendQuota"
 
 // GetSendQuotaRequest generates a request for the GetSendQuota operation.
@@ -209,6 +260,16 @@ func (c *SES) GetSendQuota(input *GetSendQuotaInput) (*GetSendQuotaOutput, error
 	return out, err
 }
 
+// GetSendQuotaWithContext is the same as GetSendQuota with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) GetSendQuotaWithContext(ctx aws.Context, input *GetSendQuotaInput) (*GetSendQuotaOutput, error) {
+	req, out := c.GetSendQuotaRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSendStatistics = "GetSendStatistics"
 
 // GetSendStatisticsRequest generates a request for the GetSendStatistics operation.
@@ -241,6 +302,16 @@ func (c *SES) GetSendStatistics(input *GetSendStatisticsInput) (*GetSendStatisti
 	return out, err
 }
 
+// GetSendStatisticsWithContext is the same as GetSendStatistics with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) GetSendStatisticsWithContext(ctx aws.Context, input *GetSendStatisticsInput) (*GetSendStatisticsOutput, error) {
+	req, out := c.GetSendStatisticsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListIdentities = "ListIdentities"
 
 // ListIdentitiesRequest generates a request for the ListIdentities operation.
@@ -277,6 +348,16 @@ func (c *SES) ListIdentities(input *ListIdentitiesInput) (*ListIdentitiesOutput,
 	return out, err
 }
 
+// ListIdentitiesWithContext is the same as ListIdentities with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) ListIdentitiesWithContext(ctx aws.Context, input *ListIdentitiesInput) (*ListIdentitiesOutput, error) {
+	req, out := c.ListIdentitiesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SES) ListIdentitiesPages(input *ListIdentitiesInput, fn func(p *ListIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListIdentitiesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -315,6 +396,16 @@ func (c *SES) ListVerifiedEmailAddresses(input *ListVerifiedEmailAddressesInput)
 	return out, err
 }
 
+// ListVerifiedEmailAddressesWithContext is the same as ListVerifiedEmailAddresses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) ListVerifiedEmailAddressesWithContext(ctx aws.Context, input *ListVerifiedEmailAddressesInput) (*ListVerifiedEmailAddressesOutput, error) {
+	req, out := c.ListVerifiedEmailAddressesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSendEmail = "SendEmail"
 
 // SendEmailRequest generates a request for the SendEmail operation.
@@ -360,6 +451,16 @@ func (c *SES) SendEmail(input *SendEmailInput) (*SendEmailOutput, error) {
 	return out, err
 }
 
+// SendEmailWithContext is the same as SendEmail with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) SendEmailWithContext(ctx aws.Context, input *SendEmailInput) (*SendEmailOutput, error) {
+	req, out := c.SendEmailRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSendRawEmail = "SendRawEmail"
 
 // SendRawEmailRequest generates a request for the SendRawEmail operation.
@@ -412,6 +513,16 @@ func (c *SES) SendRawEmail(input *SendRawEmailInput) (*SendRawEmailOutput, error
 	return out, err
 }
 
+// SendRawEmailWithContext is the same as SendRawEmail with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) SendRawEmailWithContext(ctx aws.Context, input *SendRawEmailInput) (*SendRawEmailOutput, error) {
+	req, out := c.SendRawEmailRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetIdentityDKIMEnabled = "SetIdentityDkimEnabled"
 
 // SetIdentityDKIMEnabledRequest generates a request for the SetIdentityDKIMEnabled operation.
@@ -452,6 +563,16 @@ func (c *SES) SetIdentityDKIMEnabled(input *SetIdentityDKIMEnabledInput) (*SetId
 	return out, err
 }
 
+// SetIdentityDKIMEnabledWithContext is the same as SetIdentityDKIMEnabled with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) SetIdentityDKIMEnabledWithContext(ctx aws.Context, input *SetIdentityDKIMEnabledInput) (*SetIdentityDKIMEnabledOutput, error) {
+	req, out := c.SetIdentityDKIMEnabledRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetIdentityFeedbackForwardingEnabled = "SetIdentityFeedbackForwardingEnabled"
 
 // SetIdentityFeedbackForwardingEnabledRequest generates a request for the SetIdentityFeedbackForwardingEnabled operation.
@@ -489,6 +610,16 @@ func (c *SES) SetIdentityFeedbackForwardingEnabled(input *SetIdentityFeedbackFor
 	return out, err
 }
 
+// SetIdentityFeedbackForwardingEnabledWithContext is the same as SetIdentityFeedbackForwardingEnabled with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) SetIdentityFeedbackForwardingEnabledWithContext(ctx aws.Context, input *SetIdentityFeedbackForwardingEnabledInput) (*SetIdentityFeedbackForwardingEnabledOutput, error) {
+	req, out := c.SetIdentityFeedbackForwardingEnabledRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetIdentityNotificationTopic = "SetIdentityNotificationTopic"
 
 // SetIdentityNotificationTopicRequest generates a request for the SetIdentityNotificationTopic operation.
@@ -525,6 +656,16 @@ func (c *SES) SetIdentityNotificationTopic(input *SetIdentityNotificationTopicIn
 	return out, err
 }
 
+// SetIdentityNotificationTopicWithContext is the same as SetIdentityNotificationTopic with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) SetIdentityNotificationTopicWithContext(ctx aws.Context, input *SetIdentityNotificationTopicInput) (*SetIdentityNotificationTopicOutput, error) {
+	req, out := c.SetIdentityNotificationTopicRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opVerifyDomainDKIM = "VerifyDomainDkim"
 
 // VerifyDomainDKIMRequest generates a request for the VerifyDomainDKIM operation.
@@ -566,6 +707,16 @@ func (c *SES) VerifyDomainDKIM(input *VerifyDomainDKIMInput) (*VerifyDomainDKIMO
 	return out, err
 }
 
+// VerifyDomainDKIMWithContext is the same as VerifyDomainDKIM with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) VerifyDomainDKIMWithContext(ctx aws.Context, input *VerifyDomainDKIMInput) (*VerifyDomainDKIMOutput, error) {
+	req, out := c.VerifyDomainDKIMRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opVerifyDomainIdentity = "VerifyDomainIdentity"
 
 // VerifyDomainIdentityRequest generates a request for the VerifyDomainIdentity operation.
@@ -595,6 +746,16 @@ func (c *SES) VerifyDomainIdentity(input *VerifyDomainIdentityInput) (*VerifyDom
 	return out, err
 }
 
+// VerifyDomainIdentityWithContext is the same as VerifyDomainIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) VerifyDomainIdentityWithContext(ctx aws.Context, input *VerifyDomainIdentityInput) (*VerifyDomainIdentityOutput, error) {
+	req, out := c.VerifyDomainIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opVerifyEmailAddress = "VerifyEmailAddress"
 
 // VerifyEmailAddressRequest generates a request for the VerifyEmailAddress operation.
@@ -627,6 +788,16 @@ func (c *SES) VerifyEmailAddress(input *VerifyEmailAddressInput) (*VerifyEmailAd
 	return out, err
 }
 
+// VerifyEmailAddressWithContext is the same as VerifyEmailAddress with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) VerifyEmailAddressWithContext(ctx aws.Context, input *VerifyEmailAddressInput) (*VerifyEmailAddressOutput, error) {
+	req, out := c.VerifyEmailAddressRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opVerifyEmailIdentity = "VerifyEmailIdentity"
 
 // VerifyEmailIdentityRequest generates a request for the VerifyEmailIdentity operation.
@@ -657,6 +828,16 @@ func (c *SES) VerifyEmailIdentity(input *VerifyEmailIdentityInput) (*VerifyEmail
 	return out, err
 }
 
+// VerifyEmailIdentityWithContext is the same as VerifyEmailIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SES) VerifyEmailIdentityWithContext(ctx aws.Context, input *VerifyEmailIdentityInput) (*VerifyEmailIdentityOutput, error) {
+	req, out := c.VerifyEmailIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Represents the body of the message. You can specify text, HTML, or both.
 // If you use both, then the message should display correctly in the widest
 // variety of email clients.
@@ -677,6 +858,16 @@ type metadataBody struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Body) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Body) GoString() string {
+	return s.String()
+}
+
 // Represents textual data, plus an optional character set specification.
 //
 // By default, the text must be 7-bit ASCII, due to the constraints of the
@@ -696,6 +887,16 @@ type metadataContent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Content) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Content) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to delete an identity from the
 // list of identities for the AWS Account.
 type DeleteIdentityInput struct {
@@ -709,6 +910,16 @@ type metadataDeleteIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIdentityInput) GoString() string {
+	return s.String()
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type DeleteIdentityOutput struct {
@@ -719,6 +930,16 @@ type metadataDeleteIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to delete an address from the
 // list of verified email addresses.
 type DeleteVerifiedEmailAddressInput struct {
@@ -732,6 +953,16 @@ type metadataDeleteVerifiedEmailAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVerifiedEmailAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVerifiedEmailAddressInput) GoString() string {
+	return s.String()
+}
+
 type DeleteVerifiedEmailAddressOutput struct {
 	metadataDeleteVerifiedEmailAddressOutput `json:"-" xml:"-"`
 }
@@ -740,6 +971,16 @@ type metadataDeleteVerifiedEmailAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVerifiedEmailAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVerifiedEmailAddressOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the destination of the message, consisting of To:, CC:, and BCC:
 // fields.
 //
@@ -764,6 +1005,16 @@ type metadataDestination struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Destination) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Destination) GoString() string {
+	return s.String()
+}
+
 // Given a list of verified identities, describes their DKIM attributes. The
 // DKIM attributes of an email address identity includes whether DKIM signing
 // is individually enabled or disabled for that address. The DKIM attributes
@@ -782,6 +1033,16 @@ type metadataGetIdentityDKIMAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityDKIMAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityDKIMAttributesInput) GoString() string {
+	return s.String()
+}
+
 // Represents a list of all the DKIM attributes for the specified identity.
 type GetIdentityDKIMAttributesOutput struct {
 	// The DKIM attributes for an email address or a domain.
@@ -794,6 +1055,16 @@ type metadataGetIdentityDKIMAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityDKIMAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityDKIMAttributesOutput) GoString() string {
+	return s.String()
+}
+
 type GetIdentityNotificationAttributesInput struct {
 	// A list of one or more identities.
 	Identities []*string `type:"list" required:"true"`
@@ -805,6 +1076,16 @@ type metadataGetIdentityNotificationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityNotificationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityNotificationAttributesInput) GoString() string {
+	return s.String()
+}
+
 // Describes whether an identity has Amazon Simple Notification Service (Amazon
 // SNS) topics set for bounce, complaint, and/or delivery notifications, and
 // specifies whether feedback forwarding is enabled for bounce and complaint
@@ -820,6 +1101,16 @@ type metadataGetIdentityNotificationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityNotificationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityNotificationAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to provide the verification
 // attributes for a list of identities.
 type GetIdentityVerificationAttributesInput struct {
@@ -833,6 +1124,16 @@ type metadataGetIdentityVerificationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityVerificationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityVerificationAttributesInput) GoString() string {
+	return s.String()
+}
+
 // Represents the verification attributes for a list of identities.
 type GetIdentityVerificationAttributesOutput struct {
 	// A map of Identities to IdentityVerificationAttributes objects.
@@ -845,6 +1146,16 @@ type metadataGetIdentityVerificationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetIdentityVerificationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetIdentityVerificationAttributesOutput) GoString() string {
+	return s.String()
+}
+
 type GetSendQuotaInput struct {
 	metadataGetSendQuotaInput `json:"-" xml:"-"`
 }
@@ -853,6 +1164,16 @@ type metadataGetSendQuotaInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSendQuotaInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSendQuotaInput) GoString() string {
+	return s.String()
+}
+
 // Represents the user's current activity limits returned from a successful
 // GetSendQuota request.
 type GetSendQuotaOutput struct {
@@ -877,6 +1198,16 @@ type metadataGetSendQuotaOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSendQuotaOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSendQuotaOutput) GoString() string {
+	return s.String()
+}
+
 type GetSendStatisticsInput struct {
 	metadataGetSendStatisticsInput `json:"-" xml:"-"`
 }
@@ -885,6 +1216,16 @@ type metadataGetSendStatisticsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSendStatisticsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSendStatisticsInput) GoString() string {
+	return s.String()
+}
+
 // Represents a list of SendDataPoint items returned from a successful GetSendStatistics
 // request. This list contains aggregated data from the previous two weeks of
 // sending activity.
@@ -899,6 +1240,16 @@ type metadataGetSendStatisticsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSendStatisticsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSendStatisticsOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the DKIM attributes of a verified email address or a domain.
 type IdentityDKIMAttributes struct {
 	// True if DKIM signing is enabled for email sent from the identity; false otherwise.
@@ -919,7 +1270,9 @@ type IdentityDKIMAttributes struct {
 	// Describes whether Amazon SES has successfully verified the DKIM DNS records
 	// (tokens) published in the domain name's DNS. (This only applies to domain
 	// identities, not email address identities.)
-	DKIMVerificationStatus *string `locationName:"DkimVerificationStatus" type:"string" required:"true"`
+	//
+	// See the VerificationStatus* constants for valid values.
+	DKIMVerificationStatus *string `locationName:"DkimVerificationStatus" type:"string" enum:"Pending,Success,Failed,TemporaryFailure,NotStarted" required:"true"`
 
 	metadataIdentityDKIMAttributes `json:"-" xml:"-"`
 }
@@ -928,6 +1281,16 @@ type metadataIdentityDKIMAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityDKIMAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityDKIMAttributes) GoString() string {
+	return s.String()
+}
+
 // Represents the notification attributes of an identity, including whether
 // an identity has Amazon Simple Notification Service (Amazon SNS) topics set
 // for bounce, complaint, and/or delivery notifications, and whether feedback
@@ -958,11 +1321,23 @@ type metadataIdentityNotificationAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityNotificationAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityNotificationAttributes) GoString() string {
+	return s.String()
+}
+
 // Represents the verification attributes of a single identity.
 type IdentityVerificationAttributes struct {
 	// The verification status of the identity: "Pending", "Success", "Failed",
 	// or "TemporaryFailure".
-	VerificationStatus *string `type:"string" required:"true"`
+	//
+	// See the VerificationStatus* constants for valid values.
+	VerificationStatus *string `type:"string" enum:"Pending,Success,Failed,TemporaryFailure,NotStarted" required:"true"`
 
 	// The verification token for a domain identity. Null for email address identities.
 	VerificationToken *string `type:"string"`
@@ -974,12 +1349,24 @@ type metadataIdentityVerificationAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s IdentityVerificationAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s IdentityVerificationAttributes) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to list all identities for the
 // AWS Account.
 type ListIdentitiesInput struct {
 	// The type of the identities to list. Possible values are "EmailAddress" and
 	// "Domain". If this parameter is omitted, then all identities will be listed.
-	IdentityType *string `type:"string"`
+	//
+	// See the IdentityType* constants for valid values.
+	IdentityType *string `type:"string" enum:"EmailAddress,Domain"`
 
 	// The maximum number of identities per page. Possible values are 1-1000 inclusive.
 	MaxItems *int64 `type:"integer"`
@@ -994,6 +1381,16 @@ type metadataListIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentitiesInput) GoString() string {
+	return s.String()
+}
+
 // Represents a list of all verified identities for the AWS Account.
 type ListIdentitiesOutput struct {
 	// A list of identities.
@@ -1009,6 +1406,16 @@ type metadataListIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListIdentitiesOutput) GoString() string {
+	return s.String()
+}
+
 type ListVerifiedEmailAddressesInput struct {
 	metadataListVerifiedEmailAddressesInput `json:"-" xml:"-"`
 }
@@ -1017,6 +1424,16 @@ type metadataListVerifiedEmailAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVerifiedEmailAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVerifiedEmailAddressesInput) GoString() string {
+	return s.String()
+}
+
 // Represents a list of all the email addresses verified for the current user.
 type ListVerifiedEmailAddressesOutput struct {
 	// A list of email addresses that have been verified.
@@ -1029,6 +1446,16 @@ type metadataListVerifiedEmailAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVerifiedEmailAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVerifiedEmailAddressesOutput) GoString() string {
+	return s.String()
+}
+
 // Represents the message to be sent, composed of a subject and a body.
 type Message struct {
 	// The message body.
@@ -1045,6 +1472,16 @@ type metadataMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Message) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Message) GoString() string {
+	return s.String()
+}
+
 // Represents the raw data of the message.
 type RawMessage struct {
 	// The raw data of the message. The client must ensure that the message format
@@ -1063,6 +1500,16 @@ type metadataRawMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RawMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RawMessage) GoString() string {
+	return s.String()
+}
+
 // Represents sending statistics data. Each SendDataPoint contains statistics
 // for a 15-minute period of sending activity.
 type SendDataPoint struct {
@@ -1088,6 +1535,16 @@ type metadataSendDataPoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendDataPoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendDataPoint) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to send a single email message.
 //
 // This datatype can be used in application code to compose a message consisting
@@ -1128,6 +1585,16 @@ type metadataSendEmailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendEmailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendEmailInput) GoString() string {
+	return s.String()
+}
+
 // Represents a unique message ID returned from a successful SendEmail request.
 type SendEmailOutput struct {
 	// The unique message identifier returned from the SendEmail action.
@@ -1140,6 +1607,16 @@ type metadataSendEmailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendEmailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendEmailOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to send a raw email message.
 //
 // This datatype can be used in application code to compose a message consisting
@@ -1182,6 +1659,16 @@ type metadataSendRawEmailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendRawEmailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendRawEmailInput) GoString() string {
+	return s.String()
+}
+
 // Represents a unique message ID returned from a successful SendRawEmail request.
 type SendRawEmailOutput struct {
 	// The unique message identifier returned from the SendRawEmail action.
@@ -1194,6 +1681,16 @@ type metadataSendRawEmailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendRawEmailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendRawEmailOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to enable or disable DKIM signing
 // for an identity.
 type SetIdentityDKIMEnabledInput struct {
@@ -1211,6 +1708,16 @@ type metadataSetIdentityDKIMEnabledInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityDKIMEnabledInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityDKIMEnabledInput) GoString() string {
+	return s.String()
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type SetIdentityDKIMEnabledOutput struct {
@@ -1221,6 +1728,16 @@ type metadataSetIdentityDKIMEnabledOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityDKIMEnabledOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityDKIMEnabledOutput) GoString() string {
+	return s.String()
+}
+
 type SetIdentityFeedbackForwardingEnabledInput struct {
 	// Sets whether Amazon SES will forward bounce and complaint notifications as
 	// email. true specifies that Amazon SES will forward bounce and complaint notifications
@@ -1241,6 +1758,16 @@ type metadataSetIdentityFeedbackForwardingEnabledInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityFeedbackForwardingEnabledInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityFeedbackForwardingEnabledInput) GoString() string {
+	return s.String()
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type SetIdentityFeedbackForwardingEnabledOutput struct {
@@ -1251,6 +1778,16 @@ type metadataSetIdentityFeedbackForwardingEnabledOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityFeedbackForwardingEnabledOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityFeedbackForwardingEnabledOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request to set or clear an identity's notification topic.
 type SetIdentityNotificationTopicInput struct {
 	// The identity for which the Amazon SNS topic will be set. Examples: user@example.com,
@@ -1259,7 +1796,9 @@ type SetIdentityNotificationTopicInput struct {
 
 	// The type of notifications that will be published to the specified Amazon
 	// SNS topic.
-	NotificationType *string `type:"string" required:"true"`
+	//
+	// See the NotificationType* constants for valid values.
+	NotificationType *string `type:"string" enum:"Bounce,Complaint,Delivery" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic. If the parameter
 	// is omitted from the request or a null value is passed, SnsTopic is cleared
@@ -1273,6 +1812,16 @@ type metadataSetIdentityNotificationTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityNotificationTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityNotificationTopicInput) GoString() string {
+	return s.String()
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type SetIdentityNotificationTopicOutput struct {
@@ -1283,6 +1832,16 @@ type metadataSetIdentityNotificationTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetIdentityNotificationTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetIdentityNotificationTopicOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to begin DKIM verification for
 // a domain.
 type VerifyDomainDKIMInput struct {
@@ -1296,6 +1855,16 @@ type metadataVerifyDomainDKIMInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VerifyDomainDKIMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyDomainDKIMInput) GoString() string {
+	return s.String()
+}
+
 // Represents the DNS records that must be published in the domain name's DNS
 // to complete DKIM setup.
 type VerifyDomainDKIMOutput struct {
@@ -1319,6 +1888,16 @@ type metadataVerifyDomainDKIMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VerifyDomainDKIMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyDomainDKIMOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to begin domain verification.
 type VerifyDomainIdentityInput struct {
 	// The domain to be verified.
@@ -1331,6 +1910,16 @@ type metadataVerifyDomainIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VerifyDomainIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyDomainIdentityInput) GoString() string {
+	return s.String()
+}
+
 // Represents a token used for domain ownership verification.
 type VerifyDomainIdentityOutput struct {
 	// A TXT record that must be placed in the DNS settings for the domain, in order
@@ -1344,6 +1933,16 @@ type metadataVerifyDomainIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VerifyDomainIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyDomainIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to begin email address verification.
 type VerifyEmailAddressInput struct {
 	// The email address to be verified.
@@ -1356,6 +1955,16 @@ type metadataVerifyEmailAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VerifyEmailAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyEmailAddressInput) GoString() string {
+	return s.String()
+}
+
 type VerifyEmailAddressOutput struct {
 	metadataVerifyEmailAddressOutput `json:"-" xml:"-"`
 }
@@ -1364,6 +1973,16 @@ type metadataVerifyEmailAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VerifyEmailAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyEmailAddressOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a request instructing the service to begin email address verification.
 type VerifyEmailIdentityInput struct {
 	// The email address to be verified.
@@ -1376,6 +1995,16 @@ type metadataVerifyEmailIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VerifyEmailIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyEmailIdentityInput) GoString() string {
+	return s.String()
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type VerifyEmailIdentityOutput struct {
@@ -1385,3 +2014,42 @@ type VerifyEmailIdentityOutput struct {
 type metadataVerifyEmailIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VerifyEmailIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VerifyEmailIdentityOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum IdentityType
+	IdentityTypeEmailAddress = "EmailAddress"
+	// @enum IdentityType
+	IdentityTypeDomain = "Domain"
+)
+
+const (
+	// @enum NotificationType
+	NotificationTypeBounce = "Bounce"
+	// @enum NotificationType
+	NotificationTypeComplaint = "Complaint"
+	// @enum NotificationType
+	NotificationTypeDelivery = "Delivery"
+)
+
+const (
+	// @enum VerificationStatus
+	VerificationStatusPending = "Pending"
+	// @enum VerificationStatus
+	VerificationStatusSuccess = "Success"
+	// @enum VerificationStatus
+	VerificationStatusFailed = "Failed"
+	// @enum VerificationStatus
+	VerificationStatusTemporaryFailure = "TemporaryFailure"
+	// @enum VerificationStatus
+	VerificationStatusNotStarted = "NotStarted"
+)
@@ -4,6 +4,7 @@
 package sesiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
 )
 
@@ -11,37 +12,75 @@ import (
 type SESAPI interface {
 	DeleteIdentity(*ses.DeleteIdentityInput) (*ses.DeleteIdentityOutput, error)
 
+	DeleteIdentityWithContext(aws.Context, *ses.DeleteIdentityInput) (*ses.DeleteIdentityOutput, error)
+
 	DeleteVerifiedEmailAddress(*ses.DeleteVerifiedEmailAddressInput) (*ses.DeleteVerifiedEmailAddressOutput, error)
 
+	DeleteVerifiedEmailAddressWithContext(aws.Context, *ses.DeleteVerifiedEmailAddressInput) (*ses.DeleteVerifiedEmailAddressOutput, error)
+
 	GetIdentityDKIMAttributes(*ses.GetIdentityDKIMAttributesInput) (*ses.GetIdentityDKIMAttributesOutput, error)
 
+	GetIdentityDKIMAttributesWithContext(aws.Context, *ses.GetIdentityDKIMAttributesInput) (*ses.GetIdentityDKIMAttributesOutput, error)
+
 	GetIdentityNotificationAttributes(*ses.GetIdentityNotificationAttributesInput) (*ses.GetIdentityNotificationAttributesOutput, error)
 
+	GetIdentityNotificationAttributesWithContext(aws.Context, *ses.GetIdentityNotificationAttributesInput) (*ses.GetIdentityNotificationAttributesOutput, error)
+
 	GetIdentityVerificationAttributes(*ses.GetIdentityVerificationAttributesInput) (*ses.GetIdentityVerificationAttributesOutput, error)
 
+	GetIdentityVerificationAttributesWithContext(aws.Context, *ses.GetIdentityVerificationAttributesInput) (*ses.GetIdentityVerificationAttributesOutput, error)
+
 	GetSendQuota(*ses.GetSendQuotaInput) (*ses.GetSendQuotaOutput, error)
 
+	GetSendQuotaWithContext(aws.Context, *ses.GetSendQuotaInput) (*ses.GetSendQuotaOutput, error)
+
 	GetSendStatistics(*ses.GetSendStatisticsInput) (*ses.GetSendStatisticsOutput, error)
 
+	GetSendStatisticsWithContext(aws.Context, *ses.GetSendStatisticsInput) (*ses.GetSendStatisticsOutput, error)
+
 	ListIdentities(*ses.ListIdentitiesInput) (*ses.ListIdentitiesOutput, error)
 
+	ListIdentitiesWithContext(aws.Context, *ses.ListIdentitiesInput) (*ses.ListIdentitiesOutput, error)
+
 	ListVerifiedEmailAddresses(*ses.ListVerifiedEmailAddressesInput) (*ses.ListVerifiedEmailAddressesOutput, error)
 
+	ListVerifiedEmailAddressesWithContext(aws.Context, *ses.ListVerifiedEmailAddressesInput) (*ses.ListVerifiedEmailAddressesOutput, error)
+
 	SendEmail(*ses.SendEmailInput) (*ses.SendEmailOutput, error)
 
+	SendEmailWithContext(aws.Context, *ses.SendEmailInput) (*ses.SendEmailOutput, error)
+
 	SendRawEmail(*ses.SendRawEmailInput) (*ses.SendRawEmailOutput, error)
 
+	SendRawEmailWithContext(aws.Context, *ses.SendRawEmailInput) (*ses.SendRawEmailOutput, error)
+
 	SetIdentityDKIMEnabled(*ses.SetIdentityDKIMEnabledInput) (*ses.SetIdentityDKIMEnabledOutput, error)
 
+	SetIdentityDKIMEnabledWithContext(aws.Context, *ses.SetIdentityDKIMEnabledInput) (*ses.SetIdentityDKIMEnabledOutput, error)
+
 	SetIdentityFeedbackForwardingEnabled(*ses.SetIdentityFeedbackForwardingEnabledInput) (*ses.SetIdentityFeedbackForwardingEnabledOutput, error)
 
+	SetIdentityFeedbackForwardingEnabledWithContext(aws.Context, *ses.SetIdentityFeedbackForwardingEnabledInput) (*ses.SetIdentityFeedbackForwardingEnabledOutput, error)
+
 	SetIdentityNotificationTopic(*ses.SetIdentityNotificationTopicInput) (*ses.SetIdentityNotificationTopicOutput, error)
 
+	SetIdentityNotificationTopicWithContext(aws.Context, *ses.SetIdentityNotificationTopicInput) (*ses.SetIdentityNotificationTopicOutput, error)
+
 	VerifyDomainDKIM(*ses.VerifyDomainDKIMInput) (*ses.VerifyDomainDKIMOutput, error)
 
+	VerifyDomainDKIMWithContext(aws.Context, *ses.VerifyDomainDKIMInput) (*ses.VerifyDomainDKIMOutput, error)
+
 	VerifyDomainIdentity(*ses.VerifyDomainIdentityInput) (*ses.VerifyDomainIdentityOutput, error)
 
+	VerifyDomainIdentityWithContext(aws.Context, *ses.VerifyDomainIdentityInput) (*ses.VerifyDomainIdentityOutput, error)
+
 	VerifyEmailAddress(*ses.VerifyEmailAddressInput) (*ses.VerifyEmailAddressOutput, error)
 
+	VerifyEmailAddressWithContext(aws.Context, *ses.VerifyEmailAddressInput) (*ses.VerifyEmailAddressOutput, error)
+
 	VerifyEmailIdentity(*ses.VerifyEmailIdentityInput) (*ses.VerifyEmailIdentityOutput, error)
+
+	VerifyEmailIdentityWithContext(aws.Context, *ses.VerifyEmailIdentityInput) (*ses.VerifyEmailIdentityOutput, error)
+
+	WaitUntilIdentityExists(*ses.GetIdentityVerificationAttributesInput) error
 }
@@ -0,0 +1,52 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+// Package simpledbiface provides an interface for the Amazon SimpleDB.
+package simpledbiface
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+)
+
+// SimpleDBAPI is the interface type for simpledb.SimpleDB.
+type SimpleDBAPI interface {
+	BatchDeleteAttributes(*simpledb.BatchDeleteAttributesInput) (*simpledb.BatchDeleteAttributesOutput, error)
+
+	BatchDeleteAttributesWithContext(aws.Context, *simpledb.BatchDeleteAttributesInput) (*simpledb.BatchDeleteAttributesOutput, error)
+
+	BatchPutAttributes(*simpledb.BatchPutAttributesInput) (*simpledb.BatchPutAttributesOutput, error)
+
+	BatchPutAttributesWithContext(aws.Context, *simpledb.BatchPutAttributesInput) (*simpledb.BatchPutAttributesOutput, error)
+
+	CreateDomain(*simpledb.CreateDomainInput) (*simpledb.CreateDomainOutput, error)
+
+	CreateDomainWithContext(aws.Context, *simpledb.CreateDomainInput) (*simpledb.CreateDomainOutput, error)
+
+	DeleteAttributes(*simpledb.DeleteAttributesInput) (*simpledb.DeleteAttributesOutput, error)
+
+	DeleteAttributesWithContext(aws.Context, *simpledb.DeleteAttributesInput) (*simpledb.DeleteAttributesOutput, error)
+
+	DeleteDomain(*simpledb.DeleteDomainInput) (*simpledb.DeleteDomainOutput, error)
+
+	DeleteDomainWithContext(aws.Context, *simpledb.DeleteDomainInput) (*simpledb.DeleteDomainOutput, error)
+
+	DomainMetadata(*simpledb.DomainMetadataInput) (*simpledb.DomainMetadataOutput, error)
+
+	DomainMetadataWithContext(aws.Context, *simpledb.DomainMetadataInput) (*simpledb.DomainMetadataOutput, error)
+
+	GetAttributes(*simpledb.GetAttributesInput) (*simpledb.GetAttributesOutput, error)
+
+	GetAttributesWithContext(aws.Context, *simpledb.GetAttributesInput) (*simpledb.GetAttributesOutput, error)
+
+	ListDomains(*simpledb.ListDomainsInput) (*simpledb.ListDomainsOutput, error)
+
+	ListDomainsWithContext(aws.Context, *simpledb.ListDomainsInput) (*simpledb.ListDomainsOutput, error)
+
+	PutAttributes(*simpledb.PutAttributesInput) (*simpledb.PutAttributesOutput, error)
+
+	PutAttributesWithContext(aws.Context, *simpledb.PutAttributesInput) (*simpledb.PutAttributesOutput, error)
+
+	Select(*simpledb.SelectInput) (*simpledb.SelectOutput, error)
+
+	SelectWithContext(aws.Context, *simpledb.SelectInput) (*simpledb.SelectOutput, error)
+}
@@ -0,0 +1,52 @@
+package simpledb
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+type xmlErrorDetail struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type xmlErrorResponse struct {
+	XMLName   xml.Name         `xml:"Response"`
+	Errors    []xmlErrorDetail `xml:"Errors>Error"`
+	RequestID string           `xml:"RequestID"`
+}
+
+// unmarshalError unmarshals the SimpleDB error response, which differs
+// from the error response of other Query services. The first error of the
+// response is returned.
+func unmarshalError(r *aws.Request) {
+	defer r.HTTPResponse.Body.Close()
+
+	resp := &xmlErrorResponse{}
+	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
+	if err != nil && err != io.EOF {
+		r.Error = awserr.New("SerializationError", "failed to decode SimpleDB XML error response", err)
+		return
+	}
+
+	code, msg := "", ""
+	if len(resp.Errors) > 0 {
+		code, msg = resp.Errors[0].Code, resp.Errors[0].Message
+	}
+	if code == "" {
+		// An error response without a body, e.g. to a HEAD request, still
+		// has a status code.
+		code = strings.Replace(r.HTTPResponse.Status, " ", "", -1)
+		msg = r.HTTPResponse.Status
+	}
+
+	r.Error = awserr.NewRequestFailure(
+		awserr.New(code, msg, nil),
+		r.HTTPResponse.StatusCode,
+		resp.RequestID,
+	)
+}
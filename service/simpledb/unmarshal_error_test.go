@@ -0,0 +1,38 @@
+package simpledb_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/internal/test/unit"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+func TestUnmarshalError(t *testing.T) {
+	svc := simpledb.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		body := `<?xml version="1.0"?>
+<Response><Errors><Error><Code>NoSuchDomain</Code><Message>The specified domain does not exist.</Message><BoxUsage>0.0000071759</BoxUsage></Error></Errors><RequestID>request-id</RequestID></Response>`
+		r.HTTPResponse = &http.Response{
+			StatusCode: 400,
+			Status:     "Bad Request",
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+
+	_, err := svc.DomainMetadata(&simpledb.DomainMetadataInput{DomainName: aws.String("domain")})
+	assert.Error(t, err)
+	aerr := err.(awserr.RequestFailure)
+	assert.Equal(t, "NoSuchDomain", aerr.Code())
+	assert.Equal(t, "The specified domain does not exist.", aerr.Message())
+	assert.Equal(t, 400, aerr.StatusCode())
+	assert.Equal(t, "request-id", aerr.RequestID())
+}
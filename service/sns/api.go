@@ -5,6 +5,7 @@ package sns
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddPermission = "AddPermission"
@@ -35,6 +36,16 @@ func (c *SNS) AddPermission(input *AddPermissionInput) (*AddPermissionOutput, er
 	return out, err
 }
 
+// AddPermissionWithContext is the same as AddPermission with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) AddPermissionWithContext(ctx aws.Context, input *AddPermissionInput) (*AddPermissionOutput, error) {
+	req, out := c.AddPermissionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opConfirmSubscription = "ConfirmSubscription"
 
 // ConfirmSubscriptionRequest generates a request for the ConfirmSubscription operation.
@@ -66,6 +77,16 @@ func (c *SNS) ConfirmSubscription(input *ConfirmSubscriptionInput) (*ConfirmSubs
 	return out, err
 }
 
+// ConfirmSubscriptionWithContext is the same as ConfirmSubscription with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) ConfirmSubscriptionWithContext(ctx aws.Context, input *ConfirmSubscriptionInput) (*ConfirmSubscriptionOutput, error) {
+	req, out := c.ConfirmSubscriptionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePlatformApplication = "CreatePlatformApplication"
 
 // CreatePlatformApplicationRequest generates a request for the CreatePlatformApplication operation.
@@ -105,6 +126,16 @@ func (c *SNS) CreatePlatformApplication(input *CreatePlatformApplicationInput) (
 	return out, err
 }
 
+// CreatePlatformApplicationWithContext is the same as CreatePlatformApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) CreatePlatformApplicationWithContext(ctx aws.Context, input *CreatePlatformApplicationInput) (*CreatePlatformApplicationOutput, error) {
+	req, out := c.CreatePlatformApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreatePlatformEndpoint = "CreatePlatformEndpoint"
 
 // CreatePlatformEndpointRequest generates a request for the CreatePlatformEndpoint operation.
@@ -145,6 +176,16 @@ func (c *SNS) CreatePlatformEndpoint(input *CreatePlatformEndpointInput) (*Creat
 	return out, err
 }
 
+// CreatePlatformEndpointWithContext is the same as CreatePlatformEndpoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) CreatePlatformEndpointWithContext(ctx aws.Context, input *CreatePlatformEndpointInput) (*CreatePlatformEndpointOutput, error) {
+	req, out := c.CreatePlatformEndpointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTopic = "CreateTopic"
 
 // CreateTopicRequest generates a request for the CreateTopic operation.
@@ -176,6 +217,16 @@ func (c *SNS) CreateTopic(input *CreateTopicInput) (*CreateTopicOutput, error) {
 	return out, err
 }
 
+// CreateTopicWithContext is the same as CreateTopic with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) CreateTopicWithContext(ctx aws.Context, input *CreateTopicInput) (*CreateTopicOutput, error) {
+	req, out := c.CreateTopicRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteEndpoint = "DeleteEndpoint"
 
 // DeleteEndpointRequest generates a request for the DeleteEndpoint operation.
@@ -204,6 +255,16 @@ func (c *SNS) DeleteEndpoint(input *DeleteEndpointInput) (*DeleteEndpointOutput,
 	return out, err
 }
 
+// DeleteEndpointWithContext is the same as DeleteEndpoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) DeleteEndpointWithContext(ctx aws.Context, input *DeleteEndpointInput) (*DeleteEndpointOutput, error) {
+	req, out := c.DeleteEndpointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeletePlatformApplication = "DeletePlatformApplication"
 
 // DeletePlatformApplicationRequest generates a request for the DeletePlatformApplication operation.
@@ -233,6 +294,16 @@ func (c *SNS) DeletePlatformApplication(input *DeletePlatformApplicationInput) (
 	return out, err
 }
 
+// DeletePlatformApplicationWithContext is the same as DeletePlatformApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) DeletePlatformApplicationWithContext(ctx aws.Context, input *DeletePlatformApplicationInput) (*DeletePlatformApplicationOutput, error) {
+	req, out := c.DeletePlatformApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTopic = "DeleteTopic"
 
 // DeleteTopicRequest generates a request for the DeleteTopic operation.
@@ -263,6 +334,16 @@ func (c *SNS) DeleteTopic(input *DeleteTopicInput) (*DeleteTopicOutput, error) {
 	return out, err
 }
 
+// DeleteTopicWithContext is the same as DeleteTopic with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) DeleteTopicWithContext(ctx aws.Context, input *DeleteTopicInput) (*DeleteTopicOutput, error) {
+	req, out := c.DeleteTopicRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetEndpointAttributes = "GetEndpointAttributes"
 
 // GetEndpointAttributesRequest generates a request for the GetEndpointAttributes operation.
@@ -292,6 +373,16 @@ func (c *SNS) GetEndpointAttributes(input *GetEndpointAttributesInput) (*GetEndp
 	return out, err
 }
 
+// GetEndpointAttributesWithContext is the same as GetEndpointAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) GetEndpointAttributesWithContext(ctx aws.Context, input *GetEndpointAttributesInput) (*GetEndpointAttributesOutput, error) {
+	req, out := c.GetEndpointAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetPlatformApplicationAttributes = "GetPlatformApplicationAttributes"
 
 // GetPlatformApplicationAttributesRequest generates a request for the GetPlatformApplicationAttributes operation.
@@ -321,6 +412,16 @@ func (c *SNS) GetPlatformApplicationAttributes(input *GetPlatformApplicationAttr
 	return out, err
 }
 
+// GetPlatformApplicationAttributesWithContext is the same as GetPlatformApplicationAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) GetPlatformApplicationAttributesWithContext(ctx aws.Context, input *GetPlatformApplicationAttributesInput) (*GetPlatformApplicationAttributesOutput, error) {
+	req, out := c.GetPlatformApplicationAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSubscriptionAttributes = "GetSubscriptionAttributes"
 
 // GetSubscriptionAttributesRequest generates a request for the GetSubscriptionAttributes operation.
@@ -348,6 +449,16 @@ func (c *SNS) GetSubscriptionAttributes(input *GetSubscriptionAttributesInput) (
 	return out, err
 }
 
+// GetSubscriptionAttributesWithContext is the same as GetSubscriptionAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) GetSubscriptionAttributesWithContext(ctx aws.Context, input *GetSubscriptionAttributesInput) (*GetSubscriptionAttributesOutput, error) {
+	req, out := c.GetSubscriptionAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetTopicAttributes = "GetTopicAttributes"
 
 // GetTopicAttributesRequest generates a request for the GetTopicAttributes operation.
@@ -376,6 +487,16 @@ func (c *SNS) GetTopicAttributes(input *GetTopicAttributesInput) (*GetTopicAttri
 	return out, err
 }
 
+// GetTopicAttributesWithContext is the same as GetTopicAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) GetTopicAttributesWithContext(ctx aws.Context, input *GetTopicAttributesInput) (*GetTopicAttributesOutput, error) {
+	req, out := c.GetTopicAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListEndpointsByPlatformApplication = "ListEndpointsByPlatformApplication"
 
 // ListEndpointsByPlatformApplicationRequest generates a request for the ListEndpointsByPlatformApplication operation.
@@ -416,6 +537,16 @@ func (c *SNS) ListEndpointsByPlatformApplication(input *ListEndpointsByPlatformA
 	return out, err
 }
 
+// ListEndpointsByPlatformApplicationWithContext is the same as ListEndpointsByPlatformApplication with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) ListEndpointsByPlatformApplicationWithContext(ctx aws.Context, input *ListEndpointsByPlatformApplicationInput) (*ListEndpointsByPlatformApplicationOutput, error) {
+	req, out := c.ListEndpointsByPlatformApplicationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SNS) ListEndpointsByPlatformApplicationPages(input *ListEndpointsByPlatformApplicationInput, fn func(p *ListEndpointsByPlatformApplicationOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListEndpointsByPlatformApplicationRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -463,6 +594,16 @@ func (c *SNS) ListPlatformApplications(input *ListPlatformApplicationsInput) (*L
 	return out, err
 }
 
+// ListPlatformApplicationsWithContext is the same as ListPlatformApplications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) ListPlatformApplicationsWithContext(ctx aws.Context, input *ListPlatformApplicationsInput) (*ListPlatformApplicationsOutput, error) {
+	req, out := c.ListPlatformApplicationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SNS) ListPlatformApplicationsPages(input *ListPlatformApplicationsInput, fn func(p *ListPlatformApplicationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListPlatformApplicationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -506,6 +647,16 @@ func (c *SNS) ListSubscriptions(input *ListSubscriptionsInput) (*ListSubscriptio
 	return out, err
 }
 
+// ListSubscriptionsWithContext is the same as ListSubscriptions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) ListSubscriptionsWithContext(ctx aws.Context, input *ListSubscriptionsInput) (*ListSubscriptionsOutput, error) {
+	req, out := c.ListSubscriptionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SNS) ListSubscriptionsPages(input *ListSubscriptionsInput, fn func(p *ListSubscriptionsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListSubscriptionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -549,6 +700,16 @@ func (c *SNS) ListSubscriptionsByTopic(input *ListSubscriptionsByTopicInput) (*L
 	return out, err
 }
 
+// ListSubscriptionsByTopicWithContext is the same as ListSubscriptionsByTopic with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) ListSubscriptionsByTopicWithContext(ctx aws.Context, input *ListSubscriptionsByTopicInput) (*ListSubscriptionsByTopicOutput, error) {
+	req, out := c.ListSubscriptionsByTopicRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SNS) ListSubscriptionsByTopicPages(input *ListSubscriptionsByTopicInput, fn func(p *ListSubscriptionsByTopicOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListSubscriptionsByTopicRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -591,6 +752,16 @@ func (c *SNS) ListTopics(input *ListTopicsInput) (*ListTopicsOutput, error) {
 	return out, err
 }
 
+// ListTopicsWithContext is the same as ListTopics with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) ListTopicsWithContext(ctx aws.Context, input *ListTopicsInput) (*ListTopicsOutput, error) {
+	req, out := c.ListTopicsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SNS) ListTopicsPages(input *ListTopicsInput, fn func(p *ListTopicsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListTopicsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -634,6 +805,16 @@ func (c *SNS) Publish(input *PublishInput) (*PublishOutput, error) {
 	return out, err
 }
 
+// PublishWithContext is the same as Publish with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) PublishWithContext(ctx aws.Context, input *PublishInput) (*PublishOutput, error) {
+	req, out := c.PublishRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemovePermission = "RemovePermission"
 
 // RemovePermissionRequest generates a request for the RemovePermission operation.
@@ -661,6 +842,16 @@ func (c *SNS) RemovePermission(input *RemovePermissionInput) (*RemovePermissionO
 	return out, err
 }
 
+// RemovePermissionWithContext is the same as RemovePermission with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetEndpointAttributes = "SetEndpointAttributes"
 
 // SetEndpointAttributesRequest generates a request for the SetEndpointAttributes operation.
@@ -690,6 +881,16 @@ func (c *SNS) SetEndpointAttributes(input *SetEndpointAttributesInput) (*SetEndp
 	return out, err
 }
 
+// SetEndpointAttributesWithContext is the same as SetEndpointAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) SetEndpointAttributesWithContext(ctx aws.Context, input *SetEndpointAttributesInput) (*SetEndpointAttributesOutput, error) {
+	req, out := c.SetEndpointAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetPlatformApplicationAttributes = "SetPlatformApplicationAttributes"
 
 // SetPlatformApplicationAttributesRequest generates a request for the SetPlatformApplicationAttributes operation.
@@ -719,6 +920,16 @@ func (c *SNS) SetPlatformApplicationAttributes(input *SetPlatformApplicationAttr
 	return out, err
 }
 
+// SetPlatformApplicationAttributesWithContext is the same as SetPlatformApplicationAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) SetPlatformApplicationAttributesWithContext(ctx aws.Context, input *SetPlatformApplicationAttributesInput) (*SetPlatformApplicationAttributesOutput, error) {
+	req, out := c.SetPlatformApplicationAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetSubscriptionAttributes = "SetSubscriptionAttributes"
 
 // SetSubscriptionAttributesRequest generates a request for the SetSubscriptionAttributes operation.
@@ -746,6 +957,16 @@ func (c *SNS) SetSubscriptionAttributes(input *SetSubscriptionAttributesInput) (
 	return out, err
 }
 
+// SetSubscriptionAttributesWithContext is the same as SetSubscriptionAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) SetSubscriptionAttributesWithContext(ctx aws.Context, input *SetSubscriptionAttributesInput) (*SetSubscriptionAttributesOutput, error) {
+	req, out := c.SetSubscriptionAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetTopicAttributes = "SetTopicAttributes"
 
 // SetTopicAttributesRequest generates a request for the SetTopicAttributes operation.
@@ -773,6 +994,16 @@ func (c *SNS) SetTopicAttributes(input *SetTopicAttributesInput) (*SetTopicAttri
 	return out, err
 }
 
+// SetTopicAttributesWithContext is the same as SetTopicAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) SetTopicAttributesWithContext(ctx aws.Context, input *SetTopicAttributesInput) (*SetTopicAttributesOutput, error) {
+	req, out := c.SetTopicAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSubscribe = "Subscribe"
 
 // SubscribeRequest generates a request for the Subscribe operation.
@@ -803,6 +1034,16 @@ func (c *SNS) Subscribe(input *SubscribeInput) (*SubscribeOutput, error) {
 	return out, err
 }
 
+// SubscribeWithContext is the same as Subscribe with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) SubscribeWithContext(ctx aws.Context, input *SubscribeInput) (*SubscribeOutput, error) {
+	req, out := c.SubscribeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUnsubscribe = "Unsubscribe"
 
 // UnsubscribeRequest generates a request for the Unsubscribe operation.
@@ -835,6 +1076,16 @@ func (c *SNS) Unsubscribe(input *UnsubscribeInput) (*UnsubscribeOutput, error) {
 	return out, err
 }
 
+// UnsubscribeWithContext is the same as Unsubscribe with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SNS) UnsubscribeWithContext(ctx aws.Context, input *UnsubscribeInput) (*UnsubscribeOutput, error) {
+	req, out := c.UnsubscribeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type AddPermissionInput struct {
 	// The AWS account IDs of the users (principals) who will be given access to
 	// the specified actions. The users must have AWS accounts, but do not need
@@ -859,6 +1110,16 @@ type metadataAddPermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddPermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddPermissionInput) GoString() string {
+	return s.String()
+}
+
 type AddPermissionOutput struct {
 	metadataAddPermissionOutput `json:"-" xml:"-"`
 }
@@ -867,6 +1128,16 @@ type metadataAddPermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddPermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddPermissionOutput) GoString() string {
+	return s.String()
+}
+
 // Input for ConfirmSubscription action.
 type ConfirmSubscriptionInput struct {
 	// Disallows unauthenticated unsubscribes of the subscription. If the value
@@ -888,6 +1159,16 @@ type metadataConfirmSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmSubscriptionInput) GoString() string {
+	return s.String()
+}
+
 // Response for ConfirmSubscriptions action.
 type ConfirmSubscriptionOutput struct {
 	// The ARN of the created subscription.
@@ -900,6 +1181,16 @@ type metadataConfirmSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ConfirmSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ConfirmSubscriptionOutput) GoString() string {
+	return s.String()
+}
+
 // Input for CreatePlatformApplication action.
 type CreatePlatformApplicationInput struct {
 	// For a list of attributes, see SetPlatformApplicationAttributes (http://docs.aws.amazon.com/sns/latest/api/API_SetPlatformApplicationAttributes.html)
@@ -921,6 +1212,16 @@ type metadataCreatePlatformApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePlatformApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePlatformApplicationInput) GoString() string {
+	return s.String()
+}
+
 // Response from CreatePlatformApplication action.
 type CreatePlatformApplicationOutput struct {
 	// PlatformApplicationArn is returned.
@@ -933,6 +1234,16 @@ type metadataCreatePlatformApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePlatformApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePlatformApplicationOutput) GoString() string {
+	return s.String()
+}
+
 // Input for CreatePlatformEndpoint action.
 type CreatePlatformEndpointInput struct {
 	// For a list of attributes, see SetEndpointAttributes (http://docs.aws.amazon.com/sns/latest/api/API_SetEndpointAttributes.html).
@@ -960,6 +1271,16 @@ type metadataCreatePlatformEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePlatformEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePlatformEndpointInput) GoString() string {
+	return s.String()
+}
+
 // Response from CreateEndpoint action.
 type CreatePlatformEndpointOutput struct {
 	// EndpointArn returned from CreateEndpoint action.
@@ -972,6 +1293,16 @@ type metadataCreatePlatformEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreatePlatformEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreatePlatformEndpointOutput) GoString() string {
+	return s.String()
+}
+
 // Input for CreateTopic action.
 type CreateTopicInput struct {
 	// The name of the topic you want to create.
@@ -988,6 +1319,16 @@ type metadataCreateTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTopicInput) GoString() string {
+	return s.String()
+}
+
 // Response from CreateTopic action.
 type CreateTopicOutput struct {
 	// The Amazon Resource Name (ARN) assigned to the created topic.
@@ -1000,6 +1341,16 @@ type metadataCreateTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTopicOutput) GoString() string {
+	return s.String()
+}
+
 // Input for DeleteEndpoint action.
 type DeleteEndpointInput struct {
 	// EndpointArn of endpoint to delete.
@@ -1012,6 +1363,16 @@ type metadataDeleteEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteEndpointInput) GoString() string {
+	return s.String()
+}
+
 type DeleteEndpointOutput struct {
 	metadataDeleteEndpointOutput `json:"-" xml:"-"`
 }
@@ -1020,6 +1381,16 @@ type metadataDeleteEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteEndpointOutput) GoString() string {
+	return s.String()
+}
+
 // Input for DeletePlatformApplication action.
 type DeletePlatformApplicationInput struct {
 	// PlatformApplicationArn of platform application object to delete.
@@ -1032,6 +1403,16 @@ type metadataDeletePlatformApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePlatformApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePlatformApplicationInput) GoString() string {
+	return s.String()
+}
+
 type DeletePlatformApplicationOutput struct {
 	metadataDeletePlatformApplicationOutput `json:"-" xml:"-"`
 }
@@ -1040,6 +1421,16 @@ type metadataDeletePlatformApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeletePlatformApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeletePlatformApplicationOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteTopicInput struct {
 	// The ARN of the topic you want to delete.
 	TopicARN *string `locationName:"TopicArn" type:"string" required:"true"`
@@ -1051,6 +1442,16 @@ type metadataDeleteTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTopicInput) GoString() string {
+	return s.String()
+}
+
 type DeleteTopicOutput struct {
 	metadataDeleteTopicOutput `json:"-" xml:"-"`
 }
@@ -1059,6 +1460,16 @@ type metadataDeleteTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTopicOutput) GoString() string {
+	return s.String()
+}
+
 // Endpoint for mobile app and device.
 type Endpoint struct {
 	// Attributes for endpoint.
@@ -1074,6 +1485,16 @@ type metadataEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Endpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Endpoint) GoString() string {
+	return s.String()
+}
+
 // Input for GetEndpointAttributes action.
 type GetEndpointAttributesInput struct {
 	// EndpointArn for GetEndpointAttributes input.
@@ -1086,6 +1507,16 @@ type metadataGetEndpointAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetEndpointAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetEndpointAttributesInput) GoString() string {
+	return s.String()
+}
+
 // Response from GetEndpointAttributes of the EndpointArn.
 type GetEndpointAttributesOutput struct {
 	// Attributes include the following:
@@ -1108,6 +1539,16 @@ type metadataGetEndpointAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetEndpointAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetEndpointAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for GetPlatformApplicationAttributes action.
 type GetPlatformApplicationAttributesInput struct {
 	// PlatformApplicationArn for GetPlatformApplicationAttributesInput.
@@ -1120,6 +1561,16 @@ type metadataGetPlatformApplicationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPlatformApplicationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPlatformApplicationAttributesInput) GoString() string {
+	return s.String()
+}
+
 // Response for GetPlatformApplicationAttributes action.
 type GetPlatformApplicationAttributesOutput struct {
 	// Attributes include the following:
@@ -1140,6 +1591,16 @@ type metadataGetPlatformApplicationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetPlatformApplicationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetPlatformApplicationAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for GetSubscriptionAttributes.
 type GetSubscriptionAttributesInput struct {
 	// The ARN of the subscription whose properties you want to get.
@@ -1152,6 +1613,16 @@ type metadataGetSubscriptionAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSubscriptionAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSubscriptionAttributesInput) GoString() string {
+	return s.String()
+}
+
 // Response for GetSubscriptionAttributes action.
 type GetSubscriptionAttributesOutput struct {
 	// A map of the subscription's attributes. Attributes in this map include the
@@ -1173,6 +1644,16 @@ type metadataGetSubscriptionAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSubscriptionAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSubscriptionAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for GetTopicAttributes action.
 type GetTopicAttributesInput struct {
 	// The ARN of the topic whose properties you want to get.
@@ -1185,6 +1666,16 @@ type metadataGetTopicAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTopicAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTopicAttributesInput) GoString() string {
+	return s.String()
+}
+
 // Response for GetTopicAttributes action.
 type GetTopicAttributesOutput struct {
 	// A map of the topic's attributes. Attributes in this map include the following:
@@ -1208,6 +1699,16 @@ type metadataGetTopicAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetTopicAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetTopicAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for ListEndpointsByPlatformApplication action.
 type ListEndpointsByPlatformApplicationInput struct {
 	// NextToken string is used when calling ListEndpointsByPlatformApplication
@@ -1225,6 +1726,16 @@ type metadataListEndpointsByPlatformApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListEndpointsByPlatformApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListEndpointsByPlatformApplicationInput) GoString() string {
+	return s.String()
+}
+
 // Response for ListEndpointsByPlatformApplication action.
 type ListEndpointsByPlatformApplicationOutput struct {
 	// Endpoints returned for ListEndpointsByPlatformApplication action.
@@ -1241,6 +1752,16 @@ type metadataListEndpointsByPlatformApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListEndpointsByPlatformApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListEndpointsByPlatformApplicationOutput) GoString() string {
+	return s.String()
+}
+
 // Input for ListPlatformApplications action.
 type ListPlatformApplicationsInput struct {
 	// NextToken string is used when calling ListPlatformApplications action to
@@ -1254,6 +1775,16 @@ type metadataListPlatformApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPlatformApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPlatformApplicationsInput) GoString() string {
+	return s.String()
+}
+
 // Response for ListPlatformApplications action.
 type ListPlatformApplicationsOutput struct {
 	// NextToken string is returned when calling ListPlatformApplications action
@@ -1270,6 +1801,16 @@ type metadataListPlatformApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListPlatformApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListPlatformApplicationsOutput) GoString() string {
+	return s.String()
+}
+
 // Input for ListSubscriptionsByTopic action.
 type ListSubscriptionsByTopicInput struct {
 	// Token returned by the previous ListSubscriptionsByTopic request.
@@ -1285,6 +1826,16 @@ type metadataListSubscriptionsByTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSubscriptionsByTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSubscriptionsByTopicInput) GoString() string {
+	return s.String()
+}
+
 // Response for ListSubscriptionsByTopic action.
 type ListSubscriptionsByTopicOutput struct {
 	// Token to pass along to the next ListSubscriptionsByTopic request. This element
@@ -1301,6 +1852,16 @@ type metadataListSubscriptionsByTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSubscriptionsByTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSubscriptionsByTopicOutput) GoString() string {
+	return s.String()
+}
+
 // Input for ListSubscriptions action.
 type ListSubscriptionsInput struct {
 	// Token returned by the previous ListSubscriptions request.
@@ -1313,6 +1874,16 @@ type metadataListSubscriptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSubscriptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSubscriptionsInput) GoString() string {
+	return s.String()
+}
+
 // Response for ListSubscriptions action
 type ListSubscriptionsOutput struct {
 	// Token to pass along to the next ListSubscriptions request. This element is
@@ -1329,6 +1900,16 @@ type metadataListSubscriptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListSubscriptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListSubscriptionsOutput) GoString() string {
+	return s.String()
+}
+
 type ListTopicsInput struct {
 	// Token returned by the previous ListTopics request.
 	NextToken *string `type:"string"`
@@ -1340,6 +1921,16 @@ type metadataListTopicsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTopicsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTopicsInput) GoString() string {
+	return s.String()
+}
+
 // Response for ListTopics action.
 type ListTopicsOutput struct {
 	// Token to pass along to the next ListTopics request. This element is returned
@@ -1356,6 +1947,16 @@ type metadataListTopicsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListTopicsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListTopicsOutput) GoString() string {
+	return s.String()
+}
+
 // The user-specified message attribute value. For string data types, the value
 // attribute has the same restrictions on the content as the message body. For
 // more information, see Publish (http://docs.aws.amazon.com/sns/latest/api/API_Publish.html).
@@ -1385,6 +1986,16 @@ type metadataMessageAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MessageAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MessageAttributeValue) GoString() string {
+	return s.String()
+}
+
 // Platform application object.
 type PlatformApplication struct {
 	// Attributes for platform application object.
@@ -1400,6 +2011,16 @@ type metadataPlatformApplication struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PlatformApplication) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PlatformApplication) GoString() string {
+	return s.String()
+}
+
 // Input for Publish action.
 type PublishInput struct {
 	// The message you want to send to the topic.
@@ -1471,6 +2092,16 @@ type metadataPublishInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PublishInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PublishInput) GoString() string {
+	return s.String()
+}
+
 // Response for Publish action.
 type PublishOutput struct {
 	// Unique identifier assigned to the published message.
@@ -1485,6 +2116,16 @@ type metadataPublishOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PublishOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PublishOutput) GoString() string {
+	return s.String()
+}
+
 // Input for RemovePermission action.
 type RemovePermissionInput struct {
 	// The unique label of the statement you want to remove.
@@ -1500,6 +2141,16 @@ type metadataRemovePermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemovePermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemovePermissionInput) GoString() string {
+	return s.String()
+}
+
 type RemovePermissionOutput struct {
 	metadataRemovePermissionOutput `json:"-" xml:"-"`
 }
@@ -1508,6 +2159,16 @@ type metadataRemovePermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemovePermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemovePermissionOutput) GoString() string {
+	return s.String()
+}
+
 // Input for SetEndpointAttributes action.
 type SetEndpointAttributesInput struct {
 	// A map of the endpoint attributes. Attributes in this map include the following:
@@ -1533,6 +2194,16 @@ type metadataSetEndpointAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetEndpointAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetEndpointAttributesInput) GoString() string {
+	return s.String()
+}
+
 type SetEndpointAttributesOutput struct {
 	metadataSetEndpointAttributesOutput `json:"-" xml:"-"`
 }
@@ -1541,6 +2212,16 @@ type metadataSetEndpointAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetEndpointAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetEndpointAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for SetPlatformApplicationAttributes action.
 type SetPlatformApplicationAttributesInput struct {
 	// A map of the platform application attributes. Attributes in this map include
@@ -1570,6 +2251,16 @@ type metadataSetPlatformApplicationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetPlatformApplicationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetPlatformApplicationAttributesInput) GoString() string {
+	return s.String()
+}
+
 type SetPlatformApplicationAttributesOutput struct {
 	metadataSetPlatformApplicationAttributesOutput `json:"-" xml:"-"`
 }
@@ -1578,6 +2269,16 @@ type metadataSetPlatformApplicationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetPlatformApplicationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetPlatformApplicationAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for SetSubscriptionAttributes action.
 type SetSubscriptionAttributesInput struct {
 	// The name of the attribute you want to set. Only a subset of the subscriptions
@@ -1599,6 +2300,16 @@ type metadataSetSubscriptionAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetSubscriptionAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetSubscriptionAttributesInput) GoString() string {
+	return s.String()
+}
+
 type SetSubscriptionAttributesOutput struct {
 	metadataSetSubscriptionAttributesOutput `json:"-" xml:"-"`
 }
@@ -1607,6 +2318,16 @@ type metadataSetSubscriptionAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetSubscriptionAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetSubscriptionAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for SetTopicAttributes action.
 type SetTopicAttributesInput struct {
 	// The name of the attribute you want to set. Only a subset of the topic's attributes
@@ -1628,6 +2349,16 @@ type metadataSetTopicAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTopicAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTopicAttributesInput) GoString() string {
+	return s.String()
+}
+
 type SetTopicAttributesOutput struct {
 	metadataSetTopicAttributesOutput `json:"-" xml:"-"`
 }
@@ -1636,6 +2367,16 @@ type metadataSetTopicAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetTopicAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetTopicAttributesOutput) GoString() string {
+	return s.String()
+}
+
 // Input for Subscribe action.
 type SubscribeInput struct {
 	// The endpoint that you want to receive notifications. Endpoints vary by protocol:
@@ -1669,6 +2410,16 @@ type metadataSubscribeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubscribeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubscribeInput) GoString() string {
+	return s.String()
+}
+
 // Response for Subscribe action.
 type SubscribeOutput struct {
 	// The ARN of the subscription, if the service was able to create a subscription
@@ -1682,6 +2433,16 @@ type metadataSubscribeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SubscribeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SubscribeOutput) GoString() string {
+	return s.String()
+}
+
 // A wrapper type for the attributes of an Amazon SNS subscription.
 type Subscription struct {
 	// The subscription's endpoint (format depends on the protocol).
@@ -1706,6 +2467,16 @@ type metadataSubscription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Subscription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Subscription) GoString() string {
+	return s.String()
+}
+
 // A wrapper type for the topic's Amazon Resource Name (ARN). To retrieve a
 // topic's attributes, use GetTopicAttributes.
 type Topic struct {
@@ -1719,6 +2490,16 @@ type metadataTopic struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Topic) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Topic) GoString() string {
+	return s.String()
+}
+
 // Input for Unsubscribe action.
 type UnsubscribeInput struct {
 	// The ARN of the subscription to be deleted.
@@ -1731,6 +2512,16 @@ type metadataUnsubscribeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UnsubscribeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnsubscribeInput) GoString() string {
+	return s.String()
+}
+
 type UnsubscribeOutput struct {
 	metadataUnsubscribeOutput `json:"-" xml:"-"`
 }
@@ -1738,3 +2529,13 @@ type UnsubscribeOutput struct {
 type metadataUnsubscribeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UnsubscribeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UnsubscribeOutput) GoString() string {
+	return s.String()
+}
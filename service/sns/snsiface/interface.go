@@ -4,6 +4,7 @@
 package snsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
 )
 
@@ -11,51 +12,101 @@ import (
 type SNSAPI interface {
 	AddPermission(*sns.AddPermissionInput) (*sns.AddPermissionOutput, error)
 
+	AddPermissionWithContext(aws.Context, *sns.AddPermissionInput) (*sns.AddPermissionOutput, error)
+
 	ConfirmSubscription(*sns.ConfirmSubscriptionInput) (*sns.ConfirmSubscriptionOutput, error)
 
+	ConfirmSubscriptionWithContext(aws.Context, *sns.ConfirmSubscriptionInput) (*sns.ConfirmSubscriptionOutput, error)
+
 	CreatePlatformApplication(*sns.CreatePlatformApplicationInput) (*sns.CreatePlatformApplicationOutput, error)
 
+	CreatePlatformApplicationWithContext(aws.Context, *sns.CreatePlatformApplicationInput) (*sns.CreatePlatformApplicationOutput, error)
+
 	CreatePlatformEndpoint(*sns.CreatePlatformEndpointInput) (*sns.CreatePlatformEndpointOutput, error)
 
+	CreatePlatformEndpointWithContext(aws.Context, *sns.CreatePlatformEndpointInput) (*sns.CreatePlatformEndpointOutput, error)
+
 	CreateTopic(*sns.CreateTopicInput) (*sns.CreateTopicOutput, error)
 
+	CreateTopicWithContext(aws.Context, *sns.CreateTopicInput) (*sns.CreateTopicOutput, error)
+
 	DeleteEndpoint(*sns.DeleteEndpointInput) (*sns.DeleteEndpointOutput, error)
 
+	DeleteEndpointWithContext(aws.Context, *sns.DeleteEndpointInput) (*sns.DeleteEndpointOutput, error)
+
 	DeletePlatformApplication(*sns.DeletePlatformApplicationInput) (*sns.DeletePlatformApplicationOutput, error)
 
+	DeletePlatformApplicationWithContext(aws.Context, *sns.DeletePlatformApplicationInput) (*sns.DeletePlatformApplicationOutput, error)
+
 	DeleteTopic(*sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error)
 
+	DeleteTopicWithContext(aws.Context, *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error)
+
 	GetEndpointAttributes(*sns.GetEndpointAttributesInput) (*sns.GetEndpointAttributesOutput, error)
 
+	GetEndpointAttributesWithContext(aws.Context, *sns.GetEndpointAttributesInput) (*sns.GetEndpointAttributesOutput, error)
+
 	GetPlatformApplicationAttributes(*sns.GetPlatformApplicationAttributesInput) (*sns.GetPlatformApplicationAttributesOutput, error)
 
+	GetPlatformApplicationAttributesWithContext(aws.Context, *sns.GetPlatformApplicationAttributesInput) (*sns.GetPlatformApplicationAttributesOutput, error)
+
 	GetSubscriptionAttributes(*sns.GetSubscriptionAttributesInput) (*sns.GetSubscriptionAttributesOutput, error)
 
+	GetSubscriptionAttributesWithContext(aws.Context, *sns.GetSubscriptionAttributesInput) (*sns.GetSubscriptionAttributesOutput, error)
+
 	GetTopicAttributes(*sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error)
 
+	GetTopicAttributesWithContext(aws.Context, *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error)
+
 	ListEndpointsByPlatformApplication(*sns.ListEndpointsByPlatformApplicationInput) (*sns.ListEndpointsByPlatformApplicationOutput, error)
 
+	ListEndpointsByPlatformApplicationWithContext(aws.Context, *sns.ListEndpointsByPlatformApplicationInput) (*sns.ListEndpointsByPlatformApplicationOutput, error)
+
 	ListPlatformApplications(*sns.ListPlatformApplicationsInput) (*sns.ListPlatformApplicationsOutput, error)
 
+	ListPlatformApplicationsWithContext(aws.Context, *sns.ListPlatformApplicationsInput) (*sns.ListPlatformApplicationsOutput, error)
+
 	ListSubscriptions(*sns.ListSubscriptionsInput) (*sns.ListSubscriptionsOutput, error)
 
+	ListSubscriptionsWithContext(aws.Context, *sns.ListSubscriptionsInput) (*sns.ListSubscriptionsOutput, error)
+
 	ListSubscriptionsByTopic(*sns.ListSubscriptionsByTopicInput) (*sns.ListSubscriptionsByTopicOutput, error)
 
+	ListSubscriptionsByTopicWithContext(aws.Context, *sns.ListSubscriptionsByTopicInput) (*sns.ListSubscriptionsByTopicOutput, error)
+
 	ListTopics(*sns.ListTopicsInput) (*sns.ListTopicsOutput, error)
 
+	ListTopicsWithContext(aws.Context, *sns.ListTopicsInput) (*sns.ListTopicsOutput, error)
+
 	Publish(*sns.PublishInput) (*sns.PublishOutput, error)
 
+	PublishWithContext(aws.Context, *sns.PublishInput) (*sns.PublishOutput, error)
+
 	RemovePermission(*sns.RemovePermissionInput) (*sns.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(aws.Context, *sns.RemovePermissionInput) (*sns.RemovePermissionOutput, error)
+
 	SetEndpointAttributes(*sns.SetEndpointAttributesInput) (*sns.SetEndpointAttributesOutput, error)
 
+	SetEndpointAttributesWithContext(aws.Context, *sns.SetEndpointAttributesInput) (*sns.SetEndpointAttributesOutput, error)
+
 	SetPlatformApplicationAttributes(*sns.SetPlatformApplicationAttributesInput) (*sns.SetPlatformApplicationAttributesOutput, error)
 
+	SetPlatformApplicationAttributesWithContext(aws.Context, *sns.SetPlatformApplicationAttributesInput) (*sns.SetPlatformApplicationAttributesOutput, error)
+
 	SetSubscriptionAttributes(*sns.SetSubscriptionAttributesInput) (*sns.SetSubscriptionAttributesOutput, error)
 
+	SetSubscriptionAttributesWithContext(aws.Context, *sns.SetSubscriptionAttributesInput) (*sns.SetSubscriptionAttributesOutput, error)
+
 	SetTopicAttributes(*sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error)
 
+	SetTopicAttributesWithContext(aws.Context, *sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error)
+
 	Subscribe(*sns.SubscribeInput) (*sns.SubscribeOutput, error)
 
+	SubscribeWithContext(aws.Context, *sns.SubscribeInput) (*sns.SubscribeOutput, error)
+
 	Unsubscribe(*sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error)
+
+	UnsubscribeWithContext(aws.Context, *sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error)
 }
@@ -5,6 +5,7 @@ package sqs
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddPermission = "AddPermission"
@@ -51,6 +52,16 @@ func (c *SQS) AddPermission(input *AddPermissionInput) (*AddPermissionOutput, er
 	return out, err
 }
 
+// AddPermissionWithContext is the same as AddPermission with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) AddPermissionWithContext(ctx aws.Context, input *AddPermissionInput) (*AddPermissionOutput, error) {
+	req, out := c.AddPermissionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opChangeMessageVisibility = "ChangeMessageVisibility"
 
 // ChangeMessageVisibilityRequest generates a request for the ChangeMessageVisibility operation.
@@ -107,6 +118,16 @@ func (c *SQS) ChangeMessageVisibility(input *ChangeMessageVisibilityInput) (*Cha
 	return out, err
 }
 
+// ChangeMessageVisibilityWithContext is the same as ChangeMessageVisibility with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) ChangeMessageVisibilityWithContext(ctx aws.Context, input *ChangeMessageVisibilityInput) (*ChangeMessageVisibilityOutput, error) {
+	req, out := c.ChangeMessageVisibilityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opChangeMessageVisibilityBatch = "ChangeMessageVisibilityBatch"
 
 // ChangeMessageVisibilityBatchRequest generates a request for the ChangeMessageVisibilityBatch operation.
@@ -146,6 +167,16 @@ func (c *SQS) ChangeMessageVisibilityBatch(input *ChangeMessageVisibilityBatchIn
 	return out, err
 }
 
+// ChangeMessageVisibilityBatchWithContext is the same as ChangeMessageVisibilityBatch with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) ChangeMessageVisibilityBatchWithContext(ctx aws.Context, input *ChangeMessageVisibilityBatchInput) (*ChangeMessageVisibilityBatchOutput, error) {
+	req, out := c.ChangeMessageVisibilityBatchRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateQueue = "CreateQueue"
 
 // CreateQueueRequest generates a request for the CreateQueue operation.
@@ -197,6 +228,16 @@ func (c *SQS) CreateQueue(input *CreateQueueInput) (*CreateQueueOutput, error) {
 	return out, err
 }
 
+// CreateQueueWithContext is the same as CreateQueue with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) CreateQueueWithContext(ctx aws.Context, input *CreateQueueInput) (*CreateQueueOutput, error) {
+	req, out := c.CreateQueueRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMessage = "DeleteMessage"
 
 // DeleteMessageRequest generates a request for the DeleteMessage operation.
@@ -243,6 +284,16 @@ func (c *SQS) DeleteMessage(input *DeleteMessageInput) (*DeleteMessageOutput, er
 	return out, err
 }
 
+// DeleteMessageWithContext is the same as DeleteMessage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) DeleteMessageWithContext(ctx aws.Context, input *DeleteMessageInput) (*DeleteMessageOutput, error) {
+	req, out := c.DeleteMessageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteMessageBatch = "DeleteMessageBatch"
 
 // DeleteMessageBatchRequest generates a request for the DeleteMessageBatch operation.
@@ -282,6 +333,16 @@ func (c *SQS) DeleteMessageBatch(input *DeleteMessageBatchInput) (*DeleteMessage
 	return out, err
 }
 
+// DeleteMessageBatchWithContext is the same as DeleteMessageBatch with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) DeleteMessageBatchWithContext(ctx aws.Context, input *DeleteMessageBatchInput) (*DeleteMessageBatchOutput, error) {
+	req, out := c.DeleteMessageBatchRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteQueue = "DeleteQueue"
 
 // DeleteQueueRequest generates a request for the DeleteQueue operation.
@@ -325,6 +386,16 @@ func (c *SQS) DeleteQueue(input *DeleteQueueInput) (*DeleteQueueOutput, error) {
 	return out, err
 }
 
+// DeleteQueueWithContext is the same as DeleteQueue with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) DeleteQueueWithContext(ctx aws.Context, input *DeleteQueueInput) (*DeleteQueueOutput, error) {
+	req, out := c.DeleteQueueRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetQueueAttributes = "GetQueueAttributes"
 
 // GetQueueAttributesRequest generates a request for the GetQueueAttributes operation.
@@ -386,6 +457,16 @@ func (c *SQS) GetQueueAttributes(input *GetQueueAttributesInput) (*GetQueueAttri
 	return out, err
 }
 
+// GetQueueAttributesWithContext is the same as GetQueueAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) GetQueueAttributesWithContext(ctx aws.Context, input *GetQueueAttributesInput) (*GetQueueAttributesOutput, error) {
+	req, out := c.GetQueueAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetQueueURL = "GetQueueUrl"
 
 // GetQueueURLRequest generates a request for the GetQueueURL operation.
@@ -420,6 +501,16 @@ func (c *SQS) GetQueueURL(input *GetQueueURLInput) (*GetQueueURLOutput, error) {
 	return out, err
 }
 
+// GetQueueURLWithContext is the same as GetQueueURL with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) GetQueueURLWithContext(ctx aws.Context, input *GetQueueURLInput) (*GetQueueURLOutput, error) {
+	req, out := c.GetQueueURLRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListDeadLetterSourceQueues = "ListDeadLetterSourceQueues"
 
 // ListDeadLetterSourceQueuesRequest generates a request for the ListDeadLetterSourceQueues operation.
@@ -451,6 +542,16 @@ func (c *SQS) ListDeadLetterSourceQueues(input *ListDeadLetterSourceQueuesInput)
 	return out, err
 }
 
+// ListDeadLetterSourceQueuesWithContext is the same as ListDeadLetterSourceQueues with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) ListDeadLetterSourceQueuesWithContext(ctx aws.Context, input *ListDeadLetterSourceQueuesInput) (*ListDeadLetterSourceQueuesOutput, error) {
+	req, out := c.ListDeadLetterSourceQueuesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListQueues = "ListQueues"
 
 // ListQueuesRequest generates a request for the ListQueues operation.
@@ -480,6 +581,16 @@ func (c *SQS) ListQueues(input *ListQueuesInput) (*ListQueuesOutput, error) {
 	return out, err
 }
 
+// ListQueuesWithContext is the same as ListQueues with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) ListQueuesWithContext(ctx aws.Context, input *ListQueuesInput) (*ListQueuesOutput, error) {
+	req, out := c.ListQueuesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPurgeQueue = "PurgeQueue"
 
 // PurgeQueueRequest generates a request for the PurgeQueue operation.
@@ -515,6 +626,16 @@ func (c *SQS) PurgeQueue(input *PurgeQueueInput) (*PurgeQueueOutput, error) {
 	return out, err
 }
 
+// PurgeQueueWithContext is the same as PurgeQueue with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) PurgeQueueWithContext(ctx aws.Context, input *PurgeQueueInput) (*PurgeQueueOutput, error) {
+	req, out := c.PurgeQueueRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opReceiveMessage = "ReceiveMessage"
 
 // ReceiveMessageRequest generates a request for the ReceiveMessage operation.
@@ -583,6 +704,16 @@ func (c *SQS) ReceiveMessage(input *ReceiveMessageInput) (*ReceiveMessageOutput,
 	return out, err
 }
 
+// ReceiveMessageWithContext is the same as ReceiveMessage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) ReceiveMessageWithContext(ctx aws.Context, input *ReceiveMessageInput) (*ReceiveMessageOutput, error) {
+	req, out := c.ReceiveMessageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRemovePermission = "RemovePermission"
 
 // RemovePermissionRequest generates a request for the RemovePermission operation.
@@ -611,6 +742,16 @@ func (c *SQS) RemovePermission(input *RemovePermissionInput) (*RemovePermissionO
 	return out, err
 }
 
+// RemovePermissionWithContext is the same as RemovePermission with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) RemovePermissionWithContext(ctx aws.Context, input *RemovePermissionInput) (*RemovePermissionOutput, error) {
+	req, out := c.RemovePermissionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSendMessage = "SendMessage"
 
 // SendMessageRequest generates a request for the SendMessage operation.
@@ -649,6 +790,16 @@ func (c *SQS) SendMessage(input *SendMessageInput) (*SendMessageOutput, error) {
 	return out, err
 }
 
+// SendMessageWithContext is the same as SendMessage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) SendMessageWithContext(ctx aws.Context, input *SendMessageInput) (*SendMessageOutput, error) {
+	req, out := c.SendMessageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSendMessageBatch = "SendMessageBatch"
 
 // SendMessageBatchRequest generates a request for the SendMessageBatch operation.
@@ -701,6 +852,16 @@ func (c *SQS) SendMessageBatch(input *SendMessageBatchInput) (*SendMessageBatchO
 	return out, err
 }
 
+// SendMessageBatchWithContext is the same as SendMessageBatch with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) SendMessageBatchWithContext(ctx aws.Context, input *SendMessageBatchInput) (*SendMessageBatchOutput, error) {
+	req, out := c.SendMessageBatchRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSetQueueAttributes = "SetQueueAttributes"
 
 // SetQueueAttributesRequest generates a request for the SetQueueAttributes operation.
@@ -735,6 +896,16 @@ func (c *SQS) SetQueueAttributes(input *SetQueueAttributesInput) (*SetQueueAttri
 	return out, err
 }
 
+// SetQueueAttributesWithContext is the same as SetQueueAttributes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SQS) SetQueueAttributesWithContext(ctx aws.Context, input *SetQueueAttributesInput) (*SetQueueAttributesOutput, error) {
+	req, out := c.SetQueueAttributesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type AddPermissionInput struct {
 	// The AWS account number of the principal (http://docs.aws.amazon.com/general/latest/gr/glos-chap.html#P)
 	// who will be given permission. The principal must have an AWS account, but
@@ -769,6 +940,16 @@ type metadataAddPermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddPermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddPermissionInput) GoString() string {
+	return s.String()
+}
+
 type AddPermissionOutput struct {
 	metadataAddPermissionOutput `json:"-" xml:"-"`
 }
@@ -777,6 +958,16 @@ type metadataAddPermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddPermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddPermissionOutput) GoString() string {
+	return s.String()
+}
+
 // This is used in the responses of batch API to give a detailed description
 // of the result of an action on each entry in the request.
 type BatchResultErrorEntry struct {
@@ -799,6 +990,16 @@ type metadataBatchResultErrorEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s BatchResultErrorEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s BatchResultErrorEntry) GoString() string {
+	return s.String()
+}
+
 type ChangeMessageVisibilityBatchInput struct {
 	// A list of receipt handles of the messages for which the visibility timeout
 	// must be changed.
@@ -814,6 +1015,16 @@ type metadataChangeMessageVisibilityBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeMessageVisibilityBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeMessageVisibilityBatchInput) GoString() string {
+	return s.String()
+}
+
 // For each message in the batch, the response contains a ChangeMessageVisibilityBatchResultEntry
 // tag if the message succeeds or a BatchResultErrorEntry tag if the message
 // fails.
@@ -831,6 +1042,16 @@ type metadataChangeMessageVisibilityBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeMessageVisibilityBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeMessageVisibilityBatchOutput) GoString() string {
+	return s.String()
+}
+
 // Encloses a receipt handle and an entry id for each message in ChangeMessageVisibilityBatch.
 //
 //  All of the following parameters are list parameters that must be prefixed
@@ -862,6 +1083,16 @@ type metadataChangeMessageVisibilityBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeMessageVisibilityBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeMessageVisibilityBatchRequestEntry) GoString() string {
+	return s.String()
+}
+
 // Encloses the id of an entry in ChangeMessageVisibilityBatch.
 type ChangeMessageVisibilityBatchResultEntry struct {
 	// Represents a message whose visibility timeout has been changed successfully.
@@ -874,6 +1105,16 @@ type metadataChangeMessageVisibilityBatchResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeMessageVisibilityBatchResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeMessageVisibilityBatchResultEntry) GoString() string {
+	return s.String()
+}
+
 type ChangeMessageVisibilityInput struct {
 	// The URL of the Amazon SQS queue to take action on.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -893,6 +1134,16 @@ type metadataChangeMessageVisibilityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeMessageVisibilityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeMessageVisibilityInput) GoString() string {
+	return s.String()
+}
+
 type ChangeMessageVisibilityOutput struct {
 	metadataChangeMessageVisibilityOutput `json:"-" xml:"-"`
 }
@@ -901,6 +1152,16 @@ type metadataChangeMessageVisibilityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChangeMessageVisibilityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChangeMessageVisibilityOutput) GoString() string {
+	return s.String()
+}
+
 type CreateQueueInput struct {
 	// A map of attributes with their corresponding values.
 	//
@@ -936,6 +1197,16 @@ type metadataCreateQueueInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateQueueInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateQueueInput) GoString() string {
+	return s.String()
+}
+
 // Returns the QueueUrl element of the created queue.
 type CreateQueueOutput struct {
 	// The URL for the created Amazon SQS queue.
@@ -948,6 +1219,16 @@ type metadataCreateQueueOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateQueueOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateQueueOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteMessageBatchInput struct {
 	// A list of receipt handles for the messages to be deleted.
 	Entries []*DeleteMessageBatchRequestEntry `locationNameList:"DeleteMessageBatchRequestEntry" type:"list" flattened:"true" required:"true"`
@@ -962,6 +1243,16 @@ type metadataDeleteMessageBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMessageBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMessageBatchInput) GoString() string {
+	return s.String()
+}
+
 // For each message in the batch, the response contains a DeleteMessageBatchResultEntry
 // tag if the message is deleted or a BatchResultErrorEntry tag if the message
 // cannot be deleted.
@@ -979,6 +1270,16 @@ type metadataDeleteMessageBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMessageBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMessageBatchOutput) GoString() string {
+	return s.String()
+}
+
 // Encloses a receipt handle and an identifier for it.
 type DeleteMessageBatchRequestEntry struct {
 	// An identifier for this particular receipt handle. This is used to communicate
@@ -996,6 +1297,16 @@ type metadataDeleteMessageBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMessageBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMessageBatchRequestEntry) GoString() string {
+	return s.String()
+}
+
 // Encloses the id an entry in DeleteMessageBatch.
 type DeleteMessageBatchResultEntry struct {
 	// Represents a successfully deleted message.
@@ -1008,6 +1319,16 @@ type metadataDeleteMessageBatchResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMessageBatchResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMessageBatchResultEntry) GoString() string {
+	return s.String()
+}
+
 type DeleteMessageInput struct {
 	// The URL of the Amazon SQS queue to take action on.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -1022,6 +1343,16 @@ type metadataDeleteMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMessageInput) GoString() string {
+	return s.String()
+}
+
 type DeleteMessageOutput struct {
 	metadataDeleteMessageOutput `json:"-" xml:"-"`
 }
@@ -1030,6 +1361,16 @@ type metadataDeleteMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteMessageOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteQueueInput struct {
 	// The URL of the Amazon SQS queue to take action on.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -1041,6 +1382,16 @@ type metadataDeleteQueueInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteQueueInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteQueueInput) GoString() string {
+	return s.String()
+}
+
 type DeleteQueueOutput struct {
 	metadataDeleteQueueOutput `json:"-" xml:"-"`
 }
@@ -1049,6 +1400,16 @@ type metadataDeleteQueueOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteQueueOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteQueueOutput) GoString() string {
+	return s.String()
+}
+
 type GetQueueAttributesInput struct {
 	// A list of attributes to retrieve information for.
 	AttributeNames []*string `locationNameList:"AttributeName" type:"list" flattened:"true"`
@@ -1063,6 +1424,16 @@ type metadataGetQueueAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetQueueAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetQueueAttributesInput) GoString() string {
+	return s.String()
+}
+
 // A list of returned queue attributes.
 type GetQueueAttributesOutput struct {
 	// A map of attributes to the respective values.
@@ -1075,6 +1446,16 @@ type metadataGetQueueAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetQueueAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetQueueAttributesOutput) GoString() string {
+	return s.String()
+}
+
 type GetQueueURLInput struct {
 	// The name of the queue whose URL must be fetched. Maximum 80 characters; alphanumeric
 	// characters, hyphens (-), and underscores (_) are allowed.
@@ -1090,6 +1471,16 @@ type metadataGetQueueURLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetQueueURLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetQueueURLInput) GoString() string {
+	return s.String()
+}
+
 // For more information, see Responses (http://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/UnderstandingResponses.html)
 // in the Amazon SQS Developer Guide.
 type GetQueueURLOutput struct {
@@ -1103,6 +1494,16 @@ type metadataGetQueueURLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetQueueURLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetQueueURLOutput) GoString() string {
+	return s.String()
+}
+
 type ListDeadLetterSourceQueuesInput struct {
 	// The queue URL of a dead letter queue.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -1114,6 +1515,16 @@ type metadataListDeadLetterSourceQueuesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeadLetterSourceQueuesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeadLetterSourceQueuesInput) GoString() string {
+	return s.String()
+}
+
 // A list of your dead letter source queues.
 type ListDeadLetterSourceQueuesOutput struct {
 	// A list of source queue URLs that have the RedrivePolicy queue attribute configured
@@ -1127,6 +1538,16 @@ type metadataListDeadLetterSourceQueuesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDeadLetterSourceQueuesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDeadLetterSourceQueuesOutput) GoString() string {
+	return s.String()
+}
+
 type ListQueuesInput struct {
 	// A string to use for filtering the list results. Only those queues whose name
 	// begins with the specified string are returned.
@@ -1139,6 +1560,16 @@ type metadataListQueuesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListQueuesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListQueuesInput) GoString() string {
+	return s.String()
+}
+
 // A list of your queues.
 type ListQueuesOutput struct {
 	// A list of queue URLs, up to 1000 entries.
@@ -1151,6 +1582,16 @@ type metadataListQueuesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListQueuesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListQueuesOutput) GoString() string {
+	return s.String()
+}
+
 // An Amazon SQS message.
 type Message struct {
 	// SenderId, SentTimestamp, ApproximateReceiveCount, and/or ApproximateFirstReceiveTimestamp.
@@ -1191,6 +1632,16 @@ type metadataMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Message) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Message) GoString() string {
+	return s.String()
+}
+
 // The user-specified message attribute value. For string data types, the value
 // attribute has the same restrictions on the content as the message body. For
 // more information, see SendMessage (http://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_SendMessage.html).
@@ -1226,6 +1677,16 @@ type metadataMessageAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MessageAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MessageAttributeValue) GoString() string {
+	return s.String()
+}
+
 type PurgeQueueInput struct {
 	// The queue URL of the queue to delete the messages from when using the PurgeQueue
 	// API.
@@ -1238,6 +1699,16 @@ type metadataPurgeQueueInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PurgeQueueInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PurgeQueueInput) GoString() string {
+	return s.String()
+}
+
 type PurgeQueueOutput struct {
 	metadataPurgeQueueOutput `json:"-" xml:"-"`
 }
@@ -1246,6 +1717,16 @@ type metadataPurgeQueueOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PurgeQueueOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PurgeQueueOutput) GoString() string {
+	return s.String()
+}
+
 type ReceiveMessageInput struct {
 	// A list of attributes that need to be returned along with each message.
 	//
@@ -1302,6 +1783,16 @@ type metadataReceiveMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReceiveMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReceiveMessageInput) GoString() string {
+	return s.String()
+}
+
 // A list of received messages.
 type ReceiveMessageOutput struct {
 	// A list of messages.
@@ -1314,6 +1805,16 @@ type metadataReceiveMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ReceiveMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ReceiveMessageOutput) GoString() string {
+	return s.String()
+}
+
 type RemovePermissionInput struct {
 	// The identification of the permission to remove. This is the label added with
 	// the AddPermission action.
@@ -1329,6 +1830,16 @@ type metadataRemovePermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemovePermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemovePermissionInput) GoString() string {
+	return s.String()
+}
+
 type RemovePermissionOutput struct {
 	metadataRemovePermissionOutput `json:"-" xml:"-"`
 }
@@ -1337,6 +1848,16 @@ type metadataRemovePermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RemovePermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RemovePermissionOutput) GoString() string {
+	return s.String()
+}
+
 type SendMessageBatchInput struct {
 	// A list of SendMessageBatchRequestEntry items.
 	Entries []*SendMessageBatchRequestEntry `locationNameList:"SendMessageBatchRequestEntry" type:"list" flattened:"true" required:"true"`
@@ -1351,6 +1872,16 @@ type metadataSendMessageBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendMessageBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendMessageBatchInput) GoString() string {
+	return s.String()
+}
+
 // For each message in the batch, the response contains a SendMessageBatchResultEntry
 // tag if the message succeeds or a BatchResultErrorEntry tag if the message
 // fails.
@@ -1369,6 +1900,16 @@ type metadataSendMessageBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendMessageBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendMessageBatchOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the details of a single Amazon SQS message along with a Id.
 type SendMessageBatchRequestEntry struct {
 	// The number of seconds for which the message has to be delayed.
@@ -1393,6 +1934,16 @@ type metadataSendMessageBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendMessageBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendMessageBatchRequestEntry) GoString() string {
+	return s.String()
+}
+
 // Encloses a message ID for successfully enqueued message of a SendMessageBatch.
 type SendMessageBatchResultEntry struct {
 	// An identifier for the message in this batch.
@@ -1420,6 +1971,16 @@ type metadataSendMessageBatchResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendMessageBatchResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendMessageBatchResultEntry) GoString() string {
+	return s.String()
+}
+
 type SendMessageInput struct {
 	// The number of seconds (0 to 900 - 15 minutes) to delay a specific message.
 	// Messages with a positive DelaySeconds value become available for processing
@@ -1445,6 +2006,16 @@ type metadataSendMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendMessageInput) GoString() string {
+	return s.String()
+}
+
 // The MD5OfMessageBody and MessageId elements.
 type SendMessageOutput struct {
 	// An MD5 digest of the non-URL-encoded message attribute string. This can be
@@ -1471,6 +2042,16 @@ type metadataSendMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SendMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SendMessageOutput) GoString() string {
+	return s.String()
+}
+
 type SetQueueAttributesInput struct {
 	// A map of attributes to set.
 	//
@@ -1508,6 +2089,16 @@ type metadataSetQueueAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SetQueueAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetQueueAttributesInput) GoString() string {
+	return s.String()
+}
+
 type SetQueueAttributesOutput struct {
 	metadataSetQueueAttributesOutput `json:"-" xml:"-"`
 }
@@ -1515,3 +2106,42 @@ type SetQueueAttributesOutput struct {
 type metadataSetQueueAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s SetQueueAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SetQueueAttributesOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum QueueAttributeName
+	QueueAttributeNamePolicy = "Policy"
+	// @enum QueueAttributeName
+	QueueAttributeNameVisibilityTimeout = "VisibilityTimeout"
+	// @enum QueueAttributeName
+	QueueAttributeNameMaximumMessageSize = "MaximumMessageSize"
+	// @enum QueueAttributeName
+	QueueAttributeNameMessageRetentionPeriod = "MessageRetentionPeriod"
+	// @enum QueueAttributeName
+	QueueAttributeNameApproximateNumberOfMessages = "ApproximateNumberOfMessages"
+	// @enum QueueAttributeName
+	QueueAttributeNameApproximateNumberOfMessagesNotVisible = "ApproximateNumberOfMessagesNotVisible"
+	// @enum QueueAttributeName
+	QueueAttributeNameCreatedTimestamp = "CreatedTimestamp"
+	// @enum QueueAttributeName
+	QueueAttributeNameLastModifiedTimestamp = "LastModifiedTimestamp"
+	// @enum QueueAttributeName
+	QueueAttributeNameQueueArn = "QueueArn"
+	// @enum QueueAttributeName
+	QueueAttributeNameApproximateNumberOfMessagesDelayed = "ApproximateNumberOfMessagesDelayed"
+	// @enum QueueAttributeName
+	QueueAttributeNameDelaySeconds = "DelaySeconds"
+	// @enum QueueAttributeName
+	QueueAttributeNameReceiveMessageWaitTimeSeconds = "ReceiveMessageWaitTimeSeconds"
+	// @enum QueueAttributeName
+	QueueAttributeNameRedrivePolicy = "RedrivePolicy"
+)
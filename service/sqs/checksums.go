@@ -15,18 +15,27 @@ var (
 	errChecksumMissingMD5  = fmt.Errorf("cannot verify checksum. missing response MD5")
 )
 
+// Named request handlers which verify the MD5 checksums of SQS messages.
+// These can be used to remove, or swap out, an individual checksum
+// validation from a request's handler lists.
+var (
+	VerifySendMessageHandler      = aws.NamedHandler{Name: "sqs.VerifySendMessageHandler", Fn: verifySendMessage}
+	VerifySendMessageBatchHandler = aws.NamedHandler{Name: "sqs.VerifySendMessageBatchHandler", Fn: verifySendMessageBatch}
+	VerifyReceiveMessageHandler   = aws.NamedHandler{Name: "sqs.VerifyReceiveMessageHandler", Fn: verifyReceiveMessage}
+)
+
 func setupChecksumValidation(r *aws.Request) {
-	if r.Config.DisableComputeChecksums {
+	if aws.BooleanValue(r.Config.DisableComputeChecksums) {
 		return
 	}
 
 	switch r.Operation.Name {
 	case opSendMessage:
-		r.Handlers.Unmarshal.PushBack(verifySendMessage)
+		r.Handlers.Unmarshal.PushBackNamed(VerifySendMessageHandler)
 	case opSendMessageBatch:
-		r.Handlers.Unmarshal.PushBack(verifySendMessageBatch)
+		r.Handlers.Unmarshal.PushBackNamed(VerifySendMessageBatchHandler)
 	case opReceiveMessage:
-		r.Handlers.Unmarshal.PushBack(verifyReceiveMessage)
+		r.Handlers.Unmarshal.PushBackNamed(VerifyReceiveMessageHandler)
 	}
 }
 
@@ -4,6 +4,7 @@
 package sqsiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 )
 
@@ -11,35 +12,69 @@ import (
 type SQSAPI interface {
 	AddPermission(*sqs.AddPermissionInput) (*sqs.AddPermissionOutput, error)
 
+	AddPermissionWithContext(aws.Context, *sqs.AddPermissionInput) (*sqs.AddPermissionOutput, error)
+
 	ChangeMessageVisibility(*sqs.ChangeMessageVisibilityInput) (*sqs.ChangeMessageVisibilityOutput, error)
 
+	ChangeMessageVisibilityWithContext(aws.Context, *sqs.ChangeMessageVisibilityInput) (*sqs.ChangeMessageVisibilityOutput, error)
+
 	ChangeMessageVisibilityBatch(*sqs.ChangeMessageVisibilityBatchInput) (*sqs.ChangeMessageVisibilityBatchOutput, error)
 
+	ChangeMessageVisibilityBatchWithContext(aws.Context, *sqs.ChangeMessageVisibilityBatchInput) (*sqs.ChangeMessageVisibilityBatchOutput, error)
+
 	CreateQueue(*sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error)
 
+	CreateQueueWithContext(aws.Context, *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error)
+
 	DeleteMessage(*sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error)
 
+	DeleteMessageWithContext(aws.Context, *sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error)
+
 	DeleteMessageBatch(*sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error)
 
+	DeleteMessageBatchWithContext(aws.Context, *sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error)
+
 	DeleteQueue(*sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error)
 
+	DeleteQueueWithContext(aws.Context, *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error)
+
 	GetQueueAttributes(*sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
 
+	GetQueueAttributesWithContext(aws.Context, *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+
 	GetQueueURL(*sqs.GetQueueURLInput) (*sqs.GetQueueURLOutput, error)
 
+	GetQueueURLWithContext(aws.Context, *sqs.GetQueueURLInput) (*sqs.GetQueueURLOutput, error)
+
 	ListDeadLetterSourceQueues(*sqs.ListDeadLetterSourceQueuesInput) (*sqs.ListDeadLetterSourceQueuesOutput, error)
 
+	ListDeadLetterSourceQueuesWithContext(aws.Context, *sqs.ListDeadLetterSourceQueuesInput) (*sqs.ListDeadLetterSourceQueuesOutput, error)
+
 	ListQueues(*sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error)
 
+	ListQueuesWithContext(aws.Context, *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error)
+
 	PurgeQueue(*sqs.PurgeQueueInput) (*sqs.PurgeQueueOutput, error)
 
+	PurgeQueueWithContext(aws.Context, *sqs.PurgeQueueInput) (*sqs.PurgeQueueOutput, error)
+
 	ReceiveMessage(*sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
 
+	ReceiveMessageWithContext(aws.Context, *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
+
 	RemovePermission(*sqs.RemovePermissionInput) (*sqs.RemovePermissionOutput, error)
 
+	RemovePermissionWithContext(aws.Context, *sqs.RemovePermissionInput) (*sqs.RemovePermissionOutput, error)
+
 	SendMessage(*sqs.SendMessageInput) (*sqs.SendMessageOutput, error)
 
+	SendMessageWithContext(aws.Context, *sqs.SendMessageInput) (*sqs.SendMessageOutput, error)
+
 	SendMessageBatch(*sqs.SendMessageBatchInput) (*sqs.SendMessageBatchOutput, error)
 
+	SendMessageBatchWithContext(aws.Context, *sqs.SendMessageBatchInput) (*sqs.SendMessageBatchOutput, error)
+
 	SetQueueAttributes(*sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error)
+
+	SetQueueAttributesWithContext(aws.Context, *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error)
 }
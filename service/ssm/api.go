@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateAssociation = "CreateAssociation"
@@ -44,6 +45,16 @@ func (c *SSM) CreateAssociation(input *CreateAssociationInput) (*CreateAssociati
 	return out, err
 }
 
+// CreateAssociationWithContext is the same as CreateAssociation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) CreateAssociationWithContext(ctx aws.Context, input *CreateAssociationInput) (*CreateAssociationOutput, error) {
+	req, out := c.CreateAssociationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateAssociationBatch = "CreateAssociationBatch"
 
 // CreateAssociationBatchRequest generates a request for the CreateAssociationBatch operation.
@@ -79,6 +90,16 @@ func (c *SSM) CreateAssociationBatch(input *CreateAssociationBatchInput) (*Creat
 	return out, err
 }
 
+// CreateAssociationBatchWithContext is the same as CreateAssociationBatch with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) CreateAssociationBatchWithContext(ctx aws.Context, input *CreateAssociationBatchInput) (*CreateAssociationBatchOutput, error) {
+	req, out := c.CreateAssociationBatchRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateDocument = "CreateDocument"
 
 // CreateDocumentRequest generates a request for the CreateDocument operation.
@@ -109,6 +130,16 @@ func (c *SSM) CreateDocument(input *CreateDocumentInput) (*CreateDocumentOutput,
 	return out, err
 }
 
+// CreateDocumentWithContext is the same as CreateDocument with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) CreateDocumentWithContext(ctx aws.Context, input *CreateDocumentInput) (*CreateDocumentOutput, error) {
+	req, out := c.CreateDocumentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteAssociation = "DeleteAssociation"
 
 // DeleteAssociationRequest generates a request for the DeleteAssociation operation.
@@ -142,6 +173,16 @@ func (c *SSM) DeleteAssociation(input *DeleteAssociationInput) (*DeleteAssociati
 	return out, err
 }
 
+// DeleteAssociationWithContext is the same as DeleteAssociation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) DeleteAssociationWithContext(ctx aws.Context, input *DeleteAssociationInput) (*DeleteAssociationOutput, error) {
+	req, out := c.DeleteAssociationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteDocument = "DeleteDocument"
 
 // DeleteDocumentRequest generates a request for the DeleteDocument operation.
@@ -172,6 +213,16 @@ func (c *SSM) DeleteDocument(input *DeleteDocumentInput) (*DeleteDocumentOutput,
 	return out, err
 }
 
+// DeleteDocumentWithContext is the same as DeleteDocument with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) DeleteDocumentWithContext(ctx aws.Context, input *DeleteDocumentInput) (*DeleteDocumentOutput, error) {
+	req, out := c.DeleteDocumentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAssociation = "DescribeAssociation"
 
 // DescribeAssociationRequest generates a request for the DescribeAssociation operation.
@@ -199,6 +250,16 @@ func (c *SSM) DescribeAssociation(input *DescribeAssociationInput) (*DescribeAss
 	return out, err
 }
 
+// DescribeAssociationWithContext is the same as DescribeAssociation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) DescribeAssociationWithContext(ctx aws.Context, input *DescribeAssociationInput) (*DescribeAssociationOutput, error) {
+	req, out := c.DescribeAssociationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDocument = "DescribeDocument"
 
 // DescribeDocumentRequest generates a request for the DescribeDocument operation.
@@ -226,6 +287,16 @@ func (c *SSM) DescribeDocument(input *DescribeDocumentInput) (*DescribeDocumentO
 	return out, err
 }
 
+// DescribeDocumentWithContext is the same as DescribeDocument with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) DescribeDocumentWithContext(ctx aws.Context, input *DescribeDocumentInput) (*DescribeDocumentOutput, error) {
+	req, out := c.DescribeDocumentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetDocument = "GetDocument"
 
 // GetDocumentRequest generates a request for the GetDocument operation.
@@ -253,6 +324,16 @@ func (c *SSM) GetDocument(input *GetDocumentInput) (*GetDocumentOutput, error) {
 	return out, err
 }
 
+// GetDocumentWithContext is the same as GetDocument with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) GetDocumentWithContext(ctx aws.Context, input *GetDocumentInput) (*GetDocumentOutput, error) {
+	req, out := c.GetDocumentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListAssociations = "ListAssociations"
 
 // ListAssociationsRequest generates a request for the ListAssociations operation.
@@ -280,6 +361,16 @@ func (c *SSM) ListAssociations(input *ListAssociationsInput) (*ListAssociationsO
 	return out, err
 }
 
+// ListAssociationsWithContext is the same as ListAssociations with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) ListAssociationsWithContext(ctx aws.Context, input *ListAssociationsInput) (*ListAssociationsOutput, error) {
+	req, out := c.ListAssociationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListDocuments = "ListDocuments"
 
 // ListDocumentsRequest generates a request for the ListDocuments operation.
@@ -307,6 +398,16 @@ func (c *SSM) ListDocuments(input *ListDocumentsInput) (*ListDocumentsOutput, er
 	return out, err
 }
 
+// ListDocumentsWithContext is the same as ListDocuments with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) ListDocumentsWithContext(ctx aws.Context, input *ListDocumentsInput) (*ListDocumentsOutput, error) {
+	req, out := c.ListDocumentsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateAssociationStatus = "UpdateAssociationStatus"
 
 // UpdateAssociationStatusRequest generates a request for the UpdateAssociationStatus operation.
@@ -335,13 +436,23 @@ func (c *SSM) UpdateAssociationStatus(input *UpdateAssociationStatusInput) (*Upd
 	return out, err
 }
 
+// UpdateAssociationStatusWithContext is the same as UpdateAssociationStatus with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SSM) UpdateAssociationStatusWithContext(ctx aws.Context, input *UpdateAssociationStatusInput) (*UpdateAssociationStatusOutput, error) {
+	req, out := c.UpdateAssociationStatusRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Describes an association of a configuration document and an instance.
 type Association struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataAssociation `json:"-" xml:"-"`
 }
@@ -350,16 +461,26 @@ type metadataAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Association) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Association) GoString() string {
+	return s.String()
+}
+
 // Describes an association.
 type AssociationDescription struct {
 	// The date when the association was made.
 	Date *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	// The association status.
 	Status *AssociationStatus `type:"structure"`
@@ -371,13 +492,25 @@ type metadataAssociationDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociationDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociationDescription) GoString() string {
+	return s.String()
+}
+
 // Describes a filter.
 type AssociationFilter struct {
 	// The name of the filter.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	//
+	// See the AssociationFilterKey* constants for valid values.
+	Key *string `locationName:"key" type:"string" enum:"InstanceId,Name" required:"true"`
 
 	// The filter value.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataAssociationFilter `json:"-" xml:"-"`
 }
@@ -386,19 +519,31 @@ type metadataAssociationFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociationFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociationFilter) GoString() string {
+	return s.String()
+}
+
 // Describes an association status.
 type AssociationStatus struct {
 	// A user-defined string.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" max:"1024"`
 
 	// The date when the status changed.
 	Date *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// The reason for the status.
-	Message *string `type:"string" required:"true"`
+	Message *string `type:"string" max:"1024" required:"true"`
 
 	// The status.
-	Name *string `type:"string" required:"true"`
+	//
+	// See the AssociationStatusName* constants for valid values.
+	Name *string `type:"string" enum:"Pending,Success,Failed" required:"true"`
 
 	metadataAssociationStatus `json:"-" xml:"-"`
 }
@@ -407,6 +552,16 @@ type metadataAssociationStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssociationStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssociationStatus) GoString() string {
+	return s.String()
+}
+
 type CreateAssociationBatchInput struct {
 	// One or more associations.
 	Entries []*CreateAssociationBatchRequestEntry `locationNameList:"entries" type:"list" required:"true"`
@@ -418,6 +573,16 @@ type metadataCreateAssociationBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAssociationBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAssociationBatchInput) GoString() string {
+	return s.String()
+}
+
 type CreateAssociationBatchOutput struct {
 	// Information about the associations that failed.
 	Failed []*FailedCreateAssociation `locationNameList:"FailedCreateAssociationEntry" type:"list"`
@@ -432,13 +597,23 @@ type metadataCreateAssociationBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAssociationBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAssociationBatchOutput) GoString() string {
+	return s.String()
+}
+
 // Describes the association of a configuration document and an instance.
 type CreateAssociationBatchRequestEntry struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataCreateAssociationBatchRequestEntry `json:"-" xml:"-"`
 }
@@ -447,12 +622,22 @@ type metadataCreateAssociationBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAssociationBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAssociationBatchRequestEntry) GoString() string {
+	return s.String()
+}
+
 type CreateAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataCreateAssociationInput `json:"-" xml:"-"`
 }
@@ -461,6 +646,16 @@ type metadataCreateAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAssociationInput) GoString() string {
+	return s.String()
+}
+
 type CreateAssociationOutput struct {
 	// Information about the association.
 	AssociationDescription *AssociationDescription `type:"structure"`
@@ -472,13 +667,23 @@ type metadataCreateAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateAssociationOutput) GoString() string {
+	return s.String()
+}
+
 type CreateDocumentInput struct {
 	// A valid JSON file. For more information about the contents of this file,
 	// see Configuration Document (http://docs.aws.amazon.com/ssm/latest/APIReference/aws-ssm-document.html).
-	Content *string `type:"string" required:"true"`
+	Content *string `type:"string" min:"1" required:"true"`
 
 	// A name for the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataCreateDocumentInput `json:"-" xml:"-"`
 }
@@ -487,6 +692,16 @@ type metadataCreateDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDocumentInput) GoString() string {
+	return s.String()
+}
+
 type CreateDocumentOutput struct {
 	// Information about the configuration document.
 	DocumentDescription *DocumentDescription `type:"structure"`
@@ -498,12 +713,22 @@ type metadataCreateDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateDocumentOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDeleteAssociationInput `json:"-" xml:"-"`
 }
@@ -512,6 +737,16 @@ type metadataDeleteAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAssociationInput) GoString() string {
+	return s.String()
+}
+
 type DeleteAssociationOutput struct {
 	metadataDeleteAssociationOutput `json:"-" xml:"-"`
 }
@@ -520,9 +755,19 @@ type metadataDeleteAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteAssociationOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDeleteDocumentInput `json:"-" xml:"-"`
 }
@@ -531,6 +776,16 @@ type metadataDeleteDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDocumentInput) GoString() string {
+	return s.String()
+}
+
 type DeleteDocumentOutput struct {
 	metadataDeleteDocumentOutput `json:"-" xml:"-"`
 }
@@ -539,12 +794,22 @@ type metadataDeleteDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteDocumentOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDescribeAssociationInput `json:"-" xml:"-"`
 }
@@ -553,6 +818,16 @@ type metadataDescribeAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAssociationInput) GoString() string {
+	return s.String()
+}
+
 type DescribeAssociationOutput struct {
 	// Information about the association.
 	AssociationDescription *AssociationDescription `type:"structure"`
@@ -564,9 +839,19 @@ type metadataDescribeAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAssociationOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDescribeDocumentInput `json:"-" xml:"-"`
 }
@@ -575,6 +860,16 @@ type metadataDescribeDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDocumentInput) GoString() string {
+	return s.String()
+}
+
 type DescribeDocumentOutput struct {
 	// Information about the configuration document.
 	Document *DocumentDescription `type:"structure"`
@@ -586,19 +881,31 @@ type metadataDescribeDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDocumentOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a configuration document.
 type DocumentDescription struct {
 	// The date when the configuration document was created.
 	CreatedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	// The SHA1 hash of the document, which you can use for verification purposes.
 	SHA1 *string `locationName:"Sha1" type:"string"`
 
 	// The status of the configuration document.
-	Status *string `type:"string"`
+	//
+	// See the DocumentStatus* constants for valid values.
+	Status *string `type:"string" enum:"Creating,Active,Deleting"`
 
 	metadataDocumentDescription `json:"-" xml:"-"`
 }
@@ -607,13 +914,25 @@ type metadataDocumentDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DocumentDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DocumentDescription) GoString() string {
+	return s.String()
+}
+
 // Describes a filter.
 type DocumentFilter struct {
 	// The name of the filter.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	//
+	// See the DocumentFilterKey* constants for valid values.
+	Key *string `locationName:"key" type:"string" enum:"Name" required:"true"`
 
 	// The value of the filter.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataDocumentFilter `json:"-" xml:"-"`
 }
@@ -622,10 +941,20 @@ type metadataDocumentFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DocumentFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DocumentFilter) GoString() string {
+	return s.String()
+}
+
 // Describes the name of a configuration document.
 type DocumentIdentifier struct {
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataDocumentIdentifier `json:"-" xml:"-"`
 }
@@ -634,13 +963,25 @@ type metadataDocumentIdentifier struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DocumentIdentifier) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DocumentIdentifier) GoString() string {
+	return s.String()
+}
+
 // Describes a failed association.
 type FailedCreateAssociation struct {
 	// The association.
 	Entry *CreateAssociationBatchRequestEntry `type:"structure"`
 
 	// The source of the failure.
-	Fault *string `type:"string"`
+	//
+	// See the Fault* constants for valid values.
+	Fault *string `type:"string" enum:"Client,Server,Unknown"`
 
 	// A description of the failure.
 	Message *string `type:"string"`
@@ -652,9 +993,19 @@ type metadataFailedCreateAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FailedCreateAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FailedCreateAssociation) GoString() string {
+	return s.String()
+}
+
 type GetDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataGetDocumentInput `json:"-" xml:"-"`
 }
@@ -663,12 +1014,22 @@ type metadataGetDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDocumentInput) GoString() string {
+	return s.String()
+}
+
 type GetDocumentOutput struct {
 	// The contents of the configuration document.
-	Content *string `type:"string"`
+	Content *string `type:"string" min:"1"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataGetDocumentOutput `json:"-" xml:"-"`
 }
@@ -677,14 +1038,24 @@ type metadataGetDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetDocumentOutput) GoString() string {
+	return s.String()
+}
+
 type ListAssociationsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" required:"true"`
+	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" min:"1" required:"true"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -697,6 +1068,16 @@ type metadataListAssociationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAssociationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAssociationsInput) GoString() string {
+	return s.String()
+}
+
 type ListAssociationsOutput struct {
 	// The associations.
 	Associations []*Association `locationNameList:"Association" type:"list"`
@@ -712,14 +1093,24 @@ type metadataListAssociationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListAssociationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListAssociationsOutput) GoString() string {
+	return s.String()
+}
+
 type ListDocumentsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list"`
+	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list" min:"1"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -732,6 +1123,16 @@ type metadataListDocumentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDocumentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDocumentsInput) GoString() string {
+	return s.String()
+}
+
 type ListDocumentsOutput struct {
 	// The names of the configuration documents.
 	DocumentIdentifiers []*DocumentIdentifier `locationNameList:"DocumentIdentifier" type:"list"`
@@ -747,15 +1148,25 @@ type metadataListDocumentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDocumentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDocumentsOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateAssociationStatusInput struct {
 	// The association status.
 	AssociationStatus *AssociationStatus `type:"structure" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataUpdateAssociationStatusInput `json:"-" xml:"-"`
 }
@@ -764,6 +1175,16 @@ type metadataUpdateAssociationStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateAssociationStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAssociationStatusInput) GoString() string {
+	return s.String()
+}
+
 type UpdateAssociationStatusOutput struct {
 	// Information about the association.
 	AssociationDescription *AssociationDescription `type:"structure"`
@@ -774,3 +1195,52 @@ type UpdateAssociationStatusOutput struct {
 type metadataUpdateAssociationStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s UpdateAssociationStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateAssociationStatusOutput) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum AssociationFilterKey
+	AssociationFilterKeyInstanceId = "InstanceId"
+	// @enum AssociationFilterKey
+	AssociationFilterKeyName = "Name"
+)
+
+const (
+	// @enum AssociationStatusName
+	AssociationStatusNamePending = "Pending"
+	// @enum AssociationStatusName
+	AssociationStatusNameSuccess = "Success"
+	// @enum AssociationStatusName
+	AssociationStatusNameFailed = "Failed"
+)
+
+const (
+	// @enum DocumentFilterKey
+	DocumentFilterKeyName = "Name"
+)
+
+const (
+	// @enum DocumentStatus
+	DocumentStatusCreating = "Creating"
+	// @enum DocumentStatus
+	DocumentStatusActive = "Active"
+	// @enum DocumentStatus
+	DocumentStatusDeleting = "Deleting"
+)
+
+const (
+	// @enum Fault
+	FaultClient = "Client"
+	// @enum Fault
+	FaultServer = "Server"
+	// @enum Fault
+	FaultUnknown = "Unknown"
+)
@@ -4,6 +4,7 @@
 package ssmiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
@@ -11,23 +12,45 @@ import (
 type SSMAPI interface {
 	CreateAssociation(*ssm.CreateAssociationInput) (*ssm.CreateAssociationOutput, error)
 
+	CreateAssociationWithContext(aws.Context, *ssm.CreateAssociationInput) (*ssm.CreateAssociationOutput, error)
+
 	CreateAssociationBatch(*ssm.CreateAssociationBatchInput) (*ssm.CreateAssociationBatchOutput, error)
 
+	CreateAssociationBatchWithContext(aws.Context, *ssm.CreateAssociationBatchInput) (*ssm.CreateAssociationBatchOutput, error)
+
 	CreateDocument(*ssm.CreateDocumentInput) (*ssm.CreateDocumentOutput, error)
 
+	CreateDocumentWithContext(aws.Context, *ssm.CreateDocumentInput) (*ssm.CreateDocumentOutput, error)
+
 	DeleteAssociation(*ssm.DeleteAssociationInput) (*ssm.DeleteAssociationOutput, error)
 
+	DeleteAssociationWithContext(aws.Context, *ssm.DeleteAssociationInput) (*ssm.DeleteAssociationOutput, error)
+
 	DeleteDocument(*ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error)
 
+	DeleteDocumentWithContext(aws.Context, *ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error)
+
 	DescribeAssociation(*ssm.DescribeAssociationInput) (*ssm.DescribeAssociationOutput, error)
 
+	DescribeAssociationWithContext(aws.Context, *ssm.DescribeAssociationInput) (*ssm.DescribeAssociationOutput, error)
+
 	DescribeDocument(*ssm.DescribeDocumentInput) (*ssm.DescribeDocumentOutput, error)
 
+	DescribeDocumentWithContext(aws.Context, *ssm.DescribeDocumentInput) (*ssm.DescribeDocumentOutput, error)
+
 	GetDocument(*ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error)
 
+	GetDocumentWithContext(aws.Context, *ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error)
+
 	ListAssociations(*ssm.ListAssociationsInput) (*ssm.ListAssociationsOutput, error)
 
+	ListAssociationsWithContext(aws.Context, *ssm.ListAssociationsInput) (*ssm.ListAssociationsOutput, error)
+
 	ListDocuments(*ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error)
 
+	ListDocumentsWithContext(aws.Context, *ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error)
+
 	UpdateAssociationStatus(*ssm.UpdateAssociationStatusInput) (*ssm.UpdateAssociationStatusOutput, error)
+
+	UpdateAssociationStatusWithContext(aws.Context, *ssm.UpdateAssociationStatusInput) (*ssm.UpdateAssociationStatusOutput, error)
 }
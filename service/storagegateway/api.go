@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opActivateGateway = "ActivateGateway"
@@ -44,6 +45,16 @@ func (c *StorageGateway) ActivateGateway(input *ActivateGatewayInput) (*Activate
 	return out, err
 }
 
+// ActivateGatewayWithContext is the same as ActivateGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ActivateGatewayWithContext(ctx aws.Context, input *ActivateGatewayInput) (*ActivateGatewayOutput, error) {
+	req, out := c.ActivateGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddCache = "AddCache"
 
 // AddCacheRequest generates a request for the AddCache operation.
@@ -77,6 +88,16 @@ func (c *StorageGateway) AddCache(input *AddCacheInput) (*AddCacheOutput, error)
 	return out, err
 }
 
+// AddCacheWithContext is the same as AddCache with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) AddCacheWithContext(ctx aws.Context, input *AddCacheInput) (*AddCacheOutput, error) {
+	req, out := c.AddCacheRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddUploadBuffer = "AddUploadBuffer"
 
 // AddUploadBufferRequest generates a request for the AddUploadBuffer operation.
@@ -110,6 +131,16 @@ func (c *StorageGateway) AddUploadBuffer(input *AddUploadBufferInput) (*AddUploa
 	return out, err
 }
 
+// AddUploadBufferWithContext is the same as AddUploadBuffer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) AddUploadBufferWithContext(ctx aws.Context, input *AddUploadBufferInput) (*AddUploadBufferOutput, error) {
+	req, out := c.AddUploadBufferRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddWorkingStorage = "AddWorkingStorage"
 
 // AddWorkingStorageRequest generates a request for the AddWorkingStorage operation.
@@ -147,6 +178,16 @@ func (c *StorageGateway) AddWorkingStorage(input *AddWorkingStorageInput) (*AddW
 	return out, err
 }
 
+// AddWorkingStorageWithContext is the same as AddWorkingStorage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) AddWorkingStorageWithContext(ctx aws.Context, input *AddWorkingStorageInput) (*AddWorkingStorageOutput, error) {
+	req, out := c.AddWorkingStorageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelArchival = "CancelArchival"
 
 // CancelArchivalRequest generates a request for the CancelArchival operation.
@@ -175,6 +216,16 @@ func (c *StorageGateway) CancelArchival(input *CancelArchivalInput) (*CancelArch
 	return out, err
 }
 
+// CancelArchivalWithContext is the same as CancelArchival with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) CancelArchivalWithContext(ctx aws.Context, input *CancelArchivalInput) (*CancelArchivalOutput, error) {
+	req, out := c.CancelArchivalRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCancelRetrieval = "CancelRetrieval"
 
 // CancelRetrievalRequest generates a request for the CancelRetrieval operation.
@@ -204,6 +255,16 @@ func (c *StorageGateway) CancelRetrieval(input *CancelRetrievalInput) (*CancelRe
 	return out, err
 }
 
+// CancelRetrievalWithContext is the same as CancelRetrieval with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) CancelRetrievalWithContext(ctx aws.Context, input *CancelRetrievalInput) (*CancelRetrievalOutput, error) {
+	req, out := c.CancelRetrievalRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCachediSCSIVolume = "CreateCachediSCSIVolume"
 
 // CreateCachediSCSIVolumeRequest generates a request for the CreateCachediSCSIVolume operation.
@@ -241,6 +302,16 @@ func (c *StorageGateway) CreateCachediSCSIVolume(input *CreateCachediSCSIVolumeI
 	return out, err
 }
 
+// CreateCachediSCSIVolumeWithContext is the same as CreateCachediSCSIVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) CreateCachediSCSIVolumeWithContext(ctx aws.Context, input *CreateCachediSCSIVolumeInput) (*CreateCachediSCSIVolumeOutput, error) {
+	req, out := c.CreateCachediSCSIVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshot = "CreateSnapshot"
 
 // CreateSnapshotRequest generates a request for the CreateSnapshot operation.
@@ -288,6 +359,16 @@ func (c *StorageGateway) CreateSnapshot(input *CreateSnapshotInput) (*CreateSnap
 	return out, err
 }
 
+// CreateSnapshotWithContext is the same as CreateSnapshot with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) CreateSnapshotWithContext(ctx aws.Context, input *CreateSnapshotInput) (*CreateSnapshotOutput, error) {
+	req, out := c.CreateSnapshotRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateSnapshotFromVolumeRecoveryPoint = "CreateSnapshotFromVolumeRecoveryPoint"
 
 // CreateSnapshotFromVolumeRecoveryPointRequest generates a request for the CreateSnapshotFromVolumeRecoveryPoint operation.
@@ -332,6 +413,16 @@ func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPoint(input *CreateSnap
 	return out, err
 }
 
+// CreateSnapshotFromVolumeRecoveryPointWithContext is the same as CreateSnapshotFromVolumeRecoveryPoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPointWithContext(ctx aws.Context, input *CreateSnapshotFromVolumeRecoveryPointInput) (*CreateSnapshotFromVolumeRecoveryPointOutput, error) {
+	req, out := c.CreateSnapshotFromVolumeRecoveryPointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateStorediSCSIVolume = "CreateStorediSCSIVolume"
 
 // CreateStorediSCSIVolumeRequest generates a request for the CreateStorediSCSIVolume operation.
@@ -371,6 +462,16 @@ func (c *StorageGateway) CreateStorediSCSIVolume(input *CreateStorediSCSIVolumeI
 	return out, err
 }
 
+// CreateStorediSCSIVolumeWithContext is the same as CreateStorediSCSIVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) CreateStorediSCSIVolumeWithContext(ctx aws.Context, input *CreateStorediSCSIVolumeInput) (*CreateStorediSCSIVolumeOutput, error) {
+	req, out := c.CreateStorediSCSIVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateTapes = "CreateTapes"
 
 // CreateTapesRequest generates a request for the CreateTapes operation.
@@ -402,6 +503,16 @@ func (c *StorageGateway) CreateTapes(input *CreateTapesInput) (*CreateTapesOutpu
 	return out, err
 }
 
+// CreateTapesWithContext is the same as CreateTapes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) CreateTapesWithContext(ctx aws.Context, input *CreateTapesInput) (*CreateTapesOutput, error) {
+	req, out := c.CreateTapesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteBandwidthRateLimit = "DeleteBandwidthRateLimit"
 
 // DeleteBandwidthRateLimitRequest generates a request for the DeleteBandwidthRateLimit operation.
@@ -433,6 +544,16 @@ func (c *StorageGateway) DeleteBandwidthRateLimit(input *DeleteBandwidthRateLimi
 	return out, err
 }
 
+// DeleteBandwidthRateLimitWithContext is the same as DeleteBandwidthRateLimit with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DeleteBandwidthRateLimitWithContext(ctx aws.Context, input *DeleteBandwidthRateLimitInput) (*DeleteBandwidthRateLimitOutput, error) {
+	req, out := c.DeleteBandwidthRateLimitRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteChapCredentials = "DeleteChapCredentials"
 
 // DeleteChapCredentialsRequest generates a request for the DeleteChapCredentials operation.
@@ -461,6 +582,16 @@ func (c *StorageGateway) DeleteChapCredentials(input *DeleteChapCredentialsInput
 	return out, err
 }
 
+// DeleteChapCredentialsWithContext is the same as DeleteChapCredentials with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DeleteChapCredentialsWithContext(ctx aws.Context, input *DeleteChapCredentialsInput) (*DeleteChapCredentialsOutput, error) {
+	req, out := c.DeleteChapCredentialsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteGateway = "DeleteGateway"
 
 // DeleteGatewayRequest generates a request for the DeleteGateway operation.
@@ -503,6 +634,16 @@ func (c *StorageGateway) DeleteGateway(input *DeleteGatewayInput) (*DeleteGatewa
 	return out, err
 }
 
+// DeleteGatewayWithContext is the same as DeleteGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DeleteGatewayWithContext(ctx aws.Context, input *DeleteGatewayInput) (*DeleteGatewayOutput, error) {
+	req, out := c.DeleteGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteSnapshotSchedule = "DeleteSnapshotSchedule"
 
 // DeleteSnapshotScheduleRequest generates a request for the DeleteSnapshotSchedule operation.
@@ -539,6 +680,16 @@ func (c *StorageGateway) DeleteSnapshotSchedule(input *DeleteSnapshotScheduleInp
 	return out, err
 }
 
+// DeleteSnapshotScheduleWithContext is the same as DeleteSnapshotSchedule with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DeleteSnapshotScheduleWithContext(ctx aws.Context, input *DeleteSnapshotScheduleInput) (*DeleteSnapshotScheduleOutput, error) {
+	req, out := c.DeleteSnapshotScheduleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTape = "DeleteTape"
 
 // DeleteTapeRequest generates a request for the DeleteTape operation.
@@ -566,6 +717,16 @@ func (c *StorageGateway) DeleteTape(input *DeleteTapeInput) (*DeleteTapeOutput,
 	return out, err
 }
 
+// DeleteTapeWithContext is the same as DeleteTape with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DeleteTapeWithContext(ctx aws.Context, input *DeleteTapeInput) (*DeleteTapeOutput, error) {
+	req, out := c.DeleteTapeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteTapeArchive = "DeleteTapeArchive"
 
 // DeleteTapeArchiveRequest generates a request for the DeleteTapeArchive operation.
@@ -593,6 +754,16 @@ func (c *StorageGateway) DeleteTapeArchive(input *DeleteTapeArchiveInput) (*Dele
 	return out, err
 }
 
+// DeleteTapeArchiveWithContext is the same as DeleteTapeArchive with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DeleteTapeArchiveWithContext(ctx aws.Context, input *DeleteTapeArchiveInput) (*DeleteTapeArchiveOutput, error) {
+	req, out := c.DeleteTapeArchiveRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeleteVolume = "DeleteVolume"
 
 // DeleteVolumeRequest generates a request for the DeleteVolume operation.
@@ -633,6 +804,16 @@ func (c *StorageGateway) DeleteVolume(input *DeleteVolumeInput) (*DeleteVolumeOu
 	return out, err
 }
 
+// DeleteVolumeWithContext is the same as DeleteVolume with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DeleteVolumeWithContext(ctx aws.Context, input *DeleteVolumeInput) (*DeleteVolumeOutput, error) {
+	req, out := c.DeleteVolumeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeBandwidthRateLimit = "DescribeBandwidthRateLimit"
 
 // DescribeBandwidthRateLimitRequest generates a request for the DescribeBandwidthRateLimit operation.
@@ -666,6 +847,16 @@ func (c *StorageGateway) DescribeBandwidthRateLimit(input *DescribeBandwidthRate
 	return out, err
 }
 
+// DescribeBandwidthRateLimitWithContext is the same as DescribeBandwidthRateLimit with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeBandwidthRateLimitWithContext(ctx aws.Context, input *DescribeBandwidthRateLimitInput) (*DescribeBandwidthRateLimitOutput, error) {
+	req, out := c.DescribeBandwidthRateLimitRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCache = "DescribeCache"
 
 // DescribeCacheRequest generates a request for the DescribeCache operation.
@@ -697,6 +888,16 @@ func (c *StorageGateway) DescribeCache(input *DescribeCacheInput) (*DescribeCach
 	return out, err
 }
 
+// DescribeCacheWithContext is the same as DescribeCache with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeCacheWithContext(ctx aws.Context, input *DescribeCacheInput) (*DescribeCacheOutput, error) {
+	req, out := c.DescribeCacheRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCachediSCSIVolumes = "DescribeCachediSCSIVolumes"
 
 // DescribeCachediSCSIVolumesRequest generates a request for the DescribeCachediSCSIVolumes operation.
@@ -730,6 +931,16 @@ func (c *StorageGateway) DescribeCachediSCSIVolumes(input *DescribeCachediSCSIVo
 	return out, err
 }
 
+// DescribeCachediSCSIVolumesWithContext is the same as DescribeCachediSCSIVolumes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeCachediSCSIVolumesWithContext(ctx aws.Context, input *DescribeCachediSCSIVolumesInput) (*DescribeCachediSCSIVolumesOutput, error) {
+	req, out := c.DescribeCachediSCSIVolumesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeChapCredentials = "DescribeChapCredentials"
 
 // DescribeChapCredentialsRequest generates a request for the DescribeChapCredentials operation.
@@ -759,6 +970,16 @@ func (c *StorageGateway) DescribeChapCredentials(input *DescribeChapCredentialsI
 	return out, err
 }
 
+// DescribeChapCredentialsWithContext is the same as DescribeChapCredentials with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeChapCredentialsWithContext(ctx aws.Context, input *DescribeChapCredentialsInput) (*DescribeChapCredentialsOutput, error) {
+	req, out := c.DescribeChapCredentialsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeGatewayInformation = "DescribeGatewayInformation"
 
 // DescribeGatewayInformationRequest generates a request for the DescribeGatewayInformation operation.
@@ -789,6 +1010,16 @@ func (c *StorageGateway) DescribeGatewayInformation(input *DescribeGatewayInform
 	return out, err
 }
 
+// DescribeGatewayInformationWithContext is the same as DescribeGatewayInformation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeGatewayInformationWithContext(ctx aws.Context, input *DescribeGatewayInformationInput) (*DescribeGatewayInformationOutput, error) {
+	req, out := c.DescribeGatewayInformationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeMaintenanceStartTime = "DescribeMaintenanceStartTime"
 
 // DescribeMaintenanceStartTimeRequest generates a request for the DescribeMaintenanceStartTime operation.
@@ -818,6 +1049,16 @@ func (c *StorageGateway) DescribeMaintenanceStartTime(input *DescribeMaintenance
 	return out, err
 }
 
+// DescribeMaintenanceStartTimeWithContext is the same as DescribeMaintenanceStartTime with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeMaintenanceStartTimeWithContext(ctx aws.Context, input *DescribeMaintenanceStartTimeInput) (*DescribeMaintenanceStartTimeOutput, error) {
+	req, out := c.DescribeMaintenanceStartTimeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSnapshotSchedule = "DescribeSnapshotSchedule"
 
 // DescribeSnapshotScheduleRequest generates a request for the DescribeSnapshotSchedule operation.
@@ -847,6 +1088,16 @@ func (c *StorageGateway) DescribeSnapshotSchedule(input *DescribeSnapshotSchedul
 	return out, err
 }
 
+// DescribeSnapshotScheduleWithContext is the same as DescribeSnapshotSchedule with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeSnapshotScheduleWithContext(ctx aws.Context, input *DescribeSnapshotScheduleInput) (*DescribeSnapshotScheduleOutput, error) {
+	req, out := c.DescribeSnapshotScheduleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeStorediSCSIVolumes = "DescribeStorediSCSIVolumes"
 
 // DescribeStorediSCSIVolumesRequest generates a request for the DescribeStorediSCSIVolumes operation.
@@ -877,6 +1128,16 @@ func (c *StorageGateway) DescribeStorediSCSIVolumes(input *DescribeStorediSCSIVo
 	return out, err
 }
 
+// DescribeStorediSCSIVolumesWithContext is the same as DescribeStorediSCSIVolumes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeStorediSCSIVolumesWithContext(ctx aws.Context, input *DescribeStorediSCSIVolumesInput) (*DescribeStorediSCSIVolumesOutput, error) {
+	req, out := c.DescribeStorediSCSIVolumesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTapeArchives = "DescribeTapeArchives"
 
 // DescribeTapeArchivesRequest generates a request for the DescribeTapeArchives operation.
@@ -914,6 +1175,16 @@ func (c *StorageGateway) DescribeTapeArchives(input *DescribeTapeArchivesInput)
 	return out, err
 }
 
+// DescribeTapeArchivesWithContext is the same as DescribeTapeArchives with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeTapeArchivesWithContext(ctx aws.Context, input *DescribeTapeArchivesInput) (*DescribeTapeArchivesOutput, error) {
+	req, out := c.DescribeTapeArchivesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *StorageGateway) DescribeTapeArchivesPages(input *DescribeTapeArchivesInput, fn func(p *DescribeTapeArchivesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTapeArchivesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -959,6 +1230,16 @@ func (c *StorageGateway) DescribeTapeRecoveryPoints(input *DescribeTapeRecoveryP
 	return out, err
 }
 
+// DescribeTapeRecoveryPointsWithContext is the same as DescribeTapeRecoveryPoints with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeTapeRecoveryPointsWithContext(ctx aws.Context, input *DescribeTapeRecoveryPointsInput) (*DescribeTapeRecoveryPointsOutput, error) {
+	req, out := c.DescribeTapeRecoveryPointsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *StorageGateway) DescribeTapeRecoveryPointsPages(input *DescribeTapeRecoveryPointsInput, fn func(p *DescribeTapeRecoveryPointsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTapeRecoveryPointsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1001,6 +1282,16 @@ func (c *StorageGateway) DescribeTapes(input *DescribeTapesInput) (*DescribeTape
 	return out, err
 }
 
+// DescribeTapesWithContext is the same as DescribeTapes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeTapesWithContext(ctx aws.Context, input *DescribeTapesInput) (*DescribeTapesOutput, error) {
+	req, out := c.DescribeTapesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *StorageGateway) DescribeTapesPages(input *DescribeTapesInput, fn func(p *DescribeTapesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeTapesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1040,6 +1331,16 @@ func (c *StorageGateway) DescribeUploadBuffer(input *DescribeUploadBufferInput)
 	return out, err
 }
 
+// DescribeUploadBufferWithContext is the same as DescribeUploadBuffer with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeUploadBufferWithContext(ctx aws.Context, input *DescribeUploadBufferInput) (*DescribeUploadBufferOutput, error) {
+	req, out := c.DescribeUploadBufferRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeVTLDevices = "DescribeVTLDevices"
 
 // DescribeVTLDevicesRequest generates a request for the DescribeVTLDevices operation.
@@ -1076,6 +1377,16 @@ func (c *StorageGateway) DescribeVTLDevices(input *DescribeVTLDevicesInput) (*De
 	return out, err
 }
 
+// DescribeVTLDevicesWithContext is the same as DescribeVTLDevices with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeVTLDevicesWithContext(ctx aws.Context, input *DescribeVTLDevicesInput) (*DescribeVTLDevicesOutput, error) {
+	req, out := c.DescribeVTLDevicesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *StorageGateway) DescribeVTLDevicesPages(input *DescribeVTLDevicesInput, fn func(p *DescribeVTLDevicesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeVTLDevicesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1119,6 +1430,16 @@ func (c *StorageGateway) DescribeWorkingStorage(input *DescribeWorkingStorageInp
 	return out, err
 }
 
+// DescribeWorkingStorageWithContext is the same as DescribeWorkingStorage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DescribeWorkingStorageWithContext(ctx aws.Context, input *DescribeWorkingStorageInput) (*DescribeWorkingStorageOutput, error) {
+	req, out := c.DescribeWorkingStorageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDisableGateway = "DisableGateway"
 
 // DisableGatewayRequest generates a request for the DisableGateway operation.
@@ -1152,6 +1473,16 @@ func (c *StorageGateway) DisableGateway(input *DisableGatewayInput) (*DisableGat
 	return out, err
 }
 
+// DisableGatewayWithContext is the same as DisableGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) DisableGatewayWithContext(ctx aws.Context, input *DisableGatewayInput) (*DisableGatewayOutput, error) {
+	req, out := c.DisableGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListGateways = "ListGateways"
 
 // ListGatewaysRequest generates a request for the ListGateways operation.
@@ -1195,6 +1526,16 @@ func (c *StorageGateway) ListGateways(input *ListGatewaysInput) (*ListGatewaysOu
 	return out, err
 }
 
+// ListGatewaysWithContext is the same as ListGateways with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ListGatewaysWithContext(ctx aws.Context, input *ListGatewaysInput) (*ListGatewaysOutput, error) {
+	req, out := c.ListGatewaysRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *StorageGateway) ListGatewaysPages(input *ListGatewaysInput, fn func(p *ListGatewaysOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListGatewaysRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1238,6 +1579,16 @@ func (c *StorageGateway) ListLocalDisks(input *ListLocalDisksInput) (*ListLocalD
 	return out, err
 }
 
+// ListLocalDisksWithContext is the same as ListLocalDisks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ListLocalDisksWithContext(ctx aws.Context, input *ListLocalDisksInput) (*ListLocalDisksOutput, error) {
+	req, out := c.ListLocalDisksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListVolumeInitiators = "ListVolumeInitiators"
 
 // ListVolumeInitiatorsRequest generates a request for the ListVolumeInitiators operation.
@@ -1266,6 +1617,16 @@ func (c *StorageGateway) ListVolumeInitiators(input *ListVolumeInitiatorsInput)
 	return out, err
 }
 
+// ListVolumeInitiatorsWithContext is the same as ListVolumeInitiators with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ListVolumeInitiatorsWithContext(ctx aws.Context, input *ListVolumeInitiatorsInput) (*ListVolumeInitiatorsOutput, error) {
+	req, out := c.ListVolumeInitiatorsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListVolumeRecoveryPoints = "ListVolumeRecoveryPoints"
 
 // ListVolumeRecoveryPointsRequest generates a request for the ListVolumeRecoveryPoints operation.
@@ -1299,6 +1660,16 @@ func (c *StorageGateway) ListVolumeRecoveryPoints(input *ListVolumeRecoveryPoint
 	return out, err
 }
 
+// ListVolumeRecoveryPointsWithContext is the same as ListVolumeRecoveryPoints with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ListVolumeRecoveryPointsWithContext(ctx aws.Context, input *ListVolumeRecoveryPointsInput) (*ListVolumeRecoveryPointsOutput, error) {
+	req, out := c.ListVolumeRecoveryPointsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opListVolumes = "ListVolumes"
 
 // ListVolumesRequest generates a request for the ListVolumes operation.
@@ -1341,6 +1712,16 @@ func (c *StorageGateway) ListVolumes(input *ListVolumesInput) (*ListVolumesOutpu
 	return out, err
 }
 
+// ListVolumesWithContext is the same as ListVolumes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ListVolumesWithContext(ctx aws.Context, input *ListVolumesInput) (*ListVolumesOutput, error) {
+	req, out := c.ListVolumesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *StorageGateway) ListVolumesPages(input *ListVolumesInput, fn func(p *ListVolumesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListVolumesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1385,6 +1766,16 @@ func (c *StorageGateway) ResetCache(input *ResetCacheInput) (*ResetCacheOutput,
 	return out, err
 }
 
+// ResetCacheWithContext is the same as ResetCache with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ResetCacheWithContext(ctx aws.Context, input *ResetCacheInput) (*ResetCacheOutput, error) {
+	req, out := c.ResetCacheRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveTapeArchive = "RetrieveTapeArchive"
 
 // RetrieveTapeArchiveRequest generates a request for the RetrieveTapeArchive operation.
@@ -1419,6 +1810,16 @@ func (c *StorageGateway) RetrieveTapeArchive(input *RetrieveTapeArchiveInput) (*
 	return out, err
 }
 
+// RetrieveTapeArchiveWithContext is the same as RetrieveTapeArchive with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) RetrieveTapeArchiveWithContext(ctx aws.Context, input *RetrieveTapeArchiveInput) (*RetrieveTapeArchiveOutput, error) {
+	req, out := c.RetrieveTapeArchiveRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRetrieveTapeRecoveryPoint = "RetrieveTapeRecoveryPoint"
 
 // RetrieveTapeRecoveryPointRequest generates a request for the RetrieveTapeRecoveryPoint operation.
@@ -1454,6 +1855,16 @@ func (c *StorageGateway) RetrieveTapeRecoveryPoint(input *RetrieveTapeRecoveryPo
 	return out, err
 }
 
+// RetrieveTapeRecoveryPointWithContext is the same as RetrieveTapeRecoveryPoint with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) RetrieveTapeRecoveryPointWithContext(ctx aws.Context, input *RetrieveTapeRecoveryPointInput) (*RetrieveTapeRecoveryPointOutput, error) {
+	req, out := c.RetrieveTapeRecoveryPointRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opShutdownGateway = "ShutdownGateway"
 
 // ShutdownGatewayRequest generates a request for the ShutdownGateway operation.
@@ -1499,6 +1910,16 @@ func (c *StorageGateway) ShutdownGateway(input *ShutdownGatewayInput) (*Shutdown
 	return out, err
 }
 
+// ShutdownGatewayWithContext is the same as ShutdownGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) ShutdownGatewayWithContext(ctx aws.Context, input *ShutdownGatewayInput) (*ShutdownGatewayOutput, error) {
+	req, out := c.ShutdownGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStartGateway = "StartGateway"
 
 // StartGatewayRequest generates a request for the StartGateway operation.
@@ -1535,6 +1956,16 @@ func (c *StorageGateway) StartGateway(input *StartGatewayInput) (*StartGatewayOu
 	return out, err
 }
 
+// StartGatewayWithContext is the same as StartGateway with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) StartGatewayWithContext(ctx aws.Context, input *StartGatewayInput) (*StartGatewayOutput, error) {
+	req, out := c.StartGatewayRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateBandwidthRateLimit = "UpdateBandwidthRateLimit"
 
 // UpdateBandwidthRateLimitRequest generates a request for the UpdateBandwidthRateLimit operation.
@@ -1572,6 +2003,16 @@ func (c *StorageGateway) UpdateBandwidthRateLimit(input *UpdateBandwidthRateLimi
 	return out, err
 }
 
+// UpdateBandwidthRateLimitWithContext is the same as UpdateBandwidthRateLimit with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) UpdateBandwidthRateLimitWithContext(ctx aws.Context, input *UpdateBandwidthRateLimitInput) (*UpdateBandwidthRateLimitOutput, error) {
+	req, out := c.UpdateBandwidthRateLimitRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateChapCredentials = "UpdateChapCredentials"
 
 // UpdateChapCredentialsRequest generates a request for the UpdateChapCredentials operation.
@@ -1604,6 +2045,16 @@ func (c *StorageGateway) UpdateChapCredentials(input *UpdateChapCredentialsInput
 	return out, err
 }
 
+// UpdateChapCredentialsWithContext is the same as UpdateChapCredentials with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) UpdateChapCredentialsWithContext(ctx aws.Context, input *UpdateChapCredentialsInput) (*UpdateChapCredentialsOutput, error) {
+	req, out := c.UpdateChapCredentialsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateGatewayInformation = "UpdateGatewayInformation"
 
 // UpdateGatewayInformationRequest generates a request for the UpdateGatewayInformation operation.
@@ -1633,6 +2084,16 @@ func (c *StorageGateway) UpdateGatewayInformation(input *UpdateGatewayInformatio
 	return out, err
 }
 
+// UpdateGatewayInformationWithContext is the same as UpdateGatewayInformation with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) UpdateGatewayInformationWithContext(ctx aws.Context, input *UpdateGatewayInformationInput) (*UpdateGatewayInformationOutput, error) {
+	req, out := c.UpdateGatewayInformationRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateGatewaySoftwareNow = "UpdateGatewaySoftwareNow"
 
 // UpdateGatewaySoftwareNowRequest generates a request for the UpdateGatewaySoftwareNow operation.
@@ -1672,6 +2133,16 @@ func (c *StorageGateway) UpdateGatewaySoftwareNow(input *UpdateGatewaySoftwareNo
 	return out, err
 }
 
+// UpdateGatewaySoftwareNowWithContext is the same as UpdateGatewaySoftwareNow with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) UpdateGatewaySoftwareNowWithContext(ctx aws.Context, input *UpdateGatewaySoftwareNowInput) (*UpdateGatewaySoftwareNowOutput, error) {
+	req, out := c.UpdateGatewaySoftwareNowRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateMaintenanceStartTime = "UpdateMaintenanceStartTime"
 
 // UpdateMaintenanceStartTimeRequest generates a request for the UpdateMaintenanceStartTime operation.
@@ -1701,6 +2172,16 @@ func (c *StorageGateway) UpdateMaintenanceStartTime(input *UpdateMaintenanceStar
 	return out, err
 }
 
+// UpdateMaintenanceStartTimeWithContext is the same as UpdateMaintenanceStartTime with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) UpdateMaintenanceStartTimeWithContext(ctx aws.Context, input *UpdateMaintenanceStartTimeInput) (*UpdateMaintenanceStartTimeOutput, error) {
+	req, out := c.UpdateMaintenanceStartTimeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateSnapshotSchedule = "UpdateSnapshotSchedule"
 
 // UpdateSnapshotScheduleRequest generates a request for the UpdateSnapshotSchedule operation.
@@ -1736,6 +2217,16 @@ func (c *StorageGateway) UpdateSnapshotSchedule(input *UpdateSnapshotScheduleInp
 	return out, err
 }
 
+// UpdateSnapshotScheduleWithContext is the same as UpdateSnapshotSchedule with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) UpdateSnapshotScheduleWithContext(ctx aws.Context, input *UpdateSnapshotScheduleInput) (*UpdateSnapshotScheduleOutput, error) {
+	req, out := c.UpdateSnapshotScheduleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opUpdateVTLDeviceType = "UpdateVTLDeviceType"
 
 // UpdateVTLDeviceTypeRequest generates a request for the UpdateVTLDeviceType operation.
@@ -1766,6 +2257,16 @@ func (c *StorageGateway) UpdateVTLDeviceType(input *UpdateVTLDeviceTypeInput) (*
 	return out, err
 }
 
+// UpdateVTLDeviceTypeWithContext is the same as UpdateVTLDeviceType with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *StorageGateway) UpdateVTLDeviceTypeWithContext(ctx aws.Context, input *UpdateVTLDeviceTypeInput) (*UpdateVTLDeviceTypeOutput, error) {
+	req, out := c.UpdateVTLDeviceTypeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   ActivateGatewayInput$ActivationKey   GatewayName   ActivateGatewayInput$GatewayRegion
@@ -1779,11 +2280,11 @@ type ActivateGatewayInput struct {
 	// also include other activation-related parameters, however, these are merely
 	// defaults -- the arguments you pass to the ActivateGateway API call determine
 	// the actual configuration of your gateway.
-	ActivationKey *string `type:"string" required:"true"`
+	ActivationKey *string `type:"string" min:"1" max:"50" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string" required:"true"`
+	GatewayName *string `type:"string" min:"2" max:"255" pattern:"^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$" required:"true"`
 
 	// One of the values that indicates the region where you want to store the snapshot
 	// backups. The gateway region specified must be the same region as the region
@@ -1794,29 +2295,29 @@ type ActivateGatewayInput struct {
 	//
 	// Valid Values: "us-east-1", "us-west-1", "us-west-2", "eu-west-1", "eu-central-1",
 	// "ap-northeast-1", "ap-southeast-1", "ap-southeast-2", "sa-east-1"
-	GatewayRegion *string `type:"string" required:"true"`
+	GatewayRegion *string `type:"string" min:"1" max:"25" required:"true"`
 
 	// One of the values that indicates the time zone you want to set for the gateway.
 	// The time zone is used, for example, for scheduling snapshots and your gateway's
 	// maintenance schedule.
-	GatewayTimezone *string `type:"string" required:"true"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10" required:"true"`
 
 	// One of the values that defines the type of gateway to activate. The type
 	// specified is critical to all later functions of the gateway and cannot be
 	// changed after activation. The default value is STORED.
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The value that indicates the type of medium changer to use for gateway-VTL.
 	// This field is optional.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	MediumChangerType *string `type:"string"`
+	MediumChangerType *string `type:"string" min:"2" max:"50"`
 
 	// The value that indicates the type of tape drive to use for gateway-VTL. This
 	// field is optional.
 	//
 	// Valid Values: "IBM-ULT3580-TD5"
-	TapeDriveType *string `type:"string"`
+	TapeDriveType *string `type:"string" min:"2" max:"50"`
 
 	metadataActivateGatewayInput `json:"-" xml:"-"`
 }
@@ -1825,6 +2326,16 @@ type metadataActivateGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivateGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivateGatewayInput) GoString() string {
+	return s.String()
+}
+
 // AWS Storage Gateway returns the Amazon Resource Name (ARN) of the activated
 // gateway. It is a string made of information such as your account, gateway
 // name, and region. This ARN is used to reference the gateway in other API
@@ -1832,7 +2343,7 @@ type metadataActivateGatewayInput struct {
 type ActivateGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataActivateGatewayOutput `json:"-" xml:"-"`
 }
@@ -1841,12 +2352,22 @@ type metadataActivateGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type AddCacheInput struct {
-	DiskIDs []*string `locationName:"DiskIds" type:"list" required:"true"`
+// String returns the string representation
+func (s ActivateGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivateGatewayOutput) GoString() string {
+	return s.String()
+}
+
+type AddCacheInput struct {
+	DiskIDs []*string `locationName:"DiskIds" type:"list" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddCacheInput `json:"-" xml:"-"`
 }
@@ -1855,10 +2376,20 @@ type metadataAddCacheInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddCacheInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddCacheInput) GoString() string {
+	return s.String()
+}
+
 type AddCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddCacheOutput `json:"-" xml:"-"`
 }
@@ -1867,12 +2398,22 @@ type metadataAddCacheOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddCacheOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddCacheOutput) GoString() string {
+	return s.String()
+}
+
 type AddUploadBufferInput struct {
 	DiskIDs []*string `locationName:"DiskIds" type:"list" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddUploadBufferInput `json:"-" xml:"-"`
 }
@@ -1881,10 +2422,20 @@ type metadataAddUploadBufferInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddUploadBufferInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddUploadBufferInput) GoString() string {
+	return s.String()
+}
+
 type AddUploadBufferOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddUploadBufferOutput `json:"-" xml:"-"`
 }
@@ -1893,6 +2444,16 @@ type metadataAddUploadBufferOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddUploadBufferOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddUploadBufferOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   AddWorkingStorageInput$DiskIds
@@ -1904,7 +2465,7 @@ type AddWorkingStorageInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddWorkingStorageInput `json:"-" xml:"-"`
 }
@@ -1913,12 +2474,22 @@ type metadataAddWorkingStorageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddWorkingStorageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddWorkingStorageInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway for which working storage was
 // configured.
 type AddWorkingStorageOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddWorkingStorageOutput `json:"-" xml:"-"`
 }
@@ -1927,20 +2498,30 @@ type metadataAddWorkingStorageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddWorkingStorageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddWorkingStorageOutput) GoString() string {
+	return s.String()
+}
+
 type CachediSCSIVolume struct {
-	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
+	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -1952,15 +2533,25 @@ type metadataCachediSCSIVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CachediSCSIVolume) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CachediSCSIVolume) GoString() string {
+	return s.String()
+}
+
 // CancelArchivalInput
 type CancelArchivalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel archiving
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelArchivalInput `json:"-" xml:"-"`
 }
@@ -1969,11 +2560,21 @@ type metadataCancelArchivalInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelArchivalInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelArchivalInput) GoString() string {
+	return s.String()
+}
+
 // CancelArchivalOutput
 type CancelArchivalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which archiving was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelArchivalOutput `json:"-" xml:"-"`
 }
@@ -1982,15 +2583,25 @@ type metadataCancelArchivalOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelArchivalOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelArchivalOutput) GoString() string {
+	return s.String()
+}
+
 // CancelRetrievalInput
 type CancelRetrievalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel retrieval
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelRetrievalInput `json:"-" xml:"-"`
 }
@@ -1999,11 +2610,21 @@ type metadataCancelRetrievalInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelRetrievalInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelRetrievalInput) GoString() string {
+	return s.String()
+}
+
 // CancelRetrievalOutput
 type CancelRetrievalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which retrieval was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelRetrievalOutput `json:"-" xml:"-"`
 }
@@ -2012,25 +2633,35 @@ type metadataCancelRetrievalOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelRetrievalOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelRetrievalOutput) GoString() string {
+	return s.String()
+}
+
 // Describes Challenge-Handshake Authentication Protocol (CHAP) information
 // that supports authentication between your gateway and iSCSI initiators.
 type ChapInfo struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The secret key that the initiator (for example, the Windows client) must
 	// provide to participate in mutual CHAP with the target.
-	SecretToAuthenticateInitiator *string `type:"string"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"1" max:"100"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"1" max:"100"`
 
 	// The Amazon Resource Name (ARN) of the volume.
 	//
 	// Valid Values: 50 to 500 lowercase letters, numbers, periods (.), and hyphens
 	// (-).
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataChapInfo `json:"-" xml:"-"`
 }
@@ -2039,18 +2670,28 @@ type metadataChapInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChapInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChapInfo) GoString() string {
+	return s.String()
+}
+
 type CreateCachediSCSIVolumeInput struct {
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" min:"5" max:"100" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" required:"true"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z" required:"true"`
 
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" pattern:"^[-\\.;a-z0-9]+$" required:"true"`
 
 	VolumeSizeInBytes *int64 `type:"long" required:"true"`
 
@@ -2061,10 +2702,20 @@ type metadataCreateCachediSCSIVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCachediSCSIVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCachediSCSIVolumeInput) GoString() string {
+	return s.String()
+}
+
 type CreateCachediSCSIVolumeOutput struct {
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateCachediSCSIVolumeOutput `json:"-" xml:"-"`
 }
@@ -2073,10 +2724,20 @@ type metadataCreateCachediSCSIVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCachediSCSIVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCachediSCSIVolumeOutput) GoString() string {
+	return s.String()
+}
+
 type CreateSnapshotFromVolumeRecoveryPointInput struct {
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotFromVolumeRecoveryPointInput `json:"-" xml:"-"`
 }
@@ -2085,10 +2746,20 @@ type metadataCreateSnapshotFromVolumeRecoveryPointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotFromVolumeRecoveryPointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotFromVolumeRecoveryPointInput) GoString() string {
+	return s.String()
+}
+
 type CreateSnapshotFromVolumeRecoveryPointOutput struct {
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -2099,6 +2770,16 @@ type metadataCreateSnapshotFromVolumeRecoveryPointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotFromVolumeRecoveryPointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotFromVolumeRecoveryPointOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   CreateSnapshotInput$SnapshotDescription   CreateSnapshotInput$VolumeARN
@@ -2106,11 +2787,11 @@ type CreateSnapshotInput struct {
 	// Textual description of the snapshot that appears in the Amazon EC2 console,
 	// Elastic Block Store snapshots panel in the Description field, and in the
 	// AWS Storage Gateway snapshot Details pane, Description field
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotInput `json:"-" xml:"-"`
 }
@@ -2119,15 +2800,25 @@ type metadataCreateSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type CreateSnapshotOutput struct {
 	// The snapshot ID that is used to refer to the snapshot in future operations
 	// such as describing snapshots (Amazon Elastic Compute Cloud API DescribeSnapshots)
 	// or creating a volume from a snapshot (CreateStorediSCSIVolume).
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
 	// The Amazon Resource Name (ARN) of the volume of which the snapshot was taken.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateSnapshotOutput `json:"-" xml:"-"`
 }
@@ -2136,6 +2827,16 @@ type metadataCreateSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateSnapshotOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   CreateStorediSCSIVolumeInput$DiskId   CreateStorediSCSIVolumeInput$NetworkInterfaceId
@@ -2145,18 +2846,18 @@ type CreateStorediSCSIVolumeInput struct {
 	// The unique identifier for the gateway local disk that is configured as a
 	// stored volume. Use ListLocalDisks (http://docs.aws.amazon.com/storagegateway/latest/userguide/API_ListLocalDisks.html)
 	// to list disk IDs for a gateway.
-	DiskID *string `locationName:"DiskId" type:"string" required:"true"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The network interface of the gateway on which to expose the iSCSI target.
 	// Only IPv4 addresses are accepted. Use DescribeGatewayInformation to get a
 	// list of the network interfaces available on a gateway.
 	//
 	// Valid Values: A valid IP address.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" required:"true"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z" required:"true"`
 
 	// Specify this field as true if you want to preserve the data on the local
 	// disk. Otherwise, specifying this field as false creates an empty volume.
@@ -2169,13 +2870,13 @@ type CreateStorediSCSIVolumeInput struct {
 	// volume from a snapshot otherwise do not include this field. To list snapshots
 	// for your account use DescribeSnapshots (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/ApiReference-query-DescribeSnapshots.html)
 	// in the Amazon Elastic Compute Cloud API Reference.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
 	// The name of the iSCSI target used by initiators to connect to the target
 	// and as a suffix for the target ARN. For example, specifying TargetName as
 	// myvolume results in the target ARN of arn:aws:storagegateway:us-east-1:111122223333:gateway/mygateway/target/iqn.1997-05.com.amazon:myvolume.
 	// The target name must be unique across all volumes of a gateway.
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" pattern:"^[-\\.;a-z0-9]+$" required:"true"`
 
 	metadataCreateStorediSCSIVolumeInput `json:"-" xml:"-"`
 }
@@ -2184,14 +2885,24 @@ type metadataCreateStorediSCSIVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStorediSCSIVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStorediSCSIVolumeInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type CreateStorediSCSIVolumeOutput struct {
 	// he Amazon Resource Name (ARN) of the volume target that includes the iSCSI
 	// name that initiators can use to connect to the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	// The Amazon Resource Name (ARN) of the configured volume.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	// The size of the volume in bytes.
 	VolumeSizeInBytes *int64 `type:"long"`
@@ -2203,28 +2914,38 @@ type metadataCreateStorediSCSIVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateStorediSCSIVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateStorediSCSIVolumeOutput) GoString() string {
+	return s.String()
+}
+
 // CreateTapesInput
 type CreateTapesInput struct {
 	// A unique identifier that you use to retry a request. If you retry a request,
 	// use the same ClientToken you specified in the initial request.
 	//
 	// Using the same ClientToken prevents creating the tape multiple times.
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" min:"5" max:"100" required:"true"`
 
 	// The unique Amazon Resource Name(ARN) that represents the gateway to associate
 	// the virtual tapes with. Use the ListGateways operation to return a list of
 	// gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The number of virtual tapes you want to create.
-	NumTapesToCreate *int64 `type:"integer" required:"true"`
+	NumTapesToCreate *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// A prefix you append to the barcode of the virtual tape you are creating.
 	// This makes a barcode unique.
 	//
 	// The prefix must be 1 to 4 characters in length and must be upper-case letters
 	// A-Z.
-	TapeBarcodePrefix *string `type:"string" required:"true"`
+	TapeBarcodePrefix *string `type:"string" min:"1" max:"4" pattern:"^[A-Z]*$" required:"true"`
 
 	// The size, in bytes, of the virtual tapes you want to create.
 	//
@@ -2238,6 +2959,16 @@ type metadataCreateTapesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTapesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTapesInput) GoString() string {
+	return s.String()
+}
+
 // CreateTapeOutput
 type CreateTapesOutput struct {
 	// A list of unique Amazon Resource Named (ARN) the represents the virtual tapes
@@ -2251,12 +2982,22 @@ type metadataCreateTapesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateTapesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateTapesOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteBandwidthRateLimitInput struct {
-	BandwidthType *string `type:"string" required:"true"`
+	BandwidthType *string `type:"string" min:"3" max:"25" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteBandwidthRateLimitInput `json:"-" xml:"-"`
 }
@@ -2265,12 +3006,22 @@ type metadataDeleteBandwidthRateLimitInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteBandwidthRateLimitInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteBandwidthRateLimitInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway whose bandwidth rate information
 // was deleted.
 type DeleteBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteBandwidthRateLimitOutput `json:"-" xml:"-"`
 }
@@ -2279,16 +3030,26 @@ type metadataDeleteBandwidthRateLimitOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteBandwidthRateLimitOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteBandwidthRateLimitOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   DeleteChapCredentialsInput$InitiatorName   DeleteChapCredentialsInput$TargetARN
 type DeleteChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDeleteChapCredentialsInput `json:"-" xml:"-"`
 }
@@ -2297,13 +3058,23 @@ type metadataDeleteChapCredentialsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteChapCredentialsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteChapCredentialsInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type DeleteChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The Amazon Resource Name (ARN) of the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeleteChapCredentialsOutput `json:"-" xml:"-"`
 }
@@ -2312,11 +3083,21 @@ type metadataDeleteChapCredentialsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteChapCredentialsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteChapCredentialsOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the id of the gateway to delete.
 type DeleteGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteGatewayInput `json:"-" xml:"-"`
 }
@@ -2325,11 +3106,21 @@ type metadataDeleteGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteGatewayInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the id of the deleted gateway.
 type DeleteGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteGatewayOutput `json:"-" xml:"-"`
 }
@@ -2338,8 +3129,18 @@ type metadataDeleteGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type DeleteSnapshotScheduleInput struct {
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteSnapshotScheduleInput `json:"-" xml:"-"`
 }
@@ -2348,8 +3149,18 @@ type metadataDeleteSnapshotScheduleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotScheduleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotScheduleInput) GoString() string {
+	return s.String()
+}
+
 type DeleteSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteSnapshotScheduleOutput `json:"-" xml:"-"`
 }
@@ -2358,11 +3169,21 @@ type metadataDeleteSnapshotScheduleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteSnapshotScheduleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteSnapshotScheduleOutput) GoString() string {
+	return s.String()
+}
+
 // DeleteTapeArchiveInput
 type DeleteTapeArchiveInput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape to delete from the virtual
 	// tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeArchiveInput `json:"-" xml:"-"`
 }
@@ -2371,11 +3192,21 @@ type metadataDeleteTapeArchiveInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTapeArchiveInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTapeArchiveInput) GoString() string {
+	return s.String()
+}
+
 // DeleteTapeArchiveOutput
 type DeleteTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape that was deleted from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeArchiveOutput `json:"-" xml:"-"`
 }
@@ -2384,15 +3215,25 @@ type metadataDeleteTapeArchiveOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTapeArchiveOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTapeArchiveOutput) GoString() string {
+	return s.String()
+}
+
 // DeleteTapeInput
 type DeleteTapeInput struct {
 	// The unique Amazon Resource Name (ARN) of the gateway that the virtual tape
 	// to delete is associated with. Use the ListGateways operation to return a
 	// list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape to delete.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeInput `json:"-" xml:"-"`
 }
@@ -2401,10 +3242,20 @@ type metadataDeleteTapeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTapeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTapeInput) GoString() string {
+	return s.String()
+}
+
 // DeleteTapeOutput
 type DeleteTapeOutput struct {
 	// The Amazon Resource Name (ARN) of the deleted virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeOutput `json:"-" xml:"-"`
 }
@@ -2413,11 +3264,21 @@ type metadataDeleteTapeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteTapeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteTapeOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the DeleteVolumeInput$VolumeARN to delete.
 type DeleteVolumeInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteVolumeInput `json:"-" xml:"-"`
 }
@@ -2426,11 +3287,21 @@ type metadataDeleteVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVolumeInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the storage volume that was deleted
 type DeleteVolumeOutput struct {
 	// The Amazon Resource Name (ARN) of the storage volume that was deleted. It
 	// is the same ARN you provided in the request.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteVolumeOutput `json:"-" xml:"-"`
 }
@@ -2439,11 +3310,21 @@ type metadataDeleteVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeleteVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeleteVolumeOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway.
 type DescribeBandwidthRateLimitInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeBandwidthRateLimitInput `json:"-" xml:"-"`
 }
@@ -2452,19 +3333,29 @@ type metadataDescribeBandwidthRateLimitInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeBandwidthRateLimitInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeBandwidthRateLimitInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type DescribeBandwidthRateLimitOutput struct {
 	// The average download bandwidth rate limit in bits per second. This field
 	// does not appear in the response if the download rate limit is not set.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second. This field does
 	// not appear in the response if the upload rate limit is not set.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeBandwidthRateLimitOutput `json:"-" xml:"-"`
 }
@@ -2473,10 +3364,20 @@ type metadataDescribeBandwidthRateLimitOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeBandwidthRateLimitOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeBandwidthRateLimitOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeCacheInput `json:"-" xml:"-"`
 }
@@ -2485,6 +3386,16 @@ type metadataDescribeCacheInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheInput) GoString() string {
+	return s.String()
+}
+
 type DescribeCacheOutput struct {
 	CacheAllocatedInBytes *int64 `type:"long"`
 
@@ -2500,7 +3411,7 @@ type DescribeCacheOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeCacheOutput `json:"-" xml:"-"`
 }
@@ -2509,6 +3420,16 @@ type metadataDescribeCacheOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCacheOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCacheOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeCachediSCSIVolumesInput struct {
 	VolumeARNs []*string `type:"list" required:"true"`
 
@@ -2519,6 +3440,16 @@ type metadataDescribeCachediSCSIVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCachediSCSIVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCachediSCSIVolumesInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type DescribeCachediSCSIVolumesOutput struct {
 	// An array of objects where each object contains metadata about one cached
@@ -2532,12 +3463,22 @@ type metadataDescribeCachediSCSIVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCachediSCSIVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCachediSCSIVolumesOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the Amazon Resource Name (ARN) of the iSCSI volume
 // target.
 type DescribeChapCredentialsInput struct {
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDescribeChapCredentialsInput `json:"-" xml:"-"`
 }
@@ -2546,6 +3487,16 @@ type metadataDescribeChapCredentialsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeChapCredentialsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeChapCredentialsInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing a .
 type DescribeChapCredentialsOutput struct {
 	// An array of ChapInfo objects that represent CHAP credentials. Each object
@@ -2572,11 +3523,21 @@ type metadataDescribeChapCredentialsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeChapCredentialsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeChapCredentialsOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the id of the gateway.
 type DescribeGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeGatewayInformationInput `json:"-" xml:"-"`
 }
@@ -2585,37 +3546,47 @@ type metadataDescribeGatewayInformationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeGatewayInformationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeGatewayInformationInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type DescribeGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The gateway ID.
-	GatewayID *string `locationName:"GatewayId" type:"string"`
+	GatewayID *string `locationName:"GatewayId" type:"string" min:"12" max:"30"`
 
 	// A NetworkInterface array that contains descriptions of the gateway network
 	// interfaces.
 	GatewayNetworkInterfaces []*NetworkInterface `type:"list"`
 
 	// One of the values that indicates the operating state of the gateway.
-	GatewayState *string `type:"string"`
+	GatewayState *string `type:"string" min:"2" max:"25"`
 
 	// One of the values that indicates the time zone configured for the gateway.
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	// The type of the gateway.
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The date on which the last software update was applied to the gateway. If
 	// the gateway has never been updated, this field does not return a value in
 	// the response.
-	LastSoftwareUpdate *string `type:"string"`
+	LastSoftwareUpdate *string `type:"string" min:"1" max:"25"`
 
 	// The date on which an update to the gateway is available. This date is in
 	// the time zone of the gateway. If the gateway is not available for an update
 	// this field is not returned in the response.
-	NextUpdateAvailabilityDate *string `type:"string"`
+	NextUpdateAvailabilityDate *string `type:"string" min:"1" max:"25"`
 
 	metadataDescribeGatewayInformationOutput `json:"-" xml:"-"`
 }
@@ -2624,11 +3595,21 @@ type metadataDescribeGatewayInformationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeGatewayInformationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeGatewayInformationOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway.
 type DescribeMaintenanceStartTimeInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeMaintenanceStartTimeInput `json:"-" xml:"-"`
 }
@@ -2637,18 +3618,28 @@ type metadataDescribeMaintenanceStartTimeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMaintenanceStartTimeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMaintenanceStartTimeInput) GoString() string {
+	return s.String()
+}
+
 type DescribeMaintenanceStartTimeOutput struct {
-	DayOfWeek *int64 `type:"integer"`
+	DayOfWeek *int64 `type:"integer" min:"0" max:"6"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	HourOfDay *int64 `type:"integer"`
+	HourOfDay *int64 `type:"integer" min:"0" max:"23"`
 
-	MinuteOfHour *int64 `type:"integer"`
+	MinuteOfHour *int64 `type:"integer" min:"0" max:"59"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
 	metadataDescribeMaintenanceStartTimeOutput `json:"-" xml:"-"`
 }
@@ -2657,12 +3648,22 @@ type metadataDescribeMaintenanceStartTimeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeMaintenanceStartTimeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeMaintenanceStartTimeOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the DescribeSnapshotScheduleInput$VolumeARN of the
 // volume.
 type DescribeSnapshotScheduleInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeSnapshotScheduleInput `json:"-" xml:"-"`
 }
@@ -2671,16 +3672,26 @@ type metadataDescribeSnapshotScheduleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotScheduleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotScheduleInput) GoString() string {
+	return s.String()
+}
+
 type DescribeSnapshotScheduleOutput struct {
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
-	RecurrenceInHours *int64 `type:"integer"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24"`
 
-	StartAt *int64 `type:"integer"`
+	StartAt *int64 `type:"integer" min:"0" max:"23"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeSnapshotScheduleOutput `json:"-" xml:"-"`
 }
@@ -2689,6 +3700,16 @@ type metadataDescribeSnapshotScheduleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSnapshotScheduleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSnapshotScheduleOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON Object containing a list of DescribeStorediSCSIVolumesInput$VolumeARNs.
 type DescribeStorediSCSIVolumesInput struct {
 	// An array of strings where each string represents the Amazon Resource Name
@@ -2703,6 +3724,16 @@ type metadataDescribeStorediSCSIVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStorediSCSIVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStorediSCSIVolumesInput) GoString() string {
+	return s.String()
+}
+
 type DescribeStorediSCSIVolumesOutput struct {
 	StorediSCSIVolumes []*StorediSCSIVolume `type:"list"`
 
@@ -2713,15 +3744,25 @@ type metadataDescribeStorediSCSIVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeStorediSCSIVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeStorediSCSIVolumesOutput) GoString() string {
+	return s.String()
+}
+
 // DescribeTapeArchivesInput
 type DescribeTapeArchivesInput struct {
 	// Specifies that the number of virtual tapes descried be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// virtual tapes.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe.
@@ -2734,6 +3775,16 @@ type metadataDescribeTapeArchivesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTapeArchivesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTapeArchivesInput) GoString() string {
+	return s.String()
+}
+
 // DescribeTapeArchivesOutput
 type DescribeTapeArchivesOutput struct {
 	// An opaque string that indicates the position at which the virtual tapes that
@@ -2741,7 +3792,7 @@ type DescribeTapeArchivesOutput struct {
 	// to fetch the next set of virtual tapes in the virtual tape shelf (VTS). If
 	// there are no more virtual tapes to describe, this field does not appear in
 	// the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape objects in the virtual tape shelf (VTS). The description
 	// includes of the Amazon Resource Name(ARN) of the virtual tapes. The information
@@ -2756,19 +3807,29 @@ type metadataDescribeTapeArchivesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTapeArchivesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTapeArchivesOutput) GoString() string {
+	return s.String()
+}
+
 // DescribeTapeRecoveryPointsInput
 type DescribeTapeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tape recovery points that are described
 	// be limited to the specified number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the virtual tape recovery points.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataDescribeTapeRecoveryPointsInput `json:"-" xml:"-"`
 }
@@ -2777,11 +3838,21 @@ type metadataDescribeTapeRecoveryPointsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTapeRecoveryPointsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTapeRecoveryPointsInput) GoString() string {
+	return s.String()
+}
+
 // DescribeTapeRecoveryPointsOutput
 type DescribeTapeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the virtual tape recovery
 	// points that were listed for description ended.
@@ -2789,7 +3860,7 @@ type DescribeTapeRecoveryPointsOutput struct {
 	// Use this marker in your next request to list the next set of virtual tape
 	// recovery points in the list. If there are no more recovery points to describe,
 	// this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of TapeRecoveryPointInfos that are available for the specified gateway.
 	TapeRecoveryPointInfos []*TapeRecoveryPointInfo `type:"list"`
@@ -2801,23 +3872,33 @@ type metadataDescribeTapeRecoveryPointsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTapeRecoveryPointsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTapeRecoveryPointsOutput) GoString() string {
+	return s.String()
+}
+
 // DescribeTapesInput
 type DescribeTapesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tapes described be limited to the specified
 	// number.
 	//
 	// Amazon Web Services may impose its own limit, if this field is not set.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A marker value, obtained in a previous call to DescribeTapes. This marker
 	// indicates which page of results to retrieve.
 	//
 	// If not specified, the first page of results is retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe. If this parameter is not specified,
@@ -2832,6 +3913,16 @@ type metadataDescribeTapesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTapesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTapesInput) GoString() string {
+	return s.String()
+}
+
 // DescribeTapesOutput
 type DescribeTapesOutput struct {
 	// An opaque string which can be used as part of a subsequent DescribeTapes
@@ -2839,7 +3930,7 @@ type DescribeTapesOutput struct {
 	//
 	// If a response does not contain a marker, then there are no more results
 	// to be retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape descriptions.
 	Tapes []*Tape `type:"list"`
@@ -2851,10 +3942,20 @@ type metadataDescribeTapesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTapesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTapesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeUploadBufferInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeUploadBufferInput `json:"-" xml:"-"`
 }
@@ -2863,12 +3964,22 @@ type metadataDescribeUploadBufferInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeUploadBufferInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeUploadBufferInput) GoString() string {
+	return s.String()
+}
+
 type DescribeUploadBufferOutput struct {
 	DiskIDs []*string `locationName:"DiskIds" type:"list"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	UploadBufferAllocatedInBytes *int64 `type:"long"`
 
@@ -2881,19 +3992,29 @@ type metadataDescribeUploadBufferOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeUploadBufferOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeUploadBufferOutput) GoString() string {
+	return s.String()
+}
+
 // DescribeVTLDevicesInput
 type DescribeVTLDevicesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of VTL devices described be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the VTL devices.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of strings, where each string represents the Amazon Resource Name
 	// (ARN) of a VTL device.
@@ -2910,17 +4031,27 @@ type metadataDescribeVTLDevicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVTLDevicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVTLDevicesInput) GoString() string {
+	return s.String()
+}
+
 // DescribeVTLDevicesOutput
 type DescribeVTLDevicesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the VTL devices that
 	// were fetched for description ended. Use the marker in your next request to
 	// fetch the next set of VTL devices in the list. If there are no more VTL devices
 	// to describe, this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of VTL device objects composed of the Amazon Resource Name(ARN)
 	// of the VTL devices.
@@ -2933,11 +4064,21 @@ type metadataDescribeVTLDevicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeVTLDevicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeVTLDevicesOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway.
 type DescribeWorkingStorageInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeWorkingStorageInput `json:"-" xml:"-"`
 }
@@ -2946,6 +4087,16 @@ type metadataDescribeWorkingStorageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkingStorageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkingStorageInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type DescribeWorkingStorageOutput struct {
 	// An array of the gateway's local disk IDs that are configured as working storage.
@@ -2956,7 +4107,7 @@ type DescribeWorkingStorageOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The total working storage in bytes allocated for the gateway. If no working
 	// storage is configured for the gateway, this field returns 0.
@@ -2973,20 +4124,30 @@ type metadataDescribeWorkingStorageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkingStorageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkingStorageOutput) GoString() string {
+	return s.String()
+}
+
 // Lists iSCSI information about a VTL device.
 type DeviceiSCSIAttributes struct {
 	// Indicates whether mutual CHAP is enabled for the iSCSI target.
 	ChapEnabled *bool `type:"boolean"`
 
 	// The network interface identifier of the VTL device.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"`
 
 	// The port used to communicate with iSCSI VTL device targets.
 	NetworkInterfacePort *int64 `type:"integer"`
 
 	// Specifies the unique Amazon Resource Name(ARN) that encodes the iSCSI qualified
 	// name(iqn) of a tape drive or media changer target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeviceiSCSIAttributes `json:"-" xml:"-"`
 }
@@ -2995,11 +4156,21 @@ type metadataDeviceiSCSIAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeviceiSCSIAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeviceiSCSIAttributes) GoString() string {
+	return s.String()
+}
+
 // DisableGatewayInput
 type DisableGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDisableGatewayInput `json:"-" xml:"-"`
 }
@@ -3008,10 +4179,20 @@ type metadataDisableGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableGatewayInput) GoString() string {
+	return s.String()
+}
+
 // DisableGatewayOutput
 type DisableGatewayOutput struct {
 	// The unique Amazon Resource Name of the disabled gateway.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDisableGatewayOutput `json:"-" xml:"-"`
 }
@@ -3020,12 +4201,22 @@ type metadataDisableGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DisableGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DisableGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type Disk struct {
 	DiskAllocationResource *string `type:"string"`
 
-	DiskAllocationType *string `type:"string"`
+	DiskAllocationType *string `type:"string" min:"3" max:"100"`
 
-	DiskID *string `locationName:"DiskId" type:"string"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300"`
 
 	DiskNode *string `type:"string"`
 
@@ -3042,12 +4233,24 @@ type metadataDisk struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Disk) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Disk) GoString() string {
+	return s.String()
+}
+
 // Provides additional information about an error that was returned by the service
 // as an or. See the errorCode and errorDetails members for more information
 // about the error.
 type Error struct {
 	// Additional information about the error.
-	ErrorCode *string `locationName:"errorCode" type:"string"`
+	//
+	// See the ErrorCode* constants for valid values.
+	ErrorCode *string `locationName:"errorCode" type:"string" enum:"ActivationKeyExpired,ActivationKeyInvalid,ActivationKeyNotFound,GatewayInternalError,GatewayNotConnected,GatewayNotFound,GatewayProxyNetworkConnectionBusy,AuthenticationFailure,BandwidthThrottleScheduleNotFound,Blocked,CannotExportSnapshot,ChapCredentialNotFound,DiskAlreadyAllocated,DiskDoesNotExist,DiskSizeGreaterThanVolumeMaxSize,DiskSizeLessThanVolumeSize,DiskSizeNotGigAligned,DuplicateCertificateInfo,DuplicateSchedule,EndpointNotFound,IAMNotSupported,InitiatorInvalid,InitiatorNotFound,InternalError,InvalidGateway,InvalidEndpoint,InvalidParameters,InvalidSchedule,LocalStorageLimitExceeded,LunAlreadyAllocated ,LunInvalid,MaximumContentLengthExceeded,MaximumTapeCartridgeCountExceeded,MaximumVolumeCountExceeded,NetworkConfigurationChanged,NoDisksAvailable,NotImplemented,NotSupported,OperationAborted,OutdatedGateway,ParametersNotImplemented,RegionInvalid,RequestTimeout,ServiceUnavailable,SnapshotDeleted,SnapshotIdInvalid,SnapshotInProgress,SnapshotNotFound,SnapshotScheduleNotFound,StagingAreaFull,StorageFailure,TapeCartridgeNotFound,TargetAlreadyExists,TargetInvalid,TargetNotFound,UnauthorizedOperation,VolumeAlreadyExists,VolumeIdInvalid,VolumeInUse,VolumeNotFound,VolumeNotReady"`
 
 	// Human-readable text that provides detail about the error that occurred.
 	ErrorDetails map[string]*string `locationName:"errorDetails" type:"map"`
@@ -3059,14 +4262,24 @@ type metadataError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Error) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Error) GoString() string {
+	return s.String()
+}
+
 type GatewayInfo struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	GatewayOperationalState *string `type:"string"`
+	GatewayOperationalState *string `type:"string" min:"2" max:"25"`
 
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	metadataGatewayInfo `json:"-" xml:"-"`
 }
@@ -3075,17 +4288,27 @@ type metadataGatewayInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GatewayInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GatewayInfo) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing zero or more of the following fields:
 //
 //   ListGatewaysInput$Limit   ListGatewaysInput$Marker
 type ListGatewaysInput struct {
 	// Specifies that the list of gateways returned be limited to the specified
 	// number of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin the returned
 	// list of gateways.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysInput `json:"-" xml:"-"`
 }
@@ -3094,10 +4317,20 @@ type metadataListGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGatewaysInput) GoString() string {
+	return s.String()
+}
+
 type ListGatewaysOutput struct {
 	Gateways []*GatewayInfo `type:"list"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysOutput `json:"-" xml:"-"`
 }
@@ -3106,11 +4339,21 @@ type metadataListGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListGatewaysOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway.
 type ListLocalDisksInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListLocalDisksInput `json:"-" xml:"-"`
 }
@@ -3119,12 +4362,22 @@ type metadataListLocalDisksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListLocalDisksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListLocalDisksInput) GoString() string {
+	return s.String()
+}
+
 type ListLocalDisksOutput struct {
 	Disks []*Disk `type:"list"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataListLocalDisksOutput `json:"-" xml:"-"`
 }
@@ -3133,11 +4386,21 @@ type metadataListLocalDisksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListLocalDisksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListLocalDisksOutput) GoString() string {
+	return s.String()
+}
+
 // ListVolumeInitiatorsInput
 type ListVolumeInitiatorsInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes for the gateway.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListVolumeInitiatorsInput `json:"-" xml:"-"`
 }
@@ -3146,6 +4409,16 @@ type metadataListVolumeInitiatorsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVolumeInitiatorsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVolumeInitiatorsInput) GoString() string {
+	return s.String()
+}
+
 // ListVolumeInitiatorsOutput
 type ListVolumeInitiatorsOutput struct {
 	// The host names and port numbers of all iSCSI initiators that are connected
@@ -3159,10 +4432,20 @@ type metadataListVolumeInitiatorsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVolumeInitiatorsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVolumeInitiatorsOutput) GoString() string {
+	return s.String()
+}
+
 type ListVolumeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListVolumeRecoveryPointsInput `json:"-" xml:"-"`
 }
@@ -3171,10 +4454,20 @@ type metadataListVolumeRecoveryPointsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVolumeRecoveryPointsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVolumeRecoveryPointsInput) GoString() string {
+	return s.String()
+}
+
 type ListVolumeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointInfos []*VolumeRecoveryPointInfo `type:"list"`
 
@@ -3185,22 +4478,32 @@ type metadataListVolumeRecoveryPointsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVolumeRecoveryPointsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVolumeRecoveryPointsOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object that contains one or more of the following fields:
 //
 //   ListVolumesInput$Limit   ListVolumesInput$Marker
 type ListVolumesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the list of volumes returned be limited to the specified number
 	// of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that indicates the position at which to begin the returned list
 	// of volumes. Obtain the marker from the response of a previous List iSCSI
 	// Volumes request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListVolumesInput `json:"-" xml:"-"`
 }
@@ -3209,12 +4512,22 @@ type metadataListVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVolumesInput) GoString() string {
+	return s.String()
+}
+
 type ListVolumesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	VolumeInfos []*VolumeInfo `type:"list"`
 
@@ -3225,6 +4538,16 @@ type metadataListVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListVolumesOutput) GoString() string {
+	return s.String()
+}
+
 // Describes a gateway's network interface.
 type NetworkInterface struct {
 	// The Internet Protocol version 4 (IPv4) address of the interface.
@@ -3246,10 +4569,20 @@ type metadataNetworkInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s NetworkInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s NetworkInterface) GoString() string {
+	return s.String()
+}
+
 type ResetCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataResetCacheInput `json:"-" xml:"-"`
 }
@@ -3258,10 +4591,20 @@ type metadataResetCacheInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetCacheInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetCacheInput) GoString() string {
+	return s.String()
+}
+
 type ResetCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataResetCacheOutput `json:"-" xml:"-"`
 }
@@ -3270,6 +4613,16 @@ type metadataResetCacheOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResetCacheOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResetCacheOutput) GoString() string {
+	return s.String()
+}
+
 // RetrieveTapeArchiveInput
 type RetrieveTapeArchiveInput struct {
 	// The Amazon Resource Name (ARN) of the gateway you want to retrieve the virtual
@@ -3278,11 +4631,11 @@ type RetrieveTapeArchiveInput struct {
 	//
 	// You retrieve archived virtual tapes to only one gateway and the gateway
 	// must be a gateway-VTL.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to retrieve from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeArchiveInput `json:"-" xml:"-"`
 }
@@ -3291,10 +4644,20 @@ type metadataRetrieveTapeArchiveInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveTapeArchiveInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveTapeArchiveInput) GoString() string {
+	return s.String()
+}
+
 // RetrieveTapeArchiveOutput
 type RetrieveTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the retrieved virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeArchiveOutput `json:"-" xml:"-"`
 }
@@ -3303,15 +4666,25 @@ type metadataRetrieveTapeArchiveOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveTapeArchiveOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveTapeArchiveOutput) GoString() string {
+	return s.String()
+}
+
 // RetrieveTapeRecoveryPointInput
 type RetrieveTapeRecoveryPointInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape for which you want to
 	// retrieve the recovery point.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeRecoveryPointInput `json:"-" xml:"-"`
 }
@@ -3320,11 +4693,21 @@ type metadataRetrieveTapeRecoveryPointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveTapeRecoveryPointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveTapeRecoveryPointInput) GoString() string {
+	return s.String()
+}
+
 // RetrieveTapeRecoveryPointOutput
 type RetrieveTapeRecoveryPointOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which the recovery
 	// point was retrieved.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeRecoveryPointOutput `json:"-" xml:"-"`
 }
@@ -3333,11 +4716,21 @@ type metadataRetrieveTapeRecoveryPointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RetrieveTapeRecoveryPointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RetrieveTapeRecoveryPointOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway to shut down.
 type ShutdownGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataShutdownGatewayInput `json:"-" xml:"-"`
 }
@@ -3346,11 +4739,21 @@ type metadataShutdownGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ShutdownGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ShutdownGatewayInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway that was shut down.
 type ShutdownGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataShutdownGatewayOutput `json:"-" xml:"-"`
 }
@@ -3359,11 +4762,21 @@ type metadataShutdownGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ShutdownGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ShutdownGatewayOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway to start.
 type StartGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataStartGatewayInput `json:"-" xml:"-"`
 }
@@ -3372,11 +4785,21 @@ type metadataStartGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartGatewayInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway that was restarted.
 type StartGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataStartGatewayOutput `json:"-" xml:"-"`
 }
@@ -3385,24 +4808,34 @@ type metadataStartGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartGatewayOutput) GoString() string {
+	return s.String()
+}
+
 type StorediSCSIVolume struct {
 	PreservedExistingData *bool `type:"boolean"`
 
-	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
+	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string"`
+	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string" min:"1" max:"300"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -3414,6 +4847,16 @@ type metadataStorediSCSIVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StorediSCSIVolume) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StorediSCSIVolume) GoString() string {
+	return s.String()
+}
+
 // Describes a virtual tape object.
 type Tape struct {
 	// For archiving virtual tapes, indicates how much data remains to be uploaded
@@ -3423,10 +4866,10 @@ type Tape struct {
 	Progress *float64 `type:"double"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies a specific virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"7" max:"16" pattern:"^[A-Z0-9]*$"`
 
 	// The size, in bytes, of the virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -3436,7 +4879,7 @@ type Tape struct {
 
 	// The virtual tape library (VTL) device that the virtual tape is associated
 	// with.
-	VTLDevice *string `type:"string"`
+	VTLDevice *string `type:"string" min:"50" max:"500"`
 
 	metadataTape `json:"-" xml:"-"`
 }
@@ -3445,6 +4888,16 @@ type metadataTape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Tape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Tape) GoString() string {
+	return s.String()
+}
+
 // Represents a virtual tape that is archived in the virtual tape shelf (VTS).
 type TapeArchive struct {
 	// The time that the archiving of the virtual tape was completed.
@@ -3457,13 +4910,13 @@ type TapeArchive struct {
 	// being retrieved to.
 	//
 	// The virtual tape is retrieved from the virtual tape shelf (VTS).
-	RetrievedTo *string `type:"string"`
+	RetrievedTo *string `type:"string" min:"50" max:"500"`
 
 	// The Amazon Resource Name (ARN) of an archived virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies the archived virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"7" max:"16" pattern:"^[A-Z0-9]*$"`
 
 	// The size, in bytes, of the archived virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -3478,10 +4931,20 @@ type metadataTapeArchive struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TapeArchive) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TapeArchive) GoString() string {
+	return s.String()
+}
+
 // Describes a recovery point.
 type TapeRecoveryPointInfo struct {
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The time when the point-in-time view of the virtual tape was replicated for
 	// later recovery.
@@ -3502,19 +4965,29 @@ type metadataTapeRecoveryPointInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TapeRecoveryPointInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TapeRecoveryPointInfo) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   UpdateBandwidthRateLimitInput$AverageDownloadRateLimitInBitsPerSec   UpdateBandwidthRateLimitInput$AverageUploadRateLimitInBitsPerSec
 type UpdateBandwidthRateLimitInput struct {
 	// The average download bandwidth rate limit in bits per second.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateBandwidthRateLimitInput `json:"-" xml:"-"`
 }
@@ -3523,12 +4996,22 @@ type metadataUpdateBandwidthRateLimitInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateBandwidthRateLimitInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateBandwidthRateLimitInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway whose throttle information was
 // updated.
 type UpdateBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateBandwidthRateLimitOutput `json:"-" xml:"-"`
 }
@@ -3537,19 +5020,29 @@ type metadataUpdateBandwidthRateLimitOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateBandwidthRateLimitOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateBandwidthRateLimitOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   UpdateChapCredentialsInput$InitiatorName   UpdateChapCredentialsInput$SecretToAuthenticateInitiator
 //   UpdateChapCredentialsInput$SecretToAuthenticateTarget   UpdateChapCredentialsInput$TargetARN
 type UpdateChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+" required:"true"`
 
 	// The secret key that the initiator (for example, the Windows client) must
 	// provide to participate in mutual CHAP with the target.
 	//
 	// The secret key must be between 12 and 16 bytes when encoded in UTF-8.
-	SecretToAuthenticateInitiator *string `type:"string" required:"true"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
@@ -3557,11 +5050,11 @@ type UpdateChapCredentialsInput struct {
 	// Byte constraints: Minimum bytes of 12. Maximum bytes of 16.
 	//
 	// The secret key must be between 12 and 16 bytes when encoded in UTF-8.
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"1" max:"100"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataUpdateChapCredentialsInput `json:"-" xml:"-"`
 }
@@ -3570,15 +5063,25 @@ type metadataUpdateChapCredentialsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateChapCredentialsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateChapCredentialsInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 type UpdateChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target. This is the same initiator
 	// name specified in the request.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The Amazon Resource Name (ARN) of the target. This is the same target specified
 	// in the request.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataUpdateChapCredentialsOutput `json:"-" xml:"-"`
 }
@@ -3587,16 +5090,26 @@ type metadataUpdateChapCredentialsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateChapCredentialsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateChapCredentialsOutput) GoString() string {
+	return s.String()
+}
+
 type UpdateGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string"`
+	GatewayName *string `type:"string" min:"2" max:"255" pattern:"^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$"`
 
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	metadataUpdateGatewayInformationInput `json:"-" xml:"-"`
 }
@@ -3605,11 +5118,21 @@ type metadataUpdateGatewayInformationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateGatewayInformationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateGatewayInformationInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway that was updated.
 type UpdateGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewayInformationOutput `json:"-" xml:"-"`
 }
@@ -3618,11 +5141,21 @@ type metadataUpdateGatewayInformationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateGatewayInformationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateGatewayInformationOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway to update.
 type UpdateGatewaySoftwareNowInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateGatewaySoftwareNowInput `json:"-" xml:"-"`
 }
@@ -3631,11 +5164,21 @@ type metadataUpdateGatewaySoftwareNowInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateGatewaySoftwareNowInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateGatewaySoftwareNowInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway that was updated.
 type UpdateGatewaySoftwareNowOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewaySoftwareNowOutput `json:"-" xml:"-"`
 }
@@ -3644,27 +5187,37 @@ type metadataUpdateGatewaySoftwareNowOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateGatewaySoftwareNowOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateGatewaySoftwareNowOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the following fields:
 //
 //   UpdateMaintenanceStartTimeInput$DayOfWeek   UpdateMaintenanceStartTimeInput$HourOfDay
 //   UpdateMaintenanceStartTimeInput$MinuteOfHour
 type UpdateMaintenanceStartTimeInput struct {
 	// The maintenance start time day of the week.
-	DayOfWeek *int64 `type:"integer" required:"true"`
+	DayOfWeek *int64 `type:"integer" min:"0" max:"6" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The hour component of the maintenance start time represented as hh, where
 	// hh is the hour (00 to 23). The hour of the day is in the time zone of the
 	// gateway.
-	HourOfDay *int64 `type:"integer" required:"true"`
+	HourOfDay *int64 `type:"integer" min:"0" max:"23" required:"true"`
 
 	// The minute component of the maintenance start time represented as mm, where
 	// mm is the minute (00 to 59). The minute of the hour is in the time zone of
 	// the gateway.
-	MinuteOfHour *int64 `type:"integer" required:"true"`
+	MinuteOfHour *int64 `type:"integer" min:"0" max:"59" required:"true"`
 
 	metadataUpdateMaintenanceStartTimeInput `json:"-" xml:"-"`
 }
@@ -3673,12 +5226,22 @@ type metadataUpdateMaintenanceStartTimeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateMaintenanceStartTimeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateMaintenanceStartTimeInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the gateway whose maintenance start time
 // is updated.
 type UpdateMaintenanceStartTimeOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateMaintenanceStartTimeOutput `json:"-" xml:"-"`
 }
@@ -3687,25 +5250,35 @@ type metadataUpdateMaintenanceStartTimeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateMaintenanceStartTimeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateMaintenanceStartTimeOutput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   UpdateSnapshotScheduleInput$Description   UpdateSnapshotScheduleInput$RecurrenceInHours
 //   UpdateSnapshotScheduleInput$StartAt   UpdateSnapshotScheduleInput$VolumeARN
 type UpdateSnapshotScheduleInput struct {
 	// Optional description of the snapshot that overwrites the existing description.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
 	// Frequency of snapshots. Specify the number of hours between snapshots.
-	RecurrenceInHours *int64 `type:"integer" required:"true"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24" required:"true"`
 
 	// The hour of the day at which the snapshot schedule begins represented as
 	// hh, where hh is the hour (0 to 23). The hour of the day is in the time zone
 	// of the gateway.
-	StartAt *int64 `type:"integer" required:"true"`
+	StartAt *int64 `type:"integer" min:"0" max:"23" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateSnapshotScheduleInput `json:"-" xml:"-"`
 }
@@ -3714,9 +5287,19 @@ type metadataUpdateSnapshotScheduleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateSnapshotScheduleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateSnapshotScheduleInput) GoString() string {
+	return s.String()
+}
+
 // A JSON object containing the of the updated storage volume.
 type UpdateSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateSnapshotScheduleOutput `json:"-" xml:"-"`
 }
@@ -3725,15 +5308,25 @@ type metadataUpdateSnapshotScheduleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateSnapshotScheduleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateSnapshotScheduleOutput) GoString() string {
+	return s.String()
+}
+
 // UpdateVTLDeviceTypeInput
 type UpdateVTLDeviceTypeInput struct {
 	// The type of medium changer you want to select.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	DeviceType *string `type:"string" required:"true"`
+	DeviceType *string `type:"string" min:"2" max:"50" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the medium changer you want to select.
-	VTLDeviceARN *string `type:"string" required:"true"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateVTLDeviceTypeInput `json:"-" xml:"-"`
 }
@@ -3742,10 +5335,20 @@ type metadataUpdateVTLDeviceTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateVTLDeviceTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateVTLDeviceTypeInput) GoString() string {
+	return s.String()
+}
+
 // UpdateVTLDeviceTypeOutput
 type UpdateVTLDeviceTypeOutput struct {
 	// The Amazon Resource Name (ARN) of the medium changer you have selected.
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateVTLDeviceTypeOutput `json:"-" xml:"-"`
 }
@@ -3754,6 +5357,16 @@ type metadataUpdateVTLDeviceTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UpdateVTLDeviceTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UpdateVTLDeviceTypeOutput) GoString() string {
+	return s.String()
+}
+
 // Represents a device object associated with a gateway-VTL.
 type VTLDevice struct {
 	// A list of iSCSI information about a VTL device.
@@ -3761,7 +5374,7 @@ type VTLDevice struct {
 
 	// Specifies the unique Amazon Resource Name (ARN) of the device (tape drive
 	// or media changer).
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	VTLDeviceProductIdentifier *string `type:"string"`
 
@@ -3776,10 +5389,20 @@ type metadataVTLDevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VTLDevice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VTLDevice) GoString() string {
+	return s.String()
+}
+
 type VolumeInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	metadataVolumeInfo `json:"-" xml:"-"`
 }
@@ -3788,8 +5411,18 @@ type metadataVolumeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeInfo) GoString() string {
+	return s.String()
+}
+
 type VolumeRecoveryPointInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -3804,22 +5437,32 @@ type metadataVolumeRecoveryPointInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s VolumeRecoveryPointInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeRecoveryPointInfo) GoString() string {
+	return s.String()
+}
+
 // Lists iSCSI information about a volume.
 type VolumeiSCSIAttributes struct {
 	// Indicates whether mutual CHAP is enabled for the iSCSI target.
 	ChapEnabled *bool `type:"boolean"`
 
 	// The logical disk number.
-	LunNumber *int64 `type:"integer"`
+	LunNumber *int64 `type:"integer" min:"1"`
 
 	// The network interface identifier.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"`
 
 	// The port used to communicate with iSCSI targets.
 	NetworkInterfacePort *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the volume target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataVolumeiSCSIAttributes `json:"-" xml:"-"`
 }
@@ -3827,3 +5470,138 @@ type VolumeiSCSIAttributes struct {
 type metadataVolumeiSCSIAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s VolumeiSCSIAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s VolumeiSCSIAttributes) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ErrorCode
+	ErrorCodeActivationKeyExpired = "ActivationKeyExpired"
+	// @enum ErrorCode
+	ErrorCodeActivationKeyInvalid = "ActivationKeyInvalid"
+	// @enum ErrorCode
+	ErrorCodeActivationKeyNotFound = "ActivationKeyNotFound"
+	// @enum ErrorCode
+	ErrorCodeGatewayInternalError = "GatewayInternalError"
+	// @enum ErrorCode
+	ErrorCodeGatewayNotConnected = "GatewayNotConnected"
+	// @enum ErrorCode
+	ErrorCodeGatewayNotFound = "GatewayNotFound"
+	// @enum ErrorCode
+	ErrorCodeGatewayProxyNetworkConnectionBusy = "GatewayProxyNetworkConnectionBusy"
+	// @enum ErrorCode
+	ErrorCodeAuthenticationFailure = "AuthenticationFailure"
+	// @enum ErrorCode
+	ErrorCodeBandwidthThrottleScheduleNotFound = "BandwidthThrottleScheduleNotFound"
+	// @enum ErrorCode
+	ErrorCodeBlocked = "Blocked"
+	// @enum ErrorCode
+	ErrorCodeCannotExportSnapshot = "CannotExportSnapshot"
+	// @enum ErrorCode
+	ErrorCodeChapCredentialNotFound = "ChapCredentialNotFound"
+	// @enum ErrorCode
+	ErrorCodeDiskAlreadyAllocated = "DiskAlreadyAllocated"
+	// @enum ErrorCode
+	ErrorCodeDiskDoesNotExist = "DiskDoesNotExist"
+	// @enum ErrorCode
+	ErrorCodeDiskSizeGreaterThanVolumeMaxSize = "DiskSizeGreaterThanVolumeMaxSize"
+	// @enum ErrorCode
+	ErrorCodeDiskSizeLessThanVolumeSize = "DiskSizeLessThanVolumeSize"
+	// @enum ErrorCode
+	ErrorCodeDiskSizeNotGigAligned = "DiskSizeNotGigAligned"
+	// @enum ErrorCode
+	ErrorCodeDuplicateCertificateInfo = "DuplicateCertificateInfo"
+	// @enum ErrorCode
+	ErrorCodeDuplicateSchedule = "DuplicateSchedule"
+	// @enum ErrorCode
+	ErrorCodeEndpointNotFound = "EndpointNotFound"
+	// @enum ErrorCode
+	ErrorCodeIAMNotSupported = "IAMNotSupported"
+	// @enum ErrorCode
+	ErrorCodeInitiatorInvalid = "InitiatorInvalid"
+	// @enum ErrorCode
+	ErrorCodeInitiatorNotFound = "InitiatorNotFound"
+	// @enum ErrorCode
+	ErrorCodeInternalError = "InternalError"
+	// @enum ErrorCode
+	ErrorCodeInvalidGateway = "InvalidGateway"
+	// @enum ErrorCode
+	ErrorCodeInvalidEndpoint = "InvalidEndpoint"
+	// @enum ErrorCode
+	ErrorCodeInvalidParameters = "InvalidParameters"
+	// @enum ErrorCode
+	ErrorCodeInvalidSchedule = "InvalidSchedule"
+	// @enum ErrorCode
+	ErrorCodeLocalStorageLimitExceeded = "LocalStorageLimitExceeded"
+	// @enum ErrorCode
+	ErrorCodeLunAlreadyAllocated = "LunAlreadyAllocated "
+	// @enum ErrorCode
+	ErrorCodeLunInvalid = "LunInvalid"
+	// @enum ErrorCode
+	ErrorCodeMaximumContentLengthExceeded = "MaximumContentLengthExceeded"
+	// @enum ErrorCode
+	ErrorCodeMaximumTapeCartridgeCountExceeded = "MaximumTapeCartridgeCountExceeded"
+	// @enum ErrorCode
+	ErrorCodeMaximumVolumeCountExceeded = "MaximumVolumeCountExceeded"
+	// @enum ErrorCode
+	ErrorCodeNetworkConfigurationChanged = "NetworkConfigurationChanged"
+	// @enum ErrorCode
+	ErrorCodeNoDisksAvailable = "NoDisksAvailable"
+	// @enum ErrorCode
+	ErrorCodeNotImplemented = "NotImplemented"
+	// @enum ErrorCode
+	ErrorCodeNotSupported = "NotSupported"
+	// @enum ErrorCode
+	ErrorCodeOperationAborted = "OperationAborted"
+	// @enum ErrorCode
+	ErrorCodeOutdatedGateway = "OutdatedGateway"
+	// @enum ErrorCode
+	ErrorCodeParametersNotImplemented = "ParametersNotImplemented"
+	// @enum ErrorCode
+	ErrorCodeRegionInvalid = "RegionInvalid"
+	// @enum ErrorCode
+	ErrorCodeRequestTimeout = "RequestTimeout"
+	// @enum ErrorCode
+	ErrorCodeServiceUnavailable = "ServiceUnavailable"
+	// @enum ErrorCode
+	ErrorCodeSnapshotDeleted = "SnapshotDeleted"
+	// @enum ErrorCode
+	ErrorCodeSnapshotIdInvalid = "SnapshotIdInvalid"
+	// @enum ErrorCode
+	ErrorCodeSnapshotInProgress = "SnapshotInProgress"
+	// @enum ErrorCode
+	ErrorCodeSnapshotNotFound = "SnapshotNotFound"
+	// @enum ErrorCode
+	ErrorCodeSnapshotScheduleNotFound = "SnapshotScheduleNotFound"
+	// @enum ErrorCode
+	ErrorCodeStagingAreaFull = "StagingAreaFull"
+	// @enum ErrorCode
+	ErrorCodeStorageFailure = "StorageFailure"
+	// @enum ErrorCode
+	ErrorCodeTapeCartridgeNotFound = "TapeCartridgeNotFound"
+	// @enum ErrorCode
+	ErrorCodeTargetAlreadyExists = "TargetAlreadyExists"
+	// @enum ErrorCode
+	ErrorCodeTargetInvalid = "TargetInvalid"
+	// @enum ErrorCode
+	ErrorCodeTargetNotFound = "TargetNotFound"
+	// @enum ErrorCode
+	ErrorCodeUnauthorizedOperation = "UnauthorizedOperation"
+	// @enum ErrorCode
+	ErrorCodeVolumeAlreadyExists = "VolumeAlreadyExists"
+	// @enum ErrorCode
+	ErrorCodeVolumeIdInvalid = "VolumeIdInvalid"
+	// @enum ErrorCode
+	ErrorCodeVolumeInUse = "VolumeInUse"
+	// @enum ErrorCode
+	ErrorCodeVolumeNotFound = "VolumeNotFound"
+	// @enum ErrorCode
+	ErrorCodeVolumeNotReady = "VolumeNotReady"
+)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAssumeRole = "AssumeRole"
@@ -106,6 +107,16 @@ func (c *STS) AssumeRole(input *AssumeRoleInput) (*AssumeRoleOutput, error) {
 	return out, err
 }
 
+// AssumeRoleWithContext is the same as AssumeRole with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *STS) AssumeRoleWithContext(ctx aws.Context, input *AssumeRoleInput) (*AssumeRoleOutput, error) {
+	req, out := c.AssumeRoleRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssumeRoleWithSAML = "AssumeRoleWithSAML"
 
 // AssumeRoleWithSAMLRequest generates a request for the AssumeRoleWithSAML operation.
@@ -177,6 +188,16 @@ func (c *STS) AssumeRoleWithSAML(input *AssumeRoleWithSAMLInput) (*AssumeRoleWit
 	return out, err
 }
 
+// AssumeRoleWithSAMLWithContext is the same as AssumeRoleWithSAML with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *STS) AssumeRoleWithSAMLWithContext(ctx aws.Context, input *AssumeRoleWithSAMLInput) (*AssumeRoleWithSAMLOutput, error) {
+	req, out := c.AssumeRoleWithSAMLRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAssumeRoleWithWebIdentity = "AssumeRoleWithWebIdentity"
 
 // AssumeRoleWithWebIdentityRequest generates a request for the AssumeRoleWithWebIdentity operation.
@@ -270,6 +291,16 @@ func (c *STS) AssumeRoleWithWebIdentity(input *AssumeRoleWithWebIdentityInput) (
 	return out, err
 }
 
+// AssumeRoleWithWebIdentityWithContext is the same as AssumeRoleWithWebIdentity with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *STS) AssumeRoleWithWebIdentityWithContext(ctx aws.Context, input *AssumeRoleWithWebIdentityInput) (*AssumeRoleWithWebIdentityOutput, error) {
+	req, out := c.AssumeRoleWithWebIdentityRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDecodeAuthorizationMessage = "DecodeAuthorizationMessage"
 
 // DecodeAuthorizationMessageRequest generates a request for the DecodeAuthorizationMessage operation.
@@ -320,6 +351,16 @@ func (c *STS) DecodeAuthorizationMessage(input *DecodeAuthorizationMessageInput)
 	return out, err
 }
 
+// DecodeAuthorizationMessageWithContext is the same as DecodeAuthorizationMessage with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *STS) DecodeAuthorizationMessageWithContext(ctx aws.Context, input *DecodeAuthorizationMessageInput) (*DecodeAuthorizationMessageOutput, error) {
+	req, out := c.DecodeAuthorizationMessageRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetFederationToken = "GetFederationToken"
 
 // GetFederationTokenRequest generates a request for the GetFederationToken operation.
@@ -412,6 +453,16 @@ func (c *STS) GetFederationToken(input *GetFederationTokenInput) (*GetFederation
 	return out, err
 }
 
+// GetFederationTokenWithContext is the same as GetFederationToken with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *STS) GetFederationTokenWithContext(ctx aws.Context, input *GetFederationTokenInput) (*GetFederationTokenOutput, error) {
+	req, out := c.GetFederationTokenRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetSessionToken = "GetSessionToken"
 
 // GetSessionTokenRequest generates a request for the GetSessionToken operation.
@@ -469,11 +520,21 @@ func (c *STS) GetSessionToken(input *GetSessionTokenInput) (*GetSessionTokenOutp
 	return out, err
 }
 
+// GetSessionTokenWithContext is the same as GetSessionToken with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *STS) GetSessionTokenWithContext(ctx aws.Context, input *GetSessionTokenInput) (*GetSessionTokenOutput, error) {
+	req, out := c.GetSessionTokenRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type AssumeRoleInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"3600"`
 
 	// A unique identifier that is used by third parties to assume a role in their
 	// customers' accounts. For each role that the third party can assume, they
@@ -483,7 +544,7 @@ type AssumeRoleInput struct {
 	// to help third parties bind a role to the customer who created it. For more
 	// information about the external ID, see About the External ID (http://docs.aws.amazon.com/STS/latest/UsingSTS/sts-delegating-externalid.html"
 	// target="_blank) in Using Temporary Security Credentials.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" min:"2" max:"1224" pattern:"[\\w+=,.@:\\/-]*"`
 
 	// An IAM policy in JSON format.
 	//
@@ -496,27 +557,27 @@ type AssumeRoleInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRole (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session. The session name is included
 	// as part of the AssumedRoleUser.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The identification number of the MFA device that is associated with the user
 	// who is making the AssumeRole call. Specify this value if the trust policy
 	// of the role being assumed includes a condition that requires MFA authentication.
 	// The value is either the serial number for a hardware device (such as GAHT12345678)
 	// or an Amazon Resource Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*"`
 
 	// The value provided by the MFA device, if the trust policy of the role being
 	// assumed requires MFA (that is, if the policy includes a condition that tests
 	// for MFA). If the role being assumed requires MFA and if the TokenCode value
 	// is missing or expired, the AssumeRole call returns an "access denied" error.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6" pattern:"[\\d]*"`
 
 	metadataAssumeRoleInput `json:"-" xml:"-"`
 }
@@ -525,6 +586,16 @@ type metadataAssumeRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssumeRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssumeRoleInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful AssumeRole request, including temporary
 // AWS credentials that can be used to make AWS requests.
 type AssumeRoleOutput struct {
@@ -542,7 +613,7 @@ type AssumeRoleOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	metadataAssumeRoleOutput `json:"-" xml:"-"`
 }
@@ -551,6 +622,16 @@ type metadataAssumeRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssumeRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssumeRoleOutput) GoString() string {
+	return s.String()
+}
+
 type AssumeRoleWithSAMLInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
@@ -560,7 +641,7 @@ type AssumeRoleWithSAMLInput struct {
 	//
 	// The maximum duration for a session is 1 hour, and the minimum duration is
 	// 15 minutes, even if values outside this range are specified.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -576,21 +657,21 @@ type AssumeRoleWithSAMLInput struct {
 	//
 	// The policy must be 2048 bytes or shorter, and its packed size must be less
 	// than 450 bytes.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider in IAM that describes
 	// the IdP.
-	PrincipalARN *string `locationName:"PrincipalArn" type:"string" required:"true"`
+	PrincipalARN *string `locationName:"PrincipalArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The base-64 encoded SAML authentication response provided by the IdP.
 	//
 	// For more information, see Configuring a Relying Party and Adding Claims
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/create-role-saml-IdP-tasks.html)
 	// in the Using IAM guide.
-	SAMLAssertion *string `type:"string" required:"true"`
+	SAMLAssertion *string `type:"string" min:"4" max:"50000" required:"true"`
 
 	metadataAssumeRoleWithSAMLInput `json:"-" xml:"-"`
 }
@@ -599,6 +680,16 @@ type metadataAssumeRoleWithSAMLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssumeRoleWithSAMLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssumeRoleWithSAMLInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful AssumeRoleWithSAML request, including
 // temporary AWS credentials that can be used to make AWS requests.
 type AssumeRoleWithSAMLOutput struct {
@@ -630,7 +721,7 @@ type AssumeRoleWithSAMLOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	// The value of the NameID element in the Subject element of the SAML assertion.
 	Subject *string `type:"string"`
@@ -652,11 +743,21 @@ type metadataAssumeRoleWithSAMLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssumeRoleWithSAMLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssumeRoleWithSAMLOutput) GoString() string {
+	return s.String()
+}
+
 type AssumeRoleWithWebIdentityInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -669,7 +770,7 @@ type AssumeRoleWithWebIdentityInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRoleWithWebIdentity (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	// The fully qualified host component of the domain name of the identity provider.
 	//
@@ -678,23 +779,23 @@ type AssumeRoleWithWebIdentityInput struct {
 	// 2.0 access tokens. Do not include URL schemes and port numbers.
 	//
 	// Do not specify this value for OpenID Connect ID tokens.
-	ProviderID *string `locationName:"ProviderId" type:"string"`
+	ProviderID *string `locationName:"ProviderId" type:"string" min:"4" max:"2048"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session. Typically, you pass the name
 	// or identifier that is associated with the user who is using your application.
 	// That way, the temporary security credentials that your application will use
 	// are associated with that user. This session name is included as part of the
 	// ARN and assumed role ID in the AssumedRoleUser response element.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The OAuth 2.0 access token or OpenID Connect ID token that is provided by
 	// the identity provider. Your application must get this token by authenticating
 	// the user who is using your application with a web identity provider before
 	// the application makes an AssumeRoleWithWebIdentity call.
-	WebIdentityToken *string `type:"string" required:"true"`
+	WebIdentityToken *string `type:"string" min:"4" max:"2048" required:"true"`
 
 	metadataAssumeRoleWithWebIdentityInput `json:"-" xml:"-"`
 }
@@ -703,6 +804,16 @@ type metadataAssumeRoleWithWebIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssumeRoleWithWebIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssumeRoleWithWebIdentityInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful AssumeRoleWithWebIdentity request,
 // including temporary AWS credentials that can be used to make AWS requests.
 type AssumeRoleWithWebIdentityOutput struct {
@@ -725,7 +836,7 @@ type AssumeRoleWithWebIdentityOutput struct {
 	// A percentage value that indicates the size of the policy in packed form.
 	// The service rejects any policy with a packed size greater than 100 percent,
 	// which means the policy exceeded the allowed space.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	// The issuing authority of the web identity token presented. For OpenID Connect
 	// ID Tokens this contains the value of the iss field. For OAuth 2.0 access
@@ -739,7 +850,7 @@ type AssumeRoleWithWebIdentityOutput struct {
 	// the user and the application that acquired the WebIdentityToken (pairwise
 	// identifier). For OpenID Connect ID tokens, this field contains the value
 	// returned by the identity provider as the token's sub (Subject) claim.
-	SubjectFromWebIdentityToken *string `type:"string"`
+	SubjectFromWebIdentityToken *string `type:"string" min:"6" max:"255"`
 
 	metadataAssumeRoleWithWebIdentityOutput `json:"-" xml:"-"`
 }
@@ -748,6 +859,16 @@ type metadataAssumeRoleWithWebIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssumeRoleWithWebIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssumeRoleWithWebIdentityOutput) GoString() string {
+	return s.String()
+}
+
 // The identifiers for the temporary security credentials that the operation
 // returns.
 type AssumedRoleUser struct {
@@ -755,12 +876,12 @@ type AssumedRoleUser struct {
 	// AssumeRole action. For more information about ARNs and how to use them in
 	// policies, see  Identifiers for IAM Entities  (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html"
 	// target="_blank) in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A unique identifier that contains the role ID and the role session name of
 	// the role that is being assumed. The role ID is generated by AWS when the
 	// role is created.
-	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" required:"true"`
+	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@:-]*" required:"true"`
 
 	metadataAssumedRoleUser `json:"-" xml:"-"`
 }
@@ -769,19 +890,29 @@ type metadataAssumedRoleUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AssumedRoleUser) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AssumedRoleUser) GoString() string {
+	return s.String()
+}
+
 // AWS credentials for API authentication.
 type Credentials struct {
 	// The access key ID that identifies the temporary security credentials.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The date on which the current credentials expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The secret access key that can be used to sign requests.
-	SecretAccessKey *string `type:"string" required:"true"`
+	SecretAccessKey *string `type:"string" sensitive:"true" required:"true"`
 
 	// The token that users must pass to the service API to use the temporary credentials.
-	SessionToken *string `type:"string" required:"true"`
+	SessionToken *string `type:"string" sensitive:"true" required:"true"`
 
 	metadataCredentials `json:"-" xml:"-"`
 }
@@ -790,9 +921,19 @@ type metadataCredentials struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Credentials) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Credentials) GoString() string {
+	return s.String()
+}
+
 type DecodeAuthorizationMessageInput struct {
 	// The encoded message that was returned with the response.
-	EncodedMessage *string `type:"string" required:"true"`
+	EncodedMessage *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataDecodeAuthorizationMessageInput `json:"-" xml:"-"`
 }
@@ -801,6 +942,16 @@ type metadataDecodeAuthorizationMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecodeAuthorizationMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecodeAuthorizationMessageInput) GoString() string {
+	return s.String()
+}
+
 // A document that contains additional information about the authorization status
 // of a request from an encoded message that is returned in response to an AWS
 // request.
@@ -816,17 +967,27 @@ type metadataDecodeAuthorizationMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecodeAuthorizationMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecodeAuthorizationMessageOutput) GoString() string {
+	return s.String()
+}
+
 // Identifiers for the federated user that is associated with the credentials.
 type FederatedUser struct {
 	// The ARN that specifies the federated user that is associated with the credentials.
 	// For more information about ARNs and how to use them in policies, see Identifiers
 	// for IAM Entities (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html"
 	// target="_blank) in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The string that identifies the federated user associated with the credentials,
 	// similar to the unique ID of an IAM user.
-	FederatedUserID *string `locationName:"FederatedUserId" type:"string" required:"true"`
+	FederatedUserID *string `locationName:"FederatedUserId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@\\:-]*" required:"true"`
 
 	metadataFederatedUser `json:"-" xml:"-"`
 }
@@ -835,6 +996,16 @@ type metadataFederatedUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FederatedUser) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FederatedUser) GoString() string {
+	return s.String()
+}
+
 type GetFederationTokenInput struct {
 	// The duration, in seconds, that the session should last. Acceptable durations
 	// for federation sessions range from 900 seconds (15 minutes) to 129600 seconds
@@ -843,13 +1014,13 @@ type GetFederationTokenInput struct {
 	// seconds (one hour). If the specified duration is longer than one hour, the
 	// session obtained by using AWS account (root) credentials defaults to one
 	// hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The name of the federated user. The name is used as an identifier for the
 	// temporary security credentials (such as Bob). For example, you can reference
 	// the federated user name in a resource-based policy, such as in an Amazon
 	// S3 bucket policy.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// An IAM policy in JSON format that is passed with the GetFederationToken call
 	// and evaluated along with the policy or policies that are attached to the
@@ -869,7 +1040,7 @@ type GetFederationTokenInput struct {
 	// For more information about how permissions work, see Permissions for GetFederationToken
 	// (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-get-federation-token.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\x{0009}\\x{000A}\\x{000D}\\x{0020}-\\x{00FF}]+"`
 
 	metadataGetFederationTokenInput `json:"-" xml:"-"`
 }
@@ -878,6 +1049,16 @@ type metadataGetFederationTokenInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetFederationTokenInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetFederationTokenInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetFederationToken request, including
 // temporary AWS credentials that can be used to make AWS requests.
 type GetFederationTokenOutput struct {
@@ -893,7 +1074,7 @@ type GetFederationTokenOutput struct {
 	// A percentage value indicating the size of the policy in packed form. The
 	// service rejects policies for which the packed size is greater than 100 percent
 	// of the allowed value.
-	PackedPolicySize *int64 `type:"integer"`
+	PackedPolicySize *int64 `type:"integer" min:"0"`
 
 	metadataGetFederationTokenOutput `json:"-" xml:"-"`
 }
@@ -902,6 +1083,16 @@ type metadataGetFederationTokenOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetFederationTokenOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetFederationTokenOutput) GoString() string {
+	return s.String()
+}
+
 type GetSessionTokenInput struct {
 	// The duration, in seconds, that the credentials should remain valid. Acceptable
 	// durations for IAM user sessions range from 900 seconds (15 minutes) to 129600
@@ -909,7 +1100,7 @@ type GetSessionTokenInput struct {
 	// for AWS account owners are restricted to a maximum of 3600 seconds (one hour).
 	// If the duration is longer than one hour, the session for AWS account owners
 	// defaults to one hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The identification number of the MFA device that is associated with the IAM
 	// user who is making the GetSessionToken call. Specify this value if the IAM
@@ -918,14 +1109,14 @@ type GetSessionTokenInput struct {
 	// Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
 	// You can find the device for an IAM user by going to the AWS Management Console
 	// and viewing the user's security credentials.
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*"`
 
 	// The value provided by the MFA device, if MFA is required. If any policy requires
 	// the IAM user to submit an MFA code, specify this value. If MFA authentication
 	// is required, and the user does not provide a code when requesting a set of
 	// temporary security credentials, the user will receive an "access denied"
 	// response when requesting resources that require MFA authentication.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6" pattern:"[\\d]*"`
 
 	metadataGetSessionTokenInput `json:"-" xml:"-"`
 }
@@ -934,6 +1125,16 @@ type metadataGetSessionTokenInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetSessionTokenInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSessionTokenInput) GoString() string {
+	return s.String()
+}
+
 // Contains the response to a successful GetSessionToken request, including
 // temporary AWS credentials that can be used to make AWS requests.
 type GetSessionTokenOutput struct {
@@ -946,3 +1147,13 @@ type GetSessionTokenOutput struct {
 type metadataGetSessionTokenOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s GetSessionTokenOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetSessionTokenOutput) GoString() string {
+	return s.String()
+}
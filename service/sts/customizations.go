@@ -1,12 +1,15 @@
 package sts
 
-import "github.com/aws/aws-sdk-go/aws"
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/internal/signer/v4"
+)
 
 func init() {
 	initRequest = func(r *aws.Request) {
 		switch r.Operation.Name {
 		case opAssumeRoleWithSAML, opAssumeRoleWithWebIdentity:
-			r.Handlers.Sign.Clear() // these operations are unsigned
+			r.Handlers.Sign.Remove(v4.SignRequestHandler.Name) // these operations are unsigned
 		}
 	}
 }
@@ -9,13 +9,13 @@ import (
 )
 
 var svc = sts.New(&aws.Config{
-	Region: "mock-region",
+	Region: aws.String("mock-region"),
 })
 
 func TestUnsignedRequest_AssumeRoleWithSAML(t *testing.T) {
 	req, _ := svc.AssumeRoleWithSAMLRequest(&sts.AssumeRoleWithSAMLInput{
-		PrincipalARN:  aws.String("ARN"),
-		RoleARN:       aws.String("ARN"),
+		PrincipalARN:  aws.String("arn:aws:iam::123456789012:saml-provider/provider"),
+		RoleARN:       aws.String("arn:aws:iam::123456789012:role/role"),
 		SAMLAssertion: aws.String("ASSERT"),
 	})
 
@@ -26,7 +26,7 @@ func TestUnsignedRequest_AssumeRoleWithSAML(t *testing.T) {
 
 func TestUnsignedRequest_AssumeRoleWithWebIdentity(t *testing.T) {
 	req, _ := svc.AssumeRoleWithWebIdentityRequest(&sts.AssumeRoleWithWebIdentityInput{
-		RoleARN:          aws.String("ARN"),
+		RoleARN:          aws.String("arn:aws:iam::123456789012:role/role"),
 		RoleSessionName:  aws.String("SESSION"),
 		WebIdentityToken: aws.String("TOKEN"),
 	})
@@ -5,6 +5,7 @@ package support
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opAddAttachmentsToSet = "AddAttachmentsToSet"
@@ -43,6 +44,16 @@ func (c *Support) AddAttachmentsToSet(input *AddAttachmentsToSetInput) (*AddAtta
 	return out, err
 }
 
+// AddAttachmentsToSetWithContext is the same as AddAttachmentsToSet with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) AddAttachmentsToSetWithContext(ctx aws.Context, input *AddAttachmentsToSetInput) (*AddAttachmentsToSetOutput, error) {
+	req, out := c.AddAttachmentsToSetRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opAddCommunicationToCase = "AddCommunicationToCase"
 
 // AddCommunicationToCaseRequest generates a request for the AddCommunicationToCase operation.
@@ -77,6 +88,16 @@ func (c *Support) AddCommunicationToCase(input *AddCommunicationToCaseInput) (*A
 	return out, err
 }
 
+// AddCommunicationToCaseWithContext is the same as AddCommunicationToCase with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) AddCommunicationToCaseWithContext(ctx aws.Context, input *AddCommunicationToCaseInput) (*AddCommunicationToCaseOutput, error) {
+	req, out := c.AddCommunicationToCaseRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCreateCase = "CreateCase"
 
 // CreateCaseRequest generates a request for the CreateCase operation.
@@ -133,6 +154,16 @@ func (c *Support) CreateCase(input *CreateCaseInput) (*CreateCaseOutput, error)
 	return out, err
 }
 
+// CreateCaseWithContext is the same as CreateCase with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) CreateCaseWithContext(ctx aws.Context, input *CreateCaseInput) (*CreateCaseOutput, error) {
+	req, out := c.CreateCaseRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeAttachment = "DescribeAttachment"
 
 // DescribeAttachmentRequest generates a request for the DescribeAttachment operation.
@@ -163,6 +194,16 @@ func (c *Support) DescribeAttachment(input *DescribeAttachmentInput) (*DescribeA
 	return out, err
 }
 
+// DescribeAttachmentWithContext is the same as DescribeAttachment with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeAttachmentWithContext(ctx aws.Context, input *DescribeAttachmentInput) (*DescribeAttachmentOutput, error) {
+	req, out := c.DescribeAttachmentRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeCases = "DescribeCases"
 
 // DescribeCasesRequest generates a request for the DescribeCases operation.
@@ -209,6 +250,16 @@ func (c *Support) DescribeCases(input *DescribeCasesInput) (*DescribeCasesOutput
 	return out, err
 }
 
+// DescribeCasesWithContext is the same as DescribeCases with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeCasesWithContext(ctx aws.Context, input *DescribeCasesInput) (*DescribeCasesOutput, error) {
+	req, out := c.DescribeCasesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Support) DescribeCasesPages(input *DescribeCasesInput, fn func(p *DescribeCasesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCasesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -258,6 +309,16 @@ func (c *Support) DescribeCommunications(input *DescribeCommunicationsInput) (*D
 	return out, err
 }
 
+// DescribeCommunicationsWithContext is the same as DescribeCommunications with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeCommunicationsWithContext(ctx aws.Context, input *DescribeCommunicationsInput) (*DescribeCommunicationsOutput, error) {
+	req, out := c.DescribeCommunicationsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *Support) DescribeCommunicationsPages(input *DescribeCommunicationsInput, fn func(p *DescribeCommunicationsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeCommunicationsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -302,6 +363,16 @@ func (c *Support) DescribeServices(input *DescribeServicesInput) (*DescribeServi
 	return out, err
 }
 
+// DescribeServicesWithContext is the same as DescribeServices with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeServicesWithContext(ctx aws.Context, input *DescribeServicesInput) (*DescribeServicesOutput, error) {
+	req, out := c.DescribeServicesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeSeverityLevels = "DescribeSeverityLevels"
 
 // DescribeSeverityLevelsRequest generates a request for the DescribeSeverityLevels operation.
@@ -331,6 +402,16 @@ func (c *Support) DescribeSeverityLevels(input *DescribeSeverityLevelsInput) (*D
 	return out, err
 }
 
+// DescribeSeverityLevelsWithContext is the same as DescribeSeverityLevels with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeSeverityLevelsWithContext(ctx aws.Context, input *DescribeSeverityLevelsInput) (*DescribeSeverityLevelsOutput, error) {
+	req, out := c.DescribeSeverityLevelsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorCheckRefreshStatuses = "DescribeTrustedAdvisorCheckRefreshStatuses"
 
 // DescribeTrustedAdvisorCheckRefreshStatusesRequest generates a request for the DescribeTrustedAdvisorCheckRefreshStatuses operation.
@@ -359,6 +440,16 @@ func (c *Support) DescribeTrustedAdvisorCheckRefreshStatuses(input *DescribeTrus
 	return out, err
 }
 
+// DescribeTrustedAdvisorCheckRefreshStatusesWithContext is the same as DescribeTrustedAdvisorCheckRefreshStatuses with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeTrustedAdvisorCheckRefreshStatusesWithContext(ctx aws.Context, input *DescribeTrustedAdvisorCheckRefreshStatusesInput) (*DescribeTrustedAdvisorCheckRefreshStatusesOutput, error) {
+	req, out := c.DescribeTrustedAdvisorCheckRefreshStatusesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorCheckResult = "DescribeTrustedAdvisorCheckResult"
 
 // DescribeTrustedAdvisorCheckResultRequest generates a request for the DescribeTrustedAdvisorCheckResult operation.
@@ -397,6 +488,16 @@ func (c *Support) DescribeTrustedAdvisorCheckResult(input *DescribeTrustedAdviso
 	return out, err
 }
 
+// DescribeTrustedAdvisorCheckResultWithContext is the same as DescribeTrustedAdvisorCheckResult with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeTrustedAdvisorCheckResultWithContext(ctx aws.Context, input *DescribeTrustedAdvisorCheckResultInput) (*DescribeTrustedAdvisorCheckResultOutput, error) {
+	req, out := c.DescribeTrustedAdvisorCheckResultRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorCheckSummaries = "DescribeTrustedAdvisorCheckSummaries"
 
 // DescribeTrustedAdvisorCheckSummariesRequest generates a request for the DescribeTrustedAdvisorCheckSummaries operation.
@@ -427,6 +528,16 @@ func (c *Support) DescribeTrustedAdvisorCheckSummaries(input *DescribeTrustedAdv
 	return out, err
 }
 
+// DescribeTrustedAdvisorCheckSummariesWithContext is the same as DescribeTrustedAdvisorCheckSummaries with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeTrustedAdvisorCheckSummariesWithContext(ctx aws.Context, input *DescribeTrustedAdvisorCheckSummariesInput) (*DescribeTrustedAdvisorCheckSummariesOutput, error) {
+	req, out := c.DescribeTrustedAdvisorCheckSummariesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeTrustedAdvisorChecks = "DescribeTrustedAdvisorChecks"
 
 // DescribeTrustedAdvisorChecksRequest generates a request for the DescribeTrustedAdvisorChecks operation.
@@ -457,6 +568,16 @@ func (c *Support) DescribeTrustedAdvisorChecks(input *DescribeTrustedAdvisorChec
 	return out, err
 }
 
+// DescribeTrustedAdvisorChecksWithContext is the same as DescribeTrustedAdvisorChecks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) DescribeTrustedAdvisorChecksWithContext(ctx aws.Context, input *DescribeTrustedAdvisorChecksInput) (*DescribeTrustedAdvisorChecksOutput, error) {
+	req, out := c.DescribeTrustedAdvisorChecksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRefreshTrustedAdvisorCheck = "RefreshTrustedAdvisorCheck"
 
 // RefreshTrustedAdvisorCheckRequest generates a request for the RefreshTrustedAdvisorCheck operation.
@@ -493,6 +614,16 @@ func (c *Support) RefreshTrustedAdvisorCheck(input *RefreshTrustedAdvisorCheckIn
 	return out, err
 }
 
+// RefreshTrustedAdvisorCheckWithContext is the same as RefreshTrustedAdvisorCheck with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) RefreshTrustedAdvisorCheckWithContext(ctx aws.Context, input *RefreshTrustedAdvisorCheckInput) (*RefreshTrustedAdvisorCheckOutput, error) {
+	req, out := c.RefreshTrustedAdvisorCheckRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opResolveCase = "ResolveCase"
 
 // ResolveCaseRequest generates a request for the ResolveCase operation.
@@ -521,6 +652,16 @@ func (c *Support) ResolveCase(input *ResolveCaseInput) (*ResolveCaseOutput, erro
 	return out, err
 }
 
+// ResolveCaseWithContext is the same as ResolveCase with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *Support) ResolveCaseWithContext(ctx aws.Context, input *ResolveCaseInput) (*ResolveCaseOutput, error) {
+	req, out := c.ResolveCaseRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 type AddAttachmentsToSetInput struct {
 	// The ID of the attachment set. If an AttachmentSetId is not specified, a new
 	// attachment set is created, and the ID of the set is returned in the response.
@@ -539,6 +680,16 @@ type metadataAddAttachmentsToSetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddAttachmentsToSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddAttachmentsToSetInput) GoString() string {
+	return s.String()
+}
+
 // The ID and expiry time of the attachment set returned by the AddAttachmentsToSet
 // operation.
 type AddAttachmentsToSetOutput struct {
@@ -558,6 +709,16 @@ type metadataAddAttachmentsToSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddAttachmentsToSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddAttachmentsToSetOutput) GoString() string {
+	return s.String()
+}
+
 // To be written.
 type AddCommunicationToCaseInput struct {
 	// The ID of a set of one or more attachments for the communication to add to
@@ -582,6 +743,16 @@ type metadataAddCommunicationToCaseInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddCommunicationToCaseInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddCommunicationToCaseInput) GoString() string {
+	return s.String()
+}
+
 // The result of the AddCommunicationToCase operation.
 type AddCommunicationToCaseOutput struct {
 	// True if AddCommunicationToCase succeeds. Otherwise, returns an error.
@@ -594,6 +765,16 @@ type metadataAddCommunicationToCaseOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AddCommunicationToCaseOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AddCommunicationToCaseOutput) GoString() string {
+	return s.String()
+}
+
 // An attachment to a case communication. The attachment consists of the file
 // name and the content of the file.
 type Attachment struct {
@@ -610,6 +791,16 @@ type metadataAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Attachment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Attachment) GoString() string {
+	return s.String()
+}
+
 // The file name and ID of an attachment to a case communication. You can use
 // the ID to retrieve the attachment with the DescribeAttachment operation.
 type AttachmentDetails struct {
@@ -626,6 +817,16 @@ type metadataAttachmentDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s AttachmentDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s AttachmentDetails) GoString() string {
+	return s.String()
+}
+
 // A JSON-formatted object that contains the metadata for a support case. It
 // is contained the response from a DescribeCases request. CaseDetails contains
 // the following fields:
@@ -673,7 +874,7 @@ type CaseDetails struct {
 	RecentCommunications *RecentCaseCommunications `locationName:"recentCommunications" type:"structure"`
 
 	// The code for the AWS service returned by the call to DescribeServices.
-	ServiceCode *string `locationName:"serviceCode" type:"string"`
+	ServiceCode *string `locationName:"serviceCode" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The code for the severity level returned by the call to DescribeSeverityLevels.
 	SeverityCode *string `locationName:"severityCode" type:"string"`
@@ -697,6 +898,16 @@ type metadataCaseDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CaseDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CaseDetails) GoString() string {
+	return s.String()
+}
+
 // A JSON-formatted name/value pair that represents the category name and category
 // code of the problem, selected from the DescribeServices response for each
 // AWS service.
@@ -714,6 +925,16 @@ type metadataCategory struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Category) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Category) GoString() string {
+	return s.String()
+}
+
 // A communication associated with an AWS Support case. The communication consists
 // of the case ID, the message body, attachment information, the account email
 // address, and the date and time of the communication.
@@ -741,6 +962,16 @@ type metadataCommunication struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Communication) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Communication) GoString() string {
+	return s.String()
+}
+
 type CreateCaseInput struct {
 	// The ID of a set of one or more attachments for the case. Create the set by
 	// using AddAttachmentsToSet.
@@ -766,7 +997,7 @@ type CreateCaseInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// The code for the AWS service returned by the call to DescribeServices.
-	ServiceCode *string `locationName:"serviceCode" type:"string"`
+	ServiceCode *string `locationName:"serviceCode" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The code for the severity level returned by the call to DescribeSeverityLevels.
 	//
@@ -785,6 +1016,16 @@ type metadataCreateCaseInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCaseInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCaseInput) GoString() string {
+	return s.String()
+}
+
 // The AWS Support case ID returned by a successful completion of the CreateCase
 // operation.
 type CreateCaseOutput struct {
@@ -799,6 +1040,16 @@ type metadataCreateCaseOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateCaseOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateCaseOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeAttachmentInput struct {
 	// The ID of the attachment to return. Attachment IDs are returned by the DescribeCommunications
 	// operation.
@@ -811,6 +1062,16 @@ type metadataDescribeAttachmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAttachmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAttachmentInput) GoString() string {
+	return s.String()
+}
+
 // The content and file name of the attachment returned by the DescribeAttachment
 // operation.
 type DescribeAttachmentOutput struct {
@@ -824,6 +1085,16 @@ type metadataDescribeAttachmentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeAttachmentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeAttachmentOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeCasesInput struct {
 	// The start date for a filtered date search on support case communications.
 	// Case communications are available for 12 months after creation.
@@ -835,7 +1106,7 @@ type DescribeCasesInput struct {
 
 	// A list of ID numbers of the support cases you want returned. The maximum
 	// number of cases is 100.
-	CaseIDList []*string `locationName:"caseIdList" type:"list"`
+	CaseIDList []*string `locationName:"caseIdList" type:"list" min:"0" max:"100"`
 
 	// The ID displayed for a case in the AWS Support Center user interface.
 	DisplayID *string `locationName:"displayId" type:"string"`
@@ -854,7 +1125,7 @@ type DescribeCasesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -866,6 +1137,16 @@ type metadataDescribeCasesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCasesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCasesInput) GoString() string {
+	return s.String()
+}
+
 // Returns an array of CaseDetails objects and a NextToken that defines a point
 // for pagination in the result set.
 type DescribeCasesOutput struct {
@@ -882,6 +1163,16 @@ type metadataDescribeCasesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCasesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCasesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeCommunicationsInput struct {
 	// The start date for a filtered date search on support case communications.
 	// Case communications are available for 12 months after creation.
@@ -896,7 +1187,7 @@ type DescribeCommunicationsInput struct {
 	CaseID *string `locationName:"caseId" type:"string" required:"true"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -908,6 +1199,16 @@ type metadataDescribeCommunicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCommunicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCommunicationsInput) GoString() string {
+	return s.String()
+}
+
 // The communications returned by the DescribeCommunications operation.
 type DescribeCommunicationsOutput struct {
 	// The communications for the case.
@@ -923,6 +1224,16 @@ type metadataDescribeCommunicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeCommunicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeCommunicationsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeServicesInput struct {
 	// The ISO 639-1 code for the language in which AWS provides support. AWS Support
 	// currently supports English ("en") and Japanese ("ja"). Language parameters
@@ -930,7 +1241,7 @@ type DescribeServicesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// A JSON-formatted list of service codes available for AWS services.
-	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list"`
+	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list" min:"0" max:"100"`
 
 	metadataDescribeServicesInput `json:"-" xml:"-"`
 }
@@ -939,6 +1250,16 @@ type metadataDescribeServicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServicesInput) GoString() string {
+	return s.String()
+}
+
 // The list of AWS services returned by the DescribeServices operation.
 type DescribeServicesOutput struct {
 	// A JSON-formatted list of AWS services.
@@ -951,6 +1272,16 @@ type metadataDescribeServicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeServicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeServicesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeSeverityLevelsInput struct {
 	// The ISO 639-1 code for the language in which AWS provides support. AWS Support
 	// currently supports English ("en") and Japanese ("ja"). Language parameters
@@ -964,6 +1295,16 @@ type metadataDescribeSeverityLevelsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSeverityLevelsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSeverityLevelsInput) GoString() string {
+	return s.String()
+}
+
 // The list of severity levels returned by the DescribeSeverityLevels operation.
 type DescribeSeverityLevelsOutput struct {
 	// The available severity levels for the support case. Available severity levels
@@ -977,6 +1318,16 @@ type metadataDescribeSeverityLevelsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeSeverityLevelsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeSeverityLevelsOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTrustedAdvisorCheckRefreshStatusesInput struct {
 	// The IDs of the Trusted Advisor checks.
 	CheckIDs []*string `locationName:"checkIds" type:"list" required:"true"`
@@ -988,6 +1339,16 @@ type metadataDescribeTrustedAdvisorCheckRefreshStatusesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorCheckRefreshStatusesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorCheckRefreshStatusesInput) GoString() string {
+	return s.String()
+}
+
 // The statuses of the Trusted Advisor checks returned by the DescribeTrustedAdvisorCheckRefreshStatuses
 // operation.
 type DescribeTrustedAdvisorCheckRefreshStatusesOutput struct {
@@ -1001,6 +1362,16 @@ type metadataDescribeTrustedAdvisorCheckRefreshStatusesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorCheckRefreshStatusesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorCheckRefreshStatusesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTrustedAdvisorCheckResultInput struct {
 	// The unique identifier for the Trusted Advisor check.
 	CheckID *string `locationName:"checkId" type:"string" required:"true"`
@@ -1017,6 +1388,16 @@ type metadataDescribeTrustedAdvisorCheckResultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorCheckResultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorCheckResultInput) GoString() string {
+	return s.String()
+}
+
 // The result of the Trusted Advisor check returned by the DescribeTrustedAdvisorCheckResult
 // operation.
 type DescribeTrustedAdvisorCheckResultOutput struct {
@@ -1030,6 +1411,16 @@ type metadataDescribeTrustedAdvisorCheckResultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorCheckResultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorCheckResultOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTrustedAdvisorCheckSummariesInput struct {
 	// The IDs of the Trusted Advisor checks.
 	CheckIDs []*string `locationName:"checkIds" type:"list" required:"true"`
@@ -1041,6 +1432,16 @@ type metadataDescribeTrustedAdvisorCheckSummariesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorCheckSummariesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorCheckSummariesInput) GoString() string {
+	return s.String()
+}
+
 // The summaries of the Trusted Advisor checks returned by the DescribeTrustedAdvisorCheckSummaries
 // operation.
 type DescribeTrustedAdvisorCheckSummariesOutput struct {
@@ -1054,6 +1455,16 @@ type metadataDescribeTrustedAdvisorCheckSummariesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorCheckSummariesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorCheckSummariesOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeTrustedAdvisorChecksInput struct {
 	// The ISO 639-1 code for the language in which AWS provides support. AWS Support
 	// currently supports English ("en") and Japanese ("ja"). Language parameters
@@ -1067,6 +1478,16 @@ type metadataDescribeTrustedAdvisorChecksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorChecksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorChecksInput) GoString() string {
+	return s.String()
+}
+
 // Information about the Trusted Advisor checks returned by the DescribeTrustedAdvisorChecks
 // operation.
 type DescribeTrustedAdvisorChecksOutput struct {
@@ -1080,6 +1501,16 @@ type metadataDescribeTrustedAdvisorChecksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeTrustedAdvisorChecksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeTrustedAdvisorChecksOutput) GoString() string {
+	return s.String()
+}
+
 // The five most recent communications associated with the case.
 type RecentCaseCommunications struct {
 	// The five most recent communications associated with the case.
@@ -1095,6 +1526,16 @@ type metadataRecentCaseCommunications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecentCaseCommunications) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecentCaseCommunications) GoString() string {
+	return s.String()
+}
+
 type RefreshTrustedAdvisorCheckInput struct {
 	// The unique identifier for the Trusted Advisor check.
 	CheckID *string `locationName:"checkId" type:"string" required:"true"`
@@ -1106,6 +1547,16 @@ type metadataRefreshTrustedAdvisorCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RefreshTrustedAdvisorCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RefreshTrustedAdvisorCheckInput) GoString() string {
+	return s.String()
+}
+
 // The current refresh status of a Trusted Advisor check.
 type RefreshTrustedAdvisorCheckOutput struct {
 	// The current refresh status for a check, including the amount of time until
@@ -1119,6 +1570,16 @@ type metadataRefreshTrustedAdvisorCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RefreshTrustedAdvisorCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RefreshTrustedAdvisorCheckOutput) GoString() string {
+	return s.String()
+}
+
 type ResolveCaseInput struct {
 	// The AWS Support case ID requested or returned in the call. The case ID is
 	// an alphanumeric string formatted as shown in this example: case-12345678910-2013-c4c1d2bf33c5cf47
@@ -1131,6 +1592,16 @@ type metadataResolveCaseInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResolveCaseInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResolveCaseInput) GoString() string {
+	return s.String()
+}
+
 // The status of the case returned by the ResolveCase operation.
 type ResolveCaseOutput struct {
 	// The status of the case after the ResolveCase request was processed.
@@ -1146,6 +1617,16 @@ type metadataResolveCaseOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ResolveCaseOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ResolveCaseOutput) GoString() string {
+	return s.String()
+}
+
 // Information about an AWS service returned by the DescribeServices operation.
 type Service struct {
 	// A list of categories that describe the type of support issue a case describes.
@@ -1155,7 +1636,7 @@ type Service struct {
 
 	// The code for an AWS service returned by the DescribeServices response. The
 	// Name element contains the corresponding friendly name.
-	Code *string `locationName:"code" type:"string"`
+	Code *string `locationName:"code" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The friendly name for an AWS service. The Code element contains the corresponding
 	// code.
@@ -1168,6 +1649,16 @@ type metadataService struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Service) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Service) GoString() string {
+	return s.String()
+}
+
 // A code and name pair that represent a severity level that can be applied
 // to a support case.
 type SeverityLevel struct {
@@ -1185,6 +1676,16 @@ type metadataSeverityLevel struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SeverityLevel) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SeverityLevel) GoString() string {
+	return s.String()
+}
+
 // The container for summary information that relates to the category of the
 // Trusted Advisor check.
 type TrustedAdvisorCategorySpecificSummary struct {
@@ -1199,6 +1700,16 @@ type metadataTrustedAdvisorCategorySpecificSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedAdvisorCategorySpecificSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorCategorySpecificSummary) GoString() string {
+	return s.String()
+}
+
 // The description and metadata for a Trusted Advisor check.
 type TrustedAdvisorCheckDescription struct {
 	// The category of the Trusted Advisor check.
@@ -1228,6 +1739,16 @@ type metadataTrustedAdvisorCheckDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedAdvisorCheckDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorCheckDescription) GoString() string {
+	return s.String()
+}
+
 // The refresh status of a Trusted Advisor check.
 type TrustedAdvisorCheckRefreshStatus struct {
 	// The unique identifier for the Trusted Advisor check.
@@ -1248,6 +1769,16 @@ type metadataTrustedAdvisorCheckRefreshStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedAdvisorCheckRefreshStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorCheckRefreshStatus) GoString() string {
+	return s.String()
+}
+
 // The results of a Trusted Advisor check returned by DescribeTrustedAdvisorCheckResult.
 type TrustedAdvisorCheckResult struct {
 	// Summary information that relates to the category of the check. Cost Optimizing
@@ -1278,6 +1809,16 @@ type metadataTrustedAdvisorCheckResult struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedAdvisorCheckResult) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorCheckResult) GoString() string {
+	return s.String()
+}
+
 // A summary of a Trusted Advisor check result, including the alert status,
 // last refresh, and number of resources examined.
 type TrustedAdvisorCheckSummary struct {
@@ -1309,6 +1850,16 @@ type metadataTrustedAdvisorCheckSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedAdvisorCheckSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorCheckSummary) GoString() string {
+	return s.String()
+}
+
 // The estimated cost savings that might be realized if the recommended actions
 // are taken.
 type TrustedAdvisorCostOptimizingSummary struct {
@@ -1327,6 +1878,16 @@ type metadataTrustedAdvisorCostOptimizingSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedAdvisorCostOptimizingSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorCostOptimizingSummary) GoString() string {
+	return s.String()
+}
+
 // Contains information about a resource identified by a Trusted Advisor check.
 type TrustedAdvisorResourceDetail struct {
 	// Specifies whether the AWS resource was ignored by Trusted Advisor because
@@ -1356,6 +1917,16 @@ type metadataTrustedAdvisorResourceDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TrustedAdvisorResourceDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorResourceDetail) GoString() string {
+	return s.String()
+}
+
 // Details about AWS resources that were analyzed in a call to Trusted Advisor
 // DescribeTrustedAdvisorCheckSummaries.
 type TrustedAdvisorResourcesSummary struct {
@@ -1380,3 +1951,13 @@ type TrustedAdvisorResourcesSummary struct {
 type metadataTrustedAdvisorResourcesSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s TrustedAdvisorResourcesSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TrustedAdvisorResourcesSummary) GoString() string {
+	return s.String()
+}
@@ -4,6 +4,7 @@
 package supportiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/support"
 )
 
@@ -11,29 +12,57 @@ import (
 type SupportAPI interface {
 	AddAttachmentsToSet(*support.AddAttachmentsToSetInput) (*support.AddAttachmentsToSetOutput, error)
 
+	AddAttachmentsToSetWithContext(aws.Context, *support.AddAttachmentsToSetInput) (*support.AddAttachmentsToSetOutput, error)
+
 	AddCommunicationToCase(*support.AddCommunicationToCaseInput) (*support.AddCommunicationToCaseOutput, error)
 
+	AddCommunicationToCaseWithContext(aws.Context, *support.AddCommunicationToCaseInput) (*support.AddCommunicationToCaseOutput, error)
+
 	CreateCase(*support.CreateCaseInput) (*support.CreateCaseOutput, error)
 
+	CreateCaseWithContext(aws.Context, *support.CreateCaseInput) (*support.CreateCaseOutput, error)
+
 	DescribeAttachment(*support.DescribeAttachmentInput) (*support.DescribeAttachmentOutput, error)
 
+	DescribeAttachmentWithContext(aws.Context, *support.DescribeAttachmentInput) (*support.DescribeAttachmentOutput, error)
+
 	DescribeCases(*support.DescribeCasesInput) (*support.DescribeCasesOutput, error)
 
+	DescribeCasesWithContext(aws.Context, *support.DescribeCasesInput) (*support.DescribeCasesOutput, error)
+
 	DescribeCommunications(*support.DescribeCommunicationsInput) (*support.DescribeCommunicationsOutput, error)
 
+	DescribeCommunicationsWithContext(aws.Context, *support.DescribeCommunicationsInput) (*support.DescribeCommunicationsOutput, error)
+
 	DescribeServices(*support.DescribeServicesInput) (*support.DescribeServicesOutput, error)
 
+	DescribeServicesWithContext(aws.Context, *support.DescribeServicesInput) (*support.DescribeServicesOutput, error)
+
 	DescribeSeverityLevels(*support.DescribeSeverityLevelsInput) (*support.DescribeSeverityLevelsOutput, error)
 
+	DescribeSeverityLevelsWithContext(aws.Context, *support.DescribeSeverityLevelsInput) (*support.DescribeSeverityLevelsOutput, error)
+
 	DescribeTrustedAdvisorCheckRefreshStatuses(*support.DescribeTrustedAdvisorCheckRefreshStatusesInput) (*support.DescribeTrustedAdvisorCheckRefreshStatusesOutput, error)
 
+	DescribeTrustedAdvisorCheckRefreshStatusesWithContext(aws.Context, *support.DescribeTrustedAdvisorCheckRefreshStatusesInput) (*support.DescribeTrustedAdvisorCheckRefreshStatusesOutput, error)
+
 	DescribeTrustedAdvisorCheckResult(*support.DescribeTrustedAdvisorCheckResultInput) (*support.DescribeTrustedAdvisorCheckResultOutput, error)
 
+	DescribeTrustedAdvisorCheckResultWithContext(aws.Context, *support.DescribeTrustedAdvisorCheckResultInput) (*support.DescribeTrustedAdvisorCheckResultOutput, error)
+
 	DescribeTrustedAdvisorCheckSummaries(*support.DescribeTrustedAdvisorCheckSummariesInput) (*support.DescribeTrustedAdvisorCheckSummariesOutput, error)
 
+	DescribeTrustedAdvisorCheckSummariesWithContext(aws.Context, *support.DescribeTrustedAdvisorCheckSummariesInput) (*support.DescribeTrustedAdvisorCheckSummariesOutput, error)
+
 	DescribeTrustedAdvisorChecks(*support.DescribeTrustedAdvisorChecksInput) (*support.DescribeTrustedAdvisorChecksOutput, error)
 
+	DescribeTrustedAdvisorChecksWithContext(aws.Context, *support.DescribeTrustedAdvisorChecksInput) (*support.DescribeTrustedAdvisorChecksOutput, error)
+
 	RefreshTrustedAdvisorCheck(*support.RefreshTrustedAdvisorCheckInput) (*support.RefreshTrustedAdvisorCheckOutput, error)
 
+	RefreshTrustedAdvisorCheckWithContext(aws.Context, *support.RefreshTrustedAdvisorCheckInput) (*support.RefreshTrustedAdvisorCheckOutput, error)
+
 	ResolveCase(*support.ResolveCaseInput) (*support.ResolveCaseOutput, error)
+
+	ResolveCaseWithContext(aws.Context, *support.ResolveCaseInput) (*support.ResolveCaseOutput, error)
 }
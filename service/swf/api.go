@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCountClosedWorkflowExecutions = "CountClosedWorkflowExecutions"
@@ -55,6 +56,16 @@ func (c *SWF) CountClosedWorkflowExecutions(input *CountClosedWorkflowExecutions
 	return out, err
 }
 
+// CountClosedWorkflowExecutionsWithContext is the same as CountClosedWorkflowExecutions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) CountClosedWorkflowExecutionsWithContext(ctx aws.Context, input *CountClosedWorkflowExecutionsInput) (*WorkflowExecutionCount, error) {
+	req, out := c.CountClosedWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCountOpenWorkflowExecutions = "CountOpenWorkflowExecutions"
 
 // CountOpenWorkflowExecutionsRequest generates a request for the CountOpenWorkflowExecutions operation.
@@ -101,6 +112,16 @@ func (c *SWF) CountOpenWorkflowExecutions(input *CountOpenWorkflowExecutionsInpu
 	return out, err
 }
 
+// CountOpenWorkflowExecutionsWithContext is the same as CountOpenWorkflowExecutions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) CountOpenWorkflowExecutionsWithContext(ctx aws.Context, input *CountOpenWorkflowExecutionsInput) (*WorkflowExecutionCount, error) {
+	req, out := c.CountOpenWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCountPendingActivityTasks = "CountPendingActivityTasks"
 
 // CountPendingActivityTasksRequest generates a request for the CountPendingActivityTasks operation.
@@ -146,6 +167,16 @@ func (c *SWF) CountPendingActivityTasks(input *CountPendingActivityTasksInput) (
 	return out, err
 }
 
+// CountPendingActivityTasksWithContext is the same as CountPendingActivityTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) CountPendingActivityTasksWithContext(ctx aws.Context, input *CountPendingActivityTasksInput) (*PendingTaskCount, error) {
+	req, out := c.CountPendingActivityTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opCountPendingDecisionTasks = "CountPendingDecisionTasks"
 
 // CountPendingDecisionTasksRequest generates a request for the CountPendingDecisionTasks operation.
@@ -191,6 +222,16 @@ func (c *SWF) CountPendingDecisionTasks(input *CountPendingDecisionTasksInput) (
 	return out, err
 }
 
+// CountPendingDecisionTasksWithContext is the same as CountPendingDecisionTasks with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) CountPendingDecisionTasksWithContext(ctx aws.Context, input *CountPendingDecisionTasksInput) (*PendingTaskCount, error) {
+	req, out := c.CountPendingDecisionTasksRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeprecateActivityType = "DeprecateActivityType"
 
 // DeprecateActivityTypeRequest generates a request for the DeprecateActivityType operation.
@@ -237,6 +278,16 @@ func (c *SWF) DeprecateActivityType(input *DeprecateActivityTypeInput) (*Depreca
 	return out, err
 }
 
+// DeprecateActivityTypeWithContext is the same as DeprecateActivityType with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) DeprecateActivityTypeWithContext(ctx aws.Context, input *DeprecateActivityTypeInput) (*DeprecateActivityTypeOutput, error) {
+	req, out := c.DeprecateActivityTypeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeprecateDomain = "DeprecateDomain"
 
 // DeprecateDomainRequest generates a request for the DeprecateDomain operation.
@@ -284,6 +335,16 @@ func (c *SWF) DeprecateDomain(input *DeprecateDomainInput) (*DeprecateDomainOutp
 	return out, err
 }
 
+// DeprecateDomainWithContext is the same as DeprecateDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) DeprecateDomainWithContext(ctx aws.Context, input *DeprecateDomainInput) (*DeprecateDomainOutput, error) {
+	req, out := c.DeprecateDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDeprecateWorkflowType = "DeprecateWorkflowType"
 
 // DeprecateWorkflowTypeRequest generates a request for the DeprecateWorkflowType operation.
@@ -331,6 +392,16 @@ func (c *SWF) DeprecateWorkflowType(input *DeprecateWorkflowTypeInput) (*Depreca
 	return out, err
 }
 
+// DeprecateWorkflowTypeWithContext is the same as DeprecateWorkflowType with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) DeprecateWorkflowTypeWithContext(ctx aws.Context, input *DeprecateWorkflowTypeInput) (*DeprecateWorkflowTypeOutput, error) {
+	req, out := c.DeprecateWorkflowTypeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeActivityType = "DescribeActivityType"
 
 // DescribeActivityTypeRequest generates a request for the DescribeActivityType operation.
@@ -376,6 +447,16 @@ func (c *SWF) DescribeActivityType(input *DescribeActivityTypeInput) (*DescribeA
 	return out, err
 }
 
+// DescribeActivityTypeWithContext is the same as DescribeActivityType with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) DescribeActivityTypeWithContext(ctx aws.Context, input *DescribeActivityTypeInput) (*DescribeActivityTypeOutput, error) {
+	req, out := c.DescribeActivityTypeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeDomain = "DescribeDomain"
 
 // DescribeDomainRequest generates a request for the DescribeDomain operation.
@@ -418,6 +499,16 @@ func (c *SWF) DescribeDomain(input *DescribeDomainInput) (*DescribeDomainOutput,
 	return out, err
 }
 
+// DescribeDomainWithContext is the same as DescribeDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) DescribeDomainWithContext(ctx aws.Context, input *DescribeDomainInput) (*DescribeDomainOutput, error) {
+	req, out := c.DescribeDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeWorkflowExecution = "DescribeWorkflowExecution"
 
 // DescribeWorkflowExecutionRequest generates a request for the DescribeWorkflowExecution operation.
@@ -461,6 +552,16 @@ func (c *SWF) DescribeWorkflowExecution(input *DescribeWorkflowExecutionInput) (
 	return out, err
 }
 
+// DescribeWorkflowExecutionWithContext is the same as DescribeWorkflowExecution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) DescribeWorkflowExecutionWithContext(ctx aws.Context, input *DescribeWorkflowExecutionInput) (*DescribeWorkflowExecutionOutput, error) {
+	req, out := c.DescribeWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeWorkflowType = "DescribeWorkflowType"
 
 // DescribeWorkflowTypeRequest generates a request for the DescribeWorkflowType operation.
@@ -506,6 +607,16 @@ func (c *SWF) DescribeWorkflowType(input *DescribeWorkflowTypeInput) (*DescribeW
 	return out, err
 }
 
+// DescribeWorkflowTypeWithContext is the same as DescribeWorkflowType with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) DescribeWorkflowTypeWithContext(ctx aws.Context, input *DescribeWorkflowTypeInput) (*DescribeWorkflowTypeOutput, error) {
+	req, out := c.DescribeWorkflowTypeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opGetWorkflowExecutionHistory = "GetWorkflowExecutionHistory"
 
 // GetWorkflowExecutionHistoryRequest generates a request for the GetWorkflowExecutionHistory operation.
@@ -556,6 +667,16 @@ func (c *SWF) GetWorkflowExecutionHistory(input *GetWorkflowExecutionHistoryInpu
 	return out, err
 }
 
+// GetWorkflowExecutionHistoryWithContext is the same as GetWorkflowExecutionHistory with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) GetWorkflowExecutionHistoryWithContext(ctx aws.Context, input *GetWorkflowExecutionHistoryInput) (*GetWorkflowExecutionHistoryOutput, error) {
+	req, out := c.GetWorkflowExecutionHistoryRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SWF) GetWorkflowExecutionHistoryPages(input *GetWorkflowExecutionHistoryInput, fn func(p *GetWorkflowExecutionHistoryOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.GetWorkflowExecutionHistoryRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -614,6 +735,16 @@ func (c *SWF) ListActivityTypes(input *ListActivityTypesInput) (*ListActivityTyp
 	return out, err
 }
 
+// ListActivityTypesWithContext is the same as ListActivityTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) ListActivityTypesWithContext(ctx aws.Context, input *ListActivityTypesInput) (*ListActivityTypesOutput, error) {
+	req, out := c.ListActivityTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SWF) ListActivityTypesPages(input *ListActivityTypesInput, fn func(p *ListActivityTypesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListActivityTypesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -675,6 +806,16 @@ func (c *SWF) ListClosedWorkflowExecutions(input *ListClosedWorkflowExecutionsIn
 	return out, err
 }
 
+// ListClosedWorkflowExecutionsWithContext is the same as ListClosedWorkflowExecutions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) ListClosedWorkflowExecutionsWithContext(ctx aws.Context, input *ListClosedWorkflowExecutionsInput) (*WorkflowExecutionInfos, error) {
+	req, out := c.ListClosedWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SWF) ListClosedWorkflowExecutionsPages(input *ListClosedWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListClosedWorkflowExecutionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -733,6 +874,16 @@ func (c *SWF) ListDomains(input *ListDomainsInput) (*ListDomainsOutput, error) {
 	return out, err
 }
 
+// ListDomainsWithContext is the same as ListDomains with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) ListDomainsWithContext(ctx aws.Context, input *ListDomainsInput) (*ListDomainsOutput, error) {
+	req, out := c.ListDomainsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SWF) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListDomainsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -794,6 +945,16 @@ func (c *SWF) ListOpenWorkflowExecutions(input *ListOpenWorkflowExecutionsInput)
 	return out, err
 }
 
+// ListOpenWorkflowExecutionsWithContext is the same as ListOpenWorkflowExecutions with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) ListOpenWorkflowExecutionsWithContext(ctx aws.Context, input *ListOpenWorkflowExecutionsInput) (*WorkflowExecutionInfos, error) {
+	req, out := c.ListOpenWorkflowExecutionsRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SWF) ListOpenWorkflowExecutionsPages(input *ListOpenWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListOpenWorkflowExecutionsRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -850,6 +1011,16 @@ func (c *SWF) ListWorkflowTypes(input *ListWorkflowTypesInput) (*ListWorkflowTyp
 	return out, err
 }
 
+// ListWorkflowTypesWithContext is the same as ListWorkflowTypes with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) ListWorkflowTypesWithContext(ctx aws.Context, input *ListWorkflowTypesInput) (*ListWorkflowTypesOutput, error) {
+	req, out := c.ListWorkflowTypesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SWF) ListWorkflowTypesPages(input *ListWorkflowTypesInput, fn func(p *ListWorkflowTypesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.ListWorkflowTypesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -908,6 +1079,16 @@ func (c *SWF) PollForActivityTask(input *PollForActivityTaskInput) (*PollForActi
 	return out, err
 }
 
+// PollForActivityTaskWithContext is the same as PollForActivityTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) PollForActivityTaskWithContext(ctx aws.Context, input *PollForActivityTaskInput) (*PollForActivityTaskOutput, error) {
+	req, out := c.PollForActivityTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opPollForDecisionTask = "PollForDecisionTask"
 
 // PollForDecisionTaskRequest generates a request for the PollForDecisionTask operation.
@@ -973,6 +1154,16 @@ func (c *SWF) PollForDecisionTask(input *PollForDecisionTaskInput) (*PollForDeci
 	return out, err
 }
 
+// PollForDecisionTaskWithContext is the same as PollForDecisionTask with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) PollForDecisionTaskWithContext(ctx aws.Context, input *PollForDecisionTaskInput) (*PollForDecisionTaskOutput, error) {
+	req, out := c.PollForDecisionTaskRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *SWF) PollForDecisionTaskPages(input *PollForDecisionTaskInput, fn func(p *PollForDecisionTaskOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.PollForDecisionTaskRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -1042,6 +1233,16 @@ func (c *SWF) RecordActivityTaskHeartbeat(input *RecordActivityTaskHeartbeatInpu
 	return out, err
 }
 
+// RecordActivityTaskHeartbeatWithContext is the same as RecordActivityTaskHeartbeat with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RecordActivityTaskHeartbeatWithContext(ctx aws.Context, input *RecordActivityTaskHeartbeatInput) (*RecordActivityTaskHeartbeatOutput, error) {
+	req, out := c.RecordActivityTaskHeartbeatRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterActivityType = "RegisterActivityType"
 
 // RegisterActivityTypeRequest generates a request for the RegisterActivityType operation.
@@ -1089,6 +1290,16 @@ func (c *SWF) RegisterActivityType(input *RegisterActivityTypeInput) (*RegisterA
 	return out, err
 }
 
+// RegisterActivityTypeWithContext is the same as RegisterActivityType with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RegisterActivityTypeWithContext(ctx aws.Context, input *RegisterActivityTypeInput) (*RegisterActivityTypeOutput, error) {
+	req, out := c.RegisterActivityTypeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterDomain = "RegisterDomain"
 
 // RegisterDomainRequest generates a request for the RegisterDomain operation.
@@ -1131,6 +1342,16 @@ func (c *SWF) RegisterDomain(input *RegisterDomainInput) (*RegisterDomainOutput,
 	return out, err
 }
 
+// RegisterDomainWithContext is the same as RegisterDomain with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RegisterDomainWithContext(ctx aws.Context, input *RegisterDomainInput) (*RegisterDomainOutput, error) {
+	req, out := c.RegisterDomainRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRegisterWorkflowType = "RegisterWorkflowType"
 
 // RegisterWorkflowTypeRequest generates a request for the RegisterWorkflowType operation.
@@ -1181,6 +1402,16 @@ func (c *SWF) RegisterWorkflowType(input *RegisterWorkflowTypeInput) (*RegisterW
 	return out, err
 }
 
+// RegisterWorkflowTypeWithContext is the same as RegisterWorkflowType with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RegisterWorkflowTypeWithContext(ctx aws.Context, input *RegisterWorkflowTypeInput) (*RegisterWorkflowTypeOutput, error) {
+	req, out := c.RegisterWorkflowTypeRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRequestCancelWorkflowExecution = "RequestCancelWorkflowExecution"
 
 // RequestCancelWorkflowExecutionRequest generates a request for the RequestCancelWorkflowExecution operation.
@@ -1230,6 +1461,16 @@ func (c *SWF) RequestCancelWorkflowExecution(input *RequestCancelWorkflowExecuti
 	return out, err
 }
 
+// RequestCancelWorkflowExecutionWithContext is the same as RequestCancelWorkflowExecution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RequestCancelWorkflowExecutionWithContext(ctx aws.Context, input *RequestCancelWorkflowExecutionInput) (*RequestCancelWorkflowExecutionOutput, error) {
+	req, out := c.RequestCancelWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondActivityTaskCanceled = "RespondActivityTaskCanceled"
 
 // RespondActivityTaskCanceledRequest generates a request for the RespondActivityTaskCanceled operation.
@@ -1284,6 +1525,16 @@ func (c *SWF) RespondActivityTaskCanceled(input *RespondActivityTaskCanceledInpu
 	return out, err
 }
 
+// RespondActivityTaskCanceledWithContext is the same as RespondActivityTaskCanceled with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RespondActivityTaskCanceledWithContext(ctx aws.Context, input *RespondActivityTaskCanceledInput) (*RespondActivityTaskCanceledOutput, error) {
+	req, out := c.RespondActivityTaskCanceledRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondActivityTaskCompleted = "RespondActivityTaskCompleted"
 
 // RespondActivityTaskCompletedRequest generates a request for the RespondActivityTaskCompleted operation.
@@ -1336,6 +1587,16 @@ func (c *SWF) RespondActivityTaskCompleted(input *RespondActivityTaskCompletedIn
 	return out, err
 }
 
+// RespondActivityTaskCompletedWithContext is the same as RespondActivityTaskCompleted with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RespondActivityTaskCompletedWithContext(ctx aws.Context, input *RespondActivityTaskCompletedInput) (*RespondActivityTaskCompletedOutput, error) {
+	req, out := c.RespondActivityTaskCompletedRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondActivityTaskFailed = "RespondActivityTaskFailed"
 
 // RespondActivityTaskFailedRequest generates a request for the RespondActivityTaskFailed operation.
@@ -1385,6 +1646,16 @@ func (c *SWF) RespondActivityTaskFailed(input *RespondActivityTaskFailedInput) (
 	return out, err
 }
 
+// RespondActivityTaskFailedWithContext is the same as RespondActivityTaskFailed with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RespondActivityTaskFailedWithContext(ctx aws.Context, input *RespondActivityTaskFailedInput) (*RespondActivityTaskFailedOutput, error) {
+	req, out := c.RespondActivityTaskFailedRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRespondDecisionTaskCompleted = "RespondDecisionTaskCompleted"
 
 // RespondDecisionTaskCompletedRequest generates a request for the RespondDecisionTaskCompleted operation.
@@ -1427,6 +1698,16 @@ func (c *SWF) RespondDecisionTaskCompleted(input *RespondDecisionTaskCompletedIn
 	return out, err
 }
 
+// RespondDecisionTaskCompletedWithContext is the same as RespondDecisionTaskCompleted with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) RespondDecisionTaskCompletedWithContext(ctx aws.Context, input *RespondDecisionTaskCompletedInput) (*RespondDecisionTaskCompletedOutput, error) {
+	req, out := c.RespondDecisionTaskCompletedRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opSignalWorkflowExecution = "SignalWorkflowExecution"
 
 // SignalWorkflowExecutionRequest generates a request for the SignalWorkflowExecution operation.
@@ -1474,6 +1755,16 @@ func (c *SWF) SignalWorkflowExecution(input *SignalWorkflowExecutionInput) (*Sig
 	return out, err
 }
 
+// SignalWorkflowExecutionWithContext is the same as SignalWorkflowExecution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) SignalWorkflowExecutionWithContext(ctx aws.Context, input *SignalWorkflowExecutionInput) (*SignalWorkflowExecutionOutput, error) {
+	req, out := c.SignalWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opStartWorkflowExecution = "StartWorkflowExecution"
 
 // StartWorkflowExecutionRequest generates a request for the StartWorkflowExecution operation.
@@ -1524,6 +1815,16 @@ func (c *SWF) StartWorkflowExecution(input *StartWorkflowExecutionInput) (*Start
 	return out, err
 }
 
+// StartWorkflowExecutionWithContext is the same as StartWorkflowExecution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) StartWorkflowExecutionWithContext(ctx aws.Context, input *StartWorkflowExecutionInput) (*StartWorkflowExecutionOutput, error) {
+	req, out := c.StartWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateWorkflowExecution = "TerminateWorkflowExecution"
 
 // TerminateWorkflowExecutionRequest generates a request for the TerminateWorkflowExecution operation.
@@ -1574,10 +1875,20 @@ func (c *SWF) TerminateWorkflowExecution(input *TerminateWorkflowExecutionInput)
 	return out, err
 }
 
+// TerminateWorkflowExecutionWithContext is the same as TerminateWorkflowExecution with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *SWF) TerminateWorkflowExecutionWithContext(ctx aws.Context, input *TerminateWorkflowExecutionInput) (*TerminateWorkflowExecutionOutput, error) {
+	req, out := c.TerminateWorkflowExecutionRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Provides details of the ActivityTaskCancelRequested event.
 type ActivityTaskCancelRequestedEventAttributes struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelActivityTask decision for this cancellation
@@ -1592,10 +1903,20 @@ type metadataActivityTaskCancelRequestedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTaskCancelRequestedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTaskCancelRequestedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ActivityTaskCanceled event.
 type ActivityTaskCanceledEventAttributes struct {
 	// Details of the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// If set, contains the Id of the last ActivityTaskCancelRequested event recorded
 	// for this activity task. This information can be useful for diagnosing problems
@@ -1619,10 +1940,20 @@ type metadataActivityTaskCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTaskCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTaskCanceledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ActivityTaskCompleted event.
 type ActivityTaskCompletedEventAttributes struct {
 	// The results of the activity task (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1641,13 +1972,23 @@ type metadataActivityTaskCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTaskCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTaskCompletedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ActivityTaskFailed event.
 type ActivityTaskFailedEventAttributes struct {
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1666,17 +2007,27 @@ type metadataActivityTaskFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTaskFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTaskFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ActivityTaskScheduled event.
 type ActivityTaskScheduledEventAttributes struct {
 	// The unique id of the activity task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision that
 	// resulted in the scheduling of this activity task. This information can be
@@ -1688,20 +2039,20 @@ type ActivityTaskScheduledEventAttributes struct {
 	// progress by calling RecordActivityTaskHeartbeat. If the timeout is exceeded,
 	// the activity task is automatically timed out. If the worker subsequently
 	// attempts to record a heartbeat or return a result, it will be ignored.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum amount of time for this activity task.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time the activity task can wait to be assigned to a
 	// worker.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time a worker may take to process the activity task.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The task list in which the activity task has been scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -1717,7 +2068,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataActivityTaskScheduledEventAttributes `json:"-" xml:"-"`
 }
@@ -1726,11 +2077,21 @@ type metadataActivityTaskScheduledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTaskScheduledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTaskScheduledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ActivityTaskStarted event.
 type ActivityTaskStartedEventAttributes struct {
 	// Identity of the worker that was assigned this task. This aids diagnostics
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1744,11 +2105,21 @@ type metadataActivityTaskStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTaskStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTaskStartedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ActivityTaskTimedOut event.
 type ActivityTaskTimedOutEventAttributes struct {
 	// Contains the content of the details parameter for the last call made by the
 	// activity to RecordActivityTaskHeartbeat.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1761,7 +2132,9 @@ type ActivityTaskTimedOutEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The type of the timeout that caused this event.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	//
+	// See the ActivityTaskTimeoutType* constants for valid values.
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE,SCHEDULE_TO_START,SCHEDULE_TO_CLOSE,HEARTBEAT" required:"true"`
 
 	metadataActivityTaskTimedOutEventAttributes `json:"-" xml:"-"`
 }
@@ -1770,19 +2143,29 @@ type metadataActivityTaskTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTaskTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTaskTimedOutEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Represents an activity type.
 type ActivityType struct {
 	// The name of this activity.
 	//
 	// The combination of activity type name and version must be unique within
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of this activity.
 	//
 	// The combination of activity type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataActivityType `json:"-" xml:"-"`
 }
@@ -1791,6 +2174,16 @@ type metadataActivityType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityType) GoString() string {
+	return s.String()
+}
+
 // Configuration settings registered with the activity type.
 type ActivityTypeConfiguration struct {
 	// Optional. The default maximum time, in seconds, before which a worker processing
@@ -1805,7 +2198,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list specified for this activity type at registration.
 	// This default is used if a task list is not provided when a task is scheduled
@@ -1823,7 +2216,7 @@ type ActivityTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, for tasks of this activity type. You can override this default when
@@ -1831,7 +2224,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, that a task of an activity type can wait before being assigned to a
@@ -1840,7 +2233,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration for tasks of an activity type specified
 	// when registering the activity type. You can override this default when scheduling
@@ -1848,7 +2241,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataActivityTypeConfiguration `json:"-" xml:"-"`
 }
@@ -1857,6 +2250,16 @@ type metadataActivityTypeConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTypeConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTypeConfiguration) GoString() string {
+	return s.String()
+}
+
 // Detailed information about an activity type.
 type ActivityTypeInfo struct {
 	// The ActivityType type structure representing the activity type.
@@ -1869,10 +2272,12 @@ type ActivityTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the activity type provided in RegisterActivityType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the activity type.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	//
+	// See the RegistrationStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	metadataActivityTypeInfo `json:"-" xml:"-"`
 }
@@ -1881,6 +2286,16 @@ type metadataActivityTypeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ActivityTypeInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ActivityTypeInfo) GoString() string {
+	return s.String()
+}
+
 // Provides details of the CancelTimer decision.
 //
 // Access Control
@@ -1898,7 +2313,7 @@ type metadataActivityTypeInfo struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelTimerDecisionAttributes struct {
 	// Required. The unique Id of the timer to cancel.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerDecisionAttributes `json:"-" xml:"-"`
 }
@@ -1907,6 +2322,16 @@ type metadataCancelTimerDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelTimerDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelTimerDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the CancelTimerFailed event.
 type CancelTimerFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -1915,7 +2340,9 @@ type CancelTimerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the CancelTimerFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"TIMER_ID_UNKNOWN,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CancelTimer decision to cancel this timer. This information
@@ -1924,7 +2351,7 @@ type CancelTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the CancelTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -1933,6 +2360,16 @@ type metadataCancelTimerFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelTimerFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelTimerFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the CancelWorkflowExecution decision.
 //
 // Access Control
@@ -1950,7 +2387,7 @@ type metadataCancelTimerFailedEventAttributes struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelWorkflowExecutionDecisionAttributes struct {
 	// Optional. details of the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataCancelWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -1959,6 +2396,16 @@ type metadataCancelWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelWorkflowExecutionDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the CancelWorkflowExecutionFailed event.
 type CancelWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -1967,7 +2414,9 @@ type CancelWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the CancelWorkflowExecutionFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CancelWorkflowExecution decision for this cancellation
@@ -1982,10 +2431,20 @@ type metadataCancelWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CancelWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CancelWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provide details of the ChildWorkflowExecutionCanceled event.
 type ChildWorkflowExecutionCanceledEventAttributes struct {
 	// Details of the cancellation (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -2011,6 +2470,16 @@ type metadataChildWorkflowExecutionCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChildWorkflowExecutionCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChildWorkflowExecutionCanceledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ChildWorkflowExecutionCompleted event.
 type ChildWorkflowExecutionCompletedEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -2020,7 +2489,7 @@ type ChildWorkflowExecutionCompletedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The result of the child workflow execution (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -2040,10 +2509,20 @@ type metadataChildWorkflowExecutionCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChildWorkflowExecutionCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChildWorkflowExecutionCompletedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ChildWorkflowExecutionFailed event.
 type ChildWorkflowExecutionFailedEventAttributes struct {
 	// The details of the failure (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -2052,7 +2531,7 @@ type ChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The reason for the failure (if provided).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -2072,6 +2551,16 @@ type metadataChildWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChildWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChildWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ChildWorkflowExecutionStarted event.
 type ChildWorkflowExecutionStartedEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -2093,6 +2582,16 @@ type metadataChildWorkflowExecutionStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChildWorkflowExecutionStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChildWorkflowExecutionStartedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ChildWorkflowExecutionTerminated event.
 type ChildWorkflowExecutionTerminatedEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -2119,6 +2618,16 @@ type metadataChildWorkflowExecutionTerminatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChildWorkflowExecutionTerminatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChildWorkflowExecutionTerminatedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ChildWorkflowExecutionTimedOut event.
 type ChildWorkflowExecutionTimedOutEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -2134,7 +2643,9 @@ type ChildWorkflowExecutionTimedOutEventAttributes struct {
 
 	// The type of the timeout that caused the child workflow execution to time
 	// out.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	//
+	// See the WorkflowExecutionTimeoutType* constants for valid values.
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	// The child workflow execution that timed out.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -2149,12 +2660,24 @@ type metadataChildWorkflowExecutionTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ChildWorkflowExecutionTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ChildWorkflowExecutionTimedOutEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Used to filter the closed workflow executions in visibility APIs by their
 // close status.
 type CloseStatusFilter struct {
 	// Required. The close status that must match the close status of an execution
 	// for it to meet the criteria of this filter.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	//
+	// See the CloseStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"COMPLETED,FAILED,CANCELED,TERMINATED,CONTINUED_AS_NEW,TIMED_OUT" required:"true"`
 
 	metadataCloseStatusFilter `json:"-" xml:"-"`
 }
@@ -2163,6 +2686,16 @@ type metadataCloseStatusFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CloseStatusFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CloseStatusFilter) GoString() string {
+	return s.String()
+}
+
 // Provides details of the CompleteWorkflowExecution decision.
 //
 // Access Control
@@ -2181,7 +2714,7 @@ type metadataCloseStatusFilter struct {
 type CompleteWorkflowExecutionDecisionAttributes struct {
 	// The result of the workflow execution. The form of the result is implementation
 	// defined.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataCompleteWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -2190,6 +2723,16 @@ type metadataCompleteWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CompleteWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CompleteWorkflowExecutionDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the CompleteWorkflowExecutionFailed event.
 type CompleteWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -2198,7 +2741,9 @@ type CompleteWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the CompleteWorkflowExecutionFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CompleteWorkflowExecution decision to complete this
@@ -2213,6 +2758,16 @@ type metadataCompleteWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CompleteWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CompleteWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ContinueAsNewWorkflowExecution decision.
 //
 // Access Control
@@ -2248,7 +2803,9 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// If set, specifies the total duration for this workflow execution. This overrides
 	// the defaultExecutionStartToCloseTimeout specified when registering the workflow
@@ -2261,16 +2818,16 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this field.
 	// If neither this field is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the new workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure"`
@@ -2284,7 +2841,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for the new workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -2297,9 +2854,9 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
-	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string"`
+	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string" min:"1" max:"64"`
 
 	metadataContinueAsNewWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -2308,7 +2865,17 @@ type metadataContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Provides details of the ContinueAsNewWorkflowExecutionFailed event.
+// String returns the string representation
+func (s ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ContinueAsNewWorkflowExecutionDecisionAttributes) GoString() string {
+	return s.String()
+}
+
+// Provides details of the ContinueAsNewWorkflowExecutionFailed event.
 type ContinueAsNewWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
 	// can be useful for diagnostic purposes.
@@ -2316,7 +2883,9 @@ type ContinueAsNewWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the ContinueAsNewWorkflowExecutionFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,WORKFLOW_TYPE_DEPRECATED,WORKFLOW_TYPE_DOES_NOT_EXIST,DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_CHILD_POLICY_UNDEFINED,CONTINUE_AS_NEW_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the ContinueAsNewWorkflowExecution decision that started
@@ -2331,6 +2900,16 @@ type metadataContinueAsNewWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ContinueAsNewWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ContinueAsNewWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 type CountClosedWorkflowExecutionsInput struct {
 	// If specified, only workflow executions that match this close status are counted.
 	// This filter has an affect only if executionStatus is specified as CLOSED.
@@ -2347,7 +2926,7 @@ type CountClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2383,9 +2962,19 @@ type metadataCountClosedWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CountClosedWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CountClosedWorkflowExecutionsInput) GoString() string {
+	return s.String()
+}
+
 type CountOpenWorkflowExecutionsInput struct {
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2418,9 +3007,19 @@ type metadataCountOpenWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CountOpenWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CountOpenWorkflowExecutionsInput) GoString() string {
+	return s.String()
+}
+
 type CountPendingActivityTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2432,9 +3031,19 @@ type metadataCountPendingActivityTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CountPendingActivityTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CountPendingActivityTasksInput) GoString() string {
+	return s.String()
+}
+
 type CountPendingDecisionTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2446,6 +3055,16 @@ type metadataCountPendingDecisionTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CountPendingDecisionTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CountPendingDecisionTasksInput) GoString() string {
+	return s.String()
+}
+
 // Specifies a decision made by the decider. A decision can be one of these
 // types:
 //
@@ -2567,7 +3186,9 @@ type Decision struct {
 	ContinueAsNewWorkflowExecutionDecisionAttributes *ContinueAsNewWorkflowExecutionDecisionAttributes `locationName:"continueAsNewWorkflowExecutionDecisionAttributes" type:"structure"`
 
 	// Specifies the type of the decision.
-	DecisionType *string `locationName:"decisionType" type:"string" required:"true"`
+	//
+	// See the DecisionType* constants for valid values.
+	DecisionType *string `locationName:"decisionType" type:"string" enum:"ScheduleActivityTask,RequestCancelActivityTask,CompleteWorkflowExecution,FailWorkflowExecution,CancelWorkflowExecution,ContinueAsNewWorkflowExecution,RecordMarker,StartTimer,CancelTimer,SignalExternalWorkflowExecution,RequestCancelExternalWorkflowExecution,StartChildWorkflowExecution" required:"true"`
 
 	// Provides details of the FailWorkflowExecution decision. It is not set for
 	// other decision types.
@@ -2608,10 +3229,20 @@ type metadataDecision struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Decision) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Decision) GoString() string {
+	return s.String()
+}
+
 // Provides details of the DecisionTaskCompleted event.
 type DecisionTaskCompletedEventAttributes struct {
 	// User defined context for the workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -2630,6 +3261,16 @@ type metadataDecisionTaskCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecisionTaskCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecisionTaskCompletedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details about the DecisionTaskScheduled event.
 type DecisionTaskScheduledEventAttributes struct {
 	// The maximum duration for this decision task. The task is considered timed
@@ -2637,7 +3278,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The name of the task list in which the decision task was scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2650,7 +3291,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataDecisionTaskScheduledEventAttributes `json:"-" xml:"-"`
 }
@@ -2659,11 +3300,21 @@ type metadataDecisionTaskScheduledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecisionTaskScheduledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecisionTaskScheduledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the DecisionTaskStarted event.
 type DecisionTaskStartedEventAttributes struct {
 	// Identity of the decider making the request. This enables diagnostic tracing
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -2677,6 +3328,16 @@ type metadataDecisionTaskStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecisionTaskStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecisionTaskStartedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the DecisionTaskTimedOut event.
 type DecisionTaskTimedOutEventAttributes struct {
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
@@ -2690,7 +3351,9 @@ type DecisionTaskTimedOutEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The type of timeout that expired before the decision task could be completed.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	//
+	// See the DecisionTaskTimeoutType* constants for valid values.
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	metadataDecisionTaskTimedOutEventAttributes `json:"-" xml:"-"`
 }
@@ -2699,12 +3362,22 @@ type metadataDecisionTaskTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DecisionTaskTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DecisionTaskTimedOutEventAttributes) GoString() string {
+	return s.String()
+}
+
 type DeprecateActivityTypeInput struct {
 	// The activity type to deprecate.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateActivityTypeInput `json:"-" xml:"-"`
 }
@@ -2713,6 +3386,16 @@ type metadataDeprecateActivityTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeprecateActivityTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeprecateActivityTypeInput) GoString() string {
+	return s.String()
+}
+
 type DeprecateActivityTypeOutput struct {
 	metadataDeprecateActivityTypeOutput `json:"-" xml:"-"`
 }
@@ -2721,9 +3404,19 @@ type metadataDeprecateActivityTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeprecateActivityTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeprecateActivityTypeOutput) GoString() string {
+	return s.String()
+}
+
 type DeprecateDomainInput struct {
 	// The name of the domain to deprecate.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateDomainInput `json:"-" xml:"-"`
 }
@@ -2732,6 +3425,16 @@ type metadataDeprecateDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeprecateDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeprecateDomainInput) GoString() string {
+	return s.String()
+}
+
 type DeprecateDomainOutput struct {
 	metadataDeprecateDomainOutput `json:"-" xml:"-"`
 }
@@ -2740,9 +3443,19 @@ type metadataDeprecateDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeprecateDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeprecateDomainOutput) GoString() string {
+	return s.String()
+}
+
 type DeprecateWorkflowTypeInput struct {
 	// The name of the domain in which the workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to deprecate.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -2754,6 +3467,16 @@ type metadataDeprecateWorkflowTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeprecateWorkflowTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeprecateWorkflowTypeInput) GoString() string {
+	return s.String()
+}
+
 type DeprecateWorkflowTypeOutput struct {
 	metadataDeprecateWorkflowTypeOutput `json:"-" xml:"-"`
 }
@@ -2762,13 +3485,23 @@ type metadataDeprecateWorkflowTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DeprecateWorkflowTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DeprecateWorkflowTypeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeActivityTypeInput struct {
 	// The activity type to get information about. Activity types are identified
 	// by the name and version that were supplied when the activity was registered.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeActivityTypeInput `json:"-" xml:"-"`
 }
@@ -2777,6 +3510,16 @@ type metadataDescribeActivityTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeActivityTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeActivityTypeInput) GoString() string {
+	return s.String()
+}
+
 // Detailed information about an activity type.
 type DescribeActivityTypeOutput struct {
 	// The configuration settings registered with the activity type.
@@ -2800,9 +3543,19 @@ type metadataDescribeActivityTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeActivityTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeActivityTypeOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeDomainInput struct {
 	// The name of the domain to describe.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeDomainInput `json:"-" xml:"-"`
 }
@@ -2811,6 +3564,16 @@ type metadataDescribeDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDomainInput) GoString() string {
+	return s.String()
+}
+
 // Contains details of a domain.
 type DescribeDomainOutput struct {
 	// Contains the configuration settings of a domain.
@@ -2826,9 +3589,19 @@ type metadataDescribeDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeDomainOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow execution to describe.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -2840,6 +3613,16 @@ type metadataDescribeWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkflowExecutionInput) GoString() string {
+	return s.String()
+}
+
 // Contains details about a workflow execution.
 type DescribeWorkflowExecutionOutput struct {
 	// The configuration settings for this workflow execution including timeout
@@ -2857,7 +3640,7 @@ type DescribeWorkflowExecutionOutput struct {
 	// The latest executionContext provided by the decider for this workflow execution.
 	// A decider can provide an executionContext (a free-form string) when closing
 	// a decision task using RespondDecisionTaskCompleted.
-	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string"`
+	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string" max:"32768"`
 
 	// The number of tasks for this workflow execution. This includes open and closed
 	// tasks of all types.
@@ -2870,9 +3653,19 @@ type metadataDescribeWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkflowExecutionOutput) GoString() string {
+	return s.String()
+}
+
 type DescribeWorkflowTypeInput struct {
 	// The name of the domain in which this workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to describe.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -2884,6 +3677,16 @@ type metadataDescribeWorkflowTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkflowTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkflowTypeInput) GoString() string {
+	return s.String()
+}
+
 // Contains details about a workflow type.
 type DescribeWorkflowTypeOutput struct {
 	// Configuration settings of the workflow type registered through RegisterWorkflowType
@@ -2907,10 +3710,20 @@ type metadataDescribeWorkflowTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkflowTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkflowTypeOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the configuration settings of a domain.
 type DomainConfiguration struct {
 	// The retention period for workflow executions in this domain.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataDomainConfiguration `json:"-" xml:"-"`
 }
@@ -2919,13 +3732,23 @@ type metadataDomainConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DomainConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DomainConfiguration) GoString() string {
+	return s.String()
+}
+
 // Contains general information about a domain.
 type DomainInfo struct {
 	// The description of the domain provided through RegisterDomain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain. This name is unique within the account.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The status of the domain:
 	//
@@ -2933,7 +3756,9 @@ type DomainInfo struct {
 	// this domain for registering types and creating new workflow executions.
 	//  DEPRECATED: The domain was deprecated using DeprecateDomain, but is still
 	// in use. You should not create new workflow executions in this domain.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	//
+	// See the RegistrationStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	metadataDomainInfo `json:"-" xml:"-"`
 }
@@ -2942,6 +3767,16 @@ type metadataDomainInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DomainInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DomainInfo) GoString() string {
+	return s.String()
+}
+
 // Used to filter the workflow executions in visibility APIs by various time-based
 // rules. Each parameter, if specified, defines a rule that must be satisfied
 // by each returned query result. The parameter values are in the Unix Time
@@ -2961,6 +3796,16 @@ type metadataExecutionTimeFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExecutionTimeFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExecutionTimeFilter) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ExternalWorkflowExecutionCancelRequested event.
 type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
 	// The id of the RequestCancelExternalWorkflowExecutionInitiated event corresponding
@@ -2979,6 +3824,16 @@ type metadataExternalWorkflowExecutionCancelRequestedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExternalWorkflowExecutionCancelRequestedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExternalWorkflowExecutionCancelRequestedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ExternalWorkflowExecutionSignaled event.
 type ExternalWorkflowExecutionSignaledEventAttributes struct {
 	// The id of the SignalExternalWorkflowExecutionInitiated event corresponding
@@ -2997,6 +3852,16 @@ type metadataExternalWorkflowExecutionSignaledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ExternalWorkflowExecutionSignaledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ExternalWorkflowExecutionSignaledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the FailWorkflowExecution decision.
 //
 // Access Control
@@ -3014,10 +3879,10 @@ type metadataExternalWorkflowExecutionSignaledEventAttributes struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type FailWorkflowExecutionDecisionAttributes struct {
 	// Optional. Details of the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// A descriptive reason for the failure that may help in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataFailWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -3026,6 +3891,16 @@ type metadataFailWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FailWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FailWorkflowExecutionDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the FailWorkflowExecutionFailed event.
 type FailWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -3034,7 +3909,9 @@ type FailWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the FailWorkflowExecutionFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the FailWorkflowExecution decision to fail this execution.
@@ -3049,9 +3926,19 @@ type metadataFailWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FailWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FailWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 type GetWorkflowExecutionHistoryInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specifies the workflow execution for which to return the history.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -3063,7 +3950,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3071,7 +3958,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimeStamp of the events.
@@ -3084,6 +3971,16 @@ type metadataGetWorkflowExecutionHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetWorkflowExecutionHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetWorkflowExecutionHistoryInput) GoString() string {
+	return s.String()
+}
+
 // Paginated representation of a workflow history for a workflow execution.
 // This is the up to date, complete and authoritative record of the events related
 // to all tasks and events in the life of the workflow execution.
@@ -3097,7 +3994,7 @@ type GetWorkflowExecutionHistoryOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataGetWorkflowExecutionHistoryOutput `json:"-" xml:"-"`
 }
@@ -3106,6 +4003,16 @@ type metadataGetWorkflowExecutionHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s GetWorkflowExecutionHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s GetWorkflowExecutionHistoryOutput) GoString() string {
+	return s.String()
+}
+
 // Event within a workflow execution. A history event can be one of these types:
 //
 //   WorkflowExecutionStarted: The workflow execution was started.  WorkflowExecutionCompleted:
@@ -3275,7 +4182,9 @@ type HistoryEvent struct {
 	EventTimestamp *time.Time `locationName:"eventTimestamp" type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// The type of the history event.
-	EventType *string `locationName:"eventType" type:"string" required:"true"`
+	//
+	// See the EventType* constants for valid values.
+	EventType *string `locationName:"eventType" type:"string" enum:"WorkflowExecutionStarted,WorkflowExecutionCancelRequested,WorkflowExecutionCompleted,CompleteWorkflowExecutionFailed,WorkflowExecutionFailed,FailWorkflowExecutionFailed,WorkflowExecutionTimedOut,WorkflowExecutionCanceled,CancelWorkflowExecutionFailed,WorkflowExecutionContinuedAsNew,ContinueAsNewWorkflowExecutionFailed,WorkflowExecutionTerminated,DecisionTaskScheduled,DecisionTaskStarted,DecisionTaskCompleted,DecisionTaskTimedOut,ActivityTaskScheduled,ScheduleActivityTaskFailed,ActivityTaskStarted,ActivityTaskCompleted,ActivityTaskFailed,ActivityTaskTimedOut,ActivityTaskCanceled,ActivityTaskCancelRequested,RequestCancelActivityTaskFailed,WorkflowExecutionSignaled,MarkerRecorded,RecordMarkerFailed,TimerStarted,StartTimerFailed,TimerFired,TimerCanceled,CancelTimerFailed,StartChildWorkflowExecutionInitiated,StartChildWorkflowExecutionFailed,ChildWorkflowExecutionStarted,ChildWorkflowExecutionCompleted,ChildWorkflowExecutionFailed,ChildWorkflowExecutionTimedOut,ChildWorkflowExecutionCanceled,ChildWorkflowExecutionTerminated,SignalExternalWorkflowExecutionInitiated,SignalExternalWorkflowExecutionFailed,ExternalWorkflowExecutionSignaled,RequestCancelExternalWorkflowExecutionInitiated,RequestCancelExternalWorkflowExecutionFailed,ExternalWorkflowExecutionCancelRequested" required:"true"`
 
 	// If the event is of type ExternalWorkflowExecutionCancelRequested then this
 	// member is set and provides detailed information about the event. It is not
@@ -3408,9 +4317,19 @@ type metadataHistoryEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s HistoryEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s HistoryEvent) GoString() string {
+	return s.String()
+}
+
 type ListActivityTypesInput struct {
 	// The name of the domain in which the activity types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3419,10 +4338,10 @@ type ListActivityTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If specified, only lists the activity types that have this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3430,10 +4349,12 @@ type ListActivityTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the activity types to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	//
+	// See the RegistrationStatus* constants for valid values.
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default, the results
 	// are returned in ascending alphabetical order by name of the activity types.
@@ -3446,6 +4367,16 @@ type metadataListActivityTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListActivityTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListActivityTypesInput) GoString() string {
+	return s.String()
+}
+
 // Contains a paginated list of activity type information structures.
 type ListActivityTypesOutput struct {
 	// If a NextPageToken was returned by a previous call, there are more results
@@ -3454,7 +4385,7 @@ type ListActivityTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// List of activity type information.
 	TypeInfos []*ActivityTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -3466,6 +4397,16 @@ type metadataListActivityTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListActivityTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListActivityTypesOutput) GoString() string {
+	return s.String()
+}
+
 type ListClosedWorkflowExecutionsInput struct {
 	// If specified, only workflow executions that match this close status are listed.
 	// For example, if TERMINATED is specified, then only TERMINATED workflow executions
@@ -3485,7 +4426,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -3501,7 +4442,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3509,7 +4450,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start or the close time of the executions.
@@ -3543,6 +4484,16 @@ type metadataListClosedWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListClosedWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListClosedWorkflowExecutionsInput) GoString() string {
+	return s.String()
+}
+
 type ListDomainsInput struct {
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3551,7 +4502,7 @@ type ListDomainsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3559,10 +4510,12 @@ type ListDomainsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the domains to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	//
+	// See the RegistrationStatus* constants for valid values.
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default, the results
 	// are returned in ascending alphabetical order by name of the domains.
@@ -3575,6 +4528,16 @@ type metadataListDomainsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDomainsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDomainsInput) GoString() string {
+	return s.String()
+}
+
 // Contains a paginated collection of DomainInfo structures.
 type ListDomainsOutput struct {
 	// A list of DomainInfo structures.
@@ -3586,7 +4549,7 @@ type ListDomainsOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataListDomainsOutput `json:"-" xml:"-"`
 }
@@ -3595,9 +4558,19 @@ type metadataListDomainsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListDomainsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListDomainsOutput) GoString() string {
+	return s.String()
+}
+
 type ListOpenWorkflowExecutionsInput struct {
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -3613,7 +4586,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3621,7 +4594,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start time of the executions.
@@ -3650,9 +4623,19 @@ type metadataListOpenWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListOpenWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListOpenWorkflowExecutionsInput) GoString() string {
+	return s.String()
+}
+
 type ListWorkflowTypesInput struct {
 	// The name of the domain in which the workflow types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3661,10 +4644,10 @@ type ListWorkflowTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If specified, lists the workflow type with this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3672,10 +4655,12 @@ type ListWorkflowTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the workflow types to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	//
+	// See the RegistrationStatus* constants for valid values.
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in ascending alphabetical order of the name of the workflow
@@ -3689,6 +4674,16 @@ type metadataListWorkflowTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListWorkflowTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListWorkflowTypesInput) GoString() string {
+	return s.String()
+}
+
 // Contains a paginated list of information structures about workflow types.
 type ListWorkflowTypesOutput struct {
 	// If a NextPageToken was returned by a previous call, there are more results
@@ -3697,7 +4692,7 @@ type ListWorkflowTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The list of workflow type information.
 	TypeInfos []*WorkflowTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -3709,6 +4704,16 @@ type metadataListWorkflowTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ListWorkflowTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ListWorkflowTypesOutput) GoString() string {
+	return s.String()
+}
+
 // Provides details of the MarkerRecorded event.
 type MarkerRecordedEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -3718,10 +4723,10 @@ type MarkerRecordedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details of the marker (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataMarkerRecordedEventAttributes `json:"-" xml:"-"`
 }
@@ -3730,10 +4735,20 @@ type metadataMarkerRecordedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s MarkerRecordedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s MarkerRecordedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Contains the count of tasks in a task list.
 type PendingTaskCount struct {
 	// The number of tasks in the task list.
-	Count *int64 `locationName:"count" type:"integer" required:"true"`
+	Count *int64 `locationName:"count" type:"integer" min:"0" required:"true"`
 
 	// If set to true, indicates that the actual count was more than the maximum
 	// supported by this API and the count returned is the truncated value.
@@ -3746,14 +4761,24 @@ type metadataPendingTaskCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PendingTaskCount) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PendingTaskCount) GoString() string {
+	return s.String()
+}
+
 type PollForActivityTaskInput struct {
 	// The name of the domain that contains the task lists being polled.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the worker making the request, recorded in the ActivityTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// Specifies the task list to poll for activity tasks.
 	//
@@ -3770,17 +4795,27 @@ type metadataPollForActivityTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PollForActivityTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PollForActivityTaskInput) GoString() string {
+	return s.String()
+}
+
 // Unit of work sent to an activity worker.
 type PollForActivityTaskOutput struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of this activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The inputs provided when the activity task was scheduled. The form of the
 	// input is user defined and should be meaningful to the activity implementation.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskStarted event recorded in the history.
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
@@ -3788,7 +4823,7 @@ type PollForActivityTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution that started this activity task.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -3800,14 +4835,24 @@ type metadataPollForActivityTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PollForActivityTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PollForActivityTaskOutput) GoString() string {
+	return s.String()
+}
+
 type PollForDecisionTaskInput struct {
 	// The name of the domain containing the task lists to poll.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the decider making the request, which is recorded in the DecisionTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3816,7 +4861,7 @@ type PollForDecisionTaskInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" min:"0" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3829,7 +4874,7 @@ type PollForDecisionTaskInput struct {
 	// to get the next page. You must call PollForDecisionTask again (with the nextPageToken)
 	// to retrieve the next page of history records. Calling PollForDecisionTask
 	// with a nextPageToken will not return a new decision task..
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimestamp of the events.
@@ -3850,6 +4895,16 @@ type metadataPollForDecisionTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PollForDecisionTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PollForDecisionTaskInput) GoString() string {
+	return s.String()
+}
+
 // A structure that represents a decision task. Decision tasks are sent to deciders
 // in order for them to make decisions.
 type PollForDecisionTaskOutput struct {
@@ -3863,7 +4918,7 @@ type PollForDecisionTaskOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The id of the DecisionTaskStarted event of the previous decision task of
 	// this workflow execution that was processed by the decider. This can be used
@@ -3877,7 +4932,7 @@ type PollForDecisionTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution for which this decision task was created.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -3892,16 +4947,26 @@ type metadataPollForDecisionTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s PollForDecisionTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s PollForDecisionTaskOutput) GoString() string {
+	return s.String()
+}
+
 type RecordActivityTaskHeartbeatInput struct {
 	// If specified, contains details about the progress of the task.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRecordActivityTaskHeartbeatInput `json:"-" xml:"-"`
 }
@@ -3910,6 +4975,16 @@ type metadataRecordActivityTaskHeartbeatInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordActivityTaskHeartbeatInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordActivityTaskHeartbeatInput) GoString() string {
+	return s.String()
+}
+
 // Status information about an activity task.
 type RecordActivityTaskHeartbeatOutput struct {
 	// Set to true if cancellation of the task is requested.
@@ -3922,6 +4997,16 @@ type metadataRecordActivityTaskHeartbeatOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordActivityTaskHeartbeatOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordActivityTaskHeartbeatOutput) GoString() string {
+	return s.String()
+}
+
 // Provides details of the RecordMarker decision.
 //
 // Access Control
@@ -3939,10 +5024,10 @@ type metadataRecordActivityTaskHeartbeatOutput struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RecordMarkerDecisionAttributes struct {
 	// Optional. details of the marker.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Required. The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerDecisionAttributes `json:"-" xml:"-"`
 }
@@ -3951,6 +5036,16 @@ type metadataRecordMarkerDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordMarkerDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordMarkerDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the RecordMarkerFailed event.
 type RecordMarkerFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -3959,7 +5054,9 @@ type RecordMarkerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the RecordMarkerFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RecordMarkerFailed decision for this cancellation request.
@@ -3968,7 +5065,7 @@ type RecordMarkerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The marker's name.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -3977,6 +5074,16 @@ type metadataRecordMarkerFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RecordMarkerFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RecordMarkerFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 type RegisterActivityTypeInput struct {
 	// If set, specifies the default maximum time before which a worker processing
 	// a task of this type must report progress by calling RecordActivityTaskHeartbeat.
@@ -3989,7 +5096,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling tasks of this
 	// activity type. This default task list is used if a task list is not provided
@@ -4004,7 +5111,7 @@ type RegisterActivityTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration for a task of this activity
 	// type. This default can be overridden when scheduling an activity task using
@@ -4012,7 +5119,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a task of this activity
 	// type can wait before being assigned to a worker. This default can be overridden
@@ -4020,7 +5127,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a worker can take to
 	// process tasks of this activity type. This default can be overridden when
@@ -4028,13 +5135,13 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// A textual description of the activity type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which this activity is to be registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the activity type within the domain.
 	//
@@ -4042,7 +5149,7 @@ type RegisterActivityTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the activity type.
 	//
@@ -4051,7 +5158,7 @@ type RegisterActivityTypeInput struct {
 	// end with whitespace. It must not contain a : (colon), / (slash), | (vertical
 	// bar), or any control characters (\u0000-\u001f | \u007f - \u009f). Also,
 	// it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterActivityTypeInput `json:"-" xml:"-"`
 }
@@ -4060,6 +5167,16 @@ type metadataRegisterActivityTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterActivityTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterActivityTypeInput) GoString() string {
+	return s.String()
+}
+
 type RegisterActivityTypeOutput struct {
 	metadataRegisterActivityTypeOutput `json:"-" xml:"-"`
 }
@@ -4068,9 +5185,19 @@ type metadataRegisterActivityTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterActivityTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterActivityTypeOutput) GoString() string {
+	return s.String()
+}
+
 type RegisterDomainInput struct {
 	// A text description of the domain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// Name of the domain to register. The name must be unique in the region that
 	// the domain is registered in.
@@ -4079,7 +5206,7 @@ type RegisterDomainInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The duration (in days) that records and histories of workflow executions
 	// on the domain should be kept by the service. After the retention period,
@@ -4092,7 +5219,7 @@ type RegisterDomainInput struct {
 	// The maximum workflow execution retention period is 90 days. For more information
 	// about Amazon SWF service limits, see: Amazon SWF Service Limits (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dg-limits.html)
 	// in the Amazon SWF Developer Guide.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataRegisterDomainInput `json:"-" xml:"-"`
 }
@@ -4101,6 +5228,16 @@ type metadataRegisterDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterDomainInput) GoString() string {
+	return s.String()
+}
+
 type RegisterDomainOutput struct {
 	metadataRegisterDomainOutput `json:"-" xml:"-"`
 }
@@ -4109,6 +5246,16 @@ type metadataRegisterDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterDomainOutput) GoString() string {
+	return s.String()
+}
+
 type RegisterWorkflowTypeInput struct {
 	// If set, specifies the default policy to use for the child workflow executions
 	// when a workflow execution of this type is terminated, by calling the TerminateWorkflowExecution
@@ -4123,7 +5270,9 @@ type RegisterWorkflowTypeInput struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// If set, specifies the default maximum duration for executions of this workflow
 	// type. You can override this default when starting an execution through the
@@ -4134,7 +5283,7 @@ type RegisterWorkflowTypeInput struct {
 	// specify a value of "NONE" for defaultExecutionStartToCloseTimeout; there
 	// is a one-year max limit on the time that a workflow execution can run. Exceeding
 	// this limit will always cause the workflow execution to time out.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling decision tasks
 	// for executions of this workflow type. This default is used only if a task
@@ -4150,7 +5299,7 @@ type RegisterWorkflowTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration of decision tasks for this
 	// workflow type. This default can be overridden when starting a workflow execution
@@ -4159,13 +5308,13 @@ type RegisterWorkflowTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Textual description of the workflow type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which to register the workflow type.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the workflow type.
 	//
@@ -4173,7 +5322,7 @@ type RegisterWorkflowTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the workflow type.
 	//
@@ -4183,7 +5332,7 @@ type RegisterWorkflowTypeInput struct {
 	// not start or end with whitespace. It must not contain a : (colon), / (slash),
 	// | (vertical bar), or any control characters (\u0000-\u001f | \u007f - \u009f).
 	// Also, it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterWorkflowTypeInput `json:"-" xml:"-"`
 }
@@ -4192,6 +5341,16 @@ type metadataRegisterWorkflowTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterWorkflowTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterWorkflowTypeInput) GoString() string {
+	return s.String()
+}
+
 type RegisterWorkflowTypeOutput struct {
 	metadataRegisterWorkflowTypeOutput `json:"-" xml:"-"`
 }
@@ -4200,6 +5359,16 @@ type metadataRegisterWorkflowTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RegisterWorkflowTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RegisterWorkflowTypeOutput) GoString() string {
+	return s.String()
+}
+
 // Provides details of the RequestCancelActivityTask decision.
 //
 // Access Control
@@ -4217,7 +5386,7 @@ type metadataRegisterWorkflowTypeOutput struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RequestCancelActivityTaskDecisionAttributes struct {
 	// The activityId of the activity task to be canceled.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelActivityTaskDecisionAttributes `json:"-" xml:"-"`
 }
@@ -4226,10 +5395,20 @@ type metadataRequestCancelActivityTaskDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestCancelActivityTaskDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestCancelActivityTaskDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the RequestCancelActivityTaskFailed event.
 type RequestCancelActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the RequestCancelActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The cause of the failure. This information is generated by the system and
 	// can be useful for diagnostic purposes.
@@ -4237,7 +5416,9 @@ type RequestCancelActivityTaskFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the RequestCancelActivityTaskFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"ACTIVITY_ID_UNKNOWN,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelActivityTask decision for this cancellation
@@ -4252,9 +5433,19 @@ type metadataRequestCancelActivityTaskFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Provides details of the RequestCancelExternalWorkflowExecution decision.
-//
-// Access Control
+// String returns the string representation
+func (s RequestCancelActivityTaskFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestCancelActivityTaskFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
+// Provides details of the RequestCancelExternalWorkflowExecution decision.
+//
+// Access Control
 //
 // You can use IAM policies to control this decision's access to Amazon SWF
 // resources as follows:
@@ -4270,13 +5461,13 @@ type metadataRequestCancelActivityTaskFailedEventAttributes struct {
 type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The workflowId of the external workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -4285,6 +5476,16 @@ type metadataRequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestCancelExternalWorkflowExecutionDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the RequestCancelExternalWorkflowExecutionFailed event.
 type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -4293,9 +5494,11 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the RequestCancelExternalWorkflowExecutionFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -4310,11 +5513,11 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow to which the cancel request was to
 	// be delivered.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -4323,11 +5526,21 @@ type metadataRequestCancelExternalWorkflowExecutionFailedEventAttributes struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestCancelExternalWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the RequestCancelExternalWorkflowExecutionInitiated event.
 type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -4336,10 +5549,10 @@ type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution to be canceled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution to be canceled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"-" xml:"-"`
 }
@@ -4348,15 +5561,25 @@ type metadataRequestCancelExternalWorkflowExecutionInitiatedEventAttributes stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GoString() string {
+	return s.String()
+}
+
 type RequestCancelWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to cancel.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The runId of the workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelWorkflowExecutionInput `json:"-" xml:"-"`
 }
@@ -4365,6 +5588,16 @@ type metadataRequestCancelWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestCancelWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestCancelWorkflowExecutionInput) GoString() string {
+	return s.String()
+}
+
 type RequestCancelWorkflowExecutionOutput struct {
 	metadataRequestCancelWorkflowExecutionOutput `json:"-" xml:"-"`
 }
@@ -4373,16 +5606,26 @@ type metadataRequestCancelWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RequestCancelWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RequestCancelWorkflowExecutionOutput) GoString() string {
+	return s.String()
+}
+
 type RespondActivityTaskCanceledInput struct {
 	// Optional. Information about the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCanceledInput `json:"-" xml:"-"`
 }
@@ -4391,6 +5634,16 @@ type metadataRespondActivityTaskCanceledInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondActivityTaskCanceledInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondActivityTaskCanceledInput) GoString() string {
+	return s.String()
+}
+
 type RespondActivityTaskCanceledOutput struct {
 	metadataRespondActivityTaskCanceledOutput `json:"-" xml:"-"`
 }
@@ -4399,17 +5652,27 @@ type metadataRespondActivityTaskCanceledOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondActivityTaskCanceledOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondActivityTaskCanceledOutput) GoString() string {
+	return s.String()
+}
+
 type RespondActivityTaskCompletedInput struct {
 	// The result of the activity task. It is a free form string that is implementation
 	// specific.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCompletedInput `json:"-" xml:"-"`
 }
@@ -4418,6 +5681,16 @@ type metadataRespondActivityTaskCompletedInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondActivityTaskCompletedInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondActivityTaskCompletedInput) GoString() string {
+	return s.String()
+}
+
 type RespondActivityTaskCompletedOutput struct {
 	metadataRespondActivityTaskCompletedOutput `json:"-" xml:"-"`
 }
@@ -4426,19 +5699,29 @@ type metadataRespondActivityTaskCompletedOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondActivityTaskCompletedOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondActivityTaskCompletedOutput) GoString() string {
+	return s.String()
+}
+
 type RespondActivityTaskFailedInput struct {
 	// Optional. Detailed information about the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Description of the error that may assist in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskFailedInput `json:"-" xml:"-"`
 }
@@ -4447,6 +5730,16 @@ type metadataRespondActivityTaskFailedInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondActivityTaskFailedInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondActivityTaskFailedInput) GoString() string {
+	return s.String()
+}
+
 type RespondActivityTaskFailedOutput struct {
 	metadataRespondActivityTaskFailedOutput `json:"-" xml:"-"`
 }
@@ -4455,20 +5748,30 @@ type metadataRespondActivityTaskFailedOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondActivityTaskFailedOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondActivityTaskFailedOutput) GoString() string {
+	return s.String()
+}
+
 type RespondDecisionTaskCompletedInput struct {
 	// The list of decisions (possibly empty) made by the decider while processing
 	// this decision task. See the docs for the Decision structure for details.
 	Decisions []*Decision `locationName:"decisions" type:"list"`
 
 	// User defined context to add to workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The taskToken from the DecisionTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondDecisionTaskCompletedInput `json:"-" xml:"-"`
 }
@@ -4477,6 +5780,16 @@ type metadataRespondDecisionTaskCompletedInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondDecisionTaskCompletedInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondDecisionTaskCompletedInput) GoString() string {
+	return s.String()
+}
+
 type RespondDecisionTaskCompletedOutput struct {
 	metadataRespondDecisionTaskCompletedOutput `json:"-" xml:"-"`
 }
@@ -4485,6 +5798,16 @@ type metadataRespondDecisionTaskCompletedOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RespondDecisionTaskCompletedOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RespondDecisionTaskCompletedOutput) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ScheduleActivityTask decision.
 //
 // Access Control
@@ -4510,14 +5833,14 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the activity task to schedule.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// If set, specifies the maximum time before which a worker processing a task
 	// of this type must report progress by calling RecordActivityTaskHeartbeat.
@@ -4528,10 +5851,10 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum duration for this activity task.
 	//
@@ -4542,7 +5865,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. If set, specifies the maximum duration the activity task can wait
 	// to be assigned to a worker. This overrides the default schedule-to-start
@@ -4555,7 +5878,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-start timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the maximum duration a worker may take to process this
 	// activity task. This overrides the default start-to-close timeout specified
@@ -4568,7 +5891,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default start-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the name of the task list in which to schedule the activity
 	// task. If not specified, the defaultTaskList registered with the activity
@@ -4592,7 +5915,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataScheduleActivityTaskDecisionAttributes `json:"-" xml:"-"`
 }
@@ -4601,10 +5924,20 @@ type metadataScheduleActivityTaskDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScheduleActivityTaskDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScheduleActivityTaskDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the ScheduleActivityTaskFailed event.
 type ScheduleActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the ScheduleActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The activity type provided in the ScheduleActivityTask decision that failed.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
@@ -4615,7 +5948,9 @@ type ScheduleActivityTaskFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the ScheduleActivityTaskFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"ACTIVITY_TYPE_DEPRECATED,ACTIVITY_TYPE_DOES_NOT_EXIST,ACTIVITY_ID_ALREADY_IN_USE,OPEN_ACTIVITIES_LIMIT_EXCEEDED,ACTIVITY_CREATION_RATE_EXCEEDED,DEFAULT_SCHEDULE_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_SCHEDULE_TO_START_TIMEOUT_UNDEFINED,DEFAULT_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_HEARTBEAT_TIMEOUT_UNDEFINED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision that
 	// resulted in the scheduling of this activity task. This information can be
@@ -4630,6 +5965,16 @@ type metadataScheduleActivityTaskFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ScheduleActivityTaskFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ScheduleActivityTaskFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the SignalExternalWorkflowExecution decision.
 //
 // Access Control
@@ -4648,21 +5993,21 @@ type metadataScheduleActivityTaskFailedEventAttributes struct {
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Optional. Input data to be provided with the signal. The target workflow
 	// execution will use the signal name and input data to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to be signaled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The name of the signal.The target workflow execution will use the
 	// signal name and input to process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The workflowId of the workflow execution to be signaled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionDecisionAttributes `json:"-" xml:"-"`
 }
@@ -4671,6 +6016,16 @@ type metadataSignalExternalWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SignalExternalWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SignalExternalWorkflowExecutionDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the SignalExternalWorkflowExecutionFailed event.
 type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -4679,9 +6034,11 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the SignalExternalWorkflowExecutionFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -4697,11 +6054,11 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 
 	// The runId of the external workflow execution that the signal was being delivered
 	// to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution that the signal was being
 	// delivered to.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -4710,11 +6067,21 @@ type metadataSignalExternalWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SignalExternalWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SignalExternalWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the SignalExternalWorkflowExecutionInitiated event.
 type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -4723,16 +6090,16 @@ type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Input provided to the signal (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to send the signal to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the external workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionInitiatedEventAttributes `json:"-" xml:"-"`
 }
@@ -4741,22 +6108,32 @@ type metadataSignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SignalExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SignalExternalWorkflowExecutionInitiatedEventAttributes) GoString() string {
+	return s.String()
+}
+
 type SignalWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to signal.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to attach to the WorkflowExecutionSignaled event in the target workflow
 	// execution's history.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to signal.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal. This name must be meaningful to the target workflow.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the workflow execution to signal.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalWorkflowExecutionInput `json:"-" xml:"-"`
 }
@@ -4765,6 +6142,16 @@ type metadataSignalWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SignalWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SignalWorkflowExecutionInput) GoString() string {
+	return s.String()
+}
+
 type SignalWorkflowExecutionOutput struct {
 	metadataSignalWorkflowExecutionOutput `json:"-" xml:"-"`
 }
@@ -4773,6 +6160,16 @@ type metadataSignalWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s SignalWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s SignalWorkflowExecutionOutput) GoString() string {
+	return s.String()
+}
+
 // Provides details of the StartChildWorkflowExecution decision.
 //
 // Access Control
@@ -4809,11 +6206,13 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the child workflow execution.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -4825,16 +6224,16 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this parameter.
 	// If neither this parameter is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input to be provided to the workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the child workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list to be used for decision tasks of the child workflow
 	// execution.
@@ -4857,7 +6256,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -4870,7 +6269,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Required. The workflowId of the workflow execution.
 	//
@@ -4878,7 +6277,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the workflow execution to be started.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -4890,6 +6289,16 @@ type metadataStartChildWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartChildWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartChildWorkflowExecutionDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the StartChildWorkflowExecutionFailed event.
 type StartChildWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -4898,9 +6307,11 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the StartChildWorkflowExecutionFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"WORKFLOW_TYPE_DOES_NOT_EXIST,WORKFLOW_TYPE_DEPRECATED,OPEN_CHILDREN_LIMIT_EXCEEDED,OPEN_WORKFLOWS_LIMIT_EXCEEDED,CHILD_CREATION_RATE_EXCEEDED,WORKFLOW_ALREADY_RUNNING,DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_CHILD_POLICY_UNDEFINED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -4915,7 +6326,7 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type provided in the StartChildWorkflowExecution Decision that
 	// failed.
@@ -4928,6 +6339,16 @@ type metadataStartChildWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartChildWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartChildWorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the StartChildWorkflowExecutionInitiated event.
 type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// The policy to use for the child workflow executions if this execution gets
@@ -4941,11 +6362,13 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -4958,13 +6381,13 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The inputs provided to the child workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associated with the child workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list used for the decision tasks of the child workflow
 	// execution.
@@ -4978,16 +6401,16 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for the decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the child workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -4999,6 +6422,16 @@ type metadataStartChildWorkflowExecutionInitiatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartChildWorkflowExecutionInitiatedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the StartTimer decision.
 //
 // Access Control
@@ -5017,13 +6450,13 @@ type metadataStartChildWorkflowExecutionInitiatedEventAttributes struct {
 type StartTimerDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Required. The duration to wait before firing the timer.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// Required. The unique Id of the timer.
 	//
@@ -5031,7 +6464,7 @@ type StartTimerDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerDecisionAttributes `json:"-" xml:"-"`
 }
@@ -5040,6 +6473,16 @@ type metadataStartTimerDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartTimerDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartTimerDecisionAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the StartTimerFailed event.
 type StartTimerFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -5048,7 +6491,9 @@ type StartTimerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	//
+	// See the StartTimerFailedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"TIMER_ID_ALREADY_IN_USE,OPEN_TIMERS_LIMIT_EXCEEDED,TIMER_CREATION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartTimer decision for this activity task. This information
@@ -5057,7 +6502,7 @@ type StartTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the StartTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -5066,6 +6511,16 @@ type metadataStartTimerFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartTimerFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartTimerFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 type StartWorkflowExecutionInput struct {
 	// If set, specifies the policy to use for the child workflow executions of
 	// this workflow execution if it is terminated, by calling the TerminateWorkflowExecution
@@ -5083,10 +6538,12 @@ type StartWorkflowExecutionInput struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// The name of the domain in which the workflow execution is created.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -5101,18 +6558,18 @@ type StartWorkflowExecutionInput struct {
 	// parameter or as a default when the workflow type is registered. If neither
 	// this parameter nor a default execution start-to-close timeout is specified,
 	// a fault is returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input for the workflow execution. This is a free form string which should
 	// be meaningful to the workflow you are starting. This input is made available
 	// to the new workflow execution in the WorkflowExecutionStarted history event.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the workflow execution. You can specify
 	// a maximum of 5 tags. You can list workflow executions with a specific tag
 	// by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions and
 	// specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The task list to use for the decision tasks generated for this workflow execution.
 	// This overrides the defaultTaskList specified when registering the workflow
@@ -5137,7 +6594,7 @@ type StartWorkflowExecutionInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -5150,7 +6607,7 @@ type StartWorkflowExecutionInput struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The user defined identifier associated with the workflow execution. You can
 	// use this to associate a custom identifier with the workflow execution. You
@@ -5162,7 +6619,7 @@ type StartWorkflowExecutionInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the workflow to start.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5174,11 +6631,21 @@ type metadataStartWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartWorkflowExecutionInput) GoString() string {
+	return s.String()
+}
+
 // Specifies the runId of a workflow execution.
 type StartWorkflowExecutionOutput struct {
 	// The runId of a workflow execution. This Id is generated by the service and
 	// can be used to uniquely identify the workflow execution within a domain.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64"`
 
 	metadataStartWorkflowExecutionOutput `json:"-" xml:"-"`
 }
@@ -5187,11 +6654,21 @@ type metadataStartWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s StartWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s StartWorkflowExecutionOutput) GoString() string {
+	return s.String()
+}
+
 // Used to filter the workflow executions in visibility APIs based on a tag.
 type TagFilter struct {
 	// Required. Specifies the tag that must be associated with the execution for
 	// it to meet the filter criteria.
-	Tag *string `locationName:"tag" type:"string" required:"true"`
+	Tag *string `locationName:"tag" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTagFilter `json:"-" xml:"-"`
 }
@@ -5200,10 +6677,20 @@ type metadataTagFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TagFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TagFilter) GoString() string {
+	return s.String()
+}
+
 // Represents a task list.
 type TaskList struct {
 	// The name of the task list.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTaskList `json:"-" xml:"-"`
 }
@@ -5212,6 +6699,16 @@ type metadataTaskList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TaskList) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TaskList) GoString() string {
+	return s.String()
+}
+
 type TerminateWorkflowExecutionInput struct {
 	// If set, specifies the policy to use for the child workflow executions of
 	// the workflow execution being terminated. This policy overrides the child
@@ -5229,22 +6726,24 @@ type TerminateWorkflowExecutionInput struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. Details for terminating the workflow execution.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The domain of the workflow execution to terminate.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Optional. A descriptive reason for terminating the workflow execution.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The runId of the workflow execution to terminate.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to terminate.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTerminateWorkflowExecutionInput `json:"-" xml:"-"`
 }
@@ -5253,6 +6752,16 @@ type metadataTerminateWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateWorkflowExecutionInput) GoString() string {
+	return s.String()
+}
+
 type TerminateWorkflowExecutionOutput struct {
 	metadataTerminateWorkflowExecutionOutput `json:"-" xml:"-"`
 }
@@ -5261,6 +6770,16 @@ type metadataTerminateWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateWorkflowExecutionOutput) GoString() string {
+	return s.String()
+}
+
 // Provides details of the TimerCanceled event.
 type TimerCanceledEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5275,7 +6794,7 @@ type TimerCanceledEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that was canceled.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerCanceledEventAttributes `json:"-" xml:"-"`
 }
@@ -5284,6 +6803,16 @@ type metadataTimerCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TimerCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TimerCanceledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the TimerFired event.
 type TimerFiredEventAttributes struct {
 	// The id of the TimerStarted event that was recorded when this timer was started.
@@ -5292,7 +6821,7 @@ type TimerFiredEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that fired.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerFiredEventAttributes `json:"-" xml:"-"`
 }
@@ -5301,11 +6830,21 @@ type metadataTimerFiredEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TimerFiredEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TimerFiredEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the TimerStarted event.
 type TimerStartedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartTimer decision for this activity task. This information
@@ -5317,10 +6856,10 @@ type TimerStartedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The unique Id of the timer that was started.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerStartedEventAttributes `json:"-" xml:"-"`
 }
@@ -5329,13 +6868,23 @@ type metadataTimerStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TimerStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TimerStartedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Represents a workflow execution.
 type WorkflowExecution struct {
 	// A system-generated unique identifier for the workflow execution.
-	RunID *string `locationName:"runId" type:"string" required:"true"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The user defined identifier associated with the workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecution `json:"-" xml:"-"`
 }
@@ -5344,12 +6893,24 @@ type metadataWorkflowExecution struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecution) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecution) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionCancelRequested event.
 type WorkflowExecutionCancelRequestedEventAttributes struct {
 	// If set, indicates that the request to cancel the workflow execution was automatically
 	// generated, and specifies the cause. This happens if the parent workflow execution
 	// times out or is terminated, and the child policy is set to cancel child executions.
-	Cause *string `locationName:"cause" type:"string"`
+	//
+	// See the WorkflowExecutionCancelRequestedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"CHILD_POLICY_APPLIED"`
 
 	// The id of the RequestCancelExternalWorkflowExecutionInitiated event corresponding
 	// to the RequestCancelExternalWorkflowExecution decision to cancel this workflow
@@ -5368,6 +6929,16 @@ type metadataWorkflowExecutionCancelRequestedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionCancelRequestedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionCancelRequestedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionCanceled event.
 type WorkflowExecutionCanceledEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5377,7 +6948,7 @@ type WorkflowExecutionCanceledEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details for the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCanceledEventAttributes `json:"-" xml:"-"`
 }
@@ -5386,6 +6957,16 @@ type metadataWorkflowExecutionCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionCanceledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionCompleted event.
 type WorkflowExecutionCompletedEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5395,7 +6976,7 @@ type WorkflowExecutionCompletedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The result produced by the workflow execution upon successful completion.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCompletedEventAttributes `json:"-" xml:"-"`
 }
@@ -5404,6 +6985,16 @@ type metadataWorkflowExecutionCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionCompletedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // The configuration settings for a workflow execution including timeout values,
 // tasklist etc. These configuration settings are determined from the defaults
 // specified when registering the workflow type and those specified when starting
@@ -5420,13 +7011,15 @@ type WorkflowExecutionConfiguration struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The total duration for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" required:"true"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The task list used for the decision tasks generated for this workflow execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -5438,13 +7031,13 @@ type WorkflowExecutionConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" required:"true"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataWorkflowExecutionConfiguration `json:"-" xml:"-"`
 }
@@ -5453,6 +7046,16 @@ type metadataWorkflowExecutionConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionConfiguration) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionContinuedAsNew event.
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	// The policy to use for the child workflow executions of the new execution
@@ -5466,7 +7069,9 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the ContinueAsNewWorkflowExecution decision that started
@@ -5478,27 +7083,27 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the new workflow execution.
-	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" required:"true"`
+	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The list of tags associated with the new workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for the new workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Represents a workflow type.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5510,11 +7115,21 @@ type metadataWorkflowExecutionContinuedAsNewEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionContinuedAsNewEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionContinuedAsNewEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Contains the count of workflow executions returned from CountOpenWorkflowExecutions
 // or CountClosedWorkflowExecutions
 type WorkflowExecutionCount struct {
 	// The number of workflow executions.
-	Count *int64 `locationName:"count" type:"integer" required:"true"`
+	Count *int64 `locationName:"count" type:"integer" min:"0" required:"true"`
 
 	// If set to true, indicates that the actual count was more than the maximum
 	// supported by this API and the count returned is the truncated value.
@@ -5527,6 +7142,16 @@ type metadataWorkflowExecutionCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionCount) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionCount) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionFailed event.
 type WorkflowExecutionFailedEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5536,10 +7161,10 @@ type WorkflowExecutionFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The descriptive reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionFailedEventAttributes `json:"-" xml:"-"`
 }
@@ -5548,10 +7173,20 @@ type metadataWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionFailedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Used to filter the workflow executions in visibility APIs by their workflowId.
 type WorkflowExecutionFilter struct {
 	// The workflowId to pass of match the criteria of this filter.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionFilter `json:"-" xml:"-"`
 }
@@ -5560,6 +7195,16 @@ type metadataWorkflowExecutionFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionFilter) GoString() string {
+	return s.String()
+}
+
 // Contains information about a workflow execution.
 type WorkflowExecutionInfo struct {
 	// Set to true if a cancellation is requested for this workflow execution.
@@ -5575,7 +7220,9 @@ type WorkflowExecutionInfo struct {
 	// not complete in the alloted time and was automatically timed out.  CONTINUED_AS_NEW:
 	// the execution is logically continued. This means the current execution was
 	// completed and a new execution was started to carry on the workflow.
-	CloseStatus *string `locationName:"closeStatus" type:"string"`
+	//
+	// See the CloseStatus* constants for valid values.
+	CloseStatus *string `locationName:"closeStatus" type:"string" enum:"COMPLETED,FAILED,CANCELED,TERMINATED,CONTINUED_AS_NEW,TIMED_OUT"`
 
 	// The time when the workflow execution was closed. Set only if the execution
 	// status is CLOSED.
@@ -5585,7 +7232,9 @@ type WorkflowExecutionInfo struct {
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
 
 	// The current status of the execution.
-	ExecutionStatus *string `locationName:"executionStatus" type:"string" required:"true"`
+	//
+	// See the ExecutionStatus* constants for valid values.
+	ExecutionStatus *string `locationName:"executionStatus" type:"string" enum:"OPEN,CLOSED" required:"true"`
 
 	// If this workflow execution is a child of another execution then contains
 	// the workflow execution that started this execution.
@@ -5597,7 +7246,7 @@ type WorkflowExecutionInfo struct {
 	// The list of tags associated with the workflow execution. Tags can be used
 	// to identify and list workflow executions of interest through the visibility
 	// APIs. A workflow execution can have a maximum of 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The type of the workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5609,6 +7258,16 @@ type metadataWorkflowExecutionInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionInfo) GoString() string {
+	return s.String()
+}
+
 // Contains a paginated list of information about workflow executions.
 type WorkflowExecutionInfos struct {
 	// The list of workflow information structures.
@@ -5620,7 +7279,7 @@ type WorkflowExecutionInfos struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataWorkflowExecutionInfos `json:"-" xml:"-"`
 }
@@ -5629,22 +7288,32 @@ type metadataWorkflowExecutionInfos struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionInfos) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionInfos) GoString() string {
+	return s.String()
+}
+
 // Contains the counts of open tasks, child workflow executions and timers for
 // a workflow execution.
 type WorkflowExecutionOpenCounts struct {
 	// The count of activity tasks whose status is OPEN.
-	OpenActivityTasks *int64 `locationName:"openActivityTasks" type:"integer" required:"true"`
+	OpenActivityTasks *int64 `locationName:"openActivityTasks" type:"integer" min:"0" required:"true"`
 
 	// The count of child workflow executions whose status is OPEN.
-	OpenChildWorkflowExecutions *int64 `locationName:"openChildWorkflowExecutions" type:"integer" required:"true"`
+	OpenChildWorkflowExecutions *int64 `locationName:"openChildWorkflowExecutions" type:"integer" min:"0" required:"true"`
 
 	// The count of decision tasks whose status is OPEN. A workflow execution can
 	// have at most one open decision task.
-	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" required:"true"`
+	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" min:"0" max:"1" required:"true"`
 
 	// The count of timers started by this workflow execution that have not fired
 	// yet.
-	OpenTimers *int64 `locationName:"openTimers" type:"integer" required:"true"`
+	OpenTimers *int64 `locationName:"openTimers" type:"integer" min:"0" required:"true"`
 
 	metadataWorkflowExecutionOpenCounts `json:"-" xml:"-"`
 }
@@ -5653,6 +7322,16 @@ type metadataWorkflowExecutionOpenCounts struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionOpenCounts) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionOpenCounts) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionSignaled event.
 type WorkflowExecutionSignaledEventAttributes struct {
 	// The id of the SignalExternalWorkflowExecutionInitiated event corresponding
@@ -5669,11 +7348,11 @@ type WorkflowExecutionSignaledEventAttributes struct {
 
 	// Inputs provided with the signal (if any). The decider can use the signal
 	// name and inputs to determine how to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The name of the signal received. The decider can use the signal name and
 	// inputs to determine how to the process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionSignaledEventAttributes `json:"-" xml:"-"`
 }
@@ -5682,6 +7361,16 @@ type metadataWorkflowExecutionSignaledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionSignaledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionSignaledEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of WorkflowExecutionStarted event.
 type WorkflowExecutionStartedEventAttributes struct {
 	// The policy to use for the child workflow executions if this workflow execution
@@ -5695,21 +7384,23 @@ type WorkflowExecutionStartedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// If this workflow execution was started due to a ContinueAsNewWorkflowExecution
 	// decision, then it contains the runId of the previous workflow execution that
 	// was closed and continued as this execution.
-	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string"`
+	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string" max:"64"`
 
 	// The maximum duration for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this workflow execution.
@@ -5724,19 +7415,19 @@ type WorkflowExecutionStartedEventAttributes struct {
 
 	// The list of tags associated with this workflow execution. An execution can
 	// have up to 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list for scheduling the decision tasks for this workflow
 	// execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for this workflow type.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflow type of this execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5748,12 +7439,24 @@ type metadataWorkflowExecutionStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionStartedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionTerminated event.
 type WorkflowExecutionTerminatedEventAttributes struct {
 	// If set, indicates that the workflow execution was automatically terminated,
 	// and specifies the cause. This happens if the parent workflow execution times
 	// out or is terminated and the child policy is set to terminate child executions.
-	Cause *string `locationName:"cause" type:"string"`
+	//
+	// See the WorkflowExecutionTerminatedCause* constants for valid values.
+	Cause *string `locationName:"cause" type:"string" enum:"CHILD_POLICY_APPLIED,EVENT_LIMIT_EXCEEDED,OPERATOR_INITIATED"`
 
 	// The policy used for the child workflow executions of this workflow execution.
 	//
@@ -5764,13 +7467,15 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The details provided for the termination (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the termination (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionTerminatedEventAttributes `json:"-" xml:"-"`
 }
@@ -5779,6 +7484,16 @@ type metadataWorkflowExecutionTerminatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionTerminatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionTerminatedEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Provides details of the WorkflowExecutionTimedOut event.
 type WorkflowExecutionTimedOutEventAttributes struct {
 	// The policy used for the child workflow executions of this workflow execution.
@@ -5790,10 +7505,14 @@ type WorkflowExecutionTimedOutEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The type of timeout that caused this event.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	//
+	// See the WorkflowExecutionTimeoutType* constants for valid values.
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	metadataWorkflowExecutionTimedOutEventAttributes `json:"-" xml:"-"`
 }
@@ -5802,19 +7521,29 @@ type metadataWorkflowExecutionTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowExecutionTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowExecutionTimedOutEventAttributes) GoString() string {
+	return s.String()
+}
+
 // Represents a workflow type.
 type WorkflowType struct {
 	// Required. The name of the workflow type.
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The version of the workflow type.
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataWorkflowType `json:"-" xml:"-"`
 }
@@ -5823,6 +7552,16 @@ type metadataWorkflowType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowType) GoString() string {
+	return s.String()
+}
+
 // The configuration settings of a workflow type.
 type WorkflowTypeConfiguration struct {
 	// Optional. The default policy to use for the child workflow executions when
@@ -5838,7 +7577,9 @@ type WorkflowTypeConfiguration struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string"`
+	//
+	// See the ChildPolicy* constants for valid values.
+	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. The default maximum duration, specified when registering the workflow
 	// type, for executions of this workflow type. This default can be overridden
@@ -5847,7 +7588,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list, specified when registering the workflow
 	// type, for decisions tasks scheduled for workflow executions of this type.
@@ -5866,7 +7607,7 @@ type WorkflowTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the workflow
 	// type, that a decision task for executions of this workflow type might take
@@ -5878,7 +7619,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataWorkflowTypeConfiguration `json:"-" xml:"-"`
 }
@@ -5887,14 +7628,24 @@ type metadataWorkflowTypeConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowTypeConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowTypeConfiguration) GoString() string {
+	return s.String()
+}
+
 // Used to filter workflow execution query results by type. Each parameter,
 // if specified, defines a rule that must be satisfied by each returned result.
 type WorkflowTypeFilter struct {
 	// Required. Name of the workflow type.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Version of the workflow type.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" max:"64"`
 
 	metadataWorkflowTypeFilter `json:"-" xml:"-"`
 }
@@ -5903,6 +7654,16 @@ type metadataWorkflowTypeFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkflowTypeFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowTypeFilter) GoString() string {
+	return s.String()
+}
+
 // Contains information about a workflow type.
 type WorkflowTypeInfo struct {
 	// The date when this type was registered.
@@ -5913,10 +7674,12 @@ type WorkflowTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the type registered through RegisterWorkflowType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the workflow type.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	//
+	// See the RegistrationStatus* constants for valid values.
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// The workflow type this information is about.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5927,3 +7690,350 @@ type WorkflowTypeInfo struct {
 type metadataWorkflowTypeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s WorkflowTypeInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkflowTypeInfo) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum ActivityTaskTimeoutType
+	ActivityTaskTimeoutTypeStartToClose = "START_TO_CLOSE"
+	// @enum ActivityTaskTimeoutType
+	ActivityTaskTimeoutTypeScheduleToStart = "SCHEDULE_TO_START"
+	// @enum ActivityTaskTimeoutType
+	ActivityTaskTimeoutTypeScheduleToClose = "SCHEDULE_TO_CLOSE"
+	// @enum ActivityTaskTimeoutType
+	ActivityTaskTimeoutTypeHeartbeat = "HEARTBEAT"
+)
+
+const (
+	// @enum CancelTimerFailedCause
+	CancelTimerFailedCauseTimerIdUnknown = "TIMER_ID_UNKNOWN"
+	// @enum CancelTimerFailedCause
+	CancelTimerFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum CancelWorkflowExecutionFailedCause
+	CancelWorkflowExecutionFailedCauseUnhandledDecision = "UNHANDLED_DECISION"
+	// @enum CancelWorkflowExecutionFailedCause
+	CancelWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum ChildPolicy
+	ChildPolicyTerminate = "TERMINATE"
+	// @enum ChildPolicy
+	ChildPolicyRequestCancel = "REQUEST_CANCEL"
+	// @enum ChildPolicy
+	ChildPolicyAbandon = "ABANDON"
+)
+
+const (
+	// @enum CloseStatus
+	CloseStatusCompleted = "COMPLETED"
+	// @enum CloseStatus
+	CloseStatusFailed = "FAILED"
+	// @enum CloseStatus
+	CloseStatusCanceled = "CANCELED"
+	// @enum CloseStatus
+	CloseStatusTerminated = "TERMINATED"
+	// @enum CloseStatus
+	CloseStatusContinuedAsNew = "CONTINUED_AS_NEW"
+	// @enum CloseStatus
+	CloseStatusTimedOut = "TIMED_OUT"
+)
+
+const (
+	// @enum CompleteWorkflowExecutionFailedCause
+	CompleteWorkflowExecutionFailedCauseUnhandledDecision = "UNHANDLED_DECISION"
+	// @enum CompleteWorkflowExecutionFailedCause
+	CompleteWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseUnhandledDecision = "UNHANDLED_DECISION"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseWorkflowTypeDeprecated = "WORKFLOW_TYPE_DEPRECATED"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseWorkflowTypeDoesNotExist = "WORKFLOW_TYPE_DOES_NOT_EXIST"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultExecutionStartToCloseTimeoutUndefined = "DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultTaskStartToCloseTimeoutUndefined = "DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultTaskListUndefined = "DEFAULT_TASK_LIST_UNDEFINED"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseDefaultChildPolicyUndefined = "DEFAULT_CHILD_POLICY_UNDEFINED"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseContinueAsNewWorkflowExecutionRateExceeded = "CONTINUE_AS_NEW_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	// @enum ContinueAsNewWorkflowExecutionFailedCause
+	ContinueAsNewWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum DecisionTaskTimeoutType
+	DecisionTaskTimeoutTypeStartToClose = "START_TO_CLOSE"
+)
+
+const (
+	// @enum DecisionType
+	DecisionTypeScheduleActivityTask = "ScheduleActivityTask"
+	// @enum DecisionType
+	DecisionTypeRequestCancelActivityTask = "RequestCancelActivityTask"
+	// @enum DecisionType
+	DecisionTypeCompleteWorkflowExecution = "CompleteWorkflowExecution"
+	// @enum DecisionType
+	DecisionTypeFailWorkflowExecution = "FailWorkflowExecution"
+	// @enum DecisionType
+	DecisionTypeCancelWorkflowExecution = "CancelWorkflowExecution"
+	// @enum DecisionType
+	DecisionTypeContinueAsNewWorkflowExecution = "ContinueAsNewWorkflowExecution"
+	// @enum DecisionType
+	DecisionTypeRecordMarker = "RecordMarker"
+	// @enum DecisionType
+	DecisionTypeStartTimer = "StartTimer"
+	// @enum DecisionType
+	DecisionTypeCancelTimer = "CancelTimer"
+	// @enum DecisionType
+	DecisionTypeSignalExternalWorkflowExecution = "SignalExternalWorkflowExecution"
+	// @enum DecisionType
+	DecisionTypeRequestCancelExternalWorkflowExecution = "RequestCancelExternalWorkflowExecution"
+	// @enum DecisionType
+	DecisionTypeStartChildWorkflowExecution = "StartChildWorkflowExecution"
+)
+
+const (
+	// @enum EventType
+	EventTypeWorkflowExecutionStarted = "WorkflowExecutionStarted"
+	// @enum EventType
+	EventTypeWorkflowExecutionCancelRequested = "WorkflowExecutionCancelRequested"
+	// @enum EventType
+	EventTypeWorkflowExecutionCompleted = "WorkflowExecutionCompleted"
+	// @enum EventType
+	EventTypeCompleteWorkflowExecutionFailed = "CompleteWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeWorkflowExecutionFailed = "WorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeFailWorkflowExecutionFailed = "FailWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeWorkflowExecutionTimedOut = "WorkflowExecutionTimedOut"
+	// @enum EventType
+	EventTypeWorkflowExecutionCanceled = "WorkflowExecutionCanceled"
+	// @enum EventType
+	EventTypeCancelWorkflowExecutionFailed = "CancelWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeWorkflowExecutionContinuedAsNew = "WorkflowExecutionContinuedAsNew"
+	// @enum EventType
+	EventTypeContinueAsNewWorkflowExecutionFailed = "ContinueAsNewWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeWorkflowExecutionTerminated = "WorkflowExecutionTerminated"
+	// @enum EventType
+	EventTypeDecisionTaskScheduled = "DecisionTaskScheduled"
+	// @enum EventType
+	EventTypeDecisionTaskStarted = "DecisionTaskStarted"
+	// @enum EventType
+	EventTypeDecisionTaskCompleted = "DecisionTaskCompleted"
+	// @enum EventType
+	EventTypeDecisionTaskTimedOut = "DecisionTaskTimedOut"
+	// @enum EventType
+	EventTypeActivityTaskScheduled = "ActivityTaskScheduled"
+	// @enum EventType
+	EventTypeScheduleActivityTaskFailed = "ScheduleActivityTaskFailed"
+	// @enum EventType
+	EventTypeActivityTaskStarted = "ActivityTaskStarted"
+	// @enum EventType
+	EventTypeActivityTaskCompleted = "ActivityTaskCompleted"
+	// @enum EventType
+	EventTypeActivityTaskFailed = "ActivityTaskFailed"
+	// @enum EventType
+	EventTypeActivityTaskTimedOut = "ActivityTaskTimedOut"
+	// @enum EventType
+	EventTypeActivityTaskCanceled = "ActivityTaskCanceled"
+	// @enum EventType
+	EventTypeActivityTaskCancelRequested = "ActivityTaskCancelRequested"
+	// @enum EventType
+	EventTypeRequestCancelActivityTaskFailed = "RequestCancelActivityTaskFailed"
+	// @enum EventType
+	EventTypeWorkflowExecutionSignaled = "WorkflowExecutionSignaled"
+	// @enum EventType
+	EventTypeMarkerRecorded = "MarkerRecorded"
+	// @enum EventType
+	EventTypeRecordMarkerFailed = "RecordMarkerFailed"
+	// @enum EventType
+	EventTypeTimerStarted = "TimerStarted"
+	// @enum EventType
+	EventTypeStartTimerFailed = "StartTimerFailed"
+	// @enum EventType
+	EventTypeTimerFired = "TimerFired"
+	// @enum EventType
+	EventTypeTimerCanceled = "TimerCanceled"
+	// @enum EventType
+	EventTypeCancelTimerFailed = "CancelTimerFailed"
+	// @enum EventType
+	EventTypeStartChildWorkflowExecutionInitiated = "StartChildWorkflowExecutionInitiated"
+	// @enum EventType
+	EventTypeStartChildWorkflowExecutionFailed = "StartChildWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeChildWorkflowExecutionStarted = "ChildWorkflowExecutionStarted"
+	// @enum EventType
+	EventTypeChildWorkflowExecutionCompleted = "ChildWorkflowExecutionCompleted"
+	// @enum EventType
+	EventTypeChildWorkflowExecutionFailed = "ChildWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeChildWorkflowExecutionTimedOut = "ChildWorkflowExecutionTimedOut"
+	// @enum EventType
+	EventTypeChildWorkflowExecutionCanceled = "ChildWorkflowExecutionCanceled"
+	// @enum EventType
+	EventTypeChildWorkflowExecutionTerminated = "ChildWorkflowExecutionTerminated"
+	// @enum EventType
+	EventTypeSignalExternalWorkflowExecutionInitiated = "SignalExternalWorkflowExecutionInitiated"
+	// @enum EventType
+	EventTypeSignalExternalWorkflowExecutionFailed = "SignalExternalWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeExternalWorkflowExecutionSignaled = "ExternalWorkflowExecutionSignaled"
+	// @enum EventType
+	EventTypeRequestCancelExternalWorkflowExecutionInitiated = "RequestCancelExternalWorkflowExecutionInitiated"
+	// @enum EventType
+	EventTypeRequestCancelExternalWorkflowExecutionFailed = "RequestCancelExternalWorkflowExecutionFailed"
+	// @enum EventType
+	EventTypeExternalWorkflowExecutionCancelRequested = "ExternalWorkflowExecutionCancelRequested"
+)
+
+const (
+	// @enum ExecutionStatus
+	ExecutionStatusOpen = "OPEN"
+	// @enum ExecutionStatus
+	ExecutionStatusClosed = "CLOSED"
+)
+
+const (
+	// @enum FailWorkflowExecutionFailedCause
+	FailWorkflowExecutionFailedCauseUnhandledDecision = "UNHANDLED_DECISION"
+	// @enum FailWorkflowExecutionFailedCause
+	FailWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum RecordMarkerFailedCause
+	RecordMarkerFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum RegistrationStatus
+	RegistrationStatusRegistered = "REGISTERED"
+	// @enum RegistrationStatus
+	RegistrationStatusDeprecated = "DEPRECATED"
+)
+
+const (
+	// @enum RequestCancelActivityTaskFailedCause
+	RequestCancelActivityTaskFailedCauseActivityIdUnknown = "ACTIVITY_ID_UNKNOWN"
+	// @enum RequestCancelActivityTaskFailedCause
+	RequestCancelActivityTaskFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum RequestCancelExternalWorkflowExecutionFailedCause
+	RequestCancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution = "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	// @enum RequestCancelExternalWorkflowExecutionFailedCause
+	RequestCancelExternalWorkflowExecutionFailedCauseRequestCancelExternalWorkflowExecutionRateExceeded = "REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	// @enum RequestCancelExternalWorkflowExecutionFailedCause
+	RequestCancelExternalWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseActivityTypeDeprecated = "ACTIVITY_TYPE_DEPRECATED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseActivityTypeDoesNotExist = "ACTIVITY_TYPE_DOES_NOT_EXIST"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseActivityIdAlreadyInUse = "ACTIVITY_ID_ALREADY_IN_USE"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseOpenActivitiesLimitExceeded = "OPEN_ACTIVITIES_LIMIT_EXCEEDED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseActivityCreationRateExceeded = "ACTIVITY_CREATION_RATE_EXCEEDED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseDefaultScheduleToCloseTimeoutUndefined = "DEFAULT_SCHEDULE_TO_CLOSE_TIMEOUT_UNDEFINED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseDefaultTaskListUndefined = "DEFAULT_TASK_LIST_UNDEFINED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseDefaultScheduleToStartTimeoutUndefined = "DEFAULT_SCHEDULE_TO_START_TIMEOUT_UNDEFINED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseDefaultStartToCloseTimeoutUndefined = "DEFAULT_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseDefaultHeartbeatTimeoutUndefined = "DEFAULT_HEARTBEAT_TIMEOUT_UNDEFINED"
+	// @enum ScheduleActivityTaskFailedCause
+	ScheduleActivityTaskFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum SignalExternalWorkflowExecutionFailedCause
+	SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution = "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	// @enum SignalExternalWorkflowExecutionFailedCause
+	SignalExternalWorkflowExecutionFailedCauseSignalExternalWorkflowExecutionRateExceeded = "SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	// @enum SignalExternalWorkflowExecutionFailedCause
+	SignalExternalWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseWorkflowTypeDoesNotExist = "WORKFLOW_TYPE_DOES_NOT_EXIST"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseWorkflowTypeDeprecated = "WORKFLOW_TYPE_DEPRECATED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseOpenChildrenLimitExceeded = "OPEN_CHILDREN_LIMIT_EXCEEDED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded = "OPEN_WORKFLOWS_LIMIT_EXCEEDED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseChildCreationRateExceeded = "CHILD_CREATION_RATE_EXCEEDED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning = "WORKFLOW_ALREADY_RUNNING"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseDefaultExecutionStartToCloseTimeoutUndefined = "DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseDefaultTaskListUndefined = "DEFAULT_TASK_LIST_UNDEFINED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseDefaultTaskStartToCloseTimeoutUndefined = "DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseDefaultChildPolicyUndefined = "DEFAULT_CHILD_POLICY_UNDEFINED"
+	// @enum StartChildWorkflowExecutionFailedCause
+	StartChildWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum StartTimerFailedCause
+	StartTimerFailedCauseTimerIdAlreadyInUse = "TIMER_ID_ALREADY_IN_USE"
+	// @enum StartTimerFailedCause
+	StartTimerFailedCauseOpenTimersLimitExceeded = "OPEN_TIMERS_LIMIT_EXCEEDED"
+	// @enum StartTimerFailedCause
+	StartTimerFailedCauseTimerCreationRateExceeded = "TIMER_CREATION_RATE_EXCEEDED"
+	// @enum StartTimerFailedCause
+	StartTimerFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
+)
+
+const (
+	// @enum WorkflowExecutionCancelRequestedCause
+	WorkflowExecutionCancelRequestedCauseChildPolicyApplied = "CHILD_POLICY_APPLIED"
+)
+
+const (
+	// @enum WorkflowExecutionTerminatedCause
+	WorkflowExecutionTerminatedCauseChildPolicyApplied = "CHILD_POLICY_APPLIED"
+	// @enum WorkflowExecutionTerminatedCause
+	WorkflowExecutionTerminatedCauseEventLimitExceeded = "EVENT_LIMIT_EXCEEDED"
+	// @enum WorkflowExecutionTerminatedCause
+	WorkflowExecutionTerminatedCauseOperatorInitiated = "OPERATOR_INITIATED"
+)
+
+const (
+	// @enum WorkflowExecutionTimeoutType
+	WorkflowExecutionTimeoutTypeStartToClose = "START_TO_CLOSE"
+)
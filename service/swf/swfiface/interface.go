@@ -4,6 +4,7 @@
 package swfiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/swf"
 )
 
@@ -11,63 +12,125 @@ import (
 type SWFAPI interface {
 	CountClosedWorkflowExecutions(*swf.CountClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
 
+	CountClosedWorkflowExecutionsWithContext(aws.Context, *swf.CountClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
+
 	CountOpenWorkflowExecutions(*swf.CountOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
 
+	CountOpenWorkflowExecutionsWithContext(aws.Context, *swf.CountOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionCount, error)
+
 	CountPendingActivityTasks(*swf.CountPendingActivityTasksInput) (*swf.PendingTaskCount, error)
 
+	CountPendingActivityTasksWithContext(aws.Context, *swf.CountPendingActivityTasksInput) (*swf.PendingTaskCount, error)
+
 	CountPendingDecisionTasks(*swf.CountPendingDecisionTasksInput) (*swf.PendingTaskCount, error)
 
+	CountPendingDecisionTasksWithContext(aws.Context, *swf.CountPendingDecisionTasksInput) (*swf.PendingTaskCount, error)
+
 	DeprecateActivityType(*swf.DeprecateActivityTypeInput) (*swf.DeprecateActivityTypeOutput, error)
 
+	DeprecateActivityTypeWithContext(aws.Context, *swf.DeprecateActivityTypeInput) (*swf.DeprecateActivityTypeOutput, error)
+
 	DeprecateDomain(*swf.DeprecateDomainInput) (*swf.DeprecateDomainOutput, error)
 
+	DeprecateDomainWithContext(aws.Context, *swf.DeprecateDomainInput) (*swf.DeprecateDomainOutput, error)
+
 	DeprecateWorkflowType(*swf.DeprecateWorkflowTypeInput) (*swf.DeprecateWorkflowTypeOutput, error)
 
+	DeprecateWorkflowTypeWithContext(aws.Context, *swf.DeprecateWorkflowTypeInput) (*swf.DeprecateWorkflowTypeOutput, error)
+
 	DescribeActivityType(*swf.DescribeActivityTypeInput) (*swf.DescribeActivityTypeOutput, error)
 
+	DescribeActivityTypeWithContext(aws.Context, *swf.DescribeActivityTypeInput) (*swf.DescribeActivityTypeOutput, error)
+
 	DescribeDomain(*swf.DescribeDomainInput) (*swf.DescribeDomainOutput, error)
 
+	DescribeDomainWithContext(aws.Context, *swf.DescribeDomainInput) (*swf.DescribeDomainOutput, error)
+
 	DescribeWorkflowExecution(*swf.DescribeWorkflowExecutionInput) (*swf.DescribeWorkflowExecutionOutput, error)
 
+	DescribeWorkflowExecutionWithContext(aws.Context, *swf.DescribeWorkflowExecutionInput) (*swf.DescribeWorkflowExecutionOutput, error)
+
 	DescribeWorkflowType(*swf.DescribeWorkflowTypeInput) (*swf.DescribeWorkflowTypeOutput, error)
 
+	DescribeWorkflowTypeWithContext(aws.Context, *swf.DescribeWorkflowTypeInput) (*swf.DescribeWorkflowTypeOutput, error)
+
 	GetWorkflowExecutionHistory(*swf.GetWorkflowExecutionHistoryInput) (*swf.GetWorkflowExecutionHistoryOutput, error)
 
+	GetWorkflowExecutionHistoryWithContext(aws.Context, *swf.GetWorkflowExecutionHistoryInput) (*swf.GetWorkflowExecutionHistoryOutput, error)
+
 	ListActivityTypes(*swf.ListActivityTypesInput) (*swf.ListActivityTypesOutput, error)
 
+	ListActivityTypesWithContext(aws.Context, *swf.ListActivityTypesInput) (*swf.ListActivityTypesOutput, error)
+
 	ListClosedWorkflowExecutions(*swf.ListClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
 
+	ListClosedWorkflowExecutionsWithContext(aws.Context, *swf.ListClosedWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
+
 	ListDomains(*swf.ListDomainsInput) (*swf.ListDomainsOutput, error)
 
+	ListDomainsWithContext(aws.Context, *swf.ListDomainsInput) (*swf.ListDomainsOutput, error)
+
 	ListOpenWorkflowExecutions(*swf.ListOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
 
+	ListOpenWorkflowExecutionsWithContext(aws.Context, *swf.ListOpenWorkflowExecutionsInput) (*swf.WorkflowExecutionInfos, error)
+
 	ListWorkflowTypes(*swf.ListWorkflowTypesInput) (*swf.ListWorkflowTypesOutput, error)
 
+	ListWorkflowTypesWithContext(aws.Context, *swf.ListWorkflowTypesInput) (*swf.ListWorkflowTypesOutput, error)
+
 	PollForActivityTask(*swf.PollForActivityTaskInput) (*swf.PollForActivityTaskOutput, error)
 
+	PollForActivityTaskWithContext(aws.Context, *swf.PollForActivityTaskInput) (*swf.PollForActivityTaskOutput, error)
+
 	PollForDecisionTask(*swf.PollForDecisionTaskInput) (*swf.PollForDecisionTaskOutput, error)
 
+	PollForDecisionTaskWithContext(aws.Context, *swf.PollForDecisionTaskInput) (*swf.PollForDecisionTaskOutput, error)
+
 	RecordActivityTaskHeartbeat(*swf.RecordActivityTaskHeartbeatInput) (*swf.RecordActivityTaskHeartbeatOutput, error)
 
+	RecordActivityTaskHeartbeatWithContext(aws.Context, *swf.RecordActivityTaskHeartbeatInput) (*swf.RecordActivityTaskHeartbeatOutput, error)
+
 	RegisterActivityType(*swf.RegisterActivityTypeInput) (*swf.RegisterActivityTypeOutput, error)
 
+	RegisterActivityTypeWithContext(aws.Context, *swf.RegisterActivityTypeInput) (*swf.RegisterActivityTypeOutput, error)
+
 	RegisterDomain(*swf.RegisterDomainInput) (*swf.RegisterDomainOutput, error)
 
+	RegisterDomainWithContext(aws.Context, *swf.RegisterDomainInput) (*swf.RegisterDomainOutput, error)
+
 	RegisterWorkflowType(*swf.RegisterWorkflowTypeInput) (*swf.RegisterWorkflowTypeOutput, error)
 
+	RegisterWorkflowTypeWithContext(aws.Context, *swf.RegisterWorkflowTypeInput) (*swf.RegisterWorkflowTypeOutput, error)
+
 	RequestCancelWorkflowExecution(*swf.RequestCancelWorkflowExecutionInput) (*swf.RequestCancelWorkflowExecutionOutput, error)
 
+	RequestCancelWorkflowExecutionWithContext(aws.Context, *swf.RequestCancelWorkflowExecutionInput) (*swf.RequestCancelWorkflowExecutionOutput, error)
+
 	RespondActivityTaskCanceled(*swf.RespondActivityTaskCanceledInput) (*swf.RespondActivityTaskCanceledOutput, error)
 
+	RespondActivityTaskCanceledWithContext(aws.Context, *swf.RespondActivityTaskCanceledInput) (*swf.RespondActivityTaskCanceledOutput, error)
+
 	RespondActivityTaskCompleted(*swf.RespondActivityTaskCompletedInput) (*swf.RespondActivityTaskCompletedOutput, error)
 
+	RespondActivityTaskCompletedWithContext(aws.Context, *swf.RespondActivityTaskCompletedInput) (*swf.RespondActivityTaskCompletedOutput, error)
+
 	RespondActivityTaskFailed(*swf.RespondActivityTaskFailedInput) (*swf.RespondActivityTaskFailedOutput, error)
 
+	RespondActivityTaskFailedWithContext(aws.Context, *swf.RespondActivityTaskFailedInput) (*swf.RespondActivityTaskFailedOutput, error)
+
 	RespondDecisionTaskCompleted(*swf.RespondDecisionTaskCompletedInput) (*swf.RespondDecisionTaskCompletedOutput, error)
 
+	RespondDecisionTaskCompletedWithContext(aws.Context, *swf.RespondDecisionTaskCompletedInput) (*swf.RespondDecisionTaskCompletedOutput, error)
+
 	SignalWorkflowExecution(*swf.SignalWorkflowExecutionInput) (*swf.SignalWorkflowExecutionOutput, error)
 
+	SignalWorkflowExecutionWithContext(aws.Context, *swf.SignalWorkflowExecutionInput) (*swf.SignalWorkflowExecutionOutput, error)
+
 	StartWorkflowExecution(*swf.StartWorkflowExecutionInput) (*swf.StartWorkflowExecutionOutput, error)
 
+	StartWorkflowExecutionWithContext(aws.Context, *swf.StartWorkflowExecutionInput) (*swf.StartWorkflowExecutionOutput, error)
+
 	TerminateWorkflowExecution(*swf.TerminateWorkflowExecutionInput) (*swf.TerminateWorkflowExecutionOutput, error)
+
+	TerminateWorkflowExecutionWithContext(aws.Context, *swf.TerminateWorkflowExecutionInput) (*swf.TerminateWorkflowExecutionOutput, error)
 }
@@ -5,6 +5,7 @@ package workspaces
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
 const opCreateWorkspaces = "CreateWorkspaces"
@@ -36,6 +37,16 @@ func (c *WorkSpaces) CreateWorkspaces(input *CreateWorkspacesInput) (*CreateWork
 	return out, err
 }
 
+// CreateWorkspacesWithContext is the same as CreateWorkspaces with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *WorkSpaces) CreateWorkspacesWithContext(ctx aws.Context, input *CreateWorkspacesInput) (*CreateWorkspacesOutput, error) {
+	req, out := c.CreateWorkspacesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opDescribeWorkspaceBundles = "DescribeWorkspaceBundles"
 
 // DescribeWorkspaceBundlesRequest generates a request for the DescribeWorkspaceBundles operation.
@@ -78,6 +89,16 @@ func (c *WorkSpaces) DescribeWorkspaceBundles(input *DescribeWorkspaceBundlesInp
 	return out, err
 }
 
+// DescribeWorkspaceBundlesWithContext is the same as DescribeWorkspaceBundles with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *WorkSpaces) DescribeWorkspaceBundlesWithContext(ctx aws.Context, input *DescribeWorkspaceBundlesInput) (*DescribeWorkspaceBundlesOutput, error) {
+	req, out := c.DescribeWorkspaceBundlesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *WorkSpaces) DescribeWorkspaceBundlesPages(input *DescribeWorkspaceBundlesInput, fn func(p *DescribeWorkspaceBundlesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeWorkspaceBundlesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -125,6 +146,16 @@ func (c *WorkSpaces) DescribeWorkspaceDirectories(input *DescribeWorkspaceDirect
 	return out, err
 }
 
+// DescribeWorkspaceDirectoriesWithContext is the same as DescribeWorkspaceDirectories with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *WorkSpaces) DescribeWorkspaceDirectoriesWithContext(ctx aws.Context, input *DescribeWorkspaceDirectoriesInput) (*DescribeWorkspaceDirectoriesOutput, error) {
+	req, out := c.DescribeWorkspaceDirectoriesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *WorkSpaces) DescribeWorkspaceDirectoriesPages(input *DescribeWorkspaceDirectoriesInput, fn func(p *DescribeWorkspaceDirectoriesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeWorkspaceDirectoriesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -173,6 +204,16 @@ func (c *WorkSpaces) DescribeWorkspaces(input *DescribeWorkspacesInput) (*Descri
 	return out, err
 }
 
+// DescribeWorkspacesWithContext is the same as DescribeWorkspaces with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *WorkSpaces) DescribeWorkspacesWithContext(ctx aws.Context, input *DescribeWorkspacesInput) (*DescribeWorkspacesOutput, error) {
+	req, out := c.DescribeWorkspacesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 func (c *WorkSpaces) DescribeWorkspacesPages(input *DescribeWorkspacesInput, fn func(p *DescribeWorkspacesOutput, lastPage bool) (shouldContinue bool)) error {
 	page, _ := c.DescribeWorkspacesRequest(input)
 	return page.EachPage(func(p interface{}, lastPage bool) bool {
@@ -213,6 +254,16 @@ func (c *WorkSpaces) RebootWorkspaces(input *RebootWorkspacesInput) (*RebootWork
 	return out, err
 }
 
+// RebootWorkspacesWithContext is the same as RebootWorkspaces with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *WorkSpaces) RebootWorkspacesWithContext(ctx aws.Context, input *RebootWorkspacesInput) (*RebootWorkspacesOutput, error) {
+	req, out := c.RebootWorkspacesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opRebuildWorkspaces = "RebuildWorkspaces"
 
 // RebuildWorkspacesRequest generates a request for the RebuildWorkspaces operation.
@@ -255,6 +306,16 @@ func (c *WorkSpaces) RebuildWorkspaces(input *RebuildWorkspacesInput) (*RebuildW
 	return out, err
 }
 
+// RebuildWorkspacesWithContext is the same as RebuildWorkspaces with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *WorkSpaces) RebuildWorkspacesWithContext(ctx aws.Context, input *RebuildWorkspacesInput) (*RebuildWorkspacesOutput, error) {
+	req, out := c.RebuildWorkspacesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 const opTerminateWorkspaces = "TerminateWorkspaces"
 
 // TerminateWorkspacesRequest generates a request for the TerminateWorkspaces operation.
@@ -291,10 +352,22 @@ func (c *WorkSpaces) TerminateWorkspaces(input *TerminateWorkspacesInput) (*Term
 	return out, err
 }
 
+// TerminateWorkspacesWithContext is the same as TerminateWorkspaces with the addition of
+// the ability to pass a context. The request is canceled, including any
+// in-flight HTTP request and retry delay, once the context is done.
+func (c *WorkSpaces) TerminateWorkspacesWithContext(ctx aws.Context, input *TerminateWorkspacesInput) (*TerminateWorkspacesOutput, error) {
+	req, out := c.TerminateWorkspacesRequest(input)
+	req.SetContext(ctx)
+	err := req.Send()
+	return out, err
+}
+
 // Contains information about the compute type of a WorkSpace bundle.
 type ComputeType struct {
 	// The name of the compute type for the bundle.
-	Name *string `type:"string"`
+	//
+	// See the Compute* constants for valid values.
+	Name *string `type:"string" enum:"VALUE,STANDARD,PERFORMANCE"`
 
 	metadataComputeType `json:"-" xml:"-"`
 }
@@ -303,10 +376,20 @@ type metadataComputeType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s ComputeType) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s ComputeType) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the CreateWorkspaces operation.
 type CreateWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to create.
-	Workspaces []*WorkspaceRequest `type:"list" required:"true"`
+	Workspaces []*WorkspaceRequest `type:"list" min:"1" max:"25" required:"true"`
 
 	metadataCreateWorkspacesInput `json:"-" xml:"-"`
 }
@@ -315,6 +398,16 @@ type metadataCreateWorkspacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateWorkspacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateWorkspacesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the result of the CreateWorkspaces operation.
 type CreateWorkspacesOutput struct {
 	// An array of structures that represent the WorkSpaces that could not be created.
@@ -334,11 +427,21 @@ type metadataCreateWorkspacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s CreateWorkspacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s CreateWorkspacesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains default WorkSpace creation information.
 type DefaultWorkspaceCreationProperties struct {
 	// The identifier of any custom security groups that are applied to the WorkSpaces
 	// when they are created.
-	CustomSecurityGroupID *string `locationName:"CustomSecurityGroupId" type:"string"`
+	CustomSecurityGroupID *string `locationName:"CustomSecurityGroupId" type:"string" pattern:"^(sg-[0-9a-f]{8})$"`
 
 	// The organizational unit (OU) in the directory that the WorkSpace machine
 	// accounts are placed in.
@@ -361,15 +464,25 @@ type metadataDefaultWorkspaceCreationProperties struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DefaultWorkspaceCreationProperties) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DefaultWorkspaceCreationProperties) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DescribeWorkspaceBundles operation.
 type DescribeWorkspaceBundlesInput struct {
 	// An array of strings that contains the identifiers of the bundles to retrieve.
 	// This parameter cannot be combined with any other filter parameter.
-	BundleIDs []*string `locationName:"BundleIds" type:"list"`
+	BundleIDs []*string `locationName:"BundleIds" type:"list" min:"1" max:"25"`
 
 	// The NextToken value from a previous call to this operation. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	// The owner of the bundles to retrieve. This parameter cannot be combined with
 	// any other filter parameter.
@@ -387,6 +500,16 @@ type metadataDescribeWorkspaceBundlesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkspaceBundlesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkspaceBundlesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DescribeWorkspaceBundles operation.
 type DescribeWorkspaceBundlesOutput struct {
 	// An array of structures that contain information about the bundles.
@@ -395,7 +518,7 @@ type DescribeWorkspaceBundlesOutput struct {
 	// If not null, more results are available. Pass this value for the NextToken
 	// parameter in a subsequent call to this operation to retrieve the next set
 	// of items. This token is valid for one day and must be used within that timeframe.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	metadataDescribeWorkspaceBundlesOutput `json:"-" xml:"-"`
 }
@@ -404,15 +527,25 @@ type metadataDescribeWorkspaceBundlesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkspaceBundlesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkspaceBundlesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DescribeWorkspaceDirectories operation.
 type DescribeWorkspaceDirectoriesInput struct {
 	// An array of strings that contains the directory identifiers to retrieve information
 	// for. If this member is null, all directories are retrieved.
-	DirectoryIDs []*string `locationName:"DirectoryIds" type:"list"`
+	DirectoryIDs []*string `locationName:"DirectoryIds" type:"list" min:"1" max:"25"`
 
 	// The NextToken value from a previous call to this operation. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	metadataDescribeWorkspaceDirectoriesInput `json:"-" xml:"-"`
 }
@@ -421,6 +554,16 @@ type metadataDescribeWorkspaceDirectoriesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkspaceDirectoriesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkspaceDirectoriesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the DescribeWorkspaceDirectories operation.
 type DescribeWorkspaceDirectoriesOutput struct {
 	// An array of structures that contain information about the directories.
@@ -429,7 +572,7 @@ type DescribeWorkspaceDirectoriesOutput struct {
 	// If not null, more results are available. Pass this value for the NextToken
 	// parameter in a subsequent call to this operation to retrieve the next set
 	// of items. This token is valid for one day and must be used within that timeframe.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	metadataDescribeWorkspaceDirectoriesOutput `json:"-" xml:"-"`
 }
@@ -438,28 +581,38 @@ type metadataDescribeWorkspaceDirectoriesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkspaceDirectoriesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkspaceDirectoriesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the DescribeWorkspaces operation.
 type DescribeWorkspacesInput struct {
 	// The identifier of a bundle to obtain the WorkSpaces for. All WorkSpaces that
 	// are created from this bundle will be retrieved. This parameter cannot be
 	// combined with any other filter parameter.
-	BundleID *string `locationName:"BundleId" type:"string"`
+	BundleID *string `locationName:"BundleId" type:"string" pattern:"^wsb-[0-9a-z]{8,63}$"`
 
 	// Specifies the directory identifier to which to limit the WorkSpaces. Optionally,
 	// you can specify a specific directory user with the UserName parameter. This
 	// parameter cannot be combined with any other filter parameter.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{8,63}$"`
 
 	// The maximum number of items to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"25"`
 
 	// The NextToken value from a previous call to this operation. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	// Used with the DirectoryId parameter to specify the directory user for which
 	// to obtain the WorkSpace.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"63"`
 
 	// An array of strings that contain the identifiers of the WorkSpaces for which
 	// to retrieve information. This parameter cannot be combined with any other
@@ -468,7 +621,7 @@ type DescribeWorkspacesInput struct {
 	// Because the CreateWorkspaces operation is asynchronous, the identifier returned
 	// by CreateWorkspaces is not immediately available. If you immediately call
 	// DescribeWorkspaces with this identifier, no information will be returned.
-	WorkspaceIDs []*string `locationName:"WorkspaceIds" type:"list"`
+	WorkspaceIDs []*string `locationName:"WorkspaceIds" type:"list" min:"1" max:"25"`
 
 	metadataDescribeWorkspacesInput `json:"-" xml:"-"`
 }
@@ -477,12 +630,22 @@ type metadataDescribeWorkspacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkspacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkspacesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results for the DescribeWorkspaces operation.
 type DescribeWorkspacesOutput struct {
 	// If not null, more results are available. Pass this value for the NextToken
 	// parameter in a subsequent call to this operation to retrieve the next set
 	// of items. This token is valid for one day and must be used within that timeframe.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"63"`
 
 	// An array of structures that contain the information about the WorkSpaces.
 	//
@@ -497,6 +660,16 @@ type metadataDescribeWorkspacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s DescribeWorkspacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s DescribeWorkspacesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about a WorkSpace that could not be created.
 type FailedCreateWorkspaceRequest struct {
 	// The error code.
@@ -516,6 +689,16 @@ type metadataFailedCreateWorkspaceRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FailedCreateWorkspaceRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FailedCreateWorkspaceRequest) GoString() string {
+	return s.String()
+}
+
 // Contains information about a WorkSpace that could not be rebooted (RebootWorkspaces),
 // rebuilt (RebuildWorkspaces), or terminated (TerminateWorkspaces).
 type FailedWorkspaceChangeRequest struct {
@@ -526,7 +709,7 @@ type FailedWorkspaceChangeRequest struct {
 	ErrorMessage *string `type:"string"`
 
 	// The identifier of the WorkSpace.
-	WorkspaceID *string `locationName:"WorkspaceId" type:"string"`
+	WorkspaceID *string `locationName:"WorkspaceId" type:"string" pattern:"^ws-[0-9a-z]{8,63}$"`
 
 	metadataFailedWorkspaceChangeRequest `json:"-" xml:"-"`
 }
@@ -535,11 +718,21 @@ type metadataFailedWorkspaceChangeRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s FailedWorkspaceChangeRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s FailedWorkspaceChangeRequest) GoString() string {
+	return s.String()
+}
+
 // Contains information used with the RebootWorkspaces operation to reboot a
 // WorkSpace.
 type RebootRequest struct {
 	// The identifier of the WorkSpace to reboot.
-	WorkspaceID *string `locationName:"WorkspaceId" type:"string" required:"true"`
+	WorkspaceID *string `locationName:"WorkspaceId" type:"string" pattern:"^ws-[0-9a-z]{8,63}$" required:"true"`
 
 	metadataRebootRequest `json:"-" xml:"-"`
 }
@@ -548,10 +741,20 @@ type metadataRebootRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootRequest) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the RebootWorkspaces operation.
 type RebootWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to reboot.
-	RebootWorkspaceRequests []*RebootRequest `type:"list" required:"true"`
+	RebootWorkspaceRequests []*RebootRequest `type:"list" min:"1" max:"25" required:"true"`
 
 	metadataRebootWorkspacesInput `json:"-" xml:"-"`
 }
@@ -560,6 +763,16 @@ type metadataRebootWorkspacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootWorkspacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootWorkspacesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the RebootWorkspaces operation.
 type RebootWorkspacesOutput struct {
 	// An array of structures that represent any WorkSpaces that could not be rebooted.
@@ -572,11 +785,21 @@ type metadataRebootWorkspacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebootWorkspacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebootWorkspacesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information used with the RebuildWorkspaces operation to rebuild
 // a WorkSpace.
 type RebuildRequest struct {
 	// The identifier of the WorkSpace to rebuild.
-	WorkspaceID *string `locationName:"WorkspaceId" type:"string" required:"true"`
+	WorkspaceID *string `locationName:"WorkspaceId" type:"string" pattern:"^ws-[0-9a-z]{8,63}$" required:"true"`
 
 	metadataRebuildRequest `json:"-" xml:"-"`
 }
@@ -585,10 +808,20 @@ type metadataRebuildRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebuildRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebuildRequest) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the RebuildWorkspaces operation.
 type RebuildWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to rebuild.
-	RebuildWorkspaceRequests []*RebuildRequest `type:"list" required:"true"`
+	RebuildWorkspaceRequests []*RebuildRequest `type:"list" min:"1" max:"1" required:"true"`
 
 	metadataRebuildWorkspacesInput `json:"-" xml:"-"`
 }
@@ -597,6 +830,16 @@ type metadataRebuildWorkspacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebuildWorkspacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebuildWorkspacesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the RebuildWorkspaces operation.
 type RebuildWorkspacesOutput struct {
 	// An array of structures that represent any WorkSpaces that could not be rebuilt.
@@ -609,11 +852,21 @@ type metadataRebuildWorkspacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s RebuildWorkspacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s RebuildWorkspacesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information used with the TerminateWorkspaces operation to terminate
 // a WorkSpace.
 type TerminateRequest struct {
 	// The identifier of the WorkSpace to terminate.
-	WorkspaceID *string `locationName:"WorkspaceId" type:"string" required:"true"`
+	WorkspaceID *string `locationName:"WorkspaceId" type:"string" pattern:"^ws-[0-9a-z]{8,63}$" required:"true"`
 
 	metadataTerminateRequest `json:"-" xml:"-"`
 }
@@ -622,10 +875,20 @@ type metadataTerminateRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateRequest) GoString() string {
+	return s.String()
+}
+
 // Contains the inputs for the TerminateWorkspaces operation.
 type TerminateWorkspacesInput struct {
 	// An array of structures that specify the WorkSpaces to terminate.
-	TerminateWorkspaceRequests []*TerminateRequest `type:"list" required:"true"`
+	TerminateWorkspaceRequests []*TerminateRequest `type:"list" min:"1" max:"25" required:"true"`
 
 	metadataTerminateWorkspacesInput `json:"-" xml:"-"`
 }
@@ -634,6 +897,16 @@ type metadataTerminateWorkspacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateWorkspacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateWorkspacesInput) GoString() string {
+	return s.String()
+}
+
 // Contains the results of the TerminateWorkspaces operation.
 type TerminateWorkspacesOutput struct {
 	// An array of structures that represent any WorkSpaces that could not be terminated.
@@ -646,10 +919,20 @@ type metadataTerminateWorkspacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s TerminateWorkspacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s TerminateWorkspacesOutput) GoString() string {
+	return s.String()
+}
+
 // Contains information about the user storage for a WorkSpace bundle.
 type UserStorage struct {
 	// The amount of user storage for the bundle.
-	Capacity *string `type:"string"`
+	Capacity *string `type:"string" min:"1"`
 
 	metadataUserStorage `json:"-" xml:"-"`
 }
@@ -658,14 +941,24 @@ type metadataUserStorage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s UserStorage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s UserStorage) GoString() string {
+	return s.String()
+}
+
 // Contains information about a WorkSpace.
 type Workspace struct {
 	// The identifier of the bundle that the WorkSpace was created from.
-	BundleID *string `locationName:"BundleId" type:"string"`
+	BundleID *string `locationName:"BundleId" type:"string" pattern:"^wsb-[0-9a-z]{8,63}$"`
 
 	// The identifier of the AWS Directory Service directory that the WorkSpace
 	// belongs to.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{8,63}$"`
 
 	// If the WorkSpace could not be created, this contains the error code.
 	ErrorCode *string `type:"string"`
@@ -678,16 +971,18 @@ type Workspace struct {
 	IPAddress *string `locationName:"IpAddress" type:"string"`
 
 	// The operational state of the WorkSpace.
-	State *string `type:"string"`
+	//
+	// See the Tate* constants for valid values.
+	State *string `type:"string" enum:"PENDING,AVAILABLE,IMPAIRED,UNHEALTHY,REBOOTING,REBUILDING,TERMINATING,TERMINATED,SUSPENDED,ERROR"`
 
 	// The identifier of the subnet that the WorkSpace is in.
-	SubnetID *string `locationName:"SubnetId" type:"string"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"^(subnet-[0-9a-f]{8})$"`
 
 	// The user that the WorkSpace is assigned to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"63"`
 
 	// The identifier of the WorkSpace.
-	WorkspaceID *string `locationName:"WorkspaceId" type:"string"`
+	WorkspaceID *string `locationName:"WorkspaceId" type:"string" pattern:"^ws-[0-9a-z]{8,63}$"`
 
 	metadataWorkspace `json:"-" xml:"-"`
 }
@@ -696,10 +991,20 @@ type metadataWorkspace struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s Workspace) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s Workspace) GoString() string {
+	return s.String()
+}
+
 // Contains information about a WorkSpace bundle.
 type WorkspaceBundle struct {
 	// The bundle identifier.
-	BundleID *string `locationName:"BundleId" type:"string"`
+	BundleID *string `locationName:"BundleId" type:"string" pattern:"^wsb-[0-9a-z]{8,63}$"`
 
 	// A ComputeType object that specifies the compute type for the bundle.
 	ComputeType *ComputeType `type:"structure"`
@@ -708,7 +1013,7 @@ type WorkspaceBundle struct {
 	Description *string `type:"string"`
 
 	// The name of the bundle.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1"`
 
 	// The owner of the bundle. This contains the owner's account identifier, or
 	// AMAZON if the bundle is provided by AWS.
@@ -725,6 +1030,16 @@ type metadataWorkspaceBundle struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkspaceBundle) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkspaceBundle) GoString() string {
+	return s.String()
+}
+
 // Contains information about an AWS Directory Service directory for use with
 // Amazon WorkSpaces.
 type WorkspaceDirectory struct {
@@ -732,31 +1047,35 @@ type WorkspaceDirectory struct {
 	Alias *string `type:"string"`
 
 	// The user name for the service account.
-	CustomerUserName *string `type:"string"`
+	CustomerUserName *string `type:"string" min:"1" max:"63"`
 
 	// An array of strings that contains the IP addresses of the DNS servers for
 	// the directory.
 	DNSIPAddresses []*string `locationName:"DnsIpAddresses" type:"list"`
 
 	// The directory identifier.
-	DirectoryID *string `locationName:"DirectoryId" type:"string"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{8,63}$"`
 
 	// The name of the directory.
 	DirectoryName *string `type:"string"`
 
 	// The directory type.
-	DirectoryType *string `type:"string"`
+	//
+	// See the WorkspaceDirectoryType* constants for valid values.
+	DirectoryType *string `type:"string" enum:"SIMPLE_AD,AD_CONNECTOR"`
 
 	// The identifier of the IAM role. This is the role that allows Amazon WorkSpaces
 	// to make calls to other services, such as Amazon EC2, on your behalf.
-	IAMRoleID *string `locationName:"IamRoleId" type:"string"`
+	IAMRoleID *string `locationName:"IamRoleId" type:"string" pattern:"^arn:aws:[A-Za-z0-9][A-za-z0-9_/.-]{0,62}:[A-za-z0-9_/.-]{0,63}:[A-za-z0-9_/.-]{0,63}:[A-Za-z0-9][A-za-z0-9_/.-]{0,127}$"`
 
 	// The registration code for the directory. This is the code that users enter
 	// in their Amazon WorkSpaces client application to connect to the directory.
-	RegistrationCode *string `type:"string"`
+	RegistrationCode *string `type:"string" min:"1" max:"20"`
 
 	// The state of the directory's registration with Amazon WorkSpaces
-	State *string `type:"string"`
+	//
+	// See the WorkspaceDirectoryState* constants for valid values.
+	State *string `type:"string" enum:"REGISTERING,REGISTERED,DEREGISTERING,DEREGISTERED,ERROR"`
 
 	// An array of strings that contains the identifiers of the subnets used with
 	// the directory.
@@ -767,7 +1086,7 @@ type WorkspaceDirectory struct {
 	WorkspaceCreationProperties *DefaultWorkspaceCreationProperties `type:"structure"`
 
 	// The identifier of the security group that is assigned to new WorkSpaces.
-	WorkspaceSecurityGroupID *string `locationName:"WorkspaceSecurityGroupId" type:"string"`
+	WorkspaceSecurityGroupID *string `locationName:"WorkspaceSecurityGroupId" type:"string" pattern:"^(sg-[0-9a-f]{8})$"`
 
 	metadataWorkspaceDirectory `json:"-" xml:"-"`
 }
@@ -776,21 +1095,31 @@ type metadataWorkspaceDirectory struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation
+func (s WorkspaceDirectory) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkspaceDirectory) GoString() string {
+	return s.String()
+}
+
 // Contains information about a WorkSpace creation request.
 type WorkspaceRequest struct {
 	// The identifier of the bundle to create the WorkSpace from. You can use the
 	// DescribeWorkspaceBundles operation to obtain a list of the bundles that are
 	// available.
-	BundleID *string `locationName:"BundleId" type:"string" required:"true"`
+	BundleID *string `locationName:"BundleId" type:"string" pattern:"^wsb-[0-9a-z]{8,63}$" required:"true"`
 
 	// The identifier of the AWS Directory Service directory to create the WorkSpace
 	// in. You can use the DescribeWorkspaceDirectories operation to obtain a list
 	// of the directories that are available.
-	DirectoryID *string `locationName:"DirectoryId" type:"string" required:"true"`
+	DirectoryID *string `locationName:"DirectoryId" type:"string" pattern:"^d-[0-9a-f]{8,63}$" required:"true"`
 
 	// The username that the WorkSpace is assigned to. This username must exist
 	// in the AWS Directory Service directory specified by the DirectoryId member.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"63" required:"true"`
 
 	metadataWorkspaceRequest `json:"-" xml:"-"`
 }
@@ -798,3 +1127,65 @@ type WorkspaceRequest struct {
 type metadataWorkspaceRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
+
+// String returns the string representation
+func (s WorkspaceRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation
+func (s WorkspaceRequest) GoString() string {
+	return s.String()
+}
+
+const (
+	// @enum Compute
+	ComputeValue = "VALUE"
+	// @enum Compute
+	ComputeStandard = "STANDARD"
+	// @enum Compute
+	ComputePerformance = "PERFORMANCE"
+)
+
+const (
+	// @enum WorkspaceDirectoryState
+	WorkspaceDirectoryStateRegistering = "REGISTERING"
+	// @enum WorkspaceDirectoryState
+	WorkspaceDirectoryStateRegistered = "REGISTERED"
+	// @enum WorkspaceDirectoryState
+	WorkspaceDirectoryStateDeregistering = "DEREGISTERING"
+	// @enum WorkspaceDirectoryState
+	WorkspaceDirectoryStateDeregistered = "DEREGISTERED"
+	// @enum WorkspaceDirectoryState
+	WorkspaceDirectoryStateError = "ERROR"
+)
+
+const (
+	// @enum WorkspaceDirectoryType
+	WorkspaceDirectoryTypeSimpleAd = "SIMPLE_AD"
+	// @enum WorkspaceDirectoryType
+	WorkspaceDirectoryTypeAdConnector = "AD_CONNECTOR"
+)
+
+const (
+	// @enum tate
+	TatePending = "PENDING"
+	// @enum tate
+	TateAvailable = "AVAILABLE"
+	// @enum tate
+	TateImpaired = "IMPAIRED"
+	// @enum tate
+	TateUnhealthy = "UNHEALTHY"
+	// @enum tate
+	TateRebooting = "REBOOTING"
+	// @enum tate
+	TateRebuilding = "REBUILDING"
+	// @enum tate
+	TateTerminating = "TERMINATING"
+	// @enum tate
+	TateTerminated = "TERMINATED"
+	// @enum tate
+	TateSuspended = "SUSPENDED"
+	// @enum tate
+	TateError = "ERROR"
+)
@@ -4,6 +4,7 @@
 package workspacesiface
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
 )
 
@@ -11,15 +12,29 @@ import (
 type WorkSpacesAPI interface {
 	CreateWorkspaces(*workspaces.CreateWorkspacesInput) (*workspaces.CreateWorkspacesOutput, error)
 
+	CreateWorkspacesWithContext(aws.Context, *workspaces.CreateWorkspacesInput) (*workspaces.CreateWorkspacesOutput, error)
+
 	DescribeWorkspaceBundles(*workspaces.DescribeWorkspaceBundlesInput) (*workspaces.DescribeWorkspaceBundlesOutput, error)
 
+	DescribeWorkspaceBundlesWithContext(aws.Context, *workspaces.DescribeWorkspaceBundlesInput) (*workspaces.DescribeWorkspaceBundlesOutput, error)
+
 	DescribeWorkspaceDirectories(*workspaces.DescribeWorkspaceDirectoriesInput) (*workspaces.DescribeWorkspaceDirectoriesOutput, error)
 
+	DescribeWorkspaceDirectoriesWithContext(aws.Context, *workspaces.DescribeWorkspaceDirectoriesInput) (*workspaces.DescribeWorkspaceDirectoriesOutput, error)
+
 	DescribeWorkspaces(*workspaces.DescribeWorkspacesInput) (*workspaces.DescribeWorkspacesOutput, error)
 
+	DescribeWorkspacesWithContext(aws.Context, *workspaces.DescribeWorkspacesInput) (*workspaces.DescribeWorkspacesOutput, error)
+
 	RebootWorkspaces(*workspaces.RebootWorkspacesInput) (*workspaces.RebootWorkspacesOutput, error)
 
+	RebootWorkspacesWithContext(aws.Context, *workspaces.RebootWorkspacesInput) (*workspaces.RebootWorkspacesOutput, error)
+
 	RebuildWorkspaces(*workspaces.RebuildWorkspacesInput) (*workspaces.RebuildWorkspacesOutput, error)
 
+	RebuildWorkspacesWithContext(aws.Context, *workspaces.RebuildWorkspacesInput) (*workspaces.RebuildWorkspacesOutput, error)
+
 	TerminateWorkspaces(*workspaces.TerminateWorkspacesInput) (*workspaces.TerminateWorkspacesOutput, error)
+
+	TerminateWorkspacesWithContext(aws.Context, *workspaces.TerminateWorkspacesInput) (*workspaces.TerminateWorkspacesOutput, error)
 }